@@ -0,0 +1,76 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// OrgAccess represents the effective access of the member to the org.
+type OrgAccess struct {
+	OrgID    string
+	OrgName  string
+	MemberID string
+	Email    string
+	Role     string
+}
+
+func (svc service) ListOrgAccess(ctx context.Context, token string) ([]OrgAccess, error) {
+	if err := svc.isAdmin(ctx, token); err != nil {
+		return []OrgAccess{}, err
+	}
+
+	orgs, err := svc.orgs.RetrieveAll(ctx)
+	if err != nil {
+		return []OrgAccess{}, err
+	}
+
+	oms, err := svc.members.RetrieveAll(ctx)
+	if err != nil {
+		return []OrgAccess{}, err
+	}
+
+	if len(oms) == 0 {
+		return []OrgAccess{}, nil
+	}
+
+	names := make(map[string]string, len(orgs))
+	for _, o := range orgs {
+		names[o.ID] = o.Name
+	}
+
+	var memberIDs []string
+	seen := make(map[string]bool)
+	for _, om := range oms {
+		if !seen[om.MemberID] {
+			seen[om.MemberID] = true
+			memberIDs = append(memberIDs, om.MemberID)
+		}
+	}
+
+	up, err := svc.users.GetUsersByIDs(ctx, &protomfx.UsersByIDsReq{Ids: memberIDs})
+	if err != nil {
+		return []OrgAccess{}, err
+	}
+
+	emails := make(map[string]string)
+	for _, user := range up.GetUsers() {
+		emails[user.GetId()] = user.GetEmail()
+	}
+
+	oas := []OrgAccess{}
+	for _, om := range oms {
+		oas = append(oas, OrgAccess{
+			OrgID:    om.OrgID,
+			OrgName:  names[om.OrgID],
+			MemberID: om.MemberID,
+			Email:    emails[om.MemberID],
+			Role:     om.Role,
+		})
+	}
+
+	return oas, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// KeyActivity represents the API usage of the key. Each request identified
+// or authorized by the platform services is counted as a call, and the
+// rejected ones are counted as errors. The login and recovery keys have no
+// ID, so their usage is aggregated per user and key type.
+type KeyActivity struct {
+	KeyID    string
+	KeyType  uint32
+	UserID   string
+	Email    string
+	Calls    uint64
+	Errors   uint64
+	LastUsed time.Time
+}
+
+// ActivityPage contains the API usage of the keys issued by the page of
+// the org members.
+type ActivityPage struct {
+	Total  uint64
+	Offset uint64
+	Limit  uint64
+	Keys   []KeyActivity
+}
+
+// ActivityRepository specifies the key activity aggregation API.
+type ActivityRepository interface {
+	// Record records the API call made using the key. The failed calls
+	// are counted as errors as well.
+	Record(ctx context.Context, key Key, failed bool, at time.Time) error
+
+	// RetrieveByUsers retrieves the activity of the keys issued by the users.
+	RetrieveByUsers(ctx context.Context, userIDs []string) ([]KeyActivity, error)
+}
+
+// Activity specifies an API used to review the API usage within the org,
+// so that the leaked tokens and misbehaving integrations can be spotted.
+type Activity interface {
+	// ListActivityByOrg retrieves the API usage of the keys issued by the
+	// org members, paginated by the members. The most recently used keys
+	// are listed first. Only accessible by the org admins.
+	ListActivityByOrg(ctx context.Context, token, orgID string, pm PageMetadata) (ActivityPage, error)
+}
+
+func (svc service) ListActivityByOrg(ctx context.Context, token, orgID string, pm PageMetadata) (ActivityPage, error) {
+	if err := svc.canAccessOrg(ctx, token, orgID, Admin); err != nil {
+		return ActivityPage{}, err
+	}
+
+	mp, err := svc.members.RetrieveByOrgID(ctx, orgID, pm)
+	if err != nil {
+		return ActivityPage{}, err
+	}
+
+	ids := make([]string, 0, len(mp.OrgMembers))
+	for _, m := range mp.OrgMembers {
+		ids = append(ids, m.MemberID)
+	}
+
+	kas, err := svc.activity.RetrieveByUsers(ctx, ids)
+	if err != nil {
+		return ActivityPage{}, err
+	}
+
+	sort.SliceStable(kas, func(i, j int) bool {
+		return kas[i].LastUsed.After(kas[j].LastUsed)
+	})
+
+	return ActivityPage{
+		Total:  mp.Total,
+		Offset: mp.Offset,
+		Limit:  mp.Limit,
+		Keys:   kas,
+	}, nil
+}
+
+// recordActivity records the API call made using the token. The calls made
+// using the malformed tokens can't be attributed to the key, so they are
+// ignored. Recording is best effort and never fails the call.
+func (svc service) recordActivity(ctx context.Context, token string, err error) {
+	key, perr := svc.tokenizer.Parse(token)
+	if perr != nil && perr != ErrAPIKeyExpired {
+		return
+	}
+
+	if key.IssuerID == "" {
+		return
+	}
+
+	svc.activity.Record(ctx, key, err != nil, time.Now())
+}
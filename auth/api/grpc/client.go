@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/go-kit/kit/endpoint"
 	kitot "github.com/go-kit/kit/tracing/opentracing"
@@ -14,6 +15,8 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	opentracing "github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -23,12 +26,21 @@ const (
 var _ protomfx.AuthServiceClient = (*grpcClient)(nil)
 
 type grpcClient struct {
-	issue        endpoint.Endpoint
-	identify     endpoint.Endpoint
-	authorize    endpoint.Endpoint
-	retrieveRole endpoint.Endpoint
-	assignRole   endpoint.Endpoint
-	timeout      time.Duration
+	issue          endpoint.Endpoint
+	identify       endpoint.Endpoint
+	identifyBatch  endpoint.Endpoint
+	identifyDeleg  endpoint.Endpoint
+	authorize      endpoint.Endpoint
+	authorizeBatch endpoint.Endpoint
+	retrieveRole   endpoint.Endpoint
+	assignRole     endpoint.Endpoint
+	assignMembers  endpoint.Endpoint
+	signPayload    endpoint.Endpoint
+	retrieveSigKey endpoint.Endpoint
+	checkQuota     endpoint.Endpoint
+	removeUser     endpoint.Endpoint
+	filterMembers  endpoint.Endpoint
+	timeout        time.Duration
 }
 
 // NewClient returns new gRPC client instance.
@@ -50,6 +62,22 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeIdentifyResponse,
 			protomfx.UserIdentity{},
 		).Endpoint()),
+		identifyBatch: kitot.TraceClient(tracer, "identify_batch")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"IdentifyBatch",
+			encodeIdentifyBatchRequest,
+			decodeIdentifyBatchResponse,
+			protomfx.UserIdentitiesRes{},
+		).Endpoint()),
+		identifyDeleg: kitot.TraceClient(tracer, "identify_delegated")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"IdentifyDelegated",
+			encodeIdentifyRequest,
+			decodeIdentifyDelegatedResponse,
+			protomfx.DelegatedIdentity{},
+		).Endpoint()),
 		authorize: kitot.TraceClient(tracer, "authorize")(kitgrpc.NewClient(
 			conn,
 			svcName,
@@ -58,6 +86,14 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeEmptyResponse,
 			empty.Empty{},
 		).Endpoint()),
+		authorizeBatch: kitot.TraceClient(tracer, "authorize_batch")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"AuthorizeBatch",
+			encodeAuthorizeBatchRequest,
+			decodeEmptyResponse,
+			empty.Empty{},
+		).Endpoint()),
 		retrieveRole: kitot.TraceClient(tracer, "retrieve_role")(kitgrpc.NewClient(
 			conn,
 			svcName,
@@ -74,6 +110,54 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeEmptyResponse,
 			empty.Empty{},
 		).Endpoint()),
+		assignMembers: kitot.TraceClient(tracer, "assign_members")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"AssignMembers",
+			encodeAssignMembersRequest,
+			decodeEmptyResponse,
+			empty.Empty{},
+		).Endpoint()),
+		signPayload: kitot.TraceClient(tracer, "sign_payload")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"SignPayload",
+			encodeSignPayloadRequest,
+			decodeSignPayloadResponse,
+			protomfx.SignPayloadRes{},
+		).Endpoint()),
+		retrieveSigKey: kitot.TraceClient(tracer, "retrieve_signing_key")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RetrieveSigningKey",
+			encodeRetrieveSigningKeyRequest,
+			decodeRetrieveSigningKeyResponse,
+			protomfx.SigningKeyRes{},
+		).Endpoint()),
+		checkQuota: kitot.TraceClient(tracer, "check_quota")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"CheckQuota",
+			encodeCheckQuotaRequest,
+			decodeEmptyResponse,
+			empty.Empty{},
+		).Endpoint()),
+		removeUser: kitot.TraceClient(tracer, "remove_user")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RemoveUser",
+			encodeRemoveUserRequest,
+			decodeEmptyResponse,
+			empty.Empty{},
+		).Endpoint()),
+		filterMembers: kitot.TraceClient(tracer, "filter_org_members")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"FilterOrgMembers",
+			encodeFilterOrgMembersRequest,
+			decodeFilterOrgMembersResponse,
+			protomfx.OrgMembersRes{},
+		).Endpoint()),
 
 		timeout: timeout,
 	}
@@ -125,6 +209,58 @@ func decodeIdentifyResponse(_ context.Context, grpcRes interface{}) (interface{}
 	return identityRes{id: res.GetId(), email: res.GetEmail()}, nil
 }
 
+func (client grpcClient) IdentifyBatch(ctx context.Context, req *protomfx.TokensReq, _ ...grpc.CallOption) (*protomfx.UserIdentitiesRes, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.identifyBatch(ctx, identityBatchReq{tokens: req.GetTokens()})
+	if err != nil {
+		return nil, err
+	}
+
+	ir := res.(identitiesRes)
+	ids := []*protomfx.UserIdentity{}
+	for _, id := range ir.identities {
+		ids = append(ids, &protomfx.UserIdentity{Id: id.id, Email: id.email})
+	}
+
+	return &protomfx.UserIdentitiesRes{Identities: ids}, nil
+}
+
+func encodeIdentifyBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(identityBatchReq)
+	return &protomfx.TokensReq{Tokens: req.tokens}, nil
+}
+
+func decodeIdentifyBatchResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.UserIdentitiesRes)
+
+	ids := []identityRes{}
+	for _, id := range res.GetIdentities() {
+		ids = append(ids, identityRes{id: id.GetId(), email: id.GetEmail()})
+	}
+
+	return identitiesRes{identities: ids}, nil
+}
+
+func (client grpcClient) IdentifyDelegated(ctx context.Context, token *protomfx.Token, _ ...grpc.CallOption) (*protomfx.DelegatedIdentity, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.identifyDeleg(ctx, identityReq{token: token.GetValue()})
+	if err != nil {
+		return nil, err
+	}
+
+	dr := res.(delegatedIdentityRes)
+	return &protomfx.DelegatedIdentity{Id: dr.id, ThingID: dr.thingID, Action: dr.action, ExpiresAt: dr.expiresAt}, nil
+}
+
+func decodeIdentifyDelegatedResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.DelegatedIdentity)
+	return delegatedIdentityRes{id: res.GetId(), thingID: res.GetThingID(), action: res.GetAction(), expiresAt: res.GetExpiresAt()}, nil
+}
+
 func (client grpcClient) Authorize(ctx context.Context, req *protomfx.AuthorizeReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
 	ctx, close := context.WithTimeout(ctx, client.timeout)
 	defer close()
@@ -148,6 +284,40 @@ func encodeAuthorizeRequest(_ context.Context, grpcReq interface{}) (interface{}
 	}, nil
 }
 
+func (client grpcClient) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	ars := []authReq{}
+	for _, r := range req.GetRequests() {
+		ars = append(ars, authReq{Token: r.GetToken(), Object: r.GetObject(), Subject: r.GetSubject(), Action: r.GetAction()})
+	}
+
+	res, err := client.authorizeBatch(ctx, authBatchReq{requests: ars})
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	er := res.(emptyRes)
+	return &empty.Empty{}, er.err
+}
+
+func encodeAuthorizeBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(authBatchReq)
+
+	ars := []*protomfx.AuthorizeReq{}
+	for _, r := range req.requests {
+		ars = append(ars, &protomfx.AuthorizeReq{
+			Token:   r.Token,
+			Object:  r.Object,
+			Subject: r.Subject,
+			Action:  r.Action,
+		})
+	}
+
+	return &protomfx.AuthorizeBatchReq{Requests: ars}, nil
+}
+
 func (client grpcClient) AssignRole(ctx context.Context, req *protomfx.AssignRoleReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
 	ctx, close := context.WithTimeout(ctx, client.timeout)
 	defer close()
@@ -169,6 +339,35 @@ func encodeAssignRoleRequest(_ context.Context, grpcReq interface{}) (interface{
 	}, nil
 }
 
+func (client grpcClient) AssignMembers(ctx context.Context, req *protomfx.AssignMembersReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	oms := []orgMember{}
+	for _, m := range req.GetMembers() {
+		oms = append(oms, orgMember{email: m.GetEmail(), role: m.GetRole()})
+	}
+
+	res, err := client.assignMembers(ctx, assignMembersReq{token: req.GetToken(), orgID: req.GetOrgID(), members: oms})
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	er := res.(emptyRes)
+	return &empty.Empty{}, er.err
+}
+
+func encodeAssignMembersRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(assignMembersReq)
+
+	oms := []*protomfx.OrgMember{}
+	for _, m := range req.members {
+		oms = append(oms, &protomfx.OrgMember{Email: m.email, Role: m.role})
+	}
+
+	return &protomfx.AssignMembersReq{Token: req.token, OrgID: req.orgID, Members: oms}, nil
+}
+
 func (client grpcClient) RetrieveRole(ctx context.Context, req *protomfx.RetrieveRoleReq, _ ...grpc.CallOption) (r *protomfx.RetrieveRoleRes, err error) {
 	ctx, close := context.WithTimeout(ctx, client.timeout)
 	defer close()
@@ -194,6 +393,116 @@ func decodeRetrieveRoleResponse(_ context.Context, grpcRes interface{}) (interfa
 	return retrieveRoleRes{role: res.GetRole()}, nil
 }
 
+func (client grpcClient) SignPayload(ctx context.Context, req *protomfx.SignPayloadReq, _ ...grpc.CallOption) (*protomfx.SignPayloadRes, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.signPayload(ctx, signPayloadReq{secret: req.GetSecret(), orgID: req.GetOrgID(), payload: req.GetPayload()})
+	if err != nil {
+		return nil, err
+	}
+
+	sr := res.(signPayloadRes)
+	return &protomfx.SignPayloadRes{Signature: sr.signature}, nil
+}
+
+func encodeSignPayloadRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(signPayloadReq)
+	return &protomfx.SignPayloadReq{Secret: req.secret, OrgID: req.orgID, Payload: req.payload}, nil
+}
+
+func decodeSignPayloadResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.SignPayloadRes)
+	return signPayloadRes{signature: res.GetSignature()}, nil
+}
+
+func (client grpcClient) RetrieveSigningKey(ctx context.Context, req *protomfx.SigningKeyReq, _ ...grpc.CallOption) (*protomfx.SigningKeyRes, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.retrieveSigKey(ctx, signingKeyReq{orgID: req.GetOrgID()})
+	if err != nil {
+		return nil, err
+	}
+
+	kr := res.(signingKeyRes)
+	return &protomfx.SigningKeyRes{PublicKey: kr.publicKey}, nil
+}
+
+func encodeRetrieveSigningKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(signingKeyReq)
+	return &protomfx.SigningKeyReq{OrgID: req.orgID}, nil
+}
+
+func decodeRetrieveSigningKeyResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.SigningKeyRes)
+	return signingKeyRes{publicKey: res.GetPublicKey()}, nil
+}
+
+// CheckQuota returns ErrQuotaExceeded if the quota of the resource is
+// exceeded, so that the services can report it to their clients.
+func (client grpcClient) CheckQuota(ctx context.Context, req *protomfx.CheckQuotaReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.checkQuota(ctx, checkQuotaReq{orgID: req.GetOrgID(), resource: req.GetResource(), usage: req.GetUsage(), count: req.GetCount()})
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			return &empty.Empty{}, errors.ErrQuotaExceeded
+		}
+		return &empty.Empty{}, err
+	}
+
+	er := res.(emptyRes)
+	return &empty.Empty{}, er.err
+}
+
+func encodeCheckQuotaRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(checkQuotaReq)
+	return &protomfx.CheckQuotaReq{OrgID: req.orgID, Resource: req.resource, Usage: req.usage, Count: req.count}, nil
+}
+
+func (client grpcClient) RemoveUser(ctx context.Context, req *protomfx.RemoveUserReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.removeUser(ctx, removeUserReq{id: req.GetId()})
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	er := res.(emptyRes)
+	return &empty.Empty{}, er.err
+}
+
+func encodeRemoveUserRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(removeUserReq)
+	return &protomfx.RemoveUserReq{Id: req.id}, nil
+}
+
+func (client grpcClient) FilterOrgMembers(ctx context.Context, req *protomfx.OrgMembersReq, _ ...grpc.CallOption) (*protomfx.OrgMembersRes, error) {
+	ctx, close := context.WithTimeout(ctx, client.timeout)
+	defer close()
+
+	res, err := client.filterMembers(ctx, filterOrgMembersReq{orgID: req.GetOrgID(), memberIDs: req.GetMemberIDs()})
+	if err != nil {
+		return &protomfx.OrgMembersRes{}, err
+	}
+
+	fr := res.(filterOrgMembersRes)
+	return &protomfx.OrgMembersRes{MemberIDs: fr.memberIDs}, nil
+}
+
+func encodeFilterOrgMembersRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(filterOrgMembersReq)
+	return &protomfx.OrgMembersReq{OrgID: req.orgID, MemberIDs: req.memberIDs}, nil
+}
+
+func decodeFilterOrgMembersResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.OrgMembersRes)
+	return filterOrgMembersRes{memberIDs: res.GetMemberIDs()}, nil
+}
+
 func decodeAssignResponse(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(authReq)
 	return &protomfx.AuthorizeReq{
@@ -55,6 +55,49 @@ func identifyEndpoint(svc auth.Service) endpoint.Endpoint {
 	}
 }
 
+func identifyDelegatedEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(identityReq)
+		if err := req.validate(); err != nil {
+			return delegatedIdentityRes{}, err
+		}
+
+		key, err := svc.IdentifyDelegated(ctx, req.token)
+		if err != nil {
+			return delegatedIdentityRes{}, err
+		}
+
+		ret := delegatedIdentityRes{
+			id:        key.IssuerID,
+			thingID:   key.Scope.ThingID,
+			action:    key.Scope.Action,
+			expiresAt: key.ExpiresAt.UnixNano(),
+		}
+
+		return ret, nil
+	}
+}
+
+func identifyBatchEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(identityBatchReq)
+		if err := req.validate(); err != nil {
+			return identitiesRes{}, err
+		}
+
+		ids := []identityRes{}
+		for _, token := range req.tokens {
+			id, err := svc.Identify(ctx, token)
+			if err != nil {
+				return identitiesRes{}, err
+			}
+			ids = append(ids, identityRes{id: id.ID, email: id.Email})
+		}
+
+		return identitiesRes{identities: ids}, nil
+	}
+}
+
 func authorizeEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(authReq)
@@ -78,6 +121,31 @@ func authorizeEndpoint(svc auth.Service) endpoint.Endpoint {
 	}
 }
 
+func authorizeBatchEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(authBatchReq)
+
+		if err := req.validate(); err != nil {
+			return emptyRes{}, err
+		}
+
+		for _, r := range req.requests {
+			ar := auth.AuthzReq{
+				Token:   r.Token,
+				Object:  r.Object,
+				Subject: r.Subject,
+				Action:  r.Action,
+			}
+
+			if err := svc.Authorize(ctx, ar); err != nil {
+				return emptyRes{}, err
+			}
+		}
+
+		return emptyRes{}, nil
+	}
+}
+
 func assignRoleEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(assignRoleReq)
@@ -93,6 +161,27 @@ func assignRoleEndpoint(svc auth.Service) endpoint.Endpoint {
 		return emptyRes{}, nil
 	}
 }
+
+func assignMembersEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(assignMembersReq)
+
+		if err := req.validate(); err != nil {
+			return emptyRes{}, err
+		}
+
+		oms := []auth.OrgMember{}
+		for _, m := range req.members {
+			oms = append(oms, auth.OrgMember{Email: m.email, Role: m.role})
+		}
+
+		if err := svc.AssignMembers(ctx, req.token, req.orgID, oms...); err != nil {
+			return emptyRes{}, err
+		}
+
+		return emptyRes{}, nil
+	}
+}
 func retrieveRoleEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(retrieveRoleReq)
@@ -113,3 +202,81 @@ func retrieveRoleEndpoint(svc auth.Service) endpoint.Endpoint {
 		return res, nil
 	}
 }
+
+func signPayloadEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(signPayloadReq)
+		if err := req.validate(); err != nil {
+			return signPayloadRes{}, err
+		}
+
+		signature, err := svc.SignPayload(ctx, req.secret, req.orgID, req.payload)
+		if err != nil {
+			return signPayloadRes{}, err
+		}
+
+		return signPayloadRes{signature: signature}, nil
+	}
+}
+
+func checkQuotaEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(checkQuotaReq)
+		if err := req.validate(); err != nil {
+			return emptyRes{}, err
+		}
+
+		if err := svc.CheckQuota(ctx, req.orgID, req.resource, req.usage, req.count); err != nil {
+			return emptyRes{}, err
+		}
+
+		return emptyRes{}, nil
+	}
+}
+
+func removeUserEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeUserReq)
+		if err := req.validate(); err != nil {
+			return emptyRes{}, err
+		}
+
+		if _, err := svc.RemoveUser(ctx, req.id); err != nil {
+			return emptyRes{}, err
+		}
+
+		return emptyRes{}, nil
+	}
+}
+
+func filterOrgMembersEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(filterOrgMembersReq)
+		if err := req.validate(); err != nil {
+			return filterOrgMembersRes{}, err
+		}
+
+		ids, err := svc.FilterOrgMembers(ctx, req.orgID, req.memberIDs...)
+		if err != nil {
+			return filterOrgMembersRes{}, err
+		}
+
+		return filterOrgMembersRes{memberIDs: ids}, nil
+	}
+}
+
+func retrieveSigningKeyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(signingKeyReq)
+		if err := req.validate(); err != nil {
+			return signingKeyRes{}, err
+		}
+
+		key, err := svc.RetrieveSigningKey(ctx, req.orgID)
+		if err != nil {
+			return signingKeyRes{}, err
+		}
+
+		return signingKeyRes{publicKey: key}, nil
+	}
+}
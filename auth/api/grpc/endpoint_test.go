@@ -14,6 +14,7 @@ import (
 	grpcapi "github.com/MainfluxLabs/mainflux/auth/api/grpc"
 	"github.com/MainfluxLabs/mainflux/auth/jwt"
 	"github.com/MainfluxLabs/mainflux/auth/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/opentracing/opentracing-go/mocktracer"
@@ -29,6 +30,7 @@ const (
 	email         = "test@example.com"
 	id            = "testID"
 	loginDuration = 30 * time.Minute
+	signingSecret = "signing-secret"
 )
 
 var svc auth.Service
@@ -37,8 +39,10 @@ func newService() auth.Service {
 	repo := mocks.NewKeyRepository()
 	idProvider := uuid.NewMock()
 	t := jwt.New(secret)
+	quotas := mocks.NewQuotaRepository()
+	quotas.Save(context.Background(), id, map[string]uint64{auth.ThingsResource: 1})
 
-	return auth.New(nil, nil, nil, repo, nil, nil, idProvider, t, loginDuration)
+	return auth.New(nil, nil, nil, nil, mocks.NewEmailer(), repo, nil, nil, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), quotas, idProvider, t, loginDuration, signingSecret)
 }
 
 func startGRPCServer(svc auth.Service, port int) {
@@ -180,6 +184,300 @@ func TestIdentify(t *testing.T) {
 	}
 }
 
+func TestIdentifyBatch(t *testing.T) {
+	_, loginSecret, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing user key expected to succeed: %s", err))
+
+	_, apiSecret, err := svc.Issue(context.Background(), loginSecret, auth.Key{Type: auth.APIKey, IssuedAt: time.Now(), ExpiresAt: time.Now().Add(time.Minute), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing API key expected to succeed: %s", err))
+
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	idt := &protomfx.UserIdentity{Email: email, Id: id}
+
+	cases := []struct {
+		desc   string
+		tokens []string
+		idts   []*protomfx.UserIdentity
+		code   codes.Code
+	}{
+		{
+			desc:   "identify users with user and API tokens",
+			tokens: []string{loginSecret, apiSecret},
+			idts:   []*protomfx.UserIdentity{idt, idt},
+			code:   codes.OK,
+		},
+		{
+			desc:   "identify users with valid and invalid tokens",
+			tokens: []string{loginSecret, "invalid"},
+			idts:   nil,
+			code:   codes.Unauthenticated,
+		},
+		{
+			desc:   "identify users with empty token",
+			tokens: []string{loginSecret, ""},
+			idts:   nil,
+			code:   codes.Unauthenticated,
+		},
+		{
+			desc:   "identify users with empty list of tokens",
+			tokens: []string{},
+			idts:   nil,
+			code:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := client.IdentifyBatch(context.Background(), &protomfx.TokensReq{Tokens: tc.tokens})
+		assert.Equal(t, len(tc.idts), len(res.GetIdentities()), fmt.Sprintf("%s: expected %d identities got %d", tc.desc, len(tc.idts), len(res.GetIdentities())))
+		for i, idt := range res.GetIdentities() {
+			assert.Equal(t, tc.idts[i].Id, idt.GetId(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.idts[i].Id, idt.GetId()))
+			assert.Equal(t, tc.idts[i].Email, idt.GetEmail(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.idts[i].Email, idt.GetEmail()))
+		}
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
+func TestAssignMembers(t *testing.T) {
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	member := &protomfx.OrgMember{Email: email, Role: auth.Viewer}
+
+	cases := []struct {
+		desc    string
+		token   string
+		orgID   string
+		members []*protomfx.OrgMember
+		code    codes.Code
+	}{
+		{
+			desc:    "assign members with empty token",
+			token:   "",
+			orgID:   id,
+			members: []*protomfx.OrgMember{member},
+			code:    codes.Unauthenticated,
+		},
+		{
+			desc:    "assign members without org",
+			token:   "token",
+			orgID:   "",
+			members: []*protomfx.OrgMember{member},
+			code:    codes.InvalidArgument,
+		},
+		{
+			desc:    "assign members with empty list of members",
+			token:   "token",
+			orgID:   id,
+			members: []*protomfx.OrgMember{},
+			code:    codes.InvalidArgument,
+		},
+		{
+			desc:    "assign members with invalid role",
+			token:   "token",
+			orgID:   id,
+			members: []*protomfx.OrgMember{{Email: email, Role: auth.Owner}},
+			code:    codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := client.AssignMembers(context.Background(), &protomfx.AssignMembersReq{Token: tc.token, OrgID: tc.orgID, Members: tc.members})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
+func TestSignPayload(t *testing.T) {
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	cases := []struct {
+		desc   string
+		secret string
+		orgID  string
+		code   codes.Code
+	}{
+		{
+			desc:   "sign payload of org without signing key",
+			secret: signingSecret,
+			orgID:  id,
+			code:   codes.OK,
+		},
+		{
+			desc:   "sign payload without org",
+			secret: signingSecret,
+			orgID:  "",
+			code:   codes.InvalidArgument,
+		},
+		{
+			desc:   "sign payload with invalid signing secret",
+			secret: "invalid",
+			orgID:  id,
+			code:   codes.Unauthenticated,
+		},
+		{
+			desc:   "sign payload without signing secret",
+			secret: "",
+			orgID:  id,
+			code:   codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := client.SignPayload(context.Background(), &protomfx.SignPayloadReq{Secret: tc.secret, OrgID: tc.orgID, Payload: []byte("payload")})
+		assert.Empty(t, res.GetSignature(), fmt.Sprintf("%s: expected empty signature got %v", tc.desc, res.GetSignature()))
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
+func TestRetrieveSigningKey(t *testing.T) {
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	cases := []struct {
+		desc  string
+		orgID string
+		code  codes.Code
+	}{
+		{
+			desc:  "retrieve signing key of org without signing key",
+			orgID: id,
+			code:  codes.OK,
+		},
+		{
+			desc:  "retrieve signing key without org",
+			orgID: "",
+			code:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := client.RetrieveSigningKey(context.Background(), &protomfx.SigningKeyReq{OrgID: tc.orgID})
+		assert.Empty(t, res.GetPublicKey(), fmt.Sprintf("%s: expected empty public key got %v", tc.desc, res.GetPublicKey()))
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	cases := []struct {
+		desc     string
+		orgID    string
+		resource string
+		count    uint64
+		err      error
+		code     codes.Code
+	}{
+		{
+			desc:     "check quota within limit",
+			orgID:    id,
+			resource: auth.ThingsResource,
+			count:    1,
+			code:     codes.OK,
+		},
+		{
+			desc:     "check quota exceeding limit",
+			orgID:    id,
+			resource: auth.ThingsResource,
+			count:    2,
+			err:      errors.ErrQuotaExceeded,
+		},
+		{
+			desc:     "check quota of unknown resource",
+			orgID:    id,
+			resource: "webhooks",
+			count:    1,
+			code:     codes.InvalidArgument,
+		},
+		{
+			desc:     "check quota without org",
+			orgID:    "",
+			resource: auth.ThingsResource,
+			count:    1,
+			code:     codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := client.CheckQuota(context.Background(), &protomfx.CheckQuotaReq{OrgID: tc.orgID, Resource: tc.resource, Count: tc.count})
+		if tc.err != nil {
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			continue
+		}
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
+func TestRemoveUser(t *testing.T) {
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	cases := []struct {
+		desc string
+		id   string
+		code codes.Code
+	}{
+		{
+			desc: "remove user without id",
+			id:   "",
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := client.RemoveUser(context.Background(), &protomfx.RemoveUserReq{Id: tc.id})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
+func TestFilterOrgMembers(t *testing.T) {
+	authAddr := fmt.Sprintf("localhost:%d", port)
+	conn, _ := grpc.Dial(authAddr, grpc.WithInsecure())
+	client := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+
+	cases := []struct {
+		desc      string
+		orgID     string
+		memberIDs []string
+		code      codes.Code
+	}{
+		{
+			desc:      "filter org members without org id",
+			orgID:     "",
+			memberIDs: []string{id},
+			code:      codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := client.FilterOrgMembers(context.Background(), &protomfx.OrgMembersReq{OrgID: tc.orgID, MemberIDs: tc.memberIDs})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "gRPC status can't be extracted from the error")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.code, e.Code()))
+	}
+}
+
 /* TODO: Finish tests when the method is finished
 func TestAuthorize(t *testing.T) {
 	_, loginSecret, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
@@ -26,6 +26,24 @@ func (req identityReq) validate() error {
 	return nil
 }
 
+type identityBatchReq struct {
+	tokens []string
+}
+
+func (req identityBatchReq) validate() error {
+	if len(req.tokens) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, token := range req.tokens {
+		if token == "" {
+			return apiutil.ErrBearerToken
+		}
+	}
+
+	return nil
+}
+
 type issueReq struct {
 	id      string
 	email   string
@@ -65,6 +83,24 @@ func (req authReq) validate() error {
 	return nil
 }
 
+type authBatchReq struct {
+	requests []authReq
+}
+
+func (req authBatchReq) validate() error {
+	if len(req.requests) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, ar := range req.requests {
+		if err := ar.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type assignRoleReq struct {
 	ID   string
 	Role string
@@ -82,6 +118,43 @@ func (req assignRoleReq) validate() error {
 	return nil
 }
 
+type orgMember struct {
+	email string
+	role  string
+}
+
+type assignMembersReq struct {
+	token   string
+	orgID   string
+	members []orgMember
+}
+
+func (req assignMembersReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if len(req.members) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, m := range req.members {
+		if m.email == "" {
+			return apiutil.ErrMissingEmail
+		}
+
+		if m.role != auth.Admin && m.role != auth.Viewer && m.role != auth.Editor {
+			return apiutil.ErrInvalidMemberRole
+		}
+	}
+
+	return nil
+}
+
 type retrieveRoleReq struct {
 	id string
 }
@@ -93,3 +166,77 @@ func (req retrieveRoleReq) validate() error {
 
 	return nil
 }
+
+type signPayloadReq struct {
+	secret  string
+	orgID   string
+	payload []byte
+}
+
+func (req signPayloadReq) validate() error {
+	if req.secret == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	return nil
+}
+
+type checkQuotaReq struct {
+	orgID    string
+	resource string
+	usage    uint64
+	count    uint64
+}
+
+func (req checkQuotaReq) validate() error {
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	if req.resource == "" {
+		return apiutil.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type signingKeyReq struct {
+	orgID string
+}
+
+func (req signingKeyReq) validate() error {
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	return nil
+}
+
+type filterOrgMembersReq struct {
+	orgID     string
+	memberIDs []string
+}
+
+func (req filterOrgMembersReq) validate() error {
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	return nil
+}
+
+type removeUserReq struct {
+	id string
+}
+
+func (req removeUserReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
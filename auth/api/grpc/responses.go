@@ -8,6 +8,17 @@ type identityRes struct {
 	email string
 }
 
+type delegatedIdentityRes struct {
+	id        string
+	thingID   string
+	action    string
+	expiresAt int64
+}
+
+type identitiesRes struct {
+	identities []identityRes
+}
+
 type issueRes struct {
 	value string
 }
@@ -19,3 +30,15 @@ type emptyRes struct {
 type retrieveRoleRes struct {
 	role string
 }
+
+type signPayloadRes struct {
+	signature []byte
+}
+
+type signingKeyRes struct {
+	publicKey []byte
+}
+
+type filterOrgMembersRes struct {
+	memberIDs []string
+}
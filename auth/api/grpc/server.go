@@ -21,11 +21,20 @@ import (
 var _ protomfx.AuthServiceServer = (*grpcServer)(nil)
 
 type grpcServer struct {
-	issue        kitgrpc.Handler
-	identify     kitgrpc.Handler
-	authorize    kitgrpc.Handler
-	assignRole   kitgrpc.Handler
-	retrieveRole kitgrpc.Handler
+	issue          kitgrpc.Handler
+	identify       kitgrpc.Handler
+	identifyBatch  kitgrpc.Handler
+	identifyDeleg  kitgrpc.Handler
+	authorize      kitgrpc.Handler
+	authorizeBatch kitgrpc.Handler
+	assignRole     kitgrpc.Handler
+	retrieveRole   kitgrpc.Handler
+	assignMembers  kitgrpc.Handler
+	signPayload    kitgrpc.Handler
+	retrieveSigKey kitgrpc.Handler
+	checkQuota     kitgrpc.Handler
+	removeUser     kitgrpc.Handler
+	filterMembers  kitgrpc.Handler
 }
 
 // NewServer returns new AuthServiceServer instance.
@@ -41,11 +50,26 @@ func NewServer(tracer opentracing.Tracer, svc auth.Service) protomfx.AuthService
 			decodeIdentifyRequest,
 			encodeIdentifyResponse,
 		),
+		identifyBatch: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "identify_batch")(identifyBatchEndpoint(svc)),
+			decodeIdentifyBatchRequest,
+			encodeIdentifyBatchResponse,
+		),
+		identifyDeleg: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "identify_delegated")(identifyDelegatedEndpoint(svc)),
+			decodeIdentifyRequest,
+			encodeIdentifyDelegatedResponse,
+		),
 		authorize: kitgrpc.NewServer(
 			kitot.TraceServer(tracer, "authorize")(authorizeEndpoint(svc)),
 			decodeAuthorizeRequest,
 			encodeEmptyResponse,
 		),
+		authorizeBatch: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "authorize_batch")(authorizeBatchEndpoint(svc)),
+			decodeAuthorizeBatchRequest,
+			encodeEmptyResponse,
+		),
 		assignRole: kitgrpc.NewServer(
 			kitot.TraceServer(tracer, "assign_role")(assignRoleEndpoint(svc)),
 			decodeAssignRoleRequest,
@@ -56,6 +80,36 @@ func NewServer(tracer opentracing.Tracer, svc auth.Service) protomfx.AuthService
 			decodeRetrieveRoleRequest,
 			encodeRetrieveRoleResponse,
 		),
+		assignMembers: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "assign_members")(assignMembersEndpoint(svc)),
+			decodeAssignMembersRequest,
+			encodeEmptyResponse,
+		),
+		signPayload: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "sign_payload")(signPayloadEndpoint(svc)),
+			decodeSignPayloadRequest,
+			encodeSignPayloadResponse,
+		),
+		retrieveSigKey: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "retrieve_signing_key")(retrieveSigningKeyEndpoint(svc)),
+			decodeRetrieveSigningKeyRequest,
+			encodeRetrieveSigningKeyResponse,
+		),
+		checkQuota: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "check_quota")(checkQuotaEndpoint(svc)),
+			decodeCheckQuotaRequest,
+			encodeEmptyResponse,
+		),
+		removeUser: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "remove_user")(removeUserEndpoint(svc)),
+			decodeRemoveUserRequest,
+			encodeEmptyResponse,
+		),
+		filterMembers: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "filter_org_members")(filterOrgMembersEndpoint(svc)),
+			decodeFilterOrgMembersRequest,
+			encodeFilterOrgMembersResponse,
+		),
 	}
 }
 
@@ -83,6 +137,30 @@ func (s *grpcServer) Authorize(ctx context.Context, req *protomfx.AuthorizeReq)
 	return res.(*empty.Empty), nil
 }
 
+func (s *grpcServer) IdentifyBatch(ctx context.Context, req *protomfx.TokensReq) (*protomfx.UserIdentitiesRes, error) {
+	_, res, err := s.identifyBatch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*protomfx.UserIdentitiesRes), nil
+}
+
+func (s *grpcServer) IdentifyDelegated(ctx context.Context, token *protomfx.Token) (*protomfx.DelegatedIdentity, error) {
+	_, res, err := s.identifyDeleg.ServeGRPC(ctx, token)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*protomfx.DelegatedIdentity), nil
+}
+
+func (s *grpcServer) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq) (*empty.Empty, error) {
+	_, res, err := s.authorizeBatch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*empty.Empty), nil
+}
+
 func (s *grpcServer) AssignRole(ctx context.Context, req *protomfx.AssignRoleReq) (*empty.Empty, error) {
 	_, res, err := s.assignRole.ServeGRPC(ctx, req)
 	if err != nil {
@@ -99,11 +177,70 @@ func (s *grpcServer) RetrieveRole(ctx context.Context, req *protomfx.RetrieveRol
 	return res.(*protomfx.RetrieveRoleRes), nil
 }
 
+func (s *grpcServer) AssignMembers(ctx context.Context, req *protomfx.AssignMembersReq) (*empty.Empty, error) {
+	_, res, err := s.assignMembers.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*empty.Empty), nil
+}
+
+func (s *grpcServer) SignPayload(ctx context.Context, req *protomfx.SignPayloadReq) (*protomfx.SignPayloadRes, error) {
+	_, res, err := s.signPayload.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*protomfx.SignPayloadRes), nil
+}
+
+func (s *grpcServer) RetrieveSigningKey(ctx context.Context, req *protomfx.SigningKeyReq) (*protomfx.SigningKeyRes, error) {
+	_, res, err := s.retrieveSigKey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*protomfx.SigningKeyRes), nil
+}
+
+func (s *grpcServer) CheckQuota(ctx context.Context, req *protomfx.CheckQuotaReq) (*empty.Empty, error) {
+	_, res, err := s.checkQuota.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*empty.Empty), nil
+}
+
+func (s *grpcServer) RemoveUser(ctx context.Context, req *protomfx.RemoveUserReq) (*empty.Empty, error) {
+	_, res, err := s.removeUser.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*empty.Empty), nil
+}
+
+func (s *grpcServer) FilterOrgMembers(ctx context.Context, req *protomfx.OrgMembersReq) (*protomfx.OrgMembersRes, error) {
+	_, res, err := s.filterMembers.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*protomfx.OrgMembersRes), nil
+}
+
 func decodeAssignRoleRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.AssignRoleReq)
 	return assignRoleReq{ID: req.GetId(), Role: req.GetRole()}, nil
 }
 
+func decodeAssignMembersRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.AssignMembersReq)
+
+	oms := []orgMember{}
+	for _, m := range req.GetMembers() {
+		oms = append(oms, orgMember{email: m.GetEmail(), role: m.GetRole()})
+	}
+
+	return assignMembersReq{token: req.GetToken(), orgID: req.GetOrgID(), members: oms}, nil
+}
+
 func decodeRetrieveRoleRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.RetrieveRoleReq)
 	return retrieveRoleReq{id: req.GetId()}, nil
@@ -114,6 +251,46 @@ func encodeRetrieveRoleResponse(_ context.Context, grpcRes interface{}) (interfa
 	return &protomfx.RetrieveRoleRes{Role: res.role}, nil
 }
 
+func decodeSignPayloadRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.SignPayloadReq)
+	return signPayloadReq{secret: req.GetSecret(), orgID: req.GetOrgID(), payload: req.GetPayload()}, nil
+}
+
+func encodeSignPayloadResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(signPayloadRes)
+	return &protomfx.SignPayloadRes{Signature: res.signature}, nil
+}
+
+func decodeRetrieveSigningKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.SigningKeyReq)
+	return signingKeyReq{orgID: req.GetOrgID()}, nil
+}
+
+func encodeRetrieveSigningKeyResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(signingKeyRes)
+	return &protomfx.SigningKeyRes{PublicKey: res.publicKey}, nil
+}
+
+func decodeCheckQuotaRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.CheckQuotaReq)
+	return checkQuotaReq{orgID: req.GetOrgID(), resource: req.GetResource(), usage: req.GetUsage(), count: req.GetCount()}, nil
+}
+
+func decodeRemoveUserRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.RemoveUserReq)
+	return removeUserReq{id: req.GetId()}, nil
+}
+
+func decodeFilterOrgMembersRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.OrgMembersReq)
+	return filterOrgMembersReq{orgID: req.GetOrgID(), memberIDs: req.GetMemberIDs()}, nil
+}
+
+func encodeFilterOrgMembersResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(filterOrgMembersRes)
+	return &protomfx.OrgMembersRes{MemberIDs: res.memberIDs}, nil
+}
+
 func decodeIssueRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.IssueReq)
 	return issueReq{id: req.GetId(), email: req.GetEmail(), keyType: req.GetType()}, nil
@@ -134,11 +311,43 @@ func encodeIdentifyResponse(_ context.Context, grpcRes interface{}) (interface{}
 	return &protomfx.UserIdentity{Id: res.id, Email: res.email}, nil
 }
 
+func encodeIdentifyDelegatedResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(delegatedIdentityRes)
+	return &protomfx.DelegatedIdentity{Id: res.id, ThingID: res.thingID, Action: res.action, ExpiresAt: res.expiresAt}, nil
+}
+
 func decodeAuthorizeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.AuthorizeReq)
 	return authReq{Token: req.GetToken(), Object: req.GetObject(), Subject: req.GetSubject(), Action: req.GetAction()}, nil
 }
 
+func decodeIdentifyBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.TokensReq)
+	return identityBatchReq{tokens: req.GetTokens()}, nil
+}
+
+func encodeIdentifyBatchResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(identitiesRes)
+
+	ids := []*protomfx.UserIdentity{}
+	for _, id := range res.identities {
+		ids = append(ids, &protomfx.UserIdentity{Id: id.id, Email: id.email})
+	}
+
+	return &protomfx.UserIdentitiesRes{Identities: ids}, nil
+}
+
+func decodeAuthorizeBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.AuthorizeBatchReq)
+
+	ars := []authReq{}
+	for _, r := range req.GetRequests() {
+		ars = append(ars, authReq{Token: r.GetToken(), Object: r.GetObject(), Subject: r.GetSubject(), Action: r.GetAction()})
+	}
+
+	return authBatchReq{requests: ars}, nil
+}
+
 func encodeEmptyResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(emptyRes)
 	return &empty.Empty{}, encodeError(res.err)
@@ -151,7 +360,11 @@ func encodeError(err error) error {
 	case errors.Contains(err, apiutil.ErrMalformedEntity),
 		err == apiutil.ErrInvalidAuthKey,
 		err == apiutil.ErrMissingID,
-		err == apiutil.ErrMissingMemberType:
+		err == apiutil.ErrMissingOrgID,
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrInvalidMemberRole,
+		err == apiutil.ErrMissingMemberType,
+		err == auth.ErrUnknownResource:
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Contains(err, errors.ErrAuthentication),
 		errors.Contains(err, auth.ErrKeyExpired),
@@ -160,6 +373,12 @@ func encodeError(err error) error {
 		return status.Error(codes.Unauthenticated, err.Error())
 	case errors.Contains(err, errors.ErrAuthorization):
 		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Contains(err, errors.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Contains(err, errors.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Contains(err, errors.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
@@ -0,0 +1,46 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func checkAuthzEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(checkAuthzReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		var ars []auth.AuthzReq
+		for _, c := range req.Checks {
+			ars = append(ars, auth.AuthzReq{
+				Subject: c.Subject,
+				Action:  c.Action,
+				Object:  c.Object,
+			})
+		}
+
+		allowed, err := svc.CheckAuthz(ctx, req.token, ars)
+		if err != nil {
+			return nil, err
+		}
+
+		res := checkAuthzRes{Checks: []checkRes{}}
+		for i, c := range req.Checks {
+			res.Checks = append(res.Checks, checkRes{
+				Subject: c.Subject,
+				Action:  c.Action,
+				Object:  c.Object,
+				Allowed: allowed[i],
+			})
+		}
+
+		return res, nil
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package authz_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	httpapi "github.com/MainfluxLabs/mainflux/auth/api/http"
+	"github.com/MainfluxLabs/mainflux/auth/jwt"
+	"github.com/MainfluxLabs/mainflux/auth/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secret        = "secret"
+	contentType   = "application/json"
+	ownerID       = "ownerID"
+	ownerEmail    = "owner@example.com"
+	viewerID      = "viewerID"
+	viewerEmail   = "viewer@example.com"
+	wrongValue    = "wrong_value"
+	loginDuration = 30 * time.Minute
+)
+
+var (
+	org           = auth.Org{Name: "testName"}
+	usersByIDs    = map[string]users.User{ownerID: {ID: ownerID, Email: ownerEmail}, viewerID: {ID: viewerID, Email: viewerEmail}}
+	usersByEmails = map[string]users.User{ownerEmail: {ID: ownerID, Email: ownerEmail}, viewerEmail: {ID: viewerID, Email: viewerEmail}}
+)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+type checkRes struct {
+	Subject string `json:"subject"`
+	Action  string `json:"action"`
+	Object  string `json:"object,omitempty"`
+	Allowed bool   `json:"allowed"`
+}
+
+type checkAuthzRes struct {
+	Checks []checkRes `json:"checks"`
+}
+
+func newService() auth.Service {
+	membsRepo := mocks.NewMembersRepository()
+	orgsRepo := mocks.NewOrgRepository(membsRepo)
+	rolesRepo := mocks.NewRolesRepository()
+	keysRepo := mocks.NewKeyRepository()
+
+	idProvider := uuid.NewMock()
+	t := jwt.New(secret)
+	uc := mocks.NewUsersService(usersByIDs, usersByEmails)
+	tc := thmocks.NewThingsServiceClient(nil, nil, map[string]things.Group{})
+
+	return auth.New(orgsRepo, tc, uc, nil, mocks.NewEmailer(), keysRepo, rolesRepo, membsRepo, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idProvider, t, loginDuration, "")
+}
+
+func newServer(svc auth.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(svc, mocktracer.New(), logger)
+	return httptest.NewServer(mux)
+}
+
+func TestCheckAuthz(t *testing.T) {
+	svc := newService()
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, auth.OrgMember{Email: viewerEmail, Role: auth.Viewer})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	checks := []checkRes{
+		{Subject: auth.RootSub},
+		{Subject: auth.OrgSub, Action: auth.Viewer, Object: or.ID},
+		{Subject: auth.OrgSub, Action: auth.Editor, Object: or.ID},
+	}
+	body, err := json.Marshal(map[string]interface{}{"checks": checks})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	data := string(body)
+
+	var tooMany []checkRes
+	for i := 0; i <= 100; i++ {
+		tooMany = append(tooMany, checkRes{Subject: auth.RootSub})
+	}
+	body, err = json.Marshal(map[string]interface{}{"checks": tooMany})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	tooManyData := string(body)
+
+	cases := []struct {
+		desc        string
+		token       string
+		contentType string
+		body        string
+		res         checkAuthzRes
+		status      int
+	}{
+		{
+			desc:        "check authz as org owner",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        data,
+			res: checkAuthzRes{Checks: []checkRes{
+				{Subject: auth.RootSub, Allowed: false},
+				{Subject: auth.OrgSub, Action: auth.Viewer, Object: or.ID, Allowed: true},
+				{Subject: auth.OrgSub, Action: auth.Editor, Object: or.ID, Allowed: true},
+			}},
+			status: http.StatusOK,
+		},
+		{
+			desc:        "check authz as org viewer",
+			token:       viewerToken,
+			contentType: contentType,
+			body:        data,
+			res: checkAuthzRes{Checks: []checkRes{
+				{Subject: auth.RootSub, Allowed: false},
+				{Subject: auth.OrgSub, Action: auth.Viewer, Object: or.ID, Allowed: true},
+				{Subject: auth.OrgSub, Action: auth.Editor, Object: or.ID, Allowed: false},
+			}},
+			status: http.StatusOK,
+		},
+		{
+			desc:        "check authz with invalid auth token",
+			token:       wrongValue,
+			contentType: contentType,
+			body:        data,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "check authz without auth token",
+			token:       "",
+			contentType: contentType,
+			body:        data,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "check authz without checks",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        `{"checks":[]}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "check authz with too many checks",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        tooManyData,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "check authz with invalid subject",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        fmt.Sprintf(`{"checks":[{"subject":"%s"}]}`, wrongValue),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "check authz of org without object",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        fmt.Sprintf(`{"checks":[{"subject":"%s","action":"%s"}]}`, auth.OrgSub, auth.Viewer),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "check authz of group without object",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        fmt.Sprintf(`{"checks":[{"subject":"%s","action":"%s"}]}`, auth.GroupSub, auth.Viewer),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "check authz with malformed body",
+			token:       ownerToken,
+			contentType: contentType,
+			body:        wrongValue,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "check authz without content type",
+			token:       ownerToken,
+			contentType: "",
+			body:        data,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      client,
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/authz/check", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var body checkAuthzRes
+		err = json.NewDecoder(res.Body).Decode(&body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+	}
+}
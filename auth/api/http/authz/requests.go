@@ -0,0 +1,58 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+const maxChecks = 100
+
+type checkReq struct {
+	Subject string `json:"subject"`
+	Action  string `json:"action"`
+	Object  string `json:"object,omitempty"`
+}
+
+func (req checkReq) validate() error {
+	switch req.Subject {
+	case auth.RootSub:
+	case auth.OrgSub, auth.GroupSub, auth.ThingSub:
+		if req.Object == "" {
+			return apiutil.ErrMissingObject
+		}
+	default:
+		return apiutil.ErrInvalidSubject
+	}
+
+	return nil
+}
+
+type checkAuthzReq struct {
+	token  string
+	Checks []checkReq `json:"checks"`
+}
+
+func (req checkAuthzReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.Checks) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	if len(req.Checks) > maxChecks {
+		return apiutil.ErrLimitSize
+	}
+
+	for _, c := range req.Checks {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
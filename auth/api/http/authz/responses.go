@@ -0,0 +1,35 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package authz
+
+import (
+	"net/http"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var _ apiutil.Response = (*checkAuthzRes)(nil)
+
+type checkRes struct {
+	Subject string `json:"subject"`
+	Action  string `json:"action"`
+	Object  string `json:"object,omitempty"`
+	Allowed bool   `json:"allowed"`
+}
+
+type checkAuthzRes struct {
+	Checks []checkRes `json:"checks"`
+}
+
+func (res checkAuthzRes) Code() int {
+	return http.StatusOK
+}
+
+func (res checkAuthzRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res checkAuthzRes) Empty() bool {
+	return false
+}
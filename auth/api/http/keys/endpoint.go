@@ -22,6 +22,7 @@ func issueEndpoint(svc auth.Service) endpoint.Endpoint {
 		newKey := auth.Key{
 			IssuedAt: now,
 			Type:     req.Type,
+			Scope:    auth.Scope{ThingID: req.ThingID, Action: req.Action},
 		}
 
 		duration := time.Duration(req.Duration * time.Second)
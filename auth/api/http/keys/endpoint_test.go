@@ -36,6 +36,8 @@ const (
 type issueRequest struct {
 	Duration time.Duration `json:"duration,omitempty"`
 	Type     uint32        `json:"type,omitempty"`
+	ThingID  string        `json:"thing_id,omitempty"`
+	Action   string        `json:"action,omitempty"`
 }
 
 type testRequest struct {
@@ -68,7 +70,7 @@ func newService() auth.Service {
 	idProvider := uuid.NewMock()
 	t := jwt.New(secret)
 
-	return auth.New(nil, nil, nil, repo, nil, nil, idProvider, t, loginDuration)
+	return auth.New(nil, nil, nil, nil, mocks.NewEmailer(), repo, nil, nil, nil, mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idProvider, t, loginDuration, "")
 }
 
 func newServer(svc auth.Service) *httptest.Server {
@@ -94,6 +96,8 @@ func TestIssue(t *testing.T) {
 	lk := issueRequest{Type: auth.LoginKey}
 	ak := issueRequest{Type: auth.APIKey, Duration: time.Hour}
 	rk := issueRequest{Type: auth.RecoveryKey}
+	dkNoThing := issueRequest{Type: auth.DelegatedKey, Action: auth.SubscribeAction}
+	dkInvalidAction := issueRequest{Type: auth.DelegatedKey, ThingID: "thingID", Action: "publish"}
 
 	cases := []struct {
 		desc   string
@@ -172,6 +176,20 @@ func TestIssue(t *testing.T) {
 			token:  loginSecret,
 			status: http.StatusBadRequest,
 		},
+		{
+			desc:   "issue delegated key without thing",
+			req:    toJSON(dkNoThing),
+			ct:     contentType,
+			token:  loginSecret,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "issue delegated key with invalid action",
+			req:    toJSON(dkInvalidAction),
+			ct:     contentType,
+			token:  loginSecret,
+			status: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range cases {
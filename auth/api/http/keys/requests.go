@@ -14,6 +14,8 @@ type issueKeyReq struct {
 	token    string
 	Type     uint32        `json:"type,omitempty"`
 	Duration time.Duration `json:"duration,omitempty"`
+	ThingID  string        `json:"thing_id,omitempty"`
+	Action   string        `json:"action,omitempty"`
 }
 
 // It is not possible to issue Reset key using HTTP API.
@@ -24,10 +26,15 @@ func (req issueKeyReq) validate() error {
 
 	if req.Type != auth.LoginKey &&
 		req.Type != auth.RecoveryKey &&
-		req.Type != auth.APIKey {
+		req.Type != auth.APIKey &&
+		req.Type != auth.DelegatedKey {
 		return apiutil.ErrInvalidAPIKey
 	}
 
+	if req.Type == auth.DelegatedKey && (req.ThingID == "" || req.Action != auth.SubscribeAction) {
+		return auth.ErrInvalidScope
+	}
+
 	return nil
 }
 
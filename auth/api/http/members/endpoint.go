@@ -37,7 +37,7 @@ func assignMembersEndpoint(svc auth.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		if err := svc.AssignMembers(ctx, req.token, req.orgID, req.OrgMembers...); err != nil {
+		if err := svc.AssignMembers(ctx, req.token, req.orgID, buildOrgMembers(req)...); err != nil {
 			return nil, err
 		}
 
@@ -67,7 +67,7 @@ func updateMembersEndpoint(svc auth.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		if err := svc.UpdateMembers(ctx, req.token, req.orgID, req.OrgMembers...); err != nil {
+		if err := svc.UpdateMembers(ctx, req.token, req.orgID, buildOrgMembers(req)...); err != nil {
 			return nil, err
 		}
 
@@ -112,8 +112,25 @@ func buildMembersResponse(omp auth.OrgMembersPage) memberPageRes {
 			Email: memb.Email,
 			Role:  memb.Role,
 		}
+		if !memb.ExpiresAt.IsZero() {
+			expiresAt := memb.ExpiresAt
+			m.ExpiresAt = &expiresAt
+		}
 		res.Members = append(res.Members, m)
 	}
 
 	return res
 }
+
+func buildOrgMembers(req membersReq) []auth.OrgMember {
+	var oms []auth.OrgMember
+	for _, m := range req.OrgMembers {
+		oms = append(oms, auth.OrgMember{
+			Email:     m.Email,
+			Role:      m.Role,
+			ExpiresAt: m.ExpiresAt,
+		})
+	}
+
+	return oms
+}
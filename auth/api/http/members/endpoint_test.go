@@ -94,7 +94,7 @@ func newService() auth.Service {
 	uc := mocks.NewUsersService(usersByIDs, usersByEmails)
 	tc := thmocks.NewThingsServiceClient(nil, nil, nil)
 
-	return auth.New(orgsRepo, tc, uc, nil, rolesRepo, membsRepo, idProvider, t, loginDuration)
+	return auth.New(orgsRepo, tc, uc, nil, mocks.NewEmailer(), nil, rolesRepo, membsRepo, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idProvider, t, loginDuration, "")
 }
 
 func newServer(svc auth.Service) *httptest.Server {
@@ -125,6 +125,8 @@ func TestAssignMembers(t *testing.T) {
 
 	data := toJSON(membersReq{OrgMembers: []auth.OrgMember{editorMember}})
 	invalidData := toJSON(membersReq{OrgMembers: []auth.OrgMember{invalidMember}})
+	expiringData := fmt.Sprintf(`{"org_members":[{"email":"%s","role":"%s","expires_at":"%s"}]}`, adminEmail, auth.Admin, time.Now().Add(time.Hour).Format(time.RFC3339))
+	expiredData := fmt.Sprintf(`{"org_members":[{"email":"%s","role":"%s","expires_at":"%s"}]}`, adminEmail, auth.Admin, time.Now().Add(-time.Hour).Format(time.RFC3339))
 
 	cases := []struct {
 		desc   string
@@ -147,6 +149,20 @@ func TestAssignMembers(t *testing.T) {
 			req:    invalidData,
 			status: http.StatusBadRequest,
 		},
+		{
+			desc:   "assign member to org with membership expiry",
+			token:  token,
+			id:     or.ID,
+			req:    expiringData,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "assign member to org with past membership expiry",
+			token:  token,
+			id:     or.ID,
+			req:    expiredData,
+			status: http.StatusBadRequest,
+		},
 		{
 			desc:   "assign member to org with invalid auth token",
 			token:  wrongValue,
@@ -1,6 +1,8 @@
 package members
 
 import (
+	"time"
+
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 )
@@ -45,10 +47,16 @@ func (req memberReq) validate() error {
 	return nil
 }
 
+type orgMember struct {
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
 type membersReq struct {
 	token      string
 	orgID      string
-	OrgMembers []auth.OrgMember `json:"org_members"`
+	OrgMembers []orgMember `json:"org_members"`
 }
 
 func (req membersReq) validate() error {
@@ -68,6 +76,10 @@ func (req membersReq) validate() error {
 		if m.Role != auth.Admin && m.Role != auth.Viewer && m.Role != auth.Editor {
 			return apiutil.ErrInvalidMemberRole
 		}
+
+		if !m.ExpiresAt.IsZero() && !m.ExpiresAt.After(time.Now()) {
+			return apiutil.ErrInvalidMemberExpiry
+		}
 	}
 
 	return nil
@@ -162,7 +162,7 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, apiutil.ErrMalformedEntity),
 		err == apiutil.ErrMissingID,
@@ -170,6 +170,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrMissingMemberType,
 		err == apiutil.ErrNameSize,
 		err == apiutil.ErrInvalidMemberRole,
+		err == apiutil.ErrInvalidMemberExpiry,
 		err == apiutil.ErrInvalidQueryParams:
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrAuthentication),
@@ -179,7 +180,8 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		w.WriteHeader(http.StatusNotFound)
 	case errors.Contains(err, errors.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
-	case errors.Contains(err, errors.ErrAuthorization):
+	case errors.Contains(err, errors.ErrAuthorization),
+		errors.Contains(err, errors.ErrQuotaExceeded):
 		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, auth.ErrOrgMemberAlreadyAssigned):
 		w.WriteHeader(http.StatusConflict)
@@ -198,7 +200,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
@@ -1,7 +1,9 @@
 package orgs
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/go-kit/kit/endpoint"
@@ -93,6 +95,66 @@ func deleteOrgEndpoint(svc auth.Service) endpoint.Endpoint {
 	}
 }
 
+func createSigningKeyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		sk, err := svc.CreateSigningKey(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := signingKeyRes{
+			OrgID:     sk.OrgID,
+			PublicKey: sk.PublicKey,
+			CreatedAt: sk.CreatedAt,
+			created:   true,
+		}
+
+		return res, nil
+	}
+}
+
+func viewSigningKeyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		sk, err := svc.ViewSigningKey(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := signingKeyRes{
+			OrgID:     sk.OrgID,
+			PublicKey: sk.PublicKey,
+			CreatedAt: sk.CreatedAt,
+		}
+
+		return res, nil
+	}
+}
+
+func removeSigningKeyEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveSigningKey(ctx, req.token, req.id); err != nil {
+			return nil, err
+		}
+
+		return deleteRes{}, nil
+	}
+}
+
 func listOrgsEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(listOrgsReq)
@@ -139,6 +201,85 @@ func listOrgsByMemberEndpoint(svc auth.Service) endpoint.Endpoint {
 	}
 }
 
+func listOrgActivityEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listOrgActivityReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pm := auth.PageMetadata{
+			Offset: req.offset,
+			Limit:  req.limit,
+		}
+
+		page, err := svc.ListActivityByOrg(ctx, req.token, req.id, pm)
+		if err != nil {
+			return nil, err
+		}
+
+		res := activityPageRes{
+			Total:  page.Total,
+			Offset: page.Offset,
+			Limit:  page.Limit,
+			Keys:   []keyActivityRes{},
+		}
+		for _, ka := range page.Keys {
+			kar := keyActivityRes{
+				KeyID:    ka.KeyID,
+				KeyType:  ka.KeyType,
+				UserID:   ka.UserID,
+				Email:    ka.Email,
+				Calls:    ka.Calls,
+				Errors:   ka.Errors,
+				LastUsed: ka.LastUsed,
+			}
+			if ka.Calls > 0 {
+				kar.ErrorRate = float64(ka.Errors) / float64(ka.Calls)
+			}
+			res.Keys = append(res.Keys, kar)
+		}
+
+		return res, nil
+	}
+}
+
+func updateQuotasEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateQuotasReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.UpdateQuotas(ctx, req.token, req.id, req.Quotas); err != nil {
+			return nil, err
+		}
+
+		return orgRes{}, nil
+	}
+}
+
+func viewQuotasEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		qs, err := svc.ViewQuotas(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := quotasRes{Quotas: []quotaRes{}}
+		for _, q := range qs {
+			res.Quotas = append(res.Quotas, quotaRes{Resource: q.Resource, Limit: q.Limit, Usage: q.Usage})
+		}
+
+		return res, nil
+	}
+}
+
 func backupEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(backupReq)
@@ -155,6 +296,42 @@ func backupEndpoint(svc auth.Service) endpoint.Endpoint {
 	}
 }
 
+func listOrgAccessEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgAccessReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		oas, err := svc.ListOrgAccess(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.format == csvFormat {
+			content, err := generateAccessCSV(oas)
+			if err != nil {
+				return nil, err
+			}
+
+			return accessFileRes{name: "org-access", content: content}, nil
+		}
+
+		res := orgAccessPageRes{OrgAccess: []orgAccessRes{}}
+		for _, oa := range oas {
+			res.OrgAccess = append(res.OrgAccess, orgAccessRes{
+				OrgID:    oa.OrgID,
+				OrgName:  oa.OrgName,
+				MemberID: oa.MemberID,
+				Email:    oa.Email,
+				Role:     oa.Role,
+			})
+		}
+
+		return res, nil
+	}
+}
+
 func restoreEndpoint(svc auth.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(restoreReq)
@@ -226,6 +403,10 @@ func buildBackupResponse(b auth.Backup) backupRes {
 			CreatedAt: mRel.CreatedAt,
 			UpdatedAt: mRel.UpdatedAt,
 		}
+		if !mRel.ExpiresAt.IsZero() {
+			expiresAt := mRel.ExpiresAt
+			view.ExpiresAt = &expiresAt
+		}
 		res.OrgMembers = append(res.OrgMembers, view)
 	}
 
@@ -254,8 +435,33 @@ func buildRestoreReq(req restoreReq) (b auth.Backup) {
 			CreatedAt: om.CreatedAt,
 			UpdatedAt: om.UpdatedAt,
 		}
+		if om.ExpiresAt != nil {
+			m.ExpiresAt = *om.ExpiresAt
+		}
 		b.OrgMembers = append(b.OrgMembers, m)
 	}
 
 	return b
 }
+
+func generateAccessCSV(oas []auth.OrgAccess) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write([]string{"org_id", "org_name", "member_id", "email", "role"}); err != nil {
+		return nil, err
+	}
+
+	for _, oa := range oas {
+		if err := writer.Write([]string{oa.OrgID, oa.OrgName, oa.MemberID, oa.Email, oa.Role}); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -2,6 +2,7 @@ package orgs_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -95,7 +96,7 @@ func newService() auth.Service {
 	uc := mocks.NewUsersService(usersByIDs, usersByEmails)
 	tc := thmocks.NewThingsServiceClient(nil, nil, nil)
 
-	return auth.New(orgsRepo, tc, uc, nil, rolesRepo, membsRepo, idProvider, t, loginDuration)
+	return auth.New(orgsRepo, tc, uc, nil, mocks.NewEmailer(), nil, rolesRepo, membsRepo, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idProvider, t, loginDuration, "")
 }
 
 func newServer(svc auth.Service) *httptest.Server {
@@ -461,6 +462,385 @@ func TestDeleteOrg(t *testing.T) {
 	}
 }
 
+func TestSigningKey(t *testing.T) {
+	svc := newService()
+	_, token, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	or, err := svc.CreateOrg(context.Background(), token, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), token, or.ID, viewerMember)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc   string
+		method string
+		token  string
+		status int
+		key    bool
+	}{
+		{
+			desc:   "view signing key of org without key",
+			method: http.MethodGet,
+			token:  token,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "create signing key as viewer",
+			method: http.MethodPost,
+			token:  viewerToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "create signing key with invalid auth token",
+			method: http.MethodPost,
+			token:  wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "create signing key",
+			method: http.MethodPost,
+			token:  token,
+			status: http.StatusCreated,
+			key:    true,
+		},
+		{
+			desc:   "view signing key as viewer",
+			method: http.MethodGet,
+			token:  viewerToken,
+			status: http.StatusOK,
+			key:    true,
+		},
+		{
+			desc:   "remove signing key as viewer",
+			method: http.MethodDelete,
+			token:  viewerToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "remove signing key",
+			method: http.MethodDelete,
+			token:  token,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "view removed signing key",
+			method: http.MethodGet,
+			token:  token,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: client,
+			method: tc.method,
+			url:    fmt.Sprintf("%s/orgs/%s/signing-key", ts.URL, or.ID),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body signingKeyRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.key, len(body.PublicKey) == ed25519.PublicKeySize, fmt.Sprintf("%s: expected public key %t got %v", tc.desc, tc.key, body.PublicKey))
+	}
+}
+
+func TestListOrgActivity(t *testing.T) {
+	svc := newService()
+	_, token, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	or, err := svc.CreateOrg(context.Background(), token, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), token, or.ID, viewerMember)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	for i := 0; i < 3; i++ {
+		_, err := svc.Identify(context.Background(), viewerToken)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	}
+	err = svc.Authorize(context.Background(), auth.AuthzReq{Token: viewerToken, Object: or.ID, Subject: auth.OrgSub, Action: auth.Admin})
+	require.NotNil(t, err, "expected authorization error")
+
+	viewerActivity := keyActivityRes{
+		KeyType:   auth.LoginKey,
+		UserID:    viewerID,
+		Email:     viewerEmail,
+		Calls:     4,
+		Errors:    1,
+		ErrorRate: 0.25,
+	}
+
+	cases := []struct {
+		desc   string
+		token  string
+		url    string
+		status int
+		keys   []keyActivityRes
+	}{
+		{
+			desc:   "list org activity",
+			token:  token,
+			url:    fmt.Sprintf("%s/orgs/%s/activity", ts.URL, or.ID),
+			status: http.StatusOK,
+			keys:   []keyActivityRes{viewerActivity},
+		},
+		{
+			desc:   "list org activity past the members",
+			token:  token,
+			url:    fmt.Sprintf("%s/orgs/%s/activity?offset=%d", ts.URL, or.ID, n),
+			status: http.StatusOK,
+			keys:   []keyActivityRes{},
+		},
+		{
+			desc:   "list org activity as viewer",
+			token:  viewerToken,
+			url:    fmt.Sprintf("%s/orgs/%s/activity", ts.URL, or.ID),
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "list org activity with invalid auth token",
+			token:  wrongValue,
+			url:    fmt.Sprintf("%s/orgs/%s/activity", ts.URL, or.ID),
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "list org activity without auth token",
+			token:  "",
+			url:    fmt.Sprintf("%s/orgs/%s/activity", ts.URL, or.ID),
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "list org activity with invalid limit",
+			token:  token,
+			url:    fmt.Sprintf("%s/orgs/%s/activity?limit=%s", ts.URL, or.ID, wrongValue),
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: client,
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body activityPageRes
+		json.NewDecoder(res.Body).Decode(&body)
+		for i := range body.Keys {
+			assert.False(t, body.Keys[i].LastUsed.IsZero(), fmt.Sprintf("%s: expected last used time", tc.desc))
+			body.Keys[i].LastUsed = time.Time{}
+		}
+		assert.ElementsMatch(t, tc.keys, body.Keys, fmt.Sprintf("%s: expected keys %v got %v", tc.desc, tc.keys, body.Keys))
+	}
+}
+
+func TestUpdateQuotas(t *testing.T) {
+	svc := newService()
+	_, token, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: adminID, Subject: adminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	or, err := svc.CreateOrg(context.Background(), token, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignRole(context.Background(), adminID, auth.RoleAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	data := toJSON(updateQuotasReq{Quotas: map[string]uint64{auth.ThingsResource: 10, auth.MembersResource: 5}})
+	unknownData := toJSON(updateQuotasReq{Quotas: map[string]uint64{"webhooks": 10}})
+
+	cases := []struct {
+		desc   string
+		req    string
+		id     string
+		ct     string
+		token  string
+		status int
+	}{
+		{
+			desc:   "update quotas",
+			req:    data,
+			id:     or.ID,
+			ct:     contentType,
+			token:  adminToken,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "update quotas as org owner",
+			req:    data,
+			id:     or.ID,
+			ct:     contentType,
+			token:  token,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "update quotas with unknown resource",
+			req:    unknownData,
+			id:     or.ID,
+			ct:     contentType,
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "update quotas of non-existing org",
+			req:    data,
+			id:     wrongValue,
+			ct:     contentType,
+			token:  adminToken,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "update quotas with invalid auth token",
+			req:    data,
+			id:     or.ID,
+			ct:     contentType,
+			token:  wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "update quotas with invalid request format",
+			req:    "{",
+			id:     or.ID,
+			ct:     contentType,
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "update quotas without content type",
+			req:    data,
+			id:     or.ID,
+			ct:     "",
+			token:  adminToken,
+			status: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      client,
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/orgs/%s/quotas", ts.URL, tc.id),
+			token:       tc.token,
+			contentType: tc.ct,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestViewQuotas(t *testing.T) {
+	svc := newService()
+	_, token, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: adminID, Subject: adminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	or, err := svc.CreateOrg(context.Background(), token, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), token, or.ID, viewerMember)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignRole(context.Background(), adminID, auth.RoleAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.UpdateQuotas(context.Background(), adminToken, or.ID, map[string]uint64{auth.MembersResource: 5})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	quotas := []quotaRes{
+		{Resource: auth.ThingsResource},
+		{Resource: auth.ProfilesResource},
+		{Resource: auth.GroupsResource},
+		{Resource: auth.MembersResource, Limit: 5, Usage: 2},
+	}
+
+	cases := []struct {
+		desc   string
+		token  string
+		id     string
+		status int
+		quotas []quotaRes
+	}{
+		{
+			desc:   "view quotas",
+			token:  token,
+			id:     or.ID,
+			status: http.StatusOK,
+			quotas: quotas,
+		},
+		{
+			desc:   "view quotas as viewer",
+			token:  viewerToken,
+			id:     or.ID,
+			status: http.StatusOK,
+			quotas: quotas,
+		},
+		{
+			desc:   "view quotas with invalid auth token",
+			token:  wrongValue,
+			id:     or.ID,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "view quotas without auth token",
+			token:  "",
+			id:     or.ID,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: client,
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/orgs/%s/quotas", ts.URL, tc.id),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body quotasRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.quotas, body.Quotas, fmt.Sprintf("%s: expected quotas %v got %v", tc.desc, tc.quotas, body.Quotas))
+	}
+}
+
 func TestListOrgs(t *testing.T) {
 	svc := newService()
 	_, token, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
@@ -712,6 +1092,103 @@ func TestBackup(t *testing.T) {
 	}
 }
 
+func TestListOrgAccess(t *testing.T) {
+	svc := newService()
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	o, err := svc.CreateOrg(context.Background(), adminToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignRole(context.Background(), id, auth.RoleAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	data := orgAccessPageRes{
+		OrgAccess: []orgAccessRes{
+			{
+				OrgID:    o.ID,
+				OrgName:  o.Name,
+				MemberID: id,
+				Email:    email,
+				Role:     auth.Owner,
+			},
+		},
+	}
+	csvData := fmt.Sprintf("org_id,org_name,member_id,email,role\n%s,%s,%s,%s,%s\n", o.ID, o.Name, id, email, auth.Owner)
+
+	accessURL := fmt.Sprintf("%s/access-review", ts.URL)
+
+	cases := []struct {
+		desc        string
+		token       string
+		url         string
+		res         string
+		contentType string
+		status      int
+	}{
+		{
+			desc:   "list org access with invalid auth token",
+			token:  wrongValue,
+			url:    accessURL,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "list org access with unauthorized credentials",
+			token:  viewerToken,
+			url:    accessURL,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "list org access with invalid format",
+			token:  adminToken,
+			url:    fmt.Sprintf("%s?format=xml", accessURL),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:        "list org access with admin credentials",
+			token:       adminToken,
+			url:         accessURL,
+			res:         toJSON(data) + "\n",
+			contentType: contentType,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "list org access as csv",
+			token:       adminToken,
+			url:         fmt.Sprintf("%s?format=csv", accessURL),
+			res:         csvData,
+			contentType: "text/csv",
+			status:      http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: client,
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.contentType, res.Header.Get("Content-Type"), fmt.Sprintf("%s: expected content type %s got %s", tc.desc, tc.contentType, res.Header.Get("Content-Type")))
+		assert.Equal(t, tc.res, string(body), fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, body))
+	}
+}
+
 func TestRestore(t *testing.T) {
 	svc := newService()
 	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
@@ -828,6 +1305,44 @@ type orgRes struct {
 	Metadata    map[string]interface{} `json:"metadata"`
 }
 
+type signingKeyRes struct {
+	OrgID     string    `json:"org_id"`
+	PublicKey []byte    `json:"public_key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type keyActivityRes struct {
+	KeyID     string    `json:"key_id"`
+	KeyType   uint32    `json:"key_type"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Calls     uint64    `json:"calls"`
+	Errors    uint64    `json:"errors"`
+	ErrorRate float64   `json:"error_rate"`
+	LastUsed  time.Time `json:"last_used"`
+}
+
+type activityPageRes struct {
+	Total  uint64           `json:"total"`
+	Offset uint64           `json:"offset"`
+	Limit  uint64           `json:"limit"`
+	Keys   []keyActivityRes `json:"keys"`
+}
+
+type updateQuotasReq struct {
+	Quotas map[string]uint64 `json:"quotas"`
+}
+
+type quotaRes struct {
+	Resource string `json:"resource"`
+	Limit    uint64 `json:"limit"`
+	Usage    uint64 `json:"usage"`
+}
+
+type quotasRes struct {
+	Quotas []quotaRes `json:"quotas"`
+}
+
 type orgsPageRes struct {
 	pageRes
 	Orgs []orgRes `json:"orgs"`
@@ -868,3 +1383,15 @@ type backup struct {
 	Orgs       []orgRes         `json:"orgs"`
 	OrgMembers []viewOrgMembers `json:"org_members"`
 }
+
+type orgAccessRes struct {
+	OrgID    string `json:"org_id"`
+	OrgName  string `json:"org_name"`
+	MemberID string `json:"member_id"`
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+}
+
+type orgAccessPageRes struct {
+	OrgAccess []orgAccessRes `json:"org_access"`
+}
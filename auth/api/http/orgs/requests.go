@@ -103,6 +103,43 @@ func (req listOrgsByMemberReq) validate() error {
 	return nil
 }
 
+type listOrgActivityReq struct {
+	token  string
+	id     string
+	offset uint64
+	limit  uint64
+}
+
+func (req listOrgActivityReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type updateQuotasReq struct {
+	token  string
+	id     string
+	Quotas map[string]uint64 `json:"quotas"`
+}
+
+func (req updateQuotasReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
 type orgReq struct {
 	token string
 	id    string
@@ -132,6 +169,23 @@ func (req backupReq) validate() error {
 	return nil
 }
 
+type orgAccessReq struct {
+	token  string
+	format string
+}
+
+func (req orgAccessReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.format != jsonFormat && req.format != csvFormat {
+		return apiutil.ErrInvalidFormat
+	}
+
+	return nil
+}
+
 type restoreReq struct {
 	token      string
 	Orgs       []viewOrgRes     `json:"orgs"`
@@ -13,6 +13,11 @@ var (
 	_ apiutil.Response = (*deleteRes)(nil)
 	_ apiutil.Response = (*backupRes)(nil)
 	_ apiutil.Response = (*restoreRes)(nil)
+	_ apiutil.Response = (*orgAccessPageRes)(nil)
+	_ apiutil.Response = (*accessFileRes)(nil)
+	_ apiutil.Response = (*signingKeyRes)(nil)
+	_ apiutil.Response = (*activityPageRes)(nil)
+	_ apiutil.Response = (*quotasRes)(nil)
 )
 
 type viewOrgRes struct {
@@ -102,12 +107,88 @@ func (res deleteRes) Empty() bool {
 	return true
 }
 
-type viewOrgMembers struct {
-	MemberID  string    `json:"member_id"`
+type signingKeyRes struct {
 	OrgID     string    `json:"org_id"`
-	Role      string    `json:"role"`
+	PublicKey []byte    `json:"public_key"`
 	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	created   bool
+}
+
+func (res signingKeyRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res signingKeyRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res signingKeyRes) Empty() bool {
+	return false
+}
+
+type keyActivityRes struct {
+	KeyID     string    `json:"key_id,omitempty"`
+	KeyType   uint32    `json:"key_type"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Calls     uint64    `json:"calls"`
+	Errors    uint64    `json:"errors"`
+	ErrorRate float64   `json:"error_rate"`
+	LastUsed  time.Time `json:"last_used"`
+}
+
+type activityPageRes struct {
+	Total  uint64           `json:"total"`
+	Offset uint64           `json:"offset"`
+	Limit  uint64           `json:"limit"`
+	Keys   []keyActivityRes `json:"keys"`
+}
+
+func (res activityPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res activityPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res activityPageRes) Empty() bool {
+	return false
+}
+
+type quotaRes struct {
+	Resource string `json:"resource"`
+	Limit    uint64 `json:"limit"`
+	Usage    uint64 `json:"usage"`
+}
+
+type quotasRes struct {
+	Quotas []quotaRes `json:"quotas"`
+}
+
+func (res quotasRes) Code() int {
+	return http.StatusOK
+}
+
+func (res quotasRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res quotasRes) Empty() bool {
+	return false
+}
+
+type viewOrgMembers struct {
+	MemberID  string     `json:"member_id"`
+	OrgID     string     `json:"org_id"`
+	Role      string     `json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
 
 type backupRes struct {
@@ -127,6 +208,49 @@ func (res backupRes) Empty() bool {
 	return false
 }
 
+type orgAccessRes struct {
+	OrgID    string `json:"org_id"`
+	OrgName  string `json:"org_name"`
+	MemberID string `json:"member_id"`
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+}
+
+type orgAccessPageRes struct {
+	OrgAccess []orgAccessRes `json:"org_access"`
+}
+
+func (res orgAccessPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res orgAccessPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res orgAccessPageRes) Empty() bool {
+	return false
+}
+
+type accessFileRes struct {
+	name    string
+	content []byte
+}
+
+func (res accessFileRes) Code() int {
+	return http.StatusOK
+}
+
+func (res accessFileRes) Headers() map[string]string {
+	return map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s.%s"`, res.name, csvFormat),
+	}
+}
+
+func (res accessFileRes) Empty() bool {
+	return false
+}
+
 type restoreRes struct{}
 
 func (res restoreRes) Code() int {
@@ -17,15 +17,19 @@ import (
 )
 
 const (
-	contentType = "application/json"
-	offsetKey   = "offset"
-	limitKey    = "limit"
-	metadataKey = "metadata"
-	nameKey     = "name"
-	defOffset   = 0
-	defLimit    = 10
-	orgIDKey    = "orgID"
-	idKey       = "id"
+	contentType    = "application/json"
+	csvContentType = "text/csv"
+	offsetKey      = "offset"
+	limitKey       = "limit"
+	metadataKey    = "metadata"
+	nameKey        = "name"
+	formatKey      = "format"
+	jsonFormat     = "json"
+	csvFormat      = "csv"
+	defOffset      = 0
+	defLimit       = 10
+	orgIDKey       = "orgID"
+	idKey          = "id"
 )
 
 // MakeHandler returns a HTTP handler for API endpoints.
@@ -61,6 +65,48 @@ func MakeHandler(svc auth.Service, mux *bone.Mux, tracer opentracing.Tracer, log
 		opts...,
 	))
 
+	mux.Post("/orgs/:id/signing-key", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_signing_key")(createSigningKeyEndpoint(svc)),
+		decodeOrgRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/orgs/:id/signing-key", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_signing_key")(viewSigningKeyEndpoint(svc)),
+		decodeOrgRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/orgs/:id/signing-key", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_signing_key")(removeSigningKeyEndpoint(svc)),
+		decodeOrgRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/orgs/:id/activity", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_org_activity")(listOrgActivityEndpoint(svc)),
+		decodeListOrgActivity,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Put("/orgs/:id/quotas", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_quotas")(updateQuotasEndpoint(svc)),
+		decodeUpdateQuotas,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/orgs/:id/quotas", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_quotas")(viewQuotasEndpoint(svc)),
+		decodeOrgRequest,
+		encodeResponse,
+		opts...,
+	))
+
 	mux.Get("/orgs", kithttp.NewServer(
 		kitot.TraceServer(tracer, "list_orgs")(listOrgsEndpoint(svc)),
 		decodeListOrgs,
@@ -82,6 +128,13 @@ func MakeHandler(svc auth.Service, mux *bone.Mux, tracer opentracing.Tracer, log
 		opts...,
 	))
 
+	mux.Get("/access-review", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_org_access")(listOrgAccessEndpoint(svc)),
+		decodeListOrgAccess,
+		encodeFileResponse,
+		opts...,
+	))
+
 	mux.Post("/restore", kithttp.NewServer(
 		kitot.TraceServer(tracer, "restore")(restoreEndpoint(svc)),
 		decodeRestore,
@@ -184,6 +237,27 @@ func decodeListOrgsByMember(_ context.Context, r *http.Request) (interface{}, er
 	return req, nil
 }
 
+func decodeListOrgActivity(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadUintQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listOrgActivityReq{
+		token:  apiutil.ExtractBearerToken(r),
+		id:     bone.GetValue(r, idKey),
+		offset: o,
+		limit:  l,
+	}
+
+	return req, nil
+}
+
 func decodeCreateOrgs(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
 		return nil, apiutil.ErrUnsupportedContentType
@@ -213,6 +287,22 @@ func decodeUpdateOrg(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeUpdateQuotas(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateQuotasReq{
+		id:    bone.GetValue(r, idKey),
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
 func decodeOrgRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	req := orgReq{
 		token: apiutil.ExtractBearerToken(r),
@@ -230,6 +320,20 @@ func decodeBackup(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeListOrgAccess(_ context.Context, r *http.Request) (interface{}, error) {
+	format, err := apiutil.ReadStringQuery(r, formatKey, jsonFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	req := orgAccessReq{
+		token:  apiutil.ExtractBearerToken(r),
+		format: format,
+	}
+
+	return req, nil
+}
+
 func decodeRestore(_ context.Context, r *http.Request) (interface{}, error) {
 	req := restoreReq{
 		token: apiutil.ExtractBearerToken(r),
@@ -260,15 +364,33 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeFileResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	fr, ok := response.(accessFileRes)
+	if !ok {
+		return encodeResponse(ctx, w, response)
+	}
+
+	w.Header().Set("Content-Type", csvContentType)
+	for k, v := range fr.Headers() {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(fr.Code())
+
+	_, err := w.Write(fr.content)
+	return err
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, apiutil.ErrMalformedEntity),
+		errors.Contains(err, auth.ErrUnknownResource),
 		err == apiutil.ErrMissingID,
 		err == apiutil.ErrEmptyList,
 		err == apiutil.ErrMissingMemberType,
 		err == apiutil.ErrNameSize,
 		err == apiutil.ErrInvalidMemberRole,
-		err == apiutil.ErrInvalidQueryParams:
+		err == apiutil.ErrInvalidQueryParams,
+		err == apiutil.ErrInvalidFormat:
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrAuthentication),
 		err == apiutil.ErrBearerToken:
@@ -277,7 +399,8 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		w.WriteHeader(http.StatusNotFound)
 	case errors.Contains(err, errors.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
-	case errors.Contains(err, errors.ErrAuthorization):
+	case errors.Contains(err, errors.ErrAuthorization),
+		errors.Contains(err, errors.ErrQuotaExceeded):
 		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, auth.ErrOrgMemberAlreadyAssigned):
 		w.WriteHeader(http.StatusConflict)
@@ -296,7 +419,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
@@ -0,0 +1,72 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package overview
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func viewOverviewEndpoint(svc auth.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewOverviewReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ov, err := svc.ViewOverview(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildOverviewResponse(ov), nil
+	}
+}
+
+func buildOverviewResponse(ov auth.Overview) overviewRes {
+	res := overviewRes{
+		Counts: countsRes{
+			Orgs:     ov.Orgs,
+			Users:    ov.Users,
+			Things:   ov.Things,
+			Profiles: ov.Profiles,
+			Groups:   ov.Groups,
+		},
+		Throughput: throughputRes{
+			InMsgs:      ov.Throughput.InMsgs,
+			OutMsgs:     ov.Throughput.OutMsgs,
+			InBytes:     ov.Throughput.InBytes,
+			OutBytes:    ov.Throughput.OutBytes,
+			InMsgsRate:  ov.Throughput.InMsgsRate,
+			OutMsgsRate: ov.Throughput.OutMsgsRate,
+		},
+		Consumers:   []consumerLagRes{},
+		Services:    []serviceHealthRes{},
+		Unavailable: []string{},
+	}
+
+	for _, cl := range ov.Consumers {
+		res.Consumers = append(res.Consumers, consumerLagRes{
+			Stream:  cl.Stream,
+			Group:   cl.Group,
+			Pending: cl.Pending,
+			Lag:     cl.Lag,
+		})
+	}
+
+	for _, sh := range ov.Services {
+		res.Services = append(res.Services, serviceHealthRes{
+			Name:    sh.Name,
+			Status:  sh.Status,
+			Version: sh.Version,
+			Error:   sh.Error,
+		})
+	}
+
+	res.Unavailable = append(res.Unavailable, ov.Unavailable...)
+
+	return res
+}
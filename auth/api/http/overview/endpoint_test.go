@@ -0,0 +1,201 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package overview_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	httpapi "github.com/MainfluxLabs/mainflux/auth/api/http"
+	"github.com/MainfluxLabs/mainflux/auth/jwt"
+	"github.com/MainfluxLabs/mainflux/auth/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secret        = "secret"
+	id            = "123e4567-e89b-12d3-a456-000000000001"
+	email         = "user@example.com"
+	viewerID      = "viewerID"
+	viewerEmail   = "viewer@example.com"
+	wrongValue    = "wrong_value"
+	groupID       = "groupID"
+	loginDuration = 30 * time.Minute
+)
+
+var (
+	org        = auth.Org{Name: "testName"}
+	usersByIDs = map[string]users.User{id: {ID: id, Email: email}, viewerID: {ID: viewerID, Email: viewerEmail}}
+	throughput = auth.Throughput{InMsgs: 100, OutMsgs: 200, InBytes: 1000, OutBytes: 2000, InMsgsRate: 1.5, OutMsgsRate: 3}
+	services   = []auth.ServiceHealth{{Name: "things", Status: "pass", Version: "0.0.0"}, {Name: "users", Status: "fail", Error: "connection refused"}}
+)
+
+type testRequest struct {
+	client *http.Client
+	method string
+	url    string
+	token  string
+	body   io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	req.Header.Set("Referer", "http://localhost")
+	return tr.client.Do(req)
+}
+
+type countsRes struct {
+	Orgs     uint64 `json:"orgs"`
+	Users    uint64 `json:"users"`
+	Things   uint64 `json:"things"`
+	Profiles uint64 `json:"profiles"`
+	Groups   uint64 `json:"groups"`
+}
+
+type throughputRes struct {
+	InMsgs      uint64  `json:"in_msgs"`
+	OutMsgs     uint64  `json:"out_msgs"`
+	InBytes     uint64  `json:"in_bytes"`
+	OutBytes    uint64  `json:"out_bytes"`
+	InMsgsRate  float64 `json:"in_msgs_rate"`
+	OutMsgsRate float64 `json:"out_msgs_rate"`
+}
+
+type serviceHealthRes struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Version string `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type overviewRes struct {
+	Counts      countsRes          `json:"counts"`
+	Throughput  throughputRes      `json:"throughput"`
+	Consumers   []interface{}      `json:"consumers"`
+	Services    []serviceHealthRes `json:"services"`
+	Unavailable []string           `json:"unavailable"`
+}
+
+func newService() auth.Service {
+	membsRepo := mocks.NewMembersRepository()
+	orgsRepo := mocks.NewOrgRepository(membsRepo)
+	rolesRepo := mocks.NewRolesRepository()
+	keysRepo := mocks.NewKeyRepository()
+
+	idProvider := uuid.NewMock()
+	t := jwt.New(secret)
+	uc := mocks.NewUsersService(usersByIDs, map[string]users.User{})
+	tc := thmocks.NewThingsServiceClient(nil, nil, map[string]things.Group{groupID: {ID: groupID}})
+	// The consumer lags are reported as unavailable.
+	monitor := mocks.NewMonitor(throughput, nil, services)
+
+	return auth.New(orgsRepo, tc, uc, monitor, mocks.NewEmailer(), keysRepo, rolesRepo, membsRepo, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idProvider, t, loginDuration, "")
+}
+
+func newServer(svc auth.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(svc, mocktracer.New(), logger)
+	return httptest.NewServer(mux)
+}
+
+func TestViewOverview(t *testing.T) {
+	svc := newService()
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	_, err = svc.CreateOrg(context.Background(), adminToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignRole(context.Background(), id, auth.RoleAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	data := overviewRes{
+		Counts:      countsRes{Orgs: 1, Users: uint64(len(usersByIDs)), Groups: 1},
+		Throughput:  throughputRes(throughput),
+		Consumers:   []interface{}{},
+		Services:    []serviceHealthRes{},
+		Unavailable: []string{auth.ConsumersSection},
+	}
+	for _, sh := range services {
+		data.Services = append(data.Services, serviceHealthRes(sh))
+	}
+
+	cases := []struct {
+		desc   string
+		token  string
+		res    overviewRes
+		status int
+	}{
+		{
+			desc:   "view overview with admin credentials",
+			token:  adminToken,
+			res:    data,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "view overview with invalid auth token",
+			token:  wrongValue,
+			res:    overviewRes{},
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "view overview without auth token",
+			token:  "",
+			res:    overviewRes{},
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "view overview with unauthorized credentials",
+			token:  viewerToken,
+			res:    overviewRes{},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: client,
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/overview", ts.URL),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+
+		var body overviewRes
+		err = json.NewDecoder(res.Body).Decode(&body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package overview
+
+import "github.com/MainfluxLabs/mainflux/pkg/apiutil"
+
+type viewOverviewReq struct {
+	token string
+}
+
+func (req viewOverviewReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
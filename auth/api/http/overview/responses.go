@@ -0,0 +1,63 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package overview
+
+import (
+	"net/http"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var _ apiutil.Response = (*overviewRes)(nil)
+
+type countsRes struct {
+	Orgs     uint64 `json:"orgs"`
+	Users    uint64 `json:"users"`
+	Things   uint64 `json:"things"`
+	Profiles uint64 `json:"profiles"`
+	Groups   uint64 `json:"groups"`
+}
+
+type throughputRes struct {
+	InMsgs      uint64  `json:"in_msgs"`
+	OutMsgs     uint64  `json:"out_msgs"`
+	InBytes     uint64  `json:"in_bytes"`
+	OutBytes    uint64  `json:"out_bytes"`
+	InMsgsRate  float64 `json:"in_msgs_rate"`
+	OutMsgsRate float64 `json:"out_msgs_rate"`
+}
+
+type consumerLagRes struct {
+	Stream  string `json:"stream"`
+	Group   string `json:"group"`
+	Pending uint64 `json:"pending"`
+	Lag     uint64 `json:"lag"`
+}
+
+type serviceHealthRes struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Version string `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type overviewRes struct {
+	Counts      countsRes          `json:"counts"`
+	Throughput  throughputRes      `json:"throughput"`
+	Consumers   []consumerLagRes   `json:"consumers"`
+	Services    []serviceHealthRes `json:"services"`
+	Unavailable []string           `json:"unavailable"`
+}
+
+func (res overviewRes) Code() int {
+	return http.StatusOK
+}
+
+func (res overviewRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res overviewRes) Empty() bool {
+	return false
+}
@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/auth/api/http/authz"
 	"github.com/MainfluxLabs/mainflux/auth/api/http/keys"
 	"github.com/MainfluxLabs/mainflux/auth/api/http/members"
 	"github.com/MainfluxLabs/mainflux/auth/api/http/orgs"
+	"github.com/MainfluxLabs/mainflux/auth/api/http/overview"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux"
 	"github.com/go-zoo/bone"
@@ -22,6 +24,8 @@ func MakeHandler(svc auth.Service, tracer opentracing.Tracer, logger logger.Logg
 	mux = orgs.MakeHandler(svc, mux, tracer, logger)
 	mux = keys.MakeHandler(svc, mux, tracer, logger)
 	mux = members.MakeHandler(svc, mux, tracer, logger)
+	mux = overview.MakeHandler(svc, mux, tracer, logger)
+	mux = authz.MakeHandler(svc, mux, tracer, logger)
 	mux.GetFunc("/health", mainflux.Health("auth"))
 	mux.Handle("/metrics", promhttp.Handler())
 	return mux
@@ -7,6 +7,7 @@ package api
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"time"
 
@@ -34,10 +35,10 @@ func (lm *loggingMiddleware) Issue(ctx context.Context, token string, newKey aut
 		}
 		message := fmt.Sprintf("Method issue for %s took %s to complete", d, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Issue(ctx, token, newKey)
@@ -47,10 +48,10 @@ func (lm *loggingMiddleware) Revoke(ctx context.Context, token, id string) (err
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method revoke for key %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Revoke(ctx, token, id)
@@ -60,23 +61,36 @@ func (lm *loggingMiddleware) RetrieveKey(ctx context.Context, token, id string)
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method retrieve for key %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RetrieveKey(ctx, token, id)
 }
 
+func (lm *loggingMiddleware) IdentifyDelegated(ctx context.Context, token string) (key auth.Key, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method identify_delegated took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.IdentifyDelegated(ctx, token)
+}
+
 func (lm *loggingMiddleware) Identify(ctx context.Context, key string) (id auth.Identity, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method identify took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Identify(ctx, key)
@@ -86,22 +100,35 @@ func (lm *loggingMiddleware) Authorize(ctx context.Context, ar auth.AuthzReq) (e
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method authorize took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 	return lm.svc.Authorize(ctx, ar)
 }
 
+func (lm *loggingMiddleware) CheckAuthz(ctx context.Context, token string, ars []auth.AuthzReq) (allowed []bool, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method check_authz for %d requests took %s to complete", len(ars), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CheckAuthz(ctx, token, ars)
+}
+
 func (lm *loggingMiddleware) CreateOrg(ctx context.Context, token string, org auth.Org) (o auth.Org, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method create_org for name %s took %s to complete", org.Name, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateOrg(ctx, token, org)
@@ -111,10 +138,10 @@ func (lm *loggingMiddleware) UpdateOrg(ctx context.Context, token string, org au
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_org for name %s took %s to complete", org.Name, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateOrg(ctx, token, org)
@@ -124,10 +151,10 @@ func (lm *loggingMiddleware) RemoveOrg(ctx context.Context, token string, id str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_org for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveOrg(ctx, token, id)
@@ -137,10 +164,10 @@ func (lm *loggingMiddleware) ViewOrg(ctx context.Context, token, id string) (o a
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_org for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewOrg(ctx, token, id)
@@ -150,10 +177,10 @@ func (lm *loggingMiddleware) ListOrgs(ctx context.Context, token string, pm auth
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_orgs took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListOrgs(ctx, token, pm)
@@ -163,23 +190,49 @@ func (lm *loggingMiddleware) ViewMember(ctx context.Context, token, orgID, membe
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_member for org id %s and member id %s took %s to complete", orgID, memberID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewMember(ctx, token, orgID, memberID)
 }
 
+func (lm *loggingMiddleware) ExpireMembers(ctx context.Context, now time.Time, notice time.Duration) (me auth.MembersExpiry, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method expire_members revoked %d and found %d expiring memberships and took %s to complete", len(me.Expired), len(me.Expiring), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ExpireMembers(ctx, now, notice)
+}
+
+func (lm *loggingMiddleware) FilterOrgMembers(ctx context.Context, orgID string, memberIDs ...string) (ids []string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method filter_org_members for org id %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.FilterOrgMembers(ctx, orgID, memberIDs...)
+}
+
 func (lm *loggingMiddleware) ListMembersByOrg(ctx context.Context, token, orgID string, pm auth.PageMetadata) (op auth.OrgMembersPage, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_members_by_org for org id %s took %s to complete", orgID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListMembersByOrg(ctx, token, orgID, pm)
@@ -189,10 +242,10 @@ func (lm *loggingMiddleware) ListOrgsByMember(ctx context.Context, token, member
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_orgs_by_member for member id %s took %s to complete", memberID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListOrgsByMember(ctx, token, memberID, pm)
@@ -202,10 +255,10 @@ func (lm *loggingMiddleware) AssignMembers(ctx context.Context, token, orgID str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method assign_members for members %s and org id %s took %s to complete", oms, orgID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.AssignMembers(ctx, token, orgID, oms...)
@@ -215,10 +268,10 @@ func (lm *loggingMiddleware) UnassignMembers(ctx context.Context, token string,
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method unassign_members for member ids %s and org id %s took %s to complete", memberIDs, orgID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UnassignMembers(ctx, token, orgID, memberIDs...)
@@ -228,10 +281,10 @@ func (lm *loggingMiddleware) UpdateMembers(ctx context.Context, token, orgID str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_members for members %s and org id %s took %s to complete", oms, orgID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateMembers(ctx, token, orgID, oms...)
@@ -241,10 +294,10 @@ func (lm *loggingMiddleware) Backup(ctx context.Context, token string) (backup a
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method backup took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Backup(ctx, token)
@@ -254,20 +307,111 @@ func (lm *loggingMiddleware) Restore(ctx context.Context, token string, backup a
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method restore took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Restore(ctx, token, backup)
 }
 
+func (lm *loggingMiddleware) ListOrgAccess(ctx context.Context, token string) (oas []auth.OrgAccess, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_org_access took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListOrgAccess(ctx, token)
+}
+
+func (lm *loggingMiddleware) ViewOverview(ctx context.Context, token string) (ov auth.Overview, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_overview took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewOverview(ctx, token)
+}
+
+func (lm *loggingMiddleware) UpdateQuotas(ctx context.Context, token, orgID string, limits map[string]uint64) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_quotas for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateQuotas(ctx, token, orgID, limits)
+}
+
+func (lm *loggingMiddleware) ViewQuotas(ctx context.Context, token, orgID string) (qs []auth.Quota, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_quotas for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewQuotas(ctx, token, orgID)
+}
+
+func (lm *loggingMiddleware) CheckQuota(ctx context.Context, orgID, resource string, usage, count uint64) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method check_quota for org %s and resource %s took %s to complete", orgID, resource, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CheckQuota(ctx, orgID, resource, usage, count)
+}
+
+func (lm *loggingMiddleware) RemoveUser(ctx context.Context, id string) (orgIDs []string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_user for user %s removed %d orgs and took %s to complete", id, len(orgIDs), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveUser(ctx, id)
+}
+
+func (lm *loggingMiddleware) ListActivityByOrg(ctx context.Context, token, orgID string, pm auth.PageMetadata) (ap auth.ActivityPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_activity_by_org for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListActivityByOrg(ctx, token, orgID, pm)
+}
+
 func (lm *loggingMiddleware) AssignRole(ctx context.Context, id, role string) (err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method assign_role for id %s and role %s took %s to complete", id, role, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
 	}(time.Now())
@@ -279,10 +423,75 @@ func (lm *loggingMiddleware) RetrieveRole(ctx context.Context, id string) (role
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method retrieve_role for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
 	}(time.Now())
 
 	return lm.svc.RetrieveRole(ctx, id)
 }
+
+func (lm *loggingMiddleware) CreateSigningKey(ctx context.Context, token, orgID string) (sk auth.SigningKey, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_signing_key for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateSigningKey(ctx, token, orgID)
+}
+
+func (lm *loggingMiddleware) ViewSigningKey(ctx context.Context, token, orgID string) (sk auth.SigningKey, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_signing_key for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewSigningKey(ctx, token, orgID)
+}
+
+func (lm *loggingMiddleware) RemoveSigningKey(ctx context.Context, token, orgID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_signing_key for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveSigningKey(ctx, token, orgID)
+}
+
+func (lm *loggingMiddleware) SignPayload(ctx context.Context, secret, orgID string, payload []byte) (signature []byte, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method sign_payload for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.SignPayload(ctx, secret, orgID, payload)
+}
+
+func (lm *loggingMiddleware) RetrieveSigningKey(ctx context.Context, orgID string) (key ed25519.PublicKey, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method retrieve_signing_key for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RetrieveSigningKey(ctx, orgID)
+}
@@ -7,6 +7,7 @@ package api
 
 import (
 	"context"
+	"crypto/ed25519"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
@@ -57,6 +58,15 @@ func (ms *metricsMiddleware) RetrieveKey(ctx context.Context, token, id string)
 	return ms.svc.RetrieveKey(ctx, token, id)
 }
 
+func (ms *metricsMiddleware) IdentifyDelegated(ctx context.Context, token string) (auth.Key, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "identify_delegated").Add(1)
+		ms.latency.With("method", "identify_delegated").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.IdentifyDelegated(ctx, token)
+}
+
 func (ms *metricsMiddleware) Identify(ctx context.Context, token string) (auth.Identity, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "identify").Add(1)
@@ -74,6 +84,15 @@ func (ms *metricsMiddleware) Authorize(ctx context.Context, ar auth.AuthzReq) er
 	return ms.svc.Authorize(ctx, ar)
 }
 
+func (ms *metricsMiddleware) CheckAuthz(ctx context.Context, token string, ars []auth.AuthzReq) ([]bool, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "check_authz").Add(1)
+		ms.latency.With("method", "check_authz").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CheckAuthz(ctx, token, ars)
+}
+
 func (ms *metricsMiddleware) CreateOrg(ctx context.Context, token string, org auth.Org) (auth.Org, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "create_org").Add(1)
@@ -161,6 +180,24 @@ func (ms *metricsMiddleware) ViewMember(ctx context.Context, token, orgID, membe
 	return ms.svc.ViewMember(ctx, token, orgID, memberID)
 }
 
+func (ms *metricsMiddleware) ExpireMembers(ctx context.Context, now time.Time, notice time.Duration) (auth.MembersExpiry, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "expire_members").Add(1)
+		ms.latency.With("method", "expire_members").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ExpireMembers(ctx, now, notice)
+}
+
+func (ms *metricsMiddleware) FilterOrgMembers(ctx context.Context, orgID string, memberIDs ...string) ([]string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "filter_org_members").Add(1)
+		ms.latency.With("method", "filter_org_members").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.FilterOrgMembers(ctx, orgID, memberIDs...)
+}
+
 func (ms *metricsMiddleware) ListMembersByOrg(ctx context.Context, token, orgID string, pm auth.PageMetadata) (auth.OrgMembersPage, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "list_members_by_org").Add(1)
@@ -188,6 +225,69 @@ func (ms *metricsMiddleware) Restore(ctx context.Context, token string, backup a
 	return ms.svc.Restore(ctx, token, backup)
 }
 
+func (ms *metricsMiddleware) ListOrgAccess(ctx context.Context, token string) ([]auth.OrgAccess, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_org_access").Add(1)
+		ms.latency.With("method", "list_org_access").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListOrgAccess(ctx, token)
+}
+
+func (ms *metricsMiddleware) ViewOverview(ctx context.Context, token string) (auth.Overview, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_overview").Add(1)
+		ms.latency.With("method", "view_overview").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewOverview(ctx, token)
+}
+
+func (ms *metricsMiddleware) UpdateQuotas(ctx context.Context, token, orgID string, limits map[string]uint64) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_quotas").Add(1)
+		ms.latency.With("method", "update_quotas").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateQuotas(ctx, token, orgID, limits)
+}
+
+func (ms *metricsMiddleware) ViewQuotas(ctx context.Context, token, orgID string) ([]auth.Quota, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_quotas").Add(1)
+		ms.latency.With("method", "view_quotas").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewQuotas(ctx, token, orgID)
+}
+
+func (ms *metricsMiddleware) CheckQuota(ctx context.Context, orgID, resource string, usage, count uint64) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "check_quota").Add(1)
+		ms.latency.With("method", "check_quota").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CheckQuota(ctx, orgID, resource, usage, count)
+}
+
+func (ms *metricsMiddleware) RemoveUser(ctx context.Context, id string) ([]string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_user").Add(1)
+		ms.latency.With("method", "remove_user").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveUser(ctx, id)
+}
+
+func (ms *metricsMiddleware) ListActivityByOrg(ctx context.Context, token, orgID string, pm auth.PageMetadata) (auth.ActivityPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_activity_by_org").Add(1)
+		ms.latency.With("method", "list_activity_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListActivityByOrg(ctx, token, orgID, pm)
+}
+
 func (ms *metricsMiddleware) AssignRole(ctx context.Context, id, role string) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "assign_role").Add(1)
@@ -205,3 +305,48 @@ func (ms *metricsMiddleware) RetrieveRole(ctx context.Context, id string) (strin
 
 	return ms.svc.RetrieveRole(ctx, id)
 }
+
+func (ms *metricsMiddleware) CreateSigningKey(ctx context.Context, token, orgID string) (auth.SigningKey, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_signing_key").Add(1)
+		ms.latency.With("method", "create_signing_key").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateSigningKey(ctx, token, orgID)
+}
+
+func (ms *metricsMiddleware) ViewSigningKey(ctx context.Context, token, orgID string) (auth.SigningKey, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_signing_key").Add(1)
+		ms.latency.With("method", "view_signing_key").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewSigningKey(ctx, token, orgID)
+}
+
+func (ms *metricsMiddleware) RemoveSigningKey(ctx context.Context, token, orgID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_signing_key").Add(1)
+		ms.latency.With("method", "remove_signing_key").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveSigningKey(ctx, token, orgID)
+}
+
+func (ms *metricsMiddleware) SignPayload(ctx context.Context, secret, orgID string, payload []byte) ([]byte, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "sign_payload").Add(1)
+		ms.latency.With("method", "sign_payload").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.SignPayload(ctx, secret, orgID, payload)
+}
+
+func (ms *metricsMiddleware) RetrieveSigningKey(ctx context.Context, orgID string) (ed25519.PublicKey, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "retrieve_signing_key").Add(1)
+		ms.latency.With("method", "retrieve_signing_key").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RetrieveSigningKey(ctx, orgID)
+}
@@ -0,0 +1,13 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import "time"
+
+// Emailer sends the emails about the org memberships.
+type Emailer interface {
+	// SendMembershipExpiry notifies the member that the membership of the
+	// org expires at the provided time.
+	SendMembershipExpiry(to []string, orgName string, expiresAt time.Time) error
+}
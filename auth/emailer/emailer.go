@@ -0,0 +1,28 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+package emailer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/email"
+)
+
+var _ auth.Emailer = (*emailer)(nil)
+
+type emailer struct {
+	agent *email.Agent
+}
+
+// New creates new emailer utility
+func New(c *email.Config) (auth.Emailer, error) {
+	e, err := email.New(c)
+	return &emailer{agent: e}, err
+}
+
+func (e *emailer) SendMembershipExpiry(To []string, orgName string, expiresAt time.Time) error {
+	content := fmt.Sprintf("Your membership of the org %s expires at %s.", orgName, expiresAt.UTC().Format(time.RFC1123))
+	return e.agent.Send(To, "", "Org membership expiry", "", content, "")
+}
@@ -17,10 +17,12 @@ type claims struct {
 	jwt.StandardClaims
 	IssuerID string  `json:"issuer_id,omitempty"`
 	Type     *uint32 `json:"type,omitempty"`
+	ThingID  string  `json:"thing_id,omitempty"`
+	Action   string  `json:"action,omitempty"`
 }
 
 func (c claims) Valid() error {
-	if c.Type == nil || *c.Type > auth.APIKey || c.Issuer != issuerName {
+	if c.Type == nil || *c.Type > auth.DelegatedKey || c.Issuer != issuerName {
 		return errors.ErrMalformedEntity
 	}
 
@@ -45,6 +47,8 @@ func (svc tokenizer) Issue(key auth.Key) (string, error) {
 		},
 		IssuerID: key.IssuerID,
 		Type:     &key.Type,
+		ThingID:  key.Scope.ThingID,
+		Action:   key.Scope.Action,
 	}
 
 	if !key.ExpiresAt.IsZero() {
@@ -69,9 +73,9 @@ func (svc tokenizer) Parse(token string) (auth.Key, error) {
 
 	if err != nil {
 		if e, ok := err.(*jwt.ValidationError); ok && e.Errors == jwt.ValidationErrorExpired {
-			// Expired User key needs to be revoked.
-			if c.Type != nil && *c.Type == auth.APIKey {
-				return c.toKey(), auth.ErrAPIKeyExpired
+			// Expired User and delegated keys need to be revoked.
+			if key := c.toKey(); c.Type != nil && key.Persisted() {
+				return key, auth.ErrAPIKeyExpired
 			}
 			return auth.Key{}, errors.Wrap(auth.ErrKeyExpired, err)
 		}
@@ -87,6 +91,7 @@ func (c claims) toKey() auth.Key {
 		IssuerID: c.IssuerID,
 		Subject:  c.Subject,
 		IssuedAt: time.Unix(c.IssuedAt, 0).UTC(),
+		Scope:    auth.Scope{ThingID: c.ThingID, Action: c.Action},
 	}
 	if c.ExpiresAt != 0 {
 		key.ExpiresAt = time.Unix(c.ExpiresAt, 0).UTC()
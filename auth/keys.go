@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 )
 
 var (
@@ -20,6 +21,13 @@ var (
 	// ErrAPIKeyExpired indicates that the Key is expired
 	// and that the key type is API key.
 	ErrAPIKeyExpired = errors.New("use of expired API key")
+
+	// ErrInvalidScope indicates that the delegated Key scope is invalid.
+	ErrInvalidScope = errors.New("invalid delegated key scope")
+
+	// ErrInvalidKeyDuration indicates that the delegated Key duration
+	// exceeds the maximum duration.
+	ErrInvalidKeyDuration = errors.New("invalid delegated key duration")
 )
 
 const (
@@ -29,8 +37,28 @@ const (
 	RecoveryKey
 	// APIKey enables the one to act on behalf of the user.
 	APIKey
+	// DelegatedKey enables the one to perform the single action on the
+	// single thing on behalf of the user, e.g. to embed it in the
+	// shareable dashboard links.
+	DelegatedKey
+)
+
+const (
+	// SubscribeAction allows subscribing to the messages of the thing.
+	SubscribeAction = "subscribe"
+
+	// MaxDelegatedDuration is the maximum duration of the delegated key.
+	MaxDelegatedDuration = 24 * time.Hour
+
+	defDelegatedDuration = time.Hour
 )
 
+// Scope restricts the delegated key to the single action on the thing.
+type Scope struct {
+	ThingID string
+	Action  string
+}
+
 // Key represents API key.
 type Key struct {
 	ID        string
@@ -39,6 +67,7 @@ type Key struct {
 	Subject   string
 	IssuedAt  time.Time
 	ExpiresAt time.Time
+	Scope     Scope
 }
 
 // Identity contains ID and Email.
@@ -47,6 +76,11 @@ type Identity struct {
 	Email string
 }
 
+// Persisted verifies if the key is persisted, so that it can be revoked.
+func (k Key) Persisted() bool {
+	return k.Type == APIKey || k.Type == DelegatedKey
+}
+
 // Expired verifies if the key is expired.
 func (k Key) Expired() bool {
 	if k.Type == APIKey && k.ExpiresAt.IsZero() {
@@ -68,6 +102,10 @@ type Keys interface {
 	// RetrieveKey retrieves data for the Key identified by the provided
 	// ID, that is issued by the user identified by the provided key.
 	RetrieveKey(ctx context.Context, token, id string) (Key, error)
+
+	// IdentifyDelegated validates the delegated key token and returns
+	// the key, whose scope the caller must check against the request.
+	IdentifyDelegated(ctx context.Context, token string) (Key, error)
 }
 
 // KeyRepository specifies Key persistence API.
@@ -81,6 +119,9 @@ type KeyRepository interface {
 
 	// Remove removes Key with provided ID.
 	Remove(context.Context, string, string) error
+
+	// RemoveByIssuer removes all Keys issued by the user with provided ID.
+	RemoveByIssuer(context.Context, string) error
 }
 
 func (svc service) Issue(ctx context.Context, token string, key Key) (Key, string, error) {
@@ -90,6 +131,8 @@ func (svc service) Issue(ctx context.Context, token string, key Key) (Key, strin
 	switch key.Type {
 	case APIKey:
 		return svc.userKey(ctx, token, key)
+	case DelegatedKey:
+		return svc.delegatedKey(ctx, token, key)
 	case RecoveryKey:
 		return svc.tmpKey(recoveryDuration, key)
 	default:
@@ -145,3 +188,46 @@ func (svc service) userKey(ctx context.Context, token string, key Key) (Key, str
 
 	return key, secret, nil
 }
+
+func (svc service) IdentifyDelegated(ctx context.Context, token string) (Key, error) {
+	key, err := svc.tokenizer.Parse(token)
+	if err == ErrAPIKeyExpired {
+		err = svc.keys.Remove(ctx, key.IssuerID, key.ID)
+		return Key{}, errors.Wrap(ErrKeyExpired, err)
+	}
+	if err != nil {
+		return Key{}, errors.Wrap(errIdentify, err)
+	}
+
+	if key.Type != DelegatedKey {
+		return Key{}, errors.ErrAuthentication
+	}
+
+	// The delegated key is revoked by removing it.
+	if _, err := svc.keys.Retrieve(ctx, key.IssuerID, key.ID); err != nil {
+		return Key{}, errors.ErrAuthentication
+	}
+
+	return key, nil
+}
+
+func (svc service) delegatedKey(ctx context.Context, token string, key Key) (Key, string, error) {
+	if key.Scope.ThingID == "" || key.Scope.Action != SubscribeAction {
+		return Key{}, "", ErrInvalidScope
+	}
+
+	if key.ExpiresAt.IsZero() {
+		key.ExpiresAt = key.IssuedAt.Add(defDelegatedDuration)
+	}
+	if !key.ExpiresAt.After(key.IssuedAt) || key.ExpiresAt.Sub(key.IssuedAt) > MaxDelegatedDuration {
+		return Key{}, "", ErrInvalidKeyDuration
+	}
+
+	// The user can delegate the access to the thing it can view only.
+	ar := &protomfx.AuthorizeReq{Token: token, Object: key.Scope.ThingID, Subject: ThingSub, Action: Viewer}
+	if _, err := svc.things.Authorize(ctx, ar); err != nil {
+		return Key{}, "", err
+	}
+
+	return svc.userKey(ctx, token, key)
+}
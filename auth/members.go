@@ -15,6 +15,18 @@ type OrgMember struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	Email     string
+	// ExpiresAt is the time the membership is revoked at. The membership
+	// doesn't expire if it's zero.
+	ExpiresAt time.Time
+}
+
+// MembersExpiry contains the memberships handled by the expiry scan.
+type MembersExpiry struct {
+	// Expiring are the memberships expiring within the notice period,
+	// whose members are notified once.
+	Expiring []OrgMember
+	// Expired are the memberships revoked by the scan.
+	Expired []OrgMember
 }
 
 // OrgMembersPage contains page related metadata as well as list of members that
@@ -34,7 +46,11 @@ type MembersRepository interface {
 	// Remove removes memberships.
 	Remove(ctx context.Context, orgID string, memberIDs ...string) error
 
+	// RemoveByMember removes all memberships of the member.
+	RemoveByMember(ctx context.Context, memberID string) error
+
 	// RetrieveRole retrieves role of membership specified by memberID and orgID.
+	// The expired memberships aren't retrieved, even before they're removed.
 	RetrieveRole(ctx context.Context, memberID, orgID string) (string, error)
 
 	// RetrieveByOrgID retrieves members assigned to an org identified by orgID.
@@ -42,6 +58,18 @@ type MembersRepository interface {
 
 	// RetrieveAll retrieves all members.
 	RetrieveAll(ctx context.Context) ([]OrgMember, error)
+
+	// RetrieveExpiring retrieves the memberships expiring by the provided
+	// time, whose members weren't notified about the expiry.
+	RetrieveExpiring(ctx context.Context, before time.Time) ([]OrgMember, error)
+
+	// UpdateNotified marks the members of the memberships as notified about
+	// the expiry.
+	UpdateNotified(ctx context.Context, oms ...OrgMember) error
+
+	// RemoveExpired removes the memberships expired by the provided time,
+	// and returns them.
+	RemoveExpired(ctx context.Context, now time.Time) ([]OrgMember, error)
 }
 
 // Memberships specifies an API that must be fullfiled by the domain service
@@ -61,6 +89,15 @@ type Members interface {
 
 	// ViewMember retrieves member identified by memberID in org identified by orgID.
 	ViewMember(ctx context.Context, token, orgID, memberID string) (OrgMember, error)
+
+	// ExpireMembers revokes the memberships expired by the provided time,
+	// and notifies the members of the ones expiring within the notice, which
+	// weren't notified yet. The notified memberships are returned as expiring.
+	ExpireMembers(ctx context.Context, now time.Time, notice time.Duration) (MembersExpiry, error)
+
+	// FilterOrgMembers returns the IDs of the provided members which are
+	// members of the org identified by orgID.
+	FilterOrgMembers(ctx context.Context, orgID string, memberIDs ...string) ([]string, error)
 }
 
 func (svc service) AssignMembers(ctx context.Context, token, orgID string, oms ...OrgMember) error {
@@ -69,9 +106,9 @@ func (svc service) AssignMembers(ctx context.Context, token, orgID string, oms .
 	}
 
 	var memberEmails []string
-	var roleByEmail = make(map[string]string)
+	var memberByEmail = make(map[string]OrgMember)
 	for _, om := range oms {
-		roleByEmail[om.Email] = om.Role
+		memberByEmail[om.Email] = om
 		memberEmails = append(memberEmails, om.Email)
 	}
 
@@ -87,14 +124,24 @@ func (svc service) AssignMembers(ctx context.Context, token, orgID string, oms .
 		member := OrgMember{
 			OrgID:     orgID,
 			MemberID:  user.Id,
-			Role:      roleByEmail[user.Email],
+			Role:      memberByEmail[user.Email].Role,
 			UpdatedAt: timestamp,
 			CreatedAt: timestamp,
+			ExpiresAt: memberByEmail[user.Email].ExpiresAt,
 		}
 
 		members = append(members, member)
 	}
 
+	usage, err := svc.membersUsage(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.CheckQuota(ctx, orgID, MembersResource, usage, uint64(len(members))); err != nil {
+		return err
+	}
+
 	if err := svc.members.Save(ctx, members...); err != nil {
 		return err
 	}
@@ -150,9 +197,9 @@ func (svc service) UpdateMembers(ctx context.Context, token, orgID string, membe
 	}
 
 	var memberEmails []string
-	var roleByEmail = make(map[string]string)
+	var memberByEmail = make(map[string]OrgMember)
 	for _, m := range members {
-		roleByEmail[m.Email] = m.Role
+		memberByEmail[m.Email] = m
 		memberEmails = append(memberEmails, m.Email)
 	}
 
@@ -171,8 +218,9 @@ func (svc service) UpdateMembers(ctx context.Context, token, orgID string, membe
 		om := OrgMember{
 			OrgID:     orgID,
 			MemberID:  user.Id,
-			Role:      roleByEmail[user.Email],
+			Role:      memberByEmail[user.Email].Role,
 			UpdatedAt: getTimestmap(),
+			ExpiresAt: memberByEmail[user.Email].ExpiresAt,
 		}
 
 		oms = append(oms, om)
@@ -198,9 +246,9 @@ func (svc service) ListMembersByOrg(ctx context.Context, token string, orgID str
 	var oms []OrgMember
 	if len(omp.OrgMembers) > 0 {
 		var memberIDs []string
-		var roleByEmail = make(map[string]string)
+		var memberByID = make(map[string]OrgMember)
 		for _, m := range omp.OrgMembers {
-			roleByEmail[m.MemberID] = m.Role
+			memberByID[m.MemberID] = m
 			memberIDs = append(memberIDs, m.MemberID)
 		}
 
@@ -212,9 +260,10 @@ func (svc service) ListMembersByOrg(ctx context.Context, token string, orgID str
 
 		for _, user := range page.Users {
 			mbr := OrgMember{
-				MemberID: user.Id,
-				Email:    user.Email,
-				Role:     roleByEmail[user.Id],
+				MemberID:  user.Id,
+				Email:     user.Email,
+				Role:      memberByID[user.Id].Role,
+				ExpiresAt: memberByID[user.Id].ExpiresAt,
 			}
 			oms = append(oms, mbr)
 		}
@@ -232,6 +281,107 @@ func (svc service) ListMembersByOrg(ctx context.Context, token string, orgID str
 	return mpg, nil
 }
 
+func (svc service) ExpireMembers(ctx context.Context, now time.Time, notice time.Duration) (MembersExpiry, error) {
+	expired, err := svc.members.RemoveExpired(ctx, now)
+	if err != nil {
+		return MembersExpiry{}, err
+	}
+
+	expiring, err := svc.members.RetrieveExpiring(ctx, now.Add(notice))
+	if err != nil {
+		return MembersExpiry{}, err
+	}
+
+	if err := svc.setMemberEmails(ctx, expired, expiring); err != nil {
+		return MembersExpiry{}, err
+	}
+
+	notified := svc.notifyExpiring(ctx, expiring)
+	if err := svc.members.UpdateNotified(ctx, notified...); err != nil {
+		return MembersExpiry{}, err
+	}
+
+	return MembersExpiry{Expiring: notified, Expired: expired}, nil
+}
+
+func (svc service) FilterOrgMembers(ctx context.Context, orgID string, memberIDs ...string) ([]string, error) {
+	ids := []string{}
+	for _, memberID := range memberIDs {
+		if _, err := svc.members.RetrieveRole(ctx, memberID, orgID); err != nil {
+			if errors.Contains(err, errors.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		ids = append(ids, memberID)
+	}
+
+	return ids, nil
+}
+
+// notifyExpiring emails the members about the expiry of their memberships,
+// and returns the ones which were notified. The members which weren't
+// notified are retrieved again by the next expiry scan.
+func (svc service) notifyExpiring(ctx context.Context, oms []OrgMember) []OrgMember {
+	orgNames := make(map[string]string)
+	var notified []OrgMember
+	for _, om := range oms {
+		if om.Email == "" {
+			continue
+		}
+
+		name, ok := orgNames[om.OrgID]
+		if !ok {
+			org, err := svc.orgs.RetrieveByID(ctx, om.OrgID)
+			if err != nil {
+				continue
+			}
+			name = org.Name
+			orgNames[om.OrgID] = name
+		}
+
+		if err := svc.emailer.SendMembershipExpiry([]string{om.Email}, name, om.ExpiresAt); err != nil {
+			continue
+		}
+		notified = append(notified, om)
+	}
+
+	return notified
+}
+
+// setMemberEmails sets the emails of the members, so that they can be
+// notified about the expiry of their memberships.
+func (svc service) setMemberEmails(ctx context.Context, lists ...[]OrgMember) error {
+	var memberIDs []string
+	for _, oms := range lists {
+		for _, om := range oms {
+			memberIDs = append(memberIDs, om.MemberID)
+		}
+	}
+
+	if len(memberIDs) == 0 {
+		return nil
+	}
+
+	page, err := svc.users.GetUsersByIDs(ctx, &protomfx.UsersByIDsReq{Ids: memberIDs})
+	if err != nil {
+		return err
+	}
+
+	emails := make(map[string]string)
+	for _, user := range page.Users {
+		emails[user.Id] = user.Email
+	}
+
+	for _, oms := range lists {
+		for i := range oms {
+			oms[i].Email = emails[oms[i].MemberID]
+		}
+	}
+
+	return nil
+}
+
 func (svc service) canAssignMembers(ctx context.Context, token, orgID string, memberIDs ...string) error {
 	if err := svc.canAccessOrg(ctx, token, orgID, Admin); err != nil {
 		return err
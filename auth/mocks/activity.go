@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+)
+
+type activityRepositoryMock struct {
+	mu       sync.Mutex
+	activity map[string]map[string]auth.KeyActivity
+}
+
+// NewActivityRepository returns mock of key activity repository.
+func NewActivityRepository() auth.ActivityRepository {
+	return &activityRepositoryMock{
+		activity: make(map[string]map[string]auth.KeyActivity),
+	}
+}
+
+func (arm *activityRepositoryMock) Record(_ context.Context, key auth.Key, failed bool, at time.Time) error {
+	arm.mu.Lock()
+	defer arm.mu.Unlock()
+
+	kas, ok := arm.activity[key.IssuerID]
+	if !ok {
+		kas = make(map[string]auth.KeyActivity)
+		arm.activity[key.IssuerID] = kas
+	}
+
+	field := fmt.Sprintf("%d:%s", key.Type, key.ID)
+	ka := kas[field]
+	ka.KeyID = key.ID
+	ka.KeyType = key.Type
+	ka.UserID = key.IssuerID
+	ka.Email = key.Subject
+	ka.Calls++
+	if failed {
+		ka.Errors++
+	}
+	ka.LastUsed = at
+	kas[field] = ka
+
+	return nil
+}
+
+func (arm *activityRepositoryMock) RetrieveByUsers(_ context.Context, userIDs []string) ([]auth.KeyActivity, error) {
+	arm.mu.Lock()
+	defer arm.mu.Unlock()
+
+	kas := []auth.KeyActivity{}
+	for _, id := range userIDs {
+		for _, ka := range arm.activity[id] {
+			kas = append(kas, ka)
+		}
+	}
+
+	return kas, nil
+}
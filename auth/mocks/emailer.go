@@ -0,0 +1,38 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var errSend = errors.New("failed to send email")
+
+type emailerMock struct {
+	failing map[string]bool
+}
+
+// NewEmailer provides emailer instance for the test, which fails to send the
+// emails to the provided addresses.
+func NewEmailer(failing ...string) auth.Emailer {
+	e := &emailerMock{failing: make(map[string]bool)}
+	for _, to := range failing {
+		e.failing[to] = true
+	}
+
+	return e
+}
+
+func (e *emailerMock) SendMembershipExpiry(to []string, _ string, _ time.Time) error {
+	for _, addr := range to {
+		if e.failing[addr] {
+			return errSend
+		}
+	}
+
+	return nil
+}
@@ -54,3 +54,16 @@ func (krm *keyRepositoryMock) Remove(ctx context.Context, issuerID, id string) e
 	}
 	return nil
 }
+
+func (krm *keyRepositoryMock) RemoveByIssuer(ctx context.Context, issuerID string) error {
+	krm.mu.Lock()
+	defer krm.mu.Unlock()
+
+	for id, key := range krm.keys {
+		if key.IssuerID == issuerID {
+			delete(krm.keys, id)
+		}
+	}
+
+	return nil
+}
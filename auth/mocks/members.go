@@ -2,7 +2,9 @@ package mocks
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -14,6 +16,7 @@ type membersRepositoryMock struct {
 	mu          sync.Mutex
 	members        map[string]auth.OrgMember
 	membersByOrgID map[string][]auth.OrgMember
+	notified       map[string]bool
 }
 
 // NewMembersRepository returns mock of org repository
@@ -21,6 +24,7 @@ func NewMembersRepository() auth.MembersRepository {
 	return &membersRepositoryMock{
 		members: make(map[string]auth.OrgMember),
 		membersByOrgID: make(map[string][]auth.OrgMember),
+		notified:       make(map[string]bool),
 	}
 }
 
@@ -34,9 +38,10 @@ func (mrm *membersRepositoryMock) Save(ctx context.Context, oms ...auth.OrgMembe
 		}
 
 		m := auth.OrgMember{
-			MemberID: om.MemberID,
-			Role:     om.Role,
-			OrgID:    om.OrgID,
+			MemberID:  om.MemberID,
+			Role:      om.Role,
+			OrgID:     om.OrgID,
+			ExpiresAt: om.ExpiresAt,
 		}
 
 		mrm.members[om.MemberID] = m
@@ -65,6 +70,24 @@ func (mrm *membersRepositoryMock) Remove(ctx context.Context, orgID string, memb
 	return nil
 }
 
+func (mrm *membersRepositoryMock) RemoveByMember(ctx context.Context, memberID string) error {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	delete(mrm.members, memberID)
+	for orgID, oms := range mrm.membersByOrgID {
+		var kept []auth.OrgMember
+		for _, om := range oms {
+			if om.MemberID != memberID {
+				kept = append(kept, om)
+			}
+		}
+		mrm.membersByOrgID[orgID] = kept
+	}
+
+	return nil
+}
+
 func (mrm *membersRepositoryMock) Update(ctx context.Context, oms ...auth.OrgMember) error {
 	mrm.mu.Lock()
 	defer mrm.mu.Unlock()
@@ -74,9 +97,12 @@ func (mrm *membersRepositoryMock) Update(ctx context.Context, oms ...auth.OrgMem
 			return errors.ErrNotFound
 		}
 		mrm.members[om.MemberID] = auth.OrgMember{
-			MemberID: om.MemberID,
-			Role:     om.Role,
+			MemberID:  om.MemberID,
+			OrgID:     mrm.members[om.MemberID].OrgID,
+			Role:      om.Role,
+			ExpiresAt: om.ExpiresAt,
 		}
+		delete(mrm.notified, om.MemberID)
 	}
 
 	return nil
@@ -86,11 +112,12 @@ func (mrm *membersRepositoryMock) RetrieveRole(ctx context.Context, memberID, or
 	mrm.mu.Lock()
 	defer mrm.mu.Unlock()
 
-	if _, ok := mrm.members[memberID]; !ok {
+	m, ok := mrm.members[memberID]
+	if !ok || expired(m, time.Now()) {
 		return "", errors.ErrNotFound
 	}
 
-	return mrm.members[memberID].Role, nil
+	return m.Role, nil
 }
 
 func (mrm *membersRepositoryMock) RetrieveByOrgID(ctx context.Context, orgID string, pm auth.PageMetadata) (auth.OrgMembersPage, error) {
@@ -109,7 +136,7 @@ func (mrm *membersRepositoryMock) RetrieveByOrgID(ctx context.Context, orgID str
 	return auth.OrgMembersPage{
 		OrgMembers: oms,
 		PageMetadata: auth.PageMetadata{
-			Total:  uint64(len(mrm.members)),
+			Total:  i,
 			Offset: pm.Offset,
 			Limit:  pm.Limit,
 		},
@@ -127,3 +154,62 @@ func (mrm *membersRepositoryMock) RetrieveAll(ctx context.Context) ([]auth.OrgMe
 
 	return oms, nil
 }
+
+func (mrm *membersRepositoryMock) RetrieveExpiring(ctx context.Context, before time.Time) ([]auth.OrgMember, error) {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	oms := []auth.OrgMember{}
+	for _, m := range mrm.members {
+		if expired(m, before) && !mrm.notified[m.MemberID] {
+			oms = append(oms, m)
+		}
+	}
+
+	sort.Slice(oms, func(i, j int) bool {
+		return oms[i].ExpiresAt.Before(oms[j].ExpiresAt)
+	})
+
+	return oms, nil
+}
+
+func (mrm *membersRepositoryMock) UpdateNotified(ctx context.Context, oms ...auth.OrgMember) error {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	for _, om := range oms {
+		mrm.notified[om.MemberID] = true
+	}
+
+	return nil
+}
+
+func (mrm *membersRepositoryMock) RemoveExpired(ctx context.Context, now time.Time) ([]auth.OrgMember, error) {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	oms := []auth.OrgMember{}
+	for id, m := range mrm.members {
+		if !expired(m, now) {
+			continue
+		}
+
+		oms = append(oms, m)
+		delete(mrm.members, id)
+		delete(mrm.notified, id)
+
+		var kept []auth.OrgMember
+		for _, om := range mrm.membersByOrgID[m.OrgID] {
+			if om.MemberID != id {
+				kept = append(kept, om)
+			}
+		}
+		mrm.membersByOrgID[m.OrgID] = kept
+	}
+
+	return oms, nil
+}
+
+func expired(om auth.OrgMember, now time.Time) bool {
+	return !om.ExpiresAt.IsZero() && !om.ExpiresAt.After(now)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ auth.Monitor = (*monitorMock)(nil)
+
+type monitorMock struct {
+	throughput auth.Throughput
+	lags       []auth.ConsumerLag
+	services   []auth.ServiceHealth
+}
+
+// NewMonitor returns the mock platform monitor. The consumer lags are
+// reported as unavailable if the provided lags are nil.
+func NewMonitor(tp auth.Throughput, cls []auth.ConsumerLag, shs []auth.ServiceHealth) auth.Monitor {
+	return &monitorMock{
+		throughput: tp,
+		lags:       cls,
+		services:   shs,
+	}
+}
+
+func (mm *monitorMock) Throughput(context.Context) (auth.Throughput, error) {
+	return mm.throughput, nil
+}
+
+func (mm *monitorMock) ConsumerLags(context.Context) ([]auth.ConsumerLag, error) {
+	if mm.lags == nil {
+		return []auth.ConsumerLag{}, errors.ErrNotFound
+	}
+
+	return mm.lags, nil
+}
+
+func (mm *monitorMock) Health(context.Context) []auth.ServiceHealth {
+	return mm.services
+}
@@ -54,6 +54,21 @@ func (orm *orgRepositoryMock) Update(ctx context.Context, org auth.Org) error {
 	return nil
 }
 
+func (orm *orgRepositoryMock) UpdateOwner(ctx context.Context, org auth.Org) error {
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+
+	o, ok := orm.orgs[org.ID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	o.OwnerID = org.OwnerID
+	o.UpdatedAt = org.UpdatedAt
+	orm.orgs[org.ID] = o
+
+	return nil
+}
+
 func (orm *orgRepositoryMock) Remove(ctx context.Context, owner, id string) error {
 	orm.mu.Lock()
 	defer orm.mu.Unlock()
@@ -86,7 +101,7 @@ func (orm *orgRepositoryMock) RetrieveByOwner(ctx context.Context, ownerID strin
 	i := uint64(0)
 	orgs := make([]auth.Org, 0)
 	for _, k := range keys {
-		if i >= pm.Offset && i < pm.Offset+pm.Limit {
+		if pm.Limit == 0 || i >= pm.Offset && i < pm.Offset+pm.Limit {
 			if orm.orgs[k].OwnerID == ownerID && strings.Contains(orm.orgs[k].Name, pm.Name) {
 				orgs = append(orgs, orm.orgs[k])
 			}
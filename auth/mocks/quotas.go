@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+)
+
+type quotaRepositoryMock struct {
+	mu     sync.Mutex
+	quotas map[string]map[string]uint64
+}
+
+// NewQuotaRepository returns mock of quota repository.
+func NewQuotaRepository() auth.QuotaRepository {
+	return &quotaRepositoryMock{
+		quotas: make(map[string]map[string]uint64),
+	}
+}
+
+func (qrm *quotaRepositoryMock) Save(_ context.Context, orgID string, limits map[string]uint64) error {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	saved := make(map[string]uint64)
+	for res, limit := range limits {
+		if limit > 0 {
+			saved[res] = limit
+		}
+	}
+	qrm.quotas[orgID] = saved
+
+	return nil
+}
+
+func (qrm *quotaRepositoryMock) RetrieveByOrg(_ context.Context, orgID string) (map[string]uint64, error) {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	limits := make(map[string]uint64)
+	for res, limit := range qrm.quotas[orgID] {
+		limits[res] = limit
+	}
+
+	return limits, nil
+}
@@ -56,10 +56,6 @@ func (rrm *rolesRepositoryMock) RemoveRole(ctx context.Context, id string) error
 	rrm.mu.Lock()
 	defer rrm.mu.Unlock()
 
-	if _, ok := rrm.roles[id]; !ok {
-		return errors.ErrNotFound
-	}
-
 	delete(rrm.roles, id)
 
 	return nil
@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+type signingKeyRepositoryMock struct {
+	mu   sync.Mutex
+	keys map[string]auth.SigningKey
+}
+
+// NewSigningKeyRepository returns mock of signing key repository.
+func NewSigningKeyRepository() auth.SigningKeyRepository {
+	return &signingKeyRepositoryMock{
+		keys: make(map[string]auth.SigningKey),
+	}
+}
+
+func (skrm *signingKeyRepositoryMock) Save(_ context.Context, sk auth.SigningKey) error {
+	skrm.mu.Lock()
+	defer skrm.mu.Unlock()
+
+	skrm.keys[sk.OrgID] = sk
+
+	return nil
+}
+
+func (skrm *signingKeyRepositoryMock) RetrieveByOrg(_ context.Context, orgID string) (auth.SigningKey, error) {
+	skrm.mu.Lock()
+	defer skrm.mu.Unlock()
+
+	sk, ok := skrm.keys[orgID]
+	if !ok {
+		return auth.SigningKey{}, errors.ErrNotFound
+	}
+
+	return sk, nil
+}
+
+func (skrm *signingKeyRepositoryMock) Remove(_ context.Context, orgID string) error {
+	skrm.mu.Lock()
+	defer skrm.mu.Unlock()
+
+	delete(skrm.keys, orgID)
+
+	return nil
+}
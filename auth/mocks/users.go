@@ -5,6 +5,7 @@ import (
 
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 )
 
@@ -40,3 +41,7 @@ func (svc *usersServiceClientMock) GetUsersByEmails(ctx context.Context, in *pro
 
 	return &protomfx.UsersRes{Users: users}, nil
 }
+
+func (svc *usersServiceClientMock) GetStats(ctx context.Context, _ *empty.Empty, opts ...grpc.CallOption) (*protomfx.UsersStatsRes, error) {
+	return &protomfx.UsersStatsRes{Users: uint64(len(svc.usersByID))}, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+)
+
+// errNoSuchKey is the prefix of the error returned for the streams which
+// haven't been created yet, i.e. no event has been published to them.
+const errNoSuchKey = "ERR no such key"
+
+func (m *monitor) ConsumerLags(ctx context.Context) ([]auth.ConsumerLag, error) {
+	cls := []auth.ConsumerLag{}
+	for _, stream := range m.cfg.Streams {
+		groups, err := m.client.XInfoGroups(ctx, stream).Result()
+		if err != nil {
+			if strings.HasPrefix(err.Error(), errNoSuchKey) {
+				continue
+			}
+			return []auth.ConsumerLag{}, err
+		}
+
+		for _, g := range groups {
+			// The streams are capped, so the undelivered events can be counted
+			// by reading them, starting from the last delivered one.
+			events, err := m.client.XRange(ctx, stream, g.LastDeliveredID, "+").Result()
+			if err != nil {
+				return []auth.ConsumerLag{}, err
+			}
+
+			lag := uint64(len(events))
+			if lag > 0 && events[0].ID == g.LastDeliveredID {
+				lag--
+			}
+
+			cls = append(cls, auth.ConsumerLag{
+				Stream:  stream,
+				Group:   g.Name,
+				Pending: uint64(g.Pending),
+				Lag:     lag,
+			})
+		}
+	}
+
+	return cls, nil
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package monitor contains the platform monitor implementation, which
+// collects the broker throughput, the event consumer lags and the health
+// of the platform services.
+package monitor
@@ -0,0 +1,75 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/auth"
+)
+
+const (
+	healthPath = "/health"
+	failStatus = "fail"
+)
+
+func (m *monitor) Health(ctx context.Context) []auth.ServiceHealth {
+	var wg sync.WaitGroup
+	shs := make([]auth.ServiceHealth, 0, len(m.cfg.Services))
+	res := make(chan auth.ServiceHealth, len(m.cfg.Services))
+	for name, url := range m.cfg.Services {
+		wg.Add(1)
+		go func(name, url string) {
+			defer wg.Done()
+			res <- m.health(ctx, name, url)
+		}(name, url)
+	}
+	wg.Wait()
+	close(res)
+
+	for sh := range res {
+		shs = append(shs, sh)
+	}
+	sort.Slice(shs, func(i, j int) bool { return shs[i].Name < shs[j].Name })
+
+	return shs
+}
+
+func (m *monitor) health(ctx context.Context, name, url string) auth.ServiceHealth {
+	sh := auth.ServiceHealth{Name: name, Status: failStatus}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+healthPath, nil)
+	if err != nil {
+		sh.Error = err.Error()
+		return sh
+	}
+
+	res, err := m.http.Do(req)
+	if err != nil {
+		sh.Error = err.Error()
+		return sh
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		sh.Error = res.Status
+		return sh
+	}
+
+	var hi mainflux.HealthInfo
+	if err := json.NewDecoder(res.Body).Decode(&hi); err != nil {
+		sh.Error = err.Error()
+		return sh
+	}
+
+	sh.Status = hi.Status
+	sh.Version = hi.Version
+
+	return sh
+}
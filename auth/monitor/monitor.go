@@ -0,0 +1,47 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/go-redis/redis/v8"
+)
+
+// Config contains the platform monitor configuration.
+type Config struct {
+	// BrokerURL is the URL of the NATS monitoring endpoint. The throughput
+	// is reported as unavailable if it's empty.
+	BrokerURL string
+	// Streams contains the event streams whose consumer groups are monitored.
+	Streams []string
+	// Services maps the names of the monitored services to their HTTP URLs.
+	Services map[string]string
+	// Timeout is the timeout of the requests sent to the broker and the services.
+	Timeout time.Duration
+}
+
+var _ auth.Monitor = (*monitor)(nil)
+
+type monitor struct {
+	cfg    Config
+	client *redis.Client
+	http   *http.Client
+
+	mu   sync.Mutex
+	prev sample
+}
+
+// New returns the platform monitor which reads the consumer groups of the
+// event streams using the provided Redis client.
+func New(cfg Config, client *redis.Client) auth.Monitor {
+	return &monitor{
+		cfg:    cfg,
+		client: client,
+		http:   &http.Client{Timeout: cfg.Timeout},
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/auth/monitor"
+	"github.com/stretchr/testify/assert"
+)
+
+const timeout = time.Second
+
+func TestHealth(t *testing.T) {
+	healthy := httptest.NewServer(mainflux.Health("things"))
+	defer healthy.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	m := monitor.New(monitor.Config{
+		Services: map[string]string{"things": healthy.URL, "users": failing.URL},
+		Timeout:  timeout,
+	}, nil)
+
+	shs := m.Health(context.Background())
+	expected := []auth.ServiceHealth{
+		{Name: "things", Status: "pass", Version: mainflux.Version},
+		{Name: "users", Status: "fail", Error: "503 Service Unavailable"},
+	}
+	assert.Equal(t, expected, shs, fmt.Sprintf("expected %v got %v", expected, shs))
+}
+
+func TestThroughput(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	start := now.Add(-10 * time.Second)
+	inMsgs, outMsgs := uint64(100), uint64(200)
+
+	broker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"start":     start,
+			"now":       now,
+			"in_msgs":   inMsgs,
+			"out_msgs":  outMsgs,
+			"in_bytes":  inMsgs * 10,
+			"out_bytes": outMsgs * 10,
+		})
+	}))
+	defer broker.Close()
+
+	m := monitor.New(monitor.Config{BrokerURL: broker.URL, Timeout: timeout}, nil)
+
+	// The first snapshot is averaged over the broker uptime.
+	tp, err := m.Throughput(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	expected := auth.Throughput{InMsgs: 100, OutMsgs: 200, InBytes: 1000, OutBytes: 2000, InMsgsRate: 10, OutMsgsRate: 20}
+	assert.Equal(t, expected, tp, fmt.Sprintf("first snapshot: expected %v got %v", expected, tp))
+
+	// The next snapshot is calculated since the previous one.
+	now = now.Add(5 * time.Second)
+	inMsgs, outMsgs = 150, 300
+	tp, err = m.Throughput(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	expected = auth.Throughput{InMsgs: 150, OutMsgs: 300, InBytes: 1500, OutBytes: 3000, InMsgsRate: 10, OutMsgsRate: 20}
+	assert.Equal(t, expected, tp, fmt.Sprintf("next snapshot: expected %v got %v", expected, tp))
+
+	m = monitor.New(monitor.Config{Timeout: timeout}, nil)
+	_, err = m.Throughput(context.Background())
+	assert.NotNil(t, err, "expected error for the broker without monitoring URL")
+}
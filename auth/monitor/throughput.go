@@ -0,0 +1,88 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const varzPath = "/varz"
+
+var (
+	errBrokerNotConfigured = errors.New("broker monitoring URL not configured")
+	errBrokerStatus        = errors.New("unexpected broker monitoring status")
+)
+
+// varz contains the subset of the NATS server stats used for the throughput.
+type varz struct {
+	Start    time.Time `json:"start"`
+	Now      time.Time `json:"now"`
+	InMsgs   uint64    `json:"in_msgs"`
+	OutMsgs  uint64    `json:"out_msgs"`
+	InBytes  uint64    `json:"in_bytes"`
+	OutBytes uint64    `json:"out_bytes"`
+}
+
+// sample is the previous throughput snapshot the rates are calculated from.
+type sample struct {
+	time    time.Time
+	inMsgs  uint64
+	outMsgs uint64
+}
+
+func (m *monitor) Throughput(ctx context.Context) (auth.Throughput, error) {
+	if m.cfg.BrokerURL == "" {
+		return auth.Throughput{}, errBrokerNotConfigured
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.cfg.BrokerURL+varzPath, nil)
+	if err != nil {
+		return auth.Throughput{}, err
+	}
+
+	res, err := m.http.Do(req)
+	if err != nil {
+		return auth.Throughput{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return auth.Throughput{}, errors.Wrap(errBrokerStatus, errors.New(res.Status))
+	}
+
+	var v varz
+	if err := json.NewDecoder(res.Body).Decode(&v); err != nil {
+		return auth.Throughput{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Until the first snapshot is taken, or after the broker is restarted,
+	// the rates are averaged over the broker uptime.
+	prev := m.prev
+	if prev.time.IsZero() || prev.time.Before(v.Start) || v.InMsgs < prev.inMsgs || v.OutMsgs < prev.outMsgs {
+		prev = sample{time: v.Start}
+	}
+	m.prev = sample{time: v.Now, inMsgs: v.InMsgs, outMsgs: v.OutMsgs}
+
+	tp := auth.Throughput{
+		InMsgs:   v.InMsgs,
+		OutMsgs:  v.OutMsgs,
+		InBytes:  v.InBytes,
+		OutBytes: v.OutBytes,
+	}
+	if elapsed := v.Now.Sub(prev.time).Seconds(); elapsed > 0 {
+		tp.InMsgsRate = float64(v.InMsgs-prev.inMsgs) / elapsed
+		tp.OutMsgsRate = float64(v.OutMsgs-prev.outMsgs) / elapsed
+	}
+
+	return tp, nil
+}
@@ -88,6 +88,10 @@ type Orgs interface {
 
 	// Restore adds orgs and org members from a backup. Only accessible by admin.
 	Restore(ctx context.Context, token string, backup Backup) error
+
+	// ListOrgAccess retrieves the effective access of all members to all orgs,
+	// used for the periodic access reviews. Only accessible by admin.
+	ListOrgAccess(ctx context.Context, token string) ([]OrgAccess, error)
 }
 
 // OrgRepository specifies an org persistence API.
@@ -98,6 +102,9 @@ type OrgRepository interface {
 	// Update an org
 	Update(ctx context.Context, org Org) error
 
+	// UpdateOwner changes the owner of the org.
+	UpdateOwner(ctx context.Context, org Org) error
+
 	// Remove an org
 	Remove(ctx context.Context, owner, id string) error
 
@@ -118,7 +125,7 @@ type OrgRepository interface {
 }
 
 func (svc service) CreateOrg(ctx context.Context, token string, o Org) (Org, error) {
-	user, err := svc.Identify(ctx, token)
+	user, err := svc.identify(ctx, token)
 	if err != nil {
 		return Org{}, err
 	}
@@ -164,7 +171,7 @@ func (svc service) ListOrgs(ctx context.Context, token string, pm PageMetadata)
 		return svc.orgs.RetrieveByAdmin(ctx, pm)
 	}
 
-	user, err := svc.Identify(ctx, token)
+	user, err := svc.identify(ctx, token)
 	if err != nil {
 		return OrgsPage{}, err
 	}
@@ -173,7 +180,7 @@ func (svc service) ListOrgs(ctx context.Context, token string, pm PageMetadata)
 }
 
 func (svc service) RemoveOrg(ctx context.Context, token, id string) error {
-	user, err := svc.Identify(ctx, token)
+	user, err := svc.identify(ctx, token)
 	if err != nil {
 		return err
 	}
@@ -186,7 +193,7 @@ func (svc service) RemoveOrg(ctx context.Context, token, id string) error {
 }
 
 func (svc service) UpdateOrg(ctx context.Context, token string, o Org) (Org, error) {
-	user, err := svc.Identify(ctx, token)
+	user, err := svc.identify(ctx, token)
 	if err != nil {
 		return Org{}, err
 	}
@@ -229,7 +236,7 @@ func (svc service) ListOrgsByMember(ctx context.Context, token string, memberID
 		return svc.orgs.RetrieveByMemberID(ctx, memberID, pm)
 	}
 
-	user, err := svc.Identify(ctx, token)
+	user, err := svc.identify(ctx, token)
 	if err != nil {
 		return OrgsPage{}, err
 	}
@@ -246,7 +253,7 @@ func (svc service) canAccessOrg(ctx context.Context, token, orgID, action string
 		return nil
 	}
 
-	user, err := svc.Identify(ctx, token)
+	user, err := svc.identify(ctx, token)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,133 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+)
+
+const (
+	// UsersSection is the overview section containing the number of users.
+	UsersSection = "users"
+	// ThingsSection is the overview section containing the number of things, profiles and groups.
+	ThingsSection = "things"
+	// ThroughputSection is the overview section containing the message throughput.
+	ThroughputSection = "throughput"
+	// ConsumersSection is the overview section containing the event consumer lags.
+	ConsumersSection = "consumers"
+)
+
+// Overview contains the platform-level stats shown on the admin overview page.
+type Overview struct {
+	Orgs       uint64
+	Users      uint64
+	Things     uint64
+	Profiles   uint64
+	Groups     uint64
+	Throughput Throughput
+	Consumers  []ConsumerLag
+	Services   []ServiceHealth
+	// Unavailable contains the sections which couldn't be retrieved,
+	// e.g. because the service providing them is down.
+	Unavailable []string
+}
+
+// Throughput represents the snapshot of the message broker throughput.
+// The rates are the number of messages per second since the previous snapshot.
+type Throughput struct {
+	InMsgs      uint64
+	OutMsgs     uint64
+	InBytes     uint64
+	OutBytes    uint64
+	InMsgsRate  float64
+	OutMsgsRate float64
+}
+
+// ConsumerLag represents the state of the consumer group of the event stream.
+type ConsumerLag struct {
+	Stream string
+	Group  string
+	// Pending is the number of the events delivered, but not yet acknowledged.
+	Pending uint64
+	// Lag is the number of the events not yet delivered to the group.
+	Lag uint64
+}
+
+// ServiceHealth represents the health of the platform service.
+type ServiceHealth struct {
+	Name    string
+	Status  string
+	Version string
+	Error   string
+}
+
+// Monitor provides the runtime state of the platform.
+type Monitor interface {
+	// Throughput returns the snapshot of the message broker throughput.
+	Throughput(ctx context.Context) (Throughput, error)
+
+	// ConsumerLags returns the lags of the event stream consumer groups.
+	ConsumerLags(ctx context.Context) ([]ConsumerLag, error)
+
+	// Health returns the health of the platform services. The services
+	// which can't be reached are reported as failing.
+	Health(ctx context.Context) []ServiceHealth
+}
+
+// Platform specifies the platform-level API used by the admin dashboard.
+type Platform interface {
+	// ViewOverview retrieves the platform-level stats. The sections which
+	// can't be retrieved are listed as unavailable instead of failing the
+	// whole overview. Only accessible by admin.
+	ViewOverview(ctx context.Context, token string) (Overview, error)
+}
+
+func (svc service) ViewOverview(ctx context.Context, token string) (Overview, error) {
+	if err := svc.isAdmin(ctx, token); err != nil {
+		return Overview{}, err
+	}
+
+	op, err := svc.orgs.RetrieveByAdmin(ctx, PageMetadata{Limit: 1})
+	if err != nil {
+		return Overview{}, err
+	}
+
+	ov := Overview{
+		Orgs:        op.Total,
+		Consumers:   []ConsumerLag{},
+		Unavailable: []string{},
+	}
+
+	if us, err := svc.users.GetStats(ctx, &empty.Empty{}); err == nil {
+		ov.Users = us.GetUsers()
+	} else {
+		ov.Unavailable = append(ov.Unavailable, UsersSection)
+	}
+
+	if ts, err := svc.things.GetStats(ctx, &empty.Empty{}); err == nil {
+		ov.Things = ts.GetThings()
+		ov.Profiles = ts.GetProfiles()
+		ov.Groups = ts.GetGroups()
+	} else {
+		ov.Unavailable = append(ov.Unavailable, ThingsSection)
+	}
+
+	if tp, err := svc.monitor.Throughput(ctx); err == nil {
+		ov.Throughput = tp
+	} else {
+		ov.Unavailable = append(ov.Unavailable, ThroughputSection)
+	}
+
+	if cls, err := svc.monitor.ConsumerLags(ctx); err == nil {
+		ov.Consumers = cls
+	} else {
+		ov.Unavailable = append(ov.Unavailable, ConsumersSection)
+	}
+
+	ov.Services = svc.monitor.Health(ctx)
+
+	return ov, nil
+}
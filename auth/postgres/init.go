@@ -6,6 +6,8 @@ package postgres
 import (
 	"fmt"
 
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 	migrate "github.com/rubenv/sql-migrate"
@@ -13,35 +15,45 @@ import (
 
 // Config defines the options that are used when connecting to a PostgreSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the PostgreSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
+
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
 		Migrations: []*migrate.Migration{
 			{
 				Id: "auth_1",
@@ -87,9 +99,50 @@ func migrateDB(db *sqlx.DB) error {
 					`DROP TABLE IF EXISTS member_relations`,
 				},
 			},
+			dbutil.SearchMigration("auth_2", "orgs.name"),
+			{
+				Id: "auth_3",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS signing_keys (
+						org_id      UUID PRIMARY KEY,
+						public_key  BYTEA NOT NULL,
+						private_key BYTEA NOT NULL,
+						created_at  TIMESTAMPTZ,
+						FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS signing_keys`,
+				},
+			},
+			{
+				Id: "auth_4",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS quotas (
+						org_id      UUID NOT NULL,
+						resource    VARCHAR(64) NOT NULL,
+						quota       BIGINT NOT NULL,
+						FOREIGN KEY (org_id) REFERENCES orgs (id) ON DELETE CASCADE,
+						PRIMARY KEY (org_id, resource)
+					)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS quotas`,
+				},
+			},
+			{
+				Id: "auth_5",
+				Up: []string{
+					`ALTER TABLE member_relations ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ`,
+					`ALTER TABLE member_relations ADD COLUMN IF NOT EXISTS expiry_notified BOOLEAN NOT NULL DEFAULT FALSE`,
+					`CREATE INDEX IF NOT EXISTS member_relations_expires_at_idx ON member_relations (expires_at) WHERE expires_at IS NOT NULL`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS member_relations_expires_at_idx`,
+					`ALTER TABLE member_relations DROP COLUMN IF EXISTS expiry_notified`,
+					`ALTER TABLE member_relations DROP COLUMN IF EXISTS expires_at`,
+				},
+			},
 		},
 	}
-
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
 }
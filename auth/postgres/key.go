@@ -69,6 +69,18 @@ func (kr repo) Remove(ctx context.Context, issuerID, id string) error {
 	return nil
 }
 
+func (kr repo) RemoveByIssuer(ctx context.Context, issuerID string) error {
+	q := `DELETE FROM keys WHERE issuer_id = :issuer_id`
+	key := dbKey{
+		IssuerID: issuerID,
+	}
+	if _, err := kr.db.NamedExecContext(ctx, q, key); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
 type dbKey struct {
 	ID        string       `db:"id"`
 	Type      uint32       `db:"type"`
@@ -14,6 +14,7 @@ import (
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
 )
 
 var _ auth.MembersRepository = (*membersRepository)(nil)
@@ -37,7 +38,7 @@ func (or membersRepository) RetrieveByOrgID(ctx context.Context, orgID string, p
 		return auth.OrgMembersPage{}, errors.Wrap(auth.ErrRetrieveMembersByOrg, err)
 	}
 
-	q := fmt.Sprintf(`SELECT member_id, org_id, created_at, updated_at, role FROM member_relations
+	q := fmt.Sprintf(`SELECT member_id, org_id, created_at, updated_at, role, expires_at FROM member_relations
 					  WHERE org_id = :org_id %s LIMIT :limit OFFSET :offset`, mq)
 
 	dbmp, err := toDBOrgMemberPage("", orgID, pm)
@@ -87,14 +88,23 @@ func (or membersRepository) RetrieveByOrgID(ctx context.Context, orgID string, p
 }
 
 func toMember(dbmb dbMember) (auth.OrgMember, error) {
-	return auth.OrgMember{
-		MemberID: dbmb.MemberID,
-		Role:     dbmb.Role,
-	}, nil
+	om := auth.OrgMember{
+		MemberID:  dbmb.MemberID,
+		OrgID:     dbmb.OrgID,
+		Role:      dbmb.Role,
+		CreatedAt: dbmb.CreatedAt,
+		UpdatedAt: dbmb.UpdatedAt,
+	}
+	if dbmb.ExpiresAt.Valid {
+		om.ExpiresAt = dbmb.ExpiresAt.Time
+	}
+
+	return om, nil
 }
 
 func (or membersRepository) RetrieveRole(ctx context.Context, memberID, orgID string) (string, error) {
-	q := `SELECT role FROM member_relations WHERE member_id = $1 AND org_id = $2`
+	q := `SELECT role FROM member_relations WHERE member_id = $1 AND org_id = $2
+	      AND (expires_at IS NULL OR expires_at > NOW())`
 
 	member := auth.OrgMember{}
 	if err := or.db.QueryRowxContext(ctx, q, memberID, orgID).StructScan(&member); err != nil {
@@ -115,8 +125,8 @@ func (or membersRepository) Save(ctx context.Context, oms ...auth.OrgMember) err
 		return errors.Wrap(auth.ErrAssignMember, err)
 	}
 
-	qIns := `INSERT INTO member_relations (org_id, member_id, role, created_at, updated_at)
-			 VALUES(:org_id, :member_id, :role, :created_at, :updated_at)`
+	qIns := `INSERT INTO member_relations (org_id, member_id, role, created_at, updated_at, expires_at)
+			 VALUES(:org_id, :member_id, :role, :created_at, :updated_at, :expires_at)`
 
 	for _, om := range oms {
 		dbom := toDBOrgMember(om)
@@ -184,8 +194,23 @@ func (or membersRepository) Remove(ctx context.Context, orgID string, ids ...str
 	return nil
 }
 
+func (or membersRepository) RemoveByMember(ctx context.Context, memberID string) error {
+	q := `DELETE FROM member_relations WHERE member_id = :member_id`
+
+	dbom := toDBOrgMember(auth.OrgMember{MemberID: memberID})
+	if _, err := or.db.NamedExecContext(ctx, q, dbom); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok && pgErr.Code == pgerrcode.InvalidTextRepresentation {
+			return errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		return errors.Wrap(auth.ErrUnassignMember, err)
+	}
+
+	return nil
+}
+
 func (or membersRepository) Update(ctx context.Context, oms ...auth.OrgMember) error {
-	qUpd := `UPDATE member_relations SET role = :role, updated_at = :updated_at
+	qUpd := `UPDATE member_relations SET role = :role, updated_at = :updated_at, expires_at = :expires_at, expiry_notified = FALSE
 			 WHERE org_id = :org_id AND member_id = :member_id`
 
 	for _, om := range oms {
@@ -220,7 +245,7 @@ func (or membersRepository) Update(ctx context.Context, oms ...auth.OrgMember) e
 }
 
 func (or membersRepository) RetrieveAll(ctx context.Context) ([]auth.OrgMember, error) {
-	q := `SELECT org_id, member_id, role, created_at, updated_at FROM member_relations;`
+	q := `SELECT org_id, member_id, role, created_at, updated_at, expires_at FROM member_relations;`
 
 	rows, err := or.db.NamedQueryContext(ctx, q, map[string]interface{}{})
 	if err != nil {
@@ -241,12 +266,65 @@ func (or membersRepository) RetrieveAll(ctx context.Context) ([]auth.OrgMember,
 	return oms, nil
 }
 
+func (or membersRepository) RetrieveExpiring(ctx context.Context, before time.Time) ([]auth.OrgMember, error) {
+	q := `SELECT org_id, member_id, role, created_at, updated_at, expires_at FROM member_relations
+	      WHERE expires_at IS NOT NULL AND expires_at <= $1 AND NOT expiry_notified ORDER BY expires_at`
+
+	rows, err := or.db.QueryxContext(ctx, q, before)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	return scanOrgMembers(rows)
+}
+
+func (or membersRepository) UpdateNotified(ctx context.Context, oms ...auth.OrgMember) error {
+	q := `UPDATE member_relations SET expiry_notified = TRUE WHERE org_id = :org_id AND member_id = :member_id`
+
+	for _, om := range oms {
+		if _, err := or.db.NamedExecContext(ctx, q, toDBOrgMember(om)); err != nil {
+			return errors.Wrap(errors.ErrUpdateEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (or membersRepository) RemoveExpired(ctx context.Context, now time.Time) ([]auth.OrgMember, error) {
+	q := `DELETE FROM member_relations WHERE expires_at IS NOT NULL AND expires_at <= $1
+	      RETURNING org_id, member_id, role, created_at, updated_at, expires_at`
+
+	rows, err := or.db.QueryxContext(ctx, q, now)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+	defer rows.Close()
+
+	return scanOrgMembers(rows)
+}
+
+func scanOrgMembers(rows *sqlx.Rows) ([]auth.OrgMember, error) {
+	oms := []auth.OrgMember{}
+	for rows.Next() {
+		dbom := dbOrgMember{}
+		if err := rows.StructScan(&dbom); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		oms = append(oms, toMemberRelation(dbom))
+	}
+
+	return oms, nil
+}
+
 type dbMember struct {
-	MemberID  string    `db:"member_id"`
-	OrgID     string    `db:"org_id"`
-	Role      string    `db:"role"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	MemberID  string       `db:"member_id"`
+	OrgID     string       `db:"org_id"`
+	Role      string       `db:"role"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt time.Time    `db:"updated_at"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
 }
 
 type dbOrgMemberPage struct {
@@ -268,29 +346,40 @@ func toDBOrgMemberPage(memberID, orgID string, pm auth.PageMetadata) (dbOrgMembe
 }
 
 type dbOrgMember struct {
-	OrgID     string    `db:"org_id"`
-	MemberID  string    `db:"member_id"`
-	Role      string    `db:"role"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	OrgID     string       `db:"org_id"`
+	MemberID  string       `db:"member_id"`
+	Role      string       `db:"role"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt time.Time    `db:"updated_at"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
 }
 
 func toDBOrgMember(om auth.OrgMember) dbOrgMember {
-	return dbOrgMember{
+	dbom := dbOrgMember{
 		OrgID:     om.OrgID,
 		MemberID:  om.MemberID,
 		Role:      om.Role,
 		CreatedAt: om.CreatedAt,
 		UpdatedAt: om.UpdatedAt,
 	}
+	if !om.ExpiresAt.IsZero() {
+		dbom.ExpiresAt = sql.NullTime{Time: om.ExpiresAt, Valid: true}
+	}
+
+	return dbom
 }
 
 func toMemberRelation(dbom dbOrgMember) auth.OrgMember {
-	return auth.OrgMember{
+	om := auth.OrgMember{
 		OrgID:     dbom.OrgID,
 		MemberID:  dbom.MemberID,
 		Role:      dbom.Role,
 		CreatedAt: dbom.CreatedAt,
 		UpdatedAt: dbom.UpdatedAt,
 	}
+	if dbom.ExpiresAt.Valid {
+		om.ExpiresAt = dbom.ExpiresAt.Time
+	}
+
+	return om
 }
@@ -96,6 +96,35 @@ func (or orgRepository) Update(ctx context.Context, org auth.Org) error {
 	return nil
 }
 
+func (or orgRepository) UpdateOwner(ctx context.Context, org auth.Org) error {
+	q := `UPDATE orgs SET owner_id = :owner_id, updated_at = :updated_at WHERE id = :id`
+
+	dbo, err := toDBOrg(org)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, err := or.db.NamedExecContext(ctx, q, dbo)
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok && pgErr.Code == pgerrcode.InvalidTextRepresentation {
+			return errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt != 1 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
 func (or orgRepository) Remove(ctx context.Context, owner, orgID string) error {
 	qd := `DELETE FROM orgs WHERE id = :id AND owner_id = :owner_id;`
 	org := auth.Org{
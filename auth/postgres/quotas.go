@@ -0,0 +1,94 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ auth.QuotaRepository = (*quotaRepository)(nil)
+
+type quotaRepository struct {
+	db Database
+}
+
+// NewQuotaRepo instantiates a PostgreSQL implementation of quota repository.
+func NewQuotaRepo(db Database) auth.QuotaRepository {
+	return &quotaRepository{
+		db: db,
+	}
+}
+
+func (qr quotaRepository) Save(ctx context.Context, orgID string, limits map[string]uint64) error {
+	tx, err := qr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	qDel := `DELETE FROM quotas WHERE org_id = :org_id;`
+	if _, err := tx.NamedExecContext(ctx, qDel, dbQuota{OrgID: orgID}); err != nil {
+		tx.Rollback()
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	qIns := `INSERT INTO quotas (org_id, resource, quota) VALUES (:org_id, :resource, :quota);`
+	for res, limit := range limits {
+		if limit == 0 {
+			continue
+		}
+
+		dbq := dbQuota{OrgID: orgID, Resource: res, Quota: int64(limit)}
+		if _, err := tx.NamedExecContext(ctx, qIns, dbq); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.ForeignKeyViolation:
+					return errors.Wrap(errors.ErrNotFound, err)
+				}
+			}
+			return errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (qr quotaRepository) RetrieveByOrg(ctx context.Context, orgID string) (map[string]uint64, error) {
+	q := `SELECT org_id, resource, quota FROM quotas WHERE org_id = $1;`
+
+	rows, err := qr.db.QueryxContext(ctx, q, orgID)
+	if err != nil {
+		return map[string]uint64{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	limits := map[string]uint64{}
+	for rows.Next() {
+		var dbq dbQuota
+		if err := rows.StructScan(&dbq); err != nil {
+			return map[string]uint64{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		limits[dbq.Resource] = uint64(dbq.Quota)
+	}
+
+	return limits, nil
+}
+
+type dbQuota struct {
+	OrgID    string `db:"org_id"`
+	Resource string `db:"resource"`
+	Quota    int64  `db:"quota"`
+}
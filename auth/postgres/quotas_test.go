@@ -0,0 +1,137 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/auth/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveQuotas(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	repoOrg := postgres.NewOrgRepo(dbMiddleware)
+	repo := postgres.NewQuotaRepo(dbMiddleware)
+
+	org := createSigningOrg(t, repoOrg)
+	unknownID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		orgID  string
+		limits map[string]uint64
+		saved  map[string]uint64
+		err    error
+	}{
+		{
+			desc:   "save quotas",
+			orgID:  org.ID,
+			limits: map[string]uint64{auth.ThingsResource: 10, auth.GroupsResource: 2},
+			saved:  map[string]uint64{auth.ThingsResource: 10, auth.GroupsResource: 2},
+			err:    nil,
+		},
+		{
+			desc:   "replace quotas",
+			orgID:  org.ID,
+			limits: map[string]uint64{auth.ProfilesResource: 5, auth.MembersResource: 3},
+			saved:  map[string]uint64{auth.ProfilesResource: 5, auth.MembersResource: 3},
+			err:    nil,
+		},
+		{
+			desc:   "save quotas without zero limits",
+			orgID:  org.ID,
+			limits: map[string]uint64{auth.ThingsResource: 20, auth.MembersResource: 0},
+			saved:  map[string]uint64{auth.ThingsResource: 20},
+			err:    nil,
+		},
+		{
+			desc:   "remove quotas",
+			orgID:  org.ID,
+			limits: map[string]uint64{},
+			saved:  map[string]uint64{},
+			err:    nil,
+		},
+		{
+			desc:   "save quotas of non-existing org",
+			orgID:  unknownID,
+			limits: map[string]uint64{auth.ThingsResource: 10},
+			saved:  map[string]uint64{},
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "save quotas with invalid org id",
+			orgID:  invalidID,
+			limits: map[string]uint64{auth.ThingsResource: 10},
+			saved:  nil,
+			err:    errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.orgID, tc.limits)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.saved == nil {
+			continue
+		}
+
+		limits, err := repo.RetrieveByOrg(context.Background(), tc.orgID)
+		require.Nil(t, err, fmt.Sprintf("%s: got unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.saved, limits, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.saved, limits))
+	}
+}
+
+func TestRetrieveQuotasByOrg(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	repoOrg := postgres.NewOrgRepo(dbMiddleware)
+	repo := postgres.NewQuotaRepo(dbMiddleware)
+
+	org := createSigningOrg(t, repoOrg)
+	noQuotas := createSigningOrg(t, repoOrg)
+	removed := createSigningOrg(t, repoOrg)
+
+	limits := map[string]uint64{auth.ThingsResource: 10, auth.ProfilesResource: 4}
+	err := repo.Save(context.Background(), org.ID, limits)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	err = repo.Save(context.Background(), removed.ID, limits)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	// The quotas are removed together with their org.
+	err = repoOrg.Remove(context.Background(), removed.OwnerID, removed.ID)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		orgID  string
+		limits map[string]uint64
+	}{
+		{
+			desc:   "retrieve quotas of the org",
+			orgID:  org.ID,
+			limits: limits,
+		},
+		{
+			desc:   "retrieve quotas of the org without quotas",
+			orgID:  noQuotas.ID,
+			limits: map[string]uint64{},
+		},
+		{
+			desc:   "retrieve quotas of the removed org",
+			orgID:  removed.ID,
+			limits: map[string]uint64{},
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByOrg(context.Background(), tc.orgID)
+		assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.limits, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.limits, res))
+	}
+}
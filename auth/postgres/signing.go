@@ -0,0 +1,100 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/encryption"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ auth.SigningKeyRepository = (*signingKeyRepository)(nil)
+
+type signingKeyRepository struct {
+	db     Database
+	cipher encryption.Cipher
+}
+
+// NewSigningKeyRepo instantiates a PostgreSQL implementation of signing key
+// repository. The private keys are encrypted using AES-GCM with the SHA-256
+// hash of the provided key.
+func NewSigningKeyRepo(db Database, key string) (auth.SigningKeyRepository, error) {
+	c, err := encryption.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingKeyRepository{
+		db:     db,
+		cipher: c,
+	}, nil
+}
+
+func (skr signingKeyRepository) Save(ctx context.Context, sk auth.SigningKey) error {
+	q := `INSERT INTO signing_keys (org_id, public_key, private_key, created_at) VALUES (:org_id, :public_key, :private_key, :created_at)
+		  ON CONFLICT (org_id) DO UPDATE SET public_key = EXCLUDED.public_key, private_key = EXCLUDED.private_key, created_at = EXCLUDED.created_at;`
+
+	priv, err := skr.cipher.Encrypt(sk.PrivateKey, []byte(sk.OrgID))
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	dbsk := dbSigningKey{
+		OrgID:      sk.OrgID,
+		PublicKey:  sk.PublicKey,
+		PrivateKey: priv,
+		CreatedAt:  sk.CreatedAt,
+	}
+
+	if _, err := skr.db.NamedExecContext(ctx, q, dbsk); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (skr signingKeyRepository) RetrieveByOrg(ctx context.Context, orgID string) (auth.SigningKey, error) {
+	q := `SELECT org_id, public_key, private_key, created_at FROM signing_keys WHERE org_id = $1;`
+
+	var dbsk dbSigningKey
+	if err := skr.db.QueryRowxContext(ctx, q, orgID).StructScan(&dbsk); err != nil {
+		if err == sql.ErrNoRows {
+			return auth.SigningKey{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return auth.SigningKey{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	priv, err := skr.cipher.Decrypt(dbsk.PrivateKey, []byte(dbsk.OrgID))
+	if err != nil {
+		return auth.SigningKey{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return auth.SigningKey{
+		OrgID:      dbsk.OrgID,
+		PublicKey:  dbsk.PublicKey,
+		PrivateKey: priv,
+		CreatedAt:  dbsk.CreatedAt,
+	}, nil
+}
+
+func (skr signingKeyRepository) Remove(ctx context.Context, orgID string) error {
+	q := `DELETE FROM signing_keys WHERE org_id = :org_id;`
+
+	if _, err := skr.db.NamedExecContext(ctx, q, dbSigningKey{OrgID: orgID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbSigningKey struct {
+	OrgID      string    `db:"org_id"`
+	PublicKey  []byte    `db:"public_key"`
+	PrivateKey []byte    `db:"private_key"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,153 @@
+package postgres_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/auth/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signingSecret = "secret"
+
+func TestSaveSigningKey(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	repoOrg := postgres.NewOrgRepo(dbMiddleware)
+	repo, err := postgres.NewSigningKeyRepo(dbMiddleware, signingSecret)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	org := createSigningOrg(t, repoOrg)
+	sk := newSigningKey(t, org.ID)
+	replaced := newSigningKey(t, org.ID)
+
+	cases := []struct {
+		desc string
+		key  auth.SigningKey
+		err  error
+	}{
+		{
+			desc: "save signing key",
+			key:  sk,
+			err:  nil,
+		},
+		{
+			desc: "replace signing key",
+			key:  replaced,
+			err:  nil,
+		},
+		{
+			desc: "save signing key of non-existing org",
+			key:  newSigningKey(t, invalidID),
+			err:  errors.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.key)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	saved, err := repo.RetrieveByOrg(context.Background(), org.ID)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	assert.Equal(t, replaced.PublicKey, saved.PublicKey, fmt.Sprintf("expected public key %v got %v\n", replaced.PublicKey, saved.PublicKey))
+	assert.Equal(t, replaced.PrivateKey, saved.PrivateKey, fmt.Sprintf("expected private key %v got %v\n", replaced.PrivateKey, saved.PrivateKey))
+
+	var stored []byte
+	err = db.QueryRowx(`SELECT private_key FROM signing_keys WHERE org_id = $1`, org.ID).Scan(&stored)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	assert.NotEqual(t, []byte(replaced.PrivateKey), stored, "expected private key to be encrypted at rest\n")
+}
+
+func TestRetrieveSigningKey(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	repoOrg := postgres.NewOrgRepo(dbMiddleware)
+	repo, err := postgres.NewSigningKeyRepo(dbMiddleware, signingSecret)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	org := createSigningOrg(t, repoOrg)
+	sk := newSigningKey(t, org.ID)
+	err = repo.Save(context.Background(), sk)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	unknownID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		orgID string
+		key   ed25519.PublicKey
+		err   error
+	}{
+		{
+			desc:  "retrieve signing key",
+			orgID: org.ID,
+			key:   sk.PublicKey,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve signing key of org without key",
+			orgID: unknownID,
+			key:   nil,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByOrg(context.Background(), tc.orgID)
+		assert.Equal(t, tc.key, res.PublicKey, fmt.Sprintf("%s: expected public key %v got %v\n", tc.desc, tc.key, res.PublicKey))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRemoveSigningKey(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	repoOrg := postgres.NewOrgRepo(dbMiddleware)
+	repo, err := postgres.NewSigningKeyRepo(dbMiddleware, signingSecret)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	org := createSigningOrg(t, repoOrg)
+	err = repo.Save(context.Background(), newSigningKey(t, org.ID))
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	err = repo.Remove(context.Background(), org.ID)
+	assert.Nil(t, err, fmt.Sprintf("remove signing key: got unexpected error: %s", err))
+
+	_, err = repo.RetrieveByOrg(context.Background(), org.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("retrieve removed signing key: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func createSigningOrg(t *testing.T, repo auth.OrgRepository) auth.Org {
+	orgID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	ownerID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	org := auth.Org{
+		ID:        orgID,
+		OwnerID:   ownerID,
+		Name:      orgName,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	err = repo.Save(context.Background(), org)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	return org
+}
+
+func newSigningKey(t *testing.T, orgID string) auth.SigningKey {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	return auth.SigningKey{
+		OrgID:      orgID,
+		PublicKey:  pub,
+		PrivateKey: priv,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+const (
+	// ThingsResource is the quota resource limiting the number of things.
+	ThingsResource = "things"
+	// ProfilesResource is the quota resource limiting the number of profiles.
+	ProfilesResource = "profiles"
+	// GroupsResource is the quota resource limiting the number of groups.
+	GroupsResource = "groups"
+	// MembersResource is the quota resource limiting the number of org members.
+	MembersResource = "members"
+)
+
+// QuotaResources contains the resources whose number can be limited. Only
+// the resources counted by the things and auth services can be limited.
+var QuotaResources = []string{ThingsResource, ProfilesResource, GroupsResource, MembersResource}
+
+// ErrUnknownResource indicates the quota of the resource which can't be limited.
+var ErrUnknownResource = errors.New("unknown quota resource")
+
+// Quota represents the maximum number of the resources of the kind the org
+// can have, along with the number of them the org currently has. The zero
+// limit means that the number of the resources isn't limited.
+type Quota struct {
+	Resource string
+	Limit    uint64
+	Usage    uint64
+}
+
+// QuotaRepository specifies an org quota persistence API. The quotas are
+// represented as the limits of the resources.
+type QuotaRepository interface {
+	// Save replaces the quotas of the org.
+	Save(ctx context.Context, orgID string, limits map[string]uint64) error
+
+	// RetrieveByOrg retrieves the quotas of the org. The resources without
+	// the quota are omitted.
+	RetrieveByOrg(ctx context.Context, orgID string) (map[string]uint64, error)
+}
+
+// Quotas specifies an API for managing the soft limits of the number of the
+// resources each org can have. The limits are checked by the services when
+// the resources are created.
+type Quotas interface {
+	// UpdateQuotas replaces the quotas of the org. The resources omitted, or
+	// having the zero limit, aren't limited. Only accessible by admin.
+	UpdateQuotas(ctx context.Context, token, orgID string, limits map[string]uint64) error
+
+	// ViewQuotas retrieves the quotas and the usage of all the resources
+	// of the org.
+	ViewQuotas(ctx context.Context, token, orgID string) ([]Quota, error)
+
+	// CheckQuota returns ErrQuotaExceeded if the org, having the usage of
+	// the resource, can't have the count more of them.
+	CheckQuota(ctx context.Context, orgID, resource string, usage, count uint64) error
+}
+
+func (svc service) UpdateQuotas(ctx context.Context, token, orgID string, limits map[string]uint64) error {
+	if err := svc.isAdmin(ctx, token); err != nil {
+		return err
+	}
+
+	for res := range limits {
+		if !isQuotaResource(res) {
+			return ErrUnknownResource
+		}
+	}
+
+	if _, err := svc.orgs.RetrieveByID(ctx, orgID); err != nil {
+		return err
+	}
+
+	return svc.quotas.Save(ctx, orgID, limits)
+}
+
+func (svc service) ViewQuotas(ctx context.Context, token, orgID string) ([]Quota, error) {
+	if err := svc.canAccessOrg(ctx, token, orgID, Viewer); err != nil {
+		return []Quota{}, err
+	}
+
+	limits, err := svc.quotas.RetrieveByOrg(ctx, orgID)
+	if err != nil {
+		return []Quota{}, err
+	}
+
+	st, err := svc.things.GetOrgStats(ctx, &protomfx.OrgStatsReq{OrgID: orgID})
+	if err != nil {
+		return []Quota{}, err
+	}
+
+	members, err := svc.membersUsage(ctx, orgID)
+	if err != nil {
+		return []Quota{}, err
+	}
+
+	usage := map[string]uint64{
+		ThingsResource:   st.GetThings(),
+		ProfilesResource: st.GetProfiles(),
+		GroupsResource:   st.GetGroups(),
+		MembersResource:  members,
+	}
+
+	qs := make([]Quota, 0, len(QuotaResources))
+	for _, res := range QuotaResources {
+		qs = append(qs, Quota{Resource: res, Limit: limits[res], Usage: usage[res]})
+	}
+
+	return qs, nil
+}
+
+func (svc service) CheckQuota(ctx context.Context, orgID, resource string, usage, count uint64) error {
+	if !isQuotaResource(resource) {
+		return ErrUnknownResource
+	}
+
+	limits, err := svc.quotas.RetrieveByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	if limit := limits[resource]; limit > 0 && usage+count > limit {
+		return errors.ErrQuotaExceeded
+	}
+
+	return nil
+}
+
+func (svc service) membersUsage(ctx context.Context, orgID string) (uint64, error) {
+	// Only the total is needed, so a single member is retrieved.
+	mp, err := svc.members.RetrieveByOrgID(ctx, orgID, PageMetadata{Limit: 1})
+	if err != nil {
+		return 0, err
+	}
+
+	return mp.Total, nil
+}
+
+func isQuotaResource(resource string) bool {
+	for _, res := range QuotaResources {
+		if res == resource {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,122 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	activityPrefix = "activity"
+	// activityTTL is the time after which the activity of the user is
+	// dropped if none of the user keys is used meanwhile.
+	activityTTL = 30 * 24 * time.Hour
+
+	callsField  = "calls"
+	errorsField = "errors"
+	lastField   = "last"
+	emailField  = "email"
+)
+
+var _ auth.ActivityRepository = (*activityRepository)(nil)
+
+type activityRepository struct {
+	client *redis.Client
+}
+
+// NewActivityRepository returns Redis key activity repository. The activity
+// of each user is aggregated in a single hash, whose fields are prefixed by
+// the type and the ID of the key.
+func NewActivityRepository(client *redis.Client) auth.ActivityRepository {
+	return &activityRepository{
+		client: client,
+	}
+}
+
+func (ar *activityRepository) Record(ctx context.Context, key auth.Key, failed bool, at time.Time) error {
+	hkey := activityKey(key.IssuerID)
+	prefix := fmt.Sprintf("%d:%s:", key.Type, key.ID)
+
+	pipe := ar.client.TxPipeline()
+	pipe.HIncrBy(ctx, hkey, prefix+callsField, 1)
+	if failed {
+		pipe.HIncrBy(ctx, hkey, prefix+errorsField, 1)
+	}
+	pipe.HSet(ctx, hkey, prefix+lastField, at.UnixNano(), prefix+emailField, key.Subject)
+	pipe.Expire(ctx, hkey, activityTTL)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (ar *activityRepository) RetrieveByUsers(ctx context.Context, userIDs []string) ([]auth.KeyActivity, error) {
+	if len(userIDs) == 0 {
+		return []auth.KeyActivity{}, nil
+	}
+
+	pipe := ar.client.Pipeline()
+	cmds := make([]*redis.StringStringMapCmd, len(userIDs))
+	for i, id := range userIDs {
+		cmds[i] = pipe.HGetAll(ctx, activityKey(id))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return []auth.KeyActivity{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	kas := []auth.KeyActivity{}
+	for i, cmd := range cmds {
+		keys := map[string]*auth.KeyActivity{}
+		for field, val := range cmd.Val() {
+			parts := strings.SplitN(field, ":", 3)
+			if len(parts) != 3 {
+				continue
+			}
+
+			prefix := parts[0] + ":" + parts[1]
+			ka, ok := keys[prefix]
+			if !ok {
+				typ, err := strconv.ParseUint(parts[0], 10, 32)
+				if err != nil {
+					continue
+				}
+				ka = &auth.KeyActivity{KeyID: parts[1], KeyType: uint32(typ), UserID: userIDs[i]}
+				keys[prefix] = ka
+			}
+
+			switch parts[2] {
+			case callsField:
+				ka.Calls, _ = strconv.ParseUint(val, 10, 64)
+			case errorsField:
+				ka.Errors, _ = strconv.ParseUint(val, 10, 64)
+			case lastField:
+				ns, _ := strconv.ParseInt(val, 10, 64)
+				ka.LastUsed = time.Unix(0, ns).UTC()
+			case emailField:
+				ka.Email = val
+			}
+		}
+
+		for _, ka := range keys {
+			kas = append(kas, *ka)
+		}
+	}
+
+	return kas, nil
+}
+
+func activityKey(userID string) string {
+	return fmt.Sprintf("%s:%s", activityPrefix, userID)
+}
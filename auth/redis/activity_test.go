@@ -0,0 +1,77 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/auth/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userID  = "userID"
+	keyID   = "keyID"
+	email   = "user@example.com"
+	unknown = "unknown"
+)
+
+func TestActivity(t *testing.T) {
+	repo := redis.NewActivityRepository(redisClient)
+
+	now := time.Now().UTC().Round(time.Millisecond)
+	apiKey := auth.Key{ID: keyID, Type: auth.APIKey, IssuerID: userID, Subject: email}
+	loginKey := auth.Key{Type: auth.LoginKey, IssuerID: userID, Subject: email}
+
+	records := []struct {
+		key    auth.Key
+		failed bool
+		at     time.Time
+	}{
+		{key: apiKey, failed: false, at: now.Add(-time.Minute)},
+		{key: apiKey, failed: true, at: now},
+		{key: loginKey, failed: false, at: now.Add(-time.Hour)},
+	}
+
+	for _, r := range records {
+		err := repo.Record(context.Background(), r.key, r.failed, r.at)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc    string
+		userIDs []string
+		keys    []auth.KeyActivity
+	}{
+		{
+			desc:    "retrieve activity of user",
+			userIDs: []string{userID},
+			keys: []auth.KeyActivity{
+				{KeyID: keyID, KeyType: auth.APIKey, UserID: userID, Email: email, Calls: 2, Errors: 1, LastUsed: now},
+				{KeyType: auth.LoginKey, UserID: userID, Email: email, Calls: 1, LastUsed: now.Add(-time.Hour)},
+			},
+		},
+		{
+			desc:    "retrieve activity of user without activity",
+			userIDs: []string{unknown},
+			keys:    []auth.KeyActivity{},
+		},
+		{
+			desc:    "retrieve activity without users",
+			userIDs: []string{},
+			keys:    []auth.KeyActivity{},
+		},
+	}
+
+	for _, tc := range cases {
+		kas, err := repo.RetrieveByUsers(context.Background(), tc.userIDs)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.ElementsMatch(t, tc.keys, kas, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.keys, kas))
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package redis contains the event store implementation using Redis
+// streams as the underlying storage, and the key activity repository
+// aggregating the API usage in Redis hashes.
+package redis
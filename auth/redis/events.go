@@ -0,0 +1,99 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+)
+
+const (
+	orgPrefix         = "org."
+	orgCreate         = orgPrefix + "create"
+	orgRemove         = orgPrefix + "remove"
+	orgMemberAssign   = orgPrefix + "member.assign"
+	orgMemberExpiring = orgPrefix + "member.expiring"
+	orgMemberExpire   = orgPrefix + "member.expire"
+
+	userPrefix = "user."
+	userRemove = userPrefix + "remove"
+)
+
+var (
+	_ events.Encoder = (*createOrgEvent)(nil)
+	_ events.Encoder = (*removeOrgEvent)(nil)
+	_ events.Encoder = (*assignMemberEvent)(nil)
+	_ events.Encoder = (*removeUserEvent)(nil)
+	_ events.Encoder = (*memberExpiryEvent)(nil)
+)
+
+type createOrgEvent struct {
+	id      string
+	ownerID string
+	name    string
+}
+
+func (coe createOrgEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        coe.id,
+		"owner_id":  coe.ownerID,
+		"name":      coe.name,
+		"operation": orgCreate,
+	}
+}
+
+type removeOrgEvent struct {
+	id string
+}
+
+func (roe removeOrgEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        roe.id,
+		"operation": orgRemove,
+	}
+}
+
+type assignMemberEvent struct {
+	orgID string
+	email string
+	role  string
+}
+
+func (ame assignMemberEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"org_id":    ame.orgID,
+		"email":     ame.email,
+		"role":      ame.role,
+		"operation": orgMemberAssign,
+	}
+}
+
+type removeUserEvent struct {
+	id string
+}
+
+func (rue removeUserEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        rue.id,
+		"operation": userRemove,
+	}
+}
+
+type memberExpiryEvent struct {
+	operation string
+	member    auth.OrgMember
+}
+
+func (mee memberExpiryEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"org_id":     mee.member.OrgID,
+		"member_id":  mee.member.MemberID,
+		"email":      mee.member.Email,
+		"role":       mee.member.Role,
+		"expires_at": mee.member.ExpiresAt.UTC().Format(time.RFC3339),
+		"operation":  mee.operation,
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"crypto/ed25519"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	streamID  = "mainflux.auth"
+	streamLen = 1000
+)
+
+var _ auth.Service = (*eventStore)(nil)
+
+type eventStore struct {
+	svc    auth.Service
+	client *redis.Client
+}
+
+// NewEventStoreMiddleware returns wrapper around auth service that sends
+// events to event store.
+func NewEventStoreMiddleware(svc auth.Service, client *redis.Client) auth.Service {
+	return eventStore{
+		svc:    svc,
+		client: client,
+	}
+}
+
+func (es eventStore) Issue(ctx context.Context, token string, key auth.Key) (auth.Key, string, error) {
+	return es.svc.Issue(ctx, token, key)
+}
+
+func (es eventStore) Revoke(ctx context.Context, token, id string) error {
+	return es.svc.Revoke(ctx, token, id)
+}
+
+func (es eventStore) RetrieveKey(ctx context.Context, token, id string) (auth.Key, error) {
+	return es.svc.RetrieveKey(ctx, token, id)
+}
+
+func (es eventStore) IdentifyDelegated(ctx context.Context, token string) (auth.Key, error) {
+	return es.svc.IdentifyDelegated(ctx, token)
+}
+
+func (es eventStore) Identify(ctx context.Context, token string) (auth.Identity, error) {
+	return es.svc.Identify(ctx, token)
+}
+
+func (es eventStore) Authorize(ctx context.Context, ar auth.AuthzReq) error {
+	return es.svc.Authorize(ctx, ar)
+}
+
+func (es eventStore) CheckAuthz(ctx context.Context, token string, ars []auth.AuthzReq) ([]bool, error) {
+	return es.svc.CheckAuthz(ctx, token, ars)
+}
+
+func (es eventStore) AssignRole(ctx context.Context, id, role string) error {
+	return es.svc.AssignRole(ctx, id, role)
+}
+
+func (es eventStore) RetrieveRole(ctx context.Context, id string) (string, error) {
+	return es.svc.RetrieveRole(ctx, id)
+}
+
+// CreateOrg sends the event which triggers the provisioning of the default
+// org entities owned by other services, such as groups and profiles.
+func (es eventStore) CreateOrg(ctx context.Context, token string, org auth.Org) (auth.Org, error) {
+	o, err := es.svc.CreateOrg(ctx, token, org)
+	if err != nil {
+		return o, err
+	}
+
+	event := createOrgEvent{
+		id:      o.ID,
+		ownerID: o.OwnerID,
+		name:    o.Name,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return o, nil
+}
+
+func (es eventStore) UpdateOrg(ctx context.Context, token string, org auth.Org) (auth.Org, error) {
+	return es.svc.UpdateOrg(ctx, token, org)
+}
+
+func (es eventStore) ViewOrg(ctx context.Context, token, id string) (auth.Org, error) {
+	return es.svc.ViewOrg(ctx, token, id)
+}
+
+func (es eventStore) ListOrgs(ctx context.Context, token string, pm auth.PageMetadata) (auth.OrgsPage, error) {
+	return es.svc.ListOrgs(ctx, token, pm)
+}
+
+func (es eventStore) ListOrgsByMember(ctx context.Context, token, memberID string, pm auth.PageMetadata) (auth.OrgsPage, error) {
+	return es.svc.ListOrgsByMember(ctx, token, memberID, pm)
+}
+
+// RemoveOrg sends the event which triggers the removal of the org
+// entities owned by other services, such as groups, things and profiles.
+func (es eventStore) RemoveOrg(ctx context.Context, token, id string) error {
+	if err := es.svc.RemoveOrg(ctx, token, id); err != nil {
+		return err
+	}
+
+	event := removeOrgEvent{
+		id: id,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return nil
+}
+
+func (es eventStore) Backup(ctx context.Context, token string) (auth.Backup, error) {
+	return es.svc.Backup(ctx, token)
+}
+
+func (es eventStore) Restore(ctx context.Context, token string, backup auth.Backup) error {
+	return es.svc.Restore(ctx, token, backup)
+}
+
+func (es eventStore) ListOrgAccess(ctx context.Context, token string) ([]auth.OrgAccess, error) {
+	return es.svc.ListOrgAccess(ctx, token)
+}
+
+func (es eventStore) ViewOverview(ctx context.Context, token string) (auth.Overview, error) {
+	return es.svc.ViewOverview(ctx, token)
+}
+
+func (es eventStore) UpdateQuotas(ctx context.Context, token, orgID string, limits map[string]uint64) error {
+	return es.svc.UpdateQuotas(ctx, token, orgID, limits)
+}
+
+func (es eventStore) ViewQuotas(ctx context.Context, token, orgID string) ([]auth.Quota, error) {
+	return es.svc.ViewQuotas(ctx, token, orgID)
+}
+
+func (es eventStore) CheckQuota(ctx context.Context, orgID, resource string, usage, count uint64) error {
+	return es.svc.CheckQuota(ctx, orgID, resource, usage, count)
+}
+
+// RemoveUser sends the events which trigger the removal of the orgs removed
+// along with the user, and of the user data owned by other services, such
+// as the group roles. The events of the removed orgs are sent even if the
+// removal of the user fails midway, since the orgs aren't retrieved again
+// when the removal is retried.
+func (es eventStore) RemoveUser(ctx context.Context, id string) ([]string, error) {
+	orgIDs, err := es.svc.RemoveUser(ctx, id)
+	for _, orgID := range orgIDs {
+		event := removeOrgEvent{
+			id: orgID,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
+
+	if err != nil {
+		return orgIDs, err
+	}
+
+	event := removeUserEvent{
+		id: id,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return orgIDs, nil
+}
+
+func (es eventStore) ListActivityByOrg(ctx context.Context, token, orgID string, pm auth.PageMetadata) (auth.ActivityPage, error) {
+	return es.svc.ListActivityByOrg(ctx, token, orgID, pm)
+}
+
+func (es eventStore) AssignMembers(ctx context.Context, token, orgID string, oms ...auth.OrgMember) error {
+	if err := es.svc.AssignMembers(ctx, token, orgID, oms...); err != nil {
+		return err
+	}
+
+	for _, om := range oms {
+		event := assignMemberEvent{
+			orgID: orgID,
+			email: om.Email,
+			role:  om.Role,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
+
+	return nil
+}
+
+func (es eventStore) UnassignMembers(ctx context.Context, token string, orgID string, memberIDs ...string) error {
+	return es.svc.UnassignMembers(ctx, token, orgID, memberIDs...)
+}
+
+func (es eventStore) UpdateMembers(ctx context.Context, token, orgID string, oms ...auth.OrgMember) error {
+	return es.svc.UpdateMembers(ctx, token, orgID, oms...)
+}
+
+func (es eventStore) ListMembersByOrg(ctx context.Context, token, orgID string, pm auth.PageMetadata) (auth.OrgMembersPage, error) {
+	return es.svc.ListMembersByOrg(ctx, token, orgID, pm)
+}
+
+func (es eventStore) ViewMember(ctx context.Context, token, orgID, memberID string) (auth.OrgMember, error) {
+	return es.svc.ViewMember(ctx, token, orgID, memberID)
+}
+
+func (es eventStore) FilterOrgMembers(ctx context.Context, orgID string, memberIDs ...string) ([]string, error) {
+	return es.svc.FilterOrgMembers(ctx, orgID, memberIDs...)
+}
+
+// ExpireMembers sends the events of the revoked memberships, and of the
+// expiring ones, through which their members are notified.
+func (es eventStore) ExpireMembers(ctx context.Context, now time.Time, notice time.Duration) (auth.MembersExpiry, error) {
+	me, err := es.svc.ExpireMembers(ctx, now, notice)
+	if err != nil {
+		return me, err
+	}
+
+	for _, om := range me.Expiring {
+		event := memberExpiryEvent{
+			operation: orgMemberExpiring,
+			member:    om,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
+
+	for _, om := range me.Expired {
+		event := memberExpiryEvent{
+			operation: orgMemberExpire,
+			member:    om,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
+
+	return me, nil
+}
+
+func (es eventStore) CreateSigningKey(ctx context.Context, token, orgID string) (auth.SigningKey, error) {
+	return es.svc.CreateSigningKey(ctx, token, orgID)
+}
+
+func (es eventStore) ViewSigningKey(ctx context.Context, token, orgID string) (auth.SigningKey, error) {
+	return es.svc.ViewSigningKey(ctx, token, orgID)
+}
+
+func (es eventStore) RemoveSigningKey(ctx context.Context, token, orgID string) error {
+	return es.svc.RemoveSigningKey(ctx, token, orgID)
+}
+
+func (es eventStore) SignPayload(ctx context.Context, secret, orgID string, payload []byte) ([]byte, error) {
+	return es.svc.SignPayload(ctx, secret, orgID, payload)
+}
+
+func (es eventStore) RetrieveSigningKey(ctx context.Context, orgID string) (ed25519.PublicKey, error) {
+	return es.svc.RetrieveSigningKey(ctx, orgID)
+}
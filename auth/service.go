@@ -10,6 +10,8 @@ import (
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -20,6 +22,8 @@ const (
 	Viewer           = "viewer"
 	RootSub          = "root"
 	OrgSub           = "org"
+	GroupSub         = "group"
+	ThingSub         = "thing"
 )
 
 var (
@@ -60,6 +64,12 @@ type AuthzReq struct {
 // functionalities through `auth` to perform authorization.
 type Authz interface {
 	Authorize(ctx context.Context, ar AuthzReq) error
+
+	// CheckAuthz checks each of the provided authorization requests on
+	// behalf of the token holder, returning whether each of them is allowed.
+	// The tokens of the requests are ignored. The group and thing requests
+	// are checked by the things service.
+	CheckAuthz(ctx context.Context, token string, ars []AuthzReq) ([]bool, error)
 }
 
 // Service specifies an API that must be fulfilled by the domain service
@@ -73,6 +83,11 @@ type Service interface {
 	Orgs
 	Members
 	Keys
+	Platform
+	SigningKeys
+	Activity
+	Quotas
+	Users
 }
 
 var _ Service = (*service)(nil)
@@ -81,31 +96,56 @@ type service struct {
 	orgs          OrgRepository
 	users         protomfx.UsersServiceClient
 	things        protomfx.ThingsServiceClient
+	monitor       Monitor
+	emailer       Emailer
 	keys          KeyRepository
 	roles         RolesRepository
 	members       MembersRepository
+	signingKeys   SigningKeyRepository
+	activity      ActivityRepository
+	quotas        QuotaRepository
 	idProvider    uuid.IDProvider
 	tokenizer     Tokenizer
 	loginDuration time.Duration
+	signingSecret string
 }
 
-// New instantiates the auth service implementation.
-func New(orgs OrgRepository, tc protomfx.ThingsServiceClient, uc protomfx.UsersServiceClient, keys KeyRepository, roles RolesRepository,
-	members MembersRepository, idp uuid.IDProvider, tokenizer Tokenizer, duration time.Duration) Service {
+// New instantiates the auth service implementation. The payloads are signed
+// only for the callers providing the signing secret, and never if the secret
+// is empty.
+func New(orgs OrgRepository, tc protomfx.ThingsServiceClient, uc protomfx.UsersServiceClient, monitor Monitor, emailer Emailer, keys KeyRepository, roles RolesRepository,
+	members MembersRepository, signingKeys SigningKeyRepository, activity ActivityRepository, quotas QuotaRepository, idp uuid.IDProvider, tokenizer Tokenizer, duration time.Duration, signingSecret string) Service {
 	return &service{
 		tokenizer:     tokenizer,
 		things:        tc,
 		orgs:          orgs,
 		users:         uc,
+		monitor:       monitor,
+		emailer:       emailer,
 		keys:          keys,
 		roles:         roles,
 		members:       members,
+		signingKeys:   signingKeys,
+		activity:      activity,
+		quotas:        quotas,
 		idProvider:    idp,
 		loginDuration: duration,
+		signingSecret: signingSecret,
 	}
 }
 
 func (svc service) Authorize(ctx context.Context, ar AuthzReq) error {
+	err := svc.authorize(ctx, ar)
+	// The root subject is checked to find out whether the user is the
+	// admin, so its denial isn't the error of the call.
+	if ar.Subject == OrgSub {
+		svc.recordActivity(ctx, ar.Token, err)
+	}
+
+	return err
+}
+
+func (svc service) authorize(ctx context.Context, ar AuthzReq) error {
 	switch ar.Subject {
 	case RootSub:
 		return svc.isAdmin(ctx, ar.Token)
@@ -116,8 +156,92 @@ func (svc service) Authorize(ctx context.Context, ar AuthzReq) error {
 	}
 }
 
+func (svc service) CheckAuthz(ctx context.Context, token string, ars []AuthzReq) ([]bool, error) {
+	if _, err := svc.identify(ctx, token); err != nil {
+		return nil, err
+	}
+
+	allowed := make([]bool, len(ars))
+	var thingsReqs []int
+	for i, ar := range ars {
+		if ar.Subject == GroupSub || ar.Subject == ThingSub {
+			thingsReqs = append(thingsReqs, i)
+			continue
+		}
+
+		ar.Token = token
+		err := svc.authorize(ctx, ar)
+		switch {
+		case err == nil:
+			allowed[i] = true
+		case errors.Contains(err, errors.ErrAuthorization),
+			errors.Contains(err, errors.ErrNotFound),
+			err == errUnknownSubject:
+		default:
+			return nil, err
+		}
+	}
+
+	if err := svc.checkThingsAuthz(ctx, token, ars, thingsReqs, allowed); err != nil {
+		return nil, err
+	}
+
+	return allowed, nil
+}
+
+// checkThingsAuthz checks the group and thing requests at the given indexes
+// using the things service. The requests are checked in a single batch, and
+// one by one only if the batch is denied, to find out the denied ones.
+func (svc service) checkThingsAuthz(ctx context.Context, token string, ars []AuthzReq, idxs []int, allowed []bool) error {
+	if len(idxs) == 0 {
+		return nil
+	}
+
+	var reqs []*protomfx.AuthorizeReq
+	for _, i := range idxs {
+		reqs = append(reqs, &protomfx.AuthorizeReq{Token: token, Object: ars[i].Object, Subject: ars[i].Subject, Action: ars[i].Action})
+	}
+
+	_, err := svc.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: reqs})
+	switch {
+	case err == nil:
+		for _, i := range idxs {
+			allowed[i] = true
+		}
+		return nil
+	case !deniedByThings(err):
+		return err
+	}
+
+	for k, i := range idxs {
+		_, err := svc.things.Authorize(ctx, reqs[k])
+		switch {
+		case err == nil:
+			allowed[i] = true
+		case !deniedByThings(err):
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deniedByThings reports whether the things service denied the request,
+// either since the access isn't allowed or the object doesn't exist.
+func deniedByThings(err error) bool {
+	switch status.Code(err) {
+	case codes.PermissionDenied, codes.NotFound, codes.InvalidArgument:
+		return true
+	}
+
+	return errors.Contains(err, errors.ErrAuthorization) || errors.Contains(err, errors.ErrNotFound)
+}
+
 func (svc service) Identify(ctx context.Context, token string) (Identity, error) {
-	return svc.identify(ctx, token)
+	id, err := svc.identify(ctx, token)
+	svc.recordActivity(ctx, token, err)
+
+	return id, err
 }
 
 func (svc service) identify(ctx context.Context, token string) (Identity, error) {
@@ -134,7 +258,7 @@ func (svc service) identify(ctx context.Context, token string) (Identity, error)
 	case RecoveryKey, LoginKey:
 		return Identity{ID: key.IssuerID, Email: key.Subject}, nil
 	case APIKey:
-		_, err := svc.keys.Retrieve(context.TODO(), key.IssuerID, key.ID)
+		_, err := svc.keys.Retrieve(ctx, key.IssuerID, key.ID)
 		if err != nil {
 			return Identity{}, errors.ErrAuthentication
 		}
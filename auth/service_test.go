@@ -5,6 +5,7 @@ package auth_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"testing"
 	"time"
@@ -30,6 +31,8 @@ const (
 	editorEmail     = "editor@test.com"
 	adminEmail      = "admin@test.com"
 	id              = "testID"
+	thingID         = "thingID"
+	groupID         = "groupID"
 	ownerID         = "ownerID"
 	adminID         = "adminID"
 	editorID        = "editorID"
@@ -41,6 +44,7 @@ const (
 	n               = 10
 
 	loginDuration = 30 * time.Minute
+	signingSecret = "signing-secret"
 )
 
 var (
@@ -49,9 +53,16 @@ var (
 	usersByEmails = map[string]users.User{adminEmail: {ID: adminID, Email: adminEmail}, editorEmail: {ID: editorID, Email: editorEmail}, viewerEmail: {ID: viewerID, Email: viewerEmail}, ownerEmail: {ID: ownerID, Email: ownerEmail}}
 	usersByIDs    = map[string]users.User{adminID: {ID: adminID, Email: adminEmail}, editorID: {ID: editorID, Email: editorEmail}, viewerID: {ID: viewerID, Email: viewerEmail}, ownerID: {ID: ownerID, Email: ownerEmail}}
 	idProvider    = uuid.New()
+	throughput    = auth.Throughput{InMsgs: 100, OutMsgs: 200, InBytes: 1000, OutBytes: 2000, InMsgsRate: 1.5, OutMsgsRate: 3}
+	consumerLags  = []auth.ConsumerLag{{Stream: "mainflux.things", Group: "mainflux.webhooks", Pending: 1, Lag: 2}}
+	services      = []auth.ServiceHealth{{Name: "things", Status: "pass", Version: "0.0.0"}, {Name: "users", Status: "fail", Error: "connection refused"}}
 )
 
 func newService() auth.Service {
+	return newServiceWithEmailer(mocks.NewEmailer())
+}
+
+func newServiceWithEmailer(emailer auth.Emailer) auth.Service {
 	keyRepo := mocks.NewKeyRepository()
 	idMockProvider := uuid.NewMock()
 	membsRepo := mocks.NewMembersRepository()
@@ -60,7 +71,21 @@ func newService() auth.Service {
 	uc := mocks.NewUsersService(usersByIDs, usersByEmails)
 	tc := thmocks.NewThingsServiceClient(nil, nil, createGroups())
 	t := jwt.New(secret)
-	return auth.New(orgRepo, tc, uc, keyRepo, roleRepo, membsRepo, idMockProvider, t, loginDuration)
+	return auth.New(orgRepo, tc, uc, mocks.NewMonitor(throughput, consumerLags, services), emailer, keyRepo, roleRepo, membsRepo, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idMockProvider, t, loginDuration, signingSecret)
+}
+
+// newDelegatingService returns the service whose users, identified by the token,
+// can view the things and delegate the access to them.
+func newDelegatingService(token string) auth.Service {
+	keyRepo := mocks.NewKeyRepository()
+	idMockProvider := uuid.NewMock()
+	membsRepo := mocks.NewMembersRepository()
+	orgRepo := mocks.NewOrgRepository(membsRepo)
+	roleRepo := mocks.NewRolesRepository()
+	uc := mocks.NewUsersService(usersByIDs, usersByEmails)
+	tc := thmocks.NewThingsServiceClient(nil, map[string]string{token: groupID}, map[string]things.Group{token: {ID: groupID}})
+	t := jwt.New(secret)
+	return auth.New(orgRepo, tc, uc, mocks.NewMonitor(throughput, consumerLags, services), mocks.NewEmailer(), keyRepo, roleRepo, membsRepo, mocks.NewSigningKeyRepository(), mocks.NewActivityRepository(), mocks.NewQuotaRepository(), idMockProvider, t, loginDuration, signingSecret)
 }
 
 func createGroups() map[string]things.Group {
@@ -337,6 +362,127 @@ func TestIdentify(t *testing.T) {
 	}
 }
 
+func TestIssueDelegated(t *testing.T) {
+	_, loginSecret, err := newService().Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	svc := newDelegatingService(loginSecret)
+
+	now := time.Now()
+	cases := []struct {
+		desc  string
+		key   auth.Key
+		token string
+		err   error
+	}{
+		{
+			desc:  "issue delegated key",
+			key:   auth.Key{Type: auth.DelegatedKey, IssuedAt: now, Scope: auth.Scope{ThingID: thingID, Action: auth.SubscribeAction}},
+			token: loginSecret,
+			err:   nil,
+		},
+		{
+			desc:  "issue delegated key with expiration time",
+			key:   auth.Key{Type: auth.DelegatedKey, IssuedAt: now, ExpiresAt: now.Add(time.Minute), Scope: auth.Scope{ThingID: thingID, Action: auth.SubscribeAction}},
+			token: loginSecret,
+			err:   nil,
+		},
+		{
+			desc:  "issue delegated key without thing",
+			key:   auth.Key{Type: auth.DelegatedKey, IssuedAt: now, Scope: auth.Scope{Action: auth.SubscribeAction}},
+			token: loginSecret,
+			err:   auth.ErrInvalidScope,
+		},
+		{
+			desc:  "issue delegated key with invalid action",
+			key:   auth.Key{Type: auth.DelegatedKey, IssuedAt: now, Scope: auth.Scope{ThingID: thingID, Action: "publish"}},
+			token: loginSecret,
+			err:   auth.ErrInvalidScope,
+		},
+		{
+			desc:  "issue delegated key exceeding max duration",
+			key:   auth.Key{Type: auth.DelegatedKey, IssuedAt: now, ExpiresAt: now.Add(auth.MaxDelegatedDuration + time.Minute), Scope: auth.Scope{ThingID: thingID, Action: auth.SubscribeAction}},
+			token: loginSecret,
+			err:   auth.ErrInvalidKeyDuration,
+		},
+		{
+			desc:  "issue delegated key for unauthorized thing",
+			key:   auth.Key{Type: auth.DelegatedKey, IssuedAt: now, Scope: auth.Scope{ThingID: thingID, Action: auth.SubscribeAction}},
+			token: "invalid",
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		_, _, err := svc.Issue(context.Background(), tc.token, tc.key)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestIdentifyDelegated(t *testing.T) {
+	_, loginSecret, err := newService().Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	svc := newDelegatingService(loginSecret)
+
+	scope := auth.Scope{ThingID: thingID, Action: auth.SubscribeAction}
+	_, delegSecret, err := svc.Issue(context.Background(), loginSecret, auth.Key{Type: auth.DelegatedKey, IssuedAt: time.Now(), Scope: scope})
+	require.Nil(t, err, fmt.Sprintf("Issuing delegated key expected to succeed: %s", err))
+
+	revoked, revokedSecret, err := svc.Issue(context.Background(), loginSecret, auth.Key{Type: auth.DelegatedKey, IssuedAt: time.Now(), Scope: scope})
+	require.Nil(t, err, fmt.Sprintf("Issuing delegated key expected to succeed: %s", err))
+	err = svc.Revoke(context.Background(), loginSecret, revoked.ID)
+	require.Nil(t, err, fmt.Sprintf("Revoking delegated key expected to succeed: %s", err))
+
+	_, apiSecret, err := svc.Issue(context.Background(), loginSecret, auth.Key{Type: auth.APIKey, IssuedAt: time.Now(), ExpiresAt: time.Now().Add(time.Minute)})
+	require.Nil(t, err, fmt.Sprintf("Issuing API key expected to succeed: %s", err))
+
+	_, err = svc.Identify(context.Background(), delegSecret)
+	assert.True(t, errors.Contains(err, errors.ErrAuthentication), fmt.Sprintf("identify delegated key expected %s got %s\n", errors.ErrAuthentication, err))
+
+	cases := []struct {
+		desc  string
+		token string
+		scope auth.Scope
+		err   error
+	}{
+		{
+			desc:  "identify delegated key",
+			token: delegSecret,
+			scope: scope,
+			err:   nil,
+		},
+		{
+			desc:  "identify revoked delegated key",
+			token: revokedSecret,
+			scope: auth.Scope{},
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "identify API key as delegated key",
+			token: apiSecret,
+			scope: auth.Scope{},
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "identify login key as delegated key",
+			token: loginSecret,
+			scope: auth.Scope{},
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "identify invalid delegated key",
+			token: invalid,
+			scope: auth.Scope{},
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		key, err := svc.IdentifyDelegated(context.Background(), tc.token)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.scope, key.Scope, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.scope, key.Scope))
+	}
+}
+
 func TestAuthorize(t *testing.T) {
 	svc := newService()
 
@@ -351,6 +497,115 @@ func TestAuthorize(t *testing.T) {
 	require.Nil(t, err, fmt.Sprintf("authorizing initial %v authz request expected to succeed: %s", pr, err))
 }
 
+func TestCheckAuthz(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	ars := []auth.AuthzReq{
+		{Subject: auth.RootSub},
+		{Subject: auth.OrgSub, Object: or.ID, Action: auth.Viewer},
+		{Subject: auth.OrgSub, Object: or.ID, Action: auth.Editor},
+		{Subject: auth.OrgSub, Object: or.ID, Action: auth.Owner},
+		{Subject: invalid},
+	}
+
+	cases := []struct {
+		desc    string
+		token   string
+		allowed []bool
+		err     error
+	}{
+		{
+			desc:    "check authz as org owner",
+			token:   ownerToken,
+			allowed: []bool{false, true, true, true, false},
+			err:     nil,
+		},
+		{
+			desc:    "check authz as org viewer",
+			token:   viewerToken,
+			allowed: []bool{false, true, false, false, false},
+			err:     nil,
+		},
+		{
+			desc:    "check authz with invalid credentials",
+			token:   invalid,
+			allowed: nil,
+			err:     errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		allowed, err := svc.CheckAuthz(context.Background(), tc.token, ars)
+		assert.Equal(t, tc.allowed, allowed, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.allowed, allowed))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+
+func TestCheckThingsAuthz(t *testing.T) {
+	_, loginSecret, err := newService().Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: id, Subject: email})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, otherSecret, err := newService().Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	svc := newDelegatingService(loginSecret)
+
+	cases := []struct {
+		desc    string
+		token   string
+		ars     []auth.AuthzReq
+		allowed []bool
+		err     error
+	}{
+		{
+			desc:  "check allowed group and thing authz",
+			token: loginSecret,
+			ars: []auth.AuthzReq{
+				{Subject: auth.GroupSub, Object: groupID, Action: auth.Viewer},
+				{Subject: auth.ThingSub, Object: thingID, Action: auth.Viewer},
+			},
+			allowed: []bool{true, true},
+			err:     nil,
+		},
+		{
+			desc:  "check partially denied group and thing authz",
+			token: loginSecret,
+			ars: []auth.AuthzReq{
+				{Subject: auth.RootSub},
+				{Subject: auth.GroupSub, Object: invalid, Action: auth.Viewer},
+				{Subject: auth.ThingSub, Object: thingID, Action: auth.Viewer},
+			},
+			allowed: []bool{false, false, true},
+			err:     nil,
+		},
+		{
+			desc:  "check group authz of user unknown to things",
+			token: otherSecret,
+			ars: []auth.AuthzReq{
+				{Subject: auth.GroupSub, Object: groupID, Action: auth.Viewer},
+			},
+			allowed: nil,
+			err:     errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		allowed, err := svc.CheckAuthz(context.Background(), tc.token, tc.ars)
+		assert.Equal(t, tc.allowed, allowed, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.allowed, allowed))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
 func TestCreateOrg(t *testing.T) {
 	svc := newService()
 
@@ -1180,6 +1435,159 @@ func TestListMembersByOrg(t *testing.T) {
 	}
 }
 
+func TestExpireMembers(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	now := time.Now()
+	notice := 72 * time.Hour
+	oms := []auth.OrgMember{
+		{Email: adminEmail, Role: auth.Admin, ExpiresAt: now.Add(30 * 24 * time.Hour)},
+		{Email: editorEmail, Role: auth.Editor, ExpiresAt: now.Add(-time.Hour)},
+		{Email: viewerEmail, Role: auth.Viewer, ExpiresAt: now.Add(time.Hour)},
+	}
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, oms...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc     string
+		now      time.Time
+		expiring []string
+		expired  []string
+	}{
+		{
+			desc:     "expire members",
+			now:      now,
+			expiring: []string{viewerEmail},
+			expired:  []string{editorEmail},
+		},
+		{
+			desc:     "expire members already notified",
+			now:      now,
+			expiring: []string{},
+			expired:  []string{},
+		},
+		{
+			desc:     "expire members after expiry",
+			now:      now.Add(2 * time.Hour),
+			expiring: []string{},
+			expired:  []string{viewerEmail},
+		},
+		{
+			desc:     "expire members within notice",
+			now:      now.Add(28 * 24 * time.Hour),
+			expiring: []string{adminEmail},
+			expired:  []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		me, err := svc.ExpireMembers(context.Background(), tc.now, notice)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.ElementsMatch(t, tc.expiring, memberEmails(me.Expiring), fmt.Sprintf("%s: expected expiring %v got %v\n", tc.desc, tc.expiring, me.Expiring))
+		assert.ElementsMatch(t, tc.expired, memberEmails(me.Expired), fmt.Sprintf("%s: expected expired %v got %v\n", tc.desc, tc.expired, me.Expired))
+	}
+
+	_, editorToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: editorID, Subject: editorEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, err = svc.ViewOrg(context.Background(), editorToken, or.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("viewing org by expired member: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestExpireMembersWithFailedNotification(t *testing.T) {
+	svc := newServiceWithEmailer(mocks.NewEmailer(viewerEmail))
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	now := time.Now()
+	notice := 72 * time.Hour
+	oms := []auth.OrgMember{
+		{Email: adminEmail, Role: auth.Admin, ExpiresAt: now.Add(time.Hour)},
+		{Email: viewerEmail, Role: auth.Viewer, ExpiresAt: now.Add(time.Hour)},
+	}
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, oms...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc     string
+		expiring []string
+	}{
+		{
+			desc:     "expire members with failed notification",
+			expiring: []string{adminEmail},
+		},
+		{
+			desc:     "expire members with notification failed again",
+			expiring: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		me, err := svc.ExpireMembers(context.Background(), now, notice)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.ElementsMatch(t, tc.expiring, memberEmails(me.Expiring), fmt.Sprintf("%s: expected expiring %v got %v\n", tc.desc, tc.expiring, me.Expiring))
+	}
+}
+
+func memberEmails(oms []auth.OrgMember) []string {
+	emails := []string{}
+	for _, om := range oms {
+		emails = append(emails, om.Email)
+	}
+
+	return emails
+}
+
+func TestFilterOrgMembers(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc      string
+		memberIDs []string
+		ids       []string
+	}{
+		{
+			desc:      "filter org members",
+			memberIDs: []string{ownerID, viewerID, editorID},
+			ids:       []string{ownerID, viewerID, editorID},
+		},
+		{
+			desc:      "filter org members with non-member",
+			memberIDs: []string{viewerID, invalid},
+			ids:       []string{viewerID},
+		},
+		{
+			desc:      "filter org members without members",
+			memberIDs: []string{},
+			ids:       []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		ids, err := svc.FilterOrgMembers(context.Background(), or.ID, tc.memberIDs...)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.ids, ids, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.ids, ids))
+	}
+}
+
 func TestBackup(t *testing.T) {
 	svc := newService()
 
@@ -1253,57 +1661,174 @@ func TestBackup(t *testing.T) {
 	}
 }
 
-func TestRestore(t *testing.T) {
+func TestListOrgAccess(t *testing.T) {
 	svc := newService()
 
-	_, superAdminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
 	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
 	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
 	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, superAdminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
 
-	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
-	require.Nil(t, err, fmt.Sprintf("saving role expected to succeed: %s", err))
-
-	var memberIDs []string
-	var groupIDs []string
-	for i := 0; i < n; i++ {
-		memberID, err := idProvider.ID()
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
-		memberIDs = append(memberIDs, memberID)
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
-		groupID, err := idProvider.ID()
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
-		groupIDs = append(groupIDs, groupID)
-	}
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
-	orgs := []auth.Org{{ID: id, OwnerID: ownerID, Name: name}}
-	var orgMembers []auth.OrgMember
-	for _, memberID := range memberIDs {
-		orgMembers = append(orgMembers, auth.OrgMember{MemberID: memberID, OrgID: id})
-	}
+	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
+	require.Nil(t, err, fmt.Sprintf("saving role expected to succeed: %s", err))
 
-	backup := auth.Backup{
-		Orgs:       orgs,
-		OrgMembers: orgMembers,
+	oas := []auth.OrgAccess{{OrgID: or.ID, OrgName: or.Name, MemberID: ownerID, Email: ownerEmail, Role: auth.Owner}}
+	for _, m := range members {
+		u := usersByEmails[m.Email]
+		oas = append(oas, auth.OrgAccess{OrgID: or.ID, OrgName: or.Name, MemberID: u.ID, Email: u.Email, Role: m.Role})
 	}
 
 	cases := []struct {
-		desc   string
-		token  string
-		backup auth.Backup
-		err    error
+		desc  string
+		token string
+		oas   []auth.OrgAccess
+		err   error
 	}{
 		{
-			desc:   "restore all orgs, org members and org groups",
-			token:  superAdminToken,
-			backup: backup,
-			err:    nil,
+			desc:  "list org access",
+			token: superAdminToken,
+			oas:   oas,
+			err:   nil,
 		},
 		{
-			desc:   "restore with invalid credentials",
-			token:  invalid,
-			backup: backup,
-			err:    errors.ErrAuthentication,
+			desc:  "list org access with invalid credentials",
+			token: invalid,
+			oas:   []auth.OrgAccess{},
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "list org access with unauthorised credentials",
+			token: viewerToken,
+			oas:   []auth.OrgAccess{},
+			err:   errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		oas, err := svc.ListOrgAccess(context.Background(), tc.token)
+		assert.ElementsMatch(t, tc.oas, oas, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.oas, oas))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestViewOverview(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, superAdminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	_, err = svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
+	require.Nil(t, err, fmt.Sprintf("saving role expected to succeed: %s", err))
+
+	ov := auth.Overview{
+		Orgs:        1,
+		Users:       uint64(len(usersByIDs)),
+		Groups:      n,
+		Throughput:  throughput,
+		Consumers:   consumerLags,
+		Services:    services,
+		Unavailable: []string{},
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		overview auth.Overview
+		err      error
+	}{
+		{
+			desc:     "view overview",
+			token:    superAdminToken,
+			overview: ov,
+			err:      nil,
+		},
+		{
+			desc:     "view overview with invalid credentials",
+			token:    invalid,
+			overview: auth.Overview{},
+			err:      errors.ErrAuthentication,
+		},
+		{
+			desc:     "view overview with unauthorised credentials",
+			token:    viewerToken,
+			overview: auth.Overview{},
+			err:      errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		overview, err := svc.ViewOverview(context.Background(), tc.token)
+		assert.Equal(t, tc.overview, overview, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.overview, overview))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRestore(t *testing.T) {
+	svc := newService()
+
+	_, superAdminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
+	require.Nil(t, err, fmt.Sprintf("saving role expected to succeed: %s", err))
+
+	var memberIDs []string
+	var groupIDs []string
+	for i := 0; i < n; i++ {
+		memberID, err := idProvider.ID()
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+		memberIDs = append(memberIDs, memberID)
+
+		groupID, err := idProvider.ID()
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	orgs := []auth.Org{{ID: id, OwnerID: ownerID, Name: name}}
+	var orgMembers []auth.OrgMember
+	for _, memberID := range memberIDs {
+		orgMembers = append(orgMembers, auth.OrgMember{MemberID: memberID, OrgID: id})
+	}
+
+	backup := auth.Backup{
+		Orgs:       orgs,
+		OrgMembers: orgMembers,
+	}
+
+	cases := []struct {
+		desc   string
+		token  string
+		backup auth.Backup
+		err    error
+	}{
+		{
+			desc:   "restore all orgs, org members and org groups",
+			token:  superAdminToken,
+			backup: backup,
+			err:    nil,
+		},
+		{
+			desc:   "restore with invalid credentials",
+			token:  invalid,
+			backup: backup,
+			err:    errors.ErrAuthentication,
 		},
 		{
 			desc:   "restore without credentials",
@@ -1324,3 +1849,595 @@ func TestRestore(t *testing.T) {
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
+
+func TestCreateSigningKey(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: adminID, Subject: adminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, editorToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: editorID, Subject: editorEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		err   error
+	}{
+		{
+			desc:  "create signing key as owner",
+			token: ownerToken,
+			orgID: or.ID,
+			err:   nil,
+		},
+		{
+			desc:  "replace signing key as admin",
+			token: adminToken,
+			orgID: or.ID,
+			err:   nil,
+		},
+		{
+			desc:  "create signing key as editor",
+			token: editorToken,
+			orgID: or.ID,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "create signing key with wrong credentials",
+			token: invalid,
+			orgID: or.ID,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "create signing key without credentials",
+			token: "",
+			orgID: or.ID,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		sk, err := svc.CreateSigningKey(context.Background(), tc.token, tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.Len(t, sk.PublicKey, ed25519.PublicKeySize, fmt.Sprintf("%s expected public key\n", tc.desc))
+			assert.Empty(t, sk.PrivateKey, fmt.Sprintf("%s expected no private key\n", tc.desc))
+		}
+	}
+}
+
+func TestViewSigningKey(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	otherOrg, err := svc.CreateOrg(context.Background(), ownerToken, auth.Org{Name: "other"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	sk, err := svc.CreateSigningKey(context.Background(), ownerToken, or.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		key   ed25519.PublicKey
+		err   error
+	}{
+		{
+			desc:  "view signing key as owner",
+			token: ownerToken,
+			orgID: or.ID,
+			key:   sk.PublicKey,
+			err:   nil,
+		},
+		{
+			desc:  "view signing key as viewer",
+			token: viewerToken,
+			orgID: or.ID,
+			key:   sk.PublicKey,
+			err:   nil,
+		},
+		{
+			desc:  "view signing key with wrong credentials",
+			token: invalid,
+			orgID: or.ID,
+			key:   nil,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "view signing key of org without key",
+			token: ownerToken,
+			orgID: otherOrg.ID,
+			key:   nil,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := svc.ViewSigningKey(context.Background(), tc.token, tc.orgID)
+		assert.Equal(t, tc.key, res.PublicKey, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.key, res.PublicKey))
+		assert.Empty(t, res.PrivateKey, fmt.Sprintf("%s expected no private key\n", tc.desc))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRemoveSigningKey(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	_, err = svc.CreateSigningKey(context.Background(), ownerToken, or.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		err   error
+	}{
+		{
+			desc:  "remove signing key as viewer",
+			token: viewerToken,
+			orgID: or.ID,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "remove signing key with wrong credentials",
+			token: invalid,
+			orgID: or.ID,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "remove signing key as owner",
+			token: ownerToken,
+			orgID: or.ID,
+			err:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveSigningKey(context.Background(), tc.token, tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	sig, err := svc.SignPayload(context.Background(), signingSecret, or.ID, []byte("payload"))
+	assert.Nil(t, err, fmt.Sprintf("sign payload after removing key: unexpected error: %s\n", err))
+	assert.Empty(t, sig, "sign payload after removing key: expected empty signature")
+}
+
+func TestListActivityByOrg(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: adminID, Subject: adminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, editorToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: editorID, Subject: editorEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	apiKey, apiToken, err := svc.Issue(context.Background(), ownerToken, auth.Key{Type: auth.APIKey, IssuedAt: time.Now(), ExpiresAt: time.Now().Add(time.Hour)})
+	assert.Nil(t, err, fmt.Sprintf("Issuing API key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	for i := 0; i < 2; i++ {
+		_, err := svc.Identify(context.Background(), apiToken)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	}
+
+	// The use of the revoked key is counted as the error.
+	err = svc.Revoke(context.Background(), ownerToken, apiKey.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	_, err = svc.Identify(context.Background(), apiToken)
+	require.NotNil(t, err, "expected error identifying revoked key")
+
+	_, err = svc.Identify(context.Background(), editorToken)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	// The denied root access isn't counted, unlike the denied org access.
+	err = svc.Authorize(context.Background(), auth.AuthzReq{Token: editorToken, Subject: auth.RootSub})
+	require.NotNil(t, err, "expected error authorizing root access")
+	err = svc.Authorize(context.Background(), auth.AuthzReq{Token: editorToken, Object: or.ID, Subject: auth.OrgSub, Action: auth.Admin})
+	require.NotNil(t, err, "expected error authorizing org access")
+
+	expected := []auth.KeyActivity{
+		{KeyID: apiKey.ID, KeyType: auth.APIKey, UserID: ownerID, Email: ownerEmail, Calls: 3, Errors: 1},
+		{KeyType: auth.LoginKey, UserID: editorID, Email: editorEmail, Calls: 2, Errors: 1},
+	}
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		keys  []auth.KeyActivity
+		err   error
+	}{
+		{
+			desc:  "list activity as owner",
+			token: ownerToken,
+			orgID: or.ID,
+			keys:  expected,
+			err:   nil,
+		},
+		{
+			desc:  "list activity as admin",
+			token: adminToken,
+			orgID: or.ID,
+			keys:  expected,
+			err:   nil,
+		},
+		{
+			desc:  "list activity as editor",
+			token: editorToken,
+			orgID: or.ID,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "list activity with wrong credentials",
+			token: invalid,
+			orgID: or.ID,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := svc.ListActivityByOrg(context.Background(), tc.token, tc.orgID, auth.PageMetadata{Limit: n})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+		for i := range page.Keys {
+			assert.False(t, page.Keys[i].LastUsed.IsZero(), fmt.Sprintf("%s expected last used time\n", tc.desc))
+			page.Keys[i].LastUsed = time.Time{}
+		}
+		assert.ElementsMatch(t, tc.keys, page.Keys, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.keys, page.Keys))
+	}
+}
+
+func TestUpdateQuotas(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc   string
+		token  string
+		orgID  string
+		limits map[string]uint64
+		err    error
+	}{
+		{
+			desc:   "update quotas as admin",
+			token:  adminToken,
+			orgID:  or.ID,
+			limits: map[string]uint64{auth.ThingsResource: 10, auth.MembersResource: 5},
+			err:    nil,
+		},
+		{
+			desc:   "update quotas as org owner",
+			token:  ownerToken,
+			orgID:  or.ID,
+			limits: map[string]uint64{auth.ThingsResource: 100},
+			err:    errors.ErrAuthorization,
+		},
+		{
+			desc:   "update quotas with unknown resource",
+			token:  adminToken,
+			orgID:  or.ID,
+			limits: map[string]uint64{"webhooks": 10},
+			err:    auth.ErrUnknownResource,
+		},
+		{
+			desc:   "update quotas of non-existing org",
+			token:  adminToken,
+			orgID:  invalid,
+			limits: map[string]uint64{auth.ThingsResource: 10},
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "update quotas with wrong credentials",
+			token:  invalid,
+			orgID:  or.ID,
+			limits: map[string]uint64{auth.ThingsResource: 10},
+			err:    errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateQuotas(context.Background(), tc.token, tc.orgID, tc.limits)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestViewQuotas(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, viewerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: viewerID, Subject: viewerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.UpdateQuotas(context.Background(), adminToken, or.ID, map[string]uint64{auth.MembersResource: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	// The owner is the org member as well.
+	expected := []auth.Quota{
+		{Resource: auth.ThingsResource},
+		{Resource: auth.ProfilesResource},
+		{Resource: auth.GroupsResource},
+		{Resource: auth.MembersResource, Limit: 10, Usage: uint64(len(members) + 1)},
+	}
+
+	cases := []struct {
+		desc   string
+		token  string
+		orgID  string
+		quotas []auth.Quota
+		err    error
+	}{
+		{
+			desc:   "view quotas as org owner",
+			token:  ownerToken,
+			orgID:  or.ID,
+			quotas: expected,
+			err:    nil,
+		},
+		{
+			desc:   "view quotas as org viewer",
+			token:  viewerToken,
+			orgID:  or.ID,
+			quotas: expected,
+			err:    nil,
+		},
+		{
+			desc:  "view quotas with wrong credentials",
+			token: invalid,
+			orgID: or.ID,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		qs, err := svc.ViewQuotas(context.Background(), tc.token, tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.Equal(t, tc.quotas, qs, fmt.Sprintf("%s expected %v got %v\n", tc.desc, tc.quotas, qs))
+		}
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: rootAdminID, Subject: superAdminEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	err = svc.AssignRole(context.Background(), rootAdminID, auth.RoleRootAdmin)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.UpdateQuotas(context.Background(), adminToken, or.ID, map[string]uint64{auth.ThingsResource: 10, auth.MembersResource: 2})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc     string
+		orgID    string
+		resource string
+		usage    uint64
+		count    uint64
+		err      error
+	}{
+		{
+			desc:     "check quota within limit",
+			orgID:    or.ID,
+			resource: auth.ThingsResource,
+			usage:    5,
+			count:    5,
+			err:      nil,
+		},
+		{
+			desc:     "check quota exceeding limit",
+			orgID:    or.ID,
+			resource: auth.ThingsResource,
+			usage:    5,
+			count:    6,
+			err:      errors.ErrQuotaExceeded,
+		},
+		{
+			desc:     "check quota of unlimited resource",
+			orgID:    or.ID,
+			resource: auth.ProfilesResource,
+			usage:    1000,
+			count:    1,
+			err:      nil,
+		},
+		{
+			desc:     "check quota of unknown resource",
+			orgID:    or.ID,
+			resource: "webhooks",
+			count:    1,
+			err:      auth.ErrUnknownResource,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.CheckQuota(context.Background(), tc.orgID, tc.resource, tc.usage, tc.count)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	// The owner and a single member fill the members quota.
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members[1:]...)
+	assert.True(t, errors.Contains(err, errors.ErrQuotaExceeded), fmt.Sprintf("assigning members over quota expected %s got %s\n", errors.ErrQuotaExceeded, err))
+
+	err = svc.AssignMembers(context.Background(), ownerToken, or.ID, members[0])
+	assert.Nil(t, err, fmt.Sprintf("assigning members within quota expected to succeed: %s", err))
+}
+
+func TestSignPayload(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	assert.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	or, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	otherOrg, err := svc.CreateOrg(context.Background(), ownerToken, auth.Org{Name: "other"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	sk, err := svc.CreateSigningKey(context.Background(), ownerToken, or.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	payload := []byte(`{"config_id":"id","version":1}`)
+
+	cases := []struct {
+		desc   string
+		secret string
+		orgID  string
+		signed bool
+		err    error
+	}{
+		{
+			desc:   "sign payload of org with signing key",
+			secret: signingSecret,
+			orgID:  or.ID,
+			signed: true,
+			err:    nil,
+		},
+		{
+			desc:   "sign payload of org without signing key",
+			secret: signingSecret,
+			orgID:  otherOrg.ID,
+			signed: false,
+			err:    nil,
+		},
+		{
+			desc:   "sign payload with invalid signing secret",
+			secret: invalid,
+			orgID:  or.ID,
+			signed: false,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "sign payload without signing secret",
+			secret: "",
+			orgID:  or.ID,
+			signed: false,
+			err:    errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		sig, err := svc.SignPayload(context.Background(), tc.secret, tc.orgID, payload)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.signed, ed25519.Verify(sk.PublicKey, payload, sig), fmt.Sprintf("%s: expected verified %t\n", tc.desc, tc.signed))
+
+		key, err := svc.RetrieveSigningKey(context.Background(), tc.orgID)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.orgID == or.ID, len(key) > 0, fmt.Sprintf("%s: expected signing key %t\n", tc.desc, tc.orgID == or.ID))
+	}
+}
+
+func TestRemoveUser(t *testing.T) {
+	svc := newService()
+
+	_, ownerToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: ownerID, Subject: ownerEmail})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+	_, adminToken, err := svc.Issue(context.Background(), "", auth.Key{Type: auth.LoginKey, IssuedAt: time.Now(), IssuerID: adminID, Subject: adminEmail})
+	require.Nil(t, err, fmt.Sprintf("Issuing login key expected to succeed: %s", err))
+
+	apiKey, _, err := svc.Issue(context.Background(), ownerToken, auth.Key{Type: auth.APIKey, IssuedAt: time.Now()})
+	require.Nil(t, err, fmt.Sprintf("Issuing API key expected to succeed: %s", err))
+
+	handedOver, err := svc.CreateOrg(context.Background(), ownerToken, org)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	err = svc.AssignMembers(context.Background(), ownerToken, handedOver.ID, members...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	removed, err := svc.CreateOrg(context.Background(), ownerToken, auth.Org{Name: "removed"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc    string
+		id      string
+		removed []string
+		err     error
+	}{
+		{
+			desc:    "remove user without id",
+			id:      "",
+			removed: nil,
+			err:     errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "remove org owner",
+			id:      ownerID,
+			removed: []string{removed.ID},
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		ids, err := svc.RemoveUser(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.removed, ids, fmt.Sprintf("%s: expected removed orgs %v got %v\n", tc.desc, tc.removed, ids))
+	}
+
+	or, err := svc.ViewOrg(context.Background(), adminToken, handedOver.ID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	assert.Equal(t, adminID, or.OwnerID, fmt.Sprintf("expected org owner %s got %s\n", adminID, or.OwnerID))
+
+	_, err = svc.RetrieveKey(context.Background(), ownerToken, apiKey.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("expected %s got %s\n", errors.ErrNotFound, err))
+}
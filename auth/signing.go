@@ -0,0 +1,135 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/subtle"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// SigningKey represents the Ed25519 key pair of the org, used to sign the
+// configs and the config notifications sent to the things, so that the
+// things can verify that the configs originate from the platform.
+type SigningKey struct {
+	OrgID      string
+	PublicKey  ed25519.PublicKey
+	PrivateKey ed25519.PrivateKey
+	CreatedAt  time.Time
+}
+
+// SigningKeyRepository specifies a signing key persistence API.
+type SigningKeyRepository interface {
+	// Save persists the signing key, replacing the existing key of the org.
+	Save(ctx context.Context, sk SigningKey) error
+
+	// RetrieveByOrg retrieves the signing key of the org.
+	RetrieveByOrg(ctx context.Context, orgID string) (SigningKey, error)
+
+	// Remove removes the signing key of the org.
+	Remove(ctx context.Context, orgID string) error
+}
+
+// SigningKeys specifies an API for managing the org signing keys. The
+// private key never leaves the service.
+type SigningKeys interface {
+	// CreateSigningKey generates the signing key of the org, replacing the
+	// existing one. Only the public key is returned.
+	CreateSigningKey(ctx context.Context, token, orgID string) (SigningKey, error)
+
+	// ViewSigningKey retrieves the public signing key of the org.
+	ViewSigningKey(ctx context.Context, token, orgID string) (SigningKey, error)
+
+	// RemoveSigningKey removes the signing key of the org, so that the
+	// payloads are no longer signed.
+	RemoveSigningKey(ctx context.Context, token, orgID string) error
+
+	// SignPayload signs the payload using the signing key of the org. The
+	// signature is empty if the org has no signing key. The payloads are
+	// signed only for the services which provide the signing secret.
+	SignPayload(ctx context.Context, secret, orgID string, payload []byte) ([]byte, error)
+
+	// RetrieveSigningKey retrieves the public signing key of the org, used
+	// by the services distributing the key to the things. The key is empty
+	// if the org has no signing key.
+	RetrieveSigningKey(ctx context.Context, orgID string) (ed25519.PublicKey, error)
+}
+
+func (svc service) CreateSigningKey(ctx context.Context, token, orgID string) (SigningKey, error) {
+	if err := svc.canAccessOrg(ctx, token, orgID, Admin); err != nil {
+		return SigningKey{}, err
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	sk := SigningKey{
+		OrgID:      orgID,
+		PublicKey:  pub,
+		PrivateKey: priv,
+		CreatedAt:  getTimestmap(),
+	}
+	if err := svc.signingKeys.Save(ctx, sk); err != nil {
+		return SigningKey{}, err
+	}
+	sk.PrivateKey = nil
+
+	return sk, nil
+}
+
+func (svc service) ViewSigningKey(ctx context.Context, token, orgID string) (SigningKey, error) {
+	if err := svc.canAccessOrg(ctx, token, orgID, Viewer); err != nil {
+		return SigningKey{}, err
+	}
+
+	sk, err := svc.signingKeys.RetrieveByOrg(ctx, orgID)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	sk.PrivateKey = nil
+
+	return sk, nil
+}
+
+func (svc service) RemoveSigningKey(ctx context.Context, token, orgID string) error {
+	if err := svc.canAccessOrg(ctx, token, orgID, Admin); err != nil {
+		return err
+	}
+
+	return svc.signingKeys.Remove(ctx, orgID)
+}
+
+func (svc service) SignPayload(ctx context.Context, secret, orgID string, payload []byte) ([]byte, error) {
+	if svc.signingSecret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(svc.signingSecret)) != 1 {
+		return nil, errors.ErrAuthentication
+	}
+
+	sk, err := svc.signingKeys.RetrieveByOrg(ctx, orgID)
+	if err != nil {
+		if errors.Contains(err, errors.ErrNotFound) {
+			return []byte{}, nil
+		}
+		return nil, err
+	}
+
+	return ed25519.Sign(sk.PrivateKey, payload), nil
+}
+
+func (svc service) RetrieveSigningKey(ctx context.Context, orgID string) (ed25519.PublicKey, error) {
+	sk, err := svc.signingKeys.RetrieveByOrg(ctx, orgID)
+	if err != nil {
+		if errors.Contains(err, errors.ErrNotFound) {
+			return ed25519.PublicKey{}, nil
+		}
+		return nil, err
+	}
+
+	return sk.PublicKey, nil
+}
@@ -9,13 +9,15 @@ import (
 	"context"
 
 	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	opentracing "github.com/opentracing/opentracing-go"
 )
 
 const (
-	saveOp     = "save"
-	retrieveOp = "retrieve_by_id"
-	revokeOp   = "remove"
+	saveOp      = "save"
+	retrieveOp  = "retrieve_by_id"
+	revokeOp    = "remove"
+	revokeAllOp = "remove_by_issuer"
 )
 
 var _ auth.KeyRepository = (*keyRepositoryMiddleware)(nil)
@@ -60,13 +62,22 @@ func (krm keyRepositoryMiddleware) Remove(ctx context.Context, owner, id string)
 	return krm.repo.Remove(ctx, owner, id)
 }
 
+func (krm keyRepositoryMiddleware) RemoveByIssuer(ctx context.Context, owner string) error {
+	span := createSpan(ctx, krm.tracer, revokeAllOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return krm.repo.RemoveByIssuer(ctx, owner)
+}
+
 func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
 	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
 		return tracer.StartSpan(
 			opName,
 			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
 		)
 	}
 
-	return tracer.StartSpan(opName)
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
 }
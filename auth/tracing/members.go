@@ -6,6 +6,7 @@ package tracing
 
 import (
 	"context"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	opentracing "github.com/opentracing/opentracing-go"
@@ -14,9 +15,13 @@ import (
 const (
 	assignMembers         = "assign_members"
 	unassignMembers       = "unassign_members"
+	removeByMember        = "remove_by_member"
 	updateMembers         = "update_members"
 	retrieveMembersByOrg  = "retrieve_members_by_org"
 	retrieveAllMembers    = "retrieve_all_members"
+	retrieveExpiring      = "retrieve_expiring_members"
+	updateNotified        = "update_notified_members"
+	removeExpired         = "remove_expired_members"
 )
 
 var _ auth.MembersRepository = (*membersRepositoryMiddleware)(nil)
@@ -50,6 +55,14 @@ func (orm membersRepositoryMiddleware) Remove(ctx context.Context, orgID string,
 	return orm.repo.Remove(ctx, orgID, memberIDs...)
 }
 
+func (orm membersRepositoryMiddleware) RemoveByMember(ctx context.Context, memberID string) error {
+	span := createSpan(ctx, orm.tracer, removeByMember)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return orm.repo.RemoveByMember(ctx, memberID)
+}
+
 func (orm membersRepositoryMiddleware) Update(ctx context.Context, oms ...auth.OrgMember) error {
 	span := createSpan(ctx, orm.tracer, updateMembers)
 	defer span.Finish()
@@ -81,3 +94,27 @@ func (orm membersRepositoryMiddleware) RetrieveAll(ctx context.Context) ([]auth.
 
 	return orm.repo.RetrieveAll(ctx)
 }
+
+func (orm membersRepositoryMiddleware) RetrieveExpiring(ctx context.Context, before time.Time) ([]auth.OrgMember, error) {
+	span := createSpan(ctx, orm.tracer, retrieveExpiring)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return orm.repo.RetrieveExpiring(ctx, before)
+}
+
+func (orm membersRepositoryMiddleware) UpdateNotified(ctx context.Context, oms ...auth.OrgMember) error {
+	span := createSpan(ctx, orm.tracer, updateNotified)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return orm.repo.UpdateNotified(ctx, oms...)
+}
+
+func (orm membersRepositoryMiddleware) RemoveExpired(ctx context.Context, now time.Time) ([]auth.OrgMember, error) {
+	span := createSpan(ctx, orm.tracer, removeExpired)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return orm.repo.RemoveExpired(ctx, now)
+}
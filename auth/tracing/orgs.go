@@ -15,6 +15,7 @@ const (
 	saveOrg                 = "save_org"
 	deleteOrg               = "delete_org"
 	updateOrg               = "update_org"
+	updateOrgOwner          = "update_org_owner"
 	retrieveByID            = "retrieve_by_id"
 	retrieveByOwner         = "retrieve_by_owner"
 	retrieveOrgsByMember    = "retrieve_orgs_by_member"
@@ -53,6 +54,14 @@ func (orm orgRepositoryMiddleware) Update(ctx context.Context, org auth.Org) err
 	return orm.repo.Update(ctx, org)
 }
 
+func (orm orgRepositoryMiddleware) UpdateOwner(ctx context.Context, org auth.Org) error {
+	span := createSpan(ctx, orm.tracer, updateOrgOwner)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return orm.repo.UpdateOwner(ctx, org)
+}
+
 func (orm orgRepositoryMiddleware) Remove(ctx context.Context, owner, orgID string) error {
 	span := createSpan(ctx, orm.tracer, deleteOrg)
 	defer span.Finish()
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveQuotas          = "save_quotas"
+	retrieveQuotasByOrg = "retrieve_quotas_by_org"
+)
+
+var _ auth.QuotaRepository = (*quotaRepositoryMiddleware)(nil)
+
+type quotaRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   auth.QuotaRepository
+}
+
+// QuotaRepositoryMiddleware tracks request and their latency, and adds spans to context.
+func QuotaRepositoryMiddleware(tracer opentracing.Tracer, qr auth.QuotaRepository) auth.QuotaRepository {
+	return quotaRepositoryMiddleware{
+		tracer: tracer,
+		repo:   qr,
+	}
+}
+
+func (qrm quotaRepositoryMiddleware) Save(ctx context.Context, orgID string, limits map[string]uint64) error {
+	span := createSpan(ctx, qrm.tracer, saveQuotas)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return qrm.repo.Save(ctx, orgID, limits)
+}
+
+func (qrm quotaRepositoryMiddleware) RetrieveByOrg(ctx context.Context, orgID string) (map[string]uint64, error) {
+	span := createSpan(ctx, qrm.tracer, retrieveQuotasByOrg)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return qrm.repo.RetrieveByOrg(ctx, orgID)
+}
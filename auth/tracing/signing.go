@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveSigningKey          = "save_signing_key"
+	retrieveSigningKeyByOrg = "retrieve_signing_key_by_org"
+	removeSigningKey        = "remove_signing_key"
+)
+
+var _ auth.SigningKeyRepository = (*signingKeyRepositoryMiddleware)(nil)
+
+type signingKeyRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   auth.SigningKeyRepository
+}
+
+// SigningKeyRepositoryMiddleware tracks request and their latency, and adds spans to context.
+func SigningKeyRepositoryMiddleware(tracer opentracing.Tracer, skr auth.SigningKeyRepository) auth.SigningKeyRepository {
+	return signingKeyRepositoryMiddleware{
+		tracer: tracer,
+		repo:   skr,
+	}
+}
+
+func (skrm signingKeyRepositoryMiddleware) Save(ctx context.Context, sk auth.SigningKey) error {
+	span := createSpan(ctx, skrm.tracer, saveSigningKey)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return skrm.repo.Save(ctx, sk)
+}
+
+func (skrm signingKeyRepositoryMiddleware) RetrieveByOrg(ctx context.Context, orgID string) (auth.SigningKey, error) {
+	span := createSpan(ctx, skrm.tracer, retrieveSigningKeyByOrg)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return skrm.repo.RetrieveByOrg(ctx, orgID)
+}
+
+func (skrm signingKeyRepositoryMiddleware) Remove(ctx context.Context, orgID string) error {
+	span := createSpan(ctx, skrm.tracer, removeSigningKey)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return skrm.repo.Remove(ctx, orgID)
+}
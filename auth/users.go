@@ -0,0 +1,117 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// membersPageLimit is the number of org members retrieved at once while
+// looking for the new org owner.
+const membersPageLimit = 100
+
+// Users specifies an API for the cleanup of the auth data of the deleted users.
+type Users interface {
+	// RemoveUser removes the org memberships, the role and the keys of the
+	// deleted user. The orgs owned by the user are handed over to their
+	// earliest admin, while the orgs without admins are removed. The IDs of
+	// the removed orgs are returned, even if the removal fails midway, so
+	// that the removal can be retried.
+	RemoveUser(ctx context.Context, id string) ([]string, error)
+}
+
+func (svc service) RemoveUser(ctx context.Context, id string) ([]string, error) {
+	if id == "" {
+		return nil, errors.ErrMalformedEntity
+	}
+
+	op, err := svc.orgs.RetrieveByOwner(ctx, id, PageMetadata{})
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+	for _, o := range op.Orgs {
+		adminID, err := svc.earliestAdmin(ctx, o.ID)
+		if err != nil {
+			return removed, err
+		}
+
+		if adminID == "" {
+			if err := svc.orgs.Remove(ctx, id, o.ID); err != nil {
+				return removed, err
+			}
+			removed = append(removed, o.ID)
+			continue
+		}
+
+		if err := svc.handOverOrg(ctx, o.ID, adminID); err != nil {
+			return removed, err
+		}
+	}
+
+	if err := svc.members.RemoveByMember(ctx, id); err != nil {
+		return removed, err
+	}
+
+	if err := svc.keys.RemoveByIssuer(ctx, id); err != nil {
+		return removed, err
+	}
+
+	if err := svc.roles.RemoveRole(ctx, id); err != nil {
+		return removed, err
+	}
+
+	return removed, nil
+}
+
+// earliestAdmin returns the ID of the admin of the org who became the member
+// first, or an empty string if the org has no admins.
+func (svc service) earliestAdmin(ctx context.Context, orgID string) (string, error) {
+	var admin OrgMember
+	for offset := uint64(0); ; offset += membersPageLimit {
+		mp, err := svc.members.RetrieveByOrgID(ctx, orgID, PageMetadata{Offset: offset, Limit: membersPageLimit})
+		if err != nil {
+			return "", err
+		}
+
+		for _, m := range mp.OrgMembers {
+			if m.Role != Admin {
+				continue
+			}
+			if admin.MemberID == "" || m.CreatedAt.Before(admin.CreatedAt) {
+				admin = m
+			}
+		}
+
+		if len(mp.OrgMembers) == 0 || offset+membersPageLimit >= mp.Total {
+			return admin.MemberID, nil
+		}
+	}
+}
+
+func (svc service) handOverOrg(ctx context.Context, orgID, ownerID string) error {
+	timestamp := getTimestmap()
+
+	org := Org{
+		ID:        orgID,
+		OwnerID:   ownerID,
+		UpdatedAt: timestamp,
+	}
+
+	if err := svc.orgs.UpdateOwner(ctx, org); err != nil {
+		return err
+	}
+
+	om := OrgMember{
+		OrgID:     orgID,
+		MemberID:  ownerID,
+		Role:      Owner,
+		UpdatedAt: timestamp,
+	}
+
+	return svc.members.Update(ctx, om)
+}
@@ -26,6 +26,7 @@ func issueCert(svc certs.Service) endpoint.Endpoint {
 			ThingID:    res.ThingID,
 			ClientCert: res.ClientCert,
 			ClientKey:  res.ClientKey,
+			IssuingCA:  res.IssuingCA,
 			Expiration: res.Expire,
 			created:    true,
 		}, nil
@@ -78,6 +79,7 @@ func viewCert(svc certs.Service) endpoint.Endpoint {
 			CertSerial: cert.Serial,
 			ThingID:    cert.ThingID,
 			ClientCert: cert.ClientCert,
+			IssuingCA:  cert.IssuingCA,
 			Expiration: cert.Expire,
 		}
 
@@ -85,6 +87,29 @@ func viewCert(svc certs.Service) endpoint.Endpoint {
 	}
 }
 
+func lookupCert(svc certs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(lookupReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cert, err := svc.LookupCert(ctx, req.token, req.serial)
+		if err != nil {
+			return nil, err
+		}
+
+		res := lookupCertRes{
+			CertSerial: cert.Serial,
+			ThingID:    cert.ThingID,
+			OwnerID:    cert.OwnerID,
+			Expiration: cert.Expire,
+		}
+
+		return res, nil
+	}
+}
+
 func revokeCert(svc certs.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(revokeReq)
@@ -94,3 +119,69 @@ func revokeCert(svc certs.Service) endpoint.Endpoint {
 		return svc.RevokeCert(ctx, req.token, req.certID)
 	}
 }
+
+func renewCert(svc certs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cert, err := svc.RenewCert(ctx, req.token, req.serialID)
+		if err != nil {
+			return nil, err
+		}
+
+		return certsRes{
+			CertSerial: cert.Serial,
+			ThingID:    cert.ThingID,
+			ClientCert: cert.ClientCert,
+			ClientKey:  cert.ClientKey,
+			IssuingCA:  cert.IssuingCA,
+			Expiration: cert.Expire,
+			created:    true,
+		}, nil
+	}
+}
+
+func updateExpiryConfig(svc certs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateExpiryConfigReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cfg := certs.ExpiryConfig{
+			OrgID:      req.orgID,
+			Thresholds: req.Thresholds,
+			SmtpID:     req.SmtpID,
+			SmppID:     req.SmppID,
+		}
+		if err := svc.UpdateExpiryConfig(ctx, req.token, cfg); err != nil {
+			return nil, err
+		}
+
+		return updateExpiryConfigRes{}, nil
+	}
+}
+
+func viewExpiryConfig(svc certs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewExpiryConfigReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cfg, err := svc.ViewExpiryConfig(ctx, req.token, req.orgID)
+		if err != nil {
+			return nil, err
+		}
+
+		return expiryConfigRes{
+			OrgID:      cfg.OrgID,
+			Thresholds: cfg.Thresholds,
+			SmtpID:     cfg.SmtpID,
+			SmppID:     cfg.SmppID,
+		}, nil
+	}
+}
@@ -30,10 +30,10 @@ func (lm *loggingMiddleware) IssueCert(ctx context.Context, token, thingID, ttl
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method issue_cert for thing: %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.IssueCert(ctx, token, thingID, ttl, keyBits, keyType)
@@ -43,10 +43,10 @@ func (lm *loggingMiddleware) ListCerts(ctx context.Context, token, thingID strin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_certs for thing id: %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListCerts(ctx, token, thingID, offset, limit)
@@ -56,10 +56,10 @@ func (lm *loggingMiddleware) ListSerials(ctx context.Context, token, thingID str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_serials for thing id: %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListSerials(ctx, token, thingID, offset, limit)
@@ -69,24 +69,115 @@ func (lm *loggingMiddleware) ViewCert(ctx context.Context, token, serialID strin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_cert for serial id %s took %s to complete", serialID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewCert(ctx, token, serialID)
 }
 
+func (lm *loggingMiddleware) LookupCert(ctx context.Context, token, serialID string) (c certs.Cert, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method lookup_cert for serial id %s took %s to complete", serialID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.LookupCert(ctx, token, serialID)
+}
+
 func (lm *loggingMiddleware) RevokeCert(ctx context.Context, token, thingID string) (c certs.Revoke, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method revoke_cert for thing: %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RevokeCert(ctx, token, thingID)
 }
+
+func (lm *loggingMiddleware) RenewCert(ctx context.Context, token, serialID string) (c certs.Cert, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method renew_cert for cert: %s took %s to complete", serialID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RenewCert(ctx, token, serialID)
+}
+
+func (lm *loggingMiddleware) RevokeCertsByOrg(ctx context.Context, orgID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method revoke_certs_by_org for org: %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RevokeCertsByOrg(ctx, orgID)
+}
+
+func (lm *loggingMiddleware) RevokeCertsByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method revoke_certs_by_group for group: %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RevokeCertsByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) UpdateExpiryConfig(ctx context.Context, token string, cfg certs.ExpiryConfig) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_expiry_config for org: %s took %s to complete", cfg.OrgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateExpiryConfig(ctx, token, cfg)
+}
+
+func (lm *loggingMiddleware) ViewExpiryConfig(ctx context.Context, token, orgID string) (cfg certs.ExpiryConfig, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_expiry_config for org: %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewExpiryConfig(ctx, token, orgID)
+}
+
+func (lm *loggingMiddleware) NotifyExpiring(ctx context.Context, now time.Time) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method notify_expiring for time %s took %s to complete", now, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.NotifyExpiring(ctx, now)
+}
@@ -66,6 +66,15 @@ func (ms *metricsMiddleware) ViewCert(ctx context.Context, token, serialID strin
 	return ms.svc.ViewCert(ctx, token, serialID)
 }
 
+func (ms *metricsMiddleware) LookupCert(ctx context.Context, token, serialID string) (certs.Cert, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "lookup_cert").Add(1)
+		ms.latency.With("method", "lookup_cert").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.LookupCert(ctx, token, serialID)
+}
+
 func (ms *metricsMiddleware) RevokeCert(ctx context.Context, token, thingID string) (certs.Revoke, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "revoke_cert").Add(1)
@@ -74,3 +83,57 @@ func (ms *metricsMiddleware) RevokeCert(ctx context.Context, token, thingID stri
 
 	return ms.svc.RevokeCert(ctx, token, thingID)
 }
+
+func (ms *metricsMiddleware) RenewCert(ctx context.Context, token, serialID string) (certs.Cert, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "renew_cert").Add(1)
+		ms.latency.With("method", "renew_cert").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RenewCert(ctx, token, serialID)
+}
+
+func (ms *metricsMiddleware) RevokeCertsByOrg(ctx context.Context, orgID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "revoke_certs_by_org").Add(1)
+		ms.latency.With("method", "revoke_certs_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RevokeCertsByOrg(ctx, orgID)
+}
+
+func (ms *metricsMiddleware) RevokeCertsByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "revoke_certs_by_group").Add(1)
+		ms.latency.With("method", "revoke_certs_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RevokeCertsByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) UpdateExpiryConfig(ctx context.Context, token string, cfg certs.ExpiryConfig) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_expiry_config").Add(1)
+		ms.latency.With("method", "update_expiry_config").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateExpiryConfig(ctx, token, cfg)
+}
+
+func (ms *metricsMiddleware) ViewExpiryConfig(ctx context.Context, token, orgID string) (certs.ExpiryConfig, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_expiry_config").Add(1)
+		ms.latency.With("method", "view_expiry_config").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewExpiryConfig(ctx, token, orgID)
+}
+
+func (ms *metricsMiddleware) NotifyExpiring(ctx context.Context, now time.Time) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "notify_expiring").Add(1)
+		ms.latency.With("method", "notify_expiring").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.NotifyExpiring(ctx, now)
+}
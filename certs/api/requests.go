@@ -5,7 +5,10 @@ package api
 
 import "github.com/MainfluxLabs/mainflux/pkg/apiutil"
 
-const maxLimitSize = 100
+const (
+	maxLimitSize     = 100
+	maxThresholdDays = 365
+)
 
 type addCertsReq struct {
 	token   string
@@ -64,6 +67,23 @@ func (req *viewReq) validate() error {
 	return nil
 }
 
+type lookupReq struct {
+	token  string
+	serial string
+}
+
+func (req lookupReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.serial == "" {
+		return apiutil.ErrMissingSerial
+	}
+
+	return nil
+}
+
 type revokeReq struct {
 	token  string
 	certID string
@@ -80,3 +100,50 @@ func (req *revokeReq) validate() error {
 
 	return nil
 }
+
+type updateExpiryConfigReq struct {
+	token      string
+	orgID      string
+	Thresholds []uint `json:"thresholds"`
+	SmtpID     string `json:"smtp_id"`
+	SmppID     string `json:"smpp_id"`
+}
+
+func (req updateExpiryConfigReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	if len(req.Thresholds) == 0 {
+		return apiutil.ErrInvalidThreshold
+	}
+
+	for _, t := range req.Thresholds {
+		if t == 0 || t > maxThresholdDays {
+			return apiutil.ErrInvalidThreshold
+		}
+	}
+
+	return nil
+}
+
+type viewExpiryConfigReq struct {
+	token string
+	orgID string
+}
+
+func (req viewExpiryConfigReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ type certsRes struct {
 	ClientCert string    `json:"client_cert"`
 	ClientKey  string    `json:"client_key"`
 	CertSerial string    `json:"cert_serial"`
+	IssuingCA  string    `json:"issuing_ca,omitempty"`
 	Expiration time.Time `json:"expiration"`
 	created    bool
 }
@@ -55,3 +56,55 @@ func (res certsRes) Headers() map[string]string {
 func (res certsRes) Empty() bool {
 	return false
 }
+
+type lookupCertRes struct {
+	ThingID    string    `json:"thing_id"`
+	OwnerID    string    `json:"owner_id"`
+	CertSerial string    `json:"cert_serial"`
+	Expiration time.Time `json:"expiration"`
+}
+
+func (res lookupCertRes) Code() int {
+	return http.StatusOK
+}
+
+func (res lookupCertRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res lookupCertRes) Empty() bool {
+	return false
+}
+
+type expiryConfigRes struct {
+	OrgID      string `json:"org_id"`
+	Thresholds []uint `json:"thresholds"`
+	SmtpID     string `json:"smtp_id,omitempty"`
+	SmppID     string `json:"smpp_id,omitempty"`
+}
+
+func (res expiryConfigRes) Code() int {
+	return http.StatusOK
+}
+
+func (res expiryConfigRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res expiryConfigRes) Empty() bool {
+	return false
+}
+
+type updateExpiryConfigRes struct{}
+
+func (res updateExpiryConfigRes) Code() int {
+	return http.StatusOK
+}
+
+func (res updateExpiryConfigRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res updateExpiryConfigRes) Empty() bool {
+	return true
+}
@@ -22,6 +22,7 @@ const (
 	contentType = "application/json"
 	offsetKey   = "offset"
 	limitKey    = "limit"
+	serialKey   = "serial"
 	defOffset   = 0
 	defLimit    = 10
 )
@@ -41,6 +42,13 @@ func MakeHandler(svc certs.Service, logger logger.Logger) http.Handler {
 		opts...,
 	))
 
+	r.Get("/certs/lookup", kithttp.NewServer(
+		lookupCert(svc),
+		decodeLookupCert,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Get("/certs/:certId", kithttp.NewServer(
 		viewCert(svc),
 		decodeViewCert,
@@ -55,6 +63,13 @@ func MakeHandler(svc certs.Service, logger logger.Logger) http.Handler {
 		opts...,
 	))
 
+	r.Post("/certs/:certId/renew", kithttp.NewServer(
+		renewCert(svc),
+		decodeViewCert,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Get("/serials/:thingId", kithttp.NewServer(
 		listSerials(svc),
 		decodeListCerts,
@@ -62,6 +77,20 @@ func MakeHandler(svc certs.Service, logger logger.Logger) http.Handler {
 		opts...,
 	))
 
+	r.Put("/orgs/:orgId/certs/expiry", kithttp.NewServer(
+		updateExpiryConfig(svc),
+		decodeUpdateExpiryConfig,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/orgs/:orgId/certs/expiry", kithttp.NewServer(
+		viewExpiryConfig(svc),
+		decodeViewExpiryConfig,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Handle("/metrics", promhttp.Handler())
 	r.GetFunc("/health", mainflux.Health("certs"))
 
@@ -114,6 +143,20 @@ func decodeViewCert(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeLookupCert(_ context.Context, r *http.Request) (interface{}, error) {
+	s, err := apiutil.ReadStringQuery(r, serialKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := lookupReq{
+		token:  apiutil.ExtractBearerToken(r),
+		serial: s,
+	}
+
+	return req, nil
+}
+
 func decodeCerts(_ context.Context, r *http.Request) (interface{}, error) {
 	if r.Header.Get("Content-Type") != contentType {
 		return nil, apiutil.ErrUnsupportedContentType
@@ -136,18 +179,51 @@ func decodeRevokeCerts(_ context.Context, r *http.Request) (interface{}, error)
 	return req, nil
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func decodeUpdateExpiryConfig(_ context.Context, r *http.Request) (interface{}, error) {
+	if r.Header.Get("Content-Type") != contentType {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateExpiryConfigReq{
+		token: apiutil.ExtractBearerToken(r),
+		orgID: bone.GetValue(r, "orgId"),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeViewExpiryConfig(_ context.Context, r *http.Request) (interface{}, error) {
+	req := viewExpiryConfigReq{
+		token: apiutil.ExtractBearerToken(r),
+		orgID: bone.GetValue(r, "orgId"),
+	}
+
+	return req, nil
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, errors.ErrAuthentication),
 		err == apiutil.ErrBearerToken:
 		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, errors.ErrAuthorization):
+		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
 		w.WriteHeader(http.StatusUnsupportedMediaType)
 	case errors.Contains(err, apiutil.ErrMalformedEntity),
 		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingOrgID,
 		err == apiutil.ErrMissingCertData,
+		err == apiutil.ErrInvalidThreshold,
+		err == apiutil.ErrMissingSerial,
+		errors.Contains(err, apiutil.ErrInvalidQueryParams),
 		err == apiutil.ErrLimitSize:
 		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
 	case errors.Contains(err, errors.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
 
@@ -162,7 +238,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
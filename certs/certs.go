@@ -3,7 +3,10 @@
 
 package certs
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ConfigsPage contains page related metadata as well as list
 type Page struct {
@@ -29,4 +32,19 @@ type Repository interface {
 
 	// RetrieveBySerial retrieves a certificate for a given serial ID
 	RetrieveBySerial(ctx context.Context, ownerID, serialID string) (Cert, error)
+
+	// RetrieveBySerialID retrieves a certificate for a given serial ID regardless of its owner
+	RetrieveBySerialID(ctx context.Context, serialID string) (Cert, error)
+
+	// RetrieveExpiring retrieves the certificates expiring in the provided time range
+	RetrieveExpiring(ctx context.Context, from, to time.Time) ([]Cert, error)
+
+	// RetrieveByOrg retrieves the certificates issued for the things of the org
+	RetrieveByOrg(ctx context.Context, orgID string) ([]Cert, error)
+
+	// RetrieveByGroup retrieves the certificates issued for the things of the group
+	RetrieveByGroup(ctx context.Context, groupID string) ([]Cert, error)
+
+	// UpdateNotified updates the last expiry threshold the certificate was notified about
+	UpdateNotified(ctx context.Context, serialID string, threshold uint) error
 }
@@ -0,0 +1,215 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package certs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+const (
+	protocol = "certs"
+	day      = 24 * time.Hour
+)
+
+// ErrNotifyExpiring indicates failure to notify about the expiring certificates.
+var ErrNotifyExpiring = errors.New("failed to notify about expiring certificates")
+
+// ExpiryConfig contains the thresholds, in days before the expiration, at
+// which the org is notified about its expiring certificates, and the
+// notifiers the notifications are sent with.
+type ExpiryConfig struct {
+	OrgID      string
+	Thresholds []uint
+	SmtpID     string
+	SmppID     string
+}
+
+// ExpiryConfigRepository specifies an expiry config persistence API.
+type ExpiryConfigRepository interface {
+	// Save saves the expiry config of the org, replacing the existing one.
+	Save(ctx context.Context, cfg ExpiryConfig) error
+
+	// RetrieveByOrg retrieves the expiry config of the org identified by the provided ID.
+	RetrieveByOrg(ctx context.Context, orgID string) (ExpiryConfig, error)
+
+	// RetrieveAll retrieves the expiry configs of all orgs.
+	RetrieveAll(ctx context.Context) ([]ExpiryConfig, error)
+
+	// Remove removes the expiry config of the org identified by the provided ID.
+	Remove(ctx context.Context, orgID string) error
+}
+
+// Expiry represents the event published when the certificate crosses one of
+// the expiry thresholds of its org.
+type Expiry struct {
+	ThingID  string    `json:"thing_id"`
+	OrgID    string    `json:"org_id,omitempty"`
+	Serial   string    `json:"serial"`
+	Expire   time.Time `json:"expire"`
+	DaysLeft uint      `json:"days_left"`
+}
+
+func (cs *certsService) UpdateExpiryConfig(ctx context.Context, token string, cfg ExpiryConfig) error {
+	if _, err := cs.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: cfg.OrgID, Subject: auth.OrgSub, Action: auth.Admin}); err != nil {
+		return err
+	}
+
+	sort.Sort(sort.Reverse(uintSlice(cfg.Thresholds)))
+
+	return cs.expiryRepo.Save(ctx, cfg)
+}
+
+func (cs *certsService) ViewExpiryConfig(ctx context.Context, token, orgID string) (ExpiryConfig, error) {
+	if _, err := cs.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: orgID, Subject: auth.OrgSub, Action: auth.Viewer}); err != nil {
+		return ExpiryConfig{}, err
+	}
+
+	cfg, err := cs.expiryRepo.RetrieveByOrg(ctx, orgID)
+	switch {
+	case errors.Contains(err, errors.ErrNotFound):
+		return ExpiryConfig{OrgID: orgID, Thresholds: cs.conf.ExpiryThresholds}, nil
+	case err != nil:
+		return ExpiryConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+func (cs *certsService) NotifyExpiring(ctx context.Context, now time.Time) error {
+	cfgs, err := cs.expiryRepo.RetrieveAll(ctx)
+	if err != nil {
+		return errors.Wrap(ErrNotifyExpiring, err)
+	}
+
+	maxDays := maxThreshold(cs.conf.ExpiryThresholds)
+	orgCfgs := make(map[string]ExpiryConfig)
+	for _, cfg := range cfgs {
+		orgCfgs[cfg.OrgID] = cfg
+		if m := maxThreshold(cfg.Thresholds); m > maxDays {
+			maxDays = m
+		}
+	}
+
+	if maxDays == 0 {
+		return nil
+	}
+
+	crts, err := cs.certsRepo.RetrieveExpiring(ctx, now, now.Add(time.Duration(maxDays)*day))
+	if err != nil {
+		return errors.Wrap(ErrNotifyExpiring, err)
+	}
+
+	var errs error
+	for _, c := range crts {
+		cfg, ok := orgCfgs[c.OrgID]
+		if !ok {
+			cfg = ExpiryConfig{OrgID: c.OrgID, Thresholds: cs.conf.ExpiryThresholds}
+		}
+
+		threshold := crossedThreshold(cfg.Thresholds, c.Expire.Sub(now))
+		if threshold == 0 || (c.Notified != 0 && c.Notified <= threshold) {
+			continue
+		}
+
+		if err := cs.notifyExpiry(c, cfg, now); err != nil {
+			errs = errors.Wrap(ErrNotifyExpiring, err)
+			continue
+		}
+
+		if err := cs.certsRepo.UpdateNotified(ctx, c.Serial, threshold); err != nil {
+			errs = errors.Wrap(ErrNotifyExpiring, err)
+		}
+	}
+
+	return errs
+}
+
+// notifyExpiry publishes the expiry event to the alarms subject, and sends
+// the notification to the notifiers of the org.
+func (cs *certsService) notifyExpiry(c Cert, cfg ExpiryConfig, now time.Time) error {
+	exp := Expiry{
+		ThingID:  c.ThingID,
+		OrgID:    c.OrgID,
+		Serial:   c.Serial,
+		Expire:   c.Expire,
+		DaysLeft: uint(c.Expire.Sub(now) / day),
+	}
+
+	payload, err := json.Marshal(exp)
+	if err != nil {
+		return err
+	}
+
+	event := protomfx.Message{
+		Publisher: c.ThingID,
+		Protocol:  messaging.AlarmProtocol,
+		Payload:   payload,
+		Created:   now.UnixNano(),
+		OrgID:     c.OrgID,
+		ProfileConfig: &protomfx.Config{
+			ContentType: messaging.JSONContentType,
+		},
+	}
+	if err := cs.publisher.Publish(event); err != nil {
+		return err
+	}
+
+	if cfg.SmtpID == "" && cfg.SmppID == "" {
+		return nil
+	}
+
+	text := fmt.Sprintf("Certificate %s of thing %s expires on %s", c.Serial, c.ThingID, c.Expire.UTC().Format(time.RFC3339))
+	msg := protomfx.Message{
+		Publisher: c.ThingID,
+		Protocol:  protocol,
+		Payload:   []byte(text),
+		Created:   now.UnixNano(),
+		OrgID:     c.OrgID,
+		ProfileConfig: &protomfx.Config{
+			SmtpID: cfg.SmtpID,
+			SmppID: cfg.SmppID,
+		},
+	}
+
+	return cs.publisher.Publish(msg)
+}
+
+// crossedThreshold returns the smallest threshold, in days, which is not
+// shorter than the time left until the expiration, or zero if there's none.
+func crossedThreshold(thresholds []uint, left time.Duration) uint {
+	var crossed uint
+	for _, t := range thresholds {
+		if left <= time.Duration(t)*day && (crossed == 0 || t < crossed) {
+			crossed = t
+		}
+	}
+
+	return crossed
+}
+
+func maxThreshold(thresholds []uint) uint {
+	var m uint
+	for _, t := range thresholds {
+		if t > m {
+			m = t
+		}
+	}
+
+	return m
+}
+
+type uintSlice []uint
+
+func (s uintSlice) Len() int           { return len(s) }
+func (s uintSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s uintSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
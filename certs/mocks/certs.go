@@ -6,6 +6,7 @@ package mocks
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/certs"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -35,6 +36,8 @@ func (c *certsRepoMock) Save(ctx context.Context, cert certs.Cert) (string, erro
 	crt := certs.Cert{
 		OwnerID: cert.OwnerID,
 		ThingID: cert.ThingID,
+		OrgID:   cert.OrgID,
+		GroupID: cert.GroupID,
 		Serial:  cert.Serial,
 		Expire:  cert.Expire,
 	}
@@ -135,3 +138,72 @@ func (c *certsRepoMock) RetrieveBySerial(ctx context.Context, ownerID, serialID
 
 	return crt, nil
 }
+
+func (c *certsRepoMock) RetrieveExpiring(ctx context.Context, from, to time.Time) ([]certs.Cert, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var crts []certs.Cert
+	for _, crt := range c.certsBySerial {
+		if crt.Expire.After(from) && !crt.Expire.After(to) {
+			crts = append(crts, crt)
+		}
+	}
+
+	return crts, nil
+}
+
+func (c *certsRepoMock) RetrieveByOrg(ctx context.Context, orgID string) ([]certs.Cert, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var crts []certs.Cert
+	for _, crt := range c.certsBySerial {
+		if crt.OrgID == orgID {
+			crts = append(crts, crt)
+		}
+	}
+
+	return crts, nil
+}
+
+func (c *certsRepoMock) RetrieveByGroup(ctx context.Context, groupID string) ([]certs.Cert, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var crts []certs.Cert
+	for _, crt := range c.certsBySerial {
+		if crt.GroupID == groupID {
+			crts = append(crts, crt)
+		}
+	}
+
+	return crts, nil
+}
+
+func (c *certsRepoMock) RetrieveBySerialID(ctx context.Context, serialID string) (certs.Cert, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	crt, ok := c.certsBySerial[serialID]
+	if !ok {
+		return certs.Cert{}, errors.ErrNotFound
+	}
+
+	return crt, nil
+}
+
+func (c *certsRepoMock) UpdateNotified(ctx context.Context, serialID string, threshold uint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	crt, ok := c.certsBySerial[serialID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	crt.Notified = threshold
+	c.certsBySerial[serialID] = crt
+
+	return nil
+}
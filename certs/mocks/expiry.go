@@ -0,0 +1,68 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/certs"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ certs.ExpiryConfigRepository = (*expiryConfigRepoMock)(nil)
+
+type expiryConfigRepoMock struct {
+	mu      sync.Mutex
+	configs map[string]certs.ExpiryConfig
+}
+
+// NewExpiryConfigRepository creates in-memory expiry config repository.
+func NewExpiryConfigRepository() certs.ExpiryConfigRepository {
+	return &expiryConfigRepoMock{
+		configs: make(map[string]certs.ExpiryConfig),
+	}
+}
+
+func (er *expiryConfigRepoMock) Save(_ context.Context, cfg certs.ExpiryConfig) error {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	er.configs[cfg.OrgID] = cfg
+
+	return nil
+}
+
+func (er *expiryConfigRepoMock) RetrieveByOrg(_ context.Context, orgID string) (certs.ExpiryConfig, error) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	cfg, ok := er.configs[orgID]
+	if !ok {
+		return certs.ExpiryConfig{}, errors.ErrNotFound
+	}
+
+	return cfg, nil
+}
+
+func (er *expiryConfigRepoMock) RetrieveAll(_ context.Context) ([]certs.ExpiryConfig, error) {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	var cfgs []certs.ExpiryConfig
+	for _, cfg := range er.configs {
+		cfgs = append(cfgs, cfg)
+	}
+
+	return cfgs, nil
+}
+
+func (er *expiryConfigRepoMock) Remove(_ context.Context, orgID string) error {
+	er.mu.Lock()
+	defer er.mu.Unlock()
+
+	delete(er.configs, orgID)
+
+	return nil
+}
@@ -40,7 +40,7 @@ func NewRepository(db *sqlx.DB, log logger.Logger) certs.Repository {
 
 func (cr certsRepository) RetrieveAll(ctx context.Context, ownerID string, offset, limit uint64) (certs.Page, error) {
 	q := `SELECT thing_id, owner_id, serial, expire FROM certs WHERE owner_id = $1 ORDER BY expire LIMIT $2 OFFSET $3;`
-	rows, err := cr.db.Query(q, ownerID, limit, offset)
+	rows, err := cr.db.QueryContext(ctx, q, ownerID, limit, offset)
 	if err != nil {
 		cr.log.Error(fmt.Sprintf("Failed to retrieve configs due to %s", err))
 		return certs.Page{}, err
@@ -60,7 +60,7 @@ func (cr certsRepository) RetrieveAll(ctx context.Context, ownerID string, offse
 
 	q = `SELECT COUNT(*) FROM certs WHERE owner_id = $1`
 	var total uint64
-	if err := cr.db.QueryRow(q, ownerID).Scan(&total); err != nil {
+	if err := cr.db.QueryRowContext(ctx, q, ownerID).Scan(&total); err != nil {
 		cr.log.Error(fmt.Sprintf("Failed to count certs due to %s", err))
 		return certs.Page{}, err
 	}
@@ -74,16 +74,16 @@ func (cr certsRepository) RetrieveAll(ctx context.Context, ownerID string, offse
 }
 
 func (cr certsRepository) Save(ctx context.Context, cert certs.Cert) (string, error) {
-	q := `INSERT INTO certs (thing_id, owner_id, serial, expire) VALUES (:thing_id, :owner_id, :serial, :expire)`
+	q := `INSERT INTO certs (thing_id, owner_id, org_id, group_id, serial, expire) VALUES (:thing_id, :owner_id, :org_id, :group_id, :serial, :expire)`
 
-	tx, err := cr.db.Beginx()
+	tx, err := cr.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return "", errors.Wrap(errors.ErrCreateEntity, err)
 	}
 
 	dbcrt := toDBCert(cert)
 
-	if _, err := tx.NamedExec(q, dbcrt); err != nil {
+	if _, err := tx.NamedExecContext(ctx, q, dbcrt); err != nil {
 		e := err
 		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UniqueViolation {
 			e = errors.New("error conflict")
@@ -117,7 +117,7 @@ func (cr certsRepository) Remove(ctx context.Context, ownerID, serial string) er
 
 func (cr certsRepository) RetrieveByThing(ctx context.Context, ownerID, thingID string, offset, limit uint64) (certs.Page, error) {
 	q := `SELECT thing_id, owner_id, serial, expire FROM certs WHERE owner_id = $1 AND thing_id = $2 ORDER BY expire LIMIT $3 OFFSET $4;`
-	rows, err := cr.db.Query(q, ownerID, thingID, limit, offset)
+	rows, err := cr.db.QueryContext(ctx, q, ownerID, thingID, limit, offset)
 	if err != nil {
 		cr.log.Error(fmt.Sprintf("Failed to retrieve configs due to %s", err))
 		return certs.Page{}, err
@@ -137,7 +137,7 @@ func (cr certsRepository) RetrieveByThing(ctx context.Context, ownerID, thingID
 
 	q = `SELECT COUNT(*) FROM certs WHERE owner_id = $1 AND thing_id = $2`
 	var total uint64
-	if err := cr.db.QueryRow(q, ownerID, thingID).Scan(&total); err != nil {
+	if err := cr.db.QueryRowContext(ctx, q, ownerID, thingID).Scan(&total); err != nil {
 		cr.log.Error(fmt.Sprintf("Failed to count certs due to %s", err))
 		return certs.Page{}, err
 	}
@@ -150,6 +150,22 @@ func (cr certsRepository) RetrieveByThing(ctx context.Context, ownerID, thingID
 	}, nil
 }
 
+func (cr certsRepository) RetrieveBySerialID(ctx context.Context, serialID string) (certs.Cert, error) {
+	q := `SELECT thing_id, owner_id, serial, expire FROM certs WHERE serial = $1`
+	var dbcrt dbCert
+
+	if err := cr.db.QueryRowxContext(ctx, q, serialID).StructScan(&dbcrt); err != nil {
+		pqErr, ok := err.(*pgconn.PgError)
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pqErr.Code {
+			return certs.Cert{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+
+		return certs.Cert{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toCert(dbcrt), nil
+}
+
 func (cr certsRepository) RetrieveBySerial(ctx context.Context, ownerID, serialID string) (certs.Cert, error) {
 	q := `SELECT thing_id, owner_id, serial, expire FROM certs WHERE owner_id = $1 AND serial = $2`
 	var dbcrt dbCert
@@ -169,6 +185,75 @@ func (cr certsRepository) RetrieveBySerial(ctx context.Context, ownerID, serialI
 	return c, nil
 }
 
+func (cr certsRepository) RetrieveExpiring(ctx context.Context, from, to time.Time) ([]certs.Cert, error) {
+	q := `SELECT thing_id, owner_id, org_id, serial, expire, notified FROM certs WHERE expire > $1 AND expire <= $2 ORDER BY expire;`
+	rows, err := cr.db.QueryxContext(ctx, q, from, to)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	certificates := []certs.Cert{}
+	for rows.Next() {
+		var dbcrt dbCert
+		if err := rows.StructScan(&dbcrt); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		certificates = append(certificates, toCert(dbcrt))
+	}
+
+	return certificates, nil
+}
+
+func (cr certsRepository) RetrieveByOrg(ctx context.Context, orgID string) ([]certs.Cert, error) {
+	q := `SELECT thing_id, owner_id, org_id, group_id, serial, expire, notified FROM certs WHERE org_id = $1;`
+	return cr.retrieve(ctx, q, orgID)
+}
+
+func (cr certsRepository) RetrieveByGroup(ctx context.Context, groupID string) ([]certs.Cert, error) {
+	q := `SELECT thing_id, owner_id, org_id, group_id, serial, expire, notified FROM certs WHERE group_id = $1;`
+	return cr.retrieve(ctx, q, groupID)
+}
+
+func (cr certsRepository) retrieve(ctx context.Context, query string, args ...interface{}) ([]certs.Cert, error) {
+	rows, err := cr.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	certificates := []certs.Cert{}
+	for rows.Next() {
+		var dbcrt dbCert
+		if err := rows.StructScan(&dbcrt); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		certificates = append(certificates, toCert(dbcrt))
+	}
+
+	return certificates, nil
+}
+
+func (cr certsRepository) UpdateNotified(ctx context.Context, serialID string, threshold uint) error {
+	q := `UPDATE certs SET notified = $1 WHERE serial = $2;`
+
+	res, err := cr.db.ExecContext(ctx, q, threshold, serialID)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt != 1 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
 func (cr certsRepository) rollback(content string, tx *sqlx.Tx, err error) {
 	cr.log.Error(fmt.Sprintf("%s %s", content, err))
 
@@ -178,18 +263,24 @@ func (cr certsRepository) rollback(content string, tx *sqlx.Tx, err error) {
 }
 
 type dbCert struct {
-	ThingID string    `db:"thing_id"`
-	Serial  string    `db:"serial"`
-	Expire  time.Time `db:"expire"`
-	OwnerID string    `db:"owner_id"`
+	ThingID  string    `db:"thing_id"`
+	Serial   string    `db:"serial"`
+	Expire   time.Time `db:"expire"`
+	OwnerID  string    `db:"owner_id"`
+	OrgID    string    `db:"org_id"`
+	GroupID  string    `db:"group_id"`
+	Notified uint      `db:"notified"`
 }
 
 func toDBCert(c certs.Cert) dbCert {
 	return dbCert{
-		ThingID: c.ThingID,
-		OwnerID: c.OwnerID,
-		Serial:  c.Serial,
-		Expire:  c.Expire,
+		ThingID:  c.ThingID,
+		OwnerID:  c.OwnerID,
+		OrgID:    c.OrgID,
+		GroupID:  c.GroupID,
+		Serial:   c.Serial,
+		Expire:   c.Expire,
+		Notified: c.Notified,
 	}
 }
 
@@ -199,5 +290,8 @@ func toCert(cdb dbCert) certs.Cert {
 	c.ThingID = cdb.ThingID
 	c.Serial = cdb.Serial
 	c.Expire = cdb.Expire
+	c.OrgID = cdb.OrgID
+	c.GroupID = cdb.GroupID
+	c.Notified = cdb.Notified
 	return c
 }
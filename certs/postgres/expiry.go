@@ -0,0 +1,127 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/MainfluxLabs/mainflux/certs"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ certs.ExpiryConfigRepository = (*expiryConfigRepository)(nil)
+
+type expiryConfigRepository struct {
+	db *sqlx.DB
+}
+
+// NewExpiryConfigRepository instantiates a PostgreSQL implementation of
+// expiry config repository.
+func NewExpiryConfigRepository(db *sqlx.DB) certs.ExpiryConfigRepository {
+	return &expiryConfigRepository{db: db}
+}
+
+func (er expiryConfigRepository) Save(ctx context.Context, cfg certs.ExpiryConfig) error {
+	q := `INSERT INTO expiry_configs (org_id, thresholds, smtp_id, smpp_id) VALUES (:org_id, :thresholds, :smtp_id, :smpp_id)
+		ON CONFLICT (org_id) DO UPDATE SET thresholds = :thresholds, smtp_id = :smtp_id, smpp_id = :smpp_id;`
+
+	dbcfg, err := toDBExpiryConfig(cfg)
+	if err != nil {
+		return errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	if _, err := er.db.NamedExecContext(ctx, q, dbcfg); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (er expiryConfigRepository) RetrieveByOrg(ctx context.Context, orgID string) (certs.ExpiryConfig, error) {
+	q := `SELECT org_id, thresholds, smtp_id, smpp_id FROM expiry_configs WHERE org_id = $1;`
+
+	var dbcfg dbExpiryConfig
+	if err := er.db.QueryRowxContext(ctx, q, orgID).StructScan(&dbcfg); err != nil {
+		if err == sql.ErrNoRows {
+			return certs.ExpiryConfig{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return certs.ExpiryConfig{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toExpiryConfig(dbcfg)
+}
+
+func (er expiryConfigRepository) RetrieveAll(ctx context.Context) ([]certs.ExpiryConfig, error) {
+	q := `SELECT org_id, thresholds, smtp_id, smpp_id FROM expiry_configs;`
+
+	rows, err := er.db.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var cfgs []certs.ExpiryConfig
+	for rows.Next() {
+		var dbcfg dbExpiryConfig
+		if err := rows.StructScan(&dbcfg); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		cfg, err := toExpiryConfig(dbcfg)
+		if err != nil {
+			return nil, err
+		}
+		cfgs = append(cfgs, cfg)
+	}
+
+	return cfgs, nil
+}
+
+func (er expiryConfigRepository) Remove(ctx context.Context, orgID string) error {
+	q := `DELETE FROM expiry_configs WHERE org_id = $1;`
+
+	if _, err := er.db.ExecContext(ctx, q, orgID); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbExpiryConfig struct {
+	OrgID      string `db:"org_id"`
+	Thresholds []byte `db:"thresholds"`
+	SmtpID     string `db:"smtp_id"`
+	SmppID     string `db:"smpp_id"`
+}
+
+func toDBExpiryConfig(cfg certs.ExpiryConfig) (dbExpiryConfig, error) {
+	thresholds, err := json.Marshal(cfg.Thresholds)
+	if err != nil {
+		return dbExpiryConfig{}, err
+	}
+
+	return dbExpiryConfig{
+		OrgID:      cfg.OrgID,
+		Thresholds: thresholds,
+		SmtpID:     cfg.SmtpID,
+		SmppID:     cfg.SmppID,
+	}, nil
+}
+
+func toExpiryConfig(dbcfg dbExpiryConfig) (certs.ExpiryConfig, error) {
+	var thresholds []uint
+	if err := json.Unmarshal(dbcfg.Thresholds, &thresholds); err != nil {
+		return certs.ExpiryConfig{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return certs.ExpiryConfig{
+		OrgID:      dbcfg.OrgID,
+		Thresholds: thresholds,
+		SmtpID:     dbcfg.SmtpID,
+		SmppID:     dbcfg.SmppID,
+	}, nil
+}
@@ -6,56 +6,55 @@
 package postgres
 
 import (
-	"errors"
 	"fmt"
 
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
-const primaryKey = "primary_key"
-
-// ErrMigrate indicates error during database migrations.
-var ErrMigrate = errors.New("error executing database migrations")
-
 // Config defines the options that are used when connecting to a PostgreSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the PostgreSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate
-// failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
-		mErr, ok := err.(*migrate.TxError)
-		if ok && mErr.Migration.Id == primaryKey {
-			return db, ErrMigrate
-		}
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
 		Migrations: []*migrate.Migration{
 			{
 				Id: "certs_1",
@@ -72,9 +71,37 @@ func migrateDB(db *sqlx.DB) error {
 					"DROP TABLE IF EXISTS certs;",
 				},
 			},
+			{
+				Id: "certs_2",
+				Up: []string{
+					`ALTER TABLE IF EXISTS certs ADD COLUMN IF NOT EXISTS org_id TEXT NOT NULL DEFAULT '';`,
+					`ALTER TABLE IF EXISTS certs ADD COLUMN IF NOT EXISTS notified INTEGER NOT NULL DEFAULT 0;`,
+					`CREATE INDEX IF NOT EXISTS certs_expire_idx ON certs (expire);`,
+					`CREATE TABLE IF NOT EXISTS expiry_configs (
+						org_id       TEXT PRIMARY KEY,
+						thresholds   JSONB NOT NULL,
+						smtp_id      TEXT NOT NULL DEFAULT '',
+						smpp_id      TEXT NOT NULL DEFAULT ''
+					);`,
+				},
+				Down: []string{
+					"DROP TABLE IF EXISTS expiry_configs;",
+					"DROP INDEX IF EXISTS certs_expire_idx;",
+					"ALTER TABLE IF EXISTS certs DROP COLUMN IF EXISTS notified;",
+					"ALTER TABLE IF EXISTS certs DROP COLUMN IF EXISTS org_id;",
+				},
+			},
+			{
+				Id: "certs_3",
+				Up: []string{
+					`ALTER TABLE IF EXISTS certs ADD COLUMN IF NOT EXISTS group_id TEXT NOT NULL DEFAULT '';`,
+					`CREATE INDEX IF NOT EXISTS certs_group_id_idx ON certs (group_id);`,
+				},
+				Down: []string{
+					"DROP INDEX IF EXISTS certs_group_id_idx;",
+					"ALTER TABLE IF EXISTS certs DROP COLUMN IF EXISTS group_id;",
+				},
+			},
 		},
 	}
-
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
 }
@@ -0,0 +1,61 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/certs"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	stream = "mainflux.things"
+	group  = "mainflux.certs"
+
+	groupRemove = "group.remove"
+	orgCleanup  = "org.cleanup"
+)
+
+// Subscriber represents event source for things events.
+type Subscriber interface {
+	// Subscribes to the things event stream until the context is canceled.
+	Subscribe(ctx context.Context) error
+}
+
+type eventStore struct {
+	svc      certs.Service
+	client   *redis.Client
+	consumer string
+	logger   logger.Logger
+}
+
+// NewEventStore returns new event store instance.
+func NewEventStore(svc certs.Service, client *redis.Client, consumer string, log logger.Logger) Subscriber {
+	return eventStore{
+		svc:      svc,
+		client:   client,
+		consumer: consumer,
+		logger:   log,
+	}
+}
+
+func (es eventStore) Subscribe(ctx context.Context) error {
+	return events.NewSubscriber(es.client, group, es.consumer, []string{stream}, es.logger).Subscribe(ctx, es.handle)
+}
+
+// handle revokes the certificates of the things of the removed group, and
+// of the removed org once the things service has removed the org groups.
+func (es eventStore) handle(ctx context.Context, event events.Event) error {
+	switch event.Operation {
+	case groupRemove:
+		return es.svc.RevokeCertsByGroup(ctx, event.Read("id"))
+	case orgCleanup:
+		return es.svc.RevokeCertsByOrg(ctx, event.Read("id"))
+	}
+
+	return nil
+}
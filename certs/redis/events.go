@@ -0,0 +1,36 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+)
+
+const (
+	certPrefix = "cert."
+	certIssue  = certPrefix + "issue"
+)
+
+var (
+	_ events.Encoder = (*issueCertEvent)(nil)
+)
+
+type issueCertEvent struct {
+	serial  string
+	thingID string
+	orgID   string
+	expire  time.Time
+}
+
+func (ice issueCertEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"serial":    ice.serial,
+		"thing_id":  ice.thingID,
+		"org_id":    ice.orgID,
+		"expire":    ice.expire.Unix(),
+		"operation": certIssue,
+	}
+}
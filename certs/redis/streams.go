@@ -0,0 +1,111 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/certs"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	streamID  = "mainflux.certs"
+	streamLen = 1000
+)
+
+var _ certs.Service = (*eventStore)(nil)
+
+type eventStore struct {
+	svc    certs.Service
+	client *redis.Client
+}
+
+// NewEventStoreMiddleware returns wrapper around certs service that sends
+// events to event store.
+func NewEventStoreMiddleware(svc certs.Service, client *redis.Client) certs.Service {
+	return eventStore{
+		svc:    svc,
+		client: client,
+	}
+}
+
+func (es eventStore) IssueCert(ctx context.Context, token, thingID, ttl string, keyBits int, keyType string) (certs.Cert, error) {
+	c, err := es.svc.IssueCert(ctx, token, thingID, ttl, keyBits, keyType)
+	if err != nil {
+		return c, err
+	}
+
+	es.issueCert(ctx, c)
+
+	return c, nil
+}
+
+func (es eventStore) ListCerts(ctx context.Context, token, thingID string, offset, limit uint64) (certs.Page, error) {
+	return es.svc.ListCerts(ctx, token, thingID, offset, limit)
+}
+
+func (es eventStore) ListSerials(ctx context.Context, token, thingID string, offset, limit uint64) (certs.Page, error) {
+	return es.svc.ListSerials(ctx, token, thingID, offset, limit)
+}
+
+func (es eventStore) ViewCert(ctx context.Context, token, serialID string) (certs.Cert, error) {
+	return es.svc.ViewCert(ctx, token, serialID)
+}
+
+func (es eventStore) LookupCert(ctx context.Context, token, serialID string) (certs.Cert, error) {
+	return es.svc.LookupCert(ctx, token, serialID)
+}
+
+func (es eventStore) RevokeCert(ctx context.Context, token, serialID string) (certs.Revoke, error) {
+	return es.svc.RevokeCert(ctx, token, serialID)
+}
+
+func (es eventStore) RenewCert(ctx context.Context, token, serialID string) (certs.Cert, error) {
+	c, err := es.svc.RenewCert(ctx, token, serialID)
+	if err != nil {
+		return c, err
+	}
+
+	es.issueCert(ctx, c)
+
+	return c, nil
+}
+
+func (es eventStore) RevokeCertsByOrg(ctx context.Context, orgID string) error {
+	return es.svc.RevokeCertsByOrg(ctx, orgID)
+}
+
+func (es eventStore) RevokeCertsByGroup(ctx context.Context, groupID string) error {
+	return es.svc.RevokeCertsByGroup(ctx, groupID)
+}
+
+func (es eventStore) UpdateExpiryConfig(ctx context.Context, token string, cfg certs.ExpiryConfig) error {
+	return es.svc.UpdateExpiryConfig(ctx, token, cfg)
+}
+
+func (es eventStore) ViewExpiryConfig(ctx context.Context, token, orgID string) (certs.ExpiryConfig, error) {
+	return es.svc.ViewExpiryConfig(ctx, token, orgID)
+}
+
+func (es eventStore) NotifyExpiring(ctx context.Context, now time.Time) error {
+	return es.svc.NotifyExpiring(ctx, now)
+}
+
+func (es eventStore) issueCert(ctx context.Context, c certs.Cert) {
+	event := issueCertEvent{
+		serial:  c.Serial,
+		thingID: c.ThingID,
+		orgID:   c.OrgID,
+		expire:  c.Expire,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+}
@@ -5,12 +5,18 @@ package certs
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
+	"fmt"
 	"time"
 
+	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/certs/pki"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	mfsdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
 )
@@ -22,6 +28,11 @@ var (
 	// ErrFailedCertRevocation failed to revoke certificate
 	ErrFailedCertRevocation = errors.New("failed to revoke certificate")
 
+	// ErrFailedCertRenewal failed to renew certificate
+	ErrFailedCertRenewal = errors.New("failed to renew certificate")
+
+	errUnsupportedKeyType = errors.New("unsupported certificate key type")
+
 	errFailedToRemoveCertFromDB = errors.New("failed to remove cert serial from db")
 )
 
@@ -42,8 +53,37 @@ type Service interface {
 	// ViewCert retrieves the certificate issued for a given serial ID
 	ViewCert(ctx context.Context, token, serialID string) (Cert, error)
 
+	// LookupCert retrieves the thing and the owner of the certificate with a
+	// given serial ID, without the certificate itself. Only the root admin
+	// can look up the certificates of all users.
+	LookupCert(ctx context.Context, token, serialID string) (Cert, error)
+
 	// RevokeCert revokes a certificate for a given serial ID
 	RevokeCert(ctx context.Context, token, serialID string) (Revoke, error)
+
+	// RenewCert issues a new certificate for the thing of the certificate
+	// with a given serial ID, having the same key type, key size and
+	// validity period, and revokes the renewed certificate.
+	RenewCert(ctx context.Context, token, serialID string) (Cert, error)
+
+	// RevokeCertsByOrg revokes and removes the certificates issued for the
+	// things of the removed org, along with its expiry notification config.
+	RevokeCertsByOrg(ctx context.Context, orgID string) error
+
+	// RevokeCertsByGroup revokes and removes the certificates issued for the
+	// things of the removed group.
+	RevokeCertsByGroup(ctx context.Context, groupID string) error
+
+	// UpdateExpiryConfig updates the expiry notification config of the org.
+	UpdateExpiryConfig(ctx context.Context, token string, cfg ExpiryConfig) error
+
+	// ViewExpiryConfig retrieves the expiry notification config of the org,
+	// or the default one if the org hasn't configured it.
+	ViewExpiryConfig(ctx context.Context, token, orgID string) (ExpiryConfig, error)
+
+	// NotifyExpiring notifies the orgs about the certificates which crossed
+	// one of the expiry thresholds at the provided time.
+	NotifyExpiring(ctx context.Context, now time.Time) error
 }
 
 // Config defines the service parameters
@@ -66,24 +106,31 @@ type Config struct {
 	PKIPath        string
 	PKIRole        string
 	PKIToken       string
+
+	// ExpiryThresholds are the default expiry thresholds, in days.
+	ExpiryThresholds []uint
 }
 
 type certsService struct {
-	auth      protomfx.AuthServiceClient
-	certsRepo Repository
-	sdk       mfsdk.SDK
-	conf      Config
-	pki       pki.Agent
+	auth       protomfx.AuthServiceClient
+	certsRepo  Repository
+	expiryRepo ExpiryConfigRepository
+	sdk        mfsdk.SDK
+	conf       Config
+	pki        pki.Agent
+	publisher  messaging.Publisher
 }
 
 // New returns new Certs service.
-func New(auth protomfx.AuthServiceClient, certs Repository, sdk mfsdk.SDK, config Config, pki pki.Agent) Service {
+func New(auth protomfx.AuthServiceClient, certs Repository, expiry ExpiryConfigRepository, sdk mfsdk.SDK, config Config, pki pki.Agent, publisher messaging.Publisher) Service {
 	return &certsService{
-		certsRepo: certs,
-		sdk:       sdk,
-		auth:      auth,
-		conf:      config,
-		pki:       pki,
+		certsRepo:  certs,
+		expiryRepo: expiry,
+		sdk:        sdk,
+		auth:       auth,
+		conf:       config,
+		pki:        pki,
+		publisher:  publisher,
 	}
 }
 
@@ -103,6 +150,9 @@ type Cert struct {
 	PrivateKeyType string    `json:"private_key_type" mapstructure:"private_key_type"`
 	Serial         string    `json:"serial" mapstructure:"serial_number"`
 	Expire         time.Time `json:"expire" mapstructure:"-"`
+	OrgID          string    `json:"org_id,omitempty" mapstructure:"-"`
+	GroupID        string    `json:"group_id,omitempty" mapstructure:"-"`
+	Notified       uint      `json:"-" mapstructure:"-"`
 }
 
 func (cs *certsService) IssueCert(ctx context.Context, token, thingID string, ttl string, keyBits int, keyType string) (Cert, error) {
@@ -116,6 +166,11 @@ func (cs *certsService) IssueCert(ctx context.Context, token, thingID string, tt
 		return Cert{}, errors.Wrap(ErrFailedCertCreation, err)
 	}
 
+	group, err := cs.sdk.ViewGroupByThing(thingID, token)
+	if err != nil {
+		return Cert{}, errors.Wrap(ErrFailedCertCreation, err)
+	}
+
 	cert, err := cs.pki.IssueCert(thing.Key, ttl, keyType, keyBits)
 	if err != nil {
 		return Cert{}, errors.Wrap(ErrFailedCertCreation, err)
@@ -131,8 +186,12 @@ func (cs *certsService) IssueCert(ctx context.Context, token, thingID string, tt
 		PrivateKeyType: cert.PrivateKeyType,
 		Serial:         cert.Serial,
 		Expire:         cert.Expire,
+		OrgID:          group.OrgID,
+		GroupID:        group.ID,
 	}
 
+	// The cert is already issued by the PKI, so it's saved regardless of
+	// the request cancellation.
 	_, err = cs.certsRepo.Save(context.Background(), c)
 	return c, err
 }
@@ -161,6 +220,8 @@ func (cs *certsService) RevokeCert(ctx context.Context, token, thingID string) (
 			return revoke, errors.Wrap(ErrFailedCertRevocation, err)
 		}
 		revoke.RevocationTime = revTime
+		// The cert is already revoked by the PKI, so it's removed regardless
+		// of the request cancellation.
 		if err = cs.certsRepo.Remove(context.Background(), u.GetId(), c.Serial); err != nil {
 			return revoke, errors.Wrap(errFailedToRemoveCertFromDB, err)
 		}
@@ -169,6 +230,103 @@ func (cs *certsService) RevokeCert(ctx context.Context, token, thingID string) (
 	return revoke, nil
 }
 
+func (cs *certsService) RevokeCertsByOrg(ctx context.Context, orgID string) error {
+	crts, err := cs.certsRepo.RetrieveByOrg(ctx, orgID)
+	if err != nil {
+		return errors.Wrap(ErrFailedCertRevocation, err)
+	}
+
+	if err := cs.revokeCerts(crts); err != nil {
+		return err
+	}
+
+	return cs.expiryRepo.Remove(ctx, orgID)
+}
+
+func (cs *certsService) RevokeCertsByGroup(ctx context.Context, groupID string) error {
+	crts, err := cs.certsRepo.RetrieveByGroup(ctx, groupID)
+	if err != nil {
+		return errors.Wrap(ErrFailedCertRevocation, err)
+	}
+
+	return cs.revokeCerts(crts)
+}
+
+func (cs *certsService) revokeCerts(crts []Cert) error {
+	for _, c := range crts {
+		if _, err := cs.pki.Revoke(c.Serial); err != nil {
+			return errors.Wrap(ErrFailedCertRevocation, err)
+		}
+		if err := cs.certsRepo.Remove(context.Background(), c.OwnerID, c.Serial); err != nil {
+			return errors.Wrap(errFailedToRemoveCertFromDB, err)
+		}
+	}
+
+	return nil
+}
+
+func (cs *certsService) RenewCert(ctx context.Context, token, serialID string) (Cert, error) {
+	u, err := cs.auth.Identify(ctx, &protomfx.Token{Value: token})
+	if err != nil {
+		return Cert{}, err
+	}
+
+	cert, err := cs.certsRepo.RetrieveBySerial(ctx, u.GetId(), serialID)
+	if err != nil {
+		return Cert{}, err
+	}
+
+	vcert, err := cs.pki.Read(serialID)
+	if err != nil {
+		return Cert{}, errors.Wrap(ErrFailedCertRenewal, err)
+	}
+
+	ttl, keyType, keyBits, err := certParams(vcert.ClientCert)
+	if err != nil {
+		return Cert{}, errors.Wrap(ErrFailedCertRenewal, err)
+	}
+
+	c, err := cs.IssueCert(ctx, token, cert.ThingID, ttl, keyBits, keyType)
+	if err != nil {
+		return Cert{}, errors.Wrap(ErrFailedCertRenewal, err)
+	}
+
+	// The new cert is already issued, so the renewed one is revoked
+	// regardless of the request cancellation.
+	if _, err := cs.pki.Revoke(serialID); err != nil {
+		return c, errors.Wrap(ErrFailedCertRevocation, err)
+	}
+	if err := cs.certsRepo.Remove(context.Background(), u.GetId(), serialID); err != nil {
+		return c, errors.Wrap(errFailedToRemoveCertFromDB, err)
+	}
+
+	return c, nil
+}
+
+// certParams returns the validity period, key type and key size of the PEM
+// encoded certificate, in the format accepted by the PKI.
+func certParams(cert string) (string, string, int, error) {
+	block, _ := pem.Decode([]byte(cert))
+	if block == nil {
+		return "", "", 0, errors.ErrMalformedEntity
+	}
+
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", "", 0, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	ttl := fmt.Sprintf("%ds", int64(c.NotAfter.Sub(c.NotBefore).Seconds()))
+	switch k := c.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return ttl, "rsa", k.N.BitLen(), nil
+	case *ecdsa.PublicKey:
+		return ttl, "ec", k.Curve.Params().BitSize, nil
+	default:
+		return "", "", 0, errUnsupportedKeyType
+	}
+}
+
 func (cs *certsService) ListCerts(ctx context.Context, token, thingID string, offset, limit uint64) (Page, error) {
 	u, err := cs.auth.Identify(ctx, &protomfx.Token{Value: token})
 	if err != nil {
@@ -220,9 +378,43 @@ func (cs *certsService) ViewCert(ctx context.Context, token, serialID string) (C
 	c := Cert{
 		ThingID:    cert.ThingID,
 		ClientCert: vcert.ClientCert,
+		IssuingCA:  vcert.IssuingCA,
 		Serial:     cert.Serial,
 		Expire:     cert.Expire,
 	}
 
 	return c, nil
 }
+
+func (cs *certsService) LookupCert(ctx context.Context, token, serialID string) (Cert, error) {
+	if err := cs.isAdmin(ctx, token); err != nil {
+		return Cert{}, err
+	}
+
+	cert, err := cs.certsRepo.RetrieveBySerialID(ctx, serialID)
+	if err != nil {
+		return Cert{}, err
+	}
+
+	c := Cert{
+		OwnerID: cert.OwnerID,
+		ThingID: cert.ThingID,
+		Serial:  cert.Serial,
+		Expire:  cert.Expire,
+	}
+
+	return c, nil
+}
+
+func (cs *certsService) isAdmin(ctx context.Context, token string) error {
+	req := &protomfx.AuthorizeReq{
+		Token:   token,
+		Subject: auth.RootSub,
+	}
+
+	if _, err := cs.auth.Authorize(ctx, req); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return nil
+}
@@ -17,10 +17,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/certs"
 	ctmocks "github.com/MainfluxLabs/mainflux/certs/mocks"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -37,6 +39,8 @@ const (
 	wrongValue = "wrong-value"
 	email      = "user@example.com"
 	token      = email
+	adminEmail = "admin@example.com"
+	adminToken = adminEmail
 	password   = "password"
 	thingsNum  = 1
 	thingKey   = "thingKey"
@@ -59,13 +63,25 @@ const (
 	caKeyPath         = "../docker/ssl/certs/ca.key"
 	cfgSignHoursValid = "24h"
 	cfgSignRSABits    = 2048
+
+	orgID   = "org-id"
+	groupID = "group-id"
+	smtpID  = "smtp-id"
 )
 
-var usersList = []users.User{{Email: email, Password: password}}
+var defThresholds = []uint{30, 7, 1}
+
+var (
+	admin     = users.User{ID: "2e248e36-2d26-46ea-97b0-1e38d674cbe4", Email: adminEmail, Password: password, Role: auth.RootSub}
+	usersList = []users.User{{Email: email, Password: password}, admin}
+)
 
 func newService() (certs.Service, error) {
-	auth := mocks.NewAuthService("", usersList)
-	ac := auth
+	return newServiceWithPublisher(mocks.NewPublisher())
+}
+
+func newServiceWithPublisher(pub messaging.Publisher) (certs.Service, error) {
+	ac := mocks.NewAuthService(admin.ID, usersList)
 	server := newThingsServer(newThingsService(ac))
 	config := mfsdk.Config{
 		ThingsURL: server.URL,
@@ -73,6 +89,7 @@ func newService() (certs.Service, error) {
 
 	sdk := mfsdk.NewSDK(config)
 	repo := ctmocks.NewCertsRepository()
+	expiryRepo := ctmocks.NewExpiryConfigRepository()
 
 	tlsCert, caCert, err := loadCertificates(caPath, caKeyPath)
 	if err != nil {
@@ -96,11 +113,13 @@ func newService() (certs.Service, error) {
 		SignX509Cert:   caCert,
 		SignHoursValid: cfgSignHoursValid,
 		SignRSABits:    cfgSignRSABits,
+
+		ExpiryThresholds: defThresholds,
 	}
 
 	pki := ctmocks.NewPkiAgent(tlsCert, caCert, cfgSignRSABits, cfgSignHoursValid, authTimeout)
 
-	return certs.New(auth, repo, sdk, c, pki), nil
+	return certs.New(ac, repo, expiryRepo, sdk, c, pki, pub), nil
 }
 
 func newThingsService(auth protomfx.AuthServiceClient) things.Service {
@@ -108,8 +127,9 @@ func newThingsService(auth protomfx.AuthServiceClient) things.Service {
 	for i := 0; i < thingsNum; i++ {
 		id := strconv.Itoa(i + 1)
 		ths[id] = things.Thing{
-			ID:  id,
-			Key: thingKey,
+			ID:      id,
+			GroupID: groupID,
+			Key:     thingKey,
 		}
 	}
 
@@ -227,6 +247,127 @@ func TestRevokeCert(t *testing.T) {
 
 }
 
+func TestRevokeCertsByOrg(t *testing.T) {
+	svc, err := newService()
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	c, err := svc.IssueCert(context.Background(), token, thingID, ttl, keyBits, key)
+	require.Nil(t, err, fmt.Sprintf("unexpected cert creation error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		orgID string
+		err   error
+	}{
+		{
+			desc:  "revoke certs by org",
+			orgID: c.OrgID,
+			err:   nil,
+		},
+		{
+			desc:  "revoke certs by org without certs",
+			orgID: wrongValue,
+			err:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RevokeCertsByOrg(context.Background(), tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	_, err = svc.ViewCert(context.Background(), token, c.Serial)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("view revoked cert: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestRevokeCertsByGroup(t *testing.T) {
+	svc, err := newService()
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	c, err := svc.IssueCert(context.Background(), token, thingID, ttl, keyBits, key)
+	require.Nil(t, err, fmt.Sprintf("unexpected cert creation error: %s\n", err))
+	require.NotEmpty(t, c.GroupID, "issue cert: expected the thing group")
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "revoke certs by group without certs",
+			groupID: wrongValue,
+			err:     nil,
+		},
+		{
+			desc:    "revoke certs by group",
+			groupID: c.GroupID,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RevokeCertsByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	_, err = svc.ViewCert(context.Background(), token, c.Serial)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("view revoked cert: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestRenewCert(t *testing.T) {
+	svc, err := newService()
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	c, err := svc.IssueCert(context.Background(), token, thingID, ttl, keyBits, key)
+	require.Nil(t, err, fmt.Sprintf("unexpected cert creation error: %s\n", err))
+
+	cases := []struct {
+		desc   string
+		token  string
+		serial string
+		err    error
+	}{
+		{
+			desc:   "renew cert with invalid token",
+			token:  wrongValue,
+			serial: c.Serial,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "renew non-existing cert",
+			token:  token,
+			serial: wrongValue,
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "renew cert",
+			token:  token,
+			serial: c.Serial,
+			err:    nil,
+		},
+		{
+			desc:   "renew already renewed cert",
+			token:  token,
+			serial: c.Serial,
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		rc, err := svc.RenewCert(context.Background(), tc.token, tc.serial)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+
+		assert.NotEqual(t, c.Serial, rc.Serial, fmt.Sprintf("%s: expected new serial", tc.desc))
+		assert.Equal(t, c.ThingID, rc.ThingID, fmt.Sprintf("%s: expected thing %s got %s\n", tc.desc, c.ThingID, rc.ThingID))
+		cert, err := readCert([]byte(rc.ClientCert))
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, time.Hour, cert.NotAfter.Sub(cert.NotBefore), fmt.Sprintf("%s: expected the renewed validity period", tc.desc))
+	}
+}
+
 func TestListCerts(t *testing.T) {
 	svc, err := newService()
 	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
@@ -303,6 +444,7 @@ func TestListSerials(t *testing.T) {
 		crt := certs.Cert{
 			OwnerID: cert.OwnerID,
 			ThingID: cert.ThingID,
+			GroupID: cert.GroupID,
 			Serial:  cert.Serial,
 			Expire:  cert.Expire,
 		}
@@ -414,6 +556,204 @@ func TestViewCert(t *testing.T) {
 	}
 }
 
+func TestLookupCert(t *testing.T) {
+	svc, err := newService()
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	ic, err := svc.IssueCert(context.Background(), token, thingID, ttl, keyBits, key)
+	require.Nil(t, err, fmt.Sprintf("unexpected cert creation error: %s\n", err))
+
+	cert := certs.Cert{
+		OwnerID: ic.OwnerID,
+		ThingID: thingID,
+		Serial:  ic.Serial,
+		Expire:  ic.Expire,
+	}
+
+	cases := []struct {
+		desc     string
+		token    string
+		serialID string
+		cert     certs.Cert
+		err      error
+	}{
+		{
+			desc:     "look up cert as admin",
+			token:    adminToken,
+			serialID: cert.Serial,
+			cert:     cert,
+			err:      nil,
+		},
+		{
+			desc:     "look up cert as non-admin user",
+			token:    token,
+			serialID: cert.Serial,
+			cert:     certs.Cert{},
+			err:      errors.ErrAuthorization,
+		},
+		{
+			desc:     "look up cert with invalid token",
+			token:    wrongValue,
+			serialID: cert.Serial,
+			cert:     certs.Cert{},
+			err:      errors.ErrAuthentication,
+		},
+		{
+			desc:     "look up cert with invalid serial",
+			token:    adminToken,
+			serialID: wrongValue,
+			cert:     certs.Cert{},
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		cert, err := svc.LookupCert(context.Background(), tc.token, tc.serialID)
+		assert.Equal(t, tc.cert, cert, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cert, cert))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestUpdateExpiryConfig(t *testing.T) {
+	svc, err := newService()
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	cfg := certs.ExpiryConfig{OrgID: orgID, Thresholds: []uint{1, 14}, SmtpID: smtpID}
+
+	cases := []struct {
+		desc  string
+		token string
+		cfg   certs.ExpiryConfig
+		res   certs.ExpiryConfig
+		err   error
+	}{
+		{
+			desc:  "update expiry config",
+			token: token,
+			cfg:   cfg,
+			res:   certs.ExpiryConfig{OrgID: orgID, Thresholds: []uint{14, 1}, SmtpID: smtpID},
+			err:   nil,
+		},
+		{
+			desc:  "update expiry config with invalid token",
+			token: wrongValue,
+			cfg:   cfg,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateExpiryConfig(context.Background(), tc.token, tc.cfg)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+
+		res, err := svc.ViewExpiryConfig(context.Background(), tc.token, tc.cfg.OrgID)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.res, res))
+	}
+}
+
+func TestViewExpiryConfig(t *testing.T) {
+	svc, err := newService()
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		res   certs.ExpiryConfig
+		err   error
+	}{
+		{
+			desc:  "view default expiry config",
+			token: token,
+			orgID: orgID,
+			res:   certs.ExpiryConfig{OrgID: orgID, Thresholds: defThresholds},
+			err:   nil,
+		},
+		{
+			desc:  "view expiry config with invalid token",
+			token: wrongValue,
+			orgID: orgID,
+			res:   certs.ExpiryConfig{},
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := svc.ViewExpiryConfig(context.Background(), tc.token, tc.orgID)
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.res, res))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestNotifyExpiring(t *testing.T) {
+	pub := &publisher{}
+	svc, err := newServiceWithPublisher(pub)
+	require.Nil(t, err, fmt.Sprintf("unexpected service creation error: %s\n", err))
+
+	cert, err := svc.IssueCert(context.Background(), token, thingID, ttl, keyBits, key)
+	require.Nil(t, err, fmt.Sprintf("unexpected cert creation error: %s\n", err))
+
+	cases := []struct {
+		desc string
+		now  time.Time
+		msgs int
+	}{
+		{
+			desc: "notify about cert before the thresholds",
+			now:  cert.Expire.Add(-31 * 24 * time.Hour),
+			msgs: 0,
+		},
+		{
+			desc: "notify about cert crossing the threshold",
+			now:  cert.Expire.Add(-20 * 24 * time.Hour),
+			msgs: 1,
+		},
+		{
+			desc: "notify about cert crossing the same threshold again",
+			now:  cert.Expire.Add(-10 * 24 * time.Hour),
+			msgs: 0,
+		},
+		{
+			desc: "notify about cert crossing the lower threshold",
+			now:  cert.Expire.Add(-time.Hour),
+			msgs: 1,
+		},
+		{
+			desc: "notify about expired cert",
+			now:  cert.Expire.Add(time.Hour),
+			msgs: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		pub.msgs = nil
+		err := svc.NotifyExpiring(context.Background(), tc.now)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.msgs, len(pub.msgs), fmt.Sprintf("%s: expected %d messages got %d\n", tc.desc, tc.msgs, len(pub.msgs)))
+		for _, msg := range pub.msgs {
+			assert.Equal(t, messaging.AlarmProtocol, msg.Protocol, fmt.Sprintf("%s: expected %s protocol got %s\n", tc.desc, messaging.AlarmProtocol, msg.Protocol))
+			assert.Equal(t, thingID, msg.Publisher, fmt.Sprintf("%s: expected %s publisher got %s\n", tc.desc, thingID, msg.Publisher))
+		}
+	}
+}
+
+type publisher struct {
+	msgs []protomfx.Message
+}
+
+func (pub *publisher) Publish(msg protomfx.Message) error {
+	pub.msgs = append(pub.msgs, msg)
+	return nil
+}
+
+func (pub *publisher) Close() error {
+	return nil
+}
+
 func newThingsServer(svc things.Service) *httptest.Server {
 	logger := logger.NewMock()
 	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
@@ -1,8 +1,10 @@
 package cli
 
 import (
-	"strconv"
+	"fmt"
+	"io/ioutil"
 
+	mfxsdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
 	"github.com/spf13/cobra"
 )
 
@@ -11,26 +13,25 @@ func NewCertsCmd() *cobra.Command {
 	var keySize uint16
 	var keyType string
 	var ttl uint32
+	var out string
 
 	issueCmd := cobra.Command{
-		Use:   "issue <thing_id> <user_token> [--keysize=2048] [--keytype=rsa] [--ttl=8760]",
+		Use:   "issue <thing_id> <user_token> [--keysize=2048] [--keytype=rsa] [--ttl=8760] [--out=<file>]",
 		Short: "Issue certificate",
 		Long:  `Issues new certificate for a thing`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
 			}
 
-			thingID := args[0]
-			valid := strconv.FormatUint(uint64(ttl), 10)
-
-			c, err := sdk.IssueCert(thingID, int(keySize), keyType, valid, args[1])
+			c, err := sdk.IssueCert(args[0], int(keySize), keyType, fmt.Sprintf("%dh", ttl), args[1])
 			if err != nil {
 				logError(err)
 				return
 			}
-			logJSON(c)
+			logCert(c, out)
 		},
 	}
 
@@ -38,14 +39,101 @@ func NewCertsCmd() *cobra.Command {
 	issueCmd.Flags().StringVar(&keyType, "keytype", "rsa", "certificate key type: RSA or EC")
 	issueCmd.Flags().Uint32Var(&ttl, "ttl", 8760, "certificate time to live in hours")
 
+	getCmd := cobra.Command{
+		Use:   "get <cert_serial> <user_token> [--out=<file>]",
+		Short: "Get certificate",
+		Long:  `Gets certificate with the given serial`,
+		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
+			if len(args) != 2 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			c, err := sdk.ViewCert(args[0], args[1])
+			if err != nil {
+				logError(err)
+				return
+			}
+			logCert(c, out)
+		},
+	}
+
+	serialsCmd := cobra.Command{
+		Use:   "serials <thing_id> <user_token>",
+		Short: "List certificate serials",
+		Long:  `Lists serials of the certificates issued for a thing`,
+		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
+			if len(args) != 2 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			cp, err := sdk.ListSerials(args[0], uint64(Offset), uint64(Limit), args[1])
+			if err != nil {
+				logError(err)
+				return
+			}
+			logJSON(cp)
+		},
+	}
+
+	renewCmd := cobra.Command{
+		Use:   "renew <cert_serial> <user_token> [--out=<file>]",
+		Short: "Renew certificate",
+		Long:  `Issues new certificate with the parameters of the given one, and revokes the given certificate`,
+		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
+			if len(args) != 2 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			c, err := sdk.RenewCert(args[0], args[1])
+			if err != nil {
+				logError(err)
+				return
+			}
+			logCert(c, out)
+		},
+	}
+
+	revokeCmd := cobra.Command{
+		Use:   "revoke <thing_id> <user_token>",
+		Short: "Revoke certificates",
+		Long:  `Revokes all certificates issued for a thing`,
+		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
+			if len(args) != 2 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			if err := sdk.RevokeCert(args[0], args[1]); err != nil {
+				logError(err)
+				return
+			}
+			logOK()
+		},
+	}
+
+	for _, c := range []*cobra.Command{&issueCmd, &getCmd, &renewCmd} {
+		c.Flags().StringVar(&out, "out", "", "file the PEM bundle of the certificate is written to")
+	}
+
 	cmd := cobra.Command{
-		Use:   "certs [issue | get]",
+		Use:   "certs [issue | get | serials | renew | revoke]",
 		Short: "Certificates management",
-		Long:  `Certificates management: create certificates for things"`,
+		Long:  `Certificates management: issue, get, list, renew and revoke certificates for things`,
 	}
 
 	cmdCerts := []cobra.Command{
 		issueCmd,
+		getCmd,
+		serialsCmd,
+		renewCmd,
+		revokeCmd,
 	}
 
 	for i := range cmdCerts {
@@ -54,3 +142,18 @@ func NewCertsCmd() *cobra.Command {
 
 	return &cmd
 }
+
+// logCert writes the PEM bundle of the certificate to the out file if it's
+// set, and logs the certificate otherwise.
+func logCert(c mfxsdk.Cert, out string) {
+	if out == "" {
+		logJSON(c)
+		return
+	}
+
+	if err := ioutil.WriteFile(out, c.PEM(), 0600); err != nil {
+		logError(err)
+		return
+	}
+	logOK()
+}
@@ -1,20 +1,53 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	mfxsdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
+	"github.com/fatih/color"
 	"github.com/pelletier/go-toml"
+	"github.com/spf13/cobra"
+)
+
+const (
+	configDir  = ".mainflux"
+	configFile = "config"
+	httpPath   = "/http"
+)
+
+var (
+	errContextNotFound = errors.New("context was not found")
+	errMissingURL      = errors.New("context url is not set")
+	errInvalidKey      = errors.New("invalid key, expected url, token or org")
+
+	// config is the configuration read from the config file.
+	config = Config{}
 )
 
 type Config struct {
-	Offset    uint   `toml:"offset"`
-	Limit     uint   `toml:"limit"`
-	Name      string `toml:"name"`
-	RawOutput bool   `toml:"raw_output"`
+	Offset         uint               `toml:"offset"`
+	Limit          uint               `toml:"limit"`
+	Name           string             `toml:"name"`
+	RawOutput      bool               `toml:"raw_output"`
+	CurrentContext string             `toml:"current_context"`
+	Contexts       map[string]Context `toml:"contexts"`
+}
+
+// Context represents a named Mainflux deployment used by the CLI.
+// The service URLs are derived from the deployment URL.
+type Context struct {
+	URL             string `toml:"url" json:"url"`
+	Token           string `toml:"token" json:"token,omitempty"`
+	Org             string `toml:"org" json:"org,omitempty"`
+	TLSVerification bool   `toml:"tls_verification" json:"tls_verification"`
+	APIVersion      string `toml:"api_version" json:"api_version,omitempty"`
 }
 
 // read - retrieve config from a file
@@ -31,22 +64,60 @@ func read(file string) (Config, error) {
 	return c, nil
 }
 
+// write - store config to a file readable only by the user, since it
+// contains the context tokens
+func write(file string, c Config) error {
+	data, err := toml.Marshal(c)
+	if err != nil {
+		return errors.New(fmt.Sprintf("failed to marshal config TOML: %s", err))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return errors.New(fmt.Sprintf("failed to create config directory: %s", err))
+	}
+
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		return errors.New(fmt.Sprintf("failed to write config file: %s", err))
+	}
+	return nil
+}
+
+// configPath returns the config path parameter, or ~/.mainflux/config
+// if it is not set.
+func configPath() string {
+	if ConfigPath != "" {
+		return ConfigPath
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, configDir, configFile)
+}
+
 func ParseConfig() {
-	if ConfigPath == "" {
+	path := configPath()
+	if path == "" {
 		// No config file
 		return
 	}
 
-	if _, err := os.Stat(ConfigPath); os.IsNotExist(err) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		// The default config file is optional.
+		if ConfigPath == "" {
+			return
+		}
 		errConfigNotFound := errors.Wrap(errors.New("config file was not found"), err)
 		logError(errConfigNotFound)
 		return
 	}
 
-	config, err := read(ConfigPath)
+	c, err := read(path)
 	if err != nil {
 		log.Fatal(err)
 	}
+	config = c
 
 	if config.Offset != 0 {
 		Offset = config.Offset
@@ -64,3 +135,246 @@ func ParseConfig() {
 		RawOutput = config.RawOutput
 	}
 }
+
+// ApplyContext sets the SDK configuration from the current context. The
+// settings passed by the command line flags take precedence.
+func ApplyContext(cmd *cobra.Command, conf *mfxsdk.Config) {
+	ctx, ok := config.Contexts[config.CurrentContext]
+	if !ok || ctx.URL == "" {
+		return
+	}
+
+	urls := []struct {
+		flag string
+		url  *string
+		path string
+	}{
+		{"auth-url", &conf.AuthURL, ""},
+		{"certs-url", &conf.CertsURL, ""},
+		{"things-url", &conf.ThingsURL, ""},
+		{"webhooks-url", &conf.WebhooksURL, ""},
+		{"users-url", &conf.UsersURL, ""},
+		{"http-url", &conf.HTTPAdapterURL, httpPath},
+	}
+
+	for _, u := range urls {
+		if !cmd.Flags().Changed(u.flag) {
+			*u.url = ctx.URL + u.path
+		}
+	}
+	conf.ReaderURL = ctx.URL
+	conf.BootstrapURL = ctx.URL
+
+	if !cmd.Flags().Changed("insecure") {
+		conf.TLSVerification = ctx.TLSVerification
+	}
+
+	if !cmd.Flags().Changed("api-version") {
+		conf.APIVersion = ctx.APIVersion
+	}
+}
+
+// withOrg returns the arguments with the org of the current context
+// inserted at the org ID position, if the org ID argument is omitted, and
+// with the token of the current context appended, if the user token
+// argument is omitted as well.
+func withOrg(args []string, pos, n int) []string {
+	ctx, ok := config.Contexts[config.CurrentContext]
+	if !ok || ctx.Org == "" || len(args) < n-2 || len(args) >= n || pos > len(args) {
+		return withToken(args, n)
+	}
+
+	res := append([]string{}, args[:pos]...)
+	res = append(res, ctx.Org)
+	return withToken(append(res, args[pos:]...), n)
+}
+
+// withToken returns the arguments with the token of the current context
+// appended, if the user token argument is omitted.
+func withToken(args []string, n int) []string {
+	ctx, ok := config.Contexts[config.CurrentContext]
+	if !ok || ctx.Token == "" || len(args) != n-1 {
+		return args
+	}
+
+	return append(append([]string{}, args...), ctx.Token)
+}
+
+var cmdConfig = []cobra.Command{
+	{
+		Use:   "set-context <name> <JSON_context>",
+		Short: "Create or update context",
+		Long: `Create or update the named context, e.g.
+		set-context staging '{"url":"https://staging.example.com","token":"<user_token>","org":"<org_id>","tls_verification":true,"api_version":"v1"}'`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			var ctx Context
+			if err := json.Unmarshal([]byte(args[1]), &ctx); err != nil {
+				logError(err)
+				return
+			}
+
+			if ctx.URL == "" {
+				logError(errMissingURL)
+				return
+			}
+
+			if config.Contexts == nil {
+				config.Contexts = map[string]Context{}
+			}
+			config.Contexts[args[0]] = ctx
+
+			if err := write(configPath(), config); err != nil {
+				logError(err)
+				return
+			}
+
+			logOK()
+		},
+	},
+	{
+		Use:   "use-context <name>",
+		Short: "Switch context",
+		Long:  `Switch the context used by the subsequent commands`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			if _, ok := config.Contexts[args[0]]; !ok {
+				logError(errContextNotFound)
+				return
+			}
+			config.CurrentContext = args[0]
+
+			if err := write(configPath(), config); err != nil {
+				logError(err)
+				return
+			}
+
+			logOK()
+		},
+	},
+	{
+		Use:   "delete-context <name>",
+		Short: "Delete context",
+		Long:  `Delete the named context`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			if _, ok := config.Contexts[args[0]]; !ok {
+				logError(errContextNotFound)
+				return
+			}
+			delete(config.Contexts, args[0])
+			if config.CurrentContext == args[0] {
+				config.CurrentContext = ""
+			}
+
+			if err := write(configPath(), config); err != nil {
+				logError(err)
+				return
+			}
+
+			logOK()
+		},
+	},
+	{
+		Use:   "get-contexts",
+		Short: "List contexts",
+		Long:  `List the names of the contexts, marking the current one`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 0 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			var names []string
+			for name := range config.Contexts {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				if name == config.CurrentContext {
+					fmt.Println(color.BlueString("* %s", name))
+					continue
+				}
+				fmt.Printf("  %s\n", name)
+			}
+		},
+	},
+	{
+		Use:   "current-context",
+		Short: "Show current context",
+		Long:  `Show the current context`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 0 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			ctx, ok := config.Contexts[config.CurrentContext]
+			if !ok {
+				logError(errContextNotFound)
+				return
+			}
+			ctx.Token = ""
+
+			fmt.Println(config.CurrentContext)
+			logJSON(ctx)
+		},
+	},
+	{
+		Use:   "get [url | token | org]",
+		Short: "Get current context value",
+		Long: `Print the value of the current context, e.g. to pass the token to other commands:
+		things get all $(mainfluxlabs-cli config get token)`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				logUsage(cmd.Use)
+				return
+			}
+
+			ctx, ok := config.Contexts[config.CurrentContext]
+			if !ok {
+				logError(errContextNotFound)
+				return
+			}
+
+			switch args[0] {
+			case "url":
+				fmt.Println(ctx.URL)
+			case "token":
+				fmt.Println(ctx.Token)
+			case "org":
+				fmt.Println(ctx.Org)
+			default:
+				logError(errInvalidKey)
+			}
+		},
+	},
+}
+
+// NewConfigCmd returns config command.
+func NewConfigCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "config [set-context | use-context | delete-context | get-contexts | current-context | get]",
+		Short: "Contexts management",
+		Long:  `Contexts management: create, switch, delete and list the contexts stored in the config file`,
+	}
+
+	for i := range cmdConfig {
+		cmd.AddCommand(&cmdConfig[i])
+	}
+
+	return &cmd
+}
@@ -13,6 +13,7 @@ var cmdGroupRoles = []cobra.Command{
 		Short: "Create roles by group ",
 		Long:  `Creates new roles by group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -35,6 +36,7 @@ var cmdGroupRoles = []cobra.Command{
 		Short: "Roles by group",
 		Long:  `Lists all roles of a group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -52,6 +54,7 @@ var cmdGroupRoles = []cobra.Command{
 		Short: "Update roles by group",
 		Long:  `Update group roles record`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -76,6 +79,7 @@ var cmdGroupRoles = []cobra.Command{
 		Short: "Delete roles by group",
 		Long:  `Delete roles by group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -12,7 +12,7 @@ import (
 
 var cmdGroups = []cobra.Command{
 	{
-		Use:   "create <JSON_group> <org_id> <user_token>",
+		Use:   "create <JSON_group> [<org_id>] <user_token>",
 		Short: "Create group",
 		Long: `Creates new group:
 		{
@@ -21,8 +21,10 @@ var cmdGroups = []cobra.Command{
 			"Metadata":<metadata>,
 		}
 		Name - is unique group name
-		Metadata - JSON structured string`,
+		Metadata - JSON structured string
+		org_id - defaults to the org of the current context`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 1, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -47,6 +49,7 @@ var cmdGroups = []cobra.Command{
 		all - lists all groups
 		<group_id> - shows group with provided group ID`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) < 2 {
 				logUsage(cmd.Use)
 				return
@@ -85,6 +88,7 @@ var cmdGroups = []cobra.Command{
 		Short: "Delete group",
 		Long:  `Delete group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -101,6 +105,7 @@ var cmdGroups = []cobra.Command{
 		Short: "Update group",
 		Long:  `Update group record`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -123,6 +128,7 @@ var cmdGroups = []cobra.Command{
 		Short: "Things by group",
 		Long:  `Lists all things of a group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -140,6 +146,7 @@ var cmdGroups = []cobra.Command{
 		Short: "Group by thing",
 		Long:  `View group by specified thing`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -157,6 +164,7 @@ var cmdGroups = []cobra.Command{
 		Short: "Profiles by group",
 		Long:  `Lists all profiles of a group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -174,6 +182,7 @@ var cmdGroups = []cobra.Command{
 		Short: "Group by profile",
 		Long:  `View group by specified profile`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
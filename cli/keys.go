@@ -15,6 +15,7 @@ var cmdAPIKeys = []cobra.Command{
 		Short: "Issue key",
 		Long:  `Issues a new Key`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -40,6 +41,7 @@ var cmdAPIKeys = []cobra.Command{
 		Short: "Revoke key",
 		Long:  `Removes API key from database`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -58,6 +60,7 @@ var cmdAPIKeys = []cobra.Command{
 		Short: "Retrieve key",
 		Long:  `Retrieves API key with given id`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -20,6 +20,7 @@ var cmdOrgs = []cobra.Command{
 		Name - is unique org name
 		Metadata - JSON structured string`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -45,6 +46,7 @@ var cmdOrgs = []cobra.Command{
 		all - lists all orgs
 		<org_id> - shows org with provided org ID`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) < 2 {
 				logUsage(cmd.Use)
 				return
@@ -83,6 +85,7 @@ var cmdOrgs = []cobra.Command{
 		Short: "Delete org",
 		Long:  `Delete org.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -95,10 +98,11 @@ var cmdOrgs = []cobra.Command{
 		},
 	},
 	{
-		Use:   "update <JSON_org> <org_id> <user_token>",
+		Use:   "update <JSON_org> [<org_id>] <user_token>",
 		Short: "Update org",
 		Long:  `Update org record`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 1, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -119,10 +123,11 @@ var cmdOrgs = []cobra.Command{
 		},
 	},
 	{
-		Use:   "member <org_id> <member_id> <user_token>",
+		Use:   "member [<org_id>] <member_id> <user_token>",
 		Short: "View member",
 		Long:  `View member by specified org`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 0, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -136,10 +141,11 @@ var cmdOrgs = []cobra.Command{
 		},
 	},
 	{
-		Use:   "assign <JSON_members> <org_id> <user_token>",
+		Use:   "assign <JSON_members> [<org_id>] <user_token>",
 		Short: "Assign a member to org",
 		Long:  `Assign a member to org`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 1, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -160,10 +166,11 @@ var cmdOrgs = []cobra.Command{
 		},
 	},
 	{
-		Use:   "unassign <JSON_members> <org_id> <user_token>",
+		Use:   "unassign <JSON_members> [<org_id>] <user_token>",
 		Short: "Unassign a member from org",
 		Long:  `Unassign a member from org`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 1, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -184,10 +191,11 @@ var cmdOrgs = []cobra.Command{
 		},
 	},
 	{
-		Use:   "update-members <JSON_members> <org_id> <user_token>",
+		Use:   "update-members <JSON_members> [<org_id>] <user_token>",
 		Short: "Update members",
 		Long:  `Update members by org`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 1, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -208,10 +216,11 @@ var cmdOrgs = []cobra.Command{
 		},
 	},
 	{
-		Use:   "members <org_id> <user_token>",
+		Use:   "members [<org_id>] <user_token>",
 		Short: "Members by org",
 		Long:  `Lists members by org.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withOrg(args, 0, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -229,6 +238,7 @@ var cmdOrgs = []cobra.Command{
 		Short: "Orgs by member",
 		Long:  `Lists orgs by member.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
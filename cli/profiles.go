@@ -16,6 +16,7 @@ var cmdProfiles = []cobra.Command{
 		Short: "Create profile",
 		Long:  `Creates new profile and generates it's UUID`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -45,7 +46,7 @@ var cmdProfiles = []cobra.Command{
 		<by-id> - shows profile with provided <id>`,
 
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) != 2 || len(args) != 3 {
+			if len(args) != 2 && len(args) != 3 {
 				logUsage(cmd.Use)
 				return
 			}
@@ -100,6 +101,7 @@ var cmdProfiles = []cobra.Command{
 		Short: "Update profile",
 		Long:  `Updates profile record`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -124,6 +126,7 @@ var cmdProfiles = []cobra.Command{
 		Short: "Delete profile",
 		Long:  `Delete profile by ID`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -16,6 +16,7 @@ var cmdThings = []cobra.Command{
 		Short: "Create thing",
 		Long:  `Create new thing, generate his UUID and store it`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -44,7 +45,7 @@ var cmdThings = []cobra.Command{
 		<profile> - list things by profile based on defined <id>
 		<by-id> - shows thing with provided <id>`,
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) != 2 || len(args) != 3 {
+			if len(args) != 2 && len(args) != 3 {
 				logUsage(cmd.Use)
 				return
 			}
@@ -118,6 +119,7 @@ var cmdThings = []cobra.Command{
 		Short: "Delete thing",
 		Long:  `Removes thing from database`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -155,6 +157,7 @@ var cmdThings = []cobra.Command{
 		Short: "Update thing",
 		Long:  `Update thing record`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
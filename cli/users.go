@@ -44,6 +44,7 @@ var cmdUsers = []cobra.Command{
 		all - lists all users
 		<user_id> - shows user with provided <user_id>`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -129,6 +130,7 @@ var cmdUsers = []cobra.Command{
 		Short: "Update user",
 		Long:  `Update user metadata`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 2)
 			if len(args) != 2 {
 				logUsage(cmd.Use)
 				return
@@ -153,6 +155,7 @@ var cmdUsers = []cobra.Command{
 		Short: "Update password",
 		Long:  `Update user password`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -13,6 +13,7 @@ var cmdWebhooks = []cobra.Command{
 		Short: "Create webhooks",
 		Long:  `Create webhooks for certain group.`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -37,6 +38,7 @@ var cmdWebhooks = []cobra.Command{
 		<group> - lists all webhooks by group by provided <id>
 		<by-id> - shows webhook by provided <id>`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -65,6 +67,7 @@ var cmdWebhooks = []cobra.Command{
 		Short: "Update webhook by id",
 		Long:  `Update webhook record`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -89,6 +92,7 @@ var cmdWebhooks = []cobra.Command{
 		Short: "Delete webhooks",
 		Long:  `Delete webhooks by provided IDs`,
 		Run: func(cmd *cobra.Command, args []string) {
+			args = withToken(args, 3)
 			if len(args) != 3 {
 				logUsage(cmd.Use)
 				return
@@ -0,0 +1,313 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies/api"
+	httpapi "github.com/MainfluxLabs/mainflux/consumers/anomalies/api/http"
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies/postgres"
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies/tracing"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/clients"
+	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/jmoiron/sqlx"
+	opentracing "github.com/opentracing/opentracing-go"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName              = "anomalies"
+	stopWaitTime         = 5 * time.Second
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defJaegerURL         = ""
+	defBrokerURL         = "nats://localhost:4222"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "anomalies"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defClientTLS         = "false"
+	defCACerts           = ""
+	defHTTPPort          = "9029"
+	defServerCert        = ""
+	defServerKey         = ""
+	defThingsGRPCURL     = "localhost:8183"
+	defThingsGRPCTimeout = "1s"
+	defESConsumerName    = "anomalies"
+	defESGroup           = "mainflux.anomalies"
+
+	envLogLevel          = "MF_ANOMALIES_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envBrokerURL         = "MF_BROKER_URL"
+	envDBHost            = "MF_ANOMALIES_DB_HOST"
+	envDBPort            = "MF_ANOMALIES_DB_PORT"
+	envDBUser            = "MF_ANOMALIES_DB_USER"
+	envDBPass            = "MF_ANOMALIES_DB_PASS"
+	envDB                = "MF_ANOMALIES_DB"
+	envDBSSLMode         = "MF_ANOMALIES_DB_SSL_MODE"
+	envDBSSLCert         = "MF_ANOMALIES_DB_SSL_CERT"
+	envDBSSLKey          = "MF_ANOMALIES_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_ANOMALIES_DB_SSL_ROOT_CERT"
+	envClientTLS         = "MF_ANOMALIES_CLIENT_TLS"
+	envCACerts           = "MF_ANOMALIES_CA_CERTS"
+	envHTTPPort          = "MF_ANOMALIES_HTTP_PORT"
+	envServerCert        = "MF_ANOMALIES_SERVER_CERT"
+	envServerKey         = "MF_ANOMALIES_SERVER_KEY"
+	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
+	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envESPrefix          = "MF_ANOMALIES"
+)
+
+type config struct {
+	brokerURL         string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	thingsConfig      clients.Config
+	jaegerURL         string
+	thingsGRPCTimeout time.Duration
+	esConfig          events.Config
+}
+
+func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer pubSub.Close()
+
+	anomaliesTracer, anomaliesCloser := jaeger.Init(svcName, cfg.jaegerURL, logger)
+	defer anomaliesCloser.Close()
+
+	db := connectToDB(cfg.dbConfig, logger)
+	defer db.Close()
+
+	thingsTracer, thingsCloser := jaeger.Init("anomalies_things", cfg.jaegerURL, logger)
+	defer thingsCloser.Close()
+
+	thingsConn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
+	defer thingsConn.Close()
+
+	things := thingsapi.NewClient(thingsConn, thingsTracer, cfg.thingsGRPCTimeout)
+
+	dbTracer, dbCloser := jaeger.Init("anomalies_db", cfg.jaegerURL, logger)
+	defer dbCloser.Close()
+
+	svc := newService(things, pubSub, dbTracer, db, logger)
+
+	if err = consumers.Start(svcName, pubSub, svc, brokers.SubjectSenML); err != nil {
+		logger.Error(fmt.Sprintf("Failed to create anomalies consumer: %s", err))
+	}
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, httpapi.MakeHandler(anomaliesTracer, svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	g.Go(func() error {
+		return events.SubscribeGroupRemovals(ctx, cfg.esConfig, defESGroup, svc.RemoveDetectorsByGroup, logger.Module("events"))
+	})
+
+	g.Go(func() error {
+		if sig := errors.SignalHandler(ctx); sig != nil {
+			cancel()
+			logger.Info(fmt.Sprintf("Anomalies service shutdown by signal: %s", sig))
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Anomalies service terminated: %s", err))
+	}
+}
+
+func loadConfig() config {
+	tls, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envClientTLS)
+	}
+
+	thingsGRPCTimeout, err := time.ParseDuration(mainflux.Env(envThingsGRPCTimeout, defThingsGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
+	dbConfig := postgres.Config{
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	httpConfig := servers.Config{
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	thingsConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envThingsGRPCURL, defThingsGRPCURL),
+		ClientName: clients.Things,
+	}
+
+	esConfig, err := events.LoadConfig(envESPrefix, defESConsumerName)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	return config{
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		thingsConfig:      thingsConfig,
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		thingsGRPCTimeout: thingsGRPCTimeout,
+		esConfig:          esConfig,
+	}
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
+func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
+	db, err := postgres.Connect(dbConfig)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to postgres: %s", err))
+		os.Exit(1)
+	}
+	return db
+}
+
+func newService(ts protomfx.ThingsServiceClient, publisher messaging.Publisher, dbTracer opentracing.Tracer, db *sqlx.DB, logger logger.Logger) anomalies.Service {
+	database := postgres.NewDatabase(db)
+
+	detectorsRepo := postgres.NewDetectorRepository(database)
+	detectorsRepo = tracing.DetectorRepositoryMiddleware(dbTracer, detectorsRepo)
+
+	idProvider := uuid.New()
+
+	svc := anomalies.New(ts, detectorsRepo, publisher, idProvider)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(
+		svc,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "anomalies",
+			Subsystem: "api",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, []string{"method"}),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "anomalies",
+			Subsystem: "api",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, []string{"method"}),
+	)
+
+	return svc
+}
@@ -6,20 +6,29 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux"
 	"github.com/MainfluxLabs/mainflux/auth"
 	api "github.com/MainfluxLabs/mainflux/auth/api"
 	httpapi "github.com/MainfluxLabs/mainflux/auth/api/http"
+	"github.com/MainfluxLabs/mainflux/auth/emailer"
 	"github.com/MainfluxLabs/mainflux/auth/jwt"
+	"github.com/MainfluxLabs/mainflux/auth/monitor"
 	"github.com/MainfluxLabs/mainflux/auth/postgres"
+	rediscache "github.com/MainfluxLabs/mainflux/auth/redis"
 	"github.com/MainfluxLabs/mainflux/auth/tracing"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/email"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	jobspostgres "github.com/MainfluxLabs/mainflux/pkg/jobs/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	serversgrpc "github.com/MainfluxLabs/mainflux/pkg/servers/grpc"
@@ -28,6 +37,7 @@ import (
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
 	usersapi "github.com/MainfluxLabs/mainflux/users/api/grpc"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	"github.com/jmoiron/sqlx"
 	"github.com/opentracing/opentracing-go"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
@@ -38,78 +48,159 @@ const (
 	stopWaitTime = 5 * time.Second
 	svcName      = "auth"
 
-	defLogLevel        = "error"
-	defDBHost          = "localhost"
-	defDBPort          = "5432"
-	defDBUser          = "mainflux"
-	defDBPass          = "mainflux"
-	defDB              = "auth"
-	defDBSSLMode       = "disable"
-	defDBSSLCert       = ""
-	defDBSSLKey        = ""
-	defDBSSLRootCert   = ""
-	defHTTPPort        = "8180"
-	defGRPCPort        = "8181"
-	defSecret          = "auth"
-	defServerCert      = ""
-	defServerKey       = ""
-	defJaegerURL       = ""
-	defLoginDuration   = "10h"
-	defAdminEmail      = ""
-	defTimeout         = "1s"
-	defThingsGRPCURL   = "localhost:8183"
-	defThingsCACerts   = ""
-	defThingsClientTLS = "false"
-	defUsersCACerts    = ""
-	defUsersClientTLS  = "false"
-	defUsersGRPCURL    = "localhost:8184"
-
-	envLogLevel        = "MF_AUTH_LOG_LEVEL"
-	envDBHost          = "MF_AUTH_DB_HOST"
-	envDBPort          = "MF_AUTH_DB_PORT"
-	envDBUser          = "MF_AUTH_DB_USER"
-	envDBPass          = "MF_AUTH_DB_PASS"
-	envDB              = "MF_AUTH_DB"
-	envDBSSLMode       = "MF_AUTH_DB_SSL_MODE"
-	envDBSSLCert       = "MF_AUTH_DB_SSL_CERT"
-	envDBSSLKey        = "MF_AUTH_DB_SSL_KEY"
-	envDBSSLRootCert   = "MF_AUTH_DB_SSL_ROOT_CERT"
-	envHTTPPort        = "MF_AUTH_HTTP_PORT"
-	envGRPCPort        = "MF_AUTH_GRPC_PORT"
-	envTimeout         = "MF_AUTH_GRPC_TIMEOUT"
-	envSecret          = "MF_AUTH_SECRET"
-	envServerCert      = "MF_AUTH_SERVER_CERT"
-	envServerKey       = "MF_AUTH_SERVER_KEY"
-	envJaegerURL       = "MF_JAEGER_URL"
-	envLoginDuration   = "MF_AUTH_LOGIN_TOKEN_DURATION"
-	envAdminEmail      = "MF_USERS_ADMIN_EMAIL"
-	envThingsGRPCURL   = "MF_THINGS_AUTH_GRPC_URL"
-	envThingsCACerts   = "MF_THINGS_CA_CERTS"
-	envThingsClientTLS = "MF_THINGS_CLIENT_TLS"
-	envUsersGRPCURL    = "MF_USERS_GRPC_URL"
-	envUsersCACerts    = "MF_USERS_CA_CERTS"
-	envUsersClientTLS  = "MF_USERS_CLIENT_TLS"
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "auth"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defHTTPPort          = "8180"
+	defGRPCPort          = "8181"
+	defSecret            = "auth"
+	defServerCert        = ""
+	defServerKey         = ""
+	defJaegerURL         = ""
+	defLoginDuration     = "10h"
+	defAdminEmail        = ""
+	defTimeout           = "1s"
+	defThingsGRPCURL     = "localhost:8183"
+	defThingsCACerts     = ""
+	defThingsClientTLS   = "false"
+	defUsersCACerts      = ""
+	defUsersClientTLS    = "false"
+	defUsersGRPCURL      = "localhost:8184"
+	defRateLimit         = "0"
+	defRateLimitBurst    = "0"
+	defRateLimitURL      = "localhost:6379"
+	defRateLimitPass     = ""
+	defRateLimitDB       = "0"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defMonitorBrokerURL  = "http://localhost:8222"
+	defMonitorStreams    = "mainflux.auth,mainflux.things,mainflux.certs"
+	defMonitorServices   = "users=http://localhost:8180,things=http://localhost:8182"
+	defExpiryScan        = "1h"
+	defExpiryNotice      = "72h"
+	defEmailHost         = "localhost"
+	defEmailPort         = "25"
+	defEmailUsername     = "root"
+	defEmailPassword     = ""
+	defEmailFromAddress  = ""
+	defEmailFromName     = ""
+	defEmailTemplate     = "email.tmpl"
+	defEncryptionKey     = ""
+	defSigningSecret     = ""
+
+	envLogLevel          = "MF_AUTH_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envDBHost            = "MF_AUTH_DB_HOST"
+	envDBPort            = "MF_AUTH_DB_PORT"
+	envDBUser            = "MF_AUTH_DB_USER"
+	envDBPass            = "MF_AUTH_DB_PASS"
+	envDB                = "MF_AUTH_DB"
+	envDBSSLMode         = "MF_AUTH_DB_SSL_MODE"
+	envDBSSLCert         = "MF_AUTH_DB_SSL_CERT"
+	envDBSSLKey          = "MF_AUTH_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_AUTH_DB_SSL_ROOT_CERT"
+	envHTTPPort          = "MF_AUTH_HTTP_PORT"
+	envGRPCPort          = "MF_AUTH_GRPC_PORT"
+	envTimeout           = "MF_AUTH_GRPC_TIMEOUT"
+	envSecret            = "MF_AUTH_SECRET"
+	envServerCert        = "MF_AUTH_SERVER_CERT"
+	envServerKey         = "MF_AUTH_SERVER_KEY"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envLoginDuration     = "MF_AUTH_LOGIN_TOKEN_DURATION"
+	envAdminEmail        = "MF_USERS_ADMIN_EMAIL"
+	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
+	envThingsCACerts     = "MF_THINGS_CA_CERTS"
+	envThingsClientTLS   = "MF_THINGS_CLIENT_TLS"
+	envUsersGRPCURL      = "MF_USERS_GRPC_URL"
+	envUsersCACerts      = "MF_USERS_CA_CERTS"
+	envUsersClientTLS    = "MF_USERS_CLIENT_TLS"
+	envRateLimit         = "MF_AUTH_RATE_LIMIT"
+	envRateLimitBurst    = "MF_AUTH_RATE_LIMIT_BURST"
+	envRateLimitURL      = "MF_AUTH_RATE_LIMIT_URL"
+	envRateLimitPass     = "MF_AUTH_RATE_LIMIT_PASS"
+	envRateLimitDB       = "MF_AUTH_RATE_LIMIT_DB"
+	envESURL             = "MF_AUTH_ES_URL"
+	envESPass            = "MF_AUTH_ES_PASS"
+	envESDB              = "MF_AUTH_ES_DB"
+	envMonitorBrokerURL  = "MF_AUTH_MONITOR_BROKER_URL"
+	envMonitorStreams    = "MF_AUTH_MONITOR_STREAMS"
+	envMonitorServices   = "MF_AUTH_MONITOR_SERVICES"
+	envExpiryScan        = "MF_AUTH_MEMBER_EXPIRY_SCAN_INTERVAL"
+	envExpiryNotice      = "MF_AUTH_MEMBER_EXPIRY_NOTICE"
+	envEmailHost         = "MF_EMAIL_HOST"
+	envEmailPort         = "MF_EMAIL_PORT"
+	envEmailUsername     = "MF_EMAIL_USERNAME"
+	envEmailPassword     = "MF_EMAIL_PASSWORD"
+	envEmailFromAddress  = "MF_EMAIL_FROM_ADDRESS"
+	envEmailFromName     = "MF_EMAIL_FROM_NAME"
+	envEmailTemplate     = "MF_EMAIL_TEMPLATE"
+	envEncryptionKey     = "MF_AUTH_ENCRYPTION_KEY"
+	envSigningSecret     = "MF_AUTH_SIGNING_SECRET"
 )
 
 type config struct {
-	logLevel      string
-	dbConfig      postgres.Config
-	httpConfig    servers.Config
-	grpcConfig    servers.Config
-	thingsConfig  clients.Config
-	usersConfig   clients.Config
-	secret        string
-	jaegerURL     string
-	loginDuration time.Duration
-	timeout       time.Duration
-	adminEmail    string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	grpcConfig        servers.Config
+	thingsConfig      clients.Config
+	usersConfig       clients.Config
+	secret            string
+	jaegerURL         string
+	loginDuration     time.Duration
+	timeout           time.Duration
+	adminEmail        string
+	rateLimitConfig   servers.RateLimitConfig
+	rateLimitURL      string
+	rateLimitPass     string
+	rateLimitDB       string
+	esURL             string
+	esPass            string
+	esDB              string
+	monitorConfig     monitor.Config
+	expirySchedule    jobs.Schedule
+	expiryNotice      time.Duration
+	emailConf         email.Config
+	encryptionKey     string
+	signingSecret     string
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
@@ -126,7 +217,7 @@ func main() {
 	dbTracer, dbCloser := jaeger.Init("auth_db", cfg.jaegerURL, logger)
 	defer dbCloser.Close()
 
-	usrConn := clientsgrpc.Connect(cfg.usersConfig, logger)
+	usrConn := clientsgrpc.Connect(cfg.usersConfig, logger.Module("users"))
 	defer usrConn.Close()
 
 	usersTracer, usersCloser := jaeger.Init("auth_users", cfg.jaegerURL, logger)
@@ -134,7 +225,7 @@ func main() {
 
 	uc := usersapi.NewClient(usrConn, usersTracer, cfg.timeout)
 
-	thConn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	thConn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer thConn.Close()
 
 	thingsTracer, thingsCloser := jaeger.Init("auth_things", cfg.jaegerURL, logger)
@@ -142,15 +233,44 @@ func main() {
 
 	tc := thingsapi.NewClient(thConn, thingsTracer, cfg.timeout)
 
-	svc := newService(db, tc, uc, dbTracer, cfg.secret, logger, cfg.loginDuration)
+	esClient := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
+	defer esClient.Close()
+
+	svc := newService(db, tc, uc, esClient, cfg, dbTracer, logger)
+
+	handler := httpapi.MakeHandler(svc, authHttpTracer, logger.Module("http"))
+	rlClient := connectToRedis(cfg.rateLimitURL, cfg.rateLimitPass, cfg.rateLimitDB, logger)
+	defer rlClient.Close()
+	limiter := servershttp.NewLimiter(rlClient, svcName, cfg.rateLimitConfig)
+	handler = servershttp.RateLimit(handler, limiter, svcName, logger)
+	tunables := []mfconfig.Tunable{
+		mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides),
+		mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout),
+		mfconfig.RateLimit(limiter, envRateLimit, defRateLimit, envRateLimitBurst, defRateLimitBurst),
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, tunables...)
+	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, httpapi.MakeHandler(svc, authHttpTracer, logger), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, handler, cfg.httpConfig, logger)
 	})
 	g.Go(func() error {
 		return serversgrpc.Start(ctx, authGrpcTracer, svc, cfg.grpcConfig, logger)
 	})
 
+	counter, latency := jobs.NewMetrics(svcName)
+	scheduler := jobs.New(jobspostgres.NewLocker(db), nil, uuid.New(), counter, latency, logger)
+	scheduler.Schedule("expire_members", cfg.expirySchedule, func(ctx context.Context, now time.Time) error {
+		_, err := svc.ExpireMembers(ctx, now, cfg.expiryNotice)
+		return err
+	})
+
+	g.Go(func() error {
+		return scheduler.Run(ctx)
+	})
+
 	g.Go(func() error {
 		if sig := errors.SignalHandler(ctx); sig != nil {
 			cancel()
@@ -164,24 +284,42 @@ func main() {
 }
 
 func loadConfig() config {
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envHTTPPort, defHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	grpcConfig := servers.Config{
@@ -216,6 +354,16 @@ func loadConfig() config {
 		ClientName: clients.Users,
 	}
 
+	rateLimit, err := strconv.ParseFloat(mainflux.Env(envRateLimit, defRateLimit), 64)
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimit, err.Error())
+	}
+
+	rateLimitBurst, err := strconv.Atoi(mainflux.Env(envRateLimitBurst, defRateLimitBurst))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimitBurst, err.Error())
+	}
+
 	loginDuration, err := time.ParseDuration(mainflux.Env(envLoginDuration, defLoginDuration))
 	if err != nil {
 		log.Fatal(err)
@@ -226,20 +374,113 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envTimeout, err.Error())
 	}
 
+	var streams []string
+	for _, s := range strings.Split(mainflux.Env(envMonitorStreams, defMonitorStreams), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			streams = append(streams, s)
+		}
+	}
+
+	services := make(map[string]string)
+	for _, s := range strings.Split(mainflux.Env(envMonitorServices, defMonitorServices), ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		name, url, ok := strings.Cut(s, "=")
+		if !ok || name == "" || url == "" {
+			log.Fatalf("Invalid %s value: %s", envMonitorServices, s)
+		}
+		services[name] = url
+	}
+
+	expirySchedule, err := jobs.ParseSchedule(mainflux.Env(envExpiryScan, defExpiryScan))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envExpiryScan)
+	}
+
+	expiryNotice, err := time.ParseDuration(mainflux.Env(envExpiryNotice, defExpiryNotice))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envExpiryNotice)
+	}
+
+	monitorConfig := monitor.Config{
+		BrokerURL: mainflux.Env(envMonitorBrokerURL, defMonitorBrokerURL),
+		Streams:   streams,
+		Services:  services,
+		Timeout:   timeout,
+	}
+
+	emailConf := email.Config{
+		FromAddress: mainflux.Env(envEmailFromAddress, defEmailFromAddress),
+		FromName:    mainflux.Env(envEmailFromName, defEmailFromName),
+		Host:        mainflux.Env(envEmailHost, defEmailHost),
+		Port:        mainflux.Env(envEmailPort, defEmailPort),
+		Username:    mainflux.Env(envEmailUsername, defEmailUsername),
+		Password:    mainflux.Env(envEmailPassword, defEmailPassword),
+		Template:    mainflux.Env(envEmailTemplate, defEmailTemplate),
+	}
+
+	encryptionKey := mainflux.Env(envEncryptionKey, defEncryptionKey)
+	signingSecret := mainflux.Env(envSigningSecret, defSigningSecret)
+	if signingSecret != "" && encryptionKey == "" {
+		log.Fatalf("%s must be set to encrypt the signing keys of the orgs\n", envEncryptionKey)
+	}
+
 	return config{
-		logLevel:      mainflux.Env(envLogLevel, defLogLevel),
-		dbConfig:      dbConfig,
-		httpConfig:    httpConfig,
-		grpcConfig:    grpcConfig,
-		thingsConfig:  thingsConfig,
-		usersConfig:   usersConfig,
-		secret:        mainflux.Env(envSecret, defSecret),
-		jaegerURL:     mainflux.Env(envJaegerURL, defJaegerURL),
-		loginDuration: loginDuration,
-		timeout:       timeout,
-		adminEmail:    mainflux.Env(envAdminEmail, defAdminEmail),
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		grpcConfig:        grpcConfig,
+		thingsConfig:      thingsConfig,
+		usersConfig:       usersConfig,
+		secret:            mainflux.Env(envSecret, defSecret),
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		loginDuration:     loginDuration,
+		timeout:           timeout,
+		adminEmail:        mainflux.Env(envAdminEmail, defAdminEmail),
+		rateLimitConfig:   servers.RateLimitConfig{Rate: rateLimit, Burst: rateLimitBurst},
+		rateLimitURL:      mainflux.Env(envRateLimitURL, defRateLimitURL),
+		rateLimitPass:     mainflux.Env(envRateLimitPass, defRateLimitPass),
+		rateLimitDB:       mainflux.Env(envRateLimitDB, defRateLimitDB),
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		monitorConfig:     monitorConfig,
+		expirySchedule:    expirySchedule,
+		expiryNotice:      expiryNotice,
+		emailConf:         emailConf,
+		encryptionKey:     encryptionKey,
+		signingSecret:     signingSecret,
+	}
+
+}
+
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
 	}
+	defer db.Close()
 
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
 }
 
 func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
@@ -252,7 +493,7 @@ func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(db *sqlx.DB, tc protomfx.ThingsServiceClient, uc protomfx.UsersServiceClient, tracer opentracing.Tracer, secret string, logger logger.Logger, duration time.Duration) auth.Service {
+func newService(db *sqlx.DB, tc protomfx.ThingsServiceClient, uc protomfx.UsersServiceClient, esClient *redis.Client, cfg config, tracer opentracing.Tracer, logger logger.Logger) auth.Service {
 	orgsRepo := postgres.NewOrgRepo(db)
 	orgsRepo = tracing.OrgRepositoryMiddleware(tracer, orgsRepo)
 
@@ -265,11 +506,30 @@ func newService(db *sqlx.DB, tc protomfx.ThingsServiceClient, uc protomfx.UsersS
 	membsRepo := postgres.NewMembersRepo(db)
 	membsRepo = tracing.MembersRepositoryMiddleware(tracer, membsRepo)
 
+	signingKeysRepo, err := postgres.NewSigningKeyRepo(db, cfg.encryptionKey)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to create signing key repository: %s", err))
+		os.Exit(1)
+	}
+	signingKeysRepo = tracing.SigningKeyRepositoryMiddleware(tracer, signingKeysRepo)
+
+	quotasRepo := postgres.NewQuotaRepo(database)
+	quotasRepo = tracing.QuotaRepositoryMiddleware(tracer, quotasRepo)
+
 	idProvider := uuid.New()
-	t := jwt.New(secret)
+	t := jwt.New(cfg.secret)
+
+	m := monitor.New(cfg.monitorConfig, esClient)
+	activityRepo := rediscache.NewActivityRepository(esClient)
+
+	emailer, err := emailer.New(&cfg.emailConf)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to configure e-mailing util: %s", err.Error()))
+	}
 
-	svc := auth.New(orgsRepo, tc, uc, keysRepo, rolesRepo, membsRepo, idProvider, t, duration)
-	svc = api.LoggingMiddleware(svc, logger)
+	svc := auth.New(orgsRepo, tc, uc, m, emailer, keysRepo, rolesRepo, membsRepo, signingKeysRepo, activityRepo, quotasRepo, idProvider, t, cfg.loginDuration, cfg.signingSecret)
+	svc = rediscache.NewEventStoreMiddleware(svc, esClient)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -11,6 +11,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux"
@@ -19,16 +20,26 @@ import (
 	"github.com/MainfluxLabs/mainflux/certs/api"
 	vault "github.com/MainfluxLabs/mainflux/certs/pki"
 	"github.com/MainfluxLabs/mainflux/certs/postgres"
+	rediscache "github.com/MainfluxLabs/mainflux/certs/redis"
+	rediscons "github.com/MainfluxLabs/mainflux/certs/redis/consumer"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	jobspostgres "github.com/MainfluxLabs/mainflux/pkg/jobs/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	mfsdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	"github.com/jmoiron/sqlx"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
@@ -38,26 +49,38 @@ const (
 	stopWaitTime = 5 * time.Second
 	svcName      = "certs"
 
-	defLogLevel        = "error"
-	defDBHost          = "localhost"
-	defDBPort          = "5432"
-	defDBUser          = "mainflux"
-	defDBPass          = "mainflux"
-	defDB              = "certs"
-	defDBSSLMode       = "disable"
-	defDBSSLCert       = ""
-	defDBSSLKey        = ""
-	defDBSSLRootCert   = ""
-	defClientTLS       = "false"
-	defCACerts         = ""
-	defPort            = "8204"
-	defServerCert      = ""
-	defServerKey       = ""
-	defCertsURL        = "http://localhost"
-	defThingsURL       = "http://things:8182"
-	defJaegerURL       = ""
-	defAuthGRPCURL     = "localhost:8181"
-	defAuthGRPCTimeout = "1s"
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "certs"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defClientTLS         = "false"
+	defCACerts           = ""
+	defPort              = "8204"
+	defServerCert        = ""
+	defServerKey         = ""
+	defCertsURL          = "http://localhost"
+	defThingsURL         = "http://things:8182"
+	defJaegerURL         = ""
+	defAuthGRPCURL       = "localhost:8181"
+	defAuthGRPCTimeout   = "1s"
+	defBrokerURL         = "nats://localhost:4222"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defESConsumerName    = "certs"
+
+	defExpiryScanInterval = "1h"
+	defExpiryThresholds   = "30,7,1"
 
 	defSignCAPath     = "ca.crt"
 	defSignCAKeyPath  = "ca.key"
@@ -69,30 +92,42 @@ const (
 	defVaultToken      = ""
 	defVaultPKIIntPath = "pki_int"
 
-	envPort            = "MF_CERTS_HTTP_PORT"
-	envLogLevel        = "MF_CERTS_LOG_LEVEL"
-	envDBHost          = "MF_CERTS_DB_HOST"
-	envDBPort          = "MF_CERTS_DB_PORT"
-	envDBUser          = "MF_CERTS_DB_USER"
-	envDBPass          = "MF_CERTS_DB_PASS"
-	envDB              = "MF_CERTS_DB"
-	envDBSSLMode       = "MF_CERTS_DB_SSL_MODE"
-	envDBSSLCert       = "MF_CERTS_DB_SSL_CERT"
-	envDBSSLKey        = "MF_CERTS_DB_SSL_KEY"
-	envDBSSLRootCert   = "MF_CERTS_DB_SSL_ROOT_CERT"
-	envClientTLS       = "MF_CERTS_CLIENT_TLS"
-	envCACerts         = "MF_CERTS_CA_CERTS"
-	envServerCert      = "MF_CERTS_SERVER_CERT"
-	envServerKey       = "MF_CERTS_SERVER_KEY"
-	envCertsURL        = "MF_SDK_CERTS_URL"
-	envJaegerURL       = "MF_JAEGER_URL"
-	envAuthGRPCURL     = "MF_AUTH_GRPC_URL"
-	envAuthGRPCTimeout = "MF_AUTH_GRPC_TIMEOUT"
-	envThingsURL       = "MF_THINGS_URL"
-	envSignCAPath      = "MF_CERTS_SIGN_CA_PATH"
-	envSignCAKey       = "MF_CERTS_SIGN_CA_KEY_PATH"
-	envSignHoursValid  = "MF_CERTS_SIGN_HOURS_VALID"
-	envSignRSABits     = "MF_CERTS_SIGN_RSA_BITS"
+	envPort              = "MF_CERTS_HTTP_PORT"
+	envLogLevel          = "MF_CERTS_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envDBHost            = "MF_CERTS_DB_HOST"
+	envDBPort            = "MF_CERTS_DB_PORT"
+	envDBUser            = "MF_CERTS_DB_USER"
+	envDBPass            = "MF_CERTS_DB_PASS"
+	envDB                = "MF_CERTS_DB"
+	envDBSSLMode         = "MF_CERTS_DB_SSL_MODE"
+	envDBSSLCert         = "MF_CERTS_DB_SSL_CERT"
+	envDBSSLKey          = "MF_CERTS_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_CERTS_DB_SSL_ROOT_CERT"
+	envClientTLS         = "MF_CERTS_CLIENT_TLS"
+	envCACerts           = "MF_CERTS_CA_CERTS"
+	envServerCert        = "MF_CERTS_SERVER_CERT"
+	envServerKey         = "MF_CERTS_SERVER_KEY"
+	envCertsURL          = "MF_SDK_CERTS_URL"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
+	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envThingsURL         = "MF_THINGS_URL"
+	envSignCAPath        = "MF_CERTS_SIGN_CA_PATH"
+	envSignCAKey         = "MF_CERTS_SIGN_CA_KEY_PATH"
+	envSignHoursValid    = "MF_CERTS_SIGN_HOURS_VALID"
+	envSignRSABits       = "MF_CERTS_SIGN_RSA_BITS"
+	envBrokerURL         = "MF_BROKER_URL"
+	envESURL             = "MF_CERTS_ES_URL"
+	envESPass            = "MF_CERTS_ES_PASS"
+	envESDB              = "MF_CERTS_ES_DB"
+	envESConsumerName    = "MF_CERTS_EVENT_CONSUMER"
+
+	envExpiryScanInterval = "MF_CERTS_EXPIRY_SCAN_INTERVAL"
+	envExpiryThresholds   = "MF_CERTS_EXPIRY_THRESHOLDS"
 
 	envVaultHost       = "MF_CERTS_VAULT_HOST"
 	envVaultPKIIntPath = "MF_VAULT_PKI_INT_PATH"
@@ -108,14 +143,24 @@ var (
 )
 
 type config struct {
-	logLevel        string
-	dbConfig        postgres.Config
-	httpConfig      servers.Config
-	authConfig      clients.Config
-	certsURL        string
-	thingsURL       string
-	jaegerURL       string
-	authGRPCTimeout time.Duration
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	authConfig        clients.Config
+	certsURL          string
+	thingsURL         string
+	jaegerURL         string
+	authGRPCTimeout   time.Duration
+	brokerURL         string
+	esURL             string
+	esPass            string
+	esDB              string
+	esConsumerName    string
+	// Expiry notifications settings
+	expiryScanSchedule jobs.Schedule
+	expiryThresholds   []uint
 	// Sign and issue certificates without 3rd party PKI
 	signCAPath     string
 	signCAKeyPath  string
@@ -129,15 +174,33 @@ type config struct {
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
 	tlsCert, caCert, err := loadCertificates(cfg)
 	if err != nil {
 		logger.Error("Failed to load CA certificates for issuing client certs")
@@ -158,15 +221,39 @@ func main() {
 	authTracer, authCloser := jaeger.Init("certs_auth", cfg.jaegerURL, logger)
 	defer authCloser.Close()
 
-	authConn := clientsgrpc.Connect(cfg.authConfig, logger)
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
 	defer authConn.Close()
 
 	auth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
 
-	svc := newService(auth, db, logger, tlsCert, caCert, cfg, pkiClient)
+	publisher, err := brokers.NewPublisher(cfg.brokerURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer publisher.Close()
+
+	esClient := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
+	defer esClient.Close()
+
+	svc := newService(auth, publisher, db, esClient, logger, tlsCert, caCert, cfg, pkiClient)
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, api.MakeHandler(svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	counter, latency := jobs.NewMetrics(svcName)
+	scheduler := jobs.New(jobspostgres.NewLocker(db), nil, uuid.New(), counter, latency, logger)
+	// The errors are logged by the logging middleware, and the failed
+	// notifications are sent again on the next run.
+	scheduler.Schedule("notify_expiring_certs", cfg.expiryScanSchedule, svc.NotifyExpiring)
+
+	g.Go(func() error {
+		return scheduler.Run(ctx)
+	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, api.MakeHandler(svc, logger), cfg.httpConfig, logger)
+		return subscribeToThingsES(ctx, svc, esClient, cfg.esConsumerName, logger)
 	})
 
 	g.Go(func() error {
@@ -187,24 +274,42 @@ func loadConfig() config {
 	if err != nil {
 		tls = false
 	}
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	authGRPCTimeout, err := time.ParseDuration(mainflux.Env(envAuthGRPCTimeout, defAuthGRPCTimeout))
@@ -224,15 +329,35 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envSignRSABits, err.Error())
 	}
 
+	expiryScanSchedule, err := jobs.ParseSchedule(mainflux.Env(envExpiryScanInterval, defExpiryScanInterval))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envExpiryScanInterval)
+	}
+
+	expiryThresholds, err := parseThresholds(mainflux.Env(envExpiryThresholds, defExpiryThresholds))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envExpiryThresholds)
+	}
+
 	return config{
-		logLevel:        mainflux.Env(envLogLevel, defLogLevel),
-		dbConfig:        dbConfig,
-		httpConfig:      httpConfig,
-		authConfig:      authConfig,
-		certsURL:        mainflux.Env(envCertsURL, defCertsURL),
-		thingsURL:       mainflux.Env(envThingsURL, defThingsURL),
-		jaegerURL:       mainflux.Env(envJaegerURL, defJaegerURL),
-		authGRPCTimeout: authGRPCTimeout,
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		authConfig:        authConfig,
+		certsURL:          mainflux.Env(envCertsURL, defCertsURL),
+		thingsURL:         mainflux.Env(envThingsURL, defThingsURL),
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		authGRPCTimeout:   authGRPCTimeout,
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		esConsumerName:    mainflux.Env(envESConsumerName, defESConsumerName),
+
+		expiryScanSchedule: expiryScanSchedule,
+		expiryThresholds:   expiryThresholds,
 
 		signCAKeyPath:  mainflux.Env(envSignCAKey, defSignCAKeyPath),
 		signCAPath:     mainflux.Env(envSignCAPath, defSignCAPath),
@@ -247,6 +372,18 @@ func loadConfig() config {
 
 }
 
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
 func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	db, err := postgres.Connect(dbConfig)
 	if err != nil {
@@ -256,8 +393,23 @@ func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(ac protomfx.AuthServiceClient, db *sqlx.DB, logger logger.Logger, tlsCert tls.Certificate, x509Cert *x509.Certificate, cfg config, pkiAgent vault.Agent) certs.Service {
+func connectToRedis(esURL, esPass, esDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(esDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to event store: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     esURL,
+		Password: esPass,
+		DB:       db,
+	})
+}
+
+func newService(ac protomfx.AuthServiceClient, publisher messaging.Publisher, db *sqlx.DB, esClient *redis.Client, logger logger.Logger, tlsCert tls.Certificate, x509Cert *x509.Certificate, cfg config, pkiAgent vault.Agent) certs.Service {
 	certsRepo := postgres.NewRepository(db, logger)
+	expiryRepo := postgres.NewExpiryConfigRepository(db)
 
 	certsConfig := certs.Config{
 		LogLevel:       cfg.logLevel,
@@ -278,6 +430,8 @@ func newService(ac protomfx.AuthServiceClient, db *sqlx.DB, logger logger.Logger
 		PKIHost:        cfg.pkiHost,
 		PKIPath:        cfg.pkiPath,
 		PKIRole:        cfg.pkiRole,
+
+		ExpiryThresholds: cfg.expiryThresholds,
 	}
 
 	config := mfsdk.Config{
@@ -287,8 +441,9 @@ func newService(ac protomfx.AuthServiceClient, db *sqlx.DB, logger logger.Logger
 
 	sdk := mfsdk.NewSDK(config)
 
-	svc := certs.New(ac, certsRepo, sdk, certsConfig, pkiAgent)
-	svc = api.NewLoggingMiddleware(svc, logger)
+	svc := certs.New(ac, certsRepo, expiryRepo, sdk, certsConfig, pkiAgent, publisher)
+	svc = rediscache.NewEventStoreMiddleware(svc, esClient)
+	svc = api.NewLoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -307,6 +462,22 @@ func newService(ac protomfx.AuthServiceClient, db *sqlx.DB, logger logger.Logger
 	return svc
 }
 
+func parseThresholds(value string) ([]uint, error) {
+	var thresholds []uint
+	for _, v := range strings.Split(value, ",") {
+		t, err := strconv.ParseUint(strings.TrimSpace(v), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		if t == 0 {
+			return nil, errors.New("threshold must be positive")
+		}
+		thresholds = append(thresholds, uint(t))
+	}
+
+	return thresholds, nil
+}
+
 func loadCertificates(conf config) (tls.Certificate, *x509.Certificate, error) {
 	var tlsCert tls.Certificate
 	var caCert *x509.Certificate
@@ -345,3 +516,14 @@ func loadCertificates(conf config) (tls.Certificate, *x509.Certificate, error) {
 
 	return tlsCert, caCert, nil
 }
+
+func subscribeToThingsES(ctx context.Context, svc certs.Service, client *redis.Client, consumer string, logger logger.Logger) error {
+	eventStore := rediscons.NewEventStore(svc, client, consumer, logger.Module("events"))
+	logger.Info("Subscribed to Redis Event Store")
+	if err := eventStore.Subscribe(ctx); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to subscribe to things event store: %s", err))
+		return err
+	}
+
+	return nil
+}
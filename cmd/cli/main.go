@@ -34,6 +34,7 @@ func main() {
 		Use: "mainfluxlabs-cli",
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			cli.ParseConfig()
+			cli.ApplyContext(cmd, &sdkConf)
 
 			sdkConf.MsgContentType = sdk.ContentType(msgContentType)
 			s := sdk.NewSDK(sdkConf)
@@ -54,6 +55,7 @@ func main() {
 	provisionCmd := cli.NewProvisionCmd()
 	certsCmd := cli.NewCertsCmd()
 	keysCmd := cli.NewKeysCmd()
+	configCmd := cli.NewConfigCmd()
 
 	// Root Commands
 	rootCmd.AddCommand(healthCmd)
@@ -68,6 +70,7 @@ func main() {
 	rootCmd.AddCommand(provisionCmd)
 	rootCmd.AddCommand(certsCmd)
 	rootCmd.AddCommand(keysCmd)
+	rootCmd.AddCommand(configCmd)
 
 	// Root Flags
 	rootCmd.PersistentFlags().StringVarP(
@@ -118,6 +121,13 @@ func main() {
 		"HTTP adapter URL",
 	)
 
+	rootCmd.PersistentFlags().StringVar(
+		&sdkConf.APIVersion,
+		"api-version",
+		sdkConf.APIVersion,
+		"API version prefixing the request paths, such as v1",
+	)
+
 	rootCmd.PersistentFlags().StringVarP(
 		&msgContentType,
 		"content-type",
@@ -139,7 +149,7 @@ func main() {
 		"config",
 		"c",
 		cli.ConfigPath,
-		"Config path",
+		"Config path (defaults to ~/.mainflux/config)",
 	)
 
 	rootCmd.PersistentFlags().BoolVarP(
@@ -15,15 +15,19 @@ import (
 	"github.com/MainfluxLabs/mainflux/coap"
 	"github.com/MainfluxLabs/mainflux/coap/api"
 	logger "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	mfmetrics "github.com/MainfluxLabs/mainflux/pkg/metrics"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	gocoap "github.com/plgd-dev/go-coap/v2"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
@@ -36,20 +40,34 @@ const (
 	defPort              = "5683"
 	defBrokerURL         = "nats://localhost:4222"
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
 	defClientTLS         = "false"
 	defCACerts           = ""
 	defJaegerURL         = ""
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defCacheTTL          = "5m"
 
 	envPort              = "MF_COAP_ADAPTER_PORT"
 	envBrokerURL         = "MF_BROKER_URL"
 	envLogLevel          = "MF_COAP_ADAPTER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
 	envClientTLS         = "MF_COAP_ADAPTER_CLIENT_TLS"
 	envCACerts           = "MF_COAP_ADAPTER_CA_CERTS"
 	envJaegerURL         = "MF_JAEGER_URL"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envESURL             = "MF_COAP_ADAPTER_ES_URL"
+	envESPass            = "MF_COAP_ADAPTER_ES_PASS"
+	envESDB              = "MF_COAP_ADAPTER_ES_DB"
+	envCacheTTL          = "MF_COAP_ADAPTER_CACHE_TTL"
 )
 
 type config struct {
@@ -57,38 +75,75 @@ type config struct {
 	thingsConfig      clients.Config
 	brokerURL         string
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	jaegerURL         string
 	thingsGRPCTimeout time.Duration
+	esURL             string
+	esPass            string
+	esDB              string
+	cacheTTL          time.Duration
+	tenantConfig      mfmetrics.TenantConfig
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	conn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.coapConfig.RequestTimeout))
+	})
+
+	conn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer conn.Close()
 
 	thingsTracer, thingsCloser := jaeger.Init("coap_things", cfg.jaegerURL, logger)
 	defer thingsCloser.Close()
 
-	tc := thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout)
+	esClient := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
+	defer esClient.Close()
+
+	tc := auth.NewThingsCache(thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout), cfg.cacheTTL)
+	g.Go(func() error {
+		return auth.SubscribeThingsEvents(ctx, tc, esClient, logger.Module("events"))
+	})
 
-	nps, err := brokers.NewPubSub(cfg.brokerURL, "", logger)
+	nps, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
 	}
 	defer nps.Close()
 
-	svc := coap.New(tc, nps)
+	nps = mfmetrics.TenantPubSub(
+		nps,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "coap_adapter",
+			Subsystem: "api",
+			Name:      "tenant_message_count",
+			Help:      "Number of messages published per tenant.",
+		}, mfmetrics.TenantLabelNames),
+		mfmetrics.NewTenants(cfg.tenantConfig),
+	)
+
+	svc := coap.New(tc, nps, auth.NewNetworkAuthorizer(esClient))
 
-	svc = api.LoggingMiddleware(svc, logger)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 
 	svc = api.MetricsMiddleware(
 		svc,
@@ -128,10 +183,22 @@ func main() {
 }
 
 func loadConfig() config {
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	coapConfig := servers.Config{
-		ServerName:   svcName,
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	tls, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
@@ -151,13 +218,30 @@ func loadConfig() config {
 		ClientName: clients.Things,
 	}
 
+	cacheTTL, err := time.ParseDuration(mainflux.Env(envCacheTTL, defCacheTTL))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envCacheTTL, err.Error())
+	}
+
+	tenantConfig, err := mfmetrics.LoadTenantConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	return config{
 		coapConfig:        coapConfig,
 		thingsConfig:      thingsConfig,
 		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		cacheTTL:          cacheTTL,
+		tenantConfig:      tenantConfig,
 	}
 }
 
@@ -176,3 +260,17 @@ func startCOAPServer(ctx context.Context, cfg config, svc coap.Service, l logger
 		return err
 	}
 }
+
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
@@ -0,0 +1,223 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	authapi "github.com/MainfluxLabs/mainflux/auth/api/grpc"
+	"github.com/MainfluxLabs/mainflux/exports"
+	"github.com/MainfluxLabs/mainflux/exports/api"
+	httpapi "github.com/MainfluxLabs/mainflux/exports/api/http"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/clients"
+	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName      = "exports"
+	stopWaitTime = 5 * time.Second
+
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defJaegerURL         = ""
+	defClientTLS         = "false"
+	defCACerts           = ""
+	defHTTPPort          = "9032"
+	defServerCert        = ""
+	defServerKey         = ""
+	defAuthGRPCURL       = "localhost:8181"
+	defAuthGRPCTimeout   = "1s"
+	defAuthURL           = "http://localhost:8180"
+	defThingsURL         = "http://localhost:8182"
+	defReaderURL         = "http://localhost:8905"
+	defPlatformTimeout   = "30s"
+	defTempDir           = ""
+
+	envLogLevel          = "MF_EXPORTS_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envClientTLS         = "MF_EXPORTS_CLIENT_TLS"
+	envCACerts           = "MF_EXPORTS_CA_CERTS"
+	envHTTPPort          = "MF_EXPORTS_HTTP_PORT"
+	envServerCert        = "MF_EXPORTS_SERVER_CERT"
+	envServerKey         = "MF_EXPORTS_SERVER_KEY"
+	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
+	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envAuthURL           = "MF_EXPORTS_AUTH_URL"
+	envThingsURL         = "MF_EXPORTS_THINGS_URL"
+	envReaderURL         = "MF_EXPORTS_READER_URL"
+	envPlatformTimeout   = "MF_EXPORTS_PLATFORM_TIMEOUT"
+	envTempDir           = "MF_EXPORTS_TEMP_DIR"
+)
+
+type config struct {
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	httpConfig        servers.Config
+	authConfig        clients.Config
+	jaegerURL         string
+	authGRPCTimeout   time.Duration
+	authURL           string
+	thingsURL         string
+	readerURL         string
+	platformTimeout   time.Duration
+	tempDir           string
+}
+
+func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	cfg := loadConfig()
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	exportsTracer, exportsCloser := jaeger.Init(svcName, cfg.jaegerURL, logger)
+	defer exportsCloser.Close()
+
+	authTracer, authCloser := jaeger.Init("exports_auth", cfg.jaegerURL, logger)
+	defer authCloser.Close()
+
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
+	defer authConn.Close()
+
+	auth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
+
+	svc := newService(auth, cfg, logger)
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, httpapi.MakeHandler(exportsTracer, svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	g.Go(func() error {
+		if sig := errors.SignalHandler(ctx); sig != nil {
+			cancel()
+			logger.Info(fmt.Sprintf("Exports service shutdown by signal: %s", sig))
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Exports service terminated: %s", err))
+	}
+}
+
+func loadConfig() config {
+	tls, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envClientTLS)
+	}
+
+	authGRPCTimeout, err := time.ParseDuration(mainflux.Env(envAuthGRPCTimeout, defAuthGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
+	}
+
+	platformTimeout, err := time.ParseDuration(mainflux.Env(envPlatformTimeout, defPlatformTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envPlatformTimeout, err.Error())
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	httpConfig := servers.Config{
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	authConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envAuthGRPCURL, defAuthGRPCURL),
+		ClientName: clients.Auth,
+	}
+
+	return config{
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		httpConfig:        httpConfig,
+		authConfig:        authConfig,
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		authGRPCTimeout:   authGRPCTimeout,
+		authURL:           mainflux.Env(envAuthURL, defAuthURL),
+		thingsURL:         mainflux.Env(envThingsURL, defThingsURL),
+		readerURL:         mainflux.Env(envReaderURL, defReaderURL),
+		platformTimeout:   platformTimeout,
+		tempDir:           mainflux.Env(envTempDir, defTempDir),
+	}
+}
+
+func newService(ac protomfx.AuthServiceClient, cfg config, logger logger.Logger) exports.Service {
+	platform := exports.NewPlatform(cfg.authURL, cfg.thingsURL, cfg.readerURL, cfg.platformTimeout)
+
+	svc := exports.New(ac, platform, cfg.tempDir)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(
+		svc,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "exports",
+			Subsystem: "api",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, []string{"method"}),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "exports",
+			Subsystem: "api",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, []string{"method"}),
+	)
+
+	return svc
+}
@@ -0,0 +1,285 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/federation"
+	"github.com/MainfluxLabs/mainflux/federation/api"
+	"github.com/MainfluxLabs/mainflux/federation/postgres"
+	"github.com/MainfluxLabs/mainflux/logger"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/nats"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/jmoiron/sqlx"
+	broker "github.com/nats-io/nats.go"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName      = "federation"
+	stopWaitTime = 5 * time.Second
+
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defBrokerURL         = "nats://localhost:4222"
+	defHTTPPort          = "9026"
+	defSubjects          = "senml.>,json.>"
+	defRemoteURL         = ""
+	defRemoteCACerts     = ""
+	defRemoteClientCert  = ""
+	defRemoteClientKey   = ""
+	defSkipMigrations    = "false"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "federation"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defRetryInterval     = "100ms"
+	defRetryMaxInterval  = "5s"
+
+	envLogLevel          = "MF_FEDERATION_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envBrokerURL         = "MF_BROKER_URL"
+	envHTTPPort          = "MF_FEDERATION_HTTP_PORT"
+	envSubjects          = "MF_FEDERATION_SUBJECTS"
+	envRemoteURL         = "MF_FEDERATION_REMOTE_URL"
+	envRemoteCACerts     = "MF_FEDERATION_REMOTE_CA_CERTS"
+	envRemoteClientCert  = "MF_FEDERATION_REMOTE_CLIENT_CERT"
+	envRemoteClientKey   = "MF_FEDERATION_REMOTE_CLIENT_KEY"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envDBHost            = "MF_FEDERATION_DB_HOST"
+	envDBPort            = "MF_FEDERATION_DB_PORT"
+	envDBUser            = "MF_FEDERATION_DB_USER"
+	envDBPass            = "MF_FEDERATION_DB_PASS"
+	envDB                = "MF_FEDERATION_DB"
+	envDBSSLMode         = "MF_FEDERATION_DB_SSL_MODE"
+	envDBSSLCert         = "MF_FEDERATION_DB_SSL_CERT"
+	envDBSSLKey          = "MF_FEDERATION_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_FEDERATION_DB_SSL_ROOT_CERT"
+	envRetryInterval     = "MF_FEDERATION_RETRY_INTERVAL"
+	envRetryMaxInterval  = "MF_FEDERATION_RETRY_MAX_INTERVAL"
+)
+
+type config struct {
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	brokerURL         string
+	httpConfig        servers.Config
+	subjects          []string
+	remoteURL         string
+	remoteCACerts     string
+	remoteClientCert  string
+	remoteClientKey   string
+	dbConfig          postgres.Config
+	retry             federation.RetryConfig
+}
+
+func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, svcName, logger.Module("messaging"))
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer pubSub.Close()
+
+	remote := connectToRemote(cfg, logger)
+	defer remote.Close()
+
+	db := connectToDB(cfg.dbConfig, logger)
+	defer db.Close()
+
+	outbox := postgres.NewOutboxRepository(postgres.NewDatabase(db))
+	f := federation.New(cfg.subjects, remote, outbox, cfg.retry, logger)
+	if err := f.Federate(svcName, pubSub); err != nil {
+		logger.Error(fmt.Sprintf("Failed to federate messages: %s", err))
+		os.Exit(1)
+	}
+
+	g.Go(func() error {
+		return f.Relay(ctx)
+	})
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, api.MakeHandler(svcName), cfg.httpConfig, logger.Module("http"))
+	})
+
+	g.Go(func() error {
+		if sig := errors.SignalHandler(ctx); sig != nil {
+			cancel()
+			logger.Info(fmt.Sprintf("Federation service shutdown by signal: %s", sig))
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Federation service terminated: %s", err))
+	}
+}
+
+func loadConfig() config {
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	httpConfig := servers.Config{
+		ServerName:     svcName,
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	var subjects []string
+	for _, s := range strings.Split(mainflux.Env(envSubjects, defSubjects), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			subjects = append(subjects, s)
+		}
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
+	dbConfig := postgres.Config{
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	retryInterval, err := time.ParseDuration(mainflux.Env(envRetryInterval, defRetryInterval))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRetryInterval, err.Error())
+	}
+
+	retryMaxInterval, err := time.ParseDuration(mainflux.Env(envRetryMaxInterval, defRetryMaxInterval))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRetryMaxInterval, err.Error())
+	}
+
+	return config{
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		httpConfig:        httpConfig,
+		subjects:          subjects,
+		remoteURL:         mainflux.Env(envRemoteURL, defRemoteURL),
+		remoteCACerts:     mainflux.Env(envRemoteCACerts, defRemoteCACerts),
+		remoteClientCert:  mainflux.Env(envRemoteClientCert, defRemoteClientCert),
+		remoteClientKey:   mainflux.Env(envRemoteClientKey, defRemoteClientKey),
+		dbConfig:          dbConfig,
+		retry: federation.RetryConfig{
+			Interval:    retryInterval,
+			MaxInterval: retryMaxInterval,
+		},
+	}
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
+func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
+	db, err := postgres.Connect(dbConfig)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to postgres: %s", err))
+		os.Exit(1)
+	}
+	return db
+}
+
+func connectToRemote(cfg config, logger logger.Logger) messaging.Publisher {
+	if cfg.remoteURL == "" {
+		logger.Error(fmt.Sprintf("Remote cluster URL is not set in %s", envRemoteURL))
+		os.Exit(1)
+	}
+
+	var opts []broker.Option
+	if cfg.remoteCACerts != "" {
+		opts = append(opts, broker.RootCAs(cfg.remoteCACerts))
+	}
+	if cfg.remoteClientCert != "" || cfg.remoteClientKey != "" {
+		opts = append(opts, broker.ClientCert(cfg.remoteClientCert, cfg.remoteClientKey))
+	}
+
+	pub, err := nats.NewPublisher(cfg.remoteURL, opts...)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to remote cluster: %s", err))
+		os.Exit(1)
+	}
+
+	return pub
+}
@@ -15,15 +15,20 @@ import (
 	adapter "github.com/MainfluxLabs/mainflux/http"
 	"github.com/MainfluxLabs/mainflux/http/api"
 	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	mfmetrics "github.com/MainfluxLabs/mainflux/pkg/metrics"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 )
@@ -33,6 +38,9 @@ const (
 	svcName      = "http-adapter"
 
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
 	defClientTLS         = "false"
 	defCACerts           = ""
 	defPort              = "8180"
@@ -40,8 +48,19 @@ const (
 	defJaegerURL         = ""
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defCacheTTL          = "5m"
+	defPublishAck        = ""
+	defAckTimeout        = "5s"
+	defRateLimit         = "0"
+	defRateLimitBurst    = "0"
 
 	envLogLevel          = "MF_HTTP_ADAPTER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
 	envClientTLS         = "MF_HTTP_ADAPTER_CLIENT_TLS"
 	envCACerts           = "MF_HTTP_ADAPTER_CA_CERTS"
 	envPort              = "MF_HTTP_ADAPTER_PORT"
@@ -49,6 +68,14 @@ const (
 	envJaegerURL         = "MF_JAEGER_URL"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envESURL             = "MF_HTTP_ADAPTER_ES_URL"
+	envESPass            = "MF_HTTP_ADAPTER_ES_PASS"
+	envESDB              = "MF_HTTP_ADAPTER_ES_DB"
+	envCacheTTL          = "MF_HTTP_ADAPTER_CACHE_TTL"
+	envPublishAck        = "MF_HTTP_ADAPTER_PUBLISH_ACK"
+	envAckTimeout        = "MF_HTTP_ADAPTER_ACK_TIMEOUT"
+	envRateLimit         = "MF_HTTP_ADAPTER_RATE_LIMIT"
+	envRateLimitBurst    = "MF_HTTP_ADAPTER_RATE_LIMIT_BURST"
 )
 
 type config struct {
@@ -56,21 +83,40 @@ type config struct {
 	thingsConfig      clients.Config
 	brokerURL         string
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	jaegerURL         string
 	thingsGRPCTimeout time.Duration
+	esURL             string
+	esPass            string
+	esDB              string
+	cacheTTL          time.Duration
+	publishAck        string
+	ackConfig         messaging.AckConfig
+	rateLimitConfig   servers.RateLimitConfig
+	tenantConfig      mfmetrics.TenantConfig
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	conn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	conn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer conn.Close()
 
 	httpTracer, closer := jaeger.Init("http_adapter", cfg.jaegerURL, logger)
@@ -79,17 +125,34 @@ func main() {
 	thingsTracer, thingsCloser := jaeger.Init("http_things", cfg.jaegerURL, logger)
 	defer thingsCloser.Close()
 
-	pub, err := brokers.NewPublisher(cfg.brokerURL)
+	pub, err := newPublisher(cfg)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
 	}
 	defer pub.Close()
 
-	tc := thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout)
-	svc := adapter.New(pub, tc)
+	pub = mfmetrics.TenantPublisher(
+		pub,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "http_adapter",
+			Subsystem: "api",
+			Name:      "tenant_message_count",
+			Help:      "Number of messages published per tenant.",
+		}, mfmetrics.TenantLabelNames),
+		mfmetrics.NewTenants(cfg.tenantConfig),
+	)
+
+	esClient := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
+	defer esClient.Close()
 
-	svc = api.LoggingMiddleware(svc, logger)
+	tc := auth.NewThingsCache(thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout), cfg.cacheTTL)
+	g.Go(func() error {
+		return auth.SubscribeThingsEvents(ctx, tc, esClient, logger.Module("events"))
+	})
+	svc := adapter.New(pub, tc, auth.NewNetworkAuthorizer(esClient), cfg.publishAck)
+
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -106,8 +169,21 @@ func main() {
 		}, []string{"method"}),
 	)
 
+	handler := api.MakeHandler(svc, httpTracer, logger.Module("http"))
+	limiter := servershttp.NewLimiter(esClient, svcName, cfg.rateLimitConfig)
+	handler = servershttp.RateLimit(handler, limiter, svcName, logger)
+	tunables := []mfconfig.Tunable{
+		mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides),
+		mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout),
+		mfconfig.RateLimit(limiter, envRateLimit, defRateLimit, envRateLimitBurst, defRateLimitBurst),
+	}
+
 	g.Go(func() error {
-		return servershttp.Start(ctx, api.MakeHandler(svc, httpTracer, logger), cfg.httpConfig, logger)
+		return mfconfig.Reload(ctx, cfgFile, logger, tunables...)
+	})
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, handler, cfg.httpConfig, logger)
 	})
 	g.Go(func() error {
 		if sig := errors.SignalHandler(ctx); sig != nil {
@@ -134,10 +210,22 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
 	}
 
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	thingsConfig := clients.Config{
@@ -147,12 +235,80 @@ func loadConfig() config {
 		ClientName: clients.Things,
 	}
 
+	cacheTTL, err := time.ParseDuration(mainflux.Env(envCacheTTL, defCacheTTL))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envCacheTTL, err.Error())
+	}
+
+	publishAck := mainflux.Env(envPublishAck, defPublishAck)
+	if !messaging.ValidAck(publishAck) {
+		log.Fatalf("Invalid value passed for %s\n", envPublishAck)
+	}
+
+	ackTimeout, err := time.ParseDuration(mainflux.Env(envAckTimeout, defAckTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envAckTimeout, err.Error())
+	}
+
+	ackConfig := messaging.AckConfig{
+		Timeout: ackTimeout,
+	}
+
+	rateLimit, err := strconv.ParseFloat(mainflux.Env(envRateLimit, defRateLimit), 64)
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimit, err.Error())
+	}
+
+	rateLimitBurst, err := strconv.Atoi(mainflux.Env(envRateLimitBurst, defRateLimitBurst))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimitBurst, err.Error())
+	}
+
+	tenantConfig, err := mfmetrics.LoadTenantConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	return config{
 		httpConfig:        httpConfig,
 		thingsConfig:      thingsConfig,
 		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		cacheTTL:          cacheTTL,
+		publishAck:        publishAck,
+		ackConfig:         ackConfig,
+		rateLimitConfig:   servers.RateLimitConfig{Rate: rateLimit, Burst: rateLimitBurst},
+		tenantConfig:      tenantConfig,
+	}
+}
+
+// newPublisher returns the publisher which confirms the persistence of the
+// messages if the confirmation is enabled.
+func newPublisher(cfg config) (messaging.Publisher, error) {
+	if cfg.publishAck == "" {
+		return brokers.NewPublisher(cfg.brokerURL)
+	}
+
+	return brokers.NewAckPublisher(cfg.brokerURL, cfg.ackConfig)
+}
+
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
 	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
 }
@@ -0,0 +1,339 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	authapi "github.com/MainfluxLabs/mainflux/auth/api/grpc"
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/MainfluxLabs/mainflux/consumers/inbox/api"
+	httpapi "github.com/MainfluxLabs/mainflux/consumers/inbox/api/http"
+	"github.com/MainfluxLabs/mainflux/consumers/inbox/postgres"
+	"github.com/MainfluxLabs/mainflux/consumers/inbox/tracing"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/clients"
+	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/jmoiron/sqlx"
+	opentracing "github.com/opentracing/opentracing-go"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName              = "inbox"
+	stopWaitTime         = 5 * time.Second
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defJaegerURL         = ""
+	defBrokerURL         = "nats://localhost:4222"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "inbox"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defClientTLS         = "false"
+	defCACerts           = ""
+	defHTTPPort          = "9027"
+	defServerCert        = ""
+	defServerKey         = ""
+	defThingsGRPCURL     = "localhost:8183"
+	defThingsGRPCTimeout = "1s"
+	defAuthGRPCURL       = "localhost:8181"
+	defAuthGRPCTimeout   = "1s"
+	defESConsumerName    = "inbox"
+	defESGroup           = "mainflux.inbox"
+
+	envLogLevel          = "MF_INBOX_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envBrokerURL         = "MF_BROKER_URL"
+	envDBHost            = "MF_INBOX_DB_HOST"
+	envDBPort            = "MF_INBOX_DB_PORT"
+	envDBUser            = "MF_INBOX_DB_USER"
+	envDBPass            = "MF_INBOX_DB_PASS"
+	envDB                = "MF_INBOX_DB"
+	envDBSSLMode         = "MF_INBOX_DB_SSL_MODE"
+	envDBSSLCert         = "MF_INBOX_DB_SSL_CERT"
+	envDBSSLKey          = "MF_INBOX_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_INBOX_DB_SSL_ROOT_CERT"
+	envClientTLS         = "MF_INBOX_CLIENT_TLS"
+	envCACerts           = "MF_INBOX_CA_CERTS"
+	envHTTPPort          = "MF_INBOX_PORT"
+	envServerCert        = "MF_INBOX_SERVER_CERT"
+	envServerKey         = "MF_INBOX_SERVER_KEY"
+	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
+	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
+	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envESPrefix          = "MF_INBOX"
+)
+
+type config struct {
+	brokerURL         string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	thingsConfig      clients.Config
+	authConfig        clients.Config
+	jaegerURL         string
+	thingsGRPCTimeout time.Duration
+	authGRPCTimeout   time.Duration
+	esConfig          events.Config
+}
+
+func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer pubSub.Close()
+
+	inboxTracer, inboxCloser := jaeger.Init(svcName, cfg.jaegerURL, logger)
+	defer inboxCloser.Close()
+
+	db := connectToDB(cfg.dbConfig, logger)
+	defer db.Close()
+
+	thingsTracer, thingsCloser := jaeger.Init("inbox_things", cfg.jaegerURL, logger)
+	defer thingsCloser.Close()
+
+	thConn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
+	defer thConn.Close()
+
+	tc := thingsapi.NewClient(thConn, thingsTracer, cfg.thingsGRPCTimeout)
+
+	authTracer, authCloser := jaeger.Init("inbox_auth", cfg.jaegerURL, logger)
+	defer authCloser.Close()
+
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
+	defer authConn.Close()
+
+	ac := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
+
+	dbTracer, dbCloser := jaeger.Init("inbox_db", cfg.jaegerURL, logger)
+	defer dbCloser.Close()
+
+	svc := newService(logger, dbTracer, db, ac, tc)
+
+	if err = consumers.Start(svcName, pubSub, svc, brokers.SubjectSmtp, brokers.SubjectSmpp); err != nil {
+		logger.Error(fmt.Sprintf("Failed to create inbox: %s", err))
+	}
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, httpapi.MakeHandler(inboxTracer, svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	g.Go(func() error {
+		return events.SubscribeGroupRemovals(ctx, cfg.esConfig, defESGroup, svc.RemoveNotificationsByGroup, logger.Module("events"))
+	})
+
+	g.Go(func() error {
+		if sig := errors.SignalHandler(ctx); sig != nil {
+			cancel()
+			logger.Info(fmt.Sprintf("Inbox service shutdown by signal: %s", sig))
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Inbox service terminated: %s", err))
+	}
+}
+
+func loadConfig() config {
+	thingsGRPCTimeout, err := time.ParseDuration(mainflux.Env(envThingsGRPCTimeout, defThingsGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
+	}
+
+	authGRPCTimeout, err := time.ParseDuration(mainflux.Env(envAuthGRPCTimeout, defAuthGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
+	}
+
+	tls, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envClientTLS)
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
+	dbConfig := postgres.Config{
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	httpConfig := servers.Config{
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	thingsConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envThingsGRPCURL, defThingsGRPCURL),
+		ClientName: clients.Things,
+	}
+
+	authConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envAuthGRPCURL, defAuthGRPCURL),
+		ClientName: clients.Auth,
+	}
+
+	esConfig, err := events.LoadConfig(envESPrefix, defESConsumerName)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	return config{
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		thingsConfig:      thingsConfig,
+		authConfig:        authConfig,
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		thingsGRPCTimeout: thingsGRPCTimeout,
+		authGRPCTimeout:   authGRPCTimeout,
+		esConfig:          esConfig,
+	}
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
+func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
+	db, err := postgres.Connect(dbConfig)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to postgres: %s", err))
+		os.Exit(1)
+	}
+	return db
+}
+
+func newService(logger logger.Logger, dbTracer opentracing.Tracer, db *sqlx.DB, ac protomfx.AuthServiceClient, tc protomfx.ThingsServiceClient) inbox.Service {
+	idp := uuid.New()
+	database := postgres.NewDatabase(db)
+
+	notificationRepo := postgres.NewNotificationRepository(database)
+	notificationRepo = tracing.NotificationRepositoryMiddleware(dbTracer, notificationRepo)
+	svc := inbox.New(idp, ac, tc, notificationRepo)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(
+		svc,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "inbox",
+			Subsystem: "api",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, []string{"method"}),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "inbox",
+			Subsystem: "api",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, []string{"method"}),
+	)
+
+	return svc
+}
@@ -16,15 +16,20 @@ import (
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/readers"
 	"github.com/MainfluxLabs/mainflux/readers/api"
 	"github.com/MainfluxLabs/mainflux/readers/mongodb"
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -36,34 +41,54 @@ const (
 	svcName      = "mongodb-reader"
 
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
 	defPort              = "8180"
 	defDB                = "mainflux"
 	defDBHost            = "localhost"
 	defDBPort            = "27017"
+	defOrgDBs            = ""
 	defClientTLS         = "false"
 	defCACerts           = ""
 	defServerCert        = ""
 	defServerKey         = ""
 	defJaegerURL         = ""
+	defBrokerURL         = "nats://localhost:4222"
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
 	defAuthGRPCURL       = "localhost:8181"
 	defAuthGRPCTimeout   = "1s"
+	defRateLimit         = "0"
+	defRateLimitBurst    = "0"
+	defRateLimitURL      = "localhost:6379"
+	defRateLimitPass     = ""
+	defRateLimitDB       = "0"
 
 	envLogLevel          = "MF_MONGO_READER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
 	envPort              = "MF_MONGO_READER_PORT"
 	envDB                = "MF_MONGO_READER_DB"
 	envDBHost            = "MF_MONGO_READER_DB_HOST"
 	envDBPort            = "MF_MONGO_READER_DB_PORT"
+	envOrgDBs            = "MF_MONGO_READER_ORG_DBS"
 	envClientTLS         = "MF_MONGO_READER_CLIENT_TLS"
 	envCACerts           = "MF_MONGO_READER_CA_CERTS"
 	envServerCert        = "MF_MONGO_READER_SERVER_CERT"
 	envServerKey         = "MF_MONGO_READER_SERVER_KEY"
 	envJaegerURL         = "MF_JAEGER_URL"
+	envBrokerURL         = "MF_BROKER_URL"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
 	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
 	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envRateLimit         = "MF_MONGO_READER_RATE_LIMIT"
+	envRateLimitBurst    = "MF_MONGO_READER_RATE_LIMIT_BURST"
+	envRateLimitURL      = "MF_MONGO_READER_RATE_LIMIT_URL"
+	envRateLimitPass     = "MF_MONGO_READER_RATE_LIMIT_PASS"
+	envRateLimitDB       = "MF_MONGO_READER_RATE_LIMIT_DB"
 )
 
 type config struct {
@@ -71,24 +96,41 @@ type config struct {
 	authConfig        clients.Config
 	thingsConfig      clients.Config
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	dbName            string
 	dbHost            string
 	dbPort            string
+	orgDBs            map[string]string
 	jaegerURL         string
+	brokerURL         string
 	thingsGRPCTimeout time.Duration
 	authGRPCTimeout   time.Duration
+	rateLimitConfig   servers.RateLimitConfig
+	rateLimitURL      string
+	rateLimitPass     string
+	rateLimitDB       string
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfigs()
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	conn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	conn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer conn.Close()
 
 	thingsTracer, thingsCloser := jaeger.Init("mongodb_things", cfg.jaegerURL, logger)
@@ -99,17 +141,47 @@ func main() {
 	authTracer, authCloser := jaeger.Init("mongodb_auth", cfg.jaegerURL, logger)
 	defer authCloser.Close()
 
-	authConn := clientsgrpc.Connect(cfg.authConfig, logger)
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
 	defer authConn.Close()
 
 	auth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
 
 	db := connectToMongoDB(cfg.dbHost, cfg.dbPort, cfg.dbName, logger)
 
-	repo := newService(db, logger)
+	counter, latency := makeMetrics()
+	repo := newService(db, logger, counter, latency)
+	queries := mongodb.NewQueryRepository(db)
+
+	orgRepos := make(map[string]readers.MessageRepository)
+	for orgID, name := range cfg.orgDBs {
+		orgDB := connectToMongoDB(cfg.dbHost, cfg.dbPort, name, logger)
+		orgRepos[orgID] = newService(orgDB, logger, counter, latency)
+	}
+
+	publisher, err := brokers.NewPublisher(cfg.brokerURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer publisher.Close()
+
+	handler := api.MakeHandler(repo, orgRepos, queries, tc, auth, publisher, uuid.New(), svcName, logger.Module("http"))
+	rlClient := connectToRedis(cfg.rateLimitURL, cfg.rateLimitPass, cfg.rateLimitDB, logger)
+	defer rlClient.Close()
+	limiter := servershttp.NewLimiter(rlClient, svcName, cfg.rateLimitConfig)
+	handler = servershttp.RateLimit(handler, limiter, svcName, logger)
+	tunables := []mfconfig.Tunable{
+		mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides),
+		mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout),
+		mfconfig.RateLimit(limiter, envRateLimit, defRateLimit, envRateLimitBurst, defRateLimitBurst),
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, tunables...)
+	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, api.MakeHandler(repo, tc, auth, svcName, logger), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, handler, cfg.httpConfig, logger)
 	})
 
 	g.Go(func() error {
@@ -142,12 +214,29 @@ func loadConfigs() config {
 		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
 	}
 
+	orgDBs, err := dbutil.ParseOrgDatabases(mainflux.Env(envOrgDBs, defOrgDBs))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s: %s\n", envOrgDBs, err)
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	thingsConfig := clients.Config{
@@ -163,17 +252,36 @@ func loadConfigs() config {
 		URL:        mainflux.Env(envAuthGRPCURL, defAuthGRPCURL),
 		ClientName: clients.Auth,
 	}
+
+	rateLimit, err := strconv.ParseFloat(mainflux.Env(envRateLimit, defRateLimit), 64)
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimit, err.Error())
+	}
+
+	rateLimitBurst, err := strconv.Atoi(mainflux.Env(envRateLimitBurst, defRateLimitBurst))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimitBurst, err.Error())
+	}
+
 	return config{
 		httpConfig:        httpConfig,
 		thingsConfig:      thingsConfig,
 		authConfig:        authConfig,
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		dbName:            mainflux.Env(envDB, defDB),
 		dbHost:            mainflux.Env(envDBHost, defDBHost),
 		dbPort:            mainflux.Env(envDBPort, defDBPort),
+		orgDBs:            orgDBs,
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
 		authGRPCTimeout:   authGRPCTimeout,
+		rateLimitConfig:   servers.RateLimitConfig{Rate: rateLimit, Burst: rateLimitBurst},
+		rateLimitURL:      mainflux.Env(envRateLimitURL, defRateLimitURL),
+		rateLimitPass:     mainflux.Env(envRateLimitPass, defRateLimitPass),
+		rateLimitDB:       mainflux.Env(envRateLimitDB, defRateLimitDB),
 	}
 }
 
@@ -188,24 +296,42 @@ func connectToMongoDB(host, port, name string, logger logger.Logger) *mongo.Data
 	return client.Database(name)
 }
 
-func newService(db *mongo.Database, logger logger.Logger) readers.MessageRepository {
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
+
+func newService(db *mongo.Database, logger logger.Logger, counter *kitprometheus.Counter, latency *kitprometheus.Summary) readers.MessageRepository {
 	repo := mongodb.New(db)
-	repo = api.LoggingMiddleware(repo, logger)
-	repo = api.MetricsMiddleware(
-		repo,
-		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
-			Namespace: "mongodb",
-			Subsystem: "message_reader",
-			Name:      "request_count",
-			Help:      "Number of requests received.",
-		}, []string{"method"}),
-		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
-			Namespace: "mongodb",
-			Subsystem: "message_reader",
-			Name:      "request_latency_microseconds",
-			Help:      "Total duration of requests in microseconds.",
-		}, []string{"method"}),
-	)
+	repo = api.LoggingMiddleware(repo, logger.Module("api"))
+	repo = api.MetricsMiddleware(repo, counter, latency)
 
 	return repo
 }
+
+func makeMetrics() (*kitprometheus.Counter, *kitprometheus.Summary) {
+	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "mongodb",
+		Subsystem: "message_reader",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, []string{"method"})
+
+	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "mongodb",
+		Subsystem: "message_reader",
+		Name:      "request_latency_microseconds",
+		Help:      "Total duration of requests in microseconds.",
+	}, []string{"method"})
+
+	return counter, latency
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux"
@@ -15,6 +16,8 @@ import (
 	"github.com/MainfluxLabs/mainflux/consumers/writers/api"
 	"github.com/MainfluxLabs/mainflux/consumers/writers/mongodb"
 	"github.com/MainfluxLabs/mainflux/logger"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
@@ -30,41 +33,71 @@ const (
 	svcName      = "mongodb-writer"
 	stopWaitTime = 5 * time.Second
 
-	defLogLevel  = "error"
-	defBrokerURL = "nats://localhost:4222"
-	defPort      = "8180"
-	defDB        = "mainflux"
-	defDBHost    = "localhost"
-	defDBPort    = "27017"
-
-	envBrokerURL = "MF_BROKER_URL"
-	envLogLevel  = "MF_MONGO_WRITER_LOG_LEVEL"
-	envPort      = "MF_MONGO_WRITER_PORT"
-	envDB        = "MF_MONGO_WRITER_DB"
-	envDBHost    = "MF_MONGO_WRITER_DB_HOST"
-	envDBPort    = "MF_MONGO_WRITER_DB_PORT"
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defBrokerURL         = "nats://localhost:4222"
+	defPort              = "8180"
+	defName              = "mongodb"
+	defPrimary           = "false"
+	defDB                = "mainflux"
+	defDBHost            = "localhost"
+	defDBPort            = "27017"
+	defOrgDBs            = ""
+
+	envBrokerURL         = "MF_BROKER_URL"
+	envLogLevel          = "MF_MONGO_WRITER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envPort              = "MF_MONGO_WRITER_PORT"
+	envName              = "MF_MONGO_WRITER_NAME"
+	envPrimary           = "MF_MONGO_WRITER_PRIMARY"
+	envDB                = "MF_MONGO_WRITER_DB"
+	envDBHost            = "MF_MONGO_WRITER_DB_HOST"
+	envDBPort            = "MF_MONGO_WRITER_DB_PORT"
+	envOrgDBs            = "MF_MONGO_WRITER_ORG_DBS"
 )
 
 type config struct {
-	httpConfig servers.Config
-	brokerURL  string
-	logLevel   string
-	dbName     string
-	dbHost     string
-	dbPort     string
+	httpConfig        servers.Config
+	brokerURL         string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	name              string
+	primary           bool
+	dbName            string
+	dbHost            string
+	dbPort            string
+	orgDBs            map[string]string
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfigs()
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger)
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
@@ -78,20 +111,25 @@ func main() {
 		os.Exit(1)
 	}
 
-	db := client.Database(cfg.dbName)
-	repo := mongodb.New(db)
-
 	counter, latency := makeMetrics()
-	repo = api.LoggingMiddleware(repo, logger)
-	repo = api.MetricsMiddleware(repo, counter, latency)
+	repo := newService(client.Database(cfg.dbName), logger, counter, latency)
+
+	routes := consumers.Routes{}
+	for orgID, name := range cfg.orgDBs {
+		routes[orgID] = newService(client.Database(name), logger, counter, latency)
+	}
 
-	if err := consumers.Start(svcName, pubSub, repo, brokers.SubjectSenML, brokers.SubjectJSON); err != nil {
+	start := consumers.StartWriter
+	if cfg.primary {
+		start = consumers.StartPrimaryWriter
+	}
+	if err := start(svcName, cfg.name, pubSub, repo, routes, brokers.SubjectSenML, brokers.SubjectJSON); err != nil {
 		logger.Error(fmt.Sprintf("Failed to start MongoDB writer: %s", err))
 		os.Exit(1)
 	}
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, api.MakeHandler(svcName), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, api.MakeHandler(svcName), cfg.httpConfig, logger.Module("http"))
 	})
 
 	g.Go(func() error {
@@ -109,22 +147,57 @@ func main() {
 }
 
 func loadConfigs() config {
+	primary, err := strconv.ParseBool(mainflux.Env(envPrimary, defPrimary))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envPrimary)
+	}
+
+	orgDBs, err := dbutil.ParseOrgDatabases(mainflux.Env(envOrgDBs, defOrgDBs))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s: %s\n", envOrgDBs, err)
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	return config{
-		httpConfig: httpConfig,
-		brokerURL:  mainflux.Env(envBrokerURL, defBrokerURL),
-		logLevel:   mainflux.Env(envLogLevel, defLogLevel),
-		dbName:     mainflux.Env(envDB, defDB),
-		dbHost:     mainflux.Env(envDBHost, defDBHost),
-		dbPort:     mainflux.Env(envDBPort, defDBPort),
+		httpConfig:        httpConfig,
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbName:            mainflux.Env(envDB, defDB),
+		dbHost:            mainflux.Env(envDBHost, defDBHost),
+		dbPort:            mainflux.Env(envDBPort, defDBPort),
+		name:              mainflux.Env(envName, defName),
+		primary:           primary,
+		orgDBs:            orgDBs,
 	}
 }
 
+func newService(db *mongo.Database, logger logger.Logger, counter *kitprometheus.Counter, latency *kitprometheus.Summary) consumers.Consumer {
+	repo := mongodb.New(db)
+	repo = api.LoggingMiddleware(repo, logger.Module("api"))
+	repo = api.MetricsMiddleware(repo, counter, latency)
+
+	return repo
+}
+
 func makeMetrics() (*kitprometheus.Counter, *kitprometheus.Summary) {
 	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
 		Namespace: "mongodb",
@@ -12,25 +12,29 @@ import (
 
 	"github.com/MainfluxLabs/mainflux"
 	authapi "github.com/MainfluxLabs/mainflux/auth/api/grpc"
+	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/mqtt"
 	mqttapi "github.com/MainfluxLabs/mainflux/mqtt/api"
 	mqttapihttp "github.com/MainfluxLabs/mainflux/mqtt/api/http"
 	"github.com/MainfluxLabs/mainflux/mqtt/postgres"
 	mqttredis "github.com/MainfluxLabs/mainflux/mqtt/redis"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
 	mqttpub "github.com/MainfluxLabs/mainflux/pkg/messaging/mqtt"
+	mfmetrics "github.com/MainfluxLabs/mainflux/pkg/metrics"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	"github.com/MainfluxLabs/mainflux/pkg/ulid"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
-	"github.com/MainfluxLabs/mproxy/logger"
-	mp "github.com/MainfluxLabs/mproxy/pkg/mqtt"
 	"github.com/MainfluxLabs/mproxy/pkg/session"
 	ws "github.com/MainfluxLabs/mproxy/pkg/websocket"
 	"github.com/cenkalti/backoff/v4"
@@ -46,6 +50,10 @@ const (
 	stopWaitTime = 5 * time.Second
 
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
 	defMQTTPort          = "1883"
 	defTargetHost        = "0.0.0.0"
 	defTargetPort        = "1883"
@@ -59,6 +67,9 @@ const (
 	defWSPort            = "8285"
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
+	defCacheTTL          = "5m"
+	defShortTopics       = "false"
+	defTopicAliases      = ""
 	defBrokerURL         = "nats://localhost:4222"
 	defJaegerURL         = ""
 	defClientTLS         = "false"
@@ -85,6 +96,10 @@ const (
 	defAuthGRPCTimeout   = "1s"
 
 	envLogLevel          = "MF_MQTT_ADAPTER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
 	envMQTTPort          = "MF_MQTT_ADAPTER_MQTT_PORT"
 	envTargetHost        = "MF_MQTT_ADAPTER_MQTT_TARGET_HOST"
 	envTargetPort        = "MF_MQTT_ADAPTER_MQTT_TARGET_PORT"
@@ -98,6 +113,9 @@ const (
 	envWSPort            = "MF_MQTT_ADAPTER_WS_PORT"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envCacheTTL          = "MF_MQTT_ADAPTER_CACHE_TTL"
+	envShortTopics       = "MF_MQTT_ADAPTER_SHORT_TOPICS"
+	envTopicAliases      = "MF_MQTT_ADAPTER_TOPIC_ALIASES"
 	envBrokerURL         = "MF_BROKER_URL"
 	envJaegerURL         = "MF_JAEGER_URL"
 	envClientTLS         = "MF_MQTT_ADAPTER_CLIENT_TLS"
@@ -140,7 +158,12 @@ type config struct {
 	httpTargetPath    string
 	jaegerURL         string
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	thingsGRPCTimeout time.Duration
+	cacheTTL          time.Duration
+	shortTopics       bool
+	topicAliases      map[string]string
 	brokerURL         string
 	instance          string
 	esURL             string
@@ -151,18 +174,37 @@ type config struct {
 	authCacheDB       string
 	authGRPCTimeout   time.Duration
 	dbConfig          postgres.Config
+	tenantConfig      mfmetrics.TenantConfig
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
 	db := connectToDB(cfg.dbConfig, logger)
 	defer db.Close()
 
@@ -178,13 +220,13 @@ func main() {
 		}
 	}
 
-	conn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	conn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer conn.Close()
 
 	ec := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
 	defer ec.Close()
 
-	nps, err := brokers.NewPubSub(cfg.brokerURL, "mqtt", logger)
+	nps, err := brokers.NewPubSub(cfg.brokerURL, "mqtt", logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
@@ -203,7 +245,7 @@ func main() {
 			brokers.SubjectJSON,
 		}
 
-		fwd := mqtt.NewForwarder(subjects, logger)
+		fwd := mqtt.NewForwarder(subjects, logger.Module("mqtt"))
 		if err := fwd.Forward(svcName, nps, mpub); err != nil {
 			logger.Error(fmt.Sprintf("Failed to forward message broker messages: %s", err))
 			os.Exit(1)
@@ -217,8 +259,24 @@ func main() {
 	}
 	defer np.Close()
 
+	np = mfmetrics.TenantPublisher(
+		np,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "mqtt_adapter",
+			Subsystem: "api",
+			Name:      "tenant_message_count",
+			Help:      "Number of messages published per tenant.",
+		}, mfmetrics.TenantLabelNames),
+		mfmetrics.NewTenants(cfg.tenantConfig),
+	)
+
 	es := mqttredis.NewEventStore(ec, cfg.instance)
 
+	sessions := mqttredis.NewSessionRegistry(ec, sessionInstance(cfg.instance, logger), logger.Module("sessions"))
+	g.Go(func() error {
+		return sessions.Listen(ctx)
+	})
+
 	ac := connectToRedis(cfg.authCacheURL, cfg.authPass, cfg.authCacheDB, logger)
 	defer ac.Close()
 
@@ -232,16 +290,20 @@ func main() {
 	authTracer, authCloser := jaeger.Init("mqtt_auth", cfg.jaegerURL, logger)
 	defer authCloser.Close()
 
-	authConn := clientsgrpc.Connect(cfg.authConfig, logger)
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
 	defer authConn.Close()
 
 	usersAuth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
-	tc := thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout)
+	tc := auth.NewThingsCache(thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout), cfg.cacheTTL)
+	g.Go(func() error {
+		return auth.SubscribeThingsEvents(ctx, tc, ec, logger.Module("events"))
+	})
 
-	svc := newService(usersAuth, tc, db, logger)
+	svc := newService(usersAuth, tc, db, es, logger)
 
 	// Event handler for MQTT hooks
-	h := mqtt.NewHandler([]messaging.Publisher{np}, es, logger, tc, svc)
+	topics := mqtt.NewTopicTranslator(cfg.shortTopics, cfg.topicAliases)
+	h := mqtt.NewHandler([]messaging.Publisher{np}, es, sessions, logger.Module("mqtt"), tc, svc, topics)
 
 	logger.Info(fmt.Sprintf("Starting MQTT proxy on port %s", cfg.port))
 	g.Go(func() error {
@@ -254,7 +316,7 @@ func main() {
 	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, mqttapihttp.MakeHandler(mqttTracer, svc, logger), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, mqttapihttp.MakeHandler(mqttTracer, svc, logger.Module("http")), cfg.httpConfig, logger.Module("http"))
 	})
 
 	g.Go(func() error {
@@ -281,6 +343,21 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
 	}
 
+	cacheTTL, err := time.ParseDuration(mainflux.Env(envCacheTTL, defCacheTTL))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envCacheTTL, err.Error())
+	}
+
+	shortTopics, err := strconv.ParseBool(mainflux.Env(envShortTopics, defShortTopics))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envShortTopics)
+	}
+
+	topicAliases, err := mqtt.ParseAliases(mainflux.Env(envTopicAliases, defTopicAliases))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envTopicAliases, err.Error())
+	}
+
 	mqttTimeout, err := time.ParseDuration(mainflux.Env(envTimeout, defTimeout))
 	if err != nil {
 		log.Fatalf("Invalid %s value: %s", envTimeout, err.Error())
@@ -291,24 +368,42 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
 	}
 
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envHTTPPort, defHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	thingsConfig := clients.Config{
@@ -325,6 +420,11 @@ func loadConfig() config {
 		ClientName: clients.Auth,
 	}
 
+	tenantConfig, err := mfmetrics.LoadTenantConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	return config{
 		port:              mainflux.Env(envMQTTPort, defMQTTPort),
 		httpConfig:        httpConfig,
@@ -341,8 +441,13 @@ func loadConfig() config {
 		httpTargetPath:    mainflux.Env(envHTTPTargetPath, defHTTPTargetPath),
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
+		cacheTTL:          cacheTTL,
+		shortTopics:       shortTopics,
+		topicAliases:      topicAliases,
 		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		instance:          mainflux.Env(envInstance, defInstance),
 		esURL:             mainflux.Env(envESURL, defESURL),
 		esPass:            mainflux.Env(envESPass, defESPass),
@@ -352,6 +457,7 @@ func loadConfig() config {
 		authCacheDB:       mainflux.Env(envAuthCacheDB, defAuthCacheDB),
 		authGRPCTimeout:   authGRPCTimeout,
 		dbConfig:          dbConfig,
+		tenantConfig:      tenantConfig,
 	}
 }
 
@@ -369,10 +475,26 @@ func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *
 	})
 }
 
+// sessionInstance returns the instance name identifying the sessions of the
+// adapter replica, generating a unique one if the instance name is not set.
+func sessionInstance(instance string, logger logger.Logger) string {
+	if instance != "" {
+		return instance
+	}
+
+	id, err := uuid.New().ID()
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to generate session instance: %s", err))
+		os.Exit(1)
+	}
+
+	return id
+}
+
 func proxyMQTT(ctx context.Context, cfg config, logger logger.Logger, handler session.Handler) error {
 	address := fmt.Sprintf(":%s", cfg.port)
 	target := fmt.Sprintf("%s:%s", cfg.targetHost, cfg.targetPort)
-	mp := mp.New(address, target, handler, logger)
+	mp := mqtt.NewProxy(address, target, handler, logger)
 
 	errCh := make(chan error)
 	go func() {
@@ -426,6 +548,18 @@ func healthcheck(cfg config) func() error {
 	}
 }
 
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
 func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	db, err := postgres.Connect(dbConfig)
 	if err != nil {
@@ -435,12 +569,12 @@ func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(ac protomfx.AuthServiceClient, tc protomfx.ThingsServiceClient, db *sqlx.DB, logger logger.Logger) mqtt.Service {
+func newService(ac protomfx.AuthServiceClient, tc protomfx.ThingsServiceClient, db *sqlx.DB, es mqttredis.EventStore, logger logger.Logger) mqtt.Service {
 	subscriptions := postgres.NewRepository(db)
 	idp := ulid.New()
-	svc := mqtt.NewMqttService(ac, tc, subscriptions, idp)
+	svc := mqtt.NewMqttService(ac, tc, subscriptions, es, idp)
 
-	svc = mqttapi.LoggingMiddleware(svc, logger)
+	svc = mqttapi.LoggingMiddleware(svc, logger.Module("api"))
 	svc = mqttapi.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
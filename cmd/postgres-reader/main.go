@@ -16,15 +16,21 @@ import (
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/readers"
 	"github.com/MainfluxLabs/mainflux/readers/api"
 	"github.com/MainfluxLabs/mainflux/readers/postgres"
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	"github.com/jmoiron/sqlx"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
@@ -35,6 +41,10 @@ const (
 	stopWaitTime = 5 * time.Second
 
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
 	defPort              = "8180"
 	defClientTLS         = "false"
 	defCACerts           = ""
@@ -47,13 +57,24 @@ const (
 	defDBSSLCert         = ""
 	defDBSSLKey          = ""
 	defDBSSLRootCert     = ""
+	defOrgDBs            = ""
 	defJaegerURL         = ""
+	defBrokerURL         = "nats://localhost:4222"
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
 	defAuthGRPCURL       = "localhost:8181"
 	defAuthGRPCTimeout   = "1s"
+	defRateLimit         = "0"
+	defRateLimitBurst    = "0"
+	defRateLimitURL      = "localhost:6379"
+	defRateLimitPass     = ""
+	defRateLimitDB       = "0"
 
 	envLogLevel          = "MF_POSTGRES_READER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
 	envPort              = "MF_POSTGRES_READER_PORT"
 	envClientTLS         = "MF_POSTGRES_READER_CLIENT_TLS"
 	envCACerts           = "MF_POSTGRES_READER_CA_CERTS"
@@ -66,35 +87,64 @@ const (
 	envDBSSLCert         = "MF_POSTGRES_READER_DB_SSL_CERT"
 	envDBSSLKey          = "MF_POSTGRES_READER_DB_SSL_KEY"
 	envDBSSLRootCert     = "MF_POSTGRES_READER_DB_SSL_ROOT_CERT"
+	envOrgDBs            = "MF_POSTGRES_READER_ORG_DBS"
 	envJaegerURL         = "MF_JAEGER_URL"
+	envBrokerURL         = "MF_BROKER_URL"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
 	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
 	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envRateLimit         = "MF_POSTGRES_READER_RATE_LIMIT"
+	envRateLimitBurst    = "MF_POSTGRES_READER_RATE_LIMIT_BURST"
+	envRateLimitURL      = "MF_POSTGRES_READER_RATE_LIMIT_URL"
+	envRateLimitPass     = "MF_POSTGRES_READER_RATE_LIMIT_PASS"
+	envRateLimitDB       = "MF_POSTGRES_READER_RATE_LIMIT_DB"
 )
 
 type config struct {
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	dbConfig          postgres.Config
+	orgDBs            map[string]string
 	httpConfig        servers.Config
 	authConfig        clients.Config
 	thingsConfig      clients.Config
 	jaegerURL         string
+	brokerURL         string
 	thingsGRPCTimeout time.Duration
 	authGRPCTimeout   time.Duration
+	rateLimitConfig   servers.RateLimitConfig
+	rateLimitURL      string
+	rateLimitPass     string
+	rateLimitDB       string
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	conn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	conn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer conn.Close()
 
 	thingsTracer, thingsCloser := jaeger.Init("postgres_things", cfg.jaegerURL, logger)
@@ -105,7 +155,7 @@ func main() {
 	authTracer, authCloser := jaeger.Init("postgres_auth", cfg.jaegerURL, logger)
 	defer authCloser.Close()
 
-	authConn := clientsgrpc.Connect(cfg.authConfig, logger)
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
 	defer authConn.Close()
 
 	auth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
@@ -113,10 +163,45 @@ func main() {
 	db := connectToDB(cfg.dbConfig, logger)
 	defer db.Close()
 
-	repo := newService(db, logger)
+	counter, latency := makeMetrics()
+	repo := newService(db, logger, counter, latency)
+	queries := postgres.NewQueryRepository(db)
+
+	orgRepos := make(map[string]readers.MessageRepository)
+	for orgID, name := range cfg.orgDBs {
+		dbConfig := cfg.dbConfig
+		dbConfig.Name = name
+
+		orgDB := connectToDB(dbConfig, logger)
+		defer orgDB.Close()
+
+		orgRepos[orgID] = newService(orgDB, logger, counter, latency)
+	}
+
+	publisher, err := brokers.NewPublisher(cfg.brokerURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer publisher.Close()
+
+	handler := api.MakeHandler(repo, orgRepos, queries, tc, auth, publisher, uuid.New(), svcName, logger.Module("http"))
+	rlClient := connectToRedis(cfg.rateLimitURL, cfg.rateLimitPass, cfg.rateLimitDB, logger)
+	defer rlClient.Close()
+	limiter := servershttp.NewLimiter(rlClient, svcName, cfg.rateLimitConfig)
+	handler = servershttp.RateLimit(handler, limiter, svcName, logger)
+	tunables := []mfconfig.Tunable{
+		mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides),
+		mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout),
+		mfconfig.RateLimit(limiter, envRateLimit, defRateLimit, envRateLimitBurst, defRateLimitBurst),
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, tunables...)
+	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, api.MakeHandler(repo, tc, auth, svcName, logger), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, handler, cfg.httpConfig, logger)
 	})
 
 	g.Go(func() error {
@@ -133,22 +218,45 @@ func main() {
 }
 
 func loadConfig() config {
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	orgDBs, err := dbutil.ParseOrgDatabases(mainflux.Env(envOrgDBs, defOrgDBs))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s: %s\n", envOrgDBs, err)
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	tls, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
@@ -180,15 +288,45 @@ func loadConfig() config {
 		ClientName: clients.Auth,
 	}
 
+	rateLimit, err := strconv.ParseFloat(mainflux.Env(envRateLimit, defRateLimit), 64)
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimit, err.Error())
+	}
+
+	rateLimitBurst, err := strconv.Atoi(mainflux.Env(envRateLimitBurst, defRateLimitBurst))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimitBurst, err.Error())
+	}
+
 	return config{
 		httpConfig:        httpConfig,
 		thingsConfig:      thingsConfig,
 		authConfig:        authConfig,
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		dbConfig:          dbConfig,
+		orgDBs:            orgDBs,
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
 		authGRPCTimeout:   authGRPCTimeout,
+		rateLimitConfig:   servers.RateLimitConfig{Rate: rateLimit, Burst: rateLimitBurst},
+		rateLimitURL:      mainflux.Env(envRateLimitURL, defRateLimitURL),
+		rateLimitPass:     mainflux.Env(envRateLimitPass, defRateLimitPass),
+		rateLimitDB:       mainflux.Env(envRateLimitDB, defRateLimitDB),
+	}
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
 	}
 }
 
@@ -201,24 +339,42 @@ func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(db *sqlx.DB, logger logger.Logger) readers.MessageRepository {
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
+
+func newService(db *sqlx.DB, logger logger.Logger, counter *kitprometheus.Counter, latency *kitprometheus.Summary) readers.MessageRepository {
 	svc := postgres.New(db)
-	svc = api.LoggingMiddleware(svc, logger)
-	svc = api.MetricsMiddleware(
-		svc,
-		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
-			Namespace: "postgres",
-			Subsystem: "message_reader",
-			Name:      "request_count",
-			Help:      "Number of requests received.",
-		}, []string{"method"}),
-		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
-			Namespace: "postgres",
-			Subsystem: "message_reader",
-			Name:      "request_latency_microseconds",
-			Help:      "Total duration of requests in microseconds.",
-		}, []string{"method"}),
-	)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(svc, counter, latency)
 
 	return svc
 }
+
+func makeMetrics() (*kitprometheus.Counter, *kitprometheus.Summary) {
+	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "postgres",
+		Subsystem: "message_reader",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, []string{"method"})
+
+	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "postgres",
+		Subsystem: "message_reader",
+		Name:      "request_latency_microseconds",
+		Help:      "Total duration of requests in microseconds.",
+	}, []string{"method"})
+
+	return counter, latency
+}
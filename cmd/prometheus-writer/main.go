@@ -0,0 +1,202 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/consumers/writers/api"
+	"github.com/MainfluxLabs/mainflux/consumers/writers/prometheus"
+	"github.com/MainfluxLabs/mainflux/logger"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName      = "prometheus-writer"
+	stopWaitTime = 5 * time.Second
+
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defBrokerURL         = "nats://localhost:4222"
+	defPort              = "8180"
+	defName              = "prometheus"
+	defPrimary           = "false"
+	defURL               = "http://localhost:9090/api/v1/write"
+	defUsername          = ""
+	defPassword          = ""
+	defTimeout           = "10s"
+	envBrokerURL         = "MF_BROKER_URL"
+	envLogLevel          = "MF_PROMETHEUS_WRITER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envPort              = "MF_PROMETHEUS_WRITER_PORT"
+	envName              = "MF_PROMETHEUS_WRITER_NAME"
+	envPrimary           = "MF_PROMETHEUS_WRITER_PRIMARY"
+	envURL               = "MF_PROMETHEUS_WRITER_URL"
+	envUsername          = "MF_PROMETHEUS_WRITER_USERNAME"
+	envPassword          = "MF_PROMETHEUS_WRITER_PASSWORD"
+	envTimeout           = "MF_PROMETHEUS_WRITER_TIMEOUT"
+)
+
+type config struct {
+	brokerURL         string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	name              string
+	primary           bool
+	writeConfig       prometheus.Config
+	httpConfig        servers.Config
+}
+
+func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	cfg := loadConfig()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer pubSub.Close()
+
+	repo := newService(cfg.writeConfig, logger)
+
+	start := consumers.StartWriter
+	if cfg.primary {
+		start = consumers.StartPrimaryWriter
+	}
+	if err = start(svcName, cfg.name, pubSub, repo, consumers.Routes{}, brokers.SubjectSenML); err != nil {
+		logger.Error(fmt.Sprintf("Failed to create Prometheus writer: %s", err))
+	}
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, api.MakeHandler(svcName), cfg.httpConfig, logger.Module("http"))
+	})
+
+	g.Go(func() error {
+		if sig := errors.SignalHandler(ctx); sig != nil {
+			cancel()
+			logger.Info(fmt.Sprintf("Prometheus writer service shutdown by signal: %s", sig))
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Prometheus writer service terminated: %s", err))
+	}
+}
+
+func loadConfig() config {
+	primary, err := strconv.ParseBool(mainflux.Env(envPrimary, defPrimary))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envPrimary)
+	}
+
+	timeout, err := time.ParseDuration(mainflux.Env(envTimeout, defTimeout))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envTimeout)
+	}
+
+	writeConfig := prometheus.Config{
+		URL:      mainflux.Env(envURL, defURL),
+		Username: mainflux.Env(envUsername, defUsername),
+		Password: mainflux.Env(envPassword, defPassword),
+		Timeout:  timeout,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	httpConfig := servers.Config{
+		ServerName:     svcName,
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	return config{
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		name:              mainflux.Env(envName, defName),
+		primary:           primary,
+		writeConfig:       writeConfig,
+		httpConfig:        httpConfig,
+	}
+}
+
+func newService(cfg prometheus.Config, logger logger.Logger) consumers.Consumer {
+	counter, latency := makeMetrics()
+
+	svc := prometheus.New(cfg)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(svc, counter, latency)
+
+	return svc
+}
+
+func makeMetrics() (*kitprometheus.Counter, *kitprometheus.Summary) {
+	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "prometheus",
+		Subsystem: "message_writer",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, []string{"method"})
+
+	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "prometheus",
+		Subsystem: "message_writer",
+		Name:      "request_latency_microseconds",
+		Help:      "Total duration of requests in microseconds.",
+	}, []string{"method"})
+
+	return counter, latency
+}
@@ -0,0 +1,345 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/clients"
+	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	jobspostgres "github.com/MainfluxLabs/mainflux/pkg/jobs/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/MainfluxLabs/mainflux/reports/api"
+	httpapi "github.com/MainfluxLabs/mainflux/reports/api/http"
+	"github.com/MainfluxLabs/mainflux/reports/postgres"
+	"github.com/MainfluxLabs/mainflux/reports/tracing"
+	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/jmoiron/sqlx"
+	opentracing "github.com/opentracing/opentracing-go"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	svcName              = "reports"
+	stopWaitTime         = 5 * time.Second
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defJaegerURL         = ""
+	defBrokerURL         = "nats://localhost:4222"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "reports"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defClientTLS         = "false"
+	defCACerts           = ""
+	defHTTPPort          = "9028"
+	defServerCert        = ""
+	defServerKey         = ""
+	defThingsGRPCURL     = "localhost:8183"
+	defThingsGRPCTimeout = "1s"
+	defReaderURL         = "http://localhost:8905"
+	defReaderToken       = ""
+	defURL               = "http://localhost:9028"
+	defSchedulerInterval = "1m"
+	defESConsumerName    = "reports"
+	defESGroup           = "mainflux.reports"
+
+	envLogLevel          = "MF_REPORTS_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envBrokerURL         = "MF_BROKER_URL"
+	envDBHost            = "MF_REPORTS_DB_HOST"
+	envDBPort            = "MF_REPORTS_DB_PORT"
+	envDBUser            = "MF_REPORTS_DB_USER"
+	envDBPass            = "MF_REPORTS_DB_PASS"
+	envDB                = "MF_REPORTS_DB"
+	envDBSSLMode         = "MF_REPORTS_DB_SSL_MODE"
+	envDBSSLCert         = "MF_REPORTS_DB_SSL_CERT"
+	envDBSSLKey          = "MF_REPORTS_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_REPORTS_DB_SSL_ROOT_CERT"
+	envClientTLS         = "MF_REPORTS_CLIENT_TLS"
+	envCACerts           = "MF_REPORTS_CA_CERTS"
+	envHTTPPort          = "MF_REPORTS_HTTP_PORT"
+	envServerCert        = "MF_REPORTS_SERVER_CERT"
+	envServerKey         = "MF_REPORTS_SERVER_KEY"
+	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
+	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envReaderURL         = "MF_REPORTS_READER_URL"
+	envReaderToken       = "MF_REPORTS_READER_TOKEN"
+	envURL               = "MF_REPORTS_URL"
+	envSchedulerInterval = "MF_REPORTS_SCHEDULER_INTERVAL"
+	envESPrefix          = "MF_REPORTS"
+)
+
+type config struct {
+	brokerURL         string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	thingsConfig      clients.Config
+	jaegerURL         string
+	thingsGRPCTimeout time.Duration
+	readerURL         string
+	readerToken       string
+	url               string
+	schedule          jobs.Schedule
+	esConfig          events.Config
+}
+
+func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	publisher, err := brokers.NewPublisher(cfg.brokerURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
+		os.Exit(1)
+	}
+	defer publisher.Close()
+
+	reportsTracer, reportsCloser := jaeger.Init(svcName, cfg.jaegerURL, logger)
+	defer reportsCloser.Close()
+
+	db := connectToDB(cfg.dbConfig, logger)
+	defer db.Close()
+
+	thingsTracer, thingsCloser := jaeger.Init("reports_things", cfg.jaegerURL, logger)
+	defer thingsCloser.Close()
+
+	thingsConn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
+	defer thingsConn.Close()
+
+	things := thingsapi.NewClient(thingsConn, thingsTracer, cfg.thingsGRPCTimeout)
+
+	dbTracer, dbCloser := jaeger.Init("reports_db", cfg.jaegerURL, logger)
+	defer dbCloser.Close()
+
+	svc := newService(things, publisher, dbTracer, db, cfg, logger)
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, httpapi.MakeHandler(reportsTracer, svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	counter, latency := jobs.NewMetrics(svcName)
+	scheduler := jobs.New(jobspostgres.NewLocker(db), nil, uuid.New(), counter, latency, logger)
+	// The errors are logged by the logging middleware, and the failed
+	// reports are generated again on the next run.
+	scheduler.Schedule("run_scheduled_reports", cfg.schedule, svc.RunScheduled)
+
+	g.Go(func() error {
+		return scheduler.Run(ctx)
+	})
+
+	g.Go(func() error {
+		return events.SubscribeGroupRemovals(ctx, cfg.esConfig, defESGroup, svc.RemoveReportsByGroup, logger.Module("events"))
+	})
+
+	g.Go(func() error {
+		if sig := errors.SignalHandler(ctx); sig != nil {
+			cancel()
+			logger.Info(fmt.Sprintf("Reports service shutdown by signal: %s", sig))
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		logger.Error(fmt.Sprintf("Reports service terminated: %s", err))
+	}
+}
+
+func loadConfig() config {
+	tls, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envClientTLS)
+	}
+
+	thingsGRPCTimeout, err := time.ParseDuration(mainflux.Env(envThingsGRPCTimeout, defThingsGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
+	}
+
+	schedule, err := jobs.ParseSchedule(mainflux.Env(envSchedulerInterval, defSchedulerInterval))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSchedulerInterval)
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
+	dbConfig := postgres.Config{
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	httpConfig := servers.Config{
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	thingsConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envThingsGRPCURL, defThingsGRPCURL),
+		ClientName: clients.Things,
+	}
+
+	esConfig, err := events.LoadConfig(envESPrefix, defESConsumerName)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	return config{
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		thingsConfig:      thingsConfig,
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		thingsGRPCTimeout: thingsGRPCTimeout,
+		readerURL:         mainflux.Env(envReaderURL, defReaderURL),
+		readerToken:       mainflux.Env(envReaderToken, defReaderToken),
+		url:               mainflux.Env(envURL, defURL),
+		schedule:          schedule,
+		esConfig:          esConfig,
+	}
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
+func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
+	db, err := postgres.Connect(dbConfig)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to postgres: %s", err))
+		os.Exit(1)
+	}
+	return db
+}
+
+func newService(ts protomfx.ThingsServiceClient, publisher messaging.Publisher, dbTracer opentracing.Tracer, db *sqlx.DB, cfg config, logger logger.Logger) reports.Service {
+	database := postgres.NewDatabase(db)
+
+	reportsRepo := postgres.NewReportRepository(database)
+	reportsRepo = tracing.ReportRepositoryMiddleware(dbTracer, reportsRepo)
+
+	artifactsRepo := postgres.NewArtifactRepository(database)
+	artifactsRepo = tracing.ArtifactRepositoryMiddleware(dbTracer, artifactsRepo)
+
+	reader := reports.NewMessageReader(cfg.readerURL, cfg.readerToken)
+	idProvider := uuid.New()
+
+	svc := reports.New(ts, reportsRepo, artifactsRepo, reader, publisher, idProvider, cfg.url)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(
+		svc,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "reports",
+			Subsystem: "api",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, []string{"method"}),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "reports",
+			Subsystem: "api",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, []string{"method"}),
+	)
+
+	return svc
+}
@@ -19,12 +19,18 @@ import (
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers/postgres"
 	mfsmpp "github.com/MainfluxLabs/mainflux/consumers/notifiers/smpp"
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers/tracing"
+	consumerspostgres "github.com/MainfluxLabs/mainflux/consumers/postgres"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	jobspostgres "github.com/MainfluxLabs/mainflux/pkg/jobs/postgres"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
@@ -42,6 +48,10 @@ const (
 	stopWaitTime = 5 * time.Second
 
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
 	defFrom              = ""
 	defJaegerURL         = ""
 	defBrokerURL         = "nats://localhost:4222"
@@ -61,6 +71,9 @@ const (
 	defServerKey         = ""
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
+	defDedupWindow       = "1h"
+	defESConsumerName    = "smpp-notifier"
+	defESGroup           = "mainflux.smpp-notifier"
 
 	defAddress    = ""
 	defUsername   = ""
@@ -72,6 +85,10 @@ const (
 	defDstAddrNPI = "0"
 
 	envLogLevel          = "MF_SMPP_NOTIFIER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
 	envFrom              = "MF_SMPP_NOTIFIER_SOURCE_ADDR"
 	envJaegerURL         = "MF_JAEGER_URL"
 	envBrokerURL         = "MF_BROKER_URL"
@@ -91,6 +108,8 @@ const (
 	envServerKey         = "MF_SMPP_NOTIFIER_SERVER_KEY"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envDedupWindow       = "MF_SMPP_NOTIFIER_DEDUP_WINDOW"
+	envESPrefix          = "MF_SMPP_NOTIFIER"
 
 	envAddress    = "MF_SMPP_ADDRESS"
 	envUsername   = "MF_SMPP_USERNAME"
@@ -105,6 +124,8 @@ const (
 type config struct {
 	brokerURL         string
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	dbConfig          postgres.Config
 	httpConfig        servers.Config
 	thingsConfig      clients.Config
@@ -112,19 +133,39 @@ type config struct {
 	from              string
 	jaegerURL         string
 	thingsGRPCTimeout time.Duration
+	dedupWindow       time.Duration
+	esConfig          events.Config
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger)
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, svcName, logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
@@ -140,7 +181,7 @@ func main() {
 	thingsTracer, thingsCloser := jaeger.Init("smpp_things", cfg.jaegerURL, logger)
 	defer thingsCloser.Close()
 
-	thConn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	thConn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer thConn.Close()
 
 	tc := thingsapi.NewClient(thConn, thingsTracer, cfg.thingsGRPCTimeout)
@@ -150,12 +191,25 @@ func main() {
 
 	svc := newService(cfg, logger, dbTracer, db, tc)
 
-	if err = consumers.Start(svcName, pubSub, svc, brokers.SubjectSmpp); err != nil {
+	processedRepo := consumerspostgres.NewProcessedRepository(postgres.NewDatabase(db))
+	if err = consumers.StartIdempotent(svcName, pubSub, svc, processedRepo, brokers.SubjectSmpp); err != nil {
 		logger.Error(fmt.Sprintf("Failed to create SMPP notifier: %s", err))
 	}
 
+	counter, latency := jobs.NewMetrics(svcName)
+	scheduler := jobs.New(jobspostgres.NewLocker(db), nil, uuid.New(), counter, latency, logger)
+	scheduler.Schedule("remove_processed_messages", jobs.Every(cfg.dedupWindow), consumers.RemoveProcessed(processedRepo, cfg.dedupWindow))
+
 	g.Go(func() error {
-		return servershttp.Start(ctx, httpapi.MakeHandler(notifiersTracer, svc, logger), cfg.httpConfig, logger)
+		return scheduler.Run(ctx)
+	})
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, httpapi.MakeHandler(notifiersTracer, svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	g.Go(func() error {
+		return events.SubscribeGroupRemovals(ctx, cfg.esConfig, defESGroup, svc.RemoveNotifiersByGroup, logger.Module("events"))
 	})
 
 	g.Go(func() error {
@@ -178,6 +232,11 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
 	}
 
+	dedupWindow, err := time.ParseDuration(mainflux.Env(envDedupWindow, defDedupWindow))
+	if err != nil || dedupWindow <= 0 {
+		log.Fatalf("Invalid value passed for %s\n", envDedupWindow)
+	}
+
 	thingsTLS, err := strconv.ParseBool(mainflux.Env(envThingsTLS, defThingsTLS))
 	if err != nil {
 		log.Fatalf("Invalid value passed for %s\n", envThingsTLS)
@@ -211,24 +270,42 @@ func loadConfig() config {
 		DestAddrNPI:   uint8(danpi),
 	}
 
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envHTTPPort, defHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	thingsConfig := clients.Config{
@@ -238,8 +315,15 @@ func loadConfig() config {
 		ClientName: clients.Things,
 	}
 
+	esConfig, err := events.LoadConfig(envESPrefix, defESConsumerName)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	return config{
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		smppConf:          smppConf,
 		dbConfig:          dbConfig,
@@ -248,8 +332,22 @@ func loadConfig() config {
 		from:              mainflux.Env(envFrom, defFrom),
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
+		dedupWindow:       dedupWindow,
+		esConfig:          esConfig,
+	}
+
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
 	}
+	defer db.Close()
 
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
 }
 
 func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
@@ -268,8 +366,12 @@ func newService(c config, logger logger.Logger, dbTracer opentracing.Tracer, db
 	notifier := mfsmpp.New(c.smppConf, c.from)
 	notifierRepo := postgres.NewNotifierRepository(database)
 	notifierRepo = tracing.NotifierRepositoryMiddleware(dbTracer, notifierRepo)
-	svc := notifiers.New(idp, notifier, notifierRepo, tc)
-	svc = api.LoggingMiddleware(svc, logger)
+	windowRepo := postgres.NewMaintenanceWindowRepository(database)
+	windowRepo = tracing.MaintenanceWindowRepositoryMiddleware(dbTracer, windowRepo)
+	scheduleRepo := postgres.NewOnCallScheduleRepository(database)
+	scheduleRepo = tracing.OnCallScheduleRepositoryMiddleware(dbTracer, scheduleRepo)
+	svc := notifiers.New(idp, notifier, notifierRepo, windowRepo, scheduleRepo, tc)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -16,8 +16,11 @@ import (
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	mfmetrics "github.com/MainfluxLabs/mainflux/pkg/metrics"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	serversgrpc "github.com/MainfluxLabs/mainflux/pkg/servers/grpc"
@@ -29,6 +32,7 @@ import (
 	thhttpapi "github.com/MainfluxLabs/mainflux/things/api/http"
 	"github.com/MainfluxLabs/mainflux/things/postgres"
 	rediscache "github.com/MainfluxLabs/mainflux/things/redis"
+	rediscons "github.com/MainfluxLabs/mainflux/things/redis/consumer"
 	localusers "github.com/MainfluxLabs/mainflux/things/standalone"
 	"github.com/MainfluxLabs/mainflux/things/tracing"
 	usersapi "github.com/MainfluxLabs/mainflux/users/api/grpc"
@@ -44,100 +48,133 @@ const (
 	stopWaitTime = 5 * time.Second
 	svcName      = "things"
 
-	defLogLevel        = "error"
-	defDBHost          = "localhost"
-	defDBPort          = "5432"
-	defDBUser          = "mainflux"
-	defDBPass          = "mainflux"
-	defDB              = "things"
-	defDBSSLMode       = "disable"
-	defDBSSLCert       = ""
-	defDBSSLKey        = ""
-	defDBSSLRootCert   = ""
-	defClientTLS       = "false"
-	defCACerts         = ""
-	defCacheURL        = "localhost:6379"
-	defCachePass       = ""
-	defCacheDB         = "0"
-	defESURL           = "localhost:6379"
-	defESPass          = ""
-	defESDB            = "0"
-	defHTTPPort        = "8182"
-	defAuthHTTPPort    = "8989"
-	defAuthGRPCPort    = "8183"
-	defServerCert      = ""
-	defServerKey       = ""
-	defStandaloneEmail = ""
-	defStandaloneToken = ""
-	defJaegerURL       = ""
-	defAuthGRPCURL     = "localhost:8181"
-	defAuthGRPCTimeout = "1s"
-	defUsersCACerts    = ""
-	defUsersClientTLS  = "false"
-	defUsersGRPCURL    = "localhost:8184"
-	defTimeout         = "1s"
-
-	envLogLevel         = "MF_THINGS_LOG_LEVEL"
-	envDBHost           = "MF_THINGS_DB_HOST"
-	envDBPort           = "MF_THINGS_DB_PORT"
-	envDBUser           = "MF_THINGS_DB_USER"
-	envDBPass           = "MF_THINGS_DB_PASS"
-	envDB               = "MF_THINGS_DB"
-	envDBSSLMode        = "MF_THINGS_DB_SSL_MODE"
-	envDBSSLCert        = "MF_THINGS_DB_SSL_CERT"
-	envDBSSLKey         = "MF_THINGS_DB_SSL_KEY"
-	envDBSSLRootCert    = "MF_THINGS_DB_SSL_ROOT_CERT"
-	envClientTLS        = "MF_THINGS_CLIENT_TLS"
-	envCACerts          = "MF_THINGS_CA_CERTS"
-	envCacheURL         = "MF_THINGS_CACHE_URL"
-	envCachePass        = "MF_THINGS_CACHE_PASS"
-	envCacheDB          = "MF_THINGS_CACHE_DB"
-	envESURL            = "MF_THINGS_ES_URL"
-	envESPass           = "MF_THINGS_ES_PASS"
-	envESDB             = "MF_THINGS_ES_DB"
-	envHTTPPort         = "MF_THINGS_HTTP_PORT"
-	envAuthHTTPPort     = "MF_THINGS_AUTH_HTTP_PORT"
-	envAuthGRPCPort     = "MF_THINGS_AUTH_GRPC_PORT"
-	envServerCert       = "MF_THINGS_SERVER_CERT"
-	envServerKey        = "MF_THINGS_SERVER_KEY"
-	envStandaloneEmail  = "MF_THINGS_STANDALONE_EMAIL"
-	envStandaloneToken  = "MF_THINGS_STANDALONE_TOKEN"
-	envJaegerURL        = "MF_JAEGER_URL"
-	envAuthGRPCURL      = "MF_AUTH_GRPC_URL"
-	envAuthGRPCTimeout  = "MF_AUTH_GRPC_TIMEOUT"
-	envUsersGRPCURL     = "MF_USERS_GRPC_URL"
-	envUsersCACerts     = "MF_USERS_CA_CERTS"
-	envUsersClientTLS   = "MF_USERS_CLIENT_TLS"
-	envUsersGRPCTimeout = "MF_USERS_GRPC_TIMEOUT"
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "things"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defClientTLS         = "false"
+	defCACerts           = ""
+	defCacheURL          = "localhost:6379"
+	defCachePass         = ""
+	defCacheDB           = "0"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defESConsumerName    = "things"
+	defHTTPPort          = "8182"
+	defAuthHTTPPort      = "8989"
+	defAuthGRPCPort      = "8183"
+	defServerCert        = ""
+	defServerKey         = ""
+	defStandaloneEmail   = ""
+	defStandaloneToken   = ""
+	defJaegerURL         = ""
+	defAuthGRPCURL       = "localhost:8181"
+	defAuthGRPCTimeout   = "1s"
+	defUsersCACerts      = ""
+	defUsersClientTLS    = "false"
+	defUsersGRPCURL      = "localhost:8184"
+	defTimeout           = "1s"
+	defRateLimit         = "0"
+	defRateLimitBurst    = "0"
+
+	envLogLevel          = "MF_THINGS_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envDBHost            = "MF_THINGS_DB_HOST"
+	envDBPort            = "MF_THINGS_DB_PORT"
+	envDBUser            = "MF_THINGS_DB_USER"
+	envDBPass            = "MF_THINGS_DB_PASS"
+	envDB                = "MF_THINGS_DB"
+	envDBSSLMode         = "MF_THINGS_DB_SSL_MODE"
+	envDBSSLCert         = "MF_THINGS_DB_SSL_CERT"
+	envDBSSLKey          = "MF_THINGS_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_THINGS_DB_SSL_ROOT_CERT"
+	envClientTLS         = "MF_THINGS_CLIENT_TLS"
+	envCACerts           = "MF_THINGS_CA_CERTS"
+	envCacheURL          = "MF_THINGS_CACHE_URL"
+	envCachePass         = "MF_THINGS_CACHE_PASS"
+	envCacheDB           = "MF_THINGS_CACHE_DB"
+	envESURL             = "MF_THINGS_ES_URL"
+	envESPass            = "MF_THINGS_ES_PASS"
+	envESDB              = "MF_THINGS_ES_DB"
+	envESConsumerName    = "MF_THINGS_EVENT_CONSUMER"
+	envHTTPPort          = "MF_THINGS_HTTP_PORT"
+	envAuthHTTPPort      = "MF_THINGS_AUTH_HTTP_PORT"
+	envAuthGRPCPort      = "MF_THINGS_AUTH_GRPC_PORT"
+	envServerCert        = "MF_THINGS_SERVER_CERT"
+	envServerKey         = "MF_THINGS_SERVER_KEY"
+	envStandaloneEmail   = "MF_THINGS_STANDALONE_EMAIL"
+	envStandaloneToken   = "MF_THINGS_STANDALONE_TOKEN"
+	envJaegerURL         = "MF_JAEGER_URL"
+	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
+	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envUsersGRPCURL      = "MF_USERS_GRPC_URL"
+	envUsersCACerts      = "MF_USERS_CA_CERTS"
+	envUsersClientTLS    = "MF_USERS_CLIENT_TLS"
+	envUsersGRPCTimeout  = "MF_USERS_GRPC_TIMEOUT"
+	envRateLimit         = "MF_THINGS_RATE_LIMIT"
+	envRateLimitBurst    = "MF_THINGS_RATE_LIMIT_BURST"
 )
 
 type config struct {
-	logLevel         string
-	dbConfig         postgres.Config
-	httpConfig       servers.Config
-	authHttpConfig   servers.Config
-	grpcConfig       servers.Config
-	authConfig       clients.Config
-	usersConfig      clients.Config
-	cacheURL         string
-	cachePass        string
-	cacheDB          string
-	esURL            string
-	esPass           string
-	esDB             string
-	standaloneEmail  string
-	standaloneToken  string
-	jaegerURL        string
-	authGRPCTimeout  time.Duration
-	usersGRPCTimeout time.Duration
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	authHttpConfig    servers.Config
+	rateLimitConfig   servers.RateLimitConfig
+	tenantConfig      mfmetrics.TenantConfig
+	grpcConfig        servers.Config
+	authConfig        clients.Config
+	usersConfig       clients.Config
+	cacheURL          string
+	cachePass         string
+	cacheDB           string
+	esURL             string
+	esPass            string
+	esDB              string
+	esConsumerName    string
+	standaloneEmail   string
+	standaloneToken   string
+	jaegerURL         string
+	authGRPCTimeout   time.Duration
+	usersGRPCTimeout  time.Duration
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
@@ -169,7 +206,7 @@ func main() {
 	cacheTracer, cacheCloser := jaeger.Init("things_cache", cfg.jaegerURL, logger)
 	defer cacheCloser.Close()
 
-	usrConn := clientsgrpc.Connect(cfg.usersConfig, logger)
+	usrConn := clientsgrpc.Connect(cfg.usersConfig, logger.Module("users"))
 	defer usrConn.Close()
 
 	usersTracer, usersCloser := jaeger.Init("things_users", cfg.jaegerURL, logger)
@@ -177,20 +214,39 @@ func main() {
 
 	users := usersapi.NewClient(usrConn, usersTracer, cfg.usersGRPCTimeout)
 
-	svc := newService(auth, users, dbTracer, cacheTracer, db, cacheClient, esClient, logger)
+	svc := newService(auth, users, dbTracer, cacheTracer, db, cacheClient, esClient, cfg.tenantConfig, logger)
+
+	thingsHandler := thhttpapi.MakeHandler(thingsHttpTracer, svc, logger.Module("http"))
+	authHandler := authhttpapi.MakeHandler(thingsHttpTracer, svc, logger.Module("http"))
+	limiter := servershttp.NewLimiter(cacheClient, svcName, cfg.rateLimitConfig)
+	thingsHandler = servershttp.RateLimit(thingsHandler, limiter, svcName, logger)
+	authHandler = servershttp.RateLimit(authHandler, limiter, svcName, logger)
+	tunables := []mfconfig.Tunable{
+		mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides),
+		mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout),
+		mfconfig.RateLimit(limiter, envRateLimit, defRateLimit, envRateLimitBurst, defRateLimitBurst),
+	}
+
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, tunables...)
+	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, thhttpapi.MakeHandler(thingsHttpTracer, svc, logger), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, thingsHandler, cfg.httpConfig, logger)
 	})
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, authhttpapi.MakeHandler(thingsHttpTracer, svc, logger), cfg.authHttpConfig, logger)
+		return servershttp.Start(ctx, authHandler, cfg.authHttpConfig, logger)
 	})
 
 	g.Go(func() error {
 		return serversgrpc.Start(ctx, thingsGrpcTracer, svc, cfg.grpcConfig, logger)
 	})
 
+	g.Go(func() error {
+		return subscribeToAuthES(ctx, svc, esClient, cfg.esConsumerName, logger)
+	})
+
 	g.Go(func() error {
 		if sig := errors.SignalHandler(ctx); sig != nil {
 			cancel()
@@ -225,32 +281,67 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
 	}
 
+	rateLimit, err := strconv.ParseFloat(mainflux.Env(envRateLimit, defRateLimit), 64)
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimit, err.Error())
+	}
+
+	rateLimitBurst, err := strconv.Atoi(mainflux.Env(envRateLimitBurst, defRateLimitBurst))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimitBurst, err.Error())
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envHTTPPort, defHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	authHttpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envAuthHTTPPort, defAuthHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envAuthHTTPPort, defAuthHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
+	}
+
+	tenantConfig, err := mfmetrics.LoadTenantConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	grpcConfig := servers.Config{
@@ -276,24 +367,29 @@ func loadConfig() config {
 	}
 
 	return config{
-		logLevel:         mainflux.Env(envLogLevel, defLogLevel),
-		dbConfig:         dbConfig,
-		httpConfig:       httpConfig,
-		authHttpConfig:   authHttpConfig,
-		grpcConfig:       grpcConfig,
-		authConfig:       authConfig,
-		usersConfig:      usersConfig,
-		cacheURL:         mainflux.Env(envCacheURL, defCacheURL),
-		cachePass:        mainflux.Env(envCachePass, defCachePass),
-		cacheDB:          mainflux.Env(envCacheDB, defCacheDB),
-		esURL:            mainflux.Env(envESURL, defESURL),
-		esPass:           mainflux.Env(envESPass, defESPass),
-		esDB:             mainflux.Env(envESDB, defESDB),
-		standaloneEmail:  mainflux.Env(envStandaloneEmail, defStandaloneEmail),
-		standaloneToken:  mainflux.Env(envStandaloneToken, defStandaloneToken),
-		jaegerURL:        mainflux.Env(envJaegerURL, defJaegerURL),
-		authGRPCTimeout:  authGRPCTimeout,
-		usersGRPCTimeout: usersGRPCTimeout,
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		authHttpConfig:    authHttpConfig,
+		rateLimitConfig:   servers.RateLimitConfig{Rate: rateLimit, Burst: rateLimitBurst},
+		tenantConfig:      tenantConfig,
+		grpcConfig:        grpcConfig,
+		authConfig:        authConfig,
+		usersConfig:       usersConfig,
+		cacheURL:          mainflux.Env(envCacheURL, defCacheURL),
+		cachePass:         mainflux.Env(envCachePass, defCachePass),
+		cacheDB:           mainflux.Env(envCacheDB, defCacheDB),
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		esConsumerName:    mainflux.Env(envESConsumerName, defESConsumerName),
+		standaloneEmail:   mainflux.Env(envStandaloneEmail, defStandaloneEmail),
+		standaloneToken:   mainflux.Env(envStandaloneToken, defStandaloneToken),
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		authGRPCTimeout:   authGRPCTimeout,
+		usersGRPCTimeout:  usersGRPCTimeout,
 	}
 }
 
@@ -311,6 +407,29 @@ func connectToRedis(cacheURL, cachePass string, cacheDB string, logger logger.Lo
 	})
 }
 
+func subscribeToAuthES(ctx context.Context, svc things.Service, client *redis.Client, consumer string, logger logger.Logger) error {
+	eventStore := rediscons.NewEventStore(svc, client, consumer, logger.Module("events"))
+	logger.Info("Subscribed to Redis Event Store")
+	if err := eventStore.Subscribe(ctx); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to subscribe to auth event store: %s", err))
+		return err
+	}
+
+	return nil
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
 func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	db, err := postgres.Connect(dbConfig)
 	if err != nil {
@@ -325,11 +444,11 @@ func createAuthClient(cfg config, tracer opentracing.Tracer, logger logger.Logge
 		return localusers.NewAuthService(cfg.standaloneEmail, cfg.standaloneToken), nil
 	}
 
-	conn := clientsgrpc.Connect(cfg.authConfig, logger)
+	conn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
 	return authapi.NewClient(conn, tracer, cfg.authGRPCTimeout), conn.Close
 }
 
-func newService(ac protomfx.AuthServiceClient, uc protomfx.UsersServiceClient, dbTracer opentracing.Tracer, cacheTracer opentracing.Tracer, db *sqlx.DB, cacheClient *redis.Client, esClient *redis.Client, logger logger.Logger) things.Service {
+func newService(ac protomfx.AuthServiceClient, uc protomfx.UsersServiceClient, dbTracer opentracing.Tracer, cacheTracer opentracing.Tracer, db *sqlx.DB, cacheClient *redis.Client, esClient *redis.Client, tenantConfig mfmetrics.TenantConfig, logger logger.Logger) things.Service {
 	database := postgres.NewDatabase(db)
 
 	thingsRepo := postgres.NewThingRepository(database)
@@ -349,14 +468,28 @@ func newService(ac protomfx.AuthServiceClient, uc protomfx.UsersServiceClient, d
 
 	groupCache := rediscache.NewGroupCache(cacheClient)
 	groupCache = tracing.GroupCacheMiddleware(cacheTracer, groupCache)
+
+	consumers := rediscons.NewConsumerGroups(esClient)
 	idProvider := uuid.New()
 
 	rolesRepo := postgres.NewRolesRepository(db)
 	rolesRepo = tracing.RolesRepositoryMiddleware(dbTracer, rolesRepo)
 
-	svc := things.New(ac, uc, thingsRepo, profilesRepo, groupsRepo, rolesRepo, profileCache, thingCache, groupCache, idProvider)
+	sharesRepo := postgres.NewShareRepository(database)
+	sharesRepo = tracing.ShareRepositoryMiddleware(dbTracer, sharesRepo)
+
+	orgTemplatesRepo := postgres.NewOrgTemplateRepository(database)
+	orgTemplatesRepo = tracing.OrgTemplateRepositoryMiddleware(dbTracer, orgTemplatesRepo)
+
+	gatewaysRepo := postgres.NewGatewayRepository(database)
+	gatewaysRepo = tracing.GatewayRepositoryMiddleware(dbTracer, gatewaysRepo)
+
+	aclsRepo := postgres.NewNetworkACLRepository(database)
+	aclsRepo = tracing.NetworkACLRepositoryMiddleware(dbTracer, aclsRepo)
+
+	svc := things.New(ac, uc, thingsRepo, profilesRepo, groupsRepo, rolesRepo, sharesRepo, orgTemplatesRepo, gatewaysRepo, aclsRepo, profileCache, thingCache, groupCache, consumers, idProvider)
 	svc = rediscache.NewEventStoreMiddleware(svc, esClient)
-	svc = api.LoggingMiddleware(svc, logger)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -371,6 +504,13 @@ func newService(ac protomfx.AuthServiceClient, uc protomfx.UsersServiceClient, d
 			Name:      "request_latency_microseconds",
 			Help:      "Total duration of requests in microseconds.",
 		}, []string{"method"}),
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "things",
+			Subsystem: "api",
+			Name:      "tenant_request_count",
+			Help:      "Number of org or group scoped requests received per tenant.",
+		}, append([]string{"method"}, mfmetrics.TenantLabelNames...)),
+		mfmetrics.NewTenants(tenantConfig),
 	)
 	return svc
 }
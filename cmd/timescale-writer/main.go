@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux"
@@ -15,8 +16,11 @@ import (
 	"github.com/MainfluxLabs/mainflux/consumers/writers/api"
 	"github.com/MainfluxLabs/mainflux/consumers/writers/timescale"
 	"github.com/MainfluxLabs/mainflux/logger"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
@@ -29,50 +33,87 @@ const (
 	svcName      = "timescaledb-writer"
 	stopWaitTime = 5 * time.Second
 
-	defLogLevel      = "error"
-	defBrokerURL     = "nats://localhost:4222"
-	defPort          = "8180"
-	defDBHost        = "localhost"
-	defDBPort        = "5432"
-	defDBUser        = "mainflux"
-	defDBPass        = "mainflux"
-	defDB            = "mainflux"
-	defDBSSLMode     = "disable"
-	defDBSSLCert     = ""
-	defDBSSLKey      = ""
-	defDBSSLRootCert = ""
-	envBrokerURL     = "MF_BROKER_URL"
-	envLogLevel      = "MF_TIMESCALE_WRITER_LOG_LEVEL"
-	envPort          = "MF_TIMESCALE_WRITER_PORT"
-	envDBHost        = "MF_TIMESCALE_WRITER_DB_HOST"
-	envDBPort        = "MF_TIMESCALE_WRITER_DB_PORT"
-	envDBUser        = "MF_TIMESCALE_WRITER_DB_USER"
-	envDBPass        = "MF_TIMESCALE_WRITER_DB_PASS"
-	envDB            = "MF_TIMESCALE_WRITER_DB"
-	envDBSSLMode     = "MF_TIMESCALE_WRITER_DB_SSL_MODE"
-	envDBSSLCert     = "MF_TIMESCALE_WRITER_DB_SSL_CERT"
-	envDBSSLKey      = "MF_TIMESCALE_WRITER_DB_SSL_KEY"
-	envDBSSLRootCert = "MF_TIMESCALE_WRITER_DB_SSL_ROOT_CERT"
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defBrokerURL         = "nats://localhost:4222"
+	defPort              = "8180"
+	defName              = "timescale"
+	defPrimary           = "false"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "mainflux"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defOrgDBs            = ""
+	envBrokerURL         = "MF_BROKER_URL"
+	envLogLevel          = "MF_TIMESCALE_WRITER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envPort              = "MF_TIMESCALE_WRITER_PORT"
+	envName              = "MF_TIMESCALE_WRITER_NAME"
+	envPrimary           = "MF_TIMESCALE_WRITER_PRIMARY"
+	envDBHost            = "MF_TIMESCALE_WRITER_DB_HOST"
+	envDBPort            = "MF_TIMESCALE_WRITER_DB_PORT"
+	envDBUser            = "MF_TIMESCALE_WRITER_DB_USER"
+	envDBPass            = "MF_TIMESCALE_WRITER_DB_PASS"
+	envDB                = "MF_TIMESCALE_WRITER_DB"
+	envDBSSLMode         = "MF_TIMESCALE_WRITER_DB_SSL_MODE"
+	envDBSSLCert         = "MF_TIMESCALE_WRITER_DB_SSL_CERT"
+	envDBSSLKey          = "MF_TIMESCALE_WRITER_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_TIMESCALE_WRITER_DB_SSL_ROOT_CERT"
+	envOrgDBs            = "MF_TIMESCALE_WRITER_ORG_DBS"
 )
 
 type config struct {
-	brokerURL  string
-	logLevel   string
-	dbConfig   timescale.Config
-	httpConfig servers.Config
+	brokerURL         string
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	name              string
+	primary           bool
+	dbConfig          timescale.Config
+	orgDBs            map[string]string
+	httpConfig        servers.Config
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger)
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
@@ -82,14 +123,30 @@ func main() {
 	db := connectToDB(cfg.dbConfig, logger)
 	defer db.Close()
 
-	repo := newService(db, logger)
+	counter, latency := makeMetrics()
+	repo := newService(db, logger, counter, latency)
+
+	routes := consumers.Routes{}
+	for orgID, name := range cfg.orgDBs {
+		dbConfig := cfg.dbConfig
+		dbConfig.Name = name
+
+		orgDB := connectToDB(dbConfig, logger)
+		defer orgDB.Close()
 
-	if err = consumers.Start(svcName, pubSub, repo, brokers.SubjectSenML, brokers.SubjectJSON); err != nil {
+		routes[orgID] = newService(orgDB, logger, counter, latency)
+	}
+
+	start := consumers.StartWriter
+	if cfg.primary {
+		start = consumers.StartPrimaryWriter
+	}
+	if err = start(svcName, cfg.name, pubSub, repo, routes, brokers.SubjectSenML, brokers.SubjectJSON); err != nil {
 		logger.Error(fmt.Sprintf("Failed to create Timescale writer: %s", err))
 	}
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, api.MakeHandler(svcName), cfg.httpConfig, logger)
+		return servershttp.Start(ctx, api.MakeHandler(svcName), cfg.httpConfig, logger.Module("http"))
 	})
 
 	g.Go(func() error {
@@ -106,29 +163,74 @@ func main() {
 }
 
 func loadConfig() config {
+	primary, err := strconv.ParseBool(mainflux.Env(envPrimary, defPrimary))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envPrimary)
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := timescale.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	orgDBs, err := dbutil.ParseOrgDatabases(mainflux.Env(envOrgDBs, defOrgDBs))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s: %s\n", envOrgDBs, err)
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		Port:         mainflux.Env(envPort, defPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		Port:           mainflux.Env(envPort, defPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	return config{
-		brokerURL:  mainflux.Env(envBrokerURL, defBrokerURL),
-		logLevel:   mainflux.Env(envLogLevel, defLogLevel),
-		dbConfig:   dbConfig,
-		httpConfig: httpConfig,
+		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		orgDBs:            orgDBs,
+		name:              mainflux.Env(envName, defName),
+		primary:           primary,
+		httpConfig:        httpConfig,
+	}
+}
+
+func runMigrations(dbConfig timescale.Config) {
+	db, err := timescale.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to timescale: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, timescale.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
 	}
 }
 
@@ -141,24 +243,28 @@ func connectToDB(dbConfig timescale.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(db *sqlx.DB, logger logger.Logger) consumers.Consumer {
+func newService(db *sqlx.DB, logger logger.Logger, counter *kitprometheus.Counter, latency *kitprometheus.Summary) consumers.Consumer {
 	svc := timescale.New(db)
-	svc = api.LoggingMiddleware(svc, logger)
-	svc = api.MetricsMiddleware(
-		svc,
-		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
-			Namespace: "timescale",
-			Subsystem: "message_writer",
-			Name:      "request_count",
-			Help:      "Number of requests received.",
-		}, []string{"method"}),
-		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
-			Namespace: "timescale",
-			Subsystem: "message_writer",
-			Name:      "request_latency_microseconds",
-			Help:      "Total duration of requests in microseconds.",
-		}, []string{"method"}),
-	)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
+	svc = api.MetricsMiddleware(svc, counter, latency)
 
 	return svc
 }
+
+func makeMetrics() (*kitprometheus.Counter, *kitprometheus.Summary) {
+	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "timescale",
+		Subsystem: "message_writer",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, []string{"method"})
+
+	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "timescale",
+		Subsystem: "message_writer",
+		Name:      "request_latency_microseconds",
+		Help:      "Total duration of requests in microseconds.",
+	}, []string{"method"})
+
+	return counter, latency
+}
@@ -17,9 +17,13 @@ import (
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/email"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	jobspostgres "github.com/MainfluxLabs/mainflux/pkg/jobs/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	serversgrpc "github.com/MainfluxLabs/mainflux/pkg/servers/grpc"
@@ -28,10 +32,13 @@ import (
 	"github.com/MainfluxLabs/mainflux/users"
 	httpapi "github.com/MainfluxLabs/mainflux/users/api/http"
 	"github.com/MainfluxLabs/mainflux/users/bcrypt"
+	"github.com/MainfluxLabs/mainflux/users/captcha"
 	"github.com/MainfluxLabs/mainflux/users/emailer"
 	"github.com/MainfluxLabs/mainflux/users/postgres"
+	usersredis "github.com/MainfluxLabs/mainflux/users/redis"
 	"github.com/MainfluxLabs/mainflux/users/tracing"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	"github.com/jmoiron/sqlx"
 	opentracing "github.com/opentracing/opentracing-go"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
@@ -42,20 +49,24 @@ const (
 	stopWaitTime = 5 * time.Second
 	svcName      = "users"
 
-	defLogLevel      = "error"
-	defDBHost        = "localhost"
-	defDBPort        = "5432"
-	defDBUser        = "mainflux"
-	defDBPass        = "mainflux"
-	defDB            = "users"
-	defDBSSLMode     = "disable"
-	defDBSSLCert     = ""
-	defDBSSLKey      = ""
-	defDBSSLRootCert = ""
-	defHTTPPort      = "8180"
-	defServerCert    = ""
-	defServerKey     = ""
-	defJaegerURL     = ""
+	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
+	defDBHost            = "localhost"
+	defDBPort            = "5432"
+	defDBUser            = "mainflux"
+	defDBPass            = "mainflux"
+	defDB                = "users"
+	defDBSSLMode         = "disable"
+	defDBSSLCert         = ""
+	defDBSSLKey          = ""
+	defDBSSLRootCert     = ""
+	defHTTPPort          = "8180"
+	defServerCert        = ""
+	defServerKey         = ""
+	defJaegerURL         = ""
 
 	defEmailHost        = "localhost"
 	defEmailPort        = "25"
@@ -68,7 +79,10 @@ const (
 	defAdminPassword    = ""
 	defPassRegex        = "^.{8,}$"
 
-	defTokenResetEndpoint = "/reset-request" // URL where user lands after click on the reset link from email
+	defTokenResetEndpoint    = "/reset-request"  // URL where user lands after click on the reset link from email
+	defTokenDeletionEndpoint = "/delete-account" // URL where user lands after click on the account deletion link from email
+	defDeletionGracePeriod   = "720h"
+	defDeletionScanInterval  = "1h"
 
 	defAuthTLS         = "false"
 	defAuthCACerts     = ""
@@ -76,22 +90,38 @@ const (
 	defAuthGRPCTimeout = "1s"
 	defGRPCPort        = "8184"
 
-	defSelfRegister = "true" // By default, everybody can create a user. Otherwise, only admin can create a user.
-
-	envLogLevel      = "MF_USERS_LOG_LEVEL"
-	envDBHost        = "MF_USERS_DB_HOST"
-	envDBPort        = "MF_USERS_DB_PORT"
-	envDBUser        = "MF_USERS_DB_USER"
-	envDBPass        = "MF_USERS_DB_PASS"
-	envDB            = "MF_USERS_DB"
-	envDBSSLMode     = "MF_USERS_DB_SSL_MODE"
-	envDBSSLCert     = "MF_USERS_DB_SSL_CERT"
-	envDBSSLKey      = "MF_USERS_DB_SSL_KEY"
-	envDBSSLRootCert = "MF_USERS_DB_SSL_ROOT_CERT"
-	envHTTPPort      = "MF_USERS_HTTP_PORT"
-	envServerCert    = "MF_USERS_SERVER_CERT"
-	envServerKey     = "MF_USERS_SERVER_KEY"
-	envJaegerURL     = "MF_JAEGER_URL"
+	defSelfRegister   = "true" // By default, everybody can create a user. Otherwise, only admin can create a user.
+	defRateLimit      = "0"
+	defRateLimitBurst = "0"
+	defRateLimitURL   = "localhost:6379"
+	defRateLimitPass  = ""
+	defRateLimitDB    = "0"
+
+	defLoginCaptchaAfter  = "0"
+	defLoginMaxFailures   = "0"
+	defLoginFailureWindow = "15m"
+	defCaptchaURL         = captcha.HCaptchaURL
+	defCaptchaSecret      = ""
+	defCaptchaTimeout     = "5s"
+
+	envLogLevel          = "MF_USERS_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
+	envDBHost            = "MF_USERS_DB_HOST"
+	envDBPort            = "MF_USERS_DB_PORT"
+	envDBUser            = "MF_USERS_DB_USER"
+	envDBPass            = "MF_USERS_DB_PASS"
+	envDB                = "MF_USERS_DB"
+	envDBSSLMode         = "MF_USERS_DB_SSL_MODE"
+	envDBSSLCert         = "MF_USERS_DB_SSL_CERT"
+	envDBSSLKey          = "MF_USERS_DB_SSL_KEY"
+	envDBSSLRootCert     = "MF_USERS_DB_SSL_ROOT_CERT"
+	envHTTPPort          = "MF_USERS_HTTP_PORT"
+	envServerCert        = "MF_USERS_SERVER_CERT"
+	envServerKey         = "MF_USERS_SERVER_KEY"
+	envJaegerURL         = "MF_JAEGER_URL"
 
 	envAdminEmail    = "MF_USERS_ADMIN_EMAIL"
 	envAdminPassword = "MF_USERS_ADMIN_PASSWORD"
@@ -105,7 +135,10 @@ const (
 	envEmailFromName    = "MF_EMAIL_FROM_NAME"
 	envEmailTemplate    = "MF_EMAIL_TEMPLATE"
 
-	envTokenResetEndpoint = "MF_TOKEN_RESET_ENDPOINT"
+	envTokenResetEndpoint    = "MF_TOKEN_RESET_ENDPOINT"
+	envTokenDeletionEndpoint = "MF_TOKEN_DELETION_ENDPOINT"
+	envDeletionGracePeriod   = "MF_USERS_DELETION_GRACE_PERIOD"
+	envDeletionScanInterval  = "MF_USERS_DELETION_SCAN_INTERVAL"
 
 	envAuthTLS         = "MF_AUTH_CLIENT_TLS"
 	envAuthCACerts     = "MF_AUTH_CA_CERTS"
@@ -113,34 +146,74 @@ const (
 	envauthGRPCTimeout = "MF_AUTH_GRPC_TIMEOUT"
 	envGRPCPort        = "MF_USERS_GRPC_PORT"
 
-	envSelfRegister = "MF_USERS_ALLOW_SELF_REGISTER"
+	envSelfRegister   = "MF_USERS_ALLOW_SELF_REGISTER"
+	envRateLimit      = "MF_USERS_RATE_LIMIT"
+	envRateLimitBurst = "MF_USERS_RATE_LIMIT_BURST"
+	envRateLimitURL   = "MF_USERS_RATE_LIMIT_URL"
+	envRateLimitPass  = "MF_USERS_RATE_LIMIT_PASS"
+	envRateLimitDB    = "MF_USERS_RATE_LIMIT_DB"
+
+	envLoginCaptchaAfter  = "MF_USERS_LOGIN_CAPTCHA_AFTER"
+	envLoginMaxFailures   = "MF_USERS_LOGIN_MAX_FAILURES"
+	envLoginFailureWindow = "MF_USERS_LOGIN_FAILURE_WINDOW"
+	envCaptchaURL         = "MF_USERS_CAPTCHA_URL"
+	envCaptchaSecret      = "MF_USERS_CAPTCHA_SECRET"
+	envCaptchaTimeout     = "MF_USERS_CAPTCHA_TIMEOUT"
 )
 
 type config struct {
-	logLevel        string
-	dbConfig        postgres.Config
-	httpConfig      servers.Config
-	grpcConfig      servers.Config
-	emailConf       email.Config
-	authConfig      clients.Config
-	jaegerURL       string
-	resetURL        string
-	authGRPCTimeout time.Duration
-	adminEmail      string
-	adminPassword   string
-	passRegex       *regexp.Regexp
-	selfRegister    bool
+	logLevel          string
+	logFormat         string
+	logLevelOverrides string
+	dbConfig          postgres.Config
+	httpConfig        servers.Config
+	grpcConfig        servers.Config
+	emailConf         email.Config
+	authConfig        clients.Config
+	jaegerURL         string
+	resetURL          string
+	deletionURL       string
+	gracePeriod       time.Duration
+	deletionSchedule  jobs.Schedule
+	authGRPCTimeout   time.Duration
+	adminEmail        string
+	adminPassword     string
+	passRegex         *regexp.Regexp
+	selfRegister      bool
+	rateLimitConfig   servers.RateLimitConfig
+	rateLimitURL      string
+	rateLimitPass     string
+	rateLimitDB       string
+	throttleConfig    users.ThrottleConfig
+	captchaURL        string
+	captchaSecret     string
+	captchaTimeout    time.Duration
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
+
 	db := connectToDB(cfg.dbConfig, logger)
 	defer db.Close()
 
@@ -153,7 +226,7 @@ func main() {
 	authTracer, closer := jaeger.Init("users_auth", cfg.jaegerURL, logger)
 	defer closer.Close()
 
-	authConn := clientsgrpc.Connect(cfg.authConfig, logger)
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
 	defer authConn.Close()
 
 	auth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
@@ -161,10 +234,27 @@ func main() {
 	dbTracer, dbCloser := jaeger.Init("users_db", cfg.jaegerURL, logger)
 	defer dbCloser.Close()
 
-	svc := newService(db, dbTracer, auth, cfg, logger)
+	// The Redis client is shared by the rate limiter and the login throttle.
+	rlClient := connectToRedis(cfg.rateLimitURL, cfg.rateLimitPass, cfg.rateLimitDB, logger)
+	defer rlClient.Close()
+
+	svc := newService(db, dbTracer, auth, rlClient, cfg, logger)
+
+	handler := httpapi.MakeHandler(svc, usersHttpTracer, logger.Module("http"))
+	limiter := servershttp.NewLimiter(rlClient, svcName, cfg.rateLimitConfig)
+	handler = servershttp.RateLimit(handler, limiter, svcName, logger)
+	tunables := []mfconfig.Tunable{
+		mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides),
+		mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout),
+		mfconfig.RateLimit(limiter, envRateLimit, defRateLimit, envRateLimitBurst, defRateLimitBurst),
+	}
 
 	g.Go(func() error {
-		return servershttp.Start(ctx, httpapi.MakeHandler(svc, usersHttpTracer, logger), cfg.httpConfig, logger)
+		return mfconfig.Reload(ctx, cfgFile, logger, tunables...)
+	})
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, handler, cfg.httpConfig, logger)
 	})
 
 	g.Go(func() error {
@@ -179,6 +269,16 @@ func main() {
 		return serversgrpc.Start(ctx, usersGrpcTracer, svc, cfg.grpcConfig, logger)
 	})
 
+	counter, latency := jobs.NewMetrics(svcName)
+	scheduler := jobs.New(jobspostgres.NewLocker(db), nil, uuid.New(), counter, latency, logger)
+	// The errors are logged by the logging middleware, and the failed
+	// removals are retried on the next run.
+	scheduler.Schedule("remove_scheduled_users", cfg.deletionSchedule, svc.RemoveScheduledUsers)
+
+	g.Go(func() error {
+		return scheduler.Run(ctx)
+	})
+
 	if err := g.Wait(); err != nil {
 		logger.Error(fmt.Sprintf("Users service terminated: %s", err))
 	}
@@ -205,24 +305,87 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envSelfRegister, err.Error())
 	}
 
+	rateLimit, err := strconv.ParseFloat(mainflux.Env(envRateLimit, defRateLimit), 64)
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimit, err.Error())
+	}
+
+	rateLimitBurst, err := strconv.Atoi(mainflux.Env(envRateLimitBurst, defRateLimitBurst))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRateLimitBurst, err.Error())
+	}
+
+	captchaAfter, err := strconv.Atoi(mainflux.Env(envLoginCaptchaAfter, defLoginCaptchaAfter))
+	if err != nil || captchaAfter < 0 {
+		log.Fatalf("Invalid value passed for %s\n", envLoginCaptchaAfter)
+	}
+
+	maxFailures, err := strconv.Atoi(mainflux.Env(envLoginMaxFailures, defLoginMaxFailures))
+	if err != nil || maxFailures < 0 {
+		log.Fatalf("Invalid value passed for %s\n", envLoginMaxFailures)
+	}
+
+	failureWindow, err := time.ParseDuration(mainflux.Env(envLoginFailureWindow, defLoginFailureWindow))
+	if err != nil || failureWindow <= 0 {
+		log.Fatalf("Invalid value passed for %s\n", envLoginFailureWindow)
+	}
+
+	captchaSecret := mainflux.Env(envCaptchaSecret, defCaptchaSecret)
+	if captchaAfter > 0 && captchaSecret == "" {
+		log.Fatalf("%s must be set to require the CAPTCHA after %s failed logins\n", envCaptchaSecret, envLoginCaptchaAfter)
+	}
+
+	captchaTimeout, err := time.ParseDuration(mainflux.Env(envCaptchaTimeout, defCaptchaTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envCaptchaTimeout, err.Error())
+	}
+
+	gracePeriod, err := time.ParseDuration(mainflux.Env(envDeletionGracePeriod, defDeletionGracePeriod))
+	if err != nil || gracePeriod < 0 {
+		log.Fatalf("Invalid value passed for %s\n", envDeletionGracePeriod)
+	}
+
+	deletionSchedule, err := jobs.ParseSchedule(mainflux.Env(envDeletionScanInterval, defDeletionScanInterval))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envDeletionScanInterval)
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		Port:         mainflux.Env(envHTTPPort, defHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	grpcConfig := servers.Config{
@@ -251,23 +414,62 @@ func loadConfig() config {
 	}
 
 	return config{
-		logLevel:        mainflux.Env(envLogLevel, defLogLevel),
-		dbConfig:        dbConfig,
-		httpConfig:      httpConfig,
-		grpcConfig:      grpcConfig,
-		emailConf:       emailConf,
-		authConfig:      authConfig,
-		jaegerURL:       mainflux.Env(envJaegerURL, defJaegerURL),
-		resetURL:        mainflux.Env(envTokenResetEndpoint, defTokenResetEndpoint),
-		authGRPCTimeout: authGRPCTimeout,
-		adminEmail:      mainflux.Env(envAdminEmail, defAdminEmail),
-		adminPassword:   mainflux.Env(envAdminPassword, defAdminPassword),
-		passRegex:       passRegex,
-		selfRegister:    selfRegister,
+		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
+		dbConfig:          dbConfig,
+		httpConfig:        httpConfig,
+		grpcConfig:        grpcConfig,
+		emailConf:         emailConf,
+		authConfig:        authConfig,
+		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
+		resetURL:          mainflux.Env(envTokenResetEndpoint, defTokenResetEndpoint),
+		deletionURL:       mainflux.Env(envTokenDeletionEndpoint, defTokenDeletionEndpoint),
+		gracePeriod:       gracePeriod,
+		deletionSchedule:  deletionSchedule,
+		authGRPCTimeout:   authGRPCTimeout,
+		adminEmail:        mainflux.Env(envAdminEmail, defAdminEmail),
+		adminPassword:     mainflux.Env(envAdminPassword, defAdminPassword),
+		passRegex:         passRegex,
+		selfRegister:      selfRegister,
+		rateLimitConfig:   servers.RateLimitConfig{Rate: rateLimit, Burst: rateLimitBurst},
+		rateLimitURL:      mainflux.Env(envRateLimitURL, defRateLimitURL),
+		rateLimitPass:     mainflux.Env(envRateLimitPass, defRateLimitPass),
+		rateLimitDB:       mainflux.Env(envRateLimitDB, defRateLimitDB),
+		throttleConfig:    users.ThrottleConfig{CaptchaAfter: captchaAfter, MaxFailures: maxFailures, Window: failureWindow},
+		captchaURL:        mainflux.Env(envCaptchaURL, defCaptchaURL),
+		captchaSecret:     captchaSecret,
+		captchaTimeout:    captchaTimeout,
 	}
 
 }
 
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
+	}
+}
+
 func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	db, err := postgres.Connect(dbConfig)
 	if err != nil {
@@ -277,20 +479,35 @@ func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(db *sqlx.DB, tracer opentracing.Tracer, ac protomfx.AuthServiceClient, c config, logger logger.Logger) users.Service {
+func throttlingEnabled(cfg users.ThrottleConfig) bool {
+	return cfg.CaptchaAfter > 0 || cfg.MaxFailures > 0
+}
+
+func newService(db *sqlx.DB, tracer opentracing.Tracer, ac protomfx.AuthServiceClient, rlClient *redis.Client, c config, logger logger.Logger) users.Service {
 	database := postgres.NewDatabase(db)
 	hasher := bcrypt.New()
 	userRepo := tracing.UserRepositoryMiddleware(postgres.NewUserRepo(database), tracer)
+	deletionRepo := tracing.DeletionRepositoryMiddleware(postgres.NewDeletionRepo(database), tracer)
 
-	emailer, err := emailer.New(c.resetURL, &c.emailConf)
+	emailer, err := emailer.New(c.resetURL, c.deletionURL, &c.emailConf)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to configure e-mailing util: %s", err.Error()))
 	}
 
 	idProvider := uuid.New()
 
-	svc := users.New(userRepo, hasher, ac, emailer, idProvider, c.passRegex)
-	svc = httpapi.LoggingMiddleware(svc, logger)
+	var throttle users.LoginThrottle
+	if throttlingEnabled(c.throttleConfig) {
+		throttle = usersredis.NewLoginThrottle(rlClient, c.throttleConfig)
+	}
+
+	var verifier users.CaptchaVerifier
+	if c.captchaSecret != "" {
+		verifier = captcha.NewVerifier(c.captchaURL, c.captchaSecret, c.captchaTimeout)
+	}
+
+	svc := users.New(userRepo, deletionRepo, hasher, ac, emailer, idProvider, c.passRegex, c.gracePeriod, throttle, verifier)
+	svc = httpapi.LoggingMiddleware(svc, logger.Module("api"))
 	svc = httpapi.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -16,13 +16,19 @@ import (
 	"time"
 
 	"github.com/MainfluxLabs/mainflux"
+	authapi "github.com/MainfluxLabs/mainflux/auth/api/grpc"
 	"github.com/MainfluxLabs/mainflux/consumers"
+	consumerspostgres "github.com/MainfluxLabs/mainflux/consumers/postgres"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	jobspostgres "github.com/MainfluxLabs/mainflux/pkg/jobs/postgres"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/servers"
 	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
@@ -32,8 +38,11 @@ import (
 	"github.com/MainfluxLabs/mainflux/webhooks/api"
 	httpapi "github.com/MainfluxLabs/mainflux/webhooks/api/http"
 	"github.com/MainfluxLabs/mainflux/webhooks/postgres"
+	whredis "github.com/MainfluxLabs/mainflux/webhooks/redis"
+	rediscons "github.com/MainfluxLabs/mainflux/webhooks/redis/consumer"
 	"github.com/MainfluxLabs/mainflux/webhooks/tracing"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
 	"github.com/jmoiron/sqlx"
 	"github.com/opentracing/opentracing-go"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
@@ -46,6 +55,10 @@ const (
 
 	defBrokerURL         = "nats://localhost:4222"
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
+	defSkipMigrations    = "false"
 	defDBHost            = "localhost"
 	defDBPort            = "5432"
 	defDBUser            = "mainflux"
@@ -63,9 +76,23 @@ const (
 	defServerKey         = ""
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
+	defDedupWindow       = "1h"
+	defAuthGRPCURL       = "localhost:8181"
+	defAuthGRPCTimeout   = "1s"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defESConsumerName    = "webhooks"
+	defRecentMessages    = "0"
+	defRecentMessagesTTL = "15m"
+	defEncryptionKey     = ""
 
 	envBrokerURL         = "MF_BROKER_URL"
 	envLogLevel          = "MF_WEBHOOKS_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
+	envSkipMigrations    = "MF_DB_SKIP_MIGRATIONS"
 	envDBHost            = "MF_WEBHOOKS_DB_HOST"
 	envDBPort            = "MF_WEBHOOKS_DB_PORT"
 	envDBUser            = "MF_WEBHOOKS_DB_USER"
@@ -83,29 +110,69 @@ const (
 	envJaegerURL         = "MF_JAEGER_URL"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envDedupWindow       = "MF_WEBHOOKS_DEDUP_WINDOW"
+	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
+	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envESURL             = "MF_WEBHOOKS_ES_URL"
+	envESPass            = "MF_WEBHOOKS_ES_PASS"
+	envESDB              = "MF_WEBHOOKS_ES_DB"
+	envESConsumerName    = "MF_WEBHOOKS_EVENT_CONSUMER"
+	envRecentMessages    = "MF_WEBHOOKS_RECENT_MESSAGES"
+	envRecentMessagesTTL = "MF_WEBHOOKS_RECENT_MESSAGES_TTL"
+	envEncryptionKey     = "MF_WEBHOOKS_ENCRYPTION_KEY"
 )
 
 type config struct {
 	brokerURL         string
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	dbConfig          postgres.Config
 	httpConfig        servers.Config
 	thingsConfig      clients.Config
+	authConfig        clients.Config
 	jaegerURL         string
 	thingsGRPCTimeout time.Duration
+	dedupWindow       time.Duration
+	authGRPCTimeout   time.Duration
+	esURL             string
+	esPass            string
+	esDB              string
+	esConsumerName    string
+	recentMessages    uint64
+	recentMessagesTTL time.Duration
+	encryptionKey     string
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
+	if len(os.Args) > 1 && os.Args[1] == migrations.Command {
+		runMigrations(cfg.dbConfig)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	pubSub, err := brokers.NewPubSub(cfg.brokerURL, "", logger)
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides), mfconfig.RequestTimeout(cfg.httpConfig.RequestTimeout))
+	})
+
+	pubSub, err := brokers.NewPubSub(cfg.brokerURL, svcName, logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
@@ -121,22 +188,57 @@ func main() {
 	thingsTracer, thingsCloser := jaeger.Init("webhooks_things", cfg.jaegerURL, logger)
 	defer thingsCloser.Close()
 
-	thingsConn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	thingsConn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer thingsConn.Close()
 
 	things := thingsapi.NewClient(thingsConn, thingsTracer, cfg.thingsGRPCTimeout)
 
+	authTracer, authCloser := jaeger.Init("webhooks_auth", cfg.jaegerURL, logger)
+	defer authCloser.Close()
+
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
+	defer authConn.Close()
+
+	auth := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
+
 	dbTracer, dbCloser := jaeger.Init("webhooks_db", cfg.jaegerURL, logger)
 	defer dbCloser.Close()
 
-	svc := newService(things, dbTracer, db, logger)
+	esClient := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
+	defer esClient.Close()
+
+	forwarder := webhooks.NewForwarder(logger)
+	defer func() {
+		if err := forwarder.Close(); err != nil {
+			logger.Error(fmt.Sprintf("Failed to flush webhook batches: %s", err))
+		}
+	}()
+
+	svc := newService(things, auth, dbTracer, db, esClient, forwarder, cfg, logger)
 
-	if err = consumers.Start(svcName, pubSub, svc, brokers.SubjectWebhook); err != nil {
+	processedRepo := consumerspostgres.NewProcessedRepository(postgres.NewDatabase(db))
+	if err = consumers.StartIdempotent(svcName, pubSub, svc, processedRepo, brokers.SubjectWebhook); err != nil {
 		logger.Error(fmt.Sprintf("Failed to create Webhook: %s", err))
 	}
 
+	if err = consumers.Start(svcName, pubSub, svc, brokers.SubjectAlarms); err != nil {
+		logger.Error(fmt.Sprintf("Failed to subscribe to alarms: %s", err))
+	}
+
+	counter, latency := jobs.NewMetrics(svcName)
+	scheduler := jobs.New(jobspostgres.NewLocker(db), nil, uuid.New(), counter, latency, logger)
+	scheduler.Schedule("remove_processed_messages", jobs.Every(cfg.dedupWindow), consumers.RemoveProcessed(processedRepo, cfg.dedupWindow))
+
 	g.Go(func() error {
-		return servershttp.Start(ctx, httpapi.MakeHandler(webhooksTracer, svc, logger), cfg.httpConfig, logger)
+		return scheduler.Run(ctx)
+	})
+
+	g.Go(func() error {
+		return servershttp.Start(ctx, httpapi.MakeHandler(webhooksTracer, svc, logger.Module("http")), cfg.httpConfig, logger)
+	})
+
+	g.Go(func() error {
+		return subscribeToES(ctx, svc, esClient, cfg.esConsumerName, logger)
 	})
 
 	g.Go(func() error {
@@ -163,24 +265,62 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
 	}
 
+	dedupWindow, err := time.ParseDuration(mainflux.Env(envDedupWindow, defDedupWindow))
+	if err != nil || dedupWindow <= 0 {
+		log.Fatalf("Invalid value passed for %s\n", envDedupWindow)
+	}
+
+	authGRPCTimeout, err := time.ParseDuration(mainflux.Env(envAuthGRPCTimeout, defAuthGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
+	}
+
+	skipMigrations, err := strconv.ParseBool(mainflux.Env(envSkipMigrations, defSkipMigrations))
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envSkipMigrations)
+	}
+
+	recentMessages, err := strconv.ParseUint(mainflux.Env(envRecentMessages, defRecentMessages), 10, 64)
+	if err != nil {
+		log.Fatalf("Invalid value passed for %s\n", envRecentMessages)
+	}
+
+	recentMessagesTTL, err := time.ParseDuration(mainflux.Env(envRecentMessagesTTL, defRecentMessagesTTL))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRecentMessagesTTL, err.Error())
+	}
+
 	dbConfig := postgres.Config{
-		Host:        mainflux.Env(envDBHost, defDBHost),
-		Port:        mainflux.Env(envDBPort, defDBPort),
-		User:        mainflux.Env(envDBUser, defDBUser),
-		Pass:        mainflux.Env(envDBPass, defDBPass),
-		Name:        mainflux.Env(envDB, defDB),
-		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
-		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
-		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
-		SSLRootCert: mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		Host:           mainflux.Env(envDBHost, defDBHost),
+		Port:           mainflux.Env(envDBPort, defDBPort),
+		User:           mainflux.Env(envDBUser, defDBUser),
+		Pass:           mainflux.Env(envDBPass, defDBPass),
+		Name:           mainflux.Env(envDB, defDB),
+		SSLMode:        mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:        mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:         mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert:    mainflux.Env(envDBSSLRootCert, defDBSSLRootCert),
+		SkipMigrations: skipMigrations,
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	requestTimeout, err := servers.LoadRequestTimeout()
+	if err != nil {
+		log.Fatalf(err.Error())
 	}
 
 	httpConfig := servers.Config{
-		ServerName:   svcName,
-		ServerCert:   mainflux.Env(envServerCert, defServerCert),
-		ServerKey:    mainflux.Env(envServerKey, defServerKey),
-		Port:         mainflux.Env(envHTTPPort, defHTTPPort),
-		StopWaitTime: stopWaitTime,
+		ServerName:     svcName,
+		ServerCert:     mainflux.Env(envServerCert, defServerCert),
+		ServerKey:      mainflux.Env(envServerKey, defServerKey),
+		Port:           mainflux.Env(envHTTPPort, defHTTPPort),
+		StopWaitTime:   stopWaitTime,
+		Headers:        headersConfig,
+		RequestTimeout: requestTimeout,
 	}
 
 	thingsConfig := clients.Config{
@@ -190,14 +330,50 @@ func loadConfig() config {
 		ClientName: clients.Things,
 	}
 
+	authConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envAuthGRPCURL, defAuthGRPCURL),
+		ClientName: clients.Auth,
+	}
+
+	encryptionKey := mainflux.Env(envEncryptionKey, defEncryptionKey)
+	if encryptionKey == "" {
+		log.Fatalf("%s must be set to encrypt the org secrets\n", envEncryptionKey)
+	}
+
 	return config{
 		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		dbConfig:          dbConfig,
 		httpConfig:        httpConfig,
 		thingsConfig:      thingsConfig,
+		authConfig:        authConfig,
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
 		thingsGRPCTimeout: thingsAuthGRPCTimeout,
+		dedupWindow:       dedupWindow,
+		authGRPCTimeout:   authGRPCTimeout,
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		esConsumerName:    mainflux.Env(envESConsumerName, defESConsumerName),
+		recentMessages:    recentMessages,
+		recentMessagesTTL: recentMessagesTTL,
+		encryptionKey:     encryptionKey,
+	}
+}
+
+func runMigrations(dbConfig postgres.Config) {
+	db, err := postgres.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to postgres: %s", err)
+	}
+	defer db.Close()
+
+	if err := migrations.Run(db, postgres.Migrations(), os.Args[2:], os.Stdout); err != nil {
+		log.Fatalf("Failed to run migrations: %s", err)
 	}
 }
 
@@ -210,15 +386,48 @@ func connectToDB(dbConfig postgres.Config, logger logger.Logger) *sqlx.DB {
 	return db
 }
 
-func newService(ts protomfx.ThingsServiceClient, dbTracer opentracing.Tracer, db *sqlx.DB, logger logger.Logger) webhooks.Service {
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
+
+func subscribeToES(ctx context.Context, svc webhooks.Service, client *redis.Client, consumer string, logger logger.Logger) error {
+	eventStore := rediscons.NewEventStore(svc, client, consumer, logger.Module("events"))
+	logger.Info("Subscribed to Redis Event Store")
+	if err := eventStore.Subscribe(ctx); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to subscribe to event store: %s", err))
+		return err
+	}
+
+	return nil
+}
+
+func newService(ts protomfx.ThingsServiceClient, ac protomfx.AuthServiceClient, dbTracer opentracing.Tracer, db *sqlx.DB, esClient *redis.Client, forwarder webhooks.Forwarder, cfg config, logger logger.Logger) webhooks.Service {
 	database := postgres.NewDatabase(db)
 	webhooksRepo := postgres.NewWebhookRepository(database)
 	webhooksRepo = tracing.WebhookRepositoryMiddleware(dbTracer, webhooksRepo)
-	forwarder := webhooks.NewForwarder()
+	secretsRepo, err := postgres.NewSecretRepository(database, cfg.encryptionKey)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to create secret repository: %s", err))
+		os.Exit(1)
+	}
+	secretsRepo = tracing.SecretRepositoryMiddleware(dbTracer, secretsRepo)
+	eventsRepo := postgres.NewEventWebhookRepository(database)
+	eventsRepo = tracing.EventWebhookRepositoryMiddleware(dbTracer, eventsRepo)
+	messagesRepo := whredis.NewMessageRepository(esClient, cfg.recentMessages, cfg.recentMessagesTTL)
 	idProvider := uuid.New()
 
-	svc := webhooks.New(ts, webhooksRepo, forwarder, idProvider)
-	svc = api.LoggingMiddleware(svc, logger)
+	svc := webhooks.New(ts, ac, webhooksRepo, secretsRepo, eventsRepo, messagesRepo, forwarder, idProvider)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/MainfluxLabs/mainflux"
+	authapi "github.com/MainfluxLabs/mainflux/auth/api/grpc"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	clientsgrpc "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
 	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
@@ -21,12 +23,20 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	logger "github.com/MainfluxLabs/mainflux/logger"
+	mfconfig "github.com/MainfluxLabs/mainflux/pkg/config"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	mfmetrics "github.com/MainfluxLabs/mainflux/pkg/metrics"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	thingsapi "github.com/MainfluxLabs/mainflux/things/api/grpc"
 	adapter "github.com/MainfluxLabs/mainflux/ws"
 	"github.com/MainfluxLabs/mainflux/ws/api"
+	wsredis "github.com/MainfluxLabs/mainflux/ws/redis"
+	wsconsumer "github.com/MainfluxLabs/mainflux/ws/redis/consumer"
+	"github.com/go-redis/redis/v8"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 )
 
@@ -36,58 +46,128 @@ const (
 	defPort              = "8190"
 	defBrokerURL         = "nats://localhost:4222"
 	defLogLevel          = "error"
+	defLogFormat         = "json"
+	defLogLevelOverrides = ""
+	defConfigFile        = ""
 	defClientTLS         = "false"
 	defCACerts           = ""
 	defJaegerURL         = ""
 	defThingsGRPCURL     = "localhost:8183"
 	defThingsGRPCTimeout = "1s"
+	defAuthGRPCURL       = "localhost:8181"
+	defAuthGRPCTimeout   = "1s"
+	defESURL             = "localhost:6379"
+	defESPass            = ""
+	defESDB              = "0"
+	defCacheTTL          = "5m"
 
 	envPort              = "MF_WS_ADAPTER_PORT"
 	envBrokerURL         = "MF_BROKER_URL"
 	envLogLevel          = "MF_WS_ADAPTER_LOG_LEVEL"
+	envLogFormat         = "MF_LOG_FORMAT"
+	envLogLevelOverrides = "MF_LOG_LEVEL_OVERRIDES"
+	envConfigFile        = "MF_CONFIG_FILE"
 	envClientTLS         = "MF_WS_ADAPTER_CLIENT_TLS"
 	envCACerts           = "MF_WS_ADAPTER_CA_CERTS"
 	envJaegerURL         = "MF_JAEGER_URL"
 	envThingsGRPCURL     = "MF_THINGS_AUTH_GRPC_URL"
 	envThingsGRPCTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envAuthGRPCURL       = "MF_AUTH_GRPC_URL"
+	envAuthGRPCTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envESURL             = "MF_WS_ADAPTER_ES_URL"
+	envESPass            = "MF_WS_ADAPTER_ES_PASS"
+	envESDB              = "MF_WS_ADAPTER_ES_DB"
+	envCacheTTL          = "MF_WS_ADAPTER_CACHE_TTL"
 )
 
 type config struct {
 	thingsConfig      clients.Config
+	authConfig        clients.Config
 	port              string
 	brokerURL         string
 	logLevel          string
+	logFormat         string
+	logLevelOverrides string
 	jaegerURL         string
 	thingsGRPCTimeout time.Duration
+	authGRPCTimeout   time.Duration
+	esURL             string
+	esPass            string
+	esDB              string
+	cacheTTL          time.Duration
+	headersConfig     servers.HeadersConfig
+	tenantConfig      mfmetrics.TenantConfig
 }
 
 func main() {
+	cfgFile := mainflux.Env(envConfigFile, defConfigFile)
+	if err := mfconfig.Load(cfgFile); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	cfg := loadConfig()
 
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	logger, err := logger.NewWithConfig(os.Stdout, logger.Config{
+		Level:     cfg.logLevel,
+		Format:    cfg.logFormat,
+		Overrides: cfg.logLevelOverrides,
+	})
 	if err != nil {
 		log.Fatalf(err.Error())
 	}
 
-	conn := clientsgrpc.Connect(cfg.thingsConfig, logger)
+	g.Go(func() error {
+		return mfconfig.Reload(ctx, cfgFile, logger, mfconfig.LogLevel(logger, envLogLevel, defLogLevel, envLogLevelOverrides, defLogLevelOverrides))
+	})
+
+	conn := clientsgrpc.Connect(cfg.thingsConfig, logger.Module("things"))
 	defer conn.Close()
 
 	thingsTracer, thingsCloser := jaeger.Init("ws_things", cfg.jaegerURL, logger)
 	defer thingsCloser.Close()
 
-	tc := thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout)
+	authTracer, authCloser := jaeger.Init("ws_auth", cfg.jaegerURL, logger)
+	defer authCloser.Close()
+
+	authConn := clientsgrpc.Connect(cfg.authConfig, logger.Module("auth"))
+	defer authConn.Close()
+
+	ac := authapi.NewClient(authConn, authTracer, cfg.authGRPCTimeout)
 
-	nps, err := brokers.NewPubSub(cfg.brokerURL, "", logger)
+	esClient := connectToRedis(cfg.esURL, cfg.esPass, cfg.esDB, logger)
+	defer esClient.Close()
+
+	tc := auth.NewThingsCache(thingsapi.NewClient(conn, thingsTracer, cfg.thingsGRPCTimeout), cfg.cacheTTL)
+	g.Go(func() error {
+		return auth.SubscribeThingsEvents(ctx, tc, esClient, logger.Module("events"))
+	})
+
+	nps, err := brokers.NewPubSub(cfg.brokerURL, "", logger.Module("messaging"))
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to message broker: %s", err))
 		os.Exit(1)
 	}
 	defer nps.Close()
 
-	svc := newService(tc, nps, logger)
+	nps = mfmetrics.TenantPubSub(
+		nps,
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "ws_adapter",
+			Subsystem: "api",
+			Name:      "tenant_message_count",
+			Help:      "Number of messages published per tenant.",
+		}, mfmetrics.TenantLabelNames),
+		mfmetrics.NewTenants(cfg.tenantConfig),
+	)
+
+	svc := newService(tc, ac, nps, esClient, logger)
+
+	g.Go(func() error {
+		return wsconsumer.NewEventStore(svc, esClient, logger.Module("events")).Subscribe(ctx)
+	})
 
 	g.Go(func() error {
 		return startWSServer(ctx, cfg, svc, logger)
@@ -118,6 +198,11 @@ func loadConfig() config {
 		log.Fatalf("Invalid %s value: %s", envThingsGRPCTimeout, err.Error())
 	}
 
+	authGRPCTimeout, err := time.ParseDuration(mainflux.Env(envAuthGRPCTimeout, defAuthGRPCTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envAuthGRPCTimeout, err.Error())
+	}
+
 	thingsConfig := clients.Config{
 		ClientTLS:  tls,
 		CaCerts:    mainflux.Env(envCACerts, defCACerts),
@@ -125,19 +210,52 @@ func loadConfig() config {
 		ClientName: clients.Things,
 	}
 
+	authConfig := clients.Config{
+		ClientTLS:  tls,
+		CaCerts:    mainflux.Env(envCACerts, defCACerts),
+		URL:        mainflux.Env(envAuthGRPCURL, defAuthGRPCURL),
+		ClientName: clients.Auth,
+	}
+
+	cacheTTL, err := time.ParseDuration(mainflux.Env(envCacheTTL, defCacheTTL))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envCacheTTL, err.Error())
+	}
+
+	headersConfig, err := servers.LoadHeadersConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	tenantConfig, err := mfmetrics.LoadTenantConfig()
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	return config{
 		thingsConfig:      thingsConfig,
+		authConfig:        authConfig,
 		brokerURL:         mainflux.Env(envBrokerURL, defBrokerURL),
 		port:              mainflux.Env(envPort, defPort),
 		logLevel:          mainflux.Env(envLogLevel, defLogLevel),
+		logFormat:         mainflux.Env(envLogFormat, defLogFormat),
+		logLevelOverrides: mainflux.Env(envLogLevelOverrides, defLogLevelOverrides),
 		jaegerURL:         mainflux.Env(envJaegerURL, defJaegerURL),
 		thingsGRPCTimeout: thingsGRPCTimeout,
+		authGRPCTimeout:   authGRPCTimeout,
+		esURL:             mainflux.Env(envESURL, defESURL),
+		esPass:            mainflux.Env(envESPass, defESPass),
+		esDB:              mainflux.Env(envESDB, defESDB),
+		cacheTTL:          cacheTTL,
+		headersConfig:     headersConfig,
+		tenantConfig:      tenantConfig,
 	}
 }
 
-func newService(tc protomfx.ThingsServiceClient, nps messaging.PubSub, logger logger.Logger) adapter.Service {
-	svc := adapter.New(tc, nps)
-	svc = api.LoggingMiddleware(svc, logger)
+func newService(tc protomfx.ThingsServiceClient, ac protomfx.AuthServiceClient, nps messaging.PubSub, esClient *redis.Client, logger logger.Logger) adapter.Service {
+	svc := adapter.New(tc, ac, nps, auth.NewNetworkAuthorizer(esClient), uuid.New())
+	svc = wsredis.NewEventStoreMiddleware(svc, esClient)
+	svc = api.LoggingMiddleware(svc, logger.Module("api"))
 	svc = api.MetricsMiddleware(
 		svc,
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -160,7 +278,7 @@ func newService(tc protomfx.ThingsServiceClient, nps messaging.PubSub, logger lo
 func startWSServer(ctx context.Context, cfg config, svc adapter.Service, l logger.Logger) error {
 	p := fmt.Sprintf(":%s", cfg.port)
 	errCh := make(chan error, 2)
-	server := &http.Server{Addr: p, Handler: api.MakeHandler(svc, l)}
+	server := &http.Server{Addr: p, Handler: servershttp.Headers(servershttp.ClientAddr(api.MakeHandler(svc, l), cfg.headersConfig.TrustedProxies), cfg.headersConfig)}
 	l.Info(fmt.Sprintf("WS adapter service started, exposed port %s", cfg.port))
 
 	go func() {
@@ -181,3 +299,17 @@ func startWSServer(ctx context.Context, cfg config, svc adapter.Service, l logge
 		return err
 	}
 }
+
+func connectToRedis(redisURL, redisPass, redisDB string, logger logger.Logger) *redis.Client {
+	db, err := strconv.Atoi(redisDB)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to redis: %s", err))
+		os.Exit(1)
+	}
+
+	return redis.NewClient(&redis.Options{
+		Addr:     redisURL,
+		Password: redisPass,
+		DB:       db,
+	})
+}
@@ -10,14 +10,29 @@ import (
 	"context"
 	"sync"
 
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const protocol = "coap"
+
+var (
+	// ErrRateLimited indicates that the request was rejected because the
+	// request rate limit was exceeded.
+	ErrRateLimited = errors.New("request rate limit exceeded")
+
+	// ErrServiceUnavailable indicates that the things service couldn't be reached.
+	ErrServiceUnavailable = errors.New("things service unavailable")
 )
 
 // Service specifies CoAP service API.
 type Service interface {
-	// Publish Messssage
+	// Publish Messssage. If the message publisher is set, the key is the one
+	// of the gateway which publishes the message on behalf of the publisher.
 	Publish(ctx context.Context, key string, msg protomfx.Message) error
 
 	// Subscribe subscribes to profile with specified id, subtopic and adds subscription to
@@ -34,14 +49,16 @@ var _ Service = (*adapterService)(nil)
 type adapterService struct {
 	things  protomfx.ThingsServiceClient
 	pubsub  messaging.PubSub
+	network auth.NetworkAuthorizer
 	obsLock sync.Mutex
 }
 
 // New instantiates the CoAP adapter implementation.
-func New(things protomfx.ThingsServiceClient, pubsub messaging.PubSub) Service {
+func New(things protomfx.ThingsServiceClient, pubsub messaging.PubSub, network auth.NetworkAuthorizer) Service {
 	as := &adapterService{
 		things:  things,
 		pubsub:  pubsub,
+		network: network,
 		obsLock: sync.Mutex{},
 	}
 
@@ -49,13 +66,19 @@ func New(things protomfx.ThingsServiceClient, pubsub messaging.PubSub) Service {
 }
 
 func (svc *adapterService) Publish(ctx context.Context, key string, msg protomfx.Message) error {
-	cr := &protomfx.PubConfByKeyReq{
-		Key: key,
-	}
-	pc, err := svc.things.GetPubConfByKey(ctx, cr)
+	pc, err := svc.pubConf(ctx, key, msg.Publisher)
 	if err != nil {
-		return errors.Wrap(errors.ErrAuthorization, err)
+		return authError(err)
+	}
+
+	if err := svc.network.Authorize(ctx, pc, msg.Protocol); err != nil {
+		return err
 	}
+
+	if err := messaging.ValidatePayload(pc.GetProfileConfig(), msg.Payload); err != nil {
+		return err
+	}
+
 	m := messaging.CreateMessage(pc, msg.Protocol, msg.Subtopic, &msg.Payload)
 
 	return svc.pubsub.Publish(m)
@@ -65,8 +88,13 @@ func (svc *adapterService) Subscribe(ctx context.Context, key, subtopic string,
 	cr := &protomfx.PubConfByKeyReq{
 		Key: key,
 	}
-	if _, err := svc.things.GetPubConfByKey(ctx, cr); err != nil {
-		return errors.Wrap(errors.ErrAuthorization, err)
+	pc, err := svc.things.GetPubConfByKey(ctx, cr)
+	if err != nil {
+		return authError(err)
+	}
+
+	if err := svc.network.Authorize(ctx, pc, protocol); err != nil {
+		return err
 	}
 
 	return svc.pubsub.Subscribe(c.Token(), subtopic, c)
@@ -78,8 +106,33 @@ func (svc *adapterService) Unsubscribe(ctx context.Context, key, subtopic, token
 	}
 	_, err := svc.things.GetPubConfByKey(ctx, cr)
 	if err != nil {
-		return errors.Wrap(errors.ErrAuthorization, err)
+		return authError(err)
 	}
 
 	return svc.pubsub.Unsubscribe(token, subtopic)
 }
+
+func (svc *adapterService) pubConf(ctx context.Context, key, thingID string) (*protomfx.PubConfByKeyRes, error) {
+	if thingID != "" {
+		return svc.things.GetPubConfByGateway(ctx, &protomfx.PubConfByGatewayReq{Key: key, ThingID: thingID})
+	}
+
+	return svc.things.GetPubConfByKey(ctx, &protomfx.PubConfByKeyReq{Key: key})
+}
+
+// authError translates the error of the things service, so that the
+// bad key can be told apart from the missing permissions.
+func authError(err error) error {
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.NotFound, codes.InvalidArgument:
+		return errors.Wrap(errors.ErrAuthentication, err)
+	case codes.PermissionDenied:
+		return errors.Wrap(errors.ErrAuthorization, err)
+	case codes.ResourceExhausted:
+		return errors.Wrap(ErrRateLimited, err)
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return errors.Wrap(ErrServiceUnavailable, err)
+	default:
+		return err
+	}
+}
@@ -35,10 +35,10 @@ func (lm *loggingMiddleware) Publish(ctx context.Context, key string, msg protom
 		}
 		message := fmt.Sprintf("Method publish to %s took %s to complete", destProfile, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Publish(ctx, key, msg)
@@ -48,10 +48,10 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, key, subtopic string
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method subscribe for client %s took %s to complete", c.Token(), time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Subscribe(ctx, key, subtopic, c)
@@ -61,10 +61,10 @@ func (lm *loggingMiddleware) Unsubscribe(ctx context.Context, key, subtopic, tok
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method unsubscribe for the client %s took %s to complete", token, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Unsubscribe(ctx, key, subtopic, token)
@@ -14,6 +14,7 @@ import (
 	"github.com/MainfluxLabs/mainflux"
 	"github.com/MainfluxLabs/mainflux/coap"
 	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -28,9 +29,16 @@ const (
 	protocol     = "coap"
 	authQuery    = "auth"
 	startObserve = 0 // observe option value that indicates start of observation
+
+	// tooManyRequests is the 4.29 response code defined by RFC 8516,
+	// which isn't provided by the CoAP library.
+	tooManyRequests codes.Code = 157
 )
 
-var errBadOptions = errors.New("bad options")
+var (
+	errBadOptions       = errors.New("bad options")
+	errMethodNotAllowed = errors.New("method not allowed")
+)
 
 var (
 	logger  log.Logger
@@ -82,31 +90,46 @@ func handler(w mux.ResponseWriter, m *mux.Message) {
 		sendResp(w, &resp)
 		return
 	}
+	ctx := auth.ContextWithClientAddr(context.Background(), auth.HostAddr(w.Client().RemoteAddr().String()))
 	switch m.Code {
 	case codes.GET:
-		err = handleGet(m, w.Client(), msg, key)
+		err = handleGet(ctx, m, w.Client(), msg, key)
 	case codes.POST:
-		err = service.Publish(context.Background(), key, msg)
+		err = service.Publish(ctx, key, msg)
 	default:
-		err = errors.ErrNotFound
+		err = errMethodNotAllowed
 	}
 	if err != nil {
-		switch {
-		case err == errBadOptions:
-			resp.Code = codes.BadOption
-		case err == errors.ErrNotFound:
-			resp.Code = codes.NotFound
-		case errors.Contains(err, errors.ErrAuthorization),
-			errors.Contains(err, errors.ErrAuthentication):
-			resp.Code = codes.Unauthorized
-		default:
-			resp.Code = codes.InternalServerError
-		}
+		resp.Code = responseCode(err)
 		sendResp(w, &resp)
 	}
 }
 
-func handleGet(m *mux.Message, c mux.Client, msg protomfx.Message, key string) error {
+// responseCode returns the response code of the request failed with the
+// provided error.
+func responseCode(err error) codes.Code {
+	switch {
+	case err == errBadOptions:
+		return codes.BadOption
+	case err == errMethodNotAllowed:
+		return codes.MethodNotAllowed
+	case errors.Contains(err, errors.ErrAuthentication):
+		return codes.Unauthorized
+	case errors.Contains(err, errors.ErrAuthorization),
+		errors.Contains(err, auth.ErrNetworkDenied):
+		return codes.Forbidden
+	case errors.Contains(err, coap.ErrRateLimited):
+		return tooManyRequests
+	case errors.Contains(err, coap.ErrServiceUnavailable):
+		return codes.ServiceUnavailable
+	case errors.Contains(err, messaging.ErrInvalidPayload):
+		return codes.BadRequest
+	default:
+		return codes.InternalServerError
+	}
+}
+
+func handleGet(ctx context.Context, m *mux.Message, c mux.Client, msg protomfx.Message, key string) error {
 	var obs uint32
 	obs, err := m.Options.Observe()
 	if err != nil {
@@ -115,9 +138,9 @@ func handleGet(m *mux.Message, c mux.Client, msg protomfx.Message, key string) e
 	}
 	if obs == startObserve {
 		c := coap.NewClient(c, m.Token, logger)
-		return service.Subscribe(context.Background(), key, msg.Subtopic, c)
+		return service.Subscribe(ctx, key, msg.Subtopic, c)
 	}
-	return service.Unsubscribe(context.Background(), key, msg.Subtopic, m.Token.String())
+	return service.Unsubscribe(ctx, key, msg.Subtopic, m.Token.String())
 }
 
 func decodeMessage(msg *mux.Message) (protomfx.Message, error) {
@@ -142,10 +165,11 @@ func decodeMessage(msg *mux.Message) (protomfx.Message, error) {
 	}
 
 	ret := protomfx.Message{
-		Protocol: protocol,
-		Subtopic: subject,
-		Payload:  []byte{},
-		Created:  time.Now().UnixNano(),
+		Protocol:  protocol,
+		Subtopic:  subject,
+		Publisher: messaging.ExtractThingID(path),
+		Payload:   []byte{},
+		Created:   time.Now().UnixNano(),
 	}
 
 	if msg.Body != nil {
@@ -0,0 +1,214 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func createConfigsEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createConfigsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cfgs := []configs.Config{}
+		for _, cReq := range req.Configs {
+			c := configs.Config{
+				GroupID:  req.groupID,
+				ThingID:  cReq.ThingID,
+				Name:     cReq.Name,
+				Content:  cReq.Content,
+				Metadata: cReq.Metadata,
+			}
+			cfgs = append(cfgs, c)
+		}
+
+		saved, err := svc.CreateConfigs(ctx, req.token, cfgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := configsRes{Configs: []configRes{}, created: true}
+		for _, c := range saved {
+			res.Configs = append(res.Configs, buildConfigResponse(c))
+		}
+
+		return res, nil
+	}
+}
+
+func listConfigsByGroupEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listConfigsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListConfigsByGroup(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := configsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Configs: []configRes{},
+		}
+		for _, c := range page.Configs {
+			res.Configs = append(res.Configs, buildConfigResponse(c))
+		}
+
+		return res, nil
+	}
+}
+
+func viewConfigEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(configReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		c, err := svc.ViewConfig(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildConfigResponse(c), nil
+	}
+}
+
+func updateConfigEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateConfigReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		c := configs.Config{
+			ID:       req.id,
+			Name:     req.Name,
+			Content:  req.Content,
+			Metadata: req.Metadata,
+		}
+
+		if err := svc.UpdateConfig(ctx, req.token, c); err != nil {
+			return nil, err
+		}
+
+		return configRes{updated: true}, nil
+	}
+}
+
+func removeConfigsEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeConfigsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveConfigs(ctx, req.token, req.ConfigIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func viewDriftEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(configReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		d, err := svc.ViewDrift(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := driftRes{
+			ThingID: d.ThingID,
+			InSync:  d.InSync,
+		}
+		if d.Desired.ID != "" {
+			desired := buildConfigResponse(d.Desired)
+			res.Desired = &desired
+		}
+		if d.Applied.ThingID != "" {
+			res.Applied = &statusRes{
+				ConfigID: d.Applied.ConfigID,
+				Version:  d.Applied.Version,
+				Error:    d.Applied.Error,
+				Updated:  d.Applied.Updated,
+			}
+		}
+
+		return res, nil
+	}
+}
+
+func viewThingConfigEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(thingConfigReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		c, err := svc.ViewThingConfig(ctx, req.key)
+		if err != nil {
+			return nil, err
+		}
+
+		return thingConfigRes{
+			ID:         c.ID,
+			Content:    c.Content,
+			Version:    c.Version,
+			SigningKey: c.SigningKey,
+			Signed:     c.Signed,
+		}, nil
+	}
+}
+
+func reportStatusEndpoint(svc configs.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(reportStatusReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		s := configs.Status{
+			ConfigID: req.ConfigID,
+			Version:  req.Version,
+			Error:    req.Error,
+		}
+
+		if err := svc.ReportStatus(ctx, req.key, s); err != nil {
+			return nil, err
+		}
+
+		return reportStatusRes{}, nil
+	}
+}
+
+func buildConfigResponse(c configs.Config) configRes {
+	return configRes{
+		ID:       c.ID,
+		GroupID:  c.GroupID,
+		ThingID:  c.ThingID,
+		Name:     c.Name,
+		Content:  c.Content,
+		Version:  c.Version,
+		Metadata: c.Metadata,
+		Updated:  c.Updated,
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	httpapi "github.com/MainfluxLabs/mainflux/configs/api/http"
+	cfmocks "github.com/MainfluxLabs/mainflux/configs/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token       = "admin@example.com"
+	groupID     = "574106f7-030e-4881-8ab0-151195c29f94"
+	orgID       = "a1a0a2c4-6b4e-4e23-9a45-6c1f6f8f3e21"
+	thingID     = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	thingKey    = "5d8e1e7c-8a6f-4a4b-9a5e-2e4f0f7c8b1d"
+	wrongValue  = "wrong-value"
+	contentType = "application/json"
+	emptyValue  = ""
+)
+
+var config = configs.Config{
+	GroupID: groupID,
+	Name:    "group-config",
+	Content: map[string]interface{}{"interval": float64(60)},
+}
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	key         string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.key != "" {
+		req.Header.Set("Authorization", apiutil.ThingPrefix+tr.key)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newService() configs.Service {
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingKey: thingID, thingID: groupID}, map[string]things.Group{token: {ID: groupID}, groupID: {ID: groupID, OrgID: orgID}})
+	auth := mocks.NewAuthService("", nil)
+	return configs.New(ths, auth, cfmocks.NewConfigRepository(), cfmocks.NewStatusRepository(), mocks.NewPublisher(), uuid.NewMock(), "")
+}
+
+func newHTTPServer(svc configs.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
+	return httptest.NewServer(mux)
+}
+
+func toJSON(data interface{}) string {
+	jsonData, _ := json.Marshal(data)
+	return string(jsonData)
+}
+
+type thingConfigRes struct {
+	ID      string                 `json:"id"`
+	Content map[string]interface{} `json:"content"`
+	Version uint64                 `json:"version"`
+}
+
+type driftRes struct {
+	ThingID string `json:"thing_id"`
+	InSync  bool   `json:"in_sync"`
+}
+
+func TestCreateConfigs(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	valid := map[string]interface{}{
+		"name":     "device-config",
+		"thing_id": thingID,
+		"content":  map[string]interface{}{"interval": 10},
+		"metadata": map[string]string{"test": "data"},
+	}
+
+	invalid := func(key string, value interface{}) string {
+		c := map[string]interface{}{}
+		for k, v := range valid {
+			c[k] = v
+		}
+		c[key] = value
+		return toJSON([]map[string]interface{}{c})
+	}
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create configs",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create existing config",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create config with empty list",
+			data:        "[]",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create config with empty name",
+			data:        invalid("name", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create config without content",
+			data:        invalid("content", nil),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create config for unknown thing",
+			data:        invalid("thing_id", wrongValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "create config with invalid request format",
+			data:        "}",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create config with invalid content type",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: wrongValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create config with invalid auth token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create config with empty auth token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/configs", ts.URL, groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestViewThingConfig(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, config)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	c := cfgs[0]
+
+	cases := []struct {
+		desc   string
+		key    string
+		status int
+		res    thingConfigRes
+	}{
+		{
+			desc:   "view thing config",
+			key:    thingKey,
+			status: http.StatusOK,
+			res:    thingConfigRes{ID: c.ID, Content: c.Content, Version: c.Version},
+		},
+		{
+			desc:   "view thing config with invalid key",
+			key:    wrongValue,
+			status: http.StatusUnauthorized,
+			res:    thingConfigRes{},
+		},
+		{
+			desc:   "view thing config without key",
+			key:    emptyValue,
+			status: http.StatusUnauthorized,
+			res:    thingConfigRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/config", ts.URL),
+			key:    tc.key,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body thingConfigRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+	}
+}
+
+func TestReportStatus(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, config)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	c := cfgs[0]
+
+	applied := toJSON(map[string]interface{}{"config_id": c.ID, "version": c.Version})
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		key         string
+		status      int
+		inSync      bool
+	}{
+		{
+			desc:        "report failed status",
+			data:        toJSON(map[string]interface{}{"config_id": c.ID, "version": c.Version, "error": "invalid interval"}),
+			contentType: contentType,
+			key:         thingKey,
+			status:      http.StatusNoContent,
+			inSync:      false,
+		},
+		{
+			desc:        "report applied status",
+			data:        applied,
+			contentType: contentType,
+			key:         thingKey,
+			status:      http.StatusNoContent,
+			inSync:      true,
+		},
+		{
+			desc:        "report status without config",
+			data:        toJSON(map[string]interface{}{"version": c.Version}),
+			contentType: contentType,
+			key:         thingKey,
+			status:      http.StatusBadRequest,
+			inSync:      true,
+		},
+		{
+			desc:        "report status with invalid content type",
+			data:        applied,
+			contentType: wrongValue,
+			key:         thingKey,
+			status:      http.StatusUnsupportedMediaType,
+			inSync:      true,
+		},
+		{
+			desc:        "report status with invalid key",
+			data:        applied,
+			contentType: contentType,
+			key:         wrongValue,
+			status:      http.StatusUnauthorized,
+			inSync:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/config/status", ts.URL),
+			contentType: tc.contentType,
+			key:         tc.key,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		req = testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/things/%s/drift", ts.URL, thingID),
+			token:  token,
+		}
+		res, err = req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, http.StatusOK, res.StatusCode))
+
+		var body driftRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.inSync, body.InSync, fmt.Sprintf("%s: expected in sync %t got %t", tc.desc, tc.inSync, body.InSync))
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const (
+	minLen       = 1
+	maxLimitSize = 100
+	maxNameSize  = 254
+)
+
+// ErrMissingContent indicates the config without the content document.
+var ErrMissingContent = errors.New("missing config content")
+
+type apiReq interface {
+	validate() error
+}
+
+type createConfigReq struct {
+	Name     string                 `json:"name"`
+	ThingID  string                 `json:"thing_id,omitempty"`
+	Content  map[string]interface{} `json:"content"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createConfigReq) validate() error {
+	return validateConfig(req.Name, req.Content)
+}
+
+type createConfigsReq struct {
+	token   string
+	groupID string
+	Configs []createConfigReq
+}
+
+func (req createConfigsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Configs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, c := range req.Configs {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type configReq struct {
+	token string
+	id    string
+}
+
+func (req configReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listConfigsReq struct {
+	token        string
+	id           string
+	pageMetadata configs.PageMetadata
+}
+
+func (req listConfigsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type updateConfigReq struct {
+	token    string
+	id       string
+	Name     string                 `json:"name"`
+	Content  map[string]interface{} `json:"content"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateConfigReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateConfig(req.Name, req.Content)
+}
+
+type removeConfigsReq struct {
+	token     string
+	ConfigIDs []string `json:"config_ids,omitempty"`
+}
+
+func (req removeConfigsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.ConfigIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.ConfigIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type thingConfigReq struct {
+	key string
+}
+
+func (req thingConfigReq) validate() error {
+	if req.key == "" {
+		return apiutil.ErrBearerKey
+	}
+
+	return nil
+}
+
+type reportStatusReq struct {
+	key      string
+	ConfigID string `json:"config_id"`
+	Version  uint64 `json:"version"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (req reportStatusReq) validate() error {
+	if req.key == "" {
+		return apiutil.ErrBearerKey
+	}
+
+	if req.ConfigID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+func validateConfig(name string, content map[string]interface{}) error {
+	if name == "" || len(name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if content == nil {
+		return ErrMissingContent
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var (
+	_ apiutil.Response = (*configRes)(nil)
+	_ apiutil.Response = (*configsRes)(nil)
+	_ apiutil.Response = (*configsPageRes)(nil)
+	_ apiutil.Response = (*driftRes)(nil)
+	_ apiutil.Response = (*thingConfigRes)(nil)
+	_ apiutil.Response = (*removeRes)(nil)
+	_ apiutil.Response = (*reportStatusRes)(nil)
+)
+
+type pageRes struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+type configRes struct {
+	ID       string                 `json:"id"`
+	GroupID  string                 `json:"group_id"`
+	ThingID  string                 `json:"thing_id,omitempty"`
+	Name     string                 `json:"name"`
+	Content  map[string]interface{} `json:"content"`
+	Version  uint64                 `json:"version"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Updated  time.Time              `json:"updated"`
+	updated  bool
+}
+
+func (res configRes) Code() int {
+	return http.StatusOK
+}
+
+func (res configRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res configRes) Empty() bool {
+	return res.updated
+}
+
+type configsRes struct {
+	Configs []configRes `json:"configs"`
+	created bool
+}
+
+func (res configsRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res configsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res configsRes) Empty() bool {
+	return false
+}
+
+type configsPageRes struct {
+	pageRes
+	Configs []configRes `json:"configs"`
+}
+
+func (res configsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res configsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res configsPageRes) Empty() bool {
+	return false
+}
+
+type statusRes struct {
+	ConfigID string    `json:"config_id"`
+	Version  uint64    `json:"version"`
+	Error    string    `json:"error,omitempty"`
+	Updated  time.Time `json:"updated"`
+}
+
+type driftRes struct {
+	ThingID string     `json:"thing_id"`
+	Desired *configRes `json:"desired,omitempty"`
+	Applied *statusRes `json:"applied,omitempty"`
+	InSync  bool       `json:"in_sync"`
+}
+
+func (res driftRes) Code() int {
+	return http.StatusOK
+}
+
+func (res driftRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res driftRes) Empty() bool {
+	return false
+}
+
+type thingConfigRes struct {
+	ID         string                 `json:"id"`
+	Content    map[string]interface{} `json:"content"`
+	Version    uint64                 `json:"version"`
+	SigningKey []byte                 `json:"signing_key,omitempty"`
+	Signed     json.RawMessage        `json:"signed,omitempty"`
+}
+
+func (res thingConfigRes) Code() int {
+	return http.StatusOK
+}
+
+func (res thingConfigRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res thingConfigRes) Empty() bool {
+	return false
+}
+
+type removeRes struct{}
+
+func (res removeRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeRes) Empty() bool {
+	return true
+}
+
+type reportStatusRes struct{}
+
+func (res reportStatusRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res reportStatusRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res reportStatusRes) Empty() bool {
+	return true
+}
@@ -0,0 +1,240 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/configs"
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/go-zoo/bone"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	contentType = "application/json"
+	idKey       = "id"
+	offsetKey   = "offset"
+	limitKey    = "limit"
+	defOffset   = 0
+	defLimit    = 10
+)
+
+// MakeHandler returns a HTTP handler for API endpoints.
+func MakeHandler(tracer opentracing.Tracer, svc configs.Service, logger log.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, encodeError)),
+	}
+
+	r := bone.New()
+
+	r.Post("/groups/:id/configs", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_configs")(createConfigsEndpoint(svc)),
+		decodeCreateConfigs,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/configs", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_configs_by_group")(listConfigsByGroupEndpoint(svc)),
+		decodeListConfigs,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/configs/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_config")(viewConfigEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/configs/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_config")(updateConfigEndpoint(svc)),
+		decodeUpdateConfig,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/configs", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_configs")(removeConfigsEndpoint(svc)),
+		decodeRemoveConfigs,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/things/:id/drift", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_drift")(viewDriftEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/config", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_thing_config")(viewThingConfigEndpoint(svc)),
+		decodeThingConfig,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/config/status", kithttp.NewServer(
+		kitot.TraceServer(tracer, "report_status")(reportStatusEndpoint(svc)),
+		decodeReportStatus,
+		encodeResponse,
+		opts...,
+	))
+
+	r.GetFunc("/health", mainflux.Health("configs"))
+	r.Handle("/metrics", promhttp.Handler())
+
+	return r
+}
+
+func decodeCreateConfigs(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createConfigsReq{token: apiutil.ExtractBearerToken(r), groupID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Configs); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := configReq{token: apiutil.ExtractBearerToken(r), id: bone.GetValue(r, idKey)}
+
+	return req, nil
+}
+
+func decodeListConfigs(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listConfigsReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+		pageMetadata: configs.PageMetadata{
+			Offset: o,
+			Limit:  l,
+		},
+	}
+
+	return req, nil
+}
+
+func decodeUpdateConfig(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateConfigReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveConfigs(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeConfigsReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeThingConfig(_ context.Context, r *http.Request) (interface{}, error) {
+	req := thingConfigReq{key: apiutil.ExtractThingKey(r)}
+
+	return req, nil
+}
+
+func decodeReportStatus(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := reportStatusReq{key: apiutil.ExtractThingKey(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", contentType)
+
+	if ar, ok := response.(apiutil.Response); ok {
+		for k, v := range ar.Headers() {
+			w.Header().Set(k, v)
+		}
+
+		w.WriteHeader(ar.Code())
+
+		if ar.Empty() {
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	switch {
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Contains(err, errors.ErrAuthentication),
+		err == apiutil.ErrBearerToken,
+		err == apiutil.ErrBearerKey:
+		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, errors.ErrAuthorization):
+		w.WriteHeader(http.StatusForbidden)
+	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		errors.Contains(err, apiutil.ErrMalformedEntity),
+		err == apiutil.ErrLimitSize,
+		err == apiutil.ErrNameSize,
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingGroupID,
+		err == apiutil.ErrOffsetSize,
+		err == ErrMissingContent:
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, errors.ErrConflict):
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	if errorVal, ok := err.(errors.Error); ok {
+		w.Header().Set("Content-Type", contentType)
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	log "github.com/MainfluxLabs/mainflux/logger"
+)
+
+var _ configs.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger log.Logger
+	svc    configs.Service
+}
+
+// LoggingMiddleware adds logging facilities to the core service.
+func LoggingMiddleware(svc configs.Service, logger log.Logger) configs.Service {
+	return &loggingMiddleware{logger, svc}
+}
+
+func (lm *loggingMiddleware) CreateConfigs(ctx context.Context, token string, cfgs ...configs.Config) (response []configs.Config, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_configs took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateConfigs(ctx, token, cfgs...)
+}
+
+func (lm *loggingMiddleware) ListConfigsByGroup(ctx context.Context, token, groupID string, pm configs.PageMetadata) (response configs.ConfigsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_configs_by_group for id %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListConfigsByGroup(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) ViewConfig(ctx context.Context, token, id string) (response configs.Config, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_config for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewConfig(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateConfig(ctx context.Context, token string, config configs.Config) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_config for id %s took %s to complete", config.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateConfig(ctx, token, config)
+}
+
+func (lm *loggingMiddleware) RemoveConfigs(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_configs took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveConfigs(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveConfigsByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_configs_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveConfigsByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) ViewDrift(ctx context.Context, token, thingID string) (response configs.Drift, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_drift for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewDrift(ctx, token, thingID)
+}
+
+func (lm *loggingMiddleware) ViewThingConfig(ctx context.Context, key string) (response configs.ThingConfig, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_thing_config took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewThingConfig(ctx, key)
+}
+
+func (lm *loggingMiddleware) ReportStatus(ctx context.Context, key string, status configs.Status) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method report_status for config %s version %d took %s to complete", status.ConfigID, status.Version, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ReportStatus(ctx, key, status)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ configs.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     configs.Service
+}
+
+// MetricsMiddleware instruments core service by tracking request count and
+// latency.
+func MetricsMiddleware(svc configs.Service, counter metrics.Counter, latency metrics.Histogram) configs.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (ms *metricsMiddleware) CreateConfigs(ctx context.Context, token string, cfgs ...configs.Config) ([]configs.Config, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_configs").Add(1)
+		ms.latency.With("method", "create_configs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateConfigs(ctx, token, cfgs...)
+}
+
+func (ms *metricsMiddleware) ListConfigsByGroup(ctx context.Context, token, groupID string, pm configs.PageMetadata) (configs.ConfigsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_configs_by_group").Add(1)
+		ms.latency.With("method", "list_configs_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListConfigsByGroup(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) ViewConfig(ctx context.Context, token, id string) (configs.Config, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_config").Add(1)
+		ms.latency.With("method", "view_config").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewConfig(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateConfig(ctx context.Context, token string, config configs.Config) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_config").Add(1)
+		ms.latency.With("method", "update_config").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateConfig(ctx, token, config)
+}
+
+func (ms *metricsMiddleware) RemoveConfigs(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_configs").Add(1)
+		ms.latency.With("method", "remove_configs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveConfigs(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveConfigsByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_configs_by_group").Add(1)
+		ms.latency.With("method", "remove_configs_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveConfigsByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) ViewDrift(ctx context.Context, token, thingID string) (configs.Drift, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_drift").Add(1)
+		ms.latency.With("method", "view_drift").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewDrift(ctx, token, thingID)
+}
+
+func (ms *metricsMiddleware) ViewThingConfig(ctx context.Context, key string) (configs.ThingConfig, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_thing_config").Add(1)
+		ms.latency.With("method", "view_thing_config").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewThingConfig(ctx, key)
+}
+
+func (ms *metricsMiddleware) ReportStatus(ctx context.Context, key string, status configs.Status) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "report_status").Add(1)
+		ms.latency.With("method", "report_status").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ReportStatus(ctx, key, status)
+}
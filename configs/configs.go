@@ -0,0 +1,115 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"time"
+)
+
+// Config represents the JSON configuration document assigned to a single
+// thing, or to all things of a group. The config assigned to a thing takes
+// precedence over the config of its group. The version is incremented on
+// each update of the config content.
+type Config struct {
+	ID       string
+	GroupID  string
+	ThingID  string
+	Name     string
+	Content  map[string]interface{}
+	Version  uint64
+	Metadata map[string]interface{}
+	Updated  time.Time
+}
+
+// ThingConfig represents the config the thing should apply, together with
+// the public key the thing uses to verify the signed config notifications.
+// The signed config contains the ID, content and version of the config in
+// the signed payload envelope. The signing key and the signed config are
+// empty if the org of the thing has no signing key.
+type ThingConfig struct {
+	Config
+	SigningKey []byte
+	Signed     []byte
+}
+
+// ConfigsPage contains page related metadata as well as a list of configs
+// that belong to this page.
+type ConfigsPage struct {
+	PageMetadata
+	Configs []Config
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total  uint64
+	Offset uint64
+	Limit  uint64
+}
+
+// Status represents the config version applied by a thing, as reported
+// by the thing. The error describes a failure to apply the config.
+type Status struct {
+	ThingID  string
+	GroupID  string
+	ConfigID string
+	Version  uint64
+	Error    string
+	Updated  time.Time
+}
+
+// Drift represents the config a thing should apply compared to the
+// config it reported as applied.
+type Drift struct {
+	ThingID string
+	Desired Config
+	Applied Status
+	InSync  bool
+}
+
+// ConfigRepository specifies a config persistence API.
+type ConfigRepository interface {
+	// Save persists multiple configs. Configs are saved using a transaction.
+	// If one config fails then none will be saved.
+	Save(ctx context.Context, cs ...Config) ([]Config, error)
+
+	// RetrieveByGroupID retrieves configs related to a certain group
+	// identified by a given ID, including the configs of its things.
+	RetrieveByGroupID(ctx context.Context, groupID string, pm PageMetadata) (ConfigsPage, error)
+
+	// RetrieveByID retrieves the config having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (Config, error)
+
+	// RetrieveByThing retrieves the config assigned to the thing, or the
+	// config of the group if the thing has no config assigned.
+	RetrieveByThing(ctx context.Context, groupID, thingID string) (Config, error)
+
+	// Update performs an update to the existing config and increments its
+	// version. The updated config is returned.
+	Update(ctx context.Context, c Config) (Config, error)
+
+	// Remove removes the configs having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the configs related to a certain group
+	// identified by a given ID.
+	RemoveByGroupID(ctx context.Context, groupID string) error
+}
+
+// StatusRepository specifies an applied config status persistence API.
+type StatusRepository interface {
+	// Save persists the status, replacing the previous status of the thing.
+	Save(ctx context.Context, s Status) error
+
+	// RetrieveByThing retrieves the status reported by the thing.
+	RetrieveByThing(ctx context.Context, thingID string) (Status, error)
+
+	// RetrieveByGroup retrieves the statuses reported by the things of the
+	// group identified by the provided ID.
+	RetrieveByGroup(ctx context.Context, groupID string) ([]Status, error)
+
+	// RemoveByGroup removes the statuses reported by the things of the group
+	// identified by the provided ID.
+	RemoveByGroup(ctx context.Context, groupID string) error
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package configs contains the domain concept definitions needed to support
+// Mainflux thing configuration management service functionality.
+package configs
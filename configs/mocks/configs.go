@@ -0,0 +1,179 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ configs.ConfigRepository = (*configRepositoryMock)(nil)
+
+type configRepositoryMock struct {
+	mu      sync.Mutex
+	configs map[string]configs.Config
+}
+
+// NewConfigRepository creates in-memory config repository.
+func NewConfigRepository() configs.ConfigRepository {
+	return &configRepositoryMock{
+		configs: make(map[string]configs.Config),
+	}
+}
+
+func (crm *configRepositoryMock) Save(_ context.Context, cs ...configs.Config) ([]configs.Config, error) {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	for _, c := range cs {
+		for _, cfg := range crm.configs {
+			if cfg.GroupID == c.GroupID && (cfg.Name == c.Name || cfg.ThingID == c.ThingID) {
+				return []configs.Config{}, errors.ErrConflict
+			}
+		}
+
+		crm.configs[c.ID] = c
+	}
+
+	return cs, nil
+}
+
+func (crm *configRepositoryMock) RetrieveByGroupID(_ context.Context, groupID string, pm configs.PageMetadata) (configs.ConfigsPage, error) {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	var items []configs.Config
+	for _, c := range crm.configs {
+		if c.GroupID == groupID {
+			items = append(items, c)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ID < items[j].ID
+	})
+
+	total := uint64(len(items))
+	items = paginate(items, pm)
+
+	return configs.ConfigsPage{
+		Configs: items,
+		PageMetadata: configs.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (crm *configRepositoryMock) RetrieveByID(_ context.Context, id string) (configs.Config, error) {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	c, ok := crm.configs[id]
+	if !ok {
+		return configs.Config{}, errors.ErrNotFound
+	}
+
+	return c, nil
+}
+
+func (crm *configRepositoryMock) RetrieveByThing(_ context.Context, groupID, thingID string) (configs.Config, error) {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	var groupConfig *configs.Config
+	for _, c := range crm.configs {
+		if c.GroupID != groupID {
+			continue
+		}
+
+		switch c.ThingID {
+		case thingID:
+			return c, nil
+		case "":
+			c := c
+			groupConfig = &c
+		}
+	}
+
+	if groupConfig == nil {
+		return configs.Config{}, errors.ErrNotFound
+	}
+
+	return *groupConfig, nil
+}
+
+func (crm *configRepositoryMock) Update(_ context.Context, c configs.Config) (configs.Config, error) {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	cfg, ok := crm.configs[c.ID]
+	if !ok {
+		return configs.Config{}, errors.ErrNotFound
+	}
+
+	for _, other := range crm.configs {
+		if other.ID != c.ID && other.GroupID == cfg.GroupID && other.Name == c.Name {
+			return configs.Config{}, errors.ErrConflict
+		}
+	}
+
+	cfg.Name = c.Name
+	cfg.Content = c.Content
+	cfg.Metadata = c.Metadata
+	cfg.Updated = c.Updated
+	cfg.Version++
+	crm.configs[c.ID] = cfg
+
+	return cfg, nil
+}
+
+func (crm *configRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := crm.configs[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(crm.configs, id)
+	}
+
+	return nil
+}
+
+func (crm *configRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) error {
+	crm.mu.Lock()
+	defer crm.mu.Unlock()
+
+	for id, c := range crm.configs {
+		if c.GroupID == groupID {
+			delete(crm.configs, id)
+		}
+	}
+
+	return nil
+}
+
+func paginate[T any](items []T, pm configs.PageMetadata) []T {
+	if pm.Limit == 0 {
+		return items
+	}
+
+	if pm.Offset >= uint64(len(items)) {
+		return []T{}
+	}
+
+	end := pm.Offset + pm.Limit
+	if end > uint64(len(items)) {
+		end = uint64(len(items))
+	}
+
+	return items[pm.Offset:end]
+}
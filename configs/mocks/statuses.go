@@ -0,0 +1,74 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ configs.StatusRepository = (*statusRepositoryMock)(nil)
+
+type statusRepositoryMock struct {
+	mu       sync.Mutex
+	statuses map[string]configs.Status
+}
+
+// NewStatusRepository creates in-memory status repository.
+func NewStatusRepository() configs.StatusRepository {
+	return &statusRepositoryMock{
+		statuses: make(map[string]configs.Status),
+	}
+}
+
+func (srm *statusRepositoryMock) Save(_ context.Context, s configs.Status) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	srm.statuses[s.ThingID] = s
+
+	return nil
+}
+
+func (srm *statusRepositoryMock) RetrieveByThing(_ context.Context, thingID string) (configs.Status, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	s, ok := srm.statuses[thingID]
+	if !ok {
+		return configs.Status{}, errors.ErrNotFound
+	}
+
+	return s, nil
+}
+
+func (srm *statusRepositoryMock) RetrieveByGroup(_ context.Context, groupID string) ([]configs.Status, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	var items []configs.Status
+	for _, s := range srm.statuses {
+		if s.GroupID == groupID {
+			items = append(items, s)
+		}
+	}
+
+	return items, nil
+}
+
+func (srm *statusRepositoryMock) RemoveByGroup(_ context.Context, groupID string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for id, s := range srm.statuses {
+		if s.GroupID == groupID {
+			delete(srm.statuses, id)
+		}
+	}
+
+	return nil
+}
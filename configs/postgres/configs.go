@@ -0,0 +1,284 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const configColumns = `id, group_id, thing_id, name, content, version, metadata, updated`
+
+var _ configs.ConfigRepository = (*configRepository)(nil)
+
+type configRepository struct {
+	db Database
+}
+
+// NewConfigRepository instantiates a PostgreSQL implementation of config repository.
+func NewConfigRepository(db Database) configs.ConfigRepository {
+	return &configRepository{
+		db: db,
+	}
+}
+
+func (cr configRepository) Save(ctx context.Context, cs ...configs.Config) ([]configs.Config, error) {
+	tx, err := cr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []configs.Config{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO configs (id, group_id, thing_id, name, content, version, metadata, updated)
+		VALUES (:id, :group_id, :thing_id, :name, :content, :version, :metadata, :updated);`
+
+	for _, config := range cs {
+		dbc, err := toDBConfig(config)
+		if err != nil {
+			tx.Rollback()
+			return []configs.Config{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbc); err != nil {
+			tx.Rollback()
+			return []configs.Config{}, wrapError(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []configs.Config{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return cs, nil
+}
+
+func (cr configRepository) RetrieveByGroupID(ctx context.Context, groupID string, pm configs.PageMetadata) (configs.ConfigsPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return configs.ConfigsPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+	q := fmt.Sprintf(`SELECT %s FROM configs WHERE group_id = :group_id ORDER BY name %s;`, configColumns, olq)
+	qc := `SELECT COUNT(*) FROM configs WHERE group_id = $1;`
+
+	params := map[string]interface{}{
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	items, err := cr.retrieve(ctx, q, params)
+	if err != nil {
+		return configs.ConfigsPage{}, err
+	}
+
+	var total uint64
+	if err := cr.db.GetContext(ctx, &total, qc, groupID); err != nil {
+		return configs.ConfigsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return configs.ConfigsPage{
+		Configs: items,
+		PageMetadata: configs.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (cr configRepository) RetrieveByID(ctx context.Context, id string) (configs.Config, error) {
+	q := fmt.Sprintf(`SELECT %s FROM configs WHERE id = $1;`, configColumns)
+
+	return cr.retrieveOne(ctx, q, id)
+}
+
+func (cr configRepository) RetrieveByThing(ctx context.Context, groupID, thingID string) (configs.Config, error) {
+	// The thing config is sorted before the group config, which has no thing ID.
+	q := fmt.Sprintf(`SELECT %s FROM configs WHERE group_id = $1 AND thing_id IN ($2, '')
+		ORDER BY thing_id DESC LIMIT 1;`, configColumns)
+
+	return cr.retrieveOne(ctx, q, groupID, thingID)
+}
+
+func (cr configRepository) Update(ctx context.Context, c configs.Config) (configs.Config, error) {
+	q := fmt.Sprintf(`UPDATE configs SET name = :name, content = :content, metadata = :metadata, updated = :updated,
+		version = version + 1 WHERE id = :id RETURNING %s;`, configColumns)
+
+	dbc, err := toDBConfig(c)
+	if err != nil {
+		return configs.Config{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	rows, err := cr.db.NamedQueryContext(ctx, q, dbc)
+	if err != nil {
+		return configs.Config{}, wrapError(errors.ErrUpdateEntity, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return configs.Config{}, wrapError(errors.ErrUpdateEntity, err)
+		}
+		return configs.Config{}, errors.ErrNotFound
+	}
+
+	var updated dbConfig
+	if err := rows.StructScan(&updated); err != nil {
+		return configs.Config{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	return toConfig(updated)
+}
+
+func (cr configRepository) Remove(ctx context.Context, ids ...string) error {
+	q := `DELETE FROM configs WHERE id = :id;`
+
+	for _, id := range ids {
+		if _, err := cr.db.NamedExecContext(ctx, q, dbConfig{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (cr configRepository) RemoveByGroupID(ctx context.Context, groupID string) error {
+	q := `DELETE FROM configs WHERE group_id = :group_id;`
+
+	if _, err := cr.db.NamedExecContext(ctx, q, dbConfig{GroupID: groupID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (cr configRepository) retrieveOne(ctx context.Context, query string, args ...interface{}) (configs.Config, error) {
+	var dbc dbConfig
+	if err := cr.db.QueryRowxContext(ctx, query, args...).StructScan(&dbc); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return configs.Config{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return configs.Config{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toConfig(dbc)
+}
+
+func (cr configRepository) retrieve(ctx context.Context, query string, params map[string]interface{}) ([]configs.Config, error) {
+	rows, err := cr.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []configs.Config
+	for rows.Next() {
+		var dbc dbConfig
+		if err := rows.StructScan(&dbc); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		c, err := toConfig(dbc)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, c)
+	}
+
+	return items, nil
+}
+
+func wrapError(wrapper, err error) error {
+	pgErr, ok := err.(*pgconn.PgError)
+	if ok {
+		switch pgErr.Code {
+		case pgerrcode.InvalidTextRepresentation:
+			return errors.Wrap(errors.ErrMalformedEntity, err)
+		case pgerrcode.UniqueViolation:
+			return errors.Wrap(errors.ErrConflict, err)
+		case pgerrcode.StringDataRightTruncationDataException:
+			return errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+
+	return errors.Wrap(wrapper, err)
+}
+
+type dbConfig struct {
+	ID       string    `db:"id"`
+	GroupID  string    `db:"group_id"`
+	ThingID  string    `db:"thing_id"`
+	Name     string    `db:"name"`
+	Content  []byte    `db:"content"`
+	Version  uint64    `db:"version"`
+	Metadata []byte    `db:"metadata"`
+	Updated  time.Time `db:"updated"`
+}
+
+func toDBConfig(c configs.Config) (dbConfig, error) {
+	content := []byte("{}")
+	if len(c.Content) > 0 {
+		b, err := json.Marshal(c.Content)
+		if err != nil {
+			return dbConfig{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		content = b
+	}
+
+	metadata := []byte("{}")
+	if len(c.Metadata) > 0 {
+		b, err := json.Marshal(c.Metadata)
+		if err != nil {
+			return dbConfig{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+
+	return dbConfig{
+		ID:       c.ID,
+		GroupID:  c.GroupID,
+		ThingID:  c.ThingID,
+		Name:     c.Name,
+		Content:  content,
+		Version:  c.Version,
+		Metadata: metadata,
+		Updated:  c.Updated,
+	}, nil
+}
+
+func toConfig(dbc dbConfig) (configs.Config, error) {
+	var content map[string]interface{}
+	if err := json.Unmarshal(dbc.Content, &content); err != nil {
+		return configs.Config{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(dbc.Metadata, &metadata); err != nil {
+		return configs.Config{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return configs.Config{
+		ID:       dbc.ID,
+		GroupID:  dbc.GroupID,
+		ThingID:  dbc.ThingID,
+		Name:     dbc.Name,
+		Content:  content,
+		Version:  dbc.Version,
+		Metadata: metadata,
+		Updated:  dbc.Updated,
+	}, nil
+}
@@ -0,0 +1,342 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/configs/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	configName = "config"
+	invalidID  = "invalid"
+)
+
+var content = map[string]interface{}{"interval": float64(10)}
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newConfig(t *testing.T, groupID, thingID, name string) configs.Config {
+	return configs.Config{
+		ID:       generateUUID(t),
+		GroupID:  groupID,
+		ThingID:  thingID,
+		Name:     name,
+		Content:  content,
+		Version:  1,
+		Metadata: map[string]interface{}{"region": "eu"},
+		Updated:  time.Now().UTC(),
+	}
+}
+
+func TestSaveConfigs(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	groupConfig := newConfig(t, groupID, "", configName)
+	thingConfig := newConfig(t, groupID, generateUUID(t), "thing-config")
+
+	cases := []struct {
+		desc    string
+		configs []configs.Config
+		err     error
+	}{
+		{
+			desc:    "save group and thing configs",
+			configs: []configs.Config{groupConfig, thingConfig},
+			err:     nil,
+		},
+		{
+			desc:    "save config with existing name",
+			configs: []configs.Config{newConfig(t, groupID, generateUUID(t), configName)},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save second config of the thing",
+			configs: []configs.Config{newConfig(t, groupID, thingConfig.ThingID, "other")},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save config with invalid group id",
+			configs: []configs.Config{newConfig(t, invalidID, "", "invalid-group")},
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.configs...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveConfigByID(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	c := newConfig(t, generateUUID(t), "", configName)
+
+	_, err := repo.Save(context.Background(), c)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "retrieve existing config",
+			id:   c.ID,
+			err:  nil,
+		},
+		{
+			desc: "retrieve non-existing config",
+			id:   generateUUID(t),
+			err:  errors.ErrNotFound,
+		},
+		{
+			desc: "retrieve config with invalid id",
+			id:   invalidID,
+			err:  errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, c.Content, res.Content, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, c.Content, res.Content))
+			assert.Equal(t, c.Metadata, res.Metadata, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, c.Metadata, res.Metadata))
+		}
+	}
+}
+
+func TestRetrieveConfigsByGroupID(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		thingID := ""
+		if i > 0 {
+			thingID = generateUUID(t)
+		}
+		_, err := repo.Save(context.Background(), newConfig(t, groupID, thingID, fmt.Sprintf("%s-%d", configName, i)))
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		pm      configs.PageMetadata
+		size    uint64
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all configs of the group",
+			groupID: groupID,
+			pm:      configs.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of configs of the group",
+			groupID: groupID,
+			pm:      configs.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve configs of the group without configs",
+			groupID: generateUUID(t),
+			pm:      configs.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve configs with invalid group id",
+			groupID: invalidID,
+			pm:      configs.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroupID(context.Background(), tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.Configs)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Configs)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveConfigByThing(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	groupConfig := newConfig(t, groupID, "", configName)
+	thingConfig := newConfig(t, groupID, generateUUID(t), "thing-config")
+	_, err := repo.Save(context.Background(), groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		groupID string
+		thingID string
+		id      string
+		err     error
+	}{
+		{
+			desc:    "retrieve config assigned to the thing",
+			groupID: groupID,
+			thingID: thingConfig.ThingID,
+			id:      thingConfig.ID,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve group config of the thing without config",
+			groupID: groupID,
+			thingID: generateUUID(t),
+			id:      groupConfig.ID,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve config of the group without configs",
+			groupID: generateUUID(t),
+			thingID: thingConfig.ThingID,
+			id:      "",
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "retrieve config with invalid group id",
+			groupID: invalidID,
+			thingID: thingConfig.ThingID,
+			id:      "",
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByThing(context.Background(), tc.groupID, tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.id, res.ID, fmt.Sprintf("%s: expected config %s got %s\n", tc.desc, tc.id, res.ID))
+	}
+}
+
+func TestUpdateConfig(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	c := newConfig(t, groupID, "", configName)
+	other := newConfig(t, groupID, generateUUID(t), "other")
+	_, err := repo.Save(context.Background(), c, other)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	updated := c
+	updated.Content = map[string]interface{}{"interval": float64(20)}
+
+	conflicting := c
+	conflicting.Name = other.Name
+
+	missing := newConfig(t, groupID, "", "missing")
+
+	cases := []struct {
+		desc    string
+		config  configs.Config
+		version uint64
+		err     error
+	}{
+		{
+			desc:    "update existing config",
+			config:  updated,
+			version: c.Version + 1,
+			err:     nil,
+		},
+		{
+			desc:    "update config with existing name",
+			config:  conflicting,
+			version: 0,
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "update non-existing config",
+			config:  missing,
+			version: 0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.Update(context.Background(), tc.config)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.version, res.Version, fmt.Sprintf("%s: expected version %d got %d\n", tc.desc, tc.version, res.Version))
+		if tc.err == nil {
+			assert.Equal(t, tc.config.Content, res.Content, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.config.Content, res.Content))
+		}
+	}
+}
+
+func TestRemoveConfigs(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	c := newConfig(t, generateUUID(t), "", configName)
+
+	_, err := repo.Save(context.Background(), c)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing config",
+			id:   c.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed config",
+			id:   c.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
+
+func TestRemoveConfigsByGroupID(t *testing.T) {
+	repo := postgres.NewConfigRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	groupConfig := newConfig(t, groupID, "", configName)
+	thingConfig := newConfig(t, groupID, generateUUID(t), "thing-config")
+	_, err := repo.Save(context.Background(), groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = repo.RemoveByGroupID(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove configs by group: expected nil got %s\n", err))
+
+	page, err := repo.RetrieveByGroupID(context.Background(), groupID, configs.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected total 0 got %d\n", page.Total))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	"github.com/jmoiron/sqlx"
+
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// Config defines the options that are used when connecting to a PostgreSQL instance
+type Config struct {
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
+}
+
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
+	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
+
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "configs_1",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS configs (
+						id          UUID PRIMARY KEY,
+						group_id    UUID NOT NULL,
+						thing_id    VARCHAR(254) NOT NULL DEFAULT '',
+						name        VARCHAR(254) NOT NULL,
+						content     JSONB NOT NULL,
+						version     BIGINT NOT NULL,
+						metadata    JSONB,
+						updated     TIMESTAMPTZ NOT NULL,
+						CONSTRAINT  unique_group_name UNIQUE (group_id, name),
+						CONSTRAINT  unique_group_thing UNIQUE (group_id, thing_id)
+					)`,
+					`CREATE TABLE IF NOT EXISTS statuses (
+						thing_id    UUID PRIMARY KEY,
+						group_id    UUID NOT NULL,
+						config_id   VARCHAR(254) NOT NULL DEFAULT '',
+						version     BIGINT NOT NULL,
+						error       TEXT NOT NULL DEFAULT '',
+						updated     TIMESTAMPTZ NOT NULL
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_statuses_group_id ON statuses (group_id)`,
+				},
+				Down: []string{
+					"DROP TABLE statuses",
+					"DROP TABLE configs",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ configs.StatusRepository = (*statusRepository)(nil)
+
+type statusRepository struct {
+	db Database
+}
+
+// NewStatusRepository instantiates a PostgreSQL implementation of status repository.
+func NewStatusRepository(db Database) configs.StatusRepository {
+	return &statusRepository{
+		db: db,
+	}
+}
+
+func (sr statusRepository) Save(ctx context.Context, s configs.Status) error {
+	q := `INSERT INTO statuses (thing_id, group_id, config_id, version, error, updated)
+		VALUES (:thing_id, :group_id, :config_id, :version, :error, :updated)
+		ON CONFLICT (thing_id) DO UPDATE SET group_id = :group_id, config_id = :config_id,
+		version = :version, error = :error, updated = :updated;`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbStatus(s)); err != nil {
+		return wrapError(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (sr statusRepository) RetrieveByThing(ctx context.Context, thingID string) (configs.Status, error) {
+	q := `SELECT thing_id, group_id, config_id, version, error, updated FROM statuses WHERE thing_id = $1;`
+
+	var dbs dbStatus
+	if err := sr.db.QueryRowxContext(ctx, q, thingID).StructScan(&dbs); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return configs.Status{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return configs.Status{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return configs.Status(dbs), nil
+}
+
+func (sr statusRepository) RetrieveByGroup(ctx context.Context, groupID string) ([]configs.Status, error) {
+	q := `SELECT thing_id, group_id, config_id, version, error, updated FROM statuses WHERE group_id = :group_id;`
+
+	rows, err := sr.db.NamedQueryContext(ctx, q, map[string]interface{}{"group_id": groupID})
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []configs.Status
+	for rows.Next() {
+		var dbs dbStatus
+		if err := rows.StructScan(&dbs); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		items = append(items, configs.Status(dbs))
+	}
+
+	return items, nil
+}
+
+func (sr statusRepository) RemoveByGroup(ctx context.Context, groupID string) error {
+	q := `DELETE FROM statuses WHERE group_id = :group_id;`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbStatus{GroupID: groupID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbStatus struct {
+	ThingID  string    `db:"thing_id"`
+	GroupID  string    `db:"group_id"`
+	ConfigID string    `db:"config_id"`
+	Version  uint64    `db:"version"`
+	Error    string    `db:"error"`
+	Updated  time.Time `db:"updated"`
+}
@@ -0,0 +1,163 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/configs/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStatus(t *testing.T, groupID string, version uint64) configs.Status {
+	return configs.Status{
+		ThingID:  generateUUID(t),
+		GroupID:  groupID,
+		ConfigID: generateUUID(t),
+		Version:  version,
+		Updated:  time.Now().UTC(),
+	}
+}
+
+func TestSaveStatus(t *testing.T) {
+	repo := postgres.NewStatusRepository(postgres.NewDatabase(db))
+	s := newStatus(t, generateUUID(t), 1)
+
+	reported := s
+	reported.Version = 2
+	reported.Error = "failed to apply config"
+
+	invalid := newStatus(t, generateUUID(t), 1)
+	invalid.ThingID = invalidID
+
+	cases := []struct {
+		desc   string
+		status configs.Status
+		err    error
+	}{
+		{
+			desc:   "save status",
+			status: s,
+			err:    nil,
+		},
+		{
+			desc:   "save status replacing previous status of the thing",
+			status: reported,
+			err:    nil,
+		},
+		{
+			desc:   "save status with invalid thing id",
+			status: invalid,
+			err:    errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.status)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+
+		res, err := repo.RetrieveByThing(context.Background(), tc.status.ThingID)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.status.Version, res.Version, fmt.Sprintf("%s: expected version %d got %d\n", tc.desc, tc.status.Version, res.Version))
+		assert.Equal(t, tc.status.Error, res.Error, fmt.Sprintf("%s: expected error %s got %s\n", tc.desc, tc.status.Error, res.Error))
+	}
+}
+
+func TestRetrieveStatusByThing(t *testing.T) {
+	repo := postgres.NewStatusRepository(postgres.NewDatabase(db))
+	s := newStatus(t, generateUUID(t), 1)
+
+	err := repo.Save(context.Background(), s)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		thingID string
+		err     error
+	}{
+		{
+			desc:    "retrieve status of the thing",
+			thingID: s.ThingID,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve status of the thing without status",
+			thingID: generateUUID(t),
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "retrieve status with invalid thing id",
+			thingID: invalidID,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByThing(context.Background(), tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, s.ConfigID, res.ConfigID, fmt.Sprintf("%s: expected config %s got %s\n", tc.desc, s.ConfigID, res.ConfigID))
+		}
+	}
+}
+
+func TestRetrieveStatusesByGroup(t *testing.T) {
+	repo := postgres.NewStatusRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	n := 3
+	for i := 0; i < n; i++ {
+		err := repo.Save(context.Background(), newStatus(t, groupID, 1))
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		size    int
+	}{
+		{
+			desc:    "retrieve statuses of the group",
+			groupID: groupID,
+			size:    n,
+		},
+		{
+			desc:    "retrieve statuses of the group without statuses",
+			groupID: generateUUID(t),
+			size:    0,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByGroup(context.Background(), tc.groupID)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.size, len(res), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(res)))
+	}
+}
+
+func TestRemoveStatusesByGroup(t *testing.T) {
+	repo := postgres.NewStatusRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	for i := 0; i < 2; i++ {
+		err := repo.Save(context.Background(), newStatus(t, groupID, 1))
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	err := repo.RemoveByGroup(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove statuses by group: expected nil got %s\n", err))
+
+	res, err := repo.RetrieveByGroup(context.Background(), groupID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(res), fmt.Sprintf("expected size 0 got %d\n", len(res)))
+}
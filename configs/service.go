@@ -0,0 +1,455 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package configs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+const (
+	protocol = "configs"
+
+	// ControlSubtopic is the subtopic the config changes are published to,
+	// followed by the thing ID.
+	ControlSubtopic = "configs"
+)
+
+// ErrThingGroup indicates that the config thing doesn't belong to the config group.
+var ErrThingGroup = errors.New("thing doesn't belong to the config group")
+
+// Service specifies an API that must be fullfiled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// CreateConfigs creates configs for certain group identified by the provided ID.
+	CreateConfigs(ctx context.Context, token string, configs ...Config) ([]Config, error)
+
+	// ListConfigsByGroup retrieves data about a subset of configs
+	// related to a certain group identified by the provided ID.
+	ListConfigsByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (ConfigsPage, error)
+
+	// ViewConfig retrieves data about the config identified with the provided ID.
+	ViewConfig(ctx context.Context, token, id string) (Config, error)
+
+	// UpdateConfig updates the config identified by the provided ID.
+	UpdateConfig(ctx context.Context, token string, config Config) error
+
+	// RemoveConfigs removes the configs identified with the provided IDs.
+	RemoveConfigs(ctx context.Context, token string, ids ...string) error
+
+	// RemoveConfigsByGroup removes the configs and the statuses of the
+	// removed group identified by the provided ID.
+	RemoveConfigsByGroup(ctx context.Context, groupID string) error
+
+	// ViewDrift retrieves the config the thing identified by the provided ID
+	// should apply, compared to the config the thing applied.
+	ViewDrift(ctx context.Context, token, thingID string) (Drift, error)
+
+	// ViewThingConfig retrieves the config the thing identified by the
+	// provided key should apply, together with the public signing key of
+	// the thing org.
+	ViewThingConfig(ctx context.Context, key string) (ThingConfig, error)
+
+	// ReportStatus saves the config status reported by the thing identified
+	// by the provided key.
+	ReportStatus(ctx context.Context, key string, status Status) error
+}
+
+type configsService struct {
+	things        protomfx.ThingsServiceClient
+	auth          protomfx.AuthServiceClient
+	configs       ConfigRepository
+	statuses      StatusRepository
+	publisher     messaging.Publisher
+	idProvider    uuid.IDProvider
+	signingSecret string
+}
+
+var _ Service = (*configsService)(nil)
+
+// New instantiates the configs service implementation. The signing secret
+// is shared with the Auth service to sign the payloads sent to the things,
+// which are sent unsigned if the secret is empty.
+func New(things protomfx.ThingsServiceClient, auth protomfx.AuthServiceClient, configs ConfigRepository, statuses StatusRepository, publisher messaging.Publisher, idp uuid.IDProvider, signingSecret string) Service {
+	return &configsService{
+		things:        things,
+		auth:          auth,
+		configs:       configs,
+		statuses:      statuses,
+		publisher:     publisher,
+		idProvider:    idp,
+		signingSecret: signingSecret,
+	}
+}
+
+func (cs *configsService) CreateConfigs(ctx context.Context, token string, configs ...Config) ([]Config, error) {
+	cfgs := []Config{}
+	for _, config := range configs {
+		c, err := cs.createConfig(ctx, &config, token)
+		if err != nil {
+			return []Config{}, err
+		}
+		cfgs = append(cfgs, c)
+	}
+
+	for _, c := range cfgs {
+		if err := cs.notify(ctx, c); err != nil {
+			return []Config{}, err
+		}
+	}
+
+	return cfgs, nil
+}
+
+func (cs *configsService) createConfig(ctx context.Context, config *Config, token string) (Config, error) {
+	if _, err := cs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: config.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return Config{}, err
+	}
+
+	if err := cs.validateThing(ctx, *config); err != nil {
+		return Config{}, err
+	}
+
+	id, err := cs.idProvider.ID()
+	if err != nil {
+		return Config{}, err
+	}
+	config.ID = id
+	config.Version = 1
+	config.Updated = time.Now()
+
+	cfgs, err := cs.configs.Save(ctx, *config)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if len(cfgs) == 0 {
+		return Config{}, errors.ErrCreateEntity
+	}
+
+	return cfgs[0], nil
+}
+
+func (cs *configsService) ListConfigsByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (ConfigsPage, error) {
+	if _, err := cs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return ConfigsPage{}, err
+	}
+
+	return cs.configs.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (cs *configsService) ViewConfig(ctx context.Context, token, id string) (Config, error) {
+	return cs.retrieveConfig(ctx, token, id, things.Viewer)
+}
+
+func (cs *configsService) UpdateConfig(ctx context.Context, token string, config Config) error {
+	c, err := cs.retrieveConfig(ctx, token, config.ID, things.Editor)
+	if err != nil {
+		return err
+	}
+
+	// The config stays assigned to the same thing or group.
+	config.GroupID = c.GroupID
+	config.ThingID = c.ThingID
+	config.Updated = time.Now()
+
+	updated, err := cs.configs.Update(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	return cs.notify(ctx, updated)
+}
+
+func (cs *configsService) RemoveConfigs(ctx context.Context, token string, ids ...string) error {
+	var removed []Config
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		c, err := cs.configs.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: c.GroupID, Subject: things.GroupSub, Action: things.Editor})
+		removed = append(removed, c)
+	}
+
+	if _, err := cs.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	if err := cs.configs.Remove(ctx, ids...); err != nil {
+		return err
+	}
+
+	for _, c := range removed {
+		if err := cs.notify(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *configsService) RemoveConfigsByGroup(ctx context.Context, groupID string) error {
+	if err := cs.statuses.RemoveByGroup(ctx, groupID); err != nil {
+		return err
+	}
+
+	return cs.configs.RemoveByGroupID(ctx, groupID)
+}
+
+func (cs *configsService) ViewDrift(ctx context.Context, token, thingID string) (Drift, error) {
+	grID, err := cs.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: thingID})
+	if err != nil {
+		return Drift{}, err
+	}
+
+	if _, err := cs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: grID.GetValue(), Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return Drift{}, err
+	}
+
+	desired, err := cs.configs.RetrieveByThing(ctx, grID.GetValue(), thingID)
+	if err != nil && !errors.Contains(err, errors.ErrNotFound) {
+		return Drift{}, err
+	}
+
+	applied, err := cs.statuses.RetrieveByThing(ctx, thingID)
+	if err != nil && !errors.Contains(err, errors.ErrNotFound) {
+		return Drift{}, err
+	}
+
+	return Drift{
+		ThingID: thingID,
+		Desired: desired,
+		Applied: applied,
+		InSync:  desired.ID == applied.ConfigID && desired.Version == applied.Version && applied.Error == "",
+	}, nil
+}
+
+func (cs *configsService) ViewThingConfig(ctx context.Context, key string) (ThingConfig, error) {
+	thingID, grID, err := cs.identify(ctx, key)
+	if err != nil {
+		return ThingConfig{}, err
+	}
+
+	c, err := cs.configs.RetrieveByThing(ctx, grID, thingID)
+	if err != nil {
+		return ThingConfig{}, err
+	}
+
+	orgID, err := cs.orgID(ctx, grID)
+	if err != nil {
+		return ThingConfig{}, err
+	}
+
+	sk, err := cs.auth.RetrieveSigningKey(ctx, &protomfx.SigningKeyReq{OrgID: orgID})
+	if err != nil {
+		return ThingConfig{}, err
+	}
+
+	payload, err := json.Marshal(signedConfig{ID: c.ID, Content: c.Content, Version: c.Version})
+	if err != nil {
+		return ThingConfig{}, err
+	}
+
+	ts, sig, err := cs.sign(ctx, orgID, thingID, payload)
+	if err != nil {
+		return ThingConfig{}, err
+	}
+
+	tc := ThingConfig{Config: c, SigningKey: sk.GetPublicKey()}
+	if len(sig) > 0 {
+		if tc.Signed, err = messaging.EncodeSignedPayload(thingID, ts, payload, sig); err != nil {
+			return ThingConfig{}, err
+		}
+	}
+
+	return tc, nil
+}
+
+func (cs *configsService) ReportStatus(ctx context.Context, key string, status Status) error {
+	thingID, grID, err := cs.identify(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	status.ThingID = thingID
+	status.GroupID = grID
+	status.Updated = time.Now()
+
+	return cs.statuses.Save(ctx, status)
+}
+
+type signedConfig struct {
+	ID      string                 `json:"id"`
+	Content map[string]interface{} `json:"content"`
+	Version uint64                 `json:"version"`
+}
+
+type notification struct {
+	ConfigID string `json:"config_id"`
+	Version  uint64 `json:"version"`
+}
+
+// notify publishes the config the things affected by the changed config
+// should apply. The config content is not published, so that it's retrieved
+// by the things using their keys. Only the things which reported a status are
+// notified of the group config changes, since the things of a group are not
+// known otherwise. The notifications are signed if the org of the group has
+// a signing key.
+func (cs *configsService) notify(ctx context.Context, changed Config) error {
+	orgID, err := cs.orgID(ctx, changed.GroupID)
+	if err != nil {
+		return err
+	}
+
+	thingIDs := []string{changed.ThingID}
+	if changed.ThingID == "" {
+		statuses, err := cs.statuses.RetrieveByGroup(ctx, changed.GroupID)
+		if err != nil {
+			return err
+		}
+
+		thingIDs = []string{}
+		for _, s := range statuses {
+			thingIDs = append(thingIDs, s.ThingID)
+		}
+	}
+
+	for _, thingID := range thingIDs {
+		desired, err := cs.configs.RetrieveByThing(ctx, changed.GroupID, thingID)
+		if err != nil && !errors.Contains(err, errors.ErrNotFound) {
+			return err
+		}
+
+		// The thing config takes precedence over the changed group config.
+		if changed.ThingID == "" && desired.ThingID != "" {
+			continue
+		}
+
+		if err := cs.publish(ctx, orgID, thingID, notification{ConfigID: desired.ID, Version: desired.Version}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cs *configsService) publish(ctx context.Context, orgID, thingID string, n notification) error {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	ts, sig, err := cs.sign(ctx, orgID, thingID, payload)
+	if err != nil {
+		return err
+	}
+
+	payload, err = messaging.EncodeSignedPayload(thingID, ts, payload, sig)
+	if err != nil {
+		return err
+	}
+
+	msg := protomfx.Message{
+		Publisher: thingID,
+		Subtopic:  fmt.Sprintf("%s.%s", ControlSubtopic, thingID),
+		Protocol:  protocol,
+		Payload:   payload,
+		Created:   time.Now().UnixNano(),
+		ProfileConfig: &protomfx.Config{
+			ContentType: messaging.JSONContentType,
+			Write:       true,
+		},
+	}
+
+	return cs.publisher.Publish(msg)
+}
+
+// sign signs the payload sent to the thing using the signing key of the org,
+// and returns the signing timestamp with the signature. The signature is
+// empty if the org has no signing key, or if the signing secret is not set.
+func (cs *configsService) sign(ctx context.Context, orgID, thingID string, payload []byte) (int64, []byte, error) {
+	ts := time.Now().UnixNano()
+	if cs.signingSecret == "" {
+		return ts, []byte{}, nil
+	}
+
+	req := protomfx.SignPayloadReq{
+		OrgID:   orgID,
+		Payload: messaging.SigningInput(thingID, ts, payload),
+		Secret:  cs.signingSecret,
+	}
+	res, err := cs.auth.SignPayload(ctx, &req)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return ts, res.GetSignature(), nil
+}
+
+func (cs *configsService) orgID(ctx context.Context, groupID string) (string, error) {
+	res, err := cs.things.GetGroupsByIDs(ctx, &protomfx.GroupsReq{Ids: []string{groupID}})
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.GetGroups()) == 0 {
+		return "", errors.ErrNotFound
+	}
+
+	return res.GetGroups()[0].GetOrgID(), nil
+}
+
+func (cs *configsService) identify(ctx context.Context, key string) (string, string, error) {
+	thingID, err := cs.things.Identify(ctx, &protomfx.Token{Value: key})
+	if err != nil {
+		return "", "", err
+	}
+
+	grID, err := cs.things.GetGroupIDByThingID(ctx, thingID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return thingID.GetValue(), grID.GetValue(), nil
+}
+
+func (cs *configsService) retrieveConfig(ctx context.Context, token, id, action string) (Config, error) {
+	config, err := cs.configs.RetrieveByID(ctx, id)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if _, err := cs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: config.GroupID, Subject: things.GroupSub, Action: action}); err != nil {
+		return Config{}, err
+	}
+
+	return config, nil
+}
+
+func (cs *configsService) validateThing(ctx context.Context, config Config) error {
+	if config.ThingID == "" {
+		return nil
+	}
+
+	grID, err := cs.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: config.ThingID})
+	if err != nil {
+		return err
+	}
+
+	if grID.GetValue() != config.GroupID {
+		return errors.Wrap(errors.ErrAuthorization, ErrThingGroup)
+	}
+
+	return nil
+}
@@ -0,0 +1,435 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package configs_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	cfmocks "github.com/MainfluxLabs/mainflux/configs/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token      = "admin@example.com"
+	wrongValue = "wrong-value"
+	groupID    = "574106f7-030e-4881-8ab0-151195c29f94"
+	orgID      = "a1a0a2c4-6b4e-4e23-9a45-6c1f6f8f3e21"
+	thingID    = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	thingKey   = "5d8e1e7c-8a6f-4a4b-9a5e-2e4f0f7c8b1d"
+	otherThing = "c4bb5a4b-8a4e-4e0f-9e31-8a3b1f1c8c8e"
+	otherKey   = "7b1f7a7e-3c2d-4c55-9d47-0f3b0d4c6a2e"
+
+	signingSecret = "signing-secret"
+)
+
+var (
+	groupConfig = configs.Config{
+		GroupID: groupID,
+		Name:    "group-config",
+		Content: map[string]interface{}{"interval": float64(60)},
+	}
+	thingConfig = configs.Config{
+		GroupID: groupID,
+		ThingID: thingID,
+		Name:    "thing-config",
+		Content: map[string]interface{}{"interval": float64(10)},
+	}
+)
+
+type publisherMock struct {
+	mu       sync.Mutex
+	messages []protomfx.Message
+}
+
+func (pub *publisherMock) Publish(msg protomfx.Message) error {
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+
+	pub.messages = append(pub.messages, msg)
+	return nil
+}
+
+func (pub *publisherMock) Close() error {
+	return nil
+}
+
+func newService(pub *publisherMock) configs.Service {
+	return newSigningService(pub, map[string]ed25519.PrivateKey{})
+}
+
+func newSigningService(pub *publisherMock, signingKeys map[string]ed25519.PrivateKey) configs.Service {
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingKey: thingID, otherKey: otherThing, thingID: groupID, otherThing: groupID}, map[string]things.Group{token: {ID: groupID}, groupID: {ID: groupID, OrgID: orgID}})
+	auth := mocks.NewSigningAuthService("", nil, signingKeys)
+	return configs.New(ths, auth, cfmocks.NewConfigRepository(), cfmocks.NewStatusRepository(), pub, uuid.NewMock(), signingSecret)
+}
+
+func TestCreateConfigs(t *testing.T) {
+	svc := newService(&publisherMock{})
+
+	otherGroupCfg := thingConfig
+	otherGroupCfg.Name = "other-group"
+	otherGroupCfg.GroupID = wrongValue
+
+	unknownThingCfg := thingConfig
+	unknownThingCfg.Name = "unknown-thing"
+	unknownThingCfg.ThingID = wrongValue
+
+	cases := []struct {
+		desc   string
+		config configs.Config
+		token  string
+		err    error
+	}{
+		{
+			desc:   "create group config",
+			config: groupConfig,
+			token:  token,
+			err:    nil,
+		},
+		{
+			desc:   "create thing config",
+			config: thingConfig,
+			token:  token,
+			err:    nil,
+		},
+		{
+			desc:   "create config with existing name",
+			config: groupConfig,
+			token:  token,
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "create config with wrong credentials",
+			config: groupConfig,
+			token:  wrongValue,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "create config of other group",
+			config: otherGroupCfg,
+			token:  token,
+			err:    errors.ErrAuthorization,
+		},
+		{
+			desc:   "create config for unknown thing",
+			config: unknownThingCfg,
+			token:  token,
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		cfgs, err := svc.CreateConfigs(context.Background(), tc.token, tc.config)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.Equal(t, uint64(1), cfgs[0].Version, fmt.Sprintf("%s: expected version 1 got %d\n", tc.desc, cfgs[0].Version))
+		}
+	}
+}
+
+func TestViewThingConfig(t *testing.T) {
+	svc := newService(&publisherMock{})
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		key    string
+		config configs.Config
+		err    error
+	}{
+		{
+			desc:   "view config of thing with thing config",
+			key:    thingKey,
+			config: cfgs[1],
+			err:    nil,
+		},
+		{
+			desc:   "view config of thing with group config",
+			key:    otherKey,
+			config: cfgs[0],
+			err:    nil,
+		},
+		{
+			desc:   "view config with invalid key",
+			key:    wrongValue,
+			config: configs.Config{},
+			err:    errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		c, err := svc.ViewThingConfig(context.Background(), tc.key)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.config.ID, c.ID, fmt.Sprintf("%s: expected config %s got %s\n", tc.desc, tc.config.ID, c.ID))
+		assert.Empty(t, c.SigningKey, fmt.Sprintf("%s: expected no signing key got %v\n", tc.desc, c.SigningKey))
+		assert.Empty(t, c.Signed, fmt.Sprintf("%s: expected unsigned config got %s\n", tc.desc, c.Signed))
+	}
+}
+
+func TestViewSignedThingConfig(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	svc := newSigningService(&publisherMock{}, map[string]ed25519.PrivateKey{orgID: priv})
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	c, err := svc.ViewThingConfig(context.Background(), thingKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, cfgs[0].ID, c.ID, fmt.Sprintf("expected config %s got %s\n", cfgs[0].ID, c.ID))
+	assert.Equal(t, []byte(pub), c.SigningKey, fmt.Sprintf("expected signing key %v got %v\n", pub, c.SigningKey))
+
+	_, err = messaging.VerifyPayload(pub, otherThing, time.Minute, c.Signed)
+	assert.True(t, errors.Contains(err, messaging.ErrInvalidSignature), fmt.Sprintf("verify config signed for other thing: expected %s got %s\n", messaging.ErrInvalidSignature, err))
+
+	payload, err := messaging.VerifyPayload(pub, thingID, time.Minute, c.Signed)
+	require.Nil(t, err, fmt.Sprintf("verify config: unexpected error: %s", err))
+
+	var sc map[string]interface{}
+	err = json.Unmarshal(payload, &sc)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, cfgs[0].ID, sc["id"], fmt.Sprintf("expected config %s got %v", cfgs[0].ID, sc["id"]))
+	assert.Equal(t, cfgs[0].Content, sc["content"], fmt.Sprintf("expected content %v got %v", cfgs[0].Content, sc["content"]))
+}
+
+func TestUpdateConfig(t *testing.T) {
+	pub := &publisherMock{}
+	svc := newService(pub)
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grCfg, thCfg := cfgs[0], cfgs[1]
+
+	// Both things are notified of the group config changes once they report a status.
+	for _, key := range []string{thingKey, otherKey} {
+		err := svc.ReportStatus(context.Background(), key, configs.Status{ConfigID: grCfg.ID, Version: grCfg.Version})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+	pub.messages = nil
+
+	updatedGrCfg := grCfg
+	updatedGrCfg.Content = map[string]interface{}{"interval": float64(30)}
+
+	cases := []struct {
+		desc     string
+		config   configs.Config
+		token    string
+		notified []string
+		err      error
+	}{
+		{
+			desc:     "update group config",
+			config:   updatedGrCfg,
+			token:    token,
+			notified: []string{otherThing},
+			err:      nil,
+		},
+		{
+			desc:     "update thing config",
+			config:   thCfg,
+			token:    token,
+			notified: []string{thingID},
+			err:      nil,
+		},
+		{
+			desc:     "update config with wrong credentials",
+			config:   updatedGrCfg,
+			token:    wrongValue,
+			notified: []string{},
+			err:      errors.ErrAuthentication,
+		},
+		{
+			desc:     "update non-existing config",
+			config:   configs.Config{ID: wrongValue},
+			token:    token,
+			notified: []string{},
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		pub.messages = nil
+		err := svc.UpdateConfig(context.Background(), tc.token, tc.config)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		notified := []string{}
+		for _, msg := range pub.messages {
+			notified = append(notified, msg.Publisher)
+			assert.Equal(t, fmt.Sprintf("%s.%s", configs.ControlSubtopic, msg.Publisher), msg.Subtopic, fmt.Sprintf("%s: unexpected subtopic %s\n", tc.desc, msg.Subtopic))
+		}
+		assert.ElementsMatch(t, tc.notified, notified, fmt.Sprintf("%s: expected notified things %v got %v\n", tc.desc, tc.notified, notified))
+	}
+
+	c, err := svc.ViewConfig(context.Background(), token, grCfg.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(2), c.Version, fmt.Sprintf("update config: expected version 2 got %d", c.Version))
+}
+
+func TestRemoveConfigs(t *testing.T) {
+	pub := &publisherMock{}
+	svc := newService(pub)
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub.messages = nil
+
+	err = svc.RemoveConfigs(context.Background(), wrongValue, cfgs[1].ID)
+	assert.True(t, errors.Contains(err, errors.ErrAuthentication), fmt.Sprintf("remove config with wrong credentials: expected %s got %s\n", errors.ErrAuthentication, err))
+
+	err = svc.RemoveConfigs(context.Background(), token, cfgs[1].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	// The thing falls back to the group config.
+	require.Len(t, pub.messages, 1, "remove thing config: expected single notification")
+	var n map[string]interface{}
+	err = json.Unmarshal(pub.messages[0].Payload, &n)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, cfgs[0].ID, n["config_id"], fmt.Sprintf("remove thing config: expected config %s got %v", cfgs[0].ID, n["config_id"]))
+
+	_, err = svc.ViewConfig(context.Background(), token, cfgs[1].ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("view removed config: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestRemoveConfigsByGroup(t *testing.T) {
+	svc := newService(&publisherMock{})
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove configs by group",
+			groupID: groupID,
+			err:     nil,
+		},
+		{
+			desc:    "remove configs by group without configs",
+			groupID: wrongValue,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveConfigsByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	for _, cfg := range cfgs {
+		_, err := svc.ViewConfig(context.Background(), token, cfg.ID)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("view removed config: expected %s got %s\n", errors.ErrNotFound, err))
+	}
+}
+
+func TestSignedNotification(t *testing.T) {
+	pubKey, priv, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	otherKey, _, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	pub := &publisherMock{}
+	svc := newSigningService(pub, map[string]ed25519.PrivateKey{orgID: priv})
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig, thingConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub.messages = nil
+
+	err = svc.RemoveConfigs(context.Background(), token, cfgs[1].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Len(t, pub.messages, 1, "remove thing config: expected single notification")
+
+	_, err = messaging.VerifyPayload(otherKey, thingID, time.Minute, pub.messages[0].Payload)
+	assert.True(t, errors.Contains(err, messaging.ErrInvalidSignature), fmt.Sprintf("verify notification with other key: expected %s got %s\n", messaging.ErrInvalidSignature, err))
+
+	_, err = messaging.VerifyPayload(pubKey, otherThing, time.Minute, pub.messages[0].Payload)
+	assert.True(t, errors.Contains(err, messaging.ErrInvalidSignature), fmt.Sprintf("verify notification for other thing: expected %s got %s\n", messaging.ErrInvalidSignature, err))
+
+	payload, err := messaging.VerifyPayload(pubKey, thingID, time.Minute, pub.messages[0].Payload)
+	require.Nil(t, err, fmt.Sprintf("verify notification: unexpected error: %s", err))
+
+	var n map[string]interface{}
+	err = json.Unmarshal(payload, &n)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, cfgs[0].ID, n["config_id"], fmt.Sprintf("expected config %s got %v", cfgs[0].ID, n["config_id"]))
+}
+
+func TestViewDrift(t *testing.T) {
+	svc := newService(&publisherMock{})
+
+	cfgs, err := svc.CreateConfigs(context.Background(), token, groupConfig)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	c := cfgs[0]
+
+	cases := []struct {
+		desc   string
+		status *configs.Status
+		token  string
+		inSync bool
+		err    error
+	}{
+		{
+			desc:   "view drift of thing without status",
+			token:  token,
+			inSync: false,
+			err:    nil,
+		},
+		{
+			desc:   "view drift of thing with applied config",
+			status: &configs.Status{ConfigID: c.ID, Version: c.Version},
+			token:  token,
+			inSync: true,
+			err:    nil,
+		},
+		{
+			desc:   "view drift of thing with outdated config",
+			status: &configs.Status{ConfigID: c.ID, Version: c.Version - 1},
+			token:  token,
+			inSync: false,
+			err:    nil,
+		},
+		{
+			desc:   "view drift of thing which failed to apply config",
+			status: &configs.Status{ConfigID: c.ID, Version: c.Version, Error: "invalid interval"},
+			token:  token,
+			inSync: false,
+			err:    nil,
+		},
+		{
+			desc:   "view drift with wrong credentials",
+			token:  wrongValue,
+			inSync: false,
+			err:    errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.status != nil {
+			err := svc.ReportStatus(context.Background(), thingKey, *tc.status)
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		}
+
+		d, err := svc.ViewDrift(context.Background(), tc.token, thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.inSync, d.InSync, fmt.Sprintf("%s: expected in sync %t got %t\n", tc.desc, tc.inSync, d.InSync))
+	}
+}
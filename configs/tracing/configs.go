@@ -0,0 +1,144 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/configs"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/opentracing/opentracing-go"
+)
+
+var (
+	_ configs.ConfigRepository = (*configRepositoryMiddleware)(nil)
+	_ configs.StatusRepository = (*statusRepositoryMiddleware)(nil)
+)
+
+type configRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   configs.ConfigRepository
+}
+
+// ConfigRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func ConfigRepositoryMiddleware(tracer opentracing.Tracer, repo configs.ConfigRepository) configs.ConfigRepository {
+	return configRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (crm configRepositoryMiddleware) Save(ctx context.Context, cs ...configs.Config) ([]configs.Config, error) {
+	span := createSpan(ctx, crm.tracer, "save_configs")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.Save(ctx, cs...)
+}
+
+func (crm configRepositoryMiddleware) RetrieveByGroupID(ctx context.Context, groupID string, pm configs.PageMetadata) (configs.ConfigsPage, error) {
+	span := createSpan(ctx, crm.tracer, "retrieve_by_group_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (crm configRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (configs.Config, error) {
+	span := createSpan(ctx, crm.tracer, "retrieve_config_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.RetrieveByID(ctx, id)
+}
+
+func (crm configRepositoryMiddleware) RetrieveByThing(ctx context.Context, groupID, thingID string) (configs.Config, error) {
+	span := createSpan(ctx, crm.tracer, "retrieve_config_by_thing")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.RetrieveByThing(ctx, groupID, thingID)
+}
+
+func (crm configRepositoryMiddleware) Update(ctx context.Context, c configs.Config) (configs.Config, error) {
+	span := createSpan(ctx, crm.tracer, "update_config")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.Update(ctx, c)
+}
+
+func (crm configRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, crm.tracer, "remove_configs")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.Remove(ctx, ids...)
+}
+
+func (crm configRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, crm.tracer, "remove_configs_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return crm.repo.RemoveByGroupID(ctx, groupID)
+}
+
+type statusRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   configs.StatusRepository
+}
+
+// StatusRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func StatusRepositoryMiddleware(tracer opentracing.Tracer, repo configs.StatusRepository) configs.StatusRepository {
+	return statusRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (srm statusRepositoryMiddleware) Save(ctx context.Context, s configs.Status) error {
+	span := createSpan(ctx, srm.tracer, "save_status")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.Save(ctx, s)
+}
+
+func (srm statusRepositoryMiddleware) RetrieveByThing(ctx context.Context, thingID string) (configs.Status, error) {
+	span := createSpan(ctx, srm.tracer, "retrieve_status_by_thing")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByThing(ctx, thingID)
+}
+
+func (srm statusRepositoryMiddleware) RetrieveByGroup(ctx context.Context, groupID string) ([]configs.Status, error) {
+	span := createSpan(ctx, srm.tracer, "retrieve_statuses_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByGroup(ctx, groupID)
+}
+
+func (srm statusRepositoryMiddleware) RemoveByGroup(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, srm.tracer, "remove_statuses_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RemoveByGroup(ctx, groupID)
+}
+
+func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		return tracer.StartSpan(
+			opName,
+			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
+		)
+	}
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalies
+
+import "context"
+
+const (
+	// SpikeAnomaly represents the reading deviating from the baseline mean
+	// by more than the configured number of standard deviations.
+	SpikeAnomaly = "spike"
+	// DriftAnomaly represents the EWMA of the readings drifting away from the
+	// baseline mean beyond the EWMA control limit.
+	DriftAnomaly = "drift"
+)
+
+// Detector represents the anomaly detection configuration of the thing
+// metric, i.e. of the SenML records with the given name published by the
+// thing.
+type Detector struct {
+	ID       string
+	GroupID  string
+	ThingID  string
+	Metric   string
+	Sigma    float64
+	Window   uint64
+	Alpha    float64
+	Metadata map[string]interface{}
+}
+
+// DetectorsPage contains page related metadata as well as a list of
+// detectors that belong to this page.
+type DetectorsPage struct {
+	PageMetadata
+	Detectors []Detector
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total  uint64
+	Offset uint64
+	Limit  uint64
+}
+
+// Anomaly represents the anomaly event published to the alarms subject.
+type Anomaly struct {
+	DetectorID string  `json:"detector_id"`
+	GroupID    string  `json:"group_id"`
+	ThingID    string  `json:"thing_id"`
+	Metric     string  `json:"metric"`
+	Type       string  `json:"type"`
+	Value      float64 `json:"value"`
+	Mean       float64 `json:"mean"`
+	Stddev     float64 `json:"stddev"`
+	EWMA       float64 `json:"ewma"`
+	Sigma      float64 `json:"sigma"`
+	Time       float64 `json:"time"`
+}
+
+// DetectorRepository specifies a detector persistence API.
+type DetectorRepository interface {
+	// Save persists multiple detectors. Detectors are saved using a
+	// transaction. If one detector fails then none will be saved.
+	Save(ctx context.Context, ds ...Detector) ([]Detector, error)
+
+	// RetrieveByGroupID retrieves detectors related to a certain group
+	// identified by a given ID.
+	RetrieveByGroupID(ctx context.Context, groupID string, pm PageMetadata) (DetectorsPage, error)
+
+	// RetrieveByThingID retrieves all the detectors of the thing identified
+	// by a given ID.
+	RetrieveByThingID(ctx context.Context, thingID string) ([]Detector, error)
+
+	// RetrieveByID retrieves the detector having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (Detector, error)
+
+	// Update performs an update to the existing detector. A non-nil error is
+	// returned to indicate operation failure.
+	Update(ctx context.Context, d Detector) error
+
+	// Remove removes the detectors having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the detectors related to a certain group
+	// identified by a given ID, and returns the IDs of the removed detectors.
+	RemoveByGroupID(ctx context.Context, groupID string) ([]string, error)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func createDetectorsEndpoint(svc anomalies.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createDetectorsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		dts := []anomalies.Detector{}
+		for _, dReq := range req.Detectors {
+			d := anomalies.Detector{
+				GroupID:  req.groupID,
+				ThingID:  dReq.ThingID,
+				Metric:   dReq.Metric,
+				Sigma:    dReq.Sigma,
+				Window:   dReq.Window,
+				Alpha:    dReq.Alpha,
+				Metadata: dReq.Metadata,
+			}
+			dts = append(dts, d)
+		}
+
+		saved, err := svc.CreateDetectors(ctx, req.token, dts...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := detectorsRes{Detectors: []detectorRes{}, created: true}
+		for _, d := range saved {
+			res.Detectors = append(res.Detectors, buildDetectorResponse(d))
+		}
+
+		return res, nil
+	}
+}
+
+func listDetectorsByGroupEndpoint(svc anomalies.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listDetectorsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListDetectorsByGroup(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := detectorsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Detectors: []detectorRes{},
+		}
+		for _, d := range page.Detectors {
+			res.Detectors = append(res.Detectors, buildDetectorResponse(d))
+		}
+
+		return res, nil
+	}
+}
+
+func viewDetectorEndpoint(svc anomalies.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(detectorReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		d, err := svc.ViewDetector(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildDetectorResponse(d), nil
+	}
+}
+
+func updateDetectorEndpoint(svc anomalies.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateDetectorReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		d := anomalies.Detector{
+			ID:       req.id,
+			ThingID:  req.ThingID,
+			Metric:   req.Metric,
+			Sigma:    req.Sigma,
+			Window:   req.Window,
+			Alpha:    req.Alpha,
+			Metadata: req.Metadata,
+		}
+
+		if err := svc.UpdateDetector(ctx, req.token, d); err != nil {
+			return nil, err
+		}
+
+		return detectorRes{updated: true}, nil
+	}
+}
+
+func removeDetectorsEndpoint(svc anomalies.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeDetectorsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveDetectors(ctx, req.token, req.DetectorIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func buildDetectorResponse(d anomalies.Detector) detectorRes {
+	return detectorRes{
+		ID:       d.ID,
+		GroupID:  d.GroupID,
+		ThingID:  d.ThingID,
+		Metric:   d.Metric,
+		Sigma:    d.Sigma,
+		Window:   d.Window,
+		Alpha:    d.Alpha,
+		Metadata: d.Metadata,
+	}
+}
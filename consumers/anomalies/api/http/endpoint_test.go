@@ -0,0 +1,531 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	httpapi "github.com/MainfluxLabs/mainflux/consumers/anomalies/api/http"
+	anmocks "github.com/MainfluxLabs/mainflux/consumers/anomalies/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token       = "admin@example.com"
+	groupID     = "574106f7-030e-4881-8ab0-151195c29f94"
+	thingID     = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	wrongValue  = "wrong-value"
+	contentType = "application/json"
+	emptyValue  = ""
+)
+
+var detector = anomalies.Detector{
+	GroupID: groupID,
+	ThingID: thingID,
+	Metric:  "temperature",
+}
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newService() anomalies.Service {
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID}, map[string]things.Group{token: {ID: groupID}})
+	return anomalies.New(ths, anmocks.NewDetectorRepository(), mocks.NewPublisher(), uuid.NewMock())
+}
+
+func newHTTPServer(svc anomalies.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
+	return httptest.NewServer(mux)
+}
+
+func toJSON(data interface{}) string {
+	jsonData, _ := json.Marshal(data)
+	return string(jsonData)
+}
+
+type detectorRes struct {
+	ID      string  `json:"id"`
+	GroupID string  `json:"group_id"`
+	ThingID string  `json:"thing_id"`
+	Metric  string  `json:"metric"`
+	Sigma   float64 `json:"sigma"`
+	Window  uint64  `json:"window"`
+	Alpha   float64 `json:"alpha"`
+}
+
+type detectorsRes struct {
+	Detectors []detectorRes `json:"detectors"`
+}
+
+type detectorsPageRes struct {
+	Total     uint64        `json:"total"`
+	Detectors []detectorRes `json:"detectors"`
+}
+
+func TestCreateDetectors(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	valid := map[string]interface{}{
+		"thing_id": thingID,
+		"metric":   "temperature",
+		"sigma":    2.5,
+		"window":   50,
+		"alpha":    0.2,
+		"metadata": map[string]string{"test": "data"},
+	}
+
+	invalid := func(key string, value interface{}) string {
+		d := map[string]interface{}{}
+		for k, v := range valid {
+			d[k] = v
+		}
+		d[key] = value
+		return toJSON([]map[string]interface{}{d})
+	}
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create detectors",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create existing detector",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create detector with empty list",
+			data:        "[]",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector without metric",
+			data:        invalid("metric", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector without thing",
+			data:        invalid("thing_id", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector with negative sigma",
+			data:        invalid("sigma", -1),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector with too small window",
+			data:        invalid("window", 1),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector with invalid alpha",
+			data:        invalid("alpha", 1.5),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector with malformed data",
+			data:        `[{"metric":}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create detector without content type",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create detector with invalid token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create detector with empty token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/detectors", ts.URL, groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestCreateDetectorsWithDefaults(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	req := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPost,
+		url:         fmt.Sprintf("%s/groups/%s/detectors", ts.URL, groupID),
+		contentType: contentType,
+		token:       token,
+		body:        strings.NewReader(toJSON([]map[string]string{{"thing_id": thingID, "metric": "temperature"}})),
+	}
+	res, err := req.make()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Equal(t, http.StatusCreated, res.StatusCode, fmt.Sprintf("expected status code %d got %d", http.StatusCreated, res.StatusCode))
+
+	var body detectorsRes
+	require.Nil(t, json.NewDecoder(res.Body).Decode(&body), "unexpected error decoding response")
+	require.Len(t, body.Detectors, 1, "expected single detector")
+
+	d := body.Detectors[0]
+	assert.Equal(t, float64(anomalies.DefSigma), d.Sigma, fmt.Sprintf("expected sigma %v got %v", anomalies.DefSigma, d.Sigma))
+	assert.Equal(t, uint64(anomalies.DefWindow), d.Window, fmt.Sprintf("expected window %v got %v", anomalies.DefWindow, d.Window))
+	assert.Equal(t, anomalies.DefAlpha, d.Alpha, fmt.Sprintf("expected alpha %v got %v", anomalies.DefAlpha, d.Alpha))
+}
+
+func TestListDetectorsByGroup(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	n := 5
+	for i := 0; i < n; i++ {
+		d := detector
+		d.Metric = fmt.Sprintf("metric-%d", i)
+		_, err := svc.CreateDetectors(context.Background(), token, d)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	detectorsURL := fmt.Sprintf("%s/groups/%s/detectors", ts.URL, groupID)
+
+	cases := []struct {
+		desc   string
+		url    string
+		auth   string
+		status int
+		size   int
+	}{
+		{
+			desc:   "list detectors",
+			url:    detectorsURL,
+			auth:   token,
+			status: http.StatusOK,
+			size:   n,
+		},
+		{
+			desc:   "list detectors with limit",
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", detectorsURL, 1, 2),
+			auth:   token,
+			status: http.StatusOK,
+			size:   2,
+		},
+		{
+			desc:   "list detectors with limit greater than max",
+			url:    fmt.Sprintf("%s?limit=%d", detectorsURL, 110),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list detectors with invalid token",
+			url:    detectorsURL,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			size:   0,
+		},
+		{
+			desc:   "list detectors of inaccessible group",
+			url:    fmt.Sprintf("%s/groups/%s/detectors", ts.URL, wrongValue),
+			auth:   token,
+			status: http.StatusForbidden,
+			size:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body detectorsPageRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.size, len(body.Detectors), fmt.Sprintf("%s: expected size %d got %d", tc.desc, tc.size, len(body.Detectors)))
+	}
+}
+
+func TestViewDetector(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	dts, err := svc.CreateDetectors(context.Background(), token, detector)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	d := dts[0]
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "view detector",
+			id:     d.ID,
+			auth:   token,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "view non-existing detector",
+			id:     wrongValue,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "view detector with invalid token",
+			id:     d.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "view detector with empty token",
+			id:     d.ID,
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/detectors/%s", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestUpdateDetector(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	dts, err := svc.CreateDetectors(context.Background(), token, detector)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	d := dts[0]
+
+	data := toJSON(map[string]interface{}{
+		"thing_id": thingID,
+		"metric":   "temperature",
+		"sigma":    4,
+	})
+
+	cases := []struct {
+		desc        string
+		id          string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "update detector",
+			id:          d.ID,
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update non-existing detector",
+			id:          wrongValue,
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "update detector with too large window",
+			id:          d.ID,
+			data:        `{"thing_id":"` + thingID + `","metric":"temperature","window":100000}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update detector without content type",
+			id:          d.ID,
+			data:        data,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "update detector with invalid token",
+			id:          d.ID,
+			data:        data,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/detectors/%s", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	updated, err := svc.ViewDetector(context.Background(), token, d.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, float64(4), updated.Sigma, fmt.Sprintf("update detector: expected sigma 4 got %v", updated.Sigma))
+}
+
+func TestRemoveDetectors(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	dts, err := svc.CreateDetectors(context.Background(), token, detector)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	data := toJSON(map[string][]string{"detector_ids": {dts[0].ID}})
+
+	cases := []struct {
+		desc   string
+		data   string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "remove detectors with invalid token",
+			data:   data,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove detectors with empty list",
+			data:   `{"detector_ids":[]}`,
+			auth:   token,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "remove detectors",
+			data:   data,
+			auth:   token,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "remove removed detectors",
+			data:   data,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/detectors", ts.URL),
+			contentType: contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const (
+	minLen        = 1
+	maxLimitSize  = 100
+	maxMetricSize = 254
+	minWindow     = 2
+	maxWindow     = 10000
+)
+
+var (
+	// ErrInvalidSigma indicates the non-positive number of standard deviations.
+	ErrInvalidSigma = errors.New("invalid sigma")
+
+	// ErrInvalidWindow indicates the window size out of the supported range.
+	ErrInvalidWindow = errors.New("invalid window size")
+
+	// ErrInvalidAlpha indicates the EWMA smoothing factor out of the (0, 1] range.
+	ErrInvalidAlpha = errors.New("invalid alpha")
+)
+
+type apiReq interface {
+	validate() error
+}
+
+type createDetectorReq struct {
+	ThingID  string                 `json:"thing_id"`
+	Metric   string                 `json:"metric"`
+	Sigma    float64                `json:"sigma,omitempty"`
+	Window   uint64                 `json:"window,omitempty"`
+	Alpha    float64                `json:"alpha,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createDetectorReq) validate() error {
+	return validateDetector(req.ThingID, req.Metric, req.Sigma, req.Window, req.Alpha)
+}
+
+type createDetectorsReq struct {
+	token     string
+	groupID   string
+	Detectors []createDetectorReq
+}
+
+func (req createDetectorsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Detectors) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, d := range req.Detectors {
+		if err := d.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type detectorReq struct {
+	token string
+	id    string
+}
+
+func (req detectorReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listDetectorsReq struct {
+	token        string
+	id           string
+	pageMetadata anomalies.PageMetadata
+}
+
+func (req listDetectorsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type updateDetectorReq struct {
+	token    string
+	id       string
+	ThingID  string                 `json:"thing_id"`
+	Metric   string                 `json:"metric"`
+	Sigma    float64                `json:"sigma,omitempty"`
+	Window   uint64                 `json:"window,omitempty"`
+	Alpha    float64                `json:"alpha,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateDetectorReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateDetector(req.ThingID, req.Metric, req.Sigma, req.Window, req.Alpha)
+}
+
+type removeDetectorsReq struct {
+	token       string
+	DetectorIDs []string `json:"detector_ids,omitempty"`
+}
+
+func (req removeDetectorsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.DetectorIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.DetectorIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+// validateDetector validates the detector configuration. The zero sigma,
+// window and alpha are replaced with the defaults by the service.
+func validateDetector(thingID, metric string, sigma float64, window uint64, alpha float64) error {
+	if thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if metric == "" || len(metric) > maxMetricSize {
+		return apiutil.ErrNameSize
+	}
+
+	if sigma < 0 {
+		return ErrInvalidSigma
+	}
+
+	if window != 0 && (window < minWindow || window > maxWindow) {
+		return ErrInvalidWindow
+	}
+
+	if alpha < 0 || alpha > 1 {
+		return ErrInvalidAlpha
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var (
+	_ apiutil.Response = (*detectorRes)(nil)
+	_ apiutil.Response = (*detectorsRes)(nil)
+	_ apiutil.Response = (*detectorsPageRes)(nil)
+	_ apiutil.Response = (*removeRes)(nil)
+)
+
+type pageRes struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+type detectorRes struct {
+	ID       string                 `json:"id"`
+	GroupID  string                 `json:"group_id"`
+	ThingID  string                 `json:"thing_id"`
+	Metric   string                 `json:"metric"`
+	Sigma    float64                `json:"sigma"`
+	Window   uint64                 `json:"window"`
+	Alpha    float64                `json:"alpha"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	updated  bool
+}
+
+func (res detectorRes) Code() int {
+	return http.StatusOK
+}
+
+func (res detectorRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res detectorRes) Empty() bool {
+	return res.updated
+}
+
+type detectorsRes struct {
+	Detectors []detectorRes `json:"detectors"`
+	created   bool
+}
+
+func (res detectorsRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res detectorsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res detectorsRes) Empty() bool {
+	return false
+}
+
+type detectorsPageRes struct {
+	pageRes
+	Detectors []detectorRes `json:"detectors"`
+}
+
+func (res detectorsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res detectorsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res detectorsPageRes) Empty() bool {
+	return false
+}
+
+type removeRes struct{}
+
+func (res removeRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeRes) Empty() bool {
+	return true
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/go-zoo/bone"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	contentType = "application/json"
+	idKey       = "id"
+	offsetKey   = "offset"
+	limitKey    = "limit"
+	defOffset   = 0
+	defLimit    = 10
+)
+
+// MakeHandler returns a HTTP handler for API endpoints.
+func MakeHandler(tracer opentracing.Tracer, svc anomalies.Service, logger log.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, encodeError)),
+	}
+
+	r := bone.New()
+
+	r.Post("/groups/:id/detectors", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_detectors")(createDetectorsEndpoint(svc)),
+		decodeCreateDetectors,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/detectors", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_detectors_by_group")(listDetectorsByGroupEndpoint(svc)),
+		decodeListDetectors,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/detectors/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_detector")(viewDetectorEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/detectors/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_detector")(updateDetectorEndpoint(svc)),
+		decodeUpdateDetector,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/detectors", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_detectors")(removeDetectorsEndpoint(svc)),
+		decodeRemoveDetectors,
+		encodeResponse,
+		opts...,
+	))
+
+	r.GetFunc("/health", mainflux.Health("anomalies"))
+	r.Handle("/metrics", promhttp.Handler())
+
+	return r
+}
+
+func decodeCreateDetectors(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createDetectorsReq{token: apiutil.ExtractBearerToken(r), groupID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Detectors); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := detectorReq{token: apiutil.ExtractBearerToken(r), id: bone.GetValue(r, idKey)}
+
+	return req, nil
+}
+
+func decodeListDetectors(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listDetectorsReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+		pageMetadata: anomalies.PageMetadata{
+			Offset: o,
+			Limit:  l,
+		},
+	}
+
+	return req, nil
+}
+
+func decodeUpdateDetector(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateDetectorReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveDetectors(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeDetectorsReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", contentType)
+
+	if ar, ok := response.(apiutil.Response); ok {
+		for k, v := range ar.Headers() {
+			w.Header().Set(k, v)
+		}
+
+		w.WriteHeader(ar.Code())
+
+		if ar.Empty() {
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	switch {
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Contains(err, errors.ErrAuthentication),
+		err == apiutil.ErrBearerToken:
+		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, errors.ErrAuthorization):
+		w.WriteHeader(http.StatusForbidden)
+	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		errors.Contains(err, apiutil.ErrMalformedEntity),
+		err == apiutil.ErrLimitSize,
+		err == apiutil.ErrNameSize,
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingGroupID,
+		err == apiutil.ErrOffsetSize,
+		err == ErrInvalidSigma,
+		err == ErrInvalidWindow,
+		err == ErrInvalidAlpha:
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, errors.ErrConflict):
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	if errorVal, ok := err.(errors.Error); ok {
+		w.Header().Set("Content-Type", contentType)
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
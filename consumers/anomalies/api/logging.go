@@ -0,0 +1,119 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	log "github.com/MainfluxLabs/mainflux/logger"
+)
+
+var _ anomalies.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger log.Logger
+	svc    anomalies.Service
+}
+
+// LoggingMiddleware adds logging facilities to the core service.
+func LoggingMiddleware(svc anomalies.Service, logger log.Logger) anomalies.Service {
+	return &loggingMiddleware{logger, svc}
+}
+
+func (lm *loggingMiddleware) CreateDetectors(ctx context.Context, token string, dts ...anomalies.Detector) (response []anomalies.Detector, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_detectors for detectors %v took %s to complete", response, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateDetectors(ctx, token, dts...)
+}
+
+func (lm *loggingMiddleware) ListDetectorsByGroup(ctx context.Context, token, groupID string, pm anomalies.PageMetadata) (response anomalies.DetectorsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_detectors_by_group for id %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListDetectorsByGroup(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) ViewDetector(ctx context.Context, token, id string) (response anomalies.Detector, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_detector for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewDetector(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateDetector(ctx context.Context, token string, detector anomalies.Detector) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_detector for id %s took %s to complete", detector.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateDetector(ctx, token, detector)
+}
+
+func (lm *loggingMiddleware) RemoveDetectors(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_detectors took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveDetectors(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveDetectorsByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_detectors_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveDetectorsByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) Consume(msg interface{}) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method consume took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.Consume(msg)
+}
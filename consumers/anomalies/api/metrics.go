@@ -0,0 +1,96 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ anomalies.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     anomalies.Service
+}
+
+// MetricsMiddleware instruments core service by tracking request count and
+// latency.
+func MetricsMiddleware(svc anomalies.Service, counter metrics.Counter, latency metrics.Histogram) anomalies.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (ms *metricsMiddleware) CreateDetectors(ctx context.Context, token string, dts ...anomalies.Detector) ([]anomalies.Detector, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_detectors").Add(1)
+		ms.latency.With("method", "create_detectors").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateDetectors(ctx, token, dts...)
+}
+
+func (ms *metricsMiddleware) ListDetectorsByGroup(ctx context.Context, token, groupID string, pm anomalies.PageMetadata) (anomalies.DetectorsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_detectors_by_group").Add(1)
+		ms.latency.With("method", "list_detectors_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListDetectorsByGroup(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) ViewDetector(ctx context.Context, token, id string) (anomalies.Detector, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_detector").Add(1)
+		ms.latency.With("method", "view_detector").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewDetector(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateDetector(ctx context.Context, token string, detector anomalies.Detector) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_detector").Add(1)
+		ms.latency.With("method", "update_detector").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateDetector(ctx, token, detector)
+}
+
+func (ms *metricsMiddleware) RemoveDetectors(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_detectors").Add(1)
+		ms.latency.With("method", "remove_detectors").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveDetectors(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveDetectorsByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_detectors_by_group").Add(1)
+		ms.latency.With("method", "remove_detectors_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveDetectorsByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) Consume(msg interface{}) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "consume").Add(1)
+		ms.latency.With("method", "consume").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.Consume(msg)
+}
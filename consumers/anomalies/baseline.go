@@ -0,0 +1,87 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalies
+
+import "math"
+
+// baseline keeps the rolling statistics of the detector metric. The mean and
+// the standard deviation are calculated over the last window readings, while
+// the EWMA is calculated over all the readings.
+type baseline struct {
+	values   []float64
+	next     int
+	ewma     float64
+	drifting bool
+}
+
+func newBaseline(window uint64) *baseline {
+	return &baseline{
+		values: make([]float64, 0, window),
+	}
+}
+
+// update adds the reading to the baseline and returns the types of the
+// anomalies detected. The anomalies are detected only once the window is
+// full, and the drift is reported once, when the EWMA crosses the limit.
+func (b *baseline) update(d Detector, value float64) (stats, []string) {
+	if len(b.values) == 0 {
+		b.ewma = value
+	} else {
+		b.ewma = d.Alpha*value + (1-d.Alpha)*b.ewma
+	}
+
+	var types []string
+	s := stats{ewma: b.ewma}
+	if uint64(len(b.values)) == d.Window {
+		s.mean, s.stddev = meanStddev(b.values)
+
+		if math.Abs(value-s.mean) > d.Sigma*s.stddev {
+			types = append(types, SpikeAnomaly)
+		}
+
+		// The EWMA control limit is narrower than the readings limit, since
+		// the EWMA variance is alpha/(2-alpha) of the readings variance.
+		limit := d.Sigma * s.stddev * math.Sqrt(d.Alpha/(2-d.Alpha))
+		drifting := math.Abs(b.ewma-s.mean) > limit
+		if drifting && !b.drifting {
+			types = append(types, DriftAnomaly)
+		}
+		b.drifting = drifting
+	}
+
+	b.add(value)
+
+	return s, types
+}
+
+func (b *baseline) add(value float64) {
+	if len(b.values) < cap(b.values) {
+		b.values = append(b.values, value)
+		return
+	}
+
+	b.values[b.next] = value
+	b.next = (b.next + 1) % len(b.values)
+}
+
+type stats struct {
+	mean   float64
+	stddev float64
+	ewma   float64
+}
+
+func meanStddev(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+
+	var sq float64
+	for _, v := range values {
+		sq += (v - mean) * (v - mean)
+	}
+
+	return mean, math.Sqrt(sq / float64(len(values)))
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ anomalies.DetectorRepository = (*detectorRepositoryMock)(nil)
+
+type detectorRepositoryMock struct {
+	mu        sync.Mutex
+	detectors map[string]anomalies.Detector
+}
+
+// NewDetectorRepository creates in-memory detector repository.
+func NewDetectorRepository() anomalies.DetectorRepository {
+	return &detectorRepositoryMock{
+		detectors: make(map[string]anomalies.Detector),
+	}
+}
+
+func (drm *detectorRepositoryMock) Save(_ context.Context, ds ...anomalies.Detector) ([]anomalies.Detector, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	for _, d := range ds {
+		for _, dt := range drm.detectors {
+			if dt.ThingID == d.ThingID && dt.Metric == d.Metric {
+				return []anomalies.Detector{}, errors.ErrConflict
+			}
+		}
+
+		drm.detectors[d.ID] = d
+	}
+
+	return ds, nil
+}
+
+func (drm *detectorRepositoryMock) RetrieveByGroupID(_ context.Context, groupID string, pm anomalies.PageMetadata) (anomalies.DetectorsPage, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	var items []anomalies.Detector
+	for _, d := range drm.detectors {
+		if d.GroupID == groupID {
+			items = append(items, d)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ID < items[j].ID
+	})
+
+	total := uint64(len(items))
+	items = paginate(items, pm)
+
+	return anomalies.DetectorsPage{
+		Detectors: items,
+		PageMetadata: anomalies.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (drm *detectorRepositoryMock) RetrieveByThingID(_ context.Context, thingID string) ([]anomalies.Detector, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	var items []anomalies.Detector
+	for _, d := range drm.detectors {
+		if d.ThingID == thingID {
+			items = append(items, d)
+		}
+	}
+
+	return items, nil
+}
+
+func (drm *detectorRepositoryMock) RetrieveByID(_ context.Context, id string) (anomalies.Detector, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	d, ok := drm.detectors[id]
+	if !ok {
+		return anomalies.Detector{}, errors.ErrNotFound
+	}
+
+	return d, nil
+}
+
+func (drm *detectorRepositoryMock) Update(_ context.Context, d anomalies.Detector) error {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	if _, ok := drm.detectors[d.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	drm.detectors[d.ID] = d
+
+	return nil
+}
+
+func (drm *detectorRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := drm.detectors[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(drm.detectors, id)
+	}
+
+	return nil
+}
+
+func (drm *detectorRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) ([]string, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	ids := []string{}
+	for id, d := range drm.detectors {
+		if d.GroupID == groupID {
+			delete(drm.detectors, id)
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+func paginate(items []anomalies.Detector, pm anomalies.PageMetadata) []anomalies.Detector {
+	if pm.Limit == 0 {
+		return items
+	}
+
+	if pm.Offset >= uint64(len(items)) {
+		return []anomalies.Detector{}
+	}
+
+	end := pm.Offset + pm.Limit
+	if end > uint64(len(items)) {
+		end = uint64(len(items))
+	}
+
+	return items[pm.Offset:end]
+}
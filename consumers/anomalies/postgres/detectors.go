@@ -0,0 +1,282 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ anomalies.DetectorRepository = (*detectorRepository)(nil)
+
+type detectorRepository struct {
+	db Database
+}
+
+// NewDetectorRepository instantiates a PostgreSQL implementation of detector repository.
+func NewDetectorRepository(db Database) anomalies.DetectorRepository {
+	return &detectorRepository{
+		db: db,
+	}
+}
+
+func (dr detectorRepository) Save(ctx context.Context, ds ...anomalies.Detector) ([]anomalies.Detector, error) {
+	tx, err := dr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []anomalies.Detector{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO detectors (id, group_id, thing_id, metric, sigma, window_size, alpha, metadata)
+		VALUES (:id, :group_id, :thing_id, :metric, :sigma, :window_size, :alpha, :metadata);`
+
+	for _, detector := range ds {
+		dbd, err := toDBDetector(detector)
+		if err != nil {
+			tx.Rollback()
+			return []anomalies.Detector{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbd); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return []anomalies.Detector{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []anomalies.Detector{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []anomalies.Detector{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []anomalies.Detector{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []anomalies.Detector{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return ds, nil
+}
+
+func (dr detectorRepository) RetrieveByGroupID(ctx context.Context, groupID string, pm anomalies.PageMetadata) (anomalies.DetectorsPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return anomalies.DetectorsPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+	q := fmt.Sprintf(`SELECT id, group_id, thing_id, metric, sigma, window_size, alpha, metadata
+		FROM detectors WHERE group_id = :group_id ORDER BY thing_id, metric %s;`, olq)
+	qc := `SELECT COUNT(*) FROM detectors WHERE group_id = $1;`
+
+	params := map[string]interface{}{
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	items, err := dr.retrieve(ctx, q, params)
+	if err != nil {
+		return anomalies.DetectorsPage{}, err
+	}
+
+	var total uint64
+	if err := dr.db.GetContext(ctx, &total, qc, groupID); err != nil {
+		return anomalies.DetectorsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return anomalies.DetectorsPage{
+		Detectors: items,
+		PageMetadata: anomalies.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (dr detectorRepository) RetrieveByThingID(ctx context.Context, thingID string) ([]anomalies.Detector, error) {
+	if _, err := uuid.FromString(thingID); err != nil {
+		return []anomalies.Detector{}, nil
+	}
+
+	q := `SELECT id, group_id, thing_id, metric, sigma, window_size, alpha, metadata
+		FROM detectors WHERE thing_id = :thing_id;`
+
+	return dr.retrieve(ctx, q, map[string]interface{}{"thing_id": thingID})
+}
+
+func (dr detectorRepository) RetrieveByID(ctx context.Context, id string) (anomalies.Detector, error) {
+	q := `SELECT id, group_id, thing_id, metric, sigma, window_size, alpha, metadata FROM detectors WHERE id = $1;`
+
+	var dbd dbDetector
+	if err := dr.db.QueryRowxContext(ctx, q, id).StructScan(&dbd); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return anomalies.Detector{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return anomalies.Detector{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toDetector(dbd)
+}
+
+func (dr detectorRepository) Update(ctx context.Context, d anomalies.Detector) error {
+	q := `UPDATE detectors SET thing_id = :thing_id, metric = :metric, sigma = :sigma, window_size = :window_size,
+		alpha = :alpha, metadata = :metadata WHERE id = :id;`
+
+	dbd, err := toDBDetector(d)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, errdb := dr.db.NamedExecContext(ctx, q, dbd)
+	if errdb != nil {
+		pgErr, ok := errdb.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, errdb)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, errdb)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, errdb)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, errdb)
+	}
+
+	cnt, errdb := res.RowsAffected()
+	if errdb != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, errdb)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (dr detectorRepository) Remove(ctx context.Context, ids ...string) error {
+	q := `DELETE FROM detectors WHERE id = :id;`
+
+	for _, id := range ids {
+		if _, err := dr.db.NamedExecContext(ctx, q, dbDetector{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (dr detectorRepository) RemoveByGroupID(ctx context.Context, groupID string) ([]string, error) {
+	q := `DELETE FROM detectors WHERE group_id = :group_id RETURNING id;`
+
+	rows, err := dr.db.NamedQueryContext(ctx, q, dbDetector{GroupID: groupID})
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (dr detectorRepository) retrieve(ctx context.Context, query string, params map[string]interface{}) ([]anomalies.Detector, error) {
+	rows, err := dr.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []anomalies.Detector
+	for rows.Next() {
+		var dbd dbDetector
+		if err := rows.StructScan(&dbd); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		d, err := toDetector(dbd)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, d)
+	}
+
+	return items, nil
+}
+
+type dbDetector struct {
+	ID       string  `db:"id"`
+	GroupID  string  `db:"group_id"`
+	ThingID  string  `db:"thing_id"`
+	Metric   string  `db:"metric"`
+	Sigma    float64 `db:"sigma"`
+	Window   uint64  `db:"window_size"`
+	Alpha    float64 `db:"alpha"`
+	Metadata []byte  `db:"metadata"`
+}
+
+func toDBDetector(d anomalies.Detector) (dbDetector, error) {
+	metadata := []byte("{}")
+	if len(d.Metadata) > 0 {
+		b, err := json.Marshal(d.Metadata)
+		if err != nil {
+			return dbDetector{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+
+	return dbDetector{
+		ID:       d.ID,
+		GroupID:  d.GroupID,
+		ThingID:  d.ThingID,
+		Metric:   d.Metric,
+		Sigma:    d.Sigma,
+		Window:   d.Window,
+		Alpha:    d.Alpha,
+		Metadata: metadata,
+	}, nil
+}
+
+func toDetector(dbd dbDetector) (anomalies.Detector, error) {
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(dbd.Metadata, &metadata); err != nil {
+		return anomalies.Detector{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return anomalies.Detector{
+		ID:       dbd.ID,
+		GroupID:  dbd.GroupID,
+		ThingID:  dbd.ThingID,
+		Metric:   dbd.Metric,
+		Sigma:    dbd.Sigma,
+		Window:   dbd.Window,
+		Alpha:    dbd.Alpha,
+		Metadata: metadata,
+	}, nil
+}
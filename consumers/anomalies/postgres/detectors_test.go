@@ -0,0 +1,323 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	metric    = "temperature"
+	invalidID = "invalid"
+)
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newDetector(t *testing.T, groupID, thingID, metric string) anomalies.Detector {
+	return anomalies.Detector{
+		ID:       generateUUID(t),
+		GroupID:  groupID,
+		ThingID:  thingID,
+		Metric:   metric,
+		Sigma:    3,
+		Window:   100,
+		Alpha:    0.1,
+		Metadata: map[string]interface{}{"unit": "C"},
+	}
+}
+
+func saveDetector(t *testing.T, repo anomalies.DetectorRepository, d anomalies.Detector) anomalies.Detector {
+	_, err := repo.Save(context.Background(), d)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return d
+}
+
+func TestSaveDetectors(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	thingID := generateUUID(t)
+
+	cases := []struct {
+		desc      string
+		detectors []anomalies.Detector
+		err       error
+	}{
+		{
+			desc:      "save detectors",
+			detectors: []anomalies.Detector{newDetector(t, groupID, thingID, metric), newDetector(t, groupID, thingID, "humidity")},
+			err:       nil,
+		},
+		{
+			desc:      "save detector of existing thing metric",
+			detectors: []anomalies.Detector{newDetector(t, groupID, thingID, metric)},
+			err:       errors.ErrConflict,
+		},
+		{
+			desc:      "save detector with invalid group id",
+			detectors: []anomalies.Detector{newDetector(t, invalidID, generateUUID(t), metric)},
+			err:       errors.ErrMalformedEntity,
+		},
+		{
+			desc:      "save detector with invalid thing id",
+			detectors: []anomalies.Detector{newDetector(t, groupID, invalidID, metric)},
+			err:       errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.detectors...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveDetectorByID(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	d := saveDetector(t, repo, newDetector(t, generateUUID(t), generateUUID(t), metric))
+
+	cases := []struct {
+		desc     string
+		id       string
+		detector anomalies.Detector
+		err      error
+	}{
+		{
+			desc:     "retrieve existing detector",
+			id:       d.ID,
+			detector: d,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve non-existing detector",
+			id:       generateUUID(t),
+			detector: anomalies.Detector{},
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "retrieve detector with invalid id",
+			id:       invalidID,
+			detector: anomalies.Detector{},
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.detector, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.detector, res))
+	}
+}
+
+func TestRetrieveDetectorsByGroupID(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		saveDetector(t, repo, newDetector(t, groupID, generateUUID(t), metric))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		pm      anomalies.PageMetadata
+		size    uint64
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all detectors of the group",
+			groupID: groupID,
+			pm:      anomalies.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of detectors of the group",
+			groupID: groupID,
+			pm:      anomalies.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve detectors of the group without detectors",
+			groupID: generateUUID(t),
+			pm:      anomalies.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve detectors with invalid group id",
+			groupID: invalidID,
+			pm:      anomalies.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroupID(context.Background(), tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.Detectors)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Detectors)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveDetectorsByThingID(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	thingID := generateUUID(t)
+
+	saveDetector(t, repo, newDetector(t, groupID, thingID, metric))
+	saveDetector(t, repo, newDetector(t, groupID, thingID, "humidity"))
+	saveDetector(t, repo, newDetector(t, groupID, generateUUID(t), metric))
+
+	cases := []struct {
+		desc    string
+		thingID string
+		size    int
+	}{
+		{
+			desc:    "retrieve detectors of the thing",
+			thingID: thingID,
+			size:    2,
+		},
+		{
+			desc:    "retrieve detectors of the thing without detectors",
+			thingID: generateUUID(t),
+			size:    0,
+		},
+		{
+			desc:    "retrieve detectors with invalid thing id",
+			thingID: invalidID,
+			size:    0,
+		},
+	}
+
+	for _, tc := range cases {
+		ds, err := repo.RetrieveByThingID(context.Background(), tc.thingID)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.size, len(ds), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(ds)))
+	}
+}
+
+func TestUpdateDetector(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	thingID := generateUUID(t)
+
+	d := saveDetector(t, repo, newDetector(t, groupID, thingID, metric))
+	other := saveDetector(t, repo, newDetector(t, groupID, thingID, "humidity"))
+
+	updated := d
+	updated.Sigma = 2
+	updated.Window = 50
+	updated.Metadata = map[string]interface{}{"unit": "F"}
+
+	conflicting := d
+	conflicting.Metric = other.Metric
+
+	invalid := d
+	invalid.ThingID = invalidID
+
+	cases := []struct {
+		desc     string
+		detector anomalies.Detector
+		err      error
+	}{
+		{
+			desc:     "update existing detector",
+			detector: updated,
+			err:      nil,
+		},
+		{
+			desc:     "update detector with existing thing metric",
+			detector: conflicting,
+			err:      errors.ErrConflict,
+		},
+		{
+			desc:     "update detector with invalid thing id",
+			detector: invalid,
+			err:      errors.ErrMalformedEntity,
+		},
+		{
+			desc:     "update non-existing detector",
+			detector: newDetector(t, groupID, thingID, "pressure"),
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.detector)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), d.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, updated, res, fmt.Sprintf("expected %v got %v\n", updated, res))
+}
+
+func TestRemoveDetectors(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	d := saveDetector(t, repo, newDetector(t, generateUUID(t), generateUUID(t), metric))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing detector",
+			id:   d.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed detector",
+			id:   d.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
+
+func TestRemoveDetectorsByGroupID(t *testing.T) {
+	repo := postgres.NewDetectorRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		d := saveDetector(t, repo, newDetector(t, groupID, generateUUID(t), metric))
+		ids = append(ids, d.ID)
+	}
+
+	removed, err := repo.RemoveByGroupID(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove detectors by group: expected nil got %s\n", err))
+	assert.ElementsMatch(t, ids, removed, fmt.Sprintf("remove detectors by group: expected %v got %v\n", ids, removed))
+
+	removed, err = repo.RemoveByGroupID(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove detectors by removed group: expected nil got %s\n", err))
+	assert.Empty(t, removed, fmt.Sprintf("remove detectors by removed group: expected no ids got %v\n", removed))
+}
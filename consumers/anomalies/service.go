@@ -0,0 +1,329 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalies
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+const (
+	// DefSigma represents the default number of standard deviations the
+	// reading may deviate from the baseline mean.
+	DefSigma = 3
+	// DefWindow represents the default number of readings the baseline
+	// mean and standard deviation are calculated over.
+	DefWindow = 100
+	// DefAlpha represents the default EWMA smoothing factor.
+	DefAlpha = 0.1
+)
+
+// ErrThingGroup indicates that the detector thing doesn't belong to the detector group.
+var ErrThingGroup = errors.New("thing doesn't belong to the detector group")
+
+// Service specifies an API that must be fullfiled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// CreateDetectors creates detectors for certain group identified by the provided ID.
+	CreateDetectors(ctx context.Context, token string, detectors ...Detector) ([]Detector, error)
+
+	// ListDetectorsByGroup retrieves data about a subset of detectors
+	// related to a certain group identified by the provided ID.
+	ListDetectorsByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (DetectorsPage, error)
+
+	// ViewDetector retrieves data about the detector identified with the provided ID.
+	ViewDetector(ctx context.Context, token, id string) (Detector, error)
+
+	// UpdateDetector updates the detector identified by the provided ID and
+	// resets its baseline.
+	UpdateDetector(ctx context.Context, token string, detector Detector) error
+
+	// RemoveDetectors removes the detectors identified with the provided IDs.
+	RemoveDetectors(ctx context.Context, token string, ids ...string) error
+
+	// RemoveDetectorsByGroup removes the detectors of the removed group
+	// identified by the provided ID.
+	RemoveDetectorsByGroup(ctx context.Context, groupID string) error
+
+	consumers.Consumer
+}
+
+type anomaliesService struct {
+	things     protomfx.ThingsServiceClient
+	detectors  DetectorRepository
+	publisher  messaging.Publisher
+	idProvider uuid.IDProvider
+	mu         sync.Mutex
+	baselines  map[string]*baseline
+}
+
+var _ Service = (*anomaliesService)(nil)
+
+// New instantiates the anomalies service implementation.
+func New(things protomfx.ThingsServiceClient, detectors DetectorRepository, publisher messaging.Publisher, idp uuid.IDProvider) Service {
+	return &anomaliesService{
+		things:     things,
+		detectors:  detectors,
+		publisher:  publisher,
+		idProvider: idp,
+		baselines:  make(map[string]*baseline),
+	}
+}
+
+func (as *anomaliesService) CreateDetectors(ctx context.Context, token string, detectors ...Detector) ([]Detector, error) {
+	dts := []Detector{}
+	for _, detector := range detectors {
+		d, err := as.createDetector(ctx, &detector, token)
+		if err != nil {
+			return []Detector{}, err
+		}
+		dts = append(dts, d)
+	}
+
+	return dts, nil
+}
+
+func (as *anomaliesService) createDetector(ctx context.Context, detector *Detector, token string) (Detector, error) {
+	if _, err := as.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: detector.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return Detector{}, err
+	}
+
+	if err := as.validateThing(ctx, *detector); err != nil {
+		return Detector{}, err
+	}
+
+	id, err := as.idProvider.ID()
+	if err != nil {
+		return Detector{}, err
+	}
+	detector.ID = id
+
+	dts, err := as.detectors.Save(ctx, withDefaults(*detector))
+	if err != nil {
+		return Detector{}, err
+	}
+
+	if len(dts) == 0 {
+		return Detector{}, errors.ErrCreateEntity
+	}
+
+	return dts[0], nil
+}
+
+func (as *anomaliesService) ListDetectorsByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (DetectorsPage, error) {
+	if _, err := as.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return DetectorsPage{}, err
+	}
+
+	return as.detectors.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (as *anomaliesService) ViewDetector(ctx context.Context, token, id string) (Detector, error) {
+	return as.retrieveDetector(ctx, token, id, things.Viewer)
+}
+
+func (as *anomaliesService) UpdateDetector(ctx context.Context, token string, detector Detector) error {
+	d, err := as.retrieveDetector(ctx, token, detector.ID, things.Editor)
+	if err != nil {
+		return err
+	}
+
+	detector.GroupID = d.GroupID
+	if err := as.validateThing(ctx, detector); err != nil {
+		return err
+	}
+
+	if err := as.detectors.Update(ctx, withDefaults(detector)); err != nil {
+		return err
+	}
+
+	as.resetBaselines(detector.ID)
+
+	return nil
+}
+
+func (as *anomaliesService) RemoveDetectors(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		detector, err := as.detectors.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: detector.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := as.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	if err := as.detectors.Remove(ctx, ids...); err != nil {
+		return err
+	}
+
+	as.resetBaselines(ids...)
+
+	return nil
+}
+
+func (as *anomaliesService) RemoveDetectorsByGroup(ctx context.Context, groupID string) error {
+	ids, err := as.detectors.RemoveByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	as.resetBaselines(ids...)
+
+	return nil
+}
+
+func (as *anomaliesService) Consume(message interface{}) error {
+	ctx := context.Background()
+
+	msgs, ok := message.([]senml.Message)
+	if !ok {
+		return errors.ErrMessage
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	// All the records of the SenML message are published by the same thing.
+	detectors, err := as.detectors.RetrieveByThingID(ctx, msgs[0].Publisher)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if msg.Value == nil {
+			continue
+		}
+
+		for _, d := range detectors {
+			if d.Metric != msg.Name {
+				continue
+			}
+
+			for _, a := range as.detect(d, msg) {
+				if err := as.publish(a); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (as *anomaliesService) detect(d Detector, msg senml.Message) []Anomaly {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	b, ok := as.baselines[d.ID]
+	if !ok {
+		b = newBaseline(d.Window)
+		as.baselines[d.ID] = b
+	}
+
+	s, types := b.update(d, *msg.Value)
+
+	var anomalies []Anomaly
+	for _, t := range types {
+		anomalies = append(anomalies, Anomaly{
+			DetectorID: d.ID,
+			GroupID:    d.GroupID,
+			ThingID:    d.ThingID,
+			Metric:     d.Metric,
+			Type:       t,
+			Value:      *msg.Value,
+			Mean:       s.mean,
+			Stddev:     s.stddev,
+			EWMA:       s.ewma,
+			Sigma:      d.Sigma,
+			Time:       msg.Time,
+		})
+	}
+
+	return anomalies
+}
+
+func (as *anomaliesService) publish(a Anomaly) error {
+	payload, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	msg := protomfx.Message{
+		Publisher: a.ThingID,
+		Protocol:  messaging.AlarmProtocol,
+		Payload:   payload,
+		Created:   time.Now().UnixNano(),
+		ProfileConfig: &protomfx.Config{
+			ContentType: messaging.JSONContentType,
+		},
+	}
+
+	return as.publisher.Publish(msg)
+}
+
+func (as *anomaliesService) resetBaselines(ids ...string) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	for _, id := range ids {
+		delete(as.baselines, id)
+	}
+}
+
+func (as *anomaliesService) retrieveDetector(ctx context.Context, token, id, action string) (Detector, error) {
+	detector, err := as.detectors.RetrieveByID(ctx, id)
+	if err != nil {
+		return Detector{}, err
+	}
+
+	if _, err := as.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: detector.GroupID, Subject: things.GroupSub, Action: action}); err != nil {
+		return Detector{}, err
+	}
+
+	return detector, nil
+}
+
+func (as *anomaliesService) validateThing(ctx context.Context, detector Detector) error {
+	grID, err := as.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: detector.ThingID})
+	if err != nil {
+		return err
+	}
+
+	if grID.GetValue() != detector.GroupID {
+		return errors.Wrap(errors.ErrAuthorization, ErrThingGroup)
+	}
+
+	return nil
+}
+
+func withDefaults(d Detector) Detector {
+	if d.Sigma == 0 {
+		d.Sigma = DefSigma
+	}
+
+	if d.Window == 0 {
+		d.Window = DefWindow
+	}
+
+	if d.Alpha == 0 {
+		d.Alpha = DefAlpha
+	}
+
+	return d
+}
@@ -0,0 +1,285 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalies_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	anmocks "github.com/MainfluxLabs/mainflux/consumers/anomalies/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token      = "admin@example.com"
+	wrongValue = "wrong-value"
+	groupID    = "574106f7-030e-4881-8ab0-151195c29f94"
+	thingID    = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	otherThing = "c4bb5a4b-8a4e-4e0f-9e31-8a3b1f1c8c8e"
+	metric     = "temperature"
+)
+
+var detector = anomalies.Detector{
+	GroupID: groupID,
+	ThingID: thingID,
+	Metric:  metric,
+	Sigma:   3,
+	Window:  50,
+	Alpha:   0.2,
+}
+
+type publisherMock struct {
+	mu       sync.Mutex
+	messages []protomfx.Message
+}
+
+func (pub *publisherMock) Publish(msg protomfx.Message) error {
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+
+	pub.messages = append(pub.messages, msg)
+	return nil
+}
+
+func (pub *publisherMock) Close() error {
+	return nil
+}
+
+func newService(pub *publisherMock) anomalies.Service {
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID, otherThing: wrongValue}, map[string]things.Group{token: {ID: groupID}})
+	return anomalies.New(ths, anmocks.NewDetectorRepository(), pub, uuid.NewMock())
+}
+
+// newMessages creates the SenML messages with the provided values. The
+// baseline readings alternate between 10 and 11, so their mean is 10.5 and
+// the standard deviation is 0.5.
+func newMessages(name string, values ...float64) []senml.Message {
+	var msgs []senml.Message
+	for i := range values {
+		msgs = append(msgs, senml.Message{Publisher: thingID, Name: name, Value: &values[i], Time: float64(i)})
+	}
+
+	return msgs
+}
+
+func baselineValues() []float64 {
+	var values []float64
+	for i := 0; i < int(detector.Window)/2; i++ {
+		values = append(values, 10, 11)
+	}
+
+	return values
+}
+
+func TestCreateDetectors(t *testing.T) {
+	svc := newService(&publisherMock{})
+
+	otherThingDt := detector
+	otherThingDt.ThingID = otherThing
+
+	unknownThingDt := detector
+	unknownThingDt.ThingID = wrongValue
+
+	cases := []struct {
+		desc     string
+		detector anomalies.Detector
+		token    string
+		err      error
+	}{
+		{
+			desc:     "create detector",
+			detector: detector,
+			token:    token,
+			err:      nil,
+		},
+		{
+			desc:     "create detector for existing metric",
+			detector: detector,
+			token:    token,
+			err:      errors.ErrConflict,
+		},
+		{
+			desc:     "create detector with wrong credentials",
+			detector: detector,
+			token:    wrongValue,
+			err:      errors.ErrAuthentication,
+		},
+		{
+			desc:     "create detector for thing of other group",
+			detector: otherThingDt,
+			token:    token,
+			err:      errors.ErrAuthorization,
+		},
+		{
+			desc:     "create detector for unknown thing",
+			detector: unknownThingDt,
+			token:    token,
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.CreateDetectors(context.Background(), tc.token, tc.detector)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestConsume(t *testing.T) {
+	cases := []struct {
+		desc      string
+		msgs      []senml.Message
+		anomalies []string
+	}{
+		{
+			desc:      "consume readings within the baseline",
+			msgs:      newMessages(metric, append(baselineValues(), 10, 11, 10, 11)...),
+			anomalies: nil,
+		},
+		{
+			desc:      "consume readings before the window is full",
+			msgs:      newMessages(metric, 10, 11, 30),
+			anomalies: nil,
+		},
+		{
+			desc:      "consume spike",
+			msgs:      newMessages(metric, append(baselineValues(), 12.2, 10)...),
+			anomalies: []string{anomalies.SpikeAnomaly},
+		},
+		{
+			desc:      "consume drift",
+			msgs:      newMessages(metric, append(baselineValues(), 11.9, 11.9, 11.9, 11.9, 11.9)...),
+			anomalies: []string{anomalies.DriftAnomaly},
+		},
+		{
+			desc:      "consume spike of other metric",
+			msgs:      newMessages("humidity", append(baselineValues(), 30)...),
+			anomalies: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		pub := &publisherMock{}
+		svc := newService(pub)
+		_, err := svc.CreateDetectors(context.Background(), token, detector)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+		err = svc.Consume(tc.msgs)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		var types []string
+		for _, msg := range pub.messages {
+			assert.Equal(t, messaging.AlarmProtocol, msg.Protocol, fmt.Sprintf("%s: expected protocol %s got %s", tc.desc, messaging.AlarmProtocol, msg.Protocol))
+
+			var a anomalies.Anomaly
+			err := json.Unmarshal(msg.Payload, &a)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, thingID, a.ThingID, fmt.Sprintf("%s: expected thing %s got %s", tc.desc, thingID, a.ThingID))
+			types = append(types, a.Type)
+		}
+		assert.Equal(t, tc.anomalies, types, fmt.Sprintf("%s: expected anomalies %v got %v", tc.desc, tc.anomalies, types))
+	}
+}
+
+func TestUpdateDetector(t *testing.T) {
+	pub := &publisherMock{}
+	svc := newService(pub)
+
+	dts, err := svc.CreateDetectors(context.Background(), token, detector)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	d := dts[0]
+
+	err = svc.Consume(newMessages(metric, baselineValues()...))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	d.Sigma = 5
+	cases := []struct {
+		desc     string
+		detector anomalies.Detector
+		token    string
+		err      error
+	}{
+		{
+			desc:     "update detector with wrong credentials",
+			detector: d,
+			token:    wrongValue,
+			err:      errors.ErrAuthentication,
+		},
+		{
+			desc:     "update non-existing detector",
+			detector: anomalies.Detector{ID: wrongValue, ThingID: thingID, Metric: metric},
+			token:    token,
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "update detector",
+			detector: d,
+			token:    token,
+			err:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateDetector(context.Background(), tc.token, tc.detector)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	// The baseline is reset by the update, so the spike isn't detected
+	// until the window is full again.
+	err = svc.Consume(newMessages(metric, 30))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Len(t, pub.messages, 0, fmt.Sprintf("update detector: expected no anomalies got %d", len(pub.messages)))
+}
+
+func TestRemoveDetectorsByGroup(t *testing.T) {
+	pub := &publisherMock{}
+	svc := newService(pub)
+
+	_, err := svc.CreateDetectors(context.Background(), token, detector)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = svc.Consume(newMessages(metric, baselineValues()...))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove detectors by group without detectors",
+			groupID: wrongValue,
+			err:     nil,
+		},
+		{
+			desc:    "remove detectors by group",
+			groupID: groupID,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveDetectorsByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	dp, err := svc.ListDetectorsByGroup(context.Background(), token, groupID, anomalies.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(dp.Detectors), fmt.Sprintf("expected no detectors got %d\n", len(dp.Detectors)))
+
+	err = svc.Consume(newMessages(metric, 30))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Len(t, pub.messages, 0, fmt.Sprintf("remove detectors by group: expected no anomalies got %d", len(pub.messages)))
+}
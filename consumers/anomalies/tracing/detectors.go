@@ -0,0 +1,95 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/anomalies"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/opentracing/opentracing-go"
+)
+
+var _ anomalies.DetectorRepository = (*detectorRepositoryMiddleware)(nil)
+
+type detectorRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   anomalies.DetectorRepository
+}
+
+// DetectorRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func DetectorRepositoryMiddleware(tracer opentracing.Tracer, repo anomalies.DetectorRepository) anomalies.DetectorRepository {
+	return detectorRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (drm detectorRepositoryMiddleware) Save(ctx context.Context, ds ...anomalies.Detector) ([]anomalies.Detector, error) {
+	span := createSpan(ctx, drm.tracer, "save_detectors")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Save(ctx, ds...)
+}
+
+func (drm detectorRepositoryMiddleware) RetrieveByGroupID(ctx context.Context, groupID string, pm anomalies.PageMetadata) (anomalies.DetectorsPage, error) {
+	span := createSpan(ctx, drm.tracer, "retrieve_by_group_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (drm detectorRepositoryMiddleware) RetrieveByThingID(ctx context.Context, thingID string) ([]anomalies.Detector, error) {
+	span := createSpan(ctx, drm.tracer, "retrieve_by_thing_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByThingID(ctx, thingID)
+}
+
+func (drm detectorRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (anomalies.Detector, error) {
+	span := createSpan(ctx, drm.tracer, "retrieve_detector_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByID(ctx, id)
+}
+
+func (drm detectorRepositoryMiddleware) Update(ctx context.Context, d anomalies.Detector) error {
+	span := createSpan(ctx, drm.tracer, "update_detector")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Update(ctx, d)
+}
+
+func (drm detectorRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, drm.tracer, "remove_detectors")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Remove(ctx, ids...)
+}
+
+func (drm detectorRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) ([]string, error) {
+	span := createSpan(ctx, drm.tracer, "remove_detectors_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RemoveByGroupID(ctx, groupID)
+}
+
+func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		return tracer.StartSpan(
+			opName,
+			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
+		)
+	}
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
+}
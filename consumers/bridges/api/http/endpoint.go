@@ -0,0 +1,149 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func createDevicesEndpoint(svc bridges.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createDevicesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		dvs := []bridges.Device{}
+		for _, dReq := range req.Devices {
+			d := bridges.Device{
+				GroupID:    req.groupID,
+				ThingID:    dReq.ThingID,
+				Provider:   dReq.Provider,
+				Host:       dReq.Host,
+				DeviceID:   dReq.DeviceID,
+				Topic:      dReq.Topic,
+				Key:        dReq.Key,
+				Cert:       dReq.Cert,
+				PrivateKey: dReq.PrivateKey,
+				Metadata:   dReq.Metadata,
+			}
+			dvs = append(dvs, d)
+		}
+
+		saved, err := svc.CreateDevices(ctx, req.token, dvs...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := devicesRes{Devices: []deviceRes{}, created: true}
+		for _, d := range saved {
+			res.Devices = append(res.Devices, buildDeviceResponse(d))
+		}
+
+		return res, nil
+	}
+}
+
+func listDevicesByGroupEndpoint(svc bridges.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listDevicesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListDevicesByGroup(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := devicesPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Devices: []deviceRes{},
+		}
+		for _, d := range page.Devices {
+			res.Devices = append(res.Devices, buildDeviceResponse(d))
+		}
+
+		return res, nil
+	}
+}
+
+func viewDeviceEndpoint(svc bridges.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deviceReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		d, err := svc.ViewDevice(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildDeviceResponse(d), nil
+	}
+}
+
+func updateDeviceEndpoint(svc bridges.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateDeviceReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		d := bridges.Device{
+			ID:         req.id,
+			ThingID:    req.ThingID,
+			Provider:   req.Provider,
+			Host:       req.Host,
+			DeviceID:   req.DeviceID,
+			Topic:      req.Topic,
+			Key:        req.Key,
+			Cert:       req.Cert,
+			PrivateKey: req.PrivateKey,
+			Metadata:   req.Metadata,
+		}
+
+		if err := svc.UpdateDevice(ctx, req.token, d); err != nil {
+			return nil, err
+		}
+
+		return deviceRes{updated: true}, nil
+	}
+}
+
+func removeDevicesEndpoint(svc bridges.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeDevicesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveDevices(ctx, req.token, req.DeviceIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func buildDeviceResponse(d bridges.Device) deviceRes {
+	return deviceRes{
+		ID:       d.ID,
+		GroupID:  d.GroupID,
+		ThingID:  d.ThingID,
+		Provider: d.Provider,
+		Host:     d.Host,
+		DeviceID: d.DeviceID,
+		Topic:    d.Topic,
+		Metadata: d.Metadata,
+	}
+}
@@ -0,0 +1,528 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	httpapi "github.com/MainfluxLabs/mainflux/consumers/bridges/api/http"
+	brmocks "github.com/MainfluxLabs/mainflux/consumers/bridges/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token       = "admin@example.com"
+	groupID     = "574106f7-030e-4881-8ab0-151195c29f94"
+	thingID     = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	wrongValue  = "wrong-value"
+	contentType = "application/json"
+	emptyValue  = ""
+	azureKey    = "c2VjcmV0LWRldmljZS1rZXk="
+	n           = 5
+)
+
+var device = bridges.Device{
+	GroupID:  groupID,
+	ThingID:  thingID,
+	Provider: bridges.AzureProvider,
+	Host:     "hub.azure-devices.net",
+	DeviceID: "device",
+	Key:      azureKey,
+}
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+// thingIDs returns the IDs of the things of the group, the first of which is
+// the thingID.
+func thingIDs() []string {
+	ids := []string{thingID}
+	for i := 1; i < n; i++ {
+		ids = append(ids, fmt.Sprintf("%s-%d", thingID, i))
+	}
+
+	return ids
+}
+
+func newService() bridges.Service {
+	ths := map[string]string{}
+	for _, id := range thingIDs() {
+		ths[id] = groupID
+	}
+
+	tc := mocks.NewThingsServiceClient(nil, ths, map[string]things.Group{token: {ID: groupID}})
+	return bridges.New(tc, brmocks.NewDeviceRepository(), brmocks.NewForwarder(), uuid.NewMock())
+}
+
+func newHTTPServer(svc bridges.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
+	return httptest.NewServer(mux)
+}
+
+func toJSON(data interface{}) string {
+	jsonData, _ := json.Marshal(data)
+	return string(jsonData)
+}
+
+type devicesPageRes struct {
+	Total   uint64                   `json:"total"`
+	Devices []map[string]interface{} `json:"devices"`
+}
+
+func TestCreateDevices(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	valid := map[string]interface{}{
+		"thing_id":  thingID,
+		"provider":  bridges.AzureProvider,
+		"host":      "hub.azure-devices.net",
+		"device_id": "device",
+		"key":       azureKey,
+		"metadata":  map[string]string{"test": "data"},
+	}
+
+	invalid := func(key string, value interface{}) string {
+		d := map[string]interface{}{}
+		for k, v := range valid {
+			d[k] = v
+		}
+		d[key] = value
+		return toJSON([]map[string]interface{}{d})
+	}
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create devices",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create existing device",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create device with empty list",
+			data:        "[]",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device without thing",
+			data:        invalid("thing_id", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device with invalid provider",
+			data:        invalid("provider", "gcp"),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device without host",
+			data:        invalid("host", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device without device id",
+			data:        invalid("device_id", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device with wildcard topic",
+			data:        invalid("topic", "devices/#"),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device without key",
+			data:        invalid("key", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device with malformed data",
+			data:        `[{"provider":}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create device without content type",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create device with invalid token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create device with empty token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/devices", ts.URL, groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestListDevicesByGroup(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	for _, id := range thingIDs() {
+		d := device
+		d.ThingID = id
+		_, err := svc.CreateDevices(context.Background(), token, d)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	devicesURL := fmt.Sprintf("%s/groups/%s/devices", ts.URL, groupID)
+
+	cases := []struct {
+		desc   string
+		url    string
+		auth   string
+		status int
+		size   int
+	}{
+		{
+			desc:   "list devices",
+			url:    devicesURL,
+			auth:   token,
+			status: http.StatusOK,
+			size:   n,
+		},
+		{
+			desc:   "list devices with limit",
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", devicesURL, 1, 2),
+			auth:   token,
+			status: http.StatusOK,
+			size:   2,
+		},
+		{
+			desc:   "list devices with limit greater than max",
+			url:    fmt.Sprintf("%s?limit=%d", devicesURL, 110),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list devices with invalid token",
+			url:    devicesURL,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			size:   0,
+		},
+		{
+			desc:   "list devices of inaccessible group",
+			url:    fmt.Sprintf("%s/groups/%s/devices", ts.URL, wrongValue),
+			auth:   token,
+			status: http.StatusForbidden,
+			size:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body devicesPageRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.size, len(body.Devices), fmt.Sprintf("%s: expected size %d got %d", tc.desc, tc.size, len(body.Devices)))
+	}
+}
+
+func TestViewDevice(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, device)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	d := dvs[0]
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "view device",
+			id:     d.ID,
+			auth:   token,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "view non-existing device",
+			id:     wrongValue,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "view device with invalid token",
+			id:     d.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "view device with empty token",
+			id:     d.ID,
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/devices/%s", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var body map[string]interface{}
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&body), fmt.Sprintf("%s: unexpected error decoding response", tc.desc))
+		assert.Equal(t, d.DeviceID, body["device_id"], fmt.Sprintf("%s: expected device id %s got %v", tc.desc, d.DeviceID, body["device_id"]))
+		assert.NotContains(t, body, "key", fmt.Sprintf("%s: expected key to be omitted", tc.desc))
+	}
+}
+
+func TestUpdateDevice(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, device)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	d := dvs[0]
+
+	data := toJSON(map[string]interface{}{
+		"thing_id":  thingID,
+		"provider":  bridges.AzureProvider,
+		"host":      "other-hub.azure-devices.net",
+		"device_id": "device",
+	})
+
+	cases := []struct {
+		desc        string
+		id          string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "update device",
+			id:          d.ID,
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update non-existing device",
+			id:          wrongValue,
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "update device to other provider without credentials",
+			id:          d.ID,
+			data:        `{"thing_id":"` + thingID + `","provider":"aws","host":"iot.amazonaws.com","device_id":"device"}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update device without content type",
+			id:          d.ID,
+			data:        data,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "update device with invalid token",
+			id:          d.ID,
+			data:        data,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/devices/%s", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	updated, err := svc.ViewDevice(context.Background(), token, d.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "other-hub.azure-devices.net", updated.Host, fmt.Sprintf("update device: expected host other-hub.azure-devices.net got %s", updated.Host))
+	assert.Equal(t, azureKey, updated.Key, fmt.Sprintf("update device: expected key %s got %s", azureKey, updated.Key))
+}
+
+func TestRemoveDevices(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, device)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	data := toJSON(map[string][]string{"device_ids": {dvs[0].ID}})
+
+	cases := []struct {
+		desc   string
+		data   string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "remove devices with invalid token",
+			data:   data,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove devices with empty list",
+			data:   `{"device_ids":[]}`,
+			auth:   token,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "remove devices",
+			data:   data,
+			auth:   token,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "remove removed devices",
+			data:   data,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/devices", ts.URL),
+			contentType: contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
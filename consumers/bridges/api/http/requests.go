@@ -0,0 +1,188 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const (
+	minLen       = 1
+	maxLimitSize = 100
+	maxNameSize  = 254
+)
+
+var (
+	// ErrInvalidProvider indicates the cloud provider other than azure and aws.
+	ErrInvalidProvider = errors.New("invalid cloud provider")
+
+	// ErrInvalidTopic indicates the AWS topic containing the wildcards.
+	ErrInvalidTopic = errors.New("invalid topic")
+)
+
+type apiReq interface {
+	validate() error
+}
+
+type createDeviceReq struct {
+	ThingID    string                 `json:"thing_id"`
+	Provider   string                 `json:"provider"`
+	Host       string                 `json:"host"`
+	DeviceID   string                 `json:"device_id"`
+	Topic      string                 `json:"topic,omitempty"`
+	Key        string                 `json:"key,omitempty"`
+	Cert       string                 `json:"cert,omitempty"`
+	PrivateKey string                 `json:"private_key,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createDeviceReq) validate() error {
+	return validateDevice(req.ThingID, req.Provider, req.Host, req.DeviceID, req.Topic)
+}
+
+type createDevicesReq struct {
+	token   string
+	groupID string
+	Devices []createDeviceReq
+}
+
+func (req createDevicesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Devices) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, d := range req.Devices {
+		if err := d.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type deviceReq struct {
+	token string
+	id    string
+}
+
+func (req deviceReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listDevicesReq struct {
+	token        string
+	id           string
+	pageMetadata bridges.PageMetadata
+}
+
+func (req listDevicesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type updateDeviceReq struct {
+	token      string
+	id         string
+	ThingID    string                 `json:"thing_id"`
+	Provider   string                 `json:"provider"`
+	Host       string                 `json:"host"`
+	DeviceID   string                 `json:"device_id"`
+	Topic      string                 `json:"topic,omitempty"`
+	Key        string                 `json:"key,omitempty"`
+	Cert       string                 `json:"cert,omitempty"`
+	PrivateKey string                 `json:"private_key,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateDeviceReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateDevice(req.ThingID, req.Provider, req.Host, req.DeviceID, req.Topic)
+}
+
+type removeDevicesReq struct {
+	token     string
+	DeviceIDs []string `json:"device_ids,omitempty"`
+}
+
+func (req removeDevicesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.DeviceIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.DeviceIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+// validateDevice validates the device mapping. The credentials are validated
+// by the service, since they're kept on update if they're omitted.
+func validateDevice(thingID, provider, host, deviceID, topic string) error {
+	if thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if provider != bridges.AzureProvider && provider != bridges.AWSProvider {
+		return ErrInvalidProvider
+	}
+
+	if host == "" || len(host) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if deviceID == "" || len(deviceID) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if len(topic) > maxNameSize || strings.ContainsAny(topic, "+#") {
+		return ErrInvalidTopic
+	}
+
+	return nil
+}
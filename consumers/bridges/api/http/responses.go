@@ -0,0 +1,100 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var (
+	_ apiutil.Response = (*deviceRes)(nil)
+	_ apiutil.Response = (*devicesRes)(nil)
+	_ apiutil.Response = (*devicesPageRes)(nil)
+	_ apiutil.Response = (*removeRes)(nil)
+)
+
+type pageRes struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+// deviceRes doesn't hold the device credentials, which are write-only.
+type deviceRes struct {
+	ID       string                 `json:"id"`
+	GroupID  string                 `json:"group_id"`
+	ThingID  string                 `json:"thing_id"`
+	Provider string                 `json:"provider"`
+	Host     string                 `json:"host"`
+	DeviceID string                 `json:"device_id"`
+	Topic    string                 `json:"topic,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	updated  bool
+}
+
+func (res deviceRes) Code() int {
+	return http.StatusOK
+}
+
+func (res deviceRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deviceRes) Empty() bool {
+	return res.updated
+}
+
+type devicesRes struct {
+	Devices []deviceRes `json:"devices"`
+	created bool
+}
+
+func (res devicesRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res devicesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res devicesRes) Empty() bool {
+	return false
+}
+
+type devicesPageRes struct {
+	pageRes
+	Devices []deviceRes `json:"devices"`
+}
+
+func (res devicesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res devicesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res devicesPageRes) Empty() bool {
+	return false
+}
+
+type removeRes struct{}
+
+func (res removeRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeRes) Empty() bool {
+	return true
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	log "github.com/MainfluxLabs/mainflux/logger"
+)
+
+var _ bridges.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger log.Logger
+	svc    bridges.Service
+}
+
+// LoggingMiddleware adds logging facilities to the core service.
+func LoggingMiddleware(svc bridges.Service, logger log.Logger) bridges.Service {
+	return &loggingMiddleware{logger, svc}
+}
+
+func (lm *loggingMiddleware) CreateDevices(ctx context.Context, token string, dvs ...bridges.Device) (response []bridges.Device, err error) {
+	defer func(begin time.Time) {
+		// The devices aren't logged, since they hold the credentials.
+		var ids []string
+		for _, d := range response {
+			ids = append(ids, d.ID)
+		}
+		message := fmt.Sprintf("Method create_devices for ids %v took %s to complete", ids, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateDevices(ctx, token, dvs...)
+}
+
+func (lm *loggingMiddleware) ListDevicesByGroup(ctx context.Context, token, groupID string, pm bridges.PageMetadata) (response bridges.DevicesPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_devices_by_group for id %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListDevicesByGroup(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) ViewDevice(ctx context.Context, token, id string) (response bridges.Device, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_device for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewDevice(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateDevice(ctx context.Context, token string, device bridges.Device) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_device for id %s took %s to complete", device.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateDevice(ctx, token, device)
+}
+
+func (lm *loggingMiddleware) RemoveDevices(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_devices took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveDevices(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveDevicesByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_devices_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveDevicesByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) Consume(msg interface{}) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method consume took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.Consume(msg)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ bridges.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     bridges.Service
+}
+
+// MetricsMiddleware instruments core service by tracking request count and
+// latency.
+func MetricsMiddleware(svc bridges.Service, counter metrics.Counter, latency metrics.Histogram) bridges.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (ms *metricsMiddleware) CreateDevices(ctx context.Context, token string, dvs ...bridges.Device) ([]bridges.Device, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_devices").Add(1)
+		ms.latency.With("method", "create_devices").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateDevices(ctx, token, dvs...)
+}
+
+func (ms *metricsMiddleware) ListDevicesByGroup(ctx context.Context, token, groupID string, pm bridges.PageMetadata) (bridges.DevicesPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_devices_by_group").Add(1)
+		ms.latency.With("method", "list_devices_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListDevicesByGroup(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) ViewDevice(ctx context.Context, token, id string) (bridges.Device, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_device").Add(1)
+		ms.latency.With("method", "view_device").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewDevice(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateDevice(ctx context.Context, token string, device bridges.Device) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_device").Add(1)
+		ms.latency.With("method", "update_device").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateDevice(ctx, token, device)
+}
+
+func (ms *metricsMiddleware) RemoveDevices(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_devices").Add(1)
+		ms.latency.With("method", "remove_devices").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveDevices(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveDevicesByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_devices_by_group").Add(1)
+		ms.latency.With("method", "remove_devices_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveDevicesByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) Consume(msg interface{}) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "consume").Add(1)
+		ms.latency.With("method", "consume").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.Consume(msg)
+}
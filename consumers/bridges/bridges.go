@@ -0,0 +1,98 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package bridges
+
+import "context"
+
+const (
+	// AzureProvider represents the Azure IoT Hub.
+	AzureProvider = "azure"
+	// AWSProvider represents the AWS IoT Core.
+	AWSProvider = "aws"
+)
+
+// Device represents the mapping of the thing to the device of the cloud
+// platform, on behalf of which the messages published by the thing are
+// forwarded to the platform.
+type Device struct {
+	ID       string
+	GroupID  string
+	ThingID  string
+	Provider string
+	// Host is the Azure IoT Hub host name, or the AWS IoT Core device data
+	// endpoint.
+	Host string
+	// DeviceID is the Azure IoT Hub device ID, or the AWS IoT Core thing
+	// name. It's used as the MQTT client ID of the device.
+	DeviceID string
+	// Topic is the AWS IoT Core topic the messages are published to. The
+	// messages are sent to Azure IoT Hub as the device-to-cloud messages.
+	Topic string
+	// Key is the Azure IoT Hub device symmetric key, which signs the SAS
+	// tokens of the device.
+	Key string
+	// Cert and PrivateKey are the PEM encoded AWS IoT Core device
+	// certificate and its private key.
+	Cert       string
+	PrivateKey string
+	Metadata   map[string]interface{}
+}
+
+// DevicesPage contains page related metadata as well as a list of devices
+// that belong to this page.
+type DevicesPage struct {
+	PageMetadata
+	Devices []Device
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total  uint64
+	Offset uint64
+	Limit  uint64
+}
+
+// Message represents the message forwarded to the cloud device.
+type Message struct {
+	Subtopic    string
+	ContentType string
+	Payload     []byte
+}
+
+// DeviceRepository specifies a device persistence API.
+type DeviceRepository interface {
+	// Save persists multiple devices. Devices are saved using a transaction.
+	// If one device fails then none will be saved.
+	Save(ctx context.Context, ds ...Device) ([]Device, error)
+
+	// RetrieveByGroupID retrieves devices related to a certain group
+	// identified by a given ID.
+	RetrieveByGroupID(ctx context.Context, groupID string, pm PageMetadata) (DevicesPage, error)
+
+	// RetrieveByThingID retrieves all the devices of the thing identified
+	// by a given ID.
+	RetrieveByThingID(ctx context.Context, thingID string) ([]Device, error)
+
+	// RetrieveByID retrieves the device having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (Device, error)
+
+	// Update performs an update to the existing device. A non-nil error is
+	// returned to indicate operation failure.
+	Update(ctx context.Context, d Device) error
+
+	// Remove removes the devices having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+}
+
+// Forwarder specifies the forwarding of the messages to the cloud platforms.
+type Forwarder interface {
+	// Forward publishes the message to the cloud platform on behalf of the
+	// device.
+	Forward(d Device, msg Message) error
+
+	// Disconnect closes the connections of the devices identified by the
+	// provided IDs, so that the next message is forwarded using the current
+	// device mapping.
+	Disconnect(ids ...string)
+}
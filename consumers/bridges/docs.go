@@ -0,0 +1,7 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bridges contains the domain concept definitions needed to support
+// Mainflux cloud bridge functionality, which forwards the messages to the
+// Azure IoT Hub and the AWS IoT Core.
+package bridges
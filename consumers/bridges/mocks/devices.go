@@ -0,0 +1,141 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ bridges.DeviceRepository = (*deviceRepositoryMock)(nil)
+
+type deviceRepositoryMock struct {
+	mu      sync.Mutex
+	devices map[string]bridges.Device
+}
+
+// NewDeviceRepository creates in-memory device repository.
+func NewDeviceRepository() bridges.DeviceRepository {
+	return &deviceRepositoryMock{
+		devices: make(map[string]bridges.Device),
+	}
+}
+
+func (drm *deviceRepositoryMock) Save(_ context.Context, ds ...bridges.Device) ([]bridges.Device, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	for _, d := range ds {
+		for _, dv := range drm.devices {
+			if dv.ThingID == d.ThingID && dv.Provider == d.Provider {
+				return []bridges.Device{}, errors.ErrConflict
+			}
+		}
+
+		drm.devices[d.ID] = d
+	}
+
+	return ds, nil
+}
+
+func (drm *deviceRepositoryMock) RetrieveByGroupID(_ context.Context, groupID string, pm bridges.PageMetadata) (bridges.DevicesPage, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	var items []bridges.Device
+	for _, d := range drm.devices {
+		if d.GroupID == groupID {
+			items = append(items, d)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ID < items[j].ID
+	})
+
+	total := uint64(len(items))
+	items = paginate(items, pm)
+
+	return bridges.DevicesPage{
+		Devices: items,
+		PageMetadata: bridges.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (drm *deviceRepositoryMock) RetrieveByThingID(_ context.Context, thingID string) ([]bridges.Device, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	var items []bridges.Device
+	for _, d := range drm.devices {
+		if d.ThingID == thingID {
+			items = append(items, d)
+		}
+	}
+
+	return items, nil
+}
+
+func (drm *deviceRepositoryMock) RetrieveByID(_ context.Context, id string) (bridges.Device, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	d, ok := drm.devices[id]
+	if !ok {
+		return bridges.Device{}, errors.ErrNotFound
+	}
+
+	return d, nil
+}
+
+func (drm *deviceRepositoryMock) Update(_ context.Context, d bridges.Device) error {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	if _, ok := drm.devices[d.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	drm.devices[d.ID] = d
+
+	return nil
+}
+
+func (drm *deviceRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := drm.devices[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(drm.devices, id)
+	}
+
+	return nil
+}
+
+func paginate(items []bridges.Device, pm bridges.PageMetadata) []bridges.Device {
+	if pm.Limit == 0 {
+		return items
+	}
+
+	if pm.Offset >= uint64(len(items)) {
+		return []bridges.Device{}
+	}
+
+	end := pm.Offset + pm.Limit
+	if end > uint64(len(items)) {
+		end = uint64(len(items))
+	}
+
+	return items[pm.Offset:end]
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+)
+
+var _ bridges.Forwarder = (*MockForwarder)(nil)
+
+// MockForwarder is the forwarder which keeps the forwarded messages by the
+// device ID.
+type MockForwarder struct {
+	mu           sync.Mutex
+	messages     map[string][]bridges.Message
+	disconnected map[string]bool
+}
+
+// NewForwarder returns the mock of the cloud platforms forwarder.
+func NewForwarder() *MockForwarder {
+	return &MockForwarder{
+		messages:     make(map[string][]bridges.Message),
+		disconnected: make(map[string]bool),
+	}
+}
+
+func (fm *MockForwarder) Forward(d bridges.Device, msg bridges.Message) error {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	fm.messages[d.ID] = append(fm.messages[d.ID], msg)
+	delete(fm.disconnected, d.ID)
+
+	return nil
+}
+
+func (fm *MockForwarder) Disconnect(ids ...string) {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	for _, id := range ids {
+		fm.disconnected[id] = true
+	}
+}
+
+// Messages returns the messages forwarded to the device identified by the
+// provided ID.
+func (fm *MockForwarder) Messages(id string) []bridges.Message {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	return fm.messages[id]
+}
+
+// Disconnected reports whether the device identified by the provided ID was
+// disconnected since the last forwarded message.
+func (fm *MockForwarder) Disconnected(id string) bool {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	return fm.disconnected[id]
+}
@@ -0,0 +1,209 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	port            = 8883
+	qos             = 1
+	disconnectQuiet = 250
+	// The MQTT 3.1.1 protocol version, which is required by Azure IoT Hub.
+	protocolVersion = 4
+	azureAPIVersion = "2021-04-12"
+	// sasTokenTTL is the lifetime of the Azure SAS token. Azure IoT Hub
+	// disconnects the device once the token expires, and the new token is
+	// generated when the device reconnects.
+	sasTokenTTL = time.Hour
+)
+
+var (
+	// ErrConnect indicates the failure to connect the device to the cloud platform.
+	ErrConnect = errors.New("failed to connect device to cloud platform")
+
+	// ErrForward indicates the failure to forward the message to the cloud platform.
+	ErrForward = errors.New("failed to forward message to cloud platform")
+
+	errTimeout = errors.New("operation timed out")
+)
+
+var _ bridges.Forwarder = (*forwarder)(nil)
+
+type forwarder struct {
+	timeout time.Duration
+	logger  logger.Logger
+	mu      sync.Mutex
+	clients map[string]mqtt.Client
+}
+
+// NewForwarder returns the forwarder, which publishes the messages to Azure
+// IoT Hub and AWS IoT Core over MQTT, the same way their device SDKs do. Each
+// device is connected on the first forwarded message, and stays connected
+// until it's updated or removed.
+func NewForwarder(timeout time.Duration, logger logger.Logger) bridges.Forwarder {
+	return &forwarder{
+		timeout: timeout,
+		logger:  logger,
+		clients: make(map[string]mqtt.Client),
+	}
+}
+
+func (f *forwarder) Forward(d bridges.Device, msg bridges.Message) error {
+	client, err := f.client(d)
+	if err != nil {
+		return errors.Wrap(ErrConnect, err)
+	}
+
+	token := client.Publish(topic(d, msg), qos, false, msg.Payload)
+	if !token.WaitTimeout(f.timeout) {
+		return errors.Wrap(ErrForward, errTimeout)
+	}
+	if err := token.Error(); err != nil {
+		return errors.Wrap(ErrForward, err)
+	}
+
+	return nil
+}
+
+func (f *forwarder) Disconnect(ids ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, id := range ids {
+		if c, ok := f.clients[id]; ok {
+			c.Disconnect(disconnectQuiet)
+			delete(f.clients, id)
+		}
+	}
+}
+
+// client returns the connected client of the device. The device is connected
+// without holding the lock, so that the unreachable platform doesn't hold
+// back the devices of the other platforms.
+func (f *forwarder) client(d bridges.Device) (mqtt.Client, error) {
+	f.mu.Lock()
+	c, ok := f.clients[d.ID]
+	f.mu.Unlock()
+	if ok {
+		return c, nil
+	}
+
+	opts, err := options(d)
+	if err != nil {
+		return nil, err
+	}
+	opts.SetConnectTimeout(f.timeout).
+		SetAutoReconnect(true).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			f.logger.Warn(fmt.Sprintf("Device %s lost connection to %s: %s", d.DeviceID, d.Host, err))
+		})
+
+	c = mqtt.NewClient(opts)
+	token := c.Connect()
+	if !token.WaitTimeout(f.timeout) {
+		return nil, errTimeout
+	}
+	if err := token.Error(); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if existing, ok := f.clients[d.ID]; ok {
+		c.Disconnect(disconnectQuiet)
+		return existing, nil
+	}
+	f.clients[d.ID] = c
+
+	return c, nil
+}
+
+func options(d bridges.Device) (*mqtt.ClientOptions, error) {
+	opts := mqtt.NewClientOptions().
+		AddBroker(fmt.Sprintf("ssl://%s:%d", d.Host, port)).
+		SetClientID(d.DeviceID).
+		SetProtocolVersion(protocolVersion).
+		SetCleanSession(true)
+
+	switch d.Provider {
+	case bridges.AzureProvider:
+		key, err := base64.StdEncoding.DecodeString(d.Key)
+		if err != nil {
+			return nil, errors.Wrap(bridges.ErrInvalidCredentials, err)
+		}
+
+		username := fmt.Sprintf("%s/%s/?api-version=%s", d.Host, d.DeviceID, azureAPIVersion)
+		opts.SetTLSConfig(&tls.Config{ServerName: d.Host, MinVersion: tls.VersionTLS12}).
+			SetCredentialsProvider(func() (string, string) {
+				return username, sasToken(d.Host, d.DeviceID, key, time.Now().Add(sasTokenTTL))
+			})
+	case bridges.AWSProvider:
+		cert, err := tls.X509KeyPair([]byte(d.Cert), []byte(d.PrivateKey))
+		if err != nil {
+			return nil, errors.Wrap(bridges.ErrInvalidCredentials, err)
+		}
+
+		opts.SetTLSConfig(&tls.Config{ServerName: d.Host, MinVersion: tls.VersionTLS12, Certificates: []tls.Certificate{cert}})
+	default:
+		return nil, errors.ErrMalformedEntity
+	}
+
+	return opts, nil
+}
+
+// sasToken returns the Azure IoT Hub SAS token of the device, signed by the
+// device symmetric key.
+func sasToken(host, deviceID string, key []byte, expiry time.Time) string {
+	sr := url.QueryEscape(host + "/devices/" + deviceID)
+	se := strconv.FormatInt(expiry.Unix(), 10)
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(sr + "\n" + se))
+	sig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s", sr, url.QueryEscape(sig), se)
+}
+
+// topic returns the topic the message is published to. The Azure device-to-
+// cloud messages carry the content type and the subtopic as the message
+// properties, while the AWS subtopic is appended to the device topic.
+func topic(d bridges.Device, msg bridges.Message) string {
+	switch d.Provider {
+	case bridges.AzureProvider:
+		props := "$.ct=" + url.QueryEscape(msg.ContentType) + "&$.ce=utf-8"
+		if msg.Subtopic != "" {
+			props += "&subtopic=" + url.QueryEscape(msg.Subtopic)
+		}
+
+		return fmt.Sprintf("devices/%s/messages/events/%s", d.DeviceID, props)
+	default:
+		t := d.Topic
+		if t == "" {
+			t = fmt.Sprintf("devices/%s/messages", d.DeviceID)
+		}
+
+		if msg.Subtopic != "" {
+			t += "/" + strings.ReplaceAll(msg.Subtopic, ".", "/")
+		}
+
+		return t
+	}
+}
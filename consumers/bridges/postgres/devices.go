@@ -0,0 +1,304 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/encryption"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ bridges.DeviceRepository = (*deviceRepository)(nil)
+
+type deviceRepository struct {
+	db     Database
+	cipher encryption.Cipher
+}
+
+// NewDeviceRepository instantiates a PostgreSQL implementation of device
+// repository. The device credentials are encrypted using AES-GCM with the
+// SHA-256 hash of the provided key.
+func NewDeviceRepository(db Database, key string) (bridges.DeviceRepository, error) {
+	c, err := encryption.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deviceRepository{
+		db:     db,
+		cipher: c,
+	}, nil
+}
+
+func (dr deviceRepository) Save(ctx context.Context, ds ...bridges.Device) ([]bridges.Device, error) {
+	tx, err := dr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []bridges.Device{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO devices (id, group_id, thing_id, provider, host, device_id, topic, key, cert, private_key, metadata)
+		VALUES (:id, :group_id, :thing_id, :provider, :host, :device_id, :topic, :key, :cert, :private_key, :metadata);`
+
+	for _, device := range ds {
+		dbv, err := dr.toDBDevice(device)
+		if err != nil {
+			tx.Rollback()
+			return []bridges.Device{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbv); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return []bridges.Device{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []bridges.Device{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []bridges.Device{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []bridges.Device{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []bridges.Device{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return ds, nil
+}
+
+func (dr deviceRepository) RetrieveByGroupID(ctx context.Context, groupID string, pm bridges.PageMetadata) (bridges.DevicesPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return bridges.DevicesPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+	q := fmt.Sprintf(`SELECT id, group_id, thing_id, provider, host, device_id, topic, key, cert, private_key, metadata
+		FROM devices WHERE group_id = :group_id ORDER BY thing_id, provider %s;`, olq)
+	qc := `SELECT COUNT(*) FROM devices WHERE group_id = $1;`
+
+	params := map[string]interface{}{
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	items, err := dr.retrieve(ctx, q, params)
+	if err != nil {
+		return bridges.DevicesPage{}, err
+	}
+
+	var total uint64
+	if err := dr.db.GetContext(ctx, &total, qc, groupID); err != nil {
+		return bridges.DevicesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return bridges.DevicesPage{
+		Devices: items,
+		PageMetadata: bridges.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (dr deviceRepository) RetrieveByThingID(ctx context.Context, thingID string) ([]bridges.Device, error) {
+	if _, err := uuid.FromString(thingID); err != nil {
+		return []bridges.Device{}, nil
+	}
+
+	q := `SELECT id, group_id, thing_id, provider, host, device_id, topic, key, cert, private_key, metadata
+		FROM devices WHERE thing_id = :thing_id;`
+
+	return dr.retrieve(ctx, q, map[string]interface{}{"thing_id": thingID})
+}
+
+func (dr deviceRepository) RetrieveByID(ctx context.Context, id string) (bridges.Device, error) {
+	q := `SELECT id, group_id, thing_id, provider, host, device_id, topic, key, cert, private_key, metadata FROM devices WHERE id = $1;`
+
+	var dbv dbDevice
+	if err := dr.db.QueryRowxContext(ctx, q, id).StructScan(&dbv); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return bridges.Device{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return bridges.Device{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	d, err := dr.toDevice(dbv)
+	if err != nil {
+		return bridges.Device{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return d, nil
+}
+
+func (dr deviceRepository) Update(ctx context.Context, d bridges.Device) error {
+	q := `UPDATE devices SET thing_id = :thing_id, provider = :provider, host = :host, device_id = :device_id,
+		topic = :topic, key = :key, cert = :cert, private_key = :private_key, metadata = :metadata WHERE id = :id;`
+
+	dbv, err := dr.toDBDevice(d)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, errdb := dr.db.NamedExecContext(ctx, q, dbv)
+	if errdb != nil {
+		pgErr, ok := errdb.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, errdb)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, errdb)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, errdb)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, errdb)
+	}
+
+	cnt, errdb := res.RowsAffected()
+	if errdb != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, errdb)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (dr deviceRepository) Remove(ctx context.Context, ids ...string) error {
+	q := `DELETE FROM devices WHERE id = :id;`
+
+	for _, id := range ids {
+		if _, err := dr.db.NamedExecContext(ctx, q, dbDevice{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (dr deviceRepository) retrieve(ctx context.Context, query string, params map[string]interface{}) ([]bridges.Device, error) {
+	rows, err := dr.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []bridges.Device
+	for rows.Next() {
+		var dbv dbDevice
+		if err := rows.StructScan(&dbv); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		d, err := dr.toDevice(dbv)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		items = append(items, d)
+	}
+
+	return items, nil
+}
+
+type dbDevice struct {
+	ID         string `db:"id"`
+	GroupID    string `db:"group_id"`
+	ThingID    string `db:"thing_id"`
+	Provider   string `db:"provider"`
+	Host       string `db:"host"`
+	DeviceID   string `db:"device_id"`
+	Topic      string `db:"topic"`
+	Key        []byte `db:"key"`
+	Cert       []byte `db:"cert"`
+	PrivateKey []byte `db:"private_key"`
+	Metadata   []byte `db:"metadata"`
+}
+
+// toDBDevice encrypts the device credentials, bound to the ID of the device.
+func (dr deviceRepository) toDBDevice(d bridges.Device) (dbDevice, error) {
+	metadata := []byte("{}")
+	if len(d.Metadata) > 0 {
+		b, err := json.Marshal(d.Metadata)
+		if err != nil {
+			return dbDevice{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+
+	var creds [3][]byte
+	for i, c := range []string{d.Key, d.Cert, d.PrivateKey} {
+		enc, err := dr.cipher.Encrypt([]byte(c), []byte(d.ID))
+		if err != nil {
+			return dbDevice{}, err
+		}
+		creds[i] = enc
+	}
+
+	return dbDevice{
+		ID:         d.ID,
+		GroupID:    d.GroupID,
+		ThingID:    d.ThingID,
+		Provider:   d.Provider,
+		Host:       d.Host,
+		DeviceID:   d.DeviceID,
+		Topic:      d.Topic,
+		Key:        creds[0],
+		Cert:       creds[1],
+		PrivateKey: creds[2],
+		Metadata:   metadata,
+	}, nil
+}
+
+func (dr deviceRepository) toDevice(dbv dbDevice) (bridges.Device, error) {
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(dbv.Metadata, &metadata); err != nil {
+		return bridges.Device{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	var creds [3]string
+	for i, c := range [][]byte{dbv.Key, dbv.Cert, dbv.PrivateKey} {
+		dec, err := dr.cipher.Decrypt(c, []byte(dbv.ID))
+		if err != nil {
+			return bridges.Device{}, err
+		}
+		creds[i] = string(dec)
+	}
+
+	return bridges.Device{
+		ID:         dbv.ID,
+		GroupID:    dbv.GroupID,
+		ThingID:    dbv.ThingID,
+		Provider:   dbv.Provider,
+		Host:       dbv.Host,
+		DeviceID:   dbv.DeviceID,
+		Topic:      dbv.Topic,
+		Key:        creds[0],
+		Cert:       creds[1],
+		PrivateKey: creds[2],
+		Metadata:   metadata,
+	}, nil
+}
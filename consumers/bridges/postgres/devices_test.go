@@ -0,0 +1,331 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/MainfluxLabs/mainflux/consumers/bridges/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	encKey    = "encryption-key"
+	deviceKey = "device-key"
+	invalidID = "invalid"
+)
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newDeviceRepository(t *testing.T, key string) bridges.DeviceRepository {
+	repo, err := postgres.NewDeviceRepository(postgres.NewDatabase(db), key)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return repo
+}
+
+func newDevice(t *testing.T, groupID, thingID, provider string) bridges.Device {
+	return bridges.Device{
+		ID:         generateUUID(t),
+		GroupID:    groupID,
+		ThingID:    thingID,
+		Provider:   provider,
+		Host:       "hub.azure-devices.net",
+		DeviceID:   "device",
+		Topic:      "topic",
+		Key:        deviceKey,
+		Cert:       "cert",
+		PrivateKey: "private-key",
+		Metadata:   map[string]interface{}{"site": "plant"},
+	}
+}
+
+func saveDevice(t *testing.T, repo bridges.DeviceRepository, d bridges.Device) bridges.Device {
+	_, err := repo.Save(context.Background(), d)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return d
+}
+
+func TestSaveDevices(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	groupID := generateUUID(t)
+	thingID := generateUUID(t)
+
+	cases := []struct {
+		desc    string
+		devices []bridges.Device
+		err     error
+	}{
+		{
+			desc:    "save devices",
+			devices: []bridges.Device{newDevice(t, groupID, thingID, bridges.AzureProvider), newDevice(t, groupID, thingID, bridges.AWSProvider)},
+			err:     nil,
+		},
+		{
+			desc:    "save device of existing thing provider",
+			devices: []bridges.Device{newDevice(t, groupID, thingID, bridges.AzureProvider)},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save device with invalid group id",
+			devices: []bridges.Device{newDevice(t, invalidID, generateUUID(t), bridges.AzureProvider)},
+			err:     errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "save device with invalid thing id",
+			devices: []bridges.Device{newDevice(t, groupID, invalidID, bridges.AzureProvider)},
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.devices...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestDeviceCredentialsEncryption(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	d := saveDevice(t, repo, newDevice(t, generateUUID(t), generateUUID(t), bridges.AzureProvider))
+
+	var key []byte
+	err := db.Get(&key, `SELECT key FROM devices WHERE id = $1`, d.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.NotContains(t, string(key), deviceKey, "expected the stored key to be encrypted")
+
+	res, err := repo.RetrieveByID(context.Background(), d.ID)
+	assert.Nil(t, err, fmt.Sprintf("retrieve device: expected nil got %s\n", err))
+	assert.Equal(t, d, res, fmt.Sprintf("retrieve device: expected %v got %v\n", d, res))
+
+	otherRepo := newDeviceRepository(t, "other-key")
+	_, err = otherRepo.RetrieveByID(context.Background(), d.ID)
+	assert.True(t, errors.Contains(err, errors.ErrRetrieveEntity), fmt.Sprintf("retrieve device with other key: expected %s got %s\n", errors.ErrRetrieveEntity, err))
+}
+
+func TestRetrieveDeviceByID(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	d := saveDevice(t, repo, newDevice(t, generateUUID(t), generateUUID(t), bridges.AzureProvider))
+
+	cases := []struct {
+		desc   string
+		id     string
+		device bridges.Device
+		err    error
+	}{
+		{
+			desc:   "retrieve existing device",
+			id:     d.ID,
+			device: d,
+			err:    nil,
+		},
+		{
+			desc:   "retrieve non-existing device",
+			id:     generateUUID(t),
+			device: bridges.Device{},
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "retrieve device with invalid id",
+			id:     invalidID,
+			device: bridges.Device{},
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.device, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.device, res))
+	}
+}
+
+func TestRetrieveDevicesByGroupID(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	groupID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		saveDevice(t, repo, newDevice(t, groupID, generateUUID(t), bridges.AzureProvider))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		pm      bridges.PageMetadata
+		size    uint64
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all devices of the group",
+			groupID: groupID,
+			pm:      bridges.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of devices of the group",
+			groupID: groupID,
+			pm:      bridges.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve devices of the group without devices",
+			groupID: generateUUID(t),
+			pm:      bridges.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve devices with invalid group id",
+			groupID: invalidID,
+			pm:      bridges.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroupID(context.Background(), tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.Devices)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Devices)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveDevicesByThingID(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	groupID := generateUUID(t)
+	thingID := generateUUID(t)
+
+	saveDevice(t, repo, newDevice(t, groupID, thingID, bridges.AzureProvider))
+	saveDevice(t, repo, newDevice(t, groupID, thingID, bridges.AWSProvider))
+	saveDevice(t, repo, newDevice(t, groupID, generateUUID(t), bridges.AzureProvider))
+
+	cases := []struct {
+		desc    string
+		thingID string
+		size    int
+	}{
+		{
+			desc:    "retrieve devices of the thing",
+			thingID: thingID,
+			size:    2,
+		},
+		{
+			desc:    "retrieve devices of the thing without devices",
+			thingID: generateUUID(t),
+			size:    0,
+		},
+		{
+			desc:    "retrieve devices with invalid thing id",
+			thingID: invalidID,
+			size:    0,
+		},
+	}
+
+	for _, tc := range cases {
+		ds, err := repo.RetrieveByThingID(context.Background(), tc.thingID)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.size, len(ds), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(ds)))
+	}
+}
+
+func TestUpdateDevice(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	groupID := generateUUID(t)
+	thingID := generateUUID(t)
+
+	d := saveDevice(t, repo, newDevice(t, groupID, thingID, bridges.AzureProvider))
+	saveDevice(t, repo, newDevice(t, groupID, thingID, bridges.AWSProvider))
+
+	updated := d
+	updated.Host = "other.azure-devices.net"
+	updated.Key = "other-device-key"
+
+	conflicting := d
+	conflicting.Provider = bridges.AWSProvider
+
+	invalid := d
+	invalid.ThingID = invalidID
+
+	cases := []struct {
+		desc   string
+		device bridges.Device
+		err    error
+	}{
+		{
+			desc:   "update existing device",
+			device: updated,
+			err:    nil,
+		},
+		{
+			desc:   "update device with existing thing provider",
+			device: conflicting,
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "update device with invalid thing id",
+			device: invalid,
+			err:    errors.ErrMalformedEntity,
+		},
+		{
+			desc:   "update non-existing device",
+			device: newDevice(t, groupID, generateUUID(t), bridges.AzureProvider),
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.device)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), d.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, updated, res, fmt.Sprintf("expected %v got %v\n", updated, res))
+}
+
+func TestRemoveDevices(t *testing.T) {
+	repo := newDeviceRepository(t, encKey)
+	d := saveDevice(t, repo, newDevice(t, generateUUID(t), generateUUID(t), bridges.AzureProvider))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing device",
+			id:   d.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed device",
+			id:   d.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
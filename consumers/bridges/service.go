@@ -0,0 +1,325 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package bridges
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	mfjson "github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var (
+	// ErrThingGroup indicates that the device thing doesn't belong to the device group.
+	ErrThingGroup = errors.New("thing doesn't belong to the device group")
+
+	// ErrInvalidCredentials indicates the missing or malformed credentials of the cloud device.
+	ErrInvalidCredentials = errors.New("invalid device credentials")
+)
+
+// Service specifies an API that must be fullfiled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// CreateDevices creates devices for certain group identified by the provided ID.
+	CreateDevices(ctx context.Context, token string, devices ...Device) ([]Device, error)
+
+	// ListDevicesByGroup retrieves data about a subset of devices related to
+	// a certain group identified by the provided ID.
+	ListDevicesByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (DevicesPage, error)
+
+	// ViewDevice retrieves data about the device identified with the provided ID.
+	ViewDevice(ctx context.Context, token, id string) (Device, error)
+
+	// UpdateDevice updates the device identified by the provided ID. The
+	// stored credentials are kept if none are provided and the provider
+	// doesn't change.
+	UpdateDevice(ctx context.Context, token string, device Device) error
+
+	// RemoveDevices removes the devices identified with the provided IDs.
+	RemoveDevices(ctx context.Context, token string, ids ...string) error
+
+	// RemoveDevicesByGroup removes the devices of the removed group
+	// identified by the provided ID and disconnects them.
+	RemoveDevicesByGroup(ctx context.Context, groupID string) error
+
+	consumers.Consumer
+}
+
+type bridgesService struct {
+	things     protomfx.ThingsServiceClient
+	devices    DeviceRepository
+	forwarder  Forwarder
+	idProvider uuid.IDProvider
+}
+
+var _ Service = (*bridgesService)(nil)
+
+// New instantiates the bridges service implementation.
+func New(things protomfx.ThingsServiceClient, devices DeviceRepository, forwarder Forwarder, idp uuid.IDProvider) Service {
+	return &bridgesService{
+		things:     things,
+		devices:    devices,
+		forwarder:  forwarder,
+		idProvider: idp,
+	}
+}
+
+func (bs *bridgesService) CreateDevices(ctx context.Context, token string, devices ...Device) ([]Device, error) {
+	dvs := []Device{}
+	for _, device := range devices {
+		d, err := bs.createDevice(ctx, &device, token)
+		if err != nil {
+			return []Device{}, err
+		}
+		dvs = append(dvs, d)
+	}
+
+	return dvs, nil
+}
+
+func (bs *bridgesService) createDevice(ctx context.Context, device *Device, token string) (Device, error) {
+	if _, err := bs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: device.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return Device{}, err
+	}
+
+	if err := bs.validateThing(ctx, *device); err != nil {
+		return Device{}, err
+	}
+
+	if err := validateCredentials(*device); err != nil {
+		return Device{}, err
+	}
+
+	id, err := bs.idProvider.ID()
+	if err != nil {
+		return Device{}, err
+	}
+	device.ID = id
+
+	dvs, err := bs.devices.Save(ctx, *device)
+	if err != nil {
+		return Device{}, err
+	}
+
+	if len(dvs) == 0 {
+		return Device{}, errors.ErrCreateEntity
+	}
+
+	return dvs[0], nil
+}
+
+func (bs *bridgesService) ListDevicesByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (DevicesPage, error) {
+	if _, err := bs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return DevicesPage{}, err
+	}
+
+	return bs.devices.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (bs *bridgesService) ViewDevice(ctx context.Context, token, id string) (Device, error) {
+	return bs.retrieveDevice(ctx, token, id, things.Viewer)
+}
+
+func (bs *bridgesService) UpdateDevice(ctx context.Context, token string, device Device) error {
+	d, err := bs.retrieveDevice(ctx, token, device.ID, things.Editor)
+	if err != nil {
+		return err
+	}
+
+	device.GroupID = d.GroupID
+	if err := bs.validateThing(ctx, device); err != nil {
+		return err
+	}
+
+	if !hasCredentials(device) && device.Provider == d.Provider {
+		device.Key = d.Key
+		device.Cert = d.Cert
+		device.PrivateKey = d.PrivateKey
+	}
+
+	if err := validateCredentials(device); err != nil {
+		return err
+	}
+
+	if err := bs.devices.Update(ctx, device); err != nil {
+		return err
+	}
+
+	bs.forwarder.Disconnect(device.ID)
+
+	return nil
+}
+
+func (bs *bridgesService) RemoveDevices(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		device, err := bs.devices.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: device.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := bs.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	if err := bs.devices.Remove(ctx, ids...); err != nil {
+		return err
+	}
+
+	bs.forwarder.Disconnect(ids...)
+
+	return nil
+}
+
+func (bs *bridgesService) RemoveDevicesByGroup(ctx context.Context, groupID string) error {
+	dp, err := bs.devices.RetrieveByGroupID(ctx, groupID, PageMetadata{})
+	if err != nil {
+		return err
+	}
+
+	if len(dp.Devices) == 0 {
+		return nil
+	}
+
+	ids := []string{}
+	for _, d := range dp.Devices {
+		ids = append(ids, d.ID)
+	}
+
+	if err := bs.devices.Remove(ctx, ids...); err != nil {
+		return err
+	}
+
+	bs.forwarder.Disconnect(ids...)
+
+	return nil
+}
+
+func (bs *bridgesService) Consume(message interface{}) error {
+	ctx := context.Background()
+
+	switch m := message.(type) {
+	case []senml.Message:
+		if len(m) == 0 {
+			return nil
+		}
+
+		payload, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+
+		// All the records of the SenML message are published by the same
+		// thing, to the same subtopic.
+		msg := Message{
+			Subtopic:    m[0].Subtopic,
+			ContentType: messaging.SenMLContentType,
+			Payload:     payload,
+		}
+
+		return bs.forward(ctx, m[0].Publisher, msg)
+	case mfjson.Messages:
+		for _, jm := range m.Data {
+			payload, err := json.Marshal(jm.Payload)
+			if err != nil {
+				return err
+			}
+
+			msg := Message{
+				Subtopic:    jm.Subtopic,
+				ContentType: messaging.JSONContentType,
+				Payload:     payload,
+			}
+
+			if err := bs.forward(ctx, jm.Publisher, msg); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return errors.ErrMessage
+	}
+}
+
+// forward forwards the message to all the devices of the thing, so that the
+// failure of one cloud platform doesn't hold back the others. The first
+// error is returned.
+func (bs *bridgesService) forward(ctx context.Context, thingID string, msg Message) error {
+	devices, err := bs.devices.RetrieveByThingID(ctx, thingID)
+	if err != nil {
+		return err
+	}
+
+	var ferr error
+	for _, d := range devices {
+		if err := bs.forwarder.Forward(d, msg); err != nil && ferr == nil {
+			ferr = err
+		}
+	}
+
+	return ferr
+}
+
+func (bs *bridgesService) retrieveDevice(ctx context.Context, token, id, action string) (Device, error) {
+	device, err := bs.devices.RetrieveByID(ctx, id)
+	if err != nil {
+		return Device{}, err
+	}
+
+	if _, err := bs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: device.GroupID, Subject: things.GroupSub, Action: action}); err != nil {
+		return Device{}, err
+	}
+
+	return device, nil
+}
+
+func (bs *bridgesService) validateThing(ctx context.Context, device Device) error {
+	grID, err := bs.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: device.ThingID})
+	if err != nil {
+		return err
+	}
+
+	if grID.GetValue() != device.GroupID {
+		return errors.Wrap(errors.ErrAuthorization, ErrThingGroup)
+	}
+
+	return nil
+}
+
+func hasCredentials(d Device) bool {
+	return d.Key != "" || d.Cert != "" || d.PrivateKey != ""
+}
+
+// validateCredentials validates the credentials of the device provider, i.e.
+// the base64 encoded symmetric key of the Azure device, or the certificate
+// and private key pair of the AWS device.
+func validateCredentials(d Device) error {
+	switch d.Provider {
+	case AzureProvider:
+		key, err := base64.StdEncoding.DecodeString(d.Key)
+		if err != nil || len(key) == 0 {
+			return ErrInvalidCredentials
+		}
+	case AWSProvider:
+		if _, err := tls.X509KeyPair([]byte(d.Cert), []byte(d.PrivateKey)); err != nil {
+			return ErrInvalidCredentials
+		}
+	default:
+		return errors.ErrMalformedEntity
+	}
+
+	return nil
+}
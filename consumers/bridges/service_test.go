@@ -0,0 +1,355 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package bridges_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	brmocks "github.com/MainfluxLabs/mainflux/consumers/bridges/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	mfjson "github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token      = "admin@example.com"
+	wrongValue = "wrong-value"
+	groupID    = "574106f7-030e-4881-8ab0-151195c29f94"
+	thingID    = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	otherThing = "c4bb5a4b-8a4e-4e0f-9e31-8a3b1f1c8c8e"
+	azureKey   = "c2VjcmV0LWRldmljZS1rZXk="
+)
+
+var azureDevice = bridges.Device{
+	GroupID:  groupID,
+	ThingID:  thingID,
+	Provider: bridges.AzureProvider,
+	Host:     "hub.azure-devices.net",
+	DeviceID: "device",
+	Key:      azureKey,
+}
+
+func newService() (bridges.Service, *brmocks.MockForwarder) {
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID, otherThing: wrongValue}, map[string]things.Group{token: {ID: groupID}})
+	fwd := brmocks.NewForwarder()
+	return bridges.New(ths, brmocks.NewDeviceRepository(), fwd, uuid.NewMock()), fwd
+}
+
+func newAWSDevice(t *testing.T) bridges.Device {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "device"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	return bridges.Device{
+		GroupID:    groupID,
+		ThingID:    thingID,
+		Provider:   bridges.AWSProvider,
+		Host:       "example-ats.iot.eu-west-1.amazonaws.com",
+		DeviceID:   "device",
+		Cert:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func TestCreateDevices(t *testing.T) {
+	svc, _ := newService()
+	awsDevice := newAWSDevice(t)
+
+	otherThingDv := azureDevice
+	otherThingDv.ThingID = otherThing
+
+	unknownThingDv := azureDevice
+	unknownThingDv.ThingID = wrongValue
+
+	invalidAzureDv := azureDevice
+	invalidAzureDv.Key = "not base64"
+
+	invalidAWSDv := awsDevice
+	invalidAWSDv.PrivateKey = azureKey
+
+	cases := []struct {
+		desc   string
+		device bridges.Device
+		token  string
+		err    error
+	}{
+		{
+			desc:   "create azure device",
+			device: azureDevice,
+			token:  token,
+			err:    nil,
+		},
+		{
+			desc:   "create aws device of the same thing",
+			device: awsDevice,
+			token:  token,
+			err:    nil,
+		},
+		{
+			desc:   "create device for existing provider of thing",
+			device: azureDevice,
+			token:  token,
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "create device with wrong credentials",
+			device: azureDevice,
+			token:  wrongValue,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "create device for thing of other group",
+			device: otherThingDv,
+			token:  token,
+			err:    errors.ErrAuthorization,
+		},
+		{
+			desc:   "create device for unknown thing",
+			device: unknownThingDv,
+			token:  token,
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "create azure device with invalid key",
+			device: invalidAzureDv,
+			token:  token,
+			err:    bridges.ErrInvalidCredentials,
+		},
+		{
+			desc:   "create aws device with mismatched private key",
+			device: invalidAWSDv,
+			token:  token,
+			err:    bridges.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.CreateDevices(context.Background(), tc.token, tc.device)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestUpdateDevice(t *testing.T) {
+	svc, fwd := newService()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, azureDevice)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	dv := dvs[0]
+
+	withoutKey := dv
+	withoutKey.Key = ""
+	withoutKey.DeviceID = "renamed"
+
+	otherProvider := withoutKey
+	otherProvider.Provider = bridges.AWSProvider
+
+	cases := []struct {
+		desc   string
+		device bridges.Device
+		token  string
+		err    error
+	}{
+		{
+			desc:   "update device with wrong credentials",
+			device: dv,
+			token:  wrongValue,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "update non-existing device",
+			device: bridges.Device{ID: wrongValue, ThingID: thingID, Provider: bridges.AzureProvider, Key: azureKey},
+			token:  token,
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "update device to other provider without credentials",
+			device: otherProvider,
+			token:  token,
+			err:    bridges.ErrInvalidCredentials,
+		},
+		{
+			desc:   "update device without credentials",
+			device: withoutKey,
+			token:  token,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateDevice(context.Background(), tc.token, tc.device)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	updated, err := svc.ViewDevice(context.Background(), token, dv.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "renamed", updated.DeviceID, fmt.Sprintf("update device: expected device ID renamed got %s", updated.DeviceID))
+	assert.Equal(t, azureKey, updated.Key, fmt.Sprintf("update device: expected key %s got %s", azureKey, updated.Key))
+	assert.True(t, fwd.Disconnected(dv.ID), "update device: expected device to be disconnected")
+}
+
+func TestRemoveDevices(t *testing.T) {
+	svc, fwd := newService()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, azureDevice)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	dv := dvs[0]
+
+	cases := []struct {
+		desc  string
+		id    string
+		token string
+		err   error
+	}{
+		{
+			desc:  "remove device with wrong credentials",
+			id:    dv.ID,
+			token: wrongValue,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "remove device",
+			id:    dv.ID,
+			token: token,
+			err:   nil,
+		},
+		{
+			desc:  "remove removed device",
+			id:    dv.ID,
+			token: token,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveDevices(context.Background(), tc.token, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	assert.True(t, fwd.Disconnected(dv.ID), "remove device: expected device to be disconnected")
+}
+
+func TestRemoveDevicesByGroup(t *testing.T) {
+	svc, fwd := newService()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, azureDevice)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	dv := dvs[0]
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove devices by group",
+			groupID: groupID,
+			err:     nil,
+		},
+		{
+			desc:    "remove devices by group without devices",
+			groupID: wrongValue,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveDevicesByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	dp, err := svc.ListDevicesByGroup(context.Background(), token, groupID, bridges.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(dp.Devices), fmt.Sprintf("expected no devices got %d\n", len(dp.Devices)))
+	assert.True(t, fwd.Disconnected(dv.ID), "remove devices by group: expected device to be disconnected")
+}
+
+func TestConsume(t *testing.T) {
+	svc, fwd := newService()
+
+	dvs, err := svc.CreateDevices(context.Background(), token, azureDevice, newAWSDevice(t))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	value := 21.5
+	senmlMsgs := []senml.Message{{Publisher: thingID, Subtopic: "room.1", Name: "temperature", Value: &value}}
+	senmlPayload, err := json.Marshal(senmlMsgs)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	jsonMsgs := mfjson.Messages{Data: []mfjson.Message{{Publisher: thingID, Payload: mfjson.Payload{"temperature": 21.5}}}}
+
+	cases := []struct {
+		desc string
+		msg  interface{}
+		fwd  []bridges.Message
+		err  error
+	}{
+		{
+			desc: "consume SenML message",
+			msg:  senmlMsgs,
+			fwd:  []bridges.Message{{Subtopic: "room.1", ContentType: messaging.SenMLContentType, Payload: senmlPayload}},
+			err:  nil,
+		},
+		{
+			desc: "consume JSON message",
+			msg:  jsonMsgs,
+			fwd:  []bridges.Message{{ContentType: messaging.JSONContentType, Payload: []byte(`{"temperature":21.5}`)}},
+			err:  nil,
+		},
+		{
+			desc: "consume message of thing without devices",
+			msg:  []senml.Message{{Publisher: otherThing, Name: "temperature", Value: &value}},
+			fwd:  nil,
+			err:  nil,
+		},
+		{
+			desc: "consume unknown message",
+			msg:  wrongValue,
+			fwd:  nil,
+			err:  errors.ErrMessage,
+		},
+	}
+
+	for _, tc := range cases {
+		before := len(fwd.Messages(dvs[0].ID))
+		err := svc.Consume(tc.msg)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		for _, dv := range dvs {
+			msgs := fwd.Messages(dv.ID)[before:]
+			if len(tc.fwd) == 0 {
+				assert.Empty(t, msgs, fmt.Sprintf("%s: expected no messages forwarded to %s got %d", tc.desc, dv.Provider, len(msgs)))
+				continue
+			}
+			assert.Equal(t, tc.fwd, msgs, fmt.Sprintf("%s: expected %v forwarded to %s got %v", tc.desc, tc.fwd, dv.Provider, msgs))
+		}
+	}
+}
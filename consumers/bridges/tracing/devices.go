@@ -0,0 +1,87 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/bridges"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/opentracing/opentracing-go"
+)
+
+var _ bridges.DeviceRepository = (*deviceRepositoryMiddleware)(nil)
+
+type deviceRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   bridges.DeviceRepository
+}
+
+// DeviceRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func DeviceRepositoryMiddleware(tracer opentracing.Tracer, repo bridges.DeviceRepository) bridges.DeviceRepository {
+	return deviceRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (drm deviceRepositoryMiddleware) Save(ctx context.Context, ds ...bridges.Device) ([]bridges.Device, error) {
+	span := createSpan(ctx, drm.tracer, "save_devices")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Save(ctx, ds...)
+}
+
+func (drm deviceRepositoryMiddleware) RetrieveByGroupID(ctx context.Context, groupID string, pm bridges.PageMetadata) (bridges.DevicesPage, error) {
+	span := createSpan(ctx, drm.tracer, "retrieve_by_group_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (drm deviceRepositoryMiddleware) RetrieveByThingID(ctx context.Context, thingID string) ([]bridges.Device, error) {
+	span := createSpan(ctx, drm.tracer, "retrieve_by_thing_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByThingID(ctx, thingID)
+}
+
+func (drm deviceRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (bridges.Device, error) {
+	span := createSpan(ctx, drm.tracer, "retrieve_device_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByID(ctx, id)
+}
+
+func (drm deviceRepositoryMiddleware) Update(ctx context.Context, d bridges.Device) error {
+	span := createSpan(ctx, drm.tracer, "update_device")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Update(ctx, d)
+}
+
+func (drm deviceRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, drm.tracer, "remove_devices")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Remove(ctx, ids...)
+}
+
+func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		return tracer.StartSpan(
+			opName,
+			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
+		)
+	}
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
+}
@@ -23,22 +23,99 @@ type Consumer interface {
 	Consume(messages interface{}) error
 }
 
+// Routes maps the org IDs to the consumers which persist the messages of the
+// orgs. The messages of the orgs without the route are persisted by the
+// default consumer.
+type Routes map[string]Consumer
+
 // Start method starts consuming messages received from Message broker.
 func Start(id string, sub messaging.Subscriber, consumer Consumer, subjects ...string) error {
+	route := func(protomfx.Message) (Consumer, error) {
+		return consumer, nil
+	}
+
+	return start(id, sub, route, nil, subjects...)
+}
+
+// StartWriter method starts consuming the messages received from Message broker
+// which are routed to the writer identified by the provided name. The messages
+// whose profile config doesn't specify the writers are routed to all writers.
+// The messages are persisted by the consumer routed to the message org, or by
+// the default consumer if the org has no route.
+func StartWriter(id, name string, sub messaging.Subscriber, consumer Consumer, routes Routes, subjects ...string) error {
+	return start(id, sub, writerRoute(name, consumer, routes), nil, subjects...)
+}
+
+// StartPrimaryWriter method starts the writer the same way as StartWriter, and
+// confirms the persistence of the messages routed to the writer to the
+// publishers awaiting the writer confirmation.
+func StartPrimaryWriter(id, name string, sub messaging.Subscriber, consumer Consumer, routes Routes, subjects ...string) error {
+	confirm := func(msg protomfx.Message) bool {
+		return routedToWriter(msg, name)
+	}
+
+	return start(id, sub, writerRoute(name, consumer, routes), confirm, subjects...)
+}
+
+func writerRoute(name string, consumer Consumer, routes Routes) routeFunc {
+	return func(msg protomfx.Message) (Consumer, error) {
+		if !routedToWriter(msg, name) {
+			return nil, nil
+		}
+
+		if c, ok := routes[msg.OrgID]; ok {
+			return c, nil
+		}
+
+		return consumer, nil
+	}
+}
+
+func routedToWriter(msg protomfx.Message, name string) bool {
+	writers := msg.GetProfileConfig().GetWriters()
+	if len(writers) == 0 {
+		return true
+	}
+
+	for _, w := range writers {
+		if w == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// routeFunc returns the consumer of the message, or nil if the message
+// shouldn't be consumed.
+type routeFunc func(protomfx.Message) (Consumer, error)
+
+// confirmFunc reports whether the persistence of the handled message is
+// confirmed to its publisher.
+type confirmFunc func(protomfx.Message) bool
+
+func start(id string, sub messaging.Subscriber, route routeFunc, confirm confirmFunc, subjects ...string) error {
 	for _, subject := range subjects {
 		var transformer transformers.Transformer
 		switch subject {
 		case brokers.SubjectSenML:
-			transformer = senml.New()
-		case brokers.SubjectJSON, brokers.SubjectWebhook:
+			transformer = published{def: senml.New()}
+		case brokers.SubjectJSON:
+			transformer = published{def: json.New()}
+		case brokers.SubjectWebhook:
 			transformer = json.New()
-		case brokers.SubjectSmtp, brokers.SubjectSmpp:
+		case brokers.SubjectSmtp, brokers.SubjectSmpp, brokers.SubjectAlarms:
 			transformer = nil
 		default:
 			return errUnkownSubject
 		}
 
-		if err := sub.Subscribe(id, subject, handle(transformer, consumer)); err != nil {
+		var h messaging.MessageHandler = handle(transformer, route)
+		if confirm != nil {
+			h = confirmHandler{handleFunc: handle(transformer, route), confirm: confirm}
+		}
+
+		if err := sub.Subscribe(id, subject, h); err != nil {
 			return err
 		}
 	}
@@ -46,10 +123,38 @@ func Start(id string, sub messaging.Subscriber, consumer Consumer, subjects ...s
 	return nil
 }
 
-func handle(t transformers.Transformer, c Consumer) handleFunc {
+// published transforms the messages using the transformer resolved when they
+// were published, whose format matches the subject they were published to. The
+// messages published without the transformer are transformed by the default
+// transformer of the subject.
+type published struct {
+	def transformers.Transformer
+}
+
+func (p published) Transform(msg protomfx.Message) (interface{}, error) {
+	if msg.Transformer == "" {
+		return p.def.Transform(msg)
+	}
+
+	t, err := transformers.Get(msg.Transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Transform(msg)
+}
+
+func handle(t transformers.Transformer, route routeFunc) handleFunc {
 	return func(msg protomfx.Message) error {
+		c, err := route(msg)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return nil
+		}
+
 		m := interface{}(msg)
-		var err error
 		if t != nil {
 			m, err = t.Transform(msg)
 			if err != nil {
@@ -70,3 +175,14 @@ func (h handleFunc) Handle(msg protomfx.Message) error {
 func (h handleFunc) Cancel() error {
 	return nil
 }
+
+// confirmHandler is the message handler which confirms the persistence of
+// the handled messages.
+type confirmHandler struct {
+	handleFunc
+	confirm confirmFunc
+}
+
+func (h confirmHandler) Confirms(msg protomfx.Message) bool {
+	return h.confirm(msg)
+}
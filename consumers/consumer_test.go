@@ -0,0 +1,126 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const writerName = "postgres"
+
+func TestStartPrimaryWriter(t *testing.T) {
+	sub := &subscriberMock{handlers: map[string]messaging.MessageHandler{}}
+
+	err := consumers.StartPrimaryWriter(svcName, writerName, sub, &consumerMock{}, consumers.Routes{}, brokers.SubjectJSON)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	c, ok := sub.handlers[brokers.SubjectJSON].(messaging.Confirmer)
+	require.True(t, ok, "expected the primary writer handler to confirm the messages")
+
+	cases := []struct {
+		desc    string
+		writers []string
+		confirm bool
+	}{
+		{
+			desc:    "confirm message routed to all writers",
+			writers: nil,
+			confirm: true,
+		},
+		{
+			desc:    "confirm message routed to writer",
+			writers: []string{"mongodb", writerName},
+			confirm: true,
+		},
+		{
+			desc:    "confirm message routed to other writer",
+			writers: []string{"mongodb"},
+			confirm: false,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := protomfx.Message{ProfileConfig: &protomfx.Config{Writers: tc.writers}}
+		confirm := c.Confirms(msg)
+		assert.Equal(t, tc.confirm, confirm, fmt.Sprintf("%s: expected %t got %t", tc.desc, tc.confirm, confirm))
+	}
+
+	err = consumers.StartWriter(svcName, writerName, sub, &consumerMock{}, consumers.Routes{}, brokers.SubjectJSON)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, ok = sub.handlers[brokers.SubjectJSON].(messaging.Confirmer)
+	assert.False(t, ok, "expected the writer handler not to confirm the messages")
+}
+
+func TestStartTransformer(t *testing.T) {
+	sub := &subscriberMock{handlers: map[string]messaging.MessageHandler{}}
+	consumer := &consumerMock{}
+
+	err := consumers.Start(svcName, sub, consumer, brokers.SubjectSenML, brokers.SubjectJSON)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	senmlPayload := []byte(`[{"bn":"base-name","n":"temperature","v":22.5}]`)
+	jsonPayload := []byte(`{"temperature":22.5}`)
+	conf := &protomfx.Config{Transformer: &protomfx.Transformer{}}
+
+	cases := []struct {
+		desc    string
+		subject string
+		msg     protomfx.Message
+		res     interface{}
+		err     error
+	}{
+		{
+			desc:    "transform SenML message published without transformer",
+			subject: brokers.SubjectSenML,
+			msg:     protomfx.Message{ProfileConfig: conf, Payload: senmlPayload},
+			res:     []senml.Message{},
+		},
+		{
+			desc:    "transform SenML message published with transformer",
+			subject: brokers.SubjectSenML,
+			msg:     protomfx.Message{ProfileConfig: conf, Payload: senmlPayload, Transformer: senml.TransformerName},
+			res:     []senml.Message{},
+		},
+		{
+			desc:    "transform JSON message published without transformer",
+			subject: brokers.SubjectJSON,
+			msg:     protomfx.Message{ProfileConfig: conf, Payload: jsonPayload},
+			res:     json.Messages{},
+		},
+		{
+			desc:    "transform JSON message published with transformer",
+			subject: brokers.SubjectJSON,
+			msg:     protomfx.Message{ProfileConfig: conf, Payload: jsonPayload, Transformer: json.TransformerName},
+			res:     json.Messages{},
+		},
+		{
+			desc:    "transform message published with unknown transformer",
+			subject: brokers.SubjectJSON,
+			msg:     protomfx.Message{ProfileConfig: conf, Payload: jsonPayload, Transformer: "unknown"},
+			err:     transformers.ErrUnknownTransformer,
+		},
+	}
+
+	for _, tc := range cases {
+		consumer.msgs = nil
+		err := sub.handlers[tc.subject].Handle(tc.msg)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+		if tc.err != nil {
+			assert.Empty(t, consumer.msgs, fmt.Sprintf("%s: expected no consumed messages", tc.desc))
+			continue
+		}
+		require.Len(t, consumer.msgs, 1, fmt.Sprintf("%s: expected one consumed message", tc.desc))
+		assert.IsType(t, tc.res, consumer.msgs[0], fmt.Sprintf("%s: expected %T got %T", tc.desc, tc.res, consumer.msgs[0]))
+	}
+}
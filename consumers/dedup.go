@@ -0,0 +1,86 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// ProcessedRepository specifies the persistence API of the processed messages,
+// shared by the consumer replicas so that each message is processed once.
+type ProcessedRepository interface {
+	// Processed reports whether the message identified by the provided ID
+	// has already been processed.
+	Processed(ctx context.Context, id string) (bool, error)
+
+	// Save marks the message identified by the provided ID as processed.
+	Save(ctx context.Context, id string) error
+
+	// RemoveBefore removes the messages processed before the provided time.
+	RemoveBefore(ctx context.Context, t time.Time) error
+}
+
+// MessageID returns the identifier of the message, derived from its publisher,
+// subtopic, creation time and payload, since the messages don't carry one.
+func MessageID(msg protomfx.Message) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%d\n", msg.Publisher, msg.Subtopic, msg.Created)
+	h.Write(msg.Payload)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// StartIdempotent starts consuming the messages received from Message broker,
+// skipping the messages which have already been processed by any replica of
+// the consumer, e.g. the messages published more than once. The message is
+// marked as processed only once it's consumed, so that the message whose
+// consumption failed is processed again if it's redelivered.
+func StartIdempotent(id string, sub messaging.Subscriber, consumer Consumer, processed ProcessedRepository, subjects ...string) error {
+	route := func(msg protomfx.Message) (Consumer, error) {
+		msgID := MessageID(msg)
+		ok, err := processed.Processed(context.Background(), msgID)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return nil, nil
+		}
+
+		return idempotentConsumer{consumer: consumer, processed: processed, id: msgID}, nil
+	}
+
+	return start(id, sub, route, nil, subjects...)
+}
+
+// RemoveProcessed returns the job which removes the messages processed before
+// the dedup window, so that the repository doesn't grow indefinitely.
+func RemoveProcessed(processed ProcessedRepository, window time.Duration) jobs.Func {
+	return func(ctx context.Context, now time.Time) error {
+		return processed.RemoveBefore(ctx, now.Add(-window))
+	}
+}
+
+// idempotentConsumer marks the message as processed once it's consumed.
+type idempotentConsumer struct {
+	consumer  Consumer
+	processed ProcessedRepository
+	id        string
+}
+
+func (ic idempotentConsumer) Consume(msg interface{}) error {
+	if err := ic.consumer.Consume(msg); err != nil {
+		return err
+	}
+
+	return ic.processed.Save(context.Background(), ic.id)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const svcName = "consumer"
+
+type subscriberMock struct {
+	handlers map[string]messaging.MessageHandler
+}
+
+func (sm *subscriberMock) Subscribe(id, topic string, handler messaging.MessageHandler) error {
+	sm.handlers[topic] = handler
+	return nil
+}
+
+func (sm *subscriberMock) Unsubscribe(id, topic string) error {
+	delete(sm.handlers, topic)
+	return nil
+}
+
+func (sm *subscriberMock) Close() error {
+	return nil
+}
+
+type consumerMock struct {
+	msgs []interface{}
+	err  error
+}
+
+func (cm *consumerMock) Consume(msg interface{}) error {
+	if cm.err != nil {
+		return cm.err
+	}
+
+	cm.msgs = append(cm.msgs, msg)
+	return nil
+}
+
+type processedRepositoryMock struct {
+	processed map[string]time.Time
+}
+
+func (prm *processedRepositoryMock) Processed(_ context.Context, id string) (bool, error) {
+	_, ok := prm.processed[id]
+	return ok, nil
+}
+
+func (prm *processedRepositoryMock) Save(_ context.Context, id string) error {
+	prm.processed[id] = time.Now()
+	return nil
+}
+
+func (prm *processedRepositoryMock) RemoveBefore(_ context.Context, t time.Time) error {
+	for id, pt := range prm.processed {
+		if pt.Before(t) {
+			delete(prm.processed, id)
+		}
+	}
+
+	return nil
+}
+
+func TestStartIdempotent(t *testing.T) {
+	sub := &subscriberMock{handlers: map[string]messaging.MessageHandler{}}
+	consumer := &consumerMock{}
+	processed := &processedRepositoryMock{processed: map[string]time.Time{}}
+
+	err := consumers.StartIdempotent(svcName, sub, consumer, processed, brokers.SubjectSmtp)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	handler := sub.handlers[brokers.SubjectSmtp]
+
+	msg := protomfx.Message{
+		Publisher: "publisher",
+		Subtopic:  "temperature",
+		Payload:   []byte(`{"v":22.5}`),
+		Created:   time.Now().UnixNano(),
+	}
+	other := msg
+	other.Created++
+	failed := msg
+	failed.Created += 2
+	consumeErr := errors.New("consume failed")
+
+	cases := []struct {
+		desc     string
+		msg      protomfx.Message
+		err      error
+		consumed int
+	}{
+		{
+			desc:     "consume new message",
+			msg:      msg,
+			consumed: 1,
+		},
+		{
+			desc:     "skip processed message",
+			msg:      msg,
+			consumed: 1,
+		},
+		{
+			desc:     "consume message created at another time",
+			msg:      other,
+			consumed: 2,
+		},
+		{
+			desc:     "consume message with failing consumer",
+			msg:      failed,
+			err:      consumeErr,
+			consumed: 2,
+		},
+		{
+			desc:     "consume redelivered message which failed",
+			msg:      failed,
+			consumed: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		consumer.err = tc.err
+		err := handler.Handle(tc.msg)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+		assert.Equal(t, tc.consumed, len(consumer.msgs), fmt.Sprintf("%s: expected %d consumed messages got %d", tc.desc, tc.consumed, len(consumer.msgs)))
+	}
+
+	remove := consumers.RemoveProcessed(processed, time.Minute)
+	err = remove(context.Background(), time.Now().Add(time.Minute+time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = handler.Handle(msg)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 4, len(consumer.msgs), "expected the message to be consumed again after its ID was removed")
+}
+
+func TestMessageID(t *testing.T) {
+	msg := protomfx.Message{
+		Publisher: "publisher",
+		Subtopic:  "temperature",
+		Payload:   []byte(`{"v":22.5}`),
+		Created:   time.Now().UnixNano(),
+	}
+
+	cases := []struct {
+		desc  string
+		msg   protomfx.Message
+		equal bool
+	}{
+		{
+			desc:  "same message",
+			msg:   msg,
+			equal: true,
+		},
+		{
+			desc:  "message with another profile config",
+			msg:   protomfx.Message{Publisher: msg.Publisher, Subtopic: msg.Subtopic, Payload: msg.Payload, Created: msg.Created, ProfileConfig: &protomfx.Config{SmtpID: "smtp"}},
+			equal: true,
+		},
+		{
+			desc:  "message of another publisher",
+			msg:   protomfx.Message{Publisher: "other", Subtopic: msg.Subtopic, Payload: msg.Payload, Created: msg.Created},
+			equal: false,
+		},
+		{
+			desc:  "message with another payload",
+			msg:   protomfx.Message{Publisher: msg.Publisher, Subtopic: msg.Subtopic, Payload: []byte(`{"v":23}`), Created: msg.Created},
+			equal: false,
+		},
+	}
+
+	for _, tc := range cases {
+		equal := consumers.MessageID(msg) == consumers.MessageID(tc.msg)
+		assert.Equal(t, tc.equal, equal, fmt.Sprintf("%s: expected equal IDs %t got %t", tc.desc, tc.equal, equal))
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func listNotificationsEndpoint(svc inbox.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listNotificationsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListNotifications(ctx, req.token, req.groupID, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildNotificationsPageRes(page), nil
+	}
+}
+
+func unreadCountEndpoint(svc inbox.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(unreadCountReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		count, err := svc.UnreadCount(ctx, req.token, req.groupID)
+		if err != nil {
+			return nil, err
+		}
+
+		return unreadCountRes{Count: count}, nil
+	}
+}
+
+func markAsReadEndpoint(svc inbox.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(markAsReadReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.MarkAsRead(ctx, req.token, req.groupID, req.NotificationIDs...); err != nil {
+			return nil, err
+		}
+
+		return markAsReadRes{}, nil
+	}
+}
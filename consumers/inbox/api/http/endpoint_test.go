@@ -0,0 +1,364 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	httpapi "github.com/MainfluxLabs/mainflux/consumers/inbox/api/http"
+	ibmocks "github.com/MainfluxLabs/mainflux/consumers/inbox/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/gorilla/websocket"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token       = "admin@example.com"
+	userID      = "5a1a9d5a-7a2b-4bd5-9a0c-2c5b2b3f6c11"
+	groupID     = "50e6b371-60ff-45cf-bb52-8200e7cde536"
+	thingID     = "513d02d2-16c1-4f23-98be-9e12f8fee898"
+	wrongValue  = "wrong-value"
+	contentType = "application/json"
+	emptyValue  = ""
+	n           = 10
+)
+
+var payload = []byte(`{"temperature":40}`)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newService() inbox.Service {
+	auth := mocks.NewAuthService("", []users.User{{ID: userID, Email: token}})
+	thingsC := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID}, map[string]things.Group{token: {ID: groupID}})
+	repo := ibmocks.NewNotificationRepository()
+	idp := uuid.NewMock()
+	return inbox.New(idp, auth, thingsC, repo)
+}
+
+func newHTTPServer(svc inbox.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
+	return httptest.NewServer(mux)
+}
+
+func consume(t *testing.T, svc inbox.Service, from, count int) {
+	t.Helper()
+	for i := from; i < from+count; i++ {
+		msg := protomfx.Message{Publisher: thingID, Protocol: "http", Payload: payload, Created: int64(i)}
+		err := svc.Consume(msg)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+}
+
+type notificationRes struct {
+	ID        string `json:"id"`
+	GroupID   string `json:"group_id"`
+	Publisher string `json:"publisher"`
+	Payload   string `json:"payload"`
+	Read      bool   `json:"read"`
+}
+
+type notificationsPageRes struct {
+	Total         uint64            `json:"total"`
+	Notifications []notificationRes `json:"notifications"`
+}
+
+func TestListNotifications(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+	consume(t, svc, 1, n)
+
+	page, err := svc.ListNotifications(context.Background(), token, groupID, inbox.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = svc.MarkAsRead(context.Background(), token, groupID, page.Notifications[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	notificationsURL := fmt.Sprintf("%s/groups/%s/notifications", ts.URL, groupID)
+
+	cases := []struct {
+		desc   string
+		url    string
+		auth   string
+		status int
+		size   int
+	}{
+		{
+			desc:   "list notifications",
+			url:    notificationsURL,
+			auth:   token,
+			status: http.StatusOK,
+			size:   n,
+		},
+		{
+			desc:   "list notifications with limit",
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", notificationsURL, 0, 5),
+			auth:   token,
+			status: http.StatusOK,
+			size:   5,
+		},
+		{
+			desc:   "list unread notifications",
+			url:    fmt.Sprintf("%s?unread=true", notificationsURL),
+			auth:   token,
+			status: http.StatusOK,
+			size:   n - 1,
+		},
+		{
+			desc:   "list notifications with invalid unread filter",
+			url:    fmt.Sprintf("%s?unread=%s", notificationsURL, wrongValue),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list notifications with limit greater than max",
+			url:    fmt.Sprintf("%s?limit=%d", notificationsURL, 110),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list notifications with invalid token",
+			url:    notificationsURL,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			size:   0,
+		},
+		{
+			desc:   "list notifications with empty token",
+			url:    notificationsURL,
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			size:   0,
+		},
+		{
+			desc:   "list notifications of inaccessible group",
+			url:    fmt.Sprintf("%s/groups/%s/notifications", ts.URL, wrongValue),
+			auth:   token,
+			status: http.StatusForbidden,
+			size:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body notificationsPageRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.size, len(body.Notifications), fmt.Sprintf("%s: expected size %d got %d", tc.desc, tc.size, len(body.Notifications)))
+	}
+}
+
+func TestUnreadCount(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+	consume(t, svc, 1, n)
+
+	cases := []struct {
+		desc    string
+		groupID string
+		auth    string
+		status  int
+		count   uint64
+	}{
+		{
+			desc:    "get unread count",
+			groupID: groupID,
+			auth:    token,
+			status:  http.StatusOK,
+			count:   n,
+		},
+		{
+			desc:    "get unread count with invalid token",
+			groupID: groupID,
+			auth:    wrongValue,
+			status:  http.StatusUnauthorized,
+			count:   0,
+		},
+		{
+			desc:    "get unread count of inaccessible group",
+			groupID: wrongValue,
+			auth:    token,
+			status:  http.StatusForbidden,
+			count:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/groups/%s/notifications/unread", ts.URL, tc.groupID),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body struct {
+			Count uint64 `json:"count"`
+		}
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.count, body.Count, fmt.Sprintf("%s: expected count %d got %d", tc.desc, tc.count, body.Count))
+	}
+}
+
+func TestMarkAsRead(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+	consume(t, svc, 1, n)
+
+	page, err := svc.ListNotifications(context.Background(), token, groupID, inbox.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	data := fmt.Sprintf(`{"notification_ids":["%s"]}`, page.Notifications[0].ID)
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "mark notifications as read",
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "mark notifications as read with empty list",
+			data:        `{"notification_ids":[]}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "mark notifications as read with empty id",
+			data:        `{"notification_ids":[""]}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "mark notifications as read with malformed data",
+			data:        `{"notification_ids":}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "mark notifications as read without content type",
+			data:        data,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "mark notifications as read with invalid token",
+			data:        data,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/groups/%s/notifications/read", ts.URL, groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	unread, err := svc.UnreadCount(context.Background(), token, groupID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(n-1), unread, fmt.Sprintf("mark notifications as read: expected %d unread got %d", n-1, unread))
+}
+
+func TestSubscribe(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	wsURL := fmt.Sprintf("ws%s/groups/%s/notifications/ws", strings.TrimPrefix(ts.URL, "http"), groupID)
+
+	_, res, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s?authorization=%s", wsURL, wrongValue), nil)
+	assert.NotNil(t, err, "subscribe with invalid token: expected error got none")
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode, fmt.Sprintf("subscribe with invalid token: expected status code %d got %d", http.StatusUnauthorized, res.StatusCode))
+
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("%s?authorization=%s", wsURL, token), nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	// The subscription is registered before the connection is upgraded, so
+	// the notification consumed after the dial is pushed.
+	consume(t, svc, 1, 1)
+
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	var nt notificationRes
+	err = conn.ReadJSON(&nt)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, string(payload), nt.Payload, fmt.Sprintf("push notification: expected %s got %s", payload, nt.Payload))
+	assert.Equal(t, groupID, nt.GroupID, fmt.Sprintf("push notification: expected group %s got %s", groupID, nt.GroupID))
+}
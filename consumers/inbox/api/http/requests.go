@@ -0,0 +1,98 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+const (
+	minLen       = 1
+	maxLimitSize = 100
+)
+
+type listNotificationsReq struct {
+	token        string
+	groupID      string
+	pageMetadata inbox.PageMetadata
+}
+
+func (req listNotificationsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type unreadCountReq struct {
+	token   string
+	groupID string
+}
+
+func (req unreadCountReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	return nil
+}
+
+type markAsReadReq struct {
+	token           string
+	groupID         string
+	NotificationIDs []string `json:"notification_ids,omitempty"`
+}
+
+func (req markAsReadReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.NotificationIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.NotificationIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type subscribeReq struct {
+	token   string
+	groupID string
+}
+
+func (req subscribeReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+)
+
+type notificationRes struct {
+	ID        string `json:"id"`
+	GroupID   string `json:"group_id"`
+	Publisher string `json:"publisher"`
+	Subtopic  string `json:"subtopic,omitempty"`
+	Protocol  string `json:"protocol"`
+	Payload   string `json:"payload"`
+	Created   int64  `json:"created"`
+	Read      bool   `json:"read"`
+}
+
+type pageRes struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	Unread bool   `json:"unread"`
+}
+
+type notificationsPageRes struct {
+	pageRes
+	Notifications []notificationRes `json:"notifications"`
+}
+
+func (res notificationsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res notificationsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res notificationsPageRes) Empty() bool {
+	return false
+}
+
+type unreadCountRes struct {
+	Count uint64 `json:"count"`
+}
+
+func (res unreadCountRes) Code() int {
+	return http.StatusOK
+}
+
+func (res unreadCountRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res unreadCountRes) Empty() bool {
+	return false
+}
+
+type markAsReadRes struct{}
+
+func (res markAsReadRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res markAsReadRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res markAsReadRes) Empty() bool {
+	return true
+}
+
+func buildNotificationRes(n inbox.Notification) notificationRes {
+	return notificationRes{
+		ID:        n.ID,
+		GroupID:   n.GroupID,
+		Publisher: n.Publisher,
+		Subtopic:  n.Subtopic,
+		Protocol:  n.Protocol,
+		Payload:   string(n.Payload),
+		Created:   n.Created,
+		Read:      n.Read,
+	}
+}
+
+func buildNotificationsPageRes(page inbox.NotificationsPage) notificationsPageRes {
+	res := notificationsPageRes{
+		pageRes: pageRes{
+			Total:  page.Total,
+			Offset: page.Offset,
+			Limit:  page.Limit,
+			Unread: page.Unread,
+		},
+		Notifications: []notificationRes{},
+	}
+
+	for _, n := range page.Notifications {
+		res.Notifications = append(res.Notifications, buildNotificationRes(n))
+	}
+
+	return res
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/go-zoo/bone"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	contentType = "application/json"
+	idKey       = "id"
+	offsetKey   = "offset"
+	limitKey    = "limit"
+	unreadKey   = "unread"
+	defOffset   = 0
+	defLimit    = 10
+)
+
+// MakeHandler returns a HTTP handler for API endpoints.
+func MakeHandler(tracer opentracing.Tracer, svc inbox.Service, logger log.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, encodeError)),
+	}
+
+	r := bone.New()
+
+	r.Get("/groups/:id/notifications", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_notifications")(listNotificationsEndpoint(svc)),
+		decodeListNotifications,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/notifications/unread", kithttp.NewServer(
+		kitot.TraceServer(tracer, "unread_count")(unreadCountEndpoint(svc)),
+		decodeUnreadCount,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/groups/:id/notifications/read", kithttp.NewServer(
+		kitot.TraceServer(tracer, "mark_as_read")(markAsReadEndpoint(svc)),
+		decodeMarkAsRead,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/notifications/ws", servershttp.WithoutTimeout(subscribe(svc, logger)))
+
+	r.GetFunc("/health", mainflux.Health("inbox"))
+	r.Handle("/metrics", promhttp.Handler())
+
+	return r
+}
+
+func decodeListNotifications(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := apiutil.ReadBoolQuery(r, unreadKey, false)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listNotificationsReq{
+		token:   apiutil.ExtractBearerToken(r),
+		groupID: bone.GetValue(r, idKey),
+		pageMetadata: inbox.PageMetadata{
+			Offset: o,
+			Limit:  l,
+			Unread: u,
+		},
+	}
+
+	return req, nil
+}
+
+func decodeUnreadCount(_ context.Context, r *http.Request) (interface{}, error) {
+	req := unreadCountReq{
+		token:   apiutil.ExtractBearerToken(r),
+		groupID: bone.GetValue(r, idKey),
+	}
+
+	return req, nil
+}
+
+func decodeMarkAsRead(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := markAsReadReq{
+		token:   apiutil.ExtractBearerToken(r),
+		groupID: bone.GetValue(r, idKey),
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", contentType)
+
+	if ar, ok := response.(apiutil.Response); ok {
+		for k, v := range ar.Headers() {
+			w.Header().Set(k, v)
+		}
+
+		w.WriteHeader(ar.Code())
+
+		if ar.Empty() {
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	switch {
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Contains(err, errors.ErrAuthentication),
+		err == apiutil.ErrBearerToken:
+		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, errors.ErrAuthorization):
+		w.WriteHeader(http.StatusForbidden)
+	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		errors.Contains(err, apiutil.ErrMalformedEntity),
+		errors.Contains(err, errors.ErrMalformedEntity),
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingGroupID,
+		err == apiutil.ErrLimitSize,
+		err == apiutil.ErrOffsetSize:
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, errors.ErrUpdateEntity),
+		errors.Contains(err, errors.ErrRetrieveEntity):
+		w.WriteHeader(http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	if errorVal, ok := err.(errors.Error); ok {
+		w.Header().Set("Content-Type", contentType)
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/go-zoo/bone"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	authzQueryKey       = "authorization"
+	readwriteBufferSize = 1024
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  readwriteBufferSize,
+	WriteBufferSize: readwriteBufferSize,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+var _ inbox.Subscriber = (*wsSubscriber)(nil)
+
+// wsSubscriber pushes the notifications to the websocket connection.
+type wsSubscriber struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (ws *wsSubscriber) Handle(n inbox.Notification) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	// The connection is not set if the upgrade failed.
+	if ws.conn == nil {
+		return nil
+	}
+
+	return ws.conn.WriteJSON(buildNotificationRes(n))
+}
+
+// subscribe upgrades the connection to websocket and pushes the group
+// notifications to it until it is closed by the client. Since browsers
+// cannot set the websocket request headers, the token can be passed in
+// the authorization query parameter as well.
+func subscribe(svc inbox.Service, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := decodeSubscribe(r)
+		if err := req.validate(); err != nil {
+			encodeError(context.Background(), err, w)
+			return
+		}
+
+		// The subscription is authorized before the connection is upgraded,
+		// so that the errors are returned as regular HTTP responses. The
+		// subscriber is locked until the upgrade, so that the notifications
+		// received in the meantime are pushed once it is done.
+		sub := &wsSubscriber{}
+		sub.mu.Lock()
+		if err := svc.Subscribe(r.Context(), req.token, req.groupID, sub); err != nil {
+			sub.mu.Unlock()
+			encodeError(context.Background(), err, w)
+			return
+		}
+		defer svc.Unsubscribe(context.Background(), req.groupID, sub)
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			sub.mu.Unlock()
+			logger.Warn(fmt.Sprintf("Failed to upgrade connection to websocket: %s", err))
+			return
+		}
+		defer conn.Close()
+		sub.conn = conn
+		sub.mu.Unlock()
+
+		// The client is not expected to send any messages, so the connection
+		// is read only to detect that it is closed.
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func decodeSubscribe(r *http.Request) subscribeReq {
+	token := apiutil.ExtractBearerToken(r)
+	if token == "" {
+		if tokens := bone.GetQuery(r, authzQueryKey); len(tokens) > 0 {
+			token = tokens[0]
+		}
+	}
+
+	return subscribeReq{
+		token:   token,
+		groupID: bone.GetValue(r, idKey),
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	log "github.com/MainfluxLabs/mainflux/logger"
+)
+
+var _ inbox.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger log.Logger
+	svc    inbox.Service
+}
+
+// LoggingMiddleware adds logging facilities to the core service.
+func LoggingMiddleware(svc inbox.Service, logger log.Logger) inbox.Service {
+	return &loggingMiddleware{logger, svc}
+}
+
+func (lm *loggingMiddleware) ListNotifications(ctx context.Context, token, groupID string, pm inbox.PageMetadata) (page inbox.NotificationsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_notifications for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListNotifications(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) UnreadCount(ctx context.Context, token, groupID string) (count uint64, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method unread_count for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UnreadCount(ctx, token, groupID)
+}
+
+func (lm *loggingMiddleware) MarkAsRead(ctx context.Context, token, groupID string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method mark_as_read for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.MarkAsRead(ctx, token, groupID, ids...)
+}
+
+func (lm *loggingMiddleware) Subscribe(ctx context.Context, token, groupID string, sub inbox.Subscriber) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method subscribe for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.Subscribe(ctx, token, groupID, sub)
+}
+
+func (lm *loggingMiddleware) Unsubscribe(ctx context.Context, groupID string, sub inbox.Subscriber) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method unsubscribe for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.Unsubscribe(ctx, groupID, sub)
+}
+
+func (lm *loggingMiddleware) RemoveNotificationsByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_notifications_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveNotificationsByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) Consume(msg interface{}) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method consume took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.Consume(msg)
+}
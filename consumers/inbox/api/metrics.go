@@ -0,0 +1,94 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ inbox.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     inbox.Service
+}
+
+// MetricsMiddleware instruments core service by tracking request count and latency.
+func MetricsMiddleware(svc inbox.Service, counter metrics.Counter, latency metrics.Histogram) inbox.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (ms *metricsMiddleware) ListNotifications(ctx context.Context, token, groupID string, pm inbox.PageMetadata) (inbox.NotificationsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_notifications").Add(1)
+		ms.latency.With("method", "list_notifications").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListNotifications(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) UnreadCount(ctx context.Context, token, groupID string) (uint64, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "unread_count").Add(1)
+		ms.latency.With("method", "unread_count").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UnreadCount(ctx, token, groupID)
+}
+
+func (ms *metricsMiddleware) MarkAsRead(ctx context.Context, token, groupID string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "mark_as_read").Add(1)
+		ms.latency.With("method", "mark_as_read").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.MarkAsRead(ctx, token, groupID, ids...)
+}
+
+func (ms *metricsMiddleware) Subscribe(ctx context.Context, token, groupID string, sub inbox.Subscriber) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "subscribe").Add(1)
+		ms.latency.With("method", "subscribe").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.Subscribe(ctx, token, groupID, sub)
+}
+
+func (ms *metricsMiddleware) Unsubscribe(ctx context.Context, groupID string, sub inbox.Subscriber) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "unsubscribe").Add(1)
+		ms.latency.With("method", "unsubscribe").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.Unsubscribe(ctx, groupID, sub)
+}
+
+func (ms *metricsMiddleware) RemoveNotificationsByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_notifications_by_group").Add(1)
+		ms.latency.With("method", "remove_notifications_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveNotificationsByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) Consume(msg interface{}) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "consume").Add(1)
+		ms.latency.With("method", "consume").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.Consume(msg)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package inbox
+
+import "sync"
+
+// hub keeps track of the subscribers of each group.
+type hub struct {
+	mu     sync.RWMutex
+	groups map[string]map[Subscriber]bool
+}
+
+func newHub() *hub {
+	return &hub{
+		groups: make(map[string]map[Subscriber]bool),
+	}
+}
+
+func (h *hub) subscribe(groupID string, sub Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.groups[groupID]; !ok {
+		h.groups[groupID] = make(map[Subscriber]bool)
+	}
+	h.groups[groupID][sub] = true
+}
+
+func (h *hub) unsubscribe(groupID string, sub Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.groups[groupID], sub)
+	if len(h.groups[groupID]) == 0 {
+		delete(h.groups, groupID)
+	}
+}
+
+// publish pushes the notification to the subscribers of its group. The
+// subscribers failing to handle it are left to be removed by the transport
+// closing their connection.
+func (h *hub) publish(n Notification) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.groups[n.GroupID] {
+		sub.Handle(n)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package inbox
+
+import "context"
+
+// Notification represents the in-app notification created from a message
+// sent to the SMTP or SMPP notifiers.
+type Notification struct {
+	ID        string
+	GroupID   string
+	Publisher string
+	Subtopic  string
+	Protocol  string
+	Payload   []byte
+	Created   int64
+	Read      bool
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total  uint64
+	Offset uint64
+	Limit  uint64
+	Unread bool
+}
+
+// NotificationsPage contains page related metadata as well as a list of
+// notifications that belong to this page.
+type NotificationsPage struct {
+	PageMetadata
+	Notifications []Notification
+}
+
+// NotificationRepository specifies a notification persistence API.
+type NotificationRepository interface {
+	// Save persists the notification. The notification created from the
+	// same message is saved only once, and an ErrConflict is returned
+	// for the subsequent attempts.
+	Save(ctx context.Context, n Notification) error
+
+	// RetrieveByGroup retrieves the notifications of the group identified by
+	// the provided ID, marked as read or unread for the provided user.
+	RetrieveByGroup(ctx context.Context, userID, groupID string, pm PageMetadata) (NotificationsPage, error)
+
+	// RetrieveUnreadCount retrieves the number of the group notifications
+	// not read by the provided user.
+	RetrieveUnreadCount(ctx context.Context, userID, groupID string) (uint64, error)
+
+	// MarkAsRead marks the group notifications identified by the provided
+	// IDs as read by the provided user.
+	MarkAsRead(ctx context.Context, userID, groupID string, ids ...string) error
+
+	// RemoveByGroup removes the notifications of the group identified by the
+	// provided ID.
+	RemoveByGroup(ctx context.Context, groupID string) error
+}
+
+// Subscriber represents the client receiving the group notifications as
+// soon as they are saved.
+type Subscriber interface {
+	// Handle is used to push the notification to the subscriber.
+	Handle(n Notification) error
+}
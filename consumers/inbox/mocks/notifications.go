@@ -0,0 +1,143 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ inbox.NotificationRepository = (*notificationRepositoryMock)(nil)
+
+type notificationRepositoryMock struct {
+	mu            sync.Mutex
+	notifications map[string]inbox.Notification
+	reads         map[string]map[string]bool
+}
+
+// NewNotificationRepository returns mock of notification repository.
+func NewNotificationRepository() inbox.NotificationRepository {
+	return &notificationRepositoryMock{
+		notifications: make(map[string]inbox.Notification),
+		reads:         make(map[string]map[string]bool),
+	}
+}
+
+func (nrm *notificationRepositoryMock) Save(_ context.Context, n inbox.Notification) error {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	for _, sn := range nrm.notifications {
+		if sn.Publisher == n.Publisher && sn.Subtopic == n.Subtopic && sn.Created == n.Created {
+			return errors.ErrConflict
+		}
+	}
+
+	nrm.notifications[n.ID] = n
+
+	return nil
+}
+
+func (nrm *notificationRepositoryMock) RetrieveByGroup(_ context.Context, userID, groupID string, pm inbox.PageMetadata) (inbox.NotificationsPage, error) {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	var items []inbox.Notification
+	for _, n := range nrm.retrieve(userID, groupID) {
+		if pm.Unread && n.Read {
+			continue
+		}
+		items = append(items, n)
+	}
+
+	total := uint64(len(items))
+	if pm.Offset >= total {
+		items = []inbox.Notification{}
+	} else {
+		end := pm.Offset + pm.Limit
+		if pm.Limit == 0 || end > total {
+			end = total
+		}
+		items = items[pm.Offset:end]
+	}
+
+	return inbox.NotificationsPage{
+		PageMetadata: inbox.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+			Unread: pm.Unread,
+		},
+		Notifications: items,
+	}, nil
+}
+
+func (nrm *notificationRepositoryMock) RetrieveUnreadCount(_ context.Context, userID, groupID string) (uint64, error) {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	var count uint64
+	for _, n := range nrm.retrieve(userID, groupID) {
+		if !n.Read {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (nrm *notificationRepositoryMock) MarkAsRead(_ context.Context, userID, groupID string, ids ...string) error {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	for _, id := range ids {
+		n, ok := nrm.notifications[id]
+		if !ok || n.GroupID != groupID {
+			continue
+		}
+
+		if _, ok := nrm.reads[id]; !ok {
+			nrm.reads[id] = make(map[string]bool)
+		}
+		nrm.reads[id][userID] = true
+	}
+
+	return nil
+}
+
+func (nrm *notificationRepositoryMock) RemoveByGroup(_ context.Context, groupID string) error {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	for id, n := range nrm.notifications {
+		if n.GroupID == groupID {
+			delete(nrm.notifications, id)
+			delete(nrm.reads, id)
+		}
+	}
+
+	return nil
+}
+
+// retrieve returns the group notifications, newest first.
+func (nrm *notificationRepositoryMock) retrieve(userID, groupID string) []inbox.Notification {
+	var items []inbox.Notification
+	for _, n := range nrm.notifications {
+		if n.GroupID != groupID {
+			continue
+		}
+		n.Read = nrm.reads[n.ID][userID]
+		items = append(items, n)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Created > items[j].Created
+	})
+
+	return items
+}
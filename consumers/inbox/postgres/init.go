@@ -0,0 +1,85 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	"github.com/jmoiron/sqlx"
+
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+// Config defines the options that are used when connecting to a PostgreSQL instance
+type Config struct {
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
+}
+
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
+	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
+
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
+		Migrations: []*migrate.Migration{
+			{
+				Id: "inbox_1",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS notifications (
+						id          UUID PRIMARY KEY,
+						group_id    UUID NOT NULL,
+						publisher   VARCHAR(254) NOT NULL,
+						subtopic    VARCHAR(254) NOT NULL,
+						protocol    VARCHAR(254) NOT NULL,
+						payload     BYTEA,
+						created     BIGINT NOT NULL,
+						CONSTRAINT  unique_publisher_message UNIQUE (publisher, subtopic, created)
+					)`,
+					`CREATE INDEX IF NOT EXISTS notifications_group_created_idx ON notifications (group_id, created DESC)`,
+					`CREATE TABLE IF NOT EXISTS notification_reads (
+						notification_id UUID NOT NULL REFERENCES notifications (id) ON DELETE CASCADE,
+						user_id         VARCHAR(254) NOT NULL,
+						PRIMARY KEY (notification_id, user_id)
+					)`,
+				},
+				Down: []string{
+					"DROP TABLE notification_reads",
+					"DROP TABLE notifications",
+				},
+			},
+		},
+	}
+}
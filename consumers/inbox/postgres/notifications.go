@@ -0,0 +1,211 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ inbox.NotificationRepository = (*notificationRepository)(nil)
+
+type notificationRepository struct {
+	db Database
+}
+
+// NewNotificationRepository instantiates a PostgreSQL implementation of notification repository.
+func NewNotificationRepository(db Database) inbox.NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+func (nr notificationRepository) Save(ctx context.Context, n inbox.Notification) error {
+	q := `INSERT INTO notifications (id, group_id, publisher, subtopic, protocol, payload, created)
+		VALUES (:id, :group_id, :publisher, :subtopic, :protocol, :payload, :created);`
+
+	if _, err := nr.db.NamedExecContext(ctx, q, toDBNotification(n)); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+		}
+
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (nr notificationRepository) RetrieveByGroup(ctx context.Context, userID, groupID string, pm inbox.PageMetadata) (inbox.NotificationsPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return inbox.NotificationsPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	uq := ""
+	if pm.Unread {
+		uq = "AND r.user_id IS NULL"
+	}
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+
+	q := fmt.Sprintf(`SELECT n.id, n.group_id, n.publisher, n.subtopic, n.protocol, n.payload, n.created, r.user_id IS NOT NULL AS read
+		FROM notifications n LEFT JOIN notification_reads r ON r.notification_id = n.id AND r.user_id = :user_id
+		WHERE n.group_id = :group_id %s ORDER BY n.created DESC %s;`, uq, olq)
+	qc := fmt.Sprintf(`SELECT COUNT(*)
+		FROM notifications n LEFT JOIN notification_reads r ON r.notification_id = n.id AND r.user_id = $1
+		WHERE n.group_id = $2 %s;`, uq)
+
+	params := map[string]interface{}{
+		"user_id":  userID,
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	rows, err := nr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return inbox.NotificationsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []inbox.Notification
+	for rows.Next() {
+		dbn := dbNotification{}
+		if err := rows.StructScan(&dbn); err != nil {
+			return inbox.NotificationsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		items = append(items, toNotification(dbn))
+	}
+
+	var total uint64
+	if err := nr.db.GetContext(ctx, &total, qc, userID, groupID); err != nil {
+		return inbox.NotificationsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	page := inbox.NotificationsPage{
+		Notifications: items,
+		PageMetadata: inbox.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+			Unread: pm.Unread,
+		},
+	}
+
+	return page, nil
+}
+
+func (nr notificationRepository) RetrieveUnreadCount(ctx context.Context, userID, groupID string) (uint64, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return 0, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	q := `SELECT COUNT(*)
+		FROM notifications n LEFT JOIN notification_reads r ON r.notification_id = n.id AND r.user_id = $1
+		WHERE n.group_id = $2 AND r.user_id IS NULL;`
+
+	var count uint64
+	if err := nr.db.GetContext(ctx, &count, q, userID, groupID); err != nil {
+		return 0, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return count, nil
+}
+
+func (nr notificationRepository) MarkAsRead(ctx context.Context, userID, groupID string, ids ...string) error {
+	tx, err := nr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	// Only the notifications of the group are marked, so that the user
+	// cannot mark the notifications of the groups they cannot view.
+	q := `INSERT INTO notification_reads (notification_id, user_id)
+		SELECT id, :user_id FROM notifications WHERE id = :id AND group_id = :group_id
+		ON CONFLICT DO NOTHING;`
+
+	for _, id := range ids {
+		params := map[string]interface{}{
+			"id":       id,
+			"user_id":  userID,
+			"group_id": groupID,
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, params); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok && pgErr.Code == pgerrcode.InvalidTextRepresentation {
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+
+			return errors.Wrap(errors.ErrUpdateEntity, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (nr notificationRepository) RemoveByGroup(ctx context.Context, groupID string) error {
+	q := `DELETE FROM notifications WHERE group_id = :group_id;`
+
+	if _, err := nr.db.NamedExecContext(ctx, q, dbNotification{GroupID: groupID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbNotification struct {
+	ID        string `db:"id"`
+	GroupID   string `db:"group_id"`
+	Publisher string `db:"publisher"`
+	Subtopic  string `db:"subtopic"`
+	Protocol  string `db:"protocol"`
+	Payload   []byte `db:"payload"`
+	Created   int64  `db:"created"`
+	Read      bool   `db:"read"`
+}
+
+func toDBNotification(n inbox.Notification) dbNotification {
+	return dbNotification{
+		ID:        n.ID,
+		GroupID:   n.GroupID,
+		Publisher: n.Publisher,
+		Subtopic:  n.Subtopic,
+		Protocol:  n.Protocol,
+		Payload:   n.Payload,
+		Created:   n.Created,
+	}
+}
+
+func toNotification(dbn dbNotification) inbox.Notification {
+	return inbox.Notification{
+		ID:        dbn.ID,
+		GroupID:   dbn.GroupID,
+		Publisher: dbn.Publisher,
+		Subtopic:  dbn.Subtopic,
+		Protocol:  dbn.Protocol,
+		Payload:   dbn.Payload,
+		Created:   dbn.Created,
+		Read:      dbn.Read,
+	}
+}
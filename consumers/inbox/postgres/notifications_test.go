@@ -0,0 +1,301 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/MainfluxLabs/mainflux/consumers/inbox/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	publisher = "publisher"
+	subtopic  = "alerts"
+	protocol  = "http"
+	invalidID = "invalid"
+)
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newNotification(t *testing.T, groupID string) inbox.Notification {
+	return inbox.Notification{
+		ID:        generateUUID(t),
+		GroupID:   groupID,
+		Publisher: publisher,
+		Subtopic:  subtopic,
+		Protocol:  protocol,
+		Payload:   []byte(`{"temperature":40}`),
+		Created:   time.Now().UnixNano(),
+	}
+}
+
+func saveNotifications(t *testing.T, repo inbox.NotificationRepository, groupID string, n int) []inbox.Notification {
+	var ns []inbox.Notification
+	for i := 0; i < n; i++ {
+		nt := newNotification(t, groupID)
+		err := repo.Save(context.Background(), nt)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		ns = append(ns, nt)
+	}
+
+	return ns
+}
+
+func TestSaveNotification(t *testing.T) {
+	repo := postgres.NewNotificationRepository(postgres.NewDatabase(db))
+	n := newNotification(t, generateUUID(t))
+
+	duplicate := n
+	duplicate.ID = generateUUID(t)
+
+	invalid := newNotification(t, invalidID)
+
+	cases := []struct {
+		desc         string
+		notification inbox.Notification
+		err          error
+	}{
+		{
+			desc:         "save notification",
+			notification: n,
+			err:          nil,
+		},
+		{
+			desc:         "save notification created from the same message",
+			notification: duplicate,
+			err:          errors.ErrConflict,
+		},
+		{
+			desc:         "save notification with invalid group id",
+			notification: invalid,
+			err:          errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.notification)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveNotificationsByGroup(t *testing.T) {
+	repo := postgres.NewNotificationRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	userID := generateUUID(t)
+
+	n := 5
+	ns := saveNotifications(t, repo, groupID, n)
+
+	err := repo.MarkAsRead(context.Background(), userID, groupID, ns[0].ID, ns[1].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		userID  string
+		groupID string
+		pm      inbox.PageMetadata
+		size    int
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all notifications of the group",
+			userID:  userID,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: 10},
+			size:    n,
+			total:   uint64(n),
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of notifications of the group",
+			userID:  userID,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   uint64(n),
+			err:     nil,
+		},
+		{
+			desc:    "retrieve unread notifications of the group",
+			userID:  userID,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: 10, Unread: true},
+			size:    n - 2,
+			total:   uint64(n - 2),
+			err:     nil,
+		},
+		{
+			desc:    "retrieve unread notifications of the group for other user",
+			userID:  generateUUID(t),
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: 10, Unread: true},
+			size:    n,
+			total:   uint64(n),
+			err:     nil,
+		},
+		{
+			desc:    "retrieve notifications of the group without notifications",
+			userID:  userID,
+			groupID: generateUUID(t),
+			pm:      inbox.PageMetadata{Offset: 0, Limit: 10},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve notifications with invalid group id",
+			userID:  userID,
+			groupID: invalidID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: 10},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroup(context.Background(), tc.userID, tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(page.Notifications), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Notifications)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveUnreadCount(t *testing.T) {
+	repo := postgres.NewNotificationRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	userID := generateUUID(t)
+
+	n := 3
+	ns := saveNotifications(t, repo, groupID, n)
+
+	err := repo.MarkAsRead(context.Background(), userID, groupID, ns[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		userID  string
+		groupID string
+		count   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve unread count of the user",
+			userID:  userID,
+			groupID: groupID,
+			count:   uint64(n - 1),
+			err:     nil,
+		},
+		{
+			desc:    "retrieve unread count of other user",
+			userID:  generateUUID(t),
+			groupID: groupID,
+			count:   uint64(n),
+			err:     nil,
+		},
+		{
+			desc:    "retrieve unread count with invalid group id",
+			userID:  userID,
+			groupID: invalidID,
+			count:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		count, err := repo.RetrieveUnreadCount(context.Background(), tc.userID, tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.count, count, fmt.Sprintf("%s: expected count %d got %d\n", tc.desc, tc.count, count))
+	}
+}
+
+func TestMarkAsRead(t *testing.T) {
+	repo := postgres.NewNotificationRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	userID := generateUUID(t)
+
+	ns := saveNotifications(t, repo, groupID, 1)
+	other := saveNotifications(t, repo, generateUUID(t), 1)
+
+	cases := []struct {
+		desc    string
+		groupID string
+		id      string
+		unread  uint64
+		err     error
+	}{
+		{
+			desc:    "mark notification as read",
+			groupID: groupID,
+			id:      ns[0].ID,
+			unread:  0,
+			err:     nil,
+		},
+		{
+			desc:    "mark read notification as read",
+			groupID: groupID,
+			id:      ns[0].ID,
+			unread:  0,
+			err:     nil,
+		},
+		{
+			desc:    "mark notification of other group as read",
+			groupID: groupID,
+			id:      other[0].ID,
+			unread:  1,
+			err:     nil,
+		},
+		{
+			desc:    "mark notification with invalid id as read",
+			groupID: groupID,
+			id:      invalidID,
+			unread:  0,
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.MarkAsRead(context.Background(), userID, tc.groupID, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	// The notification of the other group is left unread.
+	count, err := repo.RetrieveUnreadCount(context.Background(), userID, other[0].GroupID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(1), count, fmt.Sprintf("expected unread count 1 got %d\n", count))
+}
+
+func TestRemoveNotificationsByGroup(t *testing.T) {
+	repo := postgres.NewNotificationRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	userID := generateUUID(t)
+
+	ns := saveNotifications(t, repo, groupID, 2)
+	err := repo.MarkAsRead(context.Background(), userID, groupID, ns[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = repo.RemoveByGroup(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove notifications by group: expected nil got %s\n", err))
+
+	page, err := repo.RetrieveByGroup(context.Background(), userID, groupID, inbox.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected total 0 got %d\n", page.Total))
+
+	var reads uint64
+	err = db.Get(&reads, `SELECT COUNT(*) FROM notification_reads WHERE notification_id = $1;`, ns[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), reads, fmt.Sprintf("expected reads of removed notification to be removed got %d\n", reads))
+}
@@ -0,0 +1,171 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package inbox
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+// Service specifies an API that must be fulfilled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// ListNotifications retrieves the notifications of the group identified by
+	// the provided ID, marked as read or unread for the user identified by the
+	// provided token.
+	ListNotifications(ctx context.Context, token, groupID string, pm PageMetadata) (NotificationsPage, error)
+
+	// UnreadCount retrieves the number of the group notifications the user
+	// identified by the provided token has not read yet.
+	UnreadCount(ctx context.Context, token, groupID string) (uint64, error)
+
+	// MarkAsRead marks the group notifications identified by the provided IDs
+	// as read by the user identified by the provided token.
+	MarkAsRead(ctx context.Context, token, groupID string, ids ...string) error
+
+	// Subscribe subscribes the subscriber to the notifications of the group
+	// identified by the provided ID.
+	Subscribe(ctx context.Context, token, groupID string, sub Subscriber) error
+
+	// Unsubscribe removes the subscription of the subscriber to the group
+	// notifications.
+	Unsubscribe(ctx context.Context, groupID string, sub Subscriber) error
+
+	// RemoveNotificationsByGroup removes the notifications of the removed
+	// group identified by the provided ID.
+	RemoveNotificationsByGroup(ctx context.Context, groupID string) error
+
+	consumers.Consumer
+}
+
+var _ Service = (*inboxService)(nil)
+
+type inboxService struct {
+	idp           uuid.IDProvider
+	auth          protomfx.AuthServiceClient
+	things        protomfx.ThingsServiceClient
+	notifications NotificationRepository
+	hub           *hub
+}
+
+// New instantiates the inbox service implementation.
+func New(idp uuid.IDProvider, auth protomfx.AuthServiceClient, things protomfx.ThingsServiceClient, notifications NotificationRepository) Service {
+	return &inboxService{
+		idp:           idp,
+		auth:          auth,
+		things:        things,
+		notifications: notifications,
+		hub:           newHub(),
+	}
+}
+
+func (is *inboxService) Consume(message interface{}) error {
+	ctx := context.Background()
+
+	msg, ok := message.(protomfx.Message)
+	if !ok {
+		return errors.ErrMessage
+	}
+
+	grID, err := is.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: msg.Publisher})
+	if err != nil {
+		return err
+	}
+
+	id, err := is.idp.ID()
+	if err != nil {
+		return err
+	}
+
+	n := Notification{
+		ID:        id,
+		GroupID:   grID.GetValue(),
+		Publisher: msg.Publisher,
+		Subtopic:  msg.Subtopic,
+		Protocol:  msg.Protocol,
+		Payload:   msg.Payload,
+		Created:   msg.Created,
+	}
+
+	// The message is received once for each of the SMTP and SMPP subjects
+	// it is published to, but it is shown in the inbox only once.
+	if err := is.notifications.Save(ctx, n); err != nil {
+		if errors.Contains(err, errors.ErrConflict) {
+			return nil
+		}
+		return err
+	}
+
+	is.hub.publish(n)
+
+	return nil
+}
+
+func (is *inboxService) ListNotifications(ctx context.Context, token, groupID string, pm PageMetadata) (NotificationsPage, error) {
+	userID, err := is.authorize(ctx, token, groupID)
+	if err != nil {
+		return NotificationsPage{}, err
+	}
+
+	return is.notifications.RetrieveByGroup(ctx, userID, groupID, pm)
+}
+
+func (is *inboxService) UnreadCount(ctx context.Context, token, groupID string) (uint64, error) {
+	userID, err := is.authorize(ctx, token, groupID)
+	if err != nil {
+		return 0, err
+	}
+
+	return is.notifications.RetrieveUnreadCount(ctx, userID, groupID)
+}
+
+func (is *inboxService) MarkAsRead(ctx context.Context, token, groupID string, ids ...string) error {
+	userID, err := is.authorize(ctx, token, groupID)
+	if err != nil {
+		return err
+	}
+
+	return is.notifications.MarkAsRead(ctx, userID, groupID, ids...)
+}
+
+func (is *inboxService) Subscribe(ctx context.Context, token, groupID string, sub Subscriber) error {
+	if _, err := is.authorize(ctx, token, groupID); err != nil {
+		return err
+	}
+
+	is.hub.subscribe(groupID, sub)
+
+	return nil
+}
+
+func (is *inboxService) Unsubscribe(_ context.Context, groupID string, sub Subscriber) error {
+	is.hub.unsubscribe(groupID, sub)
+
+	return nil
+}
+
+func (is *inboxService) RemoveNotificationsByGroup(ctx context.Context, groupID string) error {
+	return is.notifications.RemoveByGroup(ctx, groupID)
+}
+
+// authorize checks whether the user identified by the provided token can
+// view the group and returns the user ID.
+func (is *inboxService) authorize(ctx context.Context, token, groupID string) (string, error) {
+	user, err := is.auth.Identify(ctx, &protomfx.Token{Value: token})
+	if err != nil {
+		return "", errors.Wrap(errors.ErrAuthentication, err)
+	}
+
+	req := &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}
+	if _, err := is.things.Authorize(ctx, req); err != nil {
+		return "", errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return user.GetId(), nil
+}
@@ -0,0 +1,316 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package inbox_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	ibmocks "github.com/MainfluxLabs/mainflux/consumers/inbox/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token      = "admin@example.com"
+	otherToken = "user@example.com"
+	userID     = "5a1a9d5a-7a2b-4bd5-9a0c-2c5b2b3f6c11"
+	otherID    = "0b9e2f5c-3a1d-4c6e-8f7a-1d2c3b4a5e6f"
+	groupID    = "9325aef3-5a2b-448c-bae1-5d45f86ba2aa"
+	thingID    = "513d02d2-16c1-4f23-98be-9e12f8fee898"
+	wrongValue = "wrong-value"
+	n          = 10
+)
+
+var payload = []byte(`{"temperature":40}`)
+
+type subscriber struct {
+	notifications chan inbox.Notification
+}
+
+func (s subscriber) Handle(n inbox.Notification) error {
+	s.notifications <- n
+	return nil
+}
+
+func newService() inbox.Service {
+	auth := mocks.NewAuthService("", []users.User{{ID: userID, Email: token}, {ID: otherID, Email: otherToken}})
+	thingsC := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID}, map[string]things.Group{token: {ID: groupID}, otherToken: {ID: groupID}})
+	repo := ibmocks.NewNotificationRepository()
+	idp := uuid.NewMock()
+	return inbox.New(idp, auth, thingsC, repo)
+}
+
+func message(created int64) protomfx.Message {
+	return protomfx.Message{
+		Publisher:     thingID,
+		Protocol:      "http",
+		Payload:       payload,
+		Created:       created,
+		ProfileConfig: &protomfx.Config{SmtpID: "2f1fbd3c-74b1-4a4b-8d6a-13a1a0d6a1f1"},
+	}
+}
+
+func consume(t *testing.T, svc inbox.Service, count int) {
+	t.Helper()
+	for i := 1; i <= count; i++ {
+		err := svc.Consume(message(int64(i)))
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+}
+
+func TestConsume(t *testing.T) {
+	svc := newService()
+
+	invalidPublisher := message(1)
+	invalidPublisher.Publisher = wrongValue
+
+	cases := []struct {
+		desc string
+		msg  interface{}
+		err  error
+	}{
+		{
+			desc: "consume message",
+			msg:  message(1),
+			err:  nil,
+		},
+		{
+			desc: "consume message received over another subject",
+			msg:  message(1),
+			err:  nil,
+		},
+		{
+			desc: "consume message with unknown publisher",
+			msg:  invalidPublisher,
+			err:  errors.ErrNotFound,
+		},
+		{
+			desc: "consume invalid message",
+			msg:  wrongValue,
+			err:  errors.ErrMessage,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.Consume(tc.msg)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	page, err := svc.ListNotifications(context.Background(), token, groupID, inbox.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(1), page.Total, fmt.Sprintf("consume duplicate message: expected 1 notification got %d\n", page.Total))
+}
+
+func TestListNotifications(t *testing.T) {
+	svc := newService()
+	consume(t, svc, n)
+
+	page, err := svc.ListNotifications(context.Background(), token, groupID, inbox.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = svc.MarkAsRead(context.Background(), token, groupID, page.Notifications[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		groupID string
+		pm      inbox.PageMetadata
+		size    int
+		err     error
+	}{
+		{
+			desc:    "list notifications",
+			token:   token,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			err:     nil,
+		},
+		{
+			desc:    "list last notifications",
+			token:   token,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: n - 2, Limit: n},
+			size:    2,
+			err:     nil,
+		},
+		{
+			desc:    "list unread notifications",
+			token:   token,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: n, Unread: true},
+			size:    n - 1,
+			err:     nil,
+		},
+		{
+			desc:    "list unread notifications of another user",
+			token:   otherToken,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: n, Unread: true},
+			size:    n,
+			err:     nil,
+		},
+		{
+			desc:    "list notifications with invalid token",
+			token:   wrongValue,
+			groupID: groupID,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "list notifications of inaccessible group",
+			token:   token,
+			groupID: wrongValue,
+			pm:      inbox.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			err:     errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := svc.ListNotifications(context.Background(), tc.token, tc.groupID, tc.pm)
+		size := len(page.Notifications)
+		assert.Equal(t, tc.size, size, fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.size, size))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestMarkAsRead(t *testing.T) {
+	svc := newService()
+	consume(t, svc, n)
+
+	page, err := svc.ListNotifications(context.Background(), token, groupID, inbox.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	first, second := page.Notifications[0].ID, page.Notifications[1].ID
+
+	cases := []struct {
+		desc    string
+		token   string
+		groupID string
+		ids     []string
+		unread  uint64
+		err     error
+	}{
+		{
+			desc:    "mark notification as read",
+			token:   token,
+			groupID: groupID,
+			ids:     []string{first},
+			unread:  n - 1,
+			err:     nil,
+		},
+		{
+			desc:    "mark already read notification as read",
+			token:   token,
+			groupID: groupID,
+			ids:     []string{first, second},
+			unread:  n - 2,
+			err:     nil,
+		},
+		{
+			desc:    "mark non-existing notification as read",
+			token:   token,
+			groupID: groupID,
+			ids:     []string{wrongValue},
+			unread:  n - 2,
+			err:     nil,
+		},
+		{
+			desc:    "mark notification as read with invalid token",
+			token:   wrongValue,
+			groupID: groupID,
+			ids:     []string{first},
+			unread:  n - 2,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "mark notification of inaccessible group as read",
+			token:   token,
+			groupID: wrongValue,
+			ids:     []string{first},
+			unread:  n - 2,
+			err:     errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.MarkAsRead(context.Background(), tc.token, tc.groupID, tc.ids...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		unread, err := svc.UnreadCount(context.Background(), token, groupID)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		assert.Equal(t, tc.unread, unread, fmt.Sprintf("%s: expected %d unread got %d\n", tc.desc, tc.unread, unread))
+	}
+
+	unread, err := svc.UnreadCount(context.Background(), otherToken, groupID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(n), unread, fmt.Sprintf("unread count of another user: expected %d got %d\n", n, unread))
+}
+
+func TestRemoveNotificationsByGroup(t *testing.T) {
+	svc := newService()
+	consume(t, svc, n)
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove notifications by group",
+			groupID: groupID,
+			err:     nil,
+		},
+		{
+			desc:    "remove notifications by group without notifications",
+			groupID: wrongValue,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveNotificationsByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	page, err := svc.ListNotifications(context.Background(), token, groupID, inbox.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected no notifications got %d\n", page.Total))
+}
+
+func TestSubscribe(t *testing.T) {
+	svc := newService()
+	sub := subscriber{notifications: make(chan inbox.Notification, n)}
+
+	err := svc.Subscribe(context.Background(), wrongValue, groupID, sub)
+	assert.True(t, errors.Contains(err, errors.ErrAuthentication), fmt.Sprintf("subscribe with invalid token: expected %s got %s\n", errors.ErrAuthentication, err))
+
+	err = svc.Subscribe(context.Background(), token, groupID, sub)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	consume(t, svc, 1)
+	select {
+	case nt := <-sub.notifications:
+		assert.Equal(t, payload, nt.Payload, fmt.Sprintf("push notification: expected %s got %s\n", payload, nt.Payload))
+	case <-time.After(time.Second):
+		assert.Fail(t, "push notification: expected notification got none")
+	}
+
+	err = svc.Unsubscribe(context.Background(), groupID, sub)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	consume(t, svc, 2)
+	assert.Len(t, sub.notifications, 0, "push notification after unsubscribe: expected no notifications")
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/inbox"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/opentracing/opentracing-go"
+)
+
+var (
+	_ inbox.NotificationRepository = (*notificationRepositoryMiddleware)(nil)
+)
+
+type notificationRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   inbox.NotificationRepository
+}
+
+// NotificationRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func NotificationRepositoryMiddleware(tracer opentracing.Tracer, repo inbox.NotificationRepository) inbox.NotificationRepository {
+	return notificationRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (n notificationRepositoryMiddleware) Save(ctx context.Context, ntf inbox.Notification) error {
+	span := createSpan(ctx, n.tracer, "save_notification")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return n.repo.Save(ctx, ntf)
+}
+
+func (n notificationRepositoryMiddleware) RetrieveByGroup(ctx context.Context, userID, groupID string, pm inbox.PageMetadata) (inbox.NotificationsPage, error) {
+	span := createSpan(ctx, n.tracer, "retrieve_notifications_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return n.repo.RetrieveByGroup(ctx, userID, groupID, pm)
+}
+
+func (n notificationRepositoryMiddleware) RetrieveUnreadCount(ctx context.Context, userID, groupID string) (uint64, error) {
+	span := createSpan(ctx, n.tracer, "retrieve_unread_count")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return n.repo.RetrieveUnreadCount(ctx, userID, groupID)
+}
+
+func (n notificationRepositoryMiddleware) MarkAsRead(ctx context.Context, userID, groupID string, ids ...string) error {
+	span := createSpan(ctx, n.tracer, "mark_notifications_as_read")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return n.repo.MarkAsRead(ctx, userID, groupID, ids...)
+}
+
+func (n notificationRepositoryMiddleware) RemoveByGroup(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, n.tracer, "remove_notifications_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return n.repo.RemoveByGroup(ctx, groupID)
+}
+
+func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		return tracer.StartSpan(
+			opName,
+			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
+		)
+	}
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
+}
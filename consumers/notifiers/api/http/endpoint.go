@@ -5,6 +5,7 @@ package http
 
 import (
 	"context"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
 	"github.com/MainfluxLabs/mainflux/things"
@@ -159,3 +160,285 @@ func buildNotifierResponse(notifier things.Notifier, updated bool) notifierRespo
 
 	return res
 }
+
+func createWindowsEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createWindowsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		mws := []notifiers.MaintenanceWindow{}
+		for _, wReq := range req.Windows {
+			mws = append(mws, toWindow(wReq))
+		}
+
+		saved, err := svc.CreateMaintenanceWindows(ctx, req.token, req.groupID, mws...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := windowsRes{Windows: []windowRes{}, created: true}
+		for _, mw := range saved {
+			res.Windows = append(res.Windows, buildWindowResponse(mw, false))
+		}
+
+		return res, nil
+	}
+}
+
+func listWindowsByGroupEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listNotifiersReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListMaintenanceWindowsByGroup(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := windowsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+				Order:  req.pageMetadata.Order,
+				Dir:    req.pageMetadata.Dir,
+			},
+			Windows: []windowRes{},
+		}
+		for _, mw := range page.MaintenanceWindows {
+			res.Windows = append(res.Windows, buildWindowResponse(mw, false))
+		}
+
+		return res, nil
+	}
+}
+
+func viewWindowEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(notifierReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		mw, err := svc.ViewMaintenanceWindow(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildWindowResponse(mw, false), nil
+	}
+}
+
+func updateWindowEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateWindowReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		mw := toWindow(req.createWindowReq)
+		mw.ID = req.id
+
+		if err := svc.UpdateMaintenanceWindow(ctx, req.token, mw); err != nil {
+			return nil, err
+		}
+
+		return windowRes{updated: true}, nil
+	}
+}
+
+func removeWindowsEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeWindowsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveMaintenanceWindows(ctx, req.token, req.WindowIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func toWindow(req createWindowReq) notifiers.MaintenanceWindow {
+	return notifiers.MaintenanceWindow{
+		ThingID:    req.ThingID,
+		Name:       req.Name,
+		Start:      req.Start,
+		End:        req.End,
+		Recurrence: req.Recurrence,
+		Metadata:   req.Metadata,
+	}
+}
+
+func buildWindowResponse(mw notifiers.MaintenanceWindow, updated bool) windowRes {
+	return windowRes{
+		ID:         mw.ID,
+		GroupID:    mw.GroupID,
+		ThingID:    mw.ThingID,
+		Name:       mw.Name,
+		Start:      mw.Start,
+		End:        mw.End,
+		Recurrence: mw.Recurrence,
+		Metadata:   mw.Metadata,
+		updated:    updated,
+	}
+}
+
+func createSchedulesEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createSchedulesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ocs := []notifiers.OnCallSchedule{}
+		for _, sReq := range req.Schedules {
+			ocs = append(ocs, toSchedule(sReq))
+		}
+
+		saved, err := svc.CreateOnCallSchedules(ctx, req.token, req.groupID, ocs...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := schedulesRes{Schedules: []scheduleRes{}, created: true}
+		for _, s := range saved {
+			res.Schedules = append(res.Schedules, buildScheduleResponse(s, false))
+		}
+
+		return res, nil
+	}
+}
+
+func listSchedulesByGroupEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listNotifiersReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListOnCallSchedulesByGroup(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := schedulesPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+				Order:  req.pageMetadata.Order,
+				Dir:    req.pageMetadata.Dir,
+			},
+			Schedules: []scheduleRes{},
+		}
+		for _, s := range page.OnCallSchedules {
+			res.Schedules = append(res.Schedules, buildScheduleResponse(s, false))
+		}
+
+		return res, nil
+	}
+}
+
+func viewScheduleEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(notifierReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		s, err := svc.ViewOnCallSchedule(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildScheduleResponse(s, false), nil
+	}
+}
+
+func updateScheduleEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateScheduleReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		s := toSchedule(req.createScheduleReq)
+		s.ID = req.id
+
+		if err := svc.UpdateOnCallSchedule(ctx, req.token, s); err != nil {
+			return nil, err
+		}
+
+		return scheduleRes{updated: true}, nil
+	}
+}
+
+func removeSchedulesEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeSchedulesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveOnCallSchedules(ctx, req.token, req.ScheduleIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func exportScheduleEndpoint(svc notifiers.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exportScheduleReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		s, err := svc.ViewOnCallSchedule(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		content := encodeICal(s, s.Shifts(req.from, req.to), time.Now())
+		return icalRes{name: s.ID, content: content}, nil
+	}
+}
+
+func toSchedule(req createScheduleReq) notifiers.OnCallSchedule {
+	return notifiers.OnCallSchedule{
+		NotifierID: req.NotifierID,
+		Name:       req.Name,
+		Start:      req.Start,
+		Rotation:   req.Rotation,
+		Contacts:   req.Contacts,
+		Overrides:  req.Overrides,
+		Metadata:   req.Metadata,
+	}
+}
+
+func buildScheduleResponse(s notifiers.OnCallSchedule, updated bool) scheduleRes {
+	onCall, _ := s.OnCall(time.Now())
+
+	return scheduleRes{
+		ID:         s.ID,
+		GroupID:    s.GroupID,
+		NotifierID: s.NotifierID,
+		Name:       s.Name,
+		Start:      s.Start,
+		Rotation:   s.Rotation,
+		Contacts:   s.Contacts,
+		Overrides:  s.Overrides,
+		OnCall:     onCall,
+		Metadata:   s.Metadata,
+		updated:    updated,
+	}
+}
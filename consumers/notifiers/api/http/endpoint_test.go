@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
 	httpapi "github.com/MainfluxLabs/mainflux/consumers/notifiers/api/http"
@@ -67,7 +68,7 @@ func newService() notifiers.Service {
 	notifier := ntmocks.NewNotifier()
 	notifierRepo := ntmocks.NewNotifierRepository()
 	idp := uuid.NewMock()
-	return notifiers.New(idp, notifier, notifierRepo, things)
+	return notifiers.New(idp, notifier, notifierRepo, ntmocks.NewMaintenanceWindowRepository(), ntmocks.NewOnCallScheduleRepository(), things)
 }
 
 type testRequest struct {
@@ -730,3 +731,360 @@ func runRemoveNotifiersTest(t *testing.T, validContacts []string) {
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
 	}
 }
+
+func TestCreateMaintenanceWindows(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	validData := `[{"name":"maintenance","start":"2024-03-04T22:00:00Z","end":"2024-03-05T00:00:00Z","recurrence":"weekly"}]`
+	missingTimeData := `[{"name":"maintenance","start":"2024-03-04T22:00:00Z"}]`
+	invalidRangeData := `[{"name":"maintenance","start":"2024-03-05T00:00:00Z","end":"2024-03-04T22:00:00Z"}]`
+	invalidRecurrenceData := `[{"name":"maintenance","start":"2024-03-04T22:00:00Z","end":"2024-03-05T00:00:00Z","recurrence":"yearly"}]`
+	invalidNameData := `[{"name":"","start":"2024-03-04T22:00:00Z","end":"2024-03-05T00:00:00Z"}]`
+
+	cases := []struct {
+		desc        string
+		data        string
+		groupID     string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create valid maintenance windows",
+			data:        validData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create maintenance windows without end time",
+			data:        missingTimeData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create maintenance windows ending before they start",
+			data:        invalidRangeData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create maintenance windows with invalid recurrence",
+			data:        invalidRecurrenceData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create maintenance windows with invalid name",
+			data:        invalidNameData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create maintenance windows with empty JSON array",
+			data:        "[]",
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create maintenance windows with wrong auth token",
+			data:        validData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create maintenance windows without content type",
+			data:        validData,
+			groupID:     groupID,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/maintenance-windows", ts.URL, tc.groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestRemoveMaintenanceWindows(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	now := time.Now()
+	mws, err := svc.CreateMaintenanceWindows(context.Background(), token, groupID, notifiers.MaintenanceWindow{Name: "maintenance", Start: now, End: now.Add(time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc        string
+		data        []string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "remove existing maintenance windows",
+			data:        []string{mws[0].ID},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "remove non-existent maintenance windows",
+			data:        []string{wrongValue},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "remove maintenance windows with empty token",
+			data:        []string{mws[0].ID},
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "remove maintenance windows without IDs",
+			data:        []string{},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		data := struct {
+			WindowIDs []string `json:"maintenance_window_ids"`
+		}{
+			tc.data,
+		}
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/maintenance-windows", ts.URL),
+			token:       tc.auth,
+			contentType: tc.contentType,
+			body:        strings.NewReader(toJSON(data)),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestCreateOnCallSchedules(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	nfs, err := svc.CreateNotifiers(context.Background(), token, things.Notifier{GroupID: groupID, Name: notifierName, Contacts: validEmails})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	nfID := nfs[0].ID
+
+	validData := fmt.Sprintf(`[{"notifier_id":"%s","name":"on-call","start":"2024-03-04T09:00:00Z","rotation":"weekly","contacts":["user1@example.com","user2@example.com"],"overrides":[{"contact":"user2@example.com","start":"2024-03-05T09:00:00Z","end":"2024-03-06T09:00:00Z"}]}]`, nfID)
+	missingStartData := fmt.Sprintf(`[{"notifier_id":"%s","name":"on-call","rotation":"weekly","contacts":["user1@example.com"]}]`, nfID)
+	missingNotifierData := `[{"name":"on-call","start":"2024-03-04T09:00:00Z","rotation":"weekly","contacts":["user1@example.com"]}]`
+	missingContactsData := fmt.Sprintf(`[{"notifier_id":"%s","name":"on-call","start":"2024-03-04T09:00:00Z","rotation":"weekly"}]`, nfID)
+	invalidRotationData := fmt.Sprintf(`[{"notifier_id":"%s","name":"on-call","start":"2024-03-04T09:00:00Z","rotation":"monthly","contacts":["user1@example.com"]}]`, nfID)
+	invalidContactsData := fmt.Sprintf(`[{"notifier_id":"%s","name":"on-call","start":"2024-03-04T09:00:00Z","rotation":"weekly","contacts":["invalid@example.com"]}]`, nfID)
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create valid on-call schedules",
+			data:        validData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create on-call schedules without start time",
+			data:        missingStartData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create on-call schedules without notifier",
+			data:        missingNotifierData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create on-call schedules without contacts",
+			data:        missingContactsData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create on-call schedules with invalid rotation",
+			data:        invalidRotationData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create on-call schedules with invalid contacts",
+			data:        invalidContactsData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create on-call schedules with empty JSON array",
+			data:        "[]",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create on-call schedules with wrong auth token",
+			data:        validData,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create on-call schedules without content type",
+			data:        validData,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/on-call-schedules", ts.URL, groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestExportOnCallSchedule(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	nfs, err := svc.CreateNotifiers(context.Background(), token, things.Notifier{GroupID: groupID, Name: notifierName, Contacts: validEmails})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	start := time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC)
+	ocs, err := svc.CreateOnCallSchedules(context.Background(), token, groupID, notifiers.OnCallSchedule{NotifierID: nfs[0].ID, Name: "on-call", Start: start, Rotation: notifiers.Daily, Contacts: validEmails})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	id := ocs[0].ID
+
+	from := start.Unix()
+	to := start.Add(48 * time.Hour).Unix()
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		query  string
+		status int
+		events []string
+	}{
+		{
+			desc:   "export on-call schedule",
+			id:     id,
+			auth:   token,
+			query:  fmt.Sprintf("from=%d&to=%d", from, to),
+			status: http.StatusOK,
+			events: []string{
+				"DTSTART:20240304T090000Z\r\nDTEND:20240305T090000Z\r\nSUMMARY:On call: user1@example.com",
+				"DTSTART:20240305T090000Z\r\nDTEND:20240306T090000Z\r\nSUMMARY:On call: user2@example.com",
+			},
+		},
+		{
+			desc:   "export on-call schedule with invalid time range",
+			id:     id,
+			auth:   token,
+			query:  fmt.Sprintf("from=%d&to=%d", to, from),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export on-call schedule with invalid query",
+			id:     id,
+			auth:   token,
+			query:  "from=invalid",
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export non-existing on-call schedule",
+			id:     wrongValue,
+			auth:   token,
+			query:  fmt.Sprintf("from=%d&to=%d", from, to),
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "export on-call schedule with wrong auth token",
+			id:     id,
+			auth:   wrongValue,
+			query:  fmt.Sprintf("from=%d&to=%d", from, to),
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/on-call-schedules/%s/ical?%s", ts.URL, tc.id, tc.query),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		for _, event := range tc.events {
+			assert.Contains(t, string(body), event, fmt.Sprintf("%s: expected event %q", tc.desc, event))
+		}
+		if tc.status == http.StatusOK {
+			assert.Equal(t, 2, strings.Count(string(body), "BEGIN:VEVENT"), fmt.Sprintf("%s: expected 2 events", tc.desc))
+		}
+	}
+}
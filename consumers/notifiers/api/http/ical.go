@@ -0,0 +1,63 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+)
+
+const (
+	icalTimeFormat = "20060102T150405Z"
+	icalLineLen    = 75
+)
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// encodeICal encodes the shifts of the on-call schedule as the iCalendar
+// (RFC 5545) events, so that the schedule can be subscribed to from the
+// calendar applications.
+func encodeICal(s notifiers.OnCallSchedule, shifts []notifiers.Shift, now time.Time) []byte {
+	var buf bytes.Buffer
+	writeICalLine(&buf, "BEGIN:VCALENDAR")
+	writeICalLine(&buf, "VERSION:2.0")
+	writeICalLine(&buf, "PRODID:-//MainfluxLabs//Mainflux Notifiers//EN")
+	writeICalLine(&buf, "CALSCALE:GREGORIAN")
+	writeICalLine(&buf, "X-WR-CALNAME:"+icalEscaper.Replace(s.Name))
+
+	stamp := now.UTC().Format(icalTimeFormat)
+	for _, sh := range shifts {
+		writeICalLine(&buf, "BEGIN:VEVENT")
+		writeICalLine(&buf, fmt.Sprintf("UID:%s-%d@mainflux", s.ID, sh.Start.Unix()))
+		writeICalLine(&buf, "DTSTAMP:"+stamp)
+		writeICalLine(&buf, "DTSTART:"+sh.Start.UTC().Format(icalTimeFormat))
+		writeICalLine(&buf, "DTEND:"+sh.End.UTC().Format(icalTimeFormat))
+		writeICalLine(&buf, "SUMMARY:"+icalEscaper.Replace("On call: "+sh.Contact))
+		writeICalLine(&buf, "END:VEVENT")
+	}
+	writeICalLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// writeICalLine writes the content line, folded to the lines of at most 75
+// octets which are continued by the lines starting with the space.
+func writeICalLine(buf *bytes.Buffer, line string) {
+	for len(line) > icalLineLen {
+		n := icalLineLen
+		// Don't split the multi-byte characters.
+		for n > 0 && line[n]&0xC0 == 0x80 {
+			n--
+		}
+		buf.WriteString(line[:n])
+		buf.WriteString("\r\n ")
+		line = line[n:]
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -4,7 +4,11 @@
 package http
 
 import (
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/things"
 )
 
@@ -18,6 +22,19 @@ const (
 	descDir      = "desc"
 )
 
+const maxExportRange = 366 * 24 * time.Hour
+
+var (
+	// ErrMissingWindowTime indicates the missing maintenance window start or end time.
+	ErrMissingWindowTime = errors.New("missing maintenance window start or end time")
+
+	// ErrMissingScheduleStart indicates the missing on-call schedule start time.
+	ErrMissingScheduleStart = errors.New("missing on-call schedule start time")
+
+	// ErrMissingNotifierID indicates the missing on-call schedule notifier ID.
+	ErrMissingNotifierID = errors.New("missing on-call schedule notifier id")
+)
+
 type apiReq interface {
 	validate() error
 }
@@ -165,3 +182,215 @@ func (req removeNotifiersReq) validate() error {
 
 	return nil
 }
+
+type createWindowReq struct {
+	ThingID    string                 `json:"thing_id,omitempty"`
+	Name       string                 `json:"name"`
+	Start      time.Time              `json:"start"`
+	End        time.Time              `json:"end"`
+	Recurrence string                 `json:"recurrence,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createWindowReq) validate() error {
+	if req.Name == "" || len(req.Name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if req.Start.IsZero() || req.End.IsZero() {
+		return ErrMissingWindowTime
+	}
+
+	return nil
+}
+
+type createWindowsReq struct {
+	token   string
+	groupID string
+	Windows []createWindowReq `json:"maintenance_windows"`
+}
+
+func (req createWindowsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Windows) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, w := range req.Windows {
+		if err := w.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type updateWindowReq struct {
+	token string
+	id    string
+	createWindowReq
+}
+
+func (req updateWindowReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return req.createWindowReq.validate()
+}
+
+type removeWindowsReq struct {
+	token     string
+	WindowIDs []string `json:"maintenance_window_ids,omitempty"`
+}
+
+func (req removeWindowsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.WindowIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.WindowIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type createScheduleReq struct {
+	NotifierID string                 `json:"notifier_id"`
+	Name       string                 `json:"name"`
+	Start      time.Time              `json:"start"`
+	Rotation   string                 `json:"rotation"`
+	Contacts   []string               `json:"contacts"`
+	Overrides  []notifiers.Override   `json:"overrides,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createScheduleReq) validate() error {
+	if req.Name == "" || len(req.Name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if req.NotifierID == "" {
+		return ErrMissingNotifierID
+	}
+
+	if req.Start.IsZero() {
+		return ErrMissingScheduleStart
+	}
+
+	if len(req.Contacts) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	return nil
+}
+
+type createSchedulesReq struct {
+	token     string
+	groupID   string
+	Schedules []createScheduleReq `json:"on_call_schedules"`
+}
+
+func (req createSchedulesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Schedules) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, s := range req.Schedules {
+		if err := s.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type updateScheduleReq struct {
+	token string
+	id    string
+	createScheduleReq
+}
+
+func (req updateScheduleReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return req.createScheduleReq.validate()
+}
+
+type removeSchedulesReq struct {
+	token       string
+	ScheduleIDs []string `json:"on_call_schedule_ids,omitempty"`
+}
+
+func (req removeSchedulesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.ScheduleIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.ScheduleIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type exportScheduleReq struct {
+	token string
+	id    string
+	from  time.Time
+	to    time.Time
+}
+
+func (req exportScheduleReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if !req.to.After(req.from) || req.to.Sub(req.from) > maxExportRange {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	return nil
+}
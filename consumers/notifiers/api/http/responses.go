@@ -4,7 +4,11 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
 )
 
 type notifierResponse struct {
@@ -88,3 +92,148 @@ func (res NotifiersPageRes) Headers() map[string]string {
 func (res NotifiersPageRes) Empty() bool {
 	return false
 }
+
+type windowRes struct {
+	ID         string                 `json:"id"`
+	GroupID    string                 `json:"group_id"`
+	ThingID    string                 `json:"thing_id,omitempty"`
+	Name       string                 `json:"name"`
+	Start      time.Time              `json:"start"`
+	End        time.Time              `json:"end"`
+	Recurrence string                 `json:"recurrence,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	updated    bool
+}
+
+func (res windowRes) Code() int {
+	return http.StatusOK
+}
+
+func (res windowRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res windowRes) Empty() bool {
+	return res.updated
+}
+
+type windowsRes struct {
+	Windows []windowRes `json:"maintenance_windows"`
+	created bool
+}
+
+func (res windowsRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res windowsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res windowsRes) Empty() bool {
+	return false
+}
+
+type windowsPageRes struct {
+	pageRes
+	Windows []windowRes `json:"maintenance_windows"`
+}
+
+func (res windowsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res windowsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res windowsPageRes) Empty() bool {
+	return false
+}
+
+type scheduleRes struct {
+	ID         string                 `json:"id"`
+	GroupID    string                 `json:"group_id"`
+	NotifierID string                 `json:"notifier_id"`
+	Name       string                 `json:"name"`
+	Start      time.Time              `json:"start"`
+	Rotation   string                 `json:"rotation"`
+	Contacts   []string               `json:"contacts"`
+	Overrides  []notifiers.Override   `json:"overrides,omitempty"`
+	OnCall     string                 `json:"on_call,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
+	updated    bool
+}
+
+func (res scheduleRes) Code() int {
+	return http.StatusOK
+}
+
+func (res scheduleRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res scheduleRes) Empty() bool {
+	return res.updated
+}
+
+type schedulesRes struct {
+	Schedules []scheduleRes `json:"on_call_schedules"`
+	created   bool
+}
+
+func (res schedulesRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res schedulesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res schedulesRes) Empty() bool {
+	return false
+}
+
+type schedulesPageRes struct {
+	pageRes
+	Schedules []scheduleRes `json:"on_call_schedules"`
+}
+
+func (res schedulesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res schedulesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res schedulesPageRes) Empty() bool {
+	return false
+}
+
+type icalRes struct {
+	name    string
+	content []byte
+}
+
+func (res icalRes) Code() int {
+	return http.StatusOK
+}
+
+func (res icalRes) Headers() map[string]string {
+	return map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s.ics"`, res.name),
+	}
+}
+
+func (res icalRes) Empty() bool {
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux"
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
@@ -23,14 +24,18 @@ import (
 )
 
 const (
-	contentType = "application/json"
-	idKey       = "id"
-	offsetKey   = "offset"
-	limitKey    = "limit"
-	orderKey    = "order"
-	dirKey      = "dir"
-	defOffset   = 0
-	defLimit    = 10
+	contentType     = "application/json"
+	icalContentType = "text/calendar"
+	idKey           = "id"
+	offsetKey       = "offset"
+	limitKey        = "limit"
+	orderKey        = "order"
+	dirKey          = "dir"
+	fromKey         = "from"
+	toKey           = "to"
+	defOffset       = 0
+	defLimit        = 10
+	defExportRange  = 28 * 24 * time.Hour
 )
 
 // MakeHandler returns a HTTP handler for API endpoints.
@@ -73,6 +78,74 @@ func MakeHandler(tracer opentracing.Tracer, svc notifiers.Service, logger log.Lo
 		opts...,
 	))
 
+	r.Post("/groups/:id/maintenance-windows", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_maintenance_windows")(createWindowsEndpoint(svc)),
+		decodeCreateWindows,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/maintenance-windows", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_maintenance_windows_by_group")(listWindowsByGroupEndpoint(svc)),
+		decodeListNotifiers,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/maintenance-windows/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_maintenance_window")(viewWindowEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/maintenance-windows/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_maintenance_window")(updateWindowEndpoint(svc)),
+		decodeUpdateWindow,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/maintenance-windows", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_maintenance_windows")(removeWindowsEndpoint(svc)),
+		decodeRemoveWindows,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Post("/groups/:id/on-call-schedules", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_on_call_schedules")(createSchedulesEndpoint(svc)),
+		decodeCreateSchedules,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/on-call-schedules", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_on_call_schedules_by_group")(listSchedulesByGroupEndpoint(svc)),
+		decodeListNotifiers,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/on-call-schedules/:id/ical", kithttp.NewServer(
+		kitot.TraceServer(tracer, "export_on_call_schedule")(exportScheduleEndpoint(svc)),
+		decodeExportSchedule,
+		encodeICalResponse,
+		opts...,
+	))
+	r.Get("/on-call-schedules/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_on_call_schedule")(viewScheduleEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/on-call-schedules/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_on_call_schedule")(updateScheduleEndpoint(svc)),
+		decodeUpdateSchedule,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/on-call-schedules", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_on_call_schedules")(removeSchedulesEndpoint(svc)),
+		decodeRemoveSchedules,
+		encodeResponse,
+		opts...,
+	))
+
 	r.GetFunc("/health", mainflux.Health("notifiers"))
 	r.Handle("/metrics", promhttp.Handler())
 
@@ -165,6 +238,134 @@ func decodeRemoveNotifiers(_ context.Context, r *http.Request) (interface{}, err
 	return req, nil
 }
 
+func decodeCreateWindows(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createWindowsReq{token: apiutil.ExtractBearerToken(r), groupID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Windows); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateWindow(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateWindowReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveWindows(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeWindowsReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeCreateSchedules(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createSchedulesReq{token: apiutil.ExtractBearerToken(r), groupID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Schedules); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateSchedule(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateScheduleReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveSchedules(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeSchedulesReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeExportSchedule(_ context.Context, r *http.Request) (interface{}, error) {
+	now := time.Now().Unix()
+	from, err := apiutil.ReadIntQuery(r, fromKey, now)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := apiutil.ReadIntQuery(r, toKey, from+int64(defExportRange.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+
+	req := exportScheduleReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+		from:  time.Unix(from, 0),
+		to:    time.Unix(to, 0),
+	}
+
+	return req, nil
+}
+
+func encodeICalResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", icalContentType)
+
+	if ar, ok := response.(icalRes); ok {
+		for k, v := range ar.Headers() {
+			w.Header().Set(k, v)
+		}
+
+		w.WriteHeader(ar.Code())
+
+		if _, err := w.Write(ar.content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", contentType)
 
@@ -183,7 +384,7 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, errors.ErrNotFound):
 		w.WriteHeader(http.StatusNotFound)
@@ -204,7 +405,12 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrInvalidOrder,
 		err == apiutil.ErrInvalidDirection,
 		err == apiutil.ErrNameSize,
-		err == apiutil.ErrInvalidContact:
+		err == apiutil.ErrInvalidContact,
+		err == ErrMissingWindowTime,
+		err == ErrMissingScheduleStart,
+		err == ErrMissingNotifierID,
+		errors.Contains(err, notifiers.ErrInvalidWindow),
+		errors.Contains(err, notifiers.ErrInvalidSchedule):
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
@@ -219,7 +425,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
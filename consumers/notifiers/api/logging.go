@@ -31,10 +31,10 @@ func (lm *loggingMiddleware) CreateNotifiers(ctx context.Context, token string,
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method create_notifiers for notifiers %s took %s to complete", response, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateNotifiers(ctx, token, notifiers...)
@@ -44,10 +44,10 @@ func (lm *loggingMiddleware) ListNotifiersByGroup(ctx context.Context, token str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_notifiers_by_group for id %s took %s to complete", groupID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListNotifiersByGroup(ctx, token, groupID, pm)
@@ -57,10 +57,10 @@ func (lm *loggingMiddleware) ViewNotifier(ctx context.Context, token, id string)
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_notifier for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewNotifier(ctx, token, id)
@@ -70,10 +70,10 @@ func (lm *loggingMiddleware) UpdateNotifier(ctx context.Context, token string, n
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_notifier for id %s took %s to complete", notifier.ID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateNotifier(ctx, token, notifier)
@@ -83,15 +83,158 @@ func (lm *loggingMiddleware) RemoveNotifiers(ctx context.Context, token string,
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_notifiers took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveNotifiers(ctx, token, id...)
 }
 
+func (lm *loggingMiddleware) CreateMaintenanceWindows(ctx context.Context, token, groupID string, windows ...notifiers.MaintenanceWindow) (response []notifiers.MaintenanceWindow, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_maintenance_windows for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateMaintenanceWindows(ctx, token, groupID, windows...)
+}
+
+func (lm *loggingMiddleware) ListMaintenanceWindowsByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (res notifiers.MaintenanceWindowsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_maintenance_windows_by_group for id %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListMaintenanceWindowsByGroup(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) ViewMaintenanceWindow(ctx context.Context, token, id string) (response notifiers.MaintenanceWindow, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_maintenance_window for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewMaintenanceWindow(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateMaintenanceWindow(ctx context.Context, token string, window notifiers.MaintenanceWindow) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_maintenance_window for id %s took %s to complete", window.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateMaintenanceWindow(ctx, token, window)
+}
+
+func (lm *loggingMiddleware) RemoveMaintenanceWindows(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_maintenance_windows took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveMaintenanceWindows(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) CreateOnCallSchedules(ctx context.Context, token, groupID string, schedules ...notifiers.OnCallSchedule) (response []notifiers.OnCallSchedule, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_on_call_schedules for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateOnCallSchedules(ctx, token, groupID, schedules...)
+}
+
+func (lm *loggingMiddleware) ListOnCallSchedulesByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (res notifiers.OnCallSchedulesPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_on_call_schedules_by_group for id %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListOnCallSchedulesByGroup(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) ViewOnCallSchedule(ctx context.Context, token, id string) (response notifiers.OnCallSchedule, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_on_call_schedule for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewOnCallSchedule(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateOnCallSchedule(ctx context.Context, token string, schedule notifiers.OnCallSchedule) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_on_call_schedule for id %s took %s to complete", schedule.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateOnCallSchedule(ctx, token, schedule)
+}
+
+func (lm *loggingMiddleware) RemoveOnCallSchedules(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_on_call_schedules took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveOnCallSchedules(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveNotifiersByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_notifiers_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveNotifiersByGroup(ctx, groupID)
+}
+
 func (lm *loggingMiddleware) Consume(msg interface{}) (err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method consume took %s to complete", time.Since(begin))
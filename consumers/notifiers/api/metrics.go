@@ -76,6 +76,105 @@ func (ms *metricsMiddleware) RemoveNotifiers(ctx context.Context, token string,
 	return ms.svc.RemoveNotifiers(ctx, token, id...)
 }
 
+func (ms *metricsMiddleware) CreateMaintenanceWindows(ctx context.Context, token, groupID string, windows ...notifiers.MaintenanceWindow) ([]notifiers.MaintenanceWindow, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_maintenance_windows").Add(1)
+		ms.latency.With("method", "create_maintenance_windows").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateMaintenanceWindows(ctx, token, groupID, windows...)
+}
+
+func (ms *metricsMiddleware) ListMaintenanceWindowsByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (notifiers.MaintenanceWindowsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_maintenance_windows_by_group").Add(1)
+		ms.latency.With("method", "list_maintenance_windows_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListMaintenanceWindowsByGroup(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) ViewMaintenanceWindow(ctx context.Context, token, id string) (notifiers.MaintenanceWindow, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_maintenance_window").Add(1)
+		ms.latency.With("method", "view_maintenance_window").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewMaintenanceWindow(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateMaintenanceWindow(ctx context.Context, token string, window notifiers.MaintenanceWindow) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_maintenance_window").Add(1)
+		ms.latency.With("method", "update_maintenance_window").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateMaintenanceWindow(ctx, token, window)
+}
+
+func (ms *metricsMiddleware) RemoveMaintenanceWindows(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_maintenance_windows").Add(1)
+		ms.latency.With("method", "remove_maintenance_windows").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveMaintenanceWindows(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) CreateOnCallSchedules(ctx context.Context, token, groupID string, schedules ...notifiers.OnCallSchedule) ([]notifiers.OnCallSchedule, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_on_call_schedules").Add(1)
+		ms.latency.With("method", "create_on_call_schedules").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateOnCallSchedules(ctx, token, groupID, schedules...)
+}
+
+func (ms *metricsMiddleware) ListOnCallSchedulesByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (notifiers.OnCallSchedulesPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_on_call_schedules_by_group").Add(1)
+		ms.latency.With("method", "list_on_call_schedules_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListOnCallSchedulesByGroup(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) ViewOnCallSchedule(ctx context.Context, token, id string) (notifiers.OnCallSchedule, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_on_call_schedule").Add(1)
+		ms.latency.With("method", "view_on_call_schedule").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewOnCallSchedule(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateOnCallSchedule(ctx context.Context, token string, schedule notifiers.OnCallSchedule) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_on_call_schedule").Add(1)
+		ms.latency.With("method", "update_on_call_schedule").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateOnCallSchedule(ctx, token, schedule)
+}
+
+func (ms *metricsMiddleware) RemoveOnCallSchedules(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_on_call_schedules").Add(1)
+		ms.latency.With("method", "remove_on_call_schedules").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveOnCallSchedules(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveNotifiersByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_notifiers_by_group").Add(1)
+		ms.latency.With("method", "remove_notifiers_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveNotifiersByGroup(ctx, groupID)
+}
+
 func (ms *metricsMiddleware) Consume(msg interface{}) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "consume").Add(1)
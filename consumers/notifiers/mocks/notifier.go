@@ -145,3 +145,16 @@ func (nrm *notifierRepositoryMock) Remove(_ context.Context, ids ...string) erro
 
 	return nil
 }
+
+func (nrm *notifierRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) error {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	for id, nf := range nrm.notifiers {
+		if nf.GroupID == groupID {
+			delete(nrm.notifiers, id)
+		}
+	}
+
+	return nil
+}
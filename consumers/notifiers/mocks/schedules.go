@@ -0,0 +1,136 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	notifiers "github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ notifiers.OnCallScheduleRepository = (*scheduleRepositoryMock)(nil)
+
+type scheduleRepositoryMock struct {
+	mu        sync.Mutex
+	schedules map[string]notifiers.OnCallSchedule
+}
+
+// NewOnCallScheduleRepository returns a new OnCallScheduleRepository mock.
+func NewOnCallScheduleRepository() notifiers.OnCallScheduleRepository {
+	return &scheduleRepositoryMock{schedules: make(map[string]notifiers.OnCallSchedule)}
+}
+
+func (srm *scheduleRepositoryMock) Save(_ context.Context, schedules ...notifiers.OnCallSchedule) ([]notifiers.OnCallSchedule, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for _, s := range schedules {
+		for _, sch := range srm.schedules {
+			if sch.GroupID == s.GroupID && sch.Name == s.Name {
+				return []notifiers.OnCallSchedule{}, errors.ErrConflict
+			}
+		}
+
+		srm.schedules[s.ID] = s
+	}
+
+	return schedules, nil
+}
+
+func (srm *scheduleRepositoryMock) RetrieveByGroupID(_ context.Context, groupID string, pm things.PageMetadata) (notifiers.OnCallSchedulesPage, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+	var items []notifiers.OnCallSchedule
+
+	first := uint64(pm.Offset) + 1
+	last := first + uint64(pm.Limit)
+
+	for _, s := range srm.schedules {
+		if s.GroupID == groupID {
+			id := uuid.ParseID(s.ID)
+			if id >= first && id < last || pm.Limit == 0 {
+				items = append(items, s)
+			}
+		}
+	}
+
+	return notifiers.OnCallSchedulesPage{
+		OnCallSchedules: items,
+		PageMetadata: things.PageMetadata{
+			Total:  uint64(len(items)),
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (srm *scheduleRepositoryMock) RetrieveByNotifierID(_ context.Context, notifierID string) ([]notifiers.OnCallSchedule, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	var items []notifiers.OnCallSchedule
+	for _, s := range srm.schedules {
+		if s.NotifierID == notifierID {
+			items = append(items, s)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+
+	return items, nil
+}
+
+func (srm *scheduleRepositoryMock) RetrieveByID(_ context.Context, id string) (notifiers.OnCallSchedule, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	if s, ok := srm.schedules[id]; ok {
+		return s, nil
+	}
+
+	return notifiers.OnCallSchedule{}, errors.ErrNotFound
+}
+
+func (srm *scheduleRepositoryMock) Update(_ context.Context, s notifiers.OnCallSchedule) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	if _, ok := srm.schedules[s.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	srm.schedules[s.ID] = s
+
+	return nil
+}
+
+func (srm *scheduleRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := srm.schedules[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(srm.schedules, id)
+	}
+
+	return nil
+}
+
+func (srm *scheduleRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for id, s := range srm.schedules {
+		if s.GroupID == groupID {
+			delete(srm.schedules, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	notifiers "github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ notifiers.MaintenanceWindowRepository = (*windowRepositoryMock)(nil)
+
+type windowRepositoryMock struct {
+	mu      sync.Mutex
+	windows map[string]notifiers.MaintenanceWindow
+}
+
+// NewMaintenanceWindowRepository returns a new MaintenanceWindowRepository mock.
+func NewMaintenanceWindowRepository() notifiers.MaintenanceWindowRepository {
+	return &windowRepositoryMock{windows: make(map[string]notifiers.MaintenanceWindow)}
+}
+
+func (wrm *windowRepositoryMock) Save(_ context.Context, mws ...notifiers.MaintenanceWindow) ([]notifiers.MaintenanceWindow, error) {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+
+	for _, mw := range mws {
+		for _, w := range wrm.windows {
+			if w.GroupID == mw.GroupID && w.Name == mw.Name {
+				return []notifiers.MaintenanceWindow{}, errors.ErrConflict
+			}
+		}
+
+		wrm.windows[mw.ID] = mw
+	}
+
+	return mws, nil
+}
+
+func (wrm *windowRepositoryMock) RetrieveByGroupID(_ context.Context, groupID string, pm things.PageMetadata) (notifiers.MaintenanceWindowsPage, error) {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+	var items []notifiers.MaintenanceWindow
+
+	first := uint64(pm.Offset) + 1
+	last := first + uint64(pm.Limit)
+
+	for _, mw := range wrm.windows {
+		if mw.GroupID == groupID {
+			id := uuid.ParseID(mw.ID)
+			if id >= first && id < last || pm.Limit == 0 {
+				items = append(items, mw)
+			}
+		}
+	}
+
+	return notifiers.MaintenanceWindowsPage{
+		MaintenanceWindows: items,
+		PageMetadata: things.PageMetadata{
+			Total:  uint64(len(items)),
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (wrm *windowRepositoryMock) RetrieveByID(_ context.Context, id string) (notifiers.MaintenanceWindow, error) {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+
+	if mw, ok := wrm.windows[id]; ok {
+		return mw, nil
+	}
+
+	return notifiers.MaintenanceWindow{}, errors.ErrNotFound
+}
+
+func (wrm *windowRepositoryMock) Update(_ context.Context, mw notifiers.MaintenanceWindow) error {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+
+	if _, ok := wrm.windows[mw.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	wrm.windows[mw.ID] = mw
+
+	return nil
+}
+
+func (wrm *windowRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := wrm.windows[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(wrm.windows, id)
+	}
+
+	return nil
+}
+
+func (wrm *windowRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) error {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+
+	for id, mw := range wrm.windows {
+		if mw.GroupID == groupID {
+			delete(wrm.windows, id)
+		}
+	}
+
+	return nil
+}
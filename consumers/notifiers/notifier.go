@@ -44,4 +44,7 @@ type NotifierRepository interface {
 
 	// Remove removes the notifiers having the provided identifiers
 	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the notifiers related to a certain group identified by a given ID.
+	RemoveByGroupID(ctx context.Context, groupID string) error
 }
@@ -6,41 +6,52 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
 // Config defines the options that are used when connecting to a PostgreSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the PostgreSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate
-// failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
+
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
 		Migrations: []*migrate.Migration{
 			{
 				Id: "notifiers_1",
@@ -56,8 +67,54 @@ func migrateDB(db *sqlx.DB) error {
 				},
 				Down: []string{"DROP TABLE notifiers"},
 			},
+			dbutil.SearchMigration("notifiers_2"),
+			{
+				Id: "notifiers_3",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS maintenance_windows (
+						id          UUID PRIMARY KEY,
+						group_id    UUID NOT NULL,
+						thing_id    VARCHAR(254) NOT NULL DEFAULT '',
+						name        VARCHAR(254) NOT NULL,
+						start_time  TIMESTAMPTZ NOT NULL,
+						end_time    TIMESTAMPTZ NOT NULL,
+						recurrence  VARCHAR(16) NOT NULL DEFAULT '',
+						metadata    JSONB,
+						CONSTRAINT  unique_group_window_name UNIQUE (group_id, name)
+					)`,
+				},
+				Down: []string{"DROP TABLE maintenance_windows"},
+			},
+			{
+				Id: "notifiers_4",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS processed_messages (
+						id           VARCHAR(64) PRIMARY KEY,
+						processed_at TIMESTAMPTZ NOT NULL
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_processed_messages_processed_at ON processed_messages (processed_at)`,
+				},
+				Down: []string{"DROP TABLE processed_messages"},
+			},
+			{
+				Id: "notifiers_5",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS on_call_schedules (
+						id          UUID PRIMARY KEY,
+						group_id    UUID NOT NULL,
+						notifier_id UUID NOT NULL REFERENCES notifiers (id) ON DELETE CASCADE,
+						name        VARCHAR(254) NOT NULL,
+						start_time  TIMESTAMPTZ NOT NULL,
+						rotation    VARCHAR(16) NOT NULL,
+						contacts    VARCHAR(512) NOT NULL,
+						overrides   JSONB,
+						metadata    JSONB,
+						CONSTRAINT  unique_group_schedule_name UNIQUE (group_id, name)
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_on_call_schedules_notifier_id ON on_call_schedules (notifier_id)`,
+				},
+				Down: []string{"DROP TABLE on_call_schedules"},
+			},
 		},
 	}
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
 }
@@ -185,6 +185,17 @@ func (nr notifierRepository) Remove(ctx context.Context, ids ...string) error {
 	return nil
 }
 
+func (nr notifierRepository) RemoveByGroupID(ctx context.Context, groupID string) error {
+	dbNf := dbNotifier{GroupID: groupID}
+	q := `DELETE FROM notifiers WHERE group_id = :group_id;`
+
+	if _, err := nr.db.NamedExecContext(ctx, q, dbNf); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
 type dbNotifier struct {
 	ID       string `db:"id"`
 	GroupID  string `db:"group_id"`
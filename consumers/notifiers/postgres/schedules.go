@@ -0,0 +1,295 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const scheduleColumns = `id, group_id, notifier_id, name, start_time, rotation, contacts, overrides, metadata`
+
+var _ notifiers.OnCallScheduleRepository = (*scheduleRepository)(nil)
+
+type scheduleRepository struct {
+	db Database
+}
+
+// NewOnCallScheduleRepository instantiates a PostgreSQL implementation of on-call schedule repository.
+func NewOnCallScheduleRepository(db Database) notifiers.OnCallScheduleRepository {
+	return &scheduleRepository{
+		db: db,
+	}
+}
+
+func (sr scheduleRepository) Save(ctx context.Context, schedules ...notifiers.OnCallSchedule) ([]notifiers.OnCallSchedule, error) {
+	tx, err := sr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := fmt.Sprintf(`INSERT INTO on_call_schedules (%s)
+		VALUES (:id, :group_id, :notifier_id, :name, :start_time, :rotation, :contacts, :overrides, :metadata);`, scheduleColumns)
+
+	for _, s := range schedules {
+		dbS, err := toDBSchedule(s)
+		if err != nil {
+			tx.Rollback()
+			return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbS); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation, pgerrcode.ForeignKeyViolation:
+					return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return schedules, nil
+}
+
+func (sr scheduleRepository) RetrieveByGroupID(ctx context.Context, groupID string, pm things.PageMetadata) (notifiers.OnCallSchedulesPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return notifiers.OnCallSchedulesPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	oq := dbutil.GetOrderQuery(pm.Order)
+	dq := dbutil.GetDirQuery(pm.Dir)
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+
+	q := fmt.Sprintf(`SELECT %s FROM on_call_schedules WHERE group_id = :group_id ORDER BY %s %s %s;`, scheduleColumns, oq, dq, olq)
+	qc := `SELECT COUNT(*) FROM on_call_schedules WHERE group_id = $1;`
+
+	params := map[string]interface{}{
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	items, err := sr.retrieve(ctx, q, params)
+	if err != nil {
+		return notifiers.OnCallSchedulesPage{}, err
+	}
+
+	var total uint64
+	if err := sr.db.GetContext(ctx, &total, qc, groupID); err != nil {
+		return notifiers.OnCallSchedulesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	page := notifiers.OnCallSchedulesPage{
+		OnCallSchedules: items,
+		PageMetadata: things.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (sr scheduleRepository) RetrieveByNotifierID(ctx context.Context, notifierID string) ([]notifiers.OnCallSchedule, error) {
+	if _, err := uuid.FromString(notifierID); err != nil {
+		return []notifiers.OnCallSchedule{}, nil
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM on_call_schedules WHERE notifier_id = :notifier_id ORDER BY id;`, scheduleColumns)
+
+	return sr.retrieve(ctx, q, map[string]interface{}{"notifier_id": notifierID})
+}
+
+func (sr scheduleRepository) RetrieveByID(ctx context.Context, id string) (notifiers.OnCallSchedule, error) {
+	q := fmt.Sprintf(`SELECT %s FROM on_call_schedules WHERE id = $1;`, scheduleColumns)
+
+	dbS := dbSchedule{}
+	if err := sr.db.QueryRowxContext(ctx, q, id).StructScan(&dbS); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toSchedule(dbS)
+}
+
+func (sr scheduleRepository) Update(ctx context.Context, s notifiers.OnCallSchedule) error {
+	q := `UPDATE on_call_schedules SET notifier_id = :notifier_id, name = :name, start_time = :start_time,
+		rotation = :rotation, contacts = :contacts, overrides = :overrides, metadata = :metadata WHERE id = :id;`
+
+	dbS, err := toDBSchedule(s)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, err := sr.db.NamedExecContext(ctx, q, dbS)
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation, pgerrcode.ForeignKeyViolation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (sr scheduleRepository) Remove(ctx context.Context, ids ...string) error {
+	q := `DELETE FROM on_call_schedules WHERE id = :id;`
+
+	for _, id := range ids {
+		if _, err := sr.db.NamedExecContext(ctx, q, dbSchedule{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (sr scheduleRepository) RemoveByGroupID(ctx context.Context, groupID string) error {
+	q := `DELETE FROM on_call_schedules WHERE group_id = :group_id;`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbSchedule{GroupID: groupID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (sr scheduleRepository) retrieve(ctx context.Context, query string, params map[string]interface{}) ([]notifiers.OnCallSchedule, error) {
+	rows, err := sr.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []notifiers.OnCallSchedule
+	for rows.Next() {
+		dbS := dbSchedule{}
+		if err := rows.StructScan(&dbS); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		s, err := toSchedule(dbS)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, s)
+	}
+
+	return items, nil
+}
+
+type dbSchedule struct {
+	ID         string    `db:"id"`
+	GroupID    string    `db:"group_id"`
+	NotifierID string    `db:"notifier_id"`
+	Name       string    `db:"name"`
+	Start      time.Time `db:"start_time"`
+	Rotation   string    `db:"rotation"`
+	Contacts   string    `db:"contacts"`
+	Overrides  []byte    `db:"overrides"`
+	Metadata   []byte    `db:"metadata"`
+}
+
+func toDBSchedule(s notifiers.OnCallSchedule) (dbSchedule, error) {
+	metadata := []byte("{}")
+	if len(s.Metadata) > 0 {
+		b, err := json.Marshal(s.Metadata)
+		if err != nil {
+			return dbSchedule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+
+	overrides := []byte("[]")
+	if len(s.Overrides) > 0 {
+		b, err := json.Marshal(s.Overrides)
+		if err != nil {
+			return dbSchedule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		overrides = b
+	}
+
+	return dbSchedule{
+		ID:         s.ID,
+		GroupID:    s.GroupID,
+		NotifierID: s.NotifierID,
+		Name:       s.Name,
+		Start:      s.Start,
+		Rotation:   s.Rotation,
+		Contacts:   strings.Join(s.Contacts, ","),
+		Overrides:  overrides,
+		Metadata:   metadata,
+	}, nil
+}
+
+func toSchedule(dbS dbSchedule) (notifiers.OnCallSchedule, error) {
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(dbS.Metadata, &metadata); err != nil {
+		return notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	var overrides []notifiers.Override
+	if err := json.Unmarshal(dbS.Overrides, &overrides); err != nil {
+		return notifiers.OnCallSchedule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return notifiers.OnCallSchedule{
+		ID:         dbS.ID,
+		GroupID:    dbS.GroupID,
+		NotifierID: dbS.NotifierID,
+		Name:       dbS.Name,
+		Start:      dbS.Start,
+		Rotation:   dbS.Rotation,
+		Contacts:   strings.Split(dbS.Contacts, ","),
+		Overrides:  overrides,
+		Metadata:   metadata,
+	}, nil
+}
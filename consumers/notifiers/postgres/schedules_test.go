@@ -0,0 +1,363 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scheduleName = "schedule"
+
+var contacts = []string{"first@example.com", "second@example.com"}
+
+func createNotifier(t *testing.T, groupID string) things.Notifier {
+	repo := postgres.NewNotifierRepository(postgres.NewDatabase(db))
+
+	nfs, err := repo.Save(context.Background(), things.Notifier{
+		ID:       generateUUID(t),
+		GroupID:  groupID,
+		Name:     generateUUID(t),
+		Contacts: contacts,
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	return nfs[0]
+}
+
+func newSchedule(t *testing.T, groupID, notifierID, name string) notifiers.OnCallSchedule {
+	start := time.Now().UTC().Truncate(time.Second)
+	return notifiers.OnCallSchedule{
+		ID:         generateUUID(t),
+		GroupID:    groupID,
+		NotifierID: notifierID,
+		Name:       name,
+		Start:      start,
+		Rotation:   notifiers.Weekly,
+		Contacts:   contacts,
+		Overrides:  []notifiers.Override{{Contact: contacts[0], Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}},
+		Metadata:   map[string]interface{}{"team": "ops"},
+	}
+}
+
+func saveSchedule(t *testing.T, repo notifiers.OnCallScheduleRepository, s notifiers.OnCallSchedule) notifiers.OnCallSchedule {
+	_, err := repo.Save(context.Background(), s)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return s
+}
+
+func assertSchedule(t *testing.T, desc string, expected, actual notifiers.OnCallSchedule) {
+	assert.True(t, expected.Start.Equal(actual.Start), fmt.Sprintf("%s: expected start %s got %s\n", desc, expected.Start, actual.Start))
+	require.Equal(t, len(expected.Overrides), len(actual.Overrides), fmt.Sprintf("%s: expected %d overrides got %d\n", desc, len(expected.Overrides), len(actual.Overrides)))
+	for i, o := range expected.Overrides {
+		assert.Equal(t, o.Contact, actual.Overrides[i].Contact, fmt.Sprintf("%s: expected override contact %s got %s\n", desc, o.Contact, actual.Overrides[i].Contact))
+		assert.True(t, o.Start.Equal(actual.Overrides[i].Start) && o.End.Equal(actual.Overrides[i].End), fmt.Sprintf("%s: expected override %v got %v\n", desc, o, actual.Overrides[i]))
+	}
+
+	expected.Start, expected.Overrides = time.Time{}, nil
+	actual.Start, actual.Overrides = time.Time{}, nil
+	assert.Equal(t, expected, actual, fmt.Sprintf("%s: expected %v got %v\n", desc, expected, actual))
+}
+
+func TestSaveSchedules(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+
+	cases := []struct {
+		desc      string
+		schedules []notifiers.OnCallSchedule
+		err       error
+	}{
+		{
+			desc:      "save on-call schedules",
+			schedules: []notifiers.OnCallSchedule{newSchedule(t, groupID, nf.ID, scheduleName), newSchedule(t, groupID, nf.ID, "other")},
+			err:       nil,
+		},
+		{
+			desc:      "save on-call schedule with existing name",
+			schedules: []notifiers.OnCallSchedule{newSchedule(t, groupID, nf.ID, scheduleName)},
+			err:       errors.ErrConflict,
+		},
+		{
+			desc:      "save on-call schedule of non-existing notifier",
+			schedules: []notifiers.OnCallSchedule{newSchedule(t, groupID, generateUUID(t), "missing")},
+			err:       errors.ErrMalformedEntity,
+		},
+		{
+			desc:      "save on-call schedule with invalid group id",
+			schedules: []notifiers.OnCallSchedule{newSchedule(t, invalidID, nf.ID, scheduleName)},
+			err:       errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.schedules...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveScheduleByID(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+	s := saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, scheduleName))
+
+	cases := []struct {
+		desc     string
+		id       string
+		schedule notifiers.OnCallSchedule
+		err      error
+	}{
+		{
+			desc:     "retrieve existing on-call schedule",
+			id:       s.ID,
+			schedule: s,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve non-existing on-call schedule",
+			id:       generateUUID(t),
+			schedule: notifiers.OnCallSchedule{},
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "retrieve on-call schedule with invalid id",
+			id:       invalidID,
+			schedule: notifiers.OnCallSchedule{},
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assertSchedule(t, tc.desc, tc.schedule, res)
+	}
+}
+
+func TestRetrieveSchedulesByGroupID(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, fmt.Sprintf("%s-%d", scheduleName, i)))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		pm      things.PageMetadata
+		size    uint64
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all on-call schedules of the group",
+			groupID: groupID,
+			pm:      things.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of on-call schedules of the group",
+			groupID: groupID,
+			pm:      things.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve on-call schedules of the group without schedules",
+			groupID: generateUUID(t),
+			pm:      things.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve on-call schedules with invalid group id",
+			groupID: invalidID,
+			pm:      things.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroupID(context.Background(), tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.OnCallSchedules)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.OnCallSchedules)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveSchedulesByNotifierID(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+	other := createNotifier(t, groupID)
+
+	saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, scheduleName))
+	saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, "second"))
+	saveSchedule(t, repo, newSchedule(t, groupID, other.ID, "other"))
+
+	cases := []struct {
+		desc       string
+		notifierID string
+		size       int
+	}{
+		{
+			desc:       "retrieve on-call schedules of the notifier",
+			notifierID: nf.ID,
+			size:       2,
+		},
+		{
+			desc:       "retrieve on-call schedules of the notifier without schedules",
+			notifierID: generateUUID(t),
+			size:       0,
+		},
+		{
+			desc:       "retrieve on-call schedules with invalid notifier id",
+			notifierID: invalidID,
+			size:       0,
+		},
+	}
+
+	for _, tc := range cases {
+		ss, err := repo.RetrieveByNotifierID(context.Background(), tc.notifierID)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.size, len(ss), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(ss)))
+	}
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+	otherNf := createNotifier(t, groupID)
+
+	s := saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, scheduleName))
+	other := saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, "other"))
+
+	updated := s
+	updated.NotifierID = otherNf.ID
+	updated.Rotation = notifiers.Daily
+	updated.Contacts = []string{contacts[1]}
+	updated.Overrides = nil
+
+	conflicting := s
+	conflicting.Name = other.Name
+
+	missingNotifier := s
+	missingNotifier.NotifierID = generateUUID(t)
+
+	cases := []struct {
+		desc     string
+		schedule notifiers.OnCallSchedule
+		err      error
+	}{
+		{
+			desc:     "update existing on-call schedule",
+			schedule: updated,
+			err:      nil,
+		},
+		{
+			desc:     "update on-call schedule with existing name",
+			schedule: conflicting,
+			err:      errors.ErrConflict,
+		},
+		{
+			desc:     "update on-call schedule with non-existing notifier",
+			schedule: missingNotifier,
+			err:      errors.ErrMalformedEntity,
+		},
+		{
+			desc:     "update non-existing on-call schedule",
+			schedule: newSchedule(t, groupID, nf.ID, "missing"),
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.schedule)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), s.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assertSchedule(t, "update on-call schedule", updated, res)
+}
+
+func TestRemoveSchedules(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	notifierRepo := postgres.NewNotifierRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+
+	s := saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, scheduleName))
+	cascaded := saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, "cascaded"))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing on-call schedule",
+			id:   s.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed on-call schedule",
+			id:   s.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+
+	// The on-call schedules are removed together with their notifier.
+	err := notifierRepo.Remove(context.Background(), nf.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	_, err = repo.RetrieveByID(context.Background(), cascaded.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("remove notifier: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestRemoveSchedulesByGroupID(t *testing.T) {
+	repo := postgres.NewOnCallScheduleRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nf := createNotifier(t, groupID)
+
+	for i := 0; i < 2; i++ {
+		saveSchedule(t, repo, newSchedule(t, groupID, nf.ID, fmt.Sprintf("%s-%d", scheduleName, i)))
+	}
+
+	err := repo.RemoveByGroupID(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove on-call schedules by group: expected nil got %s\n", err))
+
+	page, err := repo.RetrieveByGroupID(context.Background(), groupID, things.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected total 0 got %d\n", page.Total))
+}
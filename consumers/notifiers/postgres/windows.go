@@ -0,0 +1,258 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const windowColumns = `id, group_id, thing_id, name, start_time, end_time, recurrence, metadata`
+
+var _ notifiers.MaintenanceWindowRepository = (*windowRepository)(nil)
+
+type windowRepository struct {
+	db Database
+}
+
+// NewMaintenanceWindowRepository instantiates a PostgreSQL implementation of maintenance window repository.
+func NewMaintenanceWindowRepository(db Database) notifiers.MaintenanceWindowRepository {
+	return &windowRepository{
+		db: db,
+	}
+}
+
+func (wr windowRepository) Save(ctx context.Context, mws ...notifiers.MaintenanceWindow) ([]notifiers.MaintenanceWindow, error) {
+	tx, err := wr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := fmt.Sprintf(`INSERT INTO maintenance_windows (%s)
+		VALUES (:id, :group_id, :thing_id, :name, :start_time, :end_time, :recurrence, :metadata);`, windowColumns)
+
+	for _, mw := range mws {
+		dbMw, err := toDBWindow(mw)
+		if err != nil {
+			tx.Rollback()
+			return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbMw); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return mws, nil
+}
+
+func (wr windowRepository) RetrieveByGroupID(ctx context.Context, groupID string, pm things.PageMetadata) (notifiers.MaintenanceWindowsPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return notifiers.MaintenanceWindowsPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	oq := dbutil.GetOrderQuery(pm.Order)
+	dq := dbutil.GetDirQuery(pm.Dir)
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+
+	q := fmt.Sprintf(`SELECT %s FROM maintenance_windows WHERE group_id = :group_id ORDER BY %s %s %s;`, windowColumns, oq, dq, olq)
+	qc := `SELECT COUNT(*) FROM maintenance_windows WHERE group_id = $1;`
+
+	params := map[string]interface{}{
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	rows, err := wr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return notifiers.MaintenanceWindowsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []notifiers.MaintenanceWindow
+	for rows.Next() {
+		dbMw := dbWindow{}
+		if err := rows.StructScan(&dbMw); err != nil {
+			return notifiers.MaintenanceWindowsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		mw, err := toWindow(dbMw)
+		if err != nil {
+			return notifiers.MaintenanceWindowsPage{}, err
+		}
+
+		items = append(items, mw)
+	}
+
+	var total uint64
+	if err := wr.db.GetContext(ctx, &total, qc, groupID); err != nil {
+		return notifiers.MaintenanceWindowsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	page := notifiers.MaintenanceWindowsPage{
+		MaintenanceWindows: items,
+		PageMetadata: things.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (wr windowRepository) RetrieveByID(ctx context.Context, id string) (notifiers.MaintenanceWindow, error) {
+	q := fmt.Sprintf(`SELECT %s FROM maintenance_windows WHERE id = $1;`, windowColumns)
+
+	dbMw := dbWindow{}
+	if err := wr.db.QueryRowxContext(ctx, q, id).StructScan(&dbMw); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toWindow(dbMw)
+}
+
+func (wr windowRepository) Update(ctx context.Context, mw notifiers.MaintenanceWindow) error {
+	q := `UPDATE maintenance_windows SET thing_id = :thing_id, name = :name, start_time = :start_time,
+		end_time = :end_time, recurrence = :recurrence, metadata = :metadata WHERE id = :id;`
+
+	dbMw, err := toDBWindow(mw)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, err := wr.db.NamedExecContext(ctx, q, dbMw)
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (wr windowRepository) Remove(ctx context.Context, ids ...string) error {
+	q := `DELETE FROM maintenance_windows WHERE id = :id;`
+
+	for _, id := range ids {
+		if _, err := wr.db.NamedExecContext(ctx, q, dbWindow{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (wr windowRepository) RemoveByGroupID(ctx context.Context, groupID string) error {
+	q := `DELETE FROM maintenance_windows WHERE group_id = :group_id;`
+
+	if _, err := wr.db.NamedExecContext(ctx, q, dbWindow{GroupID: groupID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbWindow struct {
+	ID         string    `db:"id"`
+	GroupID    string    `db:"group_id"`
+	ThingID    string    `db:"thing_id"`
+	Name       string    `db:"name"`
+	Start      time.Time `db:"start_time"`
+	End        time.Time `db:"end_time"`
+	Recurrence string    `db:"recurrence"`
+	Metadata   []byte    `db:"metadata"`
+}
+
+func toDBWindow(mw notifiers.MaintenanceWindow) (dbWindow, error) {
+	metadata := []byte("{}")
+	if len(mw.Metadata) > 0 {
+		b, err := json.Marshal(mw.Metadata)
+		if err != nil {
+			return dbWindow{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+
+	return dbWindow{
+		ID:         mw.ID,
+		GroupID:    mw.GroupID,
+		ThingID:    mw.ThingID,
+		Name:       mw.Name,
+		Start:      mw.Start,
+		End:        mw.End,
+		Recurrence: mw.Recurrence,
+		Metadata:   metadata,
+	}, nil
+}
+
+func toWindow(dbMw dbWindow) (notifiers.MaintenanceWindow, error) {
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(dbMw.Metadata, &metadata); err != nil {
+		return notifiers.MaintenanceWindow{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return notifiers.MaintenanceWindow{
+		ID:         dbMw.ID,
+		GroupID:    dbMw.GroupID,
+		ThingID:    dbMw.ThingID,
+		Name:       dbMw.Name,
+		Start:      dbMw.Start,
+		End:        dbMw.End,
+		Recurrence: dbMw.Recurrence,
+		Metadata:   metadata,
+	}, nil
+}
@@ -0,0 +1,284 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers/postgres"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	windowName = "window"
+	invalidID  = "invalid"
+)
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newWindow(t *testing.T, groupID, name string) notifiers.MaintenanceWindow {
+	start := time.Now().UTC().Truncate(time.Second)
+	return notifiers.MaintenanceWindow{
+		ID:         generateUUID(t),
+		GroupID:    groupID,
+		ThingID:    generateUUID(t),
+		Name:       name,
+		Start:      start,
+		End:        start.Add(time.Hour),
+		Recurrence: notifiers.Daily,
+		Metadata:   map[string]interface{}{"reason": "upgrade"},
+	}
+}
+
+func saveWindow(t *testing.T, repo notifiers.MaintenanceWindowRepository, mw notifiers.MaintenanceWindow) notifiers.MaintenanceWindow {
+	_, err := repo.Save(context.Background(), mw)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return mw
+}
+
+func assertWindow(t *testing.T, desc string, expected, actual notifiers.MaintenanceWindow) {
+	assert.True(t, expected.Start.Equal(actual.Start), fmt.Sprintf("%s: expected start %s got %s\n", desc, expected.Start, actual.Start))
+	assert.True(t, expected.End.Equal(actual.End), fmt.Sprintf("%s: expected end %s got %s\n", desc, expected.End, actual.End))
+
+	expected.Start, expected.End = time.Time{}, time.Time{}
+	actual.Start, actual.End = time.Time{}, time.Time{}
+	assert.Equal(t, expected, actual, fmt.Sprintf("%s: expected %v got %v\n", desc, expected, actual))
+}
+
+func TestSaveWindows(t *testing.T) {
+	repo := postgres.NewMaintenanceWindowRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	cases := []struct {
+		desc    string
+		windows []notifiers.MaintenanceWindow
+		err     error
+	}{
+		{
+			desc:    "save maintenance windows",
+			windows: []notifiers.MaintenanceWindow{newWindow(t, groupID, windowName), newWindow(t, groupID, "other")},
+			err:     nil,
+		},
+		{
+			desc:    "save maintenance window with existing name",
+			windows: []notifiers.MaintenanceWindow{newWindow(t, groupID, windowName)},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save maintenance window with existing name of other group",
+			windows: []notifiers.MaintenanceWindow{newWindow(t, generateUUID(t), windowName)},
+			err:     nil,
+		},
+		{
+			desc:    "save maintenance window with invalid group id",
+			windows: []notifiers.MaintenanceWindow{newWindow(t, invalidID, windowName)},
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.windows...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveWindowByID(t *testing.T) {
+	repo := postgres.NewMaintenanceWindowRepository(postgres.NewDatabase(db))
+	mw := saveWindow(t, repo, newWindow(t, generateUUID(t), windowName))
+
+	cases := []struct {
+		desc   string
+		id     string
+		window notifiers.MaintenanceWindow
+		err    error
+	}{
+		{
+			desc:   "retrieve existing maintenance window",
+			id:     mw.ID,
+			window: mw,
+			err:    nil,
+		},
+		{
+			desc:   "retrieve non-existing maintenance window",
+			id:     generateUUID(t),
+			window: notifiers.MaintenanceWindow{},
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "retrieve maintenance window with invalid id",
+			id:     invalidID,
+			window: notifiers.MaintenanceWindow{},
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assertWindow(t, tc.desc, tc.window, res)
+	}
+}
+
+func TestRetrieveWindowsByGroupID(t *testing.T) {
+	repo := postgres.NewMaintenanceWindowRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		saveWindow(t, repo, newWindow(t, groupID, fmt.Sprintf("%s-%d", windowName, i)))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		pm      things.PageMetadata
+		size    uint64
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all maintenance windows of the group",
+			groupID: groupID,
+			pm:      things.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of maintenance windows of the group",
+			groupID: groupID,
+			pm:      things.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve maintenance windows of the group without windows",
+			groupID: generateUUID(t),
+			pm:      things.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve maintenance windows with invalid group id",
+			groupID: invalidID,
+			pm:      things.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroupID(context.Background(), tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.MaintenanceWindows)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.MaintenanceWindows)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestUpdateWindow(t *testing.T) {
+	repo := postgres.NewMaintenanceWindowRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	mw := saveWindow(t, repo, newWindow(t, groupID, windowName))
+	other := saveWindow(t, repo, newWindow(t, groupID, "other"))
+
+	updated := mw
+	updated.ThingID = ""
+	updated.End = mw.Start.Add(2 * time.Hour)
+	updated.Recurrence = notifiers.Weekly
+
+	conflicting := mw
+	conflicting.Name = other.Name
+
+	cases := []struct {
+		desc   string
+		window notifiers.MaintenanceWindow
+		err    error
+	}{
+		{
+			desc:   "update existing maintenance window",
+			window: updated,
+			err:    nil,
+		},
+		{
+			desc:   "update maintenance window with existing name",
+			window: conflicting,
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "update non-existing maintenance window",
+			window: newWindow(t, groupID, "missing"),
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.window)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), mw.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assertWindow(t, "update maintenance window", updated, res)
+}
+
+func TestRemoveWindows(t *testing.T) {
+	repo := postgres.NewMaintenanceWindowRepository(postgres.NewDatabase(db))
+	mw := saveWindow(t, repo, newWindow(t, generateUUID(t), windowName))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing maintenance window",
+			id:   mw.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed maintenance window",
+			id:   mw.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
+
+func TestRemoveWindowsByGroupID(t *testing.T) {
+	repo := postgres.NewMaintenanceWindowRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	for i := 0; i < 2; i++ {
+		saveWindow(t, repo, newWindow(t, groupID, fmt.Sprintf("%s-%d", windowName, i)))
+	}
+
+	err := repo.RemoveByGroupID(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove maintenance windows by group: expected nil got %s\n", err))
+
+	page, err := repo.RetrieveByGroupID(context.Background(), groupID, things.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected total 0 got %d\n", page.Total))
+}
@@ -0,0 +1,314 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var (
+	// ErrInvalidSchedule indicates the malformed on-call schedule rotation, contacts or overrides.
+	ErrInvalidSchedule = errors.New("invalid on-call schedule")
+
+	// ErrNotifierGroup indicates that the on-call schedule notifier doesn't belong to the schedule group.
+	ErrNotifierGroup = errors.New("notifier doesn't belong to the on-call schedule group")
+)
+
+// OnCallSchedule represents the rotation of the contacts on call for the
+// notifier of the group. While the schedule is in effect, the notifications
+// are sent to the contact on call instead of the notifier contacts. The
+// contacts take turns daily or weekly in the listed order, starting at Start,
+// unless they are replaced by an override.
+type OnCallSchedule struct {
+	ID         string
+	GroupID    string
+	NotifierID string
+	Name       string
+	Start      time.Time
+	Rotation   string
+	Contacts   []string
+	Overrides  []Override
+	Metadata   map[string]interface{}
+}
+
+// Override represents the contact on call in place of the rotation from
+// Start to End, e.g. during the vacation of the rotation contact.
+type Override struct {
+	Contact string    `json:"contact"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+}
+
+// Shift represents the time range during which the contact is on call.
+type Shift struct {
+	Contact string
+	Start   time.Time
+	End     time.Time
+}
+
+// Validate returns an error if the schedule has no contacts or rotation, or
+// if the override ends before it starts.
+func (s OnCallSchedule) Validate() error {
+	if len(s.Contacts) == 0 {
+		return ErrInvalidSchedule
+	}
+
+	if _, ok := periods[s.Rotation]; !ok {
+		return ErrInvalidSchedule
+	}
+
+	for _, o := range s.Overrides {
+		if o.Contact == "" || !o.End.After(o.Start) {
+			return ErrInvalidSchedule
+		}
+	}
+
+	return nil
+}
+
+// AllContacts returns the contacts of the rotation and the overrides.
+func (s OnCallSchedule) AllContacts() []string {
+	contacts := append([]string{}, s.Contacts...)
+	for _, o := range s.Overrides {
+		contacts = append(contacts, o.Contact)
+	}
+
+	return contacts
+}
+
+// OnCall returns the contact on call at the given time. The override takes
+// precedence over the rotation, and the later override over the earlier one.
+// No contact is on call before the schedule starts.
+func (s OnCallSchedule) OnCall(t time.Time) (string, bool) {
+	for i := len(s.Overrides) - 1; i >= 0; i-- {
+		o := s.Overrides[i]
+		if !t.Before(o.Start) && t.Before(o.End) {
+			return o.Contact, true
+		}
+	}
+
+	period, ok := periods[s.Rotation]
+	if !ok || len(s.Contacts) == 0 || t.Before(s.Start) {
+		return "", false
+	}
+
+	turn := int64(t.Sub(s.Start) / period)
+	return s.Contacts[turn%int64(len(s.Contacts))], true
+}
+
+// Shifts returns the shifts of the schedule between the given times, with
+// the overrides applied. The shifts are clipped to the given time range.
+func (s OnCallSchedule) Shifts(from, to time.Time) []Shift {
+	if !to.After(from) {
+		return []Shift{}
+	}
+
+	// The contact on call only changes at the rotation handovers and at the
+	// override boundaries.
+	bounds := []time.Time{from, to}
+	if period, ok := periods[s.Rotation]; ok {
+		t := s.Start
+		if from.After(t) {
+			t = t.Add(from.Sub(t) / period * period)
+		}
+		for ; t.Before(to); t = t.Add(period) {
+			bounds = append(bounds, t)
+		}
+	}
+	for _, o := range s.Overrides {
+		bounds = append(bounds, o.Start, o.End)
+	}
+
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i].Before(bounds[j]) })
+
+	shifts := []Shift{}
+	for i := 0; i < len(bounds)-1; i++ {
+		start, end := bounds[i], bounds[i+1]
+		if start.Before(from) || end.After(to) || !end.After(start) {
+			continue
+		}
+
+		contact, ok := s.OnCall(start)
+		if !ok {
+			continue
+		}
+
+		if n := len(shifts); n > 0 && shifts[n-1].Contact == contact && shifts[n-1].End.Equal(start) {
+			shifts[n-1].End = end
+			continue
+		}
+		shifts = append(shifts, Shift{Contact: contact, Start: start, End: end})
+	}
+
+	return shifts
+}
+
+// OnCallSchedulesPage contains page related metadata as well as a list of
+// on-call schedules that belong to this page.
+type OnCallSchedulesPage struct {
+	things.PageMetadata
+	OnCallSchedules []OnCallSchedule
+}
+
+// OnCallScheduleRepository specifies an on-call schedule persistence API.
+type OnCallScheduleRepository interface {
+	// Save persists multiple on-call schedules. Schedules are saved using a transaction.
+	// If one schedule fails then none will be saved.
+	Save(ctx context.Context, schedules ...OnCallSchedule) ([]OnCallSchedule, error)
+
+	// RetrieveByGroupID retrieves on-call schedules related to a certain group
+	// identified by a given ID.
+	RetrieveByGroupID(ctx context.Context, groupID string, pm things.PageMetadata) (OnCallSchedulesPage, error)
+
+	// RetrieveByNotifierID retrieves all on-call schedules of the notifier identified by a given ID.
+	RetrieveByNotifierID(ctx context.Context, notifierID string) ([]OnCallSchedule, error)
+
+	// RetrieveByID retrieves the on-call schedule having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (OnCallSchedule, error)
+
+	// Update performs an update to the existing on-call schedule. A non-nil error is
+	// returned to indicate operation failure.
+	Update(ctx context.Context, schedule OnCallSchedule) error
+
+	// Remove removes the on-call schedules having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the on-call schedules related to a certain group
+	// identified by a given ID.
+	RemoveByGroupID(ctx context.Context, groupID string) error
+}
+
+func (ns *notifierService) CreateOnCallSchedules(ctx context.Context, token, groupID string, schedules ...OnCallSchedule) ([]OnCallSchedule, error) {
+	if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return []OnCallSchedule{}, err
+	}
+
+	ocs := []OnCallSchedule{}
+	for _, s := range schedules {
+		s.GroupID = groupID
+		if err := ns.validateSchedule(ctx, s); err != nil {
+			return []OnCallSchedule{}, err
+		}
+
+		id, err := ns.idp.ID()
+		if err != nil {
+			return []OnCallSchedule{}, err
+		}
+		s.ID = id
+
+		ocs = append(ocs, s)
+	}
+
+	return ns.scheduleRepo.Save(ctx, ocs...)
+}
+
+func (ns *notifierService) ListOnCallSchedulesByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (OnCallSchedulesPage, error) {
+	if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return OnCallSchedulesPage{}, err
+	}
+
+	return ns.scheduleRepo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (ns *notifierService) ViewOnCallSchedule(ctx context.Context, token, id string) (OnCallSchedule, error) {
+	return ns.retrieveSchedule(ctx, token, id, things.Viewer)
+}
+
+func (ns *notifierService) UpdateOnCallSchedule(ctx context.Context, token string, schedule OnCallSchedule) error {
+	s, err := ns.retrieveSchedule(ctx, token, schedule.ID, things.Editor)
+	if err != nil {
+		return err
+	}
+
+	schedule.GroupID = s.GroupID
+	if err := ns.validateSchedule(ctx, schedule); err != nil {
+		return err
+	}
+
+	return ns.scheduleRepo.Update(ctx, schedule)
+}
+
+func (ns *notifierService) RemoveOnCallSchedules(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		s, err := ns.scheduleRepo.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: s.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := ns.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return ns.scheduleRepo.Remove(ctx, ids...)
+}
+
+func (ns *notifierService) retrieveSchedule(ctx context.Context, token, id, action string) (OnCallSchedule, error) {
+	s, err := ns.scheduleRepo.RetrieveByID(ctx, id)
+	if err != nil {
+		return OnCallSchedule{}, err
+	}
+
+	if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: s.GroupID, Subject: things.GroupSub, Action: action}); err != nil {
+		return OnCallSchedule{}, err
+	}
+
+	return s, nil
+}
+
+func (ns *notifierService) validateSchedule(ctx context.Context, s OnCallSchedule) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	if err := ns.notifier.ValidateContacts(s.AllContacts()); err != nil {
+		return errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	nf, err := ns.notifierRepo.RetrieveByID(ctx, s.NotifierID)
+	if err != nil {
+		return err
+	}
+
+	if nf.GroupID != s.GroupID {
+		return errors.Wrap(errors.ErrAuthorization, ErrNotifierGroup)
+	}
+
+	return nil
+}
+
+// recipients returns the contacts on call for the notifier, or the notifier
+// contacts if no schedule of the notifier is in effect.
+func (ns *notifierService) recipients(ctx context.Context, nf things.Notifier) ([]string, error) {
+	schedules, err := ns.scheduleRepo.RetrieveByNotifierID(ctx, nf.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool)
+	contacts := []string{}
+	for _, s := range schedules {
+		c, ok := s.OnCall(now)
+		if !ok || seen[c] {
+			continue
+		}
+		seen[c] = true
+		contacts = append(contacts, c)
+	}
+
+	if len(contacts) == 0 {
+		return nf.Contacts, nil
+	}
+
+	return contacts, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	scheduleStart = time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC)
+	day           = 24 * time.Hour
+)
+
+func TestOnCallScheduleOnCall(t *testing.T) {
+	override := notifiers.Override{Contact: "override@example.com", Start: scheduleStart.Add(36 * time.Hour), End: scheduleStart.Add(48 * time.Hour)}
+
+	cases := []struct {
+		desc     string
+		rotation string
+		time     time.Time
+		contact  string
+		onCall   bool
+	}{
+		{
+			desc:     "before schedule start",
+			rotation: notifiers.Daily,
+			time:     scheduleStart.Add(-time.Minute),
+			contact:  "",
+			onCall:   false,
+		},
+		{
+			desc:     "first daily shift",
+			rotation: notifiers.Daily,
+			time:     scheduleStart.Add(time.Hour),
+			contact:  validEmails[0],
+			onCall:   true,
+		},
+		{
+			desc:     "second daily shift",
+			rotation: notifiers.Daily,
+			time:     scheduleStart.Add(day + time.Hour),
+			contact:  validEmails[1],
+			onCall:   true,
+		},
+		{
+			desc:     "daily rotation wrapping around",
+			rotation: notifiers.Daily,
+			time:     scheduleStart.Add(2*day + time.Hour),
+			contact:  validEmails[0],
+			onCall:   true,
+		},
+		{
+			desc:     "weekly rotation on next day",
+			rotation: notifiers.Weekly,
+			time:     scheduleStart.Add(day + time.Hour),
+			contact:  validEmails[0],
+			onCall:   true,
+		},
+		{
+			desc:     "weekly rotation on next week",
+			rotation: notifiers.Weekly,
+			time:     scheduleStart.Add(7*day + time.Hour),
+			contact:  validEmails[1],
+			onCall:   true,
+		},
+		{
+			desc:     "override in effect",
+			rotation: notifiers.Daily,
+			time:     scheduleStart.Add(40 * time.Hour),
+			contact:  override.Contact,
+			onCall:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		s := notifiers.OnCallSchedule{Start: scheduleStart, Rotation: tc.rotation, Contacts: validEmails, Overrides: []notifiers.Override{override}}
+		contact, onCall := s.OnCall(tc.time)
+		assert.Equal(t, tc.onCall, onCall, fmt.Sprintf("%s: expected %t got %t\n", tc.desc, tc.onCall, onCall))
+		assert.Equal(t, tc.contact, contact, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.contact, contact))
+	}
+}
+
+func TestOnCallScheduleShifts(t *testing.T) {
+	override := notifiers.Override{Contact: "override@example.com", Start: scheduleStart.Add(36 * time.Hour), End: scheduleStart.Add(60 * time.Hour)}
+	s := notifiers.OnCallSchedule{Start: scheduleStart, Rotation: notifiers.Daily, Contacts: validEmails, Overrides: []notifiers.Override{override}}
+
+	cases := []struct {
+		desc   string
+		from   time.Time
+		to     time.Time
+		shifts []notifiers.Shift
+	}{
+		{
+			desc:   "shifts before schedule start",
+			from:   scheduleStart.Add(-day),
+			to:     scheduleStart.Add(-time.Hour),
+			shifts: []notifiers.Shift{},
+		},
+		{
+			desc: "shifts clipped to time range",
+			from: scheduleStart.Add(-time.Hour),
+			to:   scheduleStart.Add(30 * time.Hour),
+			shifts: []notifiers.Shift{
+				{Contact: validEmails[0], Start: scheduleStart, End: scheduleStart.Add(day)},
+				{Contact: validEmails[1], Start: scheduleStart.Add(day), End: scheduleStart.Add(30 * time.Hour)},
+			},
+		},
+		{
+			desc: "shifts with override",
+			from: scheduleStart.Add(day),
+			to:   scheduleStart.Add(3 * day),
+			shifts: []notifiers.Shift{
+				{Contact: validEmails[1], Start: scheduleStart.Add(day), End: override.Start},
+				{Contact: override.Contact, Start: override.Start, End: override.End},
+				{Contact: validEmails[0], Start: override.End, End: scheduleStart.Add(3 * day)},
+			},
+		},
+		{
+			desc:   "shifts of empty time range",
+			from:   scheduleStart.Add(day),
+			to:     scheduleStart.Add(day),
+			shifts: []notifiers.Shift{},
+		},
+	}
+
+	for _, tc := range cases {
+		shifts := s.Shifts(tc.from, tc.to)
+		assert.Equal(t, tc.shifts, shifts, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.shifts, shifts))
+	}
+}
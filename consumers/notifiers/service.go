@@ -33,6 +33,42 @@ type Service interface {
 	// belongs to the user identified by the provided key.
 	RemoveNotifiers(ctx context.Context, token string, id ...string) error
 
+	// CreateMaintenanceWindows creates maintenance windows for certain group identified by the provided ID.
+	CreateMaintenanceWindows(ctx context.Context, token, groupID string, windows ...MaintenanceWindow) ([]MaintenanceWindow, error)
+
+	// ListMaintenanceWindowsByGroup retrieves data about a subset of maintenance
+	// windows related to a certain group identified by the provided ID.
+	ListMaintenanceWindowsByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (MaintenanceWindowsPage, error)
+
+	// ViewMaintenanceWindow retrieves data about the maintenance window identified with the provided ID.
+	ViewMaintenanceWindow(ctx context.Context, token, id string) (MaintenanceWindow, error)
+
+	// UpdateMaintenanceWindow updates the maintenance window identified by the provided ID.
+	UpdateMaintenanceWindow(ctx context.Context, token string, window MaintenanceWindow) error
+
+	// RemoveMaintenanceWindows removes the maintenance windows identified with the provided IDs.
+	RemoveMaintenanceWindows(ctx context.Context, token string, ids ...string) error
+
+	// CreateOnCallSchedules creates on-call schedules for certain group identified by the provided ID.
+	CreateOnCallSchedules(ctx context.Context, token, groupID string, schedules ...OnCallSchedule) ([]OnCallSchedule, error)
+
+	// ListOnCallSchedulesByGroup retrieves data about a subset of on-call
+	// schedules related to a certain group identified by the provided ID.
+	ListOnCallSchedulesByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (OnCallSchedulesPage, error)
+
+	// ViewOnCallSchedule retrieves data about the on-call schedule identified with the provided ID.
+	ViewOnCallSchedule(ctx context.Context, token, id string) (OnCallSchedule, error)
+
+	// UpdateOnCallSchedule updates the on-call schedule identified by the provided ID.
+	UpdateOnCallSchedule(ctx context.Context, token string, schedule OnCallSchedule) error
+
+	// RemoveOnCallSchedules removes the on-call schedules identified with the provided IDs.
+	RemoveOnCallSchedules(ctx context.Context, token string, ids ...string) error
+
+	// RemoveNotifiersByGroup removes the notifiers, on-call schedules and
+	// maintenance windows of the removed group identified by the provided ID.
+	RemoveNotifiersByGroup(ctx context.Context, groupID string) error
+
 	consumers.Consumer
 }
 
@@ -42,15 +78,19 @@ type notifierService struct {
 	idp          uuid.IDProvider
 	notifier     Notifier
 	notifierRepo NotifierRepository
+	windowRepo   MaintenanceWindowRepository
+	scheduleRepo OnCallScheduleRepository
 	things       protomfx.ThingsServiceClient
 }
 
 // New instantiates the subscriptions service implementation.
-func New(idp uuid.IDProvider, notifier Notifier, notifierRepo NotifierRepository, things protomfx.ThingsServiceClient) Service {
+func New(idp uuid.IDProvider, notifier Notifier, notifierRepo NotifierRepository, windowRepo MaintenanceWindowRepository, scheduleRepo OnCallScheduleRepository, things protomfx.ThingsServiceClient) Service {
 	return &notifierService{
 		idp:          idp,
 		notifier:     notifier,
 		notifierRepo: notifierRepo,
+		windowRepo:   windowRepo,
+		scheduleRepo: scheduleRepo,
 		things:       things,
 	}
 }
@@ -64,23 +104,13 @@ func (ns *notifierService) Consume(message interface{}) error {
 	}
 
 	if msg.ProfileConfig.SmtpID != "" {
-		smtp, err := ns.notifierRepo.RetrieveByID(ctx, msg.ProfileConfig.SmtpID)
-		if err != nil {
-			return errors.Wrap(ErrNotify, err)
-		}
-
-		if err = ns.notifier.Notify(smtp.Contacts, msg); err != nil {
+		if err := ns.notify(ctx, msg.ProfileConfig.SmtpID, msg); err != nil {
 			return err
 		}
 	}
 
 	if msg.ProfileConfig.SmppID != "" {
-		smpp, err := ns.notifierRepo.RetrieveByID(ctx, msg.ProfileConfig.SmppID)
-		if err != nil {
-			return errors.Wrap(ErrNotify, err)
-		}
-
-		if err = ns.notifier.Notify(smpp.Contacts, msg); err != nil {
+		if err := ns.notify(ctx, msg.ProfileConfig.SmppID, msg); err != nil {
 			return err
 		}
 	}
@@ -88,6 +118,32 @@ func (ns *notifierService) Consume(message interface{}) error {
 	return nil
 }
 
+// notify sends the notification to the contacts on call for the notifier,
+// or to the notifier contacts if no on-call schedule is in effect, unless
+// the notifications are silenced by the maintenance window.
+func (ns *notifierService) notify(ctx context.Context, notifierID string, msg protomfx.Message) error {
+	nf, err := ns.notifierRepo.RetrieveByID(ctx, notifierID)
+	if err != nil {
+		return errors.Wrap(ErrNotify, err)
+	}
+
+	silenced, err := ns.silenced(ctx, nf.GroupID, msg)
+	if err != nil {
+		return errors.Wrap(ErrNotify, err)
+	}
+
+	if silenced {
+		return nil
+	}
+
+	to, err := ns.recipients(ctx, nf)
+	if err != nil {
+		return errors.Wrap(ErrNotify, err)
+	}
+
+	return ns.notifier.Notify(to, msg)
+}
+
 func (ns *notifierService) CreateNotifiers(ctx context.Context, token string, notifiers ...things.Notifier) ([]things.Notifier, error) {
 	nfs := []things.Notifier{}
 	for _, notifier := range notifiers {
@@ -174,14 +230,17 @@ func (ns *notifierService) UpdateNotifier(ctx context.Context, token string, not
 }
 
 func (ns *notifierService) RemoveNotifiers(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
 	for _, id := range ids {
 		notifier, err := ns.notifierRepo.RetrieveByID(ctx, id)
 		if err != nil {
 			return err
 		}
-		if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: notifier.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
-			return errors.Wrap(errors.ErrAuthorization, err)
-		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: notifier.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := ns.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
 	}
 
 	if err := ns.notifierRepo.Remove(ctx, ids...); err != nil {
@@ -190,3 +249,15 @@ func (ns *notifierService) RemoveNotifiers(ctx context.Context, token string, id
 
 	return nil
 }
+
+func (ns *notifierService) RemoveNotifiersByGroup(ctx context.Context, groupID string) error {
+	if err := ns.scheduleRepo.RemoveByGroupID(ctx, groupID); err != nil {
+		return err
+	}
+
+	if err := ns.windowRepo.RemoveByGroupID(ctx, groupID); err != nil {
+		return err
+	}
+
+	return ns.notifierRepo.RemoveByGroupID(ctx, groupID)
+}
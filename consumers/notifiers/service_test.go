@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
 	ntmocks "github.com/MainfluxLabs/mainflux/consumers/notifiers/mocks"
@@ -22,6 +23,10 @@ import (
 const (
 	token        = "admin@example.com"
 	groupID      = "9325aef3-5a2b-448c-bae1-5d45f86ba2aa"
+	thingID      = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	otherThingID = "7e1f3a4c-6b3d-4f0e-9a35-0c8f5d2e1b7a"
+	windowName   = "maintenance"
+	scheduleName = "on-call"
 	prefixID     = "fe6b4e92-cc98-425e-b0aa-"
 	prefixName   = "test-notifier-"
 	notifierName = "notifier-test"
@@ -43,11 +48,16 @@ var (
 )
 
 func newService() notifiers.Service {
-	thingsC := mocks.NewThingsServiceClient(nil, nil, map[string]things.Group{token: {ID: groupID}})
+	return newServiceWithRepo(ntmocks.NewNotifierRepository())
+}
+
+func newServiceWithRepo(notifierRepo notifiers.NotifierRepository) notifiers.Service {
+	thingsC := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID, otherThingID: wrongValue}, map[string]things.Group{token: {ID: groupID}})
 	notifier := ntmocks.NewNotifier()
-	notifierRepo := ntmocks.NewNotifierRepository()
+	windowRepo := ntmocks.NewMaintenanceWindowRepository()
+	scheduleRepo := ntmocks.NewOnCallScheduleRepository()
 	idp := uuid.NewMock()
-	return notifiers.New(idp, notifier, notifierRepo, thingsC)
+	return notifiers.New(idp, notifier, notifierRepo, windowRepo, scheduleRepo, thingsC)
 }
 
 func TestConsume(t *testing.T) {
@@ -471,3 +481,444 @@ func runRemoveNotifiersTest(t *testing.T, validContacts []string) {
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
+
+func TestRemoveNotifiersByGroup(t *testing.T) {
+	svc := newService()
+	validNf := things.Notifier{GroupID: groupID, Name: notifierName, Contacts: validEmails, Metadata: metadata}
+	_, err := svc.CreateNotifiers(context.Background(), token, validNf)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	now := time.Now()
+	_, err = svc.CreateMaintenanceWindows(context.Background(), token, groupID, notifiers.MaintenanceWindow{Name: windowName, Start: now, End: now.Add(time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove notifiers by group",
+			groupID: groupID,
+			err:     nil,
+		},
+		{
+			desc:    "remove notifiers by group without notifiers",
+			groupID: wrongValue,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveNotifiersByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	nfp, err := svc.ListNotifiersByGroup(context.Background(), token, groupID, things.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(nfp.Notifiers), fmt.Sprintf("expected no notifiers got %d\n", len(nfp.Notifiers)))
+
+	mwp, err := svc.ListMaintenanceWindowsByGroup(context.Background(), token, groupID, things.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(mwp.MaintenanceWindows), fmt.Sprintf("expected no maintenance windows got %d\n", len(mwp.MaintenanceWindows)))
+}
+
+func TestConsumeWithMaintenanceWindow(t *testing.T) {
+	// The notifier with the invalid contacts fails to notify unless the
+	// notifications are silenced.
+	notifierRepo := ntmocks.NewNotifierRepository()
+	nf := things.Notifier{ID: fmt.Sprintf("%s%012d", prefixID, 100), GroupID: groupID, Name: notifierName, Contacts: invalidEmails}
+	_, err := notifierRepo.Save(context.Background(), nf)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	svc := newServiceWithRepo(notifierRepo)
+
+	now := time.Now()
+	past := notifiers.MaintenanceWindow{Name: "past", Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}
+	thingWindow := notifiers.MaintenanceWindow{Name: "thing", ThingID: thingID, Start: now.Add(-time.Hour), End: now.Add(time.Hour)}
+	_, err = svc.CreateMaintenanceWindows(context.Background(), token, groupID, past, thingWindow)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	config := &protomfx.Config{SmtpID: nf.ID}
+	cases := []struct {
+		desc string
+		msg  protomfx.Message
+		err  error
+	}{
+		{
+			desc: "notify thing covered by active maintenance window",
+			msg:  protomfx.Message{Publisher: thingID, ProfileConfig: config},
+			err:  nil,
+		},
+		{
+			desc: "notify thing not covered by active maintenance window",
+			msg:  protomfx.Message{Publisher: otherThingID, ProfileConfig: config},
+			err:  notifiers.ErrNotify,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.Consume(tc.msg)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestCreateMaintenanceWindows(t *testing.T) {
+	svc := newService()
+	now := time.Now()
+	mw := notifiers.MaintenanceWindow{Name: windowName, Start: now, End: now.Add(time.Hour), Recurrence: notifiers.Daily, Metadata: metadata}
+
+	thingMw := mw
+	thingMw.Name = "thing"
+	thingMw.ThingID = thingID
+
+	invalidRangeMw := mw
+	invalidRangeMw.End = now.Add(-time.Hour)
+
+	invalidRecurrenceMw := mw
+	invalidRecurrenceMw.Recurrence = wrongValue
+
+	longMw := mw
+	longMw.End = now.Add(25 * time.Hour)
+
+	otherGroupThingMw := mw
+	otherGroupThingMw.ThingID = otherThingID
+
+	cases := []struct {
+		desc    string
+		windows []notifiers.MaintenanceWindow
+		token   string
+		err     error
+	}{
+		{
+			desc:    "create maintenance windows",
+			windows: []notifiers.MaintenanceWindow{mw, thingMw},
+			token:   token,
+			err:     nil,
+		},
+		{
+			desc:    "create maintenance windows with wrong credentials",
+			windows: []notifiers.MaintenanceWindow{mw},
+			token:   wrongValue,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "create maintenance window ending before it starts",
+			windows: []notifiers.MaintenanceWindow{invalidRangeMw},
+			token:   token,
+			err:     notifiers.ErrInvalidWindow,
+		},
+		{
+			desc:    "create maintenance window with invalid recurrence",
+			windows: []notifiers.MaintenanceWindow{invalidRecurrenceMw},
+			token:   token,
+			err:     notifiers.ErrInvalidWindow,
+		},
+		{
+			desc:    "create recurring maintenance window longer than recurrence period",
+			windows: []notifiers.MaintenanceWindow{longMw},
+			token:   token,
+			err:     notifiers.ErrInvalidWindow,
+		},
+		{
+			desc:    "create maintenance window for thing from other group",
+			windows: []notifiers.MaintenanceWindow{otherGroupThingMw},
+			token:   token,
+			err:     notifiers.ErrThingGroup,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.CreateMaintenanceWindows(context.Background(), tc.token, groupID, tc.windows...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestUpdateMaintenanceWindow(t *testing.T) {
+	svc := newService()
+	now := time.Now()
+	mws, err := svc.CreateMaintenanceWindows(context.Background(), token, groupID, notifiers.MaintenanceWindow{Name: windowName, Start: now, End: now.Add(time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	mw := mws[0]
+
+	updatedMw := mw
+	updatedMw.Recurrence = notifiers.Weekly
+
+	invalidIDMw := mw
+	invalidIDMw.ID = wrongValue
+
+	invalidRangeMw := mw
+	invalidRangeMw.End = now.Add(-time.Hour)
+
+	cases := []struct {
+		desc   string
+		window notifiers.MaintenanceWindow
+		token  string
+		err    error
+	}{
+		{
+			desc:   "update existing maintenance window",
+			window: updatedMw,
+			token:  token,
+			err:    nil,
+		},
+		{
+			desc:   "update maintenance window with wrong credentials",
+			window: updatedMw,
+			token:  wrongValue,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "update non-existing maintenance window",
+			window: invalidIDMw,
+			token:  token,
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "update maintenance window with invalid time range",
+			window: invalidRangeMw,
+			token:  token,
+			err:    notifiers.ErrInvalidWindow,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateMaintenanceWindow(context.Background(), tc.token, tc.window)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRemoveMaintenanceWindows(t *testing.T) {
+	svc := newService()
+	now := time.Now()
+	mws, err := svc.CreateMaintenanceWindows(context.Background(), token, groupID, notifiers.MaintenanceWindow{Name: windowName, Start: now, End: now.Add(time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	mw := mws[0]
+
+	cases := []struct {
+		desc  string
+		id    string
+		token string
+		err   error
+	}{
+		{
+			desc:  "remove maintenance window with wrong credentials",
+			id:    mw.ID,
+			token: wrongValue,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "remove existing maintenance window",
+			id:    mw.ID,
+			token: token,
+			err:   nil,
+		},
+		{
+			desc:  "remove non-existing maintenance window",
+			id:    wrongValue,
+			token: token,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveMaintenanceWindows(context.Background(), tc.token, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestConsumeWithOnCallSchedule(t *testing.T) {
+	// The notifier with the invalid contacts fails to notify unless the
+	// notifications are sent to the contact on call.
+	notifierRepo := ntmocks.NewNotifierRepository()
+	nf := things.Notifier{ID: fmt.Sprintf("%s%012d", prefixID, 100), GroupID: groupID, Name: notifierName, Contacts: invalidEmails}
+	otherNf := nf
+	otherNf.ID = fmt.Sprintf("%s%012d", prefixID, 101)
+	otherNf.Name = "other"
+	_, err := notifierRepo.Save(context.Background(), nf, otherNf)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	svc := newServiceWithRepo(notifierRepo)
+
+	now := time.Now()
+	active := notifiers.OnCallSchedule{NotifierID: nf.ID, Name: "active", Start: now.Add(-time.Hour), Rotation: notifiers.Daily, Contacts: validEmails}
+	upcoming := notifiers.OnCallSchedule{NotifierID: otherNf.ID, Name: "upcoming", Start: now.Add(time.Hour), Rotation: notifiers.Daily, Contacts: validEmails}
+	_, err = svc.CreateOnCallSchedules(context.Background(), token, groupID, active, upcoming)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		msg  protomfx.Message
+		err  error
+	}{
+		{
+			desc: "notify contact on call",
+			msg:  protomfx.Message{Publisher: thingID, ProfileConfig: &protomfx.Config{SmtpID: nf.ID}},
+			err:  nil,
+		},
+		{
+			desc: "notify notifier contacts before schedule starts",
+			msg:  protomfx.Message{Publisher: thingID, ProfileConfig: &protomfx.Config{SmtpID: otherNf.ID}},
+			err:  notifiers.ErrNotify,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.Consume(tc.msg)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestCreateOnCallSchedules(t *testing.T) {
+	notifierRepo := ntmocks.NewNotifierRepository()
+	nf := things.Notifier{ID: fmt.Sprintf("%s%012d", prefixID, 100), GroupID: groupID, Name: notifierName, Contacts: validEmails}
+	otherGroupNf := things.Notifier{ID: fmt.Sprintf("%s%012d", prefixID, 101), GroupID: wrongValue, Name: notifierName, Contacts: validEmails}
+	_, err := notifierRepo.Save(context.Background(), nf, otherGroupNf)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	svc := newServiceWithRepo(notifierRepo)
+
+	now := time.Now()
+	s := notifiers.OnCallSchedule{
+		NotifierID: nf.ID,
+		Name:       scheduleName,
+		Start:      now,
+		Rotation:   notifiers.Weekly,
+		Contacts:   validEmails,
+		Overrides:  []notifiers.Override{{Contact: validEmails[1], Start: now, End: now.Add(time.Hour)}},
+		Metadata:   metadata,
+	}
+
+	invalidRotationS := s
+	invalidRotationS.Rotation = wrongValue
+
+	noContactsS := s
+	noContactsS.Contacts = []string{}
+
+	invalidContactsS := s
+	invalidContactsS.Contacts = invalidEmails
+
+	invalidOverrideS := s
+	invalidOverrideS.Overrides = []notifiers.Override{{Contact: validEmails[1], Start: now, End: now.Add(-time.Hour)}}
+
+	unknownNotifierS := s
+	unknownNotifierS.NotifierID = wrongValue
+
+	otherGroupNotifierS := s
+	otherGroupNotifierS.NotifierID = otherGroupNf.ID
+
+	cases := []struct {
+		desc      string
+		schedules []notifiers.OnCallSchedule
+		token     string
+		err       error
+	}{
+		{
+			desc:      "create on-call schedules",
+			schedules: []notifiers.OnCallSchedule{s},
+			token:     token,
+			err:       nil,
+		},
+		{
+			desc:      "create on-call schedules with wrong credentials",
+			schedules: []notifiers.OnCallSchedule{s},
+			token:     wrongValue,
+			err:       errors.ErrAuthentication,
+		},
+		{
+			desc:      "create on-call schedule with invalid rotation",
+			schedules: []notifiers.OnCallSchedule{invalidRotationS},
+			token:     token,
+			err:       notifiers.ErrInvalidSchedule,
+		},
+		{
+			desc:      "create on-call schedule without contacts",
+			schedules: []notifiers.OnCallSchedule{noContactsS},
+			token:     token,
+			err:       notifiers.ErrInvalidSchedule,
+		},
+		{
+			desc:      "create on-call schedule with invalid contacts",
+			schedules: []notifiers.OnCallSchedule{invalidContactsS},
+			token:     token,
+			err:       errors.ErrMalformedEntity,
+		},
+		{
+			desc:      "create on-call schedule with override ending before it starts",
+			schedules: []notifiers.OnCallSchedule{invalidOverrideS},
+			token:     token,
+			err:       notifiers.ErrInvalidSchedule,
+		},
+		{
+			desc:      "create on-call schedule for non-existing notifier",
+			schedules: []notifiers.OnCallSchedule{unknownNotifierS},
+			token:     token,
+			err:       errors.ErrNotFound,
+		},
+		{
+			desc:      "create on-call schedule for notifier from other group",
+			schedules: []notifiers.OnCallSchedule{otherGroupNotifierS},
+			token:     token,
+			err:       notifiers.ErrNotifierGroup,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.CreateOnCallSchedules(context.Background(), tc.token, groupID, tc.schedules...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestUpdateOnCallSchedule(t *testing.T) {
+	notifierRepo := ntmocks.NewNotifierRepository()
+	nf := things.Notifier{ID: fmt.Sprintf("%s%012d", prefixID, 100), GroupID: groupID, Name: notifierName, Contacts: validEmails}
+	_, err := notifierRepo.Save(context.Background(), nf)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	svc := newServiceWithRepo(notifierRepo)
+
+	ocs, err := svc.CreateOnCallSchedules(context.Background(), token, groupID, notifiers.OnCallSchedule{NotifierID: nf.ID, Name: scheduleName, Start: time.Now(), Rotation: notifiers.Daily, Contacts: validEmails})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	s := ocs[0]
+
+	updatedS := s
+	updatedS.Rotation = notifiers.Weekly
+
+	invalidIDS := s
+	invalidIDS.ID = wrongValue
+
+	invalidRotationS := s
+	invalidRotationS.Rotation = wrongValue
+
+	cases := []struct {
+		desc     string
+		schedule notifiers.OnCallSchedule
+		token    string
+		err      error
+	}{
+		{
+			desc:     "update existing on-call schedule",
+			schedule: updatedS,
+			token:    token,
+			err:      nil,
+		},
+		{
+			desc:     "update on-call schedule with wrong credentials",
+			schedule: updatedS,
+			token:    wrongValue,
+			err:      errors.ErrAuthentication,
+		},
+		{
+			desc:     "update non-existing on-call schedule",
+			schedule: invalidIDS,
+			token:    token,
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "update on-call schedule with invalid rotation",
+			schedule: invalidRotationS,
+			token:    token,
+			err:      notifiers.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateOnCallSchedule(context.Background(), tc.token, tc.schedule)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/opentracing/opentracing-go"
 )
@@ -69,12 +70,21 @@ func (n notifierRepositoryMiddleware) Remove(ctx context.Context, ids ...string)
 	return n.repo.Remove(ctx, ids...)
 }
 
+func (n notifierRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, n.tracer, "remove_notifiers_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return n.repo.RemoveByGroupID(ctx, groupID)
+}
+
 func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
 	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
 		return tracer.StartSpan(
 			opName,
 			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
 		)
 	}
-	return tracer.StartSpan(opName)
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
 }
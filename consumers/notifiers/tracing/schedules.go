@@ -0,0 +1,84 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/opentracing/opentracing-go"
+)
+
+var _ notifiers.OnCallScheduleRepository = (*scheduleRepositoryMiddleware)(nil)
+
+type scheduleRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   notifiers.OnCallScheduleRepository
+}
+
+// OnCallScheduleRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func OnCallScheduleRepositoryMiddleware(tracer opentracing.Tracer, repo notifiers.OnCallScheduleRepository) notifiers.OnCallScheduleRepository {
+	return scheduleRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (s scheduleRepositoryMiddleware) Save(ctx context.Context, schedules ...notifiers.OnCallSchedule) ([]notifiers.OnCallSchedule, error) {
+	span := createSpan(ctx, s.tracer, "save_on_call_schedules")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.Save(ctx, schedules...)
+}
+
+func (s scheduleRepositoryMiddleware) RetrieveByGroupID(ctx context.Context, groupID string, pm things.PageMetadata) (notifiers.OnCallSchedulesPage, error) {
+	span := createSpan(ctx, s.tracer, "retrieve_on_call_schedules_by_group_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (s scheduleRepositoryMiddleware) RetrieveByNotifierID(ctx context.Context, notifierID string) ([]notifiers.OnCallSchedule, error) {
+	span := createSpan(ctx, s.tracer, "retrieve_on_call_schedules_by_notifier_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.RetrieveByNotifierID(ctx, notifierID)
+}
+
+func (s scheduleRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (notifiers.OnCallSchedule, error) {
+	span := createSpan(ctx, s.tracer, "retrieve_on_call_schedule_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.RetrieveByID(ctx, id)
+}
+
+func (s scheduleRepositoryMiddleware) Update(ctx context.Context, schedule notifiers.OnCallSchedule) error {
+	span := createSpan(ctx, s.tracer, "update_on_call_schedule")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.Update(ctx, schedule)
+}
+
+func (s scheduleRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, s.tracer, "remove_on_call_schedules")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.Remove(ctx, ids...)
+}
+
+func (s scheduleRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, s.tracer, "remove_on_call_schedules_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return s.repo.RemoveByGroupID(ctx, groupID)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/opentracing/opentracing-go"
+)
+
+var _ notifiers.MaintenanceWindowRepository = (*windowRepositoryMiddleware)(nil)
+
+type windowRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   notifiers.MaintenanceWindowRepository
+}
+
+// MaintenanceWindowRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func MaintenanceWindowRepositoryMiddleware(tracer opentracing.Tracer, repo notifiers.MaintenanceWindowRepository) notifiers.MaintenanceWindowRepository {
+	return windowRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (w windowRepositoryMiddleware) Save(ctx context.Context, mws ...notifiers.MaintenanceWindow) ([]notifiers.MaintenanceWindow, error) {
+	span := createSpan(ctx, w.tracer, "save_maintenance_windows")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return w.repo.Save(ctx, mws...)
+}
+
+func (w windowRepositoryMiddleware) RetrieveByGroupID(ctx context.Context, groupID string, pm things.PageMetadata) (notifiers.MaintenanceWindowsPage, error) {
+	span := createSpan(ctx, w.tracer, "retrieve_maintenance_windows_by_group_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return w.repo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (w windowRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (notifiers.MaintenanceWindow, error) {
+	span := createSpan(ctx, w.tracer, "retrieve_maintenance_window_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return w.repo.RetrieveByID(ctx, id)
+}
+
+func (w windowRepositoryMiddleware) Update(ctx context.Context, mw notifiers.MaintenanceWindow) error {
+	span := createSpan(ctx, w.tracer, "update_maintenance_window")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return w.repo.Update(ctx, mw)
+}
+
+func (w windowRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, w.tracer, "remove_maintenance_windows")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return w.repo.Remove(ctx, ids...)
+}
+
+func (w windowRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, w.tracer, "remove_maintenance_windows_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return w.repo.RemoveByGroupID(ctx, groupID)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+const (
+	// Daily recurrence repeats the maintenance window every day.
+	Daily = "daily"
+	// Weekly recurrence repeats the maintenance window every week.
+	Weekly = "weekly"
+)
+
+var (
+	// ErrInvalidWindow indicates the malformed maintenance window time range or recurrence.
+	ErrInvalidWindow = errors.New("invalid maintenance window")
+
+	// ErrThingGroup indicates that the maintenance window thing doesn't belong to the window group.
+	ErrThingGroup = errors.New("thing doesn't belong to the maintenance window group")
+)
+
+var periods = map[string]time.Duration{
+	Daily:  24 * time.Hour,
+	Weekly: 7 * 24 * time.Hour,
+}
+
+// MaintenanceWindow represents the planned downtime of the group things, or of
+// a single thing of the group, during which no notifications are sent. The
+// recurring windows repeat daily or weekly, starting at Start.
+type MaintenanceWindow struct {
+	ID         string
+	GroupID    string
+	ThingID    string
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Recurrence string
+	Metadata   map[string]interface{}
+}
+
+// Validate returns an error if the window ends before it starts, or if the
+// recurring window lasts longer than its recurrence period.
+func (mw MaintenanceWindow) Validate() error {
+	if !mw.End.After(mw.Start) {
+		return ErrInvalidWindow
+	}
+
+	if mw.Recurrence == "" {
+		return nil
+	}
+
+	period, ok := periods[mw.Recurrence]
+	if !ok || mw.End.Sub(mw.Start) > period {
+		return ErrInvalidWindow
+	}
+
+	return nil
+}
+
+// Active reports whether the window is in effect at the given time.
+func (mw MaintenanceWindow) Active(t time.Time) bool {
+	if t.Before(mw.Start) {
+		return false
+	}
+
+	period, ok := periods[mw.Recurrence]
+	if !ok {
+		return t.Before(mw.End)
+	}
+
+	return t.Sub(mw.Start)%period < mw.End.Sub(mw.Start)
+}
+
+// Covers reports whether the window silences the notifications of the given thing.
+func (mw MaintenanceWindow) Covers(thingID string) bool {
+	return mw.ThingID == "" || mw.ThingID == thingID
+}
+
+// MaintenanceWindowsPage contains page related metadata as well as a list of
+// maintenance windows that belong to this page.
+type MaintenanceWindowsPage struct {
+	things.PageMetadata
+	MaintenanceWindows []MaintenanceWindow
+}
+
+// MaintenanceWindowRepository specifies a maintenance window persistence API.
+type MaintenanceWindowRepository interface {
+	// Save persists multiple maintenance windows. Windows are saved using a transaction.
+	// If one window fails then none will be saved.
+	Save(ctx context.Context, mws ...MaintenanceWindow) ([]MaintenanceWindow, error)
+
+	// RetrieveByGroupID retrieves maintenance windows related to a certain group
+	// identified by a given ID. All the windows of the group are retrieved if the limit is 0.
+	RetrieveByGroupID(ctx context.Context, groupID string, pm things.PageMetadata) (MaintenanceWindowsPage, error)
+
+	// RetrieveByID retrieves the maintenance window having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (MaintenanceWindow, error)
+
+	// Update performs an update to the existing maintenance window. A non-nil error is
+	// returned to indicate operation failure.
+	Update(ctx context.Context, mw MaintenanceWindow) error
+
+	// Remove removes the maintenance windows having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the maintenance windows related to a certain group
+	// identified by a given ID.
+	RemoveByGroupID(ctx context.Context, groupID string) error
+}
+
+func (ns *notifierService) CreateMaintenanceWindows(ctx context.Context, token, groupID string, windows ...MaintenanceWindow) ([]MaintenanceWindow, error) {
+	if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return []MaintenanceWindow{}, err
+	}
+
+	mws := []MaintenanceWindow{}
+	for _, mw := range windows {
+		mw.GroupID = groupID
+		if err := ns.validateWindow(ctx, mw); err != nil {
+			return []MaintenanceWindow{}, err
+		}
+
+		id, err := ns.idp.ID()
+		if err != nil {
+			return []MaintenanceWindow{}, err
+		}
+		mw.ID = id
+
+		mws = append(mws, mw)
+	}
+
+	return ns.windowRepo.Save(ctx, mws...)
+}
+
+func (ns *notifierService) ListMaintenanceWindowsByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (MaintenanceWindowsPage, error) {
+	if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return MaintenanceWindowsPage{}, err
+	}
+
+	return ns.windowRepo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (ns *notifierService) ViewMaintenanceWindow(ctx context.Context, token, id string) (MaintenanceWindow, error) {
+	return ns.retrieveWindow(ctx, token, id, things.Viewer)
+}
+
+func (ns *notifierService) UpdateMaintenanceWindow(ctx context.Context, token string, window MaintenanceWindow) error {
+	mw, err := ns.retrieveWindow(ctx, token, window.ID, things.Editor)
+	if err != nil {
+		return err
+	}
+
+	window.GroupID = mw.GroupID
+	if err := ns.validateWindow(ctx, window); err != nil {
+		return err
+	}
+
+	return ns.windowRepo.Update(ctx, window)
+}
+
+func (ns *notifierService) RemoveMaintenanceWindows(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		mw, err := ns.windowRepo.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: mw.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := ns.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return ns.windowRepo.Remove(ctx, ids...)
+}
+
+func (ns *notifierService) retrieveWindow(ctx context.Context, token, id, action string) (MaintenanceWindow, error) {
+	mw, err := ns.windowRepo.RetrieveByID(ctx, id)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+
+	if _, err := ns.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: mw.GroupID, Subject: things.GroupSub, Action: action}); err != nil {
+		return MaintenanceWindow{}, err
+	}
+
+	return mw, nil
+}
+
+func (ns *notifierService) validateWindow(ctx context.Context, mw MaintenanceWindow) error {
+	if err := mw.Validate(); err != nil {
+		return err
+	}
+
+	if mw.ThingID == "" {
+		return nil
+	}
+
+	grID, err := ns.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: mw.ThingID})
+	if err != nil {
+		return err
+	}
+
+	if grID.GetValue() != mw.GroupID {
+		return errors.Wrap(errors.ErrAuthorization, ErrThingGroup)
+	}
+
+	return nil
+}
+
+// silenced reports whether an active maintenance window of the group covers
+// the thing which published the message.
+func (ns *notifierService) silenced(ctx context.Context, groupID string, msg protomfx.Message) (bool, error) {
+	page, err := ns.windowRepo.RetrieveByGroupID(ctx, groupID, things.PageMetadata{})
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	for _, mw := range page.MaintenanceWindows {
+		if mw.Covers(msg.Publisher) && mw.Active(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
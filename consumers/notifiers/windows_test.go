@@ -0,0 +1,71 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package notifiers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/notifiers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowActive(t *testing.T) {
+	start := time.Date(2024, time.March, 4, 22, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	cases := []struct {
+		desc       string
+		recurrence string
+		time       time.Time
+		active     bool
+	}{
+		{
+			desc:   "one-off window before start",
+			time:   start.Add(-time.Minute),
+			active: false,
+		},
+		{
+			desc:   "one-off window during window",
+			time:   start.Add(time.Hour),
+			active: true,
+		},
+		{
+			desc:   "one-off window after end",
+			time:   end,
+			active: false,
+		},
+		{
+			desc:       "daily window on next day",
+			recurrence: notifiers.Daily,
+			time:       start.Add(24*time.Hour + time.Hour),
+			active:     true,
+		},
+		{
+			desc:       "daily window between occurrences",
+			recurrence: notifiers.Daily,
+			time:       start.Add(12 * time.Hour),
+			active:     false,
+		},
+		{
+			desc:       "weekly window on next day",
+			recurrence: notifiers.Weekly,
+			time:       start.Add(24*time.Hour + time.Hour),
+			active:     false,
+		},
+		{
+			desc:       "weekly window on next week",
+			recurrence: notifiers.Weekly,
+			time:       start.Add(7*24*time.Hour + time.Hour),
+			active:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		mw := notifiers.MaintenanceWindow{Start: start, End: end, Recurrence: tc.recurrence}
+		active := mw.Active(tc.time)
+		assert.Equal(t, tc.active, active, fmt.Sprintf("%s: expected %t got %t\n", tc.desc, tc.active, active))
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres contains the PostgreSQL repository of the processed
+// messages, shared by the idempotent consumers. The processed_messages table
+// is created by the migrations of the consumer services.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var _ consumers.ProcessedRepository = (*processedRepository)(nil)
+
+// Database specifies the database API used by the processed messages
+// repository, which is implemented by the databases of the consumer services.
+type Database interface {
+	NamedExecContext(context.Context, string, interface{}) (sql.Result, error)
+	GetContext(context.Context, interface{}, string, ...interface{}) error
+}
+
+type processedRepository struct {
+	db Database
+}
+
+// NewProcessedRepository instantiates a PostgreSQL implementation of processed
+// messages repository.
+func NewProcessedRepository(db Database) consumers.ProcessedRepository {
+	return &processedRepository{
+		db: db,
+	}
+}
+
+func (pr processedRepository) Processed(ctx context.Context, id string) (bool, error) {
+	q := `SELECT EXISTS (SELECT 1 FROM processed_messages WHERE id = $1);`
+
+	var processed bool
+	if err := pr.db.GetContext(ctx, &processed, q, id); err != nil {
+		return false, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return processed, nil
+}
+
+func (pr processedRepository) Save(ctx context.Context, id string) error {
+	q := `INSERT INTO processed_messages (id, processed_at) VALUES (:id, :processed_at) ON CONFLICT (id) DO NOTHING;`
+
+	dbpm := dbProcessedMessage{
+		ID:          id,
+		ProcessedAt: time.Now().UTC(),
+	}
+
+	if _, err := pr.db.NamedExecContext(ctx, q, dbpm); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (pr processedRepository) RemoveBefore(ctx context.Context, t time.Time) error {
+	q := `DELETE FROM processed_messages WHERE processed_at < :processed_at;`
+
+	if _, err := pr.db.NamedExecContext(ctx, q, dbProcessedMessage{ProcessedAt: t.UTC()}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbProcessedMessage struct {
+	ID          string    `db:"id"`
+	ProcessedAt time.Time `db:"processed_at"`
+}
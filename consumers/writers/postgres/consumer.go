@@ -6,6 +6,7 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"math"
 
 	"github.com/MainfluxLabs/mainflux/consumers"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -92,8 +93,8 @@ func (pr postgresRepo) saveJSON(messages interface{}) error {
 	if !ok {
 		return errors.ErrSaveMessage
 	}
-	q := `INSERT INTO json (created, subtopic, publisher, protocol, payload)
-          VALUES (:created, :subtopic, :publisher, :protocol, :payload);`
+	q := `INSERT INTO json (created, subtopic, publisher, protocol, payload, name, unit, value, string_value, bool_value)
+          VALUES (:created, :subtopic, :publisher, :protocol, :payload, :name, :unit, :value, :string_value, :bool_value);`
 
 	tx, err := pr.db.BeginTxx(context.Background(), nil)
 	if err != nil {
@@ -139,11 +140,16 @@ type senmlMessage struct {
 }
 
 type jsonMessage struct {
-	Created   int64  `db:"created"`
-	Subtopic  string `db:"subtopic"`
-	Publisher string `db:"publisher"`
-	Protocol  string `db:"protocol"`
-	Payload   []byte `db:"payload"`
+	Created     int64    `db:"created"`
+	Subtopic    string   `db:"subtopic"`
+	Publisher   string   `db:"publisher"`
+	Protocol    string   `db:"protocol"`
+	Payload     []byte   `db:"payload"`
+	Name        *string  `db:"name"`
+	Unit        *string  `db:"unit"`
+	Value       *float64 `db:"value"`
+	StringValue *string  `db:"string_value"`
+	BoolValue   *bool    `db:"bool_value"`
 }
 
 func toJSONMessage(msg mfjson.Message) (jsonMessage, error) {
@@ -163,6 +169,31 @@ func toJSONMessage(msg mfjson.Message) (jsonMessage, error) {
 		Protocol:  msg.Protocol,
 		Payload:   data,
 	}
+	m.extract(msg.Payload)
 
 	return m, nil
 }
+
+// extract sets the indexed columns of the top level name, unit and value
+// fields of the payload. The fields of the other types, and the numbers
+// which don't fit the column, are left empty.
+func (m *jsonMessage) extract(payload mfjson.Payload) {
+	if name, ok := payload["name"].(string); ok {
+		m.Name = &name
+	}
+
+	if unit, ok := payload["unit"].(string); ok {
+		m.Unit = &unit
+	}
+
+	switch v := payload["value"].(type) {
+	case float64:
+		if !math.IsInf(v, 0) && !math.IsNaN(v) {
+			m.Value = &v
+		}
+	case string:
+		m.StringValue = &v
+	case bool:
+		m.BoolValue = &v
+	}
+}
@@ -6,44 +6,57 @@ package postgres
 import (
 	"fmt"
 
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
+// backfillBatch is the number of messages numbered in a single transaction.
+const backfillBatch = 10000
+
 // Config defines the options that are used when connecting to a PostgreSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the PostgreSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate
-// failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
+// Migrations returns the database migrations of the service. The migrations
+// are shared with the PostgreSQL reader, which reads the same database.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
 		Migrations: []*migrate.Migration{
 			{
 				Id: "messages_1",
@@ -84,9 +97,151 @@ func migrateDB(db *sqlx.DB) error {
 					`ALTER TABLE json DROP CONSTRAINT json_pkey`,
 				},
 			},
+			{
+				// The nullable columns without the default are added without
+				// rewriting the table. They're filled in by the writer.
+				Id: "messages_3",
+				Up: []string{
+					`ALTER TABLE json
+						ADD COLUMN IF NOT EXISTS name         TEXT,
+						ADD COLUMN IF NOT EXISTS unit         TEXT,
+						ADD COLUMN IF NOT EXISTS value        FLOAT,
+						ADD COLUMN IF NOT EXISTS string_value TEXT,
+						ADD COLUMN IF NOT EXISTS bool_value   BOOL`,
+				},
+				Down: []string{
+					`ALTER TABLE json DROP COLUMN name, DROP COLUMN unit, DROP COLUMN value, DROP COLUMN string_value, DROP COLUMN bool_value`,
+				},
+			},
+			{
+				Id: "messages_4",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS saved_queries (
+						id            UUID PRIMARY KEY,
+						name          VARCHAR(254) NOT NULL UNIQUE,
+						filters       JSONB NOT NULL
+					)`,
+				},
+				Down: []string{
+					"DROP TABLE saved_queries",
+				},
+			},
+			{
+				// The indexes are built concurrently, so that the writes aren't
+				// blocked, which can't be done in the transaction. The invalid
+				// index left by the interrupted build is dropped first.
+				Id: "messages_5",
+				Up: []string{
+					`DROP INDEX CONCURRENTLY IF EXISTS json_publisher_name_created_idx`,
+					`CREATE INDEX CONCURRENTLY json_publisher_name_created_idx ON json (publisher, name, created DESC)`,
+					`DROP INDEX CONCURRENTLY IF EXISTS json_name_value_idx`,
+					`CREATE INDEX CONCURRENTLY json_name_value_idx ON json (name, value)`,
+				},
+				Down: []string{
+					`DROP INDEX CONCURRENTLY IF EXISTS json_name_value_idx`,
+					`DROP INDEX CONCURRENTLY IF EXISTS json_publisher_name_created_idx`,
+				},
+				DisableTransactionUp:   true,
+				DisableTransactionDown: true,
+			},
+			{
+				// The ID is the unique tiebreak of the messages having the same
+				// time, which the page cursor points to. The column is added
+				// without rewriting the table, so the default numbers only the
+				// new messages. The existing ones are numbered by messages_8.
+				Id: "messages_6",
+				Up: []string{
+					`CREATE SEQUENCE IF NOT EXISTS messages_id_seq`,
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS id BIGINT`,
+					`ALTER TABLE messages ALTER COLUMN id SET DEFAULT nextval('messages_id_seq')`,
+					`ALTER SEQUENCE messages_id_seq OWNED BY messages.id`,
+					`CREATE SEQUENCE IF NOT EXISTS json_id_seq`,
+					`ALTER TABLE json ADD COLUMN IF NOT EXISTS id BIGINT`,
+					`ALTER TABLE json ALTER COLUMN id SET DEFAULT nextval('json_id_seq')`,
+					`ALTER SEQUENCE json_id_seq OWNED BY json.id`,
+				},
+				Down: []string{
+					`ALTER TABLE json DROP COLUMN id`,
+					`ALTER TABLE messages DROP COLUMN id`,
+				},
+			},
+			{
+				Id: "messages_7",
+				Up: []string{
+					`DROP INDEX CONCURRENTLY IF EXISTS messages_time_id_idx`,
+					`CREATE INDEX CONCURRENTLY messages_time_id_idx ON messages (time DESC, id)`,
+					`DROP INDEX CONCURRENTLY IF EXISTS json_created_id_idx`,
+					`CREATE INDEX CONCURRENTLY json_created_id_idx ON json (created DESC, id)`,
+				},
+				Down: []string{
+					`DROP INDEX CONCURRENTLY IF EXISTS json_created_id_idx`,
+					`DROP INDEX CONCURRENTLY IF EXISTS messages_time_id_idx`,
+				},
+				DisableTransactionUp:   true,
+				DisableTransactionDown: true,
+			},
+			{
+				// The messages stored before messages_6 are numbered in batches
+				// following the time index, each batch in its own transaction,
+				// so that the writes are blocked only on the batch being
+				// numbered. Once all the messages are numbered, the ID can't
+				// be missing anymore.
+				Id: "messages_8",
+				Up: []string{
+					backfillIDs("messages", "time"),
+					`ALTER TABLE messages ALTER COLUMN id SET NOT NULL`,
+					backfillIDs("json", "created"),
+					`ALTER TABLE json ALTER COLUMN id SET NOT NULL`,
+				},
+				Down: []string{
+					`ALTER TABLE json ALTER COLUMN id DROP NOT NULL`,
+					`ALTER TABLE messages ALTER COLUMN id DROP NOT NULL`,
+				},
+				DisableTransactionUp: true,
+			},
+			{
+				// The saved queries are owned by the users, and their names are
+				// unique per owner. The queries saved before are owned by no
+				// user, so they're left to be assigned to their owners.
+				Id: "messages_9",
+				Up: []string{
+					`ALTER TABLE saved_queries
+						ADD COLUMN IF NOT EXISTS owner_id VARCHAR(254) NOT NULL DEFAULT '',
+						ADD COLUMN IF NOT EXISTS org_id   VARCHAR(254) NOT NULL DEFAULT ''`,
+					`ALTER TABLE saved_queries DROP CONSTRAINT IF EXISTS saved_queries_name_key`,
+					`ALTER TABLE saved_queries ADD CONSTRAINT saved_queries_owner_id_name_key UNIQUE (owner_id, name)`,
+				},
+				Down: []string{
+					`ALTER TABLE saved_queries DROP CONSTRAINT saved_queries_owner_id_name_key`,
+					`ALTER TABLE saved_queries ADD CONSTRAINT saved_queries_name_key UNIQUE (name)`,
+					`ALTER TABLE saved_queries DROP COLUMN owner_id, DROP COLUMN org_id`,
+				},
+			},
 		},
 	}
+}
 
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
+// backfillIDs returns the statement numbering the messages of the table
+// which have no ID, in batches of backfillBatch messages ordered by the
+// time column. Every batch is committed separately, so the statement must
+// be run outside of a transaction.
+func backfillIDs(table, column string) string {
+	return fmt.Sprintf(`DO $$
+	DECLARE
+		cur %[1]s.%[2]s%%TYPE;
+		nxt %[1]s.%[2]s%%TYPE;
+	BEGIN
+		SELECT min(%[2]s) INTO cur FROM %[1]s;
+		WHILE cur IS NOT NULL LOOP
+			SELECT %[2]s INTO nxt FROM %[1]s WHERE %[2]s >= cur ORDER BY %[2]s LIMIT 1 OFFSET %[3]d;
+			IF nxt = cur THEN
+				SELECT min(%[2]s) INTO nxt FROM %[1]s WHERE %[2]s > cur;
+			END IF;
+			UPDATE %[1]s SET id = nextval('%[1]s_id_seq')
+			WHERE id IS NULL AND %[2]s >= cur AND (nxt IS NULL OR %[2]s < nxt);
+			COMMIT;
+			cur := nxt;
+		END LOOP;
+		UPDATE %[1]s SET id = nextval('%[1]s_id_seq') WHERE id IS NULL;
+	END $$`, table, column, backfillBatch)
 }
@@ -0,0 +1,229 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	clientshttp "github.com/MainfluxLabs/mainflux/pkg/clients/http"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// NameLabel is the label holding the metric name.
+	NameLabel      = "__name__"
+	ThingLabel     = "thing"
+	SubtopicLabel  = "subtopic"
+	ProtocolLabel  = "protocol"
+	UnitLabel      = "unit"
+	remoteWriteVer = "0.1.0"
+)
+
+var errRemoteWrite = errors.New("remote write request failed")
+
+var _ consumers.Consumer = (*prometheusRepo)(nil)
+
+// Config defines the remote write endpoint the samples are pushed to.
+type Config struct {
+	URL      string
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+type prometheusRepo struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+// New returns new Prometheus remote write writer.
+func New(cfg Config) consumers.Consumer {
+	headers := map[string]string{
+		"Content-Type":                      "application/x-protobuf",
+		"Content-Encoding":                  "snappy",
+		"X-Prometheus-Remote-Write-Version": remoteWriteVer,
+	}
+	if cfg.Username != "" {
+		creds := base64.StdEncoding.EncodeToString([]byte(cfg.Username + ":" + cfg.Password))
+		headers["Authorization"] = "Basic " + creds
+	}
+
+	return &prometheusRepo{
+		client:  &http.Client{Timeout: cfg.Timeout},
+		url:     cfg.URL,
+		headers: headers,
+	}
+}
+
+func (pr prometheusRepo) Consume(message interface{}) error {
+	msgs, ok := message.([]senml.Message)
+	if !ok {
+		return errors.ErrSaveMessage
+	}
+
+	series := toTimeSeries(msgs)
+	if len(series) == 0 {
+		return nil
+	}
+
+	body := snappy.Encode(nil, encodeWriteRequest(series))
+	resp, err := clientshttp.SendRequestWithClient(pr.client, http.MethodPost, pr.url, body, pr.headers)
+	if err != nil {
+		return errors.Wrap(errors.ErrSaveMessage, errors.Wrap(clientshttp.ErrSendRequest, err))
+	}
+	defer resp.Body.Close()
+
+	// The endpoint responds with the cause of the rejected samples in the body.
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		err := errors.New(fmt.Sprintf("%s: %s", resp.Status, strings.TrimSpace(string(msg))))
+		return errors.Wrap(errors.ErrSaveMessage, errors.Wrap(errRemoteWrite, err))
+	}
+
+	return nil
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+type sample struct {
+	value     float64
+	timestamp int64
+}
+
+type timeSeries struct {
+	labels  []label
+	samples []sample
+}
+
+// toTimeSeries converts the SenML records with the numeric value into the
+// samples, grouped by the series they belong to. The records without the
+// name or the numeric value are skipped.
+func toTimeSeries(msgs []senml.Message) []timeSeries {
+	var series []timeSeries
+	index := make(map[string]int)
+	for _, msg := range msgs {
+		name := metricName(msg.Name)
+		if name == "" || msg.Value == nil {
+			continue
+		}
+
+		labels := []label{{NameLabel, name}}
+		for _, l := range []label{
+			{ProtocolLabel, msg.Protocol},
+			{SubtopicLabel, msg.Subtopic},
+			{ThingLabel, msg.Publisher},
+			{UnitLabel, msg.Unit},
+		} {
+			if l.value != "" {
+				labels = append(labels, l)
+			}
+		}
+
+		key := seriesKey(labels)
+		i, ok := index[key]
+		if !ok {
+			i = len(series)
+			index[key] = i
+			series = append(series, timeSeries{labels: labels})
+		}
+
+		s := sample{value: *msg.Value, timestamp: int64(msg.Time * 1e3)}
+		series[i].samples = append(series[i].samples, s)
+	}
+
+	// The samples of a series have to be pushed in the chronological order.
+	for _, ts := range series {
+		sort.SliceStable(ts.samples, func(i, j int) bool {
+			return ts.samples[i].timestamp < ts.samples[j].timestamp
+		})
+	}
+
+	return series
+}
+
+// metricName replaces the characters not allowed in the metric names by
+// underscores, prefixing the name starting with a digit by an underscore.
+func metricName(name string) string {
+	if name == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				sb.WriteByte('_')
+			}
+		default:
+			c = '_'
+		}
+		sb.WriteRune(c)
+	}
+
+	return sb.String()
+}
+
+func seriesKey(labels []label) string {
+	var sb strings.Builder
+	for _, l := range labels {
+		sb.WriteString(l.name)
+		sb.WriteByte(0)
+		sb.WriteString(l.value)
+		sb.WriteByte(0)
+	}
+
+	return sb.String()
+}
+
+// encodeWriteRequest encodes the series as the remote write WriteRequest
+// protobuf message. The labels are already sorted by their names.
+func encodeWriteRequest(series []timeSeries) []byte {
+	var req []byte
+	for _, ts := range series {
+		var buf []byte
+		for _, l := range ts.labels {
+			var lb []byte
+			lb = protowire.AppendTag(lb, 1, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.name)
+			lb = protowire.AppendTag(lb, 2, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.value)
+
+			buf = protowire.AppendTag(buf, 1, protowire.BytesType)
+			buf = protowire.AppendBytes(buf, lb)
+		}
+
+		for _, s := range ts.samples {
+			var sb []byte
+			sb = protowire.AppendTag(sb, 1, protowire.Fixed64Type)
+			sb = protowire.AppendFixed64(sb, math.Float64bits(s.value))
+			sb = protowire.AppendTag(sb, 2, protowire.VarintType)
+			sb = protowire.AppendVarint(sb, uint64(s.timestamp))
+
+			buf = protowire.AppendTag(buf, 2, protowire.BytesType)
+			buf = protowire.AppendBytes(buf, sb)
+		}
+
+		req = protowire.AppendTag(req, 1, protowire.BytesType)
+		req = protowire.AppendBytes(req, buf)
+	}
+
+	return req
+}
@@ -0,0 +1,188 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus_test
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers/writers/prometheus"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	publisher = "2580f0a9-0bc1-4d2e-bd2e-d0e1b6ff4c54"
+	username  = "mainflux"
+	password  = "secret"
+)
+
+type sample struct {
+	value     float64
+	timestamp int64
+}
+
+type series struct {
+	labels  map[string]string
+	samples []sample
+}
+
+type remoteWrite struct {
+	series  []series
+	headers http.Header
+}
+
+func TestConsume(t *testing.T) {
+	var writes []remoteWrite
+	status := http.StatusNoContent
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err, fmt.Sprintf("unexpected error reading request body: %s", err))
+		data, err := snappy.Decode(nil, body)
+		require.Nil(t, err, fmt.Sprintf("unexpected error decoding request body: %s", err))
+		writes = append(writes, remoteWrite{series: decodeWriteRequest(t, data), headers: r.Header})
+		w.WriteHeader(status)
+	}))
+	defer ts.Close()
+
+	repo := prometheus.New(prometheus.Config{URL: ts.URL, Username: username, Password: password, Timeout: time.Second})
+
+	temp, hum, str := 21.5, 40.0, "on"
+	now := float64(time.Now().Unix())
+	msgs := []senml.Message{
+		{Publisher: publisher, Subtopic: "room", Protocol: "mqtt", Name: "temperature", Unit: "Cel", Time: now + 1, Value: &temp},
+		{Publisher: publisher, Subtopic: "room", Protocol: "mqtt", Name: "temperature", Unit: "Cel", Time: now, Value: &temp},
+		{Publisher: publisher, Protocol: "http", Name: "1-humidity.rel", Time: now, Value: &hum},
+		{Publisher: publisher, Protocol: "http", Name: "switch", Time: now, StringValue: &str},
+	}
+
+	cases := []struct {
+		desc   string
+		msgs   interface{}
+		status int
+		series []series
+		err    error
+	}{
+		{
+			desc:   "consume numeric SenML messages",
+			msgs:   msgs,
+			status: http.StatusNoContent,
+			series: []series{
+				{
+					labels:  map[string]string{"__name__": "temperature", "protocol": "mqtt", "subtopic": "room", "thing": publisher, "unit": "Cel"},
+					samples: []sample{{temp, int64(now * 1e3)}, {temp, int64((now + 1) * 1e3)}},
+				},
+				{
+					labels:  map[string]string{"__name__": "_1_humidity_rel", "protocol": "http", "thing": publisher},
+					samples: []sample{{hum, int64(now * 1e3)}},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc:   "consume SenML messages without numeric values",
+			msgs:   msgs[3:],
+			status: http.StatusNoContent,
+			series: nil,
+			err:    nil,
+		},
+		{
+			desc:   "consume messages rejected by remote write endpoint",
+			msgs:   msgs[:1],
+			status: http.StatusBadRequest,
+			series: []series{
+				{
+					labels:  map[string]string{"__name__": "temperature", "protocol": "mqtt", "subtopic": "room", "thing": publisher, "unit": "Cel"},
+					samples: []sample{{temp, int64((now + 1) * 1e3)}},
+				},
+			},
+			err: errors.ErrSaveMessage,
+		},
+		{
+			desc:   "consume JSON messages",
+			msgs:   json.Messages{Data: []json.Message{{Publisher: publisher}}},
+			status: http.StatusNoContent,
+			series: nil,
+			err:    errors.ErrSaveMessage,
+		},
+	}
+
+	for _, tc := range cases {
+		writes = nil
+		status = tc.status
+
+		err := repo.Consume(tc.msgs)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		if tc.series == nil {
+			assert.Empty(t, writes, fmt.Sprintf("%s: expected no remote write request\n", tc.desc))
+			continue
+		}
+
+		require.Len(t, writes, 1, fmt.Sprintf("%s: expected single remote write request\n", tc.desc))
+		assert.Equal(t, tc.series, writes[0].series, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.series, writes[0].series))
+		assert.Equal(t, "snappy", writes[0].headers.Get("Content-Encoding"), fmt.Sprintf("%s: expected snappy content encoding\n", tc.desc))
+		user, pass, ok := (&http.Request{Header: writes[0].headers}).BasicAuth()
+		assert.True(t, ok && user == username && pass == password, fmt.Sprintf("%s: expected basic auth credentials\n", tc.desc))
+	}
+}
+
+// decodeWriteRequest decodes the remote write WriteRequest protobuf message.
+func decodeWriteRequest(t *testing.T, data []byte) []series {
+	var res []series
+	for _, ts := range decodeFields(t, data)[1] {
+		s := series{labels: map[string]string{}}
+		fields := decodeFields(t, ts.([]byte))
+		for _, l := range fields[1] {
+			lf := decodeFields(t, l.([]byte))
+			s.labels[string(lf[1][0].([]byte))] = string(lf[2][0].([]byte))
+		}
+		for _, smp := range fields[2] {
+			sf := decodeFields(t, smp.([]byte))
+			s.samples = append(s.samples, sample{
+				value:     math.Float64frombits(sf[1][0].(uint64)),
+				timestamp: int64(sf[2][0].(uint64)),
+			})
+		}
+		res = append(res, s)
+	}
+
+	return res
+}
+
+func decodeFields(t *testing.T, data []byte) map[protowire.Number][]interface{} {
+	fields := map[protowire.Number][]interface{}{}
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		require.GreaterOrEqual(t, n, 0, "unexpected invalid protobuf tag")
+		data = data[n:]
+
+		var val interface{}
+		switch typ {
+		case protowire.BytesType:
+			val, n = protowire.ConsumeBytes(data)
+		case protowire.Fixed64Type:
+			val, n = protowire.ConsumeFixed64(data)
+		case protowire.VarintType:
+			val, n = protowire.ConsumeVarint(data)
+		default:
+			require.Fail(t, fmt.Sprintf("unexpected protobuf wire type %d", typ))
+		}
+		require.GreaterOrEqual(t, n, 0, "unexpected invalid protobuf field")
+		fields[num] = append(fields[num], val)
+		data = data[n:]
+	}
+
+	return fields
+}
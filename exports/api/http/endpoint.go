@@ -0,0 +1,28 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/exports"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func exportOrgEndpoint(svc exports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exportOrgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		archive, err := svc.ExportOrg(ctx, req.token, req.orgID, time.Unix(req.from, 0), time.Unix(req.to, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		return archiveRes{archive: archive}, nil
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/exports"
+	httpapi "github.com/MainfluxLabs/mainflux/exports/api/http"
+	exmocks "github.com/MainfluxLabs/mainflux/exports/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminToken     = "admin@example.com"
+	token          = "user@example.com"
+	wrongValue     = "wrong-value"
+	orgID          = "374106f7-030e-4881-8ab0-151195c29f92"
+	zipContentType = "application/zip"
+)
+
+var (
+	admin     = users.User{ID: "874106f7-030e-4881-8ab0-151195c29f97", Email: adminToken, Role: auth.RootSub}
+	user      = users.User{ID: "674106f7-030e-4881-8ab0-151195c29f95", Email: token, Role: auth.Owner}
+	usersList = []users.User{admin, user}
+	org       = exmocks.Org{
+		Org:      json.RawMessage(`{"id":"` + orgID + `","name":"org"}`),
+		Members:  []json.RawMessage{json.RawMessage(`{"id":"` + user.ID + `","email":"` + user.Email + `","role":"owner"}`)},
+		Activity: []json.RawMessage{},
+		Groups:   []json.RawMessage{},
+		Messages: map[string][]json.RawMessage{
+			"messages": {json.RawMessage(`{"time":1500,"name":"temp","value":20}`)},
+		},
+	}
+)
+
+type testRequest struct {
+	client *http.Client
+	method string
+	url    string
+	token  string
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newService(tempDir string) exports.Service {
+	authSvc := mocks.NewAuthService(admin.ID, usersList)
+	platform := exmocks.NewPlatform(map[string]exmocks.Org{orgID: org})
+	return exports.New(authSvc, platform, tempDir)
+}
+
+func newHTTPServer(svc exports.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
+	return httptest.NewServer(mux)
+}
+
+func TestExportOrg(t *testing.T) {
+	svc := newService(t.TempDir())
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		orgID  string
+		query  string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "export org",
+			orgID:  orgID,
+			query:  "from=1000&to=2000",
+			auth:   adminToken,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "export org without period",
+			orgID:  orgID,
+			auth:   adminToken,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "export org with from after to",
+			orgID:  orgID,
+			query:  "from=2000&to=1000",
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export org with invalid from",
+			orgID:  orgID,
+			query:  "from=" + wrongValue,
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export org with invalid to",
+			orgID:  orgID,
+			query:  "to=" + wrongValue,
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export org as non-admin user",
+			orgID:  orgID,
+			auth:   token,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "export org with invalid token",
+			orgID:  orgID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "export org without token",
+			orgID:  orgID,
+			auth:   "",
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "export non-existing org",
+			orgID:  wrongValue,
+			auth:   adminToken,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/orgs/%s/export?%s", ts.URL, tc.orgID, tc.query),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		body, err := io.ReadAll(res.Body)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		assert.Equal(t, zipContentType, res.Header.Get("Content-Type"), fmt.Sprintf("%s: expected zip content type", tc.desc))
+		assert.Contains(t, res.Header.Get("Content-Disposition"), fmt.Sprintf(`filename="org-%s-`, tc.orgID), fmt.Sprintf("%s: expected archive file name", tc.desc))
+
+		zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		names := []string{}
+		for _, zf := range zr.File {
+			names = append(names, zf.Name)
+		}
+		assert.Contains(t, names, exports.ManifestFile, fmt.Sprintf("%s: expected manifest in archive", tc.desc))
+	}
+}
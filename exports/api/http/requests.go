@@ -0,0 +1,29 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import "github.com/MainfluxLabs/mainflux/pkg/apiutil"
+
+type exportOrgReq struct {
+	token string
+	orgID string
+	from  int64
+	to    int64
+}
+
+func (req exportOrgReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	if req.from < 0 || req.from > req.to {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MainfluxLabs/mainflux/exports"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var _ apiutil.Response = (*archiveRes)(nil)
+
+type archiveRes struct {
+	archive exports.Archive
+}
+
+func (res archiveRes) Code() int {
+	return http.StatusOK
+}
+
+func (res archiveRes) Headers() map[string]string {
+	return map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s"`, res.archive.Name),
+		"Content-Length":      strconv.FormatInt(res.archive.Size, 10),
+	}
+}
+
+func (res archiveRes) Empty() bool {
+	return false
+}
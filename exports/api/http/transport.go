@@ -0,0 +1,118 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/exports"
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/go-zoo/bone"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	contentType    = "application/json"
+	zipContentType = "application/zip"
+	idKey          = "id"
+	fromKey        = "from"
+	toKey          = "to"
+	defFrom        = 0
+)
+
+// MakeHandler returns a HTTP handler for API endpoints.
+func MakeHandler(tracer opentracing.Tracer, svc exports.Service, logger log.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, encodeError)),
+	}
+
+	r := bone.New()
+
+	r.Get("/orgs/:id/export", kithttp.NewServer(
+		kitot.TraceServer(tracer, "export_org")(exportOrgEndpoint(svc)),
+		decodeExportOrg,
+		encodeArchiveResponse,
+		opts...,
+	))
+
+	r.GetFunc("/health", mainflux.Health("exports"))
+	r.Handle("/metrics", promhttp.Handler())
+
+	return r
+}
+
+func decodeExportOrg(_ context.Context, r *http.Request) (interface{}, error) {
+	from, err := apiutil.ReadIntQuery(r, fromKey, defFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := apiutil.ReadIntQuery(r, toKey, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	req := exportOrgReq{
+		token: apiutil.ExtractBearerToken(r),
+		orgID: bone.GetValue(r, idKey),
+		from:  from,
+		to:    to,
+	}
+
+	return req, nil
+}
+
+func encodeArchiveResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	ar, ok := response.(archiveRes)
+	if !ok {
+		return nil
+	}
+	defer ar.archive.Content.Close()
+
+	w.Header().Set("Content-Type", zipContentType)
+	for k, v := range ar.Headers() {
+		w.Header().Set(k, v)
+	}
+
+	w.WriteHeader(ar.Code())
+
+	_, err := io.Copy(w, ar.archive.Content)
+	return err
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	switch {
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Contains(err, errors.ErrAuthentication),
+		err == apiutil.ErrBearerToken:
+		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, errors.ErrAuthorization):
+		w.WriteHeader(http.StatusForbidden)
+	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		err == apiutil.ErrMissingOrgID:
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, exports.ErrReadPlatform):
+		w.WriteHeader(http.StatusBadGateway)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	if errorVal, ok := err.(errors.Error); ok {
+		w.Header().Set("Content-Type", contentType)
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
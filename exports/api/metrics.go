@@ -0,0 +1,42 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/exports"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ exports.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     exports.Service
+}
+
+// MetricsMiddleware instruments core service by tracking request count and
+// latency.
+func MetricsMiddleware(svc exports.Service, counter metrics.Counter, latency metrics.Histogram) exports.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (ms *metricsMiddleware) ExportOrg(ctx context.Context, token, orgID string, from, to time.Time) (exports.Archive, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "export_org").Add(1)
+		ms.latency.With("method", "export_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ExportOrg(ctx, token, orgID, from, to)
+}
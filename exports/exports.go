@@ -0,0 +1,320 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package exports
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// The files of the export package.
+const (
+	ManifestFile     = "manifest.json"
+	OrgFile          = "org.json"
+	MembersFile      = "members.json"
+	ActivityFile     = "activity.json"
+	GroupsFile       = "groups.json"
+	GroupExportFile  = "export.json"
+	GroupMembersFile = "members.json"
+	SenMLFile        = "messages/senml.ndjson"
+	JSONFile         = "messages/json.ndjson"
+
+	groupsDir   = "groups"
+	senmlFormat = "messages"
+	jsonFormat  = "json"
+)
+
+// ErrExport indicates failure to generate the export package.
+var ErrExport = errors.New("failed to generate export package")
+
+// File describes the file of the export package. The records are the number
+// of the entities or the messages in the file.
+type File struct {
+	Name    string `json:"name"`
+	Size    int64  `json:"size"`
+	SHA256  string `json:"sha256"`
+	Records uint64 `json:"records"`
+}
+
+// Manifest describes the export package, along with the checksums of its
+// files, so that the package integrity can be verified.
+type Manifest struct {
+	OrgID     string    `json:"org_id"`
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	CreatedAt time.Time `json:"created_at"`
+	Files     []File    `json:"files"`
+}
+
+// Archive represents the generated export package. The package is removed
+// once its content is closed.
+type Archive struct {
+	Name     string
+	Size     int64
+	Manifest Manifest
+	Content  io.ReadCloser
+}
+
+// Service specifies an API that must be fullfiled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// ExportOrg generates the export package of the org identified by the
+	// provided ID, containing the org, its members, their API usage, the
+	// groups with their members, profiles and things, and the messages
+	// published within the org in the given period. Only accessible by admin.
+	ExportOrg(ctx context.Context, token, orgID string, from, to time.Time) (Archive, error)
+}
+
+var _ Service = (*exportsService)(nil)
+
+type exportsService struct {
+	auth     protomfx.AuthServiceClient
+	platform Platform
+	tempDir  string
+}
+
+// New instantiates the exports service implementation. The export packages
+// are generated in the provided temporary directory, or in the default one
+// if empty.
+func New(auth protomfx.AuthServiceClient, platform Platform, tempDir string) Service {
+	return &exportsService{
+		auth:     auth,
+		platform: platform,
+		tempDir:  tempDir,
+	}
+}
+
+func (es *exportsService) ExportOrg(ctx context.Context, token, orgID string, from, to time.Time) (Archive, error) {
+	if _, err := es.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Subject: auth.RootSub}); err != nil {
+		return Archive{}, err
+	}
+
+	// The package is generated in the temporary file, so that the failures
+	// are reported before any of it is sent.
+	f, err := os.CreateTemp(es.tempDir, "org-export-*.zip")
+	if err != nil {
+		return Archive{}, errors.Wrap(ErrExport, err)
+	}
+
+	mf, err := es.writePackage(ctx, f, token, orgID, from, to)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return Archive{}, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return Archive{}, errors.Wrap(ErrExport, err)
+	}
+
+	return Archive{
+		Name:     fmt.Sprintf("org-%s-%s.zip", orgID, mf.CreatedAt.Format("20060102T150405Z")),
+		Size:     info.Size(),
+		Manifest: mf,
+		Content:  tempFile{File: f},
+	}, nil
+}
+
+func (es *exportsService) writePackage(ctx context.Context, w io.Writer, token, orgID string, from, to time.Time) (Manifest, error) {
+	pw := packageWriter{zip: zip.NewWriter(w)}
+	mf := Manifest{
+		OrgID:     orgID,
+		From:      from.UTC(),
+		To:        to.UTC(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	org, err := es.platform.ViewOrg(ctx, token, orgID)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := pw.writeJSON(OrgFile, org, 1); err != nil {
+		return Manifest{}, err
+	}
+
+	members, err := es.platform.ListOrgMembers(ctx, token, orgID)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := pw.writeJSON(MembersFile, members, uint64(len(members))); err != nil {
+		return Manifest{}, err
+	}
+
+	activity, err := es.platform.ListOrgActivity(ctx, token, orgID)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := pw.writeJSON(ActivityFile, activity, uint64(len(activity))); err != nil {
+		return Manifest{}, err
+	}
+
+	groups, err := es.platform.ListGroups(ctx, token, orgID)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := pw.writeJSON(GroupsFile, groups, uint64(len(groups))); err != nil {
+		return Manifest{}, err
+	}
+
+	for _, gr := range groups {
+		var group struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(gr, &group); err != nil {
+			return Manifest{}, errors.Wrap(ErrReadPlatform, err)
+		}
+
+		ge, err := es.platform.ExportGroup(ctx, token, group.ID)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if err := pw.writeJSON(path.Join(groupsDir, group.ID, GroupExportFile), ge, 1); err != nil {
+			return Manifest{}, err
+		}
+
+		grMembers, err := es.platform.ListGroupMembers(ctx, token, group.ID)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if err := pw.writeJSON(path.Join(groupsDir, group.ID, GroupMembersFile), grMembers, uint64(len(grMembers))); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	if err := pw.writeMessages(ctx, es.platform, SenMLFile, token, orgID, senmlFormat, from, to); err != nil {
+		return Manifest{}, err
+	}
+
+	if err := pw.writeMessages(ctx, es.platform, JSONFile, token, orgID, jsonFormat, from, to); err != nil {
+		return Manifest{}, err
+	}
+
+	mf.Files = pw.files
+	if err := pw.writeJSON(ManifestFile, mf, 0); err != nil {
+		return Manifest{}, err
+	}
+
+	if err := pw.zip.Close(); err != nil {
+		return Manifest{}, errors.Wrap(ErrExport, err)
+	}
+
+	return mf, nil
+}
+
+// packageWriter writes the files to the package, keeping track of their
+// sizes and checksums.
+type packageWriter struct {
+	zip   *zip.Writer
+	files []File
+}
+
+func (pw *packageWriter) writeJSON(name string, v interface{}, records uint64) error {
+	fw, err := pw.create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(fw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return errors.Wrap(ErrExport, err)
+	}
+
+	// The manifest doesn't describe itself.
+	if name != ManifestFile {
+		pw.files = append(pw.files, fw.file(name, records))
+	}
+
+	return nil
+}
+
+func (pw *packageWriter) writeMessages(ctx context.Context, p Platform, name, token, orgID, format string, from, to time.Time) error {
+	fw, err := pw.create(name)
+	if err != nil {
+		return err
+	}
+
+	var records uint64
+	err = p.ReadMessages(ctx, token, orgID, format, from, to, func(msg json.RawMessage) error {
+		if _, err := fw.Write(msg); err != nil {
+			return errors.Wrap(ErrExport, err)
+		}
+		if _, err := fw.Write([]byte{'\n'}); err != nil {
+			return errors.Wrap(ErrExport, err)
+		}
+		records++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pw.files = append(pw.files, fw.file(name, records))
+
+	return nil
+}
+
+func (pw *packageWriter) create(name string) (*fileWriter, error) {
+	w, err := pw.zip.Create(name)
+	if err != nil {
+		return nil, errors.Wrap(ErrExport, err)
+	}
+
+	return &fileWriter{w: w, hash: sha256.New()}, nil
+}
+
+type fileWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	size int64
+}
+
+func (fw *fileWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	fw.hash.Write(p[:n])
+	fw.size += int64(n)
+	return n, err
+}
+
+func (fw *fileWriter) file(name string, records uint64) File {
+	return File{
+		Name:    name,
+		Size:    fw.size,
+		SHA256:  hex.EncodeToString(fw.hash.Sum(nil)),
+		Records: records,
+	}
+}
+
+// tempFile removes the temporary file once closed.
+type tempFile struct {
+	*os.File
+}
+
+func (tf tempFile) Close() error {
+	err := tf.File.Close()
+	if rerr := os.Remove(tf.File.Name()); err == nil {
+		err = rerr
+	}
+
+	return err
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/exports"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// Org contains the data of the org read by the platform mock. The groups are
+// identified by their IDs, while the messages are mapped by their format and
+// filtered by their time, in seconds.
+type Org struct {
+	Org          json.RawMessage
+	Members      []json.RawMessage
+	Activity     []json.RawMessage
+	Groups       []json.RawMessage
+	GroupExports map[string]json.RawMessage
+	GroupMembers map[string][]json.RawMessage
+	Messages     map[string][]json.RawMessage
+}
+
+var _ exports.Platform = (*platformMock)(nil)
+
+type platformMock struct {
+	orgs map[string]Org
+}
+
+// NewPlatform returns the platform which reads the data of the provided orgs.
+func NewPlatform(orgs map[string]Org) exports.Platform {
+	return &platformMock{
+		orgs: orgs,
+	}
+}
+
+func (pm *platformMock) ViewOrg(_ context.Context, _, orgID string) (json.RawMessage, error) {
+	org, err := pm.org(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return org.Org, nil
+}
+
+func (pm *platformMock) ListOrgMembers(_ context.Context, _, orgID string) ([]json.RawMessage, error) {
+	org, err := pm.org(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return org.Members, nil
+}
+
+func (pm *platformMock) ListOrgActivity(_ context.Context, _, orgID string) ([]json.RawMessage, error) {
+	org, err := pm.org(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return org.Activity, nil
+}
+
+func (pm *platformMock) ListGroups(_ context.Context, _, orgID string) ([]json.RawMessage, error) {
+	org, err := pm.org(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return org.Groups, nil
+}
+
+func (pm *platformMock) ExportGroup(_ context.Context, _, groupID string) (json.RawMessage, error) {
+	for _, org := range pm.orgs {
+		if ge, ok := org.GroupExports[groupID]; ok {
+			return ge, nil
+		}
+	}
+
+	return nil, errors.Wrap(exports.ErrReadPlatform, errors.ErrNotFound)
+}
+
+func (pm *platformMock) ListGroupMembers(_ context.Context, _, groupID string) ([]json.RawMessage, error) {
+	for _, org := range pm.orgs {
+		if members, ok := org.GroupMembers[groupID]; ok {
+			return members, nil
+		}
+	}
+
+	return nil, errors.Wrap(exports.ErrReadPlatform, errors.ErrNotFound)
+}
+
+func (pm *platformMock) ReadMessages(_ context.Context, _, orgID, format string, from, to time.Time, h exports.MessageHandler) error {
+	org, err := pm.org(orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range org.Messages[format] {
+		var m struct {
+			Time float64 `json:"time"`
+		}
+		if err := json.Unmarshal(msg, &m); err != nil {
+			return errors.Wrap(exports.ErrReadPlatform, err)
+		}
+		if m.Time < float64(from.Unix()) || m.Time > float64(to.Unix()) {
+			continue
+		}
+
+		if err := h(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pm *platformMock) org(orgID string) (Org, error) {
+	org, ok := pm.orgs[orgID]
+	if !ok {
+		return Org{}, errors.Wrap(exports.ErrReadPlatform, errors.ErrNotFound)
+	}
+
+	return org, nil
+}
@@ -0,0 +1,240 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package exports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const (
+	// pageSize is the maximum page size accepted by the auth and things services.
+	pageSize = 100
+	// readLimit is the maximum page size accepted by the readers.
+	readLimit = 1000
+)
+
+// ErrReadPlatform indicates failure to read the org data from the platform services.
+var ErrReadPlatform = errors.New("failed to read org data")
+
+// MessageHandler handles the message read by the platform.
+type MessageHandler func(msg json.RawMessage) error
+
+// Platform specifies an API for reading the org data from the platform
+// services, authorized with the token of the admin requesting the export.
+// The entities are read in their API representation.
+type Platform interface {
+	// ViewOrg retrieves the org identified by the provided ID.
+	ViewOrg(ctx context.Context, token, orgID string) (json.RawMessage, error)
+
+	// ListOrgMembers retrieves the members of the org, along with their roles.
+	ListOrgMembers(ctx context.Context, token, orgID string) ([]json.RawMessage, error)
+
+	// ListOrgActivity retrieves the API usage of the keys issued by the org members.
+	ListOrgActivity(ctx context.Context, token, orgID string) ([]json.RawMessage, error)
+
+	// ListGroups retrieves the groups of the org.
+	ListGroups(ctx context.Context, token, orgID string) ([]json.RawMessage, error)
+
+	// ExportGroup retrieves the profiles and the things of the group, in the
+	// format imported by the things service.
+	ExportGroup(ctx context.Context, token, groupID string) (json.RawMessage, error)
+
+	// ListGroupMembers retrieves the members of the group, along with their roles.
+	ListGroupMembers(ctx context.Context, token, groupID string) ([]json.RawMessage, error)
+
+	// ReadMessages reads the messages of the given format published within
+	// the org in the given period, and calls the handler for each of them.
+	ReadMessages(ctx context.Context, token, orgID, format string, from, to time.Time, h MessageHandler) error
+}
+
+var _ Platform = (*platform)(nil)
+
+type platform struct {
+	authURL    string
+	thingsURL  string
+	readersURL string
+	client     *http.Client
+}
+
+// NewPlatform returns the platform reading the org data from the auth, things
+// and readers services HTTP APIs at the given URLs.
+func NewPlatform(authURL, thingsURL, readersURL string, timeout time.Duration) Platform {
+	return &platform{
+		authURL:    authURL,
+		thingsURL:  thingsURL,
+		readersURL: readersURL,
+		client:     &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *platform) ViewOrg(ctx context.Context, token, orgID string) (json.RawMessage, error) {
+	var org json.RawMessage
+	if err := p.get(ctx, token, fmt.Sprintf("%s/orgs/%s", p.authURL, url.PathEscape(orgID)), &org); err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+func (p *platform) ListOrgMembers(ctx context.Context, token, orgID string) ([]json.RawMessage, error) {
+	return p.list(ctx, token, fmt.Sprintf("%s/orgs/%s/members", p.authURL, url.PathEscape(orgID)), nil, "members")
+}
+
+func (p *platform) ListOrgActivity(ctx context.Context, token, orgID string) ([]json.RawMessage, error) {
+	return p.list(ctx, token, fmt.Sprintf("%s/orgs/%s/activity", p.authURL, url.PathEscape(orgID)), nil, "keys")
+}
+
+func (p *platform) ListGroups(ctx context.Context, token, orgID string) ([]json.RawMessage, error) {
+	params := url.Values{}
+	params.Set("org_id", orgID)
+
+	return p.list(ctx, token, fmt.Sprintf("%s/groups", p.thingsURL), params, "groups")
+}
+
+func (p *platform) ExportGroup(ctx context.Context, token, groupID string) (json.RawMessage, error) {
+	var ge json.RawMessage
+	if err := p.get(ctx, token, fmt.Sprintf("%s/groups/%s/export", p.thingsURL, url.PathEscape(groupID)), &ge); err != nil {
+		return nil, err
+	}
+
+	return ge, nil
+}
+
+func (p *platform) ListGroupMembers(ctx context.Context, token, groupID string) ([]json.RawMessage, error) {
+	return p.list(ctx, token, fmt.Sprintf("%s/groups/%s/members", p.thingsURL, url.PathEscape(groupID)), nil, "group_members")
+}
+
+type messagesPage struct {
+	Messages   []json.RawMessage `json:"messages"`
+	NextCursor string            `json:"next_cursor"`
+}
+
+func (p *platform) ReadMessages(ctx context.Context, token, orgID, format string, from, to time.Time, h MessageHandler) error {
+	params := url.Values{}
+	params.Set("org_id", orgID)
+	params.Set("format", format)
+	params.Set("from", strconv.FormatInt(from.Unix(), 10))
+	params.Set("to", strconv.FormatInt(to.Unix(), 10))
+	params.Set("limit", strconv.Itoa(readLimit))
+
+	// The messages are read using the cursor, so that the messages stored in
+	// the meantime don't shift the pages.
+	for {
+		var page messagesPage
+		if err := p.get(ctx, token, fmt.Sprintf("%s/messages?%s", p.readersURL, params.Encode()), &page); err != nil {
+			return err
+		}
+
+		for _, msg := range page.Messages {
+			if err := h(msg); err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor == "" || len(page.Messages) == 0 {
+			return nil
+		}
+		params.Set("cursor", page.NextCursor)
+	}
+}
+
+// list reads all pages of the entities listed under the given key.
+func (p *platform) list(ctx context.Context, token, path string, params url.Values, key string) ([]json.RawMessage, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("limit", strconv.Itoa(pageSize))
+
+	entities := []json.RawMessage{}
+	for offset := uint64(0); ; offset += pageSize {
+		params.Set("offset", strconv.FormatUint(offset, 10))
+
+		var page map[string]json.RawMessage
+		if err := p.get(ctx, token, fmt.Sprintf("%s?%s", path, params.Encode()), &page); err != nil {
+			return nil, err
+		}
+
+		var items []json.RawMessage
+		if err := unmarshalField(page, key, &items); err != nil {
+			return nil, err
+		}
+		entities = append(entities, items...)
+
+		var total uint64
+		if err := unmarshalField(page, "total", &total); err != nil {
+			return nil, err
+		}
+
+		if len(items) == 0 || offset+pageSize >= total {
+			return entities, nil
+		}
+	}
+}
+
+func (p *platform) get(ctx context.Context, token, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(ErrReadPlatform, err)
+	}
+	req.Header.Set("Authorization", apiutil.BearerPrefix+token)
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(ErrReadPlatform, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Wrap(ErrReadPlatform, statusError(res))
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(ErrReadPlatform, err)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return errors.Wrap(ErrReadPlatform, err)
+	}
+
+	return nil
+}
+
+// statusError returns the error matching the response status, so that the
+// missing or inaccessible entities are reported as such.
+func statusError(res *http.Response) error {
+	switch res.StatusCode {
+	case http.StatusUnauthorized:
+		return errors.ErrAuthentication
+	case http.StatusForbidden:
+		return errors.ErrAuthorization
+	case http.StatusNotFound:
+		return errors.ErrNotFound
+	default:
+		return errors.New(res.Status)
+	}
+}
+
+func unmarshalField(page map[string]json.RawMessage, key string, v interface{}) error {
+	field, ok := page[key]
+	if !ok {
+		return nil
+	}
+
+	if err := json.Unmarshal(field, v); err != nil {
+		return errors.Wrap(ErrReadPlatform, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package exports_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/exports"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nMembers  = 150
+	nMessages = 1500
+	cursor    = "next"
+)
+
+func newPlatformServer() *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/orgs/"+orgID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+adminToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		members := []map[string]string{}
+		for i := offset; i < offset+limit && i < nMembers; i++ {
+			members = append(members, map[string]string{"id": strconv.Itoa(i)})
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": nMembers, "offset": offset, "limit": limit, "members": members})
+	})
+
+	mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		first, next := 0, cursor
+		if r.URL.Query().Get("cursor") == cursor {
+			first, next = limit, ""
+		}
+
+		msgs := []map[string]int{}
+		for i := first; i < first+limit && i < nMessages; i++ {
+			msgs = append(msgs, map[string]int{"time": i})
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"messages": msgs, "next_cursor": next})
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestListOrgMembers(t *testing.T) {
+	ts := newPlatformServer()
+	defer ts.Close()
+
+	platform := exports.NewPlatform(ts.URL, ts.URL, ts.URL, time.Second)
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		size  int
+		err   error
+	}{
+		{
+			desc:  "list all pages of org members",
+			token: adminToken,
+			orgID: orgID,
+			size:  nMembers,
+			err:   nil,
+		},
+		{
+			desc:  "list org members with unauthorized token",
+			token: token,
+			orgID: orgID,
+			size:  0,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "list members of non-existing org",
+			token: adminToken,
+			orgID: wrongValue,
+			size:  0,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		members, err := platform.ListOrgMembers(context.Background(), tc.token, tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(members), fmt.Sprintf("%s: expected %d members got %d\n", tc.desc, tc.size, len(members)))
+	}
+}
+
+func TestReadMessages(t *testing.T) {
+	ts := newPlatformServer()
+	defer ts.Close()
+
+	platform := exports.NewPlatform(ts.URL, ts.URL, ts.URL, time.Second)
+
+	count := 0
+	err := platform.ReadMessages(context.Background(), adminToken, orgID, "messages", from, to, func(msg json.RawMessage) error {
+		count++
+		return nil
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, nMessages, count, fmt.Sprintf("read messages using cursor: expected %d messages got %d\n", nMessages, count))
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package exports_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/exports"
+	exmocks "github.com/MainfluxLabs/mainflux/exports/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminToken = "admin@example.com"
+	token      = "user@example.com"
+	wrongValue = "wrong-value"
+	orgID      = "374106f7-030e-4881-8ab0-151195c29f92"
+	groupID    = "574106f7-030e-4881-8ab0-151195c29f94"
+)
+
+var (
+	admin     = users.User{ID: "874106f7-030e-4881-8ab0-151195c29f97", Email: adminToken, Role: auth.RootSub}
+	user      = users.User{ID: "674106f7-030e-4881-8ab0-151195c29f95", Email: token, Role: auth.Owner}
+	usersList = []users.User{admin, user}
+	from      = time.Unix(1000, 0)
+	to        = time.Unix(2000, 0)
+	org       = exmocks.Org{
+		Org:          json.RawMessage(`{"id":"` + orgID + `","name":"org"}`),
+		Members:      []json.RawMessage{json.RawMessage(`{"id":"` + user.ID + `","email":"` + user.Email + `","role":"owner"}`)},
+		Activity:     []json.RawMessage{},
+		Groups:       []json.RawMessage{json.RawMessage(`{"id":"` + groupID + `","name":"group"}`)},
+		GroupExports: map[string]json.RawMessage{groupID: json.RawMessage(`{"profiles":[],"things":[]}`)},
+		GroupMembers: map[string][]json.RawMessage{groupID: {json.RawMessage(`{"id":"` + user.ID + `","role":"owner"}`)}},
+		Messages: map[string][]json.RawMessage{
+			"messages": {json.RawMessage(`{"time":1500,"name":"temp","value":20}`), json.RawMessage(`{"time":2500,"name":"temp","value":21}`)},
+			"json":     {json.RawMessage(`{"time":1200,"payload":{"temp":20}}`)},
+		},
+	}
+)
+
+func newService(tempDir string) exports.Service {
+	authSvc := mocks.NewAuthService(admin.ID, usersList)
+	platform := exmocks.NewPlatform(map[string]exmocks.Org{orgID: org})
+	return exports.New(authSvc, platform, tempDir)
+}
+
+func TestExportOrg(t *testing.T) {
+	tempDir := t.TempDir()
+	svc := newService(tempDir)
+
+	cases := []struct {
+		desc  string
+		token string
+		orgID string
+		files map[string]uint64
+		err   error
+	}{
+		{
+			desc:  "export org",
+			token: adminToken,
+			orgID: orgID,
+			files: map[string]uint64{
+				exports.OrgFile:      1,
+				exports.MembersFile:  1,
+				exports.ActivityFile: 0,
+				exports.GroupsFile:   1,
+				fmt.Sprintf("groups/%s/%s", groupID, exports.GroupExportFile):  1,
+				fmt.Sprintf("groups/%s/%s", groupID, exports.GroupMembersFile): 1,
+				exports.SenMLFile: 1,
+				exports.JSONFile:  1,
+			},
+			err: nil,
+		},
+		{
+			desc:  "export org as non-admin user",
+			token: token,
+			orgID: orgID,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "export org with invalid token",
+			token: wrongValue,
+			orgID: orgID,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "export non-existing org",
+			token: adminToken,
+			orgID: wrongValue,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		archive, err := svc.ExportOrg(context.Background(), tc.token, tc.orgID, from, to)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err != nil {
+			temp, err := os.ReadDir(tempDir)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Empty(t, temp, fmt.Sprintf("%s: expected failed package to be removed", tc.desc))
+			continue
+		}
+
+		content, err := io.ReadAll(archive.Content)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, archive.Size, int64(len(content)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, archive.Size, len(content)))
+
+		err = archive.Content.Close()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		temp, err := os.ReadDir(tempDir)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Empty(t, temp, fmt.Sprintf("%s: expected package to be removed", tc.desc))
+
+		zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		entries := make(map[string][]byte)
+		for _, zf := range zr.File {
+			rc, err := zf.Open()
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			data, err := io.ReadAll(rc)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			rc.Close()
+			entries[zf.Name] = data
+		}
+
+		var mf exports.Manifest
+		err = json.Unmarshal(entries[exports.ManifestFile], &mf)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.orgID, mf.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", tc.desc, tc.orgID, mf.OrgID))
+		assert.Equal(t, len(tc.files), len(mf.Files), fmt.Sprintf("%s: expected %d files got %d\n", tc.desc, len(tc.files), len(mf.Files)))
+		assert.Equal(t, archive.Manifest, mf, fmt.Sprintf("%s: expected manifest %v got %v\n", tc.desc, archive.Manifest, mf))
+
+		for _, file := range mf.Files {
+			records, ok := tc.files[file.Name]
+			assert.True(t, ok, fmt.Sprintf("%s: unexpected file %s\n", tc.desc, file.Name))
+			assert.Equal(t, records, file.Records, fmt.Sprintf("%s: expected %d records in %s got %d\n", tc.desc, records, file.Name, file.Records))
+
+			sum := sha256.Sum256(entries[file.Name])
+			assert.Equal(t, hex.EncodeToString(sum[:]), file.SHA256, fmt.Sprintf("%s: expected checksum of %s to match\n", tc.desc, file.Name))
+			assert.Equal(t, int64(len(entries[file.Name])), file.Size, fmt.Sprintf("%s: expected size of %s to match\n", tc.desc, file.Name))
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package federation contains the federation service, which replicates
+// messages of the selected subjects from the local cluster to a remote
+// Mainflux cluster. The messages which fail to replicate are kept in the
+// durable outbox. Replication of the entity metadata is left for follow-up,
+// so each cluster manages its own entities.
+package federation
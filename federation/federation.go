@@ -0,0 +1,207 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// Protocol is the protocol of the messages replicated from another cluster.
+// Messages with this protocol are never replicated again, which prevents
+// messages from looping between the federated clusters.
+const Protocol = "federation"
+
+// ErrReplicate indicates failure to publish the message to the remote cluster.
+var ErrReplicate = errors.New("failed to replicate message")
+
+// relayBatch is the number of the outbox messages relayed at once.
+const relayBatch = 100
+
+// RetryConfig contains the intervals of relaying the outbox messages to the
+// remote cluster. The interval doubles after every failed relay, up to the
+// max interval.
+type RetryConfig struct {
+	Interval    time.Duration
+	MaxInterval time.Duration
+}
+
+// Federator replicates messages between federated clusters.
+type Federator interface {
+	// Federate subscribes to the local cluster messages and publishes
+	// them to the remote cluster.
+	Federate(id string, local messaging.Subscriber) error
+
+	// Relay publishes the messages of the outbox to the remote cluster
+	// until the context is canceled.
+	Relay(ctx context.Context) error
+}
+
+type federator struct {
+	subjects []string
+	remote   messaging.Publisher
+	outbox   OutboxRepository
+	retry    RetryConfig
+	logger   logger.Logger
+
+	// mu serializes publishing to the remote cluster and relaying the
+	// outbox, while pending is set as long as the outbox may hold messages.
+	mu      sync.Mutex
+	pending bool
+}
+
+// New returns federator which replicates the messages published to the
+// given subjects. The messages which fail to replicate are saved to the
+// outbox and relayed using the retry config.
+func New(subjects []string, remote messaging.Publisher, outbox OutboxRepository, retry RetryConfig, logger logger.Logger) Federator {
+	return &federator{
+		subjects: subjects,
+		remote:   remote,
+		outbox:   outbox,
+		retry:    retry,
+		logger:   logger,
+	}
+}
+
+func (f *federator) Federate(id string, local messaging.Subscriber) error {
+	// The outbox may hold the messages of the previous run, which are
+	// relayed before the new messages are published.
+	oms, err := f.outbox.RetrieveAll(context.Background(), 1)
+	if err != nil {
+		return err
+	}
+	f.pending = len(oms) > 0
+
+	for _, subject := range f.subjects {
+		if err := local.Subscribe(id, subject, handleFunc(f.handle)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handle publishes the message to the remote cluster. The message which
+// fails to publish is saved to the outbox, and so are the messages received
+// while the outbox isn't empty, so that the messages are replicated in order.
+func (f *federator) handle(msg protomfx.Message) error {
+	if msg.Protocol == Protocol {
+		return nil
+	}
+
+	msg = Replicate(msg)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.pending {
+		err := f.remote.Publish(msg)
+		if err == nil {
+			return nil
+		}
+		f.logger.Warn(fmt.Sprintf("Failed to replicate message, saving it to outbox: %s", err))
+	}
+
+	if err := f.outbox.Save(context.Background(), msg); err != nil {
+		return errors.Wrap(ErrReplicate, err)
+	}
+	f.pending = true
+
+	return nil
+}
+
+func (f *federator) Relay(ctx context.Context) error {
+	interval := f.retry.Interval
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+
+		if err := f.relay(ctx); err != nil {
+			f.logger.Warn(fmt.Sprintf("Failed to relay outbox messages, retrying in %s: %s", interval, err))
+			if interval *= 2; interval > f.retry.MaxInterval {
+				interval = f.retry.MaxInterval
+			}
+			continue
+		}
+		interval = f.retry.Interval
+	}
+}
+
+// relay publishes the messages of the outbox in batches, until the outbox
+// is empty or publishing fails. The published messages are removed from the
+// outbox, so the message is published again if its removal fails.
+func (f *federator) relay(ctx context.Context) error {
+	for {
+		done, err := f.relayBatch(ctx)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+func (f *federator) relayBatch(ctx context.Context) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.pending {
+		return true, nil
+	}
+
+	oms, err := f.outbox.RetrieveAll(ctx, relayBatch)
+	if err != nil {
+		return false, err
+	}
+	if len(oms) == 0 {
+		f.pending = false
+		return true, nil
+	}
+
+	for _, om := range oms {
+		if err := f.remote.Publish(om.Message); err != nil {
+			return false, errors.Wrap(ErrReplicate, err)
+		}
+		if err := f.outbox.Remove(ctx, om.ID); err != nil {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// Replicate returns the copy of the message to be published to the remote
+// cluster. Entity IDs are not shared between the clusters, so the message
+// is only stored by the remote cluster, while notifiers and webhooks of the
+// local profile are not triggered there.
+func Replicate(msg protomfx.Message) protomfx.Message {
+	msg.Protocol = Protocol
+	if msg.ProfileConfig != nil {
+		msg.ProfileConfig = &protomfx.Config{
+			ContentType: msg.ProfileConfig.ContentType,
+			Write:       msg.ProfileConfig.Write,
+			Transformer: msg.ProfileConfig.Transformer,
+		}
+	}
+
+	return msg
+}
+
+type handleFunc func(msg protomfx.Message) error
+
+func (h handleFunc) Handle(msg protomfx.Message) error {
+	return h(msg)
+}
+
+func (h handleFunc) Cancel() error {
+	return nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package federation_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/federation"
+	"github.com/MainfluxLabs/mainflux/federation/mocks"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	svcName = "federation"
+	subject = "senml.>"
+)
+
+var (
+	retry      = federation.RetryConfig{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+	errPublish = errors.New("failed to publish")
+)
+
+type subscriberMock struct {
+	handlers map[string]messaging.MessageHandler
+}
+
+func (sm *subscriberMock) Subscribe(id, topic string, handler messaging.MessageHandler) error {
+	sm.handlers[topic] = handler
+	return nil
+}
+
+func (sm *subscriberMock) Unsubscribe(id, topic string) error {
+	delete(sm.handlers, topic)
+	return nil
+}
+
+func (sm *subscriberMock) Close() error {
+	return nil
+}
+
+type publisherMock struct {
+	mu       sync.Mutex
+	msgs     []protomfx.Message
+	failures int
+	attempts int
+}
+
+func (pm *publisherMock) Publish(msg protomfx.Message) error {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.attempts++
+	if pm.failures > 0 {
+		pm.failures--
+		return errPublish
+	}
+
+	pm.msgs = append(pm.msgs, msg)
+	return nil
+}
+
+func (pm *publisherMock) Close() error {
+	return nil
+}
+
+func TestFederate(t *testing.T) {
+	sub := &subscriberMock{handlers: map[string]messaging.MessageHandler{}}
+	pub := &publisherMock{}
+
+	f := federation.New([]string{subject}, pub, mocks.NewOutboxRepository(), retry, logger.NewMock())
+	err := f.Federate(svcName, sub)
+	assert.Nil(t, err, fmt.Sprintf("federate: unexpected error %s", err))
+
+	cfg := &protomfx.Config{
+		ContentType: messaging.SenMLContentType,
+		Write:       true,
+		WebhookID:   "webhook",
+		SmtpID:      "smtp",
+		SmppID:      "smpp",
+	}
+
+	cases := []struct {
+		desc     string
+		msg      protomfx.Message
+		expected []protomfx.Message
+	}{
+		{
+			desc: "replicate local message",
+			msg:  protomfx.Message{Protocol: "http", Publisher: "thing", ProfileConfig: cfg},
+			expected: []protomfx.Message{{
+				Protocol:      federation.Protocol,
+				Publisher:     "thing",
+				ProfileConfig: &protomfx.Config{ContentType: messaging.SenMLContentType, Write: true},
+			}},
+		},
+		{
+			desc:     "replicate message received from remote cluster",
+			msg:      protomfx.Message{Protocol: federation.Protocol, Publisher: "thing", ProfileConfig: cfg},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		pub.msgs = nil
+		err := sub.handlers[subject].Handle(tc.msg)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.expected, pub.msgs, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.expected, pub.msgs))
+	}
+}
+
+func TestFederateOutbox(t *testing.T) {
+	sub := &subscriberMock{handlers: map[string]messaging.MessageHandler{}}
+	pub := &publisherMock{}
+	outbox := mocks.NewOutboxRepository()
+
+	f := federation.New([]string{subject}, pub, outbox, retry, logger.NewMock())
+	err := f.Federate(svcName, sub)
+	assert.Nil(t, err, fmt.Sprintf("federate: unexpected error %s", err))
+
+	first := protomfx.Message{Protocol: "http", Publisher: "first"}
+	second := protomfx.Message{Protocol: "http", Publisher: "second"}
+
+	pub.failures = 1
+	err = sub.handlers[subject].Handle(first)
+	assert.Nil(t, err, fmt.Sprintf("replicate message failing to publish: unexpected error %s", err))
+	err = sub.handlers[subject].Handle(second)
+	assert.Nil(t, err, fmt.Sprintf("replicate message while outbox isn't empty: unexpected error %s", err))
+
+	oms, err := outbox.RetrieveAll(context.Background(), 10)
+	assert.Nil(t, err, fmt.Sprintf("retrieve outbox: unexpected error %s", err))
+	assert.Equal(t, 2, len(oms), fmt.Sprintf("expected 2 outbox messages got %d", len(oms)))
+	assert.Equal(t, 1, pub.attempts, fmt.Sprintf("expected 1 attempt got %d", pub.attempts))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go f.Relay(ctx)
+
+	expected := []protomfx.Message{federation.Replicate(first), federation.Replicate(second)}
+	assert.Eventually(t, func() bool {
+		oms, err := outbox.RetrieveAll(context.Background(), 10)
+		return err == nil && len(oms) == 0
+	}, time.Second, time.Millisecond, "expected outbox to be relayed")
+
+	// Once the outbox is relayed, the messages are published directly.
+	third := protomfx.Message{Protocol: "http", Publisher: "third"}
+	err = sub.handlers[subject].Handle(third)
+	assert.Nil(t, err, fmt.Sprintf("replicate message after relaying outbox: unexpected error %s", err))
+	expected = append(expected, federation.Replicate(third))
+
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+	assert.Equal(t, expected, pub.msgs, fmt.Sprintf("expected %v got %v", expected, pub.msgs))
+}
+
+func TestFederatePreviousOutbox(t *testing.T) {
+	sub := &subscriberMock{handlers: map[string]messaging.MessageHandler{}}
+	pub := &publisherMock{}
+	outbox := mocks.NewOutboxRepository()
+
+	previous := federation.Replicate(protomfx.Message{Protocol: "http", Publisher: "previous"})
+	err := outbox.Save(context.Background(), previous)
+	assert.Nil(t, err, fmt.Sprintf("save outbox message: unexpected error %s", err))
+
+	f := federation.New([]string{subject}, pub, outbox, retry, logger.NewMock())
+	err = f.Federate(svcName, sub)
+	assert.Nil(t, err, fmt.Sprintf("federate: unexpected error %s", err))
+
+	// The new message is queued behind the message of the previous run.
+	msg := protomfx.Message{Protocol: "http", Publisher: "thing"}
+	err = sub.handlers[subject].Handle(msg)
+	assert.Nil(t, err, fmt.Sprintf("replicate message: unexpected error %s", err))
+	assert.Equal(t, 0, pub.attempts, fmt.Sprintf("expected 0 attempts got %d", pub.attempts))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go f.Relay(ctx)
+
+	expected := []protomfx.Message{previous, federation.Replicate(msg)}
+	assert.Eventually(t, func() bool {
+		pub.mu.Lock()
+		defer pub.mu.Unlock()
+		return len(pub.msgs) == len(expected)
+	}, time.Second, time.Millisecond, "expected outbox to be relayed")
+
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+	assert.Equal(t, expected, pub.msgs, fmt.Sprintf("expected %v got %v", expected, pub.msgs))
+}
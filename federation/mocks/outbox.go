@@ -0,0 +1,66 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/federation"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+var _ federation.OutboxRepository = (*outboxRepositoryMock)(nil)
+
+type outboxRepositoryMock struct {
+	mu      sync.Mutex
+	counter int64
+	msgs    map[int64]protomfx.Message
+}
+
+// NewOutboxRepository returns mock of the outbox repository.
+func NewOutboxRepository() federation.OutboxRepository {
+	return &outboxRepositoryMock{
+		msgs: make(map[int64]protomfx.Message),
+	}
+}
+
+func (orm *outboxRepositoryMock) Save(_ context.Context, msg protomfx.Message) error {
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+
+	orm.counter++
+	orm.msgs[orm.counter] = msg
+	return nil
+}
+
+func (orm *outboxRepositoryMock) RetrieveAll(_ context.Context, limit uint64) ([]federation.OutboxMessage, error) {
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+
+	var oms []federation.OutboxMessage
+	for id, msg := range orm.msgs {
+		oms = append(oms, federation.OutboxMessage{ID: id, Message: msg})
+	}
+	sort.Slice(oms, func(i, j int) bool { return oms[i].ID < oms[j].ID })
+
+	if uint64(len(oms)) > limit {
+		oms = oms[:limit]
+	}
+
+	return oms, nil
+}
+
+func (orm *outboxRepositoryMock) Remove(_ context.Context, id int64) error {
+	orm.mu.Lock()
+	defer orm.mu.Unlock()
+
+	if _, ok := orm.msgs[id]; !ok {
+		return errors.ErrNotFound
+	}
+	delete(orm.msgs, id)
+	return nil
+}
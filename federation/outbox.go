@@ -0,0 +1,31 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package federation
+
+import (
+	"context"
+
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// OutboxMessage represents the message which failed to replicate, kept in
+// the outbox until it's published to the remote cluster.
+type OutboxMessage struct {
+	ID      int64
+	Message protomfx.Message
+}
+
+// OutboxRepository specifies the durable outbox of the messages which
+// failed to replicate.
+type OutboxRepository interface {
+	// Save appends the message to the outbox.
+	Save(ctx context.Context, msg protomfx.Message) error
+
+	// RetrieveAll retrieves up to the limit of the oldest messages of the
+	// outbox, in the order they were saved.
+	RetrieveAll(ctx context.Context, limit uint64) ([]OutboxMessage, error)
+
+	// Remove removes the message from the outbox.
+	Remove(ctx context.Context, id int64) error
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/federation"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/gogo/protobuf/proto"
+)
+
+var _ federation.OutboxRepository = (*outboxRepository)(nil)
+
+type outboxRepository struct {
+	db Database
+}
+
+// NewOutboxRepository instantiates a PostgreSQL implementation of outbox
+// repository. The messages are stored in the protobuf encoding.
+func NewOutboxRepository(db Database) federation.OutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
+
+func (or outboxRepository) Save(ctx context.Context, msg protomfx.Message) error {
+	q := `INSERT INTO outbox (message, created_at) VALUES (:message, :created_at);`
+
+	data, err := proto.Marshal(&msg)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	dbom := dbOutboxMessage{
+		Message:   data,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if _, err := or.db.NamedExecContext(ctx, q, dbom); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (or outboxRepository) RetrieveAll(ctx context.Context, limit uint64) ([]federation.OutboxMessage, error) {
+	q := `SELECT id, message, created_at FROM outbox ORDER BY id LIMIT :limit;`
+
+	rows, err := or.db.NamedQueryContext(ctx, q, map[string]interface{}{"limit": limit})
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var oms []federation.OutboxMessage
+	for rows.Next() {
+		var dbom dbOutboxMessage
+		if err := rows.StructScan(&dbom); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		var msg protomfx.Message
+		if err := proto.Unmarshal(dbom.Message, &msg); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		oms = append(oms, federation.OutboxMessage{ID: dbom.ID, Message: msg})
+	}
+
+	return oms, nil
+}
+
+func (or outboxRepository) Remove(ctx context.Context, id int64) error {
+	q := `DELETE FROM outbox WHERE id = :id;`
+
+	if _, err := or.db.NamedExecContext(ctx, q, dbOutboxMessage{ID: id}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbOutboxMessage struct {
+	ID        int64     `db:"id"`
+	Message   []byte    `db:"message"`
+	CreatedAt time.Time `db:"created_at"`
+}
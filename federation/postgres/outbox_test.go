@@ -0,0 +1,113 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/federation/postgres"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const publisher = "publisher"
+
+func TestOutboxSave(t *testing.T) {
+	repo := postgres.NewOutboxRepository(postgres.NewDatabase(db))
+	defer cleanOutbox(t)
+
+	msg := protomfx.Message{
+		Protocol:  "federation",
+		Publisher: publisher,
+		Subtopic:  "engine",
+		Payload:   []byte(`[{"n":"current","v":1.6}]`),
+		ProfileConfig: &protomfx.Config{
+			ContentType: "application/senml+json",
+			Write:       true,
+		},
+	}
+
+	err := repo.Save(context.Background(), msg)
+	assert.Nil(t, err, fmt.Sprintf("save outbox message: expected nil got %s\n", err))
+
+	oms, err := repo.RetrieveAll(context.Background(), 10)
+	require.Nil(t, err, fmt.Sprintf("retrieve outbox messages: expected nil got %s\n", err))
+	require.Equal(t, 1, len(oms), fmt.Sprintf("expected 1 outbox message got %d\n", len(oms)))
+	got := oms[0].Message
+	assert.Equal(t, msg.Publisher, got.Publisher, fmt.Sprintf("expected %s got %s\n", msg.Publisher, got.Publisher))
+	assert.Equal(t, msg.Subtopic, got.Subtopic, fmt.Sprintf("expected %s got %s\n", msg.Subtopic, got.Subtopic))
+	assert.Equal(t, msg.Payload, got.Payload, fmt.Sprintf("expected %s got %s\n", msg.Payload, got.Payload))
+	require.NotNil(t, got.ProfileConfig, "expected profile config got nil\n")
+	assert.Equal(t, msg.ProfileConfig.ContentType, got.ProfileConfig.ContentType, fmt.Sprintf("expected %s got %s\n", msg.ProfileConfig.ContentType, got.ProfileConfig.ContentType))
+}
+
+func TestOutboxRetrieveAll(t *testing.T) {
+	repo := postgres.NewOutboxRepository(postgres.NewDatabase(db))
+	defer cleanOutbox(t)
+
+	n := 5
+	for i := 0; i < n; i++ {
+		msg := protomfx.Message{Publisher: fmt.Sprintf("%s-%d", publisher, i)}
+		err := repo.Save(context.Background(), msg)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	}
+
+	cases := []struct {
+		desc  string
+		limit uint64
+		size  int
+	}{
+		{
+			desc:  "retrieve outbox messages",
+			limit: 10,
+			size:  n,
+		},
+		{
+			desc:  "retrieve outbox messages with limit",
+			limit: 2,
+			size:  2,
+		},
+	}
+
+	for _, tc := range cases {
+		oms, err := repo.RetrieveAll(context.Background(), tc.limit)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.size, len(oms), fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.size, len(oms)))
+
+		for i, om := range oms {
+			expected := fmt.Sprintf("%s-%d", publisher, i)
+			assert.Equal(t, expected, om.Message.Publisher, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, expected, om.Message.Publisher))
+		}
+	}
+}
+
+func TestOutboxRemove(t *testing.T) {
+	repo := postgres.NewOutboxRepository(postgres.NewDatabase(db))
+	defer cleanOutbox(t)
+
+	for i := 0; i < 2; i++ {
+		err := repo.Save(context.Background(), protomfx.Message{Publisher: fmt.Sprintf("%s-%d", publisher, i)})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	}
+
+	oms, err := repo.RetrieveAll(context.Background(), 10)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	require.Equal(t, 2, len(oms), fmt.Sprintf("expected 2 outbox messages got %d\n", len(oms)))
+
+	err = repo.Remove(context.Background(), oms[0].ID)
+	assert.Nil(t, err, fmt.Sprintf("remove outbox message: expected nil got %s\n", err))
+
+	rest, err := repo.RetrieveAll(context.Background(), 10)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	require.Equal(t, 1, len(rest), fmt.Sprintf("expected 1 outbox message got %d\n", len(rest)))
+	assert.Equal(t, oms[1].ID, rest[0].ID, fmt.Sprintf("expected %d got %d\n", oms[1].ID, rest[0].ID))
+}
+
+func cleanOutbox(t *testing.T) {
+	_, err := db.Exec("DELETE FROM outbox")
+	require.Nil(t, err, fmt.Sprintf("clean outbox: unexpected error: %s\n", err))
+}
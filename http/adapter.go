@@ -8,13 +8,15 @@ package http
 import (
 	"context"
 
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 )
 
 // Service specifies coap service API.
 type Service interface {
-	// Publish Messssage
+	// Publish Messssage. If the message publisher is set, the key is the one
+	// of the gateway which publishes the message on behalf of the publisher.
 	Publish(ctx context.Context, token string, msg protomfx.Message) (m protomfx.Message, err error)
 }
 
@@ -23,26 +25,59 @@ var _ Service = (*adapterService)(nil)
 type adapterService struct {
 	publisher messaging.Publisher
 	things    protomfx.ThingsServiceClient
+	network   auth.NetworkAuthorizer
+	ack       string
 }
 
-// New instantiates the HTTP adapter implementation.
-func New(publisher messaging.Publisher, things protomfx.ThingsServiceClient) Service {
+// New instantiates the HTTP adapter implementation. If the persistence
+// confirmation level is set, the message is published once its persistence
+// is confirmed at that level, which requires the messaging.AckPublisher.
+func New(publisher messaging.Publisher, things protomfx.ThingsServiceClient, network auth.NetworkAuthorizer, ack string) Service {
 	return &adapterService{
 		publisher: publisher,
 		things:    things,
+		network:   network,
+		ack:       ack,
 	}
 }
 
 func (as *adapterService) Publish(ctx context.Context, key string, msg protomfx.Message) (m protomfx.Message, err error) {
-	cr := &protomfx.PubConfByKeyReq{
-		Key: key,
-	}
-	pc, err := as.things.GetPubConfByKey(ctx, cr)
+	pc, err := as.pubConf(ctx, key, msg.Publisher)
 	if err != nil {
 		return protomfx.Message{}, err
 	}
 
+	if err := as.network.Authorize(ctx, pc, msg.Protocol); err != nil {
+		return protomfx.Message{}, err
+	}
+
+	if err := messaging.ValidatePayload(pc.GetProfileConfig(), msg.Payload); err != nil {
+		return protomfx.Message{}, err
+	}
+
 	m = messaging.CreateMessage(pc, msg.Protocol, msg.Subtopic, &msg.Payload)
+	m.Expires = msg.Expires
+
+	return m, as.publish(ctx, m)
+}
+
+func (as *adapterService) publish(ctx context.Context, msg protomfx.Message) error {
+	if as.ack == "" {
+		return as.publisher.Publish(msg)
+	}
+
+	pub, ok := as.publisher.(messaging.AckPublisher)
+	if !ok {
+		return messaging.ErrAckUnsupported
+	}
+
+	return pub.PublishAck(ctx, msg, as.ack)
+}
+
+func (as *adapterService) pubConf(ctx context.Context, key, thingID string) (*protomfx.PubConfByKeyRes, error) {
+	if thingID != "" {
+		return as.things.GetPubConfByGateway(ctx, &protomfx.PubConfByGatewayReq{Key: key, ThingID: thingID})
+	}
 
-	return m, as.publisher.Publish(m)
+	return as.things.GetPubConfByKey(ctx, &protomfx.PubConfByKeyReq{Key: key})
 }
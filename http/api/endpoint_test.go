@@ -15,6 +15,8 @@ import (
 	"github.com/MainfluxLabs/mainflux/http/api"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/opentracing/opentracing-go/mocktracer"
@@ -25,7 +27,7 @@ const ServiceErrToken = "unavailable"
 
 func newService(tc protomfx.ThingsServiceClient) adapter.Service {
 	pub := mocks.NewPublisher()
-	return adapter.New(pub, tc)
+	return adapter.New(pub, tc, auth.NewNetworkAuthorizer(nil), "")
 }
 
 func newHTTPServer(svc adapter.Service) *httptest.Server {
@@ -42,6 +44,7 @@ type testRequest struct {
 	token       string
 	body        io.Reader
 	basicAuth   bool
+	ttl         string
 }
 
 func (tr testRequest) make() (*http.Response, error) {
@@ -59,6 +62,9 @@ func (tr testRequest) make() (*http.Response, error) {
 	if tr.contentType != "" {
 		req.Header.Set("Content-Type", tr.contentType)
 	}
+	if tr.ttl != "" {
+		req.Header.Set("TTL", tr.ttl)
+	}
 	return tr.client.Do(req)
 }
 
@@ -84,6 +90,7 @@ func TestPublish(t *testing.T) {
 		key         string
 		status      int
 		basicAuth   bool
+		ttl         string
 	}{
 		"publish message": {
 			profileID:   profileID,
@@ -150,6 +157,37 @@ func TestPublish(t *testing.T) {
 			key:         thingKey,
 			status:      http.StatusAccepted,
 		},
+		"publish message with ttl": {
+			profileID:   profileID,
+			msg:         msg,
+			contentType: ctSenmlJSON,
+			key:         thingKey,
+			ttl:         "3600",
+			status:      http.StatusAccepted,
+		},
+		"publish message with zero ttl": {
+			profileID:   profileID,
+			msg:         msg,
+			contentType: ctSenmlJSON,
+			key:         thingKey,
+			ttl:         "0",
+			status:      http.StatusBadRequest,
+		},
+		"publish message with invalid ttl": {
+			profileID:   profileID,
+			msg:         msg,
+			contentType: ctSenmlJSON,
+			key:         thingKey,
+			ttl:         "-1",
+			status:      http.StatusBadRequest,
+		},
+		"publish message from network which isn't allowed": {
+			profileID:   profileID,
+			msg:         msg,
+			contentType: ctSenmlJSON,
+			key:         "restricted",
+			status:      http.StatusForbidden,
+		},
 		"publish message unable to authorize": {
 			profileID:   profileID,
 			msg:         msg,
@@ -168,9 +206,118 @@ func TestPublish(t *testing.T) {
 			token:       tc.key,
 			body:        strings.NewReader(tc.msg),
 			basicAuth:   tc.basicAuth,
+			ttl:         tc.ttl,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestPublishByGateway(t *testing.T) {
+	ctSenmlJSON := "application/senml+json"
+	gatewayKey := "gateway_key"
+	gatewayID := "1"
+	childID := "2"
+	invalidKey := "invalid"
+	msg := `[{"n":"current","t":-1,"v":1.6}]`
+	thingsClient := mocks.NewThingsServiceClient(nil, map[string]string{gatewayKey: gatewayID, "child_key": childID}, nil)
+	svc := newService(thingsClient)
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	cases := map[string]struct {
+		thingID  string
+		subtopic string
+		key      string
+		status   int
+	}{
+		"publish message on behalf of child": {
+			thingID: childID,
+			key:     gatewayKey,
+			status:  http.StatusAccepted,
+		},
+		"publish message with subtopic on behalf of child": {
+			thingID:  childID,
+			subtopic: "/temperature",
+			key:      gatewayKey,
+			status:   http.StatusAccepted,
+		},
+		"publish message on behalf of gateway itself": {
+			thingID: gatewayID,
+			key:     gatewayKey,
+			status:  http.StatusForbidden,
+		},
+		"publish message on behalf of non-existing thing": {
+			thingID: "3",
+			key:     gatewayKey,
+			status:  http.StatusForbidden,
+		},
+		"publish message on behalf of child with invalid key": {
+			thingID: childID,
+			key:     invalidKey,
+			status:  http.StatusUnauthorized,
+		},
+	}
+
+	for desc, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/things/%s/messages%s", ts.URL, tc.thingID, tc.subtopic),
+			contentType: ctSenmlJSON,
+			token:       tc.key,
+			body:        strings.NewReader(msg),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestPublishAck(t *testing.T) {
+	ctSenmlJSON := "application/senml+json"
+	thingKey := "thing_key"
+	msg := `[{"n":"current","t":-1,"v":1.6}]`
+	thingsClient := mocks.NewThingsServiceClient(map[string]string{thingKey: "1"}, nil, nil)
+
+	cases := map[string]struct {
+		pub    messaging.Publisher
+		ack    string
+		status int
+	}{
+		"publish message confirmed by writer": {
+			pub:    mocks.NewAckPublisher(nil),
+			ack:    messaging.AckWriter,
+			status: http.StatusAccepted,
+		},
+		"publish message not confirmed in time": {
+			pub:    mocks.NewAckPublisher(messaging.ErrAckTimeout),
+			ack:    messaging.AckWriter,
+			status: http.StatusGatewayTimeout,
+		},
+		"publish message using publisher which doesn't confirm": {
+			pub:    mocks.NewPublisher(),
+			ack:    messaging.AckWriter,
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for desc, tc := range cases {
+		svc := adapter.New(tc.pub, thingsClient, auth.NewNetworkAuthorizer(nil), tc.ack)
+		ts := newHTTPServer(svc)
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/messages", ts.URL),
+			contentType: ctSenmlJSON,
+			token:       thingKey,
+			body:        strings.NewReader(msg),
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", desc, tc.status, res.StatusCode))
+		ts.Close()
 	}
 }
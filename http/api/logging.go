@@ -31,10 +31,10 @@ func (lm *loggingMiddleware) Publish(ctx context.Context, token string, msg prot
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method publish by thing %s took %s to complete", m.Publisher, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Publish(ctx, token, msg)
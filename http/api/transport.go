@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,9 +16,11 @@ import (
 	adapter "github.com/MainfluxLabs/mainflux/http"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	kitot "github.com/go-kit/kit/tracing/opentracing"
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/go-zoo/bone"
@@ -30,12 +33,16 @@ const (
 	ctSenmlJSON = "application/senml+json"
 	ctSenmlCBOR = "application/senml+cbor"
 	ctJSON      = "application/json"
+	ttlHeader   = "TTL"
 )
 
 // MakeHandler returns a HTTP handler for API endpoints.
 func MakeHandler(svc adapter.Service, tracer opentracing.Tracer, logger logger.Logger) http.Handler {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, encodeError)),
+		kithttp.ServerBefore(func(ctx context.Context, r *http.Request) context.Context {
+			return auth.ContextWithClientAddr(ctx, servershttp.ClientIP(r))
+		}),
 	}
 
 	r := bone.New()
@@ -46,6 +53,13 @@ func MakeHandler(svc adapter.Service, tracer opentracing.Tracer, logger logger.L
 		opts...,
 	))
 
+	r.Post("/things/:id/messages", kithttp.NewServer(
+		kitot.TraceServer(tracer, "publish")(sendMessageEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Post("/messages", kithttp.NewServer(
 		kitot.TraceServer(tracer, "publish")(sendMessageEndpoint(svc)),
 		decodeRequest,
@@ -60,6 +74,13 @@ func MakeHandler(svc adapter.Service, tracer opentracing.Tracer, logger logger.L
 		opts...,
 	))
 
+	r.Post("/things/:id/messages/*", kithttp.NewServer(
+		kitot.TraceServer(tracer, "publish")(sendMessageEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Post("/messages/*", kithttp.NewServer(
 		kitot.TraceServer(tracer, "publish")(sendMessageEndpoint(svc)),
 		decodeRequest,
@@ -106,12 +127,20 @@ func decodeRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	}
 	defer r.Body.Close()
 
+	created := time.Now()
+	expires, err := decodeExpiry(r, created)
+	if err != nil {
+		return nil, err
+	}
+
 	req := publishReq{
 		msg: protomfx.Message{
-			Protocol: protocol,
-			Subtopic: subject,
-			Payload:  payload,
-			Created:  time.Now().UnixNano(),
+			Protocol:  protocol,
+			Subtopic:  subject,
+			Publisher: messaging.ExtractThingID(r.URL.Path),
+			Payload:   payload,
+			Created:   created.UnixNano(),
+			Expires:   expires,
 		},
 		token: token,
 	}
@@ -119,23 +148,45 @@ func decodeRequest(ctx context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+// decodeExpiry returns the expiry of the message whose time to live is set,
+// in seconds, in the TTL header, e.g. so that the stale commands aren't
+// delivered to the devices which reconnect later.
+func decodeExpiry(r *http.Request, created time.Time) (int64, error) {
+	val := r.Header.Get(ttlHeader)
+	if val == "" {
+		return 0, nil
+	}
+
+	ttl, err := strconv.ParseUint(val, 10, 32)
+	if err != nil || ttl == 0 {
+		return 0, apiutil.ErrInvalidTTL
+	}
+
+	return created.Add(time.Duration(ttl) * time.Second).UnixNano(), nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.WriteHeader(http.StatusAccepted)
 	return nil
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, errors.ErrAuthentication),
 		err == apiutil.ErrBearerToken:
 		w.WriteHeader(http.StatusUnauthorized)
-	case errors.Contains(err, errors.ErrAuthorization):
+	case errors.Contains(err, errors.ErrAuthorization),
+		errors.Contains(err, auth.ErrNetworkDenied):
 		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
 		w.WriteHeader(http.StatusUnsupportedMediaType)
 	case errors.Contains(err, messaging.ErrMalformedSubtopic),
-		errors.Contains(err, apiutil.ErrMalformedEntity):
+		errors.Contains(err, messaging.ErrInvalidPayload),
+		errors.Contains(err, apiutil.ErrMalformedEntity),
+		err == apiutil.ErrInvalidTTL:
 		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, messaging.ErrAckTimeout):
+		w.WriteHeader(http.StatusGatewayTimeout)
 
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
@@ -143,7 +194,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", ctJSON)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
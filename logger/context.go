@@ -0,0 +1,35 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import "context"
+
+type contextKey int
+
+const (
+	requestIDCtxKey contextKey = iota
+	traceIDCtxKey
+)
+
+// ContextWithRequestID returns a copy of the context carrying the request ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey, id)
+}
+
+// RequestIDFromContext returns the request ID carried by the context, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDCtxKey).(string)
+	return id
+}
+
+// ContextWithTraceID returns a copy of the context carrying the trace ID.
+func ContextWithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDCtxKey, id)
+}
+
+// TraceIDFromContext returns the trace ID carried by the context, if any.
+func TraceIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(traceIDCtxKey).(string)
+	return id
+}
@@ -3,4 +3,25 @@
 
 // Package logger contains logger API definition, wrapper that
 // can be used around any other logger.
+//
+// The log level of a module is overridden using the MF_LOG_LEVEL_OVERRIDES
+// environment variable, e.g. "messaging=debug,http=info". The services tag
+// the entries of their components with the following module names:
+//
+//	api       - the logging middleware of the service
+//	http      - the HTTP API handlers
+//	messaging - the message broker publishers and subscribers
+//	events    - the consumers of the event streams
+//	things    - the gRPC client of the things service
+//	auth      - the gRPC client of the auth service
+//	users     - the gRPC client of the users service
+//	mqtt      - the MQTT adapter handler and forwarder
+//	sessions  - the MQTT adapter session registry
+//
+// The modules derived from another module are named parent.child, and use
+// the log level override of the closest parent if they have none, e.g.
+// "mqtt=debug" applies to the "mqtt.sessions" module as well.
+//
+// The entries which don't belong to any of the modules, e.g. the service
+// startup and shutdown, use the service log level.
 package logger
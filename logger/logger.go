@@ -4,13 +4,36 @@
 package logger
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-kit/kit/log"
 )
 
+const (
+	// JSONFormat formats log entries as JSON objects.
+	JSONFormat = "json"
+	// ConsoleFormat formats log entries as logfmt key=value pairs.
+	ConsoleFormat = "console"
+
+	moduleKey    = "module"
+	requestIDKey = "request_id"
+	traceIDKey   = "trace_id"
+)
+
+var (
+	// ErrInvalidLogFormat indicates an unrecognized log format.
+	ErrInvalidLogFormat = errors.New("unrecognized log format")
+
+	// ErrInvalidLogOverride indicates a malformed module log level override.
+	ErrInvalidLogOverride = errors.New("malformed log level override")
+)
+
 // Logger specifies logging API.
 type Logger interface {
 	// Debug logs any object in JSON format on debug level.
@@ -21,47 +44,207 @@ type Logger interface {
 	Warn(string)
 	// Error logs any object in JSON format on error level.
 	Error(string)
+	// Module returns logger which tags entries with the given module name,
+	// nested as parent.child in the module of the logger, and uses the log
+	// level override of the module or of its closest parent, if one is
+	// configured.
+	Module(name string) Logger
+	// WithContext returns logger which tags entries with the request and
+	// trace IDs carried by the given context.
+	WithContext(ctx context.Context) Logger
+	// SetLevel changes the log level of the logger and all loggers
+	// derived from it.
+	SetLevel(levelText string) error
+	// SetLevelOverrides replaces module log level overrides of the logger
+	// and all loggers derived from it.
+	SetLevelOverrides(overrides string) error
+}
+
+// Config represents logger configuration.
+type Config struct {
+	// Level is the log level used by modules without an override.
+	Level string
+	// Format is the output format, either json or console.
+	Format string
+	// Overrides contains comma separated module=level pairs,
+	// e.g. "mqtt=debug,things=info".
+	Overrides string
 }
 
 var _ Logger = (*logger)(nil)
 
 type logger struct {
 	kitLogger log.Logger
+	// base is the go kit logger without the module tag, from which the
+	// loggers of the nested modules are derived.
+	base   log.Logger
+	module string
+	levels *levelSet
+}
+
+// levelSet holds log levels shared by a logger and all loggers derived
+// from it, so that they can be changed at runtime.
+type levelSet struct {
+	mu        sync.RWMutex
 	level     Level
+	overrides map[string]Level
+}
+
+func (lv *levelSet) get(module string) Level {
+	lv.mu.RLock()
+	defer lv.mu.RUnlock()
+
+	for module != "" {
+		if lvl, ok := lv.overrides[module]; ok {
+			return lvl
+		}
+
+		i := strings.LastIndex(module, ".")
+		if i < 0 {
+			break
+		}
+		module = module[:i]
+	}
+
+	return lv.level
 }
 
 // New returns wrapped go kit logger.
 func New(out io.Writer, levelText string) (Logger, error) {
+	return NewWithConfig(out, Config{Level: levelText})
+}
+
+// NewWithConfig returns wrapped go kit logger configured with the given
+// output format and module log level overrides.
+func NewWithConfig(out io.Writer, cfg Config) (Logger, error) {
 	var level Level
-	err := level.UnmarshalText(levelText)
+	err := level.UnmarshalText(cfg.Level)
+	if err != nil {
+		return nil, fmt.Errorf(`{"level":"error","message":"%s: %s","ts":"%s"}`, err, cfg.Level, time.RFC3339Nano)
+	}
+
+	overrides, err := parseOverrides(cfg.Overrides)
 	if err != nil {
-		return nil, fmt.Errorf(`{"level":"error","message":"%s: %s","ts":"%s"}`, err, levelText, time.RFC3339Nano)
+		return nil, fmt.Errorf(`{"level":"error","message":"%s: %s","ts":"%s"}`, err, cfg.Overrides, time.RFC3339Nano)
+	}
+
+	var l log.Logger
+	switch strings.ToLower(cfg.Format) {
+	case "", JSONFormat:
+		l = log.NewJSONLogger(log.NewSyncWriter(out))
+	case ConsoleFormat:
+		l = log.NewLogfmtLogger(log.NewSyncWriter(out))
+	default:
+		return nil, fmt.Errorf(`{"level":"error","message":"%s: %s","ts":"%s"}`, ErrInvalidLogFormat, cfg.Format, time.RFC3339Nano)
 	}
-	l := log.NewJSONLogger(log.NewSyncWriter(out))
 	l = log.With(l, "ts", log.DefaultTimestampUTC)
-	return &logger{l, level}, err
+
+	return &logger{kitLogger: l, base: l, levels: &levelSet{level: level, overrides: overrides}}, nil
 }
 
 func (l logger) Debug(msg string) {
-	if Debug.isAllowed(l.level) {
+	if Debug.isAllowed(l.levels.get(l.module)) {
 		l.kitLogger.Log("level", Debug.String(), "message", msg)
 	}
 }
 
 func (l logger) Info(msg string) {
-	if Info.isAllowed(l.level) {
+	if Info.isAllowed(l.levels.get(l.module)) {
 		l.kitLogger.Log("level", Info.String(), "message", msg)
 	}
 }
 
 func (l logger) Warn(msg string) {
-	if Warn.isAllowed(l.level) {
+	if Warn.isAllowed(l.levels.get(l.module)) {
 		l.kitLogger.Log("level", Warn.String(), "message", msg)
 	}
 }
 
 func (l logger) Error(msg string) {
-	if Error.isAllowed(l.level) {
+	if Error.isAllowed(l.levels.get(l.module)) {
 		l.kitLogger.Log("level", Error.String(), "message", msg)
 	}
 }
+
+func (l logger) Module(name string) Logger {
+	if l.module != "" {
+		name = l.module + "." + name
+	}
+
+	return &logger{
+		kitLogger: log.With(l.base, moduleKey, name),
+		base:      l.base,
+		module:    name,
+		levels:    l.levels,
+	}
+}
+
+func (l logger) WithContext(ctx context.Context) Logger {
+	base := l.base
+	if id := RequestIDFromContext(ctx); id != "" {
+		base = log.With(base, requestIDKey, id)
+	}
+	if id := TraceIDFromContext(ctx); id != "" {
+		base = log.With(base, traceIDKey, id)
+	}
+
+	kl := base
+	if l.module != "" {
+		kl = log.With(base, moduleKey, l.module)
+	}
+
+	return &logger{
+		kitLogger: kl,
+		base:      base,
+		module:    l.module,
+		levels:    l.levels,
+	}
+}
+
+func (l logger) SetLevel(levelText string) error {
+	var level Level
+	if err := level.UnmarshalText(levelText); err != nil {
+		return err
+	}
+
+	l.levels.mu.Lock()
+	defer l.levels.mu.Unlock()
+	l.levels.level = level
+
+	return nil
+}
+
+func (l logger) SetLevelOverrides(text string) error {
+	overrides, err := parseOverrides(text)
+	if err != nil {
+		return err
+	}
+
+	l.levels.mu.Lock()
+	defer l.levels.mu.Unlock()
+	l.levels.overrides = overrides
+
+	return nil
+}
+
+func parseOverrides(text string) (map[string]Level, error) {
+	overrides := make(map[string]Level)
+	for _, o := range strings.Split(text, ",") {
+		if o = strings.TrimSpace(o); o == "" {
+			continue
+		}
+
+		parts := strings.Split(o, "=")
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, ErrInvalidLogOverride
+		}
+
+		var level Level
+		if err := level.UnmarshalText(strings.TrimSpace(parts[1])); err != nil {
+			return nil, err
+		}
+		overrides[strings.TrimSpace(parts[0])] = level
+	}
+
+	return overrides, nil
+}
@@ -4,9 +4,11 @@
 package logger_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 
 	log "github.com/MainfluxLabs/mainflux/logger"
@@ -124,3 +126,77 @@ func TestError(t *testing.T) {
 		assert.Equal(t, tc.output, output, fmt.Sprintf("%s: expected %s got %s", desc, tc.output, output))
 	}
 }
+
+func TestModule(t *testing.T) {
+	cases := map[string]struct {
+		logLevel  string
+		overrides string
+		module    string
+		input     string
+		output    logMsg
+		err       error
+	}{
+		"debug log in module with debug override":         {log.Error.String(), "mqtt=debug", "mqtt", "input_string", logMsg{log.Debug.String(), "input_string"}, nil},
+		"debug log in module without override":            {log.Error.String(), "mqtt=debug", "things", "input_string", logMsg{"", ""}, nil},
+		"debug log in module with info override":          {log.Debug.String(), "mqtt=debug,things=info", "things", "input_string", logMsg{"", ""}, nil},
+		"debug log in nested module with parent override": {log.Error.String(), "mqtt=debug", "mqtt.sessions", "input_string", logMsg{log.Debug.String(), "input_string"}, nil},
+		"debug log in nested module with own override":    {log.Debug.String(), "mqtt=debug,mqtt.sessions=info", "mqtt.sessions", "input_string", logMsg{"", ""}, nil},
+		"debug log with malformed override":               {log.Debug.String(), "mqtt", "mqtt", "input_string", logMsg{"", ""}, log.ErrInvalidLogOverride},
+		"debug log with invalid override log level":       {log.Debug.String(), "mqtt=trace", "mqtt", "input_string", logMsg{"", ""}, log.ErrInvalidLogLevel},
+	}
+
+	for desc, tc := range cases {
+		writer = mockWriter{}
+		logger, err = log.NewWithConfig(&writer, log.Config{Level: tc.logLevel, Overrides: tc.overrides})
+		if tc.err != nil {
+			assert.ErrorContains(t, err, tc.err.Error(), fmt.Sprintf("%s: expected error %s got %s", desc, tc.err, err))
+			continue
+		}
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		logger.Module(tc.module).Debug(tc.input)
+		output, _ = writer.Read()
+		assert.Equal(t, tc.output, output, fmt.Sprintf("%s: expected %s got %s", desc, tc.output, output))
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	writer = mockWriter{}
+	logger, err = log.New(&writer, log.Info.String())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	ctx := log.ContextWithRequestID(context.Background(), "request_id")
+	ctx = log.ContextWithTraceID(ctx, "trace_id")
+	logger.WithContext(ctx).Info("input_string")
+
+	var entry map[string]string
+	err = json.Unmarshal(writer.value, &entry)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, "request_id", entry["request_id"], fmt.Sprintf("expected request id %s got %s", "request_id", entry["request_id"]))
+	assert.Equal(t, "trace_id", entry["trace_id"], fmt.Sprintf("expected trace id %s got %s", "trace_id", entry["trace_id"]))
+}
+
+func TestNestedModule(t *testing.T) {
+	writer = mockWriter{}
+	logger, err = log.NewWithConfig(&writer, log.Config{Level: log.Info.String(), Format: log.ConsoleFormat})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	ctx := log.ContextWithRequestID(context.Background(), "request_id")
+	logger.Module("mqtt").WithContext(ctx).Module("sessions").Info("input_string")
+
+	entry := string(writer.value)
+	assert.Contains(t, entry, "module=mqtt.sessions", "expected nested module name")
+	assert.Equal(t, 1, strings.Count(entry, "module="), fmt.Sprintf("expected single module key got %s", entry))
+	assert.Contains(t, entry, "request_id=request_id", "expected request id of the parent logger")
+}
+
+func TestConsoleFormat(t *testing.T) {
+	writer = mockWriter{}
+	logger, err = log.NewWithConfig(&writer, log.Config{Level: log.Info.String(), Format: log.ConsoleFormat})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	logger.Info("input_string")
+	assert.Contains(t, string(writer.value), "level=info message=input_string", "expected logfmt formatted entry")
+
+	_, err = log.NewWithConfig(&writer, log.Config{Level: log.Info.String(), Format: "xml"})
+	assert.ErrorContains(t, err, log.ErrInvalidLogFormat.Error(), fmt.Sprintf("expected error %s got %s", log.ErrInvalidLogFormat, err))
+}
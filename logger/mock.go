@@ -3,6 +3,8 @@
 
 package logger
 
+import "context"
+
 var _ Logger = (*loggerMock)(nil)
 
 type loggerMock struct{}
@@ -23,3 +25,19 @@ func (l loggerMock) Warn(msg string) {
 
 func (l loggerMock) Error(msg string) {
 }
+
+func (l loggerMock) Module(name string) Logger {
+	return l
+}
+
+func (l loggerMock) WithContext(ctx context.Context) Logger {
+	return l
+}
+
+func (l loggerMock) SetLevel(levelText string) error {
+	return nil
+}
+
+func (l loggerMock) SetLevelOverrides(overrides string) error {
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/MainfluxLabs/mainflux/mqtt"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/go-kit/kit/endpoint"
 )
 
@@ -46,3 +47,70 @@ func listSubscriptions(svc mqtt.Service) endpoint.Endpoint {
 		return res, nil
 	}
 }
+
+func lookupClient(svc mqtt.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(lookupClientReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		subs, err := svc.ListSubscriptionsByClientID(ctx, req.token, req.clientID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(subs) == 0 {
+			return nil, errors.ErrNotFound
+		}
+
+		res := lookupClientRes{
+			ClientID:      req.clientID,
+			ThingID:       subs[0].ThingID,
+			Subscriptions: []viewSubRes{},
+		}
+
+		for _, sub := range subs {
+			view := viewSubRes{
+				Subtopic:  sub.Subtopic,
+				ThingID:   sub.ThingID,
+				GroupID:   sub.GroupID,
+				ClientID:  sub.ClientID,
+				Status:    sub.Status,
+				CreatedAt: sub.CreatedAt,
+			}
+			res.Subscriptions = append(res.Subscriptions, view)
+		}
+
+		return res, nil
+	}
+}
+
+func listEvents(svc mqtt.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listEventsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		events, err := svc.ListEvents(ctx, req.thingID, req.token, req.key, req.limit)
+		if err != nil {
+			return nil, err
+		}
+
+		res := listEventsRes{Events: []eventRes{}}
+		for _, e := range events {
+			res.Events = append(res.Events, eventRes{
+				Type:     e.Type,
+				ThingID:  e.ThingID,
+				ClientID: e.ClientID,
+				Reason:   e.Reason,
+				Error:    e.Error,
+				Instance: e.Instance,
+				Time:     e.Time,
+			})
+		}
+
+		return res, nil
+	}
+}
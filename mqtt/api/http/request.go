@@ -39,3 +39,43 @@ func (req listSubscriptionsReq) validate() error {
 
 	return nil
 }
+
+type lookupClientReq struct {
+	token    string
+	clientID string
+}
+
+func (req lookupClientReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.clientID == "" {
+		return apiutil.ErrMissingClientID
+	}
+
+	return nil
+}
+
+type listEventsReq struct {
+	thingID string
+	token   string
+	key     string
+	limit   uint64
+}
+
+func (req listEventsReq) validate() error {
+	if req.thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.token == "" && req.key == "" {
+		return errAuthHeader
+	}
+
+	if req.limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
@@ -2,9 +2,17 @@
 // SPDX-License-Identifier: Apache-2.0
 package http
 
-import "github.com/MainfluxLabs/mainflux/pkg/apiutil"
+import (
+	"time"
 
-var _ apiutil.Response = (*listSubscriptionsRes)(nil)
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var (
+	_ apiutil.Response = (*listSubscriptionsRes)(nil)
+	_ apiutil.Response = (*listEventsRes)(nil)
+	_ apiutil.Response = (*lookupClientRes)(nil)
+)
 
 type listSubscriptionsRes struct {
 	pageRes
@@ -37,3 +45,47 @@ type pageRes struct {
 	Offset uint64 `json:"offset"`
 	Limit  uint64 `json:"limit"`
 }
+
+type lookupClientRes struct {
+	ClientID      string       `json:"client_id"`
+	ThingID       string       `json:"thing_id"`
+	Subscriptions []viewSubRes `json:"subscriptions"`
+}
+
+func (res lookupClientRes) Code() int {
+	return 200
+}
+
+func (res lookupClientRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res lookupClientRes) Empty() bool {
+	return false
+}
+
+type eventRes struct {
+	Type     string    `json:"type"`
+	ThingID  string    `json:"thing_id"`
+	ClientID string    `json:"client_id,omitempty"`
+	Reason   string    `json:"reason,omitempty"`
+	Error    string    `json:"error,omitempty"`
+	Instance string    `json:"instance,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+type listEventsRes struct {
+	Events []eventRes `json:"events"`
+}
+
+func (res listEventsRes) Code() int {
+	return 200
+}
+
+func (res listEventsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res listEventsRes) Empty() bool {
+	return false
+}
@@ -24,6 +24,7 @@ const (
 	contentType = "application/json"
 	offsetKey   = "offset"
 	limitKey    = "limit"
+	clientIDKey = "client_id"
 	defOffset   = 0
 	defLimit    = 10
 )
@@ -43,6 +44,20 @@ func MakeHandler(tracer opentracing.Tracer, svc mqtt.Service, logger logger.Logg
 		opts...,
 	))
 
+	r.Get("/subscriptions/lookup", kithttp.NewServer(
+		kitot.TraceServer(tracer, "lookup_client")(lookupClient(svc)),
+		decodeLookupClient,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/things/:id/events", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_events")(listEvents(svc)),
+		decodeListEvents,
+		encodeResponse,
+		opts...,
+	))
+
 	r.GetFunc("/health", mainflux.Health("mqtt"))
 	r.Handle("/metrics", promhttp.Handler())
 
@@ -71,6 +86,32 @@ func decodeListSubscriptions(ctx context.Context, r *http.Request) (interface{},
 	}, nil
 }
 
+func decodeLookupClient(_ context.Context, r *http.Request) (interface{}, error) {
+	c, err := apiutil.ReadStringQuery(r, clientIDKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return lookupClientReq{
+		token:    apiutil.ExtractBearerToken(r),
+		clientID: c,
+	}, nil
+}
+
+func decodeListEvents(_ context.Context, r *http.Request) (interface{}, error) {
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return listEventsReq{
+		thingID: bone.GetValue(r, "id"),
+		token:   apiutil.ExtractBearerToken(r),
+		key:     apiutil.ExtractThingKey(r),
+		limit:   l,
+	}, nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", contentType)
 
@@ -89,24 +130,32 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, apiutil.ErrMalformedEntity),
-		errors.Contains(err, apiutil.ErrInvalidQueryParams):
+		errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingGroupID,
+		err == apiutil.ErrMissingClientID,
+		err == apiutil.ErrLimitSize:
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrAuthentication),
-		err == apiutil.ErrBearerToken:
+		err == apiutil.ErrBearerToken,
+		err == errAuthHeader:
+		w.WriteHeader(http.StatusUnauthorized)
 	case errors.Contains(err, errors.ErrAuthorization):
 		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
 		w.WriteHeader(http.StatusUnsupportedMediaType)
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
@@ -13,6 +13,7 @@ import (
 
 	log "github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/mqtt"
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
 )
 
 var _ mqtt.Service = (*loggingMiddleware)(nil)
@@ -31,10 +32,10 @@ func (lm *loggingMiddleware) ListSubscriptions(ctx context.Context, groupID, tok
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_subscriptions took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListSubscriptions(ctx, groupID, token, key, pm)
@@ -44,10 +45,10 @@ func (lm *loggingMiddleware) CreateSubscription(ctx context.Context, sub mqtt.Su
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method create_subscription took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateSubscription(ctx, sub)
@@ -57,10 +58,10 @@ func (lm *loggingMiddleware) RemoveSubscription(ctx context.Context, sub mqtt.Su
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_subscription took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveSubscription(ctx, sub)
@@ -70,24 +71,50 @@ func (lm *loggingMiddleware) HasClientID(ctx context.Context, clientID string) (
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method has_client_id took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.HasClientID(ctx, clientID)
 }
 
+func (lm *loggingMiddleware) ListSubscriptionsByClientID(ctx context.Context, token, clientID string) (subs []mqtt.Subscription, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_subscriptions_by_client_id for client %s took %s to complete", clientID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListSubscriptionsByClientID(ctx, token, clientID)
+}
+
 func (lm *loggingMiddleware) UpdateStatus(ctx context.Context, sub mqtt.Subscription) (err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_status took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateStatus(ctx, sub)
 }
+
+func (lm *loggingMiddleware) ListEvents(ctx context.Context, thingID, token, key string, limit uint64) (_ []redis.Event, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_events for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListEvents(ctx, thingID, token, key, limit)
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/mqtt"
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
 	"github.com/go-kit/kit/metrics"
 )
 
@@ -68,6 +69,15 @@ func (ms *metricsMiddleware) HasClientID(ctx context.Context, clientID string) e
 	return ms.svc.HasClientID(ctx, clientID)
 }
 
+func (ms *metricsMiddleware) ListSubscriptionsByClientID(ctx context.Context, token, clientID string) ([]mqtt.Subscription, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_subscriptions_by_client_id").Add(1)
+		ms.latency.With("method", "list_subscriptions_by_client_id").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListSubscriptionsByClientID(ctx, token, clientID)
+}
+
 func (ms *metricsMiddleware) UpdateStatus(ctx context.Context, sub mqtt.Subscription) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "update_status").Add(1)
@@ -76,3 +86,12 @@ func (ms *metricsMiddleware) UpdateStatus(ctx context.Context, sub mqtt.Subscrip
 
 	return ms.svc.UpdateStatus(ctx, sub)
 }
+
+func (ms *metricsMiddleware) ListEvents(ctx context.Context, thingID, token, key string, limit uint64) ([]redis.Event, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_events").Add(1)
+		ms.latency.With("method", "list_events").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListEvents(ctx, thingID, token, key, limit)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt
+
+import (
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	connectOp   = "connect"
+	publishOp   = "publish"
+	subscribeOp = "subscribe"
+)
+
+// The reasons of the rejected client operations, recorded in the failure events.
+const (
+	// ReasonMissingClientID indicates that the client connected without the client ID.
+	ReasonMissingClientID = "missing_client_id"
+	// ReasonInvalidKey indicates that the password isn't a valid thing key.
+	ReasonInvalidKey = "invalid_key"
+	// ReasonKeyMismatch indicates that the thing key doesn't belong to the
+	// thing used as the username.
+	ReasonKeyMismatch = "key_mismatch"
+	// ReasonNotAuthorized indicates that the thing isn't allowed to perform the operation.
+	ReasonNotAuthorized = "not_authorized"
+	// ReasonRateLimited indicates that the things service rejected the request
+	// because the request rate limit was exceeded.
+	ReasonRateLimited = "rate_limited"
+	// ReasonSessionTakenOver indicates that the session was taken over by
+	// another connection using the same client ID.
+	ReasonSessionTakenOver = "session_taken_over"
+	// ReasonNetworkDenied indicates that the client connected from the network
+	// which isn't allowed by the network ACLs of the thing or its org.
+	ReasonNetworkDenied = "network_denied"
+	// ReasonMalformedTopic indicates that the topic or topic filter is malformed.
+	ReasonMalformedTopic = "malformed_topic"
+	// ReasonMalformedPayload indicates that the payload couldn't be decompressed
+	// using the content encoding of the thing profile, or that it doesn't match
+	// the profile schema.
+	ReasonMalformedPayload = "malformed_payload"
+	// ReasonServiceUnavailable indicates that the things service couldn't be reached.
+	ReasonServiceUnavailable = "service_unavailable"
+	// ReasonInternal indicates an unexpected error.
+	ReasonInternal = "internal_error"
+)
+
+// failureReason returns the reason of the rejection caused by the provided
+// error of the things service.
+func failureReason(err error) string {
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.NotFound, codes.InvalidArgument:
+		return ReasonInvalidKey
+	case codes.PermissionDenied:
+		return ReasonNotAuthorized
+	case codes.ResourceExhausted:
+		return ReasonRateLimited
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return ReasonServiceUnavailable
+	}
+
+	switch {
+	case errors.Contains(err, errors.ErrAuthentication),
+		errors.Contains(err, errors.ErrNotFound):
+		return ReasonInvalidKey
+	case errors.Contains(err, errors.ErrAuthorization):
+		return ReasonNotAuthorized
+	default:
+		return ReasonInternal
+	}
+}
@@ -5,6 +5,7 @@ package mqtt
 
 import (
 	"fmt"
+	"time"
 
 	log "github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
@@ -47,6 +48,12 @@ func handle(pub messaging.Publisher, logger log.Logger) handleFunc {
 			return nil
 		}
 
+		// The expired messages, e.g. the stale commands, aren't
+		// delivered to the devices.
+		if messaging.Expired(msg, time.Now()) {
+			return nil
+		}
+
 		go func() {
 			if err := pub.Publish(msg); err != nil {
 				logger.Warn(fmt.Sprintf("Failed to forward message: %s", err))
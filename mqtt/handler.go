@@ -7,10 +7,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/mqtt/redis"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -30,6 +32,8 @@ const (
 	LogInfoConnected                   = "connected with client_id %s"
 	LogInfoDisconnected                = "disconnected client_id %s and username %s"
 	LogInfoPublished                   = "published with client_id %s to the topic %s"
+	LogInfoWillPublished               = "published will of client_id %s to the topic %s"
+	LogInfoSessionTakenOver            = "closed taken over session of client_id %s and username %s"
 	LogErrFailedConnect                = "failed to connect: "
 	LogErrFailedSubscribe              = "failed to subscribe: "
 	LogErrFailedUnsubscribe            = "failed to unsubscribe: "
@@ -39,6 +43,11 @@ const (
 	logErrFailedParseSubtopic          = "failed to parse subtopic: "
 	LogErrFailedPublishConnectEvent    = "failed to publish connect event: "
 	LogErrFailedPublishToMsgBroker     = "failed to publish to mainflux message broker: "
+	LogErrFailedRegisterSession        = "failed to register session: "
+	LogErrFailedUnregisterSession      = "failed to unregister session: "
+	LogErrFailedPublishFailEvent       = "failed to publish fail event: "
+	LogErrFailedPublishWillEvent       = "failed to publish will event: "
+	LogWarnRejected                    = "rejected %s of client_id %s and username %s with reason %s: %s"
 )
 
 var (
@@ -50,8 +59,16 @@ var (
 	ErrMissingTopicSub           = errors.New("failed to subscribe due to missing topic")
 	ErrAuthentication            = errors.New("failed to perform authentication over the entity")
 	ErrSubscriptionAlreadyExists = errors.New("subscription already exists")
+	ErrSessionTakenOver          = errors.New("session taken over by another connection")
 )
 
+// will is the Last Will and Testament of the client, published on its behalf
+// once the client disconnects abnormally.
+type will struct {
+	topic string
+	msg   protomfx.Message
+}
+
 // Event implements events.Event interface
 type handler struct {
 	publishers []messaging.Publisher
@@ -59,17 +76,34 @@ type handler struct {
 	logger     logger.Logger
 	es         redis.EventStore
 	service    Service
+	sessions   redis.SessionRegistry
+	topics     TopicTranslator
+	mu         sync.Mutex
+	active     map[*session.Client]string
+	addrs      map[*session.Client]string
+	closers    map[*session.Client]func()
+	encodings  map[*session.Client]string
+	limits     map[*session.Client]*protomfx.MQTTConfig
+	wills      map[*session.Client]will
 }
 
 // NewHandler creates new Handler entity
-func NewHandler(publishers []messaging.Publisher, es redis.EventStore,
-	logger logger.Logger, things protomfx.ThingsServiceClient, svc Service) session.Handler {
+func NewHandler(publishers []messaging.Publisher, es redis.EventStore, sessions redis.SessionRegistry,
+	logger logger.Logger, things protomfx.ThingsServiceClient, svc Service, topics TopicTranslator) session.Handler {
 	return &handler{
 		es:         es,
 		logger:     logger,
 		publishers: publishers,
 		things:     things,
 		service:    svc,
+		sessions:   sessions,
+		topics:     topics,
+		active:     make(map[*session.Client]string),
+		addrs:      make(map[*session.Client]string),
+		closers:    make(map[*session.Client]func()),
+		encodings:  make(map[*session.Client]string),
+		limits:     make(map[*session.Client]*protomfx.MQTTConfig),
+		wills:      make(map[*session.Client]will),
 	}
 }
 
@@ -81,22 +115,46 @@ func (h *handler) AuthConnect(c *session.Client) error {
 	}
 
 	if c.ID == "" {
-		return ErrMissingClientID
+		return h.fail(c, connectOp, ReasonMissingClientID, ErrMissingClientID)
 	}
 
 	thid, err := h.things.Identify(context.Background(), &protomfx.Token{Value: string(c.Password)})
 	if err != nil {
-		return err
+		return h.fail(c, connectOp, failureReason(err), err)
 	}
 
 	if thid.GetValue() != c.Username {
-		return errors.ErrAuthentication
+		return h.fail(c, connectOp, ReasonKeyMismatch, errors.ErrAuthentication)
+	}
+
+	pc, err := h.things.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: string(c.Password)})
+	if err != nil {
+		return h.fail(c, connectOp, failureReason(err), err)
 	}
 
+	if !h.permitsNetwork(c, pc) {
+		return h.fail(c, connectOp, ReasonNetworkDenied, auth.ErrNetworkDenied)
+	}
+	h.setEncoding(c, pc)
+
+	h.mu.Lock()
+	h.limits[c] = pc.GetProfileConfig().GetMqtt()
+	h.mu.Unlock()
+
 	if err := h.es.Connect(c.Username); err != nil {
 		h.logger.Error(LogErrFailedPublishConnectEvent + err.Error())
 	}
 
+	sid, err := h.sessions.Register(c.ID, func() { h.closeConn(c) })
+	if err != nil {
+		h.logger.Error(LogErrFailedRegisterSession + err.Error())
+		return nil
+	}
+
+	h.mu.Lock()
+	h.active[c] = sid
+	h.mu.Unlock()
+
 	return nil
 }
 
@@ -109,10 +167,44 @@ func (h *handler) AuthPublish(c *session.Client, topic *string, payload *[]byte)
 	if topic == nil {
 		return ErrMissingTopicPub
 	}
+	if h.revoked(c) {
+		return h.fail(c, publishOp, ReasonSessionTakenOver, ErrSessionTakenOver)
+	}
 
-	if _, err := h.authAccess(c); err != nil {
+	pc, err := h.authAccess(c, publishOp)
+	if err != nil {
 		return err
 	}
+	h.setEncoding(c, pc)
+
+	// The topic is translated in place, so that the broker
+	// receives the message on the canonical topic.
+	*topic = h.topics.Translate(*topic)
+
+	// The gateway publishes on behalf of its child things to the
+	// /things/<thing_id>/messages topic.
+	if thingID := messaging.ExtractThingID(*topic); thingID != "" {
+		pc, err := h.pubConf(c, thingID)
+		if err != nil {
+			return h.fail(c, publishOp, failureReason(err), err)
+		}
+
+		if !h.permitsNetwork(c, pc) {
+			return h.fail(c, publishOp, ReasonNetworkDenied, auth.ErrNetworkDenied)
+		}
+	}
+
+	// The payload is decompressed in place, so that the broker
+	// receives the uncompressed message.
+	data, err := messaging.Decompress(pc.GetProfileConfig().GetContentEncoding(), *payload)
+	if err != nil {
+		return h.fail(c, publishOp, ReasonMalformedPayload, err)
+	}
+	*payload = data
+
+	if err := messaging.ValidatePayload(pc.GetProfileConfig(), data); err != nil {
+		return h.fail(c, publishOp, ReasonMalformedPayload, err)
+	}
 
 	return nil
 }
@@ -126,11 +218,27 @@ func (h *handler) AuthSubscribe(c *session.Client, topics *[]string) error {
 	if topics == nil || *topics == nil {
 		return ErrMissingTopicSub
 	}
+	if h.revoked(c) {
+		return h.fail(c, subscribeOp, ReasonSessionTakenOver, ErrSessionTakenOver)
+	}
 
-	if _, err := h.authAccess(c); err != nil {
+	pc, err := h.authAccess(c, subscribeOp)
+	if err != nil {
 		return err
 	}
 
+	// The subscribe request is rejected as a whole if any of its filters
+	// is malformed or names the profile other than the profile of the
+	// thing, so that no subscription is partially created.
+	for _, t := range *topics {
+		if _, err := ParseFilter(t); err != nil {
+			return h.fail(c, subscribeOp, ReasonMalformedTopic, err)
+		}
+		if id := filterProfileID(t); id != "" && id != pc.GetProfileID() {
+			return h.fail(c, subscribeOp, ReasonNotAuthorized, errors.Wrap(errors.ErrAuthorization, fmt.Errorf("%q", t)))
+		}
+	}
+
 	return nil
 }
 
@@ -153,6 +261,7 @@ func (h *handler) Publish(c *session.Client, topic *string, payload *[]byte) {
 	h.logger.Info(fmt.Sprintf(LogInfoPublished, c.ID, *topic))
 	// Topics are in the format:
 	// messages/<subtopic>/.../ct/<content_type>
+	// or things/<thing_id>/messages/<subtopic>/... if published by the gateway
 
 	subtopic, err := messaging.ExtractSubtopic(*topic)
 	if err != nil {
@@ -166,9 +275,10 @@ func (h *handler) Publish(c *session.Client, topic *string, payload *[]byte) {
 		return
 	}
 
-	pc, err := h.things.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: string(c.Password)})
+	pc, err := h.pubConf(c, messaging.ExtractThingID(*topic))
 	if err != nil {
 		h.logger.Error(LogErrFailedPublish + (ErrAuthentication).Error())
+		return
 	}
 
 	m := messaging.CreateMessage(pc, protocol, subject, payload)
@@ -193,11 +303,16 @@ func (h *handler) Subscribe(c *session.Client, topics *[]string) {
 		return
 	}
 
+	// Each filter is persisted separately, so that the filter which is
+	// already subscribed to doesn't prevent persisting the others.
 	for _, s := range subs {
-		err = h.service.CreateSubscription(context.Background(), s)
-		if err != nil {
-			h.logger.Error(LogErrFailedSubscribe + (ErrSubscriptionAlreadyExists).Error())
-			return
+		err := h.service.CreateSubscription(context.Background(), s)
+		switch {
+		case err == nil:
+		case errors.Contains(err, errors.ErrConflict):
+			h.logger.Warn(LogErrFailedSubscribe + ErrSubscriptionAlreadyExists.Error())
+		default:
+			h.logger.Error(LogErrFailedSubscribe + err.Error())
 		}
 	}
 	h.logger.Info(fmt.Sprintf(LogInfoSubscribed, c.ID, strings.Join(*topics, ",")))
@@ -212,13 +327,13 @@ func (h *handler) Unsubscribe(c *session.Client, topics *[]string) {
 
 	subs, err := h.getSubscriptions(c, topics)
 	if err != nil {
-		h.logger.Error(LogErrFailedSubscribe + err.Error())
+		h.logger.Error(LogErrFailedUnsubscribe + err.Error())
 		return
 	}
 
 	for _, s := range subs {
-		if h.service.RemoveSubscription(context.Background(), s); err != nil {
-			h.logger.Error(LogErrFailedUnsubscribe + (ErrClientNotInitialized).Error())
+		if err := h.service.RemoveSubscription(context.Background(), s); err != nil {
+			h.logger.Error(LogErrFailedUnsubscribe + err.Error())
 		}
 	}
 
@@ -233,42 +348,246 @@ func (h *handler) Disconnect(c *session.Client) {
 	}
 
 	h.logger.Error(fmt.Sprintf(LogInfoDisconnected, c.ID, c.Username))
+
+	h.mu.Lock()
+	sid, ok := h.active[c]
+	delete(h.active, c)
+	delete(h.addrs, c)
+	delete(h.closers, c)
+	delete(h.encodings, c)
+	delete(h.limits, c)
+	w, hasWill := h.wills[c]
+	delete(h.wills, c)
+	h.mu.Unlock()
+
+	// The will is cleared once the client disconnects gracefully, so the
+	// remaining one belongs to the client whose connection was dropped. It's
+	// published before the disconnect event, so that the consumers of the
+	// event stream find the will of the client once they see it disconnect.
+	if hasWill {
+		h.publishWill(c, w)
+	}
+
 	if err := h.es.Disconnect(c.Username); err != nil {
 		h.logger.Error(LogErrFailedPublishDisconnectEvent + err.Error())
 	}
+
+	if ok {
+		if err := h.sessions.Unregister(c.ID, sid); err != nil {
+			h.logger.Error(LogErrFailedUnregisterSession + err.Error())
+		}
+	}
+}
+
+// setWill authorizes the Last Will and Testament the client set at CONNECT,
+// and keeps it until the client disconnects. The will topic and payload are
+// translated and decompressed in place, like the published ones, so that the
+// broker receives the same will.
+func (h *handler) setWill(c *session.Client, topic *string, payload *[]byte) error {
+	*topic = h.topics.Translate(*topic)
+
+	pc, err := h.pubConf(c, messaging.ExtractThingID(*topic))
+	if err != nil {
+		return h.fail(c, connectOp, failureReason(err), err)
+	}
+
+	if !h.permitsNetwork(c, pc) {
+		return h.fail(c, connectOp, ReasonNetworkDenied, auth.ErrNetworkDenied)
+	}
+
+	subtopic, err := messaging.ExtractSubtopic(*topic)
+	if err != nil {
+		return h.fail(c, connectOp, ReasonMalformedTopic, ErrMalformedTopic)
+	}
+
+	subject, err := messaging.CreateSubject(subtopic)
+	if err != nil {
+		return h.fail(c, connectOp, ReasonMalformedTopic, errors.Wrap(ErrMalformedTopic, err))
+	}
+
+	data, err := messaging.Decompress(pc.GetProfileConfig().GetContentEncoding(), *payload)
+	if err != nil {
+		return h.fail(c, connectOp, ReasonMalformedPayload, err)
+	}
+	*payload = data
+
+	if err := messaging.ValidatePayload(pc.GetProfileConfig(), data); err != nil {
+		return h.fail(c, connectOp, ReasonMalformedPayload, err)
+	}
+
+	h.mu.Lock()
+	h.wills[c] = will{topic: *topic, msg: messaging.CreateMessage(pc, protocol, subject, payload)}
+	h.mu.Unlock()
+
+	return nil
+}
+
+// clearWill discards the will of the client which disconnected gracefully.
+func (h *handler) clearWill(c *session.Client) {
+	h.mu.Lock()
+	delete(h.wills, c)
+	h.mu.Unlock()
+}
+
+// publishWill publishes the will of the client disconnected abnormally, as
+// if the client published it at the moment of disconnecting.
+func (h *handler) publishWill(c *session.Client, w will) {
+	w.msg.Created = time.Now().UnixNano()
+	for _, pub := range h.publishers {
+		if err := pub.Publish(w.msg); err != nil {
+			h.logger.Error(LogErrFailedPublishToMsgBroker + err.Error())
+		}
+	}
+	h.logger.Info(fmt.Sprintf(LogInfoWillPublished, c.ID, w.topic))
+
+	if err := h.es.Will(c.Username, c.ID); err != nil {
+		h.logger.Error(LogErrFailedPublishWillEvent + err.Error())
+	}
 }
 
-func (h *handler) authAccess(c *session.Client) (protomfx.PubConfByKeyRes, error) {
+// revoked checks if the client session was taken over by a connection
+// with the same client ID, to this or another adapter instance.
+func (h *handler) revoked(c *session.Client) bool {
+	h.mu.Lock()
+	sid, ok := h.active[c]
+	h.mu.Unlock()
+
+	return ok && h.sessions.Revoked(sid)
+}
+
+// authAccess authorizes the client operation and returns the publish
+// configuration of the client thing.
+func (h *handler) authAccess(c *session.Client, operation string) (*protomfx.PubConfByKeyRes, error) {
 	pc, err := h.things.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: string(c.Password)})
 	if err != nil {
-		return protomfx.PubConfByKeyRes{}, err
+		return nil, h.fail(c, operation, failureReason(err), err)
 	}
 
 	if pc.PublisherID != c.Username {
-		return protomfx.PubConfByKeyRes{}, ErrAuthentication
+		return nil, h.fail(c, operation, ReasonKeyMismatch, ErrAuthentication)
 	}
 
-	return *pc, nil
+	if !h.permitsNetwork(c, pc) {
+		return nil, h.fail(c, operation, ReasonNetworkDenied, auth.ErrNetworkDenied)
+	}
+
+	return pc, nil
+}
+
+// setClientAddr sets the IP address the client connected from, which the
+// network ACLs of the thing are enforced on.
+func (h *handler) setClientAddr(c *session.Client, addr string) {
+	h.mu.Lock()
+	h.addrs[c] = addr
+	h.mu.Unlock()
 }
 
+// setConnCloser sets the function which closes the client connection.
+func (h *handler) setConnCloser(c *session.Client, closer func()) {
+	h.mu.Lock()
+	h.closers[c] = closer
+	h.mu.Unlock()
+}
+
+// closeConn closes the connection of the client whose session was taken
+// over, so that it doesn't stay open until its next publish or subscribe.
+func (h *handler) closeConn(c *session.Client) {
+	h.mu.Lock()
+	closer, ok := h.closers[c]
+	h.mu.Unlock()
+
+	if ok {
+		h.logger.Info(fmt.Sprintf(LogInfoSessionTakenOver, c.ID, c.Username))
+		closer()
+	}
+}
+
+// setEncoding sets the content encoding of the client payloads, which is
+// refreshed on each publish, so that the profile changes take effect without
+// reconnecting the client.
+func (h *handler) setEncoding(c *session.Client, pc *protomfx.PubConfByKeyRes) {
+	h.mu.Lock()
+	h.encodings[c] = pc.GetProfileConfig().GetContentEncoding()
+	h.mu.Unlock()
+}
+
+// sessionLimits returns the MQTT session parameters of the client profile,
+// which are set at CONNECT and kept for the lifetime of the connection.
+func (h *handler) sessionLimits(c *session.Client) *protomfx.MQTTConfig {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.limits[c]
+}
+
+// encodePayload compresses the payload sent to the client using the content
+// encoding of the client profile.
+func (h *handler) encodePayload(c *session.Client, payload []byte) ([]byte, error) {
+	h.mu.Lock()
+	encoding := h.encodings[c]
+	h.mu.Unlock()
+
+	return messaging.Compress(encoding, payload)
+}
+
+// permitsNetwork reports whether the network ACLs of the publish configuration
+// permit the client address. The client with the unknown address is permitted
+// only if there are no ACLs.
+func (h *handler) permitsNetwork(c *session.Client, pc *protomfx.PubConfByKeyRes) bool {
+	h.mu.Lock()
+	addr := h.addrs[c]
+	h.mu.Unlock()
+
+	return auth.PermitsNetwork(pc.GetNetworkACLs(), addr)
+}
+
+// pubConf returns the publish configuration of the client thing, or of the
+// child thing if the client is the gateway publishing on behalf of it.
+func (h *handler) pubConf(c *session.Client, thingID string) (*protomfx.PubConfByKeyRes, error) {
+	if thingID != "" {
+		return h.things.GetPubConfByGateway(context.Background(), &protomfx.PubConfByGatewayReq{Key: string(c.Password), ThingID: thingID})
+	}
+
+	return h.things.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: string(c.Password)})
+}
+
+// fail records the event of the rejected client operation with the reason of
+// the rejection, so that the thing owner can find out why the client failed,
+// and returns the error which caused the rejection.
+func (h *handler) fail(c *session.Client, operation, reason string, err error) error {
+	h.logger.Warn(fmt.Sprintf(LogWarnRejected, operation, c.ID, c.Username, reason, err))
+
+	if esErr := h.es.Fail(c.Username, c.ID, operation, reason, err); esErr != nil {
+		h.logger.Error(LogErrFailedPublishFailEvent + esErr.Error())
+	}
+
+	return err
+}
+
+// getSubscriptions returns the subscriptions of the session to the topic
+// filters. The filters resolving to the same subtopic, e.g. /messages/a/+ and
+// /profiles/<profile_id>/messages/a/+, result in a single subscription.
 func (h *handler) getSubscriptions(c *session.Client, topics *[]string) ([]Subscription, error) {
+	groupID, err := h.things.GetGroupIDByThingID(context.Background(), &protomfx.ThingID{Value: c.Username})
+	if err != nil {
+		return nil, err
+	}
+
 	var subs []Subscription
+	seen := make(map[string]bool)
 	for _, t := range *topics {
-
-		subtopic, err := messaging.ExtractSubtopic(t)
+		subtopic, err := ParseFilter(t)
 		if err != nil {
 			return nil, err
 		}
 
-		groupID, err := h.things.GetGroupIDByThingID(context.Background(), &protomfx.ThingID{Value: c.Username})
-
-		subject, err := messaging.CreateSubject(subtopic)
-		if err != nil {
-			return nil, err
+		if seen[subtopic] {
+			continue
 		}
+		seen[subtopic] = true
 
 		sub := Subscription{
-			Subtopic:  subject,
+			Subtopic:  subtopic,
 			GroupID:   groupID.GetValue(),
 			ThingID:   c.Username,
 			ClientID:  c.ID,
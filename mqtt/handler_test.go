@@ -2,13 +2,17 @@ package mqtt_test
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/mqtt"
 	"github.com/MainfluxLabs/mainflux/mqtt/mocks"
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
@@ -18,6 +22,10 @@ import (
 
 const (
 	thingID   = "513d02d2-16c1-4f23-98be-9e12f8fee898"
+	childID   = "7c9f1a3e-2b4d-4e8f-a1c6-5d3b9e7f2a10"
+	childKey  = "childKey"
+	zipID     = "c2a7e4b1-8f3d-4a6e-9b5c-1d7f3e9a2b64"
+	zipKey    = "compressed"
 	groupID   = "9e12f8fe-e89b-a456-12d3-513d02d21212"
 	invalidID = "invalidID"
 	clientID  = "clientID"
@@ -26,10 +34,16 @@ const (
 )
 
 var (
-	topic        = "/messages"
-	invalidTopic = "invalidTopic"
-	payload      = []byte("[{'n':'test-name', 'v': 1.2}]")
-	topics       = []string{topic}
+	topic             = "/messages"
+	invalidTopic      = "invalidTopic"
+	childTopic        = "/things/" + childID + "/messages"
+	invalidChildTopic = "/things/" + invalidID + "/messages"
+	payload           = []byte("[{'n':'test-name', 'v': 1.2}]")
+	topics            = []string{topic}
+	wildcardTopics    = []string{
+		topic + "/+/" + subtopic,
+		topic + "/" + subtopic + "/#",
+	}
 	//Test log messages for cases the handler does not provide a return value.
 	logBuffer     = bytes.Buffer{}
 	sessionClient = session.Client{
@@ -85,6 +99,15 @@ func TestAuthConnect(t *testing.T) {
 			err:     nil,
 			session: &sessionClient,
 		},
+		{
+			desc: "connect from unknown address with network ACLs",
+			err:  auth.ErrNetworkDenied,
+			session: &session.Client{
+				ID:       clientID,
+				Username: restrictedID,
+				Password: []byte(restrictedKey),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -124,6 +147,20 @@ func TestAuthPublish(t *testing.T) {
 			topic:   &topic,
 			payload: payload,
 		},
+		{
+			desc:    "publish on behalf of child",
+			client:  &sessionClient,
+			err:     nil,
+			topic:   &childTopic,
+			payload: payload,
+		},
+		{
+			desc:    "publish on behalf of thing which isn't child",
+			client:  &sessionClient,
+			err:     errors.ErrAuthorization,
+			topic:   &invalidChildTopic,
+			payload: payload,
+		},
 	}
 
 	for _, tc := range cases {
@@ -132,6 +169,82 @@ func TestAuthPublish(t *testing.T) {
 	}
 }
 
+func TestAuthPublishCompressed(t *testing.T) {
+	handler := newHandler()
+	client := session.Client{
+		ID:       clientID,
+		Username: zipID,
+		Password: []byte(zipKey),
+	}
+
+	compressed, err := messaging.Compress(messaging.GzipEncoding, payload)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error compressing payload: %s", err))
+
+	cases := []struct {
+		desc    string
+		payload []byte
+		res     []byte
+		err     error
+	}{
+		{
+			desc:    "publish compressed payload",
+			payload: compressed,
+			res:     payload,
+			err:     nil,
+		},
+		{
+			desc:    "publish uncompressed payload",
+			payload: payload,
+			res:     payload,
+			err:     messaging.ErrDecompress,
+		},
+	}
+
+	for _, tc := range cases {
+		err := handler.AuthPublish(&client, &topic, &tc.payload)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.res, tc.payload, fmt.Sprintf("%s: expected payload %s got %s\n", tc.desc, tc.res, tc.payload))
+	}
+}
+
+func TestSessionTakeover(t *testing.T) {
+	handler := newHandler()
+
+	prev := session.Client{ID: clientID, Username: thingID, Password: []byte(password)}
+	next := session.Client{ID: clientID, Username: thingID, Password: []byte(password)}
+
+	err := handler.AuthConnect(&prev)
+	assert.Nil(t, err, fmt.Sprintf("connect previous session: unexpected error %s", err))
+	err = handler.AuthConnect(&next)
+	assert.Nil(t, err, fmt.Sprintf("connect next session: unexpected error %s", err))
+
+	cases := []struct {
+		desc   string
+		client *session.Client
+		err    error
+	}{
+		{
+			desc:   "publish with session taken over",
+			client: &prev,
+			err:    mqtt.ErrSessionTakenOver,
+		},
+		{
+			desc:   "publish with session which took over",
+			client: &next,
+			err:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := handler.AuthPublish(tc.client, &topic, &payload)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	handler.Disconnect(&prev)
+	err = handler.AuthSubscribe(&next, &topics)
+	assert.Nil(t, err, fmt.Sprintf("subscribe after previous session disconnected: unexpected error %s", err))
+}
+
 func TestAuthSubscribe(t *testing.T) {
 	handler := newHandler()
 
@@ -165,6 +278,36 @@ func TestAuthSubscribe(t *testing.T) {
 			err:    nil,
 			topic:  &topics,
 		},
+		{
+			desc:   "subscribe with wildcard topics",
+			client: &sessionClient,
+			err:    nil,
+			topic:  &wildcardTopics,
+		},
+		{
+			desc:   "subscribe with wildcard root topic",
+			client: &sessionClient,
+			err:    mqtt.ErrMalformedFilter,
+			topic:  &[]string{topic, "#"},
+		},
+		{
+			desc:   "subscribe with misplaced wildcard topic",
+			client: &sessionClient,
+			err:    mqtt.ErrMalformedFilter,
+			topic:  &[]string{topic, "/messages/#/" + subtopic},
+		},
+		{
+			desc:   "subscribe with profile topic",
+			client: &sessionClient,
+			err:    nil,
+			topic:  &[]string{"/profiles/" + profileID + "/messages/#"},
+		},
+		{
+			desc:   "subscribe with other profile topic",
+			client: &sessionClient,
+			err:    errors.ErrAuthorization,
+			topic:  &[]string{topic, "/profiles/" + groupID + "/messages/#"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -173,6 +316,81 @@ func TestAuthSubscribe(t *testing.T) {
 	}
 }
 
+func TestFailEvents(t *testing.T) {
+	es := mocks.NewEventStore()
+	handler := newHandlerWithEvents(es)
+
+	cases := []struct {
+		desc   string
+		client session.Client
+		call   func(c *session.Client) error
+		event  string
+		reason string
+	}{
+		{
+			desc:   "connect with invalid password",
+			client: session.Client{ID: clientID, Username: thingID, Password: []byte("invalid")},
+			call:   handler.AuthConnect,
+			event:  "connect_fail",
+			reason: mqtt.ReasonInvalidKey,
+		},
+		{
+			desc:   "connect with the key of another thing",
+			client: session.Client{ID: clientID, Username: invalidID, Password: []byte(password)},
+			call:   handler.AuthConnect,
+			event:  "connect_fail",
+			reason: mqtt.ReasonKeyMismatch,
+		},
+		{
+			desc:   "connect without client ID",
+			client: session.Client{Username: thingID, Password: []byte(password)},
+			call:   handler.AuthConnect,
+			event:  "connect_fail",
+			reason: mqtt.ReasonMissingClientID,
+		},
+		{
+			desc:   "publish with unauthorized key",
+			client: session.Client{ID: clientID, Username: thingID, Password: []byte("token")},
+			call: func(c *session.Client) error {
+				return handler.AuthPublish(c, &topic, &payload)
+			},
+			event:  "publish_fail",
+			reason: mqtt.ReasonNotAuthorized,
+		},
+		{
+			desc:   "publish on behalf of thing which isn't child",
+			client: sessionClient,
+			call: func(c *session.Client) error {
+				return handler.AuthPublish(c, &invalidChildTopic, &payload)
+			},
+			event:  "publish_fail",
+			reason: mqtt.ReasonNotAuthorized,
+		},
+		{
+			desc:   "subscribe to malformed topic",
+			client: sessionClient,
+			call: func(c *session.Client) error {
+				return handler.AuthSubscribe(c, &[]string{invalidTopic})
+			},
+			event:  "subscribe_fail",
+			reason: mqtt.ReasonMalformedTopic,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tc.call(&tc.client)
+		assert.NotNil(t, err, fmt.Sprintf("%s: expected error", tc.desc))
+
+		events, err := es.RetrieveByThing(context.Background(), tc.client.Username, 1)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		if assert.Len(t, events, 1, fmt.Sprintf("%s: expected fail event", tc.desc)) {
+			assert.Equal(t, tc.event, events[0].Type, fmt.Sprintf("%s: expected event %s got %s", tc.desc, tc.event, events[0].Type))
+			assert.Equal(t, tc.reason, events[0].Reason, fmt.Sprintf("%s: expected reason %s got %s", tc.desc, tc.reason, events[0].Reason))
+			assert.Equal(t, tc.client.ID, events[0].ClientID, fmt.Sprintf("%s: expected client ID %s got %s", tc.desc, tc.client.ID, events[0].ClientID))
+		}
+	}
+}
+
 func TestConnect(t *testing.T) {
 	handler := newHandler()
 	logBuffer.Reset()
@@ -257,6 +475,13 @@ func TestPublish(t *testing.T) {
 			payload: payload,
 			logMsg:  "",
 		},
+		{
+			desc:    "publish on behalf of child",
+			client:  &sessionClient,
+			topic:   childTopic + "/" + subtopic,
+			payload: payload,
+			logMsg:  fmt.Sprintf(mqtt.LogInfoPublished, clientID, childTopic+"/"+subtopic),
+		},
 	}
 
 	for _, tc := range cases {
@@ -287,6 +512,12 @@ func TestSubscribe(t *testing.T) {
 			topic:  topics,
 			logMsg: fmt.Sprintf(mqtt.LogInfoSubscribed, clientID, topics[0]),
 		},
+		{
+			desc:   "subscribe with already subscribed and wildcard topics",
+			client: &sessionClient,
+			topic:  append([]string{topic}, wildcardTopics...),
+			logMsg: fmt.Sprintf(mqtt.LogInfoSubscribed, clientID, strings.Join(append([]string{topic}, wildcardTopics...), ",")),
+		},
 	}
 
 	for _, tc := range cases {
@@ -356,12 +587,16 @@ func TestDisconnect(t *testing.T) {
 }
 
 func newHandler() session.Handler {
+	return newHandlerWithEvents(mocks.NewEventStore())
+}
+
+func newHandlerWithEvents(es redis.EventStore) session.Handler {
 	logger, err := logger.New(&logBuffer, "debug")
 	if err != nil {
 		log.Fatalf("failed to create logger: %s", err)
 	}
 
-	thingsClient := thmocks.NewThingsServiceClient(nil, map[string]string{password: thingID}, nil)
-	eventStore := mocks.NewEventStore()
-	return mqtt.NewHandler([]messaging.Publisher{thmocks.NewPublisher()}, eventStore, logger, thingsClient, newService())
+	thingsClient := thmocks.NewThingsServiceClient(map[string]string{password: profileID}, map[string]string{password: thingID, thingID: groupID, childKey: childID, zipKey: zipID, restrictedKey: restrictedID}, nil)
+	sessions := mocks.NewSessionRegistry()
+	return mqtt.NewHandler([]messaging.Publisher{thmocks.NewPublisher()}, es, sessions, logger, thingsClient, newService(), mqtt.NewTopicTranslator(false, nil))
 }
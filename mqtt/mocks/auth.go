@@ -3,6 +3,7 @@ package mocks
 import (
 	"context"
 
+	rootauth "github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -10,6 +11,11 @@ import (
 	"google.golang.org/grpc"
 )
 
+const (
+	authoritiesObj = "authorities"
+	memberRelation = "member"
+)
+
 var _ protomfx.AuthServiceClient = (*authServiceMock)(nil)
 
 type MockClient struct {
@@ -63,6 +69,23 @@ func (svc authServiceMock) Identify(ctx context.Context, in *protomfx.Token, opt
 	return nil, errors.ErrAuthentication
 }
 
+func (svc authServiceMock) IdentifyBatch(ctx context.Context, in *protomfx.TokensReq, _ ...grpc.CallOption) (*protomfx.UserIdentitiesRes, error) {
+	ids := []*protomfx.UserIdentity{}
+	for _, token := range in.GetTokens() {
+		id, err := svc.Identify(ctx, &protomfx.Token{Value: token})
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return &protomfx.UserIdentitiesRes{Identities: ids}, nil
+}
+
+func (svc authServiceMock) IdentifyDelegated(_ context.Context, _ *protomfx.Token, _ ...grpc.CallOption) (*protomfx.DelegatedIdentity, error) {
+	return nil, errors.ErrAuthentication
+}
+
 func (svc authServiceMock) Issue(ctx context.Context, in *protomfx.IssueReq, opts ...grpc.CallOption) (*protomfx.Token, error) {
 	if id, ok := svc.users[in.GetEmail()]; ok {
 		switch in.Type {
@@ -74,6 +97,19 @@ func (svc authServiceMock) Issue(ctx context.Context, in *protomfx.IssueReq, opt
 }
 
 func (svc authServiceMock) Authorize(ctx context.Context, req *protomfx.AuthorizeReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	if req.GetSubject() == rootauth.RootSub {
+		id, ok := svc.users[req.GetToken()]
+		if !ok {
+			return &empty.Empty{}, errors.ErrAuthentication
+		}
+		for _, ss := range svc.authz[id] {
+			if ss.Object == authoritiesObj && ss.Relation == memberRelation {
+				return &empty.Empty{}, nil
+			}
+		}
+		return &empty.Empty{}, errors.ErrAuthorization
+	}
+
 	if req.GetToken() != "token" {
 		return &empty.Empty{}, errors.ErrAuthorization
 	}
@@ -81,6 +117,16 @@ func (svc authServiceMock) Authorize(ctx context.Context, req *protomfx.Authoriz
 	return &empty.Empty{}, nil
 }
 
+func (svc authServiceMock) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	for _, ar := range req.GetRequests() {
+		if _, err := svc.Authorize(ctx, ar); err != nil {
+			return &empty.Empty{}, err
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (svc authServiceMock) AssignRole(ctx context.Context, req *protomfx.AssignRoleReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
 	panic("not implemented")
 }
@@ -88,3 +134,27 @@ func (svc authServiceMock) AssignRole(ctx context.Context, req *protomfx.AssignR
 func (svc authServiceMock) RetrieveRole(ctx context.Context, req *protomfx.RetrieveRoleReq, _ ...grpc.CallOption) (r *protomfx.RetrieveRoleRes, err error) {
 	panic("not implemented")
 }
+
+func (svc authServiceMock) AssignMembers(ctx context.Context, req *protomfx.AssignMembersReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	panic("not implemented")
+}
+
+func (svc authServiceMock) SignPayload(ctx context.Context, req *protomfx.SignPayloadReq, _ ...grpc.CallOption) (*protomfx.SignPayloadRes, error) {
+	panic("not implemented")
+}
+
+func (svc authServiceMock) RetrieveSigningKey(ctx context.Context, req *protomfx.SigningKeyReq, _ ...grpc.CallOption) (*protomfx.SigningKeyRes, error) {
+	panic("not implemented")
+}
+
+func (svc authServiceMock) CheckQuota(ctx context.Context, req *protomfx.CheckQuotaReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	panic("not implemented")
+}
+
+func (svc authServiceMock) RemoveUser(ctx context.Context, req *protomfx.RemoveUserReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	panic("not implemented")
+}
+
+func (svc authServiceMock) FilterOrgMembers(ctx context.Context, req *protomfx.OrgMembersReq, _ ...grpc.CallOption) (*protomfx.OrgMembersRes, error) {
+	panic("not implemented")
+}
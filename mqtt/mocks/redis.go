@@ -1,19 +1,68 @@
 package mocks
 
 import (
+	"context"
+	"sync"
+	"time"
+
 	"github.com/MainfluxLabs/mainflux/mqtt/redis"
 )
 
-type MockEventStore struct{}
+type MockEventStore struct {
+	mu     sync.Mutex
+	events []redis.Event
+}
 
 func NewEventStore() redis.EventStore {
-	return MockEventStore{}
+	return &MockEventStore{}
 }
 
-func (es MockEventStore) Connect(clientID string) error {
-	return nil
+func (es *MockEventStore) Connect(clientID string) error {
+	return es.save(redis.Event{Type: "connect", ThingID: clientID})
+}
+
+func (es *MockEventStore) Disconnect(clientID string) error {
+	return es.save(redis.Event{Type: "disconnect", ThingID: clientID})
+}
+
+func (es *MockEventStore) Fail(thingID, clientID, operation, reason string, err error) error {
+	e := redis.Event{
+		Type:     operation + "_fail",
+		ThingID:  thingID,
+		ClientID: clientID,
+		Reason:   reason,
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	return es.save(e)
 }
 
-func (es MockEventStore) Disconnect(clientID string) error {
+func (es *MockEventStore) Will(thingID, clientID string) error {
+	return es.save(redis.Event{Type: "will", ThingID: thingID, ClientID: clientID})
+}
+
+func (es *MockEventStore) RetrieveByThing(_ context.Context, thingID string, limit uint64) ([]redis.Event, error) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	events := []redis.Event{}
+	for i := len(es.events) - 1; i >= 0 && uint64(len(events)) < limit; i-- {
+		if es.events[i].ThingID == thingID {
+			events = append(events, es.events[i])
+		}
+	}
+
+	return events, nil
+}
+
+func (es *MockEventStore) save(e redis.Event) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	e.Time = time.Now().UTC()
+	es.events = append(es.events, e)
+
 	return nil
 }
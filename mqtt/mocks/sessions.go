@@ -0,0 +1,75 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
+)
+
+var _ redis.SessionRegistry = (*sessionRegistryMock)(nil)
+
+type sessionRegistryMock struct {
+	mu       sync.Mutex
+	counter  int
+	sessions map[string]string
+	revoked  map[string]bool
+	revokes  map[string]func()
+}
+
+// NewSessionRegistry returns in-memory session registry which revokes
+// the previous session of the client on registration.
+func NewSessionRegistry() redis.SessionRegistry {
+	return &sessionRegistryMock{
+		sessions: make(map[string]string),
+		revoked:  make(map[string]bool),
+		revokes:  make(map[string]func()),
+	}
+}
+
+func (srm *sessionRegistryMock) Register(clientID string, revoke func()) (string, error) {
+	srm.mu.Lock()
+	srm.counter++
+	sid := fmt.Sprintf("mock/%d", srm.counter)
+	prev, ok := srm.sessions[clientID]
+	prevRevoke := srm.revokes[prev]
+	if ok {
+		srm.revoked[prev] = true
+		delete(srm.revokes, prev)
+	}
+	srm.sessions[clientID] = sid
+	srm.revokes[sid] = revoke
+	srm.mu.Unlock()
+
+	if ok && prevRevoke != nil {
+		prevRevoke()
+	}
+
+	return sid, nil
+}
+
+func (srm *sessionRegistryMock) Unregister(clientID, sessionID string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	delete(srm.revoked, sessionID)
+	delete(srm.revokes, sessionID)
+	if srm.sessions[clientID] == sessionID {
+		delete(srm.sessions, clientID)
+	}
+
+	return nil
+}
+
+func (srm *sessionRegistryMock) Revoked(sessionID string) bool {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	return srm.revoked[sessionID]
+}
+
+func (srm *sessionRegistryMock) Listen(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
@@ -55,7 +55,7 @@ func (srm *subRepoMock) Save(_ context.Context, sub mqtt.Subscription) error {
 
 	for _, s := range srm.subs {
 		for _, m := range s {
-			if m.Subtopic == sub.Subtopic && m.ThingID == sub.ThingID && m.GroupID == sub.GroupID {
+			if m.Subtopic == sub.Subtopic && m.ThingID == sub.ThingID && m.GroupID == sub.GroupID && m.ClientID == sub.ClientID {
 				return errors.ErrConflict
 			}
 		}
@@ -69,12 +69,11 @@ func (srm *subRepoMock) Remove(_ context.Context, sub mqtt.Subscription) error {
 	srm.mu.Lock()
 	defer srm.mu.Unlock()
 
-	for _, s := range srm.subs {
-		for _, m := range s {
-			if m.Subtopic == sub.Subtopic && m.ThingID == sub.ThingID && m.GroupID == sub.GroupID {
-				delete(srm.subs, m.GroupID)
-				return nil
-			}
+	subs := srm.subs[sub.GroupID]
+	for i, m := range subs {
+		if m.Subtopic == sub.Subtopic && m.ThingID == sub.ThingID && m.ClientID == sub.ClientID {
+			srm.subs[sub.GroupID] = append(subs[:i], subs[i+1:]...)
+			return nil
 		}
 	}
 
@@ -85,6 +84,22 @@ func (srm *subRepoMock) UpdateStatus(_ context.Context, sub mqtt.Subscription) e
 	return nil
 }
 
+func (srm *subRepoMock) RetrieveByClientID(_ context.Context, clientID string) ([]mqtt.Subscription, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	var subs []mqtt.Subscription
+	for _, s := range srm.subs {
+		for _, m := range s {
+			if m.ClientID == clientID {
+				subs = append(subs, m)
+			}
+		}
+	}
+
+	return subs, nil
+}
+
 func (srm *subRepoMock) HasClientID(_ context.Context, clientID string) error {
 	return nil
 }
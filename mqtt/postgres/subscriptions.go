@@ -96,6 +96,30 @@ func (mr *mqttRepository) HasClientID(ctx context.Context, clientID string) erro
 	return nil
 }
 
+func (mr *mqttRepository) RetrieveByClientID(ctx context.Context, clientID string) ([]mqtt.Subscription, error) {
+	q := `SELECT subtopic, group_id, client_id, thing_id, status, created_at FROM subscriptions WHERE client_id = :client_id ORDER BY created_at;`
+	params := map[string]interface{}{
+		"client_id": clientID,
+	}
+
+	rows, err := mr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []mqtt.Subscription
+	for rows.Next() {
+		item := dbSubscription{}
+		if err := rows.StructScan(&item); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		items = append(items, fromDBSub(item))
+	}
+
+	return items, nil
+}
+
 func (mr *mqttRepository) RetrieveByGroupID(ctx context.Context, pm mqtt.PageMetadata, groupID string) (mqtt.Page, error) {
 	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
 
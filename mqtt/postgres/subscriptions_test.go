@@ -244,3 +244,57 @@ func TestRetrieveByGroupID(t *testing.T) {
 		assert.Equal(t, tc.size, size, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.size, size))
 	}
 }
+
+func TestRetrieveByClientID(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	repo := postgres.NewRepository(dbMiddleware)
+
+	grID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	thID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	clientID := fmt.Sprintf("client-%s", thID)
+	var subs []mqtt.Subscription
+	for i := 0; i < 3; i++ {
+		sub := mqtt.Subscription{
+			Subtopic:  fmt.Sprintf("%s_%d", subtopic, i),
+			ThingID:   thID,
+			GroupID:   grID,
+			ClientID:  clientID,
+			CreatedAt: float64(i),
+		}
+
+		err = repo.Save(context.Background(), sub)
+		require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+		subs = append(subs, sub)
+	}
+
+	cases := []struct {
+		desc     string
+		clientID string
+		subs     []mqtt.Subscription
+		err      error
+	}{
+		{
+			desc:     "retrieve subscriptions of existing client",
+			clientID: clientID,
+			subs:     subs,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve subscriptions of non-existing client",
+			clientID: invalidID,
+			subs:     nil,
+			err:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		subs, err := repo.RetrieveByClientID(context.Background(), tc.clientID)
+		assert.Equal(t, tc.subs, subs, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.subs, subs))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
@@ -0,0 +1,624 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mproxy/pkg/session"
+	mptls "github.com/MainfluxLabs/mproxy/pkg/tls"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/gogo/protobuf/proto"
+)
+
+// subackFailure is the SUBACK return code of the rejected topic filter.
+const subackFailure = 0x80
+
+// forwardedTopics are the topics the forwarder publishes the messages of the
+// other protocols to, as the encoded Mainflux messages.
+var forwardedTopics = []string{
+	messaging.SenMLFormat + "/" + messages,
+	messaging.JSONFormat + "/" + messages,
+}
+
+var (
+	errClient = errors.New("failed proxying from MQTT client to MQTT broker")
+	errBroker = errors.New("failed proxying from MQTT broker to MQTT client")
+
+	errPacketTooLarge = errors.New("packet exceeds the maximum packet size")
+	errReceiveMaximum = errors.New("inflight messages exceed the receive maximum")
+)
+
+// clientAddrSetter is implemented by the handlers which are provided with the
+// IP addresses the clients connect from.
+type clientAddrSetter interface {
+	setClientAddr(c *session.Client, addr string)
+}
+
+// connCloser is implemented by the handlers which close the client
+// connections, e.g. once their sessions are taken over.
+type connCloser interface {
+	setConnCloser(c *session.Client, closer func())
+}
+
+// payloadEncoder is implemented by the handlers which encode the payloads
+// sent to the clients, e.g. compress them.
+type payloadEncoder interface {
+	encodePayload(c *session.Client, payload []byte) ([]byte, error)
+}
+
+// sessionLimiter is implemented by the handlers which provide the MQTT
+// session parameters of the connected clients.
+type sessionLimiter interface {
+	sessionLimits(c *session.Client) *protomfx.MQTTConfig
+}
+
+// willHandler is implemented by the handlers which publish the Last Will and
+// Testament of the clients disconnected abnormally.
+type willHandler interface {
+	setWill(c *session.Client, topic *string, payload *[]byte) error
+	clearWill(c *session.Client)
+}
+
+// Proxy is the MQTT proxy between the clients and the MQTT broker. Unlike the
+// mProxy, which closes the connection of the rejected client without a
+// response, it responds to the rejected CONNECT with the CONNACK return code
+// and to the rejected SUBSCRIBE with the SUBACK failure, so that the clients
+// can tell the bad credentials from the missing permissions.
+type Proxy struct {
+	address string
+	target  string
+	handler session.Handler
+	logger  logger.Logger
+	dialer  net.Dialer
+}
+
+// NewProxy returns a new MQTT proxy instance.
+func NewProxy(address, target string, handler session.Handler, logger logger.Logger) *Proxy {
+	return &Proxy{
+		address: address,
+		target:  target,
+		handler: handler,
+		logger:  logger,
+	}
+}
+
+// Listen accepts the client connections on the proxy address. It blocks
+// until the listener fails.
+func (p Proxy) Listen() error {
+	l, err := net.Listen("tcp", p.address)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	return p.Serve(l)
+}
+
+// Serve accepts the client connections on the provided listener. It blocks
+// until the listener fails or is closed.
+func (p Proxy) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go p.handle(conn)
+	}
+}
+
+func (p Proxy) handle(inbound net.Conn) {
+	// The client connection can be closed by the handler as well, so it's
+	// closed only once.
+	var once sync.Once
+	closeInbound := func() { once.Do(func() { p.close(inbound) }) }
+	defer closeInbound()
+	outbound, err := p.dialer.Dial("tcp", p.target)
+	if err != nil {
+		p.logger.Error("Cannot connect to remote broker " + p.target + " due to: " + err.Error())
+		return
+	}
+	defer p.close(outbound)
+
+	cert, err := mptls.ClientCert(inbound)
+	if err != nil {
+		p.logger.Error("Failed to get client certificate: " + err.Error())
+		return
+	}
+
+	s := newStream(inbound, outbound, p.handler, cert)
+	if h, ok := p.handler.(clientAddrSetter); ok {
+		h.setClientAddr(&s.client, auth.HostAddr(inbound.RemoteAddr().String()))
+	}
+	if h, ok := p.handler.(connCloser); ok {
+		h.setConnCloser(&s.client, closeInbound)
+	}
+	if err := s.run(); !errors.Contains(err, io.EOF) {
+		p.logger.Warn("Broken connection for client: " + s.client.ID + " with error: " + err.Error())
+	}
+}
+
+func (p Proxy) close(conn net.Conn) {
+	if err := conn.Close(); err != nil {
+		p.logger.Warn(fmt.Sprintf("Error closing connection %s", err.Error()))
+	}
+}
+
+// stream proxies the packets of a single client connection.
+type stream struct {
+	inbound  net.Conn
+	outbound net.Conn
+	handler  session.Handler
+	client   session.Client
+	// mu serializes the writes to the client, since the responses to the
+	// rejected packets are written next to the packets sent by the broker.
+	mu sync.Mutex
+	// brokerMu serializes the writes to the broker, since the expired
+	// messages are acknowledged next to the packets sent by the client.
+	brokerMu sync.Mutex
+	// limitsMu guards the session parameters of the client, set at
+	// CONNECT, and the IDs of the QoS 1 and 2 messages awaiting the
+	// acknowledgement in both directions.
+	limitsMu  sync.Mutex
+	limits    *protomfx.MQTTConfig
+	received  map[uint16]struct{}
+	delivered map[uint16]struct{}
+	// slots holds a slot per message delivered to the client, so that the
+	// delivery is held once the max inflight messages are unacknowledged.
+	slots chan struct{}
+	done  chan struct{}
+}
+
+func newStream(inbound, outbound net.Conn, handler session.Handler, cert x509.Certificate) *stream {
+	return &stream{
+		inbound:   inbound,
+		outbound:  outbound,
+		handler:   handler,
+		client:    session.Client{Cert: cert},
+		received:  make(map[uint16]struct{}),
+		delivered: make(map[uint16]struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (s *stream) run() error {
+	// The errors channel is buffered, so that the routine which
+	// fails second isn't blocked.
+	errs := make(chan error, 2)
+
+	go s.up(errs)
+	go s.down(errs)
+
+	err := <-errs
+	close(s.done)
+	s.handler.Disconnect(&s.client)
+
+	return err
+}
+
+func (s *stream) up(errs chan<- error) {
+	for {
+		// The client is disconnected once silent for one and a half
+		// times the keep alive of its profile.
+		if ka := s.sessionLimits().GetKeepAlive(); ka > 0 {
+			if err := s.inbound.SetReadDeadline(time.Now().Add(time.Duration(ka) * time.Second * 3 / 2)); err != nil {
+				errs <- errors.Wrap(errClient, err)
+				return
+			}
+		}
+
+		pkt, err := packets.ReadPacket(s.inbound)
+		if err != nil {
+			errs <- errors.Wrap(errClient, err)
+			return
+		}
+
+		oversized, err := s.oversized(pkt)
+		if err != nil {
+			errs <- errors.Wrap(errClient, err)
+			return
+		}
+		if oversized {
+			errs <- errors.Wrap(errClient, errPacketTooLarge)
+			return
+		}
+
+		forward, err := s.authorize(pkt)
+		if err != nil {
+			errs <- errors.Wrap(errClient, err)
+			return
+		}
+		if !forward {
+			continue
+		}
+
+		if err := s.track(pkt); err != nil {
+			errs <- errors.Wrap(errClient, err)
+			return
+		}
+
+		if err := s.writeBroker(pkt); err != nil {
+			errs <- errors.Wrap(errClient, err)
+			return
+		}
+
+		s.notify(pkt)
+	}
+}
+
+func (s *stream) down(errs chan<- error) {
+	for {
+		pkt, err := packets.ReadPacket(s.outbound)
+		if err != nil {
+			errs <- errors.Wrap(errBroker, err)
+			return
+		}
+
+		s.acknowledged(pkt)
+
+		// The expired messages, queued by the broker while the client
+		// was offline, aren't delivered to the client.
+		if p, ok := pkt.(*packets.PublishPacket); ok && expired(p, time.Now()) {
+			if err := s.discard(p); err != nil {
+				errs <- errors.Wrap(errBroker, err)
+				return
+			}
+			continue
+		}
+
+		if p, ok := pkt.(*packets.PublishPacket); ok {
+			if err := s.encode(p); err != nil {
+				errs <- errors.Wrap(errBroker, err)
+				return
+			}
+
+			// The messages exceeding the max packet size of the
+			// client aren't delivered to it.
+			oversized, err := s.oversized(p)
+			if err != nil {
+				errs <- errors.Wrap(errBroker, err)
+				return
+			}
+			if oversized {
+				if err := s.discard(p); err != nil {
+					errs <- errors.Wrap(errBroker, err)
+					return
+				}
+				continue
+			}
+
+			if !s.deliver(p) {
+				errs <- errors.Wrap(errBroker, io.EOF)
+				return
+			}
+		}
+
+		if err := s.write(pkt); err != nil {
+			errs <- errors.Wrap(errBroker, err)
+			return
+		}
+	}
+}
+
+// encode encodes the payload of the message sent to the client, if the
+// handler encodes the payloads.
+func (s *stream) encode(p *packets.PublishPacket) error {
+	e, ok := s.handler.(payloadEncoder)
+	if !ok {
+		return nil
+	}
+
+	payload, err := e.encodePayload(&s.client, p.Payload)
+	if err != nil {
+		return err
+	}
+	p.Payload = payload
+
+	return nil
+}
+
+// authorize authorizes the client packet. It returns false if the packet was
+// rejected and answered, but the connection can be kept open.
+func (s *stream) authorize(pkt packets.ControlPacket) (bool, error) {
+	switch p := pkt.(type) {
+	case *packets.ConnectPacket:
+		s.client.ID = p.ClientIdentifier
+		s.client.Username = p.Username
+		s.client.Password = p.Password
+		err := s.handler.AuthConnect(&s.client)
+		if w, ok := s.handler.(willHandler); ok && err == nil && p.WillFlag {
+			err = w.setWill(&s.client, &p.WillTopic, &p.WillMessage)
+		}
+		if err != nil {
+			ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+			ack.ReturnCode = connackCode(err)
+			if werr := s.write(ack); werr != nil {
+				return false, werr
+			}
+			return false, err
+		}
+		// The client values may be changed by the handler.
+		p.ClientIdentifier = s.client.ID
+		p.Username = s.client.Username
+		p.Password = s.client.Password
+		if l, ok := s.handler.(sessionLimiter); ok {
+			s.setSessionLimits(l.sessionLimits(&s.client))
+		}
+		// The broker expects the keep alive of the client profile,
+		// which replaces the one sent by the client.
+		if ka := s.sessionLimits().GetKeepAlive(); ka > 0 {
+			p.Keepalive = uint16(ka)
+		}
+		return true, nil
+	case *packets.PublishPacket:
+		// MQTT 3.1.1 has no negative PUBACK, so the rejected
+		// publish can only be answered by closing the connection.
+		if err := s.handler.AuthPublish(&s.client, &p.TopicName, &p.Payload); err != nil {
+			return false, err
+		}
+		return true, nil
+	case *packets.SubscribePacket:
+		err := s.handler.AuthSubscribe(&s.client, &p.Topics)
+		switch {
+		case err == nil:
+			return true, nil
+		case errors.Contains(err, ErrSessionTakenOver):
+			return false, err
+		}
+
+		ack := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+		ack.MessageID = p.MessageID
+		for range p.Topics {
+			ack.ReturnCodes = append(ack.ReturnCodes, subackFailure)
+		}
+		if err := s.write(ack); err != nil {
+			return false, err
+		}
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+func (s *stream) notify(pkt packets.ControlPacket) {
+	switch p := pkt.(type) {
+	case *packets.ConnectPacket:
+		s.handler.Connect(&s.client)
+	case *packets.PublishPacket:
+		s.handler.Publish(&s.client, &p.TopicName, &p.Payload)
+	case *packets.SubscribePacket:
+		s.handler.Subscribe(&s.client, &p.Topics)
+	case *packets.UnsubscribePacket:
+		s.handler.Unsubscribe(&s.client, &p.Topics)
+	case *packets.DisconnectPacket:
+		// The will isn't published once the client disconnects gracefully.
+		if w, ok := s.handler.(willHandler); ok {
+			w.clearWill(&s.client)
+		}
+	}
+}
+
+func (s *stream) write(pkt packets.ControlPacket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return pkt.Write(s.inbound)
+}
+
+func (s *stream) writeBroker(pkt packets.ControlPacket) error {
+	s.brokerMu.Lock()
+	defer s.brokerMu.Unlock()
+
+	return pkt.Write(s.outbound)
+}
+
+func (s *stream) setSessionLimits(limits *protomfx.MQTTConfig) {
+	s.limitsMu.Lock()
+	defer s.limitsMu.Unlock()
+
+	s.limits = limits
+	if n := limits.GetMaxInflight(); n > 0 {
+		s.slots = make(chan struct{}, n)
+	}
+}
+
+func (s *stream) sessionLimits() *protomfx.MQTTConfig {
+	s.limitsMu.Lock()
+	defer s.limitsMu.Unlock()
+
+	return s.limits
+}
+
+// oversized reports whether the packet exceeds the max packet size of the
+// client session.
+func (s *stream) oversized(pkt packets.ControlPacket) (bool, error) {
+	max := s.sessionLimits().GetMaxPacketSize()
+	if max == 0 {
+		return false, nil
+	}
+
+	var size byteCounter
+	if err := pkt.Write(&size); err != nil {
+		return false, err
+	}
+
+	return uint64(size) > uint64(max), nil
+}
+
+// track tracks the QoS 1 and 2 messages published by the client until the
+// broker acknowledges them, failing once they exceed the receive maximum of
+// the client session, and releases the messages delivered to the client
+// once the client acknowledges them.
+func (s *stream) track(pkt packets.ControlPacket) error {
+	switch p := pkt.(type) {
+	case *packets.PublishPacket:
+		s.limitsMu.Lock()
+		defer s.limitsMu.Unlock()
+
+		max := s.limits.GetReceiveMaximum()
+		if p.Qos == 0 || max == 0 {
+			return nil
+		}
+		// The redelivered message is already tracked.
+		if _, ok := s.received[p.MessageID]; ok {
+			return nil
+		}
+		if uint32(len(s.received)) >= max {
+			return errReceiveMaximum
+		}
+		s.received[p.MessageID] = struct{}{}
+	case *packets.PubackPacket:
+		s.release(p.MessageID)
+	case *packets.PubcompPacket:
+		s.release(p.MessageID)
+	}
+
+	return nil
+}
+
+// acknowledged stops tracking the message published by the client once the
+// broker acknowledges it.
+func (s *stream) acknowledged(pkt packets.ControlPacket) {
+	var id uint16
+	switch p := pkt.(type) {
+	case *packets.PubackPacket:
+		id = p.MessageID
+	case *packets.PubcompPacket:
+		id = p.MessageID
+	default:
+		return
+	}
+
+	s.limitsMu.Lock()
+	delete(s.received, id)
+	s.limitsMu.Unlock()
+}
+
+// deliver takes the inflight slot of the QoS 1 or 2 message delivered to the
+// client. It blocks while the max inflight messages of the client session
+// are unacknowledged, and returns false once the stream is closed.
+func (s *stream) deliver(p *packets.PublishPacket) bool {
+	if p.Qos == 0 {
+		return true
+	}
+
+	s.limitsMu.Lock()
+	slots := s.slots
+	_, redelivered := s.delivered[p.MessageID]
+	s.limitsMu.Unlock()
+
+	if slots == nil || redelivered {
+		return true
+	}
+
+	select {
+	case slots <- struct{}{}:
+	case <-s.done:
+		return false
+	}
+
+	s.limitsMu.Lock()
+	s.delivered[p.MessageID] = struct{}{}
+	s.limitsMu.Unlock()
+
+	return true
+}
+
+// release frees the inflight slot of the message acknowledged by the client.
+func (s *stream) release(id uint16) {
+	s.limitsMu.Lock()
+	_, ok := s.delivered[id]
+	delete(s.delivered, id)
+	slots := s.slots
+	s.limitsMu.Unlock()
+
+	if ok {
+		<-slots
+	}
+}
+
+// byteCounter counts the bytes written to it.
+type byteCounter uint64
+
+func (c *byteCounter) Write(b []byte) (int, error) {
+	*c += byteCounter(len(b))
+	return len(b), nil
+}
+
+// discard acknowledges the discarded message to the broker on behalf of the
+// client, so that the broker removes it from the client session. The broker
+// releases the QoS 2 message by sending PUBREL, which the client completes
+// even though it never received the message.
+func (s *stream) discard(p *packets.PublishPacket) error {
+	switch p.Qos {
+	case 1:
+		ack := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+		ack.MessageID = p.MessageID
+		return s.writeBroker(ack)
+	case 2:
+		rec := packets.NewControlPacket(packets.Pubrec).(*packets.PubrecPacket)
+		rec.MessageID = p.MessageID
+		return s.writeBroker(rec)
+	default:
+		return nil
+	}
+}
+
+// expired reports whether the message forwarded to the broker expired by the
+// given time. The payloads of the other topics are sent by the clients as is.
+func expired(p *packets.PublishPacket, now time.Time) bool {
+	forwarded := false
+	for _, t := range forwardedTopics {
+		if p.TopicName == t || strings.HasPrefix(p.TopicName, t+"/") {
+			forwarded = true
+			break
+		}
+	}
+	if !forwarded {
+		return false
+	}
+
+	var msg protomfx.Message
+	if err := proto.Unmarshal(p.Payload, &msg); err != nil {
+		return false
+	}
+
+	return messaging.Expired(msg, now)
+}
+
+// connackCode returns the CONNACK return code of the CONNECT rejected with
+// the provided error. MQTT 3.1.1 has no dedicated code for the exceeded rate
+// limit, so it's reported as the unavailable server, like the other errors
+// the client can retry on. Nor has it one for the malformed will topic, which
+// is reported as not authorized.
+func connackCode(err error) byte {
+	switch {
+	case errors.Contains(err, ErrMissingClientID):
+		return packets.ErrRefusedIDRejected
+	case errors.Contains(err, auth.ErrNetworkDenied),
+		errors.Contains(err, ErrMalformedTopic):
+		return packets.ErrRefusedNotAuthorised
+	}
+
+	switch failureReason(err) {
+	case ReasonInvalidKey:
+		return packets.ErrRefusedBadUsernameOrPassword
+	case ReasonNotAuthorized:
+		return packets.ErrRefusedNotAuthorised
+	default:
+		return packets.ErrRefusedServerUnavailable
+	}
+}
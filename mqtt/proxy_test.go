@@ -0,0 +1,658 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/mqtt"
+	"github.com/MainfluxLabs/mainflux/mqtt/mocks"
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	restrictedID  = "0a6a3c1d-8f3e-4b2a-9c7d-1e5f4a3b2c10"
+	restrictedKey = "restricted"
+	limitedID     = "5f3c2a1e-7b9d-4c8e-a6f2-3d1b9e7c5a40"
+	limitedKey    = "limited"
+)
+
+func TestProxyConnect(t *testing.T) {
+	proxy := newProxy(t)
+
+	cases := []struct {
+		desc     string
+		clientID string
+		username string
+		password string
+		code     byte
+	}{
+		{
+			desc:     "connect with valid credentials",
+			clientID: clientID,
+			username: thingID,
+			password: password,
+			code:     packets.Accepted,
+		},
+		{
+			desc:     "connect without client ID",
+			clientID: "",
+			username: thingID,
+			password: password,
+			code:     packets.ErrRefusedIDRejected,
+		},
+		{
+			desc:     "connect with invalid password",
+			clientID: clientID,
+			username: thingID,
+			password: invalidID,
+			code:     packets.ErrRefusedBadUsernameOrPassword,
+		},
+		{
+			desc:     "connect with password of another thing",
+			clientID: clientID,
+			username: invalidID,
+			password: password,
+			code:     packets.ErrRefusedBadUsernameOrPassword,
+		},
+		{
+			desc:     "connect from network which isn't allowed",
+			clientID: clientID,
+			username: restrictedID,
+			password: restrictedKey,
+			code:     packets.ErrRefusedNotAuthorised,
+		},
+	}
+
+	for _, tc := range cases {
+		conn, err := net.Dial("tcp", proxy)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		ack := connect(t, conn, tc.clientID, tc.username, tc.password)
+		assert.Equal(t, tc.code, ack.ReturnCode, fmt.Sprintf("%s: expected return code %d got %d", tc.desc, tc.code, ack.ReturnCode))
+		conn.Close()
+	}
+}
+
+func TestProxySubscribe(t *testing.T) {
+	proxy := newProxy(t)
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, thingID, password)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	cases := []struct {
+		desc   string
+		topics []string
+		codes  []byte
+	}{
+		{
+			desc:   "subscribe with malformed topic filter",
+			topics: []string{topic, "#"},
+			codes:  []byte{0x80, 0x80},
+		},
+		{
+			desc:   "subscribe with valid topics after rejected subscribe",
+			topics: wildcardTopics,
+			codes:  []byte{0, 0},
+		},
+	}
+
+	for i, tc := range cases {
+		sub := packets.NewControlPacket(packets.Subscribe).(*packets.SubscribePacket)
+		sub.MessageID = uint16(i + 1)
+		sub.Topics = tc.topics
+		sub.Qoss = make([]byte, len(tc.topics))
+		err := sub.Write(conn)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		pkt, err := packets.ReadPacket(conn)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		ack, ok := pkt.(*packets.SubackPacket)
+		require.True(t, ok, fmt.Sprintf("%s: expected SUBACK got %s", tc.desc, pkt))
+		assert.Equal(t, sub.MessageID, ack.MessageID, fmt.Sprintf("%s: expected message ID %d got %d", tc.desc, sub.MessageID, ack.MessageID))
+		assert.Equal(t, tc.codes, ack.ReturnCodes, fmt.Sprintf("%s: expected return codes %v got %v", tc.desc, tc.codes, ack.ReturnCodes))
+	}
+}
+
+func TestProxyExpiredMessages(t *testing.T) {
+	now := time.Now()
+	expired := protomfx.Message{Payload: []byte("open valve"), Created: now.Add(-2 * time.Hour).UnixNano(), Expires: now.Add(-time.Hour).UnixNano()}
+	valid := protomfx.Message{Payload: []byte("close valve"), Created: now.UnixNano(), Expires: now.Add(time.Hour).UnixNano()}
+	persistent := protomfx.Message{Payload: []byte("report"), Created: now.Add(-2 * time.Hour).UnixNano()}
+
+	var pubs []*packets.PublishPacket
+	for i, msg := range []protomfx.Message{expired, valid, persistent} {
+		payload, err := proto.Marshal(&msg)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+		pub := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+		pub.TopicName = "senml/messages/valve"
+		pub.Qos = 1
+		pub.MessageID = uint16(i + 1)
+		pub.Payload = payload
+		pubs = append(pubs, pub)
+	}
+
+	// The broker delivers the queued messages right after the client
+	// connects, and reports the IDs of the acknowledged messages.
+	acks := make(chan uint16, len(pubs))
+	proxy := newProxyWithBroker(t, func(l net.Listener) {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			pkt, err := packets.ReadPacket(conn)
+			if err != nil {
+				return
+			}
+
+			switch p := pkt.(type) {
+			case *packets.ConnectPacket:
+				ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+				ack.ReturnCode = packets.Accepted
+				ack.Write(conn)
+				for _, pub := range pubs {
+					pub.Write(conn)
+				}
+			case *packets.PubackPacket:
+				acks <- p.MessageID
+			}
+		}
+	})
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, thingID, password)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	var received []uint16
+	for range pubs[1:] {
+		pkt, err := packets.ReadPacket(conn)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		pub, ok := pkt.(*packets.PublishPacket)
+		require.True(t, ok, fmt.Sprintf("expected PUBLISH got %s", pkt))
+		received = append(received, pub.MessageID)
+
+		ack := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+		ack.MessageID = pub.MessageID
+		err = ack.Write(conn)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+	assert.Equal(t, []uint16{2, 3}, received, fmt.Sprintf("expected messages %v got %v", []uint16{2, 3}, received))
+
+	var acked []uint16
+	for range pubs {
+		select {
+		case id := <-acks:
+			acked = append(acked, id)
+		case <-time.After(time.Second):
+			t.Fatal("expected all of the messages to be acknowledged")
+		}
+	}
+	assert.ElementsMatch(t, []uint16{1, 2, 3}, acked, fmt.Sprintf("expected acknowledged messages %v got %v", []uint16{1, 2, 3}, acked))
+}
+
+func TestProxyCompressedMessages(t *testing.T) {
+	msg := protomfx.Message{Payload: []byte("close valve"), Created: time.Now().UnixNano()}
+	data, err := proto.Marshal(&msg)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	proxy := newProxyWithBroker(t, func(l net.Listener) {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			pkt, err := packets.ReadPacket(conn)
+			if err != nil {
+				return
+			}
+
+			if _, ok := pkt.(*packets.ConnectPacket); ok {
+				ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+				ack.ReturnCode = packets.Accepted
+				ack.Write(conn)
+
+				pub := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+				pub.TopicName = "senml/messages/valve"
+				pub.Payload = data
+				pub.Write(conn)
+			}
+		}
+	})
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, zipID, zipKey)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	pkt, err := packets.ReadPacket(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub, ok := pkt.(*packets.PublishPacket)
+	require.True(t, ok, fmt.Sprintf("expected PUBLISH got %s", pkt))
+
+	res, err := messaging.Decompress(messaging.GzipEncoding, pub.Payload)
+	assert.Nil(t, err, fmt.Sprintf("expected gzip compressed payload got %s", err))
+	assert.Equal(t, data, res, fmt.Sprintf("expected payload %v got %v", data, res))
+}
+
+func TestProxyKeepAlive(t *testing.T) {
+	keepAlives := make(chan uint16, 1)
+	proxy := newProxyWithBroker(t, func(l net.Listener) {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			pkt, err := packets.ReadPacket(conn)
+			if err != nil {
+				return
+			}
+
+			if p, ok := pkt.(*packets.ConnectPacket); ok {
+				keepAlives <- p.Keepalive
+				ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+				ack.ReturnCode = packets.Accepted
+				ack.Write(conn)
+			}
+		}
+	})
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, limitedID, limitedKey)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	ka := <-keepAlives
+	assert.Equal(t, uint16(1), ka, fmt.Sprintf("expected broker keep alive %d got %d", 1, ka))
+
+	// The silent client is disconnected after one and a half times the
+	// keep alive of its profile.
+	err = conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = packets.ReadPacket(conn)
+	assert.Equal(t, io.EOF, err, fmt.Sprintf("expected silent client to be disconnected got %s", err))
+}
+
+func TestProxyMaxInflight(t *testing.T) {
+	proxy := newProxyWithBroker(t, func(l net.Listener) {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			pkt, err := packets.ReadPacket(conn)
+			if err != nil {
+				return
+			}
+
+			if _, ok := pkt.(*packets.ConnectPacket); ok {
+				ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+				ack.ReturnCode = packets.Accepted
+				ack.Write(conn)
+
+				for i := 1; i <= 2; i++ {
+					pub := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+					pub.TopicName = "senml/messages/valve"
+					pub.Qos = 1
+					pub.MessageID = uint16(i)
+					pub.Payload = payload
+					pub.Write(conn)
+				}
+			}
+		}
+	})
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, limitedID, limitedKey)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	pkt, err := packets.ReadPacket(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub, ok := pkt.(*packets.PublishPacket)
+	require.True(t, ok, fmt.Sprintf("expected PUBLISH got %s", pkt))
+	assert.Equal(t, uint16(1), pub.MessageID, fmt.Sprintf("expected message %d got %d", 1, pub.MessageID))
+
+	// The second message is held until the first one is acknowledged.
+	err = conn.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = packets.ReadPacket(conn)
+	require.NotNil(t, err, "expected message to be held while the max inflight messages are unacknowledged")
+
+	puback := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+	puback.MessageID = pub.MessageID
+	err = puback.Write(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = conn.SetReadDeadline(time.Now().Add(time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pkt, err = packets.ReadPacket(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub, ok = pkt.(*packets.PublishPacket)
+	require.True(t, ok, fmt.Sprintf("expected PUBLISH got %s", pkt))
+	assert.Equal(t, uint16(2), pub.MessageID, fmt.Sprintf("expected message %d got %d", 2, pub.MessageID))
+}
+
+func TestProxyReceiveMaximum(t *testing.T) {
+	proxy := newProxy(t)
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, limitedID, limitedKey)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	// The broker doesn't acknowledge the messages, so the second one
+	// exceeds the receive maximum of the client.
+	for i := 1; i <= 2; i++ {
+		pub := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+		pub.TopicName = topic
+		pub.Qos = 1
+		pub.MessageID = uint16(i)
+		pub.Payload = payload
+		err := pub.Write(conn)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	err = conn.SetReadDeadline(time.Now().Add(time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = packets.ReadPacket(conn)
+	assert.Equal(t, io.EOF, err, fmt.Sprintf("expected client exceeding receive maximum to be disconnected got %s", err))
+}
+
+func TestProxyMaxPacketSize(t *testing.T) {
+	large := make([]byte, 512)
+	acks := make(chan uint16, 1)
+	proxy := newProxyWithBroker(t, func(l net.Listener) {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			pkt, err := packets.ReadPacket(conn)
+			if err != nil {
+				return
+			}
+
+			switch p := pkt.(type) {
+			case *packets.ConnectPacket:
+				ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+				ack.ReturnCode = packets.Accepted
+				ack.Write(conn)
+
+				for i, data := range [][]byte{large, payload} {
+					pub := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+					pub.TopicName = "senml/messages/valve"
+					pub.Qos = 1
+					pub.MessageID = uint16(i + 1)
+					pub.Payload = data
+					pub.Write(conn)
+				}
+			case *packets.PubackPacket:
+				acks <- p.MessageID
+			}
+		}
+	})
+
+	conn, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	ack := connect(t, conn, clientID, limitedID, limitedKey)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect with valid credentials expected to succeed")
+
+	// The message exceeding the max packet size of the client is
+	// acknowledged on its behalf instead of being delivered.
+	pkt, err := packets.ReadPacket(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub, ok := pkt.(*packets.PublishPacket)
+	require.True(t, ok, fmt.Sprintf("expected PUBLISH got %s", pkt))
+	assert.Equal(t, uint16(2), pub.MessageID, fmt.Sprintf("expected message %d got %d", 2, pub.MessageID))
+
+	select {
+	case id := <-acks:
+		assert.Equal(t, uint16(1), id, fmt.Sprintf("expected acknowledged message %d got %d", 1, id))
+	case <-time.After(time.Second):
+		t.Fatal("expected oversized message to be acknowledged")
+	}
+
+	pub = packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+	pub.TopicName = topic
+	pub.Payload = large
+	err = pub.Write(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = conn.SetReadDeadline(time.Now().Add(time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = packets.ReadPacket(conn)
+	assert.Equal(t, io.EOF, err, fmt.Sprintf("expected client publishing oversized packet to be disconnected got %s", err))
+}
+
+func TestProxySessionTakeover(t *testing.T) {
+	proxy := newProxy(t)
+
+	prev, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer prev.Close()
+
+	ack := connect(t, prev, clientID, thingID, password)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect previous session expected to succeed")
+
+	next, err := net.Dial("tcp", proxy)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer next.Close()
+
+	ack = connect(t, next, clientID, thingID, password)
+	require.Equal(t, byte(packets.Accepted), ack.ReturnCode, "connect next session expected to succeed")
+
+	// The connection of the session which was taken over is closed
+	// without waiting for its next publish or subscribe.
+	err = prev.SetReadDeadline(time.Now().Add(time.Second))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = packets.ReadPacket(prev)
+	assert.Equal(t, io.EOF, err, fmt.Sprintf("expected taken over session to be closed got %s", err))
+}
+
+func TestProxyWill(t *testing.T) {
+	cases := []struct {
+		desc       string
+		willTopic  string
+		disconnect bool
+		code       byte
+		events     []string
+	}{
+		{
+			desc:      "drop connection of client with will",
+			willTopic: topic + "/" + subtopic,
+			code:      packets.Accepted,
+			events:    []string{"disconnect", "will", "connect"},
+		},
+		{
+			desc:       "disconnect client with will",
+			willTopic:  topic + "/" + subtopic,
+			disconnect: true,
+			code:       packets.Accepted,
+			events:     []string{"disconnect", "connect"},
+		},
+		{
+			desc:   "drop connection of client without will",
+			code:   packets.Accepted,
+			events: []string{"disconnect", "connect"},
+		},
+		{
+			desc:      "connect with malformed will topic",
+			willTopic: invalidTopic,
+			code:      packets.ErrRefusedNotAuthorised,
+			events:    []string{"disconnect", "connect_fail", "connect"},
+		},
+	}
+
+	for _, tc := range cases {
+		es := mocks.NewEventStore()
+		proxy := newProxyWithEvents(t, serveBroker, es)
+
+		conn, err := net.Dial("tcp", proxy)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		ack := connectWithWill(t, conn, clientID, thingID, password, tc.willTopic)
+		assert.Equal(t, tc.code, ack.ReturnCode, fmt.Sprintf("%s: expected return code %d got %d", tc.desc, tc.code, ack.ReturnCode))
+
+		if tc.disconnect {
+			err := packets.NewControlPacket(packets.Disconnect).Write(conn)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		}
+		conn.Close()
+
+		// The disconnect event is the last one issued for the connection.
+		var types []string
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			events, err := es.RetrieveByThing(context.Background(), thingID, 10)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+			types = []string{}
+			for _, e := range events {
+				types = append(types, e.Type)
+			}
+			if len(types) > 0 && types[0] == "disconnect" {
+				break
+			}
+		}
+		assert.Equal(t, tc.events, types, fmt.Sprintf("%s: expected events %v got %v", tc.desc, tc.events, types))
+	}
+}
+
+func connect(t *testing.T, conn net.Conn, clientID, username, password string) *packets.ConnackPacket {
+	return connectWithWill(t, conn, clientID, username, password, "")
+}
+
+// connectWithWill connects the client setting the will on the provided topic,
+// unless it's empty.
+func connectWithWill(t *testing.T, conn net.Conn, clientID, username, password, willTopic string) *packets.ConnackPacket {
+	pkt := packets.NewControlPacket(packets.Connect).(*packets.ConnectPacket)
+	pkt.ProtocolName = "MQTT"
+	pkt.ProtocolVersion = 4
+	pkt.ClientIdentifier = clientID
+	pkt.UsernameFlag = true
+	pkt.Username = username
+	pkt.PasswordFlag = true
+	pkt.Password = []byte(password)
+	if willTopic != "" {
+		pkt.WillFlag = true
+		pkt.WillTopic = willTopic
+		pkt.WillMessage = payload
+	}
+	err := pkt.Write(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	res, err := packets.ReadPacket(conn)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	ack, ok := res.(*packets.ConnackPacket)
+	require.True(t, ok, fmt.Sprintf("expected CONNACK got %s", res))
+
+	return ack
+}
+
+// newProxy starts the proxy in front of the broker which accepts all the
+// forwarded packets, and returns the proxy address.
+func newProxy(t *testing.T) string {
+	return newProxyWithBroker(t, serveBroker)
+}
+
+// newProxyWithBroker starts the proxy in front of the broker served by the
+// provided function, and returns the proxy address.
+func newProxyWithBroker(t *testing.T, serve func(l net.Listener)) string {
+	return newProxyWithEvents(t, serve, mocks.NewEventStore())
+}
+
+// newProxyWithEvents starts the proxy in front of the broker served by the
+// provided function, whose handler issues the events to the provided event
+// store, and returns the proxy address.
+func newProxyWithEvents(t *testing.T, serve func(l net.Listener), es redis.EventStore) string {
+	broker, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	t.Cleanup(func() { broker.Close() })
+	go serve(broker)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	t.Cleanup(func() { l.Close() })
+
+	// The handler doesn't log to the shared buffer, since the connections
+	// of the proxy are handled concurrently.
+	thingsClient := thmocks.NewThingsServiceClient(nil, map[string]string{password: thingID, thingID: groupID, restrictedKey: restrictedID, zipKey: zipID, limitedKey: limitedID}, nil)
+	handler := mqtt.NewHandler([]messaging.Publisher{thmocks.NewPublisher()}, es, mocks.NewSessionRegistry(), logger.NewMock(), thingsClient, newService(), mqtt.NewTopicTranslator(false, nil))
+	proxy := mqtt.NewProxy(l.Addr().String(), broker.Addr().String(), handler, logger.NewMock())
+	go proxy.Serve(l)
+
+	return l.Addr().String()
+}
+
+func serveBroker(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+
+		go func(conn net.Conn) {
+			defer conn.Close()
+			for {
+				pkt, err := packets.ReadPacket(conn)
+				if err != nil {
+					return
+				}
+
+				switch p := pkt.(type) {
+				case *packets.ConnectPacket:
+					ack := packets.NewControlPacket(packets.Connack).(*packets.ConnackPacket)
+					ack.ReturnCode = packets.Accepted
+					ack.Write(conn)
+				case *packets.SubscribePacket:
+					ack := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+					ack.MessageID = p.MessageID
+					ack.ReturnCodes = make([]byte, len(p.Topics))
+					ack.Write(conn)
+				}
+			}
+		}(conn)
+	}
+}
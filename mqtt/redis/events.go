@@ -3,6 +3,18 @@
 
 package redis
 
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	connectEvent    = "connect"
+	disconnectEvent = "disconnect"
+	willEvent       = "will"
+	failSuffix      = "_fail"
+)
+
 type event interface {
 	Encode() map[string]interface{}
 }
@@ -11,18 +23,70 @@ var (
 	_ event = (*mqttEvent)(nil)
 )
 
+// Event represents the MQTT client event. The events of the rejected client
+// operations, e.g. connect_fail, contain the reason of the rejection.
+type Event struct {
+	Type     string
+	ThingID  string
+	ClientID string
+	Reason   string
+	Error    string
+	Instance string
+	Time     time.Time
+}
+
 type mqttEvent struct {
+	thingID   string
 	clientID  string
 	timestamp string
 	eventType string
+	reason    string
+	err       string
 	instance  string
 }
 
 func (me mqttEvent) Encode() map[string]interface{} {
-	return map[string]interface{}{
-		"thing_id":   me.clientID,
+	val := map[string]interface{}{
+		"thing_id":   me.thingID,
 		"timestamp":  me.timestamp,
 		"event_type": me.eventType,
 		"instance":   me.instance,
 	}
+
+	if me.clientID != "" {
+		val["client_id"] = me.clientID
+	}
+
+	if me.reason != "" {
+		val["reason"] = me.reason
+		val["error"] = me.err
+	}
+
+	return val
+}
+
+func decodeEvent(values map[string]interface{}) Event {
+	e := Event{
+		Type:     read(values, "event_type"),
+		ThingID:  read(values, "thing_id"),
+		ClientID: read(values, "client_id"),
+		Reason:   read(values, "reason"),
+		Error:    read(values, "error"),
+		Instance: read(values, "instance"),
+	}
+
+	if ts, err := strconv.ParseInt(read(values, "timestamp"), 10, 64); err == nil {
+		e.Time = time.Unix(ts, 0).UTC()
+	}
+
+	return e
+}
+
+func read(values map[string]interface{}, key string) string {
+	val, ok := values[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return val
 }
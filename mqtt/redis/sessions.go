@@ -0,0 +1,226 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	sessionPrefix   = "mqtt:session"
+	takeoverChannel = "mainflux.mqtt.takeover"
+
+	// sessionTTL is the expiry of the session keys, which are refreshed while
+	// the sessions are alive, so that the keys of the instances which stopped
+	// without unregistering their sessions are removed.
+	sessionTTL      = time.Minute
+	refreshInterval = sessionTTL / 3
+)
+
+// register sets the session key and returns the ID of the previous session.
+var register = redis.NewScript(`
+local prev = redis.call("GET", KEYS[1])
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return prev
+`)
+
+// refresh extends the expiry of the session key only if it still holds the
+// session ID.
+var refresh = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// unregister deletes the session key only if it still holds the session ID,
+// so that a disconnect does not remove the session registered by another
+// adapter instance which took the client over.
+var unregister = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// SessionRegistry keeps track of the MQTT adapter instances which own the
+// client sessions, so that multiple instances can run behind a TCP load
+// balancer. When a client connects using the ID of a session owned by
+// another instance, or another connection of the same instance, the
+// previous session is taken over and revoked.
+type SessionRegistry interface {
+	// Register records a new session of the client and returns its ID. The
+	// revoke function is called once the session is taken over.
+	Register(clientID string, revoke func()) (string, error)
+
+	// Unregister removes the client session, unless it has been taken over.
+	Unregister(clientID, sessionID string) error
+
+	// Revoked returns true if the session has been taken over.
+	Revoked(sessionID string) bool
+
+	// Listen receives takeover notifications and revokes the sessions
+	// which were taken over, and refreshes the expiry of the sessions which
+	// are alive, until the context is canceled.
+	Listen(ctx context.Context) error
+}
+
+var _ SessionRegistry = (*sessionRegistry)(nil)
+
+type session struct {
+	clientID string
+	revoke   func()
+	revoked  bool
+}
+
+type sessionRegistry struct {
+	client   *redis.Client
+	instance string
+	counter  uint64
+	mu       sync.RWMutex
+	sessions map[string]*session
+	logger   logger.Logger
+}
+
+// NewSessionRegistry returns Redis based session registry of the given
+// adapter instance. The instance name must be unique across the replicas.
+func NewSessionRegistry(client *redis.Client, instance string, logger logger.Logger) SessionRegistry {
+	return &sessionRegistry{
+		client:   client,
+		instance: instance,
+		sessions: make(map[string]*session),
+		logger:   logger,
+	}
+}
+
+func (sr *sessionRegistry) Register(clientID string, revoke func()) (string, error) {
+	ctx := context.Background()
+	sessionID := fmt.Sprintf("%s/%d", sr.instance, atomic.AddUint64(&sr.counter, 1))
+
+	// The session is recorded before it's registered, so that it can be
+	// taken over by the session registered right after it.
+	sr.mu.Lock()
+	sr.sessions[sessionID] = &session{clientID: clientID, revoke: revoke}
+	sr.mu.Unlock()
+
+	prev, err := register.Run(ctx, sr.client, []string{sessionKey(clientID)}, sessionID, sessionTTL.Milliseconds()).Text()
+	switch {
+	case err == redis.Nil:
+		return sessionID, nil
+	case err != nil:
+		sr.mu.Lock()
+		delete(sr.sessions, sessionID)
+		sr.mu.Unlock()
+		return "", err
+	}
+
+	if err := sr.client.Publish(ctx, takeoverChannel, prev).Err(); err != nil {
+		// The session which isn't returned is never unregistered.
+		sr.Unregister(clientID, sessionID)
+		return "", err
+	}
+
+	return sessionID, nil
+}
+
+func (sr *sessionRegistry) Unregister(clientID, sessionID string) error {
+	sr.mu.Lock()
+	delete(sr.sessions, sessionID)
+	sr.mu.Unlock()
+
+	return unregister.Run(context.Background(), sr.client, []string{sessionKey(clientID)}, sessionID).Err()
+}
+
+func (sr *sessionRegistry) Revoked(sessionID string) bool {
+	sr.mu.RLock()
+	defer sr.mu.RUnlock()
+
+	s, ok := sr.sessions[sessionID]
+	return ok && s.revoked
+}
+
+func (sr *sessionRegistry) Listen(ctx context.Context) error {
+	ps := sr.client.Subscribe(ctx, takeoverChannel)
+	defer ps.Close()
+
+	if _, err := ps.Receive(ctx); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	ch := ps.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if strings.HasPrefix(msg.Payload, sr.instance+"/") {
+				sr.revoke(msg.Payload)
+			}
+		case <-ticker.C:
+			if err := sr.refresh(ctx); err != nil {
+				sr.logger.Warn(fmt.Sprintf("Failed to refresh MQTT sessions: %s", err))
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// revoke marks the session as revoked and closes it. The sessions which were
+// already unregistered are ignored.
+func (sr *sessionRegistry) revoke(sessionID string) {
+	sr.mu.Lock()
+	s, ok := sr.sessions[sessionID]
+	if !ok || s.revoked {
+		sr.mu.Unlock()
+		return
+	}
+	s.revoked = true
+	sr.mu.Unlock()
+
+	if s.revoke != nil {
+		s.revoke()
+	}
+}
+
+// refresh extends the expiry of the keys of the sessions which are alive.
+func (sr *sessionRegistry) refresh(ctx context.Context) error {
+	sr.mu.RLock()
+	alive := make(map[string]string, len(sr.sessions))
+	for id, s := range sr.sessions {
+		if !s.revoked {
+			alive[id] = s.clientID
+		}
+	}
+	sr.mu.RUnlock()
+
+	if len(alive) == 0 {
+		return nil
+	}
+
+	_, err := sr.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for id, clientID := range alive {
+			refresh.Eval(ctx, pipe, []string{sessionKey(clientID)}, id, sessionTTL.Milliseconds())
+		}
+		return nil
+	})
+
+	return err
+}
+
+func sessionKey(clientID string) string {
+	return fmt.Sprintf("%s:%s", sessionPrefix, clientID)
+}
@@ -19,6 +19,17 @@ const (
 type EventStore interface {
 	Connect(clientID string) error
 	Disconnect(clientID string) error
+
+	// Fail issues the event of the rejected client operation, e.g. connect,
+	// publish or subscribe, with the reason of the rejection.
+	Fail(thingID, clientID, operation, reason string, err error) error
+
+	// Will issues the event of the Last Will and Testament published on
+	// behalf of the client disconnected abnormally.
+	Will(thingID, clientID string) error
+
+	// RetrieveByThing retrieves the latest events of the thing, newest first.
+	RetrieveByThing(ctx context.Context, thingID string, limit uint64) ([]Event, error)
 }
 
 // EventStore is a struct used to store event streams in Redis
@@ -36,15 +47,9 @@ func NewEventStore(client *redis.Client, instance string) EventStore {
 	}
 }
 
-func (es eventStore) storeEvent(clientID, eventType string) error {
-	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-
-	event := mqttEvent{
-		clientID:  clientID,
-		timestamp: timestamp,
-		eventType: eventType,
-		instance:  es.instance,
-	}
+func (es eventStore) storeEvent(event mqttEvent) error {
+	event.timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+	event.instance = es.instance
 
 	record := &redis.XAddArgs{
 		Stream:       streamID,
@@ -61,10 +66,52 @@ func (es eventStore) storeEvent(clientID, eventType string) error {
 
 // Connect issues event on MQTT CONNECT
 func (es eventStore) Connect(clientID string) error {
-	return es.storeEvent(clientID, "connect")
+	return es.storeEvent(mqttEvent{thingID: clientID, eventType: connectEvent})
 }
 
 // Disconnect issues event on MQTT CONNECT
 func (es eventStore) Disconnect(clientID string) error {
-	return es.storeEvent(clientID, "disconnect")
+	return es.storeEvent(mqttEvent{thingID: clientID, eventType: disconnectEvent})
+}
+
+func (es eventStore) Fail(thingID, clientID, operation, reason string, err error) error {
+	event := mqttEvent{
+		thingID:   thingID,
+		clientID:  clientID,
+		eventType: operation + failSuffix,
+		reason:    reason,
+	}
+	if err != nil {
+		event.err = err.Error()
+	}
+
+	return es.storeEvent(event)
+}
+
+func (es eventStore) Will(thingID, clientID string) error {
+	return es.storeEvent(mqttEvent{thingID: thingID, clientID: clientID, eventType: willEvent})
+}
+
+// RetrieveByThing reads the whole stream, since it's capped to the
+// stream length, and the events aren't indexed by the thing.
+func (es eventStore) RetrieveByThing(ctx context.Context, thingID string, limit uint64) ([]Event, error) {
+	msgs, err := es.client.XRevRange(ctx, streamID, "+", "-").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	for _, msg := range msgs {
+		if uint64(len(events)) == limit {
+			break
+		}
+
+		e := decodeEvent(msg.Values)
+		if e.ThingID != thingID {
+			continue
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
 }
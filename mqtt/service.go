@@ -3,6 +3,9 @@ package mqtt
 import (
 	"context"
 
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/things"
@@ -23,23 +26,33 @@ type Service interface {
 	// HasClientID  indicates if a subscription exist for a given client ID.
 	HasClientID(ctx context.Context, clientID string) error
 
+	// ListSubscriptionsByClientID lists the subscriptions of the client with a
+	// given MQTT client ID. Only the root admin can look up the clients.
+	ListSubscriptionsByClientID(ctx context.Context, token, clientID string) ([]Subscription, error)
+
 	// UpdateStatus updates the subscription status for a given client ID.
 	UpdateStatus(ctx context.Context, sub Subscription) error
+
+	// ListEvents lists the latest events of the thing, including the rejected
+	// client operations with the reasons of the rejection.
+	ListEvents(ctx context.Context, thingID, token, key string, limit uint64) ([]redis.Event, error)
 }
 
 type mqttService struct {
 	auth          protomfx.AuthServiceClient
 	things        protomfx.ThingsServiceClient
 	subscriptions Repository
+	events        redis.EventStore
 	idp           uuid.IDProvider
 }
 
 // NewMqttService instantiates the MQTT service implementation.
-func NewMqttService(auth protomfx.AuthServiceClient, things protomfx.ThingsServiceClient, subscriptions Repository, idp uuid.IDProvider) Service {
+func NewMqttService(auth protomfx.AuthServiceClient, things protomfx.ThingsServiceClient, subscriptions Repository, events redis.EventStore, idp uuid.IDProvider) Service {
 	return &mqttService{
 		auth:          auth,
 		things:        things,
 		subscriptions: subscriptions,
+		events:        events,
 		idp:           idp,
 	}
 }
@@ -70,6 +83,14 @@ func (ms *mqttService) ListSubscriptions(ctx context.Context, groupID, token, ke
 	return subs, nil
 }
 
+func (ms *mqttService) ListSubscriptionsByClientID(ctx context.Context, token, clientID string) ([]Subscription, error) {
+	if _, err := ms.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Subject: auth.RootSub}); err != nil {
+		return nil, errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return ms.subscriptions.RetrieveByClientID(ctx, clientID)
+}
+
 func (ms *mqttService) UpdateStatus(ctx context.Context, sub Subscription) error {
 	return ms.subscriptions.UpdateStatus(ctx, sub)
 }
@@ -78,6 +99,25 @@ func (ms *mqttService) HasClientID(ctx context.Context, clientID string) error {
 	return ms.subscriptions.HasClientID(ctx, clientID)
 }
 
+func (ms *mqttService) ListEvents(ctx context.Context, thingID, token, key string, limit uint64) ([]redis.Event, error) {
+	switch {
+	case token != "":
+		if _, err := ms.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: thingID, Subject: things.ThingSub, Action: things.Viewer}); err != nil {
+			return nil, err
+		}
+	default:
+		pc, err := ms.things.GetPubConfByKey(ctx, &protomfx.PubConfByKeyReq{Key: key})
+		if err != nil {
+			return nil, err
+		}
+		if pc.GetPublisherID() != thingID {
+			return nil, errors.ErrAuthorization
+		}
+	}
+
+	return ms.events.RetrieveByThing(ctx, thingID, limit)
+}
+
 func (ms *mqttService) authorize(ctx context.Context, token, key, groupID string) (err error) {
 	switch {
 	case token != "":
@@ -7,6 +7,7 @@ import (
 
 	"github.com/MainfluxLabs/mainflux/mqtt"
 	"github.com/MainfluxLabs/mainflux/mqtt/mocks"
+	"github.com/MainfluxLabs/mainflux/mqtt/redis"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
@@ -27,13 +28,17 @@ const (
 var idProvider = uuid.NewMock()
 
 func newService() mqtt.Service {
+	return newServiceWithEvents(mocks.NewEventStore())
+}
+
+func newServiceWithEvents(es redis.EventStore) mqtt.Service {
 	repo := mocks.NewRepo(make(map[string][]mqtt.Subscription))
 	mockAuthzDB := map[string][]mocks.SubjectSet{}
 	mockAuthzDB[adminUser] = []mocks.SubjectSet{{Object: "authorities", Relation: "member"}}
 	mockAuthzDB["*"] = []mocks.SubjectSet{{Object: "user", Relation: "create"}}
 	tc := thmocks.NewThingsServiceClient(nil, map[string]string{exampleUser1: groupID}, map[string]things.Group{exampleUser1: {ID: groupID}})
 	ac := mocks.NewAuth(map[string]string{exampleUser1: exampleUser1, adminUser: adminUser}, mockAuthzDB)
-	return mqtt.NewMqttService(ac, tc, repo, idProvider)
+	return mqtt.NewMqttService(ac, tc, repo, es, idProvider)
 }
 
 func TestCreateSubscription(t *testing.T) {
@@ -287,3 +292,123 @@ func TestRetrieveByGroupID(t *testing.T) {
 		assert.Equal(t, tc.page, page, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.page, page))
 	}
 }
+
+func TestListSubscriptionsByClientID(t *testing.T) {
+	svc := newService()
+
+	thID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	clientID := "lookup-client-id"
+	sub := mqtt.Subscription{
+		Subtopic: subtopic,
+		ThingID:  thID,
+		GroupID:  groupID,
+		ClientID: clientID,
+	}
+
+	err = svc.CreateSubscription(context.Background(), sub)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		token    string
+		clientID string
+		subs     []mqtt.Subscription
+		err      error
+	}{
+		{
+			desc:     "list subscriptions by client id as admin",
+			token:    adminUser,
+			clientID: clientID,
+			subs:     []mqtt.Subscription{sub},
+			err:      nil,
+		},
+		{
+			desc:     "list subscriptions by non-existing client id as admin",
+			token:    adminUser,
+			clientID: "non-existing",
+			subs:     nil,
+			err:      nil,
+		},
+		{
+			desc:     "list subscriptions by client id as non-admin user",
+			token:    exampleUser1,
+			clientID: clientID,
+			subs:     nil,
+			err:      errors.ErrAuthorization,
+		},
+		{
+			desc:     "list subscriptions by client id with invalid token",
+			token:    invalidUser,
+			clientID: clientID,
+			subs:     nil,
+			err:      errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		subs, err := svc.ListSubscriptionsByClientID(context.Background(), tc.token, tc.clientID)
+		assert.Equal(t, tc.subs, subs, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.subs, subs))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	es := mocks.NewEventStore()
+	svc := newServiceWithEvents(es)
+
+	err := es.Connect(thingID)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	err = es.Fail(thingID, clientID, "publish", mqtt.ReasonNotAuthorized, errors.ErrAuthorization)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	err = es.Connect(invalidID)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		key     string
+		limit   uint64
+		reasons []string
+		err     error
+	}{
+		{
+			desc:    "list events",
+			token:   exampleUser1,
+			limit:   10,
+			reasons: []string{mqtt.ReasonNotAuthorized, ""},
+			err:     nil,
+		},
+		{
+			desc:    "list events with limit",
+			token:   exampleUser1,
+			limit:   1,
+			reasons: []string{mqtt.ReasonNotAuthorized},
+			err:     nil,
+		},
+		{
+			desc:  "list events with invalid token",
+			token: invalidUser,
+			limit: 10,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "list events with the key of another thing",
+			key:   key,
+			limit: 10,
+			err:   errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		events, err := svc.ListEvents(context.Background(), thingID, tc.token, tc.key, tc.limit)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		var reasons []string
+		for _, e := range events {
+			reasons = append(reasons, e.Reason)
+		}
+		assert.Equal(t, tc.reasons, reasons, fmt.Sprintf("%s: expected reasons %v got %v\n", tc.desc, tc.reasons, reasons))
+	}
+}
@@ -36,4 +36,6 @@ type Repository interface {
 	UpdateStatus(ctx context.Context, sub Subscription) error
 	// HasClientID will update the subscription status.
 	HasClientID(ctx context.Context, clientID string) error
+	// RetrieveByClientID retrieves all subscriptions of the specified client.
+	RetrieveByClientID(ctx context.Context, clientID string) ([]Subscription, error)
 }
@@ -0,0 +1,148 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+)
+
+const (
+	shortMessages = "m"
+	shortProfiles = "p"
+	messages      = "messages"
+	profiles      = "profiles"
+	singleLevel   = "+"
+	multiLevel    = "#"
+	singleSubject = "*"
+	multiSubject  = ">"
+)
+
+var (
+	// ErrMalformedAlias indicates malformed topic alias.
+	ErrMalformedAlias = errors.New("malformed topic alias")
+
+	// ErrMalformedFilter indicates malformed topic filter.
+	ErrMalformedFilter = errors.New("malformed topic filter")
+)
+
+// TopicTranslator translates the topics the things publish to, to the canonical
+// /messages/<subtopic> and /profiles/<profile_id>/messages/<subtopic> topics.
+// The short topics and the topic aliases cut the bandwidth used by the
+// constrained devices.
+type TopicTranslator struct {
+	short   bool
+	aliases map[string]string
+}
+
+// NewTopicTranslator returns the topic translator. If short is set, the short
+// topics m/<subtopic> and p/<profile_id>/m/<subtopic> are translated. The aliases
+// map the topics to the canonical topics they are translated to.
+func NewTopicTranslator(short bool, aliases map[string]string) TopicTranslator {
+	return TopicTranslator{
+		short:   short,
+		aliases: aliases,
+	}
+}
+
+// Translate returns the canonical topic of the given topic. The topics which
+// are neither aliases nor short topics are returned unchanged.
+func (tt TopicTranslator) Translate(topic string) string {
+	if t, ok := tt.aliases[topic]; ok {
+		return t
+	}
+
+	if !tt.short {
+		return topic
+	}
+
+	parts := strings.Split(strings.TrimPrefix(topic, "/"), "/")
+	switch {
+	case parts[0] == shortMessages:
+		parts[0] = messages
+	case len(parts) > 2 && parts[0] == shortProfiles && parts[2] == shortMessages:
+		parts[0], parts[2] = profiles, messages
+	default:
+		return topic
+	}
+
+	return "/" + strings.Join(parts, "/")
+}
+
+// ParseAliases parses the comma separated <alias>=<topic> pairs.
+func ParseAliases(s string) (map[string]string, error) {
+	aliases := make(map[string]string)
+	if s == "" {
+		return aliases, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		alias, topic, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || alias == "" || topic == "" {
+			return nil, errors.Wrap(ErrMalformedAlias, fmt.Errorf("%q", pair))
+		}
+		aliases[alias] = topic
+	}
+
+	return aliases, nil
+}
+
+// ParseFilter validates the topic filter the thing subscribes to and returns
+// its subtopic, in which the single level (+) and multi level (#) wildcards
+// are replaced by the message broker wildcards (* and >). The wildcards are
+// allowed in the subtopic only, so the filter must start with the /messages
+// or /profiles/<profile_id>/messages topic, and the thing can't subscribe to
+// the topics outside of its messages.
+func ParseFilter(filter string) (string, error) {
+	levels := strings.Split(filter, "/")
+	switch {
+	case len(levels) > 1 && levels[0] == "" && levels[1] == messages:
+		levels = levels[2:]
+	case len(levels) > 3 && levels[0] == "" && levels[1] == profiles && levels[2] != "" && validLevel(levels[2]) && levels[3] == messages:
+		levels = levels[4:]
+	default:
+		return "", errors.Wrap(ErrMalformedFilter, fmt.Errorf("%q", filter))
+	}
+
+	for i, l := range levels {
+		switch {
+		case l == singleLevel:
+			levels[i] = singleSubject
+		case l == multiLevel && i == len(levels)-1:
+			levels[i] = multiSubject
+		case !validLevel(l):
+			return "", errors.Wrap(ErrMalformedFilter, fmt.Errorf("%q", filter))
+		}
+	}
+
+	subtopic, err := messaging.CreateSubject(strings.Join(levels, "/"))
+	if err != nil {
+		return "", err
+	}
+
+	// The escaped wildcards are decoded when the subject is created.
+	if strings.ContainsAny(subtopic, singleLevel+multiLevel) {
+		return "", errors.Wrap(ErrMalformedFilter, fmt.Errorf("%q", filter))
+	}
+
+	return subtopic, nil
+}
+
+// filterProfileID returns the profile ID of the /profiles/<profile_id>/messages
+// topic filter, or the empty string if the filter doesn't contain it.
+func filterProfileID(filter string) string {
+	levels := strings.Split(filter, "/")
+	if len(levels) > 3 && levels[0] == "" && levels[1] == profiles && levels[3] == messages {
+		return levels[2]
+	}
+
+	return ""
+}
+
+func validLevel(level string) bool {
+	return !strings.ContainsAny(level, singleLevel+multiLevel+singleSubject+multiSubject)
+}
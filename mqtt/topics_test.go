@@ -0,0 +1,208 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mqtt_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/mqtt"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const profileID = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+
+func TestTranslate(t *testing.T) {
+	aliases := map[string]string{"t": "/messages/temperature"}
+
+	cases := []struct {
+		desc  string
+		short bool
+		topic string
+		res   string
+	}{
+		{
+			desc:  "translate alias",
+			topic: "t",
+			res:   "/messages/temperature",
+		},
+		{
+			desc:  "translate canonical topic",
+			short: true,
+			topic: "/messages/temperature",
+			res:   "/messages/temperature",
+		},
+		{
+			desc:  "translate short topic",
+			short: true,
+			topic: "m/temperature",
+			res:   "/messages/temperature",
+		},
+		{
+			desc:  "translate short topic without subtopic",
+			short: true,
+			topic: "/m",
+			res:   "/messages",
+		},
+		{
+			desc:  "translate short profile topic",
+			short: true,
+			topic: fmt.Sprintf("p/%s/m/temperature", profileID),
+			res:   fmt.Sprintf("/profiles/%s/messages/temperature", profileID),
+		},
+		{
+			desc:  "translate short topic with short topics disabled",
+			short: false,
+			topic: "m/temperature",
+			res:   "m/temperature",
+		},
+		{
+			desc:  "translate unknown short topic",
+			short: true,
+			topic: "x/temperature",
+			res:   "x/temperature",
+		},
+	}
+
+	for _, tc := range cases {
+		tt := mqtt.NewTopicTranslator(tc.short, aliases)
+		res := tt.Translate(tc.topic)
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.res, res))
+	}
+}
+
+func TestParseAliases(t *testing.T) {
+	cases := []struct {
+		desc    string
+		aliases string
+		res     map[string]string
+		err     error
+	}{
+		{
+			desc:    "parse aliases",
+			aliases: "t=/messages/temperature, h=/messages/humidity",
+			res:     map[string]string{"t": "/messages/temperature", "h": "/messages/humidity"},
+			err:     nil,
+		},
+		{
+			desc:    "parse empty aliases",
+			aliases: "",
+			res:     map[string]string{},
+			err:     nil,
+		},
+		{
+			desc:    "parse alias without topic",
+			aliases: "t=",
+			res:     nil,
+			err:     mqtt.ErrMalformedAlias,
+		},
+		{
+			desc:    "parse alias without separator",
+			aliases: "t",
+			res:     nil,
+			err:     mqtt.ErrMalformedAlias,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := mqtt.ParseAliases(tc.aliases)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.res, res))
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	cases := []struct {
+		desc   string
+		filter string
+		res    string
+		err    error
+	}{
+		{
+			desc:   "parse filter without subtopic",
+			filter: "/messages",
+			res:    "",
+			err:    nil,
+		},
+		{
+			desc:   "parse filter with subtopic",
+			filter: "/messages/room/temperature",
+			res:    "room.temperature",
+			err:    nil,
+		},
+		{
+			desc:   "parse filter with single level wildcard",
+			filter: "/messages/+/temperature",
+			res:    "*.temperature",
+			err:    nil,
+		},
+		{
+			desc:   "parse filter with multi level wildcard",
+			filter: "/messages/room/#",
+			res:    "room.>",
+			err:    nil,
+		},
+		{
+			desc:   "parse filter with both wildcards",
+			filter: "/messages/+/#",
+			res:    "*.>",
+			err:    nil,
+		},
+		{
+			desc:   "parse profile filter with wildcard",
+			filter: fmt.Sprintf("/profiles/%s/messages/+", profileID),
+			res:    "*",
+			err:    nil,
+		},
+		{
+			desc:   "parse filter with wildcard root",
+			filter: "/+/room",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+		{
+			desc:   "parse filter with multi level wildcard root",
+			filter: "#",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+		{
+			desc:   "parse filter with wildcard profile",
+			filter: "/profiles/+/messages",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+		{
+			desc:   "parse filter with multi level wildcard not at the end",
+			filter: "/messages/#/temperature",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+		{
+			desc:   "parse filter with wildcard within level",
+			filter: "/messages/room+",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+		{
+			desc:   "parse filter with broker wildcard",
+			filter: "/messages/*",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+		{
+			desc:   "parse filter with escaped wildcard",
+			filter: "/messages/room%2B",
+			res:    "",
+			err:    mqtt.ErrMalformedFilter,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := mqtt.ParseFilter(tc.filter)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.res, res))
+	}
+}
@@ -15,6 +15,9 @@ var (
 	// ErrBearerKey indicates missing or invalid bearer entity key.
 	ErrBearerKey = errors.New("missing or invalid bearer entity key")
 
+	// ErrRequestTimeout indicates that the request wasn't completed before its deadline.
+	ErrRequestTimeout = errors.New("request timed out")
+
 	// ErrMissingID indicates missing entity ID.
 	ErrMissingID = errors.New("missing entity id")
 
@@ -93,6 +96,18 @@ var (
 	// ErrInvalidComparator indicates an invalid comparator.
 	ErrInvalidComparator = errors.New("invalid comparator")
 
+	// ErrInvalidInterval indicates an invalid aggregation interval.
+	ErrInvalidInterval = errors.New("invalid aggregation interval")
+
+	// ErrInvalidAggregation indicates an invalid aggregation type.
+	ErrInvalidAggregation = errors.New("invalid aggregation type")
+
+	// ErrInvalidTimezone indicates an invalid IANA time zone name.
+	ErrInvalidTimezone = errors.New("invalid time zone")
+
+	// ErrInvalidFields indicates invalid selection of the message fields.
+	ErrInvalidFields = errors.New("invalid message fields")
+
 	// ErrMissingMemberType indicates missing group member type.
 	ErrMissingMemberType = errors.New("missing group member type")
 
@@ -114,9 +129,51 @@ var (
 	// ErrInvalidMemberRole indicates an invalid member role.
 	ErrInvalidMemberRole = errors.New("invalid member role")
 
+	// ErrInvalidMemberExpiry indicates the membership expiry which isn't in the future.
+	ErrInvalidMemberExpiry = errors.New("invalid membership expiry")
+
 	// ErrMalformedEntity indicates a malformed entity specification.
 	ErrMalformedEntity = errors.New("malformed entity specification")
 
 	// ErrInvalidRole indicates an invalid role.
 	ErrInvalidRole = errors.New("invalid role")
+
+	// ErrInvalidFormat indicates an unsupported export format.
+	ErrInvalidFormat = errors.New("invalid export format")
+
+	// ErrInvalidThreshold indicates an invalid certificate expiry threshold.
+	ErrInvalidThreshold = errors.New("invalid expiry threshold")
+
+	// ErrInvalidUnits indicates an unsupported unit conversion of the profile config.
+	ErrInvalidUnits = errors.New("invalid unit conversion")
+
+	// ErrInvalidTransformer indicates that the profile config references an unknown transformer.
+	ErrInvalidTransformer = errors.New("unknown transformer")
+
+	// ErrInvalidContentEncoding indicates an unsupported payload content encoding of the profile config.
+	ErrInvalidContentEncoding = errors.New("invalid content encoding")
+
+	// ErrInvalidSchema indicates an invalid payload schema of the profile config.
+	ErrInvalidSchema = errors.New("invalid payload schema")
+
+	// ErrInvalidMQTTConfig indicates invalid MQTT session parameters of the profile config.
+	ErrInvalidMQTTConfig = errors.New("invalid mqtt session parameters")
+
+	// ErrInvalidTTL indicates an invalid message time to live.
+	ErrInvalidTTL = errors.New("invalid message ttl")
+
+	// ErrKeyPrefixSize indicates that the thing key prefix is missing or too short.
+	ErrKeyPrefixSize = errors.New("invalid key prefix size")
+
+	// ErrMissingSerial indicates missing certificate serial.
+	ErrMissingSerial = errors.New("missing certificate serial")
+
+	// ErrMissingClientID indicates missing MQTT client ID.
+	ErrMissingClientID = errors.New("missing client id")
+
+	// ErrMissingStream indicates missing event store stream.
+	ErrMissingStream = errors.New("missing stream")
+
+	// ErrInvalidStreamOffset indicates an invalid event store consumer group offset.
+	ErrInvalidStreamOffset = errors.New("invalid stream offset")
 )
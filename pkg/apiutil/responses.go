@@ -3,6 +3,12 @@
 
 package apiutil
 
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+)
+
 // Response contains HTTP response specific methods.
 type Response interface {
 	// Code returns HTTP response code.
@@ -17,5 +23,12 @@ type Response interface {
 
 // ErrorRes represents the HTTP error response body.
 type ErrorRes struct {
-	Err string `json:"error"`
+	Err       string `json:"error"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// NewErrorRes returns the error response carrying the ID of the request the
+// error occurred in, if any.
+func NewErrorRes(ctx context.Context, msg string) ErrorRes {
+	return ErrorRes{Err: msg, RequestID: logger.RequestIDFromContext(ctx)}
 }
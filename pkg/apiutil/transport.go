@@ -15,9 +15,21 @@ import (
 	"github.com/go-zoo/bone"
 )
 
+const contentType = "application/json"
+
 // LoggingErrorEncoder is a go-kit error encoder logging decorator.
 func LoggingErrorEncoder(logger logger.Logger, enc kithttp.ErrorEncoder) kithttp.ErrorEncoder {
 	return func(ctx context.Context, err error, w http.ResponseWriter) {
+		// The requests exceeding the deadline are reported distinctly from
+		// the service errors, regardless of the error the deadline caused.
+		if ctx.Err() == context.DeadlineExceeded || errors.Contains(err, context.DeadlineExceeded) {
+			logger.WithContext(ctx).Warn(err.Error())
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(http.StatusGatewayTimeout)
+			json.NewEncoder(w).Encode(NewErrorRes(ctx, ErrRequestTimeout.Error()))
+			return
+		}
+
 		switch {
 		case errors.Contains(err, ErrBearerToken),
 			errors.Contains(err, ErrMissingID),
@@ -50,7 +62,7 @@ func LoggingErrorEncoder(logger logger.Logger, enc kithttp.ErrorEncoder) kithttp
 			errors.Contains(err, ErrMalformedEntity),
 			errors.Contains(err, ErrInvalidRole),
 			errors.Contains(err, ErrInvalidQueryParams):
-			logger.Error(err.Error())
+			logger.WithContext(ctx).Error(err.Error())
 		}
 
 		enc(ctx, err, w)
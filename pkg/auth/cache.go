@@ -0,0 +1,264 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"google.golang.org/grpc"
+)
+
+const warmPageSize = 1000
+
+// ThingsCache represents things service client which caches the publish
+// configurations retrieved by thing keys.
+type ThingsCache interface {
+	protomfx.ThingsServiceClient
+
+	// RemoveThing removes the cached publish configuration of the thing.
+	RemoveThing(thingID string)
+
+	// RemoveProfile removes the cached publish configurations of the things
+	// of the profile.
+	RemoveProfile(profileID string)
+
+	// RemoveGroup removes the cached publish configurations of the things
+	// of the group.
+	RemoveGroup(groupID string)
+
+	// RemoveOrg removes the cached publish configurations of the things
+	// of the org.
+	RemoveOrg(orgID string)
+
+	// Clear removes all cached publish configurations.
+	Clear()
+
+	// Warm replaces the cached publish configurations with the publish
+	// configurations of all things, retrieved from the things service page
+	// by page. The cache is left unchanged if the retrieval fails.
+	Warm(ctx context.Context) error
+}
+
+type cachedPubConf struct {
+	pc      *protomfx.PubConfByKeyRes
+	expires time.Time
+}
+
+// index maps the IDs of the profiles, groups or orgs to the IDs of their
+// things whose publish configurations are cached.
+type index map[string]map[string]struct{}
+
+func (idx index) add(id, thingID string) {
+	if id == "" {
+		return
+	}
+	if _, ok := idx[id]; !ok {
+		idx[id] = make(map[string]struct{})
+	}
+	idx[id][thingID] = struct{}{}
+}
+
+func (idx index) remove(id, thingID string) {
+	delete(idx[id], thingID)
+	if len(idx[id]) == 0 {
+		delete(idx, id)
+	}
+}
+
+// entries contains the cached publish configurations mapped by the thing
+// keys, together with the thing keys mapped by the thing IDs and the indexes
+// used to invalidate the configurations affected by the profile, group and
+// org events.
+type entries struct {
+	pubConfs map[string]cachedPubConf
+	keys     map[string]string
+	profiles index
+	groups   index
+	orgs     index
+}
+
+func newEntries() *entries {
+	return &entries{
+		pubConfs: make(map[string]cachedPubConf),
+		keys:     make(map[string]string),
+		profiles: make(index),
+		groups:   make(index),
+		orgs:     make(index),
+	}
+}
+
+func (e *entries) put(key string, cpc cachedPubConf) {
+	thingID := cpc.pc.GetPublisherID()
+	// The previous configuration of the thing is removed, as the thing key
+	// or its profile, group or org could have changed.
+	e.removeThing(thingID)
+
+	e.pubConfs[key] = cpc
+	e.keys[thingID] = key
+	e.profiles.add(cpc.pc.GetProfileID(), thingID)
+	e.groups.add(cpc.pc.GetGroupID(), thingID)
+	e.orgs.add(cpc.pc.GetOrgID(), thingID)
+}
+
+func (e *entries) removeThing(thingID string) {
+	key, ok := e.keys[thingID]
+	if !ok {
+		return
+	}
+
+	pc := e.pubConfs[key].pc
+	e.profiles.remove(pc.GetProfileID(), thingID)
+	e.groups.remove(pc.GetGroupID(), thingID)
+	e.orgs.remove(pc.GetOrgID(), thingID)
+	delete(e.pubConfs, key)
+	delete(e.keys, thingID)
+}
+
+func (e *entries) removeIndexed(idx index, id string) {
+	for thingID := range idx[id] {
+		e.removeThing(thingID)
+	}
+}
+
+type thingsCache struct {
+	protomfx.ThingsServiceClient
+	ttl        time.Duration
+	mu         sync.RWMutex
+	entries    *entries
+	generation uint64
+}
+
+// NewThingsCache returns things service client which caches the publish
+// configurations for the given ttl. The cached configurations are meant
+// to be invalidated using the things events, see SubscribeThingsEvents.
+func NewThingsCache(things protomfx.ThingsServiceClient, ttl time.Duration) ThingsCache {
+	return &thingsCache{
+		ThingsServiceClient: things,
+		ttl:                 ttl,
+		entries:             newEntries(),
+	}
+}
+
+func (tc *thingsCache) GetPubConfByKey(ctx context.Context, req *protomfx.PubConfByKeyReq, opts ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	key := req.GetKey()
+
+	tc.mu.RLock()
+	cpc, ok := tc.entries.pubConfs[key]
+	generation := tc.generation
+	tc.mu.RUnlock()
+
+	if ok && time.Now().Before(cpc.expires) {
+		return cpc.pc, nil
+	}
+
+	pc, err := tc.ThingsServiceClient.GetPubConfByKey(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	// The configuration is not cached if it was invalidated in the meantime.
+	if generation != tc.generation {
+		return pc, nil
+	}
+
+	tc.entries.put(key, cachedPubConf{pc: pc, expires: time.Now().Add(tc.ttl)})
+
+	return pc, nil
+}
+
+func (tc *thingsCache) RemoveThing(thingID string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	tc.entries.removeThing(thingID)
+}
+
+func (tc *thingsCache) RemoveProfile(profileID string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	tc.entries.removeIndexed(tc.entries.profiles, profileID)
+}
+
+func (tc *thingsCache) RemoveGroup(groupID string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	tc.entries.removeIndexed(tc.entries.groups, groupID)
+}
+
+func (tc *thingsCache) RemoveOrg(orgID string) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	tc.entries.removeIndexed(tc.entries.orgs, orgID)
+}
+
+func (tc *thingsCache) Clear() {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	tc.entries = newEntries()
+}
+
+func (tc *thingsCache) Warm(ctx context.Context) error {
+	warmed := newEntries()
+
+	now := time.Now()
+	for offset := uint64(0); ; offset += warmPageSize {
+		res, err := tc.ThingsServiceClient.GetPubConfs(ctx, &protomfx.PubConfsReq{Offset: offset, Limit: warmPageSize})
+		if err != nil {
+			return err
+		}
+
+		for _, pc := range res.GetPubConfs() {
+			warmed.put(pc.GetKey(), cachedPubConf{
+				pc: &protomfx.PubConfByKeyRes{
+					PublisherID:   pc.GetPublisherID(),
+					OrgID:         pc.GetOrgID(),
+					ProfileConfig: pc.GetProfileConfig(),
+					NetworkACLs:   pc.GetNetworkACLs(),
+					ProfileID:     pc.GetProfileID(),
+					GroupID:       pc.GetGroupID(),
+				},
+				expires: now.Add(tc.ttl + tc.jitter()),
+			})
+		}
+
+		if len(res.GetPubConfs()) == 0 || offset+warmPageSize >= res.GetTotal() {
+			break
+		}
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.generation++
+	tc.entries = warmed
+
+	return nil
+}
+
+// jitter returns a random duration shorter than the ttl, which spreads the
+// expiration of the warmed configurations, so that they are not retrieved
+// again all at once.
+func (tc *thingsCache) jitter() time.Duration {
+	if tc.ttl <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(tc.ttl)))
+}
@@ -0,0 +1,227 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+const (
+	thingKey   = "thing-key"
+	thingID    = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	invalidKey = "invalid"
+)
+
+type thingsClientMock struct {
+	protomfx.ThingsServiceClient
+	calls     int
+	pageCalls int
+	pubConfs  []*protomfx.ThingPubConf
+	err       error
+}
+
+func (tc *thingsClientMock) GetPubConfByKey(_ context.Context, req *protomfx.PubConfByKeyReq, _ ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	tc.calls++
+	if req.GetKey() == invalidKey {
+		return nil, errors.ErrAuthentication
+	}
+
+	return &protomfx.PubConfByKeyRes{PublisherID: thingID, ProfileConfig: &protomfx.Config{Write: true}}, nil
+}
+
+func (tc *thingsClientMock) GetPubConfs(_ context.Context, req *protomfx.PubConfsReq, _ ...grpc.CallOption) (*protomfx.PubConfsRes, error) {
+	tc.pageCalls++
+	if tc.err != nil {
+		return nil, tc.err
+	}
+
+	total := uint64(len(tc.pubConfs))
+	start, end := req.GetOffset(), req.GetOffset()+req.GetLimit()
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+
+	return &protomfx.PubConfsRes{PubConfs: tc.pubConfs[start:end], Total: total}, nil
+}
+
+func TestGetPubConfByKey(t *testing.T) {
+	things := &thingsClientMock{}
+	cache := auth.NewThingsCache(things, time.Minute)
+
+	cases := []struct {
+		desc       string
+		key        string
+		invalidate func()
+		calls      int
+		err        error
+	}{
+		{
+			desc:  "get publish configuration",
+			key:   thingKey,
+			calls: 1,
+		},
+		{
+			desc:  "get cached publish configuration",
+			key:   thingKey,
+			calls: 1,
+		},
+		{
+			desc:       "get publish configuration after removing thing",
+			key:        thingKey,
+			invalidate: func() { cache.RemoveThing(thingID) },
+			calls:      2,
+		},
+		{
+			desc:       "get publish configuration after clearing cache",
+			key:        thingKey,
+			invalidate: cache.Clear,
+			calls:      3,
+		},
+		{
+			desc:  "get publish configuration with invalid key",
+			key:   invalidKey,
+			calls: 4,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "get publish configuration with invalid key again",
+			key:   invalidKey,
+			calls: 5,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.invalidate != nil {
+			tc.invalidate()
+		}
+
+		pc, err := cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: tc.key})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.calls, things.calls, fmt.Sprintf("%s: expected %d things calls got %d\n", tc.desc, tc.calls, things.calls))
+		if err == nil {
+			assert.Equal(t, thingID, pc.GetPublisherID(), fmt.Sprintf("%s: expected publisher %s got %s\n", tc.desc, thingID, pc.GetPublisherID()))
+		}
+	}
+}
+
+func TestGetPubConfByKeyExpired(t *testing.T) {
+	things := &thingsClientMock{}
+	cache := auth.NewThingsCache(things, time.Millisecond)
+
+	_, err := cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: thingKey})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	time.Sleep(2 * time.Millisecond)
+
+	_, err = cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: thingKey})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 2, things.calls, fmt.Sprintf("get expired publish configuration: expected 2 things calls got %d", things.calls))
+}
+
+func TestWarm(t *testing.T) {
+	n := 2500
+	pubConfs := []*protomfx.ThingPubConf{}
+	for i := 0; i < n; i++ {
+		pubConfs = append(pubConfs, &protomfx.ThingPubConf{
+			Key:           fmt.Sprintf("%s-%d", thingKey, i),
+			PublisherID:   fmt.Sprintf("%s-%d", thingID, i),
+			ProfileConfig: &protomfx.Config{Write: true},
+		})
+	}
+
+	things := &thingsClientMock{pubConfs: pubConfs}
+	cache := auth.NewThingsCache(things, time.Minute)
+
+	err := cache.Warm(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 3, things.pageCalls, fmt.Sprintf("warm cache: expected 3 page calls got %d", things.pageCalls))
+
+	for _, pc := range []*protomfx.ThingPubConf{pubConfs[0], pubConfs[n-1]} {
+		res, err := cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: pc.GetKey()})
+		assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		assert.Equal(t, pc.GetPublisherID(), res.GetPublisherID(), fmt.Sprintf("get warmed publish configuration: expected publisher %s got %s", pc.GetPublisherID(), res.GetPublisherID()))
+	}
+	assert.Equal(t, 0, things.calls, fmt.Sprintf("get warmed publish configuration: expected 0 things calls got %d", things.calls))
+
+	cache.RemoveThing(pubConfs[0].GetPublisherID())
+	_, err = cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: pubConfs[0].GetKey()})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 1, things.calls, fmt.Sprintf("get removed publish configuration: expected 1 things call got %d", things.calls))
+
+	things.err = errors.ErrAuthentication
+	err = cache.Warm(context.Background())
+	assert.True(t, errors.Contains(err, errors.ErrAuthentication), fmt.Sprintf("warm cache with unavailable things: expected %s got %s", errors.ErrAuthentication, err))
+
+	_, err = cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: pubConfs[1].GetKey()})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 1, things.calls, fmt.Sprintf("get publish configuration after failed warming: expected 1 things call got %d", things.calls))
+}
+
+func TestRemoveIndexed(t *testing.T) {
+	pubConfs := []*protomfx.ThingPubConf{}
+	for i := 0; i < 4; i++ {
+		pubConfs = append(pubConfs, &protomfx.ThingPubConf{
+			Key:         fmt.Sprintf("%s-%d", thingKey, i),
+			PublisherID: fmt.Sprintf("%s-%d", thingID, i),
+			ProfileID:   fmt.Sprintf("profile-%d", i),
+			GroupID:     fmt.Sprintf("group-%d", i/2),
+			OrgID:       "org",
+		})
+	}
+
+	cases := []struct {
+		desc       string
+		invalidate func(cache auth.ThingsCache)
+		removed    []string
+	}{
+		{
+			desc:       "remove profile",
+			invalidate: func(cache auth.ThingsCache) { cache.RemoveProfile("profile-0") },
+			removed:    []string{pubConfs[0].GetKey()},
+		},
+		{
+			desc:       "remove group",
+			invalidate: func(cache auth.ThingsCache) { cache.RemoveGroup("group-1") },
+			removed:    []string{pubConfs[2].GetKey(), pubConfs[3].GetKey()},
+		},
+		{
+			desc:       "remove org",
+			invalidate: func(cache auth.ThingsCache) { cache.RemoveOrg("org") },
+			removed:    []string{pubConfs[0].GetKey(), pubConfs[1].GetKey(), pubConfs[2].GetKey(), pubConfs[3].GetKey()},
+		},
+		{
+			desc:       "remove unknown group",
+			invalidate: func(cache auth.ThingsCache) { cache.RemoveGroup("unknown") },
+			removed:    []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		things := &thingsClientMock{pubConfs: pubConfs}
+		cache := auth.NewThingsCache(things, time.Minute)
+		err := cache.Warm(context.Background())
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		tc.invalidate(cache)
+
+		for _, pc := range pubConfs {
+			_, err := cache.GetPubConfByKey(context.Background(), &protomfx.PubConfByKeyReq{Key: pc.GetKey()})
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		}
+		assert.Equal(t, len(tc.removed), things.calls, fmt.Sprintf("%s: expected %d things calls got %d", tc.desc, len(tc.removed), things.calls))
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	thingsStream   = "mainflux.things"
+	thingsReadWait = time.Second
+
+	thingPrefix    = "thing."
+	thingUpdate    = thingPrefix + "update"
+	thingUpdateKey = thingPrefix + "update_key"
+	thingRemove    = thingPrefix + "remove"
+	thingUpdateACL = thingPrefix + "update_network_acl"
+
+	profileEventPrefix = "profile."
+	profileUpdate      = profileEventPrefix + "update"
+	profileRemove      = profileEventPrefix + "remove"
+
+	groupPrefix   = "group."
+	groupRemove   = groupPrefix + "remove"
+	groupTransfer = groupPrefix + "transfer"
+
+	orgPrefix    = "org."
+	orgUpdateACL = orgPrefix + "update_network_acl"
+)
+
+// SubscribeThingsEvents warms the cache and keeps the cached publish configurations
+// up to date using the things event stream, until the context is canceled. The
+// stream is read without a consumer group, so that every adapter instance receives
+// all events. The events published while the cache is being warmed are read after
+// the cache is warmed.
+func SubscribeThingsEvents(ctx context.Context, cache ThingsCache, client *redis.Client, logger logger.Logger) error {
+	lastID := lastEventID(ctx, client)
+	if err := cache.Warm(ctx); err != nil {
+		logger.Warn(fmt.Sprintf("Failed to warm things cache: %s", err))
+	}
+
+	for {
+		streams, err := client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{thingsStream, lastID},
+			Count:   100,
+			Block:   thingsReadWait,
+		}).Result()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == redis.Nil || (err == nil && len(streams) == 0) {
+			continue
+		}
+		if err != nil {
+			// Events could be missed while the stream is not available.
+			logger.Warn(fmt.Sprintf("Failed to read things events: %s", err))
+			cache.Clear()
+			time.Sleep(thingsReadWait)
+			continue
+		}
+
+		for _, msg := range streams[0].Messages {
+			lastID = msg.ID
+
+			id, _ := msg.Values["id"].(string)
+			switch msg.Values["operation"] {
+			case thingUpdate, thingUpdateKey, thingRemove, thingUpdateACL:
+				cache.RemoveThing(id)
+			case profileUpdate, profileRemove:
+				cache.RemoveProfile(id)
+			case groupRemove, groupTransfer:
+				cache.RemoveGroup(id)
+			case orgUpdateACL:
+				cache.RemoveOrg(id)
+			}
+		}
+	}
+}
+
+// lastEventID returns the ID of the last event in the things stream, so that
+// the events published after it are read. All events are read if the stream
+// is empty.
+func lastEventID(ctx context.Context, client *redis.Client) string {
+	msgs, err := client.XRevRangeN(ctx, thingsStream, "+", "-", 1).Result()
+	if err != nil {
+		return "$"
+	}
+
+	if len(msgs) == 0 {
+		return "0"
+	}
+
+	return msgs[0].ID
+}
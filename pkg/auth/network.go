@@ -0,0 +1,137 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	networkStream    = "mainflux.network"
+	networkStreamLen = 1000
+	networkDeny      = "network.deny"
+)
+
+// ErrNetworkDenied indicates that the client connected from the network
+// which isn't allowed by the network ACLs of the thing or its org.
+var ErrNetworkDenied = errors.New("network not allowed")
+
+type contextKey int
+
+const clientAddrCtxKey contextKey = iota
+
+// ContextWithClientAddr returns a copy of the context carrying the client IP address.
+func ContextWithClientAddr(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, clientAddrCtxKey, addr)
+}
+
+// ClientAddrFromContext returns the client IP address carried by the context, if any.
+func ClientAddrFromContext(ctx context.Context) string {
+	addr, _ := ctx.Value(clientAddrCtxKey).(string)
+	return addr
+}
+
+// HostAddr returns the host part of the provided network address.
+func HostAddr(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
+
+// PermitsNetwork reports whether the client IP address is permitted by all
+// of the provided network ACLs. The address is permitted by the ACL if it
+// doesn't belong to any of the denied networks and, if any networks are
+// allowed, belongs to one of them. The missing or malformed address is
+// permitted only if there are no ACLs.
+func PermitsNetwork(acls []*protomfx.NetworkACL, addr string) bool {
+	if len(acls) == 0 {
+		return true
+	}
+
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, acl := range acls {
+		if contains(acl.GetDeny(), ip) {
+			return false
+		}
+
+		if len(acl.GetAllow()) > 0 && !contains(acl.GetAllow(), ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(networks []string, ip net.IP) bool {
+	for _, n := range networks {
+		_, ipnet, err := net.ParseCIDR(n)
+		if err != nil {
+			continue
+		}
+
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NetworkAuthorizer enforces the network ACLs of the things.
+type NetworkAuthorizer interface {
+	// Authorize returns ErrNetworkDenied if the client IP address, carried
+	// by the context, isn't permitted by the network ACLs of the publish
+	// configuration. The rejection is recorded in the network event stream.
+	Authorize(ctx context.Context, pc *protomfx.PubConfByKeyRes, protocol string) error
+}
+
+type networkAuthorizer struct {
+	client *redis.Client
+}
+
+// NewNetworkAuthorizer returns the network authorizer which records the
+// rejections using the provided Redis client. The rejections aren't
+// recorded if the client is nil.
+func NewNetworkAuthorizer(client *redis.Client) NetworkAuthorizer {
+	return networkAuthorizer{client: client}
+}
+
+func (na networkAuthorizer) Authorize(ctx context.Context, pc *protomfx.PubConfByKeyRes, protocol string) error {
+	addr := ClientAddrFromContext(ctx)
+	if PermitsNetwork(pc.GetNetworkACLs(), addr) {
+		return nil
+	}
+
+	if na.client != nil {
+		record := &redis.XAddArgs{
+			Stream:       networkStream,
+			MaxLenApprox: networkStreamLen,
+			Values: map[string]interface{}{
+				"operation": networkDeny,
+				"thing_id":  pc.GetPublisherID(),
+				"org_id":    pc.GetOrgID(),
+				"protocol":  protocol,
+				"address":   addr,
+				"timestamp": strconv.FormatInt(time.Now().Unix(), 10),
+			},
+		}
+		na.client.XAdd(ctx, record).Err()
+	}
+
+	return ErrNetworkDenied
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermitsNetwork(t *testing.T) {
+	allow := &protomfx.NetworkACL{Allow: []string{"10.0.0.0/8", "2001:db8::/32"}}
+	deny := &protomfx.NetworkACL{Deny: []string{"10.1.0.0/16"}}
+	both := &protomfx.NetworkACL{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.2.0.0/16"}}
+
+	cases := []struct {
+		desc    string
+		acls    []*protomfx.NetworkACL
+		addr    string
+		permits bool
+	}{
+		{
+			desc:    "address without acls",
+			acls:    nil,
+			addr:    "192.168.1.1",
+			permits: true,
+		},
+		{
+			desc:    "missing address without acls",
+			acls:    nil,
+			addr:    "",
+			permits: true,
+		},
+		{
+			desc:    "allowed address",
+			acls:    []*protomfx.NetworkACL{allow},
+			addr:    "10.3.2.1",
+			permits: true,
+		},
+		{
+			desc:    "allowed ipv6 address",
+			acls:    []*protomfx.NetworkACL{allow},
+			addr:    "2001:db8::1",
+			permits: true,
+		},
+		{
+			desc:    "address which isn't allowed",
+			acls:    []*protomfx.NetworkACL{allow},
+			addr:    "192.168.1.1",
+			permits: false,
+		},
+		{
+			desc:    "address which isn't denied",
+			acls:    []*protomfx.NetworkACL{deny},
+			addr:    "192.168.1.1",
+			permits: true,
+		},
+		{
+			desc:    "denied address",
+			acls:    []*protomfx.NetworkACL{deny},
+			addr:    "10.1.2.3",
+			permits: false,
+		},
+		{
+			desc:    "allowed and denied address",
+			acls:    []*protomfx.NetworkACL{both},
+			addr:    "10.2.0.1",
+			permits: false,
+		},
+		{
+			desc:    "address allowed by the thing acl and denied by the org acl",
+			acls:    []*protomfx.NetworkACL{allow, deny},
+			addr:    "10.1.0.1",
+			permits: false,
+		},
+		{
+			desc:    "missing address with acls",
+			acls:    []*protomfx.NetworkACL{deny},
+			addr:    "",
+			permits: false,
+		},
+		{
+			desc:    "malformed address with acls",
+			acls:    []*protomfx.NetworkACL{deny},
+			addr:    "invalid",
+			permits: false,
+		},
+	}
+
+	for _, tc := range cases {
+		permits := auth.PermitsNetwork(tc.acls, tc.addr)
+		assert.Equal(t, tc.permits, permits, fmt.Sprintf("%s: expected %t got %t", tc.desc, tc.permits, permits))
+	}
+}
+
+func TestNetworkAuthorize(t *testing.T) {
+	na := auth.NewNetworkAuthorizer(nil)
+	pc := &protomfx.PubConfByKeyRes{PublisherID: thingID, NetworkACLs: []*protomfx.NetworkACL{{Allow: []string{"10.0.0.0/8"}}}}
+
+	cases := []struct {
+		desc string
+		addr string
+		err  error
+	}{
+		{
+			desc: "authorize allowed address",
+			addr: "10.0.0.1",
+			err:  nil,
+		},
+		{
+			desc: "authorize address which isn't allowed",
+			addr: "192.168.1.1",
+			err:  auth.ErrNetworkDenied,
+		},
+		{
+			desc: "authorize missing address",
+			addr: "",
+			err:  auth.ErrNetworkDenied,
+		},
+	}
+
+	for _, tc := range cases {
+		ctx := auth.ContextWithClientAddr(context.Background(), tc.addr)
+		err := na.Authorize(ctx, pc, "http")
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+	}
+}
@@ -6,15 +6,61 @@ package grpc
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/clients"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health"
+	"google.golang.org/grpc/keepalive"
 )
 
+const (
+	minConnectTimeout = 5 * time.Second
+	maxBackoffDelay   = 30 * time.Second
+	keepaliveTime     = 30 * time.Second
+	keepaliveTimeout  = 10 * time.Second
+)
+
+// healthServices are the names the services report their health under, which
+// are checked by the client to route the requests to the serving replicas only.
+var healthServices = map[string]string{
+	clients.Things: "protomfx.ThingsService",
+	clients.Users:  "protomfx.UsersService",
+	clients.Auth:   "protomfx.AuthService",
+}
+
+// Connect returns the client connection to the service. The service URL is
+// resolved using DNS unless it has another scheme, e.g. passthrough:///, and
+// the requests are balanced in a round-robin manner among all of the resolved
+// addresses, such as the replicas behind a Kubernetes headless service. The
+// replicas which fail the health check are skipped, and the broken connections
+// are reestablished with the exponential backoff. The calls are wrapped in
+// the retry and circuit breaker interceptors, so that the unavailable service
+// fails the calls fast instead of holding the callers until the timeout.
 func Connect(cfg clients.Config, logger logger.Logger) *grpc.ClientConn {
-	var opts []grpc.DialOption
+	opts := []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(serviceConfig(cfg.ClientName)),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  backoff.DefaultConfig.BaseDelay,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   maxBackoffDelay,
+			},
+			MinConnectTimeout: minConnectTimeout,
+		}),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepaliveTime,
+			Timeout:             keepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithChainUnaryInterceptor(unaryInterceptors(cfg.ClientName)...),
+	}
+
 	if cfg.ClientTLS {
 		if cfg.CaCerts != "" {
 			tpc, err := credentials.NewClientTLSFromFile(cfg.CaCerts, "")
@@ -25,11 +71,11 @@ func Connect(cfg clients.Config, logger logger.Logger) *grpc.ClientConn {
 			opts = append(opts, grpc.WithTransportCredentials(tpc))
 		}
 	} else {
-		opts = append(opts, grpc.WithInsecure())
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		logger.Info("gRPC communication is not encrypted")
 	}
 
-	conn, err := grpc.Dial(cfg.URL, opts...)
+	conn, err := grpc.NewClient(cfg.URL, opts...)
 	if err != nil {
 		logger.Error(fmt.Sprintf("Failed to connect to %s service: %s", cfg.ClientName, err))
 		os.Exit(1)
@@ -37,3 +83,12 @@ func Connect(cfg clients.Config, logger logger.Logger) *grpc.ClientConn {
 
 	return conn
 }
+
+func serviceConfig(clientName string) string {
+	name, ok := healthServices[clientName]
+	if !ok {
+		return `{"loadBalancingConfig": [{"round_robin": {}}]}`
+	}
+
+	return fmt.Sprintf(`{"loadBalancingConfig": [{"round_robin": {}}], "healthCheckConfig": {"serviceName": %q}}`, name)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MaxAttempts is the number of attempts of the idempotent call, after
+	// which the error of the last attempt is returned.
+	MaxAttempts = 3
+
+	// FailureThreshold is the number of consecutive failed calls which open
+	// the circuit breaker of the client.
+	FailureThreshold = 5
+
+	// OpenTimeout is the time the open circuit breaker rejects the calls
+	// before it lets a single call through to probe the service.
+	OpenTimeout = 10 * time.Second
+
+	// CallTimeout is the deadline of the call which has none.
+	CallTimeout = 10 * time.Second
+
+	retryBaseDelay = 50 * time.Millisecond
+	retryMaxDelay  = time.Second
+)
+
+// idempotentPrefixes are the method name prefixes of the read-only methods,
+// which are safe to retry.
+var idempotentPrefixes = []string{"Get", "Identify", "Authorize", "Retrieve"}
+
+// errCircuitOpen is returned without calling the service while the circuit
+// breaker is open. It has the Unavailable code, so that the callers handle it
+// the same way as the unreachable service.
+var errCircuitOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+var (
+	metricsOnce sync.Once
+	counter     metrics.Counter
+	latency     metrics.Histogram
+)
+
+// unaryInterceptors returns the interceptor chain of the named client. The
+// calls are bounded by the timeout, rejected while the circuit breaker is
+// open, and retried if they are idempotent, while each call is counted and
+// observed as a whole, with all of its attempts.
+func unaryInterceptors(clientName string) []grpc.UnaryClientInterceptor {
+	metricsOnce.Do(func() {
+		counter, latency = newMetrics()
+	})
+
+	cb := &breaker{threshold: FailureThreshold, timeout: OpenTimeout}
+
+	return []grpc.UnaryClientInterceptor{
+		metricsInterceptor(clientName, counter, latency),
+		timeoutInterceptor(CallTimeout),
+		retryInterceptor(MaxAttempts),
+		breakerInterceptor(cb),
+	}
+}
+
+func newMetrics() (metrics.Counter, metrics.Histogram) {
+	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "mainflux",
+		Subsystem: "grpc_client",
+		Name:      "request_count",
+		Help:      "Number of gRPC client calls by status code.",
+	}, []string{"client", "method", "code"})
+
+	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "mainflux",
+		Subsystem: "grpc_client",
+		Name:      "request_latency_seconds",
+		Help:      "Total duration of gRPC client calls in seconds.",
+	}, []string{"client", "method"})
+
+	return counter, latency
+}
+
+func metricsInterceptor(clientName string, counter metrics.Counter, latency metrics.Histogram) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		begin := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		name := methodName(method)
+		counter.With("client", clientName, "method", name, "code", status.Code(err).String()).Add(1)
+		latency.With("client", clientName, "method", name).Observe(time.Since(begin).Seconds())
+
+		return err
+	}
+}
+
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// retryInterceptor retries the idempotent calls which failed because the
+// service was unavailable, with the exponential backoff and full jitter, as
+// long as the call deadline allows.
+func retryInterceptor(attempts int) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !idempotent(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var err error
+		for i := 0; i < attempts; i++ {
+			if i > 0 {
+				timer := time.NewTimer(retryDelay(i))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+			}
+
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if status.Code(err) != codes.Unavailable || err == errCircuitOpen {
+				return err
+			}
+		}
+
+		return err
+	}
+}
+
+func retryDelay(attempt int) time.Duration {
+	d := retryBaseDelay << uint(attempt-1)
+	if d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(d)) + 1)
+}
+
+func breakerInterceptor(cb *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !cb.allow(time.Now()) {
+			return errCircuitOpen
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		cb.record(time.Now(), failure(err))
+
+		return err
+	}
+}
+
+// failure reports whether the error indicates that the service is unhealthy,
+// as opposed to the rejection of the request, e.g. the invalid credentials.
+func failure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+func idempotent(method string) bool {
+	name := methodName(method)
+	for _, p := range idempotentPrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// methodName returns the method name of the full method, e.g. Identify of
+// /protomfx.AuthService/Identify.
+func methodName(method string) string {
+	return method[strings.LastIndex(method, "/")+1:]
+}
+
+// breaker is the circuit breaker, which opens after the threshold of the
+// consecutive failures. Once the timeout passes, the single probe call is let
+// through, which either closes the breaker or opens it again.
+type breaker struct {
+	threshold uint
+	timeout   time.Duration
+
+	mu       sync.Mutex
+	failures uint
+	openedAt time.Time
+	probing  bool
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	if b.probing || now.Sub(b.openedAt) < b.timeout {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+func (b *breaker) record(now time.Time, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	if b.failures < b.threshold {
+		b.failures++
+	}
+	if b.failures >= b.threshold {
+		b.openedAt = now
+	}
+}
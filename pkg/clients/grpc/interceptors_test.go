@@ -0,0 +1,160 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/clients"
+	grpcclient "github.com/MainfluxLabs/mainflux/pkg/clients/grpc"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	getStatsMethod   = "/protomfx.ThingsService/GetStats"
+	assignRoleMethod = "/protomfx.AuthService/AssignRole"
+)
+
+// flakyServer fails the first calls with the provided code.
+type flakyServer struct {
+	mu    sync.Mutex
+	fails int
+	code  codes.Code
+	calls int
+}
+
+func (fs *flakyServer) handle(_ interface{}, stream grpc.ServerStream) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	var req empty.Empty
+	if err := stream.RecvMsg(&req); err != nil {
+		return err
+	}
+
+	fs.calls++
+	if fs.calls <= fs.fails {
+		return status.Error(fs.code, "failed")
+	}
+
+	return stream.SendMsg(&empty.Empty{})
+}
+
+func (fs *flakyServer) count() int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.calls
+}
+
+func newConn(t *testing.T, fs *flakyServer) *grpc.ClientConn {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	server := grpc.NewServer(grpc.UnknownServiceHandler(fs.handle))
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	cfg := clients.Config{
+		ClientName: "test",
+		URL:        fmt.Sprintf("passthrough:///%s", listener.Addr().String()),
+	}
+	conn := grpcclient.Connect(cfg, logger.NewMock())
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestRetry(t *testing.T) {
+	cases := []struct {
+		desc   string
+		method string
+		fails  int
+		code   codes.Code
+		calls  int
+		err    codes.Code
+	}{
+		{
+			desc:   "retry unavailable idempotent call",
+			method: getStatsMethod,
+			fails:  grpcclient.MaxAttempts - 1,
+			code:   codes.Unavailable,
+			calls:  grpcclient.MaxAttempts,
+			err:    codes.OK,
+		},
+		{
+			desc:   "retry unavailable idempotent call until attempts run out",
+			method: getStatsMethod,
+			fails:  grpcclient.MaxAttempts,
+			code:   codes.Unavailable,
+			calls:  grpcclient.MaxAttempts,
+			err:    codes.Unavailable,
+		},
+		{
+			desc:   "don't retry unavailable non-idempotent call",
+			method: assignRoleMethod,
+			fails:  1,
+			code:   codes.Unavailable,
+			calls:  1,
+			err:    codes.Unavailable,
+		},
+		{
+			desc:   "don't retry rejected idempotent call",
+			method: getStatsMethod,
+			fails:  1,
+			code:   codes.Unauthenticated,
+			calls:  1,
+			err:    codes.Unauthenticated,
+		},
+	}
+
+	for _, tc := range cases {
+		fs := &flakyServer{fails: tc.fails, code: tc.code}
+		conn := newConn(t, fs)
+
+		err := conn.Invoke(context.Background(), tc.method, &empty.Empty{}, &empty.Empty{})
+		assert.Equal(t, tc.err, status.Code(err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, status.Code(err)))
+		assert.Equal(t, tc.calls, fs.count(), fmt.Sprintf("%s: expected %d calls got %d", tc.desc, tc.calls, fs.count()))
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	fs := &flakyServer{fails: grpcclient.FailureThreshold, code: codes.Unavailable}
+	conn := newConn(t, fs)
+
+	for i := 0; i < grpcclient.FailureThreshold; i++ {
+		err := conn.Invoke(context.Background(), assignRoleMethod, &empty.Empty{}, &empty.Empty{})
+		assert.Equal(t, codes.Unavailable, status.Code(err), fmt.Sprintf("expected %s got %s", codes.Unavailable, status.Code(err)))
+	}
+
+	cases := []struct {
+		desc   string
+		method string
+	}{
+		{
+			desc:   "reject non-idempotent call while circuit breaker is open",
+			method: assignRoleMethod,
+		},
+		{
+			desc:   "reject idempotent call while circuit breaker is open",
+			method: getStatsMethod,
+		},
+	}
+
+	for _, tc := range cases {
+		err := conn.Invoke(context.Background(), tc.method, &empty.Empty{}, &empty.Empty{})
+		assert.Equal(t, codes.Unavailable, status.Code(err), fmt.Sprintf("%s: expected %s got %s", tc.desc, codes.Unavailable, status.Code(err)))
+		assert.Equal(t, grpcclient.FailureThreshold, fs.count(), fmt.Sprintf("%s: expected %d calls got %d", tc.desc, grpcclient.FailureThreshold, fs.count()))
+	}
+}
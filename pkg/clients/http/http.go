@@ -5,7 +5,10 @@ package http
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+	"net/url"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 )
@@ -18,9 +21,70 @@ const (
 var (
 	httpClient     = &http.Client{}
 	ErrSendRequest = errors.New("failed to send request")
+
+	// ErrClientConfig indicates an invalid HTTP client TLS or proxy configuration.
+	ErrClientConfig = errors.New("invalid http client configuration")
 )
 
+// ClientConfig represents the TLS and proxy settings of an HTTP client.
+// Certificates and keys are PEM encoded.
+type ClientConfig struct {
+	ClientCert string
+	ClientKey  string
+	CACert     string
+	ProxyURL   string
+}
+
+// Empty reports whether the default HTTP client settings are used.
+func (cfg ClientConfig) Empty() bool {
+	return cfg == ClientConfig{}
+}
+
+// NewClient returns HTTP client presenting the configured client certificate,
+// trusting the configured CA certificates in addition to the system ones and
+// sending the requests through the configured proxy.
+func NewClient(cfg ClientConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, errors.Wrap(ErrClientConfig, err)
+		}
+		transport.TLSClientConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+
+	if cfg.CACert != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cfg.CACert)) {
+			return nil, errors.Wrap(ErrClientConfig, errors.New("failed to parse CA certificates"))
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, errors.Wrap(ErrClientConfig, errors.New("invalid proxy url"))
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
 func SendRequest(method, path string, body []byte, headers map[string]string) (*http.Response, error) {
+	return SendRequestWithClient(httpClient, method, path, body, headers)
+}
+
+// SendRequestWithClient sends the request using the given HTTP client.
+func SendRequestWithClient(client *http.Client, method, path string, body []byte, headers map[string]string) (*http.Response, error) {
 	req, err := http.NewRequest(method, path, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
@@ -36,7 +100,7 @@ func SendRequest(method, path string, body []byte, headers map[string]string) (*
 		req.Header.Set(contentType, ctJSON)
 	}
 
-	response, err := httpClient.Do(req)
+	response, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,176 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package config loads service configuration from YAML and TOML files.
+//
+// Configuration files map onto the environment variables the services are
+// already configured with, so that either nested sections or flat keys can
+// be used interchangeably:
+//
+//	mf:
+//	  things:
+//	    log_level: debug
+//	    db:
+//	      host: postgres
+//	MF_THINGS_HTTP_PORT: 8182
+//
+// sets MF_THINGS_LOG_LEVEL, MF_THINGS_DB_HOST and MF_THINGS_HTTP_PORT.
+// Variables set in the environment take precedence over the file.
+// Secrets can be read from files or Vault, see LoadSecrets.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/pelletier/go-toml"
+	"gopkg.in/yaml.v3"
+)
+
+const keyPrefix = "MF_"
+
+var (
+	// ErrUnsupportedFormat indicates an unsupported configuration file extension.
+	ErrUnsupportedFormat = errors.New("unsupported configuration file format")
+
+	// ErrInvalidKey indicates a configuration key which does not map to an MF_ variable.
+	ErrInvalidKey = errors.New("invalid configuration key")
+
+	// ErrInvalidValue indicates a configuration value which is not a scalar or a list of scalars.
+	ErrInvalidValue = errors.New("invalid configuration value")
+
+	// ErrReadFile indicates failure to read or parse the configuration file.
+	ErrReadFile = errors.New("failed to read configuration file")
+)
+
+var (
+	mu sync.Mutex
+	// fromFile contains the variables which were set from the configuration
+	// file, as opposed to those set in the environment by the operator.
+	fromFile = map[string]bool{}
+)
+
+// Load reads the configuration file and sets the environment variables it
+// defines, unless they are already set in the environment, and resolves
+// secrets as described in LoadSecrets. An empty path skips the file.
+func Load(path string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if path == "" {
+		return LoadSecrets()
+	}
+
+	vars, err := Parse(path)
+	if err != nil {
+		return err
+	}
+
+	for key := range fromFile {
+		if _, ok := vars[key]; !ok {
+			os.Unsetenv(key)
+			delete(fromFile, key)
+		}
+	}
+
+	for key, val := range vars {
+		if _, ok := os.LookupEnv(key); ok && !fromFile[key] {
+			continue
+		}
+		if err := os.Setenv(key, val); err != nil {
+			return errors.Wrap(ErrReadFile, err)
+		}
+		fromFile[key] = true
+	}
+
+	return LoadSecrets()
+}
+
+// Parse reads the configuration file and returns the environment variables
+// it defines. The file format is determined by the file extension.
+func Parse(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(ErrReadFile, err)
+	}
+
+	var doc map[string]interface{}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, errors.Wrap(ErrReadFile, fmt.Errorf("%s: %w", path, err))
+		}
+	case ".toml":
+		tree, err := toml.LoadBytes(data)
+		if err != nil {
+			return nil, errors.Wrap(ErrReadFile, fmt.Errorf("%s: %w", path, err))
+		}
+		doc = tree.ToMap()
+	default:
+		return nil, errors.Wrap(ErrUnsupportedFormat, fmt.Errorf("%s", path))
+	}
+
+	vars := map[string]string{}
+	if err := flatten("", doc, vars); err != nil {
+		return nil, err
+	}
+
+	return vars, nil
+}
+
+func flatten(prefix string, doc map[string]interface{}, vars map[string]string) error {
+	keys := make([]string, 0, len(doc))
+	for k := range doc {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		key := strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(k))
+		if prefix != "" {
+			key = prefix + "_" + key
+		}
+
+		switch v := doc[k].(type) {
+		case map[string]interface{}:
+			if err := flatten(key, v, vars); err != nil {
+				return err
+			}
+		default:
+			if !strings.HasPrefix(key, keyPrefix) {
+				return errors.Wrap(ErrInvalidKey, fmt.Errorf("%s does not map to an %s variable", key, keyPrefix))
+			}
+			val, err := format(v)
+			if err != nil {
+				return errors.Wrap(ErrInvalidValue, fmt.Errorf("%s: %w", key, err))
+			}
+			vars[key] = val
+		}
+	}
+
+	return nil
+}
+
+func format(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				return "", fmt.Errorf("lists may only contain scalar values")
+			}
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte(content), 0600)
+	require.Nil(t, err, fmt.Sprintf("unexpected error writing file: %s", err))
+	return path
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		desc    string
+		name    string
+		content string
+		vars    map[string]string
+		err     error
+	}{
+		{
+			desc:    "parse nested YAML file",
+			name:    "config.yaml",
+			content: "mf:\n  things:\n    log_level: debug\n    db:\n      host: postgres\n",
+			vars:    map[string]string{"MF_THINGS_LOG_LEVEL": "debug", "MF_THINGS_DB_HOST": "postgres"},
+		},
+		{
+			desc:    "parse flat YAML file",
+			name:    "config.yml",
+			content: "MF_THINGS_HTTP_PORT: 8182\nMF_HTTP_CORS_ALLOWED_ORIGINS: [a.com, b.com]\n",
+			vars:    map[string]string{"MF_THINGS_HTTP_PORT": "8182", "MF_HTTP_CORS_ALLOWED_ORIGINS": "a.com,b.com"},
+		},
+		{
+			desc:    "parse TOML file",
+			name:    "config.toml",
+			content: "[mf.users]\nlog_level = \"info\"\nhttp_port = 8180\n",
+			vars:    map[string]string{"MF_USERS_LOG_LEVEL": "info", "MF_USERS_HTTP_PORT": "8180"},
+		},
+		{
+			desc:    "parse file with unsupported format",
+			name:    "config.json",
+			content: "{}",
+			err:     config.ErrUnsupportedFormat,
+		},
+		{
+			desc:    "parse file with invalid key",
+			name:    "config.yaml",
+			content: "things:\n  log_level: debug\n",
+			err:     config.ErrInvalidKey,
+		},
+		{
+			desc:    "parse file with invalid value",
+			name:    "config.yaml",
+			content: "mf_things_ports:\n  - a: 1\n",
+			err:     config.ErrInvalidValue,
+		},
+		{
+			desc:    "parse malformed file",
+			name:    "config.yaml",
+			content: "mf: [",
+			err:     config.ErrReadFile,
+		},
+	}
+
+	for _, tc := range cases {
+		vars, err := config.Parse(writeFile(t, tc.name, tc.content))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, tc.vars, vars, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.vars, vars))
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("MF_TEST_ENV", "env")
+	os.Unsetenv("MF_TEST_OTHER")
+	t.Cleanup(func() { os.Unsetenv("MF_TEST_OTHER") })
+
+	path := writeFile(t, "config.yaml", "mf:\n  test:\n    env: file\n    other: file\n")
+	err := config.Load(path)
+	assert.Nil(t, err, fmt.Sprintf("load configuration: unexpected error %s", err))
+
+	cases := map[string]string{
+		"MF_TEST_ENV":   "env",
+		"MF_TEST_OTHER": "file",
+	}
+	for key, expected := range cases {
+		val := os.Getenv(key)
+		assert.Equal(t, expected, val, fmt.Sprintf("%s: expected %s got %s", key, expected, val))
+	}
+
+	err = os.WriteFile(path, []byte("mf:\n  test:\n    env: file\n"), 0600)
+	require.Nil(t, err, fmt.Sprintf("unexpected error writing file: %s", err))
+	err = config.Load(path)
+	assert.Nil(t, err, fmt.Sprintf("reload configuration: unexpected error %s", err))
+
+	_, ok := os.LookupEnv("MF_TEST_OTHER")
+	assert.False(t, ok, "reload configuration: expected variable removed from the file to be unset")
+}
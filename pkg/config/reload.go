@@ -0,0 +1,88 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+)
+
+// Tunable applies a configuration value which can be changed at runtime.
+type Tunable func() error
+
+// LogLevel returns tunable which applies the log level read from the given
+// variable and the log level overrides read from the overrides variable.
+func LogLevel(l logger.Logger, levelKey, defLevel, overridesKey, defOverrides string) Tunable {
+	return func() error {
+		if err := l.SetLevel(mainflux.Env(levelKey, defLevel)); err != nil {
+			return fmt.Errorf("invalid %s value: %w", levelKey, err)
+		}
+		if err := l.SetLevelOverrides(mainflux.Env(overridesKey, defOverrides)); err != nil {
+			return fmt.Errorf("invalid %s value: %w", overridesKey, err)
+		}
+
+		return nil
+	}
+}
+
+// RateLimit returns tunable which applies the HTTP rate limit read from the
+// given rate and burst variables.
+func RateLimit(l servershttp.Limiter, rateKey, defRate, burstKey, defBurst string) Tunable {
+	return func() error {
+		rate, err := strconv.ParseFloat(mainflux.Env(rateKey, defRate), 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s value: %w", rateKey, err)
+		}
+		burst, err := strconv.Atoi(mainflux.Env(burstKey, defBurst))
+		if err != nil {
+			return fmt.Errorf("invalid %s value: %w", burstKey, err)
+		}
+
+		l.Update(servers.RateLimitConfig{Rate: rate, Burst: burst})
+		return nil
+	}
+}
+
+// RequestTimeout returns tunable which applies the HTTP request timeout.
+func RequestTimeout(rt *servers.RequestTimeout) Tunable {
+	return func() error {
+		return servers.ReloadRequestTimeout(rt)
+	}
+}
+
+// Reload reloads the configuration file and applies the tunables whenever
+// the process receives SIGHUP, until the context is canceled. Failing
+// tunables are logged and do not affect the others.
+func Reload(ctx context.Context, path string, logger logger.Logger, tunables ...Tunable) error {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	defer signal.Stop(c)
+
+	for {
+		select {
+		case <-c:
+			if err := Load(path); err != nil {
+				logger.Error(fmt.Sprintf("Failed to reload configuration file %s: %s", path, err))
+				continue
+			}
+			for _, t := range tunables {
+				if err := t(); err != nil {
+					logger.Error(fmt.Sprintf("Failed to apply reloaded configuration: %s", err))
+				}
+			}
+			logger.Info(fmt.Sprintf("Configuration reloaded from %s", path))
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
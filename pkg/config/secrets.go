@@ -0,0 +1,196 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	fileSuffix   = "_FILE"
+	vaultPrefix  = "vault:"
+	vaultTimeout = 10 * time.Second
+
+	envConfigFile = "MF_CONFIG_FILE"
+	envVaultAddr  = "MF_SECRETS_VAULT_ADDR"
+	envVaultToken = "MF_SECRETS_VAULT_TOKEN"
+	envVaultMount = "MF_SECRETS_VAULT_MOUNT"
+	defVaultMount = "secret"
+)
+
+var (
+	// ErrReadSecret indicates failure to read a secret from a file or Vault.
+	ErrReadSecret = errors.New("failed to read secret")
+
+	// ErrInvalidSecretRef indicates a malformed Vault secret reference.
+	ErrInvalidSecretRef = errors.New("invalid vault secret reference")
+)
+
+var (
+	secretsMu sync.Mutex
+	// fileSecrets contains the values of the variables read from the secret
+	// files, so that the files are read again when the secrets are reloaded.
+	fileSecrets = map[string]string{}
+	// vaultSecrets contains the Vault references of the variables resolved
+	// from Vault, so that they're fetched again when the secrets are reloaded.
+	vaultSecrets = map[string]vaultSecret{}
+)
+
+type vaultSecret struct {
+	ref string
+	val string
+}
+
+// LoadSecrets resolves secrets of the MF_ variables set in the environment.
+//
+// A variable VAR is read from the file at the path set in VAR_FILE, unless
+// VAR is set itself. Afterwards, every variable with a value of the form
+// vault:<path>#<key> is replaced with the key of the KV v2 secret at the
+// given path, fetched from the Vault server set in MF_SECRETS_VAULT_ADDR
+// using the token set in MF_SECRETS_VAULT_TOKEN. The secrets engine mount
+// path defaults to "secret" and is set in MF_SECRETS_VAULT_MOUNT.
+//
+// The secrets resolved by the previous call are read again, e.g. when the
+// configuration is reloaded, unless their variables were set to another
+// value in the meantime.
+func LoadSecrets() error {
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	if err := loadFileSecrets(); err != nil {
+		return err
+	}
+
+	return loadVaultSecrets()
+}
+
+func loadFileSecrets() error {
+	seen := map[string]bool{}
+	for _, kv := range os.Environ() {
+		key, path, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(key, keyPrefix) || !strings.HasSuffix(key, fileSuffix) || key == envConfigFile {
+			continue
+		}
+
+		target := strings.TrimSuffix(key, fileSuffix)
+		seen[target] = true
+		if val, ok := os.LookupEnv(target); ok && !ownSecret(fileSecrets, target, val) || path == "" {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return errors.Wrap(ErrReadSecret, fmt.Errorf("%s: %w", key, err))
+		}
+		val := strings.TrimRight(string(data), "\r\n")
+		if err := os.Setenv(target, val); err != nil {
+			return errors.Wrap(ErrReadSecret, err)
+		}
+		fileSecrets[target] = val
+	}
+
+	// The variables whose secret file is no longer set are unset, unless
+	// they were set to another value.
+	for target, val := range fileSecrets {
+		if seen[target] {
+			continue
+		}
+		if cur, ok := os.LookupEnv(target); ok && cur == val {
+			os.Unsetenv(target)
+		}
+		delete(fileSecrets, target)
+	}
+
+	return nil
+}
+
+// ownSecret reports whether the variable still holds the value of the
+// secret it was resolved from. The variable set to another value is no
+// longer tracked.
+func ownSecret(secrets map[string]string, key, val string) bool {
+	sval, ok := secrets[key]
+	if ok && sval != val {
+		delete(secrets, key)
+		return false
+	}
+
+	return ok
+}
+
+func loadVaultSecrets() error {
+	refs := map[string]string{}
+	for _, kv := range os.Environ() {
+		key, val, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+
+		switch s, ok := vaultSecrets[key]; {
+		case strings.HasPrefix(val, vaultPrefix):
+			refs[key] = strings.TrimPrefix(val, vaultPrefix)
+		case ok && s.val == val:
+			refs[key] = s.ref
+		case ok:
+			// The variable was set to another value.
+			delete(vaultSecrets, key)
+		}
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	client, err := api.NewClient(&api.Config{Address: os.Getenv(envVaultAddr)})
+	if err != nil {
+		return errors.Wrap(ErrReadSecret, err)
+	}
+	if token := os.Getenv(envVaultToken); token != "" {
+		client.SetToken(token)
+	}
+
+	mount := os.Getenv(envVaultMount)
+	if mount == "" {
+		mount = defVaultMount
+	}
+	kv := client.KVv2(mount)
+
+	ctx, cancel := context.WithTimeout(context.Background(), vaultTimeout)
+	defer cancel()
+
+	secrets := map[string]map[string]interface{}{}
+	for key, ref := range refs {
+		path, field, ok := strings.Cut(ref, "#")
+		if !ok || path == "" || field == "" {
+			return errors.Wrap(ErrInvalidSecretRef, fmt.Errorf("%s: expected %s<path>#<key>", key, vaultPrefix))
+		}
+
+		data, ok := secrets[path]
+		if !ok {
+			secret, err := kv.Get(ctx, path)
+			if err != nil {
+				return errors.Wrap(ErrReadSecret, fmt.Errorf("%s: %w", key, err))
+			}
+			data = secret.Data
+			secrets[path] = data
+		}
+
+		val, ok := data[field]
+		if !ok {
+			return errors.Wrap(ErrReadSecret, fmt.Errorf("%s: key %s not found in %s", key, field, path))
+		}
+		if err := os.Setenv(key, fmt.Sprint(val)); err != nil {
+			return errors.Wrap(ErrReadSecret, err)
+		}
+		vaultSecrets[key] = vaultSecret{ref: ref, val: fmt.Sprint(val)}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/config"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const vaultToken = "vault-token"
+
+func newVaultServer() *httptest.Server {
+	return newVaultServerWithSecret(func() string { return "vault-pass" })
+}
+
+func newVaultServerWithSecret(secret func() string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != vaultToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/mainflux/db" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":{"data":{"password":"%s"},"metadata":{"version":1,"created_time":"2024-01-01T00:00:00Z","deletion_time":"","destroyed":false,"custom_metadata":null}}}`, secret())
+	}))
+}
+
+func TestLoadSecrets(t *testing.T) {
+	vault := newVaultServer()
+	defer vault.Close()
+
+	secretFile := writeFile(t, "secret", "file-pass\n")
+
+	cases := []struct {
+		desc string
+		env  map[string]string
+		key  string
+		val  string
+		err  error
+	}{
+		{
+			desc: "load secret from file",
+			env:  map[string]string{"MF_TEST_DB_PASS_FILE": secretFile},
+			key:  "MF_TEST_DB_PASS",
+			val:  "file-pass",
+		},
+		{
+			desc: "load secret from file with variable set",
+			env:  map[string]string{"MF_TEST_DB_PASS_FILE": secretFile, "MF_TEST_DB_PASS": "env-pass"},
+			key:  "MF_TEST_DB_PASS",
+			val:  "env-pass",
+		},
+		{
+			desc: "load secret from non-existing file",
+			env:  map[string]string{"MF_TEST_DB_PASS_FILE": secretFile + ".missing"},
+			err:  config.ErrReadSecret,
+		},
+		{
+			desc: "load secret from vault",
+			env: map[string]string{
+				"MF_SECRETS_VAULT_ADDR":  vault.URL,
+				"MF_SECRETS_VAULT_TOKEN": vaultToken,
+				"MF_TEST_DB_PASS":        "vault:mainflux/db#password",
+			},
+			key: "MF_TEST_DB_PASS",
+			val: "vault-pass",
+		},
+		{
+			desc: "load secret from vault with token from file",
+			env: map[string]string{
+				"MF_SECRETS_VAULT_ADDR":       vault.URL,
+				"MF_SECRETS_VAULT_TOKEN_FILE": writeFile(t, "token", vaultToken),
+				"MF_TEST_DB_PASS":             "vault:mainflux/db#password",
+			},
+			key: "MF_TEST_DB_PASS",
+			val: "vault-pass",
+		},
+		{
+			desc: "load secret from vault with invalid token",
+			env: map[string]string{
+				"MF_SECRETS_VAULT_ADDR":  vault.URL,
+				"MF_SECRETS_VAULT_TOKEN": "invalid",
+				"MF_TEST_DB_PASS":        "vault:mainflux/db#password",
+			},
+			err: config.ErrReadSecret,
+		},
+		{
+			desc: "load secret from vault with missing key",
+			env: map[string]string{
+				"MF_SECRETS_VAULT_ADDR":  vault.URL,
+				"MF_SECRETS_VAULT_TOKEN": vaultToken,
+				"MF_TEST_DB_PASS":        "vault:mainflux/db#user",
+			},
+			err: config.ErrReadSecret,
+		},
+		{
+			desc: "load secret from vault with invalid reference",
+			env: map[string]string{
+				"MF_SECRETS_VAULT_ADDR":  vault.URL,
+				"MF_SECRETS_VAULT_TOKEN": vaultToken,
+				"MF_TEST_DB_PASS":        "vault:mainflux/db",
+			},
+			err: config.ErrInvalidSecretRef,
+		},
+	}
+
+	for _, tc := range cases {
+		keys := []string{"MF_TEST_DB_PASS", "MF_TEST_DB_PASS_FILE", "MF_SECRETS_VAULT_ADDR", "MF_SECRETS_VAULT_TOKEN", "MF_SECRETS_VAULT_TOKEN_FILE"}
+		for _, key := range keys {
+			os.Unsetenv(key)
+		}
+		for key, val := range tc.env {
+			os.Setenv(key, val)
+		}
+
+		err := config.LoadSecrets()
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		if tc.err == nil {
+			val := os.Getenv(tc.key)
+			assert.Equal(t, tc.val, val, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.val, val))
+		}
+
+		for _, key := range keys {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+func TestReloadSecrets(t *testing.T) {
+	vaultPass := "vault-pass"
+	vault := newVaultServerWithSecret(func() string { return vaultPass })
+	defer vault.Close()
+
+	secretFile := writeFile(t, "secret", "file-pass\n")
+
+	env := map[string]string{
+		"MF_SECRETS_VAULT_ADDR":  vault.URL,
+		"MF_SECRETS_VAULT_TOKEN": vaultToken,
+		"MF_TEST_DB_PASS_FILE":   secretFile,
+		"MF_TEST_VAULT_PASS":     "vault:mainflux/db#password",
+	}
+	for key, val := range env {
+		os.Setenv(key, val)
+	}
+	defer func() {
+		for _, key := range []string{"MF_SECRETS_VAULT_ADDR", "MF_SECRETS_VAULT_TOKEN", "MF_TEST_DB_PASS_FILE", "MF_TEST_DB_PASS", "MF_TEST_VAULT_PASS"} {
+			os.Unsetenv(key)
+		}
+	}()
+
+	err := config.LoadSecrets()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		update func()
+		env    map[string]string
+	}{
+		{
+			desc:   "reload unchanged secrets",
+			update: func() {},
+			env:    map[string]string{"MF_TEST_DB_PASS": "file-pass", "MF_TEST_VAULT_PASS": "vault-pass"},
+		},
+		{
+			desc: "reload rotated secrets",
+			update: func() {
+				err := os.WriteFile(secretFile, []byte("rotated-file-pass\n"), 0600)
+				require.Nil(t, err, fmt.Sprintf("unexpected error writing file: %s", err))
+				vaultPass = "rotated-vault-pass"
+			},
+			env: map[string]string{"MF_TEST_DB_PASS": "rotated-file-pass", "MF_TEST_VAULT_PASS": "rotated-vault-pass"},
+		},
+		{
+			desc: "reload secrets of variables set to other values",
+			update: func() {
+				os.Setenv("MF_TEST_DB_PASS", "env-pass")
+				os.Setenv("MF_TEST_VAULT_PASS", "env-vault-pass")
+				vaultPass = "vault-pass"
+			},
+			env: map[string]string{"MF_TEST_DB_PASS": "env-pass", "MF_TEST_VAULT_PASS": "env-vault-pass"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc.update()
+
+		err := config.LoadSecrets()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		for key, val := range tc.env {
+			assert.Equal(t, val, os.Getenv(key), fmt.Sprintf("%s: expected %s got %s", tc.desc, val, os.Getenv(key)))
+		}
+	}
+}
+
+func TestReloadSecretsWithoutFile(t *testing.T) {
+	os.Setenv("MF_TEST_DB_PASS_FILE", writeFile(t, "secret", "file-pass"))
+	defer os.Unsetenv("MF_TEST_DB_PASS")
+
+	err := config.LoadSecrets()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "file-pass", os.Getenv("MF_TEST_DB_PASS"), fmt.Sprintf("expected file-pass got %s", os.Getenv("MF_TEST_DB_PASS")))
+
+	// The variable whose secret file is no longer set is unset.
+	os.Unsetenv("MF_TEST_DB_PASS_FILE")
+	err = config.LoadSecrets()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, ok := os.LookupEnv("MF_TEST_DB_PASS")
+	assert.False(t, ok, "expected variable without secret file to be unset")
+}
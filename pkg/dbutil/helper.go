@@ -6,19 +6,70 @@ import (
 	"strings"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	migrate "github.com/rubenv/sql-migrate"
 )
 
 var errCreateMetadataQuery = errors.New("failed to create query for metadata")
 
-func GetNameQuery(name string) (string, string) {
-	if name == "" {
+// SearchMigration returns the migration creating the f_unaccent function, used
+// to search and order by names regardless of case and diacritics, and the trigram
+// indexes supporting the search of the given columns, formatted as table.column.
+// The unaccent function itself can't be used in indexes, since it's not immutable.
+func SearchMigration(id string, columns ...string) *migrate.Migration {
+	up := []string{
+		`CREATE EXTENSION IF NOT EXISTS unaccent`,
+		`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+		`CREATE OR REPLACE FUNCTION f_unaccent(text) RETURNS text
+			LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+			AS $$ SELECT public.unaccent('public.unaccent', $1) $$`,
+	}
+	var down []string
+
+	for _, c := range columns {
+		table, column, _ := strings.Cut(c, ".")
+		idx := fmt.Sprintf("%s_%s_search_idx", table, column)
+		up = append(up, fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING gin (%s gin_trgm_ops)", idx, table, SearchExpr(column)))
+		down = append(down, fmt.Sprintf("DROP INDEX IF EXISTS %s", idx))
+	}
+	down = append(down, `DROP FUNCTION IF EXISTS f_unaccent(text)`)
+
+	return &migrate.Migration{Id: id, Up: up, Down: down}
+}
+
+// SearchExpr returns the expression the column is searched and ordered by.
+// The trigram indexes supporting the search are created on this expression.
+func SearchExpr(column string) string {
+	return fmt.Sprintf("f_unaccent(LOWER(%s))", column)
+}
+
+// GetSearchQuery returns the query matching the column values which contain
+// the given value, regardless of case and diacritics, and the query parameter.
+func GetSearchQuery(column, value string) (string, string) {
+	if value == "" {
 		return "", ""
 	}
 
-	name = fmt.Sprintf(`%%%s%%`, strings.ToLower(name))
-	nq := `LOWER(name) LIKE :name`
+	param := fmt.Sprintf(`%%%s%%`, escapeLike(strings.ToLower(value)))
+	q := fmt.Sprintf("%s LIKE f_unaccent(:%s)", SearchExpr(column), column)
 
-	return nq, name
+	return q, param
+}
+
+// GetPrefixQuery returns the query matching the column values which start
+// with the given value, and the query parameter.
+func GetPrefixQuery(column, value string) (string, string) {
+	if value == "" {
+		return "", ""
+	}
+
+	param := fmt.Sprintf(`%s%%`, escapeLike(value))
+	q := fmt.Sprintf("%s LIKE :%s", column, column)
+
+	return q, param
+}
+
+func GetNameQuery(name string) (string, string) {
+	return GetSearchQuery("name", name)
 }
 
 func GetOwnerQuery(ownerID string) string {
@@ -48,7 +99,7 @@ func GetMetadataQuery(db string, m map[string]interface{}) (mb []byte, mq string
 func GetOrderQuery(order string) string {
 	switch order {
 	case "name":
-		return "name"
+		return SearchExpr("name")
 	default:
 		return "id"
 	}
@@ -62,6 +113,7 @@ func GetDirQuery(dir string) string {
 		return "DESC"
 	}
 }
+
 func GetOffsetLimitQuery(limit uint64) string {
 	if limit != 0 {
 		return "LIMIT :limit OFFSET :offset"
@@ -69,3 +121,8 @@ func GetOffsetLimitQuery(limit uint64) string {
 
 	return ""
 }
+
+// escapeLike escapes the LIKE pattern characters, so that the value is matched literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package dbutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// ErrMalformedOrgDatabase indicates the malformed org to database mapping.
+var ErrMalformedOrgDatabase = errors.New("malformed org database mapping")
+
+// ParseOrgDatabases parses the comma separated list of org ID and database
+// name pairs, formatted as <org_id>=<database>, into a map of database names
+// by org IDs.
+func ParseOrgDatabases(s string) (map[string]string, error) {
+	dbs := make(map[string]string)
+	if s == "" {
+		return dbs, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		orgID, db, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || orgID == "" || db == "" {
+			return nil, errors.Wrap(ErrMalformedOrgDatabase, fmt.Errorf("%q", pair))
+		}
+		dbs[orgID] = db
+	}
+
+	return dbs, nil
+}
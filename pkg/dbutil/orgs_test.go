@@ -0,0 +1,59 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package dbutil_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrgDatabases(t *testing.T) {
+	cases := []struct {
+		desc string
+		dbs  string
+		res  map[string]string
+		err  error
+	}{
+		{
+			desc: "parse org databases",
+			dbs:  "org1=messages_org1, org2=messages_org2",
+			res:  map[string]string{"org1": "messages_org1", "org2": "messages_org2"},
+			err:  nil,
+		},
+		{
+			desc: "parse empty org databases",
+			dbs:  "",
+			res:  map[string]string{},
+			err:  nil,
+		},
+		{
+			desc: "parse org without database",
+			dbs:  "org1=",
+			res:  nil,
+			err:  dbutil.ErrMalformedOrgDatabase,
+		},
+		{
+			desc: "parse database without org",
+			dbs:  "=messages_org1",
+			res:  nil,
+			err:  dbutil.ErrMalformedOrgDatabase,
+		},
+		{
+			desc: "parse org database without separator",
+			dbs:  "org1",
+			res:  nil,
+			err:  dbutil.ErrMalformedOrgDatabase,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := dbutil.ParseOrgDatabases(tc.dbs)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.res, res))
+	}
+}
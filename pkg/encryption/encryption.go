@@ -0,0 +1,79 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package encryption contains the cipher used by the services to encrypt
+// the sensitive values, such as secrets and private keys, at rest.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var (
+	// ErrEncrypt indicates failure to encrypt the value.
+	ErrEncrypt = errors.New("failed to encrypt value")
+
+	// ErrDecrypt indicates failure to decrypt the value, e.g. if it was
+	// encrypted using another key, or bound to other data.
+	ErrDecrypt = errors.New("failed to decrypt value")
+)
+
+// Cipher encrypts and decrypts the values bound to the additional data, such
+// as the ID of the owner of the value, so that the encrypted value can't be
+// moved to another owner.
+type Cipher interface {
+	// Encrypt encrypts the value, prepending the random nonce to it.
+	Encrypt(value, data []byte) ([]byte, error)
+
+	// Decrypt decrypts the value encrypted by Encrypt.
+	Decrypt(value, data []byte) ([]byte, error)
+}
+
+type aesCipher struct {
+	aead cipher.AEAD
+}
+
+// New returns the AES-GCM cipher using the SHA-256 hash of the provided key.
+func New(key string) (Cipher, error) {
+	k := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return aesCipher{aead: aead}, nil
+}
+
+func (c aesCipher) Encrypt(value, data []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(ErrEncrypt, err)
+	}
+
+	return c.aead.Seal(nonce, nonce, value, data), nil
+}
+
+func (c aesCipher) Decrypt(value, data []byte) ([]byte, error) {
+	ns := c.aead.NonceSize()
+	if len(value) < ns {
+		return nil, ErrDecrypt
+	}
+
+	plain, err := c.aead.Open(nil, value[:ns], value[ns:], data)
+	if err != nil {
+		return nil, errors.Wrap(ErrDecrypt, err)
+	}
+
+	return plain, nil
+}
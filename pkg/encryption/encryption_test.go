@@ -0,0 +1,74 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/encryption"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecrypt(t *testing.T) {
+	c, err := encryption.New("key")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	other, err := encryption.New("other-key")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	value := []byte("value")
+	data := []byte("owner")
+	enc, err := c.Encrypt(value, data)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		cipher encryption.Cipher
+		value  []byte
+		data   []byte
+		res    []byte
+		err    error
+	}{
+		{
+			desc:   "decrypt value",
+			cipher: c,
+			value:  enc,
+			data:   data,
+			res:    value,
+			err:    nil,
+		},
+		{
+			desc:   "decrypt value with other key",
+			cipher: other,
+			value:  enc,
+			data:   data,
+			res:    nil,
+			err:    encryption.ErrDecrypt,
+		},
+		{
+			desc:   "decrypt value bound to other data",
+			cipher: c,
+			value:  enc,
+			data:   []byte("other-owner"),
+			res:    nil,
+			err:    encryption.ErrDecrypt,
+		},
+		{
+			desc:   "decrypt truncated value",
+			cipher: c,
+			value:  enc[:4],
+			data:   data,
+			res:    nil,
+			err:    encryption.ErrDecrypt,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := tc.cipher.Decrypt(tc.value, tc.data)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.res, res))
+	}
+}
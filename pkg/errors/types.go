@@ -19,6 +19,9 @@ var (
 	// ErrConflict indicates that entity already exists.
 	ErrConflict = New("entity already exists")
 
+	// ErrQuotaExceeded indicates that the org can't have more entities of the kind.
+	ErrQuotaExceeded = New("quota exceeded")
+
 	// ErrCreateEntity indicates error in creating entity or entities.
 	ErrCreateEntity = New("failed to create entity in the db")
 
@@ -0,0 +1,261 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package events contains the event store consumer shared by the services
+// which react to the events of the other services.
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// ClaimInterval is the time after which the unacknowledged events are
+	// reclaimed and handled again.
+	ClaimInterval = 30 * time.Second
+
+	// MaxDeliveries is the number of the failed deliveries of the event,
+	// after which the event is dropped.
+	MaxDeliveries = 10
+
+	batchSize    = 100
+	exists       = "BUSYGROUP Consumer Group name already exists"
+	requestIDKey = "request_id"
+)
+
+// Event represents the event read from the event store stream.
+type Event struct {
+	Stream     string
+	ID         string
+	Operation  string
+	OccurredAt time.Time
+	Values     map[string]interface{}
+}
+
+// Read returns the string value of the event field, or the empty string if
+// the field is missing.
+func (e Event) Read(key string) string {
+	val, _ := e.Values[key].(string)
+	return val
+}
+
+// Encoder represents the event which is published to the event store.
+type Encoder interface {
+	// Encode returns the event field values.
+	Encode() map[string]interface{}
+}
+
+// Encode encodes the event, adding the ID of the request which caused it,
+// if any, so that the event can be correlated with the request logs.
+func Encode(ctx context.Context, e Encoder) map[string]interface{} {
+	val := e.Encode()
+	if id := logger.RequestIDFromContext(ctx); id != "" {
+		val[requestIDKey] = id
+	}
+
+	return val
+}
+
+// Handler handles the event. The event is acknowledged only if it's handled
+// successfully.
+type Handler func(ctx context.Context, event Event) error
+
+// Subscriber represents the event store consumer group member.
+type Subscriber interface {
+	// Subscribe handles the events of the streams until the context is
+	// canceled. The events which failed are handled again after the
+	// ClaimInterval, by any member of the consumer group, up to
+	// MaxDeliveries times.
+	Subscribe(ctx context.Context, h Handler) error
+}
+
+type subscriber struct {
+	client   *redis.Client
+	group    string
+	consumer string
+	streams  []string
+	logger   logger.Logger
+}
+
+// NewSubscriber returns the member of the consumer group of the streams.
+func NewSubscriber(client *redis.Client, group, consumer string, streams []string, logger logger.Logger) Subscriber {
+	return subscriber{
+		client:   client,
+		group:    group,
+		consumer: consumer,
+		streams:  streams,
+		logger:   logger,
+	}
+}
+
+func (s subscriber) Subscribe(ctx context.Context, h Handler) error {
+	args := make([]string, 0, 2*len(s.streams))
+	for _, stream := range s.streams {
+		err := s.client.XGroupCreateMkStream(ctx, stream, s.group, "$").Err()
+		if err != nil && err.Error() != exists {
+			return err
+		}
+		args = append(args, stream)
+	}
+	for range s.streams {
+		args = append(args, ">")
+	}
+
+	var failures uint
+	lastClaim := time.Now()
+	for {
+		res, err := s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  args,
+			Count:    batchSize,
+			Block:    ClaimInterval,
+		}).Result()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil && err != redis.Nil {
+			failures++
+			s.logger.Warn(fmt.Sprintf("Failed to read event streams: %s", err))
+			if !sleep(ctx, jobs.Backoff(failures)) {
+				return nil
+			}
+			continue
+		}
+		failures = 0
+
+		for _, stream := range res {
+			s.handle(ctx, h, stream.Stream, stream.Messages)
+		}
+
+		if time.Since(lastClaim) >= ClaimInterval {
+			s.reclaim(ctx, h)
+			lastClaim = time.Now()
+		}
+	}
+}
+
+// reclaim takes over the events which were read, but not acknowledged, by
+// any member of the consumer group within the claim interval, and handles
+// them again. The events which failed MaxDeliveries times are dropped.
+func (s subscriber) reclaim(ctx context.Context, h Handler) {
+	for _, stream := range s.streams {
+		pending, err := s.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  s.group,
+			Idle:   ClaimInterval,
+			Start:  "-",
+			End:    "+",
+			Count:  batchSize,
+		}).Result()
+		if err != nil {
+			s.logger.Warn(fmt.Sprintf("Failed to retrieve pending events of %s: %s", stream, err))
+			continue
+		}
+
+		ids := make([]string, 0, len(pending))
+		for _, p := range pending {
+			if p.RetryCount >= MaxDeliveries {
+				s.logger.Error(fmt.Sprintf("Dropping event %s of %s after %d failed deliveries", p.ID, stream, p.RetryCount))
+				s.client.XAck(ctx, stream, s.group, p.ID)
+				continue
+			}
+			ids = append(ids, p.ID)
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		msgs, err := s.client.XClaim(ctx, &redis.XClaimArgs{
+			Stream:   stream,
+			Group:    s.group,
+			Consumer: s.consumer,
+			MinIdle:  ClaimInterval,
+			Messages: ids,
+		}).Result()
+		if err != nil {
+			s.logger.Warn(fmt.Sprintf("Failed to claim pending events of %s: %s", stream, err))
+			continue
+		}
+		s.handle(ctx, h, stream, msgs)
+	}
+}
+
+// handle handles the stream events and acknowledges the handled ones. The
+// failed events stay pending until they are reclaimed.
+func (s subscriber) handle(ctx context.Context, h Handler, stream string, msgs []redis.XMessage) {
+	for _, msg := range msgs {
+		op, _ := msg.Values["operation"].(string)
+		event := Event{
+			Stream:     stream,
+			ID:         msg.ID,
+			Operation:  op,
+			OccurredAt: OccurredAt(msg.ID),
+			Values:     msg.Values,
+		}
+		if err := h(ctx, event); err != nil {
+			s.logger.Warn(fmt.Sprintf("Failed to handle event sourcing: %s", err.Error()))
+			continue
+		}
+		s.client.XAck(ctx, stream, s.group, msg.ID)
+	}
+}
+
+// OccurredAt returns the time the event was added to the stream, which is
+// encoded in the milliseconds part of its ID.
+func OccurredAt(id string) time.Time {
+	ms, err := strconv.ParseInt(strings.SplitN(id, "-", 2)[0], 10, 64)
+	if err != nil {
+		return time.Now()
+	}
+
+	return time.UnixMilli(ms)
+}
+
+// CompareIDs compares the IDs of the stream entries, returning -1, 0 or 1 if
+// the first ID is lower than, equal to or greater than the second one.
+func CompareIDs(a, b string) int {
+	ams, aseq := splitID(a)
+	bms, bseq := splitID(b)
+	switch {
+	case ams < bms, ams == bms && aseq < bseq:
+		return -1
+	case ams == bms && aseq == bseq:
+		return 0
+	default:
+		return 1
+	}
+}
+
+func splitID(id string) (uint64, uint64) {
+	parts := strings.SplitN(id, "-", 2)
+	ms, _ := strconv.ParseUint(parts[0], 10, 64)
+	var seq uint64
+	if len(parts) == 2 {
+		seq, _ = strconv.ParseUint(parts[1], 10, 64)
+	}
+
+	return ms, seq
+}
+
+// sleep waits for the given duration, returning false if the context was
+// canceled in the meantime.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
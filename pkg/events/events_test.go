@@ -0,0 +1,159 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOccurredAt(t *testing.T) {
+	cases := []struct {
+		desc string
+		id   string
+		time time.Time
+	}{
+		{
+			desc: "occurrence time of event",
+			id:   "1700000000123-0",
+			time: time.UnixMilli(1700000000123),
+		},
+		{
+			desc: "occurrence time of event with sequence number",
+			id:   "1700000000123-7",
+			time: time.UnixMilli(1700000000123),
+		},
+	}
+
+	for _, tc := range cases {
+		occurredAt := events.OccurredAt(tc.id)
+		assert.True(t, tc.time.Equal(occurredAt), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.time, occurredAt))
+	}
+}
+
+func TestCompareIDs(t *testing.T) {
+	cases := []struct {
+		desc string
+		a    string
+		b    string
+		res  int
+	}{
+		{
+			desc: "compare equal IDs",
+			a:    "1700000000123-1",
+			b:    "1700000000123-1",
+			res:  0,
+		},
+		{
+			desc: "compare IDs with lower time",
+			a:    "1700000000122-5",
+			b:    "1700000000123-1",
+			res:  -1,
+		},
+		{
+			desc: "compare IDs with greater time",
+			a:    "1700000000124-0",
+			b:    "1700000000123-1",
+			res:  1,
+		},
+		{
+			desc: "compare IDs with lower sequence number",
+			a:    "1700000000123-1",
+			b:    "1700000000123-2",
+			res:  -1,
+		},
+		{
+			desc: "compare IDs with greater sequence number of longer length",
+			a:    "1700000000123-10",
+			b:    "1700000000123-9",
+			res:  1,
+		},
+	}
+
+	for _, tc := range cases {
+		res := events.CompareIDs(tc.a, tc.b)
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.res, res))
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cases := []struct {
+		desc string
+		env  map[string]string
+		cfg  events.Config
+		err  bool
+	}{
+		{
+			desc: "load default config",
+			env:  map[string]string{},
+			cfg:  events.Config{URL: "localhost:6379", DB: 0, Consumer: "reports"},
+		},
+		{
+			desc: "load config from environment",
+			env: map[string]string{
+				"MF_REPORTS_ES_URL":         "es-redis:6379",
+				"MF_REPORTS_ES_PASS":        "pass",
+				"MF_REPORTS_ES_DB":          "2",
+				"MF_REPORTS_EVENT_CONSUMER": "reports-1",
+			},
+			cfg: events.Config{URL: "es-redis:6379", Pass: "pass", DB: 2, Consumer: "reports-1"},
+		},
+		{
+			desc: "load config with invalid database",
+			env:  map[string]string{"MF_REPORTS_ES_DB": "invalid"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		for _, key := range []string{"MF_REPORTS_ES_URL", "MF_REPORTS_ES_PASS", "MF_REPORTS_ES_DB", "MF_REPORTS_EVENT_CONSUMER"} {
+			t.Setenv(key, tc.env[key])
+		}
+
+		cfg, err := events.LoadConfig("MF_REPORTS", "reports")
+		assert.Equal(t, tc.err, err != nil, fmt.Sprintf("%s: expected error %t got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.cfg, cfg, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cfg, cfg))
+	}
+}
+
+type testEvent struct {
+	id string
+}
+
+func (te testEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        te.id,
+		"operation": "test.create",
+	}
+}
+
+func TestEncode(t *testing.T) {
+	cases := []struct {
+		desc string
+		ctx  context.Context
+		val  map[string]interface{}
+	}{
+		{
+			desc: "encode event without request ID",
+			ctx:  context.Background(),
+			val:  map[string]interface{}{"id": "1", "operation": "test.create"},
+		},
+		{
+			desc: "encode event with request ID",
+			ctx:  logger.ContextWithRequestID(context.Background(), "req-1"),
+			val:  map[string]interface{}{"id": "1", "operation": "test.create", "request_id": "req-1"},
+		},
+	}
+
+	for _, tc := range cases {
+		val := events.Encode(tc.ctx, testEvent{id: "1"})
+		assert.Equal(t, tc.val, val, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.val, val))
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/MainfluxLabs/mainflux"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	defURL  = "localhost:6379"
+	defPass = ""
+	defDB   = "0"
+
+	envURL      = "_ES_URL"
+	envPass     = "_ES_PASS"
+	envDB       = "_ES_DB"
+	envConsumer = "_EVENT_CONSUMER"
+
+	thingsStream = "mainflux.things"
+	groupRemove  = "group.remove"
+)
+
+// Config defines the options of the event store connection and the name of
+// the consumer group member.
+type Config struct {
+	URL      string
+	Pass     string
+	DB       int
+	Consumer string
+}
+
+// LoadConfig loads the event store config of the service from the environment
+// variables having the prefix, e.g. MF_REPORTS_ES_URL, MF_REPORTS_ES_PASS,
+// MF_REPORTS_ES_DB and MF_REPORTS_EVENT_CONSUMER for the MF_REPORTS prefix.
+func LoadConfig(prefix, consumer string) (Config, error) {
+	db, err := strconv.Atoi(mainflux.Env(prefix+envDB, defDB))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid value passed for %s: %w", prefix+envDB, err)
+	}
+
+	return Config{
+		URL:      mainflux.Env(prefix+envURL, defURL),
+		Pass:     mainflux.Env(prefix+envPass, defPass),
+		DB:       db,
+		Consumer: mainflux.Env(prefix+envConsumer, consumer),
+	}, nil
+}
+
+// GroupRemover removes the service data belonging to the group.
+type GroupRemover func(ctx context.Context, groupID string) error
+
+// SubscribeGroupRemovals connects to the event store and removes the service
+// data of the groups removed by the things service, as the member of the
+// consumer group, until the context is canceled.
+func SubscribeGroupRemovals(ctx context.Context, cfg Config, group string, remove GroupRemover, logger logger.Logger) error {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.URL,
+		Password: cfg.Pass,
+		DB:       cfg.DB,
+	})
+	defer client.Close()
+
+	logger.Info("Subscribed to Redis Event Store")
+	err := NewSubscriber(client, group, cfg.Consumer, []string{thingsStream}, logger).Subscribe(ctx, func(ctx context.Context, event Event) error {
+		if event.Operation != groupRemove {
+			return nil
+		}
+
+		return remove(ctx, event.Read("id"))
+	})
+	if err != nil {
+		logger.Warn(fmt.Sprintf("Failed to subscribe to things event store: %s", err))
+		return err
+	}
+
+	return nil
+}
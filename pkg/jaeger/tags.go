@@ -0,0 +1,24 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package jaeger
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/opentracing/opentracing-go"
+)
+
+const requestIDTag = "request_id"
+
+// RequestIDTag returns the span option tagging the span with the request ID
+// carried by the context, if any.
+func RequestIDTag(ctx context.Context) opentracing.StartSpanOption {
+	tags := opentracing.Tags{}
+	if id := logger.RequestIDFromContext(ctx); id != "" {
+		tags[requestIDTag] = id
+	}
+
+	return tags
+}
@@ -0,0 +1,341 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jobs contains the background jobs framework shared by the services.
+// The jobs are either scheduled, i.e. run periodically or on the cron
+// schedule, or queued, i.e. run once with retries. The scheduled jobs are
+// singletons among the service replicas, since each run holds the job lock.
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MaxAttempts is the number of attempts of the queued task, after which
+	// the failed task is dropped.
+	MaxAttempts = 5
+
+	pollInterval = time.Second
+	minBackoff   = time.Second
+	maxBackoff   = 5 * time.Minute
+
+	statusSuccess = "success"
+	statusFailure = "failure"
+	statusSkipped = "skipped"
+)
+
+var (
+	// ErrQueueDisabled indicates that the task is enqueued to the scheduler
+	// without the task store.
+	ErrQueueDisabled = errors.New("job queue is disabled")
+
+	// ErrUnknownTask indicates that the task has no handler.
+	ErrUnknownTask = errors.New("unknown task")
+
+	// ErrEmptyQueue indicates that there are no due tasks.
+	ErrEmptyQueue = errors.New("no due tasks")
+)
+
+// Func is the scheduled job, which runs at the provided time.
+type Func func(ctx context.Context, now time.Time) error
+
+// Handler runs the queued task of the provided payload.
+type Handler func(ctx context.Context, payload []byte) error
+
+// Task represents the queued job run.
+type Task struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+	Payload  []byte    `json:"payload,omitempty"`
+	Attempts uint      `json:"attempts"`
+	RunAt    time.Time `json:"run_at"`
+}
+
+// Store specifies the queued tasks persistence API. The tasks are shared by
+// all replicas of the service, so that each task is run by one of them.
+type Store interface {
+	// Push persists the task, which is run at its RunAt time.
+	Push(ctx context.Context, t Task) error
+
+	// Pop retrieves and removes the task due at the provided time, or
+	// returns ErrEmptyQueue if there are none.
+	Pop(ctx context.Context, now time.Time) (Task, error)
+}
+
+// Locker specifies the API of the job locks, which make the scheduled jobs
+// singletons among the replicas of the service.
+type Locker interface {
+	// Run runs the function holding the lock of the named job. It returns
+	// false without running the function if the lock is held by another run.
+	Run(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error)
+}
+
+// Scheduler runs the scheduled jobs and the queued tasks of the service. The
+// runs are counted by name and status, i.e. success, failure or skipped when
+// the job is already run by another replica, while their latency is observed
+// by name.
+type Scheduler struct {
+	locker   Locker
+	store    Store
+	counter  metrics.Counter
+	latency  metrics.Histogram
+	logger   logger.Logger
+	idp      uuid.IDProvider
+	mu       sync.Mutex
+	jobs     []job
+	handlers map[string]Handler
+}
+
+type job struct {
+	name     string
+	schedule Schedule
+	fn       Func
+}
+
+// New returns the scheduler using the provided locker. The tasks can't be
+// queued if the store is nil.
+func New(locker Locker, store Store, idp uuid.IDProvider, counter metrics.Counter, latency metrics.Histogram, logger logger.Logger) *Scheduler {
+	return &Scheduler{
+		locker:   locker,
+		store:    store,
+		counter:  counter,
+		latency:  latency,
+		logger:   logger,
+		idp:      idp,
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Schedule registers the named job, which is run by Run on the schedule.
+func (s *Scheduler) Schedule(name string, schedule Schedule, fn Func) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs = append(s.jobs, job{name: name, schedule: schedule, fn: fn})
+}
+
+// Handle registers the handler of the named tasks.
+func (s *Scheduler) Handle(name string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[name] = h
+}
+
+// Enqueue queues the named task, which is run as soon as possible by one of
+// the replicas. The failed task is retried with the exponential backoff.
+func (s *Scheduler) Enqueue(ctx context.Context, name string, payload []byte) error {
+	if s.store == nil {
+		return ErrQueueDisabled
+	}
+
+	s.mu.Lock()
+	_, ok := s.handlers[name]
+	s.mu.Unlock()
+	if !ok {
+		return ErrUnknownTask
+	}
+
+	id, err := s.idp.ID()
+	if err != nil {
+		return err
+	}
+
+	return s.store.Push(ctx, Task{ID: id, Name: name, Payload: payload, RunAt: time.Now().UTC()})
+}
+
+// Run runs the scheduled jobs and the queued tasks until the context is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.mu.Lock()
+	jobs := append([]job{}, s.jobs...)
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		wg.Add(1)
+		go func(j job) {
+			defer wg.Done()
+			s.runJob(ctx, j)
+		}(j)
+	}
+
+	if s.store != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runQueue(ctx)
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// runJob runs the job on its schedule. The runs of the same job never
+// overlap, since the next run is scheduled once the previous one is done.
+func (s *Scheduler) runJob(ctx context.Context, j job) {
+	for {
+		next := j.schedule.Next(time.Now())
+		if next.IsZero() {
+			s.logger.Warn(fmt.Sprintf("Job %s has no next run", j.name))
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case now := <-timer.C:
+			s.run(ctx, j.name, func(ctx context.Context) error {
+				return j.fn(ctx, now)
+			})
+		}
+	}
+}
+
+// runQueue polls the store for the due tasks, which are run one by one.
+func (s *Scheduler) runQueue(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.drain(ctx)
+		}
+	}
+}
+
+// drain runs the tasks due at the moment.
+func (s *Scheduler) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		t, err := s.store.Pop(ctx, time.Now().UTC())
+		if err != nil {
+			if err != ErrEmptyQueue {
+				s.logger.Warn(fmt.Sprintf("Failed to retrieve queued task: %s", err))
+			}
+			return
+		}
+
+		s.runTask(ctx, t)
+	}
+}
+
+func (s *Scheduler) runTask(ctx context.Context, t Task) {
+	s.mu.Lock()
+	h, ok := s.handlers[t.Name]
+	s.mu.Unlock()
+	if !ok {
+		s.logger.Warn(fmt.Sprintf("Dropped task %s of unknown name %s", t.ID, t.Name))
+		return
+	}
+
+	t.Attempts++
+	err := s.observe(t.Name, func() error {
+		return h(ctx, t.Payload)
+	})
+	if err == nil {
+		return
+	}
+
+	if t.Attempts >= MaxAttempts {
+		s.logger.Error(fmt.Sprintf("Dropped task %s of %s after %d attempts: %s", t.ID, t.Name, t.Attempts, err))
+		return
+	}
+
+	t.RunAt = time.Now().UTC().Add(Backoff(t.Attempts))
+	if err := s.store.Push(ctx, t); err != nil {
+		s.logger.Error(fmt.Sprintf("Failed to retry task %s of %s: %s", t.ID, t.Name, err))
+	}
+}
+
+// run runs the job holding its lock, so that the job isn't run by several
+// replicas at once.
+func (s *Scheduler) run(ctx context.Context, name string, fn func(ctx context.Context) error) {
+	var err error
+	ok, lerr := s.locker.Run(ctx, name, func(ctx context.Context) error {
+		err = s.observe(name, func() error {
+			return fn(ctx)
+		})
+		return err
+	})
+
+	switch {
+	case lerr != nil && err == nil:
+		s.logger.Warn(fmt.Sprintf("Failed to lock job %s: %s", name, lerr))
+	case err != nil:
+		s.logger.Warn(fmt.Sprintf("Job %s failed: %s", name, err))
+	case !ok:
+		s.counter.With("job", name, "status", statusSkipped).Add(1)
+	}
+}
+
+func (s *Scheduler) observe(name string, fn func() error) error {
+	defer func(begin time.Time) {
+		s.latency.With("job", name).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	err := fn()
+	status := statusSuccess
+	if err != nil {
+		status = statusFailure
+	}
+	s.counter.With("job", name, "status", status).Add(1)
+
+	return err
+}
+
+// Backoff returns the delay of the next attempt of the failed task.
+func Backoff(attempts uint) time.Duration {
+	d := minBackoff
+	for i := uint(1); i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+
+	return d
+}
+
+type localLocker struct {
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// NewLocalLocker returns the locker which prevents the concurrent runs of the
+// same job within the process only, i.e. for the services running a single
+// replica.
+func NewLocalLocker() Locker {
+	return &localLocker{running: make(map[string]bool)}
+}
+
+func (l *localLocker) Run(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	l.mu.Lock()
+	if l.running[name] {
+		l.mu.Unlock()
+		return false, nil
+	}
+	l.running[name] = true
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		delete(l.running, name)
+		l.mu.Unlock()
+	}()
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const taskName = "task"
+
+var errHandler = errors.New("handler failed")
+
+func TestEnqueue(t *testing.T) {
+	s := jobs.New(jobs.NewLocalLocker(), newStore(), uuid.New(), &counter{}, &histogram{}, logger.NewMock())
+	s.Handle(taskName, func(context.Context, []byte) error { return nil })
+
+	cases := []struct {
+		desc string
+		s    *jobs.Scheduler
+		name string
+		err  error
+	}{
+		{
+			desc: "enqueue task",
+			s:    s,
+			name: taskName,
+			err:  nil,
+		},
+		{
+			desc: "enqueue task without handler",
+			s:    s,
+			name: "unknown",
+			err:  jobs.ErrUnknownTask,
+		},
+		{
+			desc: "enqueue task without store",
+			s:    jobs.New(jobs.NewLocalLocker(), nil, uuid.New(), &counter{}, &histogram{}, logger.NewMock()),
+			name: taskName,
+			err:  jobs.ErrQueueDisabled,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tc.s.Enqueue(context.Background(), tc.name, nil)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+	}
+}
+
+func TestRunQueue(t *testing.T) {
+	st := newStore()
+	c := &counter{}
+	s := jobs.New(jobs.NewLocalLocker(), st, uuid.New(), c, &histogram{}, logger.NewMock())
+
+	payloads := make(chan string, 2)
+	s.Handle(taskName, func(_ context.Context, payload []byte) error {
+		payloads <- string(payload)
+		if string(payload) == "fail" {
+			return errHandler
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	err := s.Enqueue(ctx, taskName, []byte("ok"))
+	require.Nil(t, err, fmt.Sprintf("enqueue task: unexpected error %s", err))
+	err = s.Enqueue(ctx, taskName, []byte("fail"))
+	require.Nil(t, err, fmt.Sprintf("enqueue task: unexpected error %s", err))
+
+	var got []string
+	for i := 0; i < 2; i++ {
+		select {
+		case p := <-payloads:
+			got = append(got, p)
+		case <-time.After(5 * time.Second):
+			t.Fatal("run queued tasks: timed out")
+		}
+	}
+	cancel()
+	<-done
+
+	sort.Strings(got)
+	assert.Equal(t, []string{"fail", "ok"}, got, fmt.Sprintf("run queued tasks: expected both tasks got %v", got))
+	assert.Equal(t, float64(1), c.value(taskName, "success"), "run queued tasks: expected one successful run")
+	assert.Equal(t, float64(1), c.value(taskName, "failure"), "run queued tasks: expected one failed run")
+
+	retried := st.tasks()
+	require.Len(t, retried, 1, "run queued tasks: expected the failed task to be retried")
+	assert.Equal(t, uint(1), retried[0].Attempts, fmt.Sprintf("run queued tasks: expected 1 attempt got %d", retried[0].Attempts))
+	assert.True(t, retried[0].RunAt.After(time.Now()), "run queued tasks: expected the retry to be delayed")
+}
+
+func TestRunTaskAttempts(t *testing.T) {
+	st := newStore()
+	s := jobs.New(jobs.NewLocalLocker(), st, uuid.New(), &counter{}, &histogram{}, logger.NewMock())
+
+	runs := make(chan struct{}, 1)
+	s.Handle(taskName, func(context.Context, []byte) error {
+		runs <- struct{}{}
+		return errHandler
+	})
+
+	// The task on its last attempt is dropped once it fails.
+	err := st.Push(context.Background(), jobs.Task{ID: "id", Name: taskName, Attempts: jobs.MaxAttempts - 1, RunAt: time.Now()})
+	require.Nil(t, err, fmt.Sprintf("push task: unexpected error %s", err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-runs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("run task on last attempt: timed out")
+	}
+	cancel()
+	<-done
+
+	assert.Empty(t, st.tasks(), "run task on last attempt: expected the task to be dropped")
+}
+
+func TestSchedule(t *testing.T) {
+	c := &counter{}
+	s := jobs.New(jobs.NewLocalLocker(), nil, uuid.New(), c, &histogram{}, logger.NewMock())
+
+	runs := make(chan time.Time, 10)
+	s.Schedule("job", jobs.Every(10*time.Millisecond), func(_ context.Context, now time.Time) error {
+		runs <- now
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-runs:
+		case <-time.After(5 * time.Second):
+			t.Fatal("run scheduled job: timed out")
+		}
+	}
+	cancel()
+	<-done
+
+	assert.GreaterOrEqual(t, c.value("job", "success"), float64(3), "run scheduled job: expected at least 3 successful runs")
+}
+
+func TestLocalLocker(t *testing.T) {
+	l := jobs.NewLocalLocker()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go l.Run(context.Background(), "job", func(context.Context) error {
+		close(started)
+		<-release
+		return nil
+	})
+	<-started
+
+	ok, err := l.Run(context.Background(), "job", func(context.Context) error { return nil })
+	assert.Nil(t, err, fmt.Sprintf("run locked job: unexpected error %s", err))
+	assert.False(t, ok, "run locked job: expected the run to be skipped")
+
+	ok, err = l.Run(context.Background(), "other", func(context.Context) error { return errHandler })
+	assert.True(t, errors.Contains(err, errHandler), fmt.Sprintf("run other job: expected %s got %s", errHandler, err))
+	assert.True(t, ok, "run other job: expected the job to run")
+	close(release)
+}
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		attempts uint
+		backoff  time.Duration
+	}{
+		{attempts: 1, backoff: time.Second},
+		{attempts: 2, backoff: 2 * time.Second},
+		{attempts: 4, backoff: 8 * time.Second},
+		{attempts: 20, backoff: 5 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		backoff := jobs.Backoff(tc.attempts)
+		assert.Equal(t, tc.backoff, backoff, fmt.Sprintf("backoff after %d attempts: expected %s got %s", tc.attempts, tc.backoff, backoff))
+	}
+}
+
+type store struct {
+	mu    sync.Mutex
+	queue []jobs.Task
+}
+
+func newStore() *store {
+	return &store{}
+}
+
+func (s *store) Push(_ context.Context, t jobs.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.queue = append(s.queue, t)
+	return nil
+}
+
+func (s *store) Pop(_ context.Context, now time.Time) (jobs.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, t := range s.queue {
+		if !t.RunAt.After(now) {
+			s.queue = append(s.queue[:i], s.queue[i+1:]...)
+			return t, nil
+		}
+	}
+
+	return jobs.Task{}, jobs.ErrEmptyQueue
+}
+
+func (s *store) tasks() []jobs.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]jobs.Task{}, s.queue...)
+}
+
+type counter struct {
+	mu     sync.Mutex
+	labels []string
+	values map[string]float64
+	parent *counter
+}
+
+func (c *counter) With(labelValues ...string) metrics.Counter {
+	return &counter{labels: labelValues, parent: c}
+}
+
+func (c *counter) Add(delta float64) {
+	p := c.parent
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.values == nil {
+		p.values = make(map[string]float64)
+	}
+	p.values[fmt.Sprint(c.labels)] += delta
+}
+
+func (c *counter) value(job, status string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.values[fmt.Sprint([]string{"job", job, "status", status})]
+}
+
+type histogram struct{}
+
+func (h *histogram) With(...string) metrics.Histogram {
+	return h
+}
+
+func (h *histogram) Observe(float64) {}
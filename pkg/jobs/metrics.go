@@ -0,0 +1,30 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// NewMetrics returns the Prometheus job run counter and latency of the
+// service, which are registered once per process.
+func NewMetrics(namespace string) (metrics.Counter, metrics.Histogram) {
+	counter := kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "run_count",
+		Help:      "Number of job runs by status.",
+	}, []string{"job", "status"})
+
+	latency := kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: namespace,
+		Subsystem: "jobs",
+		Name:      "run_latency_seconds",
+		Help:      "Total duration of job runs in seconds.",
+	}, []string{"job"})
+
+	return counter, latency
+}
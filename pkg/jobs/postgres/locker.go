@@ -0,0 +1,59 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package postgres contains the job locker using the PostgreSQL advisory locks.
+package postgres
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/jmoiron/sqlx"
+)
+
+const keyPrefix = "mainflux.jobs."
+
+// ErrLock indicates failure to acquire or release the job lock.
+var ErrLock = errors.New("failed to lock job")
+
+var _ jobs.Locker = (*locker)(nil)
+
+type locker struct {
+	db *sqlx.DB
+}
+
+// NewLocker returns the job locker using the session advisory locks of the
+// provided database, which is shared by all replicas of the service. The
+// lock is released by the database if the replica holding it disconnects.
+func NewLocker(db *sqlx.DB) jobs.Locker {
+	return &locker{db: db}
+}
+
+func (l *locker) Run(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	// The session lock is held by the connection, so the same connection
+	// is used to release it.
+	conn, err := l.db.Connx(ctx)
+	if err != nil {
+		return false, errors.Wrap(ErrLock, err)
+	}
+	defer conn.Close()
+
+	key := keyPrefix + name
+	var ok bool
+	if err := conn.QueryRowxContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, key).Scan(&ok); err != nil {
+		return false, errors.Wrap(ErrLock, err)
+	}
+	if !ok {
+		return false, nil
+	}
+
+	err = fn(ctx)
+
+	// The lock is released even if the context is done.
+	if _, uerr := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, key); uerr != nil && err == nil {
+		return true, errors.Wrap(ErrLock, uerr)
+	}
+
+	return true, err
+}
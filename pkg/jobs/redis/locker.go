@@ -0,0 +1,94 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	lockPrefix = "mainflux.jobs.lock."
+	// lockTTL bounds the time the lock of the crashed replica is held. The
+	// lock is extended while the job runs.
+	lockTTL = 30 * time.Second
+)
+
+// ErrLock indicates failure to acquire or release the job lock.
+var ErrLock = errors.New("failed to lock job")
+
+// unlock removes the lock only if it's still held by the same run.
+var unlock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// extend extends the lock only if it's still held by the same run.
+var extend = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+var _ jobs.Locker = (*locker)(nil)
+
+type locker struct {
+	client *redis.Client
+	idp    uuid.IDProvider
+}
+
+// NewLocker returns the job locker using the provided Redis client, which is
+// shared by all replicas of the service.
+func NewLocker(client *redis.Client, idp uuid.IDProvider) jobs.Locker {
+	return &locker{client: client, idp: idp}
+}
+
+func (l *locker) Run(ctx context.Context, name string, fn func(ctx context.Context) error) (bool, error) {
+	token, err := l.idp.ID()
+	if err != nil {
+		return false, errors.Wrap(ErrLock, err)
+	}
+
+	key := lockPrefix + name
+	ok, err := l.client.SetNX(ctx, key, token, lockTTL).Result()
+	if err != nil {
+		return false, errors.Wrap(ErrLock, err)
+	}
+	if !ok {
+		return false, nil
+	}
+
+	done := make(chan struct{})
+	go l.keepAlive(key, token, done)
+
+	err = fn(ctx)
+	close(done)
+
+	if uerr := unlock.Run(context.Background(), l.client, []string{key}, token).Err(); uerr != nil && err == nil {
+		return true, errors.Wrap(ErrLock, uerr)
+	}
+
+	return true, err
+}
+
+// keepAlive extends the lock until the job is done.
+func (l *locker) keepAlive(key, token string, done chan struct{}) {
+	ticker := time.NewTicker(lockTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			extend.Run(context.Background(), l.client, []string{key}, token, lockTTL.Milliseconds())
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package redis contains the job locker and the queued tasks store using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/go-redis/redis/v8"
+)
+
+const queuePrefix = "mainflux.jobs.queue."
+
+var _ jobs.Store = (*store)(nil)
+
+type store struct {
+	client *redis.Client
+	key    string
+}
+
+// NewStore returns the queued tasks store of the named service using the
+// provided Redis client. The tasks are kept in the sorted set scored by the
+// time they are due.
+func NewStore(client *redis.Client, service string) jobs.Store {
+	return &store{client: client, key: queuePrefix + service}
+}
+
+func (s *store) Push(ctx context.Context, t jobs.Task) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	z := &redis.Z{Score: float64(t.RunAt.UnixMilli()), Member: data}
+	if err := s.client.ZAdd(ctx, s.key, z).Err(); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (s *store) Pop(ctx context.Context, now time.Time) (jobs.Task, error) {
+	for {
+		members, err := s.client.ZRangeByScore(ctx, s.key, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   strconv.FormatInt(now.UnixMilli(), 10),
+			Count: 1,
+		}).Result()
+		if err != nil {
+			return jobs.Task{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		if len(members) == 0 {
+			return jobs.Task{}, jobs.ErrEmptyQueue
+		}
+
+		// The task is claimed by the replica which removes it, while the
+		// others move on to the next task.
+		n, err := s.client.ZRem(ctx, s.key, members[0]).Result()
+		if err != nil {
+			return jobs.Task{}, errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+		if n == 0 {
+			continue
+		}
+
+		var t jobs.Task
+		if err := json.Unmarshal([]byte(members[0]), &t); err != nil {
+			return jobs.Task{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+
+		return t, nil
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const everyPrefix = "@every "
+
+// ErrInvalidSchedule indicates the malformed job schedule.
+var ErrInvalidSchedule = errors.New("invalid job schedule")
+
+// descriptors are the shorthands of the common cron expressions.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule specifies when the job runs.
+type Schedule interface {
+	// Next returns the first run time after the provided time.
+	Next(t time.Time) time.Time
+}
+
+type every time.Duration
+
+// Every returns the schedule running the job at the fixed interval.
+func Every(d time.Duration) Schedule {
+	return every(d)
+}
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// ParseSchedule parses the job schedule, which is either the interval, e.g.
+// 1h or @every 1h, or the cron expression.
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d, err := time.ParseDuration(strings.TrimPrefix(spec, everyPrefix)); err == nil {
+		if d <= 0 {
+			return nil, ErrInvalidSchedule
+		}
+		return Every(d), nil
+	}
+
+	return ParseCron(spec)
+}
+
+// cron is the schedule of the cron expression, which holds the bit set of
+// the matching values of each field.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+}
+
+type bounds struct {
+	min, max uint
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	// Sunday is both 0 and 7.
+	dowBounds = bounds{0, 7}
+)
+
+// ParseCron parses the standard cron expression of five fields, i.e. minute,
+// hour, day of month, month and day of week, or one of its descriptors, e.g.
+// @daily. The fields consist of the comma separated values, ranges and steps,
+// e.g. 0,30 or 1-5 or */15. The runs are scheduled in the time zone of the
+// time the next run is computed from.
+func ParseCron(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d, ok := descriptors[spec]; ok {
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, ErrInvalidSchedule
+	}
+
+	var c cron
+	var err error
+	if c.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+
+	return c, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, uint64(1)
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || s == 0 {
+				return 0, ErrInvalidSchedule
+			}
+			rng, step = part[:i], s
+		}
+
+		lo, hi := b.min, b.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			parts := strings.SplitN(rng, "-", 2)
+			l, err := parseValue(parts[0], b)
+			if err != nil {
+				return 0, err
+			}
+			h, err := parseValue(parts[1], b)
+			if err != nil || h < l {
+				return 0, ErrInvalidSchedule
+			}
+			lo, hi = l, h
+		default:
+			v, err := parseValue(rng, b)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = v, v
+			// The step of the single value, e.g. 5/15, runs up to the max.
+			if step > 1 {
+				hi = b.max
+			}
+		}
+
+		for v := uint64(lo); v <= uint64(hi); v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+func parseValue(s string, b bounds) (uint, error) {
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || uint(v) < b.min || uint(v) > b.max {
+		return 0, ErrInvalidSchedule
+	}
+
+	return uint(v), nil
+}
+
+// maxYears bounds the search of the next run, e.g. for February 30.
+const maxYears = 5
+
+func (c cron) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchDay follows the cron convention that the day matches either the day
+// of month or the day of week if both of them are restricted.
+func (c cron) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	allDom := c.dom == fieldBits(domBounds)
+	allDow := c.dow|1<<7 == fieldBits(dowBounds)
+	switch {
+	case allDom && allDow:
+		return true
+	case allDom:
+		return dow
+	case allDow:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+func fieldBits(b bounds) uint64 {
+	var bits uint64
+	for v := b.min; v <= b.max; v++ {
+		bits |= 1 << v
+	}
+
+	return bits
+}
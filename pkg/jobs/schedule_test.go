@@ -0,0 +1,184 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	from := time.Date(2024, time.January, 15, 10, 20, 30, 0, time.UTC)
+
+	cases := []struct {
+		desc string
+		spec string
+		next time.Time
+		err  error
+	}{
+		{
+			desc: "parse interval",
+			spec: "1h",
+			next: from.Add(time.Hour),
+			err:  nil,
+		},
+		{
+			desc: "parse every interval",
+			spec: "@every 90s",
+			next: from.Add(90 * time.Second),
+			err:  nil,
+		},
+		{
+			desc: "parse negative interval",
+			spec: "-1h",
+			err:  jobs.ErrInvalidSchedule,
+		},
+		{
+			desc: "parse cron expression",
+			spec: "*/15 * * * *",
+			next: time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC),
+			err:  nil,
+		},
+		{
+			desc: "parse cron descriptor",
+			spec: "@daily",
+			next: time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC),
+			err:  nil,
+		},
+		{
+			desc: "parse invalid schedule",
+			spec: "invalid",
+			err:  jobs.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range cases {
+		s, err := jobs.ParseSchedule(tc.spec)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+		next := s.Next(from)
+		assert.Equal(t, tc.next, next, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.next, next))
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// Monday.
+	from := time.Date(2024, time.January, 15, 10, 20, 30, 0, time.UTC)
+
+	cases := []struct {
+		desc string
+		spec string
+		next time.Time
+	}{
+		{
+			desc: "every minute",
+			spec: "* * * * *",
+			next: time.Date(2024, time.January, 15, 10, 21, 0, 0, time.UTC),
+		},
+		{
+			desc: "minute list",
+			spec: "5,45 * * * *",
+			next: time.Date(2024, time.January, 15, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			desc: "hour range",
+			spec: "0 2-4 * * *",
+			next: time.Date(2024, time.January, 16, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			desc: "hour range with step",
+			spec: "30 8-20/4 * * *",
+			next: time.Date(2024, time.January, 15, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			desc: "day of month",
+			spec: "0 0 1 * *",
+			next: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc: "day of week",
+			spec: "0 9 * * 5",
+			next: time.Date(2024, time.January, 19, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			desc: "sunday as 7",
+			spec: "0 9 * * 7",
+			next: time.Date(2024, time.January, 21, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			desc: "day of month or day of week",
+			spec: "0 0 20 * 3",
+			next: time.Date(2024, time.January, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc: "leap day",
+			spec: "0 0 29 2 *",
+			next: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc: "missing day",
+			spec: "0 0 30 2 *",
+			next: time.Time{},
+		},
+	}
+
+	for _, tc := range cases {
+		s, err := jobs.ParseCron(tc.spec)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		next := s.Next(from)
+		assert.Equal(t, tc.next, next, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.next, next))
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	cases := []struct {
+		desc string
+		spec string
+		err  error
+	}{
+		{
+			desc: "parse valid expression",
+			spec: "0,30 8-18/2 1-15 1,6,12 1-5",
+			err:  nil,
+		},
+		{
+			desc: "parse expression with missing field",
+			spec: "0 0 * *",
+			err:  jobs.ErrInvalidSchedule,
+		},
+		{
+			desc: "parse expression with value out of bounds",
+			spec: "60 * * * *",
+			err:  jobs.ErrInvalidSchedule,
+		},
+		{
+			desc: "parse expression with reversed range",
+			spec: "* 5-1 * * *",
+			err:  jobs.ErrInvalidSchedule,
+		},
+		{
+			desc: "parse expression with zero step",
+			spec: "*/0 * * * *",
+			err:  jobs.ErrInvalidSchedule,
+		},
+		{
+			desc: "parse expression with zero day",
+			spec: "* * 0 * *",
+			err:  jobs.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := jobs.ParseCron(tc.spec)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+	}
+}
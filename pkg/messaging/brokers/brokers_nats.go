@@ -25,6 +25,8 @@ const (
 	SubjectSmpp = "smpp"
 	// SubjectWebhook represents subject to subscribe for sending the Webhooks.
 	SubjectWebhook = "webhook"
+	// SubjectAlarms represents subject to subscribe for the alarms.
+	SubjectAlarms = "alarms"
 )
 
 func init() {
@@ -40,6 +42,16 @@ func NewPublisher(url string) (messaging.Publisher, error) {
 
 }
 
+// NewAckPublisher returns the publisher which confirms the persistence of the
+// messages stored in the configured stream.
+func NewAckPublisher(url string, cfg messaging.AckConfig) (messaging.AckPublisher, error) {
+	pb, err := nats.NewAckPublisher(url, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
 func NewPubSub(url, queue string, logger logger.Logger) (messaging.PubSub, error) {
 	pb, err := nats.NewPubSub(url, queue, logger)
 	if err != nil {
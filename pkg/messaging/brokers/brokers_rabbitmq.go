@@ -14,8 +14,22 @@ import (
 	"github.com/MainfluxLabs/mainflux/pkg/messaging/rabbitmq"
 )
 
-// SubjectAllProfiles represents subject to subscribe for all the profiles.
-const SubjectAllProfiles = "profiles.#"
+const (
+	// SubjectAllProfiles represents subject to subscribe for all the profiles.
+	SubjectAllProfiles = "profiles.#"
+	// SubjectSenML represents subject to subscribe for the SenML messages.
+	SubjectSenML = "senml.#"
+	// SubjectJSON represents subject to subscribe for the JSON messages.
+	SubjectJSON = "json.#"
+	// SubjectSmtp represents subject to subscribe for the SMTP notifications.
+	SubjectSmtp = "smtp"
+	// SubjectSmpp represents subject to subscribe for the SMPP notifications.
+	SubjectSmpp = "smpp"
+	// SubjectWebhook represents subject to subscribe for sending the Webhooks.
+	SubjectWebhook = "webhook"
+	// SubjectAlarms represents subject to subscribe for the alarms.
+	SubjectAlarms = "alarms"
+)
 
 func init() {
 	log.Println("The binary was build using RabbitMQ as the message broker")
@@ -29,6 +43,12 @@ func NewPublisher(url string) (messaging.Publisher, error) {
 	return pb, nil
 }
 
+// NewAckPublisher returns an error, since the persistence confirmation isn't
+// supported by RabbitMQ.
+func NewAckPublisher(url string, cfg messaging.AckConfig) (messaging.AckPublisher, error) {
+	return nil, messaging.ErrAckUnsupported
+}
+
 func NewPubSub(url, queue string, logger logger.Logger) (messaging.PubSub, error) {
 	pb, err := rabbitmq.NewPubSub(url, queue, logger)
 	if err != nil {
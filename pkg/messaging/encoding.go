@@ -0,0 +1,101 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// GzipEncoding represents the gzip compressed payload content encoding.
+	GzipEncoding = "gzip"
+	// ZstdEncoding represents the Zstandard compressed payload content encoding.
+	ZstdEncoding = "zstd"
+
+	// MaxDecompressedSize is the maximum size of the decompressed payload,
+	// which protects the adapters from the decompression bombs.
+	MaxDecompressedSize = 1 << 20
+)
+
+var (
+	// ErrUnsupportedEncoding indicates that the payload content encoding isn't supported.
+	ErrUnsupportedEncoding = errors.New("unsupported payload content encoding")
+
+	// ErrDecompress indicates that the payload couldn't be decompressed.
+	ErrDecompress = errors.New("failed to decompress payload")
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxDecompressedSize))
+)
+
+// ValidEncoding reports whether the payload content encoding is supported.
+// The empty encoding represents the uncompressed payload.
+func ValidEncoding(encoding string) bool {
+	switch encoding {
+	case "", GzipEncoding, ZstdEncoding:
+		return true
+	default:
+		return false
+	}
+}
+
+// Compress compresses the payload using the content encoding. The payload is
+// returned unchanged if the encoding is empty.
+func Compress(encoding string, payload []byte) ([]byte, error) {
+	switch encoding {
+	case "":
+		return payload, nil
+	case GzipEncoding:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case ZstdEncoding:
+		return zstdEncoder.EncodeAll(payload, nil), nil
+	default:
+		return nil, ErrUnsupportedEncoding
+	}
+}
+
+// Decompress decompresses the payload compressed using the content encoding.
+// The payload is returned unchanged if the encoding is empty. The payloads
+// decompressed to more than MaxDecompressedSize bytes are rejected.
+func Decompress(encoding string, payload []byte) ([]byte, error) {
+	switch encoding {
+	case "":
+		return payload, nil
+	case GzipEncoding:
+		r, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, ErrDecompress
+		}
+		defer r.Close()
+
+		data, err := io.ReadAll(io.LimitReader(r, MaxDecompressedSize+1))
+		if err != nil || len(data) > MaxDecompressedSize {
+			return nil, ErrDecompress
+		}
+		return data, nil
+	case ZstdEncoding:
+		data, err := zstdDecoder.DecodeAll(payload, nil)
+		if err != nil {
+			return nil, ErrDecompress
+		}
+		return data, nil
+	default:
+		return nil, ErrUnsupportedEncoding
+	}
+}
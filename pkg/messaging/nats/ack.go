@@ -0,0 +1,104 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package nats
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/gogo/protobuf/proto"
+	broker "github.com/nats-io/nats.go"
+)
+
+// ackHeader holds the inbox of the publisher awaiting the writer confirmation.
+const ackHeader = "Mf-Ack-Inbox"
+
+var _ messaging.AckPublisher = (*ackPublisher)(nil)
+
+type ackPublisher struct {
+	publisher
+	timeout time.Duration
+}
+
+// NewAckPublisher returns NATS message publisher which confirms the
+// persistence of the published messages by the primary writer. The
+// persistence which isn't confirmed within the timeout fails.
+func NewAckPublisher(url string, cfg messaging.AckConfig, opts ...broker.Option) (messaging.AckPublisher, error) {
+	opts = append([]broker.Option{broker.MaxReconnects(maxReconnects)}, opts...)
+	conn, err := broker.Connect(url, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &ackPublisher{
+		publisher: publisher{conn: conn},
+		timeout:   cfg.Timeout,
+	}
+	return ret, nil
+}
+
+// PublishAck publishes the written message to the subject consumed by the
+// writers and waits for the confirmation of the primary writer. The message
+// is published to the other subjects once its persistence is confirmed. The
+// message which isn't written has nothing to confirm, so it's published
+// without the confirmation.
+func (pub *ackPublisher) PublishAck(ctx context.Context, msg protomfx.Message, level string) error {
+	if level != messaging.AckWriter {
+		return messaging.ErrInvalidAck
+	}
+
+	subject, err := writeSubject(&msg)
+	if err != nil {
+		return err
+	}
+	if subject == "" {
+		return pub.Publish(msg)
+	}
+
+	data, err := proto.Marshal(&msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pub.timeout)
+	defer cancel()
+
+	m := broker.NewMsg(subject)
+	m.Data = data
+	m.Header.Set(ackHeader, broker.NewInbox())
+
+	// The inbox is subscribed to before the message is published, so that
+	// the confirmation of the writer can't be missed.
+	inbox, err := pub.conn.SubscribeSync(m.Header.Get(ackHeader))
+	if err != nil {
+		return err
+	}
+	defer inbox.Unsubscribe()
+
+	if err := pub.conn.PublishMsg(m); err != nil {
+		return err
+	}
+
+	if _, err := inbox.NextMsgWithContext(ctx); err != nil {
+		return ackError(ctx, err)
+	}
+
+	for _, subject := range notifySubjects(msg) {
+		if err := pub.conn.Publish(subject, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ackError(ctx context.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return messaging.ErrAckTimeout
+	}
+
+	return err
+}
@@ -0,0 +1,101 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package nats_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/nats"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ackClientID = "ack-writer"
+
+func TestPublishAck(t *testing.T) {
+	cfg := messaging.AckConfig{Timeout: time.Second}
+	ackPub, err := nats.NewAckPublisher(address, cfg)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	defer ackPub.Close()
+
+	topic := fmt.Sprintf("%s.%s.%s", senmlFormat, messagesSuffix, subtopic)
+	err = pubsub.Subscribe(ackClientID, topic, confirmHandler{confirm: true})
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	defer pubsub.Unsubscribe(ackClientID, topic)
+
+	unconfirmedTopic := fmt.Sprintf("%s.%s.%s", senmlFormat, messagesSuffix, "unconfirmed")
+	err = pubsub.Subscribe(ackClientID, unconfirmedTopic, confirmHandler{confirm: false})
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	defer pubsub.Unsubscribe(ackClientID, unconfirmedTopic)
+
+	cases := []struct {
+		desc     string
+		subtopic string
+		config   *protomfx.Config
+		level    string
+		err      error
+	}{
+		{
+			desc:     "publish message with writer confirmation",
+			subtopic: subtopic,
+			config:   msgConfig,
+			level:    messaging.AckWriter,
+			err:      nil,
+		},
+		{
+			desc:     "publish message which isn't written with writer confirmation",
+			subtopic: subtopic,
+			config:   &protomfx.Config{ContentType: senmlContentType},
+			level:    messaging.AckWriter,
+			err:      nil,
+		},
+		{
+			desc:     "publish message which isn't confirmed by the writer",
+			subtopic: "unconfirmed",
+			config:   msgConfig,
+			level:    messaging.AckWriter,
+			err:      messaging.ErrAckTimeout,
+		},
+		{
+			desc:     "publish message with invalid confirmation level",
+			subtopic: subtopic,
+			config:   msgConfig,
+			level:    "broker",
+			err:      messaging.ErrInvalidAck,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := protomfx.Message{
+			Publisher:     clientID,
+			Subtopic:      tc.subtopic,
+			Payload:       data,
+			ProfileConfig: tc.config,
+		}
+
+		err := ackPub.PublishAck(context.Background(), msg, tc.level)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+type confirmHandler struct {
+	confirm bool
+}
+
+func (h confirmHandler) Handle(msg protomfx.Message) error {
+	return nil
+}
+
+func (h confirmHandler) Cancel() error {
+	return nil
+}
+
+func (h confirmHandler) Confirms(msg protomfx.Message) bool {
+	return h.confirm
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers"
 	"github.com/gogo/protobuf/proto"
 	broker "github.com/nats-io/nats.go"
 )
@@ -21,6 +22,7 @@ const (
 	subjectSMTP    = "smtp"
 	subjectSMPP    = "smpp"
 	subjectWebhook = "webhook"
+	subjectAlarms  = "alarms"
 )
 
 var _ messaging.Publisher = (*publisher)(nil)
@@ -32,9 +34,11 @@ type publisher struct {
 // Publisher wraps messaging Publisher exposing
 // Close() method for NATS connection.
 
-// NewPublisher returns NATS message Publisher.
-func NewPublisher(url string) (messaging.Publisher, error) {
-	conn, err := broker.Connect(url, broker.MaxReconnects(maxReconnects))
+// NewPublisher returns NATS message Publisher. Additional connection
+// options, such as TLS client certificates, can be provided.
+func NewPublisher(url string, opts ...broker.Option) (messaging.Publisher, error) {
+	opts = append([]broker.Option{broker.MaxReconnects(maxReconnects)}, opts...)
+	conn, err := broker.Connect(url, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +48,7 @@ func NewPublisher(url string) (messaging.Publisher, error) {
 	return ret, nil
 }
 func (pub *publisher) Publish(msg protomfx.Message) (err error) {
-	format, err := getFormat(msg.ProfileConfig.ContentType)
+	subject, err := writeSubject(&msg)
 	if err != nil {
 		return err
 	}
@@ -55,15 +59,48 @@ func (pub *publisher) Publish(msg protomfx.Message) (err error) {
 	}
 
 	var subjects []string
-	subject := fmt.Sprintf("%s.%s", format, messagesSuffix)
-	if msg.ProfileConfig.Write {
-		if msg.Subtopic != "" {
-			subject = fmt.Sprintf("%s.%s", subject, msg.Subtopic)
+	if subject != "" {
+		subjects = append(subjects, subject)
+	}
+	subjects = append(subjects, notifySubjects(msg)...)
+
+	for _, subject := range subjects {
+		if err := pub.conn.Publish(subject, data); err != nil {
+			return err
 		}
+	}
 
-		subjects = append(subjects, subject)
+	return nil
+}
+
+// writeSubject returns the subject the writers consume the message from, or
+// an empty string if the message isn't written. The transformer of the written
+// message is resolved and stored in the message, and its format determines the
+// subject, so that the consumers of the subject receive the messages they're
+// able to transform.
+func writeSubject(msg *protomfx.Message) (string, error) {
+	if !msg.ProfileConfig.Write {
+		return "", nil
+	}
+
+	msg.Transformer = messaging.TransformerName(*msg)
+	format, err := transformers.Format(msg.Transformer)
+	if err != nil {
+		return "", err
 	}
 
+	subject := fmt.Sprintf("%s.%s", format, messagesSuffix)
+	if msg.Subtopic != "" {
+		subject = fmt.Sprintf("%s.%s", subject, msg.Subtopic)
+	}
+
+	return subject, nil
+}
+
+// notifySubjects returns the subjects of the notifiers, webhooks and alarms
+// the message is published to.
+func notifySubjects(msg protomfx.Message) []string {
+	var subjects []string
 	if msg.ProfileConfig.SmtpID != "" {
 		subjects = append(subjects, subjectSMTP)
 	}
@@ -76,29 +113,14 @@ func (pub *publisher) Publish(msg protomfx.Message) (err error) {
 		subjects = append(subjects, subjectWebhook)
 	}
 
-	for _, subject := range subjects {
-		if err := pub.conn.Publish(subject, data); err != nil {
-			return err
-		}
+	if msg.Protocol == messaging.AlarmProtocol {
+		subjects = append(subjects, subjectAlarms)
 	}
 
-	return nil
+	return subjects
 }
 
 func (pub *publisher) Close() error {
 	pub.conn.Close()
 	return nil
 }
-
-func getFormat(ct string) (format string, err error) {
-	switch ct {
-	case messaging.JSONContentType:
-		return messaging.JSONFormat, nil
-	case messaging.SenMLContentType:
-		return messaging.SenMLFormat, nil
-	case messaging.CBORContentType:
-		return messaging.CBORFormat, nil
-	default:
-		return messaging.SenMLFormat, nil
-	}
-}
@@ -156,6 +156,26 @@ func (ps *pubsub) natsHandler(h messaging.MessageHandler) broker.MsgHandler {
 		}
 		if err := h.Handle(msg); err != nil {
 			ps.logger.Warn(fmt.Sprintf("Failed to handle Mainflux message: %s", err))
+			return
 		}
+		ps.confirm(h, m, msg)
+	}
+}
+
+// confirm confirms the persistence of the handled message to the publisher
+// awaiting the confirmation, if the handler confirms the message.
+func (ps *pubsub) confirm(h messaging.MessageHandler, m *broker.Msg, msg protomfx.Message) {
+	inbox := m.Header.Get(ackHeader)
+	if inbox == "" {
+		return
+	}
+
+	c, ok := h.(messaging.Confirmer)
+	if !ok || !c.Confirms(msg) {
+		return
+	}
+
+	if err := ps.conn.Publish(inbox, nil); err != nil {
+		ps.logger.Warn(fmt.Sprintf("Failed to confirm Mainflux message: %s", err))
 	}
 }
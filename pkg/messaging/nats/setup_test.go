@@ -20,6 +20,7 @@ import (
 var (
 	publisher messaging.Publisher
 	pubsub    messaging.PubSub
+	address   string
 )
 
 func TestMain(m *testing.M) {
@@ -28,13 +29,14 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Could not connect to docker: %s", err)
 	}
 
-	container, err := pool.Run("nats", "1.3.0", []string{})
+	// The persistence confirmation requires the message headers.
+	container, err := pool.Run("nats", "2.9.25", []string{})
 	if err != nil {
 		log.Fatalf("Could not start container: %s", err)
 	}
 	handleInterrupt(pool, container)
 
-	address := fmt.Sprintf("%s:%s", "localhost", container.GetPort("4222/tcp"))
+	address = fmt.Sprintf("%s:%s", "localhost", container.GetPort("4222/tcp"))
 	if err := pool.Retry(func() error {
 		publisher, err = nats.NewPublisher(address)
 		return err
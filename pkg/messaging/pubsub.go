@@ -4,6 +4,7 @@
 package messaging
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"regexp"
@@ -20,10 +21,18 @@ const (
 	SenMLFormat      = "senml"
 	JSONFormat       = "json"
 	CBORFormat       = "cbor"
+	AlarmProtocol    = "alarm"
 	regExParts       = 2
 )
 
-var subtopicRegExp = regexp.MustCompile(`(?:^/profiles/[\w\-]+)?/messages(/[^?]*)?(\?.*)?$`)
+// AckWriter is the persistence confirmation level which confirms that the
+// published message was persisted by the primary writer.
+const AckWriter = "writer"
+
+var (
+	subtopicRegExp = regexp.MustCompile(`(?:^/(?:profiles|things)/[\w\-]+)?/messages(/[^?]*)?(\?.*)?$`)
+	thingIDRegExp  = regexp.MustCompile(`^/things/([\w\-]+)/messages(?:/[^?]*)?(?:\?.*)?$`)
+)
 
 var (
 	// ErrConnect indicates that connection to MQTT broker failed
@@ -52,6 +61,15 @@ var (
 
 	// ErrEmptyID indicates the absence of ID.
 	ErrEmptyID = errors.New("empty ID")
+
+	// ErrInvalidAck indicates the unknown persistence confirmation level.
+	ErrInvalidAck = errors.New("invalid persistence confirmation level")
+
+	// ErrAckUnsupported indicates that the message broker doesn't confirm the persistence of the messages.
+	ErrAckUnsupported = errors.New("persistence confirmation not supported by the message broker")
+
+	// ErrAckTimeout indicates that the persistence of the message wasn't confirmed in time.
+	ErrAckTimeout = errors.New("persistence of the message not confirmed in time")
 )
 
 // Publisher specifies message publishing API.
@@ -63,6 +81,30 @@ type Publisher interface {
 	Close() error
 }
 
+// AckPublisher specifies the API of the message publisher which confirms the
+// persistence of the published messages.
+type AckPublisher interface {
+	Publisher
+
+	// PublishAck publishes the message and waits until its persistence is
+	// confirmed at the provided level, i.e. AckWriter.
+	PublishAck(ctx context.Context, msg protomfx.Message, level string) error
+}
+
+// AckConfig represents the configuration of the publisher which confirms
+// the persistence of the published messages.
+type AckConfig struct {
+	Timeout time.Duration
+}
+
+// Confirmer is implemented by the message handlers which confirm the
+// persistence of the handled messages to the publishers awaiting the
+// AckWriter confirmation.
+type Confirmer interface {
+	// Confirms reports whether the successfully handled message is confirmed.
+	Confirms(msg protomfx.Message) bool
+}
+
 // MessageHandler represents protomfx.Message handler for Subscriber.
 type MessageHandler interface {
 	// Handle handles messages passed by underlying implementation.
@@ -91,11 +133,24 @@ type PubSub interface {
 	Subscriber
 }
 
+// ValidAck reports whether the persistence confirmation level is valid. The
+// empty level disables the confirmation.
+func ValidAck(level string) bool {
+	switch level {
+	case "", AckWriter:
+		return true
+	default:
+		return false
+	}
+}
+
 func CreateMessage(pc *protomfx.PubConfByKeyRes, protocol, subject string, payload *[]byte) protomfx.Message {
 	msg := protomfx.Message{
 		Protocol:      protocol,
 		Subtopic:      subject,
 		Publisher:     pc.PublisherID,
+		OrgID:         pc.OrgID,
+		GroupID:       pc.GroupID,
 		Payload:       *payload,
 		Created:       time.Now().UnixNano(),
 		ProfileConfig: pc.ProfileConfig,
@@ -104,6 +159,12 @@ func CreateMessage(pc *protomfx.PubConfByKeyRes, protocol, subject string, paylo
 	return msg
 }
 
+// Expired reports whether the message expired by the given time. The messages
+// without the expiry never expire.
+func Expired(msg protomfx.Message, now time.Time) bool {
+	return msg.Expires != 0 && now.UnixNano() >= msg.Expires
+}
+
 func ExtractSubtopic(path string) (string, error) {
 	subtopicParts := subtopicRegExp.FindStringSubmatch(path)
 	if len(subtopicParts) < regExParts {
@@ -113,6 +174,17 @@ func ExtractSubtopic(path string) (string, error) {
 	return subtopicParts[1], nil
 }
 
+// ExtractThingID returns the ID of the thing on whose behalf the message is
+// published, if the path is the one of the gateway, or an empty string otherwise.
+func ExtractThingID(path string) string {
+	parts := thingIDRegExp.FindStringSubmatch(path)
+	if len(parts) < regExParts {
+		return ""
+	}
+
+	return parts[1]
+}
+
 func CreateSubject(subtopic string) (string, error) {
 	if subtopic == "" {
 		return subtopic, nil
@@ -0,0 +1,120 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// maxSchemas is the number of the compiled schemas cached by the adapters,
+// over which the cache is cleared.
+const maxSchemas = 1000
+
+var (
+	// ErrInvalidSchema indicates that the profile schema is malformed, or that
+	// it references the documents other than itself.
+	ErrInvalidSchema = errors.New("invalid payload schema")
+
+	// ErrInvalidPayload indicates that the payload doesn't match the profile schema.
+	ErrInvalidPayload = errors.New("payload doesn't match the profile schema")
+)
+
+var schemas = struct {
+	sync.Mutex
+	compiled map[string]*gojsonschema.Schema
+}{compiled: make(map[string]*gojsonschema.Schema)}
+
+// ValidateSchema checks that the schema is a valid JSON schema. The schema
+// may reference only its own definitions, so that the adapters never fetch
+// the referenced documents.
+func ValidateSchema(schema string) error {
+	_, err := compile(schema)
+	return err
+}
+
+// ValidatePayload validates the JSON and SenML payload against the schema of
+// the profile config. The payloads of the profiles without the schema, and
+// the payloads of the other content types, aren't validated.
+func ValidatePayload(conf *protomfx.Config, payload []byte) error {
+	schema := conf.GetSchema()
+	if schema == "" {
+		return nil
+	}
+
+	switch conf.GetContentType() {
+	case "", JSONContentType, SenMLContentType:
+	default:
+		return nil
+	}
+
+	s, err := compile(schema)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.Validate(gojsonschema.NewBytesLoader(payload))
+	if err != nil || !res.Valid() {
+		return ErrInvalidPayload
+	}
+
+	return nil
+}
+
+func compile(schema string) (*gojsonschema.Schema, error) {
+	schemas.Lock()
+	defer schemas.Unlock()
+
+	if s, ok := schemas.compiled[schema]; ok {
+		return s, nil
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil || !localRefs(doc) {
+		return nil, ErrInvalidSchema
+	}
+
+	s, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc))
+	if err != nil {
+		return nil, ErrInvalidSchema
+	}
+
+	if len(schemas.compiled) >= maxSchemas {
+		schemas.compiled = make(map[string]*gojsonschema.Schema)
+	}
+	schemas.compiled[schema] = s
+
+	return s, nil
+}
+
+// localRefs reports whether all references of the schema document point to
+// the document itself.
+func localRefs(doc interface{}) bool {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if key == "$ref" {
+				if s, ok := val.(string); ok && !strings.HasPrefix(s, "#") {
+					return false
+				}
+			}
+			if !localRefs(val) {
+				return false
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if !localRefs(val) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+const schema = `{"type": "object", "required": ["temp"], "properties": {"temp": {"$ref": "#/definitions/temp"}}, "definitions": {"temp": {"type": "number"}}}`
+
+func TestValidateSchema(t *testing.T) {
+	cases := []struct {
+		desc   string
+		schema string
+		err    error
+	}{
+		{
+			desc:   "validate schema",
+			schema: schema,
+			err:    nil,
+		},
+		{
+			desc:   "validate malformed schema",
+			schema: `{"type": `,
+			err:    messaging.ErrInvalidSchema,
+		},
+		{
+			desc:   "validate schema with invalid type",
+			schema: `{"type": 5}`,
+			err:    messaging.ErrInvalidSchema,
+		},
+		{
+			desc:   "validate schema with remote reference",
+			schema: `{"$ref": "http://example.com/schema.json"}`,
+			err:    messaging.ErrInvalidSchema,
+		},
+	}
+
+	for _, tc := range cases {
+		err := messaging.ValidateSchema(tc.schema)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestValidatePayload(t *testing.T) {
+	cases := []struct {
+		desc    string
+		config  *protomfx.Config
+		payload string
+		err     error
+	}{
+		{
+			desc:    "validate valid payload",
+			config:  &protomfx.Config{ContentType: messaging.JSONContentType, Schema: schema},
+			payload: `{"temp": 21.5}`,
+			err:     nil,
+		},
+		{
+			desc:    "validate payload without required field",
+			config:  &protomfx.Config{ContentType: messaging.JSONContentType, Schema: schema},
+			payload: `{"hum": 40}`,
+			err:     messaging.ErrInvalidPayload,
+		},
+		{
+			desc:    "validate payload with invalid field type",
+			config:  &protomfx.Config{ContentType: messaging.JSONContentType, Schema: schema},
+			payload: `{"temp": "hot"}`,
+			err:     messaging.ErrInvalidPayload,
+		},
+		{
+			desc:    "validate malformed payload",
+			config:  &protomfx.Config{ContentType: messaging.JSONContentType, Schema: schema},
+			payload: `{"temp": `,
+			err:     messaging.ErrInvalidPayload,
+		},
+		{
+			desc:    "validate SenML payload",
+			config:  &protomfx.Config{Schema: `{"type": "array"}`},
+			payload: `[{"n": "temp", "v": 21.5}]`,
+			err:     nil,
+		},
+		{
+			desc:    "validate payload without schema",
+			config:  &protomfx.Config{ContentType: messaging.JSONContentType},
+			payload: `{"hum": 40}`,
+			err:     nil,
+		},
+		{
+			desc:    "validate payload of content type without schema validation",
+			config:  &protomfx.Config{ContentType: messaging.CBORContentType, Schema: schema},
+			payload: "\xa1",
+			err:     nil,
+		},
+		{
+			desc:    "validate payload without config",
+			config:  nil,
+			payload: `{"hum": 40}`,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := messaging.ValidatePayload(tc.config, []byte(tc.payload))
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
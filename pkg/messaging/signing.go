@@ -0,0 +1,75 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrInvalidSignature indicates that the signature of the signed payload
+	// doesn't match the payload, the thing, or the signing key.
+	ErrInvalidSignature = errors.New("invalid payload signature")
+
+	// ErrExpiredSignature indicates that the signed payload is older than
+	// the allowed maximum age.
+	ErrExpiredSignature = errors.New("expired payload signature")
+)
+
+// SignedPayload represents the payload sent to the thing, signed using the
+// Ed25519 signing key of the org. The signature covers the ID of the thing
+// and the signing timestamp, so the payload can't be replayed to the other
+// things. The payload and the signature are base64 encoded in JSON.
+type SignedPayload struct {
+	ThingID   string `json:"thing_id"`
+	Timestamp int64  `json:"timestamp"`
+	Payload   []byte `json:"payload"`
+	Signature []byte `json:"signature"`
+}
+
+// SigningInput returns the bytes signed for the payload sent to the thing
+// at the timestamp, given in Unix nanoseconds.
+func SigningInput(thingID string, timestamp int64, payload []byte) []byte {
+	input := []byte(thingID + "\n" + strconv.FormatInt(timestamp, 10) + "\n")
+	return append(input, payload...)
+}
+
+// EncodeSignedPayload wraps the payload in the signed payload envelope. The payload
+// is returned unchanged if the signature is empty, i.e. if the org has no
+// signing key.
+func EncodeSignedPayload(thingID string, timestamp int64, payload, signature []byte) ([]byte, error) {
+	if len(signature) == 0 {
+		return payload, nil
+	}
+
+	return json.Marshal(SignedPayload{ThingID: thingID, Timestamp: timestamp, Payload: payload, Signature: signature})
+}
+
+// VerifyPayload verifies the signed payload envelope sent to the thing using
+// the Ed25519 public signing key of the org, and returns the payload. The
+// payload older than the max age is rejected, unless the max age is zero.
+func VerifyPayload(publicKey []byte, thingID string, maxAge time.Duration, data []byte) ([]byte, error) {
+	var sp SignedPayload
+	if err := json.Unmarshal(data, &sp); err != nil {
+		return nil, err
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize || sp.ThingID != thingID {
+		return nil, ErrInvalidSignature
+	}
+
+	if !ed25519.Verify(publicKey, SigningInput(sp.ThingID, sp.Timestamp, sp.Payload), sp.Signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	if maxAge > 0 && time.Since(time.Unix(0, sp.Timestamp)) > maxAge {
+		return nil, ErrExpiredSignature
+	}
+
+	return sp.Payload, nil
+}
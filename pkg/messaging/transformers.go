@@ -0,0 +1,43 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+)
+
+// TransformerName returns the name of the transformer of the message, which
+// is resolved once, when the message is published. The message is transformed
+// by the transformer referenced by its profile config, or by the transformer
+// of its content type if the config doesn't reference any.
+func TransformerName(msg protomfx.Message) string {
+	if msg.Transformer != "" {
+		return msg.Transformer
+	}
+
+	conf := msg.GetProfileConfig()
+	if name := conf.GetTransformer().GetName(); name != "" {
+		return name
+	}
+
+	if conf.GetContentType() == JSONContentType {
+		return json.TransformerName
+	}
+
+	return senml.TransformerName
+}
+
+// ValidateTransformer checks that the transformer referenced by the profile
+// config is registered, so that the messages of the profile are published.
+func ValidateTransformer(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	_, err := transformers.Get(name)
+	return err
+}
@@ -0,0 +1,60 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/go-kit/kit/metrics"
+)
+
+var (
+	_ messaging.Publisher = (*tenantPublisher)(nil)
+	_ messaging.PubSub    = (*tenantPubSub)(nil)
+)
+
+type tenantPublisher struct {
+	messaging.Publisher
+	counter metrics.Counter
+	tenants *Tenants
+}
+
+// TenantPublisher wraps the publisher so that the published messages are
+// counted per tenant, labelled with the org and the group of the publisher
+// as configured by tenants. The messages are counted by the adapters, since
+// they publish every message, while the pub confs are cached.
+func TenantPublisher(pub messaging.Publisher, counter metrics.Counter, tenants *Tenants) messaging.Publisher {
+	return &tenantPublisher{
+		Publisher: pub,
+		counter:   counter,
+		tenants:   tenants,
+	}
+}
+
+func (tp *tenantPublisher) Publish(msg protomfx.Message) error {
+	if err := tp.Publisher.Publish(msg); err != nil {
+		return err
+	}
+
+	tp.counter.With(tp.tenants.Labels(msg.OrgID, msg.GroupID)...).Add(1)
+	return nil
+}
+
+type tenantPubSub struct {
+	messaging.PubSub
+	pub messaging.Publisher
+}
+
+// TenantPubSub wraps the pubsub so that the published messages are counted
+// per tenant, as done by TenantPublisher.
+func TenantPubSub(ps messaging.PubSub, counter metrics.Counter, tenants *Tenants) messaging.PubSub {
+	return &tenantPubSub{
+		PubSub: ps,
+		pub:    TenantPublisher(ps, counter, tenants),
+	}
+}
+
+func (tps *tenantPubSub) Publish(msg protomfx.Message) error {
+	return tps.pub.Publish(msg)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/metrics"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	kitmetrics "github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+var errPublish = errors.New("failed to publish")
+
+type counterMock struct {
+	mu     *sync.Mutex
+	labels []string
+	counts map[string]float64
+}
+
+func newCounter() counterMock {
+	return counterMock{mu: &sync.Mutex{}, counts: make(map[string]float64)}
+}
+
+func (c counterMock) With(labelValues ...string) kitmetrics.Counter {
+	return counterMock{mu: c.mu, labels: append(append([]string{}, c.labels...), labelValues...), counts: c.counts}
+}
+
+func (c counterMock) Add(delta float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts[strings.Join(c.labels, ",")] += delta
+}
+
+func (c counterMock) count(labelValues ...string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.counts[strings.Join(labelValues, ",")]
+}
+
+type publisherMock struct {
+	err error
+}
+
+func (pub publisherMock) Publish(msg protomfx.Message) error {
+	return pub.err
+}
+
+func (pub publisherMock) Close() error {
+	return nil
+}
+
+func TestTenantPublisher(t *testing.T) {
+	cases := []struct {
+		desc  string
+		err   error
+		msg   protomfx.Message
+		org   string
+		group string
+		count float64
+	}{
+		{
+			desc:  "publish message of group",
+			msg:   protomfx.Message{OrgID: orgID, GroupID: groupID},
+			org:   orgID,
+			group: groupID,
+			count: 1,
+		},
+		{
+			desc:  "publish message without group",
+			msg:   protomfx.Message{OrgID: orgID},
+			org:   orgID,
+			group: emptyVal,
+			count: 1,
+		},
+		{
+			desc:  "publish message with failure",
+			err:   errPublish,
+			msg:   protomfx.Message{OrgID: otherID, GroupID: groupID},
+			org:   otherID,
+			group: groupID,
+			count: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		counter := newCounter()
+		tenants := metrics.NewTenants(metrics.TenantConfig{Enabled: true, Limit: 10})
+		pub := metrics.TenantPublisher(publisherMock{err: tc.err}, counter, tenants)
+
+		err := pub.Publish(tc.msg)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		count := counter.count(metrics.OrgLabel, tc.org, metrics.GroupLabel, tc.group)
+		assert.Equal(t, tc.count, count, fmt.Sprintf("%s: expected count %v got %v\n", tc.desc, tc.count, count))
+	}
+}
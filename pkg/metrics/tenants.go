@@ -0,0 +1,147 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics contains helpers shared by the service metrics middlewares.
+package metrics
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux"
+)
+
+const (
+	// OrgLabel is the name of the metric label holding the org.
+	OrgLabel = "org"
+	// GroupLabel is the name of the metric label holding the group.
+	GroupLabel = "group"
+	// OtherTenant is the label value of the tenants exceeding the limit.
+	OtherTenant = "other"
+
+	hashLen = 12
+
+	defTenantLabels      = "false"
+	defTenantLabelsHash  = "true"
+	defTenantLabelsLimit = "100"
+
+	envTenantLabels      = "MF_METRICS_TENANT_LABELS"
+	envTenantLabelsHash  = "MF_METRICS_TENANT_LABELS_HASH"
+	envTenantLabelsLimit = "MF_METRICS_TENANT_LABELS_LIMIT"
+)
+
+// TenantLabelNames are the names of the labels set by Tenants.Labels.
+var TenantLabelNames = []string{OrgLabel, GroupLabel}
+
+// TenantConfig represents the configuration of the tenant metric labels.
+type TenantConfig struct {
+	// Enabled sets the org and group labels. If it is false, the labels
+	// are left empty, so the metrics keep their global cardinality.
+	Enabled bool
+	// Hash replaces the org and group IDs with the prefix of their hash.
+	Hash bool
+	// Limit is the maximum number of distinct values of each label. The
+	// tenants exceeding it are labelled as OtherTenant. Zero means no limit.
+	Limit int
+}
+
+// LoadTenantConfig reads the tenant labels configuration shared by all
+// services from the environment.
+func LoadTenantConfig() (TenantConfig, error) {
+	enabled, err := strconv.ParseBool(mainflux.Env(envTenantLabels, defTenantLabels))
+	if err != nil {
+		return TenantConfig{}, fmt.Errorf("invalid %s value: %w", envTenantLabels, err)
+	}
+
+	hash, err := strconv.ParseBool(mainflux.Env(envTenantLabelsHash, defTenantLabelsHash))
+	if err != nil {
+		return TenantConfig{}, fmt.Errorf("invalid %s value: %w", envTenantLabelsHash, err)
+	}
+
+	limit, err := strconv.Atoi(mainflux.Env(envTenantLabelsLimit, defTenantLabelsLimit))
+	if err != nil || limit < 0 {
+		return TenantConfig{}, fmt.Errorf("invalid %s value: %s", envTenantLabelsLimit, mainflux.Env(envTenantLabelsLimit, defTenantLabelsLimit))
+	}
+
+	return TenantConfig{
+		Enabled: enabled,
+		Hash:    hash,
+		Limit:   limit,
+	}, nil
+}
+
+// Tenants converts the org and group IDs to metric label values, keeping
+// the number of distinct values of each label within the configured limit.
+type Tenants struct {
+	cfg    TenantConfig
+	orgs   *values
+	groups *values
+}
+
+// NewTenants returns tenant labels converter using the given configuration.
+func NewTenants(cfg TenantConfig) *Tenants {
+	return &Tenants{
+		cfg:    cfg,
+		orgs:   newValues(cfg.Limit),
+		groups: newValues(cfg.Limit),
+	}
+}
+
+// Enabled reports whether the tenant labels are set.
+func (t *Tenants) Enabled() bool {
+	return t.cfg.Enabled
+}
+
+// Labels returns the org and group label name and value pairs to be passed
+// to the metric With method.
+func (t *Tenants) Labels(orgID, groupID string) []string {
+	return []string{
+		OrgLabel, t.value(t.orgs, orgID),
+		GroupLabel, t.value(t.groups, groupID),
+	}
+}
+
+func (t *Tenants) value(vs *values, id string) string {
+	if !t.cfg.Enabled || id == "" {
+		return ""
+	}
+
+	if t.cfg.Hash {
+		sum := sha256.Sum256([]byte(id))
+		id = hex.EncodeToString(sum[:])[:hashLen]
+	}
+
+	return vs.get(id)
+}
+
+type values struct {
+	mu    sync.Mutex
+	limit int
+	seen  map[string]bool
+}
+
+func newValues(limit int) *values {
+	return &values{
+		limit: limit,
+		seen:  make(map[string]bool),
+	}
+}
+
+func (vs *values) get(value string) string {
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	if vs.seen[value] {
+		return value
+	}
+
+	if vs.limit > 0 && len(vs.seen) >= vs.limit {
+		return OtherTenant
+	}
+
+	vs.seen[value] = true
+	return value
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	orgID    = "1c5ff1a8-4d0f-4a52-9a8d-3c1c1d1b6b8a"
+	groupID  = "7f4e1bd6-54c1-4bd6-9cf3-2f6f3e0b2a11"
+	otherID  = "a3c5c2f4-6a40-4b57-8c2a-5b9d5c3e6f77"
+	hashLen  = 12
+	emptyVal = ""
+)
+
+func TestLabels(t *testing.T) {
+	cases := []struct {
+		desc    string
+		cfg     metrics.TenantConfig
+		orgID   string
+		groupID string
+		org     string
+		group   string
+	}{
+		{
+			desc:    "labels with tenant labels disabled",
+			cfg:     metrics.TenantConfig{Enabled: false, Hash: true, Limit: 1},
+			orgID:   orgID,
+			groupID: groupID,
+			org:     emptyVal,
+			group:   emptyVal,
+		},
+		{
+			desc:    "labels without hashing",
+			cfg:     metrics.TenantConfig{Enabled: true, Limit: 10},
+			orgID:   orgID,
+			groupID: groupID,
+			org:     orgID,
+			group:   groupID,
+		},
+		{
+			desc:    "labels without group",
+			cfg:     metrics.TenantConfig{Enabled: true, Limit: 10},
+			orgID:   orgID,
+			groupID: emptyVal,
+			org:     orgID,
+			group:   emptyVal,
+		},
+	}
+
+	for _, tc := range cases {
+		tenants := metrics.NewTenants(tc.cfg)
+		labels := tenants.Labels(tc.orgID, tc.groupID)
+		expected := []string{metrics.OrgLabel, tc.org, metrics.GroupLabel, tc.group}
+		assert.Equal(t, expected, labels, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, expected, labels))
+	}
+}
+
+func TestLabelsHash(t *testing.T) {
+	tenants := metrics.NewTenants(metrics.TenantConfig{Enabled: true, Hash: true})
+
+	first := tenants.Labels(orgID, groupID)
+	second := tenants.Labels(orgID, groupID)
+	assert.Equal(t, first, second, fmt.Sprintf("hashing the same IDs: expected %v got %v\n", first, second))
+	assert.NotEqual(t, orgID, first[1], fmt.Sprintf("hashing org ID: expected hash got %s\n", first[1]))
+	assert.Len(t, first[1], hashLen, fmt.Sprintf("hashing org ID: expected %d characters got %d\n", hashLen, len(first[1])))
+	assert.NotEqual(t, first[1], first[3], fmt.Sprintf("hashing distinct IDs: expected distinct hashes got %s\n", first[1]))
+}
+
+func TestLabelsLimit(t *testing.T) {
+	tenants := metrics.NewTenants(metrics.TenantConfig{Enabled: true, Limit: 1})
+
+	cases := []struct {
+		desc  string
+		orgID string
+		org   string
+	}{
+		{
+			desc:  "label first org",
+			orgID: orgID,
+			org:   orgID,
+		},
+		{
+			desc:  "label org exceeding limit",
+			orgID: otherID,
+			org:   metrics.OtherTenant,
+		},
+		{
+			desc:  "label already labelled org",
+			orgID: orgID,
+			org:   orgID,
+		},
+	}
+
+	for _, tc := range cases {
+		labels := tenants.Labels(tc.orgID, "")
+		assert.Equal(t, tc.org, labels[1], fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.org, labels[1]))
+	}
+}
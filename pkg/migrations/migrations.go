@@ -0,0 +1,239 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migrations applies versioned PostgreSQL database migrations.
+//
+// Migrations are applied while holding a PostgreSQL advisory lock, so that
+// replicas of a service starting at the same time do not apply them
+// concurrently.
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/jmoiron/sqlx"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+const (
+	dialect = "postgres"
+	// lockKey is the advisory lock key held while applying migrations.
+	// Advisory locks are scoped to the database, so a single key suffices.
+	lockKey = 2023111501
+
+	// Command is the service command line argument running migrations.
+	Command = "migrate"
+
+	cmdUp     = "up"
+	cmdDown   = "down"
+	cmdStatus = "status"
+)
+
+var (
+	// ErrMigrate indicates failure to execute database migrations.
+	ErrMigrate = errors.New("failed to execute database migrations")
+
+	// ErrSchemaDrift indicates that the applied migrations differ from the
+	// migrations known to the service.
+	ErrSchemaDrift = errors.New("database schema does not match service migrations")
+
+	// ErrInvalidCommand indicates a malformed migration command.
+	ErrInvalidCommand = errors.New("invalid migration command, expected up [n], down [n] or status")
+)
+
+// MigrationStatus represents the state of a single migration.
+type MigrationStatus struct {
+	ID        string
+	Applied   bool
+	AppliedAt time.Time
+	// Unknown indicates a migration applied to the database which is
+	// not known to the service.
+	Unknown bool
+}
+
+// Start prepares the database schema when the service starts. If apply is
+// true, all unapplied migrations are applied. Otherwise, the schema is
+// only checked for drift and ErrSchemaDrift is returned if migrations have
+// to be run manually first.
+func Start(db *sqlx.DB, src migrate.MigrationSource, apply bool) error {
+	if apply {
+		_, err := Up(db, src, 0)
+		return err
+	}
+
+	return CheckDrift(db, src)
+}
+
+// Up applies at most max unapplied migrations, or all of them if max is 0,
+// and returns the number of applied migrations.
+func Up(db *sqlx.DB, src migrate.MigrationSource, max int) (int, error) {
+	return exec(db, src, migrate.Up, max)
+}
+
+// Down rolls back at most max applied migrations, or all of them if max
+// is 0, and returns the number of rolled back migrations.
+func Down(db *sqlx.DB, src migrate.MigrationSource, max int) (int, error) {
+	return exec(db, src, migrate.Down, max)
+}
+
+func exec(db *sqlx.DB, src migrate.MigrationSource, dir migrate.MigrationDirection, max int) (int, error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, errors.Wrap(ErrMigrate, err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return 0, errors.Wrap(ErrMigrate, err)
+	}
+	defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", lockKey)
+
+	n, err := migrate.ExecMax(db.DB, dialect, src, dir, max)
+	if err != nil {
+		return n, errors.Wrap(ErrMigrate, err)
+	}
+
+	return n, nil
+}
+
+// Status returns the state of the migrations known to the service followed
+// by the unknown migrations applied to the database.
+func Status(db *sqlx.DB, src migrate.MigrationSource) ([]MigrationStatus, error) {
+	migrations, err := src.FindMigrations()
+	if err != nil {
+		return nil, errors.Wrap(ErrMigrate, err)
+	}
+
+	records, err := migrate.GetMigrationRecords(db.DB, dialect)
+	if err != nil {
+		return nil, errors.Wrap(ErrMigrate, err)
+	}
+
+	applied := make(map[string]time.Time)
+	for _, r := range records {
+		applied[r.Id] = r.AppliedAt
+	}
+
+	known := make(map[string]bool)
+	prefixes := make(map[string]bool)
+	var statuses []MigrationStatus
+	for _, m := range migrations {
+		at, ok := applied[m.Id]
+		statuses = append(statuses, MigrationStatus{ID: m.Id, Applied: ok, AppliedAt: at})
+		known[m.Id] = true
+		prefixes[prefix(m.Id)] = true
+	}
+
+	// Services may share the database, so only the migrations with
+	// the ID prefix of the service are considered.
+	for _, r := range records {
+		if !known[r.Id] && prefixes[prefix(r.Id)] {
+			statuses = append(statuses, MigrationStatus{ID: r.Id, Applied: true, AppliedAt: r.AppliedAt, Unknown: true})
+		}
+	}
+
+	return statuses, nil
+}
+
+// CheckDrift returns ErrSchemaDrift if there are unapplied migrations or
+// the database contains migrations unknown to the service.
+func CheckDrift(db *sqlx.DB, src migrate.MigrationSource) error {
+	statuses, err := Status(db, src)
+	if err != nil {
+		return err
+	}
+
+	var pending, unknown []string
+	for _, s := range statuses {
+		switch {
+		case s.Unknown:
+			unknown = append(unknown, s.ID)
+		case !s.Applied:
+			pending = append(pending, s.ID)
+		}
+	}
+
+	if len(pending) > 0 || len(unknown) > 0 {
+		return errors.Wrap(ErrSchemaDrift, fmt.Errorf("pending migrations: [%s], unknown migrations: [%s]", strings.Join(pending, ", "), strings.Join(unknown, ", ")))
+	}
+
+	return nil
+}
+
+// Run executes the migration command given by the command line arguments
+// following the migrate command and writes the result to out. Up applies
+// all unapplied migrations and down rolls back the last one, unless their
+// number is given.
+func Run(db *sqlx.DB, src migrate.MigrationSource, args []string, out io.Writer) error {
+	if len(args) < 1 || len(args) > 2 {
+		return ErrInvalidCommand
+	}
+
+	max := 0
+	if args[0] == cmdDown {
+		max = 1
+	}
+	if len(args) == 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return ErrInvalidCommand
+		}
+		max = n
+	}
+
+	switch args[0] {
+	case cmdUp:
+		n, err := Up(db, src, max)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Applied %d migrations\n", n)
+	case cmdDown:
+		n, err := Down(db, src, max)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Rolled back %d migrations\n", n)
+	case cmdStatus:
+		if len(args) != 1 {
+			return ErrInvalidCommand
+		}
+		statuses, err := Status(db, src)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			fmt.Fprintf(out, "%s\t%s\n", s.ID, state(s))
+		}
+	default:
+		return ErrInvalidCommand
+	}
+
+	return nil
+}
+
+func state(s MigrationStatus) string {
+	switch {
+	case s.Unknown:
+		return fmt.Sprintf("unknown, applied at %s", s.AppliedAt.Format(time.RFC3339))
+	case s.Applied:
+		return fmt.Sprintf("applied at %s", s.AppliedAt.Format(time.RFC3339))
+	default:
+		return "pending"
+	}
+}
+
+func prefix(id string) string {
+	if i := strings.LastIndex(id, "_"); i > 0 {
+		return id[:i]
+	}
+
+	return id
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
+	migrate "github.com/rubenv/sql-migrate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunInvalidCommand(t *testing.T) {
+	src := &migrate.MemoryMigrationSource{}
+
+	cases := []struct {
+		desc string
+		args []string
+	}{
+		{
+			desc: "run without command",
+			args: []string{},
+		},
+		{
+			desc: "run unknown command",
+			args: []string{"redo"},
+		},
+		{
+			desc: "run up with invalid count",
+			args: []string{"up", "all"},
+		},
+		{
+			desc: "run down with zero count",
+			args: []string{"down", "0"},
+		},
+		{
+			desc: "run status with count",
+			args: []string{"status", "1"},
+		},
+		{
+			desc: "run with too many arguments",
+			args: []string{"up", "1", "2"},
+		},
+	}
+
+	for _, tc := range cases {
+		err := migrations.Run(nil, src, tc.args, &bytes.Buffer{})
+		assert.True(t, errors.Contains(err, migrations.ErrInvalidCommand), fmt.Sprintf("%s: expected %s got %s", tc.desc, migrations.ErrInvalidCommand, err))
+	}
+}
@@ -5,6 +5,7 @@ package mocks
 
 import (
 	"context"
+	"crypto/ed25519"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -19,10 +20,19 @@ var _ protomfx.AuthServiceClient = (*authServiceMock)(nil)
 type authServiceMock struct {
 	roles        map[string]string
 	usersByEmail map[string]users.User
+	signingKeys  map[string]ed25519.PrivateKey
+	quotas       map[string]map[string]uint64
+	orgMembers   map[string][]string
 }
 
 // NewAuthService creates mock of users service.
 func NewAuthService(adminID string, userList []users.User) protomfx.AuthServiceClient {
+	return NewSigningAuthService(adminID, userList, map[string]ed25519.PrivateKey{})
+}
+
+// NewSigningAuthService creates mock of users service, which signs the
+// payloads using the provided signing keys of the orgs.
+func NewSigningAuthService(adminID string, userList []users.User, signingKeys map[string]ed25519.PrivateKey) protomfx.AuthServiceClient {
 	usersByEmail := make(map[string]users.User)
 	roles := map[string]string{auth.RootSub: adminID}
 
@@ -34,9 +44,31 @@ func NewAuthService(adminID string, userList []users.User) protomfx.AuthServiceC
 	return &authServiceMock{
 		roles:        roles,
 		usersByEmail: usersByEmail,
+		signingKeys:  signingKeys,
+		quotas:       map[string]map[string]uint64{},
+		orgMembers:   map[string][]string{},
 	}
 }
 
+// NewQuotaAuthService creates mock of users service, which limits the
+// resources of the orgs using the provided quotas, mapped by the org IDs.
+func NewQuotaAuthService(adminID string, userList []users.User, quotas map[string]map[string]uint64) protomfx.AuthServiceClient {
+	svc := NewAuthService(adminID, userList).(*authServiceMock)
+	svc.quotas = quotas
+
+	return svc
+}
+
+// NewMembersAuthService creates mock of users service, which limits the
+// members of the orgs to the provided member IDs, mapped by the org IDs.
+// All of the users are members of the orgs which aren't provided.
+func NewMembersAuthService(adminID string, userList []users.User, orgMembers map[string][]string) protomfx.AuthServiceClient {
+	svc := NewAuthService(adminID, userList).(*authServiceMock)
+	svc.orgMembers = orgMembers
+
+	return svc
+}
+
 func (svc authServiceMock) Identify(_ context.Context, in *protomfx.Token, _ ...grpc.CallOption) (*protomfx.UserIdentity, error) {
 	if u, ok := svc.usersByEmail[in.Value]; ok {
 		return &protomfx.UserIdentity{Id: u.ID, Email: u.Email}, nil
@@ -44,6 +76,23 @@ func (svc authServiceMock) Identify(_ context.Context, in *protomfx.Token, _ ...
 	return nil, errors.ErrAuthentication
 }
 
+func (svc authServiceMock) IdentifyBatch(ctx context.Context, in *protomfx.TokensReq, _ ...grpc.CallOption) (*protomfx.UserIdentitiesRes, error) {
+	ids := []*protomfx.UserIdentity{}
+	for _, token := range in.GetTokens() {
+		id, err := svc.Identify(ctx, &protomfx.Token{Value: token})
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return &protomfx.UserIdentitiesRes{Identities: ids}, nil
+}
+
+func (svc authServiceMock) IdentifyDelegated(_ context.Context, _ *protomfx.Token, _ ...grpc.CallOption) (*protomfx.DelegatedIdentity, error) {
+	return nil, errors.ErrAuthentication
+}
+
 func (svc authServiceMock) Issue(_ context.Context, in *protomfx.IssueReq, _ ...grpc.CallOption) (*protomfx.Token, error) {
 	if u, ok := svc.usersByEmail[in.GetEmail()]; ok {
 		switch in.Type {
@@ -76,9 +125,20 @@ func (svc authServiceMock) Authorize(_ context.Context, req *protomfx.AuthorizeR
 	return &empty.Empty{}, nil
 }
 
+func (svc authServiceMock) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	for _, ar := range req.GetRequests() {
+		if _, err := svc.Authorize(ctx, ar); err != nil {
+			return &empty.Empty{}, err
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (svc authServiceMock) canAccessOrg(userID, action string) error {
 	isOwner := svc.roles[auth.RootSub] == userID || svc.roles[auth.Owner] == userID
-	isEditor := isOwner || svc.roles[auth.Editor] == userID
+	isAdmin := isOwner || svc.roles[auth.Admin] == userID
+	isEditor := isAdmin || svc.roles[auth.Editor] == userID
 	isViewer := isEditor || svc.roles[auth.Viewer] == userID
 
 	switch action {
@@ -92,6 +152,11 @@ func (svc authServiceMock) canAccessOrg(userID, action string) error {
 			return errors.ErrAuthorization
 		}
 		return nil
+	case auth.Admin:
+		if !isAdmin {
+			return errors.ErrAuthorization
+		}
+		return nil
 	case auth.Editor:
 		if !isEditor {
 			return errors.ErrAuthorization
@@ -114,3 +179,78 @@ func (svc authServiceMock) AssignRole(_ context.Context, in *protomfx.AssignRole
 func (svc authServiceMock) RetrieveRole(_ context.Context, req *protomfx.RetrieveRoleReq, _ ...grpc.CallOption) (r *protomfx.RetrieveRoleRes, err error) {
 	panic("not implemented")
 }
+
+func (svc authServiceMock) AssignMembers(_ context.Context, req *protomfx.AssignMembersReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	u, ok := svc.usersByEmail[req.GetToken()]
+	if !ok {
+		return &empty.Empty{}, errors.ErrAuthentication
+	}
+
+	if err := svc.canAccessOrg(u.ID, auth.Owner); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (svc authServiceMock) SignPayload(_ context.Context, req *protomfx.SignPayloadReq, _ ...grpc.CallOption) (*protomfx.SignPayloadRes, error) {
+	if req.GetSecret() == "" {
+		return &protomfx.SignPayloadRes{}, errors.ErrAuthentication
+	}
+
+	key, ok := svc.signingKeys[req.GetOrgID()]
+	if !ok {
+		return &protomfx.SignPayloadRes{}, nil
+	}
+
+	return &protomfx.SignPayloadRes{Signature: ed25519.Sign(key, req.GetPayload())}, nil
+}
+
+func (svc authServiceMock) RetrieveSigningKey(_ context.Context, req *protomfx.SigningKeyReq, _ ...grpc.CallOption) (*protomfx.SigningKeyRes, error) {
+	key, ok := svc.signingKeys[req.GetOrgID()]
+	if !ok {
+		return &protomfx.SigningKeyRes{}, nil
+	}
+
+	return &protomfx.SigningKeyRes{PublicKey: key.Public().(ed25519.PublicKey)}, nil
+}
+
+func (svc authServiceMock) CheckQuota(_ context.Context, req *protomfx.CheckQuotaReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	limit := svc.quotas[req.GetOrgID()][req.GetResource()]
+	if limit > 0 && req.GetUsage()+req.GetCount() > limit {
+		return &empty.Empty{}, errors.ErrQuotaExceeded
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (svc authServiceMock) RemoveUser(_ context.Context, req *protomfx.RemoveUserReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	for email, u := range svc.usersByEmail {
+		if u.ID == req.GetId() {
+			delete(svc.usersByEmail, email)
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (svc authServiceMock) FilterOrgMembers(_ context.Context, req *protomfx.OrgMembersReq, _ ...grpc.CallOption) (*protomfx.OrgMembersRes, error) {
+	members, ok := svc.orgMembers[req.GetOrgID()]
+	if !ok {
+		for _, u := range svc.usersByEmail {
+			members = append(members, u.ID)
+		}
+	}
+
+	ids := []string{}
+	for _, id := range req.GetMemberIDs() {
+		for _, m := range members {
+			if m == id {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+
+	return &protomfx.OrgMembersRes{MemberIDs: ids}, nil
+}
@@ -4,6 +4,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 )
@@ -22,3 +24,18 @@ func (pub mockPublisher) Publish(msg protomfx.Message) error {
 func (pub mockPublisher) Close() error {
 	return nil
 }
+
+type mockAckPublisher struct {
+	mockPublisher
+	err error
+}
+
+// NewAckPublisher returns mock message publisher which confirms the persistence
+// of the messages, or fails with the provided error.
+func NewAckPublisher(err error) messaging.AckPublisher {
+	return mockAckPublisher{err: err}
+}
+
+func (pub mockAckPublisher) PublishAck(_ context.Context, _ protomfx.Message, _ string) error {
+	return pub.err
+}
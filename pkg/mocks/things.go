@@ -101,6 +101,10 @@ func (svc *mainfluxThings) ViewProfileByThing(context.Context, string, string) (
 	panic("not implemented")
 }
 
+func (svc *mainfluxThings) ListThingsByKeyPrefix(context.Context, string, string, things.PageMetadata) (things.ThingsPage, error) {
+	panic("not implemented")
+}
+
 func (svc *mainfluxThings) ListThingsByProfile(context.Context, string, string, things.PageMetadata) (things.ThingsPage, error) {
 	panic("not implemented")
 }
@@ -113,6 +117,26 @@ func (svc *mainfluxThings) Restore(context.Context, string, things.Backup) error
 	panic("not implemented")
 }
 
+func (svc *mainfluxThings) ListGroupAccess(context.Context, string) ([]things.GroupAccess, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) RebuildCache(context.Context, string) (things.CacheStats, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ListConsumerGroups(context.Context, string) ([]things.ConsumerGroup, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ResetConsumerGroup(context.Context, string, string, string) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ViewOrgCleanup(context.Context, string, string) (things.OrgCleanup, error) {
+	panic("not implemented")
+}
+
 func (svc *mainfluxThings) CreateProfiles(_ context.Context, token string, prs ...things.Profile) ([]things.Profile, error) {
 	svc.mu.Lock()
 	defer svc.mu.Unlock()
@@ -142,10 +166,14 @@ func (svc *mainfluxThings) GetPubConfByKey(context.Context, string) (things.PubC
 	panic("not implemented")
 }
 
-func (svc *mainfluxThings) GetConfigByThingID(_ context.Context, thingID string) (map[string]interface{}, error) {
+func (svc *mainfluxThings) GetConfigByThingID(_ context.Context, thingID string) (things.Profile, error) {
 	panic("implement me")
 }
 
+func (svc *mainfluxThings) GetPubConfs(context.Context, things.PageMetadata) (things.PubConfsPage, error) {
+	panic("not implemented")
+}
+
 func (svc *mainfluxThings) Authorize(context.Context, things.AuthorizeReq) error {
 	panic("not implemented")
 }
@@ -158,6 +186,14 @@ func (svc *mainfluxThings) GetGroupIDByThingID(_ context.Context, thingID string
 	panic("implement me")
 }
 
+func (svc *mainfluxThings) GetStats(context.Context) (things.Stats, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) GetOrgStats(context.Context, string) (things.Stats, error) {
+	panic("not implemented")
+}
+
 func (svc *mainfluxThings) ListThingsByGroup(_ context.Context, token, groupID string, pm things.PageMetadata) (things.ThingsPage, error) {
 	panic("not implemented")
 }
@@ -178,16 +214,31 @@ func (svc *mainfluxThings) RemoveGroups(_ context.Context, token string, ids ...
 	panic("not implemented")
 }
 
+func (svc *mainfluxThings) RemoveGroupsByOrg(_ context.Context, orgID string) ([]string, error) {
+	panic("not implemented")
+}
+
 func (svc *mainfluxThings) UpdateGroup(_ context.Context, token string, group things.Group) (things.Group, error) {
 	panic("not implemented")
 }
 
+func (svc *mainfluxThings) TransferGroup(_ context.Context, token, groupID, orgID string) (things.Group, error) {
+	panic("not implemented")
+}
+
 func (svc *mainfluxThings) ViewGroup(_ context.Context, token, id string) (things.Group, error) {
 	panic("not implemented")
 }
 
 func (svc *mainfluxThings) ViewGroupByThing(_ context.Context, token string, thingID string) (things.Group, error) {
-	panic("not implemented")
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	if t, ok := svc.things[thingID]; ok {
+		return things.Group{ID: t.GroupID}, nil
+	}
+
+	return things.Group{}, errors.ErrNotFound
 }
 
 func (svc *mainfluxThings) ViewGroupByProfile(_ context.Context, token string, profileID string) (things.Group, error) {
@@ -213,3 +264,87 @@ func (svc *mainfluxThings) UpdateRolesByGroup(_ context.Context, token string, g
 func (svc *mainfluxThings) RemoveRolesByGroup(_ context.Context, token, groupID string, memberIDs ...string) error {
 	panic("not implemented")
 }
+
+func (svc *mainfluxThings) RemoveRolesByMember(_ context.Context, memberID string) ([]string, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) RemoveRolesByOrgMember(_ context.Context, orgID, memberID string) ([]string, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) CreateShare(context.Context, string, string, things.Share) (things.Share, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ListSharesByThing(context.Context, string, string) ([]things.Share, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) RemoveShare(context.Context, string, string, string) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) GetPubConfByShare(context.Context, string, string) (things.PubConfInfo, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) UpdateGateway(context.Context, string, things.Gateway) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ViewGateway(context.Context, string, string) (things.Gateway, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) RemoveGateway(context.Context, string, string) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) GetPubConfByGateway(context.Context, string, string) (things.PubConfInfo, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) UpdateThingACL(context.Context, string, string, things.NetworkACL) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ViewThingACL(context.Context, string, string) (things.NetworkACL, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) UpdateOrgACL(context.Context, string, string, things.NetworkACL) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ViewOrgACL(context.Context, string, string) (things.NetworkACL, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) RemoveOrgACL(context.Context, string) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) UpdateOrgTemplate(context.Context, string, things.OrgTemplate) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ViewOrgTemplate(context.Context, string) (things.OrgTemplate, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) RemoveOrgTemplate(context.Context, string) error {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ProvisionOrg(context.Context, string, string) ([]things.Group, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ExportGroup(context.Context, string, string) (things.GroupExport, error) {
+	panic("not implemented")
+}
+
+func (svc *mainfluxThings) ImportGroup(context.Context, string, string, things.GroupExport) (things.ImportResult, error) {
+	panic("not implemented")
+}
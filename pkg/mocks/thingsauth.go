@@ -5,8 +5,10 @@ package mocks
 
 import (
 	"context"
+	"sort"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -50,7 +52,27 @@ func (svc thingsServiceMock) GetPubConfByKey(_ context.Context, in *protomfx.Pub
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	return &protomfx.PubConfByKeyRes{PublisherID: svc.things[key]}, nil
+	// The restricted thing is allowed to connect only from the documentation
+	// network, so the clients connecting from the loopback are denied.
+	if key == "restricted" {
+		acls := []*protomfx.NetworkACL{{Allow: []string{"192.0.2.0/24"}}}
+		return &protomfx.PubConfByKeyRes{PublisherID: svc.things[key], NetworkACLs: acls}, nil
+	}
+
+	// The compressed thing exchanges the gzip compressed payloads.
+	if key == "compressed" {
+		config := &protomfx.Config{ContentEncoding: messaging.GzipEncoding}
+		return &protomfx.PubConfByKeyRes{PublisherID: svc.things[key], ProfileConfig: config}, nil
+	}
+
+	// The limited thing has the MQTT session parameters of the battery
+	// devices, which are kept to the single inflight message.
+	if key == "limited" {
+		mqtt := &protomfx.MQTTConfig{KeepAlive: 1, MaxInflight: 1, ReceiveMaximum: 1, MaxPacketSize: 256}
+		return &protomfx.PubConfByKeyRes{PublisherID: svc.things[key], ProfileConfig: &protomfx.Config{Mqtt: mqtt}}, nil
+	}
+
+	return &protomfx.PubConfByKeyRes{PublisherID: svc.things[key], ProfileID: svc.profiles[key]}, nil
 }
 
 func (svc thingsServiceMock) GetConfigByThingID(_ context.Context, in *protomfx.ThingID, _ ...grpc.CallOption) (*protomfx.ConfigByThingIDRes, error) {
@@ -85,6 +107,16 @@ func (svc thingsServiceMock) Authorize(_ context.Context, in *protomfx.Authorize
 	return &empty.Empty{}, errors.ErrAuthorization
 }
 
+func (svc thingsServiceMock) AuthorizeBatch(ctx context.Context, in *protomfx.AuthorizeBatchReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	for _, ar := range in.GetRequests() {
+		if _, err := svc.Authorize(ctx, ar); err != nil {
+			return &empty.Empty{}, err
+		}
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (svc thingsServiceMock) Identify(_ context.Context, token *protomfx.Token, _ ...grpc.CallOption) (*protomfx.ThingID, error) {
 	if c, ok := svc.things[token.GetValue()]; ok {
 		return &protomfx.ThingID{Value: c}, nil
@@ -92,11 +124,24 @@ func (svc thingsServiceMock) Identify(_ context.Context, token *protomfx.Token,
 	return nil, errors.ErrAuthentication
 }
 
+func (svc thingsServiceMock) IdentifyBatch(ctx context.Context, in *protomfx.TokensReq, _ ...grpc.CallOption) (*protomfx.ThingIDsRes, error) {
+	ids := []string{}
+	for _, token := range in.GetTokens() {
+		id, err := svc.Identify(ctx, &protomfx.Token{Value: token})
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id.GetValue())
+	}
+
+	return &protomfx.ThingIDsRes{Ids: ids}, nil
+}
+
 func (svc thingsServiceMock) GetGroupsByIDs(_ context.Context, req *protomfx.GroupsReq, _ ...grpc.CallOption) (*protomfx.GroupsRes, error) {
 	var groups []*protomfx.Group
 	for _, id := range req.Ids {
 		if group, ok := svc.groups[id]; ok {
-			groups = append(groups, &protomfx.Group{Id: group.ID, Name: group.Name, Description: group.Description})
+			groups = append(groups, &protomfx.Group{Id: group.ID, OrgID: group.OrgID, Name: group.Name, Description: group.Description})
 		}
 	}
 
@@ -109,3 +154,74 @@ func (svc thingsServiceMock) GetGroupIDByThingID(_ context.Context, in *protomfx
 	}
 	return nil, errors.ErrNotFound
 }
+
+func (svc thingsServiceMock) GetPubConfs(_ context.Context, in *protomfx.PubConfsReq, _ ...grpc.CallOption) (*protomfx.PubConfsRes, error) {
+	keys := make([]string, 0, len(svc.things))
+	for key := range svc.things {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pcs := []*protomfx.ThingPubConf{}
+	for i := in.GetOffset(); i < uint64(len(keys)) && i < in.GetOffset()+in.GetLimit(); i++ {
+		pcs = append(pcs, &protomfx.ThingPubConf{Key: keys[i], PublisherID: svc.things[keys[i]]})
+	}
+
+	return &protomfx.PubConfsRes{PubConfs: pcs, Total: uint64(len(keys))}, nil
+}
+
+func (svc thingsServiceMock) GetPubConfByShare(_ context.Context, in *protomfx.PubConfByShareReq, _ ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	id, ok := svc.things[in.GetKey()]
+	if !ok {
+		return nil, errors.ErrAuthentication
+	}
+
+	return &protomfx.PubConfByKeyRes{PublisherID: id, ShareID: in.GetKey()}, nil
+}
+
+func (svc thingsServiceMock) GetPubConfByGateway(_ context.Context, in *protomfx.PubConfByGatewayReq, _ ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	gwID, ok := svc.things[in.GetKey()]
+	if !ok {
+		return nil, errors.ErrAuthentication
+	}
+
+	// Any other existing thing is considered to be the child of the gateway.
+	for _, id := range svc.things {
+		if id == in.GetThingID() && id != gwID {
+			return &protomfx.PubConfByKeyRes{PublisherID: id}, nil
+		}
+	}
+
+	return nil, errors.ErrAuthorization
+}
+
+func (svc thingsServiceMock) GetStats(_ context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*protomfx.ThingsStatsRes, error) {
+	return &protomfx.ThingsStatsRes{
+		Things:   uint64(len(svc.things)),
+		Profiles: uint64(len(svc.profiles)),
+		Groups:   uint64(len(svc.groups)),
+	}, nil
+}
+
+func (svc thingsServiceMock) GetOrgStats(_ context.Context, in *protomfx.OrgStatsReq, _ ...grpc.CallOption) (*protomfx.ThingsStatsRes, error) {
+	grIDs := make(map[string]bool)
+	for _, gr := range svc.groups {
+		if gr.OrgID == in.GetOrgID() {
+			grIDs[gr.ID] = true
+		}
+	}
+
+	st := &protomfx.ThingsStatsRes{Groups: uint64(len(grIDs))}
+	for _, grID := range svc.things {
+		if grIDs[grID] {
+			st.Things++
+		}
+	}
+	for _, grID := range svc.profiles {
+		if grIDs[grID] {
+			st.Profiles++
+		}
+	}
+
+	return st, nil
+}
@@ -35,6 +35,10 @@ type Message struct {
 	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
 	Created              int64    `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"`
 	ProfileConfig        *Config  `protobuf:"bytes,7,opt,name=profileConfig,proto3" json:"profileConfig,omitempty"`
+	OrgID                string   `protobuf:"bytes,8,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	Expires              int64    `protobuf:"varint,9,opt,name=expires,proto3" json:"expires,omitempty"`
+	GroupID              string   `protobuf:"bytes,10,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	Transformer          string   `protobuf:"bytes,11,opt,name=transformer,proto3" json:"transformer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -122,6 +126,34 @@ func (m *Message) GetProfileConfig() *Config {
 	return nil
 }
 
+func (m *Message) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
+	}
+	return ""
+}
+
+func (m *Message) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *Message) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *Message) GetTransformer() string {
+	if m != nil {
+		return m.Transformer
+	}
+	return ""
+}
+
 type PubConfByKeyReq struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -170,11 +202,17 @@ func (m *PubConfByKeyReq) GetKey() string {
 }
 
 type PubConfByKeyRes struct {
-	PublisherID          string   `protobuf:"bytes,1,opt,name=publisherID,proto3" json:"publisherID,omitempty"`
-	ProfileConfig        *Config  `protobuf:"bytes,2,opt,name=profileConfig,proto3" json:"profileConfig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PublisherID          string        `protobuf:"bytes,1,opt,name=publisherID,proto3" json:"publisherID,omitempty"`
+	ProfileConfig        *Config       `protobuf:"bytes,2,opt,name=profileConfig,proto3" json:"profileConfig,omitempty"`
+	OrgID                string        `protobuf:"bytes,3,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	NetworkACLs          []*NetworkACL `protobuf:"bytes,4,rep,name=networkACLs,proto3" json:"networkACLs,omitempty"`
+	ProfileID            string        `protobuf:"bytes,5,opt,name=profileID,proto3" json:"profileID,omitempty"`
+	GroupID              string        `protobuf:"bytes,6,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	ShareID              string        `protobuf:"bytes,7,opt,name=shareID,proto3" json:"shareID,omitempty"`
+	ExpiresAt            int64         `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *PubConfByKeyRes) Reset()         { *m = PubConfByKeyRes{} }
@@ -224,30 +262,68 @@ func (m *PubConfByKeyRes) GetProfileConfig() *Config {
 	return nil
 }
 
-type Config struct {
-	ContentType          string       `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"`
-	Write                bool         `protobuf:"varint,2,opt,name=write,proto3" json:"write,omitempty"`
-	WebhookID            string       `protobuf:"bytes,3,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
-	SmtpID               string       `protobuf:"bytes,4,opt,name=smtpID,proto3" json:"smtpID,omitempty"`
-	SmppID               string       `protobuf:"bytes,5,opt,name=smppID,proto3" json:"smppID,omitempty"`
-	Transformer          *Transformer `protobuf:"bytes,6,opt,name=transformer,proto3" json:"transformer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *PubConfByKeyRes) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
+	}
+	return ""
 }
 
-func (m *Config) Reset()         { *m = Config{} }
-func (m *Config) String() string { return proto.CompactTextString(m) }
-func (*Config) ProtoMessage()    {}
-func (*Config) Descriptor() ([]byte, []int) {
+func (m *PubConfByKeyRes) GetNetworkACLs() []*NetworkACL {
+	if m != nil {
+		return m.NetworkACLs
+	}
+	return nil
+}
+
+func (m *PubConfByKeyRes) GetProfileID() string {
+	if m != nil {
+		return m.ProfileID
+	}
+	return ""
+}
+
+func (m *PubConfByKeyRes) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *PubConfByKeyRes) GetShareID() string {
+	if m != nil {
+		return m.ShareID
+	}
+	return ""
+}
+
+func (m *PubConfByKeyRes) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type PubConfByShareReq struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PubConfByShareReq) Reset()         { *m = PubConfByShareReq{} }
+func (m *PubConfByShareReq) String() string { return proto.CompactTextString(m) }
+func (*PubConfByShareReq) ProtoMessage()    {}
+func (*PubConfByShareReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4f5c89a6f82d4869, []int{3}
 }
-func (m *Config) XXX_Unmarshal(b []byte) error {
+func (m *PubConfByShareReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PubConfByShareReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Config.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PubConfByShareReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -257,79 +333,107 @@ func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Config) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Config.Merge(m, src)
+func (m *PubConfByShareReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubConfByShareReq.Merge(m, src)
 }
-func (m *Config) XXX_Size() int {
+func (m *PubConfByShareReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *Config) XXX_DiscardUnknown() {
-	xxx_messageInfo_Config.DiscardUnknown(m)
+func (m *PubConfByShareReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubConfByShareReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Config proto.InternalMessageInfo
+var xxx_messageInfo_PubConfByShareReq proto.InternalMessageInfo
 
-func (m *Config) GetContentType() string {
+func (m *PubConfByShareReq) GetKey() string {
 	if m != nil {
-		return m.ContentType
+		return m.Key
 	}
 	return ""
 }
 
-func (m *Config) GetWrite() bool {
+func (m *PubConfByShareReq) GetPassword() string {
 	if m != nil {
-		return m.Write
+		return m.Password
 	}
-	return false
+	return ""
 }
 
-func (m *Config) GetWebhookID() string {
-	if m != nil {
-		return m.WebhookID
-	}
-	return ""
+type PubConfByGatewayReq struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ThingID              string   `protobuf:"bytes,2,opt,name=thingID,proto3" json:"thingID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Config) GetSmtpID() string {
-	if m != nil {
-		return m.SmtpID
+func (m *PubConfByGatewayReq) Reset()         { *m = PubConfByGatewayReq{} }
+func (m *PubConfByGatewayReq) String() string { return proto.CompactTextString(m) }
+func (*PubConfByGatewayReq) ProtoMessage()    {}
+func (*PubConfByGatewayReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{4}
+}
+func (m *PubConfByGatewayReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubConfByGatewayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubConfByGatewayReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *PubConfByGatewayReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubConfByGatewayReq.Merge(m, src)
+}
+func (m *PubConfByGatewayReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubConfByGatewayReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubConfByGatewayReq.DiscardUnknown(m)
 }
 
-func (m *Config) GetSmppID() string {
+var xxx_messageInfo_PubConfByGatewayReq proto.InternalMessageInfo
+
+func (m *PubConfByGatewayReq) GetKey() string {
 	if m != nil {
-		return m.SmppID
+		return m.Key
 	}
 	return ""
 }
 
-func (m *Config) GetTransformer() *Transformer {
+func (m *PubConfByGatewayReq) GetThingID() string {
 	if m != nil {
-		return m.Transformer
+		return m.ThingID
 	}
-	return nil
+	return ""
 }
 
-type ConfigByThingIDRes struct {
-	Config               *Config  `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+type PubConfsReq struct {
+	Offset               uint64   `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConfigByThingIDRes) Reset()         { *m = ConfigByThingIDRes{} }
-func (m *ConfigByThingIDRes) String() string { return proto.CompactTextString(m) }
-func (*ConfigByThingIDRes) ProtoMessage()    {}
-func (*ConfigByThingIDRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{4}
+func (m *PubConfsReq) Reset()         { *m = PubConfsReq{} }
+func (m *PubConfsReq) String() string { return proto.CompactTextString(m) }
+func (*PubConfsReq) ProtoMessage()    {}
+func (*PubConfsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{5}
 }
-func (m *ConfigByThingIDRes) XXX_Unmarshal(b []byte) error {
+func (m *PubConfsReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConfigByThingIDRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PubConfsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConfigByThingIDRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PubConfsReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -339,48 +443,57 @@ func (m *ConfigByThingIDRes) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ConfigByThingIDRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConfigByThingIDRes.Merge(m, src)
+func (m *PubConfsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubConfsReq.Merge(m, src)
 }
-func (m *ConfigByThingIDRes) XXX_Size() int {
+func (m *PubConfsReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConfigByThingIDRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConfigByThingIDRes.DiscardUnknown(m)
+func (m *PubConfsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubConfsReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConfigByThingIDRes proto.InternalMessageInfo
+var xxx_messageInfo_PubConfsReq proto.InternalMessageInfo
 
-func (m *ConfigByThingIDRes) GetConfig() *Config {
+func (m *PubConfsReq) GetOffset() uint64 {
 	if m != nil {
-		return m.Config
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-type Transformer struct {
-	DataFilters          []string `protobuf:"bytes,1,rep,name=dataFilters,proto3" json:"dataFilters,omitempty"`
-	DataField            string   `protobuf:"bytes,2,opt,name=dataField,proto3" json:"dataField,omitempty"`
-	TimeField            string   `protobuf:"bytes,3,opt,name=timeField,proto3" json:"timeField,omitempty"`
-	TimeFormat           string   `protobuf:"bytes,4,opt,name=timeFormat,proto3" json:"timeFormat,omitempty"`
-	TimeLocation         string   `protobuf:"bytes,5,opt,name=timeLocation,proto3" json:"timeLocation,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PubConfsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *Transformer) Reset()         { *m = Transformer{} }
-func (m *Transformer) String() string { return proto.CompactTextString(m) }
-func (*Transformer) ProtoMessage()    {}
-func (*Transformer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{5}
+type ThingPubConf struct {
+	Key                  string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PublisherID          string        `protobuf:"bytes,2,opt,name=publisherID,proto3" json:"publisherID,omitempty"`
+	ProfileConfig        *Config       `protobuf:"bytes,3,opt,name=profileConfig,proto3" json:"profileConfig,omitempty"`
+	OrgID                string        `protobuf:"bytes,4,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	NetworkACLs          []*NetworkACL `protobuf:"bytes,5,rep,name=networkACLs,proto3" json:"networkACLs,omitempty"`
+	ProfileID            string        `protobuf:"bytes,6,opt,name=profileID,proto3" json:"profileID,omitempty"`
+	GroupID              string        `protobuf:"bytes,7,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *Transformer) XXX_Unmarshal(b []byte) error {
+
+func (m *ThingPubConf) Reset()         { *m = ThingPubConf{} }
+func (m *ThingPubConf) String() string { return proto.CompactTextString(m) }
+func (*ThingPubConf) ProtoMessage()    {}
+func (*ThingPubConf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{6}
+}
+func (m *ThingPubConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Transformer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ThingPubConf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Transformer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ThingPubConf.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -390,72 +503,87 @@ func (m *Transformer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Transformer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transformer.Merge(m, src)
+func (m *ThingPubConf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThingPubConf.Merge(m, src)
 }
-func (m *Transformer) XXX_Size() int {
+func (m *ThingPubConf) XXX_Size() int {
 	return m.Size()
 }
-func (m *Transformer) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transformer.DiscardUnknown(m)
+func (m *ThingPubConf) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThingPubConf.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Transformer proto.InternalMessageInfo
+var xxx_messageInfo_ThingPubConf proto.InternalMessageInfo
 
-func (m *Transformer) GetDataFilters() []string {
+func (m *ThingPubConf) GetKey() string {
 	if m != nil {
-		return m.DataFilters
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *Transformer) GetDataField() string {
+func (m *ThingPubConf) GetPublisherID() string {
 	if m != nil {
-		return m.DataField
+		return m.PublisherID
 	}
 	return ""
 }
 
-func (m *Transformer) GetTimeField() string {
+func (m *ThingPubConf) GetProfileConfig() *Config {
 	if m != nil {
-		return m.TimeField
+		return m.ProfileConfig
+	}
+	return nil
+}
+
+func (m *ThingPubConf) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
 	return ""
 }
 
-func (m *Transformer) GetTimeFormat() string {
+func (m *ThingPubConf) GetNetworkACLs() []*NetworkACL {
 	if m != nil {
-		return m.TimeFormat
+		return m.NetworkACLs
+	}
+	return nil
+}
+
+func (m *ThingPubConf) GetProfileID() string {
+	if m != nil {
+		return m.ProfileID
 	}
 	return ""
 }
 
-func (m *Transformer) GetTimeLocation() string {
+func (m *ThingPubConf) GetGroupID() string {
 	if m != nil {
-		return m.TimeLocation
+		return m.GroupID
 	}
 	return ""
 }
 
-type ThingID struct {
-	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+type NetworkACL struct {
+	Allow                []string `protobuf:"bytes,1,rep,name=allow,proto3" json:"allow,omitempty"`
+	Deny                 []string `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ThingID) Reset()         { *m = ThingID{} }
-func (m *ThingID) String() string { return proto.CompactTextString(m) }
-func (*ThingID) ProtoMessage()    {}
-func (*ThingID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{6}
+func (m *NetworkACL) Reset()         { *m = NetworkACL{} }
+func (m *NetworkACL) String() string { return proto.CompactTextString(m) }
+func (*NetworkACL) ProtoMessage()    {}
+func (*NetworkACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{7}
 }
-func (m *ThingID) XXX_Unmarshal(b []byte) error {
+func (m *NetworkACL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ThingID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NetworkACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ThingID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NetworkACL.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -465,44 +593,52 @@ func (m *ThingID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ThingID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ThingID.Merge(m, src)
+func (m *NetworkACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkACL.Merge(m, src)
 }
-func (m *ThingID) XXX_Size() int {
+func (m *NetworkACL) XXX_Size() int {
 	return m.Size()
 }
-func (m *ThingID) XXX_DiscardUnknown() {
-	xxx_messageInfo_ThingID.DiscardUnknown(m)
+func (m *NetworkACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkACL.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ThingID proto.InternalMessageInfo
+var xxx_messageInfo_NetworkACL proto.InternalMessageInfo
 
-func (m *ThingID) GetValue() string {
+func (m *NetworkACL) GetAllow() []string {
 	if m != nil {
-		return m.Value
+		return m.Allow
 	}
-	return ""
+	return nil
 }
 
-type GroupID struct {
-	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *NetworkACL) GetDeny() []string {
+	if m != nil {
+		return m.Deny
+	}
+	return nil
 }
 
-func (m *GroupID) Reset()         { *m = GroupID{} }
-func (m *GroupID) String() string { return proto.CompactTextString(m) }
-func (*GroupID) ProtoMessage()    {}
-func (*GroupID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{7}
+type PubConfsRes struct {
+	PubConfs             []*ThingPubConf `protobuf:"bytes,1,rep,name=pubConfs,proto3" json:"pubConfs,omitempty"`
+	Total                uint64          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *GroupID) XXX_Unmarshal(b []byte) error {
+
+func (m *PubConfsRes) Reset()         { *m = PubConfsRes{} }
+func (m *PubConfsRes) String() string { return proto.CompactTextString(m) }
+func (*PubConfsRes) ProtoMessage()    {}
+func (*PubConfsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{8}
+}
+func (m *PubConfsRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PubConfsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PubConfsRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -512,47 +648,61 @@ func (m *GroupID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GroupID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupID.Merge(m, src)
+func (m *PubConfsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubConfsRes.Merge(m, src)
 }
-func (m *GroupID) XXX_Size() int {
+func (m *PubConfsRes) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupID) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupID.DiscardUnknown(m)
+func (m *PubConfsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubConfsRes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupID proto.InternalMessageInfo
+var xxx_messageInfo_PubConfsRes proto.InternalMessageInfo
 
-func (m *GroupID) GetValue() string {
+func (m *PubConfsRes) GetPubConfs() []*ThingPubConf {
 	if m != nil {
-		return m.Value
+		return m.PubConfs
 	}
-	return ""
+	return nil
 }
 
-// If a token is not carrying any information itself, the type
-// field can be used to determine how to validate the token.
-// Also, different tokens can be encoded in different ways.
-type Token struct {
-	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PubConfsRes) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-func (m *Token) Reset()         { *m = Token{} }
-func (m *Token) String() string { return proto.CompactTextString(m) }
-func (*Token) ProtoMessage()    {}
-func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{8}
+type Config struct {
+	ContentType          string       `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Write                bool         `protobuf:"varint,2,opt,name=write,proto3" json:"write,omitempty"`
+	WebhookID            string       `protobuf:"bytes,3,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	SmtpID               string       `protobuf:"bytes,4,opt,name=smtpID,proto3" json:"smtpID,omitempty"`
+	SmppID               string       `protobuf:"bytes,5,opt,name=smppID,proto3" json:"smppID,omitempty"`
+	Transformer          *Transformer `protobuf:"bytes,6,opt,name=transformer,proto3" json:"transformer,omitempty"`
+	Writers              []string     `protobuf:"bytes,7,rep,name=writers,proto3" json:"writers,omitempty"`
+	ContentEncoding      string       `protobuf:"bytes,8,opt,name=contentEncoding,proto3" json:"contentEncoding,omitempty"`
+	Mqtt                 *MQTTConfig  `protobuf:"bytes,9,opt,name=mqtt,proto3" json:"mqtt,omitempty"`
+	Schema               string       `protobuf:"bytes,10,opt,name=schema,proto3" json:"schema,omitempty"`
+	Version              uint64       `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *Token) XXX_Unmarshal(b []byte) error {
+
+func (m *Config) Reset()         { *m = Config{} }
+func (m *Config) String() string { return proto.CompactTextString(m) }
+func (*Config) ProtoMessage()    {}
+func (*Config) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{9}
+}
+func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Config.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,165 +712,117 @@ func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Token) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Token.Merge(m, src)
+func (m *Config) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Config.Merge(m, src)
 }
-func (m *Token) XXX_Size() int {
+func (m *Config) XXX_Size() int {
 	return m.Size()
 }
-func (m *Token) XXX_DiscardUnknown() {
-	xxx_messageInfo_Token.DiscardUnknown(m)
+func (m *Config) XXX_DiscardUnknown() {
+	xxx_messageInfo_Config.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Token proto.InternalMessageInfo
+var xxx_messageInfo_Config proto.InternalMessageInfo
 
-func (m *Token) GetValue() string {
+func (m *Config) GetContentType() string {
 	if m != nil {
-		return m.Value
+		return m.ContentType
 	}
 	return ""
 }
 
-type UserIdentity struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Config) GetWrite() bool {
+	if m != nil {
+		return m.Write
+	}
+	return false
 }
 
-func (m *UserIdentity) Reset()         { *m = UserIdentity{} }
-func (m *UserIdentity) String() string { return proto.CompactTextString(m) }
-func (*UserIdentity) ProtoMessage()    {}
-func (*UserIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{9}
-}
-func (m *UserIdentity) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *UserIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_UserIdentity.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Config) GetWebhookID() string {
+	if m != nil {
+		return m.WebhookID
 	}
-}
-func (m *UserIdentity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserIdentity.Merge(m, src)
-}
-func (m *UserIdentity) XXX_Size() int {
-	return m.Size()
-}
-func (m *UserIdentity) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserIdentity.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_UserIdentity proto.InternalMessageInfo
-
-func (m *UserIdentity) GetId() string {
+func (m *Config) GetSmtpID() string {
 	if m != nil {
-		return m.Id
+		return m.SmtpID
 	}
 	return ""
 }
 
-func (m *UserIdentity) GetEmail() string {
+func (m *Config) GetSmppID() string {
 	if m != nil {
-		return m.Email
+		return m.SmppID
 	}
 	return ""
 }
 
-type IssueReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Type                 uint32   `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Config) GetTransformer() *Transformer {
+	if m != nil {
+		return m.Transformer
+	}
+	return nil
 }
 
-func (m *IssueReq) Reset()         { *m = IssueReq{} }
-func (m *IssueReq) String() string { return proto.CompactTextString(m) }
-func (*IssueReq) ProtoMessage()    {}
-func (*IssueReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{10}
-}
-func (m *IssueReq) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *IssueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_IssueReq.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Config) GetWriters() []string {
+	if m != nil {
+		return m.Writers
 	}
-}
-func (m *IssueReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IssueReq.Merge(m, src)
-}
-func (m *IssueReq) XXX_Size() int {
-	return m.Size()
-}
-func (m *IssueReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_IssueReq.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_IssueReq proto.InternalMessageInfo
-
-func (m *IssueReq) GetId() string {
+func (m *Config) GetContentEncoding() string {
 	if m != nil {
-		return m.Id
+		return m.ContentEncoding
 	}
 	return ""
 }
 
-func (m *IssueReq) GetEmail() string {
+func (m *Config) GetMqtt() *MQTTConfig {
 	if m != nil {
-		return m.Email
+		return m.Mqtt
+	}
+	return nil
+}
+
+func (m *Config) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
 	return ""
 }
 
-func (m *IssueReq) GetType() uint32 {
+func (m *Config) GetVersion() uint64 {
 	if m != nil {
-		return m.Type
+		return m.Version
 	}
 	return 0
 }
 
-type AuthorizeReq struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Object               string   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
-	Subject              string   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
-	Action               string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+type MQTTConfig struct {
+	KeepAlive            uint32   `protobuf:"varint,1,opt,name=keepAlive,proto3" json:"keepAlive,omitempty"`
+	MaxInflight          uint32   `protobuf:"varint,2,opt,name=maxInflight,proto3" json:"maxInflight,omitempty"`
+	ReceiveMaximum       uint32   `protobuf:"varint,3,opt,name=receiveMaximum,proto3" json:"receiveMaximum,omitempty"`
+	MaxPacketSize        uint32   `protobuf:"varint,4,opt,name=maxPacketSize,proto3" json:"maxPacketSize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthorizeReq) Reset()         { *m = AuthorizeReq{} }
-func (m *AuthorizeReq) String() string { return proto.CompactTextString(m) }
-func (*AuthorizeReq) ProtoMessage()    {}
-func (*AuthorizeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{11}
+func (m *MQTTConfig) Reset()         { *m = MQTTConfig{} }
+func (m *MQTTConfig) String() string { return proto.CompactTextString(m) }
+func (*MQTTConfig) ProtoMessage()    {}
+func (*MQTTConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{10}
 }
-func (m *AuthorizeReq) XXX_Unmarshal(b []byte) error {
+func (m *MQTTConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthorizeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MQTTConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthorizeReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MQTTConfig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -730,65 +832,65 @@ func (m *AuthorizeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AuthorizeReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthorizeReq.Merge(m, src)
+func (m *MQTTConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MQTTConfig.Merge(m, src)
 }
-func (m *AuthorizeReq) XXX_Size() int {
+func (m *MQTTConfig) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthorizeReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthorizeReq.DiscardUnknown(m)
+func (m *MQTTConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MQTTConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthorizeReq proto.InternalMessageInfo
+var xxx_messageInfo_MQTTConfig proto.InternalMessageInfo
 
-func (m *AuthorizeReq) GetToken() string {
+func (m *MQTTConfig) GetKeepAlive() uint32 {
 	if m != nil {
-		return m.Token
+		return m.KeepAlive
 	}
-	return ""
+	return 0
 }
 
-func (m *AuthorizeReq) GetObject() string {
+func (m *MQTTConfig) GetMaxInflight() uint32 {
 	if m != nil {
-		return m.Object
+		return m.MaxInflight
 	}
-	return ""
+	return 0
 }
 
-func (m *AuthorizeReq) GetSubject() string {
+func (m *MQTTConfig) GetReceiveMaximum() uint32 {
 	if m != nil {
-		return m.Subject
+		return m.ReceiveMaximum
 	}
-	return ""
+	return 0
 }
 
-func (m *AuthorizeReq) GetAction() string {
+func (m *MQTTConfig) GetMaxPacketSize() uint32 {
 	if m != nil {
-		return m.Action
+		return m.MaxPacketSize
 	}
-	return ""
+	return 0
 }
 
-type AuthorizeRes struct {
-	Authorized           bool     `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+type ConfigByThingIDRes struct {
+	Config               *Config  `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthorizeRes) Reset()         { *m = AuthorizeRes{} }
-func (m *AuthorizeRes) String() string { return proto.CompactTextString(m) }
-func (*AuthorizeRes) ProtoMessage()    {}
-func (*AuthorizeRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{12}
+func (m *ConfigByThingIDRes) Reset()         { *m = ConfigByThingIDRes{} }
+func (m *ConfigByThingIDRes) String() string { return proto.CompactTextString(m) }
+func (*ConfigByThingIDRes) ProtoMessage()    {}
+func (*ConfigByThingIDRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{11}
 }
-func (m *AuthorizeRes) XXX_Unmarshal(b []byte) error {
+func (m *ConfigByThingIDRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AuthorizeRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConfigByThingIDRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AuthorizeRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConfigByThingIDRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -798,46 +900,50 @@ func (m *AuthorizeRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AuthorizeRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthorizeRes.Merge(m, src)
+func (m *ConfigByThingIDRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigByThingIDRes.Merge(m, src)
 }
-func (m *AuthorizeRes) XXX_Size() int {
+func (m *ConfigByThingIDRes) XXX_Size() int {
 	return m.Size()
 }
-func (m *AuthorizeRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthorizeRes.DiscardUnknown(m)
+func (m *ConfigByThingIDRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigByThingIDRes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthorizeRes proto.InternalMessageInfo
+var xxx_messageInfo_ConfigByThingIDRes proto.InternalMessageInfo
 
-func (m *AuthorizeRes) GetAuthorized() bool {
+func (m *ConfigByThingIDRes) GetConfig() *Config {
 	if m != nil {
-		return m.Authorized
+		return m.Config
 	}
-	return false
+	return nil
 }
 
-type User struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Transformer struct {
+	DataFilters          []string          `protobuf:"bytes,1,rep,name=dataFilters,proto3" json:"dataFilters,omitempty"`
+	DataField            string            `protobuf:"bytes,2,opt,name=dataField,proto3" json:"dataField,omitempty"`
+	TimeField            string            `protobuf:"bytes,3,opt,name=timeField,proto3" json:"timeField,omitempty"`
+	TimeFormat           string            `protobuf:"bytes,4,opt,name=timeFormat,proto3" json:"timeFormat,omitempty"`
+	TimeLocation         string            `protobuf:"bytes,5,opt,name=timeLocation,proto3" json:"timeLocation,omitempty"`
+	Units                map[string]string `protobuf:"bytes,6,rep,name=units,proto3" json:"units,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name                 string            `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *User) Reset()         { *m = User{} }
-func (m *User) String() string { return proto.CompactTextString(m) }
-func (*User) ProtoMessage()    {}
-func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{13}
+func (m *Transformer) Reset()         { *m = Transformer{} }
+func (m *Transformer) String() string { return proto.CompactTextString(m) }
+func (*Transformer) ProtoMessage()    {}
+func (*Transformer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{12}
 }
-func (m *User) XXX_Unmarshal(b []byte) error {
+func (m *Transformer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Transformer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_User.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Transformer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -847,58 +953,86 @@ func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *User) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_User.Merge(m, src)
+func (m *Transformer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transformer.Merge(m, src)
 }
-func (m *User) XXX_Size() int {
+func (m *Transformer) XXX_Size() int {
 	return m.Size()
 }
-func (m *User) XXX_DiscardUnknown() {
-	xxx_messageInfo_User.DiscardUnknown(m)
+func (m *Transformer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transformer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_User proto.InternalMessageInfo
+var xxx_messageInfo_Transformer proto.InternalMessageInfo
 
-func (m *User) GetId() string {
+func (m *Transformer) GetDataFilters() []string {
 	if m != nil {
-		return m.Id
+		return m.DataFilters
+	}
+	return nil
+}
+
+func (m *Transformer) GetDataField() string {
+	if m != nil {
+		return m.DataField
 	}
 	return ""
 }
 
-func (m *User) GetEmail() string {
+func (m *Transformer) GetTimeField() string {
 	if m != nil {
-		return m.Email
+		return m.TimeField
 	}
 	return ""
 }
 
-func (m *User) GetStatus() string {
+func (m *Transformer) GetTimeFormat() string {
 	if m != nil {
-		return m.Status
+		return m.TimeFormat
 	}
 	return ""
 }
 
-type UsersByEmailsReq struct {
-	Emails               []string `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
+func (m *Transformer) GetTimeLocation() string {
+	if m != nil {
+		return m.TimeLocation
+	}
+	return ""
+}
+
+func (m *Transformer) GetUnits() map[string]string {
+	if m != nil {
+		return m.Units
+	}
+	return nil
+}
+
+func (m *Transformer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ThingID struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UsersByEmailsReq) Reset()         { *m = UsersByEmailsReq{} }
-func (m *UsersByEmailsReq) String() string { return proto.CompactTextString(m) }
-func (*UsersByEmailsReq) ProtoMessage()    {}
-func (*UsersByEmailsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{14}
+func (m *ThingID) Reset()         { *m = ThingID{} }
+func (m *ThingID) String() string { return proto.CompactTextString(m) }
+func (*ThingID) ProtoMessage()    {}
+func (*ThingID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{13}
 }
-func (m *UsersByEmailsReq) XXX_Unmarshal(b []byte) error {
+func (m *ThingID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UsersByEmailsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ThingID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UsersByEmailsReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ThingID.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -908,44 +1042,44 @@ func (m *UsersByEmailsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *UsersByEmailsReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UsersByEmailsReq.Merge(m, src)
+func (m *ThingID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThingID.Merge(m, src)
 }
-func (m *UsersByEmailsReq) XXX_Size() int {
+func (m *ThingID) XXX_Size() int {
 	return m.Size()
 }
-func (m *UsersByEmailsReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_UsersByEmailsReq.DiscardUnknown(m)
+func (m *ThingID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThingID.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UsersByEmailsReq proto.InternalMessageInfo
+var xxx_messageInfo_ThingID proto.InternalMessageInfo
 
-func (m *UsersByEmailsReq) GetEmails() []string {
+func (m *ThingID) GetValue() string {
 	if m != nil {
-		return m.Emails
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-type UsersByIDsReq struct {
+type ThingIDsRes struct {
 	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UsersByIDsReq) Reset()         { *m = UsersByIDsReq{} }
-func (m *UsersByIDsReq) String() string { return proto.CompactTextString(m) }
-func (*UsersByIDsReq) ProtoMessage()    {}
-func (*UsersByIDsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{15}
+func (m *ThingIDsRes) Reset()         { *m = ThingIDsRes{} }
+func (m *ThingIDsRes) String() string { return proto.CompactTextString(m) }
+func (*ThingIDsRes) ProtoMessage()    {}
+func (*ThingIDsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{14}
 }
-func (m *UsersByIDsReq) XXX_Unmarshal(b []byte) error {
+func (m *ThingIDsRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UsersByIDsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ThingIDsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UsersByIDsReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ThingIDsRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -955,44 +1089,44 @@ func (m *UsersByIDsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UsersByIDsReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UsersByIDsReq.Merge(m, src)
+func (m *ThingIDsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThingIDsRes.Merge(m, src)
 }
-func (m *UsersByIDsReq) XXX_Size() int {
+func (m *ThingIDsRes) XXX_Size() int {
 	return m.Size()
 }
-func (m *UsersByIDsReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_UsersByIDsReq.DiscardUnknown(m)
+func (m *ThingIDsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThingIDsRes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UsersByIDsReq proto.InternalMessageInfo
+var xxx_messageInfo_ThingIDsRes proto.InternalMessageInfo
 
-func (m *UsersByIDsReq) GetIds() []string {
+func (m *ThingIDsRes) GetIds() []string {
 	if m != nil {
 		return m.Ids
 	}
 	return nil
 }
 
-type UsersRes struct {
-	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+type GroupID struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UsersRes) Reset()         { *m = UsersRes{} }
-func (m *UsersRes) String() string { return proto.CompactTextString(m) }
-func (*UsersRes) ProtoMessage()    {}
-func (*UsersRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{16}
+func (m *GroupID) Reset()         { *m = GroupID{} }
+func (m *GroupID) String() string { return proto.CompactTextString(m) }
+func (*GroupID) ProtoMessage()    {}
+func (*GroupID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{15}
 }
-func (m *UsersRes) XXX_Unmarshal(b []byte) error {
+func (m *GroupID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UsersRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UsersRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupID.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,47 +1136,47 @@ func (m *UsersRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *UsersRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UsersRes.Merge(m, src)
+func (m *GroupID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupID.Merge(m, src)
 }
-func (m *UsersRes) XXX_Size() int {
+func (m *GroupID) XXX_Size() int {
 	return m.Size()
 }
-func (m *UsersRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_UsersRes.DiscardUnknown(m)
+func (m *GroupID) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupID.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UsersRes proto.InternalMessageInfo
+var xxx_messageInfo_GroupID proto.InternalMessageInfo
 
-func (m *UsersRes) GetUsers() []*User {
+func (m *GroupID) GetValue() string {
 	if m != nil {
-		return m.Users
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-type Group struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrgID                string   `protobuf:"bytes,2,opt,name=orgID,proto3" json:"orgID,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+// If a token is not carrying any information itself, the type
+// field can be used to determine how to validate the token.
+// Also, different tokens can be encoded in different ways.
+type Token struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Group) Reset()         { *m = Group{} }
-func (m *Group) String() string { return proto.CompactTextString(m) }
-func (*Group) ProtoMessage()    {}
-func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{17}
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{16}
 }
-func (m *Group) XXX_Unmarshal(b []byte) error {
+func (m *Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Token.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1052,65 +1186,44 @@ func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Group.Merge(m, src)
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
 }
-func (m *Group) XXX_Size() int {
+func (m *Token) XXX_Size() int {
 	return m.Size()
 }
-func (m *Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_Group.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Group proto.InternalMessageInfo
-
-func (m *Group) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
-
-func (m *Group) GetOrgID() string {
-	if m != nil {
-		return m.OrgID
-	}
-	return ""
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
 }
 
-func (m *Group) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_Token proto.InternalMessageInfo
 
-func (m *Group) GetDescription() string {
+func (m *Token) GetValue() string {
 	if m != nil {
-		return m.Description
+		return m.Value
 	}
 	return ""
 }
 
-type GroupsReq struct {
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+type TokensReq struct {
+	Tokens               []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupsReq) Reset()         { *m = GroupsReq{} }
-func (m *GroupsReq) String() string { return proto.CompactTextString(m) }
-func (*GroupsReq) ProtoMessage()    {}
-func (*GroupsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{18}
+func (m *TokensReq) Reset()         { *m = TokensReq{} }
+func (m *TokensReq) String() string { return proto.CompactTextString(m) }
+func (*TokensReq) ProtoMessage()    {}
+func (*TokensReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{17}
 }
-func (m *GroupsReq) XXX_Unmarshal(b []byte) error {
+func (m *TokensReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokensReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupsReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokensReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1120,44 +1233,45 @@ func (m *GroupsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GroupsReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupsReq.Merge(m, src)
+func (m *TokensReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokensReq.Merge(m, src)
 }
-func (m *GroupsReq) XXX_Size() int {
+func (m *TokensReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupsReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupsReq.DiscardUnknown(m)
+func (m *TokensReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokensReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupsReq proto.InternalMessageInfo
+var xxx_messageInfo_TokensReq proto.InternalMessageInfo
 
-func (m *GroupsReq) GetIds() []string {
+func (m *TokensReq) GetTokens() []string {
 	if m != nil {
-		return m.Ids
+		return m.Tokens
 	}
 	return nil
 }
 
-type GroupsRes struct {
-	Groups               []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+type UserIdentity struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupsRes) Reset()         { *m = GroupsRes{} }
-func (m *GroupsRes) String() string { return proto.CompactTextString(m) }
-func (*GroupsRes) ProtoMessage()    {}
-func (*GroupsRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{19}
+func (m *UserIdentity) Reset()         { *m = UserIdentity{} }
+func (m *UserIdentity) String() string { return proto.CompactTextString(m) }
+func (*UserIdentity) ProtoMessage()    {}
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{18}
 }
-func (m *GroupsRes) XXX_Unmarshal(b []byte) error {
+func (m *UserIdentity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UserIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupsRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UserIdentity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1167,45 +1281,54 @@ func (m *GroupsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *GroupsRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupsRes.Merge(m, src)
+func (m *UserIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIdentity.Merge(m, src)
 }
-func (m *GroupsRes) XXX_Size() int {
+func (m *UserIdentity) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupsRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupsRes.DiscardUnknown(m)
+func (m *UserIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserIdentity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GroupsRes proto.InternalMessageInfo
+var xxx_messageInfo_UserIdentity proto.InternalMessageInfo
 
-func (m *GroupsRes) GetGroups() []*Group {
+func (m *UserIdentity) GetId() string {
 	if m != nil {
-		return m.Groups
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-type AssignRoleReq struct {
+func (m *UserIdentity) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type DelegatedIdentity struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ThingID              string   `protobuf:"bytes,2,opt,name=thingID,proto3" json:"thingID,omitempty"`
+	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AssignRoleReq) Reset()         { *m = AssignRoleReq{} }
-func (m *AssignRoleReq) String() string { return proto.CompactTextString(m) }
-func (*AssignRoleReq) ProtoMessage()    {}
-func (*AssignRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{20}
+func (m *DelegatedIdentity) Reset()         { *m = DelegatedIdentity{} }
+func (m *DelegatedIdentity) String() string { return proto.CompactTextString(m) }
+func (*DelegatedIdentity) ProtoMessage()    {}
+func (*DelegatedIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{19}
 }
-func (m *AssignRoleReq) XXX_Unmarshal(b []byte) error {
+func (m *DelegatedIdentity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AssignRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegatedIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AssignRoleReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegatedIdentity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1215,51 +1338,65 @@ func (m *AssignRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *AssignRoleReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AssignRoleReq.Merge(m, src)
+func (m *DelegatedIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedIdentity.Merge(m, src)
 }
-func (m *AssignRoleReq) XXX_Size() int {
+func (m *DelegatedIdentity) XXX_Size() int {
 	return m.Size()
 }
-func (m *AssignRoleReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_AssignRoleReq.DiscardUnknown(m)
+func (m *DelegatedIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedIdentity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AssignRoleReq proto.InternalMessageInfo
+var xxx_messageInfo_DelegatedIdentity proto.InternalMessageInfo
 
-func (m *AssignRoleReq) GetId() string {
+func (m *DelegatedIdentity) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *AssignRoleReq) GetRole() string {
+func (m *DelegatedIdentity) GetThingID() string {
 	if m != nil {
-		return m.Role
+		return m.ThingID
 	}
 	return ""
 }
 
-type RetrieveRoleReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DelegatedIdentity) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *RetrieveRoleReq) Reset()         { *m = RetrieveRoleReq{} }
-func (m *RetrieveRoleReq) String() string { return proto.CompactTextString(m) }
-func (*RetrieveRoleReq) ProtoMessage()    {}
-func (*RetrieveRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{21}
+func (m *DelegatedIdentity) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
-func (m *RetrieveRoleReq) XXX_Unmarshal(b []byte) error {
+
+type UserIdentitiesRes struct {
+	Identities           []*UserIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UserIdentitiesRes) Reset()         { *m = UserIdentitiesRes{} }
+func (m *UserIdentitiesRes) String() string { return proto.CompactTextString(m) }
+func (*UserIdentitiesRes) ProtoMessage()    {}
+func (*UserIdentitiesRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{20}
+}
+func (m *UserIdentitiesRes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetrieveRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UserIdentitiesRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetrieveRoleReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UserIdentitiesRes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1269,44 +1406,46 @@ func (m *RetrieveRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RetrieveRoleReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetrieveRoleReq.Merge(m, src)
+func (m *UserIdentitiesRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIdentitiesRes.Merge(m, src)
 }
-func (m *RetrieveRoleReq) XXX_Size() int {
+func (m *UserIdentitiesRes) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetrieveRoleReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetrieveRoleReq.DiscardUnknown(m)
+func (m *UserIdentitiesRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserIdentitiesRes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetrieveRoleReq proto.InternalMessageInfo
+var xxx_messageInfo_UserIdentitiesRes proto.InternalMessageInfo
 
-func (m *RetrieveRoleReq) GetId() string {
+func (m *UserIdentitiesRes) GetIdentities() []*UserIdentity {
 	if m != nil {
-		return m.Id
+		return m.Identities
 	}
-	return ""
+	return nil
 }
 
-type RetrieveRoleRes struct {
-	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+type IssueReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Type                 uint32   `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RetrieveRoleRes) Reset()         { *m = RetrieveRoleRes{} }
-func (m *RetrieveRoleRes) String() string { return proto.CompactTextString(m) }
-func (*RetrieveRoleRes) ProtoMessage()    {}
-func (*RetrieveRoleRes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f5c89a6f82d4869, []int{22}
+func (m *IssueReq) Reset()         { *m = IssueReq{} }
+func (m *IssueReq) String() string { return proto.CompactTextString(m) }
+func (*IssueReq) ProtoMessage()    {}
+func (*IssueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{21}
 }
-func (m *RetrieveRoleRes) XXX_Unmarshal(b []byte) error {
+func (m *IssueReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetrieveRoleRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IssueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetrieveRoleRes.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IssueReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1316,2234 +1455,9471 @@ func (m *RetrieveRoleRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RetrieveRoleRes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetrieveRoleRes.Merge(m, src)
+func (m *IssueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueReq.Merge(m, src)
 }
-func (m *RetrieveRoleRes) XXX_Size() int {
+func (m *IssueReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetrieveRoleRes) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetrieveRoleRes.DiscardUnknown(m)
+func (m *IssueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetrieveRoleRes proto.InternalMessageInfo
+var xxx_messageInfo_IssueReq proto.InternalMessageInfo
 
-func (m *RetrieveRoleRes) GetRole() string {
+func (m *IssueReq) GetId() string {
 	if m != nil {
-		return m.Role
+		return m.Id
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Message)(nil), "protomfx.Message")
-	proto.RegisterType((*PubConfByKeyReq)(nil), "protomfx.PubConfByKeyReq")
-	proto.RegisterType((*PubConfByKeyRes)(nil), "protomfx.PubConfByKeyRes")
-	proto.RegisterType((*Config)(nil), "protomfx.Config")
-	proto.RegisterType((*ConfigByThingIDRes)(nil), "protomfx.ConfigByThingIDRes")
-	proto.RegisterType((*Transformer)(nil), "protomfx.Transformer")
-	proto.RegisterType((*ThingID)(nil), "protomfx.ThingID")
-	proto.RegisterType((*GroupID)(nil), "protomfx.GroupID")
-	proto.RegisterType((*Token)(nil), "protomfx.Token")
-	proto.RegisterType((*UserIdentity)(nil), "protomfx.UserIdentity")
-	proto.RegisterType((*IssueReq)(nil), "protomfx.IssueReq")
-	proto.RegisterType((*AuthorizeReq)(nil), "protomfx.AuthorizeReq")
-	proto.RegisterType((*AuthorizeRes)(nil), "protomfx.AuthorizeRes")
-	proto.RegisterType((*User)(nil), "protomfx.User")
-	proto.RegisterType((*UsersByEmailsReq)(nil), "protomfx.UsersByEmailsReq")
-	proto.RegisterType((*UsersByIDsReq)(nil), "protomfx.UsersByIDsReq")
-	proto.RegisterType((*UsersRes)(nil), "protomfx.UsersRes")
-	proto.RegisterType((*Group)(nil), "protomfx.Group")
-	proto.RegisterType((*GroupsReq)(nil), "protomfx.GroupsReq")
-	proto.RegisterType((*GroupsRes)(nil), "protomfx.GroupsRes")
-	proto.RegisterType((*AssignRoleReq)(nil), "protomfx.AssignRoleReq")
-	proto.RegisterType((*RetrieveRoleReq)(nil), "protomfx.RetrieveRoleReq")
-	proto.RegisterType((*RetrieveRoleRes)(nil), "protomfx.RetrieveRoleRes")
+func (m *IssueReq) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("pkg/proto/mfx.proto", fileDescriptor_4f5c89a6f82d4869) }
-
-var fileDescriptor_4f5c89a6f82d4869 = []byte{
-	// 1040 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xdb, 0x26, 0x4d, 0x4f, 0x9a, 0xb6, 0x3b, 0x5d, 0x4a, 0x30, 0xbb, 0x21, 0x3b, 0x80,
-	0x88, 0xb8, 0x48, 0x57, 0xd9, 0x05, 0x6e, 0x60, 0xd1, 0x16, 0xef, 0x46, 0x16, 0x20, 0x21, 0x53,
-	0x1e, 0xc0, 0x71, 0x26, 0xa9, 0x89, 0xe3, 0x31, 0x9e, 0x71, 0x77, 0xcd, 0x5b, 0x20, 0x71, 0xc1,
-	0x5b, 0xf0, 0x02, 0xdc, 0xc3, 0x25, 0x8f, 0x80, 0xca, 0x15, 0x6f, 0x81, 0xe6, 0xcf, 0x76, 0xdc,
-	0xa6, 0xaa, 0xb4, 0x57, 0x9e, 0xef, 0xfc, 0xcd, 0x39, 0xdf, 0x9c, 0x73, 0x0c, 0xc7, 0xc9, 0x72,
-	0x71, 0x9a, 0xa4, 0x94, 0xd3, 0xd3, 0xd5, 0xfc, 0xf5, 0x48, 0x9e, 0x50, 0x5b, 0x7e, 0x56, 0xf3,
-	0xd7, 0xf6, 0xbb, 0x0b, 0x4a, 0x17, 0x11, 0x51, 0x16, 0xd3, 0x6c, 0x7e, 0x4a, 0x56, 0x09, 0xcf,
-	0x95, 0x19, 0xfe, 0xcf, 0x82, 0xdd, 0x6f, 0x09, 0x63, 0xfe, 0x82, 0xa0, 0x07, 0xb0, 0x97, 0xa4,
-	0x74, 0x1e, 0x46, 0xc4, 0x75, 0x7a, 0xd6, 0xc0, 0x1a, 0xee, 0x79, 0xa5, 0x00, 0xd9, 0xd0, 0x66,
-	0xd9, 0x94, 0xd3, 0x24, 0x0c, 0x7a, 0x5b, 0x52, 0x59, 0x60, 0xe9, 0x99, 0x4d, 0xa3, 0x90, 0x5d,
-	0x90, 0xb4, 0xb7, 0xad, 0x3d, 0x8d, 0x40, 0x78, 0xca, 0xcb, 0x02, 0x1a, 0xf5, 0x76, 0x94, 0xa7,
-	0xc1, 0xa8, 0x07, 0xbb, 0x89, 0x9f, 0x47, 0xd4, 0x9f, 0xf5, 0x9a, 0x03, 0x6b, 0xb8, 0xef, 0x19,
-	0x28, 0x34, 0x41, 0x4a, 0x7c, 0x4e, 0x66, 0xbd, 0xd6, 0xc0, 0x1a, 0x6e, 0x7b, 0x06, 0xa2, 0x4f,
-	0xa1, 0xab, 0xd3, 0xfa, 0x8a, 0xc6, 0xf3, 0x70, 0xd1, 0xdb, 0x1d, 0x58, 0xc3, 0xce, 0xf8, 0x68,
-	0x64, 0x4a, 0x1e, 0x29, 0xb9, 0xb7, 0x6e, 0x86, 0xdf, 0x87, 0xc3, 0xef, 0xb2, 0xa9, 0x00, 0x67,
-	0xf9, 0xd7, 0x24, 0xf7, 0xc8, 0x4f, 0xe8, 0x08, 0xb6, 0x97, 0x24, 0xd7, 0xc5, 0x8a, 0x23, 0x5e,
-	0xd6, 0x8d, 0x18, 0x1a, 0x40, 0xa7, 0x28, 0xa6, 0x60, 0xa6, 0x2a, 0xba, 0x9e, 0xd1, 0xd6, 0xdd,
-	0x32, 0xfa, 0xd3, 0x82, 0x96, 0x3a, 0x8a, 0x4b, 0x02, 0x1a, 0x73, 0x12, 0xf3, 0xf3, 0x3c, 0x21,
-	0xe6, 0x92, 0x8a, 0x08, 0xdd, 0x87, 0xe6, 0xab, 0x34, 0xe4, 0x44, 0x06, 0x6f, 0x7b, 0x0a, 0x08,
-	0xea, 0x5f, 0x91, 0xe9, 0x05, 0xa5, 0x4b, 0xd7, 0x31, 0xd4, 0x17, 0x02, 0x74, 0x02, 0x2d, 0xb6,
-	0xe2, 0x89, 0xeb, 0x68, 0xe2, 0x35, 0x52, 0xf2, 0x44, 0xc8, 0x9b, 0x46, 0x2e, 0x10, 0xfa, 0x0c,
-	0x3a, 0x3c, 0xf5, 0x63, 0x36, 0xa7, 0xe9, 0x8a, 0xa4, 0x92, 0xf8, 0xce, 0xf8, 0xad, 0xb2, 0x8c,
-	0xf3, 0x52, 0xe9, 0x55, 0x2d, 0xf1, 0x33, 0x40, 0xaa, 0x90, 0xb3, 0xfc, 0xfc, 0x22, 0x8c, 0x17,
-	0xae, 0x23, 0x98, 0x1b, 0x42, 0x2b, 0x50, 0x84, 0x58, 0x1b, 0x08, 0xd1, 0x7a, 0xfc, 0xbb, 0x05,
-	0x9d, 0x4a, 0x70, 0x41, 0xc7, 0xcc, 0xe7, 0xfe, 0xcb, 0x30, 0xe2, 0x24, 0x65, 0x3d, 0x6b, 0xb0,
-	0x2d, 0xe8, 0xa8, 0x88, 0x44, 0xe1, 0x0a, 0x92, 0x68, 0xa6, 0x1b, 0xb2, 0x14, 0x08, 0x2d, 0x0f,
-	0x57, 0x44, 0x69, 0x35, 0x2d, 0x85, 0x00, 0xf5, 0x01, 0x24, 0xa0, 0xe9, 0xca, 0xe7, 0x9a, 0x9a,
-	0x8a, 0x04, 0x61, 0xd8, 0x17, 0xe8, 0x1b, 0x1a, 0xf8, 0x3c, 0xa4, 0xb1, 0x26, 0x69, 0x4d, 0x86,
-	0xdf, 0x83, 0x5d, 0x5d, 0xa9, 0x78, 0x99, 0x4b, 0x3f, 0xca, 0xcc, 0xab, 0x29, 0x20, 0x0c, 0x26,
-	0x29, 0xcd, 0x92, 0x8d, 0x06, 0x0f, 0xa1, 0x79, 0x4e, 0x97, 0x24, 0xde, 0xa0, 0x7e, 0x0a, 0xfb,
-	0x3f, 0x30, 0x92, 0xba, 0x33, 0x12, 0xf3, 0x90, 0xe7, 0xe8, 0x00, 0xb6, 0xc2, 0x99, 0x36, 0xd9,
-	0x0a, 0x67, 0xc2, 0x8b, 0xac, 0xfc, 0x30, 0xd2, 0xc5, 0x2b, 0x80, 0x1d, 0x68, 0xbb, 0x8c, 0x65,
-	0x44, 0x74, 0xf7, 0x9d, 0x3c, 0x10, 0x82, 0x1d, 0x2e, 0x5a, 0x4e, 0xb0, 0xd4, 0xf5, 0xe4, 0x19,
-	0xc7, 0xb0, 0xff, 0x3c, 0xe3, 0x17, 0x34, 0x0d, 0x7f, 0x96, 0x91, 0xee, 0x43, 0x93, 0x8b, 0x54,
-	0x4d, 0x86, 0x12, 0x88, 0x2e, 0xa2, 0xd3, 0x1f, 0x49, 0xc0, 0x75, 0x40, 0x8d, 0xc4, 0xe8, 0xb2,
-	0x4c, 0x29, 0x14, 0xf5, 0x06, 0x0a, 0x0f, 0x3f, 0x90, 0x94, 0xea, 0x7e, 0x54, 0x08, 0x8f, 0xd6,
-	0xee, 0x63, 0xe2, 0x81, 0x7c, 0x83, 0x55, 0x05, 0x6d, 0xaf, 0x22, 0xc1, 0x0e, 0xec, 0x08, 0x6e,
-	0xee, 0x58, 0xa1, 0xe8, 0x76, 0xee, 0xf3, 0x8c, 0xe9, 0x74, 0x34, 0xc2, 0x1f, 0xc3, 0x91, 0x88,
-	0xc2, 0xce, 0xf2, 0x17, 0xc2, 0x8e, 0x89, 0x4a, 0x4f, 0xa0, 0x25, 0x9d, 0x4c, 0xcf, 0x69, 0x84,
-	0x1f, 0x41, 0x57, 0xdb, 0xba, 0x0e, 0xd3, 0xab, 0x23, 0x9c, 0x19, 0x2b, 0x71, 0xc4, 0x8f, 0xa1,
-	0x2d, 0x4d, 0x44, 0x01, 0x1f, 0x40, 0x33, 0x63, 0xa6, 0x73, 0x3b, 0xe3, 0x83, 0xb2, 0xf1, 0x85,
-	0x89, 0xa7, 0x94, 0x38, 0x80, 0xa6, 0x6c, 0x91, 0x9b, 0xea, 0xa0, 0xe9, 0xc2, 0x75, 0x4c, 0x1d,
-	0x12, 0x88, 0x97, 0x8a, 0xfd, 0x15, 0xd1, 0x55, 0xc8, 0xb3, 0x1c, 0x14, 0xc2, 0x82, 0x34, 0x4c,
-	0x2a, 0xb4, 0x56, 0x45, 0xf8, 0x21, 0xec, 0xc9, 0x4b, 0x36, 0x64, 0xfd, 0xb4, 0x54, 0x33, 0xf4,
-	0x11, 0xb4, 0x16, 0x12, 0xe8, 0xbc, 0x0f, 0xcb, 0xbc, 0xa5, 0x91, 0xa7, 0xd5, 0xf8, 0x09, 0x74,
-	0x9f, 0x33, 0x16, 0x2e, 0x62, 0x8f, 0x46, 0x37, 0xf6, 0x1a, 0x82, 0x9d, 0x94, 0x46, 0x44, 0x17,
-	0x20, 0xcf, 0xf8, 0x11, 0x1c, 0x7a, 0x84, 0xa7, 0x21, 0xb9, 0x24, 0x1b, 0xdc, 0xf0, 0x87, 0x75,
-	0x13, 0x56, 0x44, 0xb2, 0xca, 0x48, 0xe3, 0x5f, 0xb6, 0xa1, 0x2b, 0xa7, 0x8f, 0x7d, 0x4f, 0xd2,
-	0xcb, 0x30, 0x20, 0xc8, 0x85, 0xc3, 0x09, 0xe1, 0xd5, 0xd5, 0x8d, 0xde, 0x29, 0x93, 0xaf, 0xed,
-	0x7d, 0x7b, 0xa3, 0x8a, 0xe1, 0x06, 0x9a, 0x00, 0x9a, 0x10, 0x5e, 0x5b, 0x67, 0xe8, 0x5e, 0x65,
-	0x0b, 0x2a, 0x91, 0xfd, 0xa0, 0xbe, 0xce, 0xaa, 0xcb, 0x0f, 0x37, 0xd0, 0x17, 0xb0, 0x57, 0x74,
-	0x35, 0x3a, 0x29, 0x8d, 0xab, 0xa3, 0x65, 0x9f, 0x8c, 0xd4, 0xff, 0x79, 0x64, 0xfe, 0xcf, 0xa3,
-	0x17, 0xe2, 0xff, 0x8c, 0x1b, 0xe8, 0x31, 0xb4, 0xd5, 0xf0, 0xcf, 0x73, 0x54, 0x79, 0x08, 0xb9,
-	0x33, 0xec, 0xeb, 0xe9, 0xe0, 0x06, 0xfa, 0x1c, 0x0e, 0x26, 0x84, 0xab, 0xe7, 0x94, 0x8d, 0x8a,
-	0x8e, 0x6b, 0x0f, 0x28, 0x9a, 0xc0, 0xbe, 0x41, 0xa8, 0xd2, 0x3d, 0x36, 0xde, 0xae, 0x73, 0x6b,
-	0xe1, 0xf7, 0x6a, 0x01, 0xc4, 0xe5, 0xe3, 0x5f, 0x2d, 0xb5, 0xb0, 0x8a, 0x27, 0x79, 0x06, 0xdd,
-	0x09, 0xe1, 0xe5, 0xd4, 0xa0, 0xb7, 0xd7, 0xa7, 0xa0, 0x98, 0x25, 0x1b, 0xd5, 0x14, 0x2a, 0x1f,
-	0x07, 0x8e, 0x4a, 0x7f, 0x35, 0xa1, 0xc8, 0xbe, 0x16, 0xa2, 0x18, 0xdd, 0x9b, 0xa3, 0x8c, 0xff,
-	0xd8, 0x82, 0x8e, 0x20, 0xdc, 0x64, 0x35, 0x82, 0xa6, 0x5c, 0x90, 0xa8, 0x62, 0x6e, 0x36, 0xa6,
-	0x5d, 0xa7, 0x19, 0x37, 0xd0, 0x27, 0xb7, 0xbd, 0xc2, 0xc9, 0xfa, 0x95, 0x66, 0x57, 0xbf, 0xf9,
-	0xdb, 0x7f, 0x09, 0x50, 0xce, 0x57, 0x95, 0xb8, 0xb5, 0xa9, 0xbb, 0x25, 0xc0, 0x4b, 0xd8, 0xaf,
-	0x0e, 0x52, 0x75, 0x18, 0x6a, 0x33, 0x68, 0x6f, 0x54, 0x31, 0xdc, 0x38, 0x3b, 0xfa, 0xeb, 0xaa,
-	0x6f, 0xfd, 0x7d, 0xd5, 0xb7, 0xfe, 0xb9, 0xea, 0x5b, 0xbf, 0xfd, 0xdb, 0x6f, 0x4c, 0x5b, 0xd2,
-	0xfa, 0xc9, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x41, 0x2c, 0xbf, 0x67, 0x77, 0x0a, 0x00, 0x00,
+func (m *IssueReq) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ThingsServiceClient is the client API for ThingsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ThingsServiceClient interface {
-	GetPubConfByKey(ctx context.Context, in *PubConfByKeyReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error)
-	GetConfigByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*ConfigByThingIDRes, error)
-	Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*ThingID, error)
-	GetGroupsByIDs(ctx context.Context, in *GroupsReq, opts ...grpc.CallOption) (*GroupsRes, error)
-	GetGroupIDByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*GroupID, error)
+type AuthorizeReq struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Object               string   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Subject              string   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Action               string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type thingsServiceClient struct {
-	cc *grpc.ClientConn
+func (m *AuthorizeReq) Reset()         { *m = AuthorizeReq{} }
+func (m *AuthorizeReq) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeReq) ProtoMessage()    {}
+func (*AuthorizeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{22}
 }
-
-func NewThingsServiceClient(cc *grpc.ClientConn) ThingsServiceClient {
-	return &thingsServiceClient{cc}
+func (m *AuthorizeReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *thingsServiceClient) GetPubConfByKey(ctx context.Context, in *PubConfByKeyReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error) {
-	out := new(PubConfByKeyRes)
-	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetPubConfByKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AuthorizeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizeReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AuthorizeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeReq.Merge(m, src)
+}
+func (m *AuthorizeReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeReq.DiscardUnknown(m)
 }
 
-func (c *thingsServiceClient) GetConfigByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*ConfigByThingIDRes, error) {
-	out := new(ConfigByThingIDRes)
-	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetConfigByThingID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AuthorizeReq proto.InternalMessageInfo
+
+func (m *AuthorizeReq) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return out, nil
+	return ""
 }
 
-func (c *thingsServiceClient) Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/Authorize", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AuthorizeReq) GetObject() string {
+	if m != nil {
+		return m.Object
 	}
-	return out, nil
+	return ""
 }
 
-func (c *thingsServiceClient) Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*ThingID, error) {
-	out := new(ThingID)
-	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/Identify", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AuthorizeReq) GetSubject() string {
+	if m != nil {
+		return m.Subject
 	}
-	return out, nil
+	return ""
 }
 
-func (c *thingsServiceClient) GetGroupsByIDs(ctx context.Context, in *GroupsReq, opts ...grpc.CallOption) (*GroupsRes, error) {
-	out := new(GroupsRes)
-	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetGroupsByIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AuthorizeReq) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return out, nil
+	return ""
 }
 
-func (c *thingsServiceClient) GetGroupIDByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*GroupID, error) {
-	out := new(GroupID)
-	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetGroupIDByThingID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// AuthorizeBatchReq is authorized only if all of its requests are authorized.
+type AuthorizeBatchReq struct {
+	Requests             []*AuthorizeReq `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-// ThingsServiceServer is the server API for ThingsService service.
-type ThingsServiceServer interface {
-	GetPubConfByKey(context.Context, *PubConfByKeyReq) (*PubConfByKeyRes, error)
-	GetConfigByThingID(context.Context, *ThingID) (*ConfigByThingIDRes, error)
-	Authorize(context.Context, *AuthorizeReq) (*emptypb.Empty, error)
-	Identify(context.Context, *Token) (*ThingID, error)
-	GetGroupsByIDs(context.Context, *GroupsReq) (*GroupsRes, error)
-	GetGroupIDByThingID(context.Context, *ThingID) (*GroupID, error)
+func (m *AuthorizeBatchReq) Reset()         { *m = AuthorizeBatchReq{} }
+func (m *AuthorizeBatchReq) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeBatchReq) ProtoMessage()    {}
+func (*AuthorizeBatchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{23}
+}
+func (m *AuthorizeBatchReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizeBatchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizeBatchReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizeBatchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeBatchReq.Merge(m, src)
+}
+func (m *AuthorizeBatchReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizeBatchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeBatchReq.DiscardUnknown(m)
 }
 
-// UnimplementedThingsServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedThingsServiceServer struct {
+var xxx_messageInfo_AuthorizeBatchReq proto.InternalMessageInfo
+
+func (m *AuthorizeBatchReq) GetRequests() []*AuthorizeReq {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
 }
 
-func (*UnimplementedThingsServiceServer) GetPubConfByKey(ctx context.Context, req *PubConfByKeyReq) (*PubConfByKeyRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPubConfByKey not implemented")
+type AuthorizeRes struct {
+	Authorized           bool     `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedThingsServiceServer) GetConfigByThingID(ctx context.Context, req *ThingID) (*ConfigByThingIDRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigByThingID not implemented")
+
+func (m *AuthorizeRes) Reset()         { *m = AuthorizeRes{} }
+func (m *AuthorizeRes) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeRes) ProtoMessage()    {}
+func (*AuthorizeRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{24}
 }
-func (*UnimplementedThingsServiceServer) Authorize(ctx context.Context, req *AuthorizeReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+func (m *AuthorizeRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedThingsServiceServer) Identify(ctx context.Context, req *Token) (*ThingID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Identify not implemented")
+func (m *AuthorizeRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizeRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedThingsServiceServer) GetGroupsByIDs(ctx context.Context, req *GroupsReq) (*GroupsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByIDs not implemented")
+func (m *AuthorizeRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeRes.Merge(m, src)
 }
-func (*UnimplementedThingsServiceServer) GetGroupIDByThingID(ctx context.Context, req *ThingID) (*GroupID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupIDByThingID not implemented")
+func (m *AuthorizeRes) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterThingsServiceServer(s *grpc.Server, srv ThingsServiceServer) {
-	s.RegisterService(&_ThingsService_serviceDesc, srv)
+func (m *AuthorizeRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeRes.DiscardUnknown(m)
 }
 
-func _ThingsService_GetPubConfByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PubConfByKeyReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ThingsServiceServer).GetPubConfByKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.ThingsService/GetPubConfByKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThingsServiceServer).GetPubConfByKey(ctx, req.(*PubConfByKeyReq))
+var xxx_messageInfo_AuthorizeRes proto.InternalMessageInfo
+
+func (m *AuthorizeRes) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _ThingsService_GetConfigByThingID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ThingID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ThingsServiceServer).GetConfigByThingID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.ThingsService/GetConfigByThingID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThingsServiceServer).GetConfigByThingID(ctx, req.(*ThingID))
-	}
-	return interceptor(ctx, in, info, handler)
+type User struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _ThingsService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthorizeReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ThingsServiceServer).Authorize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.ThingsService/Authorize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThingsServiceServer).Authorize(ctx, req.(*AuthorizeReq))
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{25}
+}
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_User.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return m.Size()
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
 }
 
-func _ThingsService_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Token)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ThingsServiceServer).Identify(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.ThingsService/Identify",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThingsServiceServer).Identify(ctx, req.(*Token))
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ThingsService_GetGroupsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GroupsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ThingsServiceServer).GetGroupsByIDs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.ThingsService/GetGroupsByIDs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThingsServiceServer).GetGroupsByIDs(ctx, req.(*GroupsReq))
+func (m *User) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ThingsService_GetGroupIDByThingID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ThingID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ThingsServiceServer).GetGroupIDByThingID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.ThingsService/GetGroupIDByThingID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThingsServiceServer).GetGroupIDByThingID(ctx, req.(*ThingID))
+func (m *User) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _ThingsService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "protomfx.ThingsService",
-	HandlerType: (*ThingsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPubConfByKey",
-			Handler:    _ThingsService_GetPubConfByKey_Handler,
-		},
-		{
-			MethodName: "GetConfigByThingID",
-			Handler:    _ThingsService_GetConfigByThingID_Handler,
-		},
-		{
-			MethodName: "Authorize",
-			Handler:    _ThingsService_Authorize_Handler,
-		},
-		{
-			MethodName: "Identify",
-			Handler:    _ThingsService_Identify_Handler,
-		},
-		{
-			MethodName: "GetGroupsByIDs",
-			Handler:    _ThingsService_GetGroupsByIDs_Handler,
-		},
-		{
-			MethodName: "GetGroupIDByThingID",
-			Handler:    _ThingsService_GetGroupIDByThingID_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/proto/mfx.proto",
+type UsersByEmailsReq struct {
+	Emails               []string `protobuf:"bytes,1,rep,name=emails,proto3" json:"emails,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UsersServiceClient is the client API for UsersService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UsersServiceClient interface {
-	GetUsersByIDs(ctx context.Context, in *UsersByIDsReq, opts ...grpc.CallOption) (*UsersRes, error)
-	GetUsersByEmails(ctx context.Context, in *UsersByEmailsReq, opts ...grpc.CallOption) (*UsersRes, error)
-}
-
-type usersServiceClient struct {
-	cc *grpc.ClientConn
+func (m *UsersByEmailsReq) Reset()         { *m = UsersByEmailsReq{} }
+func (m *UsersByEmailsReq) String() string { return proto.CompactTextString(m) }
+func (*UsersByEmailsReq) ProtoMessage()    {}
+func (*UsersByEmailsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{26}
 }
-
-func NewUsersServiceClient(cc *grpc.ClientConn) UsersServiceClient {
-	return &usersServiceClient{cc}
+func (m *UsersByEmailsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *usersServiceClient) GetUsersByIDs(ctx context.Context, in *UsersByIDsReq, opts ...grpc.CallOption) (*UsersRes, error) {
-	out := new(UsersRes)
-	err := c.cc.Invoke(ctx, "/protomfx.UsersService/GetUsersByIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UsersByEmailsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsersByEmailsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *usersServiceClient) GetUsersByEmails(ctx context.Context, in *UsersByEmailsReq, opts ...grpc.CallOption) (*UsersRes, error) {
-	out := new(UsersRes)
-	err := c.cc.Invoke(ctx, "/protomfx.UsersService/GetUsersByEmails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UsersByEmailsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsersByEmailsReq.Merge(m, src)
 }
-
-// UsersServiceServer is the server API for UsersService service.
-type UsersServiceServer interface {
-	GetUsersByIDs(context.Context, *UsersByIDsReq) (*UsersRes, error)
-	GetUsersByEmails(context.Context, *UsersByEmailsReq) (*UsersRes, error)
+func (m *UsersByEmailsReq) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedUsersServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedUsersServiceServer struct {
+func (m *UsersByEmailsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsersByEmailsReq.DiscardUnknown(m)
 }
 
-func (*UnimplementedUsersServiceServer) GetUsersByIDs(ctx context.Context, req *UsersByIDsReq) (*UsersRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
-}
-func (*UnimplementedUsersServiceServer) GetUsersByEmails(ctx context.Context, req *UsersByEmailsReq) (*UsersRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByEmails not implemented")
-}
+var xxx_messageInfo_UsersByEmailsReq proto.InternalMessageInfo
 
-func RegisterUsersServiceServer(s *grpc.Server, srv UsersServiceServer) {
-	s.RegisterService(&_UsersService_serviceDesc, srv)
+func (m *UsersByEmailsReq) GetEmails() []string {
+	if m != nil {
+		return m.Emails
+	}
+	return nil
 }
 
-func _UsersService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UsersByIDsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UsersServiceServer).GetUsersByIDs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.UsersService/GetUsersByIDs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).GetUsersByIDs(ctx, req.(*UsersByIDsReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type UsersByIDsReq struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _UsersService_GetUsersByEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UsersByEmailsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UsersServiceServer).GetUsersByEmails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.UsersService/GetUsersByEmails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UsersServiceServer).GetUsersByEmails(ctx, req.(*UsersByEmailsReq))
+func (m *UsersByIDsReq) Reset()         { *m = UsersByIDsReq{} }
+func (m *UsersByIDsReq) String() string { return proto.CompactTextString(m) }
+func (*UsersByIDsReq) ProtoMessage()    {}
+func (*UsersByIDsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{27}
+}
+func (m *UsersByIDsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsersByIDsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsersByIDsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _UsersService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "protomfx.UsersService",
-	HandlerType: (*UsersServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetUsersByIDs",
-			Handler:    _UsersService_GetUsersByIDs_Handler,
-		},
-		{
-			MethodName: "GetUsersByEmails",
-			Handler:    _UsersService_GetUsersByEmails_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/proto/mfx.proto",
+func (m *UsersByIDsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsersByIDsReq.Merge(m, src)
 }
-
-// AuthServiceClient is the client API for AuthService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AuthServiceClient interface {
-	Issue(ctx context.Context, in *IssueReq, opts ...grpc.CallOption) (*Token, error)
-	Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*UserIdentity, error)
-	Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	AssignRole(ctx context.Context, in *AssignRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	RetrieveRole(ctx context.Context, in *RetrieveRoleReq, opts ...grpc.CallOption) (*RetrieveRoleRes, error)
+func (m *UsersByIDsReq) XXX_Size() int {
+	return m.Size()
 }
-
-type authServiceClient struct {
-	cc *grpc.ClientConn
+func (m *UsersByIDsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsersByIDsReq.DiscardUnknown(m)
 }
 
-func NewAuthServiceClient(cc *grpc.ClientConn) AuthServiceClient {
-	return &authServiceClient{cc}
-}
+var xxx_messageInfo_UsersByIDsReq proto.InternalMessageInfo
 
-func (c *authServiceClient) Issue(ctx context.Context, in *IssueReq, opts ...grpc.CallOption) (*Token, error) {
-	out := new(Token)
-	err := c.cc.Invoke(ctx, "/protomfx.AuthService/Issue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UsersByIDsReq) GetIds() []string {
+	if m != nil {
+		return m.Ids
 	}
-	return out, nil
+	return nil
 }
 
-func (c *authServiceClient) Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*UserIdentity, error) {
-	out := new(UserIdentity)
-	err := c.cc.Invoke(ctx, "/protomfx.AuthService/Identify", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UsersRes struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *authServiceClient) Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/protomfx.AuthService/Authorize", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UsersRes) Reset()         { *m = UsersRes{} }
+func (m *UsersRes) String() string { return proto.CompactTextString(m) }
+func (*UsersRes) ProtoMessage()    {}
+func (*UsersRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{28}
 }
-
-func (c *authServiceClient) AssignRole(ctx context.Context, in *AssignRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/protomfx.AuthService/AssignRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UsersRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsersRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsersRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *UsersRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsersRes.Merge(m, src)
+}
+func (m *UsersRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsersRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsersRes.DiscardUnknown(m)
 }
 
-func (c *authServiceClient) RetrieveRole(ctx context.Context, in *RetrieveRoleReq, opts ...grpc.CallOption) (*RetrieveRoleRes, error) {
-	out := new(RetrieveRoleRes)
-	err := c.cc.Invoke(ctx, "/protomfx.AuthService/RetrieveRole", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UsersRes proto.InternalMessageInfo
+
+func (m *UsersRes) GetUsers() []*User {
+	if m != nil {
+		return m.Users
 	}
-	return out, nil
+	return nil
 }
 
-// AuthServiceServer is the server API for AuthService service.
-type AuthServiceServer interface {
-	Issue(context.Context, *IssueReq) (*Token, error)
-	Identify(context.Context, *Token) (*UserIdentity, error)
-	Authorize(context.Context, *AuthorizeReq) (*emptypb.Empty, error)
-	AssignRole(context.Context, *AssignRoleReq) (*emptypb.Empty, error)
-	RetrieveRole(context.Context, *RetrieveRoleReq) (*RetrieveRoleRes, error)
+type UsersStatsRes struct {
+	Users                uint64   `protobuf:"varint,1,opt,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedAuthServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAuthServiceServer struct {
+func (m *UsersStatsRes) Reset()         { *m = UsersStatsRes{} }
+func (m *UsersStatsRes) String() string { return proto.CompactTextString(m) }
+func (*UsersStatsRes) ProtoMessage()    {}
+func (*UsersStatsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{29}
 }
-
-func (*UnimplementedAuthServiceServer) Issue(ctx context.Context, req *IssueReq) (*Token, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Issue not implemented")
+func (m *UsersStatsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAuthServiceServer) Identify(ctx context.Context, req *Token) (*UserIdentity, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Identify not implemented")
+func (m *UsersStatsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsersStatsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAuthServiceServer) Authorize(ctx context.Context, req *AuthorizeReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+func (m *UsersStatsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsersStatsRes.Merge(m, src)
 }
-func (*UnimplementedAuthServiceServer) AssignRole(ctx context.Context, req *AssignRoleReq) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+func (m *UsersStatsRes) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAuthServiceServer) RetrieveRole(ctx context.Context, req *RetrieveRoleReq) (*RetrieveRoleRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetrieveRole not implemented")
+func (m *UsersStatsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsersStatsRes.DiscardUnknown(m)
 }
 
-func RegisterAuthServiceServer(s *grpc.Server, srv AuthServiceServer) {
-	s.RegisterService(&_AuthService_serviceDesc, srv)
-}
+var xxx_messageInfo_UsersStatsRes proto.InternalMessageInfo
 
-func _AuthService_Issue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IssueReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServiceServer).Issue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.AuthService/Issue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Issue(ctx, req.(*IssueReq))
+func (m *UsersStatsRes) GetUsers() uint64 {
+	if m != nil {
+		return m.Users
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AuthService_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Token)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServiceServer).Identify(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.AuthService/Identify",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Identify(ctx, req.(*Token))
-	}
-	return interceptor(ctx, in, info, handler)
+type Group struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgID                string   `protobuf:"bytes,2,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AuthService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthorizeReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServiceServer).Authorize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.AuthService/Authorize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).Authorize(ctx, req.(*AuthorizeReq))
+func (m *Group) Reset()         { *m = Group{} }
+func (m *Group) String() string { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()    {}
+func (*Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{30}
+}
+func (m *Group) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Group.Merge(m, src)
+}
+func (m *Group) XXX_Size() int {
+	return m.Size()
+}
+func (m *Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Group.DiscardUnknown(m)
 }
 
-func _AuthService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignRoleReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServiceServer).AssignRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.AuthService/AssignRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).AssignRole(ctx, req.(*AssignRoleReq))
+var xxx_messageInfo_Group proto.InternalMessageInfo
+
+func (m *Group) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AuthService_RetrieveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetrieveRoleReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServiceServer).RetrieveRole(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/protomfx.AuthService/RetrieveRole",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServiceServer).RetrieveRole(ctx, req.(*RetrieveRoleReq))
+func (m *Group) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _AuthService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "protomfx.AuthService",
-	HandlerType: (*AuthServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Issue",
-			Handler:    _AuthService_Issue_Handler,
-		},
-		{
-			MethodName: "Identify",
-			Handler:    _AuthService_Identify_Handler,
-		},
-		{
-			MethodName: "Authorize",
-			Handler:    _AuthService_Authorize_Handler,
-		},
-		{
-			MethodName: "AssignRole",
-			Handler:    _AuthService_AssignRole_Handler,
-		},
-		{
-			MethodName: "RetrieveRole",
-			Handler:    _AuthService_RetrieveRole_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/proto/mfx.proto",
+func (m *Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Group) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GroupsReq struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ProfileConfig != nil {
-		{
-			size, err := m.ProfileConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMfx(dAtA, i, uint64(size))
+func (m *GroupsReq) Reset()         { *m = GroupsReq{} }
+func (m *GroupsReq) String() string { return proto.CompactTextString(m) }
+func (*GroupsReq) ProtoMessage()    {}
+func (*GroupsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{31}
+}
+func (m *GroupsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if m.Created != 0 {
-		i = encodeVarintMfx(dAtA, i, uint64(m.Created))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *GroupsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupsReq.Merge(m, src)
+}
+func (m *GroupsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupsReq proto.InternalMessageInfo
+
+func (m *GroupsReq) GetIds() []string {
+	if m != nil {
+		return m.Ids
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+type GroupsRes struct {
+	Groups               []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupsRes) Reset()         { *m = GroupsRes{} }
+func (m *GroupsRes) String() string { return proto.CompactTextString(m) }
+func (*GroupsRes) ProtoMessage()    {}
+func (*GroupsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{32}
+}
+func (m *GroupsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GroupsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Protocol) > 0 {
-		i -= len(m.Protocol)
-		copy(dAtA[i:], m.Protocol)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Protocol)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *GroupsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupsRes.Merge(m, src)
+}
+func (m *GroupsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupsRes proto.InternalMessageInfo
+
+func (m *GroupsRes) GetGroups() []*Group {
+	if m != nil {
+		return m.Groups
 	}
-	if len(m.Publisher) > 0 {
-		i -= len(m.Publisher)
-		copy(dAtA[i:], m.Publisher)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Publisher)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+type ThingsStatsRes struct {
+	Things               uint64   `protobuf:"varint,1,opt,name=things,proto3" json:"things,omitempty"`
+	Profiles             uint64   `protobuf:"varint,2,opt,name=profiles,proto3" json:"profiles,omitempty"`
+	Groups               uint64   `protobuf:"varint,3,opt,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThingsStatsRes) Reset()         { *m = ThingsStatsRes{} }
+func (m *ThingsStatsRes) String() string { return proto.CompactTextString(m) }
+func (*ThingsStatsRes) ProtoMessage()    {}
+func (*ThingsStatsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{33}
+}
+func (m *ThingsStatsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThingsStatsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThingsStatsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Subtopic) > 0 {
-		i -= len(m.Subtopic)
-		copy(dAtA[i:], m.Subtopic)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Subtopic)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ThingsStatsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThingsStatsRes.Merge(m, src)
+}
+func (m *ThingsStatsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThingsStatsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThingsStatsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThingsStatsRes proto.InternalMessageInfo
+
+func (m *ThingsStatsRes) GetThings() uint64 {
+	if m != nil {
+		return m.Things
 	}
-	if len(m.ProfileID) > 0 {
-		i -= len(m.ProfileID)
-		copy(dAtA[i:], m.ProfileID)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.ProfileID)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ThingsStatsRes) GetProfiles() uint64 {
+	if m != nil {
+		return m.Profiles
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PubConfByKeyReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ThingsStatsRes) GetGroups() uint64 {
+	if m != nil {
+		return m.Groups
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PubConfByKeyReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrgStatsReq struct {
+	OrgID                string   `protobuf:"bytes,1,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PubConfByKeyReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *OrgStatsReq) Reset()         { *m = OrgStatsReq{} }
+func (m *OrgStatsReq) String() string { return proto.CompactTextString(m) }
+func (*OrgStatsReq) ProtoMessage()    {}
+func (*OrgStatsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{34}
+}
+func (m *OrgStatsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgStatsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgStatsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrgStatsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgStatsReq.Merge(m, src)
+}
+func (m *OrgStatsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgStatsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgStatsReq.DiscardUnknown(m)
 }
 
-func (m *PubConfByKeyRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrgStatsReq proto.InternalMessageInfo
+
+func (m *OrgStatsReq) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PubConfByKeyRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AssignRoleReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PubConfByKeyRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ProfileConfig != nil {
-		{
-			size, err := m.ProfileConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMfx(dAtA, i, uint64(size))
+func (m *AssignRoleReq) Reset()         { *m = AssignRoleReq{} }
+func (m *AssignRoleReq) String() string { return proto.CompactTextString(m) }
+func (*AssignRoleReq) ProtoMessage()    {}
+func (*AssignRoleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{35}
+}
+func (m *AssignRoleReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignRoleReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.PublisherID) > 0 {
-		i -= len(m.PublisherID)
-		copy(dAtA[i:], m.PublisherID)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.PublisherID)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AssignRoleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignRoleReq.Merge(m, src)
+}
+func (m *AssignRoleReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignRoleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignRoleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignRoleReq proto.InternalMessageInfo
+
+func (m *AssignRoleReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Config) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AssignRoleReq) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Config) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RetrieveRoleReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Transformer != nil {
-		{
-			size, err := m.Transformer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMfx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SmppID) > 0 {
-		i -= len(m.SmppID)
-		copy(dAtA[i:], m.SmppID)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.SmppID)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SmtpID) > 0 {
-		i -= len(m.SmtpID)
-		copy(dAtA[i:], m.SmtpID)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.SmtpID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.WebhookID) > 0 {
-		i -= len(m.WebhookID)
-		copy(dAtA[i:], m.WebhookID)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.WebhookID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Write {
-		i--
-		if m.Write {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RetrieveRoleReq) Reset()         { *m = RetrieveRoleReq{} }
+func (m *RetrieveRoleReq) String() string { return proto.CompactTextString(m) }
+func (*RetrieveRoleReq) ProtoMessage()    {}
+func (*RetrieveRoleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{36}
+}
+func (m *RetrieveRoleReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrieveRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrieveRoleReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ContentType) > 0 {
-		i -= len(m.ContentType)
-		copy(dAtA[i:], m.ContentType)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.ContentType)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RetrieveRoleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveRoleReq.Merge(m, src)
+}
+func (m *RetrieveRoleReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrieveRoleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveRoleReq.DiscardUnknown(m)
 }
 
-func (m *ConfigByThingIDRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RetrieveRoleReq proto.InternalMessageInfo
+
+func (m *RetrieveRoleReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConfigByThingIDRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RetrieveRoleRes struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ConfigByThingIDRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMfx(dAtA, i, uint64(size))
+func (m *RetrieveRoleRes) Reset()         { *m = RetrieveRoleRes{} }
+func (m *RetrieveRoleRes) String() string { return proto.CompactTextString(m) }
+func (*RetrieveRoleRes) ProtoMessage()    {}
+func (*RetrieveRoleRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{37}
+}
+func (m *RetrieveRoleRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrieveRoleRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrieveRoleRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RetrieveRoleRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrieveRoleRes.Merge(m, src)
+}
+func (m *RetrieveRoleRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrieveRoleRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrieveRoleRes.DiscardUnknown(m)
 }
 
-func (m *Transformer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RetrieveRoleRes proto.InternalMessageInfo
+
+func (m *RetrieveRoleRes) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Transformer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SignPayloadReq struct {
+	OrgID                string   `protobuf:"bytes,1,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Secret               string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Transformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TimeLocation) > 0 {
-		i -= len(m.TimeLocation)
-		copy(dAtA[i:], m.TimeLocation)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.TimeLocation)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TimeFormat) > 0 {
-		i -= len(m.TimeFormat)
-		copy(dAtA[i:], m.TimeFormat)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.TimeFormat)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TimeField) > 0 {
-		i -= len(m.TimeField)
-		copy(dAtA[i:], m.TimeField)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.TimeField)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DataField) > 0 {
-		i -= len(m.DataField)
-		copy(dAtA[i:], m.DataField)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.DataField)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DataFilters) > 0 {
-		for iNdEx := len(m.DataFilters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DataFilters[iNdEx])
-			copy(dAtA[i:], m.DataFilters[iNdEx])
-			i = encodeVarintMfx(dAtA, i, uint64(len(m.DataFilters[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *SignPayloadReq) Reset()         { *m = SignPayloadReq{} }
+func (m *SignPayloadReq) String() string { return proto.CompactTextString(m) }
+func (*SignPayloadReq) ProtoMessage()    {}
+func (*SignPayloadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{38}
+}
+func (m *SignPayloadReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignPayloadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignPayloadReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignPayloadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPayloadReq.Merge(m, src)
+}
+func (m *SignPayloadReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignPayloadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPayloadReq.DiscardUnknown(m)
 }
 
-func (m *ThingID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignPayloadReq proto.InternalMessageInfo
+
+func (m *SignPayloadReq) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ThingID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignPayloadReq) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
 
-func (m *ThingID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *SignPayloadReq) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GroupID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type SignPayloadRes struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignPayloadRes) Reset()         { *m = SignPayloadRes{} }
+func (m *SignPayloadRes) String() string { return proto.CompactTextString(m) }
+func (*SignPayloadRes) ProtoMessage()    {}
+func (*SignPayloadRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{39}
 }
-
-func (m *GroupID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *SignPayloadRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignPayloadRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignPayloadRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignPayloadRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPayloadRes.Merge(m, src)
+}
+func (m *SignPayloadRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignPayloadRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPayloadRes.DiscardUnknown(m)
 }
 
-func (m *Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignPayloadRes proto.InternalMessageInfo
+
+func (m *SignPayloadRes) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Token) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SigningKeyReq struct {
+	OrgID                string   `protobuf:"bytes,1,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *SigningKeyReq) Reset()         { *m = SigningKeyReq{} }
+func (m *SigningKeyReq) String() string { return proto.CompactTextString(m) }
+func (*SigningKeyReq) ProtoMessage()    {}
+func (*SigningKeyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{40}
+}
+func (m *SigningKeyReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningKeyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningKeyReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SigningKeyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningKeyReq.Merge(m, src)
+}
+func (m *SigningKeyReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningKeyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningKeyReq.DiscardUnknown(m)
 }
 
-func (m *UserIdentity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SigningKeyReq proto.InternalMessageInfo
+
+func (m *SigningKeyReq) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UserIdentity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SigningKeyRes struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UserIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *SigningKeyRes) Reset()         { *m = SigningKeyRes{} }
+func (m *SigningKeyRes) String() string { return proto.CompactTextString(m) }
+func (*SigningKeyRes) ProtoMessage()    {}
+func (*SigningKeyRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{41}
+}
+func (m *SigningKeyRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningKeyRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningKeyRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SigningKeyRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningKeyRes.Merge(m, src)
+}
+func (m *SigningKeyRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningKeyRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningKeyRes.DiscardUnknown(m)
 }
 
-func (m *IssueReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SigningKeyRes proto.InternalMessageInfo
+
+func (m *SigningKeyRes) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IssueReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CheckQuotaReq struct {
+	OrgID                string   `protobuf:"bytes,1,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	Resource             string   `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Usage                uint64   `protobuf:"varint,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	Count                uint64   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IssueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CheckQuotaReq) Reset()         { *m = CheckQuotaReq{} }
+func (m *CheckQuotaReq) String() string { return proto.CompactTextString(m) }
+func (*CheckQuotaReq) ProtoMessage()    {}
+func (*CheckQuotaReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{42}
+}
+func (m *CheckQuotaReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckQuotaReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckQuotaReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Type != 0 {
-		i = encodeVarintMfx(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *CheckQuotaReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckQuotaReq.Merge(m, src)
+}
+func (m *CheckQuotaReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckQuotaReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckQuotaReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckQuotaReq proto.InternalMessageInfo
+
+func (m *CheckQuotaReq) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *CheckQuotaReq) GetResource() string {
+	if m != nil {
+		return m.Resource
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *CheckQuotaReq) GetUsage() uint64 {
+	if m != nil {
+		return m.Usage
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AuthorizeReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckQuotaReq) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AuthorizeReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RemoveUserReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthorizeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RemoveUserReq) Reset()         { *m = RemoveUserReq{} }
+func (m *RemoveUserReq) String() string { return proto.CompactTextString(m) }
+func (*RemoveUserReq) ProtoMessage()    {}
+func (*RemoveUserReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{43}
+}
+func (m *RemoveUserReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveUserReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveUserReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Action) > 0 {
-		i -= len(m.Action)
-		copy(dAtA[i:], m.Action)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Action)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Subject) > 0 {
-		i -= len(m.Subject)
-		copy(dAtA[i:], m.Subject)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Subject)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Object) > 0 {
-		i -= len(m.Object)
-		copy(dAtA[i:], m.Object)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Object)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *RemoveUserReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveUserReq.Merge(m, src)
+}
+func (m *RemoveUserReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveUserReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveUserReq.DiscardUnknown(m)
 }
 
-func (m *AuthorizeRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RemoveUserReq proto.InternalMessageInfo
+
+func (m *RemoveUserReq) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AuthorizeRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OrgMember struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthorizeRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Authorized {
-		i--
-		if m.Authorized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *OrgMember) Reset()         { *m = OrgMember{} }
+func (m *OrgMember) String() string { return proto.CompactTextString(m) }
+func (*OrgMember) ProtoMessage()    {}
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{44}
+}
+func (m *OrgMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OrgMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgMember.Merge(m, src)
 }
-
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrgMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgMember.DiscardUnknown(m)
 }
 
-func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_OrgMember proto.InternalMessageInfo
+
+func (m *OrgMember) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UsersByEmailsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrgMember) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UsersByEmailsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type AssignMembersReq struct {
+	Token                string       `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	OrgID                string       `protobuf:"bytes,2,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	Members              []*OrgMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *UsersByEmailsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Emails) > 0 {
-		for iNdEx := len(m.Emails) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Emails[iNdEx])
-			copy(dAtA[i:], m.Emails[iNdEx])
-			i = encodeVarintMfx(dAtA, i, uint64(len(m.Emails[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *AssignMembersReq) Reset()         { *m = AssignMembersReq{} }
+func (m *AssignMembersReq) String() string { return proto.CompactTextString(m) }
+func (*AssignMembersReq) ProtoMessage()    {}
+func (*AssignMembersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{45}
+}
+func (m *AssignMembersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignMembersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignMembersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AssignMembersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignMembersReq.Merge(m, src)
+}
+func (m *AssignMembersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignMembersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignMembersReq.DiscardUnknown(m)
 }
 
-func (m *UsersByIDsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AssignMembersReq proto.InternalMessageInfo
+
+func (m *AssignMembersReq) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UsersByIDsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AssignMembersReq) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
+	}
+	return ""
 }
 
-func (m *UsersByIDsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *AssignMembersReq) GetMembers() []*OrgMember {
+	if m != nil {
+		return m.Members
 	}
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintMfx(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+type OrgMembersReq struct {
+	OrgID                string   `protobuf:"bytes,1,opt,name=orgID,proto3" json:"orgID,omitempty"`
+	MemberIDs            []string `protobuf:"bytes,2,rep,name=memberIDs,proto3" json:"memberIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrgMembersReq) Reset()         { *m = OrgMembersReq{} }
+func (m *OrgMembersReq) String() string { return proto.CompactTextString(m) }
+func (*OrgMembersReq) ProtoMessage()    {}
+func (*OrgMembersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{46}
+}
+func (m *OrgMembersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgMembersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgMembersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrgMembersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgMembersReq.Merge(m, src)
+}
+func (m *OrgMembersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgMembersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgMembersReq.DiscardUnknown(m)
 }
 
-func (m *UsersRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrgMembersReq proto.InternalMessageInfo
+
+func (m *OrgMembersReq) GetOrgID() string {
+	if m != nil {
+		return m.OrgID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UsersRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OrgMembersReq) GetMemberIDs() []string {
+	if m != nil {
+		return m.MemberIDs
+	}
+	return nil
 }
 
-func (m *UsersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Users) > 0 {
-		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMfx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type OrgMembersRes struct {
+	MemberIDs            []string `protobuf:"bytes,1,rep,name=memberIDs,proto3" json:"memberIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrgMembersRes) Reset()         { *m = OrgMembersRes{} }
+func (m *OrgMembersRes) String() string { return proto.CompactTextString(m) }
+func (*OrgMembersRes) ProtoMessage()    {}
+func (*OrgMembersRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f5c89a6f82d4869, []int{47}
+}
+func (m *OrgMembersRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgMembersRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgMembersRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OrgMembersRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgMembersRes.Merge(m, src)
+}
+func (m *OrgMembersRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgMembersRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgMembersRes.DiscardUnknown(m)
 }
 
-func (m *Group) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OrgMembersRes proto.InternalMessageInfo
+
+func (m *OrgMembersRes) GetMemberIDs() []string {
+	if m != nil {
+		return m.MemberIDs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*Message)(nil), "protomfx.Message")
+	proto.RegisterType((*PubConfByKeyReq)(nil), "protomfx.PubConfByKeyReq")
+	proto.RegisterType((*PubConfByKeyRes)(nil), "protomfx.PubConfByKeyRes")
+	proto.RegisterType((*PubConfByShareReq)(nil), "protomfx.PubConfByShareReq")
+	proto.RegisterType((*PubConfByGatewayReq)(nil), "protomfx.PubConfByGatewayReq")
+	proto.RegisterType((*PubConfsReq)(nil), "protomfx.PubConfsReq")
+	proto.RegisterType((*ThingPubConf)(nil), "protomfx.ThingPubConf")
+	proto.RegisterType((*NetworkACL)(nil), "protomfx.NetworkACL")
+	proto.RegisterType((*PubConfsRes)(nil), "protomfx.PubConfsRes")
+	proto.RegisterType((*Config)(nil), "protomfx.Config")
+	proto.RegisterType((*MQTTConfig)(nil), "protomfx.MQTTConfig")
+	proto.RegisterType((*ConfigByThingIDRes)(nil), "protomfx.ConfigByThingIDRes")
+	proto.RegisterType((*Transformer)(nil), "protomfx.Transformer")
+	proto.RegisterMapType((map[string]string)(nil), "protomfx.Transformer.UnitsEntry")
+	proto.RegisterType((*ThingID)(nil), "protomfx.ThingID")
+	proto.RegisterType((*ThingIDsRes)(nil), "protomfx.ThingIDsRes")
+	proto.RegisterType((*GroupID)(nil), "protomfx.GroupID")
+	proto.RegisterType((*Token)(nil), "protomfx.Token")
+	proto.RegisterType((*TokensReq)(nil), "protomfx.TokensReq")
+	proto.RegisterType((*UserIdentity)(nil), "protomfx.UserIdentity")
+	proto.RegisterType((*DelegatedIdentity)(nil), "protomfx.DelegatedIdentity")
+	proto.RegisterType((*UserIdentitiesRes)(nil), "protomfx.UserIdentitiesRes")
+	proto.RegisterType((*IssueReq)(nil), "protomfx.IssueReq")
+	proto.RegisterType((*AuthorizeReq)(nil), "protomfx.AuthorizeReq")
+	proto.RegisterType((*AuthorizeBatchReq)(nil), "protomfx.AuthorizeBatchReq")
+	proto.RegisterType((*AuthorizeRes)(nil), "protomfx.AuthorizeRes")
+	proto.RegisterType((*User)(nil), "protomfx.User")
+	proto.RegisterType((*UsersByEmailsReq)(nil), "protomfx.UsersByEmailsReq")
+	proto.RegisterType((*UsersByIDsReq)(nil), "protomfx.UsersByIDsReq")
+	proto.RegisterType((*UsersRes)(nil), "protomfx.UsersRes")
+	proto.RegisterType((*UsersStatsRes)(nil), "protomfx.UsersStatsRes")
+	proto.RegisterType((*Group)(nil), "protomfx.Group")
+	proto.RegisterType((*GroupsReq)(nil), "protomfx.GroupsReq")
+	proto.RegisterType((*GroupsRes)(nil), "protomfx.GroupsRes")
+	proto.RegisterType((*ThingsStatsRes)(nil), "protomfx.ThingsStatsRes")
+	proto.RegisterType((*OrgStatsReq)(nil), "protomfx.OrgStatsReq")
+	proto.RegisterType((*AssignRoleReq)(nil), "protomfx.AssignRoleReq")
+	proto.RegisterType((*RetrieveRoleReq)(nil), "protomfx.RetrieveRoleReq")
+	proto.RegisterType((*RetrieveRoleRes)(nil), "protomfx.RetrieveRoleRes")
+	proto.RegisterType((*SignPayloadReq)(nil), "protomfx.SignPayloadReq")
+	proto.RegisterType((*SignPayloadRes)(nil), "protomfx.SignPayloadRes")
+	proto.RegisterType((*SigningKeyReq)(nil), "protomfx.SigningKeyReq")
+	proto.RegisterType((*SigningKeyRes)(nil), "protomfx.SigningKeyRes")
+	proto.RegisterType((*CheckQuotaReq)(nil), "protomfx.CheckQuotaReq")
+	proto.RegisterType((*RemoveUserReq)(nil), "protomfx.RemoveUserReq")
+	proto.RegisterType((*OrgMember)(nil), "protomfx.OrgMember")
+	proto.RegisterType((*AssignMembersReq)(nil), "protomfx.AssignMembersReq")
+	proto.RegisterType((*OrgMembersReq)(nil), "protomfx.OrgMembersReq")
+	proto.RegisterType((*OrgMembersRes)(nil), "protomfx.OrgMembersRes")
 }
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrgID) > 0 {
-		i -= len(m.OrgID)
-		copy(dAtA[i:], m.OrgID)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func init() { proto.RegisterFile("pkg/proto/mfx.proto", fileDescriptor_4f5c89a6f82d4869) }
+
+var fileDescriptor_4f5c89a6f82d4869 = []byte{
+	// 2062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x18, 0xcb, 0x72, 0x23, 0x49,
+	0xd1, 0x7a, 0xcb, 0x29, 0xcb, 0x8f, 0xf2, 0xcc, 0xd0, 0x68, 0x76, 0x3c, 0xde, 0x9a, 0x5d, 0x70,
+	0x10, 0x31, 0x9a, 0x0d, 0xef, 0xae, 0xd9, 0x00, 0x76, 0xc0, 0xb6, 0xbc, 0x46, 0x31, 0x6b, 0x76,
+	0xa6, 0xc7, 0x1b, 0x70, 0xe0, 0xd2, 0x6e, 0x95, 0xe4, 0xc6, 0xfd, 0x90, 0xbb, 0xaa, 0x6d, 0x6b,
+	0x4f, 0x7c, 0x06, 0xc1, 0x1f, 0x70, 0xe2, 0xc4, 0x3f, 0x70, 0x24, 0x82, 0xe0, 0x4e, 0x0c, 0x07,
+	0x7e, 0x82, 0x03, 0x51, 0xaf, 0xee, 0xea, 0x96, 0x5a, 0xe1, 0xe1, 0xc4, 0x49, 0xca, 0xac, 0xcc,
+	0xac, 0x7c, 0x67, 0x56, 0xc3, 0xf6, 0xf4, 0x6a, 0xf2, 0x62, 0x1a, 0x47, 0x2c, 0x7a, 0x11, 0x8c,
+	0xef, 0xfa, 0xe2, 0x1f, 0x6a, 0x8b, 0x9f, 0x60, 0x7c, 0xd7, 0x7b, 0x3c, 0x89, 0xa2, 0x89, 0x4f,
+	0x24, 0xc5, 0x45, 0x32, 0x7e, 0x41, 0x82, 0x29, 0x9b, 0x49, 0x32, 0xfc, 0x8f, 0x2a, 0xb4, 0xce,
+	0x08, 0xa5, 0xce, 0x84, 0xa0, 0x0f, 0x60, 0x75, 0x1a, 0x47, 0x63, 0xcf, 0x27, 0xc3, 0x81, 0x55,
+	0xd9, 0xad, 0xec, 0xad, 0xda, 0x19, 0x02, 0xf5, 0xa0, 0x4d, 0x93, 0x0b, 0x16, 0x4d, 0x3d, 0xd7,
+	0xaa, 0x8a, 0xc3, 0x14, 0x16, 0x9c, 0xc9, 0x85, 0xef, 0xd1, 0x4b, 0x12, 0x5b, 0x35, 0xc5, 0xa9,
+	0x11, 0x9c, 0x53, 0x5c, 0xe6, 0x46, 0xbe, 0x55, 0x97, 0x9c, 0x1a, 0x46, 0x16, 0xb4, 0xa6, 0xce,
+	0xcc, 0x8f, 0x9c, 0x91, 0xd5, 0xd8, 0xad, 0xec, 0xad, 0xd9, 0x1a, 0xe4, 0x27, 0x6e, 0x4c, 0x1c,
+	0x46, 0x46, 0x56, 0x73, 0xb7, 0xb2, 0x57, 0xb3, 0x35, 0x88, 0x0e, 0xa0, 0xab, 0xd4, 0x3a, 0x8e,
+	0xc2, 0xb1, 0x37, 0xb1, 0x5a, 0xbb, 0x95, 0xbd, 0xce, 0xfe, 0x66, 0x5f, 0x9b, 0xdc, 0x97, 0x78,
+	0x3b, 0x4f, 0x86, 0x1e, 0x40, 0x23, 0x8a, 0x27, 0xc3, 0x81, 0xd5, 0x16, 0x4a, 0x48, 0x80, 0xdf,
+	0x43, 0xee, 0xa6, 0x5e, 0x4c, 0xa8, 0xb5, 0x2a, 0xef, 0x51, 0x20, 0x3f, 0x99, 0xc4, 0x51, 0x32,
+	0x1d, 0x0e, 0x2c, 0x10, 0x1c, 0x1a, 0x44, 0xbb, 0xd0, 0x61, 0xb1, 0x13, 0xd2, 0x71, 0x14, 0x07,
+	0x24, 0xb6, 0x3a, 0xe2, 0xd4, 0x44, 0xe1, 0x67, 0xb0, 0xf1, 0x3a, 0xb9, 0xe0, 0x17, 0x1f, 0xcd,
+	0x5e, 0x91, 0x99, 0x4d, 0xae, 0xd1, 0x26, 0xd4, 0xae, 0xc8, 0x4c, 0x39, 0x96, 0xff, 0xc5, 0x7f,
+	0xaa, 0x16, 0xa9, 0x28, 0x17, 0x9d, 0x7a, 0x2e, 0x0d, 0x83, 0x89, 0x9a, 0x37, 0xbf, 0xfa, 0x9e,
+	0xe6, 0xd7, 0x4c, 0xf3, 0x0f, 0xa0, 0x13, 0x12, 0x76, 0x1b, 0xc5, 0x57, 0x87, 0xc7, 0x5f, 0x53,
+	0xab, 0xbe, 0x5b, 0xdb, 0xeb, 0xec, 0x3f, 0xc8, 0x64, 0xfd, 0x2a, 0x3d, 0xb4, 0x4d, 0xc2, 0x7c,
+	0xb2, 0x34, 0x8a, 0xc9, 0x62, 0xb8, 0xae, 0x99, 0x77, 0x9d, 0x05, 0x2d, 0x7a, 0xe9, 0xc4, 0x9c,
+	0xab, 0x25, 0x4f, 0x14, 0xc8, 0x25, 0x2a, 0xcf, 0x1f, 0x32, 0x11, 0xa2, 0x9a, 0x9d, 0x21, 0xf0,
+	0x21, 0x6c, 0xa5, 0xae, 0x7a, 0xcb, 0x39, 0x16, 0xba, 0x54, 0xe4, 0x9a, 0x43, 0xe9, 0x6d, 0x14,
+	0x8f, 0x74, 0x96, 0x6a, 0x18, 0x1f, 0xc2, 0x76, 0x2a, 0xe2, 0xd4, 0x61, 0xe4, 0xd6, 0x59, 0x1c,
+	0x17, 0xae, 0x23, 0xbb, 0xf4, 0x42, 0xee, 0x2b, 0x29, 0x43, 0x83, 0xf8, 0xa7, 0xd0, 0x51, 0x22,
+	0x28, 0x67, 0x7d, 0x04, 0xcd, 0x68, 0x3c, 0xa6, 0x84, 0x09, 0xee, 0xba, 0xad, 0x20, 0xee, 0x6a,
+	0xdf, 0x0b, 0x3c, 0x26, 0xd8, 0xeb, 0xb6, 0x04, 0xf0, 0xef, 0xab, 0xb0, 0x76, 0xce, 0x05, 0x29,
+	0x11, 0x0b, 0x6e, 0x2e, 0x44, 0xbf, 0x7a, 0x8f, 0xe8, 0xd7, 0xde, 0x33, 0xfa, 0xf5, 0x25, 0xd1,
+	0x6f, 0xfc, 0x4f, 0xd1, 0x6f, 0x2e, 0x89, 0x7e, 0x2b, 0x17, 0x7d, 0x7c, 0x00, 0x90, 0x89, 0xe4,
+	0x3a, 0x39, 0xbe, 0x1f, 0xdd, 0x5a, 0x95, 0xdd, 0x1a, 0xd7, 0x49, 0x00, 0x08, 0x41, 0x7d, 0x44,
+	0xc2, 0x99, 0x55, 0x15, 0x48, 0xf1, 0x1f, 0xff, 0xda, 0xf4, 0x3b, 0x45, 0xfb, 0xd0, 0x9e, 0x2a,
+	0x50, 0xf0, 0x76, 0xf6, 0x1f, 0x65, 0x3a, 0x9b, 0x2e, 0xb6, 0x53, 0x3a, 0x7e, 0x19, 0x8b, 0x98,
+	0xe3, 0xeb, 0x98, 0x08, 0x00, 0xff, 0xbb, 0x0a, 0x4d, 0xe5, 0xa1, 0x5d, 0xe8, 0xb8, 0x51, 0xc8,
+	0x48, 0xc8, 0xce, 0x67, 0x53, 0xa2, 0x2b, 0xcf, 0x40, 0x71, 0x11, 0xb7, 0xb1, 0xc7, 0x88, 0x10,
+	0xd1, 0xb6, 0x25, 0xc0, 0x7d, 0x71, 0x4b, 0x2e, 0x2e, 0xa3, 0xe8, 0x2a, 0xad, 0xad, 0x0c, 0xc1,
+	0x53, 0x84, 0x06, 0x6c, 0x9a, 0x3a, 0x5e, 0x41, 0x12, 0x3f, 0x9d, 0xa6, 0xc5, 0xa3, 0x20, 0xf4,
+	0xe3, 0x7c, 0x6b, 0x69, 0x8a, 0xe8, 0x3e, 0x34, 0xac, 0xcb, 0x0e, 0x73, 0x1d, 0x87, 0x3b, 0x5d,
+	0xe8, 0x13, 0x53, 0xab, 0x25, 0x3c, 0xa7, 0x41, 0xb4, 0x07, 0x1b, 0xca, 0x8a, 0x93, 0xd0, 0x8d,
+	0x46, 0x5e, 0x38, 0x51, 0x1d, 0xb0, 0x88, 0x46, 0x7b, 0x50, 0x0f, 0xae, 0x19, 0x13, 0x8d, 0x30,
+	0x97, 0x07, 0x67, 0x6f, 0xce, 0xcf, 0x55, 0x5e, 0x09, 0x0a, 0xa1, 0xbe, 0x7b, 0x49, 0x02, 0x47,
+	0xb5, 0x46, 0x05, 0x71, 0x2d, 0x6e, 0x48, 0x4c, 0xbd, 0x28, 0x14, 0x5d, 0xb1, 0x6e, 0x6b, 0x10,
+	0xff, 0xb1, 0x02, 0x90, 0x89, 0xe1, 0x5e, 0xbb, 0x22, 0x64, 0x7a, 0xe8, 0x7b, 0x37, 0xd2, 0xd7,
+	0x5d, 0x3b, 0x43, 0xf0, 0x58, 0x04, 0xce, 0xdd, 0x30, 0x1c, 0xfb, 0xde, 0xe4, 0x52, 0x96, 0x51,
+	0xd7, 0x36, 0x51, 0xe8, 0x07, 0xb0, 0x1e, 0x13, 0x97, 0x78, 0x37, 0xe4, 0xcc, 0xb9, 0xf3, 0x82,
+	0x24, 0x10, 0xae, 0xef, 0xda, 0x05, 0x2c, 0xfa, 0x08, 0xba, 0x81, 0x73, 0xf7, 0xda, 0x71, 0xaf,
+	0x08, 0x7b, 0xeb, 0x7d, 0x47, 0x44, 0x18, 0xba, 0x76, 0x1e, 0x89, 0x5f, 0x02, 0x92, 0x7a, 0x1d,
+	0xcd, 0xce, 0x65, 0xa9, 0xf3, 0x34, 0xdb, 0x83, 0xa6, 0x2b, 0x8b, 0xac, 0x52, 0x52, 0x64, 0xea,
+	0x1c, 0xff, 0xa5, 0x0a, 0x1d, 0x23, 0x32, 0x5c, 0xff, 0x91, 0xc3, 0x9c, 0xaf, 0x3c, 0x5f, 0x04,
+	0x44, 0xe6, 0xb7, 0x89, 0xe2, 0xf6, 0x4b, 0x90, 0xf8, 0xba, 0x53, 0x65, 0x08, 0x7e, 0xca, 0xbc,
+	0x80, 0xc8, 0x53, 0x95, 0x53, 0x29, 0x02, 0xed, 0x00, 0x08, 0x20, 0x8a, 0x03, 0x87, 0xa9, 0xbc,
+	0x32, 0x30, 0x08, 0xc3, 0x1a, 0x87, 0xbe, 0x8e, 0x5c, 0x87, 0xf1, 0x48, 0xc8, 0x0c, 0xcb, 0xe1,
+	0xd0, 0x01, 0x34, 0x92, 0xd0, 0x63, 0xd4, 0x6a, 0x8a, 0xfa, 0xd9, 0x5d, 0x98, 0x61, 0xfd, 0x6f,
+	0x39, 0xc9, 0x49, 0xc8, 0xe2, 0x99, 0x2d, 0xc9, 0x79, 0x75, 0x86, 0x4e, 0x40, 0x54, 0x61, 0x8b,
+	0xff, 0xbd, 0x2f, 0x00, 0x32, 0xc2, 0x05, 0x5d, 0xed, 0x01, 0x34, 0x6e, 0x1c, 0x3f, 0x21, 0xca,
+	0x4e, 0x09, 0xfc, 0xa4, 0xfa, 0x45, 0x05, 0x3f, 0x85, 0x96, 0xf2, 0x77, 0x46, 0x54, 0x31, 0x88,
+	0xf0, 0x53, 0xe8, 0x28, 0x02, 0x51, 0xf8, 0x9b, 0x50, 0xf3, 0x46, 0xda, 0x9f, 0xfc, 0x2f, 0x97,
+	0x70, 0xaa, 0x46, 0xcb, 0x62, 0x09, 0x4f, 0xa0, 0x71, 0x1e, 0x5d, 0x91, 0xb0, 0xe4, 0xf8, 0x19,
+	0xac, 0x8a, 0x63, 0xdd, 0xcf, 0x99, 0x00, 0xd4, 0x0d, 0x0a, 0xc2, 0x9f, 0xc1, 0xda, 0xb7, 0x94,
+	0xc4, 0xc3, 0x11, 0x09, 0x99, 0xc7, 0x66, 0x68, 0x1d, 0xaa, 0xde, 0x48, 0xc9, 0xa9, 0x7a, 0x23,
+	0x2e, 0x9a, 0x04, 0x8e, 0xe7, 0x6b, 0x03, 0x05, 0x80, 0x29, 0x6c, 0x0d, 0x88, 0x4f, 0x26, 0x7c,
+	0x69, 0x29, 0x65, 0x2d, 0x9d, 0x35, 0x5c, 0x19, 0xc7, 0x15, 0xf1, 0x93, 0x09, 0xa0, 0xa0, 0xfc,
+	0x9c, 0xac, 0x17, 0xe7, 0xe4, 0x2b, 0xd8, 0x32, 0x54, 0xf5, 0x88, 0x70, 0xdb, 0x01, 0x80, 0x97,
+	0x22, 0xe6, 0x3b, 0xa6, 0x69, 0x9b, 0x6d, 0x50, 0xe2, 0x01, 0xb4, 0x87, 0x94, 0x26, 0x62, 0xd6,
+	0xde, 0xcb, 0x66, 0x9e, 0x1e, 0x8c, 0x77, 0x4f, 0x59, 0x8c, 0xe2, 0x3f, 0x0e, 0x61, 0xed, 0x30,
+	0x61, 0x97, 0x51, 0xec, 0x7d, 0x27, 0x24, 0x89, 0x4e, 0x7c, 0x45, 0x42, 0x1d, 0x08, 0x01, 0x88,
+	0x59, 0x7a, 0xf1, 0x3b, 0xe2, 0x32, 0x25, 0x50, 0x41, 0x62, 0x61, 0x48, 0xe4, 0x41, 0x4d, 0x2d,
+	0x0c, 0x12, 0x34, 0x1c, 0x54, 0x37, 0x1d, 0x84, 0x4f, 0x61, 0x2b, 0xbd, 0xef, 0xc8, 0x61, 0xee,
+	0x25, 0xbf, 0x74, 0x1f, 0xda, 0x31, 0xb9, 0x4e, 0x08, 0x65, 0x0b, 0x1c, 0x60, 0xaa, 0x67, 0xa7,
+	0x74, 0xb8, 0x9f, 0x53, 0x9c, 0xf2, 0xba, 0x73, 0x34, 0x2c, 0x5d, 0xd1, 0xb6, 0x0d, 0x0c, 0x1e,
+	0x40, 0x9d, 0xbb, 0xf2, 0x9e, 0xae, 0xe2, 0x2d, 0x94, 0x39, 0x2c, 0xa1, 0x3a, 0xbe, 0x12, 0xc2,
+	0x3f, 0x82, 0x4d, 0x2e, 0x85, 0x1e, 0xcd, 0x4e, 0x38, 0x9d, 0x4e, 0x4c, 0xc1, 0x94, 0x26, 0xa6,
+	0x84, 0xf0, 0x87, 0xd0, 0x55, 0xb4, 0xa2, 0x40, 0xae, 0x17, 0x14, 0xc8, 0x27, 0xd0, 0x16, 0x24,
+	0xdc, 0x80, 0x8f, 0xa0, 0x91, 0x50, 0xdd, 0x90, 0x3a, 0xfb, 0xeb, 0xf9, 0x14, 0xb0, 0xe5, 0x21,
+	0xfe, 0x58, 0x09, 0x7d, 0xcb, 0x1c, 0x26, 0xd8, 0x1e, 0x64, 0x6c, 0x62, 0x74, 0x4a, 0x32, 0x17,
+	0x1a, 0xa2, 0xf2, 0x16, 0x99, 0x2b, 0x57, 0x8d, 0xaa, 0xb9, 0x6a, 0xe8, 0xc6, 0x51, 0xcb, 0x1a,
+	0x87, 0x68, 0x93, 0x84, 0xba, 0xb1, 0x37, 0x35, 0xc2, 0x68, 0xa2, 0xf0, 0x13, 0x58, 0x15, 0x97,
+	0x94, 0x18, 0xf7, 0x59, 0x76, 0x4c, 0xd1, 0x0f, 0xa1, 0x29, 0xf6, 0x0c, 0x6d, 0xde, 0x46, 0x66,
+	0x9e, 0x20, 0xb2, 0xd5, 0x31, 0xfe, 0x2d, 0xac, 0x8b, 0xa6, 0x92, 0x59, 0xc8, 0x0b, 0x5f, 0x60,
+	0xf4, 0x22, 0x27, 0x21, 0xf5, 0x74, 0xe1, 0x6b, 0x0d, 0x55, 0x7b, 0x43, 0x0a, 0x73, 0x1e, 0x75,
+	0x5d, 0x4d, 0xf2, 0x28, 0xe9, 0xcf, 0xa0, 0xf3, 0x4d, 0x3c, 0x51, 0xa2, 0xaf, 0x33, 0x6f, 0x54,
+	0x0c, 0x6f, 0xe0, 0x4f, 0xa1, 0x7b, 0x48, 0xa9, 0x37, 0x09, 0xed, 0xc8, 0x5f, 0x58, 0x5e, 0x08,
+	0xea, 0x71, 0xe4, 0xeb, 0x96, 0x29, 0xfe, 0xe3, 0x0f, 0x61, 0xc3, 0x26, 0x2c, 0xf6, 0xc8, 0x0d,
+	0x29, 0x61, 0xc3, 0x1f, 0x17, 0x49, 0x68, 0x2a, 0xa9, 0x62, 0x48, 0xfa, 0x0d, 0xac, 0xbf, 0xf5,
+	0x26, 0xe1, 0x6b, 0xf9, 0xd6, 0x2a, 0x55, 0xd3, 0x7c, 0x9e, 0x55, 0xf3, 0xcf, 0x33, 0x9e, 0xbd,
+	0xc4, 0x8d, 0x09, 0x4b, 0xb3, 0x57, 0x40, 0xb8, 0x5f, 0x90, 0x2c, 0x26, 0x1d, 0x37, 0xd4, 0x61,
+	0x49, 0x2c, 0x95, 0x58, 0xb3, 0x33, 0x04, 0x4f, 0x36, 0x4e, 0xef, 0x85, 0x13, 0xf5, 0x4c, 0x5a,
+	0xec, 0xaf, 0xe7, 0x79, 0x32, 0x9a, 0x3e, 0x39, 0xdd, 0x57, 0x6a, 0xd6, 0xac, 0xd9, 0x19, 0x02,
+	0x07, 0xd0, 0x3d, 0xbe, 0x24, 0xee, 0xd5, 0x9b, 0x24, 0x62, 0x4e, 0xb9, 0x79, 0x3d, 0xde, 0x14,
+	0x68, 0x94, 0xc4, 0xae, 0x76, 0x74, 0x0a, 0xcb, 0xa4, 0x77, 0x26, 0x44, 0x45, 0x57, 0x02, 0x1c,
+	0xeb, 0x46, 0x49, 0x28, 0x1b, 0x6f, 0xdd, 0x96, 0x00, 0x7e, 0x0a, 0x5d, 0x9b, 0x04, 0xd1, 0x0d,
+	0x11, 0x65, 0xb4, 0x20, 0x2c, 0x9f, 0xc3, 0xea, 0x37, 0xf1, 0xe4, 0x8c, 0x04, 0x17, 0x24, 0xce,
+	0xda, 0x41, 0xa5, 0xd0, 0x39, 0xe7, 0x02, 0x1e, 0xc0, 0xa6, 0xcc, 0x12, 0xc9, 0x49, 0xcb, 0xbb,
+	0xe7, 0xe2, 0x9a, 0x7b, 0x0e, 0xad, 0x40, 0x72, 0x5a, 0x35, 0x51, 0x12, 0xdb, 0x59, 0x49, 0xa4,
+	0xfa, 0xd8, 0x9a, 0x06, 0x1f, 0x43, 0x37, 0xc5, 0x96, 0xe7, 0x2e, 0x77, 0xbd, 0xe4, 0x18, 0x0e,
+	0xa8, 0xda, 0xd2, 0x33, 0x04, 0x7e, 0x9e, 0x17, 0x42, 0xf3, 0xe4, 0x95, 0x02, 0xf9, 0xfe, 0x7f,
+	0x9a, 0xd0, 0x55, 0xc5, 0x48, 0xe2, 0x1b, 0xcf, 0x25, 0x68, 0x08, 0x1b, 0xa7, 0x84, 0x99, 0xef,
+	0x62, 0xf4, 0xfd, 0x4c, 0xed, 0xc2, 0xab, 0xba, 0x57, 0x7a, 0x44, 0xf1, 0x0a, 0x3a, 0x05, 0x74,
+	0x4a, 0x58, 0x61, 0xb3, 0x43, 0x5b, 0x85, 0xb7, 0xc2, 0x70, 0xd0, 0xfb, 0xa0, 0xb8, 0xd9, 0x99,
+	0x7b, 0x20, 0x5e, 0x41, 0x5f, 0xc2, 0x6a, 0x3a, 0x09, 0x50, 0xc9, 0xe0, 0xe8, 0x3d, 0xea, 0xcb,
+	0x2f, 0x2d, 0x7d, 0xfd, 0xa5, 0xa5, 0x7f, 0xc2, 0xbf, 0xb4, 0x08, 0x3d, 0xd6, 0xf3, 0x13, 0x09,
+	0x3d, 0x5e, 0x20, 0x43, 0xcf, 0xaa, 0x25, 0x82, 0x3e, 0x81, 0xb6, 0x1c, 0xd4, 0xe3, 0x19, 0x32,
+	0xda, 0x9b, 0xd8, 0x60, 0x7a, 0xf3, 0x76, 0x09, 0xcd, 0xbb, 0x9a, 0x43, 0xde, 0xbc, 0x5d, 0x60,
+	0xe3, 0x81, 0xee, 0x3d, 0x9c, 0x63, 0xa5, 0xd2, 0xf0, 0x9f, 0xc1, 0xfa, 0x29, 0x61, 0xb2, 0xc7,
+	0x8a, 0x21, 0x63, 0xf2, 0xa7, 0x9d, 0xb9, 0xb7, 0x00, 0x29, 0xdd, 0xb6, 0xad, 0xb9, 0x87, 0x83,
+	0xa5, 0x01, 0xd8, 0x2a, 0x08, 0x50, 0xba, 0x77, 0xb2, 0x4c, 0xa0, 0xe8, 0xe1, 0x5c, 0xa8, 0x8b,
+	0xba, 0x67, 0x68, 0x7e, 0xfb, 0x19, 0x6c, 0x99, 0x89, 0x24, 0xbe, 0x1a, 0x98, 0x8e, 0x9f, 0xfb,
+	0x9e, 0xb0, 0x3c, 0x99, 0xde, 0x08, 0x63, 0x8a, 0x5f, 0x10, 0xd0, 0x93, 0x05, 0x3c, 0xd9, 0xd7,
+	0x85, 0xe5, 0x22, 0x5f, 0x42, 0xfb, 0x94, 0x30, 0x31, 0x2a, 0x50, 0x49, 0xd0, 0x7b, 0x56, 0xc1,
+	0x59, 0xe9, 0xd0, 0xc2, 0x2b, 0xe8, 0x17, 0xc2, 0x41, 0x7a, 0xda, 0x98, 0x0e, 0x32, 0x26, 0xd0,
+	0x32, 0x09, 0xfb, 0x7f, 0xaf, 0xc8, 0xd5, 0x36, 0xad, 0xbe, 0x97, 0xd0, 0x3d, 0x25, 0x2c, 0x5b,
+	0x2a, 0xd0, 0xf7, 0xf2, 0x4b, 0x42, 0xba, 0x6a, 0xf4, 0x50, 0xe1, 0x40, 0xaa, 0x34, 0x80, 0xcd,
+	0x8c, 0x5f, 0x2e, 0x30, 0xa8, 0x37, 0x27, 0x22, 0xdd, 0x6c, 0x4a, 0xa4, 0x7c, 0x79, 0x0f, 0xc7,
+	0x14, 0x15, 0x33, 0xac, 0xfa, 0x73, 0x0b, 0x3a, 0xbc, 0xac, 0xb4, 0x51, 0x7d, 0x68, 0x88, 0x3d,
+	0x16, 0x19, 0xb7, 0xe9, 0xc5, 0xb6, 0x57, 0xac, 0x23, 0xbc, 0x82, 0x3e, 0x5f, 0x56, 0x66, 0x25,
+	0x8b, 0x33, 0x5e, 0x41, 0xc7, 0xf7, 0xaa, 0xb5, 0xc7, 0x0b, 0xf9, 0xe5, 0xa6, 0x2e, 0x84, 0x6c,
+	0x69, 0x21, 0xe9, 0xeb, 0x61, 0x5e, 0x09, 0x43, 0xc8, 0xdc, 0x1b, 0xe3, 0xff, 0xa8, 0x5f, 0xfd,
+	0x1c, 0x20, 0x5b, 0x73, 0xcc, 0x54, 0xca, 0x2d, 0x3f, 0x4b, 0x04, 0x7c, 0x05, 0x6b, 0xe6, 0x3e,
+	0x63, 0x4e, 0x82, 0xc2, 0x2a, 0xd4, 0x2b, 0x3d, 0xe2, 0x5e, 0x3d, 0xd1, 0xfb, 0x96, 0x1a, 0x4c,
+	0x66, 0x4e, 0x16, 0x47, 0xec, 0x12, 0x75, 0x8e, 0xa1, 0x63, 0x6c, 0x37, 0xc8, 0xa8, 0xac, 0xfc,
+	0x3a, 0xd5, 0x2b, 0x3b, 0xe1, 0xba, 0xfc, 0x12, 0x90, 0x56, 0x30, 0xdb, 0x69, 0x4c, 0xe7, 0xe4,
+	0x16, 0xa2, 0x5e, 0xc9, 0x01, 0x95, 0xee, 0xcd, 0xd6, 0x1c, 0x53, 0x42, 0x6e, 0xf9, 0x59, 0x1e,
+	0x9f, 0x6c, 0x71, 0x31, 0x05, 0xe4, 0xd6, 0x99, 0xa5, 0xf1, 0xd9, 0x94, 0x5f, 0x34, 0xb2, 0x99,
+	0x6f, 0x8a, 0xc9, 0xad, 0x13, 0xbd, 0x92, 0x03, 0x8a, 0x57, 0x8e, 0x36, 0xff, 0xfa, 0x6e, 0xa7,
+	0xf2, 0xb7, 0x77, 0x3b, 0x95, 0x7f, 0xbe, 0xdb, 0xa9, 0xfc, 0xe1, 0x5f, 0x3b, 0x2b, 0x17, 0x4d,
+	0x41, 0xfb, 0xe9, 0x7f, 0x07, 0x00, 0xfc, 0x7e, 0x9b, 0xd6, 0xde, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ThingsServiceClient is the client API for ThingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ThingsServiceClient interface {
+	GetPubConfByKey(ctx context.Context, in *PubConfByKeyReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error)
+	GetConfigByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*ConfigByThingIDRes, error)
+	Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AuthorizeBatch(ctx context.Context, in *AuthorizeBatchReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*ThingID, error)
+	IdentifyBatch(ctx context.Context, in *TokensReq, opts ...grpc.CallOption) (*ThingIDsRes, error)
+	GetGroupsByIDs(ctx context.Context, in *GroupsReq, opts ...grpc.CallOption) (*GroupsRes, error)
+	GetGroupIDByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*GroupID, error)
+	GetPubConfs(ctx context.Context, in *PubConfsReq, opts ...grpc.CallOption) (*PubConfsRes, error)
+	GetPubConfByShare(ctx context.Context, in *PubConfByShareReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error)
+	GetPubConfByGateway(ctx context.Context, in *PubConfByGatewayReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error)
+	GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ThingsStatsRes, error)
+	GetOrgStats(ctx context.Context, in *OrgStatsReq, opts ...grpc.CallOption) (*ThingsStatsRes, error)
+}
+
+type thingsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewThingsServiceClient(cc *grpc.ClientConn) ThingsServiceClient {
+	return &thingsServiceClient{cc}
+}
+
+func (c *thingsServiceClient) GetPubConfByKey(ctx context.Context, in *PubConfByKeyReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error) {
+	out := new(PubConfByKeyRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetPubConfByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GroupsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) GetConfigByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*ConfigByThingIDRes, error) {
+	out := new(ConfigByThingIDRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetConfigByThingID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GroupsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GroupsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *thingsServiceClient) AuthorizeBatch(ctx context.Context, in *AuthorizeBatchReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/AuthorizeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintMfx(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *thingsServiceClient) Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*ThingID, error) {
+	out := new(ThingID)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/Identify", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GroupsRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) IdentifyBatch(ctx context.Context, in *TokensReq, opts ...grpc.CallOption) (*ThingIDsRes, error) {
+	out := new(ThingIDsRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/IdentifyBatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GroupsRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) GetGroupsByIDs(ctx context.Context, in *GroupsReq, opts ...grpc.CallOption) (*GroupsRes, error) {
+	out := new(GroupsRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetGroupsByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GroupsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *thingsServiceClient) GetGroupIDByThingID(ctx context.Context, in *ThingID, opts ...grpc.CallOption) (*GroupID, error) {
+	out := new(GroupID)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetGroupIDByThingID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMfx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *thingsServiceClient) GetPubConfs(ctx context.Context, in *PubConfsReq, opts ...grpc.CallOption) (*PubConfsRes, error) {
+	out := new(PubConfsRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetPubConfs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AssignRoleReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) GetPubConfByShare(ctx context.Context, in *PubConfByShareReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error) {
+	out := new(PubConfByKeyRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetPubConfByShare", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AssignRoleReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) GetPubConfByGateway(ctx context.Context, in *PubConfByGatewayReq, opts ...grpc.CallOption) (*PubConfByKeyRes, error) {
+	out := new(PubConfByKeyRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetPubConfByGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AssignRoleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *thingsServiceClient) GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ThingsStatsRes, error) {
+	out := new(ThingsStatsRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RetrieveRoleReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *thingsServiceClient) GetOrgStats(ctx context.Context, in *OrgStatsReq, opts ...grpc.CallOption) (*ThingsStatsRes, error) {
+	out := new(ThingsStatsRes)
+	err := c.cc.Invoke(ctx, "/protomfx.ThingsService/GetOrgStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RetrieveRoleReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ThingsServiceServer is the server API for ThingsService service.
+type ThingsServiceServer interface {
+	GetPubConfByKey(context.Context, *PubConfByKeyReq) (*PubConfByKeyRes, error)
+	GetConfigByThingID(context.Context, *ThingID) (*ConfigByThingIDRes, error)
+	Authorize(context.Context, *AuthorizeReq) (*emptypb.Empty, error)
+	AuthorizeBatch(context.Context, *AuthorizeBatchReq) (*emptypb.Empty, error)
+	Identify(context.Context, *Token) (*ThingID, error)
+	IdentifyBatch(context.Context, *TokensReq) (*ThingIDsRes, error)
+	GetGroupsByIDs(context.Context, *GroupsReq) (*GroupsRes, error)
+	GetGroupIDByThingID(context.Context, *ThingID) (*GroupID, error)
+	GetPubConfs(context.Context, *PubConfsReq) (*PubConfsRes, error)
+	GetPubConfByShare(context.Context, *PubConfByShareReq) (*PubConfByKeyRes, error)
+	GetPubConfByGateway(context.Context, *PubConfByGatewayReq) (*PubConfByKeyRes, error)
+	GetStats(context.Context, *emptypb.Empty) (*ThingsStatsRes, error)
+	GetOrgStats(context.Context, *OrgStatsReq) (*ThingsStatsRes, error)
 }
 
-func (m *RetrieveRoleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedThingsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedThingsServiceServer struct {
 }
 
-func (m *RetrieveRoleRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedThingsServiceServer) GetPubConfByKey(ctx context.Context, req *PubConfByKeyReq) (*PubConfByKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubConfByKey not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetConfigByThingID(ctx context.Context, req *ThingID) (*ConfigByThingIDRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigByThingID not implemented")
+}
+func (*UnimplementedThingsServiceServer) Authorize(ctx context.Context, req *AuthorizeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (*UnimplementedThingsServiceServer) AuthorizeBatch(ctx context.Context, req *AuthorizeBatchReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeBatch not implemented")
+}
+func (*UnimplementedThingsServiceServer) Identify(ctx context.Context, req *Token) (*ThingID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identify not implemented")
+}
+func (*UnimplementedThingsServiceServer) IdentifyBatch(ctx context.Context, req *TokensReq) (*ThingIDsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentifyBatch not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetGroupsByIDs(ctx context.Context, req *GroupsReq) (*GroupsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByIDs not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetGroupIDByThingID(ctx context.Context, req *ThingID) (*GroupID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupIDByThingID not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetPubConfs(ctx context.Context, req *PubConfsReq) (*PubConfsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubConfs not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetPubConfByShare(ctx context.Context, req *PubConfByShareReq) (*PubConfByKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubConfByShare not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetPubConfByGateway(ctx context.Context, req *PubConfByGatewayReq) (*PubConfByKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubConfByGateway not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetStats(ctx context.Context, req *emptypb.Empty) (*ThingsStatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (*UnimplementedThingsServiceServer) GetOrgStats(ctx context.Context, req *OrgStatsReq) (*ThingsStatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgStats not implemented")
 }
 
-func (m *RetrieveRoleRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterThingsServiceServer(s *grpc.Server, srv ThingsServiceServer) {
+	s.RegisterService(&_ThingsService_serviceDesc, srv)
 }
 
-func (m *RetrieveRoleRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ThingsService_GetPubConfByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubConfByKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintMfx(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetPubConfByKey(ctx, in)
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMfx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMfx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetPubConfByKey",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetPubConfByKey(ctx, req.(*PubConfByKeyReq))
 	}
-	var l int
-	_ = l
-	l = len(m.ProfileID)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThingsService_GetConfigByThingID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThingID)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Subtopic)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetConfigByThingID(ctx, in)
 	}
-	l = len(m.Publisher)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetConfigByThingID",
 	}
-	l = len(m.Protocol)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetConfigByThingID(ctx, req.(*ThingID))
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThingsService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Created != 0 {
-		n += 1 + sovMfx(uint64(m.Created))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).Authorize(ctx, in)
 	}
-	if m.ProfileConfig != nil {
-		l = m.ProfileConfig.Size()
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/Authorize",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).Authorize(ctx, req.(*AuthorizeReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PubConfByKeyReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _ThingsService_AuthorizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).AuthorizeBatch(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/AuthorizeBatch",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).AuthorizeBatch(ctx, req.(*AuthorizeBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PubConfByKeyRes) Size() (n int) {
-	if m == nil {
-		return 0
+func _ThingsService_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Token)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PublisherID)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).Identify(ctx, in)
 	}
-	if m.ProfileConfig != nil {
-		l = m.ProfileConfig.Size()
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/Identify",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).Identify(ctx, req.(*Token))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Config) Size() (n int) {
-	if m == nil {
-		return 0
+func _ThingsService_IdentifyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContentType)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).IdentifyBatch(ctx, in)
 	}
-	if m.Write {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/IdentifyBatch",
 	}
-	l = len(m.WebhookID)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.SmtpID)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.SmppID)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.Transformer != nil {
-		l = m.Transformer.Size()
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ConfigByThingIDRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).IdentifyBatch(ctx, req.(*TokensReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Transformer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovMfx(uint64(l))
-		}
-	}
-	l = len(m.DataField)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.TimeField)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.TimeFormat)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.TimeLocation)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _ThingsService_GetGroupsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *ThingID) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetGroupsByIDs(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetGroupsByIDs",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetGroupsByIDs(ctx, req.(*GroupsReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GroupID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _ThingsService_GetGroupIDByThingID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThingID)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetGroupIDByThingID(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetGroupIDByThingID",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetGroupIDByThingID(ctx, req.(*ThingID))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserIdentity) Size() (n int) {
-	if m == nil {
-		return 0
+func _ThingsService_GetPubConfs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubConfsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetPubConfs(ctx, in)
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetPubConfs",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetPubConfs(ctx, req.(*PubConfsReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IssueReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+func _ThingsService_GetPubConfByShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubConfByShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetPubConfByShare(ctx, in)
 	}
-	if m.Type != 0 {
-		n += 1 + sovMfx(uint64(m.Type))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetPubConfByShare",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetPubConfByShare(ctx, req.(*PubConfByShareReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.Object)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+func _ThingsService_GetPubConfByGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubConfByGatewayReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Subject)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetPubConfByGateway(ctx, in)
 	}
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetPubConfByGateway",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetPubConfByGateway(ctx, req.(*PubConfByGatewayReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AuthorizeRes) Size() (n int) {
-	if m == nil {
-		return 0
+func _ThingsService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Authorized {
-		n += 2
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetStats(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetStats",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+func _ThingsService_GetOrgStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(ThingsServiceServer).GetOrgStats(ctx, in)
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.ThingsService/GetOrgStats",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServiceServer).GetOrgStats(ctx, req.(*OrgStatsReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UsersByEmailsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Emails) > 0 {
-		for _, s := range m.Emails {
-			l = len(s)
-			n += 1 + l + sovMfx(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _ThingsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "protomfx.ThingsService",
+	HandlerType: (*ThingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPubConfByKey",
+			Handler:    _ThingsService_GetPubConfByKey_Handler,
+		},
+		{
+			MethodName: "GetConfigByThingID",
+			Handler:    _ThingsService_GetConfigByThingID_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _ThingsService_Authorize_Handler,
+		},
+		{
+			MethodName: "AuthorizeBatch",
+			Handler:    _ThingsService_AuthorizeBatch_Handler,
+		},
+		{
+			MethodName: "Identify",
+			Handler:    _ThingsService_Identify_Handler,
+		},
+		{
+			MethodName: "IdentifyBatch",
+			Handler:    _ThingsService_IdentifyBatch_Handler,
+		},
+		{
+			MethodName: "GetGroupsByIDs",
+			Handler:    _ThingsService_GetGroupsByIDs_Handler,
+		},
+		{
+			MethodName: "GetGroupIDByThingID",
+			Handler:    _ThingsService_GetGroupIDByThingID_Handler,
+		},
+		{
+			MethodName: "GetPubConfs",
+			Handler:    _ThingsService_GetPubConfs_Handler,
+		},
+		{
+			MethodName: "GetPubConfByShare",
+			Handler:    _ThingsService_GetPubConfByShare_Handler,
+		},
+		{
+			MethodName: "GetPubConfByGateway",
+			Handler:    _ThingsService_GetPubConfByGateway_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _ThingsService_GetStats_Handler,
+		},
+		{
+			MethodName: "GetOrgStats",
+			Handler:    _ThingsService_GetOrgStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/mfx.proto",
 }
 
-func (m *UsersByIDsReq) Size() (n int) {
-	if m == nil {
-		return 0
+// UsersServiceClient is the client API for UsersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UsersServiceClient interface {
+	GetUsersByIDs(ctx context.Context, in *UsersByIDsReq, opts ...grpc.CallOption) (*UsersRes, error)
+	GetUsersByEmails(ctx context.Context, in *UsersByEmailsReq, opts ...grpc.CallOption) (*UsersRes, error)
+	GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UsersStatsRes, error)
+}
+
+type usersServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUsersServiceClient(cc *grpc.ClientConn) UsersServiceClient {
+	return &usersServiceClient{cc}
+}
+
+func (c *usersServiceClient) GetUsersByIDs(ctx context.Context, in *UsersByIDsReq, opts ...grpc.CallOption) (*UsersRes, error) {
+	out := new(UsersRes)
+	err := c.cc.Invoke(ctx, "/protomfx.UsersService/GetUsersByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovMfx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *usersServiceClient) GetUsersByEmails(ctx context.Context, in *UsersByEmailsReq, opts ...grpc.CallOption) (*UsersRes, error) {
+	out := new(UsersRes)
+	err := c.cc.Invoke(ctx, "/protomfx.UsersService/GetUsersByEmails", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *usersServiceClient) GetStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UsersStatsRes, error) {
+	out := new(UsersStatsRes)
+	err := c.cc.Invoke(ctx, "/protomfx.UsersService/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UsersRes) Size() (n int) {
-	if m == nil {
-		return 0
+// UsersServiceServer is the server API for UsersService service.
+type UsersServiceServer interface {
+	GetUsersByIDs(context.Context, *UsersByIDsReq) (*UsersRes, error)
+	GetUsersByEmails(context.Context, *UsersByEmailsReq) (*UsersRes, error)
+	GetStats(context.Context, *emptypb.Empty) (*UsersStatsRes, error)
+}
+
+// UnimplementedUsersServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedUsersServiceServer struct {
+}
+
+func (*UnimplementedUsersServiceServer) GetUsersByIDs(ctx context.Context, req *UsersByIDsReq) (*UsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByIDs not implemented")
+}
+func (*UnimplementedUsersServiceServer) GetUsersByEmails(ctx context.Context, req *UsersByEmailsReq) (*UsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByEmails not implemented")
+}
+func (*UnimplementedUsersServiceServer) GetStats(ctx context.Context, req *emptypb.Empty) (*UsersStatsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+
+func RegisterUsersServiceServer(s *grpc.Server, srv UsersServiceServer) {
+	s.RegisterService(&_UsersService_serviceDesc, srv)
+}
+
+func _UsersService_GetUsersByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersByIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Users) > 0 {
-		for _, e := range m.Users {
-			l = e.Size()
-			n += 1 + l + sovMfx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUsersByIDs(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.UsersService/GetUsersByIDs",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUsersByIDs(ctx, req.(*UsersByIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Group) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.OrgID)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+func _UsersService_GetUsersByEmails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersByEmailsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUsersByEmails(ctx, in)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.UsersService/GetUsersByEmails",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUsersByEmails(ctx, req.(*UsersByEmailsReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GroupsReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _UsersService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovMfx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetStats(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.UsersService/GetStats",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GroupsRes) Size() (n int) {
-	if m == nil {
-		return 0
+var _UsersService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "protomfx.UsersService",
+	HandlerType: (*UsersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsersByIDs",
+			Handler:    _UsersService_GetUsersByIDs_Handler,
+		},
+		{
+			MethodName: "GetUsersByEmails",
+			Handler:    _UsersService_GetUsersByEmails_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _UsersService_GetStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/mfx.proto",
+}
+
+// AuthServiceClient is the client API for AuthService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuthServiceClient interface {
+	Issue(ctx context.Context, in *IssueReq, opts ...grpc.CallOption) (*Token, error)
+	Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*UserIdentity, error)
+	IdentifyBatch(ctx context.Context, in *TokensReq, opts ...grpc.CallOption) (*UserIdentitiesRes, error)
+	IdentifyDelegated(ctx context.Context, in *Token, opts ...grpc.CallOption) (*DelegatedIdentity, error)
+	Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AuthorizeBatch(ctx context.Context, in *AuthorizeBatchReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AssignRole(ctx context.Context, in *AssignRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RetrieveRole(ctx context.Context, in *RetrieveRoleReq, opts ...grpc.CallOption) (*RetrieveRoleRes, error)
+	AssignMembers(ctx context.Context, in *AssignMembersReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SignPayload(ctx context.Context, in *SignPayloadReq, opts ...grpc.CallOption) (*SignPayloadRes, error)
+	RetrieveSigningKey(ctx context.Context, in *SigningKeyReq, opts ...grpc.CallOption) (*SigningKeyRes, error)
+	CheckQuota(ctx context.Context, in *CheckQuotaReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveUser(ctx context.Context, in *RemoveUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FilterOrgMembers(ctx context.Context, in *OrgMembersReq, opts ...grpc.CallOption) (*OrgMembersRes, error)
+}
+
+type authServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuthServiceClient(cc *grpc.ClientConn) AuthServiceClient {
+	return &authServiceClient{cc}
+}
+
+func (c *authServiceClient) Issue(ctx context.Context, in *IssueReq, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/Issue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovMfx(uint64(l))
+	return out, nil
+}
+
+func (c *authServiceClient) Identify(ctx context.Context, in *Token, opts ...grpc.CallOption) (*UserIdentity, error) {
+	out := new(UserIdentity)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/Identify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) IdentifyBatch(ctx context.Context, in *TokensReq, opts ...grpc.CallOption) (*UserIdentitiesRes, error) {
+	out := new(UserIdentitiesRes)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/IdentifyBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) IdentifyDelegated(ctx context.Context, in *Token, opts ...grpc.CallOption) (*DelegatedIdentity, error) {
+	out := new(DelegatedIdentity)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/IdentifyDelegated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Authorize(ctx context.Context, in *AuthorizeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/Authorize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AuthorizeBatch(ctx context.Context, in *AuthorizeBatchReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/AuthorizeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AssignRole(ctx context.Context, in *AssignRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/AssignRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RetrieveRole(ctx context.Context, in *RetrieveRoleReq, opts ...grpc.CallOption) (*RetrieveRoleRes, error) {
+	out := new(RetrieveRoleRes)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/RetrieveRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AssignMembers(ctx context.Context, in *AssignMembersReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/AssignMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) SignPayload(ctx context.Context, in *SignPayloadReq, opts ...grpc.CallOption) (*SignPayloadRes, error) {
+	out := new(SignPayloadRes)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/SignPayload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RetrieveSigningKey(ctx context.Context, in *SigningKeyReq, opts ...grpc.CallOption) (*SigningKeyRes, error) {
+	out := new(SigningKeyRes)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/RetrieveSigningKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) CheckQuota(ctx context.Context, in *CheckQuotaReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/CheckQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RemoveUser(ctx context.Context, in *RemoveUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/RemoveUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) FilterOrgMembers(ctx context.Context, in *OrgMembersReq, opts ...grpc.CallOption) (*OrgMembersRes, error) {
+	out := new(OrgMembersRes)
+	err := c.cc.Invoke(ctx, "/protomfx.AuthService/FilterOrgMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServiceServer is the server API for AuthService service.
+type AuthServiceServer interface {
+	Issue(context.Context, *IssueReq) (*Token, error)
+	Identify(context.Context, *Token) (*UserIdentity, error)
+	IdentifyBatch(context.Context, *TokensReq) (*UserIdentitiesRes, error)
+	IdentifyDelegated(context.Context, *Token) (*DelegatedIdentity, error)
+	Authorize(context.Context, *AuthorizeReq) (*emptypb.Empty, error)
+	AuthorizeBatch(context.Context, *AuthorizeBatchReq) (*emptypb.Empty, error)
+	AssignRole(context.Context, *AssignRoleReq) (*emptypb.Empty, error)
+	RetrieveRole(context.Context, *RetrieveRoleReq) (*RetrieveRoleRes, error)
+	AssignMembers(context.Context, *AssignMembersReq) (*emptypb.Empty, error)
+	SignPayload(context.Context, *SignPayloadReq) (*SignPayloadRes, error)
+	RetrieveSigningKey(context.Context, *SigningKeyReq) (*SigningKeyRes, error)
+	CheckQuota(context.Context, *CheckQuotaReq) (*emptypb.Empty, error)
+	RemoveUser(context.Context, *RemoveUserReq) (*emptypb.Empty, error)
+	FilterOrgMembers(context.Context, *OrgMembersReq) (*OrgMembersRes, error)
+}
+
+// UnimplementedAuthServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthServiceServer struct {
+}
+
+func (*UnimplementedAuthServiceServer) Issue(ctx context.Context, req *IssueReq) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Issue not implemented")
+}
+func (*UnimplementedAuthServiceServer) Identify(ctx context.Context, req *Token) (*UserIdentity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identify not implemented")
+}
+func (*UnimplementedAuthServiceServer) IdentifyBatch(ctx context.Context, req *TokensReq) (*UserIdentitiesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentifyBatch not implemented")
+}
+func (*UnimplementedAuthServiceServer) IdentifyDelegated(ctx context.Context, req *Token) (*DelegatedIdentity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IdentifyDelegated not implemented")
+}
+func (*UnimplementedAuthServiceServer) Authorize(ctx context.Context, req *AuthorizeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorize not implemented")
+}
+func (*UnimplementedAuthServiceServer) AuthorizeBatch(ctx context.Context, req *AuthorizeBatchReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeBatch not implemented")
+}
+func (*UnimplementedAuthServiceServer) AssignRole(ctx context.Context, req *AssignRoleReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRole not implemented")
+}
+func (*UnimplementedAuthServiceServer) RetrieveRole(ctx context.Context, req *RetrieveRoleReq) (*RetrieveRoleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveRole not implemented")
+}
+func (*UnimplementedAuthServiceServer) AssignMembers(ctx context.Context, req *AssignMembersReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignMembers not implemented")
+}
+func (*UnimplementedAuthServiceServer) SignPayload(ctx context.Context, req *SignPayloadReq) (*SignPayloadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPayload not implemented")
+}
+func (*UnimplementedAuthServiceServer) RetrieveSigningKey(ctx context.Context, req *SigningKeyReq) (*SigningKeyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrieveSigningKey not implemented")
+}
+func (*UnimplementedAuthServiceServer) CheckQuota(ctx context.Context, req *CheckQuotaReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQuota not implemented")
+}
+func (*UnimplementedAuthServiceServer) RemoveUser(ctx context.Context, req *RemoveUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
+}
+func (*UnimplementedAuthServiceServer) FilterOrgMembers(ctx context.Context, req *OrgMembersReq) (*OrgMembersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterOrgMembers not implemented")
+}
+
+func RegisterAuthServiceServer(s *grpc.Server, srv AuthServiceServer) {
+	s.RegisterService(&_AuthService_serviceDesc, srv)
+}
+
+func _AuthService_Issue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Issue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/Issue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Issue(ctx, req.(*IssueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Identify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Token)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Identify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/Identify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Identify(ctx, req.(*Token))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_IdentifyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokensReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IdentifyBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/IdentifyBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IdentifyBatch(ctx, req.(*TokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_IdentifyDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Token)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).IdentifyDelegated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/IdentifyDelegated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).IdentifyDelegated(ctx, req.(*Token))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/Authorize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Authorize(ctx, req.(*AuthorizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AuthorizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AuthorizeBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/AuthorizeBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AuthorizeBatch(ctx, req.(*AuthorizeBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AssignRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AssignRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/AssignRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AssignRole(ctx, req.(*AssignRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RetrieveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RetrieveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/RetrieveRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RetrieveRole(ctx, req.(*RetrieveRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AssignMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AssignMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/AssignMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AssignMembers(ctx, req.(*AssignMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_SignPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPayloadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SignPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/SignPayload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SignPayload(ctx, req.(*SignPayloadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RetrieveSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RetrieveSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/RetrieveSigningKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RetrieveSigningKey(ctx, req.(*SigningKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/CheckQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CheckQuota(ctx, req.(*CheckQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RemoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/RemoveUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RemoveUser(ctx, req.(*RemoveUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_FilterOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).FilterOrgMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protomfx.AuthService/FilterOrgMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).FilterOrgMembers(ctx, req.(*OrgMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuthService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "protomfx.AuthService",
+	HandlerType: (*AuthServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Issue",
+			Handler:    _AuthService_Issue_Handler,
+		},
+		{
+			MethodName: "Identify",
+			Handler:    _AuthService_Identify_Handler,
+		},
+		{
+			MethodName: "IdentifyBatch",
+			Handler:    _AuthService_IdentifyBatch_Handler,
+		},
+		{
+			MethodName: "IdentifyDelegated",
+			Handler:    _AuthService_IdentifyDelegated_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _AuthService_Authorize_Handler,
+		},
+		{
+			MethodName: "AuthorizeBatch",
+			Handler:    _AuthService_AuthorizeBatch_Handler,
+		},
+		{
+			MethodName: "AssignRole",
+			Handler:    _AuthService_AssignRole_Handler,
+		},
+		{
+			MethodName: "RetrieveRole",
+			Handler:    _AuthService_RetrieveRole_Handler,
+		},
+		{
+			MethodName: "AssignMembers",
+			Handler:    _AuthService_AssignMembers_Handler,
+		},
+		{
+			MethodName: "SignPayload",
+			Handler:    _AuthService_SignPayload_Handler,
+		},
+		{
+			MethodName: "RetrieveSigningKey",
+			Handler:    _AuthService_RetrieveSigningKey_Handler,
+		},
+		{
+			MethodName: "CheckQuota",
+			Handler:    _AuthService_CheckQuota_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _AuthService_RemoveUser_Handler,
+		},
+		{
+			MethodName: "FilterOrgMembers",
+			Handler:    _AuthService_FilterOrgMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/mfx.proto",
+}
+
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Transformer) > 0 {
+		i -= len(m.Transformer)
+		copy(dAtA[i:], m.Transformer)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Transformer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.GroupID) > 0 {
+		i -= len(m.GroupID)
+		copy(dAtA[i:], m.GroupID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.GroupID)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Expires != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ProfileConfig != nil {
+		{
+			size, err := m.ProfileConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMfx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Created != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Created))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Publisher) > 0 {
+		i -= len(m.Publisher)
+		copy(dAtA[i:], m.Publisher)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Publisher)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subtopic) > 0 {
+		i -= len(m.Subtopic)
+		copy(dAtA[i:], m.Subtopic)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Subtopic)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProfileID) > 0 {
+		i -= len(m.ProfileID)
+		copy(dAtA[i:], m.ProfileID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ProfileID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubConfByKeyReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubConfByKeyReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubConfByKeyReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubConfByKeyRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubConfByKeyRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubConfByKeyRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ShareID) > 0 {
+		i -= len(m.ShareID)
+		copy(dAtA[i:], m.ShareID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ShareID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.GroupID) > 0 {
+		i -= len(m.GroupID)
+		copy(dAtA[i:], m.GroupID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.GroupID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProfileID) > 0 {
+		i -= len(m.ProfileID)
+		copy(dAtA[i:], m.ProfileID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ProfileID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NetworkACLs) > 0 {
+		for iNdEx := len(m.NetworkACLs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkACLs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProfileConfig != nil {
+		{
+			size, err := m.ProfileConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMfx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublisherID) > 0 {
+		i -= len(m.PublisherID)
+		copy(dAtA[i:], m.PublisherID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.PublisherID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubConfByShareReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubConfByShareReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubConfByShareReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubConfByGatewayReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubConfByGatewayReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubConfByGatewayReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ThingID) > 0 {
+		i -= len(m.ThingID)
+		copy(dAtA[i:], m.ThingID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ThingID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubConfsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubConfsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubConfsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Offset != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThingPubConf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThingPubConf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThingPubConf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.GroupID) > 0 {
+		i -= len(m.GroupID)
+		copy(dAtA[i:], m.GroupID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.GroupID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ProfileID) > 0 {
+		i -= len(m.ProfileID)
+		copy(dAtA[i:], m.ProfileID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ProfileID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NetworkACLs) > 0 {
+		for iNdEx := len(m.NetworkACLs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkACLs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProfileConfig != nil {
+		{
+			size, err := m.ProfileConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMfx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublisherID) > 0 {
+		i -= len(m.PublisherID)
+		copy(dAtA[i:], m.PublisherID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.PublisherID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkACL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkACL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkACL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Deny) > 0 {
+		for iNdEx := len(m.Deny) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Deny[iNdEx])
+			copy(dAtA[i:], m.Deny[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Deny[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allow[iNdEx])
+			copy(dAtA[i:], m.Allow[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Allow[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubConfsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubConfsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubConfsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Total != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubConfs) > 0 {
+		for iNdEx := len(m.PubConfs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubConfs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Config) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Config) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Version != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Mqtt != nil {
+		{
+			size, err := m.Mqtt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMfx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ContentEncoding) > 0 {
+		i -= len(m.ContentEncoding)
+		copy(dAtA[i:], m.ContentEncoding)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ContentEncoding)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Writers) > 0 {
+		for iNdEx := len(m.Writers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Writers[iNdEx])
+			copy(dAtA[i:], m.Writers[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Writers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Transformer != nil {
+		{
+			size, err := m.Transformer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMfx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SmppID) > 0 {
+		i -= len(m.SmppID)
+		copy(dAtA[i:], m.SmppID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.SmppID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SmtpID) > 0 {
+		i -= len(m.SmtpID)
+		copy(dAtA[i:], m.SmtpID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.SmtpID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WebhookID) > 0 {
+		i -= len(m.WebhookID)
+		copy(dAtA[i:], m.WebhookID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.WebhookID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Write {
+		i--
+		if m.Write {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MQTTConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MQTTConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MQTTConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxPacketSize != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.MaxPacketSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReceiveMaximum != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.ReceiveMaximum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxInflight != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.MaxInflight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeepAlive != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.KeepAlive))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigByThingIDRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigByThingIDRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigByThingIDRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMfx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transformer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transformer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transformer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Units) > 0 {
+		for k := range m.Units {
+			v := m.Units[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMfx(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMfx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMfx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TimeLocation) > 0 {
+		i -= len(m.TimeLocation)
+		copy(dAtA[i:], m.TimeLocation)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.TimeLocation)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TimeFormat) > 0 {
+		i -= len(m.TimeFormat)
+		copy(dAtA[i:], m.TimeFormat)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.TimeFormat)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TimeField) > 0 {
+		i -= len(m.TimeField)
+		copy(dAtA[i:], m.TimeField)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.TimeField)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataField) > 0 {
+		i -= len(m.DataField)
+		copy(dAtA[i:], m.DataField)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.DataField)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DataFilters) > 0 {
+		for iNdEx := len(m.DataFilters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataFilters[iNdEx])
+			copy(dAtA[i:], m.DataFilters[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.DataFilters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThingID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThingID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThingID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThingIDsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThingIDsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThingIDsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokensReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokensReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokensReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatedIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatedIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ThingID) > 0 {
+		i -= len(m.ThingID)
+		copy(dAtA[i:], m.ThingID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.ThingID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserIdentitiesRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserIdentitiesRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserIdentitiesRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Identities) > 0 {
+		for iNdEx := len(m.Identities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Identities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Type != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizeReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizeReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizeReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Object) > 0 {
+		i -= len(m.Object)
+		copy(dAtA[i:], m.Object)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Object)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizeBatchReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizeBatchReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizeBatchReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizeRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizeRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizeRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *User) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsersByEmailsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsersByEmailsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsersByEmailsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Emails) > 0 {
+		for iNdEx := len(m.Emails) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Emails[iNdEx])
+			copy(dAtA[i:], m.Emails[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Emails[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsersByIDsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsersByIDsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsersByIDsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsersRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsersRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsersStatsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsersStatsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsersStatsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Users != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Users))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Group) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThingsStatsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThingsStatsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThingsStatsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Groups != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Groups))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Profiles != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Profiles))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Things != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Things))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrgStatsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgStatsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgStatsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignRoleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignRoleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignRoleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrieveRoleReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrieveRoleReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrieveRoleReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrieveRoleRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrieveRoleRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrieveRoleRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignPayloadReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignPayloadReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignPayloadReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignPayloadRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignPayloadRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignPayloadRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningKeyReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningKeyReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningKeyReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningKeyRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningKeyRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningKeyRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckQuotaReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckQuotaReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckQuotaReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Usage != 0 {
+		i = encodeVarintMfx(dAtA, i, uint64(m.Usage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveUserReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveUserReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveUserReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrgMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignMembersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignMembersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignMembersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMfx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrgMembersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgMembersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgMembersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MemberIDs) > 0 {
+		for iNdEx := len(m.MemberIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MemberIDs[iNdEx])
+			copy(dAtA[i:], m.MemberIDs[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.MemberIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OrgID) > 0 {
+		i -= len(m.OrgID)
+		copy(dAtA[i:], m.OrgID)
+		i = encodeVarintMfx(dAtA, i, uint64(len(m.OrgID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrgMembersRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgMembersRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgMembersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MemberIDs) > 0 {
+		for iNdEx := len(m.MemberIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MemberIDs[iNdEx])
+			copy(dAtA[i:], m.MemberIDs[iNdEx])
+			i = encodeVarintMfx(dAtA, i, uint64(len(m.MemberIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMfx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMfx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProfileID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Subtopic)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovMfx(uint64(m.Created))
+	}
+	if m.ProfileConfig != nil {
+		l = m.ProfileConfig.Size()
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovMfx(uint64(m.Expires))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Transformer)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubConfByKeyReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubConfByKeyRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublisherID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.ProfileConfig != nil {
+		l = m.ProfileConfig.Size()
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if len(m.NetworkACLs) > 0 {
+		for _, e := range m.NetworkACLs {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	l = len(m.ProfileID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.ShareID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMfx(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubConfByShareReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubConfByGatewayReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.ThingID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubConfsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovMfx(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMfx(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ThingPubConf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.PublisherID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.ProfileConfig != nil {
+		l = m.ProfileConfig.Size()
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if len(m.NetworkACLs) > 0 {
+		for _, e := range m.NetworkACLs {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	l = len(m.ProfileID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.GroupID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NetworkACL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allow) > 0 {
+		for _, s := range m.Allow {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if len(m.Deny) > 0 {
+		for _, s := range m.Deny {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PubConfsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubConfs) > 0 {
+		for _, e := range m.PubConfs {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovMfx(uint64(m.Total))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Config) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Write {
+		n += 2
+	}
+	l = len(m.WebhookID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.SmtpID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.SmppID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Transformer != nil {
+		l = m.Transformer.Size()
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if len(m.Writers) > 0 {
+		for _, s := range m.Writers {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	l = len(m.ContentEncoding)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Mqtt != nil {
+		l = m.Mqtt.Size()
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMfx(uint64(m.Version))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MQTTConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeepAlive != 0 {
+		n += 1 + sovMfx(uint64(m.KeepAlive))
+	}
+	if m.MaxInflight != 0 {
+		n += 1 + sovMfx(uint64(m.MaxInflight))
+	}
+	if m.ReceiveMaximum != 0 {
+		n += 1 + sovMfx(uint64(m.ReceiveMaximum))
+	}
+	if m.MaxPacketSize != 0 {
+		n += 1 + sovMfx(uint64(m.MaxPacketSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfigByThingIDRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Transformer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	l = len(m.DataField)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.TimeField)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.TimeFormat)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.TimeLocation)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if len(m.Units) > 0 {
+		for k, v := range m.Units {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMfx(uint64(len(k))) + 1 + len(v) + sovMfx(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMfx(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ThingID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ThingIDsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GroupID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokensReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DelegatedIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.ThingID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMfx(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserIdentitiesRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Identities) > 0 {
+		for _, e := range m.Identities {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IssueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovMfx(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizeReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizeBatchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthorizeRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsersByEmailsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Emails) > 0 {
+		for _, s := range m.Emails {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsersByIDsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsersRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UsersStatsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Users != 0 {
+		n += 1 + sovMfx(uint64(m.Users))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Group) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GroupsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GroupsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ThingsStatsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Things != 0 {
+		n += 1 + sovMfx(uint64(m.Things))
+	}
+	if m.Profiles != 0 {
+		n += 1 + sovMfx(uint64(m.Profiles))
+	}
+	if m.Groups != 0 {
+		n += 1 + sovMfx(uint64(m.Groups))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgStatsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignRoleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetrieveRoleReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetrieveRoleRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignPayloadReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignPayloadRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SigningKeyReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SigningKeyRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckQuotaReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.Usage != 0 {
+		n += 1 + sovMfx(uint64(m.Usage))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMfx(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveUserReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignMembersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgMembersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrgID)
+	if l > 0 {
+		n += 1 + l + sovMfx(uint64(l))
+	}
+	if len(m.MemberIDs) > 0 {
+		for _, s := range m.MemberIDs {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgMembersRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MemberIDs) > 0 {
+		for _, s := range m.MemberIDs {
+			l = len(s)
+			n += 1 + l + sovMfx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMfx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMfx(x uint64) (n int) {
+	return sovMfx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subtopic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subtopic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publisher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfileConfig == nil {
+				m.ProfileConfig = &Config{}
+			}
+			if err := m.ProfileConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrgID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transformer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubConfByKeyReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubConfByKeyReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubConfByKeyReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubConfByKeyRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubConfByKeyRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubConfByKeyRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfileConfig == nil {
+				m.ProfileConfig = &Config{}
+			}
+			if err := m.ProfileConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrgID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkACLs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkACLs = append(m.NetworkACLs, &NetworkACL{})
+			if err := m.NetworkACLs[len(m.NetworkACLs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubConfByShareReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubConfByShareReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubConfByShareReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubConfByGatewayReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubConfByGatewayReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubConfByGatewayReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThingID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThingID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubConfsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubConfsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubConfsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThingPubConf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThingPubConf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThingPubConf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfileConfig == nil {
+				m.ProfileConfig = &Config{}
+			}
+			if err := m.ProfileConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrgID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkACLs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkACLs = append(m.NetworkACLs, &NetworkACL{})
+			if err := m.NetworkACLs[len(m.NetworkACLs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkACL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkACL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkACL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allow = append(m.Allow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deny", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deny = append(m.Deny, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubConfsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubConfsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubConfsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubConfs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubConfs = append(m.PubConfs, &ThingPubConf{})
+			if err := m.PubConfs[len(m.PubConfs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Write = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmtpID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmtpID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transformer == nil {
+				m.Transformer = &Transformer{}
+			}
+			if err := m.Transformer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writers = append(m.Writers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mqtt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mqtt == nil {
+				m.Mqtt = &MQTTConfig{}
+			}
+			if err := m.Mqtt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MQTTConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MQTTConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MQTTConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepAlive", wireType)
+			}
+			m.KeepAlive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepAlive |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflight", wireType)
+			}
+			m.MaxInflight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInflight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveMaximum", wireType)
+			}
+			m.ReceiveMaximum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiveMaximum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketSize", wireType)
+			}
+			m.MaxPacketSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigByThingIDRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigByThingIDRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigByThingIDRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transformer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transformer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transformer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeLocation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Units == nil {
+				m.Units = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMfx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMfx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMfx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMfx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMfx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMfx
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMfx
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMfx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMfx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Units[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThingID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThingID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThingID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThingIDsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThingIDsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThingIDsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokensReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokensReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokensReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatedIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThingID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThingID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *AssignRoleReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *RetrieveRoleReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *UserIdentitiesRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserIdentitiesRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserIdentitiesRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identities = append(m.Identities, &UserIdentity{})
+			if err := m.Identities[len(m.Identities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RetrieveRoleRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovMfx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMfx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMfx(x uint64) (n int) {
-	return sovMfx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *IssueReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3566,15 +10942,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3602,11 +10978,113 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfileID = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subtopic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizeReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3634,11 +11112,11 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subtopic = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3666,11 +11144,11 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Publisher = string(dAtA[iNdEx:postIndex])
+			m.Object = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3698,66 +11176,13 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			m.Created = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Created |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -3767,27 +11192,23 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProfileConfig == nil {
-				m.ProfileConfig = &Config{}
-			}
-			if err := m.ProfileConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3811,7 +11232,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubConfByKeyReq) Unmarshal(dAtA []byte) error {
+func (m *AuthorizeBatchReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3834,17 +11255,17 @@ func (m *PubConfByKeyReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubConfByKeyReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizeBatchReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubConfByKeyReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizeBatchReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -3854,23 +11275,25 @@ func (m *PubConfByKeyReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Requests = append(m.Requests, &AuthorizeReq{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3894,7 +11317,7 @@ func (m *PubConfByKeyReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubConfByKeyRes) Unmarshal(dAtA []byte) error {
+func (m *AuthorizeRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3917,49 +11340,17 @@ func (m *PubConfByKeyRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubConfByKeyRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorizeRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubConfByKeyRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorizeRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublisherID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileConfig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -3969,28 +11360,12 @@ func (m *PubConfByKeyRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProfileConfig == nil {
-				m.ProfileConfig = &Config{}
-			}
-			if err := m.ProfileConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Authorized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMfx(dAtA[iNdEx:])
@@ -4013,7 +11388,7 @@ func (m *PubConfByKeyRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Config) Unmarshal(dAtA []byte) error {
+func (m *User) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4036,15 +11411,15 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4072,31 +11447,11 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentType = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Write = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4124,11 +11479,11 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookID = string(dAtA[iNdEx:postIndex])
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmtpID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4156,11 +11511,62 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SmtpID = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsersByEmailsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsersByEmailsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsersByEmailsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SmppID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emails", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4188,13 +11594,64 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SmppID = string(dAtA[iNdEx:postIndex])
+			m.Emails = append(m.Emails, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsersByIDsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsersByIDsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsersByIDsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transformer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -4204,27 +11661,23 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transformer == nil {
-				m.Transformer = &Transformer{}
-			}
-			if err := m.Transformer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4248,7 +11701,7 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigByThingIDRes) Unmarshal(dAtA []byte) error {
+func (m *UsersRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4271,15 +11724,15 @@ func (m *ConfigByThingIDRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigByThingIDRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsersRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigByThingIDRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsersRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4306,10 +11759,8 @@ func (m *ConfigByThingIDRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &Config{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4335,7 +11786,7 @@ func (m *ConfigByThingIDRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transformer) Unmarshal(dAtA []byte) error {
+func (m *UsersStatsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4358,17 +11809,17 @@ func (m *Transformer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transformer: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsersStatsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transformer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsersStatsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
 			}
-			var stringLen uint64
+			m.Users = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -4378,59 +11829,65 @@ func (m *Transformer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Users |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMfx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataField", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Group) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataField = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeField", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4458,11 +11915,11 @@ func (m *Transformer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeField = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4490,11 +11947,11 @@ func (m *Transformer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeFormat = string(dAtA[iNdEx:postIndex])
+			m.OrgID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeLocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4522,62 +11979,11 @@ func (m *Transformer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeLocation = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMfx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ThingID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMfx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ThingID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ThingID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4605,7 +12011,7 @@ func (m *ThingID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4629,7 +12035,7 @@ func (m *ThingID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupID) Unmarshal(dAtA []byte) error {
+func (m *GroupsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4652,15 +12058,15 @@ func (m *GroupID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupID: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4688,7 +12094,7 @@ func (m *GroupID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4712,7 +12118,7 @@ func (m *GroupID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Token) Unmarshal(dAtA []byte) error {
+func (m *GroupsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4735,17 +12141,17 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -4755,23 +12161,25 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, &Group{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4795,7 +12203,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserIdentity) Unmarshal(dAtA []byte) error {
+func (m *ThingsStatsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4818,17 +12226,17 @@ func (m *UserIdentity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserIdentity: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThingsStatsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThingsStatsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Things", wireType)
 			}
-			var stringLen uint64
+			m.Things = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -4838,29 +12246,16 @@ func (m *UserIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Things |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
 			}
-			var stringLen uint64
+			m.Profiles = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -4870,24 +12265,30 @@ func (m *UserIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Profiles |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Groups = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Groups |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMfx(dAtA[iNdEx:])
@@ -4910,7 +12311,7 @@ func (m *UserIdentity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IssueReq) Unmarshal(dAtA []byte) error {
+func (m *OrgStatsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4933,47 +12334,15 @@ func (m *IssueReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IssueReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgStatsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IssueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgStatsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5001,27 +12370,8 @@ func (m *IssueReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.OrgID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMfx(dAtA[iNdEx:])
@@ -5044,7 +12394,7 @@ func (m *IssueReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
+func (m *AssignRoleReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,15 +12417,15 @@ func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignRoleReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignRoleReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5103,11 +12453,11 @@ func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5135,43 +12485,62 @@ func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Object = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMfx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMfx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetrieveRoleReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMfx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetrieveRoleReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetrieveRoleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5199,7 +12568,7 @@ func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5223,7 +12592,7 @@ func (m *AuthorizeReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthorizeRes) Unmarshal(dAtA []byte) error {
+func (m *RetrieveRoleRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5246,17 +12615,17 @@ func (m *AuthorizeRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorizeRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrieveRoleRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorizeRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrieveRoleRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5266,12 +12635,24 @@ func (m *AuthorizeRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Authorized = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMfx(dAtA[iNdEx:])
@@ -5294,7 +12675,7 @@ func (m *AuthorizeRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *SignPayloadReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5317,15 +12698,15 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignPayloadReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignPayloadReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5353,13 +12734,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OrgID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5369,27 +12750,29 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5417,7 +12800,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Secret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5441,7 +12824,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UsersByEmailsReq) Unmarshal(dAtA []byte) error {
+func (m *SignPayloadRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5464,17 +12847,17 @@ func (m *UsersByEmailsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UsersByEmailsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignPayloadRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UsersByEmailsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignPayloadRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5484,23 +12867,25 @@ func (m *UsersByEmailsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Emails = append(m.Emails, string(dAtA[iNdEx:postIndex]))
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5524,7 +12909,7 @@ func (m *UsersByEmailsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UsersByIDsReq) Unmarshal(dAtA []byte) error {
+func (m *SigningKeyReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5547,15 +12932,15 @@ func (m *UsersByIDsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UsersByIDsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningKeyReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UsersByIDsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningKeyReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5583,7 +12968,7 @@ func (m *UsersByIDsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.OrgID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5607,7 +12992,7 @@ func (m *UsersByIDsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UsersRes) Unmarshal(dAtA []byte) error {
+func (m *SigningKeyRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5630,17 +13015,17 @@ func (m *UsersRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UsersRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningKeyRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UsersRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningKeyRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5650,24 +13035,24 @@ func (m *UsersRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5692,7 +13077,7 @@ func (m *UsersRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Group) Unmarshal(dAtA []byte) error {
+func (m *CheckQuotaReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5715,15 +13100,15 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckQuotaReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckQuotaReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5751,11 +13136,11 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OrgID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5783,13 +13168,13 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrgID = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
-			var stringLen uint64
+			m.Usage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5799,29 +13184,16 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Usage |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5831,24 +13203,11 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMfx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMfx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMfx(dAtA[iNdEx:])
@@ -5871,7 +13230,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupsReq) Unmarshal(dAtA []byte) error {
+func (m *RemoveUserReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5894,15 +13253,15 @@ func (m *GroupsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveUserReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveUserReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5930,7 +13289,7 @@ func (m *GroupsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5954,7 +13313,7 @@ func (m *GroupsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupsRes) Unmarshal(dAtA []byte) error {
+func (m *OrgMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5977,17 +13336,17 @@ func (m *GroupsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMfx
@@ -5997,25 +13356,55 @@ func (m *GroupsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMfx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMfx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &Group{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6039,7 +13428,7 @@ func (m *GroupsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignRoleReq) Unmarshal(dAtA []byte) error {
+func (m *AssignMembersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6062,15 +13451,15 @@ func (m *AssignRoleReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignRoleReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignMembersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignRoleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignMembersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6098,11 +13487,11 @@ func (m *AssignRoleReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6130,7 +13519,41 @@ func (m *AssignRoleReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.OrgID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &OrgMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6154,7 +13577,7 @@ func (m *AssignRoleReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetrieveRoleReq) Unmarshal(dAtA []byte) error {
+func (m *OrgMembersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6177,15 +13600,15 @@ func (m *RetrieveRoleReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetrieveRoleReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgMembersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrieveRoleReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgMembersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6213,7 +13636,39 @@ func (m *RetrieveRoleReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.OrgID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMfx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMfx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMfx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberIDs = append(m.MemberIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6237,7 +13692,7 @@ func (m *RetrieveRoleReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetrieveRoleRes) Unmarshal(dAtA []byte) error {
+func (m *OrgMembersRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6260,15 +13715,15 @@ func (m *RetrieveRoleRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetrieveRoleRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgMembersRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrieveRoleRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgMembersRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIDs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6296,7 +13751,7 @@ func (m *RetrieveRoleRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.MemberIDs = append(m.MemberIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
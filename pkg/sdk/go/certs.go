@@ -6,93 +6,167 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 )
 
-const certsEndpoint = "certs"
+const (
+	certsEndpoint   = "certs"
+	serialsEndpoint = "serials"
+	renewEndpoint   = "renew"
+)
 
 // Cert represents certs data.
 type Cert struct {
-	CACert     string `json:"issuing_ca,omitempty"`
-	ClientKey  string `json:"client_key,omitempty"`
-	ClientCert string `json:"client_cert,omitempty"`
+	ThingID    string    `json:"thing_id,omitempty"`
+	Serial     string    `json:"cert_serial,omitempty"`
+	CACert     string    `json:"issuing_ca,omitempty"`
+	ClientKey  string    `json:"client_key,omitempty"`
+	ClientCert string    `json:"client_cert,omitempty"`
+	Expiration time.Time `json:"expiration,omitempty"`
 }
 
-func (sdk mfSDK) IssueCert(thingID string, keyBits int, keyType, valid, token string) (Cert, error) {
-	var c Cert
+// PEM returns the PEM bundle of the client certificate, followed by the
+// client key and the issuing CA certificate if they're present. The client
+// key is returned only when the certificate is issued or renewed.
+func (c Cert) PEM() []byte {
+	var b bytes.Buffer
+	for _, p := range []string{c.ClientCert, c.ClientKey, c.CACert} {
+		if p == "" {
+			continue
+		}
+		b.WriteString(p)
+		if !strings.HasSuffix(p, "\n") {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.Bytes()
+}
+
+func (sdk mfSDK) IssueCert(thingID string, keyBits int, keyType, ttl, token string) (Cert, error) {
 	r := certReq{
 		ThingID: thingID,
 		KeyBits: keyBits,
 		KeyType: keyType,
-		Valid:   valid,
+		TTL:     ttl,
 	}
-	d, err := json.Marshal(r)
+	data, err := json.Marshal(r)
 	if err != nil {
 		return Cert{}, err
 	}
 
 	url := fmt.Sprintf("%s/%s", sdk.certsURL, certsEndpoint)
-	res, err := request(http.MethodPost, token, url, d)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
 		return Cert{}, err
 	}
-	defer res.Body.Close()
-	if res.StatusCode != http.StatusOK {
-		return Cert{}, ErrCerts
-	}
-	body, err := ioutil.ReadAll(res.Body)
+
+	return sdk.sendCertRequest(req, token, http.StatusCreated)
+}
+
+func (sdk mfSDK) ViewCert(serial, token string) (Cert, error) {
+	url := fmt.Sprintf("%s/%s/%s", sdk.certsURL, certsEndpoint, serial)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return Cert{}, err
 	}
-	if err := json.Unmarshal(body, &c); err != nil {
+
+	return sdk.sendCertRequest(req, token, http.StatusOK)
+}
+
+func (sdk mfSDK) ListSerials(thingID string, offset, limit uint64, token string) (CertsPage, error) {
+	url := fmt.Sprintf("%s/%s/%s?offset=%d&limit=%d", sdk.certsURL, serialsEndpoint, thingID, offset, limit)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return CertsPage{}, err
+	}
+
+	resp, err := sdk.sendRequest(req, token, string(CTJSON))
+	if err != nil {
+		return CertsPage{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return CertsPage{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return CertsPage{}, errors.Wrap(ErrCerts, errors.New(resp.Status))
+	}
+
+	var cp CertsPage
+	if err := json.Unmarshal(body, &cp); err != nil {
+		return CertsPage{}, err
+	}
+
+	return cp, nil
+}
+
+func (sdk mfSDK) RenewCert(serial, token string) (Cert, error) {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.certsURL, certsEndpoint, serial, renewEndpoint)
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
 		return Cert{}, err
 	}
-	return c, nil
+
+	return sdk.sendCertRequest(req, token, http.StatusCreated)
 }
 
-func (sdk mfSDK) RemoveCert(id, token string) error {
-	res, err := request(http.MethodDelete, token, fmt.Sprintf("%s/%s", sdk.certsURL, id), nil)
-	if res != nil {
-		res.Body.Close()
+func (sdk mfSDK) RevokeCert(thingID, token string) error {
+	url := fmt.Sprintf("%s/%s/%s", sdk.certsURL, certsEndpoint, thingID)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return err
 	}
+
+	resp, err := sdk.sendRequest(req, token, string(CTJSON))
 	if err != nil {
 		return err
 	}
-	switch res.StatusCode {
-	case http.StatusNoContent:
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
 		return nil
 	case http.StatusForbidden:
 		return errors.ErrAuthorization
 	default:
-		return ErrCertsRemove
+		return errors.Wrap(ErrCertsRemove, errors.New(resp.Status))
 	}
 }
 
-func (sdk mfSDK) RevokeCert(thingID, certID string, token string) error {
-	panic("not implemented")
-}
-
-func request(method, jwt, url string, data []byte) (*http.Response, error) {
-	req, err := http.NewRequest(method, url, bytes.NewReader(data))
+func (sdk mfSDK) sendCertRequest(req *http.Request, token string, status int) (Cert, error) {
+	resp, err := sdk.sendRequest(req, token, string(CTJSON))
 	if err != nil {
-		return nil, err
+		return Cert{}, err
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", jwt)
-	c := &http.Client{}
-	res, err := c.Do(req)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return Cert{}, err
+	}
+
+	if resp.StatusCode != status {
+		return Cert{}, errors.Wrap(ErrCerts, errors.New(resp.Status))
 	}
 
-	return res, nil
+	var c Cert
+	if err := json.Unmarshal(body, &c); err != nil {
+		return Cert{}, err
+	}
+
+	return c, nil
 }
 
 type certReq struct {
-	ThingID    string `json:"thing_id"`
-	KeyBits    int    `json:"key_bits"`
-	KeyType    string `json:"key_type"`
-	Encryption string `json:"encryption"`
-	Valid      string `json:"valid"`
+	ThingID string `json:"thing_id"`
+	KeyBits int    `json:"key_bits"`
+	KeyType string `json:"key_type"`
+	TTL     string `json:"ttl"`
 }
@@ -12,6 +12,7 @@ import (
 	adapter "github.com/MainfluxLabs/mainflux/http"
 	"github.com/MainfluxLabs/mainflux/http/api"
 	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	sdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
@@ -21,7 +22,7 @@ import (
 
 func newMessageService(tc protomfx.ThingsServiceClient) adapter.Service {
 	pub := mocks.NewPublisher()
-	return adapter.New(pub, tc)
+	return adapter.New(pub, tc, auth.NewNetworkAuthorizer(nil), "")
 }
 
 func newMessageServer(svc adapter.Service) *httptest.Server {
@@ -49,45 +50,45 @@ func TestSendMessage(t *testing.T) {
 
 	cases := map[string]struct {
 		profileID string
-		msg    string
-		auth   string
-		err    error
+		msg       string
+		auth      string
+		err       error
 	}{
 		"publish message": {
 			profileID: profileID,
-			msg:    msg,
-			auth:   atoken,
-			err:    nil,
+			msg:       msg,
+			auth:      atoken,
+			err:       nil,
 		},
 		"publish message without authorization token": {
 			profileID: profileID,
-			msg:    msg,
-			auth:   "",
-			err:    createError(sdk.ErrFailedPublish, http.StatusUnauthorized),
+			msg:       msg,
+			auth:      "",
+			err:       createError(sdk.ErrFailedPublish, http.StatusUnauthorized),
 		},
 		"publish message with invalid authorization token": {
 			profileID: profileID,
-			msg:    msg,
-			auth:   invalidToken,
-			err:    createError(sdk.ErrFailedPublish, http.StatusUnauthorized),
+			msg:       msg,
+			auth:      invalidToken,
+			err:       createError(sdk.ErrFailedPublish, http.StatusUnauthorized),
 		},
 		"publish message with wrong content type": {
 			profileID: profileID,
-			msg:    "text",
-			auth:   atoken,
-			err:    nil,
+			msg:       "text",
+			auth:      atoken,
+			err:       nil,
 		},
 		"publish message without profile": {
 			profileID: "",
-			msg:    msg,
-			auth:   atoken,
-			err:    nil,
+			msg:       msg,
+			auth:      atoken,
+			err:       nil,
 		},
 		"publish message unable to authorize": {
 			profileID: profileID,
-			msg:    msg,
-			auth:   invalidToken,
-			err:    createError(sdk.ErrFailedPublish, http.StatusUnauthorized),
+			msg:       msg,
+			auth:      invalidToken,
+			err:       createError(sdk.ErrFailedPublish, http.StatusUnauthorized),
 		},
 	}
 	for desc, tc := range cases {
@@ -54,6 +54,12 @@ type MessagesPage struct {
 	pageRes
 }
 
+// CertsPage contains list of certificate serials in a page with proper metadata.
+type CertsPage struct {
+	Certs []Cert `json:"certs"`
+	pageRes
+}
+
 // GroupsPage contains list of groups in a page with proper metadata.
 type GroupsPage struct {
 	Groups []Group `json:"groups"`
@@ -28,6 +28,8 @@ const (
 	CTBinary ContentType = "application/octet-stream"
 )
 
+const apiVersionHeader = "API-Version"
+
 var (
 	// ErrFailedCreation indicates that entity creation failed.
 	ErrFailedCreation = errors.New("failed to create entity")
@@ -57,7 +59,7 @@ var (
 	// ErrCerts indicates error fetching certificates.
 	ErrCerts = errors.New("failed to fetch certs data")
 
-	// ErrCertsRemove indicates failure while cleaning up from the Certs service.
+	// ErrCertsRemove indicates failure while revoking certificates.
 	ErrCertsRemove = errors.New("failed to remove certificate")
 
 	// ErrMemberAdd failed to add member to a group.
@@ -140,6 +142,14 @@ type OrgMember struct {
 	Email     string    `json:"email,omitempty"`
 }
 
+// SigningKey represents the public signing key of the org, used to verify
+// the configs and the config notifications sent to the things.
+type SigningKey struct {
+	OrgID     string    `json:"org_id,omitempty"`
+	PublicKey []byte    `json:"public_key,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
 // GroupMember represents mainflux Group Member.
 type GroupMember struct {
 	ID    string `json:"id,omitempty"`
@@ -149,11 +159,15 @@ type GroupMember struct {
 
 // Webhook represents mainflux Webhook.
 type Webhook struct {
-	ID      string            `json:"id"`
-	GroupID string            `json:"group_id"`
-	Name    string            `json:"name"`
-	Url     string            `json:"url"`
-	Headers map[string]string `json:"headers"`
+	ID         string            `json:"id"`
+	GroupID    string            `json:"group_id"`
+	Name       string            `json:"name"`
+	Url        string            `json:"url"`
+	Headers    map[string]string `json:"headers"`
+	ClientCert string            `json:"client_cert,omitempty"`
+	ClientKey  string            `json:"client_key,omitempty"`
+	CACert     string            `json:"ca_cert,omitempty"`
+	ProxyURL   string            `json:"proxy_url,omitempty"`
 }
 
 type Key struct {
@@ -319,6 +333,20 @@ type SDK interface {
 	// ListOrgsByMember lists orgs to which the specified member belongs.
 	ListOrgsByMember(memberID, token string, offset, limit uint64) (OrgsPage, error)
 
+	// CreateSigningKey generates the signing key of the org, replacing the existing one.
+	CreateSigningKey(orgID, token string) (SigningKey, error)
+
+	// SigningKey returns the public signing key of the org.
+	SigningKey(orgID, token string) (SigningKey, error)
+
+	// DeleteSigningKey removes the signing key of the org.
+	DeleteSigningKey(orgID, token string) error
+
+	// VerifyPayload verifies the payload signed for the thing using the public
+	// signing key of the org, and returns the payload. The payload older than
+	// the max age is rejected, unless the max age is zero.
+	VerifyPayload(publicKey []byte, thingID string, maxAge time.Duration, data []byte) ([]byte, error)
+
 	// CreateWebhooks creates new webhooks.
 	CreateWebhooks(whs []Webhook, groupID, token string) ([]Webhook, error)
 
@@ -347,13 +375,20 @@ type SDK interface {
 	Health() (mainflux.HealthInfo, error)
 
 	// IssueCert issues a certificate for a thing required for mtls.
-	IssueCert(thingID string, keyBits int, keyType, valid, token string) (Cert, error)
+	IssueCert(thingID string, keyBits int, keyType, ttl, token string) (Cert, error)
+
+	// ViewCert retrieves the certificate with the given serial.
+	ViewCert(serial, token string) (Cert, error)
 
-	// RemoveCert removes a certificate
-	RemoveCert(id, token string) error
+	// ListSerials lists the serials of the certificates issued for the thing.
+	ListSerials(thingID string, offset, limit uint64, token string) (CertsPage, error)
 
-	// RevokeCert revokes certificate with certID for thing with thingID
-	RevokeCert(thingID, certID, token string) error
+	// RenewCert issues a new certificate having the same parameters as the
+	// certificate with the given serial, which is revoked.
+	RenewCert(serial, token string) (Cert, error)
+
+	// RevokeCert revokes all certificates issued for the thing.
+	RevokeCert(thingID, token string) error
 
 	// Issue issues a new key, returning its token value alongside.
 	Issue(token string, duration time.Duration) (KeyRes, error)
@@ -375,6 +410,7 @@ type mfSDK struct {
 	webhooksURL    string
 	usersURL       string
 
+	apiVersion     string
 	msgContentType ContentType
 	client         *http.Client
 }
@@ -390,22 +426,34 @@ type Config struct {
 	WebhooksURL    string
 	UsersURL       string
 
+	// APIVersion specifies the version of the HTTP APIs, such as "v1", which
+	// prefixes the request paths. The deprecated unversioned paths are used
+	// if it is empty.
+	APIVersion      string
 	MsgContentType  ContentType
 	TLSVerification bool
 }
 
 // NewSDK returns new mainflux SDK instance.
 func NewSDK(conf Config) SDK {
-	return &mfSDK{
-		authURL:        conf.AuthURL,
-		bootstrapURL:   conf.BootstrapURL,
-		certsURL:       conf.CertsURL,
-		httpAdapterURL: conf.HTTPAdapterURL,
-		readerURL:      conf.ReaderURL,
-		thingsURL:      conf.ThingsURL,
-		webhooksURL:    conf.WebhooksURL,
-		usersURL:       conf.UsersURL,
+	versioned := func(url string) string {
+		if conf.APIVersion == "" {
+			return url
+		}
+		return fmt.Sprintf("%s/%s", url, conf.APIVersion)
+	}
 
+	return &mfSDK{
+		authURL:        versioned(conf.AuthURL),
+		bootstrapURL:   versioned(conf.BootstrapURL),
+		certsURL:       versioned(conf.CertsURL),
+		httpAdapterURL: versioned(conf.HTTPAdapterURL),
+		readerURL:      versioned(conf.ReaderURL),
+		thingsURL:      versioned(conf.ThingsURL),
+		webhooksURL:    versioned(conf.WebhooksURL),
+		usersURL:       versioned(conf.UsersURL),
+
+		apiVersion:     conf.APIVersion,
 		msgContentType: conf.MsgContentType,
 		client: &http.Client{
 			Transport: &http.Transport{
@@ -426,6 +474,10 @@ func (sdk mfSDK) sendRequest(req *http.Request, token, contentType string) (*htt
 		req.Header.Add("Content-Type", contentType)
 	}
 
+	if sdk.apiVersion != "" {
+		req.Header.Set(apiVersionHeader, sdk.apiVersion)
+	}
+
 	return sdk.client.Do(req)
 }
 
@@ -438,6 +490,10 @@ func (sdk mfSDK) sendThingRequest(req *http.Request, key, contentType string) (*
 		req.Header.Add("Content-Type", contentType)
 	}
 
+	if sdk.apiVersion != "" {
+		req.Header.Set(apiVersionHeader, sdk.apiVersion)
+	}
+
 	return sdk.client.Do(req)
 }
 
@@ -0,0 +1,101 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+)
+
+const signingKeyEndpoint = "signing-key"
+
+func (sdk mfSDK) CreateSigningKey(orgID, token string) (SigningKey, error) {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.authURL, orgsEndpoint, orgID, signingKeyEndpoint)
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	resp, err := sdk.sendRequest(req, token, string(CTJSON))
+	if err != nil {
+		return SigningKey{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return SigningKey{}, errors.Wrap(ErrFailedCreation, errors.New(resp.Status))
+	}
+
+	var sk SigningKey
+	if err := json.Unmarshal(body, &sk); err != nil {
+		return SigningKey{}, err
+	}
+
+	return sk, nil
+}
+
+func (sdk mfSDK) SigningKey(orgID, token string) (SigningKey, error) {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.authURL, orgsEndpoint, orgID, signingKeyEndpoint)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	resp, err := sdk.sendRequest(req, token, string(CTJSON))
+	if err != nil {
+		return SigningKey{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return SigningKey{}, errors.Wrap(ErrFailedFetch, errors.New(resp.Status))
+	}
+
+	var sk SigningKey
+	if err := json.Unmarshal(body, &sk); err != nil {
+		return SigningKey{}, err
+	}
+
+	return sk, nil
+}
+
+func (sdk mfSDK) DeleteSigningKey(orgID, token string) error {
+	url := fmt.Sprintf("%s/%s/%s/%s", sdk.authURL, orgsEndpoint, orgID, signingKeyEndpoint)
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := sdk.sendRequest(req, token, string(CTJSON))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return errors.Wrap(ErrFailedRemoval, errors.New(resp.Status))
+	}
+
+	return nil
+}
+
+func (sdk mfSDK) VerifyPayload(publicKey []byte, thingID string, maxAge time.Duration, data []byte) ([]byte, error) {
+	return messaging.VerifyPayload(publicKey, thingID, maxAge, data)
+}
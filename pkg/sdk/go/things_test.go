@@ -8,10 +8,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	sdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/things"
 	authapi "github.com/MainfluxLabs/mainflux/things/api/http"
@@ -48,20 +50,25 @@ func newThingsService() things.Service {
 	auth := mocks.NewAuthService("", usersList)
 	thingsRepo := thmocks.NewThingRepository()
 	profilesRepo := thmocks.NewProfileRepository(thingsRepo)
-	groupsRepo := thmocks.NewGroupRepository()
 	rolesRepo := thmocks.NewRolesRepository()
+	groupsRepo := thmocks.NewGroupRepository(rolesRepo)
+	sharesRepo := thmocks.NewShareRepository()
+	orgTemplatesRepo := thmocks.NewOrgTemplateRepository()
+	gatewaysRepo := thmocks.NewGatewayRepository()
+	aclsRepo := thmocks.NewNetworkACLRepository()
 	profileCache := thmocks.NewProfileCache()
 	thingCache := thmocks.NewThingCache()
 	groupCache := thmocks.NewGroupCache()
+	consumers := thmocks.NewConsumerGroups()
 	idProvider := uuid.NewMock()
 
-	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, profileCache, thingCache, groupCache, idProvider)
+	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, sharesRepo, orgTemplatesRepo, gatewaysRepo, aclsRepo, profileCache, thingCache, groupCache, consumers, idProvider)
 }
 
 func newThingsServer(svc things.Service) *httptest.Server {
 	logger := logger.NewMock()
 	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
-	return httptest.NewServer(mux)
+	return httptest.NewServer(servershttp.Version(mux, time.Time{}))
 }
 
 func newAuthServer(svc things.Service) *httptest.Server {
@@ -130,6 +137,48 @@ func TestCreateThing(t *testing.T) {
 	}
 }
 
+func TestCreateThingWithAPIVersion(t *testing.T) {
+	svc := newThingsService()
+	ts := newThingsServer(svc)
+	defer ts.Close()
+
+	mainfluxSDK := sdk.NewSDK(sdk.Config{ThingsURL: ts.URL, MsgContentType: contentType})
+
+	grID, err := mainfluxSDK.CreateGroup(group, orgID, token)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	prID, err := mainfluxSDK.CreateProfile(profile, grID, token)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	th := th1
+	th.ProfileID = prID
+
+	cases := []struct {
+		desc       string
+		apiVersion string
+		err        error
+		location   string
+	}{
+		{
+			desc:       "create new thing using supported api version",
+			apiVersion: "v1",
+			err:        nil,
+			location:   th.ID,
+		},
+		{
+			desc:       "create new thing using unsupported api version",
+			apiVersion: "v2",
+			err:        createError(sdk.ErrFailedCreation, http.StatusBadRequest),
+			location:   emptyValue,
+		},
+	}
+	for _, tc := range cases {
+		versionedSDK := sdk.NewSDK(sdk.Config{ThingsURL: ts.URL, MsgContentType: contentType, APIVersion: tc.apiVersion})
+		loc, err := versionedSDK.CreateThing(th, grID, token)
+
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s, got %s", tc.desc, tc.err, err))
+		assert.Equal(t, tc.location, loc, fmt.Sprintf("%s: expected location %s got %s", tc.desc, tc.location, loc))
+	}
+}
+
 func TestCreateThings(t *testing.T) {
 	svc := newThingsService()
 	ts := newThingsServer(svc)
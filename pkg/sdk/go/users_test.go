@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/logger"
@@ -48,7 +49,7 @@ func newUserService() users.Service {
 	auth := mocks.NewAuthService(admin.ID, usersList)
 	emailer := usmocks.NewEmailer()
 
-	return users.New(usersRepo, hasher, auth, emailer, idProvider, passRegex)
+	return users.New(usersRepo, usmocks.NewDeletionRepository(), hasher, auth, emailer, idProvider, passRegex, time.Hour, nil, nil)
 }
 
 func newUserServer(svc users.Service) *httptest.Server {
@@ -69,7 +70,7 @@ func newUserServer(svc users.Service) *httptest.Server {
 
 	sdkUser := sdk.User{Email: registerUser, Password: validPass}
 
-	token, err := svc.Login(context.Background(), admin)
+	token, err := svc.Login(context.Background(), admin, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error login: %s", err))
 
 	mainfluxSDK := sdk.NewSDK(sdkConf)
@@ -203,7 +204,7 @@ func TestCreateToken(t *testing.T) {
 	mainfluxSDK := sdk.NewSDK(sdkConf)
 	sdkUser := sdk.User{Email: userEmail, Password: validPass}
 
-	token, err := svc.Login(context.Background(), users.User{Email: sdkUser.Email, Password: sdkUser.Password})
+	token, err := svc.Login(context.Background(), users.User{Email: sdkUser.Email, Password: sdkUser.Password}, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error login: %s", err))
 
 	cases := []struct {
@@ -22,6 +22,23 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	thingsServiceName = "protomfx.ThingsService"
+	usersServiceName  = "protomfx.UsersService"
+	authServiceName   = "protomfx.AuthService"
+
+	// The connections are closed once they reach the max age, so that the
+	// clients resolve the service address again and spread the requests
+	// among the replicas added since they connected.
+	maxConnectionAge      = 5 * time.Minute
+	maxConnectionAgeGrace = 30 * time.Second
+	minKeepaliveTime      = 10 * time.Second
 )
 
 func Start(ctx context.Context, tracer opentracing.Tracer, svc interface{}, cfg servers.Config, logger logger.Logger) error {
@@ -33,6 +50,17 @@ func Start(ctx context.Context, tracer opentracing.Tracer, svc interface{}, cfg
 		return fmt.Errorf("failed to listen on port %s: %w", cfg.Port, err)
 	}
 
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionAge:      maxConnectionAge,
+			MaxConnectionAgeGrace: maxConnectionAgeGrace,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             minKeepaliveTime,
+			PermitWithoutStream: true,
+		}),
+	}
+
 	var server *grpc.Server
 	switch {
 	case cfg.ServerCert != "" || cfg.ServerKey != "":
@@ -41,23 +69,33 @@ func Start(ctx context.Context, tracer opentracing.Tracer, svc interface{}, cfg
 			return fmt.Errorf("failed to load auth certificates: %w", err)
 		}
 		logger.Info(fmt.Sprintf("%s gRPC service started using https on port %s with cert %s key %s", cfg.ServerName, cfg.Port, cfg.ServerCert, cfg.ServerKey))
-		server = grpc.NewServer(grpc.Creds(creds))
+		server = grpc.NewServer(append(opts, grpc.Creds(creds))...)
 	default:
 		logger.Info(fmt.Sprintf("%s gRPC service started using http on port %s", cfg.ServerName, cfg.Port))
-		server = grpc.NewServer()
+		server = grpc.NewServer(opts...)
 	}
 
+	var serviceName string
 	switch v := svc.(type) {
 	case things.Service:
 		protomfx.RegisterThingsServiceServer(server, grpcthings.NewServer(tracer, v))
+		serviceName = thingsServiceName
 	case users.Service:
 		protomfx.RegisterUsersServiceServer(server, grpcusers.NewServer(tracer, v))
+		serviceName = usersServiceName
 	case auth.Service:
 		protomfx.RegisterAuthServiceServer(server, grpcauth.NewServer(tracer, v))
+		serviceName = authServiceName
 	default:
 		return fmt.Errorf("unknown service: %s", cfg.ServerName)
 	}
 
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+
 	logger.Info(fmt.Sprintf("%s gRPC service started, exposed port %s", cfg.ServerName, cfg.Port))
 	go func() {
 		errCh <- server.Serve(listener)
@@ -65,6 +103,7 @@ func Start(ctx context.Context, tracer opentracing.Tracer, svc interface{}, cfg
 
 	select {
 	case <-ctx.Done():
+		healthServer.Shutdown()
 		c := make(chan bool)
 		go func() {
 			defer close(c)
@@ -0,0 +1,87 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const forwardedHeader = "X-Forwarded-For"
+
+type clientAddrKey struct{}
+
+// ClientAddr wraps the handler so that the request context carries the IP
+// address of the client. The X-Forwarded-For header is trusted only if the
+// request was sent by one of the trusted proxies, in which case the client
+// address is the last address of the header which doesn't belong to them.
+// Otherwise, the remote address of the request is used, so that the clients
+// can't choose their address by setting the header.
+func ClientAddr(h http.Handler, proxies []*net.IPNet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), clientAddrKey{}, clientAddr(r, proxies))
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ClientIP returns the IP address of the client which sent the request, as
+// resolved by the ClientAddr handler, or the remote address of the request
+// if it wasn't passed through it.
+func ClientIP(r *http.Request) string {
+	if addr, ok := r.Context().Value(clientAddrKey{}).(string); ok {
+		return addr
+	}
+
+	return hostAddr(r.RemoteAddr)
+}
+
+func clientAddr(r *http.Request, proxies []*net.IPNet) string {
+	addr := hostAddr(r.RemoteAddr)
+	if !trusted(addr, proxies) {
+		return addr
+	}
+
+	// The proxies append the address they received the request from, so the
+	// header is read from the end, skipping the addresses of the proxies.
+	fwd := strings.Split(strings.Join(r.Header.Values(forwardedHeader), ","), ",")
+	for i := len(fwd) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(fwd[i])
+		if net.ParseIP(hop) == nil {
+			return addr
+		}
+
+		addr = hop
+		if !trusted(hop, proxies) {
+			return addr
+		}
+	}
+
+	return addr
+}
+
+func trusted(addr string, proxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	for _, p := range proxies {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hostAddr(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const forwardedHeader = "X-Forwarded-For"
+
+func TestClientAddr(t *testing.T) {
+	_, proxyNet, err := net.ParseCIDR("10.0.0.0/8")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, proxyNet6, err := net.ParseCIDR("fd00::/8")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	proxies := []*net.IPNet{proxyNet, proxyNet6}
+
+	cases := []struct {
+		desc       string
+		remoteAddr string
+		forwarded  string
+		proxies    []*net.IPNet
+		addr       string
+	}{
+		{
+			desc:       "request without forwarded header",
+			remoteAddr: "192.168.1.10:4000",
+			proxies:    proxies,
+			addr:       "192.168.1.10",
+		},
+		{
+			desc:       "request with forwarded header from untrusted address",
+			remoteAddr: "192.168.1.10:4000",
+			forwarded:  "1.2.3.4",
+			proxies:    proxies,
+			addr:       "192.168.1.10",
+		},
+		{
+			desc:       "request with forwarded header without trusted proxies",
+			remoteAddr: "10.0.0.1:4000",
+			forwarded:  "1.2.3.4",
+			addr:       "10.0.0.1",
+		},
+		{
+			desc:       "request with forwarded header from trusted proxy",
+			remoteAddr: "10.0.0.1:4000",
+			forwarded:  "1.2.3.4",
+			proxies:    proxies,
+			addr:       "1.2.3.4",
+		},
+		{
+			desc:       "request with spoofed forwarded header from trusted proxy",
+			remoteAddr: "10.0.0.1:4000",
+			forwarded:  "6.6.6.6, 1.2.3.4",
+			proxies:    proxies,
+			addr:       "1.2.3.4",
+		},
+		{
+			desc:       "request through trusted proxy chain",
+			remoteAddr: "10.0.0.1:4000",
+			forwarded:  "1.2.3.4, 10.0.0.2",
+			proxies:    proxies,
+			addr:       "1.2.3.4",
+		},
+		{
+			desc:       "request with invalid forwarded address from trusted proxy",
+			remoteAddr: "10.0.0.1:4000",
+			forwarded:  "invalid, 10.0.0.2",
+			proxies:    proxies,
+			addr:       "10.0.0.2",
+		},
+		{
+			desc:       "request with IPv6 forwarded address from trusted proxy",
+			remoteAddr: "[fd00::1]:4000",
+			forwarded:  "2001:db8::1",
+			proxies:    proxies,
+			addr:       "2001:db8::1",
+		},
+	}
+
+	for _, tc := range cases {
+		var addr string
+		h := servershttp.ClientAddr(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			addr = servershttp.ClientIP(r)
+		}), tc.proxies)
+
+		req := httptest.NewRequest(http.MethodGet, "/things", nil)
+		req.RemoteAddr = tc.remoteAddr
+		if tc.forwarded != "" {
+			req.Header.Set(forwardedHeader, tc.forwarded)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, tc.addr, addr, fmt.Sprintf("%s: expected client address %s got %s\n", tc.desc, tc.addr, addr))
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+)
+
+const (
+	anyOrigin = "*"
+
+	originHeader          = "Origin"
+	varyHeader            = "Vary"
+	requestMethodHeader   = "Access-Control-Request-Method"
+	allowOriginHeader     = "Access-Control-Allow-Origin"
+	allowMethodsHeader    = "Access-Control-Allow-Methods"
+	allowHeadersHeader    = "Access-Control-Allow-Headers"
+	exposeHeadersHeader   = "Access-Control-Expose-Headers"
+	maxAgeHeader          = "Access-Control-Max-Age"
+	hstsHeader            = "Strict-Transport-Security"
+	contentTypeOptsHeader = "X-Content-Type-Options"
+	frameOptsHeader       = "X-Frame-Options"
+	referrerPolicyHeader  = "Referrer-Policy"
+)
+
+// Headers wraps the handler so that the security headers and, for allowed
+// origins, the CORS headers are set on every response. CORS preflight
+// requests are answered directly without reaching the wrapped handler.
+func Headers(h http.Handler, cfg servers.HeadersConfig) http.Handler {
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	exposed := strings.Join(cfg.ExposedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	hsts := fmt.Sprintf("max-age=%d; includeSubDomains", int(cfg.HSTSMaxAge.Seconds()))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentTypeOptsHeader, "nosniff")
+		w.Header().Set(frameOptsHeader, "DENY")
+		w.Header().Set(referrerPolicyHeader, "no-referrer")
+		if cfg.HSTSMaxAge > 0 {
+			w.Header().Set(hstsHeader, hsts)
+		}
+
+		origin := r.Header.Get(originHeader)
+		if origin == "" || !originAllowed(cfg.AllowedOrigins, origin) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add(varyHeader, originHeader)
+		w.Header().Set(allowOriginHeader, origin)
+
+		if r.Method == http.MethodOptions && r.Header.Get(requestMethodHeader) != "" {
+			w.Header().Set(allowMethodsHeader, methods)
+			w.Header().Set(allowHeadersHeader, headers)
+			w.Header().Set(maxAgeHeader, maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if exposed != "" {
+			w.Header().Set(exposeHeadersHeader, exposed)
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func originAllowed(allowed []string, origin string) bool {
+	for _, o := range allowed {
+		if o == anyOrigin || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+
+	return false
+}
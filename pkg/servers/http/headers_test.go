@@ -0,0 +1,125 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	allowedOrigin = "https://app.example.com"
+	otherOrigin   = "https://other.example.com"
+)
+
+func TestHeaders(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cfg := servers.HeadersConfig{
+		AllowedOrigins: []string{allowedOrigin},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: []string{"Authorization"},
+		ExposedHeaders: []string{"Location"},
+		MaxAge:         10 * time.Minute,
+		HSTSMaxAge:     time.Hour,
+	}
+
+	cases := []struct {
+		desc    string
+		cfg     servers.HeadersConfig
+		method  string
+		origin  string
+		status  int
+		headers map[string]string
+	}{
+		{
+			desc:   "request from allowed origin",
+			cfg:    cfg,
+			method: http.MethodGet,
+			origin: allowedOrigin,
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin":   allowedOrigin,
+				"Access-Control-Expose-Headers": "Location",
+				"Strict-Transport-Security":     "max-age=3600; includeSubDomains",
+				"X-Content-Type-Options":        "nosniff",
+				"X-Frame-Options":               "DENY",
+			},
+		},
+		{
+			desc:   "preflight request from allowed origin",
+			cfg:    cfg,
+			method: http.MethodOptions,
+			origin: allowedOrigin,
+			status: http.StatusNoContent,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin":  allowedOrigin,
+				"Access-Control-Allow-Methods": "GET, POST",
+				"Access-Control-Allow-Headers": "Authorization",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			desc:   "request from disallowed origin",
+			cfg:    cfg,
+			method: http.MethodGet,
+			origin: otherOrigin,
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"X-Content-Type-Options":      "nosniff",
+			},
+		},
+		{
+			desc:   "request from any origin allowed",
+			cfg:    servers.HeadersConfig{AllowedOrigins: []string{"*"}},
+			method: http.MethodGet,
+			origin: otherOrigin,
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin": otherOrigin,
+				"Strict-Transport-Security":   "",
+			},
+		},
+		{
+			desc:   "request without origin",
+			cfg:    cfg,
+			method: http.MethodGet,
+			status: http.StatusOK,
+			headers: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"X-Frame-Options":             "DENY",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		h := servershttp.Headers(okHandler, tc.cfg)
+		req := httptest.NewRequest(tc.method, "/", nil)
+		if tc.origin != "" {
+			req.Header.Set("Origin", tc.origin)
+		}
+		if tc.method == http.MethodOptions {
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		}
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, tc.status, rec.Code, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, rec.Code))
+		for k, v := range tc.headers {
+			got := rec.Header().Get(k)
+			assert.Equal(t, v, got, fmt.Sprintf("%s: expected header %s to be %q got %q", tc.desc, k, v, got))
+		}
+	}
+}
@@ -15,7 +15,7 @@ import (
 func Start(ctx context.Context, handler http.Handler, cfg servers.Config, logger logger.Logger) error {
 	p := fmt.Sprintf(":%s", cfg.Port)
 	errCh := make(chan error)
-	server := &http.Server{Addr: p, Handler: handler}
+	server := &http.Server{Addr: p, Handler: Headers(RequestID(ClientAddr(Version(Timeout(handler, cfg.RequestTimeout), cfg.Headers.Sunset), cfg.Headers.TrustedProxies)), cfg.Headers)}
 
 	switch {
 	case cfg.ServerCert != "" || cfg.ServerKey != "":
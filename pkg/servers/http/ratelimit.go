@@ -0,0 +1,153 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-redis/redis/v8"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	rateLimitPrefix = "rate_limit"
+	retryHeader     = "Retry-After"
+	retryAfter      = "1"
+	contentType     = "application/json"
+	tooManyRequests = "too many requests"
+)
+
+var (
+	limitedRequests = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "http",
+		Subsystem: "rate_limit",
+		Name:      "rejected_request_count",
+		Help:      "Number of requests rejected due to rate limiting.",
+	}, []string{"service"})
+
+	// tokenBucket atomically refills the bucket stored under KEYS[1] based on the
+	// elapsed time and takes a single token from it if one is available.
+	tokenBucket = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1]) or burst
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return allowed
+`)
+)
+
+// Limiter specifies an API for limiting the rate of client requests.
+type Limiter interface {
+	// Allow reports whether the client identified by the given key
+	// is allowed to issue another request.
+	Allow(ctx context.Context, key string) (bool, error)
+
+	// Update changes the limit applied to subsequent requests.
+	Update(cfg servers.RateLimitConfig)
+}
+
+var _ Limiter = (*redisLimiter)(nil)
+
+type redisLimiter struct {
+	mu      sync.RWMutex
+	client  *redis.Client
+	service string
+	rate    float64
+	burst   int
+}
+
+// NewLimiter returns Redis backed token bucket limiter which allows each
+// client to issue cfg.Rate requests per second with bursts of cfg.Burst.
+func NewLimiter(client *redis.Client, service string, cfg servers.RateLimitConfig) Limiter {
+	rl := &redisLimiter{
+		client:  client,
+		service: service,
+	}
+	rl.Update(cfg)
+
+	return rl
+}
+
+func (rl *redisLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	k := fmt.Sprintf("%s:%s:%s", rateLimitPrefix, rl.service, key)
+	now := time.Now().UnixMilli()
+
+	rl.mu.RLock()
+	rate, burst := rl.rate, rl.burst
+	rl.mu.RUnlock()
+
+	if rate <= 0 {
+		return true, nil
+	}
+
+	allowed, err := tokenBucket.Run(ctx, rl.client, []string{k}, rate, burst, now).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return allowed == 1, nil
+}
+
+func (rl *redisLimiter) Update(cfg servers.RateLimitConfig) {
+	burst := cfg.Burst
+	if burst < 1 {
+		burst = int(math.Ceil(cfg.Rate))
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.rate = cfg.Rate
+	rl.burst = burst
+}
+
+// RateLimit wraps the handler so that requests exceeding the limit are
+// rejected with 429 Too Many Requests. Clients are identified by their IP
+// address, as resolved by the ClientAddr handler, since the authorization
+// token isn't authenticated yet and could be changed on each request. If the
+// limiter fails, requests are let through so that the API stays available.
+func RateLimit(h http.Handler, l Limiter, service string, logger logger.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		allowed, err := l.Allow(r.Context(), clientKey(r))
+		if err != nil {
+			logger.Warn(fmt.Sprintf("%s service failed to check rate limit: %s", service, err))
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if !allowed {
+			limitedRequests.With("service", service).Add(1)
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set(retryHeader, retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(apiutil.NewErrorRes(r.Context(), tooManyRequests))
+			return
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func clientKey(r *http.Request) string {
+	return "ip:" + ClientIP(r)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	svcName = "test"
+	token   = "token"
+)
+
+var _ servershttp.Limiter = (*limiterMock)(nil)
+
+type limiterMock struct {
+	mu       sync.Mutex
+	burst    int
+	requests map[string]int
+	err      error
+}
+
+func (lm *limiterMock) Allow(_ context.Context, key string) (bool, error) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	if lm.err != nil {
+		return false, lm.err
+	}
+
+	lm.requests[key]++
+	return lm.requests[key] <= lm.burst, nil
+}
+
+func (lm *limiterMock) Update(cfg servers.RateLimitConfig) {
+	lm.mu.Lock()
+	defer lm.mu.Unlock()
+
+	lm.burst = cfg.Burst
+}
+
+func TestRateLimit(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	cases := []struct {
+		desc      string
+		limiter   *limiterMock
+		token     string
+		forwarded string
+		addr      string
+		count     int
+		status    int
+	}{
+		{
+			desc:    "issue requests within limit",
+			limiter: &limiterMock{burst: 3, requests: map[string]int{}},
+			addr:    "10.0.0.1:5000",
+			count:   3,
+			status:  http.StatusOK,
+		},
+		{
+			desc:    "issue requests over limit",
+			limiter: &limiterMock{burst: 3, requests: map[string]int{}},
+			addr:    "10.0.0.1:5000",
+			count:   4,
+			status:  http.StatusTooManyRequests,
+		},
+		{
+			desc:    "issue requests over limit changing token",
+			limiter: &limiterMock{burst: 1, requests: map[string]int{}},
+			token:   token,
+			addr:    "10.0.0.1:5000",
+			count:   2,
+			status:  http.StatusTooManyRequests,
+		},
+		{
+			desc:      "issue requests over limit changing forwarded address",
+			limiter:   &limiterMock{burst: 1, requests: map[string]int{}},
+			forwarded: "1.2.3.4",
+			addr:      "10.0.0.1:5000",
+			count:     2,
+			status:    http.StatusTooManyRequests,
+		},
+		{
+			desc:    "issue requests with failing limiter",
+			limiter: &limiterMock{burst: 1, requests: map[string]int{}, err: errors.New("limiter failure")},
+			addr:    "10.0.0.1:5000",
+			count:   5,
+			status:  http.StatusOK,
+		},
+	}
+
+	for _, tc := range cases {
+		h := servershttp.RateLimit(okHandler, tc.limiter, svcName, logger.NewMock())
+
+		var status int
+		for i := 0; i < tc.count; i++ {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tc.addr
+			if tc.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("%s-%d", tc.token, i))
+			}
+			if tc.forwarded != "" {
+				req.Header.Set(forwardedHeader, fmt.Sprintf("%s, 10.0.0.%d", tc.forwarded, i+2))
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			status = rec.Code
+		}
+
+		assert.Equal(t, tc.status, status, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, status))
+	}
+}
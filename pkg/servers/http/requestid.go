@@ -0,0 +1,69 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+	traceIDHeader   = "Uber-Trace-Id"
+	maxIDLen        = 128
+)
+
+var idProvider = uuid.New()
+
+// validID reports whether the ID taken from the request header may be added
+// to the log entries and the responses, so that the clients can't forge the
+// log lines or inflate them.
+func validID(id string) bool {
+	if id == "" || len(id) > maxIDLen {
+		return false
+	}
+
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// RequestID wraps the handler so that the request context carries the
+// request ID, taken from the X-Request-ID header or generated if missing,
+// and the Jaeger trace ID of the incoming request, if any, so that they
+// are added to log entries. The request ID is returned in the X-Request-ID
+// response header. The request ID longer than 128 characters, or holding
+// characters other than letters, digits, '-', '_', '.' and ':', is replaced
+// by the generated one, while such a trace ID is ignored.
+func RequestID(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		id := r.Header.Get(requestIDHeader)
+		if !validID(id) {
+			id, _ = idProvider.ID()
+		}
+		if id != "" {
+			ctx = logger.ContextWithRequestID(ctx, id)
+			w.Header().Set(requestIDHeader, id)
+		}
+
+		// Jaeger propagates trace context as {trace-id}:{span-id}:{parent-span-id}:{flags}.
+		if trace := strings.Split(r.Header.Get(traceIDHeader), ":")[0]; validID(trace) {
+			ctx = logger.ContextWithTraceID(ctx, trace)
+		}
+
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/stretchr/testify/assert"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+func TestRequestID(t *testing.T) {
+	cases := []struct {
+		desc      string
+		requestID string
+		valid     bool
+	}{
+		{
+			desc:      "request with request ID",
+			requestID: "request-id",
+			valid:     true,
+		},
+		{
+			desc:      "request without request ID",
+			requestID: "",
+		},
+		{
+			desc:      "request with too long request ID",
+			requestID: strings.Repeat("a", 129),
+		},
+		{
+			desc:      "request with request ID holding invalid characters",
+			requestID: "request-id\nlevel=error",
+		},
+	}
+
+	for _, tc := range cases {
+		var ctxID string
+		h := servershttp.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctxID = logger.RequestIDFromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/things", nil)
+		if tc.requestID != "" {
+			req.Header.Set(requestIDHeader, tc.requestID)
+		}
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+
+		resID := res.Header().Get(requestIDHeader)
+		assert.NotEmpty(t, resID, fmt.Sprintf("%s: expected request ID in response header\n", tc.desc))
+		assert.Equal(t, resID, ctxID, fmt.Sprintf("%s: expected request ID %s got %s\n", tc.desc, resID, ctxID))
+		if tc.valid {
+			assert.Equal(t, tc.requestID, resID, fmt.Sprintf("%s: expected request ID %s got %s\n", tc.desc, tc.requestID, resID))
+		}
+		if !tc.valid && tc.requestID != "" {
+			assert.NotEqual(t, tc.requestID, resID, fmt.Sprintf("%s: expected generated request ID got %s\n", tc.desc, resID))
+		}
+	}
+}
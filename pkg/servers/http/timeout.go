@@ -0,0 +1,72 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+)
+
+// untimedKey is the key of the request context without the deadline, which
+// is stored by Timeout for the routes wrapped using WithoutTimeout.
+type untimedKey struct{}
+
+// Timeout sets the deadline of the request context, so that the service and
+// repository calls using it are canceled once the timeout expires. The timeout
+// is read on each request, so that it can be changed while the server is
+// running. The routes wrapped using WithoutTimeout are left without the
+// deadline.
+func Timeout(h http.Handler, rt *servers.RequestTimeout) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timeout := rt.Value()
+		if timeout <= 0 {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), untimedKey{}, r.Context())
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// WithoutTimeout exempts the route from the request timeout, e.g. the WebSocket
+// connections, which outlive the upgrade request, or the streams, which last as
+// long as it takes to write all of the streamed entities. The request context
+// keeps its values and is still canceled once the client goes away.
+func WithoutTimeout(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parent, ok := r.Context().Value(untimedKey{}).(context.Context)
+		if !ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		h.ServeHTTP(w, r.WithContext(untimedContext{Context: r.Context(), parent: parent}))
+	})
+}
+
+// untimedContext takes the cancellation from the parent context without the
+// deadline, and the values from the request context.
+type untimedContext struct {
+	context.Context
+	parent context.Context
+}
+
+func (c untimedContext) Deadline() (time.Time, bool) {
+	return c.parent.Deadline()
+}
+
+func (c untimedContext) Done() <-chan struct{} {
+	return c.parent.Done()
+}
+
+func (c untimedContext) Err() error {
+	return c.parent.Err()
+}
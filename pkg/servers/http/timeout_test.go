@@ -0,0 +1,140 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/servers"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+const ctxValue = "value"
+
+func TestTimeout(t *testing.T) {
+	cases := []struct {
+		desc     string
+		timeout  time.Duration
+		untimed  bool
+		upgrade  string
+		accept   string
+		deadline bool
+	}{
+		{
+			desc:     "request with timeout",
+			timeout:  time.Minute,
+			deadline: true,
+		},
+		{
+			desc:     "request without timeout",
+			timeout:  0,
+			deadline: false,
+		},
+		{
+			desc:     "websocket upgrade request with timeout",
+			timeout:  time.Minute,
+			upgrade:  "websocket",
+			deadline: true,
+		},
+		{
+			desc:     "stream request with timeout",
+			timeout:  time.Minute,
+			accept:   "application/x-ndjson",
+			deadline: true,
+		},
+		{
+			desc:     "request to route without timeout",
+			timeout:  time.Minute,
+			untimed:  true,
+			deadline: false,
+		},
+		{
+			desc:     "request to route without timeout with timeout disabled",
+			timeout:  0,
+			untimed:  true,
+			deadline: false,
+		},
+	}
+
+	for _, tc := range cases {
+		var deadline bool
+		var value interface{}
+		var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, deadline = r.Context().Deadline()
+			value = r.Context().Value(ctxKey{})
+		})
+		if tc.untimed {
+			h = servershttp.WithoutTimeout(h)
+		}
+		h = servershttp.Timeout(h, servers.NewRequestTimeout(tc.timeout))
+
+		req := httptest.NewRequest(http.MethodGet, "/things", nil)
+		req = req.WithContext(context.WithValue(req.Context(), ctxKey{}, ctxValue))
+		if tc.upgrade != "" {
+			req.Header.Set("Upgrade", tc.upgrade)
+		}
+		if tc.accept != "" {
+			req.Header.Set("Accept", tc.accept)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, tc.deadline, deadline, fmt.Sprintf("%s: expected deadline %t got %t\n", tc.desc, tc.deadline, deadline))
+		assert.Equal(t, ctxValue, value, fmt.Sprintf("%s: expected context value %v got %v\n", tc.desc, ctxValue, value))
+	}
+}
+
+func TestWithoutTimeoutCancel(t *testing.T) {
+	var err error
+	h := servershttp.Timeout(servershttp.WithoutTimeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		err = r.Context().Err()
+	})), servers.NewRequestTimeout(time.Minute))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/things", nil).WithContext(ctx))
+
+	assert.Equal(t, context.Canceled, err, fmt.Sprintf("expected error %s got %s\n", context.Canceled, err))
+}
+
+func TestTimeoutUpdate(t *testing.T) {
+	rt := servers.NewRequestTimeout(0)
+
+	var deadline bool
+	h := servershttp.Timeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, deadline = r.Context().Deadline()
+	}), rt)
+
+	cases := []struct {
+		desc     string
+		timeout  time.Duration
+		deadline bool
+	}{
+		{
+			desc:     "request after enabling timeout",
+			timeout:  time.Minute,
+			deadline: true,
+		},
+		{
+			desc:     "request after disabling timeout",
+			timeout:  0,
+			deadline: false,
+		},
+	}
+
+	for _, tc := range cases {
+		rt.Update(tc.timeout)
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/things", nil))
+
+		assert.Equal(t, tc.deadline, deadline, fmt.Sprintf("%s: expected deadline %t got %t\n", tc.desc, tc.deadline, deadline))
+	}
+}
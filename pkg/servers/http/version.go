@@ -0,0 +1,80 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+const (
+	// APIVersion is the current version of the HTTP APIs.
+	APIVersion = "v1"
+
+	versionHeader     = "API-Version"
+	deprecationHeader = "Deprecation"
+	sunsetHeader      = "Sunset"
+	linkHeader        = "Link"
+	versionPrefix     = "/" + APIVersion
+
+	unsupportedVersion = "unsupported api version"
+)
+
+// unversionedPaths are served without the version prefix only, so they
+// aren't deprecated.
+var unversionedPaths = []string{"/health", "/metrics"}
+
+// Version wraps the handler so that the routes are served under the /v1
+// prefix as well as without it. The API version requested using the
+// API-Version header is checked, and the current version is returned in the
+// API-Version response header. The unversioned paths are deprecated, so their
+// responses carry the Deprecation header and, if the sunset is set, the
+// Sunset header along with the Link to the versioned path.
+func Version(h http.Handler, sunset time.Time) http.Handler {
+	versioned := http.StripPrefix(versionPrefix, h)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get(versionHeader); v != "" && v != APIVersion {
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(apiutil.NewErrorRes(r.Context(), unsupportedVersion))
+			return
+		}
+
+		w.Header().Set(versionHeader, APIVersion)
+
+		if r.URL.Path == versionPrefix || strings.HasPrefix(r.URL.Path, versionPrefix+"/") {
+			versioned.ServeHTTP(w, r)
+			return
+		}
+
+		if !deprecated(r.URL.Path) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(deprecationHeader, "true")
+		if !sunset.IsZero() {
+			w.Header().Set(sunsetHeader, sunset.UTC().Format(http.TimeFormat))
+			w.Header().Set(linkHeader, fmt.Sprintf("<%s%s>; rel=\"successor-version\"", versionPrefix, r.URL.Path))
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+func deprecated(path string) bool {
+	for _, p := range unversionedPaths {
+		if path == p {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersion(t *testing.T) {
+	sunset := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		desc    string
+		sunset  time.Time
+		path    string
+		version string
+		status  int
+		served  string
+		headers map[string]string
+	}{
+		{
+			desc:   "versioned request",
+			sunset: sunset,
+			path:   "/v1/things",
+			status: http.StatusOK,
+			served: "/things",
+			headers: map[string]string{
+				"API-Version": "v1",
+				"Deprecation": "",
+				"Sunset":      "",
+				"Link":        "",
+			},
+		},
+		{
+			desc:    "versioned request with supported version",
+			sunset:  sunset,
+			path:    "/v1/things",
+			version: "v1",
+			status:  http.StatusOK,
+			served:  "/things",
+			headers: map[string]string{
+				"API-Version": "v1",
+				"Deprecation": "",
+			},
+		},
+		{
+			desc:    "request with unsupported version",
+			sunset:  sunset,
+			path:    "/v1/things",
+			version: "v2",
+			status:  http.StatusBadRequest,
+			served:  "",
+			headers: map[string]string{
+				"Content-Type": "application/json",
+			},
+		},
+		{
+			desc:   "unversioned request",
+			sunset: sunset,
+			path:   "/things",
+			status: http.StatusOK,
+			served: "/things",
+			headers: map[string]string{
+				"API-Version": "v1",
+				"Deprecation": "true",
+				"Sunset":      "Fri, 01 Jan 2027 00:00:00 GMT",
+				"Link":        `</v1/things>; rel="successor-version"`,
+			},
+		},
+		{
+			desc:   "unversioned request without sunset",
+			path:   "/things",
+			status: http.StatusOK,
+			served: "/things",
+			headers: map[string]string{
+				"API-Version": "v1",
+				"Deprecation": "true",
+				"Sunset":      "",
+				"Link":        "",
+			},
+		},
+		{
+			desc:   "health request",
+			sunset: sunset,
+			path:   "/health",
+			status: http.StatusOK,
+			served: "/health",
+			headers: map[string]string{
+				"API-Version": "v1",
+				"Deprecation": "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		served := ""
+		h := servershttp.Version(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served = r.URL.Path
+			w.WriteHeader(http.StatusOK)
+		}), tc.sunset)
+
+		req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		if tc.version != "" {
+			req.Header.Set("API-Version", tc.version)
+		}
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+
+		assert.Equal(t, tc.status, res.Code, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.Code))
+		assert.Equal(t, tc.served, served, fmt.Sprintf("%s: expected served path %s got %s", tc.desc, tc.served, served))
+		for k, v := range tc.headers {
+			assert.Equal(t, v, res.Header().Get(k), fmt.Sprintf("%s: expected header %s to be %q got %q", tc.desc, k, v, res.Header().Get(k)))
+		}
+	}
+}
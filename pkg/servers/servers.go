@@ -4,7 +4,37 @@
 package servers
 
 import (
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
 	"time"
+
+	"github.com/MainfluxLabs/mainflux"
+)
+
+const (
+	defCORSAllowedOrigins = ""
+	defCORSAllowedMethods = "GET,POST,PUT,PATCH,DELETE,OPTIONS"
+	defCORSAllowedHeaders = "Authorization,Content-Type,API-Version,TTL,X-Share-Password"
+	defCORSExposedHeaders = "Location,X-Request-ID,API-Version,Deprecation,Sunset,Link"
+	defCORSMaxAge         = "10m"
+	defHSTSMaxAge         = "0"
+	defRequestTimeout     = "0"
+	defAPISunset          = ""
+	defTrustedProxies     = ""
+
+	envCORSAllowedOrigins = "MF_HTTP_CORS_ALLOWED_ORIGINS"
+	envCORSAllowedMethods = "MF_HTTP_CORS_ALLOWED_METHODS"
+	envCORSAllowedHeaders = "MF_HTTP_CORS_ALLOWED_HEADERS"
+	envCORSExposedHeaders = "MF_HTTP_CORS_EXPOSED_HEADERS"
+	envCORSMaxAge         = "MF_HTTP_CORS_MAX_AGE"
+	envHSTSMaxAge         = "MF_HTTP_HSTS_MAX_AGE"
+	envRequestTimeout     = "MF_HTTP_REQUEST_TIMEOUT"
+	envAPISunset          = "MF_HTTP_API_SUNSET"
+	envTrustedProxies     = "MF_HTTP_TRUSTED_PROXIES"
+
+	sunsetLayout = "2006-01-02"
 )
 
 type Config struct {
@@ -13,4 +43,172 @@ type Config struct {
 	ServerKey    string
 	Port         string
 	StopWaitTime time.Duration
+	Headers      HeadersConfig
+	// RequestTimeout specifies the deadline of the HTTP request context, which
+	// is propagated to the service and repository calls. The requests are not
+	// bounded if it is nil or not positive.
+	RequestTimeout *RequestTimeout
+}
+
+// RequestTimeout holds the HTTP request timeout, which can be changed while
+// the server is running.
+type RequestTimeout struct {
+	timeout atomic.Int64
+}
+
+// NewRequestTimeout returns the request timeout set to the given duration.
+func NewRequestTimeout(timeout time.Duration) *RequestTimeout {
+	rt := &RequestTimeout{}
+	rt.Update(timeout)
+
+	return rt
+}
+
+// Value returns the current timeout.
+func (rt *RequestTimeout) Value() time.Duration {
+	if rt == nil {
+		return 0
+	}
+
+	return time.Duration(rt.timeout.Load())
+}
+
+// Update changes the timeout applied to subsequent requests.
+func (rt *RequestTimeout) Update(timeout time.Duration) {
+	rt.timeout.Store(int64(timeout))
+}
+
+// HeadersConfig represents the CORS and security headers set on HTTP responses.
+type HeadersConfig struct {
+	// AllowedOrigins contains origins allowed to issue cross-origin requests.
+	// CORS headers are not set if it is empty, and "*" allows any origin.
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+	// MaxAge specifies how long the result of a preflight request can be cached.
+	MaxAge time.Duration
+	// HSTSMaxAge specifies the Strict-Transport-Security max age. The header
+	// is not set if it is not positive.
+	HSTSMaxAge time.Duration
+	// Sunset specifies the date after which the unversioned API paths are
+	// removed. The Sunset header is not set if it is zero.
+	Sunset time.Time
+	// TrustedProxies contains the networks of the reverse proxies whose
+	// X-Forwarded-For header is trusted to carry the client address. The
+	// header is ignored if it is empty.
+	TrustedProxies []*net.IPNet
+}
+
+// LoadHeadersConfig reads the headers configuration shared by all HTTP services
+// from the environment.
+func LoadHeadersConfig() (HeadersConfig, error) {
+	maxAge, err := time.ParseDuration(mainflux.Env(envCORSMaxAge, defCORSMaxAge))
+	if err != nil {
+		return HeadersConfig{}, fmt.Errorf("invalid %s value: %w", envCORSMaxAge, err)
+	}
+
+	hstsMaxAge, err := time.ParseDuration(mainflux.Env(envHSTSMaxAge, defHSTSMaxAge))
+	if err != nil {
+		return HeadersConfig{}, fmt.Errorf("invalid %s value: %w", envHSTSMaxAge, err)
+	}
+
+	var sunset time.Time
+	if s := mainflux.Env(envAPISunset, defAPISunset); s != "" {
+		if sunset, err = time.Parse(sunsetLayout, s); err != nil {
+			return HeadersConfig{}, fmt.Errorf("invalid %s value: %w", envAPISunset, err)
+		}
+	}
+
+	proxies, err := parseNetworks(splitList(mainflux.Env(envTrustedProxies, defTrustedProxies)))
+	if err != nil {
+		return HeadersConfig{}, fmt.Errorf("invalid %s value: %w", envTrustedProxies, err)
+	}
+
+	return HeadersConfig{
+		AllowedOrigins: splitList(mainflux.Env(envCORSAllowedOrigins, defCORSAllowedOrigins)),
+		AllowedMethods: splitList(mainflux.Env(envCORSAllowedMethods, defCORSAllowedMethods)),
+		AllowedHeaders: splitList(mainflux.Env(envCORSAllowedHeaders, defCORSAllowedHeaders)),
+		ExposedHeaders: splitList(mainflux.Env(envCORSExposedHeaders, defCORSExposedHeaders)),
+		MaxAge:         maxAge,
+		HSTSMaxAge:     hstsMaxAge,
+		Sunset:         sunset,
+		TrustedProxies: proxies,
+	}, nil
+}
+
+// LoadRequestTimeout reads the HTTP request timeout shared by all HTTP services
+// from the environment.
+func LoadRequestTimeout() (*RequestTimeout, error) {
+	timeout, err := parseRequestTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRequestTimeout(timeout), nil
+}
+
+// ReloadRequestTimeout applies the HTTP request timeout read from the
+// environment to the given timeout.
+func ReloadRequestTimeout(rt *RequestTimeout) error {
+	timeout, err := parseRequestTimeout()
+	if err != nil {
+		return err
+	}
+
+	rt.Update(timeout)
+	return nil
+}
+
+func parseRequestTimeout() (time.Duration, error) {
+	timeout, err := time.ParseDuration(mainflux.Env(envRequestTimeout, defRequestTimeout))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value: %w", envRequestTimeout, err)
+	}
+
+	return timeout, nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// parseNetworks parses the networks in CIDR notation, or the single IP addresses.
+func parseNetworks(networks []string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, n := range networks {
+		if ip := net.ParseIP(n); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipnet, err := net.ParseCIDR(n)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, ipnet)
+	}
+
+	return nets, nil
+}
+
+// RateLimitConfig represents the per-client request rate limit of an HTTP API.
+type RateLimitConfig struct {
+	// Rate is the number of requests per second a client is allowed to issue.
+	// Rate limiting is disabled if it is not positive.
+	Rate float64
+	// Burst is the maximum number of requests a client can issue at once.
+	// It defaults to the rate rounded up if not set.
+	Burst int
 }
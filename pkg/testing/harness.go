@@ -0,0 +1,285 @@
+//go:build !rabbitmq
+// +build !rabbitmq
+
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testing provides the end-to-end test harness, which runs the message
+// pipeline against the message broker and database containers. The messages
+// are published through the HTTP adapter, routed by the broker and persisted
+// by the Postgres writer, so that the tests assert on the stored messages and
+// on the messages published to the notification subjects.
+package testing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/consumers"
+	"github.com/MainfluxLabs/mainflux/consumers/writers/postgres"
+	adapter "github.com/MainfluxLabs/mainflux/http"
+	httpapi "github.com/MainfluxLabs/mainflux/http/api"
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	"github.com/MainfluxLabs/mainflux/readers"
+	readerspg "github.com/MainfluxLabs/mainflux/readers/postgres"
+	"github.com/jmoiron/sqlx"
+	opentracing "github.com/opentracing/opentracing-go"
+	dockertest "github.com/ory/dockertest/v3"
+)
+
+const (
+	writerID   = "harness-writer"
+	writerName = "postgres"
+	dbUser     = "test"
+	dbPass     = "test"
+	dbName     = "test"
+	pollPeriod = 50 * time.Millisecond
+)
+
+// ErrTimeout indicates that the expected messages didn't arrive in time.
+var ErrTimeout = errors.New("timed out waiting for messages")
+
+// Config contains the container images used by the harness.
+type Config struct {
+	NatsImage     string
+	NatsTag       string
+	PostgresImage string
+	PostgresTag   string
+}
+
+// DefaultConfig returns the images the service tests run against.
+func DefaultConfig() Config {
+	return Config{
+		NatsImage:     "nats",
+		NatsTag:       "1.3.0",
+		PostgresImage: "postgres",
+		PostgresTag:   "13.3-alpine",
+	}
+}
+
+// Harness runs the message pipeline. The things are registered using the
+// AddThing method, since the harness replaces the things service.
+type Harness struct {
+	Things *Things
+	PubSub messaging.PubSub
+	DB     *sqlx.DB
+
+	pool       *dockertest.Pool
+	containers []*dockertest.Resource
+	publisher  messaging.Publisher
+	adapter    *httptest.Server
+	reader     readers.MessageRepository
+	inboxes    []*Inbox
+}
+
+// Start starts the broker and database containers, and the HTTP adapter and
+// Postgres writer connected to them. The harness must be closed to remove the
+// containers.
+func Start(cfg Config) (*Harness, error) {
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &Harness{Things: NewThings(), pool: pool}
+	if err := h.start(cfg); err != nil {
+		h.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func (h *Harness) start(cfg Config) error {
+	logger, err := logger.New(os.Stdout, "error")
+	if err != nil {
+		return err
+	}
+
+	nc, err := h.run(cfg.NatsImage, cfg.NatsTag, nil)
+	if err != nil {
+		return err
+	}
+	brokerURL := fmt.Sprintf("nats://localhost:%s", nc.GetPort("4222/tcp"))
+
+	if err := h.pool.Retry(func() error {
+		h.publisher, err = brokers.NewPublisher(brokerURL)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if h.PubSub, err = brokers.NewPubSub(brokerURL, "", logger); err != nil {
+		return err
+	}
+
+	env := []string{
+		fmt.Sprintf("POSTGRES_USER=%s", dbUser),
+		fmt.Sprintf("POSTGRES_PASSWORD=%s", dbPass),
+		fmt.Sprintf("POSTGRES_DB=%s", dbName),
+	}
+	pc, err := h.run(cfg.PostgresImage, cfg.PostgresTag, env)
+	if err != nil {
+		return err
+	}
+
+	dbConfig := postgres.Config{
+		Host:    "localhost",
+		Port:    pc.GetPort("5432/tcp"),
+		User:    dbUser,
+		Pass:    dbPass,
+		Name:    dbName,
+		SSLMode: "disable",
+	}
+	if err := h.pool.Retry(func() error {
+		h.DB, err = postgres.Connect(dbConfig)
+		return err
+	}); err != nil {
+		return err
+	}
+	h.reader = readerspg.New(h.DB)
+
+	if err := consumers.StartWriter(writerID, writerName, h.PubSub, postgres.New(h.DB), nil, brokers.SubjectSenML, brokers.SubjectJSON); err != nil {
+		return err
+	}
+
+	svc := adapter.New(h.publisher, h.Things, auth.NewNetworkAuthorizer(nil), "")
+	h.adapter = httptest.NewServer(httpapi.MakeHandler(svc, opentracing.NoopTracer{}, logger))
+
+	return nil
+}
+
+func (h *Harness) run(image, tag string, env []string) (*dockertest.Resource, error) {
+	c, err := h.pool.Run(image, tag, env)
+	if err != nil {
+		return nil, err
+	}
+	h.containers = append(h.containers, c)
+
+	return c, nil
+}
+
+// AddThing registers the thing whose messages are published using its key.
+func (h *Harness) AddThing(th Thing) {
+	h.Things.Add(th)
+}
+
+// Publish publishes the message of the thing identified by the key through
+// the HTTP adapter. The subtopic parts are separated by the slashes.
+func (h *Harness) Publish(key, subtopic, contentType string, payload []byte) error {
+	url := fmt.Sprintf("%s/messages", h.adapter.URL)
+	if subtopic != "" {
+		url = fmt.Sprintf("%s/%s", url, strings.Trim(subtopic, "/"))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", apiutil.ThingPrefix+key)
+
+	res, err := h.adapter.Client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("unexpected publish response status: %s", res.Status)
+	}
+
+	return nil
+}
+
+// WaitMessages waits until at least n stored messages match the page metadata
+// and returns them. The format selects the SenML or JSON messages table.
+func (h *Harness) WaitMessages(pm readers.PageMetadata, n uint64, timeout time.Duration) (readers.MessagesPage, error) {
+	if pm.Limit == 0 {
+		pm.Limit = n
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		page, err := h.reader.ListAllMessages(context.Background(), pm)
+		if err != nil {
+			return readers.MessagesPage{}, err
+		}
+
+		if page.Total >= n {
+			return page, nil
+		}
+
+		if time.Now().After(deadline) {
+			return page, ErrTimeout
+		}
+		time.Sleep(pollPeriod)
+	}
+}
+
+// Subscribe returns the inbox collecting the messages published to the broker
+// subject, e.g. to the notification subjects.
+func (h *Harness) Subscribe(subject string) (*Inbox, error) {
+	in := newInbox(fmt.Sprintf("harness-inbox-%d", len(h.inboxes)), subject)
+	if err := h.PubSub.Subscribe(in.id, subject, in); err != nil {
+		return nil, err
+	}
+	h.inboxes = append(h.inboxes, in)
+
+	return in, nil
+}
+
+// Close stops the pipeline and removes the containers.
+func (h *Harness) Close() error {
+	if h.adapter != nil {
+		h.adapter.Close()
+	}
+
+	var errs []string
+	if h.PubSub != nil {
+		for _, in := range h.inboxes {
+			if err := h.PubSub.Unsubscribe(in.id, in.subject); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+
+		if err := h.PubSub.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if h.DB != nil {
+		if err := h.DB.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	for _, c := range h.containers {
+		if err := h.pool.Purge(c); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+//go:build !rabbitmq
+// +build !rabbitmq
+
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+var _ messaging.MessageHandler = (*Inbox)(nil)
+
+// Inbox collects the messages published to the broker subject.
+type Inbox struct {
+	id      string
+	subject string
+
+	mu       sync.Mutex
+	messages []protomfx.Message
+}
+
+func newInbox(id, subject string) *Inbox {
+	return &Inbox{id: id, subject: subject}
+}
+
+func (in *Inbox) Handle(msg protomfx.Message) error {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.messages = append(in.messages, msg)
+	return nil
+}
+
+func (in *Inbox) Cancel() error {
+	return nil
+}
+
+// Messages returns the messages received so far.
+func (in *Inbox) Messages() []protomfx.Message {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	return append([]protomfx.Message{}, in.messages...)
+}
+
+// Wait waits until at least n messages are received and returns them.
+func (in *Inbox) Wait(n int, timeout time.Duration) ([]protomfx.Message, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		msgs := in.Messages()
+		if len(msgs) >= n {
+			return msgs, nil
+		}
+
+		if time.Now().After(deadline) {
+			return msgs, ErrTimeout
+		}
+		time.Sleep(pollPeriod)
+	}
+}
@@ -0,0 +1,169 @@
+//go:build !rabbitmq
+// +build !rabbitmq
+
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package testing_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	mftesting "github.com/MainfluxLabs/mainflux/pkg/testing"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/readers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senmlContentType = "application/senml+json"
+	jsonContentType  = "application/json"
+	timeout          = 10 * time.Second
+	orgID            = "org"
+)
+
+var harness *mftesting.Harness
+
+func TestMain(m *testing.M) {
+	h, err := mftesting.Start(mftesting.DefaultConfig())
+	if err != nil {
+		log.Fatalf("Could not start pipeline: %s", err)
+	}
+	harness = h
+
+	code := m.Run()
+
+	if err := harness.Close(); err != nil {
+		log.Fatalf("Could not close pipeline: %s", err)
+	}
+
+	os.Exit(code)
+}
+
+func TestStoreSubtopicMessages(t *testing.T) {
+	th := mftesting.Thing{
+		ID:     "subtopic-thing",
+		Key:    "subtopic-key",
+		OrgID:  orgID,
+		Config: &protomfx.Config{ContentType: senmlContentType, Write: true},
+	}
+	harness.AddThing(th)
+
+	subtopics := []string{"", "room", "room/temperature", "building/room/temperature"}
+	for i, st := range subtopics {
+		payload := fmt.Sprintf(`[{"bn":"sensor:","n":"temperature","v":%d}]`, i)
+		err := harness.Publish(th.Key, st, senmlContentType, []byte(payload))
+		require.Nil(t, err, fmt.Sprintf("publish to subtopic %q: unexpected error: %s", st, err))
+	}
+
+	cases := []struct {
+		desc     string
+		subtopic string
+		value    float64
+	}{
+		{
+			desc:     "stored message without subtopic",
+			subtopic: "",
+			value:    0,
+		},
+		{
+			desc:     "stored message with single level subtopic",
+			subtopic: "room",
+			value:    1,
+		},
+		{
+			desc:     "stored message with two level subtopic",
+			subtopic: "room.temperature",
+			value:    2,
+		},
+		{
+			desc:     "stored message with three level subtopic",
+			subtopic: "building.room.temperature",
+			value:    3,
+		},
+	}
+
+	page, err := harness.WaitMessages(readers.PageMetadata{Publisher: th.ID}, uint64(len(subtopics)), timeout)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	for _, tc := range cases {
+		var found bool
+		for _, m := range page.Messages {
+			msg, ok := m.(senml.Message)
+			require.True(t, ok, fmt.Sprintf("%s: unexpected message type %T", tc.desc, m))
+			if msg.Subtopic == tc.subtopic && msg.Value != nil && *msg.Value == tc.value {
+				found = true
+			}
+		}
+		assert.True(t, found, fmt.Sprintf("%s: message with subtopic %q not found", tc.desc, tc.subtopic))
+	}
+}
+
+func TestStoreJSONMessages(t *testing.T) {
+	th := mftesting.Thing{
+		ID:     "json-thing",
+		Key:    "json-key",
+		OrgID:  orgID,
+		Config: &protomfx.Config{ContentType: jsonContentType, Write: true},
+	}
+	harness.AddThing(th)
+
+	err := harness.Publish(th.Key, "json/room", jsonContentType, []byte(`{"temperature":21.5}`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	pm := readers.PageMetadata{Publisher: th.ID, Subtopic: "json.room", Format: "json"}
+	page, err := harness.WaitMessages(pm, 1, timeout)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(1), page.Total, fmt.Sprintf("expected 1 stored message, got %d", page.Total))
+}
+
+func TestSkipUnwrittenMessages(t *testing.T) {
+	th := mftesting.Thing{
+		ID:     "unwritten-thing",
+		Key:    "unwritten-key",
+		OrgID:  orgID,
+		Config: &protomfx.Config{ContentType: senmlContentType, Write: false},
+	}
+	harness.AddThing(th)
+
+	err := harness.Publish(th.Key, "room", senmlContentType, []byte(`[{"n":"temperature","v":1}]`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	page, err := harness.WaitMessages(readers.PageMetadata{Publisher: th.ID}, 1, time.Second)
+	assert.Equal(t, mftesting.ErrTimeout, err, fmt.Sprintf("expected error %s got %s", mftesting.ErrTimeout, err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected no stored messages, got %d", page.Total))
+}
+
+func TestNotifyMessages(t *testing.T) {
+	inbox, err := harness.Subscribe(brokers.SubjectWebhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	th := mftesting.Thing{
+		ID:     "webhook-thing",
+		Key:    "webhook-key",
+		OrgID:  orgID,
+		Config: &protomfx.Config{ContentType: senmlContentType, WebhookID: "webhook"},
+	}
+	harness.AddThing(th)
+
+	err = harness.Publish(th.Key, "alerts/room", senmlContentType, []byte(`[{"n":"temperature","v":40}]`))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	msgs, err := inbox.Wait(1, timeout)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, th.ID, msgs[0].Publisher, fmt.Sprintf("expected publisher %s got %s", th.ID, msgs[0].Publisher))
+	assert.Equal(t, "alerts.room", msgs[0].Subtopic, fmt.Sprintf("expected subtopic alerts.room got %s", msgs[0].Subtopic))
+	assert.Equal(t, orgID, msgs[0].OrgID, fmt.Sprintf("expected org %s got %s", orgID, msgs[0].OrgID))
+}
+
+func TestPublishUnknownThing(t *testing.T) {
+	err := harness.Publish("unknown", "", senmlContentType, []byte(`[{"n":"temperature","v":1}]`))
+	assert.NotNil(t, err, "expected error publishing with unknown key")
+}
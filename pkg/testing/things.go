@@ -0,0 +1,63 @@
+//go:build !rabbitmq
+// +build !rabbitmq
+
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package testing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"google.golang.org/grpc"
+)
+
+// Thing represents the thing publishing the messages through the harness.
+// The config is the config of the thing's profile, which determines the
+// format of the messages and the subjects they are published to.
+type Thing struct {
+	ID     string
+	Key    string
+	OrgID  string
+	Config *protomfx.Config
+}
+
+var _ protomfx.ThingsServiceClient = (*Things)(nil)
+
+// Things is the things service client which authenticates the things
+// registered in the harness. The calls not used by the adapters aren't
+// implemented.
+type Things struct {
+	protomfx.ThingsServiceClient
+
+	mu     sync.RWMutex
+	things map[string]Thing
+}
+
+// NewThings returns the things service client without registered things.
+func NewThings() *Things {
+	return &Things{things: make(map[string]Thing)}
+}
+
+// Add registers the thing.
+func (ts *Things) Add(th Thing) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	ts.things[th.Key] = th
+}
+
+func (ts *Things) GetPubConfByKey(_ context.Context, in *protomfx.PubConfByKeyReq, _ ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+
+	th, ok := ts.things[in.GetKey()]
+	if !ok {
+		return nil, errors.ErrAuthentication
+	}
+
+	return &protomfx.PubConfByKeyRes{PublisherID: th.ID, OrgID: th.OrgID, ProfileConfig: th.Config}, nil
+}
@@ -22,6 +22,13 @@ var (
 	errInvalidNestedJSON = errors.New("invalid nested JSON object")
 )
 
+// TransformerName is the registered name of the JSON transformer.
+const TransformerName = "json"
+
+func init() {
+	transformers.Register(TransformerName, transformers.JSONFormat, New())
+}
+
 // TimeField represents the message fields to use as timestamp
 type TimeField struct {
 	Name     string `json:"name"`
@@ -0,0 +1,97 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package transformers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+const (
+	// SenMLFormat is the format of the transformers producing the SenML
+	// messages, which are published to the SenML subjects.
+	SenMLFormat = "senml"
+	// JSONFormat is the format of the transformers producing the JSON
+	// messages, which are published to the JSON subjects.
+	JSONFormat = "json"
+)
+
+// ErrUnknownTransformer indicates that no transformer is registered under
+// the name referenced by the profile config.
+var ErrUnknownTransformer = errors.New("unknown transformer")
+
+type entry struct {
+	format      string
+	transformer Transformer
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]entry)
+)
+
+// Register makes the transformer available under the provided name, so that
+// the profiles can reference it in their config. The format is the format of
+// the transformed messages, which determines the subject the messages are
+// published to. The built-in transformers register themselves when their
+// packages are imported, while the custom ones are registered by the
+// packages imported by the services using them. Register panics if the
+// transformer is nil or if the name is already registered.
+func Register(name, format string, t Transformer) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if t == nil {
+		panic("transformers: register nil transformer " + name)
+	}
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("transformers: transformer %s registered twice", name))
+	}
+
+	registry[name] = entry{format: format, transformer: t}
+}
+
+// Get returns the transformer registered under the provided name.
+func Get(name string) (Transformer, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	e, ok := registry[name]
+	if !ok {
+		return nil, ErrUnknownTransformer
+	}
+
+	return e.transformer, nil
+}
+
+// Format returns the format of the transformer registered under the provided
+// name.
+func Format(name string) (string, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	e, ok := registry[name]
+	if !ok {
+		return "", ErrUnknownTransformer
+	}
+
+	return e.format, nil
+}
+
+// Names returns the sorted names of the registered transformers.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package transformers_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customName = "custom"
+
+type customTransformer struct{}
+
+func (customTransformer) Transform(msg protomfx.Message) (interface{}, error) {
+	return string(msg.Payload), nil
+}
+
+func init() {
+	transformers.Register(customName, transformers.JSONFormat, customTransformer{})
+}
+
+func TestGet(t *testing.T) {
+	cases := []struct {
+		desc string
+		name string
+		err  error
+	}{
+		{
+			desc: "get senml transformer",
+			name: senml.TransformerName,
+			err:  nil,
+		},
+		{
+			desc: "get cbor transformer",
+			name: senml.CBORTransformerName,
+			err:  nil,
+		},
+		{
+			desc: "get json transformer",
+			name: json.TransformerName,
+			err:  nil,
+		},
+		{
+			desc: "get custom transformer",
+			name: customName,
+			err:  nil,
+		},
+		{
+			desc: "get unknown transformer",
+			name: "unknown",
+			err:  transformers.ErrUnknownTransformer,
+		},
+		{
+			desc: "get transformer without name",
+			name: "",
+			err:  transformers.ErrUnknownTransformer,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := transformers.Get(tc.name)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		desc   string
+		name   string
+		format string
+		err    error
+	}{
+		{
+			desc:   "get format of senml transformer",
+			name:   senml.TransformerName,
+			format: transformers.SenMLFormat,
+			err:    nil,
+		},
+		{
+			desc:   "get format of cbor transformer",
+			name:   senml.CBORTransformerName,
+			format: transformers.SenMLFormat,
+			err:    nil,
+		},
+		{
+			desc:   "get format of json transformer",
+			name:   json.TransformerName,
+			format: transformers.JSONFormat,
+			err:    nil,
+		},
+		{
+			desc:   "get format of custom transformer",
+			name:   customName,
+			format: transformers.JSONFormat,
+			err:    nil,
+		},
+		{
+			desc:   "get format of unknown transformer",
+			name:   "unknown",
+			format: "",
+			err:    transformers.ErrUnknownTransformer,
+		},
+	}
+
+	for _, tc := range cases {
+		format, err := transformers.Format(tc.name)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		assert.Equal(t, tc.format, format, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.format, format))
+	}
+}
+
+func TestRegister(t *testing.T) {
+	assert.Panics(t, func() { transformers.Register(customName, transformers.JSONFormat, customTransformer{}) }, "registering transformer twice expected to panic")
+	assert.Panics(t, func() { transformers.Register("nil", transformers.JSONFormat, nil) }, "registering nil transformer expected to panic")
+
+	names := transformers.Names()
+	expected := []string{senml.CBORTransformerName, customName, json.TransformerName, senml.TransformerName}
+	assert.Equal(t, expected, names, fmt.Sprintf("expected %v got %v", expected, names))
+
+	tr, err := transformers.Get(customName)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	res, err := tr.Transform(protomfx.Message{Payload: []byte("payload")})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "payload", res, fmt.Sprintf("expected payload got %v", res))
+}
+
+func TestCBORTransformer(t *testing.T) {
+	// Following hex-encoded bytes correspond to the content of:
+	// [{-2: "base-name", -3: 100.0, -4: "base-unit", -1: 10, -5: 10.0, -6: 100.0, 0: "name", 1: "unit", 6: 300.0, 7: 150.0, 2: 42.0, 5: 10.0}]
+	cborBytes, err := hex.DecodeString("81ac2169626173652d6e616d6522fb40590000000000002369626173652d756e6974200a24fb402400000000000025fb405900000000000000646e616d650164756e697406fb4072c0000000000007fb4062c0000000000002fb404500000000000005fb4024000000000000")
+	require.Nil(t, err, "Decoding CBOR expected to succeed")
+
+	tr, err := transformers.Get(senml.CBORTransformerName)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	// The CBOR transformer decodes the payload regardless of the profile
+	// content type.
+	msg := protomfx.Message{
+		Publisher:     "publisher",
+		Payload:       cborBytes,
+		ProfileConfig: &protomfx.Config{ContentType: senml.JSON},
+	}
+	res, err := tr.Transform(msg)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	msgs, ok := res.([]senml.Message)
+	require.True(t, ok, fmt.Sprintf("expected SenML messages got %T", res))
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "base-namename", msgs[0].Name, fmt.Sprintf("expected base-namename got %s", msgs[0].Name))
+}
@@ -15,6 +15,13 @@ const (
 	JSON = "application/senml+json"
 	// CBOR represents SenML in CBOR format content type.
 	CBOR = "application/senml+cbor"
+
+	// TransformerName is the registered name of the SenML transformer, which
+	// decodes the payload in the format of the profile content type.
+	TransformerName = "senml"
+	// CBORTransformerName is the registered name of the SenML transformer
+	// which decodes the payload in the CBOR format.
+	CBORTransformerName = "cbor"
 )
 
 var (
@@ -27,7 +34,14 @@ var formats = map[string]senml.Format{
 	CBOR: senml.CBOR,
 }
 
+func init() {
+	transformers.Register(TransformerName, transformers.SenMLFormat, New())
+	transformers.Register(CBORTransformerName, transformers.SenMLFormat, NewCBOR())
+}
+
 type transformer struct {
+	// format is the payload format, or zero if the format is taken from the
+	// profile content type.
 	format senml.Format
 }
 
@@ -36,11 +50,20 @@ func New() transformers.Transformer {
 	return transformer{}
 }
 
+// NewCBOR returns transformer service implementation for SenML messages
+// encoded in CBOR, regardless of the profile content type.
+func NewCBOR() transformers.Transformer {
+	return transformer{format: senml.CBOR}
+}
+
 func (t transformer) Transform(msg protomfx.Message) (interface{}, error) {
-	contentFormat := msg.ProfileConfig.ContentType
-	format, ok := formats[contentFormat]
-	if !ok {
-		format = formats[JSON]
+	format := t.format
+	if format == 0 {
+		f, ok := formats[msg.GetProfileConfig().GetContentType()]
+		if !ok {
+			f = formats[JSON]
+		}
+		format = f
 	}
 
 	raw, err := senml.Decode(msg.Payload, format)
@@ -53,6 +76,7 @@ func (t transformer) Transform(msg protomfx.Message) (interface{}, error) {
 		return nil, errors.Wrap(errNormalize, err)
 	}
 
+	targets := msg.GetProfileConfig().GetTransformer().GetUnits()
 	msgs := make([]Message, len(normalized.Records))
 	for i, v := range normalized.Records {
 		// Use reception timestamp if SenML message Time is missing
@@ -76,6 +100,7 @@ func (t transformer) Transform(msg protomfx.Message) (interface{}, error) {
 			StringValue: v.StringValue,
 			Sum:         v.Sum,
 		}
+		normalize(&msgs[i], targets)
 	}
 
 	return msgs, nil
@@ -151,3 +151,83 @@ func TestTransformCBOR(t *testing.T) {
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s expected %s, got %s", tc.desc, tc.err, err))
 	}
 }
+
+func TestTransformUnits(t *testing.T) {
+	tr := senml.New()
+	units := map[string]string{"degF": "Cel", "psi": "kPa", "Wh": "kWh", "Cel": "kPa"}
+
+	cases := []struct {
+		desc    string
+		payload string
+		units   map[string]string
+		unit    string
+		value   float64
+		sum     *float64
+	}{
+		{
+			desc:    "transform fahrenheit to celsius",
+			payload: `[{"n":"temperature","u":"degF","v":212,"t":1}]`,
+			units:   units,
+			unit:    "Cel",
+			value:   100,
+		},
+		{
+			desc:    "transform psi to kilopascals",
+			payload: `[{"n":"pressure","u":"psi","v":10,"t":1}]`,
+			units:   units,
+			unit:    "kPa",
+			value:   68.94757293168,
+		},
+		{
+			desc:    "transform value and sum of watt hours to kilowatt hours",
+			payload: `[{"n":"energy","u":"Wh","v":1500,"s":2500,"t":1}]`,
+			units:   units,
+			unit:    "kWh",
+			value:   1.5,
+			sum:     func() *float64 { s := 2.5; return &s }(),
+		},
+		{
+			desc:    "transform unit without conversion",
+			payload: `[{"n":"humidity","u":"%RH","v":40,"t":1}]`,
+			units:   units,
+			unit:    "%RH",
+			value:   40,
+		},
+		{
+			desc:    "transform unit with conversion to other dimension",
+			payload: `[{"n":"temperature","u":"Cel","v":21,"t":1}]`,
+			units:   units,
+			unit:    "Cel",
+			value:   21,
+		},
+		{
+			desc:    "transform without units config",
+			payload: `[{"n":"temperature","u":"degF","v":212,"t":1}]`,
+			units:   nil,
+			unit:    "degF",
+			value:   212,
+		},
+	}
+
+	for _, tc := range cases {
+		msg := protomfx.Message{
+			Publisher: "publisher",
+			Payload:   []byte(tc.payload),
+			ProfileConfig: &protomfx.Config{
+				ContentType: senml.JSON,
+				Transformer: &protomfx.Transformer{Units: tc.units},
+			},
+		}
+
+		res, err := tr.Transform(msg)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		msgs := res.([]senml.Message)
+		require.Len(t, msgs, 1, fmt.Sprintf("%s: expected 1 message got %d", tc.desc, len(msgs)))
+
+		assert.Equal(t, tc.unit, msgs[0].Unit, fmt.Sprintf("%s: expected unit %s got %s", tc.desc, tc.unit, msgs[0].Unit))
+		assert.InDelta(t, tc.value, *msgs[0].Value, 1e-9, fmt.Sprintf("%s: expected value %v got %v", tc.desc, tc.value, *msgs[0].Value))
+		if tc.sum != nil {
+			assert.InDelta(t, *tc.sum, *msgs[0].Sum, 1e-9, fmt.Sprintf("%s: expected sum %v got %v", tc.desc, *tc.sum, *msgs[0].Sum))
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package senml
+
+const (
+	temperature = "temperature"
+	pressure    = "pressure"
+	length      = "length"
+	speed       = "speed"
+	energy      = "energy"
+	volume      = "volume"
+	mass        = "mass"
+)
+
+// unit converts the values of the unit to the base unit of its dimension,
+// by multiplying them by the scale and adding the offset.
+type unit struct {
+	dimension string
+	scale     float64
+	offset    float64
+}
+
+// units contains the supported units, named as in the SenML units registry
+// where the unit is registered there.
+var units = map[string]unit{
+	"K":    {dimension: temperature, scale: 1},
+	"Cel":  {dimension: temperature, scale: 1, offset: 273.15},
+	"degF": {dimension: temperature, scale: 5.0 / 9, offset: 459.67 * 5 / 9},
+
+	"Pa":   {dimension: pressure, scale: 1},
+	"hPa":  {dimension: pressure, scale: 100},
+	"kPa":  {dimension: pressure, scale: 1000},
+	"bar":  {dimension: pressure, scale: 100000},
+	"mbar": {dimension: pressure, scale: 100},
+	"psi":  {dimension: pressure, scale: 6894.757293168},
+
+	"m":  {dimension: length, scale: 1},
+	"mm": {dimension: length, scale: 0.001},
+	"cm": {dimension: length, scale: 0.01},
+	"km": {dimension: length, scale: 1000},
+	"in": {dimension: length, scale: 0.0254},
+	"ft": {dimension: length, scale: 0.3048},
+	"mi": {dimension: length, scale: 1609.344},
+
+	"m/s":  {dimension: speed, scale: 1},
+	"km/h": {dimension: speed, scale: 1 / 3.6},
+	"mph":  {dimension: speed, scale: 0.44704},
+
+	"J":   {dimension: energy, scale: 1},
+	"Wh":  {dimension: energy, scale: 3600},
+	"kWh": {dimension: energy, scale: 3600000},
+
+	"m3":  {dimension: volume, scale: 1},
+	"l":   {dimension: volume, scale: 0.001},
+	"gal": {dimension: volume, scale: 0.003785411784},
+
+	"kg": {dimension: mass, scale: 1},
+	"g":  {dimension: mass, scale: 0.001},
+	"lb": {dimension: mass, scale: 0.45359237},
+}
+
+// CanConvert returns true if the values of the unit can be converted to the
+// target unit.
+func CanConvert(from, to string) bool {
+	f, ok := units[from]
+	if !ok {
+		return false
+	}
+
+	t, ok := units[to]
+	if !ok {
+		return false
+	}
+
+	return f.dimension == t.dimension
+}
+
+// normalize converts the value and the sum of the record to the target unit
+// of the record unit, if the profile config maps the unit to one. The sum is
+// scaled only, since it accumulates the value differences.
+func normalize(msg *Message, targets map[string]string) {
+	to, ok := targets[msg.Unit]
+	if !ok || !CanConvert(msg.Unit, to) {
+		return
+	}
+
+	f, t := units[msg.Unit], units[to]
+	if msg.Value != nil {
+		v := ((*msg.Value*f.scale + f.offset) - t.offset) / t.scale
+		msg.Value = &v
+	}
+	if msg.Sum != nil {
+		s := *msg.Sum * f.scale / t.scale
+		msg.Sum = &s
+	}
+	msg.Unit = to
+}
@@ -8,14 +8,20 @@ import (
 	"context"
 	"encoding/csv"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/readers"
 	"github.com/go-kit/kit/endpoint"
 )
 
 var header = []string{
-	"profile",
 	"subtopic",
 	"publisher",
 	"protocol",
@@ -30,40 +36,62 @@ var header = []string{
 	"update_time",
 }
 
-func listAllMessagesEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
+func listAllMessagesEndpoint(repos repositories) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(listAllMessagesReq)
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
 
-		var page readers.MessagesPage
-		switch {
-		case req.key != "":
-			pc, err := getPubConfByKey(ctx, req.key)
-			if err != nil {
-				return nil, err
-			}
-			req.pageMeta.Publisher = pc.PublisherID
+		return listMessages(ctx, repos, req)
+	}
+}
 
-			p, err := svc.ListAllMessages(req.pageMeta)
-			if err != nil {
-				return nil, err
-			}
+func listQueryMessagesEndpoint(repos repositories, queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listQueryMessagesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
 
-			page = p
-		default:
-			// Check if user is authorized to read all messages
-			if err := isAdmin(ctx, req.token); err != nil {
-				return nil, err
-			}
+		// The messages are read using the user token only by the root admin,
+		// who reads them using the saved queries of all the users.
+		if err := isAdmin(ctx, req.messagesReq.token); err != nil {
+			return nil, err
+		}
 
-			p, err := svc.ListAllMessages(req.pageMeta)
-			if err != nil {
-				return nil, err
-			}
+		sq, err := queries.RetrieveByID(ctx, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		mreq := req.messagesReq
+		mreq.pageMeta = mergeQuery(sq.Filters, mreq.pageMeta, req.params)
+		if sq.OrgID != "" {
+			mreq.orgID = sq.OrgID
+		}
+		if err := mreq.validate(); err != nil {
+			return nil, err
+		}
+
+		return listMessages(ctx, repos, mreq)
+	}
+}
+
+func listMessages(ctx context.Context, repos repositories, req listAllMessagesReq) (interface{}, error) {
+	repo, pm, err := messagesRepository(ctx, repos, req)
+	if err != nil {
+		return nil, err
+	}
 
-			page = p
+	if req.stream {
+		return streamMessagesRes{repo: repo, pageMeta: pm}, nil
+	}
+
+	if pm.Format == readers.AllFormats {
+		page, err := readers.ListAllFormats(ctx, repo, pm)
+		if err != nil {
+			return nil, err
 		}
 
 		return listMessagesRes{
@@ -72,9 +100,62 @@ func listAllMessagesEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
 			Messages:     page.Messages,
 		}, nil
 	}
+
+	page, err := repo.ListAllMessages(ctx, pm)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repositories read only the columns of the selected fields, while
+	// the messages are trimmed to the selected fields here, since the
+	// repositories always read the message time for the page cursor.
+	msgs := make([]readers.Message, 0, len(page.Messages))
+	for _, msg := range page.Messages {
+		msgs = append(msgs, readers.SelectFields(msg, pm.Fields))
+	}
+
+	return listMessagesRes{
+		PageMetadata: page.PageMetadata,
+		Total:        page.Total,
+		Messages:     msgs,
+		NextCursor:   page.NextCursor,
+	}, nil
+}
+
+// messagesRepository authorizes the request and returns the repository of
+// the requested messages, along with the page metadata limited to the
+// messages of the publisher if the request is made using the thing key or
+// the share key.
+func messagesRepository(ctx context.Context, repos repositories, req listAllMessagesReq) (readers.MessageRepository, readers.PageMetadata, error) {
+	pm := req.pageMeta
+	switch {
+	case req.key != "":
+		pc, err := getPubConfByKey(ctx, req.key)
+		if err != nil {
+			return nil, readers.PageMetadata{}, err
+		}
+		pm.Publisher = pc.PublisherID
+
+		return repos.org(pc.GetOrgID()), pm, nil
+	case req.shareKey != "":
+		pc, err := getPubConfByShare(ctx, req.shareKey, req.password)
+		if err != nil {
+			return nil, readers.PageMetadata{}, err
+		}
+		pm.Publisher = pc.PublisherID
+
+		return repos.org(pc.GetOrgID()), pm, nil
+	default:
+		// Check if user is authorized to read all messages
+		if err := isAdmin(ctx, req.token); err != nil {
+			return nil, readers.PageMetadata{}, err
+		}
+
+		return repos.org(req.orgID), pm, nil
+	}
 }
 
-func backupEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
+func backupEndpoint(repos repositories) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(listAllMessagesReq)
 		if err := req.validate(); err != nil {
@@ -85,7 +166,10 @@ func backupEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
 			return nil, err
 		}
 
-		page, err := svc.Backup(req.pageMeta)
+		// The backup holds the whole messages, so that they can be restored.
+		pm := req.pageMeta
+		pm.Fields = nil
+		page, err := repos.org(req.orgID).Backup(ctx, pm)
 		if err != nil {
 			return nil, err
 		}
@@ -99,7 +183,7 @@ func backupEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
 	}
 }
 
-func restoreEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
+func restoreEndpoint(repos repositories) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(restoreMessagesReq)
 		if err := req.validate(); err != nil {
@@ -111,7 +195,7 @@ func restoreEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
 			return nil, err
 		}
 
-		if err := svc.Restore(ctx, req.Messages...); err != nil {
+		if err := repos.org(req.orgID).Restore(ctx, req.Messages...); err != nil {
 			return nil, err
 		}
 
@@ -119,6 +203,352 @@ func restoreEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
 	}
 }
 
+func replayEndpoint(repos repositories, jobs *replays, pub messaging.Publisher, idp uuid.IDProvider, logger logger.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(replayMessagesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := isAdmin(ctx, req.token); err != nil {
+			return nil, err
+		}
+
+		repo := repos.org(req.orgID)
+		pm := req.pageMeta
+		pm.Limit = 1
+		page, err := repo.ListAllMessages(ctx, pm)
+		if err != nil {
+			return nil, err
+		}
+
+		id, err := idp.ID()
+		if err != nil {
+			return nil, err
+		}
+
+		// Messages are replayed in the background, since keeping the original
+		// delays between them may take as long as the replayed time range.
+		jobCtx := jobs.start(id)
+		go func() {
+			defer jobs.done(id)
+			replay(jobCtx, repo, req.pageMeta, pub, req.Subject, req.Speed, logger)
+		}()
+
+		return replayMessagesRes{ID: id, Total: page.Total}, nil
+	}
+}
+
+func cancelReplayEndpoint(jobs *replays) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(replayReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := isAdmin(ctx, req.token); err != nil {
+			return nil, err
+		}
+
+		if err := jobs.cancel(req.id); err != nil {
+			return nil, err
+		}
+
+		return cancelReplayRes{}, nil
+	}
+}
+
+func importEndpoint(repos repositories, logger logger.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importMessagesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := isAdmin(ctx, req.token); err != nil {
+			return nil, err
+		}
+
+		rows, err := req.rows()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 {
+			return nil, apiutil.ErrEmptyList
+		}
+
+		// The messages imported before the failure are kept, so the
+		// partial report is returned along with the failure.
+		res, err := importMessages(ctx, repos.org(req.orgID), rows)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("Imported %d of %d messages before the failure: %s", res.Imported, res.Total, err))
+			res.Error = err.Error()
+		}
+
+		return res, nil
+	}
+}
+
+func createQueryEndpoint(queries readers.QueryRepository, idp uuid.IDProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createQueryReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ownerID, err := identify(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.OrgID != "" {
+			if err := isOrgMember(ctx, req.token, req.OrgID); err != nil {
+				return nil, err
+			}
+		}
+
+		id, err := idp.ID()
+		if err != nil {
+			return nil, err
+		}
+
+		sq := readers.SavedQuery{ID: id, OwnerID: ownerID, OrgID: req.OrgID, Name: req.Name, Filters: req.Filters}
+		if err := queries.Save(ctx, sq); err != nil {
+			return nil, err
+		}
+
+		return createQueryRes{ID: id}, nil
+	}
+}
+
+func viewQueryEndpoint(queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(queryReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ownerID, err := identify(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		sq, err := queries.RetrieveByID(ctx, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		// The saved queries of the other users are hidden from the user.
+		if sq.OwnerID != ownerID {
+			return nil, errors.ErrNotFound
+		}
+
+		return buildQueryRes(sq), nil
+	}
+}
+
+func listQueriesEndpoint(queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listQueriesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ownerID, err := identify(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := queries.RetrieveAll(ctx, ownerID, req.offset, req.limit)
+		if err != nil {
+			return nil, err
+		}
+
+		res := queriesPageRes{
+			Total:   page.Total,
+			Offset:  page.Offset,
+			Limit:   page.Limit,
+			Queries: []queryRes{},
+		}
+		for _, sq := range page.Queries {
+			res.Queries = append(res.Queries, buildQueryRes(sq))
+		}
+
+		return res, nil
+	}
+}
+
+func updateQueryEndpoint(queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateQueryReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ownerID, err := identify(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		sq := readers.SavedQuery{ID: req.id, OwnerID: ownerID, Name: req.Name, Filters: req.Filters}
+		if err := queries.Update(ctx, sq); err != nil {
+			return nil, err
+		}
+
+		return updateQueryRes{}, nil
+	}
+}
+
+func removeQueryEndpoint(queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(queryReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ownerID, err := identify(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := queries.Remove(ctx, ownerID, req.id); err != nil {
+			return nil, err
+		}
+
+		return removeQueryRes{}, nil
+	}
+}
+
+func grafanaTestEndpoint(repos repositories) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listAllMessagesReq)
+		if req.token == "" && req.key == "" {
+			return nil, apiutil.ErrBearerToken
+		}
+
+		if _, _, err := messagesRepository(ctx, repos, req); err != nil {
+			return nil, err
+		}
+
+		return grafanaTestRes{}, nil
+	}
+}
+
+func grafanaSearchEndpoint(queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(grafanaSearchReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := isAdmin(ctx, req.token); err != nil {
+			return nil, err
+		}
+
+		filters, err := savedQueryFilters(ctx, queries, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		names := []string{}
+		for name := range filters {
+			if strings.HasPrefix(name, req.Target) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		return names, nil
+	}
+}
+
+func grafanaQueryEndpoint(repos repositories, queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(grafanaQueryReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		mreq := req.messagesReq
+		mreq.pageMeta = readers.PageMetadata{
+			From:  unixTime(req.Range.From),
+			To:    unixTime(req.Range.To),
+			Limit: maxLimitSize,
+		}
+		if req.MaxDataPoints > 0 && req.MaxDataPoints < maxLimitSize {
+			mreq.pageMeta.Limit = req.MaxDataPoints
+		}
+
+		repo, pm, err := messagesRepository(ctx, repos, mreq)
+		if err != nil {
+			return nil, err
+		}
+
+		saved, err := savedQueryFilters(ctx, queries, mreq.token)
+		if err != nil {
+			return nil, err
+		}
+
+		res := []grafanaSeriesRes{}
+		for _, t := range req.Targets {
+			page, err := repo.ListAllMessages(ctx, grafanaPageMetadata(t.Target, saved, pm))
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, grafanaSeriesRes{
+				Target:     t.Target,
+				Datapoints: grafanaDatapoints(page.Messages),
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func grafanaAnnotationsEndpoint(repos repositories, queries readers.QueryRepository) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(grafanaAnnotationsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		mreq := req.messagesReq
+		mreq.pageMeta = readers.PageMetadata{
+			From:  unixTime(req.Range.From),
+			To:    unixTime(req.Range.To),
+			Limit: maxLimitSize,
+		}
+
+		repo, pm, err := messagesRepository(ctx, repos, mreq)
+		if err != nil {
+			return nil, err
+		}
+
+		saved, err := savedQueryFilters(ctx, queries, mreq.token)
+		if err != nil {
+			return nil, err
+		}
+
+		page, err := repo.ListAllMessages(ctx, grafanaPageMetadata(req.Annotation.Query, saved, pm))
+		if err != nil {
+			return nil, err
+		}
+
+		return grafanaAnnotations(req.Annotation, page.Messages), nil
+	}
+}
+
+func buildQueryRes(sq readers.SavedQuery) queryRes {
+	return queryRes{
+		ID:      sq.ID,
+		OrgID:   sq.OrgID,
+		Name:    sq.Name,
+		Filters: sq.Filters,
+	}
+}
+
 func generateCSV(page readers.MessagesPage) ([]byte, error) {
 	var buf bytes.Buffer
 	writer := csv.NewWriter(&buf)
@@ -182,3 +612,18 @@ func getValue(ptr interface{}, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// repositories holds the message repositories of the orgs whose messages are
+// stored apart from the messages of the other orgs.
+type repositories struct {
+	def  readers.MessageRepository
+	orgs map[string]readers.MessageRepository
+}
+
+func (r repositories) org(orgID string) readers.MessageRepository {
+	if repo, ok := r.orgs[orgID]; ok {
+		return repo
+	}
+
+	return r.def
+}
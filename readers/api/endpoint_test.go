@@ -7,13 +7,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -41,6 +47,8 @@ const (
 	msgName       = "temperature"
 	validPass     = "password"
 	adminID       = "1"
+	contentType   = "application/json"
+	shareKey      = "share_key"
 )
 
 var (
@@ -57,9 +65,9 @@ var (
 	usersList = []users.User{user, admin}
 )
 
-func newServer(repo readers.MessageRepository, tc protomfx.ThingsServiceClient, ac protomfx.AuthServiceClient) *httptest.Server {
+func newServer(repo readers.MessageRepository, tc protomfx.ThingsServiceClient, ac protomfx.AuthServiceClient, pub messaging.Publisher) *httptest.Server {
 	logger := logger.NewMock()
-	mux := api.MakeHandler(repo, tc, ac, svcName, logger)
+	mux := api.MakeHandler(repo, nil, rmocks.NewQueryRepository(), tc, ac, pub, idProvider, svcName, logger)
 
 	id, _ := idProvider.ID()
 	user.ID = id
@@ -68,24 +76,37 @@ func newServer(repo readers.MessageRepository, tc protomfx.ThingsServiceClient,
 }
 
 type testRequest struct {
-	client *http.Client
-	method string
-	url    string
-	token  string
-	key    string
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	key         string
+	accept      string
+	password    string
+	body        io.Reader
 }
 
 func (tr testRequest) make() (*http.Response, error) {
-	req, err := http.NewRequest(tr.method, tr.url, nil)
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
 	if err != nil {
 		return nil, err
 	}
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
 	if tr.token != "" {
 		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
 	}
 	if tr.key != "" {
 		req.Header.Set("Authorization", apiutil.ThingPrefix+tr.key)
 	}
+	if tr.accept != "" {
+		req.Header.Set("Accept", tr.accept)
+	}
+	if tr.password != "" {
+		req.Header.Set("X-Share-Password", tr.password)
+	}
 
 	return tr.client.Do(req)
 }
@@ -152,7 +173,7 @@ func TestListAllMessages(t *testing.T) {
 	adminToken := adminTok.GetValue()
 
 	repo := rmocks.NewMessageRepository("", fromSenml(messages))
-	ts := newServer(repo, thSvc, authSvc)
+	ts := newServer(repo, thSvc, authSvc, mocks.NewPublisher())
 	defer ts.Close()
 
 	cases := []struct {
@@ -285,6 +306,26 @@ func TestListAllMessages(t *testing.T) {
 				Messages: messages[0:10],
 			},
 		},
+		{
+			desc:   "read page with publisher",
+			url:    fmt.Sprintf("%s/messages?publisher=%s", ts.URL, pubID),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(messages)),
+				Messages: messages[0:10],
+			},
+		},
+		{
+			desc:   "read page with unknown publisher",
+			url:    fmt.Sprintf("%s/messages?publisher=%s", ts.URL, invalid),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    0,
+				Messages: []senml.Message{},
+			},
+		},
 		{
 			desc:   "read page with subtopic",
 			url:    fmt.Sprintf("%s/messages?subtopic=%s&protocol=%s", ts.URL, subtopic, httpProt),
@@ -397,6 +438,40 @@ func TestListAllMessages(t *testing.T) {
 			token:  adminToken,
 			status: http.StatusBadRequest,
 		},
+		{
+			desc:   "read page with value range",
+			url:    fmt.Sprintf("%s/messages?vgt=%f&vlt=%f", ts.URL, v-1, v+1),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(valueMsgs)),
+				Messages: valueMsgs[0:10],
+			},
+		},
+		{
+			desc:   "read page with value out of range",
+			url:    fmt.Sprintf("%s/messages?vgt=%f&vlt=%f", ts.URL, v, v+1),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Messages: []senml.Message{},
+			},
+		},
+		{
+			desc:   "read page with non-float value range",
+			url:    fmt.Sprintf("%s/messages?vgt=ab01", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with false boolean value",
+			url:    fmt.Sprintf("%s/messages?vb=false", ts.URL),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Messages: []senml.Message{},
+			},
+		},
 		{
 			desc:   "read page with boolean value",
 			url:    fmt.Sprintf("%s/messages?vb=true", ts.URL),
@@ -456,6 +531,72 @@ func TestListAllMessages(t *testing.T) {
 				Messages: messages[5:15],
 			},
 		},
+		{
+			desc:   "read page aggregated by interval",
+			url:    fmt.Sprintf("%s/messages?interval=day&agg=sum&timezone=Europe/Belgrade&limit=-1", ts.URL),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(messages)),
+				Messages: messages,
+			},
+		},
+		{
+			desc:   "read page aggregated by invalid interval",
+			url:    fmt.Sprintf("%s/messages?interval=fortnight", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page aggregated by invalid aggregation",
+			url:    fmt.Sprintf("%s/messages?interval=day&agg=median", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page aggregated in invalid timezone",
+			url:    fmt.Sprintf("%s/messages?interval=day&timezone=Mars/Olympus", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page aggregated in json format",
+			url:    fmt.Sprintf("%s/messages?interval=day&format=json", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with selected fields",
+			url:    fmt.Sprintf("%s/messages?fields=time,value&limit=-1", ts.URL),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(messages)),
+				Messages: timeValues(messages),
+			},
+		},
+		{
+			desc:   "read page with selected field without time",
+			url:    fmt.Sprintf("%s/messages?fields=publisher&limit=-1", ts.URL),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(messages)),
+				Messages: publishers(messages),
+			},
+		},
+		{
+			desc:   "read page with invalid field",
+			url:    fmt.Sprintf("%s/messages?fields=time,invalid", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read json page with senml field",
+			url:    fmt.Sprintf("%s/messages?format=json&fields=value", ts.URL),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range cases {
@@ -479,16 +620,1710 @@ func TestListAllMessages(t *testing.T) {
 	}
 }
 
-type pageRes struct {
-	readers.PageMetadata
-	Total    uint64          `json:"total"`
-	Messages []senml.Message `json:"messages,omitempty"`
+func TestListSharedMessages(t *testing.T) {
+	pubID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	otherID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now().Unix()
+
+	var messages []senml.Message
+	var sharedMsgs []senml.Message
+	for i := 0; i < numOfMessages; i++ {
+		msg := senml.Message{
+			Publisher: otherID,
+			Protocol:  mqttProt,
+			Time:      float64(now - int64(i)),
+			Name:      "name",
+			Value:     &v,
+		}
+		if i%2 == 0 {
+			msg.Publisher = pubID
+			sharedMsgs = append(sharedMsgs, msg)
+		}
+
+		messages = append(messages, msg)
+	}
+
+	thSvc := thmocks.NewThingsServiceClient(nil, map[string]string{shareKey: pubID}, nil)
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, newAuthService(), mocks.NewPublisher())
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		url      string
+		password string
+		status   int
+		res      pageRes
+	}{
+		{
+			desc:   "read shared messages page",
+			url:    fmt.Sprintf("%s/messages?share=%s&limit=-1", ts.URL, shareKey),
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(sharedMsgs)),
+				Messages: sharedMsgs,
+			},
+		},
+		{
+			desc:     "read shared messages page with password",
+			url:      fmt.Sprintf("%s/messages?share=%s&offset=0&limit=10", ts.URL, shareKey),
+			password: validPass,
+			status:   http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(sharedMsgs)),
+				Messages: sharedMsgs[0:10],
+			},
+		},
+		{
+			desc:   "read shared messages page filtered by other publisher",
+			url:    fmt.Sprintf("%s/messages?share=%s&publisher=%s&limit=-1", ts.URL, shareKey, otherID),
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(sharedMsgs)),
+				Messages: sharedMsgs,
+			},
+		},
+		{
+			desc:   "read shared messages page with invalid share key",
+			url:    fmt.Sprintf("%s/messages?share=%s", ts.URL, invalid),
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "read shared messages page with multiple share keys",
+			url:    fmt.Sprintf("%s/messages?share=%s&share=%s", ts.URL, shareKey, shareKey),
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:   ts.Client(),
+			method:   http.MethodGet,
+			url:      tc.url,
+			password: tc.password,
+		}
+
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+
+		var page pageRes
+		json.NewDecoder(res.Body).Decode(&page)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res.Total, page.Total, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.res.Total, page.Total))
+		assert.ElementsMatch(t, tc.res.Messages, page.Messages, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Messages, page.Messages))
+	}
 }
 
-func fromSenml(in []senml.Message) []readers.Message {
-	var ret []readers.Message
-	for _, m := range in {
-		ret = append(ret, m)
+func TestListOrgMessages(t *testing.T) {
+	orgID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now().Unix()
+
+	var messages []senml.Message
+	var orgMessages []senml.Message
+	for i := 0; i < numOfMessages; i++ {
+		msg := senml.Message{
+			Protocol: mqttProt,
+			Time:     float64(now - int64(i)),
+			Name:     "name",
+			Value:    &v,
+		}
+
+		if i%2 == 0 {
+			orgMessages = append(orgMessages, msg)
+			continue
+		}
+		messages = append(messages, msg)
 	}
-	return ret
+
+	thSvc := thmocks.NewThingsServiceClient(map[string]string{userEmail: ""}, nil, nil)
+	authSvc := newAuthService()
+
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+	adminToken := adminTok.GetValue()
+
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	orgRepos := map[string]readers.MessageRepository{
+		orgID: rmocks.NewMessageRepository("", fromSenml(orgMessages)),
+	}
+	ts := httptest.NewServer(api.MakeHandler(repo, orgRepos, rmocks.NewQueryRepository(), thSvc, authSvc, mocks.NewPublisher(), idProvider, svcName, logger.NewMock()))
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		url    string
+		status int
+		res    pageRes
+	}{
+		{
+			desc:   "read messages of org with repository",
+			url:    fmt.Sprintf("%s/messages?limit=-1&org_id=%s", ts.URL, orgID),
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(orgMessages)),
+				Messages: orgMessages,
+			},
+		},
+		{
+			desc:   "read messages of org without repository",
+			url:    fmt.Sprintf("%s/messages?limit=-1&org_id=%s", ts.URL, invalid),
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(messages)),
+				Messages: messages,
+			},
+		},
+		{
+			desc:   "read messages without org",
+			url:    fmt.Sprintf("%s/messages?limit=-1", ts.URL),
+			status: http.StatusOK,
+			res: pageRes{
+				Total:    uint64(len(messages)),
+				Messages: messages,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  adminToken,
+		}
+
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+
+		var page pageRes
+		err = json.NewDecoder(res.Body).Decode(&page)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res.Total, page.Total, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.res.Total, page.Total))
+		assert.ElementsMatch(t, tc.res.Messages, page.Messages, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Messages, page.Messages))
+	}
+}
+
+type publisherMock struct {
+	mu       sync.Mutex
+	messages []protomfx.Message
+}
+
+func (pub *publisherMock) Publish(msg protomfx.Message) error {
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+
+	pub.messages = append(pub.messages, msg)
+	return nil
+}
+
+func (pub *publisherMock) Close() error {
+	return nil
+}
+
+func (pub *publisherMock) published() []protomfx.Message {
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+
+	return append([]protomfx.Message{}, pub.messages...)
+}
+
+func TestReplay(t *testing.T) {
+	now := time.Now().Unix()
+	from := now - 100
+
+	var messages []senml.Message
+	for i := 0; i < 10; i++ {
+		messages = append(messages, senml.Message{
+			Publisher: thingToken,
+			Subtopic:  subtopic,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Value:     &v,
+			Time:      float64(now - int64(i)),
+		})
+	}
+	// Messages outside of the replayed range.
+	messages = append(messages, senml.Message{Publisher: thingToken, Protocol: mqttProt, Name: msgName, Value: &v, Time: float64(from - 1)})
+
+	thSvc := thmocks.NewThingsServiceClient(nil, nil, nil)
+	authSvc := newAuthService()
+
+	tok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: user.ID, Email: user.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	userToken := tok.GetValue()
+	adminToken := adminTok.GetValue()
+
+	pub := &publisherMock{}
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, pub)
+	defer ts.Close()
+
+	rangeURL := fmt.Sprintf("%s/replay?from=%d&to=%d", ts.URL, from, now+1)
+
+	cases := []struct {
+		desc        string
+		url         string
+		contentType string
+		token       string
+		body        string
+		status      int
+		total       uint64
+	}{
+		{
+			desc:        "replay messages as admin",
+			url:         rangeURL,
+			contentType: contentType,
+			token:       adminToken,
+			body:        `{"subject":"senml.messages.replay"}`,
+			status:      http.StatusAccepted,
+			total:       10,
+		},
+		{
+			desc:        "replay messages as user",
+			url:         rangeURL,
+			contentType: contentType,
+			token:       userToken,
+			body:        `{"subject":"senml.messages.replay"}`,
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "replay messages without token",
+			url:         rangeURL,
+			contentType: contentType,
+			body:        `{"subject":"senml.messages.replay"}`,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "replay messages to invalid subject",
+			url:         rangeURL,
+			contentType: contentType,
+			token:       adminToken,
+			body:        `{"subject":"json.messages"}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "replay messages to wildcard subject",
+			url:         rangeURL,
+			contentType: contentType,
+			token:       adminToken,
+			body:        `{"subject":"senml.messages.>"}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "replay messages with negative speed",
+			url:         rangeURL,
+			contentType: contentType,
+			token:       adminToken,
+			body:        `{"subject":"senml.messages","speed":-1}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "replay messages without time range",
+			url:         fmt.Sprintf("%s/replay", ts.URL),
+			contentType: contentType,
+			token:       adminToken,
+			body:        `{"subject":"senml.messages.replay"}`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "replay messages with malformed body",
+			url:         rangeURL,
+			contentType: contentType,
+			token:       adminToken,
+			body:        `{"subject":`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:   "replay messages without content type",
+			url:    rangeURL,
+			token:  adminToken,
+			body:   `{"subject":"senml.messages.replay"}`,
+			status: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         tc.url,
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+
+		var body struct {
+			ID    string `json:"id"`
+			Total uint64 `json:"total"`
+		}
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.total, body.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, body.Total))
+		assert.Equal(t, tc.status == http.StatusAccepted, body.ID != "", fmt.Sprintf("%s: unexpected replay id %q", tc.desc, body.ID))
+	}
+
+	require.Eventually(t, func() bool { return len(pub.published()) == 10 }, time.Second, 10*time.Millisecond, "replay messages: expected 10 published messages")
+
+	msgs := pub.published()
+	for i, msg := range msgs {
+		assert.Equal(t, "replay", msg.Subtopic, fmt.Sprintf("replay messages: expected subtopic replay got %s", msg.Subtopic))
+		assert.Equal(t, thingToken, msg.Publisher, fmt.Sprintf("replay messages: expected publisher %s got %s", thingToken, msg.Publisher))
+		assert.Equal(t, messaging.SenMLContentType, msg.ProfileConfig.ContentType, fmt.Sprintf("replay messages: expected content type %s got %s", messaging.SenMLContentType, msg.ProfileConfig.ContentType))
+		// Messages are replayed from the oldest one.
+		ts := fmt.Sprintf(`"t":%d`, now-int64(len(msgs)-1-i))
+		assert.Contains(t, string(msg.Payload), ts, fmt.Sprintf("replay messages: expected %s in payload %s", ts, msg.Payload))
+	}
+}
+
+func TestReplayPages(t *testing.T) {
+	from := time.Now().Unix() - 100
+
+	var messages []senml.Message
+	// Messages having the same time are read page by page.
+	for i := 0; i < 1500; i++ {
+		messages = append(messages, senml.Message{Publisher: thingToken, Protocol: mqttProt, Name: msgName, Value: &v, Time: float64(from + 10)})
+	}
+	for i := 0; i < 2000; i++ {
+		messages = append(messages, senml.Message{Publisher: thingToken, Protocol: mqttProt, Name: msgName, Value: &v, Time: float64(from+20) + float64(i)/100})
+	}
+
+	thSvc := thmocks.NewThingsServiceClient(nil, nil, nil)
+	authSvc := newAuthService()
+
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	pub := &publisherMock{}
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, pub)
+	defer ts.Close()
+
+	req := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPost,
+		url:         fmt.Sprintf("%s/replay?from=%d&to=%d", ts.URL, from, from+100),
+		contentType: contentType,
+		token:       adminTok.GetValue(),
+		body:        strings.NewReader(`{"subject":"senml.messages"}`),
+	}
+	res, err := req.make()
+	require.Nil(t, err, fmt.Sprintf("replay messages: unexpected error %s", err))
+	require.Equal(t, http.StatusAccepted, res.StatusCode, fmt.Sprintf("replay messages: expected %d got %d", http.StatusAccepted, res.StatusCode))
+
+	require.Eventually(t, func() bool { return len(pub.published()) == len(messages) }, 10*time.Second, 10*time.Millisecond, fmt.Sprintf("replay messages: expected %d published messages", len(messages)))
+
+	var last float64
+	for _, msg := range pub.published() {
+		var recs []struct {
+			Time float64 `json:"t"`
+		}
+		err := json.Unmarshal(msg.Payload, &recs)
+		require.Nil(t, err, fmt.Sprintf("replay messages: unexpected error %s", err))
+		assert.GreaterOrEqual(t, recs[0].Time, last, "replay messages: expected messages in ascending order of time")
+		last = recs[0].Time
+	}
+}
+
+func TestCancelReplay(t *testing.T) {
+	now := time.Now().Unix()
+	messages := []senml.Message{
+		{Publisher: thingToken, Protocol: mqttProt, Name: msgName, Value: &v, Time: float64(now - 100)},
+		{Publisher: thingToken, Protocol: mqttProt, Name: msgName, Value: &v, Time: float64(now)},
+	}
+
+	thSvc := thmocks.NewThingsServiceClient(nil, nil, nil)
+	authSvc := newAuthService()
+
+	tok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: user.ID, Email: user.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	userToken := tok.GetValue()
+	adminToken := adminTok.GetValue()
+
+	pub := &publisherMock{}
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, pub)
+	defer ts.Close()
+
+	// The second message is published after 100 seconds.
+	req := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPost,
+		url:         fmt.Sprintf("%s/replay?from=%d&to=%d", ts.URL, now-100, now+1),
+		contentType: contentType,
+		token:       adminToken,
+		body:        strings.NewReader(`{"subject":"senml.messages","speed":1}`),
+	}
+	res, err := req.make()
+	require.Nil(t, err, fmt.Sprintf("replay messages: unexpected error %s", err))
+
+	var body struct {
+		ID string `json:"id"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	require.Nil(t, err, fmt.Sprintf("replay messages: unexpected error %s", err))
+	require.Eventually(t, func() bool { return len(pub.published()) == 1 }, time.Second, 10*time.Millisecond, "replay messages: expected 1 published message")
+
+	cases := []struct {
+		desc   string
+		id     string
+		token  string
+		status int
+	}{
+		{
+			desc:   "cancel replay as user",
+			id:     body.ID,
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "cancel replay without token",
+			id:     body.ID,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "cancel non-existing replay",
+			id:     "non-existing",
+			token:  adminToken,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "cancel replay as admin",
+			id:     body.ID,
+			token:  adminToken,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "cancel cancelled replay",
+			id:     body.ID,
+			token:  adminToken,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodDelete,
+			url:    fmt.Sprintf("%s/replay/%s", ts.URL, tc.id),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	assert.Len(t, pub.published(), 1, "cancel replay: expected no more published messages")
+}
+
+func TestImport(t *testing.T) {
+	thSvc := thmocks.NewThingsServiceClient(nil, nil, nil)
+	authSvc := newAuthService()
+
+	tok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: user.ID, Email: user.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	userToken := tok.GetValue()
+	adminToken := adminTok.GetValue()
+
+	repo := rmocks.NewMessageRepository("", nil)
+	ts := newServer(repo, thSvc, authSvc, &publisherMock{})
+	defer ts.Close()
+
+	importURL := fmt.Sprintf("%s/import", ts.URL)
+	senmlBody := `[
+		{"publisher":"1","subtopic":"topic","protocol":"mqtt","name":"temperature","value":5,"time":1500000000},
+		{"publisher":"1","name":"status","string_value":"on","time":1500000001},
+		{"publisher":"1","name":"temperature","value":5},
+		{"name":"temperature","value":5,"time":1500000002},
+		{"publisher":"1","name":"temperature","value":"five","time":1500000003}
+	]`
+	csvBody := "subtopic,publisher,protocol,name,unit,value,string_value,bool_value,data_value,sum,time,update_time\n" +
+		"topic,1,mqtt,temperature,C,5,,,,,1500000000,\n" +
+		"topic,1,mqtt,switch,,,,true,,,1.500000001e+09,\n" +
+		"topic,1,mqtt,temperature,C,five,,,,,1500000002,\n" +
+		"topic,1,mqtt,temperature,C,,,,,,1500000003,\n"
+
+	cases := []struct {
+		desc        string
+		contentType string
+		token       string
+		body        string
+		status      int
+		total       uint64
+		imported    uint64
+		failed      uint64
+	}{
+		{
+			desc:        "import senml messages as admin",
+			contentType: messaging.SenMLContentType,
+			token:       adminToken,
+			body:        senmlBody,
+			status:      http.StatusCreated,
+			total:       5,
+			imported:    2,
+			failed:      3,
+		},
+		{
+			desc:        "import json messages as admin",
+			contentType: contentType,
+			token:       adminToken,
+			body:        `[{"publisher":"1","name":"temperature","value":5,"time":1500000000}]`,
+			status:      http.StatusCreated,
+			total:       1,
+			imported:    1,
+			failed:      0,
+		},
+		{
+			desc:        "import csv messages as admin",
+			contentType: "text/csv",
+			token:       adminToken,
+			body:        csvBody,
+			status:      http.StatusCreated,
+			total:       4,
+			imported:    2,
+			failed:      2,
+		},
+		{
+			desc:        "import csv messages without required column",
+			contentType: "text/csv",
+			token:       adminToken,
+			body:        "publisher,name,value\n1,temperature,5\n",
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import malformed senml messages",
+			contentType: messaging.SenMLContentType,
+			token:       adminToken,
+			body:        `[{"publisher":"1",`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import empty list of messages",
+			contentType: messaging.SenMLContentType,
+			token:       adminToken,
+			body:        `[]`,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import messages as user",
+			contentType: messaging.SenMLContentType,
+			token:       userToken,
+			body:        senmlBody,
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "import malformed messages as user",
+			contentType: messaging.SenMLContentType,
+			token:       userToken,
+			body:        `[{"publisher":"1",`,
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "import messages without token",
+			contentType: messaging.SenMLContentType,
+			body:        senmlBody,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "import too large batch of messages",
+			contentType: messaging.SenMLContentType,
+			token:       adminToken,
+			body:        "[" + strings.Repeat(" ", 32<<20) + "]",
+			status:      http.StatusRequestEntityTooLarge,
+		},
+		{
+			desc:        "import messages with unsupported content type",
+			contentType: "text/plain",
+			token:       adminToken,
+			body:        senmlBody,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         importURL,
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+
+		var body struct {
+			Total    uint64 `json:"total"`
+			Imported uint64 `json:"imported"`
+			Failed   uint64 `json:"failed"`
+			Errors   []struct {
+				Row uint64 `json:"row"`
+			} `json:"errors"`
+		}
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.total, body.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, body.Total))
+		assert.Equal(t, tc.imported, body.Imported, fmt.Sprintf("%s: expected imported %d got %d", tc.desc, tc.imported, body.Imported))
+		assert.Equal(t, tc.failed, body.Failed, fmt.Sprintf("%s: expected failed %d got %d", tc.desc, tc.failed, body.Failed))
+		assert.Equal(t, int(tc.failed), len(body.Errors), fmt.Sprintf("%s: expected %d errors got %d", tc.desc, tc.failed, len(body.Errors)))
+	}
+
+	page, err := repo.ListAllMessages(context.Background(), readers.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("list imported messages got unexpected error: %s", err))
+	assert.Equal(t, uint64(5), page.Total, fmt.Sprintf("list imported messages: expected total 5 got %d", page.Total))
+}
+
+type failingRestoreRepo struct {
+	readers.MessageRepository
+}
+
+func (repo failingRestoreRepo) Restore(_ context.Context, _ ...senml.Message) error {
+	return errors.ErrCreateEntity
+}
+
+func TestImportFailure(t *testing.T) {
+	thSvc := thmocks.NewThingsServiceClient(nil, nil, nil)
+	authSvc := newAuthService()
+
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	ts := newServer(failingRestoreRepo{rmocks.NewMessageRepository("", nil)}, thSvc, authSvc, &publisherMock{})
+	defer ts.Close()
+
+	req := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPost,
+		url:         fmt.Sprintf("%s/import", ts.URL),
+		contentType: messaging.SenMLContentType,
+		token:       adminTok.GetValue(),
+		body:        strings.NewReader(`[{"publisher":"1","name":"temperature","value":5,"time":1500000000},{"publisher":"1","name":"temperature"}]`),
+	}
+	res, err := req.make()
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+
+	var body struct {
+		Total    uint64 `json:"total"`
+		Imported uint64 `json:"imported"`
+		Failed   uint64 `json:"failed"`
+		Error    string `json:"error"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode, fmt.Sprintf("expected %d got %d", http.StatusInternalServerError, res.StatusCode))
+	assert.Equal(t, uint64(2), body.Total, fmt.Sprintf("expected total 2 got %d", body.Total))
+	assert.Equal(t, uint64(0), body.Imported, fmt.Sprintf("expected imported 0 got %d", body.Imported))
+	assert.Equal(t, uint64(1), body.Failed, fmt.Sprintf("expected failed 1 got %d", body.Failed))
+	assert.NotEmpty(t, body.Error, "expected import failure in the report")
+}
+
+func TestListAllMessagesCursor(t *testing.T) {
+	now := time.Now().Unix()
+
+	// Every three messages have the same time, so that the pages end in
+	// the middle of the messages having the same time.
+	var messages []senml.Message
+	for i := 0; i < numOfMessages; i++ {
+		messages = append(messages, senml.Message{
+			Publisher: thingToken,
+			Protocol:  mqttProt,
+			Name:      fmt.Sprintf("name-%d", i),
+			Time:      float64(now - int64(i/3)),
+			Value:     &v,
+		})
+	}
+
+	thSvc := thmocks.NewThingsServiceClient(map[string]string{userEmail: ""}, nil, nil)
+	authSvc := newAuthService()
+
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+	adminToken := adminTok.GetValue()
+
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, mocks.NewPublisher())
+	defer ts.Close()
+
+	var read []senml.Message
+	cursor := ""
+	for {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/messages?limit=7&cursor=%s", ts.URL, cursor),
+			token:  adminToken,
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("read messages page: unexpected error %s", err))
+		require.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("read messages page: expected %d got %d", http.StatusOK, res.StatusCode))
+
+		var page pageRes
+		err = json.NewDecoder(res.Body).Decode(&page)
+		require.Nil(t, err, fmt.Sprintf("read messages page: unexpected error %s", err))
+
+		read = append(read, page.Messages...)
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	assert.Equal(t, messages, read, "read messages using cursor: expected all of the messages in order")
+
+	cases := []struct {
+		desc   string
+		url    string
+		status int
+	}{
+		{
+			desc:   "read messages page with invalid cursor",
+			url:    fmt.Sprintf("%s/messages?cursor=%s", ts.URL, invalid),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read messages page with cursor and offset",
+			url:    fmt.Sprintf("%s/messages?offset=10&cursor=%s", ts.URL, readers.Cursor{Time: "1", ID: "1"}.Encode()),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read messages page with cursor and interval",
+			url:    fmt.Sprintf("%s/messages?interval=hour&timezone=UTC&cursor=%s", ts.URL, readers.Cursor{Time: "1", ID: "1"}.Encode()),
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  adminToken,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestListAllFormats(t *testing.T) {
+	now := time.Now().Unix()
+
+	// The SenML and JSON messages alternate, the newest being a SenML one.
+	var msgs []readers.Message
+	for i := 0; i < 10; i++ {
+		sec := now - int64(i)
+		if i%2 == 0 {
+			msgs = append(msgs, senml.Message{Publisher: thingToken, Protocol: mqttProt, Name: msgName, Time: float64(sec), Value: &v})
+			continue
+		}
+
+		msgs = append(msgs, map[string]interface{}{
+			"created":   sec * int64(time.Second),
+			"subtopic":  subtopic,
+			"publisher": thingToken,
+			"protocol":  httpProt,
+			"payload":   map[string]interface{}{"temperature": v},
+		})
+	}
+
+	thSvc := thmocks.NewThingsServiceClient(map[string]string{userEmail: ""}, nil, nil)
+	authSvc := newAuthService()
+
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+	adminToken := adminTok.GetValue()
+
+	repo := rmocks.NewMessageRepository("", msgs)
+	ts := newServer(repo, thSvc, authSvc, mocks.NewPublisher())
+	defer ts.Close()
+
+	cases := []struct {
+		desc    string
+		url     string
+		status  int
+		total   uint64
+		formats []string
+	}{
+		{
+			desc:    "read messages of all formats",
+			url:     fmt.Sprintf("%s/messages?format=all&limit=4", ts.URL),
+			status:  http.StatusOK,
+			total:   10,
+			formats: []string{"senml", "json", "senml", "json"},
+		},
+		{
+			desc:    "read messages of all formats with offset",
+			url:     fmt.Sprintf("%s/messages?format=all&offset=7&limit=5", ts.URL),
+			status:  http.StatusOK,
+			total:   10,
+			formats: []string{"json", "senml", "json"},
+		},
+		{
+			desc:    "read messages of all formats by protocol",
+			url:     fmt.Sprintf("%s/messages?format=all&protocol=%s", ts.URL, httpProt),
+			status:  http.StatusOK,
+			total:   5,
+			formats: []string{"json", "json", "json", "json", "json"},
+		},
+		{
+			desc:   "read messages of all formats by name",
+			url:    fmt.Sprintf("%s/messages?format=all&name=%s", ts.URL, msgName),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read messages of all formats by time range",
+			url:    fmt.Sprintf("%s/messages?format=all&from=%d", ts.URL, now-5),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read messages of all formats with selected fields",
+			url:    fmt.Sprintf("%s/messages?format=all&fields=publisher", ts.URL),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read messages of all formats aggregated",
+			url:    fmt.Sprintf("%s/messages?format=all&interval=hour", ts.URL),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read messages of all formats with cursor",
+			url:    fmt.Sprintf("%s/messages?format=all&cursor=%s", ts.URL, readers.Cursor{Time: "1", ID: "1"}.Encode()),
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  adminToken,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var page struct {
+			Total    uint64                   `json:"total"`
+			Messages []map[string]interface{} `json:"messages"`
+		}
+		err = json.NewDecoder(res.Body).Decode(&page)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, page.Total))
+
+		var formats []string
+		for _, msg := range page.Messages {
+			formats = append(formats, msg["format"].(string))
+		}
+		assert.Equal(t, tc.formats, formats, fmt.Sprintf("%s: expected formats %v got %v", tc.desc, tc.formats, formats))
+	}
+}
+
+func TestStreamMessages(t *testing.T) {
+	now := time.Now().Unix()
+
+	var messages []senml.Message
+	for i := 0; i < 2500; i++ {
+		messages = append(messages, senml.Message{
+			Publisher: thingToken,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(now - int64(i)),
+			Value:     &v,
+		})
+	}
+
+	thSvc := thmocks.NewThingsServiceClient(map[string]string{userEmail: ""}, nil, nil)
+	authSvc := newAuthService()
+
+	tok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: user.ID, Email: user.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, mocks.NewPublisher())
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		url    string
+		token  string
+		status int
+		res    []senml.Message
+	}{
+		{
+			desc:   "stream all messages as admin",
+			url:    fmt.Sprintf("%s/messages", ts.URL),
+			token:  adminTok.GetValue(),
+			status: http.StatusOK,
+			res:    messages,
+		},
+		{
+			desc:   "stream messages in time range as admin",
+			url:    fmt.Sprintf("%s/messages?from=%f&to=%f", ts.URL, messages[1999].Time, messages[9].Time),
+			token:  adminTok.GetValue(),
+			status: http.StatusOK,
+			res:    messages[10:2000],
+		},
+		{
+			desc:   "stream messages with selected fields as admin",
+			url:    fmt.Sprintf("%s/messages?fields=time,value", ts.URL),
+			token:  adminTok.GetValue(),
+			status: http.StatusOK,
+			res:    timeValues(messages),
+		},
+		{
+			desc:   "stream messages with offset",
+			url:    fmt.Sprintf("%s/messages?offset=10", ts.URL),
+			token:  adminTok.GetValue(),
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "stream messages as user",
+			url:    fmt.Sprintf("%s/messages", ts.URL),
+			token:  tok.GetValue(),
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.token,
+			accept: "application/x-ndjson",
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var msgs []senml.Message
+		dec := json.NewDecoder(res.Body)
+		for dec.More() {
+			var msg senml.Message
+			err := dec.Decode(&msg)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			msgs = append(msgs, msg)
+		}
+		assert.Equal(t, tc.res, msgs, fmt.Sprintf("%s: expected %d messages got %d", tc.desc, len(tc.res), len(msgs)))
+	}
+}
+
+func TestSavedQueries(t *testing.T) {
+	now := time.Now().Unix()
+
+	var messages []senml.Message
+	var tempMsgs []senml.Message
+	var humMsgs []senml.Message
+	var protMsgs []senml.Message
+	for i := 0; i < numOfMessages; i++ {
+		msg := senml.Message{
+			Publisher: thingToken,
+			Protocol:  mqttProt,
+			Name:      "humidity",
+			Time:      float64(now - int64(i)),
+			Value:     &v,
+		}
+		if i%2 == 0 {
+			msg.Name = msgName
+			tempMsgs = append(tempMsgs, msg)
+		}
+		if i%3 == 0 {
+			msg.Protocol = httpProt
+			if msg.Name == msgName {
+				protMsgs = append(protMsgs, msg)
+			}
+		}
+		if i%2 == 0 && i%3 == 0 {
+			tempMsgs[len(tempMsgs)-1] = msg
+		}
+		if i%2 != 0 {
+			humMsgs = append(humMsgs, msg)
+		}
+		messages = append(messages, msg)
+	}
+
+	member := users.User{ID: "2", Email: "member@example.com", Password: validPass, Role: auth.Viewer}
+	other := users.User{ID: "3", Email: "other@example.com", Password: validPass}
+
+	thSvc := thmocks.NewThingsServiceClient(map[string]string{userEmail: ""}, nil, nil)
+	authSvc := mocks.NewAuthService(admin.ID, []users.User{admin, member, other})
+
+	tok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: member.ID, Email: member.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	otherTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: other.ID, Email: other.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for other user got unexpected error: %s", err))
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	userToken := tok.GetValue()
+	otherToken := otherTok.GetValue()
+	adminToken := adminTok.GetValue()
+
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, mocks.NewPublisher())
+	defer ts.Close()
+
+	create := func(name, body string) *http.Response {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/queries", ts.URL),
+			contentType: contentType,
+			token:       adminToken,
+			body:        strings.NewReader(body),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("create saved query %s: unexpected error %s", name, err))
+		return res
+	}
+
+	res := create(msgName, fmt.Sprintf(`{"name":"%s","filters":{"name":"%s"}}`, msgName, msgName))
+	require.Equal(t, http.StatusCreated, res.StatusCode, fmt.Sprintf("create saved query: expected %d got %d", http.StatusCreated, res.StatusCode))
+	queryURL := res.Header.Get("Location")
+	require.NotEmpty(t, queryURL, "create saved query: expected location header")
+
+	createCases := []struct {
+		desc        string
+		body        string
+		contentType string
+		token       string
+		status      int
+	}{
+		{
+			desc:        "create saved query with existing name",
+			body:        fmt.Sprintf(`{"name":"%s","filters":{}}`, msgName),
+			contentType: contentType,
+			token:       adminToken,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create saved query without name",
+			body:        `{"filters":{}}`,
+			contentType: contentType,
+			token:       adminToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create saved query with pagination",
+			body:        `{"name":"paginated","filters":{"limit":10}}`,
+			contentType: contentType,
+			token:       adminToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create saved query with invalid comparator",
+			body:        fmt.Sprintf(`{"name":"comparator","filters":{"comparator":"%s"}}`, invalid),
+			contentType: contentType,
+			token:       adminToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create saved query with invalid fields",
+			body:        fmt.Sprintf(`{"name":"fields","filters":{"fields":["time","%s"]}}`, invalid),
+			contentType: contentType,
+			token:       adminToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create saved query with invalid body",
+			body:        invalid,
+			contentType: contentType,
+			token:       adminToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create saved query without content type",
+			body:        `{"name":"content","filters":{}}`,
+			contentType: "",
+			token:       adminToken,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create saved query as user",
+			body:        fmt.Sprintf(`{"name":"%s","filters":{}}`, msgName),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create saved query of org as org member",
+			body:        `{"name":"org","org_id":"org-id","filters":{}}`,
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create saved query of org as non-member",
+			body:        `{"name":"org","org_id":"org-id","filters":{}}`,
+			contentType: contentType,
+			token:       otherToken,
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "create saved query without token",
+			body:        `{"name":"token","filters":{}}`,
+			contentType: contentType,
+			token:       "",
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range createCases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/queries", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	readCases := []struct {
+		desc   string
+		url    string
+		token  string
+		key    string
+		status int
+		res    pageRes
+	}{
+		{
+			desc:   "read messages using saved query",
+			url:    fmt.Sprintf("%s%s/messages?limit=%d", ts.URL, queryURL, numOfMessages),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: numOfMessages, Name: msgName},
+				Total:        uint64(len(tempMsgs)),
+				Messages:     tempMsgs,
+			},
+		},
+		{
+			desc:   "read messages using saved query with additional filter",
+			url:    fmt.Sprintf("%s%s/messages?limit=%d&protocol=%s", ts.URL, queryURL, numOfMessages, httpProt),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: numOfMessages, Name: msgName, Protocol: httpProt},
+				Total:        uint64(len(protMsgs)),
+				Messages:     protMsgs,
+			},
+		},
+		{
+			desc:   "read messages using saved query with overridden filter",
+			url:    fmt.Sprintf("%s%s/messages?limit=%d&name=humidity", ts.URL, queryURL, numOfMessages),
+			token:  adminToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: numOfMessages, Name: "humidity"},
+				Total:        uint64(len(humMsgs)),
+				Messages:     humMsgs,
+			},
+		},
+		{
+			desc:   "read messages using saved query as user",
+			url:    fmt.Sprintf("%s%s/messages", ts.URL, queryURL),
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "read messages using saved query with thing key",
+			url:    fmt.Sprintf("%s%s/messages", ts.URL, queryURL),
+			key:    thingToken,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "read messages using non-existing saved query",
+			url:    fmt.Sprintf("%s/queries/%s/messages", ts.URL, invalid),
+			token:  adminToken,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range readCases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.token,
+			key:    tc.key,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var page pageRes
+		err = json.NewDecoder(res.Body).Decode(&page)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res.PageMetadata, page.PageMetadata, fmt.Sprintf("%s: expected page metadata %v got %v", tc.desc, tc.res.PageMetadata, page.PageMetadata))
+		assert.Equal(t, tc.res.Total, page.Total, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.res.Total, page.Total))
+		assert.ElementsMatch(t, tc.res.Messages, page.Messages, fmt.Sprintf("%s: got incorrect list of messages", tc.desc))
+	}
+
+	update := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPut,
+		url:         fmt.Sprintf("%s%s", ts.URL, queryURL),
+		contentType: contentType,
+		token:       adminToken,
+		body:        strings.NewReader(fmt.Sprintf(`{"name":"%s","filters":{"name":"%s","protocol":"%s"}}`, msgName, msgName, httpProt)),
+	}
+	res, err = update.make()
+	require.Nil(t, err, fmt.Sprintf("update saved query: unexpected error %s", err))
+	require.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("update saved query: expected %d got %d", http.StatusOK, res.StatusCode))
+
+	view := testRequest{
+		client: ts.Client(),
+		method: http.MethodGet,
+		url:    fmt.Sprintf("%s%s", ts.URL, queryURL),
+		token:  adminToken,
+	}
+	res, err = view.make()
+	require.Nil(t, err, fmt.Sprintf("view saved query: unexpected error %s", err))
+	require.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("view saved query: expected %d got %d", http.StatusOK, res.StatusCode))
+
+	var sq struct {
+		Name    string               `json:"name"`
+		Filters readers.PageMetadata `json:"filters"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&sq)
+	require.Nil(t, err, fmt.Sprintf("view saved query: unexpected error %s", err))
+	assert.Equal(t, readers.PageMetadata{Name: msgName, Protocol: httpProt}, sq.Filters, "view saved query: got incorrect filters")
+
+	viewOther := view
+	viewOther.token = otherToken
+	res, err = viewOther.make()
+	require.Nil(t, err, fmt.Sprintf("view saved query of other user: unexpected error %s", err))
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, fmt.Sprintf("view saved query of other user: expected %d got %d", http.StatusNotFound, res.StatusCode))
+
+	list := testRequest{
+		client: ts.Client(),
+		method: http.MethodGet,
+		url:    fmt.Sprintf("%s/queries?limit=5", ts.URL),
+		token:  adminToken,
+	}
+	res, err = list.make()
+	require.Nil(t, err, fmt.Sprintf("list saved queries: unexpected error %s", err))
+	require.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("list saved queries: expected %d got %d", http.StatusOK, res.StatusCode))
+
+	var qp struct {
+		Total   uint64 `json:"total"`
+		Queries []struct {
+			Name string `json:"name"`
+		} `json:"queries"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&qp)
+	require.Nil(t, err, fmt.Sprintf("list saved queries: unexpected error %s", err))
+	assert.Equal(t, uint64(1), qp.Total, fmt.Sprintf("list saved queries: expected 1 got %d", qp.Total))
+
+	listUser := list
+	listUser.token = userToken
+	res, err = listUser.make()
+	require.Nil(t, err, fmt.Sprintf("list saved queries of user: unexpected error %s", err))
+	require.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("list saved queries of user: expected %d got %d", http.StatusOK, res.StatusCode))
+	err = json.NewDecoder(res.Body).Decode(&qp)
+	require.Nil(t, err, fmt.Sprintf("list saved queries of user: unexpected error %s", err))
+	assert.Equal(t, uint64(2), qp.Total, fmt.Sprintf("list saved queries of user: expected 2 got %d", qp.Total))
+
+	removeOther := testRequest{
+		client: ts.Client(),
+		method: http.MethodDelete,
+		url:    fmt.Sprintf("%s%s", ts.URL, queryURL),
+		token:  otherToken,
+	}
+	res, err = removeOther.make()
+	require.Nil(t, err, fmt.Sprintf("remove saved query as other user: unexpected error %s", err))
+	assert.Equal(t, http.StatusNoContent, res.StatusCode, fmt.Sprintf("remove saved query as other user: expected %d got %d", http.StatusNoContent, res.StatusCode))
+
+	res, err = view.make()
+	require.Nil(t, err, fmt.Sprintf("view saved query removed by other user: unexpected error %s", err))
+	assert.Equal(t, http.StatusOK, res.StatusCode, fmt.Sprintf("view saved query removed by other user: expected %d got %d", http.StatusOK, res.StatusCode))
+
+	remove := testRequest{
+		client: ts.Client(),
+		method: http.MethodDelete,
+		url:    fmt.Sprintf("%s%s", ts.URL, queryURL),
+		token:  adminToken,
+	}
+	res, err = remove.make()
+	require.Nil(t, err, fmt.Sprintf("remove saved query: unexpected error %s", err))
+	assert.Equal(t, http.StatusNoContent, res.StatusCode, fmt.Sprintf("remove saved query: expected %d got %d", http.StatusNoContent, res.StatusCode))
+
+	res, err = view.make()
+	require.Nil(t, err, fmt.Sprintf("view removed saved query: unexpected error %s", err))
+	assert.Equal(t, http.StatusNotFound, res.StatusCode, fmt.Sprintf("view removed saved query: expected %d got %d", http.StatusNotFound, res.StatusCode))
+}
+
+func TestGrafana(t *testing.T) {
+	pubID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	otherPubID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now().Unix()
+	from := time.Unix(now-10, 0).UTC()
+	to := time.Unix(now+1, 0).UTC()
+
+	low, high := 10.0, 30.0
+	var messages []senml.Message
+	var points, hotPoints, pubPoints [][2]float64
+	for i := 9; i >= 0; i-- {
+		msg := senml.Message{
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(now - int64(i)),
+			Value:     &low,
+		}
+		if i%2 == 0 {
+			msg.Value = &high
+			hotPoints = append(hotPoints, [2]float64{high, msg.Time * 1e3})
+		}
+		if i%3 == 0 {
+			msg.Publisher = otherPubID
+		}
+		if msg.Publisher == pubID {
+			pubPoints = append(pubPoints, [2]float64{*msg.Value, msg.Time * 1e3})
+		}
+		points = append(points, [2]float64{*msg.Value, msg.Time * 1e3})
+		messages = append(messages, msg)
+	}
+
+	event := senml.Message{Publisher: pubID, Subtopic: subtopic, Name: "event", Time: float64(now), StringValue: &vs}
+	old := senml.Message{Publisher: pubID, Name: msgName, Time: float64(now - 100), Value: &high}
+	messages = append(messages, event, old)
+
+	thSvc := thmocks.NewThingsServiceClient(map[string]string{userEmail: ""}, map[string]string{thingToken: pubID}, nil)
+	authSvc := newAuthService()
+
+	tok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: user.ID, Email: user.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	adminTok, err := authSvc.Issue(context.Background(), &protomfx.IssueReq{Id: admin.ID, Email: admin.Email})
+	require.Nil(t, err, fmt.Sprintf("issue token for admin got unexpected error: %s", err))
+
+	userToken := tok.GetValue()
+	adminToken := adminTok.GetValue()
+
+	repo := rmocks.NewMessageRepository("", fromSenml(messages))
+	ts := newServer(repo, thSvc, authSvc, mocks.NewPublisher())
+	defer ts.Close()
+
+	create := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPost,
+		url:         fmt.Sprintf("%s/queries", ts.URL),
+		contentType: contentType,
+		token:       adminToken,
+		body:        strings.NewReader(fmt.Sprintf(`{"name":"hot","filters":{"name":"%s","vgt":20}}`, msgName)),
+	}
+	res, err := create.make()
+	require.Nil(t, err, fmt.Sprintf("create saved query: unexpected error %s", err))
+	require.Equal(t, http.StatusCreated, res.StatusCode, fmt.Sprintf("create saved query: expected %d got %d", http.StatusCreated, res.StatusCode))
+
+	testCases := []struct {
+		desc   string
+		token  string
+		key    string
+		status int
+	}{
+		{
+			desc:   "test data source as admin",
+			token:  adminToken,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "test data source using thing key",
+			key:    thingToken,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "test data source as user",
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "test data source without credentials",
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/grafana", ts.URL),
+			token:  tc.token,
+			key:    tc.key,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	searchCases := []struct {
+		desc   string
+		body   string
+		token  string
+		status int
+		res    []string
+	}{
+		{
+			desc:   "search saved queries",
+			body:   `{"target":""}`,
+			token:  adminToken,
+			status: http.StatusOK,
+			res:    []string{"hot"},
+		},
+		{
+			desc:   "search saved queries by prefix",
+			body:   `{"target":"cold"}`,
+			token:  adminToken,
+			status: http.StatusOK,
+			res:    []string{},
+		},
+		{
+			desc:   "search saved queries as user",
+			body:   `{"target":""}`,
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "search saved queries with invalid body",
+			body:   invalid,
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range searchCases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/grafana/search", ts.URL),
+			contentType: contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var names []string
+		err = json.NewDecoder(res.Body).Decode(&names)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, names, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, names))
+	}
+
+	rng := fmt.Sprintf(`"range":{"from":"%s","to":"%s"}`, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	queryCases := []struct {
+		desc   string
+		body   string
+		token  string
+		key    string
+		status int
+		res    []grafanaSeries
+	}{
+		{
+			desc:   "query record name and saved query",
+			body:   fmt.Sprintf(`{%s,"targets":[{"target":"%s","refId":"A"},{"target":"hot","refId":"B","type":"timeserie"}]}`, rng, msgName),
+			token:  adminToken,
+			status: http.StatusOK,
+			res:    []grafanaSeries{{Target: msgName, Datapoints: points}, {Target: "hot", Datapoints: hotPoints}},
+		},
+		{
+			desc:   "query with max data points",
+			body:   fmt.Sprintf(`{%s,"maxDataPoints":2,"targets":[{"target":"%s"}]}`, rng, msgName),
+			token:  adminToken,
+			status: http.StatusOK,
+			res:    []grafanaSeries{{Target: msgName, Datapoints: points[len(points)-2:]}},
+		},
+		{
+			desc:   "query using thing key",
+			body:   fmt.Sprintf(`{%s,"targets":[{"target":"%s"}]}`, rng, msgName),
+			key:    thingToken,
+			status: http.StatusOK,
+			res:    []grafanaSeries{{Target: msgName, Datapoints: pubPoints}},
+		},
+		{
+			desc:   "query non-existing record name",
+			body:   fmt.Sprintf(`{%s,"targets":[{"target":"%s"}]}`, rng, invalid),
+			token:  adminToken,
+			status: http.StatusOK,
+			res:    []grafanaSeries{{Target: invalid, Datapoints: [][2]float64{}}},
+		},
+		{
+			desc:   "query table target",
+			body:   fmt.Sprintf(`{%s,"targets":[{"target":"%s","type":"table"}]}`, rng, msgName),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "query without range",
+			body:   fmt.Sprintf(`{"targets":[{"target":"%s"}]}`, msgName),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "query as user",
+			body:   fmt.Sprintf(`{%s,"targets":[{"target":"%s"}]}`, rng, msgName),
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "query without credentials",
+			body:   fmt.Sprintf(`{%s,"targets":[{"target":"%s"}]}`, rng, msgName),
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range queryCases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/grafana/query", ts.URL),
+			contentType: contentType,
+			token:       tc.token,
+			key:         tc.key,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var series []grafanaSeries
+		err = json.NewDecoder(res.Body).Decode(&series)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, series, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, series))
+	}
+
+	annotationsCases := []struct {
+		desc   string
+		body   string
+		token  string
+		status int
+		res    []grafanaAnnotation
+	}{
+		{
+			desc:   "list annotations",
+			body:   fmt.Sprintf(`{%s,"annotation":{"name":"events","enable":true,"query":"event"}}`, rng),
+			token:  adminToken,
+			status: http.StatusOK,
+			res:    []grafanaAnnotation{{Time: int64(event.Time * 1e3), Title: event.Name, Text: vs, Tags: []string{pubID, subtopic}}},
+		},
+		{
+			desc:   "list annotations without query",
+			body:   fmt.Sprintf(`{%s,"annotation":{"name":"events","enable":true}}`, rng),
+			token:  adminToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "list annotations as user",
+			body:   fmt.Sprintf(`{%s,"annotation":{"name":"events","enable":true,"query":"event"}}`, rng),
+			token:  userToken,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range annotationsCases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/grafana/annotations", ts.URL),
+			contentType: contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+
+		var annotations []grafanaAnnotation
+		err = json.NewDecoder(res.Body).Decode(&annotations)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, annotations, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, annotations))
+	}
+}
+
+type pageRes struct {
+	readers.PageMetadata
+	Total      uint64          `json:"total"`
+	Messages   []senml.Message `json:"messages,omitempty"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+func fromSenml(in []senml.Message) []readers.Message {
+	var ret []readers.Message
+	for _, m := range in {
+		ret = append(ret, m)
+	}
+	return ret
+}
+
+func timeValues(in []senml.Message) []senml.Message {
+	var ret []senml.Message
+	for _, m := range in {
+		ret = append(ret, senml.Message{Time: m.Time, Value: m.Value})
+	}
+	return ret
+}
+
+func publishers(in []senml.Message) []senml.Message {
+	var ret []senml.Message
+	for _, m := range in {
+		ret = append(ret, senml.Message{Publisher: m.Publisher})
+	}
+	return ret
+}
+
+type grafanaSeries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+type grafanaAnnotation struct {
+	Time  int64    `json:"time"`
+	Title string   `json:"title"`
+	Text  string   `json:"text"`
+	Tags  []string `json:"tags"`
 }
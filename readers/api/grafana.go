@@ -0,0 +1,144 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/readers"
+)
+
+// grafanaTimeSeries is the type of the Grafana targets read as time series.
+const grafanaTimeSeries = "timeserie"
+
+// savedQueryFilters returns the filters of all the saved queries of the user
+// identified by the token, mapped by the query names. No saved queries are
+// returned if the request is made using the thing key.
+func savedQueryFilters(ctx context.Context, queries readers.QueryRepository, token string) (map[string]readers.PageMetadata, error) {
+	filters := make(map[string]readers.PageMetadata)
+	if token == "" {
+		return filters, nil
+	}
+
+	ownerID, err := identify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	for offset := uint64(0); ; offset += maxLimitSize {
+		page, err := queries.RetrieveAll(ctx, ownerID, offset, maxLimitSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sq := range page.Queries {
+			filters[sq.Name] = sq.Filters
+		}
+
+		if len(page.Queries) == 0 || offset+maxLimitSize >= page.Total {
+			return filters, nil
+		}
+	}
+}
+
+// grafanaPageMetadata returns the page metadata of the messages the Grafana
+// target refers to. The target is the name of the saved query, or the SenML
+// record name if no saved query is named so. The messages are read within
+// the panel time range, and limited to the publisher the request is limited to.
+func grafanaPageMetadata(target string, saved map[string]readers.PageMetadata, pm readers.PageMetadata) readers.PageMetadata {
+	q, ok := saved[target]
+	if !ok {
+		q = readers.PageMetadata{Name: target}
+	}
+
+	q.Offset, q.Limit, q.Cursor = 0, pm.Limit, ""
+	q.From, q.To = pm.From, pm.To
+	if pm.Publisher != "" {
+		q.Publisher = pm.Publisher
+	}
+
+	return q
+}
+
+// grafanaDatapoints returns the numeric values of the SenML messages, along
+// with their times in milliseconds, in ascending order of the time.
+func grafanaDatapoints(msgs []readers.Message) [][2]float64 {
+	points := [][2]float64{}
+	for _, m := range msgs {
+		msg, ok := m.(senml.Message)
+		if !ok {
+			continue
+		}
+
+		v := msg.Value
+		if v == nil {
+			v = msg.Sum
+		}
+		if v == nil {
+			continue
+		}
+
+		points = append(points, [2]float64{*v, msg.Time * 1e3})
+	}
+
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i][1] < points[j][1]
+	})
+
+	return points
+}
+
+// grafanaAnnotations returns the SenML messages as the annotations titled
+// by the record name, and tagged by the publisher and the subtopic.
+func grafanaAnnotations(annotation grafanaAnnotation, msgs []readers.Message) []grafanaAnnotationRes {
+	res := []grafanaAnnotationRes{}
+	for _, m := range msgs {
+		msg, ok := m.(senml.Message)
+		if !ok {
+			continue
+		}
+
+		tags := []string{}
+		for _, t := range []string{msg.Publisher, msg.Subtopic} {
+			if t != "" {
+				tags = append(tags, t)
+			}
+		}
+
+		res = append(res, grafanaAnnotationRes{
+			Annotation: annotation,
+			Time:       int64(msg.Time * 1e3),
+			Title:      msg.Name,
+			Text:       annotationText(msg),
+			Tags:       tags,
+		})
+	}
+
+	return res
+}
+
+func annotationText(msg senml.Message) string {
+	switch {
+	case msg.StringValue != nil:
+		return *msg.StringValue
+	case msg.DataValue != nil:
+		return *msg.DataValue
+	case msg.BoolValue != nil:
+		return strconv.FormatBool(*msg.BoolValue)
+	case msg.Value != nil:
+		return strconv.FormatFloat(*msg.Value, 'f', -1, 64)
+	case msg.Sum != nil:
+		return strconv.FormatFloat(*msg.Sum, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func unixTime(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/readers"
+)
+
+const (
+	csvContentType = "text/csv"
+	// maxImportSize is the largest number of messages imported at once.
+	maxImportSize = 100000
+	// maxImportBytes is the largest size of the imported batch.
+	maxImportBytes = 32 << 20
+	// importBatchSize is the number of messages written at once while importing.
+	importBatchSize = maxLimitSize
+	// maxImportErrors is the largest number of invalid messages listed in the import report.
+	maxImportErrors = 100
+)
+
+var (
+	// ErrImportSize indicates that too many messages are imported at once.
+	ErrImportSize = errors.New("too many or too large imported messages")
+
+	errMissingColumn    = errors.New("missing csv column")
+	errInvalidColumn    = errors.New("invalid csv column value")
+	errInvalidMessage   = errors.New("invalid message value")
+	errMissingPublisher = errors.New("missing message publisher")
+	errMissingName      = errors.New("missing message name")
+	errMissingTime      = errors.New("missing message time")
+	errMissingValue     = errors.New("missing message value")
+)
+
+// importRow represents the imported message, along with the error of its
+// conversion if the message is invalid.
+type importRow struct {
+	msg senml.Message
+	err error
+}
+
+// importError represents the invalid message which isn't imported. The row is
+// the position of the message in the imported batch, starting from 1.
+type importError struct {
+	Row   uint64 `json:"row"`
+	Error string `json:"error"`
+}
+
+// decodeSenMLImport reads the JSON array of the SenML messages. The messages
+// whose values are of the wrong type are kept as invalid rows, while the
+// malformed JSON rejects the whole batch.
+func decodeSenMLImport(r io.Reader) ([]importRow, error) {
+	dec := json.NewDecoder(r)
+	t, err := dec.Token()
+	if err != nil {
+		return nil, importReadError(err)
+	}
+	if t != json.Delim('[') {
+		return nil, apiutil.ErrMalformedEntity
+	}
+
+	var rows []importRow
+	for dec.More() {
+		if len(rows) == maxImportSize {
+			return nil, ErrImportSize
+		}
+
+		var msg senml.Message
+		err := dec.Decode(&msg)
+		if _, ok := err.(*json.UnmarshalTypeError); ok {
+			rows = append(rows, importRow{err: errors.Wrap(errInvalidMessage, err)})
+			continue
+		}
+		if err != nil {
+			return nil, importReadError(err)
+		}
+		rows = append(rows, importRow{msg: msg})
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, importReadError(err)
+	}
+
+	return rows, nil
+}
+
+// decodeCSVImport reads the CSV messages in the format of the backup. The
+// columns are matched by the names in the header, and the unknown columns
+// are ignored.
+func decodeCSVImport(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	names, err := reader.Read()
+	if err != nil {
+		return nil, importReadError(err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range names {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"publisher", "name", "time"} {
+		if _, ok := columns[name]; !ok {
+			return nil, errors.Wrap(apiutil.ErrMalformedEntity, errors.Wrap(errMissingColumn, errors.New(name)))
+		}
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, importReadError(err)
+		}
+
+		if len(rows) == maxImportSize {
+			return nil, ErrImportSize
+		}
+
+		msg, err := csvMessage(record, columns)
+		rows = append(rows, importRow{msg: msg, err: err})
+	}
+}
+
+// importReadError returns ErrImportSize if the imported batch is larger than
+// maxImportBytes, and the malformed entity error otherwise.
+func importReadError(err error) error {
+	if _, ok := err.(*http.MaxBytesError); ok {
+		return ErrImportSize
+	}
+
+	return errors.Wrap(apiutil.ErrMalformedEntity, err)
+}
+
+func csvMessage(record []string, columns map[string]int) (senml.Message, error) {
+	value := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	msg := senml.Message{
+		Subtopic:  value("subtopic"),
+		Publisher: value("publisher"),
+		Protocol:  value("protocol"),
+		Name:      value("name"),
+		Unit:      value("unit"),
+	}
+
+	for _, name := range []string{"time", "update_time", "value", "sum"} {
+		s := value(name)
+		if s == "" {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return senml.Message{}, errors.Wrap(errInvalidColumn, errors.New(name))
+		}
+
+		switch name {
+		case "time":
+			msg.Time = f
+		case "update_time":
+			msg.UpdateTime = f
+		case "value":
+			msg.Value = &f
+		case "sum":
+			msg.Sum = &f
+		}
+	}
+
+	if s := value("bool_value"); s != "" {
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return senml.Message{}, errors.Wrap(errInvalidColumn, errors.New("bool_value"))
+		}
+		msg.BoolValue = &b
+	}
+
+	if s := value("string_value"); s != "" {
+		msg.StringValue = &s
+	}
+
+	if s := value("data_value"); s != "" {
+		msg.DataValue = &s
+	}
+
+	return msg, nil
+}
+
+// validateImportMessage checks that the message has the publisher, the name,
+// the explicit time and the value, since the imported messages don't pass
+// through the SenML transformer which fills them in.
+func validateImportMessage(msg senml.Message) error {
+	switch {
+	case msg.Publisher == "":
+		return errMissingPublisher
+	case msg.Name == "":
+		return errMissingName
+	case msg.Time <= 0:
+		return errMissingTime
+	case msg.Value == nil && msg.StringValue == nil && msg.DataValue == nil && msg.BoolValue == nil && msg.Sum == nil:
+		return errMissingValue
+	}
+
+	return nil
+}
+
+// importMessages writes the valid messages to the repository in batches, and
+// reports the invalid ones. The batches written before the write failure are
+// kept, and counted in the returned report.
+func importMessages(ctx context.Context, repo readers.MessageRepository, rows []importRow) (importMessagesRes, error) {
+	res := importMessagesRes{Total: uint64(len(rows))}
+
+	batch := make([]senml.Message, 0, importBatchSize)
+	write := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := repo.Restore(ctx, batch...); err != nil {
+			return err
+		}
+		res.Imported += uint64(len(batch))
+		batch = batch[:0]
+
+		return nil
+	}
+
+	for i, row := range rows {
+		err := row.err
+		if err == nil {
+			err = validateImportMessage(row.msg)
+		}
+
+		if err != nil {
+			res.Failed++
+			if len(res.Errors) < maxImportErrors {
+				res.Errors = append(res.Errors, importError{Row: uint64(i + 1), Error: err.Error()})
+			}
+			continue
+		}
+
+		batch = append(batch, row.msg)
+		if len(batch) == importBatchSize {
+			if err := write(); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	if err := write(); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
@@ -31,22 +31,22 @@ func MetricsMiddleware(svc readers.MessageRepository, counter metrics.Counter, l
 	}
 }
 
-func (mm *metricsMiddleware) ListAllMessages(rpm readers.PageMetadata) (readers.MessagesPage, error) {
+func (mm *metricsMiddleware) ListAllMessages(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "list_all_messages").Add(1)
 		mm.latency.With("method", "list_all_messages").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return mm.svc.ListAllMessages(rpm)
+	return mm.svc.ListAllMessages(ctx, rpm)
 }
 
-func (mm *metricsMiddleware) Backup(rpm readers.PageMetadata) (readers.MessagesPage, error) {
+func (mm *metricsMiddleware) Backup(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "backup").Add(1)
 		mm.latency.With("method", "backup").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return mm.svc.Backup(rpm)
+	return mm.svc.Backup(ctx, rpm)
 }
 
 func (mm *metricsMiddleware) Restore(ctx context.Context, messages ...senml.Message) error {
@@ -0,0 +1,116 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/url"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/readers"
+)
+
+// validateSavedQuery checks the name and the filters of the saved query. The
+// pagination isn't saved, since it's provided with every read.
+func validateSavedQuery(name string, filters readers.PageMetadata) error {
+	if name == "" || len(name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if filters.Offset != 0 || filters.Limit != 0 || filters.Cursor != "" {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	switch filters.Comparator {
+	case "",
+		readers.EqualKey,
+		readers.LowerThanKey,
+		readers.LowerThanEqualKey,
+		readers.GreaterThanKey,
+		readers.GreaterThanEqualKey:
+	default:
+		return apiutil.ErrInvalidComparator
+	}
+
+	if !readers.ValidFields(filters.Format, filters.Fields) {
+		return apiutil.ErrInvalidFields
+	}
+
+	if filters.Interval != "" {
+		return validateAggregation(filters)
+	}
+
+	return nil
+}
+
+// mergeQuery returns the page metadata of the messages read using the saved
+// query. The saved filters are overridden by the ones provided in the request
+// query parameters, while the pagination is always taken from the request.
+func mergeQuery(saved, pm readers.PageMetadata, params url.Values) readers.PageMetadata {
+	q := saved
+	q.Offset, q.Limit, q.Cursor = pm.Offset, pm.Limit, pm.Cursor
+
+	has := func(key string) bool {
+		_, ok := params[key]
+		return ok
+	}
+
+	if has(formatKey) {
+		q.Format = pm.Format
+	}
+	if has(subtopicKey) {
+		q.Subtopic = pm.Subtopic
+	}
+	if has(publisherKey) {
+		q.Publisher = pm.Publisher
+	}
+	if has(protocolKey) {
+		q.Protocol = pm.Protocol
+	}
+	if has(nameKey) {
+		q.Name = pm.Name
+	}
+	if has(valueKey) {
+		q.Value = pm.Value
+	}
+	if has(comparatorKey) {
+		q.Comparator = pm.Comparator
+	}
+	if has(valueGreaterThanKey) {
+		q.ValueGreaterThan = pm.ValueGreaterThan
+	}
+	if has(valueLowerThanKey) {
+		q.ValueLowerThan = pm.ValueLowerThan
+	}
+	if has(boolValueKey) {
+		q.BoolValue = pm.BoolValue
+	}
+	if has(stringValueKey) {
+		q.StringValue = pm.StringValue
+	}
+	if has(dataValueKey) {
+		q.DataValue = pm.DataValue
+	}
+	if has(fromKey) {
+		q.From = pm.From
+	}
+	if has(toKey) {
+		q.To = pm.To
+	}
+	if has(fieldsKey) {
+		q.Fields = pm.Fields
+	}
+	// The aggregation and the time zone of the request are set only along
+	// with the interval, so they are taken from the query parameters.
+	if has(intervalKey) {
+		q.Interval, q.Aggregation, q.Timezone = pm.Interval, pm.Aggregation, pm.Timezone
+	}
+	if has(aggregationKey) && q.Interval != "" {
+		q.Aggregation = params.Get(aggregationKey)
+	}
+	if has(timezoneKey) && q.Interval != "" {
+		q.Timezone = params.Get(timezoneKey)
+	}
+
+	return q
+}
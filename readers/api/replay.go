@@ -0,0 +1,263 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/readers"
+	mfsenml "github.com/MainfluxLabs/senml"
+)
+
+const (
+	replaySubject = "senml.messages"
+	// replayPageSize is the maximum number of messages read at once.
+	replayPageSize = 1000
+)
+
+var (
+	// ErrInvalidSubject indicates that the replay subject is not a SenML messages subject.
+	ErrInvalidSubject = errors.New("invalid replay subject")
+
+	// ErrInvalidSpeed indicates that the replay speed is negative.
+	ErrInvalidSpeed = errors.New("invalid replay speed")
+)
+
+// replaySubtopic returns the subtopic the replayed messages are published to,
+// so that they are received on the given subject. Replaying to the subject
+// without a subtopic keeps the original subtopics of the messages.
+func replaySubtopic(subject string) (string, bool, error) {
+	if subject == replaySubject {
+		return "", true, nil
+	}
+
+	if !strings.HasPrefix(subject, replaySubject+".") {
+		return "", false, ErrInvalidSubject
+	}
+
+	subtopic, err := messaging.CreateSubject(strings.TrimPrefix(subject, replaySubject+"."))
+	if err != nil || subtopic == "" || strings.ContainsAny(subtopic, "*>") {
+		return "", false, ErrInvalidSubject
+	}
+
+	return subtopic, false, nil
+}
+
+// replays holds the cancel functions of the running replays by their IDs.
+type replays struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func newReplays() *replays {
+	return &replays{cancels: make(map[string]context.CancelFunc)}
+}
+
+// start returns the context of the replay, which is cancelled once the replay
+// is cancelled or done.
+func (r *replays) start(id string) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cancels[id] = cancel
+
+	return ctx
+}
+
+func (r *replays) done(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cancel, ok := r.cancels[id]; ok {
+		cancel()
+		delete(r.cancels, id)
+	}
+}
+
+func (r *replays) cancel(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cancel, ok := r.cancels[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	cancel()
+	delete(r.cancels, id)
+
+	return nil
+}
+
+type replayer struct {
+	pub          messaging.Publisher
+	subtopic     string
+	keepSubtopic bool
+	speed        float64
+	last         float64
+	published    uint64
+	logger       logger.Logger
+}
+
+// replay publishes the messages in ascending order of their time. If the speed
+// is set, the original delays between the messages are kept, divided by the speed.
+// Since the messages are read in descending order of their time, the time range
+// is read in windows holding at most a page of messages, which are published
+// from the oldest one. The window is halved while it holds more messages, and
+// the window which can't be split, since all its messages have the same time,
+// is read page by page.
+func replay(ctx context.Context, repo readers.MessageRepository, pm readers.PageMetadata, pub messaging.Publisher, subject string, speed float64, logger logger.Logger) {
+	subtopic, keepSubtopic, err := replaySubtopic(subject)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to replay messages: %s", err))
+		return
+	}
+
+	r := replayer{
+		pub:          pub,
+		subtopic:     subtopic,
+		keepSubtopic: keepSubtopic,
+		speed:        speed,
+		last:         pm.From,
+		logger:       logger,
+	}
+
+	end := pm.To
+	from, width := pm.From, pm.To-pm.From
+	pm.Offset = 0
+	pm.Limit = replayPageSize
+
+	for from < end {
+		to := math.Min(from+width, end)
+		pm.From, pm.To, pm.Cursor = from, to, ""
+
+		page, err := repo.ListAllMessages(ctx, pm)
+		if err != nil {
+			r.failed(ctx, err)
+			return
+		}
+
+		if mid := from + (to-from)/2; page.Total > uint64(len(page.Messages)) && mid > from && mid < to {
+			width = mid - from
+			continue
+		}
+
+		for {
+			if err := r.publish(ctx, page.Messages); err != nil {
+				r.failed(ctx, err)
+				return
+			}
+
+			if page.NextCursor == "" {
+				break
+			}
+
+			pm.Cursor = page.NextCursor
+			if page, err = repo.ListAllMessages(ctx, pm); err != nil {
+				r.failed(ctx, err)
+				return
+			}
+		}
+
+		from = to
+		width *= 2
+	}
+
+	logger.Info(fmt.Sprintf("Replayed %d messages to %s", r.published, subject))
+}
+
+// publish publishes the page of the messages ordered by time descending.
+func (r *replayer) publish(ctx context.Context, msgs []readers.Message) error {
+	for i := len(msgs) - 1; i >= 0; i-- {
+		msg, ok := msgs[i].(senml.Message)
+		if !ok {
+			continue
+		}
+
+		if r.speed > 0 && r.published > 0 && msg.Time > r.last {
+			select {
+			case <-time.After(time.Duration((msg.Time - r.last) / r.speed * float64(time.Second))):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		r.last = msg.Time
+
+		subtopic := r.subtopic
+		if r.keepSubtopic {
+			subtopic = msg.Subtopic
+		}
+
+		m, err := toReplayMessage(msg, subtopic)
+		if err != nil {
+			r.logger.Warn(fmt.Sprintf("Failed to encode replayed message: %s", err))
+			continue
+		}
+
+		if err := r.pub.Publish(m); err != nil {
+			r.logger.Warn(fmt.Sprintf("Failed to publish replayed message: %s", err))
+			continue
+		}
+		r.published++
+	}
+
+	return nil
+}
+
+func (r *replayer) failed(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		r.logger.Info(fmt.Sprintf("Replay cancelled after %d messages", r.published))
+		return
+	}
+
+	r.logger.Error(fmt.Sprintf("Failed to replay messages after %d messages: %s", r.published, err))
+}
+
+func toReplayMessage(msg senml.Message, subtopic string) (protomfx.Message, error) {
+	pack := mfsenml.Pack{
+		Records: []mfsenml.Record{
+			{
+				Name:        msg.Name,
+				Unit:        msg.Unit,
+				Time:        msg.Time,
+				UpdateTime:  msg.UpdateTime,
+				Value:       msg.Value,
+				StringValue: msg.StringValue,
+				DataValue:   msg.DataValue,
+				BoolValue:   msg.BoolValue,
+				Sum:         msg.Sum,
+			},
+		},
+	}
+
+	payload, err := mfsenml.Encode(pack, mfsenml.JSON)
+	if err != nil {
+		return protomfx.Message{}, err
+	}
+
+	return protomfx.Message{
+		Subtopic:  subtopic,
+		Publisher: msg.Publisher,
+		Protocol:  msg.Protocol,
+		Payload:   payload,
+		Created:   time.Now().UnixNano(),
+		ProfileConfig: &protomfx.Config{
+			ContentType: messaging.SenMLContentType,
+			Write:       true,
+		},
+	}, nil
+}
@@ -4,12 +4,23 @@
 package api
 
 import (
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zones are validated against the embedded database
+
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
 	"github.com/MainfluxLabs/mainflux/readers"
 )
 
-const maxLimitSize = 1000
+const (
+	maxLimitSize = 1000
+	maxNameSize  = 254
+	// streamBatchSize is the number of messages read at once while streaming.
+	streamBatchSize = maxLimitSize
+)
 
 type listProfileMessagesReq struct {
 	profileID   string
@@ -46,11 +57,15 @@ func (req listProfileMessagesReq) validate() error {
 type listAllMessagesReq struct {
 	token    string
 	key      string
+	shareKey string
+	password string
+	orgID    string
+	stream   bool
 	pageMeta readers.PageMetadata
 }
 
 func (req listAllMessagesReq) validate() error {
-	if req.token == "" && req.key == "" {
+	if req.token == "" && req.key == "" && req.shareKey == "" {
 		return apiutil.ErrBearerToken
 	}
 
@@ -71,11 +86,85 @@ func (req listAllMessagesReq) validate() error {
 		return apiutil.ErrInvalidComparator
 	}
 
+	if req.pageMeta.Cursor != "" || req.stream {
+		if req.pageMeta.Offset > 0 || req.pageMeta.Interval != "" {
+			return apiutil.ErrInvalidQueryParams
+		}
+
+		if _, err := readers.DecodeCursor(req.pageMeta.Cursor); err != nil {
+			return err
+		}
+	}
+
+	if req.pageMeta.Format == readers.AllFormats {
+		return validateAllFormats(req)
+	}
+
+	if !readers.ValidFields(req.pageMeta.Format, req.pageMeta.Fields) {
+		return apiutil.ErrInvalidFields
+	}
+
+	if req.pageMeta.Interval != "" {
+		return validateAggregation(req.pageMeta)
+	}
+
+	return nil
+}
+
+// validateAllFormats allows only the filters which apply to the messages of
+// both formats. The SenML and JSON messages are merged in memory, so they
+// are neither aggregated, nor read using the cursor.
+func validateAllFormats(req listAllMessagesReq) error {
+	pm := req.pageMeta
+	if req.stream || pm.Cursor != "" || pm.Interval != "" || len(pm.Fields) > 0 {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	if pm.Name != "" || pm.Value != nil || pm.Comparator != "" ||
+		pm.ValueGreaterThan != nil || pm.ValueLowerThan != nil || pm.BoolValue != nil ||
+		pm.StringValue != "" || pm.DataValue != "" || pm.From != 0 || pm.To != 0 {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	return nil
+}
+
+func validateAggregation(pm readers.PageMetadata) error {
+	if pm.Format != "" && pm.Format != defFormat {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	switch pm.Interval {
+	case readers.MinuteInterval,
+		readers.HourInterval,
+		readers.DayInterval,
+		readers.WeekInterval,
+		readers.MonthInterval,
+		readers.YearInterval:
+	default:
+		return apiutil.ErrInvalidInterval
+	}
+
+	switch pm.Aggregation {
+	case readers.MinAggregation,
+		readers.MaxAggregation,
+		readers.AvgAggregation,
+		readers.SumAggregation,
+		readers.CountAggregation:
+	default:
+		return apiutil.ErrInvalidAggregation
+	}
+
+	if _, err := time.LoadLocation(pm.Timezone); err != nil || pm.Timezone == "" || strings.EqualFold(pm.Timezone, "local") {
+		return apiutil.ErrInvalidTimezone
+	}
+
 	return nil
 }
 
 type restoreMessagesReq struct {
 	token    string
+	orgID    string
 	Messages []senml.Message `json:"messages"`
 }
 
@@ -90,3 +179,249 @@ func (req restoreMessagesReq) validate() error {
 
 	return nil
 }
+
+type replayMessagesReq struct {
+	token    string
+	orgID    string
+	pageMeta readers.PageMetadata
+	Subject  string  `json:"subject"`
+	Speed    float64 `json:"speed,omitempty"`
+}
+
+func (req replayMessagesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.pageMeta.From == 0 || req.pageMeta.To <= req.pageMeta.From {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	if req.pageMeta.Interval != "" || (req.pageMeta.Format != "" && req.pageMeta.Format != defFormat) {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	if req.Speed < 0 {
+		return ErrInvalidSpeed
+	}
+
+	if _, _, err := replaySubtopic(req.Subject); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type replayReq struct {
+	token string
+	id    string
+}
+
+func (req replayReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type importMessagesReq struct {
+	token string
+	orgID string
+	csv   bool
+	body  io.Reader
+}
+
+func (req importMessagesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
+
+// rows reads the imported messages from the request body, in the CSV or
+// the SenML format.
+func (req importMessagesReq) rows() ([]importRow, error) {
+	if req.csv {
+		return decodeCSVImport(req.body)
+	}
+
+	return decodeSenMLImport(req.body)
+}
+
+type createQueryReq struct {
+	token   string
+	OrgID   string               `json:"org_id"`
+	Name    string               `json:"name"`
+	Filters readers.PageMetadata `json:"filters"`
+}
+
+func (req createQueryReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return validateSavedQuery(req.Name, req.Filters)
+}
+
+type updateQueryReq struct {
+	token   string
+	id      string
+	Name    string               `json:"name"`
+	Filters readers.PageMetadata `json:"filters"`
+}
+
+func (req updateQueryReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateSavedQuery(req.Name, req.Filters)
+}
+
+type queryReq struct {
+	token string
+	id    string
+}
+
+func (req queryReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listQueriesReq struct {
+	token  string
+	offset uint64
+	limit  uint64
+}
+
+func (req listQueriesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+// listQueryMessagesReq holds the request of the messages read using the saved
+// query, along with the query parameters overriding the saved filters. The
+// saved queries are owned by the users, so the messages are read only using
+// the user token.
+type listQueryMessagesReq struct {
+	id          string
+	params      url.Values
+	messagesReq listAllMessagesReq
+}
+
+func (req listQueryMessagesReq) validate() error {
+	if req.messagesReq.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+// grafanaRange represents the time range of the Grafana panel.
+type grafanaRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+func (r grafanaRange) validate() error {
+	if r.From.IsZero() || !r.To.After(r.From) {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	return nil
+}
+
+type grafanaTarget struct {
+	Target string `json:"target"`
+	RefID  string `json:"refId"`
+	Type   string `json:"type"`
+}
+
+type grafanaAnnotation struct {
+	Name      string `json:"name"`
+	Enable    bool   `json:"enable"`
+	IconColor string `json:"iconColor,omitempty"`
+	Query     string `json:"query"`
+}
+
+type grafanaSearchReq struct {
+	token  string
+	Target string `json:"target"`
+}
+
+func (req grafanaSearchReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
+
+// grafanaQueryReq holds the Grafana query, along with the credentials and
+// the org of the request, which are read as the request of all messages.
+type grafanaQueryReq struct {
+	messagesReq   listAllMessagesReq
+	Range         grafanaRange    `json:"range"`
+	MaxDataPoints uint64          `json:"maxDataPoints"`
+	Targets       []grafanaTarget `json:"targets"`
+}
+
+func (req grafanaQueryReq) validate() error {
+	if req.messagesReq.token == "" && req.messagesReq.key == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	for _, t := range req.Targets {
+		if t.Type != "" && t.Type != grafanaTimeSeries {
+			return apiutil.ErrInvalidQueryParams
+		}
+	}
+
+	return req.Range.validate()
+}
+
+type grafanaAnnotationsReq struct {
+	messagesReq listAllMessagesReq
+	Range       grafanaRange      `json:"range"`
+	Annotation  grafanaAnnotation `json:"annotation"`
+}
+
+func (req grafanaAnnotationsReq) validate() error {
+	if req.messagesReq.token == "" && req.messagesReq.key == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.Annotation.Query == "" {
+		return apiutil.ErrInvalidQueryParams
+	}
+
+	return req.Range.validate()
+}
@@ -4,6 +4,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
@@ -13,12 +14,22 @@ import (
 var (
 	_ apiutil.Response = (*listMessagesRes)(nil)
 	_ apiutil.Response = (*restoreMessagesRes)(nil)
+	_ apiutil.Response = (*replayMessagesRes)(nil)
+	_ apiutil.Response = (*cancelReplayRes)(nil)
+	_ apiutil.Response = (*importMessagesRes)(nil)
+	_ apiutil.Response = (*createQueryRes)(nil)
+	_ apiutil.Response = (*queryRes)(nil)
+	_ apiutil.Response = (*queriesPageRes)(nil)
+	_ apiutil.Response = (*updateQueryRes)(nil)
+	_ apiutil.Response = (*removeQueryRes)(nil)
+	_ apiutil.Response = (*grafanaTestRes)(nil)
 )
 
 type listMessagesRes struct {
 	readers.PageMetadata
-	Total    uint64            `json:"total"`
-	Messages []readers.Message `json:"messages,omitempty"`
+	Total      uint64            `json:"total"`
+	Messages   []readers.Message `json:"messages,omitempty"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
 func (res listMessagesRes) Headers() map[string]string {
@@ -33,6 +44,13 @@ func (res listMessagesRes) Empty() bool {
 	return false
 }
 
+// streamMessagesRes holds the repository and the page metadata of the
+// messages which are read and written while the response is encoded.
+type streamMessagesRes struct {
+	repo     readers.MessageRepository
+	pageMeta readers.PageMetadata
+}
+
 type restoreMessagesRes struct{}
 
 func (res restoreMessagesRes) Code() int {
@@ -62,3 +80,172 @@ func (res backupFileRes) Headers() map[string]string {
 func (res backupFileRes) Empty() bool {
 	return false
 }
+
+type replayMessagesRes struct {
+	ID    string `json:"id"`
+	Total uint64 `json:"total"`
+}
+
+func (res replayMessagesRes) Code() int {
+	return http.StatusAccepted
+}
+
+func (res replayMessagesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res replayMessagesRes) Empty() bool {
+	return false
+}
+
+type cancelReplayRes struct{}
+
+func (res cancelReplayRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res cancelReplayRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res cancelReplayRes) Empty() bool {
+	return true
+}
+
+// importMessagesRes reports the number of the imported messages, and lists
+// the invalid messages which are skipped. The error is set if the import
+// failed after the reported messages were imported.
+type importMessagesRes struct {
+	Total    uint64        `json:"total"`
+	Imported uint64        `json:"imported"`
+	Failed   uint64        `json:"failed"`
+	Errors   []importError `json:"errors,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+func (res importMessagesRes) Code() int {
+	if res.Error != "" {
+		return http.StatusInternalServerError
+	}
+
+	return http.StatusCreated
+}
+
+func (res importMessagesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res importMessagesRes) Empty() bool {
+	return false
+}
+
+type createQueryRes struct {
+	ID string `json:"id"`
+}
+
+func (res createQueryRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res createQueryRes) Headers() map[string]string {
+	return map[string]string{
+		"Location": fmt.Sprintf("/queries/%s", res.ID),
+	}
+}
+
+func (res createQueryRes) Empty() bool {
+	return false
+}
+
+type queryRes struct {
+	ID      string               `json:"id"`
+	OrgID   string               `json:"org_id,omitempty"`
+	Name    string               `json:"name"`
+	Filters readers.PageMetadata `json:"filters"`
+}
+
+func (res queryRes) Code() int {
+	return http.StatusOK
+}
+
+func (res queryRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res queryRes) Empty() bool {
+	return false
+}
+
+type queriesPageRes struct {
+	Total   uint64     `json:"total"`
+	Offset  uint64     `json:"offset"`
+	Limit   uint64     `json:"limit"`
+	Queries []queryRes `json:"queries"`
+}
+
+func (res queriesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res queriesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res queriesPageRes) Empty() bool {
+	return false
+}
+
+type updateQueryRes struct{}
+
+func (res updateQueryRes) Code() int {
+	return http.StatusOK
+}
+
+func (res updateQueryRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res updateQueryRes) Empty() bool {
+	return true
+}
+
+type removeQueryRes struct{}
+
+func (res removeQueryRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeQueryRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeQueryRes) Empty() bool {
+	return true
+}
+
+type grafanaTestRes struct{}
+
+func (res grafanaTestRes) Code() int {
+	return http.StatusOK
+}
+
+func (res grafanaTestRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res grafanaTestRes) Empty() bool {
+	return true
+}
+
+type grafanaSeriesRes struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+type grafanaAnnotationRes struct {
+	Annotation grafanaAnnotation `json:"annotation"`
+	Time       int64             `json:"time"`
+	Title      string            `json:"title"`
+	Text       string            `json:"text"`
+	Tags       []string          `json:"tags"`
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/readers"
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/go-zoo/bone"
@@ -24,22 +27,36 @@ import (
 const (
 	contentType            = "application/json"
 	octetStreamContentType = "application/octet-stream"
+	ndjsonContentType      = "application/x-ndjson"
 	offsetKey              = "offset"
 	limitKey               = "limit"
+	cursorKey              = "cursor"
 	formatKey              = "format"
 	subtopicKey            = "subtopic"
+	publisherKey           = "publisher"
 	protocolKey            = "protocol"
 	nameKey                = "name"
 	valueKey               = "v"
+	valueGreaterThanKey    = "vgt"
+	valueLowerThanKey      = "vlt"
 	stringValueKey         = "vs"
 	dataValueKey           = "vd"
 	boolValueKey           = "vb"
 	comparatorKey          = "comparator"
 	fromKey                = "from"
 	toKey                  = "to"
+	intervalKey            = "interval"
+	aggregationKey         = "agg"
+	timezoneKey            = "timezone"
+	fieldsKey              = "fields"
+	orgKey                 = "org_id"
+	shareKey               = "share"
+	sharePasswordHeader    = "X-Share-Password"
+	idKey                  = "id"
 	defLimit               = 10
 	defOffset              = 0
 	defFormat              = "messages"
+	defTimezone            = "UTC"
 )
 
 var (
@@ -47,8 +64,10 @@ var (
 	authc  protomfx.AuthServiceClient
 )
 
-// MakeHandler returns a HTTP handler for API endpoints.
-func MakeHandler(svc readers.MessageRepository, tc protomfx.ThingsServiceClient, ac protomfx.AuthServiceClient, svcName string, logger logger.Logger) http.Handler {
+// MakeHandler returns a HTTP handler for API endpoints. The messages of the
+// orgs are read from the org repositories, or from the default one if the org
+// has no repository.
+func MakeHandler(svc readers.MessageRepository, orgs map[string]readers.MessageRepository, queries readers.QueryRepository, tc protomfx.ThingsServiceClient, ac protomfx.AuthServiceClient, pub messaging.Publisher, idp uuid.IDProvider, svcName string, logger logger.Logger) http.Handler {
 	thingc = tc
 	authc = ac
 
@@ -56,25 +75,109 @@ func MakeHandler(svc readers.MessageRepository, tc protomfx.ThingsServiceClient,
 		kithttp.ServerErrorEncoder(encodeError),
 	}
 
+	repos := repositories{def: svc, orgs: orgs}
+	jobs := newReplays()
+
 	mux := bone.New()
-	mux.Get("/messages", kithttp.NewServer(
-		listAllMessagesEndpoint(svc),
+	mux.Get("/messages", streaming(kithttp.NewServer(
+		listAllMessagesEndpoint(repos),
 		decodeListAllMessages,
 		encodeResponse,
 		opts...,
-	))
+	)))
 	mux.Post("/restore", kithttp.NewServer(
-		restoreEndpoint(svc),
+		restoreEndpoint(repos),
 		decodeRestore,
 		encodeResponse,
 		opts...,
 	))
 	mux.Get("/backup", kithttp.NewServer(
-		backupEndpoint(svc),
+		backupEndpoint(repos),
 		decodeListAllMessages,
 		encodeBackupFileResponse,
 		opts...,
 	))
+	mux.Post("/replay", kithttp.NewServer(
+		replayEndpoint(repos, jobs, pub, idp, logger),
+		decodeReplay,
+		encodeResponse,
+		opts...,
+	))
+	mux.Delete("/replay/:id", kithttp.NewServer(
+		cancelReplayEndpoint(jobs),
+		decodeCancelReplay,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/import", kithttp.NewServer(
+		importEndpoint(repos, logger),
+		decodeImport,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/queries", kithttp.NewServer(
+		createQueryEndpoint(queries, idp),
+		decodeCreateQuery,
+		encodeResponse,
+		opts...,
+	))
+	mux.Get("/queries", kithttp.NewServer(
+		listQueriesEndpoint(queries),
+		decodeListQueries,
+		encodeResponse,
+		opts...,
+	))
+	mux.Get("/queries/:id/messages", kithttp.NewServer(
+		listQueryMessagesEndpoint(repos, queries),
+		decodeListQueryMessages,
+		encodeResponse,
+		opts...,
+	))
+	mux.Get("/queries/:id", kithttp.NewServer(
+		viewQueryEndpoint(queries),
+		decodeQuery,
+		encodeResponse,
+		opts...,
+	))
+	mux.Put("/queries/:id", kithttp.NewServer(
+		updateQueryEndpoint(queries),
+		decodeUpdateQuery,
+		encodeResponse,
+		opts...,
+	))
+	mux.Delete("/queries/:id", kithttp.NewServer(
+		removeQueryEndpoint(queries),
+		decodeQuery,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Get("/grafana", kithttp.NewServer(
+		grafanaTestEndpoint(repos),
+		decodeGrafanaTest,
+		encodeResponse,
+		opts...,
+	))
+	mux.Post("/grafana/search", kithttp.NewServer(
+		grafanaSearchEndpoint(queries),
+		decodeGrafanaSearch,
+		encodeResponse,
+		opts...,
+	))
+	mux.Post("/grafana/query", kithttp.NewServer(
+		grafanaQueryEndpoint(repos, queries),
+		decodeGrafanaQuery,
+		encodeResponse,
+		opts...,
+	))
+	mux.Post("/grafana/annotations", kithttp.NewServer(
+		grafanaAnnotationsEndpoint(repos, queries),
+		decodeGrafanaAnnotations,
+		encodeResponse,
+		opts...,
+	))
 
 	mux.GetFunc("/health", mainflux.Health(svcName))
 	mux.Handle("/metrics", promhttp.Handler())
@@ -103,6 +206,11 @@ func decodeListAllMessages(ctx context.Context, r *http.Request) (interface{}, e
 		return nil, err
 	}
 
+	publisher, err := apiutil.ReadStringQuery(r, publisherKey, "")
+	if err != nil {
+		return nil, err
+	}
+
 	protocol, err := apiutil.ReadStringQuery(r, protocolKey, "")
 	if err != nil {
 		return nil, err
@@ -113,7 +221,22 @@ func decodeListAllMessages(ctx context.Context, r *http.Request) (interface{}, e
 		return nil, err
 	}
 
-	v, err := apiutil.ReadFloatQuery(r, valueKey, 0)
+	v, err := readOptionalFloatQuery(r, valueKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vgt, err := readOptionalFloatQuery(r, valueGreaterThanKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vlt, err := readOptionalFloatQuery(r, valueLowerThanKey)
+	if err != nil {
+		return nil, err
+	}
+
+	vb, err := readOptionalBoolQuery(r, boolValueKey)
 	if err != nil {
 		return nil, err
 	}
@@ -143,34 +266,119 @@ func decodeListAllMessages(ctx context.Context, r *http.Request) (interface{}, e
 		return nil, err
 	}
 
+	interval, err := apiutil.ReadStringQuery(r, intervalKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	aggregation, err := apiutil.ReadStringQuery(r, aggregationKey, readers.AvgAggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := apiutil.ReadStringQuery(r, timezoneKey, defTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := apiutil.ReadStringQuery(r, orgKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := apiutil.ReadStringQuery(r, shareKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := apiutil.ReadStringQuery(r, cursorKey, "")
+	if err != nil {
+		return nil, err
+	}
+
 	req := listAllMessagesReq{
-		token: apiutil.ExtractBearerToken(r),
-		key:   apiutil.ExtractThingKey(r),
+		token:    apiutil.ExtractBearerToken(r),
+		key:      apiutil.ExtractThingKey(r),
+		shareKey: share,
+		password: r.Header.Get(sharePasswordHeader),
+		orgID:    orgID,
+		stream:   strings.Contains(r.Header.Get("Accept"), ndjsonContentType),
 		pageMeta: readers.PageMetadata{
-			Offset:      offset,
-			Limit:       limit,
-			Format:      format,
-			Subtopic:    subtopic,
-			Protocol:    protocol,
-			Name:        name,
-			Value:       v,
-			Comparator:  comparator,
-			StringValue: vs,
-			DataValue:   vd,
-			From:        from,
-			To:          to,
+			Offset:           offset,
+			Limit:            limit,
+			Cursor:           cursor,
+			Format:           format,
+			Subtopic:         subtopic,
+			Publisher:        publisher,
+			Protocol:         protocol,
+			Name:             name,
+			Value:            v,
+			Comparator:       comparator,
+			ValueGreaterThan: vgt,
+			ValueLowerThan:   vlt,
+			BoolValue:        vb,
+			StringValue:      vs,
+			DataValue:        vd,
+			From:             from,
+			To:               to,
+			Fields:           readFieldsQuery(r, fieldsKey),
 		},
 	}
 
-	vb, err := apiutil.ReadBoolQuery(r, boolValueKey, false)
-	if err != nil && err != apiutil.ErrNotFoundParam {
+	if interval != "" {
+		req.pageMeta.Interval = interval
+		req.pageMeta.Aggregation = aggregation
+		req.pageMeta.Timezone = timezone
+	}
+
+	return req, nil
+}
+
+// readFieldsQuery returns the selected fields, provided either as the comma
+// separated list or as the repeated query parameter, without the duplicates.
+func readFieldsQuery(r *http.Request, key string) []string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, f := range bone.GetQuery(r, key) {
+		f = strings.TrimSpace(f)
+		if f == "" || seen[f] {
+			continue
+		}
+		seen[f] = true
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// readOptionalFloatQuery returns nil if the query parameter is not set, so
+// that the zero values can be filtered on.
+func readOptionalFloatQuery(r *http.Request, key string) (*float64, error) {
+	if len(bone.GetQuery(r, key)) == 0 {
+		return nil, nil
+	}
+
+	v, err := apiutil.ReadFloatQuery(r, key, 0)
+	if err != nil {
 		return nil, err
 	}
-	if err == nil {
-		req.pageMeta.BoolValue = vb
+
+	return &v, nil
+}
+
+// readOptionalBoolQuery returns nil if the query parameter is not set, so
+// that the false values can be filtered on.
+func readOptionalBoolQuery(r *http.Request, key string) (*bool, error) {
+	if len(bone.GetQuery(r, key)) == 0 {
+		return nil, nil
+	}
+
+	b, err := apiutil.ReadBoolQuery(r, key, false)
+	if err != nil {
+		return nil, err
 	}
 
-	return req, nil
+	return &b, nil
 }
 
 func decodeRestore(ctx context.Context, r *http.Request) (interface{}, error) {
@@ -178,7 +386,177 @@ func decodeRestore(ctx context.Context, r *http.Request) (interface{}, error) {
 		return nil, apiutil.ErrUnsupportedContentType
 	}
 
-	req := restoreMessagesReq{token: apiutil.ExtractBearerToken(r)}
+	orgID, err := apiutil.ReadStringQuery(r, orgKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := restoreMessagesReq{token: apiutil.ExtractBearerToken(r), orgID: orgID}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeImport(ctx context.Context, r *http.Request) (interface{}, error) {
+	orgID, err := apiutil.ReadStringQuery(r, orgKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// The body is read by the endpoint once the user is authorized, so
+	// that the large imports of the unauthorized users aren't parsed.
+	req := importMessagesReq{
+		token: apiutil.ExtractBearerToken(r),
+		orgID: orgID,
+		body:  http.MaxBytesReader(nil, r.Body, maxImportBytes),
+	}
+
+	ct := r.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(ct, csvContentType):
+		req.csv = true
+	case strings.Contains(ct, messaging.SenMLContentType), strings.Contains(ct, contentType):
+	default:
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	return req, nil
+}
+
+func decodeReplay(ctx context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	lr, err := decodeListAllMessages(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := replayMessagesReq{
+		token:    apiutil.ExtractBearerToken(r),
+		orgID:    lr.(listAllMessagesReq).orgID,
+		pageMeta: lr.(listAllMessagesReq).pageMeta,
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+	req.pageMeta.Offset = 0
+	req.pageMeta.Limit = 0
+	req.pageMeta.Cursor = ""
+	// The replayed messages are published as they were received.
+	req.pageMeta.Fields = nil
+
+	return req, nil
+}
+
+func decodeCancelReplay(_ context.Context, r *http.Request) (interface{}, error) {
+	req := replayReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+
+	return req, nil
+}
+
+func decodeCreateQuery(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createQueryReq{token: apiutil.ExtractBearerToken(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateQuery(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateQueryReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeQuery(_ context.Context, r *http.Request) (interface{}, error) {
+	req := queryReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+
+	return req, nil
+}
+
+func decodeListQueries(_ context.Context, r *http.Request) (interface{}, error) {
+	offset, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listQueriesReq{
+		token:  apiutil.ExtractBearerToken(r),
+		offset: offset,
+		limit:  limit,
+	}
+
+	return req, nil
+}
+
+func decodeListQueryMessages(ctx context.Context, r *http.Request) (interface{}, error) {
+	lr, err := decodeListAllMessages(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listQueryMessagesReq{
+		id:          bone.GetValue(r, idKey),
+		params:      r.URL.Query(),
+		messagesReq: lr.(listAllMessagesReq),
+	}
+
+	return req, nil
+}
+
+// decodeGrafanaTest decodes the request of the Grafana data source test,
+// which only checks the credentials and the org of the request.
+func decodeGrafanaTest(_ context.Context, r *http.Request) (interface{}, error) {
+	orgID, err := apiutil.ReadStringQuery(r, orgKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := listAllMessagesReq{
+		token: apiutil.ExtractBearerToken(r),
+		key:   apiutil.ExtractThingKey(r),
+		orgID: orgID,
+	}
+
+	return req, nil
+}
+
+func decodeGrafanaSearch(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := grafanaSearchReq{token: apiutil.ExtractBearerToken(r)}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
 	}
@@ -186,7 +564,47 @@ func decodeRestore(ctx context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
-func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+func decodeGrafanaQuery(ctx context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	mr, err := decodeGrafanaTest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := grafanaQueryReq{messagesReq: mr.(listAllMessagesReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeGrafanaAnnotations(ctx context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	mr, err := decodeGrafanaTest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := grafanaAnnotationsReq{messagesReq: mr.(listAllMessagesReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if sr, ok := response.(streamMessagesRes); ok {
+		return encodeStreamResponse(ctx, w, sr)
+	}
+
 	w.Header().Set("Content-Type", contentType)
 
 	if ar, ok := response.(apiutil.Response); ok {
@@ -204,6 +622,54 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
+// encodeStreamResponse writes the messages as newline delimited JSON. The
+// messages are read in batches using the page cursor, and each batch is
+// flushed to the client as soon as it's written.
+// streaming exempts the streamed message lists from the request timeout, since
+// the stream lasts as long as it takes to write all of the matching messages.
+func streaming(h http.Handler) http.Handler {
+	untimed := servershttp.WithoutTimeout(h)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept"), ndjsonContentType) {
+			untimed.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func encodeStreamResponse(ctx context.Context, w http.ResponseWriter, res streamMessagesRes) error {
+	w.Header().Set("Content-Type", ndjsonContentType)
+	w.WriteHeader(http.StatusOK)
+
+	enc := json.NewEncoder(w)
+	flusher, _ := w.(http.Flusher)
+
+	pm := res.pageMeta
+	pm.Limit = streamBatchSize
+	for {
+		page, err := res.repo.ListAllMessages(ctx, pm)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range page.Messages {
+			if err := enc.Encode(readers.SelectFields(msg, pm.Fields)); err != nil {
+				return err
+			}
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		if page.NextCursor == "" {
+			return nil
+		}
+		pm.Cursor = page.NextCursor
+	}
+}
+
 func encodeBackupFileResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", octetStreamContentType)
 
@@ -224,7 +690,7 @@ func encodeBackupFileResponse(_ context.Context, w http.ResponseWriter, response
 	return nil
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, nil):
 	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
@@ -233,8 +699,19 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrLimitSize,
 		err == apiutil.ErrOffsetSize,
 		err == apiutil.ErrEmptyList,
-		err == apiutil.ErrInvalidComparator:
+		err == apiutil.ErrNameSize,
+		err == apiutil.ErrInvalidComparator,
+		err == apiutil.ErrInvalidInterval,
+		err == apiutil.ErrInvalidAggregation,
+		err == apiutil.ErrInvalidTimezone,
+		err == apiutil.ErrInvalidFields,
+		err == readers.ErrUnsupportedAggregation,
+		err == readers.ErrInvalidCursor,
+		err == ErrInvalidSubject,
+		err == ErrInvalidSpeed:
 		w.WriteHeader(http.StatusBadRequest)
+	case err == ErrImportSize:
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
 	case errors.Contains(err, errors.ErrAuthentication),
 		err == apiutil.ErrBearerToken:
 		w.WriteHeader(http.StatusUnauthorized)
@@ -258,7 +735,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
@@ -273,6 +750,39 @@ func getPubConfByKey(ctx context.Context, key string) (*protomfx.PubConfByKeyRes
 	return pc, nil
 }
 
+func getPubConfByShare(ctx context.Context, key, password string) (*protomfx.PubConfByKeyRes, error) {
+	pc, err := thingc.GetPubConfByShare(ctx, &protomfx.PubConfByShareReq{Key: key, Password: password})
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func identify(ctx context.Context, token string) (string, error) {
+	res, err := authc.Identify(ctx, &protomfx.Token{Value: token})
+	if err != nil {
+		return "", err
+	}
+
+	return res.GetId(), nil
+}
+
+func isOrgMember(ctx context.Context, token, orgID string) error {
+	req := &protomfx.AuthorizeReq{
+		Token:   token,
+		Object:  orgID,
+		Subject: auth.OrgSub,
+		Action:  auth.Viewer,
+	}
+
+	if _, err := authc.Authorize(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func isAdmin(ctx context.Context, token string) error {
 	req := &protomfx.AuthorizeReq{
 		Token:   token,
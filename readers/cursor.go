@@ -0,0 +1,92 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCursor indicates the malformed page cursor.
+var ErrInvalidCursor = errors.New("invalid page cursor")
+
+// Cursor points to the position following the last message of the page, in
+// the messages ordered by time descending and by ID ascending. Since the
+// message times aren't unique, the cursor holds both the time and the ID of
+// the last message of the page, and the next page starts with the message
+// following it in that order.
+type Cursor struct {
+	Time string
+	ID   string
+}
+
+// Empty indicates if the cursor points to the first page.
+func (c Cursor) Empty() bool {
+	return c.Time == ""
+}
+
+// Encode returns the opaque representation of the cursor, which is empty for
+// the empty cursor.
+func (c Cursor) Encode() string {
+	if c.Empty() {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", c.Time, c.ID)))
+}
+
+// FloatTime returns the cursor time of the messages whose time is a float.
+func (c Cursor) FloatTime() float64 {
+	t, _ := strconv.ParseFloat(c.Time, 64)
+	return t
+}
+
+// IntTime returns the cursor time of the messages whose time is an integer.
+func (c Cursor) IntTime() int64 {
+	t, err := strconv.ParseInt(c.Time, 10, 64)
+	if err != nil {
+		return int64(c.FloatTime())
+	}
+
+	return t
+}
+
+// DecodeCursor parses the opaque cursor representation. The empty
+// representation is decoded to the empty cursor.
+func DecodeCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if _, err := strconv.ParseFloat(parts[0], 64); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{Time: parts[0], ID: parts[1]}, nil
+}
+
+// NextCursor returns the cursor following the page read using the provided
+// page metadata, given the number of the page messages and the cursor of its
+// last message. The returned cursor is empty if the page isn't full, since
+// there are no more messages.
+func NextCursor(pm PageMetadata, n uint64, last Cursor) Cursor {
+	if pm.Limit == 0 || n < pm.Limit {
+		return Cursor{}
+	}
+
+	return last
+}
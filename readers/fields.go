@@ -0,0 +1,111 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import "github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+
+// JSONFormat represents the format of the JSON messages.
+const JSONFormat = "json"
+
+// senmlFields are the fields of the SenML messages which can be selected.
+var senmlFields = map[string]bool{
+	"subtopic":     true,
+	"publisher":    true,
+	"protocol":     true,
+	"name":         true,
+	"unit":         true,
+	"time":         true,
+	"update_time":  true,
+	"value":        true,
+	"string_value": true,
+	"data_value":   true,
+	"bool_value":   true,
+	"sum":          true,
+}
+
+// jsonFields are the fields of the JSON messages which can be selected.
+var jsonFields = map[string]bool{
+	"created":   true,
+	"subtopic":  true,
+	"publisher": true,
+	"protocol":  true,
+	"payload":   true,
+}
+
+// ValidFields reports whether all of the fields can be selected from the
+// messages of the provided format.
+func ValidFields(format string, fields []string) bool {
+	valid := senmlFields
+	if format == JSONFormat {
+		valid = jsonFields
+	}
+
+	for _, f := range fields {
+		if !valid[f] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SelectFields returns the message holding only the selected fields, which
+// are omitted if the message has no value for them. The message is returned
+// unchanged if no fields are selected.
+func SelectFields(msg Message, fields []string) Message {
+	if len(fields) == 0 {
+		return msg
+	}
+
+	ret := make(map[string]interface{}, len(fields))
+	switch m := msg.(type) {
+	case senml.Message:
+		for _, f := range fields {
+			if v, ok := senmlField(m, f); ok {
+				ret[f] = v
+			}
+		}
+	case map[string]interface{}:
+		for _, f := range fields {
+			if v, ok := m[f]; ok {
+				ret[f] = v
+			}
+		}
+	default:
+		return msg
+	}
+
+	return ret
+}
+
+func senmlField(msg senml.Message, field string) (interface{}, bool) {
+	switch field {
+	case "subtopic":
+		return msg.Subtopic, msg.Subtopic != ""
+	case "publisher":
+		return msg.Publisher, msg.Publisher != ""
+	case "protocol":
+		return msg.Protocol, msg.Protocol != ""
+	case "name":
+		return msg.Name, msg.Name != ""
+	case "unit":
+		return msg.Unit, msg.Unit != ""
+	case "time":
+		return msg.Time, msg.Time != 0
+	case "update_time":
+		return msg.UpdateTime, msg.UpdateTime != 0
+	case "value":
+		return msg.Value, msg.Value != nil
+	case "string_value":
+		return msg.StringValue, msg.StringValue != nil
+	case "data_value":
+		return msg.DataValue, msg.DataValue != nil
+	case "bool_value":
+		return msg.BoolValue, msg.BoolValue != nil
+	case "sum":
+		return msg.Sum, msg.Sum != nil
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+)
+
+const (
+	// SenMLFormat represents the format of the SenML messages.
+	SenMLFormat = "messages"
+
+	// AllFormats represents the unified format, in which the SenML and JSON
+	// messages are read together.
+	AllFormats = "all"
+
+	// FormatField is the field of the unified messages, which discriminates
+	// the SenML messages from the JSON ones.
+	FormatField = "format"
+
+	// SenMLDiscriminator is the format field value of the SenML messages.
+	SenMLDiscriminator = "senml"
+
+	// JSONDiscriminator is the format field value of the JSON messages.
+	JSONDiscriminator = "json"
+)
+
+// allSenMLFields are the SenML message fields, in the order they are copied
+// to the unified message.
+var allSenMLFields = []string{
+	"subtopic",
+	"publisher",
+	"protocol",
+	"name",
+	"unit",
+	"time",
+	"update_time",
+	"value",
+	"string_value",
+	"data_value",
+	"bool_value",
+	"sum",
+}
+
+// ListAllFormats reads the page of both the SenML and the JSON messages,
+// interleaved by time, the newest first. Since the messages of both formats
+// preceding the page are read as well, the page is read using the offset
+// only. Each message is returned as a map holding its format in the
+// FormatField.
+func ListAllFormats(ctx context.Context, repo MessageRepository, rpm PageMetadata) (MessagesPage, error) {
+	pm := rpm
+	pm.Offset = 0
+	pm.Limit = rpm.Offset + rpm.Limit
+	pm.Cursor = ""
+
+	pm.Format = SenMLFormat
+	sp, err := repo.ListAllMessages(ctx, pm)
+	if err != nil {
+		return MessagesPage{}, err
+	}
+
+	pm.Format = JSONFormat
+	jp, err := repo.ListAllMessages(ctx, pm)
+	if err != nil {
+		return MessagesPage{}, err
+	}
+
+	msgs := mergeByTime(sp.Messages, jp.Messages)
+
+	start, end := rpm.Offset, rpm.Offset+rpm.Limit
+	if start > uint64(len(msgs)) {
+		start = uint64(len(msgs))
+	}
+	if end > uint64(len(msgs)) {
+		end = uint64(len(msgs))
+	}
+
+	return MessagesPage{
+		PageMetadata: rpm,
+		Total:        sp.Total + jp.Total,
+		Messages:     msgs[start:end],
+	}, nil
+}
+
+// mergeByTime merges the SenML and JSON messages, each ordered by time
+// descending. The messages having the same time are ordered SenML first.
+func mergeByTime(senmlMsgs, jsonMsgs []Message) []Message {
+	msgs := make([]Message, 0, len(senmlMsgs)+len(jsonMsgs))
+
+	i, j := 0, 0
+	for i < len(senmlMsgs) || j < len(jsonMsgs) {
+		if j == len(jsonMsgs) || (i < len(senmlMsgs) && messageTime(senmlMsgs[i]) >= messageTime(jsonMsgs[j])) {
+			msgs = append(msgs, unifiedMessage(senmlMsgs[i], SenMLDiscriminator))
+			i++
+			continue
+		}
+
+		msgs = append(msgs, unifiedMessage(jsonMsgs[j], JSONDiscriminator))
+		j++
+	}
+
+	return msgs
+}
+
+// messageTime returns the message time in seconds. The JSON messages are
+// created in nanoseconds.
+func messageTime(msg Message) float64 {
+	switch m := msg.(type) {
+	case senml.Message:
+		return m.Time
+	case map[string]interface{}:
+		switch c := m["created"].(type) {
+		case int64:
+			return float64(c) / 1e9
+		case int32:
+			return float64(c) / 1e9
+		case float64:
+			return c / 1e9
+		}
+	}
+
+	return 0
+}
+
+// unifiedMessage returns the message as a map holding the message fields
+// along with its format.
+func unifiedMessage(msg Message, format string) Message {
+	ret := map[string]interface{}{}
+
+	switch m := msg.(type) {
+	case senml.Message:
+		for k, v := range SelectFields(m, allSenMLFields).(map[string]interface{}) {
+			ret[k] = v
+		}
+	case map[string]interface{}:
+		for k, v := range m {
+			ret[k] = v
+		}
+	}
+	ret[FormatField] = format
+
+	return ret
+}
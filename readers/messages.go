@@ -21,21 +21,50 @@ const (
 	GreaterThanKey = "gt"
 	// GreaterThanEqualKey represents the greater-than-or-equal comparison operator key.
 	GreaterThanEqualKey = "ge"
+
+	// MinuteInterval represents the minute aggregation interval.
+	MinuteInterval = "minute"
+	// HourInterval represents the hour aggregation interval.
+	HourInterval = "hour"
+	// DayInterval represents the calendar day aggregation interval.
+	DayInterval = "day"
+	// WeekInterval represents the calendar week aggregation interval starting on Monday.
+	WeekInterval = "week"
+	// MonthInterval represents the calendar month aggregation interval.
+	MonthInterval = "month"
+	// YearInterval represents the calendar year aggregation interval.
+	YearInterval = "year"
+
+	// MinAggregation represents the minimum value aggregation.
+	MinAggregation = "min"
+	// MaxAggregation represents the maximum value aggregation.
+	MaxAggregation = "max"
+	// AvgAggregation represents the average value aggregation.
+	AvgAggregation = "avg"
+	// SumAggregation represents the sum of values aggregation.
+	SumAggregation = "sum"
+	// CountAggregation represents the message count aggregation.
+	CountAggregation = "count"
 )
 
-// ErrReadMessages indicates failure occurred while reading messages from database.
-var ErrReadMessages = errors.New("failed to read messages from database")
+var (
+	// ErrReadMessages indicates failure occurred while reading messages from database.
+	ErrReadMessages = errors.New("failed to read messages from database")
+
+	// ErrUnsupportedAggregation indicates that the reader does not support message aggregation.
+	ErrUnsupportedAggregation = errors.New("message aggregation is not supported")
+)
 
 // MessageRepository specifies message reader API.
 type MessageRepository interface {
 	// ListAllMessages retrieves all messages from database.
-	ListAllMessages(rpm PageMetadata) (MessagesPage, error)
+	ListAllMessages(ctx context.Context, rpm PageMetadata) (MessagesPage, error)
 
 	// Restore restores message database from a backup.
 	Restore(ctx context.Context, messages ...senml.Message) error
 
 	// Backup retrieves all messages from database.
-	Backup(rpm PageMetadata) (MessagesPage, error)
+	Backup(ctx context.Context, rpm PageMetadata) (MessagesPage, error)
 }
 
 // Message represents any message format.
@@ -45,26 +74,42 @@ type Message interface{}
 // belong to this page.
 type MessagesPage struct {
 	PageMetadata
-	Total    uint64
-	Messages []Message
+	Total      uint64
+	Messages   []Message
+	NextCursor string
 }
 
-// PageMetadata represents the parameters used to create database queries
+// PageMetadata represents the parameters used to create database queries.
+// The Value is compared using the Comparator, while ValueGreaterThan and
+// ValueLowerThan bound the value range, so that the value filters can be
+// combined. If Interval is set, messages are grouped into the buckets of the
+// calendar interval in the Timezone, and each bucket is returned as a message
+// with the bucket start time and the Aggregation of the bucket values. If the
+// Cursor is set, the page following the cursor is read instead of the page
+// at the Offset, and the Total isn't counted. If Fields are set, only the
+// selected fields of the messages are read.
 type PageMetadata struct {
-	Offset      uint64  `json:"offset"`
-	Limit       uint64  `json:"limit"`
-	Subtopic    string  `json:"subtopic,omitempty"`
-	Publisher   string  `json:"publisher,omitempty"`
-	Protocol    string  `json:"protocol,omitempty"`
-	Name        string  `json:"name,omitempty"`
-	Value       float64 `json:"v,omitempty"`
-	Comparator  string  `json:"comparator,omitempty"`
-	BoolValue   bool    `json:"vb,omitempty"`
-	StringValue string  `json:"vs,omitempty"`
-	DataValue   string  `json:"vd,omitempty"`
-	From        float64 `json:"from,omitempty"`
-	To          float64 `json:"to,omitempty"`
-	Format      string  `json:"format,omitempty"`
+	Offset           uint64   `json:"offset"`
+	Limit            uint64   `json:"limit"`
+	Subtopic         string   `json:"subtopic,omitempty"`
+	Publisher        string   `json:"publisher,omitempty"`
+	Protocol         string   `json:"protocol,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	Value            *float64 `json:"v,omitempty"`
+	Comparator       string   `json:"comparator,omitempty"`
+	ValueGreaterThan *float64 `json:"vgt,omitempty"`
+	ValueLowerThan   *float64 `json:"vlt,omitempty"`
+	BoolValue        *bool    `json:"vb,omitempty"`
+	StringValue      string   `json:"vs,omitempty"`
+	DataValue        string   `json:"vd,omitempty"`
+	From             float64  `json:"from,omitempty"`
+	To               float64  `json:"to,omitempty"`
+	Format           string   `json:"format,omitempty"`
+	Interval         string   `json:"interval,omitempty"`
+	Aggregation      string   `json:"agg,omitempty"`
+	Timezone         string   `json:"timezone,omitempty"`
+	Cursor           string   `json:"cursor,omitempty"`
+	Fields           []string `json:"fields,omitempty"`
 }
 
 // ParseValueComparator convert comparison operator keys into mathematic anotation
@@ -6,6 +6,8 @@ package mocks
 import (
 	"context"
 	"encoding/json"
+	"sort"
+	"strconv"
 	"sync"
 
 	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
@@ -36,33 +38,54 @@ func (repo *messageRepositoryMock) ListProfileMessages(profileID string, rpm rea
 	return repo.readAll(profileID, rpm)
 }
 
-func (repo *messageRepositoryMock) ListAllMessages(rpm readers.PageMetadata) (readers.MessagesPage, error) {
+func (repo *messageRepositoryMock) ListAllMessages(_ context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
 	return repo.readAll("", rpm)
 }
 
-func (repo *messageRepositoryMock) Backup(rpm readers.PageMetadata) (readers.MessagesPage, error) {
+func (repo *messageRepositoryMock) Backup(_ context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
 	return repo.readAll("", rpm)
 }
 
 func (repo *messageRepositoryMock) Restore(ctx context.Context, messages ...senml.Message) error {
-	panic("not implemented")
+	repo.mutex.Lock()
+	defer repo.mutex.Unlock()
+
+	for _, msg := range messages {
+		repo.messages[""] = append(repo.messages[""], msg)
+	}
+
+	return nil
 }
 
 func (repo *messageRepositoryMock) readAll(profileID string, rpm readers.PageMetadata) (readers.MessagesPage, error) {
 	repo.mutex.Lock()
 	defer repo.mutex.Unlock()
 
+	if rpm.Format == readers.JSONFormat {
+		return repo.readJSON(profileID, rpm), nil
+	}
+
 	if rpm.Format != "" && rpm.Format != "messages" {
 		return readers.MessagesPage{}, nil
 	}
 
+	cur, err := readers.DecodeCursor(rpm.Cursor)
+	if err != nil {
+		return readers.MessagesPage{}, err
+	}
+
 	var query map[string]interface{}
 	meta, _ := json.Marshal(rpm)
 	json.Unmarshal(meta, &query)
 
+	// The message IDs are their positions in the repository.
 	var msgs []readers.Message
-	for _, m := range repo.messages[profileID] {
-		senml := m.(senml.Message)
+	var ids []int
+	for id, m := range repo.messages[profileID] {
+		senml, isSenML := m.(senml.Message)
+		if !isSenML {
+			continue
+		}
 
 		ok := true
 
@@ -87,43 +110,31 @@ func (repo *messageRepositoryMock) readAll(profileID string, rpm readers.PageMet
 			case "v":
 				if senml.Value == nil {
 					ok = false
+					break
 				}
 
-				val, okQuery := query["comparator"]
-				if okQuery {
-					switch val.(string) {
-					case readers.LowerThanKey:
-						if senml.Value != nil &&
-							*senml.Value >= rpm.Value {
-							ok = false
-						}
-					case readers.LowerThanEqualKey:
-						if senml.Value != nil &&
-							*senml.Value > rpm.Value {
-							ok = false
-						}
-					case readers.GreaterThanKey:
-						if senml.Value != nil &&
-							*senml.Value <= rpm.Value {
-							ok = false
-						}
-					case readers.GreaterThanEqualKey:
-						if senml.Value != nil &&
-							*senml.Value < rpm.Value {
-							ok = false
-						}
-					case readers.EqualKey:
-					default:
-						if senml.Value != nil &&
-							*senml.Value != rpm.Value {
-							ok = false
-						}
-					}
+				switch rpm.Comparator {
+				case readers.LowerThanKey:
+					ok = *senml.Value < *rpm.Value
+				case readers.LowerThanEqualKey:
+					ok = *senml.Value <= *rpm.Value
+				case readers.GreaterThanKey:
+					ok = *senml.Value > *rpm.Value
+				case readers.GreaterThanEqualKey:
+					ok = *senml.Value >= *rpm.Value
+				default:
+					ok = *senml.Value == *rpm.Value
+				}
+			case "vgt":
+				if senml.Value == nil || *senml.Value <= *rpm.ValueGreaterThan {
+					ok = false
+				}
+			case "vlt":
+				if senml.Value == nil || *senml.Value >= *rpm.ValueLowerThan {
+					ok = false
 				}
 			case "vb":
-				if senml.BoolValue == nil ||
-					(senml.BoolValue != nil &&
-						*senml.BoolValue != rpm.BoolValue) {
+				if senml.BoolValue == nil || *senml.BoolValue != *rpm.BoolValue {
 					ok = false
 				}
 			case "vs":
@@ -146,6 +157,11 @@ func (repo *messageRepositoryMock) readAll(profileID string, rpm readers.PageMet
 				if senml.Time >= rpm.To {
 					ok = false
 				}
+			case "cursor":
+				cid, _ := strconv.Atoi(cur.ID)
+				if senml.Time > cur.FloatTime() || (senml.Time == cur.FloatTime() && id <= cid) {
+					ok = false
+				}
 			}
 
 			if !ok {
@@ -155,26 +171,113 @@ func (repo *messageRepositoryMock) readAll(profileID string, rpm readers.PageMet
 
 		if ok {
 			msgs = append(msgs, m)
+			ids = append(ids, id)
 		}
 	}
 
+	sort.Stable(byTime{msgs: msgs, ids: ids})
+
+	offset := rpm.Offset
+	if !cur.Empty() {
+		offset = 0
+	}
+
 	numOfMessages := uint64(len(msgs))
 
-	if rpm.Offset >= numOfMessages {
+	if offset >= numOfMessages {
 		return readers.MessagesPage{}, nil
 	}
 	if rpm.Limit < 0 {
 		return readers.MessagesPage{}, nil
 	}
 
-	end := rpm.Offset + rpm.Limit
+	end := offset + rpm.Limit
 	if end > numOfMessages || rpm.Limit == noLimit {
 		end = numOfMessages
 	}
 
+	var last readers.Cursor
+	if end > offset {
+		last = readers.Cursor{
+			Time: strconv.FormatFloat(msgs[end-1].(senml.Message).Time, 'f', -1, 64),
+			ID:   strconv.Itoa(ids[end-1]),
+		}
+	}
+
 	return readers.MessagesPage{
 		PageMetadata: rpm,
 		Total:        uint64(len(msgs)),
-		Messages:     msgs[rpm.Offset:end],
+		Messages:     msgs[offset:end],
+		NextCursor:   readers.NextCursor(rpm, end-offset, last).Encode(),
 	}, nil
 }
+
+// byTime orders the SenML messages by time descending and by ID ascending.
+type byTime struct {
+	msgs []readers.Message
+	ids  []int
+}
+
+func (b byTime) Len() int {
+	return len(b.msgs)
+}
+
+func (b byTime) Less(i, j int) bool {
+	ti, tj := b.msgs[i].(senml.Message).Time, b.msgs[j].(senml.Message).Time
+	if ti != tj {
+		return ti > tj
+	}
+
+	return b.ids[i] < b.ids[j]
+}
+
+func (b byTime) Swap(i, j int) {
+	b.msgs[i], b.msgs[j] = b.msgs[j], b.msgs[i]
+	b.ids[i], b.ids[j] = b.ids[j], b.ids[i]
+}
+
+// readJSON reads the page of the JSON messages, which are stored as maps.
+func (repo *messageRepositoryMock) readJSON(profileID string, rpm readers.PageMetadata) readers.MessagesPage {
+	filters := map[string]string{
+		"subtopic":  rpm.Subtopic,
+		"publisher": rpm.Publisher,
+		"protocol":  rpm.Protocol,
+	}
+
+	var msgs []readers.Message
+	for _, m := range repo.messages[profileID] {
+		msg, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for k, v := range filters {
+			if v != "" && msg[k] != v {
+				ok = false
+			}
+		}
+		if ok {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].(map[string]interface{})["created"].(int64) > msgs[j].(map[string]interface{})["created"].(int64)
+	})
+
+	total := uint64(len(msgs))
+	if rpm.Offset >= total {
+		return readers.MessagesPage{PageMetadata: rpm, Total: total}
+	}
+
+	end := rpm.Offset + rpm.Limit
+	if end > total || rpm.Limit == noLimit {
+		end = total
+	}
+
+	return readers.MessagesPage{
+		PageMetadata: rpm,
+		Total:        total,
+		Messages:     msgs[rpm.Offset:end],
+	}
+}
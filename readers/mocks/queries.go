@@ -0,0 +1,126 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/readers"
+)
+
+var _ readers.QueryRepository = (*queryRepositoryMock)(nil)
+
+type queryRepositoryMock struct {
+	mu      sync.Mutex
+	queries map[string]readers.SavedQuery
+}
+
+// NewQueryRepository returns mock implementation of saved query repository.
+func NewQueryRepository() readers.QueryRepository {
+	return &queryRepositoryMock{
+		queries: make(map[string]readers.SavedQuery),
+	}
+}
+
+func (qrm *queryRepositoryMock) Save(_ context.Context, q readers.SavedQuery) error {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	if qrm.nameTaken(q) {
+		return errors.ErrConflict
+	}
+
+	qrm.queries[q.ID] = q
+
+	return nil
+}
+
+func (qrm *queryRepositoryMock) RetrieveByID(_ context.Context, id string) (readers.SavedQuery, error) {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	q, ok := qrm.queries[id]
+	if !ok {
+		return readers.SavedQuery{}, errors.ErrNotFound
+	}
+
+	return q, nil
+}
+
+func (qrm *queryRepositoryMock) RetrieveAll(_ context.Context, ownerID string, offset, limit uint64) (readers.SavedQueriesPage, error) {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	var items []readers.SavedQuery
+	for _, q := range qrm.queries {
+		if q.OwnerID == ownerID {
+			items = append(items, q)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	page := readers.SavedQueriesPage{
+		Total:  uint64(len(items)),
+		Offset: offset,
+		Limit:  limit,
+	}
+
+	if offset >= uint64(len(items)) {
+		return page, nil
+	}
+
+	end := uint64(len(items))
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	page.Queries = items[offset:end]
+
+	return page, nil
+}
+
+func (qrm *queryRepositoryMock) Update(_ context.Context, q readers.SavedQuery) error {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	sq, ok := qrm.queries[q.ID]
+	if !ok || sq.OwnerID != q.OwnerID {
+		return errors.ErrNotFound
+	}
+
+	if qrm.nameTaken(q) {
+		return errors.ErrConflict
+	}
+
+	sq.Name, sq.Filters = q.Name, q.Filters
+	qrm.queries[q.ID] = sq
+
+	return nil
+}
+
+func (qrm *queryRepositoryMock) Remove(_ context.Context, ownerID, id string) error {
+	qrm.mu.Lock()
+	defer qrm.mu.Unlock()
+
+	if q, ok := qrm.queries[id]; ok && q.OwnerID == ownerID {
+		delete(qrm.queries, id)
+	}
+
+	return nil
+}
+
+func (qrm *queryRepositoryMock) nameTaken(q readers.SavedQuery) bool {
+	for _, sq := range qrm.queries {
+		if sq.OwnerID == q.OwnerID && sq.Name == q.Name && sq.ID != q.ID {
+			return true
+		}
+	}
+
+	return false
+}
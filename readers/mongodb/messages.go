@@ -6,11 +6,13 @@ package mongodb
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
 	"github.com/MainfluxLabs/mainflux/readers"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -36,12 +38,12 @@ func New(db *mongo.Database) readers.MessageRepository {
 	}
 }
 
-func (repo mongoRepository) ListAllMessages(rpm readers.PageMetadata) (readers.MessagesPage, error) {
-	return repo.readAll("", rpm)
+func (repo mongoRepository) ListAllMessages(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	return repo.readAll(ctx, "", rpm)
 }
 
-func (repo mongoRepository) Backup(rpm readers.PageMetadata) (readers.MessagesPage, error) {
-	return repo.readAll("", rpm)
+func (repo mongoRepository) Backup(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	return repo.readAll(ctx, "", rpm)
 }
 
 func (repo mongoRepository) Restore(ctx context.Context, messages ...senml.Message) error {
@@ -51,7 +53,7 @@ func (repo mongoRepository) Restore(ctx context.Context, messages ...senml.Messa
 		dbMsgs = append(dbMsgs, msg)
 	}
 
-	_, err := coll.InsertMany(context.Background(), dbMsgs)
+	_, err := coll.InsertMany(ctx, dbMsgs)
 	if err != nil {
 		return errors.Wrap(errors.ErrSaveMessage, err)
 	}
@@ -59,71 +61,152 @@ func (repo mongoRepository) Restore(ctx context.Context, messages ...senml.Messa
 	return nil
 }
 
-func (repo mongoRepository) readAll(profileID string, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+func (repo mongoRepository) readAll(ctx context.Context, profileID string, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	if rpm.Interval != "" {
+		return readers.MessagesPage{}, readers.ErrUnsupportedAggregation
+	}
+
+	cur, err := readers.DecodeCursor(rpm.Cursor)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+
+	var cursorID primitive.ObjectID
+	if !cur.Empty() {
+		if cursorID, err = primitive.ObjectIDFromHex(cur.ID); err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, readers.ErrInvalidCursor)
+		}
+	}
+
 	format := defCollection
 	order := "time"
 	if rpm.Format == jsonCollection {
 		order = "created"
 		format = rpm.Format
 	}
+	// The messages having the same time are ordered by their unique ID, so
+	// that the cursor points to the same position on every read.
+	sort := bson.D{{Key: order, Value: -1}, {Key: "_id", Value: 1}}
 
 	col := repo.db.Collection(format)
 
-	sortMap := map[string]interface{}{
-		order: -1,
-	}
 	// Remove format filter and format the rest properly.
 	filter := fmtCondition(profileID, rpm)
+	query := filter
+	offset := rpm.Offset
+	if !cur.Empty() {
+		var t interface{} = cur.FloatTime()
+		if format == jsonCollection {
+			t = cur.IntTime()
+		}
+		after := bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: order, Value: bson.M{"$lt": t}}},
+			bson.D{{Key: order, Value: t}, {Key: "_id", Value: bson.M{"$gt": cursorID}}},
+		}}}
+		query = bson.D{{Key: "$and", Value: bson.A{filter, after}}}
+		offset = 0
+	}
+
+	opts := options.Find().SetSort(sort)
+	if len(rpm.Fields) > 0 {
+		opts = opts.SetProjection(fmtProjection(order, rpm.Fields))
+	}
+
 	var cursor *mongo.Cursor
-	var err error
 	switch rpm.Limit {
 	case noLimit:
-		cursor, err = col.Find(context.Background(), filter, options.Find().SetSort(sortMap))
+		cursor, err = col.Find(ctx, query, opts)
 	default:
-		cursor, err = col.Find(context.Background(), filter, options.Find().SetSort(sortMap).SetLimit(int64(rpm.Limit)).SetSkip(int64(rpm.Offset)))
+		cursor, err = col.Find(ctx, query, opts.SetLimit(int64(rpm.Limit)).SetSkip(int64(offset)))
 	}
 	if err != nil {
 		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 
 	}
-	defer cursor.Close(context.Background())
+	defer cursor.Close(ctx)
 
 	var messages []readers.Message
+	var last readers.Cursor
 	switch format {
 	case defCollection:
-		for cursor.Next(context.Background()) {
-			var m senml.Message
+		for cursor.Next(ctx) {
+			var m senmlMessage
 			if err := cursor.Decode(&m); err != nil {
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
 
-			messages = append(messages, m)
+			messages = append(messages, m.Message)
+			last = readers.Cursor{Time: strconv.FormatFloat(m.Time, 'f', -1, 64), ID: m.ID.Hex()}
 		}
 	default:
-		for cursor.Next(context.Background()) {
+		for cursor.Next(ctx) {
 			var m map[string]interface{}
 			if err := cursor.Decode(&m); err != nil {
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
 
+			id, _ := m["_id"].(primitive.ObjectID)
+			if len(rpm.Fields) > 0 {
+				delete(m, "_id")
+			}
+
 			messages = append(messages, m)
+			last = readers.Cursor{Time: fmtCreated(m["created"]), ID: id.Hex()}
 		}
 	}
 
-	total, err := col.CountDocuments(context.Background(), filter)
-	if err != nil {
-		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
-	}
-
 	mp := readers.MessagesPage{
 		PageMetadata: rpm,
-		Total:        uint64(total),
 		Messages:     messages,
+		NextCursor:   readers.NextCursor(rpm, uint64(len(messages)), last).Encode(),
 	}
 
+	// Counting the messages takes as long as reading all of them, so it's
+	// skipped when the pages are read using the cursor.
+	if !cur.Empty() {
+		return mp, nil
+	}
+
+	total, err := col.CountDocuments(ctx, filter)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	mp.Total = uint64(total)
+
 	return mp, nil
 }
 
+// senmlMessage is the SenML message read along with its ID, which the page
+// cursor points to.
+type senmlMessage struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	senml.Message `bson:",inline"`
+}
+
+func fmtCreated(created interface{}) string {
+	switch c := created.(type) {
+	case int64:
+		return strconv.FormatInt(c, 10)
+	case int32:
+		return strconv.FormatInt(int64(c), 10)
+	case float64:
+		return strconv.FormatFloat(c, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// fmtProjection returns the projection of the selected fields. The order
+// and ID fields are always read, since the page cursor is built from them.
+func fmtProjection(order string, fields []string) bson.M {
+	projection := bson.M{order: 1}
+	for _, f := range fields {
+		projection[f] = 1
+	}
+
+	return projection
+}
+
 func fmtCondition(profileID string, rpm readers.PageMetadata) bson.D {
 	filter := bson.D{}
 
@@ -138,6 +221,10 @@ func fmtCondition(profileID string, rpm readers.PageMetadata) bson.D {
 	}
 	json.Unmarshal(meta, &query)
 
+	// The value and time conditions are merged, since the repeated
+	// filter keys would override each other.
+	valueFilter := bson.M{}
+	timeFilter := bson.M{}
 	for name, value := range query {
 		switch name {
 		case
@@ -148,23 +235,29 @@ func fmtCondition(profileID string, rpm readers.PageMetadata) bson.D {
 			"protocol":
 			filter = append(filter, bson.E{Key: name, Value: value})
 		case "v":
-			bsonFilter := value
+			op := "$eq"
 			val, ok := query["comparator"]
 			if ok {
 				switch val.(string) {
-				case readers.EqualKey:
-					bsonFilter = value
 				case readers.LowerThanKey:
-					bsonFilter = bson.M{"$lt": value}
+					op = "$lt"
 				case readers.LowerThanEqualKey:
-					bsonFilter = bson.M{"$lte": value}
+					op = "$lte"
 				case readers.GreaterThanKey:
-					bsonFilter = bson.M{"$gt": value}
+					op = "$gt"
 				case readers.GreaterThanEqualKey:
-					bsonFilter = bson.M{"$gte": value}
+					op = "$gte"
 				}
 			}
-			filter = append(filter, bson.E{Key: "value", Value: bsonFilter})
+			valueFilter[op] = value
+		case "vgt":
+			if v, ok := valueFilter["$gt"].(float64); !ok || value.(float64) > v {
+				valueFilter["$gt"] = value
+			}
+		case "vlt":
+			if v, ok := valueFilter["$lt"].(float64); !ok || value.(float64) < v {
+				valueFilter["$lt"] = value
+			}
 		case "vb":
 			filter = append(filter, bson.E{Key: "bool_value", Value: value})
 		case "vs":
@@ -172,11 +265,19 @@ func fmtCondition(profileID string, rpm readers.PageMetadata) bson.D {
 		case "vd":
 			filter = append(filter, bson.E{Key: "data_value", Value: value})
 		case "from":
-			filter = append(filter, bson.E{Key: "time", Value: bson.M{"$gte": value}})
+			timeFilter["$gte"] = value
 		case "to":
-			filter = append(filter, bson.E{Key: "time", Value: bson.M{"$lt": value}})
+			timeFilter["$lt"] = value
 		}
 	}
 
+	if len(valueFilter) > 0 {
+		filter = append(filter, bson.E{Key: "value", Value: valueFilter})
+	}
+
+	if len(timeFilter) > 0 {
+		filter = append(filter, bson.E{Key: "time", Value: timeFilter})
+	}
+
 	return filter
 }
@@ -110,6 +110,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 	err = writer.Consume(messages)
 	require.Nil(t, err, fmt.Sprintf("failed to store message to MongoDB: %s", err))
 
+	below, above := v-1, v+1
 	cases := map[string]struct {
 		pageMeta readers.PageMetadata
 		page     readers.MessagesPage
@@ -176,7 +177,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value": {
 			pageMeta: readers.PageMetadata{
 				Limit: noLimit,
-				Value: v,
+				Value: &v,
 			},
 			page: readers.MessagesPage{
 				Total:    uint64(len(valueMsgs)),
@@ -186,7 +187,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v,
+				Value:      &v,
 				Comparator: readers.EqualKey,
 			},
 			page: readers.MessagesPage{
@@ -197,7 +198,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and lower-than comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v + 1,
+				Value:      &above,
 				Comparator: readers.LowerThanKey,
 			},
 			page: readers.MessagesPage{
@@ -208,7 +209,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and lower-than-or-equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v + 1,
+				Value:      &above,
 				Comparator: readers.LowerThanEqualKey,
 			},
 			page: readers.MessagesPage{
@@ -219,7 +220,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and greater-than comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v - 1,
+				Value:      &below,
 				Comparator: readers.GreaterThanKey,
 			},
 			page: readers.MessagesPage{
@@ -230,7 +231,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and greater-than-or-equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v - 1,
+				Value:      &below,
 				Comparator: readers.GreaterThanEqualKey,
 			},
 			page: readers.MessagesPage{
@@ -238,10 +239,31 @@ func TestListAllMessagesSenML(t *testing.T) {
 				Messages: fromSenml(valueMsgs),
 			},
 		},
+		"read messages with value range": {
+			pageMeta: readers.PageMetadata{
+				Limit:            noLimit,
+				ValueGreaterThan: &below,
+				ValueLowerThan:   &above,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(valueMsgs)),
+				Messages: fromSenml(valueMsgs),
+			},
+		},
+		"read messages with value out of range": {
+			pageMeta: readers.PageMetadata{
+				Limit:            noLimit,
+				ValueGreaterThan: &v,
+				ValueLowerThan:   &above,
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
 		"read messages with boolean value": {
 			pageMeta: readers.PageMetadata{
 				Limit:     noLimit,
-				BoolValue: vb,
+				BoolValue: &vb,
 			},
 			page: readers.MessagesPage{
 				Total:    uint64(len(boolMsgs)),
@@ -302,7 +324,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		result, err := reader.ListAllMessages(tc.pageMeta)
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
 		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
 		assert.ElementsMatch(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
 		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
@@ -404,7 +426,7 @@ func TestListAllMessagesJSON(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		result, err := reader.ListAllMessages(tc.pageMeta)
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
 
 		for i := 0; i < len(result.Messages); i++ {
 			m := result.Messages[i]
@@ -0,0 +1,154 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodb
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/readers"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Collection for saved queries
+const queriesCollection = "saved_queries"
+
+var _ readers.QueryRepository = (*queryRepository)(nil)
+
+type queryRepository struct {
+	db *mongo.Database
+}
+
+// NewQueryRepository instantiates a MongoDB implementation of saved query repository.
+func NewQueryRepository(db *mongo.Database) readers.QueryRepository {
+	return queryRepository{
+		db: db,
+	}
+}
+
+type dbQuery struct {
+	ID      string               `bson:"_id"`
+	OwnerID string               `bson:"owner_id"`
+	OrgID   string               `bson:"org_id"`
+	Name    string               `bson:"name"`
+	Filters readers.PageMetadata `bson:"filters"`
+}
+
+func (repo queryRepository) Save(ctx context.Context, q readers.SavedQuery) error {
+	if err := repo.checkName(ctx, q); err != nil {
+		return err
+	}
+
+	coll := repo.db.Collection(queriesCollection)
+	if _, err := coll.InsertOne(ctx, dbQuery(q)); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.Wrap(errors.ErrConflict, err)
+		}
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo queryRepository) RetrieveByID(ctx context.Context, id string) (readers.SavedQuery, error) {
+	coll := repo.db.Collection(queriesCollection)
+
+	var dbq dbQuery
+	if err := coll.FindOne(ctx, bson.M{"_id": id}).Decode(&dbq); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return readers.SavedQuery{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return readers.SavedQuery{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return readers.SavedQuery(dbq), nil
+}
+
+func (repo queryRepository) RetrieveAll(ctx context.Context, ownerID string, offset, limit uint64) (readers.SavedQueriesPage, error) {
+	coll := repo.db.Collection(queriesCollection)
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}}).SetSkip(int64(offset))
+	if limit != noLimit {
+		opts = opts.SetLimit(int64(limit))
+	}
+
+	filter := bson.M{"owner_id": ownerID}
+	cursor, err := coll.Find(ctx, filter, opts)
+	if err != nil {
+		return readers.SavedQueriesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer cursor.Close(ctx)
+
+	var items []readers.SavedQuery
+	for cursor.Next(ctx) {
+		var dbq dbQuery
+		if err := cursor.Decode(&dbq); err != nil {
+			return readers.SavedQueriesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		items = append(items, readers.SavedQuery(dbq))
+	}
+
+	total, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return readers.SavedQueriesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return readers.SavedQueriesPage{
+		Total:   uint64(total),
+		Offset:  offset,
+		Limit:   limit,
+		Queries: items,
+	}, nil
+}
+
+func (repo queryRepository) Update(ctx context.Context, q readers.SavedQuery) error {
+	if err := repo.checkName(ctx, q); err != nil {
+		return err
+	}
+
+	coll := repo.db.Collection(queriesCollection)
+	update := bson.M{"$set": bson.M{"name": q.Name, "filters": q.Filters}}
+
+	res, err := coll.UpdateOne(ctx, bson.M{"_id": q.ID, "owner_id": q.OwnerID}, update)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if res.MatchedCount == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo queryRepository) Remove(ctx context.Context, ownerID, id string) error {
+	coll := repo.db.Collection(queriesCollection)
+
+	if _, err := coll.DeleteOne(ctx, bson.M{"_id": id, "owner_id": ownerID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+// checkName returns ErrConflict if another saved query of the owner has the
+// name of the provided one, since the collection has no unique index on the
+// names.
+func (repo queryRepository) checkName(ctx context.Context, q readers.SavedQuery) error {
+	coll := repo.db.Collection(queriesCollection)
+
+	filter := bson.M{"owner_id": q.OwnerID, "name": q.Name, "_id": bson.M{"$ne": q.ID}}
+	cnt, err := coll.CountDocuments(ctx, filter)
+	if err != nil {
+		return errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	if cnt > 0 {
+		return errors.ErrConflict
+	}
+
+	return nil
+}
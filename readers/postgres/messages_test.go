@@ -4,6 +4,7 @@
 package postgres_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -97,6 +98,8 @@ func TestListAllMessagesSenML(t *testing.T) {
 	err = writer.Consume(messages)
 	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
 
+	below, above := v-1, v+1
+
 	// Since messages are not saved in natural order,
 	// cases that return subset of messages are only
 	// checking data result set size, but not content.
@@ -165,7 +168,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value": {
 			pageMeta: readers.PageMetadata{
 				Limit: noLimit,
-				Value: v,
+				Value: &v,
 			},
 			page: readers.MessagesPage{
 				Total:    uint64(len(valueMsgs)),
@@ -175,7 +178,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v,
+				Value:      &v,
 				Comparator: readers.EqualKey,
 			},
 			page: readers.MessagesPage{
@@ -186,7 +189,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and lower-than comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v + 1,
+				Value:      &above,
 				Comparator: readers.LowerThanKey,
 			},
 			page: readers.MessagesPage{
@@ -197,7 +200,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and lower-than-or-equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v + 1,
+				Value:      &above,
 				Comparator: readers.LowerThanEqualKey,
 			},
 			page: readers.MessagesPage{
@@ -208,7 +211,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and greater-than comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v - 1,
+				Value:      &below,
 				Comparator: readers.GreaterThanKey,
 			},
 			page: readers.MessagesPage{
@@ -219,7 +222,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and greater-than-or-equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v - 1,
+				Value:      &below,
 				Comparator: readers.GreaterThanEqualKey,
 			},
 			page: readers.MessagesPage{
@@ -227,10 +230,31 @@ func TestListAllMessagesSenML(t *testing.T) {
 				Messages: fromSenml(valueMsgs),
 			},
 		},
+		"read messages with value range": {
+			pageMeta: readers.PageMetadata{
+				Limit:            noLimit,
+				ValueGreaterThan: &below,
+				ValueLowerThan:   &above,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(valueMsgs)),
+				Messages: fromSenml(valueMsgs),
+			},
+		},
+		"read messages with value out of range": {
+			pageMeta: readers.PageMetadata{
+				Limit:            noLimit,
+				ValueGreaterThan: &v,
+				ValueLowerThan:   &above,
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
 		"read messages with boolean value": {
 			pageMeta: readers.PageMetadata{
 				Limit:     noLimit,
-				BoolValue: vb,
+				BoolValue: &vb,
 			},
 			page: readers.MessagesPage{
 				Total:    uint64(len(boolMsgs)),
@@ -291,7 +315,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		result, err := reader.ListAllMessages(tc.pageMeta)
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
 		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
 		assert.ElementsMatch(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
 		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
@@ -361,6 +385,39 @@ func TestListAllMessagesJSON(t *testing.T) {
 	err = writer.Consume(messages2)
 	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
 
+	id3, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	messages3 := json.Messages{}
+	namedMsgs := []map[string]interface{}{}
+	valueMsgs := []map[string]interface{}{}
+	created = time.Now().Unix()
+	for i := 0; i < msgsNum; i++ {
+		msg := json.Message{
+			Publisher: id3,
+			Subtopic:  subtopic,
+			Protocol:  httpProt,
+			Created:   created + int64(i),
+			Payload: map[string]interface{}{
+				"name":  msgName,
+				"value": float64(i),
+				"unit":  "C",
+			},
+		}
+		if i > msgsNum/2 {
+			valueMsgs = append(valueMsgs, toMap(msg))
+		}
+
+		messages3.Data = append(messages3.Data, msg)
+		namedMsgs = append(namedMsgs, toMap(msg))
+		msgs1 = append(msgs1, toMap(msg))
+		httpMsgs = append(httpMsgs, toMap(msg))
+	}
+
+	err = writer.Consume(messages3)
+	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	valueGT := float64(msgsNum / 2)
+
 	cases := map[string]struct {
 		pageMeta readers.PageMetadata
 		page     readers.MessagesPage
@@ -386,16 +443,174 @@ func TestListAllMessagesJSON(t *testing.T) {
 				Messages: fromJSON(httpMsgs),
 			},
 		},
+		"read messages with name": {
+			pageMeta: readers.PageMetadata{
+				Format: jsonFormat,
+				Limit:  noLimit,
+				Name:   msgName,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(namedMsgs)),
+				Messages: fromJSON(namedMsgs),
+			},
+		},
+		"read messages with value greater than": {
+			pageMeta: readers.PageMetadata{
+				Format:           jsonFormat,
+				Limit:            noLimit,
+				Name:             msgName,
+				ValueGreaterThan: &valueGT,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(valueMsgs)),
+				Messages: fromJSON(valueMsgs),
+			},
+		},
 	}
 
 	for desc, tc := range cases {
-		result, err := reader.ListAllMessages(tc.pageMeta)
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
 		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
 		assert.ElementsMatch(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
 		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
 	}
 }
 
+func TestListAllMessagesCursor(t *testing.T) {
+	reader := preader.New(db)
+	writer := pwriter.New(db)
+
+	pubID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	// Every three messages have the same time, publisher, subtopic and name,
+	// so that the pages end in the middle of the messages which differ only
+	// in their IDs.
+	now := time.Now().Unix()
+	var messages []senml.Message
+	for i := 0; i < msgsNum; i++ {
+		value := float64(i)
+		messages = append(messages, senml.Message{
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(now - int64(i/3)),
+			Value:     &value,
+		})
+	}
+
+	err = writer.Consume(messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	var read []readers.Message
+	pm := readers.PageMetadata{Limit: 7, Publisher: pubID}
+	for {
+		page, err := reader.ListAllMessages(context.Background(), pm)
+		require.Nil(t, err, fmt.Sprintf("read messages page: expected no error got %s", err))
+
+		read = append(read, page.Messages...)
+		if page.NextCursor == "" {
+			break
+		}
+		pm.Cursor = page.NextCursor
+	}
+
+	assert.Equal(t, fromSenml(messages), read, "read messages using cursor: expected all of the messages in order")
+}
+
+func TestListAllMessagesAggregation(t *testing.T) {
+	reader := preader.New(db)
+	writer := pwriter.New(db)
+
+	pubID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	// The third message is sent on the 10th of January in UTC, but on the
+	// 11th in Belgrade, so the calendar days differ between the time zones.
+	day := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	offsets := []time.Duration{time.Hour, 2 * time.Hour, 23*time.Hour + 30*time.Minute, 36 * time.Hour}
+	var messages []senml.Message
+	for i, o := range offsets {
+		value := float64(i + 1)
+		messages = append(messages, senml.Message{
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(day.Add(o).Unix()),
+			Value:     &value,
+		})
+	}
+
+	err = writer.Consume(messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	belgrade, err := time.LoadLocation("Europe/Belgrade")
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	localDay := time.Date(2024, time.January, 10, 0, 0, 0, 0, belgrade)
+
+	bucket := func(start time.Time, value float64) senml.Message {
+		return senml.Message{
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(start.Unix()),
+			Value:     &value,
+		}
+	}
+
+	cases := map[string]struct {
+		pageMeta readers.PageMetadata
+		page     readers.MessagesPage
+	}{
+		"aggregate sum of messages by UTC day": {
+			pageMeta: readers.PageMetadata{
+				Limit:       noLimit,
+				Publisher:   pubID,
+				Interval:    readers.DayInterval,
+				Aggregation: readers.SumAggregation,
+				Timezone:    "UTC",
+			},
+			page: readers.MessagesPage{
+				Total:    2,
+				Messages: fromSenml([]senml.Message{bucket(day.AddDate(0, 0, 1), 4), bucket(day, 6)}),
+			},
+		},
+		"aggregate sum of messages by local day": {
+			pageMeta: readers.PageMetadata{
+				Limit:       noLimit,
+				Publisher:   pubID,
+				Interval:    readers.DayInterval,
+				Aggregation: readers.SumAggregation,
+				Timezone:    belgrade.String(),
+			},
+			page: readers.MessagesPage{
+				Total:    2,
+				Messages: fromSenml([]senml.Message{bucket(localDay.AddDate(0, 0, 1), 7), bucket(localDay, 3)}),
+			},
+		},
+		"aggregate count of messages by UTC month": {
+			pageMeta: readers.PageMetadata{
+				Limit:       noLimit,
+				Publisher:   pubID,
+				Interval:    readers.MonthInterval,
+				Aggregation: readers.CountAggregation,
+				Timezone:    "UTC",
+			},
+			page: readers.MessagesPage{
+				Total:    1,
+				Messages: fromSenml([]senml.Message{bucket(day.AddDate(0, 0, -9), 4)}),
+			},
+		},
+	}
+
+	for desc, tc := range cases {
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
+		assert.Equal(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
+		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
+	}
+}
+
 func fromSenml(msg []senml.Message) []readers.Message {
 	var ret []readers.Message
 	for _, m := range msg {
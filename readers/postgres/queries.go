@@ -0,0 +1,201 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/readers"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ readers.QueryRepository = (*queryRepository)(nil)
+
+type queryRepository struct {
+	db *sqlx.DB
+}
+
+// NewQueryRepository instantiates a PostgreSQL implementation of saved query repository.
+func NewQueryRepository(db *sqlx.DB) readers.QueryRepository {
+	return &queryRepository{
+		db: db,
+	}
+}
+
+func (qr queryRepository) Save(ctx context.Context, q readers.SavedQuery) error {
+	query := `INSERT INTO saved_queries (id, owner_id, org_id, name, filters) VALUES (:id, :owner_id, :org_id, :name, :filters);`
+
+	dbq, err := toDBQuery(q)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	if _, err := qr.db.NamedExecContext(ctx, query, dbq); err != nil {
+		if err := queryError(err); err != nil {
+			return err
+		}
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (qr queryRepository) RetrieveByID(ctx context.Context, id string) (readers.SavedQuery, error) {
+	query := `SELECT id, owner_id, org_id, name, filters FROM saved_queries WHERE id = $1;`
+
+	var dbq dbQuery
+	if err := qr.db.QueryRowxContext(ctx, query, id).StructScan(&dbq); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return readers.SavedQuery{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return readers.SavedQuery{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toQuery(dbq)
+}
+
+func (qr queryRepository) RetrieveAll(ctx context.Context, ownerID string, offset, limit uint64) (readers.SavedQueriesPage, error) {
+	olq := dbutil.GetOffsetLimitQuery(limit)
+	query := fmt.Sprintf(`SELECT id, owner_id, org_id, name, filters FROM saved_queries WHERE owner_id = :owner_id ORDER BY name %s;`, olq)
+
+	params := map[string]interface{}{
+		"owner_id": ownerID,
+		"offset":   offset,
+		"limit":    limit,
+	}
+	rows, err := qr.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return readers.SavedQueriesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []readers.SavedQuery
+	for rows.Next() {
+		var dbq dbQuery
+		if err := rows.StructScan(&dbq); err != nil {
+			return readers.SavedQueriesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		q, err := toQuery(dbq)
+		if err != nil {
+			return readers.SavedQueriesPage{}, err
+		}
+		items = append(items, q)
+	}
+
+	var total uint64
+	if err := qr.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM saved_queries WHERE owner_id = $1;`, ownerID); err != nil {
+		return readers.SavedQueriesPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return readers.SavedQueriesPage{
+		Total:   total,
+		Offset:  offset,
+		Limit:   limit,
+		Queries: items,
+	}, nil
+}
+
+func (qr queryRepository) Update(ctx context.Context, q readers.SavedQuery) error {
+	query := `UPDATE saved_queries SET name = :name, filters = :filters WHERE id = :id AND owner_id = :owner_id;`
+
+	dbq, err := toDBQuery(q)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, err := qr.db.NamedExecContext(ctx, query, dbq)
+	if err != nil {
+		if err := queryError(err); err != nil {
+			return err
+		}
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (qr queryRepository) Remove(ctx context.Context, ownerID, id string) error {
+	query := `DELETE FROM saved_queries WHERE id = :id AND owner_id = :owner_id;`
+
+	if _, err := qr.db.NamedExecContext(ctx, query, dbQuery{ID: id, OwnerID: ownerID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+// queryError returns the error of the saved query which is rejected by the
+// database, or nil if the query failed for another reason.
+func queryError(err error) error {
+	pgErr, ok := err.(*pgconn.PgError)
+	if !ok {
+		return nil
+	}
+
+	switch pgErr.Code {
+	case pgerrcode.InvalidTextRepresentation, pgerrcode.StringDataRightTruncationDataException:
+		return errors.Wrap(errors.ErrMalformedEntity, err)
+	case pgerrcode.UniqueViolation:
+		return errors.Wrap(errors.ErrConflict, err)
+	}
+
+	return nil
+}
+
+type dbQuery struct {
+	ID      string `db:"id"`
+	OwnerID string `db:"owner_id"`
+	OrgID   string `db:"org_id"`
+	Name    string `db:"name"`
+	Filters []byte `db:"filters"`
+}
+
+func toDBQuery(q readers.SavedQuery) (dbQuery, error) {
+	filters, err := json.Marshal(q.Filters)
+	if err != nil {
+		return dbQuery{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return dbQuery{
+		ID:      q.ID,
+		OwnerID: q.OwnerID,
+		OrgID:   q.OrgID,
+		Name:    q.Name,
+		Filters: filters,
+	}, nil
+}
+
+func toQuery(dbq dbQuery) (readers.SavedQuery, error) {
+	var filters readers.PageMetadata
+	if err := json.Unmarshal(dbq.Filters, &filters); err != nil {
+		return readers.SavedQuery{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return readers.SavedQuery{
+		ID:      dbq.ID,
+		OwnerID: dbq.OwnerID,
+		OrgID:   dbq.OrgID,
+		Name:    dbq.Name,
+		Filters: filters,
+	}, nil
+}
@@ -0,0 +1,282 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/readers"
+	preader "github.com/MainfluxLabs/mainflux/readers/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	queryName = "query"
+	invalidID = "invalid"
+)
+
+var filters = readers.PageMetadata{Limit: 10, Subtopic: subtopic, Name: msgName, Comparator: "gt", ValueGreaterThan: &v}
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newQuery(t *testing.T, ownerID, name string) readers.SavedQuery {
+	return readers.SavedQuery{
+		ID:      generateUUID(t),
+		OwnerID: ownerID,
+		OrgID:   generateUUID(t),
+		Name:    name,
+		Filters: filters,
+	}
+}
+
+func saveQuery(t *testing.T, repo readers.QueryRepository, q readers.SavedQuery) readers.SavedQuery {
+	err := repo.Save(context.Background(), q)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return q
+}
+
+func TestSaveQuery(t *testing.T) {
+	repo := preader.NewQueryRepository(db)
+	ownerID := generateUUID(t)
+
+	invalid := newQuery(t, ownerID, queryName)
+	invalid.ID = invalidID
+
+	cases := []struct {
+		desc  string
+		query readers.SavedQuery
+		err   error
+	}{
+		{
+			desc:  "save query",
+			query: newQuery(t, ownerID, queryName),
+			err:   nil,
+		},
+		{
+			desc:  "save query with existing name",
+			query: newQuery(t, ownerID, queryName),
+			err:   errors.ErrConflict,
+		},
+		{
+			desc:  "save query with existing name of other owner",
+			query: newQuery(t, generateUUID(t), queryName),
+			err:   nil,
+		},
+		{
+			desc:  "save query with invalid id",
+			query: invalid,
+			err:   errors.ErrMalformedEntity,
+		},
+		{
+			desc:  "save query with invalid name",
+			query: newQuery(t, ownerID, strings.Repeat("m", 255)),
+			err:   errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.query)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveQueryByID(t *testing.T) {
+	repo := preader.NewQueryRepository(db)
+	q := saveQuery(t, repo, newQuery(t, generateUUID(t), queryName))
+
+	cases := []struct {
+		desc  string
+		id    string
+		query readers.SavedQuery
+		err   error
+	}{
+		{
+			desc:  "retrieve existing query",
+			id:    q.ID,
+			query: q,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve non-existing query",
+			id:    generateUUID(t),
+			query: readers.SavedQuery{},
+			err:   errors.ErrNotFound,
+		},
+		{
+			desc:  "retrieve query with invalid id",
+			id:    invalidID,
+			query: readers.SavedQuery{},
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.query, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.query, res))
+	}
+}
+
+func TestRetrieveAllQueries(t *testing.T) {
+	repo := preader.NewQueryRepository(db)
+	ownerID := generateUUID(t)
+
+	n := uint64(5)
+	var names []string
+	for i := uint64(0); i < n; i++ {
+		q := saveQuery(t, repo, newQuery(t, ownerID, fmt.Sprintf("%s-%d", queryName, i)))
+		names = append(names, q.Name)
+	}
+	saveQuery(t, repo, newQuery(t, generateUUID(t), queryName))
+
+	cases := []struct {
+		desc    string
+		ownerID string
+		offset  uint64
+		limit   uint64
+		names   []string
+		total   uint64
+	}{
+		{
+			desc:    "retrieve all queries of the owner",
+			ownerID: ownerID,
+			offset:  0,
+			limit:   n,
+			names:   names,
+			total:   n,
+		},
+		{
+			desc:    "retrieve subset of queries of the owner",
+			ownerID: ownerID,
+			offset:  1,
+			limit:   2,
+			names:   names[1:3],
+			total:   n,
+		},
+		{
+			desc:    "retrieve queries of the owner without queries",
+			ownerID: generateUUID(t),
+			offset:  0,
+			limit:   n,
+			names:   nil,
+			total:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveAll(context.Background(), tc.ownerID, tc.offset, tc.limit)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+
+		// The queries are ordered by their names.
+		var res []string
+		for _, q := range page.Queries {
+			res = append(res, q.Name)
+		}
+		assert.Equal(t, tc.names, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.names, res))
+	}
+}
+
+func TestUpdateQuery(t *testing.T) {
+	repo := preader.NewQueryRepository(db)
+	ownerID := generateUUID(t)
+
+	q := saveQuery(t, repo, newQuery(t, ownerID, queryName))
+	other := saveQuery(t, repo, newQuery(t, ownerID, "other"))
+
+	updated := q
+	updated.Name = "updated"
+	updated.Filters = readers.PageMetadata{Limit: 5, Publisher: generateUUID(t)}
+
+	conflicting := q
+	conflicting.Name = other.Name
+
+	otherOwner := updated
+	otherOwner.OwnerID = generateUUID(t)
+
+	cases := []struct {
+		desc  string
+		query readers.SavedQuery
+		err   error
+	}{
+		{
+			desc:  "update existing query",
+			query: updated,
+			err:   nil,
+		},
+		{
+			desc:  "update query with existing name",
+			query: conflicting,
+			err:   errors.ErrConflict,
+		},
+		{
+			desc:  "update query of other owner",
+			query: otherOwner,
+			err:   errors.ErrNotFound,
+		},
+		{
+			desc:  "update non-existing query",
+			query: newQuery(t, ownerID, "missing"),
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.query)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), q.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, updated, res, fmt.Sprintf("expected %v got %v\n", updated, res))
+}
+
+func TestRemoveQuery(t *testing.T) {
+	repo := preader.NewQueryRepository(db)
+	ownerID := generateUUID(t)
+	q := saveQuery(t, repo, newQuery(t, ownerID, queryName))
+
+	cases := []struct {
+		desc    string
+		ownerID string
+		id      string
+		removed bool
+	}{
+		{
+			desc:    "remove query of other owner",
+			ownerID: generateUUID(t),
+			id:      q.ID,
+			removed: false,
+		},
+		{
+			desc:    "remove existing query",
+			ownerID: ownerID,
+			id:      q.ID,
+			removed: true,
+		},
+		{
+			desc:    "remove removed query",
+			ownerID: ownerID,
+			id:      q.ID,
+			removed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.ownerID, tc.id)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.Equal(t, tc.removed, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected removed %t got %s\n", tc.desc, tc.removed, err))
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import "context"
+
+// SavedQuery represents the named reader query of the user. The messages are
+// read using its filters and aggregation, from the org the query belongs to,
+// so that the clients don't re-send them with every request. The pagination
+// isn't saved along with the query.
+type SavedQuery struct {
+	ID      string
+	OwnerID string
+	OrgID   string
+	Name    string
+	Filters PageMetadata
+}
+
+// SavedQueriesPage contains page related metadata as well as a list of saved
+// queries that belong to this page.
+type SavedQueriesPage struct {
+	Total   uint64
+	Offset  uint64
+	Limit   uint64
+	Queries []SavedQuery
+}
+
+// QueryRepository specifies a saved query persistence API.
+type QueryRepository interface {
+	// Save persists the saved query. The query names are unique per owner.
+	Save(ctx context.Context, q SavedQuery) error
+
+	// RetrieveByID retrieves the saved query having the provided identifier,
+	// regardless of its owner.
+	RetrieveByID(ctx context.Context, id string) (SavedQuery, error)
+
+	// RetrieveAll retrieves the page of the saved queries of the owner,
+	// ordered by name.
+	RetrieveAll(ctx context.Context, ownerID string, offset, limit uint64) (SavedQueriesPage, error)
+
+	// Update performs an update to the name and the filters of the existing
+	// saved query of the owner.
+	Update(ctx context.Context, q SavedQuery) error
+
+	// Remove removes the saved query of the owner having the provided identifier.
+	Remove(ctx context.Context, ownerID, id string) error
+}
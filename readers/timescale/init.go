@@ -6,6 +6,8 @@ package timescale
 import (
 	"fmt"
 
+	writer "github.com/MainfluxLabs/mainflux/consumers/writers/timescale"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 	migrate "github.com/rubenv/sql-migrate"
@@ -13,74 +15,44 @@ import (
 
 // Config defines the options that are used when connecting to a TimescaleSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the TimescaleSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate
-// failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the TimescaleSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the TimescaleSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
-		Migrations: []*migrate.Migration{
-			{
-				Id: "messages_1",
-				Up: []string{
-					`CREATE TABLE IF NOT EXISTS messages (
-						time          BIGINT NOT NULL,
-						subtopic      VARCHAR(254),
-						publisher     UUID,
-						protocol      TEXT,
-						name          VARCHAR(254),
-						unit          TEXT,
-						value         FLOAT,
-						string_value  TEXT,
-						bool_value    BOOL,
-						data_value    BYTEA,
-						sum           FLOAT,
-						update_time   FLOAT,
-						PRIMARY KEY   (time, publisher, subtopic, name)
-					);
-					SELECT create_hypertable('messages', 'time', create_default_indexes => FALSE, chunk_time_interval => 86400000, if_not_exists => TRUE);`,
-					`CREATE TABLE IF NOT EXISTS json (
-						created       BIGINT,
-						subtopic      VARCHAR(254),
-						publisher     VARCHAR(254),
-						protocol      TEXT,
-						payload       JSONB,
-						PRIMARY KEY   (publisher, subtopic, created)
-					)`,
-				},
-				Down: []string{
-					"DROP TABLE messages",
-					"DROP TABLE json",
-				},
-			},
-		},
-	}
-
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
+// Migrations returns the database migrations of the service, which are the
+// ones of the Timescale writer storing the messages.
+func Migrations() migrate.MigrationSource {
+	return writer.Migrations()
 }
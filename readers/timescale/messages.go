@@ -5,8 +5,11 @@ package timescale
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -26,6 +29,16 @@ const (
 
 var _ readers.MessageRepository = (*timescaleRepository)(nil)
 
+// aggregations maps the aggregation types to the SQL expressions computing
+// the aggregated value of a bucket.
+var aggregations = map[string]string{
+	readers.MinAggregation:   "MIN(value)",
+	readers.MaxAggregation:   "MAX(value)",
+	readers.AvgAggregation:   "AVG(value)",
+	readers.SumAggregation:   "SUM(value)",
+	readers.CountAggregation: "COUNT(*)",
+}
+
 var (
 	errInvalidMessage = errors.New("invalid message representation")
 	errTransRollback  = errors.New("failed to rollback transaction")
@@ -41,12 +54,12 @@ func New(db *sqlx.DB) readers.MessageRepository {
 		db: db,
 	}
 }
-func (tr timescaleRepository) ListAllMessages(rpm readers.PageMetadata) (readers.MessagesPage, error) {
-	return tr.readAll(rpm)
+func (tr timescaleRepository) ListAllMessages(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	return tr.readAll(ctx, rpm)
 }
 
-func (tr timescaleRepository) Backup(rpm readers.PageMetadata) (readers.MessagesPage, error) {
-	return tr.readAll(rpm)
+func (tr timescaleRepository) Backup(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	return tr.readAll(ctx, rpm)
 }
 
 func (tr timescaleRepository) Restore(ctx context.Context, messages ...senml.Message) error {
@@ -57,7 +70,7 @@ func (tr timescaleRepository) Restore(ctx context.Context, messages ...senml.Mes
 		:value, :string_value, :bool_value, :data_value, :sum,
 		:time, :update_time);`
 
-	tx, err := tr.db.BeginTxx(context.Background(), nil)
+	tx, err := tr.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(errors.ErrSaveMessage, err)
 	}
@@ -77,7 +90,7 @@ func (tr timescaleRepository) Restore(ctx context.Context, messages ...senml.Mes
 
 	for _, msg := range messages {
 		m := senmlMessage{Message: msg}
-		if _, err := tx.NamedExec(q, m); err != nil {
+		if _, err := tx.NamedExecContext(ctx, q, m); err != nil {
 			pgErr, ok := err.(*pgconn.PgError)
 			if ok {
 				switch pgErr.Code {
@@ -93,7 +106,17 @@ func (tr timescaleRepository) Restore(ctx context.Context, messages ...senml.Mes
 	return err
 }
 
-func (tr timescaleRepository) readAll(rpm readers.PageMetadata) (readers.MessagesPage, error) {
+func (tr timescaleRepository) readAll(ctx context.Context, rpm readers.PageMetadata) (readers.MessagesPage, error) {
+	cur, err := readers.DecodeCursor(rpm.Cursor)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+
+	cursorID, err := parseCursorID(cur)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+
 	order := "time"
 	format := defTable
 	olq := dbutil.GetOffsetLimitQuery(rpm.Limit)
@@ -103,24 +126,54 @@ func (tr timescaleRepository) readAll(rpm readers.PageMetadata) (readers.Message
 		format = rpm.Format
 	}
 
-	q := fmt.Sprintf(`SELECT * FROM %s %s ORDER BY %s DESC %s;`, format, fmtCondition(rpm), order, olq)
+	columns := "*"
+	if len(rpm.Fields) > 0 {
+		columns = fmtColumns(order, rpm.Fields)
+	}
+
+	condition := fmtCondition(rpm)
+	offset := rpm.Offset
+	if !cur.Empty() {
+		op := "WHERE"
+		if condition != "" {
+			op = "AND"
+		}
+		// The ID is mandatory, so no message having the cursor time is
+		// skipped by the comparison.
+		condition = fmt.Sprintf(`%s %s (%s < :cursor OR (%s = :cursor AND id > :cursor_id))`, condition, op, order, order)
+		offset = 0
+	}
+
+	// The messages having the same time are ordered by their unique ID, so
+	// that the cursor points to the same position on every read.
+	q := fmt.Sprintf(`SELECT %s FROM %s %s ORDER BY %s DESC, id %s;`, columns, format, condition, order, olq)
 
 	params := map[string]interface{}{
 		"limit":        rpm.Limit,
-		"offset":       rpm.Offset,
+		"offset":       offset,
+		"cursor":       cur.IntTime(),
+		"cursor_id":    cursorID,
 		"subtopic":     rpm.Subtopic,
 		"publisher":    rpm.Publisher,
 		"name":         rpm.Name,
 		"protocol":     rpm.Protocol,
 		"value":        rpm.Value,
+		"value_gt":     rpm.ValueGreaterThan,
+		"value_lt":     rpm.ValueLowerThan,
 		"bool_value":   rpm.BoolValue,
 		"string_value": rpm.StringValue,
 		"data_value":   rpm.DataValue,
 		"from":         rpm.From,
 		"to":           rpm.To,
+		"interval":     rpm.Interval,
+		"timezone":     rpm.Timezone,
+	}
+
+	if rpm.Interval != "" {
+		return tr.readAggregates(ctx, rpm, params)
 	}
 
-	rows, err := tr.db.NamedQuery(q, params)
+	rows, err := tr.db.NamedQueryContext(ctx, q, params)
 	if err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok {
 			if pgErr.Code == pgerrcode.UndefinedTable {
@@ -135,6 +188,7 @@ func (tr timescaleRepository) readAll(rpm readers.PageMetadata) (readers.Message
 		PageMetadata: rpm,
 		Messages:     []readers.Message{},
 	}
+	var last readers.Cursor
 	switch format {
 	case defTable:
 		for rows.Next() {
@@ -144,6 +198,7 @@ func (tr timescaleRepository) readAll(rpm readers.PageMetadata) (readers.Message
 			}
 
 			page.Messages = append(page.Messages, msg.Message)
+			last = readers.Cursor{Time: strconv.FormatFloat(msg.Time, 'f', -1, 64), ID: strconv.FormatInt(msg.ID.Int64, 10)}
 		}
 	default:
 		for rows.Next() {
@@ -156,12 +211,20 @@ func (tr timescaleRepository) readAll(rpm readers.PageMetadata) (readers.Message
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
 			page.Messages = append(page.Messages, m)
+			last = readers.Cursor{Time: strconv.FormatInt(msg.Created, 10), ID: strconv.FormatInt(msg.ID.Int64, 10)}
 		}
 
 	}
+	page.NextCursor = readers.NextCursor(rpm, uint64(len(page.Messages)), last).Encode()
+
+	// Counting the messages takes as long as reading all of them, so it's
+	// skipped when the pages are read using the cursor.
+	if !cur.Empty() {
+		return page, nil
+	}
 
 	q = fmt.Sprintf(`SELECT COUNT(*) FROM %s %s;`, format, fmtCondition(rpm))
-	rows, err = tr.db.NamedQuery(q, params)
+	rows, err = tr.db.NamedQueryContext(ctx, q, params)
 	if err != nil {
 		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 	}
@@ -178,6 +241,92 @@ func (tr timescaleRepository) readAll(rpm readers.PageMetadata) (readers.Message
 	return page, nil
 }
 
+// readAggregates groups SenML messages into the buckets of the requested
+// interval. Buckets are truncated in the local time of the requested time
+// zone, so calendar days, weeks and months follow its DST transitions.
+func (tr timescaleRepository) readAggregates(ctx context.Context, rpm readers.PageMetadata, params map[string]interface{}) (readers.MessagesPage, error) {
+	agg, ok := aggregations[rpm.Aggregation]
+	if !ok {
+		agg = aggregations[readers.AvgAggregation]
+	}
+
+	// Every occurrence of a named parameter is bound separately, so the
+	// bucket is computed once in a subquery and grouped by its alias.
+	bucket := `date_trunc(:interval, to_timestamp(time) AT TIME ZONE :timezone) AT TIME ZONE :timezone`
+	buckets := fmt.Sprintf(`SELECT %s AS bucket, subtopic, publisher, protocol, name, unit, value FROM %s %s`, bucket, defTable, fmtCondition(rpm))
+	groupBy := `GROUP BY bucket, subtopic, publisher, protocol, name, unit`
+	olq := dbutil.GetOffsetLimitQuery(rpm.Limit)
+
+	q := fmt.Sprintf(`SELECT CAST(EXTRACT(EPOCH FROM bucket) AS DOUBLE PRECISION) AS time, subtopic, publisher, protocol, name, unit,
+		CAST(%s AS DOUBLE PRECISION) AS value FROM (%s) AS m %s ORDER BY 1 DESC %s;`, agg, buckets, groupBy, olq)
+
+	rows, err := tr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == pgerrcode.UndefinedTable {
+				return readers.MessagesPage{}, nil
+			}
+		}
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	defer rows.Close()
+
+	page := readers.MessagesPage{
+		PageMetadata: rpm,
+		Messages:     []readers.Message{},
+	}
+	for rows.Next() {
+		msg := senmlMessage{Message: senml.Message{}}
+		if err := rows.StructScan(&msg); err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+
+		page.Messages = append(page.Messages, msg.Message)
+	}
+
+	q = fmt.Sprintf(`SELECT COUNT(*) FROM (SELECT 1 FROM (%s) AS m %s) AS buckets;`, buckets, groupBy)
+	rows, err = tr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&page.Total); err != nil {
+			return page, err
+		}
+	}
+
+	return page, nil
+}
+
+// parseCursorID returns the ID of the message the cursor points to.
+func parseCursorID(cur readers.Cursor) (int64, error) {
+	if cur.Empty() {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(cur.ID, 10, 64)
+	if err != nil {
+		return 0, readers.ErrInvalidCursor
+	}
+
+	return id, nil
+}
+
+// fmtColumns returns the columns of the selected fields. The order and ID
+// columns are always read, since the page cursor is built from them.
+func fmtColumns(order string, fields []string) string {
+	columns := []string{order, "id"}
+	for _, f := range fields {
+		if f != order {
+			columns = append(columns, f)
+		}
+	}
+
+	return strings.Join(columns, ", ")
+}
+
 func fmtCondition(rpm readers.PageMetadata) string {
 	var query map[string]interface{}
 	meta, err := json.Marshal(rpm)
@@ -201,6 +350,12 @@ func fmtCondition(rpm readers.PageMetadata) string {
 			comparator := readers.ParseValueComparator(query)
 			condition = fmt.Sprintf(`%s %s value %s :value`, condition, op, comparator)
 			op = "AND"
+		case "vgt":
+			condition = fmt.Sprintf(`%s %s value > :value_gt`, condition, op)
+			op = "AND"
+		case "vlt":
+			condition = fmt.Sprintf(`%s %s value < :value_lt`, condition, op)
+			op = "AND"
 		case "vb":
 			condition = fmt.Sprintf(`%s %s bool_value = :bool_value`, condition, op)
 			op = "AND"
@@ -222,16 +377,17 @@ func fmtCondition(rpm readers.PageMetadata) string {
 }
 
 type senmlMessage struct {
-	ID string `db:"id"`
+	ID sql.NullInt64 `db:"id"`
 	senml.Message
 }
 
 type jsonMessage struct {
-	Created   int64  `db:"created"`
-	Subtopic  string `db:"subtopic"`
-	Publisher string `db:"publisher"`
-	Protocol  string `db:"protocol"`
-	Payload   []byte `db:"payload"`
+	ID        sql.NullInt64 `db:"id"`
+	Created   int64         `db:"created"`
+	Subtopic  string        `db:"subtopic"`
+	Publisher string        `db:"publisher"`
+	Protocol  string        `db:"protocol"`
+	Payload   []byte        `db:"payload"`
 }
 
 func (msg jsonMessage) toMap() (map[string]interface{}, error) {
@@ -242,6 +398,11 @@ func (msg jsonMessage) toMap() (map[string]interface{}, error) {
 		"protocol":  msg.Protocol,
 		"payload":   map[string]interface{}{},
 	}
+	// The payload isn't read if it isn't among the selected fields.
+	if len(msg.Payload) == 0 {
+		return ret, nil
+	}
+
 	pld := make(map[string]interface{})
 	if err := json.Unmarshal(msg.Payload, &pld); err != nil {
 		return nil, err
@@ -4,6 +4,7 @@
 package timescale_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -13,7 +14,7 @@ import (
 	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/readers"
-	treader "github.com/MainfluxLabs/mainflux/readers/postgres"
+	treader "github.com/MainfluxLabs/mainflux/readers/timescale"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -96,6 +97,8 @@ func TestListAllMessagesSenML(t *testing.T) {
 	err = writer.Consume(messages)
 	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
 
+	below, above := v-1, v+1
+
 	// Since messages are not saved in natural order,
 	// cases that return subset of messages are only
 	// checking data result set size, but not content.
@@ -164,7 +167,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value": {
 			pageMeta: readers.PageMetadata{
 				Limit: noLimit,
-				Value: v,
+				Value: &v,
 			},
 			page: readers.MessagesPage{
 				Total:    uint64(len(valueMsgs)),
@@ -174,7 +177,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v,
+				Value:      &v,
 				Comparator: readers.EqualKey,
 			},
 			page: readers.MessagesPage{
@@ -185,7 +188,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and lower-than comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v + 1,
+				Value:      &above,
 				Comparator: readers.LowerThanKey,
 			},
 			page: readers.MessagesPage{
@@ -196,7 +199,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and lower-than-or-equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v + 1,
+				Value:      &above,
 				Comparator: readers.LowerThanEqualKey,
 			},
 			page: readers.MessagesPage{
@@ -207,7 +210,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and greater-than comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v - 1,
+				Value:      &below,
 				Comparator: readers.GreaterThanKey,
 			},
 			page: readers.MessagesPage{
@@ -218,7 +221,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 		"read messages with value and greater-than-or-equal comparator": {
 			pageMeta: readers.PageMetadata{
 				Limit:      noLimit,
-				Value:      v - 1,
+				Value:      &below,
 				Comparator: readers.GreaterThanEqualKey,
 			},
 			page: readers.MessagesPage{
@@ -226,10 +229,31 @@ func TestListAllMessagesSenML(t *testing.T) {
 				Messages: fromSenml(valueMsgs),
 			},
 		},
+		"read messages with value range": {
+			pageMeta: readers.PageMetadata{
+				Limit:            noLimit,
+				ValueGreaterThan: &below,
+				ValueLowerThan:   &above,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(valueMsgs)),
+				Messages: fromSenml(valueMsgs),
+			},
+		},
+		"read messages with value out of range": {
+			pageMeta: readers.PageMetadata{
+				Limit:            noLimit,
+				ValueGreaterThan: &v,
+				ValueLowerThan:   &above,
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
 		"read messages with boolean value": {
 			pageMeta: readers.PageMetadata{
 				Limit:     noLimit,
-				BoolValue: vb,
+				BoolValue: &vb,
 			},
 			page: readers.MessagesPage{
 				Total:    uint64(len(boolMsgs)),
@@ -290,7 +314,7 @@ func TestListAllMessagesSenML(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		result, err := reader.ListAllMessages(tc.pageMeta)
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
 		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
 		assert.ElementsMatch(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
 		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
@@ -388,13 +412,106 @@ func TestListAllMessagesJSON(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		result, err := reader.ListAllMessages(tc.pageMeta)
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
 		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
 		assert.ElementsMatch(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
 		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
 	}
 }
 
+func TestListAllMessagesAggregation(t *testing.T) {
+	reader := treader.New(db)
+	writer := twriter.New(db)
+
+	pubID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	// The third message is sent on the 10th of January in UTC, but on the
+	// 11th in Belgrade, so the calendar days differ between the time zones.
+	day := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+	offsets := []time.Duration{time.Hour, 2 * time.Hour, 23*time.Hour + 30*time.Minute, 36 * time.Hour}
+	var messages []senml.Message
+	for i, o := range offsets {
+		value := float64(i + 1)
+		messages = append(messages, senml.Message{
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(day.Add(o).Unix()),
+			Value:     &value,
+		})
+	}
+
+	err = writer.Consume(messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	belgrade, err := time.LoadLocation("Europe/Belgrade")
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	localDay := time.Date(2024, time.January, 10, 0, 0, 0, 0, belgrade)
+
+	bucket := func(start time.Time, value float64) senml.Message {
+		return senml.Message{
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      float64(start.Unix()),
+			Value:     &value,
+		}
+	}
+
+	cases := map[string]struct {
+		pageMeta readers.PageMetadata
+		page     readers.MessagesPage
+	}{
+		"aggregate sum of messages by UTC day": {
+			pageMeta: readers.PageMetadata{
+				Limit:       noLimit,
+				Publisher:   pubID,
+				Interval:    readers.DayInterval,
+				Aggregation: readers.SumAggregation,
+				Timezone:    "UTC",
+			},
+			page: readers.MessagesPage{
+				Total:    2,
+				Messages: fromSenml([]senml.Message{bucket(day.AddDate(0, 0, 1), 4), bucket(day, 6)}),
+			},
+		},
+		"aggregate sum of messages by local day": {
+			pageMeta: readers.PageMetadata{
+				Limit:       noLimit,
+				Publisher:   pubID,
+				Interval:    readers.DayInterval,
+				Aggregation: readers.SumAggregation,
+				Timezone:    belgrade.String(),
+			},
+			page: readers.MessagesPage{
+				Total:    2,
+				Messages: fromSenml([]senml.Message{bucket(localDay.AddDate(0, 0, 1), 7), bucket(localDay, 3)}),
+			},
+		},
+		"aggregate count of messages by UTC month": {
+			pageMeta: readers.PageMetadata{
+				Limit:       noLimit,
+				Publisher:   pubID,
+				Interval:    readers.MonthInterval,
+				Aggregation: readers.CountAggregation,
+				Timezone:    "UTC",
+			},
+			page: readers.MessagesPage{
+				Total:    1,
+				Messages: fromSenml([]senml.Message{bucket(day.AddDate(0, 0, -9), 4)}),
+			},
+		},
+	}
+
+	for desc, tc := range cases {
+		result, err := reader.ListAllMessages(context.Background(), tc.pageMeta)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", desc, err))
+		assert.Equal(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Messages, result.Messages))
+		assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", desc, tc.page.Total, result.Total))
+	}
+}
+
 func fromSenml(msg []senml.Message) []readers.Message {
 	var ret []readers.Message
 	for _, m := range msg {
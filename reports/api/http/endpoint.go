@@ -0,0 +1,233 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/go-kit/kit/endpoint"
+)
+
+func createReportsEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createReportsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		rps := []reports.Report{}
+		for _, rReq := range req.Reports {
+			r := reports.Report{
+				GroupID:  req.groupID,
+				ThingID:  rReq.ThingID,
+				Name:     rReq.Name,
+				Schedule: rReq.Schedule,
+				Format:   format(rReq.Format),
+				Query:    rReq.Query.toQuery(),
+				SmtpID:   rReq.SmtpID,
+				Metadata: rReq.Metadata,
+			}
+			rps = append(rps, r)
+		}
+
+		saved, err := svc.CreateReports(ctx, req.token, rps...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := reportsRes{Reports: []reportRes{}, created: true}
+		for _, r := range saved {
+			res.Reports = append(res.Reports, buildReportResponse(r))
+		}
+
+		return res, nil
+	}
+}
+
+func listReportsByGroupEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListReportsByGroup(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := reportsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Reports: []reportRes{},
+		}
+		for _, r := range page.Reports {
+			res.Reports = append(res.Reports, buildReportResponse(r))
+		}
+
+		return res, nil
+	}
+}
+
+func viewReportEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(reportReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		r, err := svc.ViewReport(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildReportResponse(r), nil
+	}
+}
+
+func updateReportEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateReportReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		r := reports.Report{
+			ID:       req.id,
+			ThingID:  req.ThingID,
+			Name:     req.Name,
+			Schedule: req.Schedule,
+			Format:   format(req.Format),
+			Query:    req.Query.toQuery(),
+			SmtpID:   req.SmtpID,
+			Metadata: req.Metadata,
+		}
+
+		if err := svc.UpdateReport(ctx, req.token, r); err != nil {
+			return nil, err
+		}
+
+		return reportRes{updated: true}, nil
+	}
+}
+
+func removeReportsEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeReportsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveReports(ctx, req.token, req.ReportIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func generateReportEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(reportReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		a, err := svc.GenerateReport(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := buildArtifactResponse(a)
+		res.created = true
+
+		return res, nil
+	}
+}
+
+func listArtifactsEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListArtifacts(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := artifactsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Artifacts: []artifactRes{},
+		}
+		for _, a := range page.Artifacts {
+			res.Artifacts = append(res.Artifacts, buildArtifactResponse(a))
+		}
+
+		return res, nil
+	}
+}
+
+func viewArtifactEndpoint(svc reports.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(artifactReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		a, err := svc.ViewArtifact(ctx, req.token, req.reportID, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return artifactFileRes{
+			name:    a.ID,
+			format:  a.Format,
+			content: a.Content,
+		}, nil
+	}
+}
+
+func format(f string) string {
+	if f == "" {
+		return reports.CSVFormat
+	}
+
+	return f
+}
+
+func buildReportResponse(r reports.Report) reportRes {
+	return reportRes{
+		ID:       r.ID,
+		GroupID:  r.GroupID,
+		ThingID:  r.ThingID,
+		Name:     r.Name,
+		Schedule: r.Schedule,
+		Format:   r.Format,
+		Query:    queryRes(r.Query),
+		SmtpID:   r.SmtpID,
+		Metadata: r.Metadata,
+		NextRun:  r.NextRun,
+	}
+}
+
+func buildArtifactResponse(a reports.Artifact) artifactRes {
+	return artifactRes{
+		ID:       a.ID,
+		ReportID: a.ReportID,
+		Format:   a.Format,
+		From:     a.From,
+		To:       a.To,
+		Created:  a.Created,
+	}
+}
@@ -0,0 +1,572 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/reports"
+	httpapi "github.com/MainfluxLabs/mainflux/reports/api/http"
+	rpmocks "github.com/MainfluxLabs/mainflux/reports/mocks"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token       = "admin@example.com"
+	groupID     = "574106f7-030e-4881-8ab0-151195c29f94"
+	thingID     = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	wrongValue  = "wrong-value"
+	contentType = "application/json"
+	emptyValue  = ""
+)
+
+var report = reports.Report{
+	GroupID:  groupID,
+	ThingID:  thingID,
+	Name:     "daily-temperature",
+	Schedule: reports.DailySchedule,
+	Format:   reports.CSVFormat,
+	Query:    reports.Query{Name: "temperature"},
+}
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tr.token != "" {
+		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
+	}
+
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+
+	return tr.client.Do(req)
+}
+
+func newService() reports.Service {
+	v := 24.5
+	msgs := []senml.Message{{Publisher: thingID, Name: "temperature", Value: &v, Time: float64(time.Now().Add(-time.Hour).Unix())}}
+
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID}, map[string]things.Group{token: {ID: groupID}})
+	return reports.New(ths, rpmocks.NewReportRepository(), rpmocks.NewArtifactRepository(), rpmocks.NewMessageReader(msgs), mocks.NewPublisher(), uuid.NewMock(), "")
+}
+
+func newHTTPServer(svc reports.Service) *httptest.Server {
+	logger := logger.NewMock()
+	mux := httpapi.MakeHandler(mocktracer.New(), svc, logger)
+	return httptest.NewServer(mux)
+}
+
+func toJSON(data interface{}) string {
+	jsonData, _ := json.Marshal(data)
+	return string(jsonData)
+}
+
+type reportRes struct {
+	ID       string `json:"id"`
+	GroupID  string `json:"group_id"`
+	Name     string `json:"name"`
+	Schedule string `json:"schedule"`
+	Format   string `json:"format"`
+}
+
+type reportsPageRes struct {
+	Total   uint64      `json:"total"`
+	Reports []reportRes `json:"reports"`
+}
+
+func TestCreateReports(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	valid := map[string]interface{}{
+		"name":     "daily",
+		"thing_id": thingID,
+		"schedule": reports.DailySchedule,
+		"query":    map[string]string{"name": "temperature", "interval": "hour", "agg": "avg", "timezone": "Europe/Belgrade"},
+		"smtp_id":  "c6f9e3a3-c6ad-4f5e-97e1-bd3a0e7ea1d2",
+		"metadata": map[string]string{"test": "data"},
+		"format":   reports.CSVFormat,
+	}
+
+	invalid := func(key string, value interface{}) string {
+		r := map[string]interface{}{}
+		for k, v := range valid {
+			r[k] = v
+		}
+		r[key] = value
+		return toJSON([]map[string]interface{}{r})
+	}
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create reports",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create existing report",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create report with empty list",
+			data:        "[]",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report with invalid schedule",
+			data:        invalid("schedule", "hourly"),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report with invalid format",
+			data:        invalid("format", "pdf"),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report with empty name",
+			data:        invalid("name", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report without thing",
+			data:        invalid("thing_id", emptyValue),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report with invalid interval",
+			data:        invalid("query", map[string]string{"interval": wrongValue, "agg": "avg"}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report with invalid time zone",
+			data:        invalid("query", map[string]string{"timezone": wrongValue}),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report with malformed data",
+			data:        `[{"name":}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create report without content type",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create report with invalid token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create report with empty token",
+			data:        toJSON([]map[string]interface{}{valid}),
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/reports", ts.URL, groupID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestListReportsByGroup(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	n := 5
+	for i := 0; i < n; i++ {
+		rp := report
+		rp.Name = fmt.Sprintf("report-%d", i)
+		_, err := svc.CreateReports(context.Background(), token, rp)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	reportsURL := fmt.Sprintf("%s/groups/%s/reports", ts.URL, groupID)
+
+	cases := []struct {
+		desc   string
+		url    string
+		auth   string
+		status int
+		size   int
+	}{
+		{
+			desc:   "list reports",
+			url:    reportsURL,
+			auth:   token,
+			status: http.StatusOK,
+			size:   n,
+		},
+		{
+			desc:   "list reports with limit",
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", reportsURL, 1, 2),
+			auth:   token,
+			status: http.StatusOK,
+			size:   2,
+		},
+		{
+			desc:   "list reports with limit greater than max",
+			url:    fmt.Sprintf("%s?limit=%d", reportsURL, 110),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list reports with invalid token",
+			url:    reportsURL,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			size:   0,
+		},
+		{
+			desc:   "list reports of inaccessible group",
+			url:    fmt.Sprintf("%s/groups/%s/reports", ts.URL, wrongValue),
+			auth:   token,
+			status: http.StatusForbidden,
+			size:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body reportsPageRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.size, len(body.Reports), fmt.Sprintf("%s: expected size %d got %d", tc.desc, tc.size, len(body.Reports)))
+	}
+}
+
+func TestUpdateReport(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	rps, err := svc.CreateReports(context.Background(), token, report)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	rp := rps[0]
+
+	data := toJSON(map[string]interface{}{
+		"name":     "weekly-temperature",
+		"thing_id": thingID,
+		"schedule": reports.WeeklySchedule,
+	})
+
+	cases := []struct {
+		desc        string
+		id          string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "update report",
+			id:          rp.ID,
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update non-existing report",
+			id:          wrongValue,
+			data:        data,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "update report with invalid schedule",
+			id:          rp.ID,
+			data:        `{"name":"weekly","thing_id":"` + thingID + `","schedule":"monthly"}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update report without content type",
+			id:          rp.ID,
+			data:        data,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "update report with invalid token",
+			id:          rp.ID,
+			data:        data,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/reports/%s", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	updated, err := svc.ViewReport(context.Background(), token, rp.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, reports.WeeklySchedule, updated.Schedule, fmt.Sprintf("update report: expected schedule %s got %s", reports.WeeklySchedule, updated.Schedule))
+	assert.Equal(t, time.Monday, updated.NextRun.Weekday(), fmt.Sprintf("update report: expected next run on Monday got %s", updated.NextRun.Weekday()))
+}
+
+func TestRemoveReports(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	rps, err := svc.CreateReports(context.Background(), token, report)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	data := toJSON(map[string][]string{"report_ids": {rps[0].ID}})
+
+	cases := []struct {
+		desc   string
+		data   string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "remove reports with invalid token",
+			data:   data,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove reports with empty list",
+			data:   `{"report_ids":[]}`,
+			auth:   token,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "remove reports",
+			data:   data,
+			auth:   token,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "remove removed reports",
+			data:   data,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/reports", ts.URL),
+			contentType: contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestGenerateReport(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	rps, err := svc.CreateReports(context.Background(), token, report)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	rp := rps[0]
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "generate report",
+			id:     rp.ID,
+			auth:   token,
+			status: http.StatusCreated,
+		},
+		{
+			desc:   "generate non-existing report",
+			id:     wrongValue,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "generate report with invalid token",
+			id:     rp.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodPost,
+			url:    fmt.Sprintf("%s/reports/%s/generate", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+
+	req := testRequest{
+		client: ts.Client(),
+		method: http.MethodGet,
+		url:    fmt.Sprintf("%s/reports/%s/artifacts", ts.URL, rp.ID),
+		token:  token,
+	}
+	res, err := req.make()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	var page struct {
+		Total     uint64 `json:"total"`
+		Artifacts []struct {
+			ID string `json:"id"`
+		} `json:"artifacts"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&page)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Len(t, page.Artifacts, 1, "list artifacts: expected single artifact")
+
+	artifactCases := []struct {
+		desc   string
+		url    string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "view artifact",
+			url:    fmt.Sprintf("%s/reports/%s/artifacts/%s", ts.URL, rp.ID, page.Artifacts[0].ID),
+			auth:   token,
+			status: http.StatusOK,
+		},
+		{
+			desc:   "view non-existing artifact",
+			url:    fmt.Sprintf("%s/reports/%s/artifacts/%s", ts.URL, rp.ID, wrongValue),
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "view artifact with invalid token",
+			url:    fmt.Sprintf("%s/reports/%s/artifacts/%s", ts.URL, rp.ID, page.Artifacts[0].ID),
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range artifactCases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status == http.StatusOK {
+			ct := res.Header.Get("Content-Type")
+			assert.Equal(t, "text/csv", ct, fmt.Sprintf("%s: expected content type text/csv got %s", tc.desc, ct))
+			body, _ := io.ReadAll(res.Body)
+			assert.Contains(t, string(body), thingID, fmt.Sprintf("%s: expected artifact to contain %s", tc.desc, thingID))
+		}
+	}
+}
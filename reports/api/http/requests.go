@@ -0,0 +1,251 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/readers"
+	"github.com/MainfluxLabs/mainflux/reports"
+)
+
+const (
+	minLen       = 1
+	maxLimitSize = 100
+	maxNameSize  = 254
+)
+
+var (
+	// ErrInvalidSchedule indicates the unsupported report schedule.
+	ErrInvalidSchedule = errors.New("missing or invalid schedule")
+
+	// ErrInvalidFormat indicates the unsupported report format.
+	ErrInvalidFormat = errors.New("invalid report format")
+)
+
+type apiReq interface {
+	validate() error
+}
+
+type queryReq struct {
+	Subtopic     string `json:"subtopic,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Interval     string `json:"interval,omitempty"`
+	Aggregation  string `json:"agg,omitempty"`
+	Timezone     string `json:"timezone,omitempty"`
+	SavedQueryID string `json:"saved_query_id,omitempty"`
+}
+
+func (req queryReq) validate() error {
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil || strings.EqualFold(req.Timezone, "local") {
+			return apiutil.ErrInvalidTimezone
+		}
+	}
+
+	if req.Interval == "" {
+		return nil
+	}
+
+	switch req.Interval {
+	case readers.MinuteInterval,
+		readers.HourInterval,
+		readers.DayInterval,
+		readers.WeekInterval,
+		readers.MonthInterval,
+		readers.YearInterval:
+	default:
+		return apiutil.ErrInvalidInterval
+	}
+
+	switch req.Aggregation {
+	case readers.MinAggregation,
+		readers.MaxAggregation,
+		readers.AvgAggregation,
+		readers.SumAggregation,
+		readers.CountAggregation:
+	default:
+		return apiutil.ErrInvalidAggregation
+	}
+
+	return nil
+}
+
+func (req queryReq) toQuery() reports.Query {
+	q := reports.Query(req)
+	if q.Interval != "" && q.Timezone == "" {
+		q.Timezone = "UTC"
+	}
+
+	return q
+}
+
+type createReportReq struct {
+	Name     string                 `json:"name"`
+	ThingID  string                 `json:"thing_id"`
+	Schedule string                 `json:"schedule"`
+	Format   string                 `json:"format,omitempty"`
+	Query    queryReq               `json:"query"`
+	SmtpID   string                 `json:"smtp_id,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createReportReq) validate() error {
+	return validateReport(req.Name, req.ThingID, req.Schedule, req.Format, req.Query)
+}
+
+type createReportsReq struct {
+	token   string
+	groupID string
+	Reports []createReportReq
+}
+
+func (req createReportsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Reports) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, r := range req.Reports {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type reportReq struct {
+	token string
+	id    string
+}
+
+func (req reportReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listReq struct {
+	token        string
+	id           string
+	pageMetadata reports.PageMetadata
+}
+
+func (req listReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type updateReportReq struct {
+	token    string
+	id       string
+	Name     string                 `json:"name"`
+	ThingID  string                 `json:"thing_id"`
+	Schedule string                 `json:"schedule"`
+	Format   string                 `json:"format,omitempty"`
+	Query    queryReq               `json:"query"`
+	SmtpID   string                 `json:"smtp_id,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateReportReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateReport(req.Name, req.ThingID, req.Schedule, req.Format, req.Query)
+}
+
+type removeReportsReq struct {
+	token     string
+	ReportIDs []string `json:"report_ids,omitempty"`
+}
+
+func (req removeReportsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.ReportIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.ReportIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type artifactReq struct {
+	token    string
+	reportID string
+	id       string
+}
+
+func (req artifactReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.reportID == "" || req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+func validateReport(name, thingID, schedule, format string, query queryReq) error {
+	if name == "" || len(name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if schedule != reports.DailySchedule && schedule != reports.WeeklySchedule {
+		return ErrInvalidSchedule
+	}
+
+	if format != "" && format != reports.CSVFormat {
+		return ErrInvalidFormat
+	}
+
+	return query.validate()
+}
@@ -0,0 +1,183 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+)
+
+var (
+	_ apiutil.Response = (*reportRes)(nil)
+	_ apiutil.Response = (*reportsRes)(nil)
+	_ apiutil.Response = (*reportsPageRes)(nil)
+	_ apiutil.Response = (*artifactRes)(nil)
+	_ apiutil.Response = (*artifactsPageRes)(nil)
+	_ apiutil.Response = (*removeRes)(nil)
+)
+
+type pageRes struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+}
+
+type queryRes struct {
+	Subtopic     string `json:"subtopic,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Interval     string `json:"interval,omitempty"`
+	Aggregation  string `json:"agg,omitempty"`
+	Timezone     string `json:"timezone,omitempty"`
+	SavedQueryID string `json:"saved_query_id,omitempty"`
+}
+
+type reportRes struct {
+	ID       string                 `json:"id"`
+	GroupID  string                 `json:"group_id"`
+	ThingID  string                 `json:"thing_id"`
+	Name     string                 `json:"name"`
+	Schedule string                 `json:"schedule"`
+	Format   string                 `json:"format"`
+	Query    queryRes               `json:"query"`
+	SmtpID   string                 `json:"smtp_id,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	NextRun  time.Time              `json:"next_run"`
+	updated  bool
+}
+
+func (res reportRes) Code() int {
+	return http.StatusOK
+}
+
+func (res reportRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res reportRes) Empty() bool {
+	return res.updated
+}
+
+type reportsRes struct {
+	Reports []reportRes `json:"reports"`
+	created bool
+}
+
+func (res reportsRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res reportsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res reportsRes) Empty() bool {
+	return false
+}
+
+type reportsPageRes struct {
+	pageRes
+	Reports []reportRes `json:"reports"`
+}
+
+func (res reportsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res reportsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res reportsPageRes) Empty() bool {
+	return false
+}
+
+type artifactRes struct {
+	ID       string    `json:"id"`
+	ReportID string    `json:"report_id"`
+	Format   string    `json:"format"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Created  time.Time `json:"created"`
+	created  bool
+}
+
+func (res artifactRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res artifactRes) Headers() map[string]string {
+	if res.created {
+		return map[string]string{
+			"Location": fmt.Sprintf("/reports/%s/artifacts/%s", res.ReportID, res.ID),
+		}
+	}
+
+	return map[string]string{}
+}
+
+func (res artifactRes) Empty() bool {
+	return false
+}
+
+type artifactsPageRes struct {
+	pageRes
+	Artifacts []artifactRes `json:"artifacts"`
+}
+
+func (res artifactsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res artifactsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res artifactsPageRes) Empty() bool {
+	return false
+}
+
+type artifactFileRes struct {
+	name    string
+	format  string
+	content []byte
+}
+
+func (res artifactFileRes) Code() int {
+	return http.StatusOK
+}
+
+func (res artifactFileRes) Headers() map[string]string {
+	return map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s.%s"`, res.name, res.format),
+	}
+}
+
+func (res artifactFileRes) Empty() bool {
+	return false
+}
+
+type removeRes struct{}
+
+func (res removeRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeRes) Empty() bool {
+	return true
+}
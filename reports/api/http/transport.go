@@ -0,0 +1,254 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux"
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kithttp "github.com/go-kit/kit/transport/http"
+	"github.com/go-zoo/bone"
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	contentType    = "application/json"
+	csvContentType = "text/csv"
+	idKey          = "id"
+	artifactIDKey  = "artifactID"
+	offsetKey      = "offset"
+	limitKey       = "limit"
+	defOffset      = 0
+	defLimit       = 10
+)
+
+// MakeHandler returns a HTTP handler for API endpoints.
+func MakeHandler(tracer opentracing.Tracer, svc reports.Service, logger log.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, encodeError)),
+	}
+
+	r := bone.New()
+
+	r.Post("/groups/:id/reports", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_reports")(createReportsEndpoint(svc)),
+		decodeCreateReports,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/groups/:id/reports", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_reports_by_group")(listReportsByGroupEndpoint(svc)),
+		decodeList,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/reports/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_report")(viewReportEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/reports/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_report")(updateReportEndpoint(svc)),
+		decodeUpdateReport,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/reports", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_reports")(removeReportsEndpoint(svc)),
+		decodeRemoveReports,
+		encodeResponse,
+		opts...,
+	))
+	r.Post("/reports/:id/generate", kithttp.NewServer(
+		kitot.TraceServer(tracer, "generate_report")(generateReportEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/reports/:id/artifacts", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_artifacts")(listArtifactsEndpoint(svc)),
+		decodeList,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/reports/:id/artifacts/:artifactID", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_artifact")(viewArtifactEndpoint(svc)),
+		decodeViewArtifact,
+		encodeArtifactResponse,
+		opts...,
+	))
+
+	r.GetFunc("/health", mainflux.Health("reports"))
+	r.Handle("/metrics", promhttp.Handler())
+
+	return r
+}
+
+func decodeCreateReports(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createReportsReq{token: apiutil.ExtractBearerToken(r), groupID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Reports); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := reportReq{token: apiutil.ExtractBearerToken(r), id: bone.GetValue(r, idKey)}
+
+	return req, nil
+}
+
+func decodeList(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+		pageMetadata: reports.PageMetadata{
+			Offset: o,
+			Limit:  l,
+		},
+	}
+
+	return req, nil
+}
+
+func decodeUpdateReport(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateReportReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveReports(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeReportsReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeViewArtifact(_ context.Context, r *http.Request) (interface{}, error) {
+	req := artifactReq{
+		token:    apiutil.ExtractBearerToken(r),
+		reportID: bone.GetValue(r, idKey),
+		id:       bone.GetValue(r, artifactIDKey),
+	}
+
+	return req, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", contentType)
+
+	if ar, ok := response.(apiutil.Response); ok {
+		for k, v := range ar.Headers() {
+			w.Header().Set(k, v)
+		}
+
+		w.WriteHeader(ar.Code())
+
+		if ar.Empty() {
+			return nil
+		}
+	}
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+func encodeArtifactResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", csvContentType)
+
+	if ar, ok := response.(artifactFileRes); ok {
+		for k, v := range ar.Headers() {
+			w.Header().Set(k, v)
+		}
+
+		w.WriteHeader(ar.Code())
+
+		if _, err := w.Write(ar.content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
+	switch {
+	case errors.Contains(err, errors.ErrNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Contains(err, errors.ErrAuthentication),
+		err == apiutil.ErrBearerToken:
+		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, errors.ErrAuthorization):
+		w.WriteHeader(http.StatusForbidden)
+	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
+		errors.Contains(err, apiutil.ErrMalformedEntity),
+		err == apiutil.ErrLimitSize,
+		err == apiutil.ErrNameSize,
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingGroupID,
+		err == apiutil.ErrOffsetSize,
+		err == apiutil.ErrInvalidInterval,
+		err == apiutil.ErrInvalidAggregation,
+		err == apiutil.ErrInvalidTimezone,
+		err == ErrInvalidSchedule,
+		err == ErrInvalidFormat:
+		w.WriteHeader(http.StatusBadRequest)
+	case errors.Contains(err, errors.ErrConflict):
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+
+	if errorVal, ok := err.(errors.Error); ok {
+		w.Header().Set("Content-Type", contentType)
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/reports"
+)
+
+var _ reports.Service = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger log.Logger
+	svc    reports.Service
+}
+
+// LoggingMiddleware adds logging facilities to the core service.
+func LoggingMiddleware(svc reports.Service, logger log.Logger) reports.Service {
+	return &loggingMiddleware{logger, svc}
+}
+
+func (lm *loggingMiddleware) CreateReports(ctx context.Context, token string, rps ...reports.Report) (response []reports.Report, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_reports for reports %s took %s to complete", response, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateReports(ctx, token, rps...)
+}
+
+func (lm *loggingMiddleware) ListReportsByGroup(ctx context.Context, token, groupID string, pm reports.PageMetadata) (response reports.ReportsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_reports_by_group for id %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListReportsByGroup(ctx, token, groupID, pm)
+}
+
+func (lm *loggingMiddleware) ViewReport(ctx context.Context, token, id string) (response reports.Report, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_report for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewReport(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateReport(ctx context.Context, token string, report reports.Report) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_report for id %s took %s to complete", report.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateReport(ctx, token, report)
+}
+
+func (lm *loggingMiddleware) RemoveReports(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_reports took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveReports(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveReportsByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_reports_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveReportsByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) GenerateReport(ctx context.Context, token, id string) (response reports.Artifact, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method generate_report for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GenerateReport(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) ListArtifacts(ctx context.Context, token, reportID string, pm reports.PageMetadata) (response reports.ArtifactsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_artifacts for report %s took %s to complete", reportID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListArtifacts(ctx, token, reportID, pm)
+}
+
+func (lm *loggingMiddleware) ViewArtifact(ctx context.Context, token, reportID, id string) (response reports.Artifact, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_artifact for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewArtifact(ctx, token, reportID, id)
+}
+
+func (lm *loggingMiddleware) RunScheduled(ctx context.Context, now time.Time) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method run_scheduled for time %s took %s to complete", now, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RunScheduled(ctx, now)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/go-kit/kit/metrics"
+)
+
+var _ reports.Service = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	svc     reports.Service
+}
+
+// MetricsMiddleware instruments core service by tracking request count and
+// latency.
+func MetricsMiddleware(svc reports.Service, counter metrics.Counter, latency metrics.Histogram) reports.Service {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		svc:     svc,
+	}
+}
+
+func (ms *metricsMiddleware) CreateReports(ctx context.Context, token string, rps ...reports.Report) ([]reports.Report, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_reports").Add(1)
+		ms.latency.With("method", "create_reports").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateReports(ctx, token, rps...)
+}
+
+func (ms *metricsMiddleware) ListReportsByGroup(ctx context.Context, token, groupID string, pm reports.PageMetadata) (reports.ReportsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_reports_by_group").Add(1)
+		ms.latency.With("method", "list_reports_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListReportsByGroup(ctx, token, groupID, pm)
+}
+
+func (ms *metricsMiddleware) ViewReport(ctx context.Context, token, id string) (reports.Report, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_report").Add(1)
+		ms.latency.With("method", "view_report").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewReport(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateReport(ctx context.Context, token string, report reports.Report) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_report").Add(1)
+		ms.latency.With("method", "update_report").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateReport(ctx, token, report)
+}
+
+func (ms *metricsMiddleware) RemoveReports(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_reports").Add(1)
+		ms.latency.With("method", "remove_reports").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveReports(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveReportsByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_reports_by_group").Add(1)
+		ms.latency.With("method", "remove_reports_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveReportsByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) GenerateReport(ctx context.Context, token, id string) (reports.Artifact, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "generate_report").Add(1)
+		ms.latency.With("method", "generate_report").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GenerateReport(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) ListArtifacts(ctx context.Context, token, reportID string, pm reports.PageMetadata) (reports.ArtifactsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_artifacts").Add(1)
+		ms.latency.With("method", "list_artifacts").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListArtifacts(ctx, token, reportID, pm)
+}
+
+func (ms *metricsMiddleware) ViewArtifact(ctx context.Context, token, reportID, id string) (reports.Artifact, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_artifact").Add(1)
+		ms.latency.With("method", "view_artifact").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewArtifact(ctx, token, reportID, id)
+}
+
+func (ms *metricsMiddleware) RunScheduled(ctx context.Context, now time.Time) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "run_scheduled").Add(1)
+		ms.latency.With("method", "run_scheduled").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RunScheduled(ctx, now)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+)
+
+var _ reports.ArtifactRepository = (*artifactRepositoryMock)(nil)
+
+type artifactRepositoryMock struct {
+	mu        sync.Mutex
+	artifacts map[string]reports.Artifact
+}
+
+// NewArtifactRepository creates in-memory artifact repository.
+func NewArtifactRepository() reports.ArtifactRepository {
+	return &artifactRepositoryMock{
+		artifacts: make(map[string]reports.Artifact),
+	}
+}
+
+func (arm *artifactRepositoryMock) Save(_ context.Context, a reports.Artifact) error {
+	arm.mu.Lock()
+	defer arm.mu.Unlock()
+
+	if _, ok := arm.artifacts[a.ID]; ok {
+		return errors.ErrConflict
+	}
+	arm.artifacts[a.ID] = a
+
+	return nil
+}
+
+func (arm *artifactRepositoryMock) RetrieveByReport(_ context.Context, reportID string, pm reports.PageMetadata) (reports.ArtifactsPage, error) {
+	arm.mu.Lock()
+	defer arm.mu.Unlock()
+
+	var items []reports.Artifact
+	for _, a := range arm.artifacts {
+		if a.ReportID == reportID {
+			a.Content = nil
+			items = append(items, a)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Created.After(items[j].Created)
+	})
+
+	total := uint64(len(items))
+	items = paginate(items, pm)
+
+	return reports.ArtifactsPage{
+		Artifacts: items,
+		PageMetadata: reports.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (arm *artifactRepositoryMock) RetrieveByID(_ context.Context, reportID, id string) (reports.Artifact, error) {
+	arm.mu.Lock()
+	defer arm.mu.Unlock()
+
+	a, ok := arm.artifacts[id]
+	if !ok || a.ReportID != reportID {
+		return reports.Artifact{}, errors.ErrNotFound
+	}
+
+	return a, nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/reports"
+)
+
+var _ reports.MessageReader = (*messageReaderMock)(nil)
+
+type messageReaderMock struct {
+	messages []senml.Message
+}
+
+// NewMessageReader returns the message reader which reads the provided messages.
+func NewMessageReader(msgs []senml.Message) reports.MessageReader {
+	return &messageReaderMock{
+		messages: msgs,
+	}
+}
+
+func (mrm *messageReaderMock) ReadMessages(_ context.Context, thingID string, q reports.Query, from, to time.Time) ([]senml.Message, error) {
+	var msgs []senml.Message
+	for _, msg := range mrm.messages {
+		if msg.Publisher != thingID || q.Subtopic != "" && msg.Subtopic != q.Subtopic || q.Name != "" && msg.Name != q.Name {
+			continue
+		}
+		if msg.Time < float64(from.Unix()) || msg.Time >= float64(to.Unix()) {
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,169 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+)
+
+var _ reports.ReportRepository = (*reportRepositoryMock)(nil)
+
+type reportRepositoryMock struct {
+	mu      sync.Mutex
+	reports map[string]reports.Report
+}
+
+// NewReportRepository creates in-memory report repository.
+func NewReportRepository() reports.ReportRepository {
+	return &reportRepositoryMock{
+		reports: make(map[string]reports.Report),
+	}
+}
+
+func (rrm *reportRepositoryMock) Save(_ context.Context, rs ...reports.Report) ([]reports.Report, error) {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	for _, r := range rs {
+		for _, rp := range rrm.reports {
+			if rp.GroupID == r.GroupID && rp.Name == r.Name {
+				return []reports.Report{}, errors.ErrConflict
+			}
+		}
+
+		rrm.reports[r.ID] = r
+	}
+
+	return rs, nil
+}
+
+func (rrm *reportRepositoryMock) RetrieveByGroupID(_ context.Context, groupID string, pm reports.PageMetadata) (reports.ReportsPage, error) {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	var items []reports.Report
+	for _, r := range rrm.reports {
+		if r.GroupID == groupID {
+			items = append(items, r)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ID < items[j].ID
+	})
+
+	total := uint64(len(items))
+	items = paginate(items, pm)
+
+	return reports.ReportsPage{
+		Reports: items,
+		PageMetadata: reports.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (rrm *reportRepositoryMock) RetrieveByID(_ context.Context, id string) (reports.Report, error) {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	r, ok := rrm.reports[id]
+	if !ok {
+		return reports.Report{}, errors.ErrNotFound
+	}
+
+	return r, nil
+}
+
+func (rrm *reportRepositoryMock) RetrieveDue(_ context.Context, now time.Time) ([]reports.Report, error) {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	var items []reports.Report
+	for _, r := range rrm.reports {
+		if !r.NextRun.After(now) {
+			items = append(items, r)
+		}
+	}
+
+	return items, nil
+}
+
+func (rrm *reportRepositoryMock) Update(_ context.Context, r reports.Report) error {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	if _, ok := rrm.reports[r.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	rrm.reports[r.ID] = r
+
+	return nil
+}
+
+func (rrm *reportRepositoryMock) UpdateNextRun(_ context.Context, id string, next time.Time) error {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	r, ok := rrm.reports[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	r.NextRun = next
+	rrm.reports[id] = r
+
+	return nil
+}
+
+func (rrm *reportRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := rrm.reports[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(rrm.reports, id)
+	}
+
+	return nil
+}
+
+func (rrm *reportRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) error {
+	rrm.mu.Lock()
+	defer rrm.mu.Unlock()
+
+	for id, r := range rrm.reports {
+		if r.GroupID == groupID {
+			delete(rrm.reports, id)
+		}
+	}
+
+	return nil
+}
+
+func paginate[T any](items []T, pm reports.PageMetadata) []T {
+	if pm.Limit == 0 {
+		return items
+	}
+
+	if pm.Offset >= uint64(len(items)) {
+		return []T{}
+	}
+
+	end := pm.Offset + pm.Limit
+	if end > uint64(len(items)) {
+		end = uint64(len(items))
+	}
+
+	return items[pm.Offset:end]
+}
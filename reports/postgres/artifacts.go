@@ -0,0 +1,146 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ reports.ArtifactRepository = (*artifactRepository)(nil)
+
+type artifactRepository struct {
+	db Database
+}
+
+// NewArtifactRepository instantiates a PostgreSQL implementation of artifact repository.
+func NewArtifactRepository(db Database) reports.ArtifactRepository {
+	return &artifactRepository{
+		db: db,
+	}
+}
+
+func (ar artifactRepository) Save(ctx context.Context, a reports.Artifact) error {
+	q := `INSERT INTO artifacts (id, report_id, format, period_from, period_to, created, content)
+		VALUES (:id, :report_id, :format, :period_from, :period_to, :created, :content);`
+
+	if _, err := ar.db.NamedExecContext(ctx, q, toDBArtifact(a)); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.ForeignKeyViolation:
+				return errors.Wrap(errors.ErrNotFound, err)
+			}
+		}
+
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (ar artifactRepository) RetrieveByReport(ctx context.Context, reportID string, pm reports.PageMetadata) (reports.ArtifactsPage, error) {
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+	q := fmt.Sprintf(`SELECT id, report_id, format, period_from, period_to, created FROM artifacts
+		WHERE report_id = :report_id ORDER BY created DESC %s;`, olq)
+	qc := `SELECT COUNT(*) FROM artifacts WHERE report_id = $1;`
+
+	params := map[string]interface{}{
+		"report_id": reportID,
+		"limit":     pm.Limit,
+		"offset":    pm.Offset,
+	}
+
+	rows, err := ar.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return reports.ArtifactsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []reports.Artifact
+	for rows.Next() {
+		var dba dbArtifact
+		if err := rows.StructScan(&dba); err != nil {
+			return reports.ArtifactsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		items = append(items, toArtifact(dba))
+	}
+
+	var total uint64
+	if err := ar.db.GetContext(ctx, &total, qc, reportID); err != nil {
+		return reports.ArtifactsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return reports.ArtifactsPage{
+		Artifacts: items,
+		PageMetadata: reports.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (ar artifactRepository) RetrieveByID(ctx context.Context, reportID, id string) (reports.Artifact, error) {
+	q := `SELECT id, report_id, format, period_from, period_to, created, content FROM artifacts WHERE id = $1 AND report_id = $2;`
+
+	var dba dbArtifact
+	if err := ar.db.QueryRowxContext(ctx, q, id, reportID).StructScan(&dba); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return reports.Artifact{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return reports.Artifact{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toArtifact(dba), nil
+}
+
+type dbArtifact struct {
+	ID       string    `db:"id"`
+	ReportID string    `db:"report_id"`
+	Format   string    `db:"format"`
+	From     time.Time `db:"period_from"`
+	To       time.Time `db:"period_to"`
+	Created  time.Time `db:"created"`
+	Content  []byte    `db:"content"`
+}
+
+func toDBArtifact(a reports.Artifact) dbArtifact {
+	return dbArtifact{
+		ID:       a.ID,
+		ReportID: a.ReportID,
+		Format:   a.Format,
+		From:     a.From,
+		To:       a.To,
+		Created:  a.Created,
+		Content:  a.Content,
+	}
+}
+
+func toArtifact(dba dbArtifact) reports.Artifact {
+	return reports.Artifact{
+		ID:       dba.ID,
+		ReportID: dba.ReportID,
+		Format:   dba.Format,
+		From:     dba.From,
+		To:       dba.To,
+		Created:  dba.Created,
+		Content:  dba.Content,
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/MainfluxLabs/mainflux/reports/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var artifactContent = []byte("time,value\n1700000000,1.6\n")
+
+func newArtifact(t *testing.T, reportID string, created time.Time) reports.Artifact {
+	return reports.Artifact{
+		ID:       generateUUID(t),
+		ReportID: reportID,
+		Format:   reports.CSVFormat,
+		From:     created.Add(-24 * time.Hour),
+		To:       created,
+		Created:  created,
+		Content:  artifactContent,
+	}
+}
+
+func TestSaveArtifact(t *testing.T) {
+	reportRepo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	repo := postgres.NewArtifactRepository(postgres.NewDatabase(db))
+	r := saveReport(t, reportRepo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+
+	a := newArtifact(t, r.ID, time.Now())
+
+	cases := []struct {
+		desc     string
+		artifact reports.Artifact
+		err      error
+	}{
+		{
+			desc:     "save artifact",
+			artifact: a,
+			err:      nil,
+		},
+		{
+			desc:     "save existing artifact",
+			artifact: a,
+			err:      errors.ErrConflict,
+		},
+		{
+			desc:     "save artifact of non-existing report",
+			artifact: newArtifact(t, generateUUID(t), time.Now()),
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "save artifact with invalid report id",
+			artifact: newArtifact(t, invalidID, time.Now()),
+			err:      errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.artifact)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveArtifactsByReport(t *testing.T) {
+	reportRepo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	repo := postgres.NewArtifactRepository(postgres.NewDatabase(db))
+	r := saveReport(t, reportRepo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+
+	n := uint64(5)
+	now := time.Now()
+	var latest reports.Artifact
+	for i := uint64(0); i < n; i++ {
+		latest = newArtifact(t, r.ID, now.Add(time.Duration(i)*time.Hour))
+		err := repo.Save(context.Background(), latest)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc     string
+		reportID string
+		pm       reports.PageMetadata
+		size     uint64
+		total    uint64
+	}{
+		{
+			desc:     "retrieve all artifacts of the report",
+			reportID: r.ID,
+			pm:       reports.PageMetadata{Offset: 0, Limit: n},
+			size:     n,
+			total:    n,
+		},
+		{
+			desc:     "retrieve subset of artifacts of the report",
+			reportID: r.ID,
+			pm:       reports.PageMetadata{Offset: 1, Limit: 2},
+			size:     2,
+			total:    n,
+		},
+		{
+			desc:     "retrieve artifacts of the report without artifacts",
+			reportID: generateUUID(t),
+			pm:       reports.PageMetadata{Offset: 0, Limit: n},
+			size:     0,
+			total:    0,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByReport(context.Background(), tc.reportID, tc.pm)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected nil got %s\n", tc.desc, err))
+		assert.Equal(t, tc.size, uint64(len(page.Artifacts)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Artifacts)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+
+		// The artifacts are listed without their content.
+		for _, a := range page.Artifacts {
+			assert.Empty(t, a.Content, fmt.Sprintf("%s: expected empty content got %s\n", tc.desc, a.Content))
+		}
+	}
+
+	page, err := repo.RetrieveByReport(context.Background(), r.ID, reports.PageMetadata{Limit: 1})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Equal(t, 1, len(page.Artifacts), fmt.Sprintf("expected size 1 got %d\n", len(page.Artifacts)))
+	assert.Equal(t, latest.ID, page.Artifacts[0].ID, fmt.Sprintf("expected latest artifact %s got %s\n", latest.ID, page.Artifacts[0].ID))
+}
+
+func TestRetrieveArtifactByID(t *testing.T) {
+	reportRepo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	repo := postgres.NewArtifactRepository(postgres.NewDatabase(db))
+	r := saveReport(t, reportRepo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+
+	a := newArtifact(t, r.ID, time.Now())
+	err := repo.Save(context.Background(), a)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		reportID string
+		id       string
+		err      error
+	}{
+		{
+			desc:     "retrieve existing artifact",
+			reportID: r.ID,
+			id:       a.ID,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve artifact of other report",
+			reportID: generateUUID(t),
+			id:       a.ID,
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "retrieve non-existing artifact",
+			reportID: r.ID,
+			id:       generateUUID(t),
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "retrieve artifact with invalid id",
+			reportID: r.ID,
+			id:       invalidID,
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.reportID, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, a.Content, res.Content, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, a.Content, res.Content))
+		}
+	}
+}
+
+func TestRemoveReportArtifacts(t *testing.T) {
+	reportRepo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	repo := postgres.NewArtifactRepository(postgres.NewDatabase(db))
+	r := saveReport(t, reportRepo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+
+	a := newArtifact(t, r.ID, time.Now())
+	err := repo.Save(context.Background(), a)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	// The artifacts are removed together with their report.
+	err = reportRepo.Remove(context.Background(), r.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	_, err = repo.RetrieveByID(context.Background(), r.ID, a.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("expected %s got %s\n", errors.ErrNotFound, err))
+}
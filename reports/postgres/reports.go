@@ -0,0 +1,313 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ reports.ReportRepository = (*reportRepository)(nil)
+
+type reportRepository struct {
+	db Database
+}
+
+// NewReportRepository instantiates a PostgreSQL implementation of report repository.
+func NewReportRepository(db Database) reports.ReportRepository {
+	return &reportRepository{
+		db: db,
+	}
+}
+
+func (rr reportRepository) Save(ctx context.Context, rs ...reports.Report) ([]reports.Report, error) {
+	tx, err := rr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []reports.Report{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO reports (id, group_id, thing_id, name, schedule, format, query, smtp_id, metadata, next_run)
+		VALUES (:id, :group_id, :thing_id, :name, :schedule, :format, :query, :smtp_id, :metadata, :next_run);`
+
+	for _, report := range rs {
+		dbr, err := toDBReport(report)
+		if err != nil {
+			tx.Rollback()
+			return []reports.Report{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbr); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return []reports.Report{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []reports.Report{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []reports.Report{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []reports.Report{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []reports.Report{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return rs, nil
+}
+
+func (rr reportRepository) RetrieveByGroupID(ctx context.Context, groupID string, pm reports.PageMetadata) (reports.ReportsPage, error) {
+	if _, err := uuid.FromString(groupID); err != nil {
+		return reports.ReportsPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+	q := fmt.Sprintf(`SELECT id, group_id, thing_id, name, schedule, format, query, smtp_id, metadata, next_run
+		FROM reports WHERE group_id = :group_id ORDER BY name %s;`, olq)
+	qc := `SELECT COUNT(*) FROM reports WHERE group_id = $1;`
+
+	params := map[string]interface{}{
+		"group_id": groupID,
+		"limit":    pm.Limit,
+		"offset":   pm.Offset,
+	}
+
+	items, err := rr.retrieve(ctx, q, params)
+	if err != nil {
+		return reports.ReportsPage{}, err
+	}
+
+	var total uint64
+	if err := rr.db.GetContext(ctx, &total, qc, groupID); err != nil {
+		return reports.ReportsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return reports.ReportsPage{
+		Reports: items,
+		PageMetadata: reports.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (rr reportRepository) RetrieveByID(ctx context.Context, id string) (reports.Report, error) {
+	q := `SELECT id, group_id, thing_id, name, schedule, format, query, smtp_id, metadata, next_run FROM reports WHERE id = $1;`
+
+	var dbr dbReport
+	if err := rr.db.QueryRowxContext(ctx, q, id).StructScan(&dbr); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return reports.Report{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return reports.Report{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toReport(dbr)
+}
+
+func (rr reportRepository) RetrieveDue(ctx context.Context, now time.Time) ([]reports.Report, error) {
+	q := `SELECT id, group_id, thing_id, name, schedule, format, query, smtp_id, metadata, next_run
+		FROM reports WHERE next_run <= :now ORDER BY next_run;`
+
+	return rr.retrieve(ctx, q, map[string]interface{}{"now": now})
+}
+
+func (rr reportRepository) Update(ctx context.Context, r reports.Report) error {
+	q := `UPDATE reports SET thing_id = :thing_id, name = :name, schedule = :schedule, format = :format, query = :query,
+		smtp_id = :smtp_id, metadata = :metadata, next_run = :next_run WHERE id = :id;`
+
+	dbr, err := toDBReport(r)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, errdb := rr.db.NamedExecContext(ctx, q, dbr)
+	if errdb != nil {
+		pgErr, ok := errdb.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, errdb)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, errdb)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, errdb)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, errdb)
+	}
+
+	cnt, errdb := res.RowsAffected()
+	if errdb != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, errdb)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (rr reportRepository) UpdateNextRun(ctx context.Context, id string, next time.Time) error {
+	q := `UPDATE reports SET next_run = :next_run WHERE id = :id;`
+
+	res, err := rr.db.NamedExecContext(ctx, q, dbReport{ID: id, NextRun: next})
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (rr reportRepository) Remove(ctx context.Context, ids ...string) error {
+	q := `DELETE FROM reports WHERE id = :id;`
+
+	for _, id := range ids {
+		if _, err := rr.db.NamedExecContext(ctx, q, dbReport{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (rr reportRepository) RemoveByGroupID(ctx context.Context, groupID string) error {
+	q := `DELETE FROM reports WHERE group_id = :group_id;`
+
+	if _, err := rr.db.NamedExecContext(ctx, q, dbReport{GroupID: groupID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (rr reportRepository) retrieve(ctx context.Context, query string, params map[string]interface{}) ([]reports.Report, error) {
+	rows, err := rr.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []reports.Report
+	for rows.Next() {
+		var dbr dbReport
+		if err := rows.StructScan(&dbr); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		r, err := toReport(dbr)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, r)
+	}
+
+	return items, nil
+}
+
+type dbReport struct {
+	ID       string    `db:"id"`
+	GroupID  string    `db:"group_id"`
+	ThingID  string    `db:"thing_id"`
+	Name     string    `db:"name"`
+	Schedule string    `db:"schedule"`
+	Format   string    `db:"format"`
+	Query    []byte    `db:"query"`
+	SmtpID   string    `db:"smtp_id"`
+	Metadata []byte    `db:"metadata"`
+	NextRun  time.Time `db:"next_run"`
+}
+
+type dbQuery struct {
+	Subtopic     string `json:"subtopic,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Interval     string `json:"interval,omitempty"`
+	Aggregation  string `json:"agg,omitempty"`
+	Timezone     string `json:"timezone,omitempty"`
+	SavedQueryID string `json:"saved_query_id,omitempty"`
+}
+
+func toDBReport(r reports.Report) (dbReport, error) {
+	query, err := json.Marshal(dbQuery(r.Query))
+	if err != nil {
+		return dbReport{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	metadata := []byte("{}")
+	if len(r.Metadata) > 0 {
+		b, err := json.Marshal(r.Metadata)
+		if err != nil {
+			return dbReport{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+
+	return dbReport{
+		ID:       r.ID,
+		GroupID:  r.GroupID,
+		ThingID:  r.ThingID,
+		Name:     r.Name,
+		Schedule: r.Schedule,
+		Format:   r.Format,
+		Query:    query,
+		SmtpID:   r.SmtpID,
+		Metadata: metadata,
+		NextRun:  r.NextRun,
+	}, nil
+}
+
+func toReport(dbr dbReport) (reports.Report, error) {
+	var query dbQuery
+	if err := json.Unmarshal(dbr.Query, &query); err != nil {
+		return reports.Report{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	var metadata map[string]interface{}
+	if err := json.Unmarshal(dbr.Metadata, &metadata); err != nil {
+		return reports.Report{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return reports.Report{
+		ID:       dbr.ID,
+		GroupID:  dbr.GroupID,
+		ThingID:  dbr.ThingID,
+		Name:     dbr.Name,
+		Schedule: dbr.Schedule,
+		Format:   dbr.Format,
+		Query:    reports.Query(query),
+		SmtpID:   dbr.SmtpID,
+		Metadata: metadata,
+		NextRun:  dbr.NextRun,
+	}, nil
+}
@@ -0,0 +1,331 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/MainfluxLabs/mainflux/reports/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	reportName = "report"
+	invalidID  = "invalid"
+)
+
+var query = reports.Query{Subtopic: "energy", Name: "power", Interval: "1h", Aggregation: "avg", Timezone: "UTC"}
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func newReport(t *testing.T, groupID, name string, nextRun time.Time) reports.Report {
+	return reports.Report{
+		ID:       generateUUID(t),
+		GroupID:  groupID,
+		ThingID:  generateUUID(t),
+		Name:     name,
+		Schedule: reports.DailySchedule,
+		Format:   reports.CSVFormat,
+		Query:    query,
+		Metadata: map[string]interface{}{"owner": "ops"},
+		NextRun:  nextRun,
+	}
+}
+
+func saveReport(t *testing.T, repo reports.ReportRepository, r reports.Report) reports.Report {
+	_, err := repo.Save(context.Background(), r)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return r
+}
+
+func TestSaveReports(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nextRun := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		desc    string
+		reports []reports.Report
+		err     error
+	}{
+		{
+			desc:    "save reports",
+			reports: []reports.Report{newReport(t, groupID, reportName, nextRun), newReport(t, groupID, "other", nextRun)},
+			err:     nil,
+		},
+		{
+			desc:    "save report with existing name",
+			reports: []reports.Report{newReport(t, groupID, reportName, nextRun)},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save report with invalid group id",
+			reports: []reports.Report{newReport(t, invalidID, reportName, nextRun)},
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.reports...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveReportByID(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	r := saveReport(t, repo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "retrieve existing report",
+			id:   r.ID,
+			err:  nil,
+		},
+		{
+			desc: "retrieve non-existing report",
+			id:   generateUUID(t),
+			err:  errors.ErrNotFound,
+		},
+		{
+			desc: "retrieve report with invalid id",
+			id:   invalidID,
+			err:  errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, r.Query, res.Query, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, r.Query, res.Query))
+			assert.Equal(t, r.Metadata, res.Metadata, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, r.Metadata, res.Metadata))
+		}
+	}
+}
+
+func TestRetrieveReportsByGroupID(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		saveReport(t, repo, newReport(t, groupID, fmt.Sprintf("%s-%d", reportName, i), time.Now().Add(time.Hour)))
+	}
+
+	cases := []struct {
+		desc    string
+		groupID string
+		pm      reports.PageMetadata
+		size    uint64
+		total   uint64
+		err     error
+	}{
+		{
+			desc:    "retrieve all reports of the group",
+			groupID: groupID,
+			pm:      reports.PageMetadata{Offset: 0, Limit: n},
+			size:    n,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve subset of reports of the group",
+			groupID: groupID,
+			pm:      reports.PageMetadata{Offset: 1, Limit: 2},
+			size:    2,
+			total:   n,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve reports of the group without reports",
+			groupID: generateUUID(t),
+			pm:      reports.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve reports with invalid group id",
+			groupID: invalidID,
+			pm:      reports.PageMetadata{Offset: 0, Limit: n},
+			size:    0,
+			total:   0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByGroupID(context.Background(), tc.groupID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.Reports)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Reports)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveDueReports(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	now := time.Now()
+
+	due := saveReport(t, repo, newReport(t, groupID, "due", now.Add(-time.Minute)))
+	notDue := saveReport(t, repo, newReport(t, groupID, "not-due", now.Add(time.Hour)))
+
+	res, err := repo.RetrieveDue(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	ids := map[string]bool{}
+	for _, r := range res {
+		ids[r.ID] = true
+	}
+	assert.True(t, ids[due.ID], "expected due report to be retrieved")
+	assert.False(t, ids[notDue.ID], "expected report which isn't due not to be retrieved")
+}
+
+func TestUpdateReport(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+	nextRun := time.Now().Add(time.Hour)
+
+	r := saveReport(t, repo, newReport(t, groupID, reportName, nextRun))
+	other := saveReport(t, repo, newReport(t, groupID, "other", nextRun))
+
+	updated := r
+	updated.Schedule = reports.WeeklySchedule
+	updated.Query.Interval = "1d"
+
+	conflicting := r
+	conflicting.Name = other.Name
+
+	invalid := r
+	invalid.ThingID = invalidID
+
+	cases := []struct {
+		desc   string
+		report reports.Report
+		err    error
+	}{
+		{
+			desc:   "update existing report",
+			report: updated,
+			err:    nil,
+		},
+		{
+			desc:   "update report with existing name",
+			report: conflicting,
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "update report with invalid thing id",
+			report: invalid,
+			err:    errors.ErrMalformedEntity,
+		},
+		{
+			desc:   "update non-existing report",
+			report: newReport(t, groupID, "missing", nextRun),
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.report)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), r.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, updated.Schedule, res.Schedule, fmt.Sprintf("expected schedule %s got %s\n", updated.Schedule, res.Schedule))
+	assert.Equal(t, updated.Query, res.Query, fmt.Sprintf("expected %v got %v\n", updated.Query, res.Query))
+}
+
+func TestUpdateReportNextRun(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	r := saveReport(t, repo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+	next := time.Now().Add(24 * time.Hour)
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "update next run of existing report",
+			id:   r.ID,
+			err:  nil,
+		},
+		{
+			desc: "update next run of non-existing report",
+			id:   generateUUID(t),
+			err:  errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.UpdateNextRun(context.Background(), tc.id, next)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), r.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.True(t, res.NextRun.Equal(next.Truncate(time.Microsecond)), fmt.Sprintf("expected next run %s got %s\n", next, res.NextRun))
+}
+
+func TestRemoveReports(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	r := saveReport(t, repo, newReport(t, generateUUID(t), reportName, time.Now().Add(time.Hour)))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing report",
+			id:   r.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed report",
+			id:   r.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
+
+func TestRemoveReportsByGroupID(t *testing.T) {
+	repo := postgres.NewReportRepository(postgres.NewDatabase(db))
+	groupID := generateUUID(t)
+
+	for i := 0; i < 2; i++ {
+		saveReport(t, repo, newReport(t, groupID, fmt.Sprintf("%s-%d", reportName, i), time.Now().Add(time.Hour)))
+	}
+
+	err := repo.RemoveByGroupID(context.Background(), groupID)
+	assert.Nil(t, err, fmt.Sprintf("remove reports by group: expected nil got %s\n", err))
+
+	page, err := repo.RetrieveByGroupID(context.Background(), groupID, reports.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected total 0 got %d\n", page.Total))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	clientshttp "github.com/MainfluxLabs/mainflux/pkg/clients/http"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+)
+
+// readLimit is the maximum page size accepted by the readers.
+const readLimit = 1000
+
+// ErrReadMessages indicates failure to read the report messages.
+var ErrReadMessages = errors.New("failed to read messages")
+
+// MessageReader specifies an API for reading the report messages.
+type MessageReader interface {
+	// ReadMessages retrieves the messages matching the query, published by
+	// the thing identified by the provided ID within the given period.
+	ReadMessages(ctx context.Context, thingID string, q Query, from, to time.Time) ([]senml.Message, error)
+}
+
+var _ MessageReader = (*messageReader)(nil)
+
+type messageReader struct {
+	url   string
+	token string
+}
+
+// NewMessageReader returns the message reader which reads the messages from
+// the readers service at the given URL, authorized with the provided admin
+// token.
+func NewMessageReader(url, token string) MessageReader {
+	return &messageReader{
+		url:   url,
+		token: token,
+	}
+}
+
+type messagesPage struct {
+	Total    uint64          `json:"total"`
+	Messages []senml.Message `json:"messages"`
+}
+
+func (mr *messageReader) ReadMessages(_ context.Context, thingID string, q Query, from, to time.Time) ([]senml.Message, error) {
+	params := url.Values{}
+	params.Set("publisher", thingID)
+	params.Set("from", strconv.FormatInt(from.Unix(), 10))
+	params.Set("to", strconv.FormatInt(to.Unix(), 10))
+	params.Set("limit", strconv.Itoa(readLimit))
+	if q.Subtopic != "" {
+		params.Set("subtopic", q.Subtopic)
+	}
+	if q.Name != "" {
+		params.Set("name", q.Name)
+	}
+	if q.Interval != "" {
+		params.Set("interval", q.Interval)
+		params.Set("agg", q.Aggregation)
+		params.Set("timezone", q.Timezone)
+	}
+
+	// The messages of the saved query are read using its filters, overridden
+	// by the ones set in the report query.
+	path := "messages"
+	if q.SavedQueryID != "" {
+		path = fmt.Sprintf("queries/%s/messages", url.PathEscape(q.SavedQueryID))
+	}
+
+	headers := map[string]string{"Authorization": apiutil.BearerPrefix + mr.token}
+
+	var msgs []senml.Message
+	for offset := uint64(0); ; offset += readLimit {
+		params.Set("offset", strconv.FormatUint(offset, 10))
+
+		page, err := mr.readPage(fmt.Sprintf("%s/%s?%s", mr.url, path, params.Encode()), headers)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, page.Messages...)
+
+		if len(page.Messages) == 0 || offset+readLimit >= page.Total {
+			return msgs, nil
+		}
+	}
+}
+
+func (mr *messageReader) readPage(url string, headers map[string]string) (messagesPage, error) {
+	res, err := clientshttp.SendRequest(http.MethodGet, url, nil, headers)
+	if err != nil {
+		return messagesPage{}, errors.Wrap(ErrReadMessages, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return messagesPage{}, errors.Wrap(ErrReadMessages, err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return messagesPage{}, errors.Wrap(ErrReadMessages, errors.New(res.Status))
+	}
+
+	var page messagesPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return messagesPage{}, errors.Wrap(ErrReadMessages, err)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+)
+
+// ErrUnsupportedFormat indicates the report format that cannot be rendered.
+var ErrUnsupportedFormat = errors.New("unsupported report format")
+
+var csvHeader = []string{
+	"time",
+	"publisher",
+	"subtopic",
+	"protocol",
+	"name",
+	"unit",
+	"value",
+	"string_value",
+	"bool_value",
+	"data_value",
+	"sum",
+}
+
+// Render renders the messages into the report artifact content of the
+// given format.
+func Render(format string, msgs []senml.Message) ([]byte, error) {
+	switch format {
+	case CSVFormat:
+		return renderCSV(msgs)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+func renderCSV(msgs []senml.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for _, msg := range msgs {
+		sec, dec := splitTime(msg.Time)
+		record := []string{
+			time.Unix(sec, dec).UTC().Format(time.RFC3339),
+			msg.Publisher,
+			msg.Subtopic,
+			msg.Protocol,
+			msg.Name,
+			msg.Unit,
+			formatFloat(msg.Value),
+			formatString(msg.StringValue),
+			formatBool(msg.BoolValue),
+			formatString(msg.DataValue),
+			formatFloat(msg.Sum),
+		}
+
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func splitTime(t float64) (int64, int64) {
+	sec := int64(t)
+	return sec, int64((t - float64(sec)) * float64(time.Second))
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func formatString(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func formatBool(v *bool) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatBool(*v)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// DailySchedule represents the report generated every day at midnight.
+	DailySchedule = "daily"
+	// WeeklySchedule represents the report generated every Monday at midnight.
+	WeeklySchedule = "weekly"
+
+	// CSVFormat represents the CSV report format.
+	CSVFormat = "csv"
+)
+
+// Query represents the reader query run for the report. The messages
+// published by the report thing within the last schedule period are read.
+// If the saved query of the readers is referenced, its filters are used
+// unless they are set in the report query.
+type Query struct {
+	Subtopic     string
+	Name         string
+	Interval     string
+	Aggregation  string
+	Timezone     string
+	SavedQueryID string
+}
+
+// Report represents the saved reader query generated on a schedule. The
+// link to each generated artifact is sent using the SMTP notifier.
+type Report struct {
+	ID       string
+	GroupID  string
+	ThingID  string
+	Name     string
+	Schedule string
+	Format   string
+	Query    Query
+	SmtpID   string
+	Metadata map[string]interface{}
+	NextRun  time.Time
+}
+
+// ReportsPage contains page related metadata as well as a list of reports
+// that belong to this page.
+type ReportsPage struct {
+	PageMetadata
+	Reports []Report
+}
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total  uint64
+	Offset uint64
+	Limit  uint64
+}
+
+// Artifact represents the generated report file.
+type Artifact struct {
+	ID       string
+	ReportID string
+	Format   string
+	From     time.Time
+	To       time.Time
+	Created  time.Time
+	Content  []byte
+}
+
+// ArtifactsPage contains page related metadata as well as a list of
+// artifacts, without their content, that belong to this page.
+type ArtifactsPage struct {
+	PageMetadata
+	Artifacts []Artifact
+}
+
+// ReportRepository specifies a report persistence API.
+type ReportRepository interface {
+	// Save persists multiple reports. Reports are saved using a transaction.
+	// If one report fails then none will be saved.
+	Save(ctx context.Context, rs ...Report) ([]Report, error)
+
+	// RetrieveByGroupID retrieves reports related to a certain group
+	// identified by a given ID.
+	RetrieveByGroupID(ctx context.Context, groupID string, pm PageMetadata) (ReportsPage, error)
+
+	// RetrieveByID retrieves the report having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (Report, error)
+
+	// RetrieveDue retrieves the reports to be generated at the provided time.
+	RetrieveDue(ctx context.Context, now time.Time) ([]Report, error)
+
+	// Update performs an update to the existing report. A non-nil error is
+	// returned to indicate operation failure.
+	Update(ctx context.Context, r Report) error
+
+	// UpdateNextRun updates the time the report is generated at next.
+	UpdateNextRun(ctx context.Context, id string, next time.Time) error
+
+	// Remove removes the reports having the provided identifiers, together
+	// with their artifacts.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the reports related to a certain group
+	// identified by a given ID, together with their artifacts.
+	RemoveByGroupID(ctx context.Context, groupID string) error
+}
+
+// ArtifactRepository specifies an artifact persistence API.
+type ArtifactRepository interface {
+	// Save persists the artifact.
+	Save(ctx context.Context, a Artifact) error
+
+	// RetrieveByReport retrieves the artifacts of the report identified by
+	// the provided ID, newest first.
+	RetrieveByReport(ctx context.Context, reportID string, pm PageMetadata) (ArtifactsPage, error)
+
+	// RetrieveByID retrieves the report artifact having the provided identifier.
+	RetrieveByID(ctx context.Context, reportID, id string) (Artifact, error)
+}
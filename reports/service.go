@@ -0,0 +1,347 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+const protocol = "reports"
+
+var (
+	// ErrGenerateReport indicates failure to generate the report artifact.
+	ErrGenerateReport = errors.New("failed to generate report")
+
+	// ErrThingGroup indicates that the report thing doesn't belong to the report group.
+	ErrThingGroup = errors.New("thing doesn't belong to the report group")
+)
+
+// Service specifies an API that must be fullfiled by the domain service
+// implementation, and all of its decorators (e.g. logging & metrics).
+type Service interface {
+	// CreateReports creates reports for certain group identified by the provided ID.
+	CreateReports(ctx context.Context, token string, reports ...Report) ([]Report, error)
+
+	// ListReportsByGroup retrieves data about a subset of reports
+	// related to a certain group identified by the provided ID.
+	ListReportsByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (ReportsPage, error)
+
+	// ViewReport retrieves data about the report identified with the provided ID.
+	ViewReport(ctx context.Context, token, id string) (Report, error)
+
+	// UpdateReport updates the report identified by the provided ID.
+	UpdateReport(ctx context.Context, token string, report Report) error
+
+	// RemoveReports removes the reports identified with the provided IDs.
+	RemoveReports(ctx context.Context, token string, ids ...string) error
+
+	// RemoveReportsByGroup removes the reports of the removed group
+	// identified by the provided ID.
+	RemoveReportsByGroup(ctx context.Context, groupID string) error
+
+	// GenerateReport generates the report identified by the provided ID for
+	// the last schedule period ending now.
+	GenerateReport(ctx context.Context, token, id string) (Artifact, error)
+
+	// ListArtifacts retrieves data about a subset of artifacts generated for
+	// the report identified by the provided ID.
+	ListArtifacts(ctx context.Context, token, reportID string, pm PageMetadata) (ArtifactsPage, error)
+
+	// ViewArtifact retrieves the artifact, including its content, identified
+	// with the provided ID.
+	ViewArtifact(ctx context.Context, token, reportID, id string) (Artifact, error)
+
+	// RunScheduled generates the reports scheduled to run at the provided time
+	// and sends the links to the generated artifacts to the SMTP notifiers.
+	RunScheduled(ctx context.Context, now time.Time) error
+}
+
+type reportsService struct {
+	things     protomfx.ThingsServiceClient
+	reports    ReportRepository
+	artifacts  ArtifactRepository
+	reader     MessageReader
+	publisher  messaging.Publisher
+	idProvider uuid.IDProvider
+	url        string
+}
+
+var _ Service = (*reportsService)(nil)
+
+// New instantiates the reports service implementation. The links to the
+// generated artifacts are built using the provided service URL.
+func New(things protomfx.ThingsServiceClient, reports ReportRepository, artifacts ArtifactRepository, reader MessageReader, publisher messaging.Publisher, idp uuid.IDProvider, url string) Service {
+	return &reportsService{
+		things:     things,
+		reports:    reports,
+		artifacts:  artifacts,
+		reader:     reader,
+		publisher:  publisher,
+		idProvider: idp,
+		url:        url,
+	}
+}
+
+func (rs *reportsService) CreateReports(ctx context.Context, token string, reports ...Report) ([]Report, error) {
+	rps := []Report{}
+	for _, report := range reports {
+		r, err := rs.createReport(ctx, &report, token)
+		if err != nil {
+			return []Report{}, err
+		}
+		rps = append(rps, r)
+	}
+
+	return rps, nil
+}
+
+func (rs *reportsService) createReport(ctx context.Context, report *Report, token string) (Report, error) {
+	if _, err := rs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: report.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return Report{}, err
+	}
+
+	if err := rs.validateThing(ctx, *report); err != nil {
+		return Report{}, err
+	}
+
+	id, err := rs.idProvider.ID()
+	if err != nil {
+		return Report{}, err
+	}
+	report.ID = id
+	report.NextRun = NextRun(report.Schedule, location(report.Query.Timezone), time.Now())
+
+	rps, err := rs.reports.Save(ctx, *report)
+	if err != nil {
+		return Report{}, err
+	}
+
+	if len(rps) == 0 {
+		return Report{}, errors.ErrCreateEntity
+	}
+
+	return rps[0], nil
+}
+
+func (rs *reportsService) ListReportsByGroup(ctx context.Context, token, groupID string, pm PageMetadata) (ReportsPage, error) {
+	if _, err := rs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Viewer}); err != nil {
+		return ReportsPage{}, err
+	}
+
+	return rs.reports.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (rs *reportsService) ViewReport(ctx context.Context, token, id string) (Report, error) {
+	return rs.retrieveReport(ctx, token, id, things.Viewer)
+}
+
+func (rs *reportsService) UpdateReport(ctx context.Context, token string, report Report) error {
+	r, err := rs.retrieveReport(ctx, token, report.ID, things.Editor)
+	if err != nil {
+		return err
+	}
+
+	report.GroupID = r.GroupID
+	if err := rs.validateThing(ctx, report); err != nil {
+		return err
+	}
+	report.NextRun = NextRun(report.Schedule, location(report.Query.Timezone), time.Now())
+
+	return rs.reports.Update(ctx, report)
+}
+
+func (rs *reportsService) RemoveReports(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		report, err := rs.reports.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: report.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := rs.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return rs.reports.Remove(ctx, ids...)
+}
+
+func (rs *reportsService) RemoveReportsByGroup(ctx context.Context, groupID string) error {
+	return rs.reports.RemoveByGroupID(ctx, groupID)
+}
+
+func (rs *reportsService) GenerateReport(ctx context.Context, token, id string) (Artifact, error) {
+	report, err := rs.retrieveReport(ctx, token, id, things.Editor)
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	now := time.Now()
+	return rs.generate(ctx, report, PreviousRun(report.Schedule, now.In(location(report.Query.Timezone))), now)
+}
+
+func (rs *reportsService) ListArtifacts(ctx context.Context, token, reportID string, pm PageMetadata) (ArtifactsPage, error) {
+	if _, err := rs.retrieveReport(ctx, token, reportID, things.Viewer); err != nil {
+		return ArtifactsPage{}, err
+	}
+
+	return rs.artifacts.RetrieveByReport(ctx, reportID, pm)
+}
+
+func (rs *reportsService) ViewArtifact(ctx context.Context, token, reportID, id string) (Artifact, error) {
+	if _, err := rs.retrieveReport(ctx, token, reportID, things.Viewer); err != nil {
+		return Artifact{}, err
+	}
+
+	return rs.artifacts.RetrieveByID(ctx, reportID, id)
+}
+
+func (rs *reportsService) RunScheduled(ctx context.Context, now time.Time) error {
+	reports, err := rs.reports.RetrieveDue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, report := range reports {
+		to := report.NextRun.In(location(report.Query.Timezone))
+		if _, err := rs.generate(ctx, report, PreviousRun(report.Schedule, to), to); err != nil {
+			// The next run is kept, so the report is generated once the error is resolved.
+			errs = errors.Wrap(ErrGenerateReport, err)
+			continue
+		}
+
+		next := NextRun(report.Schedule, location(report.Query.Timezone), now)
+		if err := rs.reports.UpdateNextRun(ctx, report.ID, next); err != nil {
+			errs = err
+		}
+	}
+
+	return errs
+}
+
+func (rs *reportsService) generate(ctx context.Context, report Report, from, to time.Time) (Artifact, error) {
+	msgs, err := rs.reader.ReadMessages(ctx, report.ThingID, report.Query, from, to)
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	content, err := Render(report.Format, msgs)
+	if err != nil {
+		return Artifact{}, errors.Wrap(ErrGenerateReport, err)
+	}
+
+	id, err := rs.idProvider.ID()
+	if err != nil {
+		return Artifact{}, err
+	}
+
+	artifact := Artifact{
+		ID:       id,
+		ReportID: report.ID,
+		Format:   report.Format,
+		From:     from,
+		To:       to,
+		Created:  time.Now(),
+		Content:  content,
+	}
+	if err := rs.artifacts.Save(ctx, artifact); err != nil {
+		return Artifact{}, err
+	}
+
+	if err := rs.notify(report, artifact); err != nil {
+		return Artifact{}, err
+	}
+
+	return artifact, nil
+}
+
+func (rs *reportsService) notify(report Report, artifact Artifact) error {
+	if report.SmtpID == "" {
+		return nil
+	}
+
+	link := fmt.Sprintf("%s/reports/%s/artifacts/%s", rs.url, report.ID, artifact.ID)
+	text := fmt.Sprintf("Report %s for the period from %s to %s is available at %s", report.Name, artifact.From.Format(time.RFC3339), artifact.To.Format(time.RFC3339), link)
+
+	msg := protomfx.Message{
+		Publisher: report.ThingID,
+		Protocol:  protocol,
+		Payload:   []byte(text),
+		Created:   artifact.Created.UnixNano(),
+		ProfileConfig: &protomfx.Config{
+			SmtpID: report.SmtpID,
+		},
+	}
+
+	return rs.publisher.Publish(msg)
+}
+
+func (rs *reportsService) retrieveReport(ctx context.Context, token, id, action string) (Report, error) {
+	report, err := rs.reports.RetrieveByID(ctx, id)
+	if err != nil {
+		return Report{}, err
+	}
+
+	if _, err := rs.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: report.GroupID, Subject: things.GroupSub, Action: action}); err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+func (rs *reportsService) validateThing(ctx context.Context, report Report) error {
+	grID, err := rs.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: report.ThingID})
+	if err != nil {
+		return err
+	}
+
+	if grID.GetValue() != report.GroupID {
+		return errors.Wrap(errors.ErrAuthorization, ErrThingGroup)
+	}
+
+	return nil
+}
+
+// NextRun returns the first scheduled run in the given location after the
+// provided time. The daily reports run at midnight and the weekly reports
+// run on Monday at midnight.
+func NextRun(schedule string, loc *time.Location, now time.Time) time.Time {
+	now = now.In(loc)
+	next := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, loc)
+	if schedule == WeeklySchedule {
+		days := (int(time.Monday) - int(next.Weekday()) + 7) % 7
+		next = next.AddDate(0, 0, days)
+	}
+
+	return next
+}
+
+// PreviousRun returns the start of the schedule period ending at the
+// provided time.
+func PreviousRun(schedule string, to time.Time) time.Time {
+	if schedule == WeeklySchedule {
+		return to.AddDate(0, 0, -7)
+	}
+
+	return to.AddDate(0, 0, -1)
+}
+
+func location(timezone string) *time.Location {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
@@ -0,0 +1,351 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package reports_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/reports"
+	rpmocks "github.com/MainfluxLabs/mainflux/reports/mocks"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token      = "admin@example.com"
+	wrongValue = "wrong-value"
+	emptyValue = ""
+	groupID    = "574106f7-030e-4881-8ab0-151195c29f94"
+	thingID    = "5384fb1c-d0ae-4cbe-be52-c54223150fe0"
+	otherThing = "c4bb5a4b-8a4e-4e0f-9e31-8a3b1f1c8c8e"
+	smtpID     = "c6f9e3a3-c6ad-4f5e-97e1-bd3a0e7ea1d2"
+	reportName = "daily-temperature"
+	serviceURL = "http://localhost:9028"
+)
+
+var report = reports.Report{
+	GroupID:  groupID,
+	ThingID:  thingID,
+	Name:     reportName,
+	Schedule: reports.DailySchedule,
+	Format:   reports.CSVFormat,
+	Query:    reports.Query{Name: "temperature"},
+	SmtpID:   smtpID,
+}
+
+type publisherMock struct {
+	mu       sync.Mutex
+	messages []protomfx.Message
+}
+
+func (pub *publisherMock) Publish(msg protomfx.Message) error {
+	pub.mu.Lock()
+	defer pub.mu.Unlock()
+
+	pub.messages = append(pub.messages, msg)
+	return nil
+}
+
+func (pub *publisherMock) Close() error {
+	return nil
+}
+
+func newService(msgs []senml.Message, pub *publisherMock) reports.Service {
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID, otherThing: wrongValue}, map[string]things.Group{token: {ID: groupID}})
+	reader := rpmocks.NewMessageReader(msgs)
+	return reports.New(ths, rpmocks.NewReportRepository(), rpmocks.NewArtifactRepository(), reader, pub, uuid.NewMock(), serviceURL)
+}
+
+func newMessages(now time.Time) []senml.Message {
+	v := 24.5
+	return []senml.Message{
+		{Publisher: thingID, Name: "temperature", Unit: "C", Value: &v, Time: float64(now.Add(-time.Hour).Unix())},
+		{Publisher: thingID, Name: "temperature", Unit: "C", Value: &v, Time: float64(now.Add(-2 * time.Minute).Unix())},
+		{Publisher: thingID, Name: "temperature", Unit: "C", Value: &v, Time: float64(now.AddDate(0, 0, -2).Unix())},
+		{Publisher: thingID, Name: "humidity", Unit: "%", Value: &v, Time: float64(now.Add(-time.Hour).Unix())},
+		{Publisher: otherThing, Name: "temperature", Unit: "C", Value: &v, Time: float64(now.Add(-time.Hour).Unix())},
+	}
+}
+
+func TestCreateReports(t *testing.T) {
+	svc := newService(nil, &publisherMock{})
+
+	otherThingRp := report
+	otherThingRp.Name = "other-thing"
+	otherThingRp.ThingID = otherThing
+
+	unknownThingRp := report
+	unknownThingRp.Name = "unknown-thing"
+	unknownThingRp.ThingID = wrongValue
+
+	cases := []struct {
+		desc   string
+		report reports.Report
+		token  string
+		err    error
+	}{
+		{
+			desc:   "create report",
+			report: report,
+			token:  token,
+			err:    nil,
+		},
+		{
+			desc:   "create report with existing name",
+			report: report,
+			token:  token,
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "create report with wrong credentials",
+			report: report,
+			token:  wrongValue,
+			err:    errors.ErrAuthentication,
+		},
+		{
+			desc:   "create report for thing of other group",
+			report: otherThingRp,
+			token:  token,
+			err:    errors.ErrAuthorization,
+		},
+		{
+			desc:   "create report for unknown thing",
+			report: unknownThingRp,
+			token:  token,
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		rps, err := svc.CreateReports(context.Background(), tc.token, tc.report)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.True(t, rps[0].NextRun.After(time.Now()), fmt.Sprintf("%s: expected next run in the future got %s\n", tc.desc, rps[0].NextRun))
+		}
+	}
+}
+
+func TestRemoveReportsByGroup(t *testing.T) {
+	svc := newService(nil, &publisherMock{})
+	_, err := svc.CreateReports(context.Background(), token, report)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove reports by group",
+			groupID: groupID,
+			err:     nil,
+		},
+		{
+			desc:    "remove reports by group without reports",
+			groupID: wrongValue,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveReportsByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	rp, err := svc.ListReportsByGroup(context.Background(), token, groupID, reports.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(rp.Reports), fmt.Sprintf("expected no reports got %d\n", len(rp.Reports)))
+}
+
+func TestGenerateReport(t *testing.T) {
+	pub := &publisherMock{}
+	svc := newService(newMessages(time.Now()), pub)
+
+	rps, err := svc.CreateReports(context.Background(), token, report)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	rp := rps[0]
+
+	cases := []struct {
+		desc  string
+		id    string
+		token string
+		err   error
+	}{
+		{
+			desc:  "generate report",
+			id:    rp.ID,
+			token: token,
+			err:   nil,
+		},
+		{
+			desc:  "generate report with wrong credentials",
+			id:    rp.ID,
+			token: wrongValue,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "generate non-existing report",
+			id:    wrongValue,
+			token: token,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.GenerateReport(context.Background(), tc.token, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	page, err := svc.ListArtifacts(context.Background(), token, rp.ID, reports.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Len(t, page.Artifacts, 1, "generate report: expected single artifact")
+
+	artifact, err := svc.ViewArtifact(context.Background(), token, rp.ID, page.Artifacts[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	// Only the temperature messages of the report thing published within the last day are rendered.
+	lines := strings.Split(strings.TrimSpace(string(artifact.Content)), "\n")
+	assert.Len(t, lines, 3, fmt.Sprintf("generate report: expected header and 2 rows got %d lines", len(lines)))
+	assert.Equal(t, "time,publisher,subtopic,protocol,name,unit,value,string_value,bool_value,data_value,sum", lines[0], "generate report: unexpected header")
+	assert.Contains(t, lines[1], fmt.Sprintf("%s,,,temperature,C,24.5", thingID), "generate report: unexpected row")
+
+	require.Len(t, pub.messages, 1, "generate report: expected single notification")
+	msg := pub.messages[0]
+	assert.Equal(t, smtpID, msg.ProfileConfig.SmtpID, fmt.Sprintf("generate report: expected smtp id %s got %s", smtpID, msg.ProfileConfig.SmtpID))
+	link := fmt.Sprintf("%s/reports/%s/artifacts/%s", serviceURL, rp.ID, artifact.ID)
+	assert.Contains(t, string(msg.Payload), link, fmt.Sprintf("generate report: expected link %s in %s", link, msg.Payload))
+}
+
+func TestRunScheduled(t *testing.T) {
+	pub := &publisherMock{}
+	svc := newService(newMessages(time.Now()), pub)
+
+	noSmtpRp := report
+	noSmtpRp.Name = "no-smtp"
+	noSmtpRp.SmtpID = emptyValue
+
+	rps, err := svc.CreateReports(context.Background(), token, report, noSmtpRp)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	run := rps[0].NextRun
+
+	cases := []struct {
+		desc      string
+		now       time.Time
+		artifacts int
+	}{
+		{
+			desc:      "run before the scheduled time",
+			now:       run.Add(-time.Minute),
+			artifacts: 0,
+		},
+		{
+			desc:      "run at the scheduled time",
+			now:       run,
+			artifacts: 1,
+		},
+		{
+			desc:      "run again at the scheduled time",
+			now:       run,
+			artifacts: 1,
+		},
+		{
+			desc:      "run at the next scheduled time",
+			now:       run.AddDate(0, 0, 1),
+			artifacts: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RunScheduled(context.Background(), tc.now)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		for _, rp := range rps {
+			page, err := svc.ListArtifacts(context.Background(), token, rp.ID, reports.PageMetadata{})
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			assert.Len(t, page.Artifacts, tc.artifacts, fmt.Sprintf("%s: expected %d artifacts got %d", tc.desc, tc.artifacts, len(page.Artifacts)))
+		}
+	}
+
+	assert.Len(t, pub.messages, 2, fmt.Sprintf("run scheduled: expected 2 notifications got %d", len(pub.messages)))
+
+	rp, err := svc.ViewReport(context.Background(), token, rps[0].ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	next := run.AddDate(0, 0, 2)
+	assert.True(t, rp.NextRun.Equal(next), fmt.Sprintf("run scheduled: expected next run %s got %s", next, rp.NextRun))
+}
+
+func TestNextRun(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Belgrade")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	// 2024-05-15 is Wednesday.
+	now := time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		desc     string
+		schedule string
+		loc      *time.Location
+		now      time.Time
+		next     time.Time
+	}{
+		{
+			desc:     "daily report",
+			schedule: reports.DailySchedule,
+			loc:      time.UTC,
+			now:      now,
+			next:     time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:     "daily report at midnight",
+			schedule: reports.DailySchedule,
+			loc:      time.UTC,
+			now:      time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC),
+			next:     time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:     "daily report in time zone",
+			schedule: reports.DailySchedule,
+			loc:      loc,
+			now:      time.Date(2024, 5, 15, 23, 30, 0, 0, time.UTC),
+			next:     time.Date(2024, 5, 17, 0, 0, 0, 0, loc),
+		},
+		{
+			desc:     "weekly report",
+			schedule: reports.WeeklySchedule,
+			loc:      time.UTC,
+			now:      now,
+			next:     time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:     "weekly report on Sunday",
+			schedule: reports.WeeklySchedule,
+			loc:      time.UTC,
+			now:      time.Date(2024, 5, 19, 23, 59, 0, 0, time.UTC),
+			next:     time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:     "weekly report on Monday",
+			schedule: reports.WeeklySchedule,
+			loc:      time.UTC,
+			now:      time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+			next:     time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range cases {
+		next := reports.NextRun(tc.schedule, tc.loc, tc.now)
+		assert.True(t, next.Equal(tc.next), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.next, next))
+	}
+}
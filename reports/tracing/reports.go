@@ -0,0 +1,145 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
+	"github.com/MainfluxLabs/mainflux/reports"
+	"github.com/opentracing/opentracing-go"
+)
+
+var (
+	_ reports.ReportRepository   = (*reportRepositoryMiddleware)(nil)
+	_ reports.ArtifactRepository = (*artifactRepositoryMiddleware)(nil)
+)
+
+type reportRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   reports.ReportRepository
+}
+
+// ReportRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func ReportRepositoryMiddleware(tracer opentracing.Tracer, repo reports.ReportRepository) reports.ReportRepository {
+	return reportRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (rrm reportRepositoryMiddleware) Save(ctx context.Context, rs ...reports.Report) ([]reports.Report, error) {
+	span := createSpan(ctx, rrm.tracer, "save_reports")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.Save(ctx, rs...)
+}
+
+func (rrm reportRepositoryMiddleware) RetrieveByGroupID(ctx context.Context, groupID string, pm reports.PageMetadata) (reports.ReportsPage, error) {
+	span := createSpan(ctx, rrm.tracer, "retrieve_by_group_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.RetrieveByGroupID(ctx, groupID, pm)
+}
+
+func (rrm reportRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (reports.Report, error) {
+	span := createSpan(ctx, rrm.tracer, "retrieve_report_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.RetrieveByID(ctx, id)
+}
+
+func (rrm reportRepositoryMiddleware) RetrieveDue(ctx context.Context, now time.Time) ([]reports.Report, error) {
+	span := createSpan(ctx, rrm.tracer, "retrieve_due_reports")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.RetrieveDue(ctx, now)
+}
+
+func (rrm reportRepositoryMiddleware) Update(ctx context.Context, r reports.Report) error {
+	span := createSpan(ctx, rrm.tracer, "update_report")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.Update(ctx, r)
+}
+
+func (rrm reportRepositoryMiddleware) UpdateNextRun(ctx context.Context, id string, next time.Time) error {
+	span := createSpan(ctx, rrm.tracer, "update_report_next_run")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.UpdateNextRun(ctx, id, next)
+}
+
+func (rrm reportRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, rrm.tracer, "remove_reports")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.Remove(ctx, ids...)
+}
+
+func (rrm reportRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, rrm.tracer, "remove_reports_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return rrm.repo.RemoveByGroupID(ctx, groupID)
+}
+
+type artifactRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   reports.ArtifactRepository
+}
+
+// ArtifactRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func ArtifactRepositoryMiddleware(tracer opentracing.Tracer, repo reports.ArtifactRepository) reports.ArtifactRepository {
+	return artifactRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (arm artifactRepositoryMiddleware) Save(ctx context.Context, a reports.Artifact) error {
+	span := createSpan(ctx, arm.tracer, "save_artifact")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return arm.repo.Save(ctx, a)
+}
+
+func (arm artifactRepositoryMiddleware) RetrieveByReport(ctx context.Context, reportID string, pm reports.PageMetadata) (reports.ArtifactsPage, error) {
+	span := createSpan(ctx, arm.tracer, "retrieve_artifacts_by_report")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return arm.repo.RetrieveByReport(ctx, reportID, pm)
+}
+
+func (arm artifactRepositoryMiddleware) RetrieveByID(ctx context.Context, reportID, id string) (reports.Artifact, error) {
+	span := createSpan(ctx, arm.tracer, "retrieve_artifact_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return arm.repo.RetrieveByID(ctx, reportID, id)
+}
+
+func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
+	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
+		return tracer.StartSpan(
+			opName,
+			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
+		)
+	}
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// GroupAccess represents the effective access of the member to the group.
+// The member's access to the group things and profiles is derived from the
+// group role.
+type GroupAccess struct {
+	OrgID     string
+	GroupID   string
+	GroupName string
+	MemberID  string
+	Email     string
+	Role      string
+}
+
+func (ts *thingsService) ListGroupAccess(ctx context.Context, token string) ([]GroupAccess, error) {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return []GroupAccess{}, err
+	}
+
+	groups, err := ts.groups.RetrieveAll(ctx)
+	if err != nil {
+		return []GroupAccess{}, err
+	}
+
+	gms, err := ts.roles.RetrieveAllRolesByGroup(ctx)
+	if err != nil {
+		return []GroupAccess{}, err
+	}
+
+	if len(gms) == 0 {
+		return []GroupAccess{}, nil
+	}
+
+	grs := make(map[string]Group, len(groups))
+	for _, gr := range groups {
+		grs[gr.ID] = gr
+	}
+
+	var memberIDs []string
+	seen := make(map[string]bool)
+	for _, gm := range gms {
+		if !seen[gm.MemberID] {
+			seen[gm.MemberID] = true
+			memberIDs = append(memberIDs, gm.MemberID)
+		}
+	}
+
+	up, err := ts.users.GetUsersByIDs(ctx, &protomfx.UsersByIDsReq{Ids: memberIDs})
+	if err != nil {
+		return []GroupAccess{}, err
+	}
+
+	emails := make(map[string]string)
+	for _, user := range up.GetUsers() {
+		emails[user.GetId()] = user.GetEmail()
+	}
+
+	gas := []GroupAccess{}
+	for _, gm := range gms {
+		gr := grs[gm.GroupID]
+		gas = append(gas, GroupAccess{
+			OrgID:     gr.OrgID,
+			GroupID:   gm.GroupID,
+			GroupName: gr.Name,
+			MemberID:  gm.MemberID,
+			Email:     emails[gm.MemberID],
+			Role:      gm.Role,
+		})
+	}
+
+	return gas, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+	"net"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// NetworkACL represents the networks, in CIDR notation, the things are
+// allowed or denied to connect from. The ACL is stored on the thing, or on
+// the org, in which case it applies to all things of the org. The denied
+// networks take precedence over the allowed ones, while the things are
+// allowed to connect from any network which isn't denied if no networks
+// are allowed.
+type NetworkACL struct {
+	Allow []string
+	Deny  []string
+}
+
+// Empty returns true if the ACL neither allows nor denies any network.
+func (acl NetworkACL) Empty() bool {
+	return len(acl.Allow) == 0 && len(acl.Deny) == 0
+}
+
+// normalize returns the ACL with its networks in the canonical CIDR
+// notation, e.g. 10.1.2.3/8 becomes 10.0.0.0/8, or ErrMalformedEntity if
+// any of the networks is malformed.
+func (acl NetworkACL) normalize() (NetworkACL, error) {
+	allow, err := normalizeNetworks(acl.Allow)
+	if err != nil {
+		return NetworkACL{}, err
+	}
+
+	deny, err := normalizeNetworks(acl.Deny)
+	if err != nil {
+		return NetworkACL{}, err
+	}
+
+	return NetworkACL{Allow: allow, Deny: deny}, nil
+}
+
+func normalizeNetworks(networks []string) ([]string, error) {
+	nets := []string{}
+	seen := make(map[string]bool)
+	for _, n := range networks {
+		_, ipnet, err := net.ParseCIDR(n)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+
+		if cidr := ipnet.String(); !seen[cidr] {
+			seen[cidr] = true
+			nets = append(nets, cidr)
+		}
+	}
+
+	return nets, nil
+}
+
+// NetworkACLRepository specifies a network ACL persistence API.
+type NetworkACLRepository interface {
+	// SaveByThing persists the network ACL of the thing identified by the
+	// provided ID, replacing the existing one. The empty ACL removes it.
+	SaveByThing(ctx context.Context, thingID string, acl NetworkACL) error
+
+	// SaveByOrg persists the network ACL of the org identified by the
+	// provided ID, replacing the existing one. The empty ACL removes it.
+	SaveByOrg(ctx context.Context, orgID string, acl NetworkACL) error
+
+	// RetrieveByThings retrieves the network ACLs of the things identified
+	// by the provided IDs, mapped by the thing IDs.
+	RetrieveByThings(ctx context.Context, thingIDs ...string) (map[string]NetworkACL, error)
+
+	// RetrieveByOrgs retrieves the network ACLs of the orgs identified by
+	// the provided IDs, mapped by the org IDs.
+	RetrieveByOrgs(ctx context.Context, orgIDs ...string) (map[string]NetworkACL, error)
+}
+
+// NetworkACLs specifies an API for managing the networks the things are
+// allowed to connect from. The ACLs are enforced by the protocol adapters.
+type NetworkACLs interface {
+	// UpdateThingACL replaces the network ACL of the thing identified by the
+	// provided ID. The empty ACL removes it.
+	UpdateThingACL(ctx context.Context, token, thingID string, acl NetworkACL) error
+
+	// ViewThingACL retrieves the network ACL of the thing identified by the provided ID.
+	ViewThingACL(ctx context.Context, token, thingID string) (NetworkACL, error)
+
+	// UpdateOrgACL replaces the network ACL applied to all things of the org
+	// identified by the provided ID. The empty ACL removes it. Only
+	// accessible by the org admins.
+	UpdateOrgACL(ctx context.Context, token, orgID string, acl NetworkACL) error
+
+	// ViewOrgACL retrieves the network ACL of the org identified by the provided ID.
+	ViewOrgACL(ctx context.Context, token, orgID string) (NetworkACL, error)
+
+	// RemoveOrgACL removes the network ACL of the removed org identified by
+	// the provided ID.
+	RemoveOrgACL(ctx context.Context, orgID string) error
+}
+
+func (ts *thingsService) UpdateThingACL(ctx context.Context, token, thingID string, acl NetworkACL) error {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return err
+	}
+
+	acl, err := acl.normalize()
+	if err != nil {
+		return err
+	}
+
+	return ts.acls.SaveByThing(ctx, thingID, acl)
+}
+
+func (ts *thingsService) ViewThingACL(ctx context.Context, token, thingID string) (NetworkACL, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Viewer,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return NetworkACL{}, err
+	}
+
+	acls, err := ts.acls.RetrieveByThings(ctx, thingID)
+	if err != nil {
+		return NetworkACL{}, err
+	}
+
+	return acls[thingID], nil
+}
+
+func (ts *thingsService) UpdateOrgACL(ctx context.Context, token, orgID string, acl NetworkACL) error {
+	if err := ts.canAccessOrg(ctx, token, orgID, auth.OrgSub, Admin); err != nil {
+		return err
+	}
+
+	acl, err := acl.normalize()
+	if err != nil {
+		return err
+	}
+
+	return ts.acls.SaveByOrg(ctx, orgID, acl)
+}
+
+func (ts *thingsService) ViewOrgACL(ctx context.Context, token, orgID string) (NetworkACL, error) {
+	if err := ts.canAccessOrg(ctx, token, orgID, auth.OrgSub, Viewer); err != nil {
+		return NetworkACL{}, err
+	}
+
+	acls, err := ts.acls.RetrieveByOrgs(ctx, orgID)
+	if err != nil {
+		return NetworkACL{}, err
+	}
+
+	return acls[orgID], nil
+}
+
+func (ts *thingsService) RemoveOrgACL(ctx context.Context, orgID string) error {
+	if orgID == "" {
+		return errors.ErrMalformedEntity
+	}
+
+	return ts.acls.SaveByOrg(ctx, orgID, NetworkACL{})
+}
+
+// networkACLs returns the network ACLs which apply to the thing, i.e. the
+// ACLs of the thing and of its org, if set.
+func (ts *thingsService) networkACLs(ctx context.Context, thingID, orgID string) ([]NetworkACL, error) {
+	ths, err := ts.acls.RetrieveByThings(ctx, thingID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := ts.acls.RetrieveByOrgs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nonEmptyACLs(ths[thingID], orgs[orgID]), nil
+}
+
+func nonEmptyACLs(acls ...NetworkACL) []NetworkACL {
+	res := []NetworkACL{}
+	for _, acl := range acls {
+		if !acl.Empty() {
+			res = append(res, acl)
+		}
+	}
+
+	return res
+}
@@ -23,9 +23,16 @@ type grpcClient struct {
 	getPubConfByKey     endpoint.Endpoint
 	getConfigByThingID  endpoint.Endpoint
 	authorize           endpoint.Endpoint
+	authorizeBatch      endpoint.Endpoint
 	identify            endpoint.Endpoint
+	identifyBatch       endpoint.Endpoint
 	getGroupsByIDs      endpoint.Endpoint
 	getGroupIDByThingID endpoint.Endpoint
+	getPubConfs         endpoint.Endpoint
+	getPubConfByShare   endpoint.Endpoint
+	getPubConfByGateway endpoint.Endpoint
+	getStats            endpoint.Endpoint
+	getOrgStats         endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -58,6 +65,14 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeEmptyResponse,
 			empty.Empty{},
 		).Endpoint()),
+		authorizeBatch: kitot.TraceClient(tracer, "authorize_batch")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"AuthorizeBatch",
+			encodeAuthorizeBatchRequest,
+			decodeEmptyResponse,
+			empty.Empty{},
+		).Endpoint()),
 		identify: kitot.TraceClient(tracer, "identify")(kitgrpc.NewClient(
 			conn,
 			svcName,
@@ -66,6 +81,14 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeIdentityResponse,
 			protomfx.ThingID{},
 		).Endpoint()),
+		identifyBatch: kitot.TraceClient(tracer, "identify_batch")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"IdentifyBatch",
+			encodeIdentifyBatchRequest,
+			decodeIdentitiesResponse,
+			protomfx.ThingIDsRes{},
+		).Endpoint()),
 		getGroupsByIDs: kitot.TraceClient(tracer, "get_groups_by_ids")(kitgrpc.NewClient(
 			conn,
 			svcName,
@@ -82,6 +105,46 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeGetGroupIDByThingIDResponse,
 			protomfx.GroupID{},
 		).Endpoint()),
+		getPubConfs: kitot.TraceClient(tracer, "get_pub_confs")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetPubConfs",
+			encodeGetPubConfsRequest,
+			decodeGetPubConfsResponse,
+			protomfx.PubConfsRes{},
+		).Endpoint()),
+		getPubConfByShare: kitot.TraceClient(tracer, "get_pub_conf_by_share")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetPubConfByShare",
+			encodeGetPubConfByShareRequest,
+			decodeGetPubConfByKeyResponse,
+			protomfx.PubConfByKeyRes{},
+		).Endpoint()),
+		getPubConfByGateway: kitot.TraceClient(tracer, "get_pub_conf_by_gateway")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetPubConfByGateway",
+			encodeGetPubConfByGatewayRequest,
+			decodeGetPubConfByKeyResponse,
+			protomfx.PubConfByKeyRes{},
+		).Endpoint()),
+		getStats: kitot.TraceClient(tracer, "get_stats")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetStats",
+			encodeGetStatsRequest,
+			decodeGetStatsResponse,
+			protomfx.ThingsStatsRes{},
+		).Endpoint()),
+		getOrgStats: kitot.TraceClient(tracer, "get_org_stats")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetOrgStats",
+			encodeGetOrgStatsRequest,
+			decodeGetStatsResponse,
+			protomfx.ThingsStatsRes{},
+		).Endpoint()),
 	}
 }
 
@@ -98,7 +161,7 @@ func (client grpcClient) GetPubConfByKey(ctx context.Context, req *protomfx.PubC
 	}
 
 	pc := res.(pubConfByKeyRes)
-	return &protomfx.PubConfByKeyRes{PublisherID: pc.publisherID, ProfileConfig: pc.profileConfig}, nil
+	return &protomfx.PubConfByKeyRes{PublisherID: pc.publisherID, OrgID: pc.orgID, ProfileConfig: pc.profileConfig, NetworkACLs: pc.networkACLs, ProfileID: pc.profileID, GroupID: pc.groupID, ShareID: pc.shareID, ExpiresAt: pc.expiresAt}, nil
 }
 
 func (client grpcClient) GetConfigByThingID(ctx context.Context, req *protomfx.ThingID, opts ...grpc.CallOption) (*protomfx.ConfigByThingIDRes, error) {
@@ -123,6 +186,24 @@ func (client grpcClient) Authorize(ctx context.Context, req *protomfx.AuthorizeR
 	return &empty.Empty{}, er.err
 }
 
+func (client grpcClient) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	ars := []authorizeReq{}
+	for _, r := range req.GetRequests() {
+		ars = append(ars, authorizeReq{token: r.GetToken(), object: r.GetObject(), subject: r.GetSubject(), action: r.GetAction()})
+	}
+
+	res, err := client.authorizeBatch(ctx, authorizeBatchReq{requests: ars})
+	if err != nil {
+		return nil, err
+	}
+
+	er := res.(emptyRes)
+	return &empty.Empty{}, er.err
+}
+
 func (client grpcClient) Identify(ctx context.Context, req *protomfx.Token, _ ...grpc.CallOption) (*protomfx.ThingID, error) {
 	ctx, cancel := context.WithTimeout(ctx, client.timeout)
 	defer cancel()
@@ -136,6 +217,19 @@ func (client grpcClient) Identify(ctx context.Context, req *protomfx.Token, _ ..
 	return &protomfx.ThingID{Value: ir.id}, nil
 }
 
+func (client grpcClient) IdentifyBatch(ctx context.Context, req *protomfx.TokensReq, _ ...grpc.CallOption) (*protomfx.ThingIDsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.identifyBatch(ctx, identifyBatchReq{keys: req.GetTokens()})
+	if err != nil {
+		return nil, err
+	}
+
+	ir := res.(identitiesRes)
+	return &protomfx.ThingIDsRes{Ids: ir.ids}, nil
+}
+
 func (client grpcClient) GetGroupsByIDs(ctx context.Context, req *protomfx.GroupsReq, _ ...grpc.CallOption) (*protomfx.GroupsRes, error) {
 	ctx, cancel := context.WithTimeout(ctx, client.timeout)
 	defer cancel()
@@ -162,11 +256,86 @@ func (client grpcClient) GetGroupIDByThingID(ctx context.Context, req *protomfx.
 	return &protomfx.GroupID{Value: tg.groupID}, nil
 }
 
+func (client grpcClient) GetPubConfs(ctx context.Context, req *protomfx.PubConfsReq, _ ...grpc.CallOption) (*protomfx.PubConfsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.getPubConfs(ctx, pubConfsReq{offset: req.GetOffset(), limit: req.GetLimit()})
+	if err != nil {
+		return nil, err
+	}
+
+	pc := res.(pubConfsRes)
+	return &protomfx.PubConfsRes{PubConfs: pc.pubConfs, Total: pc.total}, nil
+}
+
+func (client grpcClient) GetPubConfByShare(ctx context.Context, req *protomfx.PubConfByShareReq, _ ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.getPubConfByShare(ctx, pubConfByShareReq{key: req.GetKey(), password: req.GetPassword()})
+	if err != nil {
+		return nil, err
+	}
+
+	pc := res.(pubConfByKeyRes)
+	return &protomfx.PubConfByKeyRes{PublisherID: pc.publisherID, OrgID: pc.orgID, ProfileConfig: pc.profileConfig, NetworkACLs: pc.networkACLs, ProfileID: pc.profileID, GroupID: pc.groupID, ShareID: pc.shareID, ExpiresAt: pc.expiresAt}, nil
+}
+
+func (client grpcClient) GetPubConfByGateway(ctx context.Context, req *protomfx.PubConfByGatewayReq, _ ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.getPubConfByGateway(ctx, pubConfByGatewayReq{key: req.GetKey(), thingID: req.GetThingID()})
+	if err != nil {
+		return nil, err
+	}
+
+	pc := res.(pubConfByKeyRes)
+	return &protomfx.PubConfByKeyRes{PublisherID: pc.publisherID, OrgID: pc.orgID, ProfileConfig: pc.profileConfig, NetworkACLs: pc.networkACLs, ProfileID: pc.profileID, GroupID: pc.groupID, ShareID: pc.shareID, ExpiresAt: pc.expiresAt}, nil
+}
+
+func (client grpcClient) GetStats(ctx context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*protomfx.ThingsStatsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.getStats(ctx, getStatsReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	sr := res.(getStatsRes)
+	return &protomfx.ThingsStatsRes{Things: sr.things, Profiles: sr.profiles, Groups: sr.groups}, nil
+}
+
+func (client grpcClient) GetOrgStats(ctx context.Context, req *protomfx.OrgStatsReq, _ ...grpc.CallOption) (*protomfx.ThingsStatsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.getOrgStats(ctx, getOrgStatsReq{orgID: req.GetOrgID()})
+	if err != nil {
+		return nil, err
+	}
+
+	sr := res.(getStatsRes)
+	return &protomfx.ThingsStatsRes{Things: sr.things, Profiles: sr.profiles, Groups: sr.groups}, nil
+}
+
 func encodeGetPubConfByKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(pubConfByKeyReq)
 	return &protomfx.PubConfByKeyReq{Key: req.key}, nil
 }
 
+func encodeGetPubConfByShareRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(pubConfByShareReq)
+	return &protomfx.PubConfByShareReq{Key: req.key, Password: req.password}, nil
+}
+
+func encodeGetPubConfByGatewayRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(pubConfByGatewayReq)
+	return &protomfx.PubConfByGatewayReq{Key: req.key, ThingID: req.thingID}, nil
+}
+
 func encodeGetConfigByThingIDRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(configByThingIDReq)
 	return &protomfx.ThingID{Value: req.thingID}, nil
@@ -177,11 +346,27 @@ func encodeAuthorize(_ context.Context, grpcReq interface{}) (interface{}, error
 	return &protomfx.AuthorizeReq{Token: req.token, Object: req.object, Subject: req.subject, Action: req.action}, nil
 }
 
+func encodeAuthorizeBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(authorizeBatchReq)
+
+	ars := []*protomfx.AuthorizeReq{}
+	for _, r := range req.requests {
+		ars = append(ars, &protomfx.AuthorizeReq{Token: r.token, Object: r.object, Subject: r.subject, Action: r.action})
+	}
+
+	return &protomfx.AuthorizeBatchReq{Requests: ars}, nil
+}
+
 func encodeIdentifyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(identifyReq)
 	return &protomfx.Token{Value: req.key}, nil
 }
 
+func encodeIdentifyBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(identifyBatchReq)
+	return &protomfx.TokensReq{Tokens: req.keys}, nil
+}
+
 func encodeGetGroupsByIDsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(getGroupsByIDsReq)
 	return &protomfx.GroupsReq{Ids: req.ids}, nil
@@ -192,14 +377,33 @@ func encodeGetGroupIDByThingIDRequest(_ context.Context, grpcReq interface{}) (i
 	return &protomfx.ThingID{Value: req.thingID}, nil
 }
 
+func encodeGetPubConfsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(pubConfsReq)
+	return &protomfx.PubConfsReq{Offset: req.offset, Limit: req.limit}, nil
+}
+
+func encodeGetStatsRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return &empty.Empty{}, nil
+}
+
+func encodeGetOrgStatsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(getOrgStatsReq)
+	return &protomfx.OrgStatsReq{OrgID: req.orgID}, nil
+}
+
 func decodeIdentityResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(*protomfx.ThingID)
 	return identityRes{id: res.GetValue()}, nil
 }
 
+func decodeIdentitiesResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.ThingIDsRes)
+	return identitiesRes{ids: res.GetIds()}, nil
+}
+
 func decodeGetPubConfByKeyResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(*protomfx.PubConfByKeyRes)
-	return pubConfByKeyRes{publisherID: res.PublisherID, profileConfig: res.ProfileConfig}, nil
+	return pubConfByKeyRes{publisherID: res.GetPublisherID(), orgID: res.GetOrgID(), profileConfig: res.GetProfileConfig(), networkACLs: res.GetNetworkACLs(), profileID: res.GetProfileID(), groupID: res.GetGroupID(), shareID: res.GetShareID(), expiresAt: res.GetExpiresAt()}, nil
 }
 
 func decodeGetConfigByThingIDResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
@@ -220,3 +424,13 @@ func decodeGetGroupIDByThingIDResponse(_ context.Context, grpcRes interface{}) (
 	res := grpcRes.(*protomfx.GroupID)
 	return groupIDByThingIDRes{groupID: res.GetValue()}, nil
 }
+
+func decodeGetPubConfsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.PubConfsRes)
+	return pubConfsRes{pubConfs: res.GetPubConfs(), total: res.GetTotal()}, nil
+}
+
+func decodeGetStatsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.ThingsStatsRes)
+	return getStatsRes{things: res.GetThings(), profiles: res.GetProfiles(), groups: res.GetGroups()}, nil
+}
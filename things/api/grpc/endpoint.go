@@ -24,14 +24,82 @@ func getPubConfByKeyEndpoint(svc things.Service) endpoint.Endpoint {
 			return pubConfByKeyRes{}, err
 		}
 
-		config, err := buildConfigResponse(pc.ProfileConfig)
+		config, err := buildConfigResponse(pc.ProfileConfig, pc.ProfileVersion)
 		if err != nil {
 			return pubConfByKeyRes{}, err
 		}
 
 		res := pubConfByKeyRes{
 			publisherID:   pc.PublisherID,
+			orgID:         pc.OrgID,
 			profileConfig: config,
+			networkACLs:   buildNetworkACLsResponse(pc.NetworkACLs),
+			profileID:     pc.ProfileID,
+			groupID:       pc.GroupID,
+		}
+
+		return res, nil
+	}
+}
+
+func getPubConfByShareEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pubConfByShareReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pc, err := svc.GetPubConfByShare(ctx, req.key, req.password)
+		if err != nil {
+			return pubConfByKeyRes{}, err
+		}
+
+		config, err := buildConfigResponse(pc.ProfileConfig, pc.ProfileVersion)
+		if err != nil {
+			return pubConfByKeyRes{}, err
+		}
+
+		res := pubConfByKeyRes{
+			publisherID:   pc.PublisherID,
+			orgID:         pc.OrgID,
+			profileConfig: config,
+			networkACLs:   buildNetworkACLsResponse(pc.NetworkACLs),
+			profileID:     pc.ProfileID,
+			groupID:       pc.GroupID,
+			shareID:       pc.ShareID,
+		}
+		if !pc.ExpiresAt.IsZero() {
+			res.expiresAt = pc.ExpiresAt.UnixNano()
+		}
+
+		return res, nil
+	}
+}
+
+func getPubConfByGatewayEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pubConfByGatewayReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		pc, err := svc.GetPubConfByGateway(ctx, req.key, req.thingID)
+		if err != nil {
+			return pubConfByKeyRes{}, err
+		}
+
+		config, err := buildConfigResponse(pc.ProfileConfig, pc.ProfileVersion)
+		if err != nil {
+			return pubConfByKeyRes{}, err
+		}
+
+		res := pubConfByKeyRes{
+			publisherID:   pc.PublisherID,
+			orgID:         pc.OrgID,
+			profileConfig: config,
+			networkACLs:   buildNetworkACLsResponse(pc.NetworkACLs),
+			profileID:     pc.ProfileID,
+			groupID:       pc.GroupID,
 		}
 
 		return res, nil
@@ -45,12 +113,12 @@ func getConfigByThingIDEndpoint(svc things.Service) endpoint.Endpoint {
 			return nil, err
 		}
 
-		c, err := svc.GetConfigByThingID(ctx, req.thingID)
+		pr, err := svc.GetConfigByThingID(ctx, req.thingID)
 		if err != nil {
 			return configByThingIDRes{}, err
 		}
 
-		config, err := buildConfigResponse(c)
+		config, err := buildConfigResponse(pr.Config, pr.Version)
 		if err != nil {
 			return pubConfByKeyRes{}, err
 		}
@@ -81,6 +149,30 @@ func authorizeEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
+func authorizeBatchEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(authorizeBatchReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		for _, r := range req.requests {
+			ar := things.AuthorizeReq{
+				Token:   r.token,
+				Object:  r.object,
+				Subject: r.subject,
+				Action:  r.action,
+			}
+
+			if err := svc.Authorize(ctx, ar); err != nil {
+				return emptyRes{}, err
+			}
+		}
+
+		return emptyRes{}, nil
+	}
+}
+
 func identifyEndpoint(svc things.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(identifyReq)
@@ -97,6 +189,26 @@ func identifyEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
+func identifyBatchEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(identifyBatchReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ids := []string{}
+		for _, key := range req.keys {
+			id, err := svc.Identify(ctx, key)
+			if err != nil {
+				return identitiesRes{}, err
+			}
+			ids = append(ids, id)
+		}
+
+		return identitiesRes{ids: ids}, nil
+	}
+}
+
 func listGroupsByIDsEndpoint(svc things.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(getGroupsByIDsReq)
@@ -141,7 +253,68 @@ func getGroupIDByThingIDEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
-func buildConfigResponse(conf map[string]interface{}) (*protomfx.Config, error) {
+func getStatsEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		st, err := svc.GetStats(ctx)
+		if err != nil {
+			return getStatsRes{}, err
+		}
+
+		return getStatsRes{things: st.Things, profiles: st.Profiles, groups: st.Groups}, nil
+	}
+}
+
+func getOrgStatsEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getOrgStatsReq)
+		if err := req.validate(); err != nil {
+			return getStatsRes{}, err
+		}
+
+		st, err := svc.GetOrgStats(ctx, req.orgID)
+		if err != nil {
+			return getStatsRes{}, err
+		}
+
+		return getStatsRes{things: st.Things, profiles: st.Profiles, groups: st.Groups}, nil
+	}
+}
+
+func getPubConfsEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pubConfsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.GetPubConfs(ctx, things.PageMetadata{Offset: req.offset, Limit: req.limit})
+		if err != nil {
+			return pubConfsRes{}, err
+		}
+
+		pcs := []*protomfx.ThingPubConf{}
+		for _, pc := range page.PubConfs {
+			config, err := buildConfigResponse(pc.ProfileConfig, pc.ProfileVersion)
+			if err != nil {
+				return pubConfsRes{}, err
+			}
+
+			pcs = append(pcs, &protomfx.ThingPubConf{
+				Key:           pc.Key,
+				PublisherID:   pc.PublisherID,
+				OrgID:         pc.OrgID,
+				ProfileConfig: config,
+				NetworkACLs:   buildNetworkACLsResponse(pc.NetworkACLs),
+				ProfileID:     pc.ProfileID,
+				GroupID:       pc.GroupID,
+			})
+		}
+
+		return pubConfsRes{pubConfs: pcs, total: page.Total}, nil
+	}
+}
+
+func buildConfigResponse(conf map[string]interface{}, version uint64) (*protomfx.Config, error) {
 	cb, err := json.Marshal(conf)
 	if err != nil {
 		return &protomfx.Config{}, err
@@ -158,16 +331,47 @@ func buildConfigResponse(conf map[string]interface{}) (*protomfx.Config, error)
 		TimeField:    config.Transformer.TimeField,
 		TimeFormat:   config.Transformer.TimeFormat,
 		TimeLocation: config.Transformer.TimeLocation,
+		Units:        config.Transformer.Units,
+		Name:         config.Transformer.Name,
+	}
+
+	// The schema is passed on as JSON, since its structure is arbitrary.
+	var schema string
+	if config.Schema != nil {
+		sb, err := json.Marshal(config.Schema)
+		if err != nil {
+			return &protomfx.Config{}, err
+		}
+		schema = string(sb)
 	}
 
 	profileConfig := &protomfx.Config{
-		ContentType: config.ContentType,
-		Write:       config.Write,
-		Transformer: transformer,
-		WebhookID:   config.WebhookID,
-		SmtpID:      config.SmtpID,
-		SmppID:      config.SmppID,
+		ContentType:     config.ContentType,
+		Write:           config.Write,
+		Transformer:     transformer,
+		WebhookID:       config.WebhookID,
+		SmtpID:          config.SmtpID,
+		SmppID:          config.SmppID,
+		Writers:         config.Writers,
+		ContentEncoding: config.ContentEncoding,
+		Mqtt: &protomfx.MQTTConfig{
+			KeepAlive:      config.MQTT.KeepAlive,
+			MaxInflight:    config.MQTT.MaxInflight,
+			ReceiveMaximum: config.MQTT.ReceiveMaximum,
+			MaxPacketSize:  config.MQTT.MaxPacketSize,
+		},
+		Schema:  schema,
+		Version: version,
 	}
 
 	return profileConfig, nil
 }
+
+func buildNetworkACLsResponse(acls []things.NetworkACL) []*protomfx.NetworkACL {
+	res := []*protomfx.NetworkACL{}
+	for _, acl := range acls {
+		res = append(res, &protomfx.NetworkACL{Allow: acl.Allow, Deny: acl.Deny})
+	}
+
+	return res
+}
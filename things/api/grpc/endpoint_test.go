@@ -52,12 +52,14 @@ func TestGetPubConfByKey(t *testing.T) {
 	defer cancel()
 
 	cases := map[string]struct {
-		key  string
-		code codes.Code
+		key     string
+		code    codes.Code
+		version uint64
 	}{
 		"check if thing can access existing profile": {
-			key:  thKey,
-			code: codes.OK,
+			key:     thKey,
+			code:    codes.OK,
+			version: 1,
 		},
 		"check if thing with wrong access key can access existing profile": {
 			key:  wrong,
@@ -66,10 +68,132 @@ func TestGetPubConfByKey(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		_, err := cli.GetPubConfByKey(ctx, &protomfx.PubConfByKeyReq{Key: tc.key})
+		pc, err := cli.GetPubConfByKey(ctx, &protomfx.PubConfByKeyReq{Key: tc.key})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code()))
+		version := pc.GetProfileConfig().GetVersion()
+		assert.Equal(t, tc.version, version, fmt.Sprintf("%s: expected version %d got %d", desc, tc.version, version))
+	}
+}
+
+func TestGetPubConfByGateway(t *testing.T) {
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	prID := prs[0].ID
+
+	thing.GroupID = grID
+	thing.ProfileID = prID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gw, child, other := ths[0], ths[1], ths[2]
+
+	err = svc.UpdateGateway(context.Background(), token, things.Gateway{ThingID: gw.ID, Children: []string{child.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	usersAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(usersAddr, grpc.WithInsecure())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	cli := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	cases := map[string]struct {
+		key     string
+		thingID string
+		id      string
+		code    codes.Code
+	}{
+		"check if gateway can publish on behalf of child": {
+			key:     gw.Key,
+			thingID: child.ID,
+			id:      child.ID,
+			code:    codes.OK,
+		},
+		"check if gateway can publish on behalf of thing which isn't child": {
+			key:     gw.Key,
+			thingID: other.ID,
+			code:    codes.PermissionDenied,
+		},
+		"check if thing which isn't gateway can publish on behalf of child": {
+			key:     other.Key,
+			thingID: child.ID,
+			code:    codes.PermissionDenied,
+		},
+		"check if gateway with wrong key can publish on behalf of child": {
+			key:     wrong,
+			thingID: child.ID,
+			code:    codes.NotFound,
+		},
+		"check if gateway can publish without child id": {
+			key:  gw.Key,
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for desc, tc := range cases {
+		res, err := cli.GetPubConfByGateway(ctx, &protomfx.PubConfByGatewayReq{Key: tc.key, ThingID: tc.thingID})
 		e, ok := status.FromError(err)
 		assert.True(t, ok, "OK expected to be true")
 		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code()))
+		assert.Equal(t, tc.id, res.GetPublisherID(), fmt.Sprintf("%s: expected publisher %s got %s", desc, tc.id, res.GetPublisherID()))
+	}
+}
+
+func TestGetPubConfs(t *testing.T) {
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	prID := prs[0].ID
+
+	thing.GroupID = grID
+	thing.ProfileID = prID
+	_, err = svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	usersAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(usersAddr, grpc.WithInsecure())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	cli := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	cases := map[string]struct {
+		limit uint64
+		code  codes.Code
+	}{
+		"get publish configurations": {
+			limit: 100,
+			code:  codes.OK,
+		},
+		"get publish configurations with zero limit": {
+			limit: 0,
+			code:  codes.InvalidArgument,
+		},
+		"get publish configurations with limit above max": {
+			limit: 1001,
+			code:  codes.InvalidArgument,
+		},
+	}
+
+	for desc, tc := range cases {
+		res, err := cli.GetPubConfs(ctx, &protomfx.PubConfsReq{Limit: tc.limit})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code()))
+		if err == nil {
+			assert.NotEmpty(t, res.GetPubConfs(), fmt.Sprintf("%s: expected non-empty publish configurations", desc))
+			assert.Equal(t, uint64(len(res.GetPubConfs())), res.GetTotal(), fmt.Sprintf("%s: expected total %d got %d", desc, len(res.GetPubConfs()), res.GetTotal()))
+		}
 	}
 }
 
@@ -121,3 +245,106 @@ func TestIdentify(t *testing.T) {
 		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code()))
 	}
 }
+
+func TestIdentifyBatch(t *testing.T) {
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	prID := prs[0].ID
+
+	thing.GroupID = grID
+	thing.ProfileID = prID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	usersAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(usersAddr, grpc.WithInsecure())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	cli := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	cases := map[string]struct {
+		keys []string
+		ids  []string
+		code codes.Code
+	}{
+		"identify existing things": {
+			keys: []string{ths[0].Key, ths[1].Key},
+			ids:  []string{ths[0].ID, ths[1].ID},
+			code: codes.OK,
+		},
+		"identify existing and non-existent things": {
+			keys: []string{ths[0].Key, wrong},
+			ids:  nil,
+			code: codes.NotFound,
+		},
+		"identify things with empty key": {
+			keys: []string{ths[0].Key, ""},
+			ids:  nil,
+			code: codes.InvalidArgument,
+		},
+		"identify empty list of things": {
+			keys: []string{},
+			ids:  nil,
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for desc, tc := range cases {
+		res, err := cli.IdentifyBatch(ctx, &protomfx.TokensReq{Tokens: tc.keys})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.ids, res.GetIds(), fmt.Sprintf("%s: expected %v got %v", desc, tc.ids, res.GetIds()))
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code()))
+	}
+}
+
+func TestAuthorizeBatch(t *testing.T) {
+	grs, err := svc.CreateGroups(context.Background(), token, group, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	usersAddr := fmt.Sprintf("localhost:%d", port)
+	conn, err := grpc.Dial(usersAddr, grpc.WithInsecure())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	cli := grpcapi.NewClient(conn, mocktracer.New(), time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	authorizeReq := func(tok, grID string) *protomfx.AuthorizeReq {
+		return &protomfx.AuthorizeReq{Token: tok, Object: grID, Subject: things.GroupSub, Action: things.Editor}
+	}
+
+	cases := map[string]struct {
+		requests []*protomfx.AuthorizeReq
+		code     codes.Code
+	}{
+		"authorize access to groups": {
+			requests: []*protomfx.AuthorizeReq{authorizeReq(token, grs[0].ID), authorizeReq(token, grs[1].ID)},
+			code:     codes.OK,
+		},
+		"authorize access to existing and non-existent groups": {
+			requests: []*protomfx.AuthorizeReq{authorizeReq(token, grs[0].ID), authorizeReq(token, wrong)},
+			code:     codes.NotFound,
+		},
+		"authorize access to groups with invalid token": {
+			requests: []*protomfx.AuthorizeReq{authorizeReq(token, grs[0].ID), authorizeReq(wrong, grs[1].ID)},
+			code:     codes.Unauthenticated,
+		},
+		"authorize access to empty list of groups": {
+			requests: []*protomfx.AuthorizeReq{},
+			code:     codes.InvalidArgument,
+		},
+	}
+
+	for desc, tc := range cases {
+		_, err := cli.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: tc.requests})
+		e, ok := status.FromError(err)
+		assert.True(t, ok, "OK expected to be true")
+		assert.Equal(t, tc.code, e.Code(), fmt.Sprintf("%s: expected %s got %s", desc, tc.code, e.Code()))
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/MainfluxLabs/mainflux/things"
 )
 
+const maxPubConfsLimit = 1000
+
 type pubConfByKeyReq struct {
 	key string
 }
@@ -20,6 +22,36 @@ func (req pubConfByKeyReq) validate() error {
 	return nil
 }
 
+type pubConfByShareReq struct {
+	key      string
+	password string
+}
+
+func (req pubConfByShareReq) validate() error {
+	if req.key == "" {
+		return apiutil.ErrBearerKey
+	}
+
+	return nil
+}
+
+type pubConfByGatewayReq struct {
+	key     string
+	thingID string
+}
+
+func (req pubConfByGatewayReq) validate() error {
+	if req.key == "" {
+		return apiutil.ErrBearerKey
+	}
+
+	if req.thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
 type configByThingIDReq struct {
 	thingID string
 }
@@ -32,6 +64,19 @@ func (req configByThingIDReq) validate() error {
 	return nil
 }
 
+type pubConfsReq struct {
+	offset uint64
+	limit  uint64
+}
+
+func (req pubConfsReq) validate() error {
+	if req.limit == 0 || req.limit > maxPubConfsLimit {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
 type authorizeReq struct {
 	token   string
 	object  string
@@ -59,6 +104,24 @@ func (req authorizeReq) validate() error {
 	return nil
 }
 
+type authorizeBatchReq struct {
+	requests []authorizeReq
+}
+
+func (req authorizeBatchReq) validate() error {
+	if len(req.requests) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, ar := range req.requests {
+		if err := ar.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type identifyReq struct {
 	key string
 }
@@ -71,6 +134,24 @@ func (req identifyReq) validate() error {
 	return nil
 }
 
+type identifyBatchReq struct {
+	keys []string
+}
+
+func (req identifyBatchReq) validate() error {
+	if len(req.keys) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, key := range req.keys {
+		if key == "" {
+			return apiutil.ErrBearerKey
+		}
+	}
+
+	return nil
+}
+
 type getGroupsByIDsReq struct {
 	ids []string
 }
@@ -94,3 +175,17 @@ func (req groupIDByThingIDReq) validate() error {
 
 	return nil
 }
+
+type getStatsReq struct{}
+
+type getOrgStatsReq struct {
+	orgID string
+}
+
+func (req getOrgStatsReq) validate() error {
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	return nil
+}
@@ -11,15 +11,32 @@ type identityRes struct {
 	id string
 }
 
+type identitiesRes struct {
+	ids []string
+}
+
 type pubConfByKeyRes struct {
 	publisherID   string
+	orgID         string
 	profileConfig *protomfx.Config
+	networkACLs   []*protomfx.NetworkACL
+	profileID     string
+	groupID       string
+	// shareID and expiresAt are set only for the
+	// publish configuration of the shared thing.
+	shareID   string
+	expiresAt int64
 }
 
 type configByThingIDRes struct {
 	config *protomfx.Config
 }
 
+type pubConfsRes struct {
+	pubConfs []*protomfx.ThingPubConf
+	total    uint64
+}
+
 type emptyRes struct {
 	err error
 }
@@ -31,3 +48,9 @@ type getGroupsByIDsRes struct {
 type groupIDByThingIDRes struct {
 	groupID string
 }
+
+type getStatsRes struct {
+	things   uint64
+	profiles uint64
+	groups   uint64
+}
@@ -24,9 +24,16 @@ type grpcServer struct {
 	getPubConfByKey     kitgrpc.Handler
 	getConfigByThingID  kitgrpc.Handler
 	authorize           kitgrpc.Handler
+	authorizeBatch      kitgrpc.Handler
 	identify            kitgrpc.Handler
+	identifyBatch       kitgrpc.Handler
 	getGroupsByIDs      kitgrpc.Handler
 	getGroupIDByThingID kitgrpc.Handler
+	getPubConfs         kitgrpc.Handler
+	getPubConfByShare   kitgrpc.Handler
+	getPubConfByGateway kitgrpc.Handler
+	getStats            kitgrpc.Handler
+	getOrgStats         kitgrpc.Handler
 }
 
 // NewServer returns new ThingsServiceServer instance.
@@ -47,11 +54,21 @@ func NewServer(tracer opentracing.Tracer, svc things.Service) protomfx.ThingsSer
 			decodeAuthorizeRequest,
 			encodeEmptyResponse,
 		),
+		authorizeBatch: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "authorize_batch")(authorizeBatchEndpoint(svc)),
+			decodeAuthorizeBatchRequest,
+			encodeEmptyResponse,
+		),
 		identify: kitgrpc.NewServer(
 			kitot.TraceServer(tracer, "identify")(identifyEndpoint(svc)),
 			decodeIdentifyRequest,
 			encodeIdentityResponse,
 		),
+		identifyBatch: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "identify_batch")(identifyBatchEndpoint(svc)),
+			decodeIdentifyBatchRequest,
+			encodeIdentitiesResponse,
+		),
 		getGroupsByIDs: kitgrpc.NewServer(
 			kitot.TraceServer(tracer, "get_groups_by_ids")(listGroupsByIDsEndpoint(svc)),
 			decodeGetGroupsByIDsRequest,
@@ -62,6 +79,31 @@ func NewServer(tracer opentracing.Tracer, svc things.Service) protomfx.ThingsSer
 			decodeGetGroupIDByThingIDRequest,
 			encodeGetGroupIDByThingIDResponse,
 		),
+		getPubConfs: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "get_pub_confs")(getPubConfsEndpoint(svc)),
+			decodeGetPubConfsRequest,
+			encodeGetPubConfsResponse,
+		),
+		getPubConfByShare: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "get_pub_conf_by_share")(getPubConfByShareEndpoint(svc)),
+			decodeGetPubConfByShareRequest,
+			encodeGetPubConfByKeyResponse,
+		),
+		getPubConfByGateway: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "get_pub_conf_by_gateway")(getPubConfByGatewayEndpoint(svc)),
+			decodeGetPubConfByGatewayRequest,
+			encodeGetPubConfByKeyResponse,
+		),
+		getStats: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "get_stats")(getStatsEndpoint(svc)),
+			decodeGetStatsRequest,
+			encodeGetStatsResponse,
+		),
+		getOrgStats: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "get_org_stats")(getOrgStatsEndpoint(svc)),
+			decodeGetOrgStatsRequest,
+			encodeGetStatsResponse,
+		),
 	}
 }
 
@@ -91,6 +133,15 @@ func (gs *grpcServer) Authorize(ctx context.Context, req *protomfx.AuthorizeReq)
 	return res.(*empty.Empty), nil
 }
 
+func (gs *grpcServer) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq) (*empty.Empty, error) {
+	_, res, err := gs.authorizeBatch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*empty.Empty), nil
+}
+
 func (gs *grpcServer) Identify(ctx context.Context, req *protomfx.Token) (*protomfx.ThingID, error) {
 	_, res, err := gs.identify.ServeGRPC(ctx, req)
 	if err != nil {
@@ -100,6 +151,15 @@ func (gs *grpcServer) Identify(ctx context.Context, req *protomfx.Token) (*proto
 	return res.(*protomfx.ThingID), nil
 }
 
+func (gs *grpcServer) IdentifyBatch(ctx context.Context, req *protomfx.TokensReq) (*protomfx.ThingIDsRes, error) {
+	_, res, err := gs.identifyBatch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.ThingIDsRes), nil
+}
+
 func (gs *grpcServer) GetGroupsByIDs(ctx context.Context, req *protomfx.GroupsReq) (*protomfx.GroupsRes, error) {
 	_, res, err := gs.getGroupsByIDs.ServeGRPC(ctx, req)
 	if err != nil {
@@ -118,11 +178,66 @@ func (gs *grpcServer) GetGroupIDByThingID(ctx context.Context, req *protomfx.Thi
 	return res.(*protomfx.GroupID), nil
 }
 
+func (gs *grpcServer) GetPubConfs(ctx context.Context, req *protomfx.PubConfsReq) (*protomfx.PubConfsRes, error) {
+	_, res, err := gs.getPubConfs.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.PubConfsRes), nil
+}
+
+func (gs *grpcServer) GetPubConfByShare(ctx context.Context, req *protomfx.PubConfByShareReq) (*protomfx.PubConfByKeyRes, error) {
+	_, res, err := gs.getPubConfByShare.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.PubConfByKeyRes), nil
+}
+
+func (gs *grpcServer) GetPubConfByGateway(ctx context.Context, req *protomfx.PubConfByGatewayReq) (*protomfx.PubConfByKeyRes, error) {
+	_, res, err := gs.getPubConfByGateway.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.PubConfByKeyRes), nil
+}
+
+func (gs *grpcServer) GetStats(ctx context.Context, req *empty.Empty) (*protomfx.ThingsStatsRes, error) {
+	_, res, err := gs.getStats.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.ThingsStatsRes), nil
+}
+
+func (gs *grpcServer) GetOrgStats(ctx context.Context, req *protomfx.OrgStatsReq) (*protomfx.ThingsStatsRes, error) {
+	_, res, err := gs.getOrgStats.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.ThingsStatsRes), nil
+}
+
 func decodeGetPubConfByKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.PubConfByKeyReq)
 	return pubConfByKeyReq{key: req.GetKey()}, nil
 }
 
+func decodeGetPubConfByShareRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.PubConfByShareReq)
+	return pubConfByShareReq{key: req.GetKey(), password: req.GetPassword()}, nil
+}
+
+func decodeGetPubConfByGatewayRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.PubConfByGatewayReq)
+	return pubConfByGatewayReq{key: req.GetKey(), thingID: req.GetThingID()}, nil
+}
+
 func decodeGetConfigByThingIDRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.ThingID)
 	return configByThingIDReq{thingID: req.GetValue()}, nil
@@ -133,11 +248,27 @@ func decodeAuthorizeRequest(_ context.Context, grpcReq interface{}) (interface{}
 	return authorizeReq{token: req.GetToken(), object: req.GetObject(), subject: req.GetSubject(), action: req.GetAction()}, nil
 }
 
+func decodeAuthorizeBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.AuthorizeBatchReq)
+
+	ars := []authorizeReq{}
+	for _, r := range req.GetRequests() {
+		ars = append(ars, authorizeReq{token: r.GetToken(), object: r.GetObject(), subject: r.GetSubject(), action: r.GetAction()})
+	}
+
+	return authorizeBatchReq{requests: ars}, nil
+}
+
 func decodeIdentifyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.Token)
 	return identifyReq{key: req.GetValue()}, nil
 }
 
+func decodeIdentifyBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.TokensReq)
+	return identifyBatchReq{keys: req.GetTokens()}, nil
+}
+
 func decodeGetGroupsByIDsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.GroupsReq)
 	return getGroupsByIDsReq{ids: req.GetIds()}, nil
@@ -148,14 +279,33 @@ func decodeGetGroupIDByThingIDRequest(_ context.Context, grpcReq interface{}) (i
 	return groupIDByThingIDReq{thingID: req.GetValue()}, nil
 }
 
+func decodeGetPubConfsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.PubConfsReq)
+	return pubConfsReq{offset: req.GetOffset(), limit: req.GetLimit()}, nil
+}
+
+func decodeGetStatsRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return getStatsReq{}, nil
+}
+
+func decodeGetOrgStatsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*protomfx.OrgStatsReq)
+	return getOrgStatsReq{orgID: req.GetOrgID()}, nil
+}
+
 func encodeIdentityResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(identityRes)
 	return &protomfx.ThingID{Value: res.id}, nil
 }
 
+func encodeIdentitiesResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(identitiesRes)
+	return &protomfx.ThingIDsRes{Ids: res.ids}, nil
+}
+
 func encodeGetPubConfByKeyResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(pubConfByKeyRes)
-	return &protomfx.PubConfByKeyRes{PublisherID: res.publisherID, ProfileConfig: res.profileConfig}, nil
+	return &protomfx.PubConfByKeyRes{PublisherID: res.publisherID, OrgID: res.orgID, ProfileConfig: res.profileConfig, NetworkACLs: res.networkACLs, ProfileID: res.profileID, GroupID: res.groupID, ShareID: res.shareID, ExpiresAt: res.expiresAt}, nil
 }
 
 func encodeGetConfigByThingIDResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
@@ -178,13 +328,26 @@ func encodeGetGroupIDByThingIDResponse(_ context.Context, grpcRes interface{}) (
 	return &protomfx.GroupID{Value: res.groupID}, nil
 }
 
+func encodeGetPubConfsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(pubConfsRes)
+	return &protomfx.PubConfsRes{PubConfs: res.pubConfs, Total: res.total}, nil
+}
+
+func encodeGetStatsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getStatsRes)
+	return &protomfx.ThingsStatsRes{Things: res.things, Profiles: res.profiles, Groups: res.groups}, nil
+}
+
 func encodeError(err error) error {
 	switch {
 	case err == nil:
 		return nil
 	case errors.Contains(err, apiutil.ErrMalformedEntity),
 		err == apiutil.ErrMissingID,
-		err == apiutil.ErrBearerKey:
+		err == apiutil.ErrMissingOrgID,
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrBearerKey,
+		err == apiutil.ErrLimitSize:
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Contains(err, errors.ErrAuthentication):
 		return status.Error(codes.Unauthenticated, err.Error())
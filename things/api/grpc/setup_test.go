@@ -50,12 +50,17 @@ func newService(tokens map[string]string) things.Service {
 	auth := mocks.NewAuthService("", usersList)
 	thingsRepo := thmocks.NewThingRepository()
 	profilesRepo := thmocks.NewProfileRepository(thingsRepo)
-	groupsRepo := thmocks.NewGroupRepository()
 	rolesRepo := thmocks.NewRolesRepository()
+	groupsRepo := thmocks.NewGroupRepository(rolesRepo)
+	sharesRepo := thmocks.NewShareRepository()
+	orgTemplatesRepo := thmocks.NewOrgTemplateRepository()
+	gatewaysRepo := thmocks.NewGatewayRepository()
+	aclsRepo := thmocks.NewNetworkACLRepository()
 	profileCache := thmocks.NewProfileCache()
 	thingCache := thmocks.NewThingCache()
 	groupCache := thmocks.NewGroupCache()
+	consumers := thmocks.NewConsumerGroups()
 	idProvider := uuid.NewMock()
 
-	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, profileCache, thingCache, groupCache, idProvider)
+	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, sharesRepo, orgTemplatesRepo, gatewaysRepo, aclsRepo, profileCache, thingCache, groupCache, consumers, idProvider)
 }
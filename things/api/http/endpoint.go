@@ -4,8 +4,12 @@
 package http
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
 
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/go-kit/kit/endpoint"
 )
@@ -220,6 +224,43 @@ func listThingsByProfileEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
+func listThingsByKeyPrefixEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listByKeyPrefixReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListThingsByKeyPrefix(ctx, req.token, req.prefix, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := thingsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Things: []viewThingRes{},
+		}
+		for _, th := range page.Things {
+			view := viewThingRes{
+				ID:        th.ID,
+				GroupID:   th.GroupID,
+				ProfileID: th.ProfileID,
+				Key:       th.Key,
+				Name:      th.Name,
+				Metadata:  th.Metadata,
+			}
+			res.Things = append(res.Things, view)
+		}
+
+		return res, nil
+	}
+}
+
 func removeThingEndpoint(svc things.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(resourceReq)
@@ -289,6 +330,7 @@ func createProfilesEndpoint(svc things.Service) endpoint.Endpoint {
 				GroupID:  c.GroupID,
 				Config:   c.Config,
 				Metadata: c.Metadata,
+				Version:  c.Version,
 			}
 			res.Profiles = append(res.Profiles, pr)
 		}
@@ -310,6 +352,7 @@ func updateProfileEndpoint(svc things.Service) endpoint.Endpoint {
 			Name:     req.Name,
 			Config:   req.Config,
 			Metadata: req.Metadata,
+			Version:  req.Version,
 		}
 		if err := svc.UpdateProfile(ctx, req.token, profile); err != nil {
 			return nil, err
@@ -342,6 +385,7 @@ func viewProfileEndpoint(svc things.Service) endpoint.Endpoint {
 			Name:     pr.Name,
 			Metadata: pr.Metadata,
 			Config:   pr.Config,
+			Version:  pr.Version,
 		}
 
 		return res, nil
@@ -379,6 +423,7 @@ func listProfilesEndpoint(svc things.Service) endpoint.Endpoint {
 				Name:     pr.Name,
 				Config:   pr.Config,
 				Metadata: pr.Metadata,
+				Version:  pr.Version,
 			}
 
 			res.Profiles = append(res.Profiles, view)
@@ -407,6 +452,7 @@ func viewProfileByThingEndpoint(svc things.Service) endpoint.Endpoint {
 			Name:     pr.Name,
 			Config:   pr.Config,
 			Metadata: pr.Metadata,
+			Version:  pr.Version,
 		}
 
 		return res, nil
@@ -461,6 +507,141 @@ func backupEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
+func rebuildCacheEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(adminReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cs, err := svc.RebuildCache(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		res := rebuildCacheRes{
+			Things:   cs.Things,
+			Profiles: cs.Profiles,
+			Groups:   cs.Groups,
+			Roles:    cs.Roles,
+		}
+
+		return res, nil
+	}
+}
+
+func listConsumerGroupsEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(adminReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		cgs, err := svc.ListConsumerGroups(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		res := consumerGroupsRes{ConsumerGroups: []consumerGroupRes{}}
+		for _, cg := range cgs {
+			res.ConsumerGroups = append(res.ConsumerGroups, consumerGroupRes{
+				Stream:          cg.Stream,
+				Name:            cg.Name,
+				Consumers:       cg.Consumers,
+				Pending:         cg.Pending,
+				LastDeliveredID: cg.LastDeliveredID,
+				StreamLength:    cg.StreamLength,
+				LastEntryID:     cg.LastEntryID,
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func viewOrgCleanupEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resourceReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		oc, err := svc.ViewOrgCleanup(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := orgCleanupRes{
+			OrgID:     oc.OrgID,
+			Groups:    oc.Groups,
+			StartedAt: oc.StartedAt,
+			Done:      true,
+			Consumers: []cleanupProgressRes{},
+		}
+		for _, cp := range oc.Consumers {
+			res.Consumers = append(res.Consumers, cleanupProgressRes{
+				Name: cp.Name,
+				Done: cp.Done,
+			})
+			res.Done = res.Done && cp.Done
+		}
+
+		return res, nil
+	}
+}
+
+func resetConsumerGroupEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resetConsumerGroupReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.ResetConsumerGroup(ctx, req.token, req.Stream, req.Offset); err != nil {
+			return nil, err
+		}
+
+		return resetConsumerGroupRes{}, nil
+	}
+}
+
+func listGroupAccessEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(groupAccessReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		gas, err := svc.ListGroupAccess(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.format == csvFormat {
+			content, err := generateAccessCSV(gas)
+			if err != nil {
+				return nil, err
+			}
+
+			return fileRes{name: "group-access", content: content}, nil
+		}
+
+		res := groupAccessPageRes{GroupAccess: []groupAccessRes{}}
+		for _, ga := range gas {
+			res.GroupAccess = append(res.GroupAccess, groupAccessRes{
+				OrgID:     ga.OrgID,
+				GroupID:   ga.GroupID,
+				GroupName: ga.GroupName,
+				MemberID:  ga.MemberID,
+				Email:     ga.Email,
+				Role:      ga.Role,
+			})
+		}
+
+		return res, nil
+	}
+}
+
 func restoreEndpoint(svc things.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(restoreReq)
@@ -478,6 +659,92 @@ func restoreEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
+func updateOrgTemplateEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateOrgTemplateReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ot := things.OrgTemplate{}
+		for _, gt := range req.Groups {
+			pts := []things.ProfileTemplate{}
+			for _, pt := range gt.Profiles {
+				pts = append(pts, things.ProfileTemplate{
+					Name:     pt.Name,
+					Config:   pt.Config,
+					Metadata: pt.Metadata,
+				})
+			}
+
+			ot.Groups = append(ot.Groups, things.GroupTemplate{
+				Name:        gt.Name,
+				Description: gt.Description,
+				Metadata:    gt.Metadata,
+				Profiles:    pts,
+			})
+		}
+
+		if err := svc.UpdateOrgTemplate(ctx, req.token, ot); err != nil {
+			return nil, err
+		}
+
+		return updateOrgTemplateRes{}, nil
+	}
+}
+
+func viewOrgTemplateEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgTemplateReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ot, err := svc.ViewOrgTemplate(ctx, req.token)
+		if err != nil {
+			return nil, err
+		}
+
+		res := orgTemplateRes{
+			Groups:    []groupTemplateRes{},
+			UpdatedAt: ot.UpdatedAt,
+		}
+		for _, gt := range ot.Groups {
+			gtr := groupTemplateRes{
+				Name:        gt.Name,
+				Description: gt.Description,
+				Metadata:    gt.Metadata,
+				Profiles:    []profileTemplateRes{},
+			}
+			for _, pt := range gt.Profiles {
+				gtr.Profiles = append(gtr.Profiles, profileTemplateRes{
+					Name:     pt.Name,
+					Config:   pt.Config,
+					Metadata: pt.Metadata,
+				})
+			}
+			res.Groups = append(res.Groups, gtr)
+		}
+
+		return res, nil
+	}
+}
+
+func removeOrgTemplateEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(orgTemplateReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveOrgTemplate(ctx, req.token); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
 func createGroupsEndpoint(svc things.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(createGroupsReq)
@@ -571,6 +838,32 @@ func updateGroupEndpoint(svc things.Service) endpoint.Endpoint {
 	}
 }
 
+func transferGroupEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(transferGroupReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		group, err := svc.TransferGroup(ctx, req.token, req.id, req.OrgID)
+		if err != nil {
+			return nil, err
+		}
+
+		res := viewGroupRes{
+			ID:          group.ID,
+			Name:        group.Name,
+			Description: group.Description,
+			Metadata:    group.Metadata,
+			OrgID:       group.OrgID,
+			CreatedAt:   group.CreatedAt,
+			UpdatedAt:   group.UpdatedAt,
+		}
+
+		return res, nil
+	}
+}
+
 func removeGroupEndpoint(svc things.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(resourceReq)
@@ -769,6 +1062,7 @@ func buildProfilesByGroupResponse(cp things.ProfilesPage) profilesPageRes {
 			Config:   pr.Config,
 			Metadata: pr.Metadata,
 			Name:     pr.Name,
+			Version:  pr.Version,
 		}
 		res.Profiles = append(res.Profiles, c)
 	}
@@ -979,3 +1273,421 @@ func buildGroupRolesResponse(gpp things.GroupMembersPage) listGroupRolesRes {
 
 	return res
 }
+
+func generateAccessCSV(gas []things.GroupAccess) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write([]string{"org_id", "group_id", "group_name", "member_id", "email", "role"}); err != nil {
+		return nil, err
+	}
+
+	for _, ga := range gas {
+		if err := writer.Write([]string{ga.OrgID, ga.GroupID, ga.GroupName, ga.MemberID, ga.Email, ga.Role}); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func exportGroupEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(exportGroupReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ge, err := svc.ExportGroup(ctx, req.token, req.groupID)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.format == csvFormat {
+			content, err := generateExportCSV(ge)
+			if err != nil {
+				return nil, err
+			}
+
+			return fileRes{name: "group-" + req.groupID, content: content}, nil
+		}
+
+		res := groupExportRes{
+			Profiles: []profileRes{},
+			Things:   []thingRes{},
+		}
+		for _, pr := range ge.Profiles {
+			res.Profiles = append(res.Profiles, profileRes{
+				ID:       pr.ID,
+				Name:     pr.Name,
+				Config:   pr.Config,
+				Metadata: pr.Metadata,
+			})
+		}
+		for _, th := range ge.Things {
+			res.Things = append(res.Things, thingRes{
+				ID:        th.ID,
+				ProfileID: th.ProfileID,
+				Name:      th.Name,
+				Key:       th.Key,
+				Metadata:  th.Metadata,
+			})
+		}
+
+		return res, nil
+	}
+}
+
+func importGroupEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importGroupReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ge := things.GroupExport{}
+		for _, pr := range req.Profiles {
+			ge.Profiles = append(ge.Profiles, things.Profile{
+				ID:       pr.ID,
+				Name:     pr.Name,
+				Config:   pr.Config,
+				Metadata: pr.Metadata,
+			})
+		}
+		for _, th := range req.Things {
+			ge.Things = append(ge.Things, things.Thing{
+				ID:        th.ID,
+				ProfileID: th.ProfileID,
+				Name:      th.Name,
+				Key:       th.Key,
+				Metadata:  th.Metadata,
+			})
+		}
+
+		ir, err := svc.ImportGroup(ctx, req.token, req.groupID, ge)
+		if err != nil {
+			return nil, err
+		}
+
+		res := importGroupRes{
+			Profiles: []profileRes{},
+			Things:   []thingRes{},
+			Errors:   []importErrorRes{},
+		}
+		for _, pr := range ir.Profiles {
+			res.Profiles = append(res.Profiles, profileRes{
+				ID:       pr.ID,
+				GroupID:  pr.GroupID,
+				Name:     pr.Name,
+				Config:   pr.Config,
+				Metadata: pr.Metadata,
+				Version:  pr.Version,
+			})
+		}
+		for _, th := range ir.Things {
+			res.Things = append(res.Things, thingRes{
+				ID:        th.ID,
+				GroupID:   th.GroupID,
+				ProfileID: th.ProfileID,
+				Name:      th.Name,
+				Key:       th.Key,
+				Metadata:  th.Metadata,
+			})
+		}
+		for _, ie := range ir.Errors {
+			res.Errors = append(res.Errors, importErrorRes{
+				Subject: ie.Subject,
+				Index:   ie.Index,
+				Name:    ie.Name,
+				Error:   errorMessage(ie.Err),
+			})
+		}
+
+		return res, nil
+	}
+}
+
+// errorMessage returns the message of the outermost error, so that the
+// errors of the underlying storage aren't exposed.
+func errorMessage(err error) string {
+	if e, ok := err.(errors.Error); ok {
+		return e.Msg()
+	}
+
+	return err.Error()
+}
+
+func generateExportCSV(ge things.GroupExport) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return nil, err
+	}
+
+	for _, pr := range ge.Profiles {
+		config, err := encodeCSVMap(pr.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata, err := encodeCSVMap(pr.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writer.Write([]string{things.ProfileSub, pr.ID, pr.Name, "", "", config, metadata}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, th := range ge.Things {
+		metadata, err := encodeCSVMap(th.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := writer.Write([]string{things.ThingSub, th.ID, th.Name, th.ProfileID, th.Key, "", metadata}); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeCSVMap encodes the config or the metadata as the JSON in the CSV field.
+func encodeCSVMap(m map[string]interface{}) (string, error) {
+	if len(m) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func createShareEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createShareReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		sh := things.Share{
+			Name:      req.Name,
+			Password:  req.Password,
+			ExpiresAt: req.ExpiresAt,
+		}
+		saved, err := svc.CreateShare(ctx, req.token, req.thingID, sh)
+		if err != nil {
+			return nil, err
+		}
+
+		res := buildShareRes(saved)
+		res.created = true
+
+		return res, nil
+	}
+}
+
+func listSharesByThingEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		shs, err := svc.ListSharesByThing(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		res := sharesRes{Shares: []shareRes{}}
+		for _, sh := range shs {
+			res.Shares = append(res.Shares, buildShareRes(sh))
+		}
+
+		return res, nil
+	}
+}
+
+func removeShareEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeShareReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveShare(ctx, req.token, req.thingID, req.shareID); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func updateGatewayEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateGatewayReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		gw := things.Gateway{
+			ThingID:  req.thingID,
+			Children: req.Children,
+		}
+		if err := svc.UpdateGateway(ctx, req.token, gw); err != nil {
+			return nil, err
+		}
+
+		return gatewayRes{ThingID: gw.ThingID, Children: gw.Children}, nil
+	}
+}
+
+func viewGatewayEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		gw, err := svc.ViewGateway(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return gatewayRes{ThingID: gw.ThingID, Children: gw.Children}, nil
+	}
+}
+
+func removeGatewayEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveGateway(ctx, req.token, req.id); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func buildShareRes(sh things.Share) shareRes {
+	res := shareRes{
+		ID:        sh.ID,
+		ThingID:   sh.ThingID,
+		Name:      sh.Name,
+		Key:       sh.Key,
+		Protected: sh.Password != "",
+		CreatedAt: sh.CreatedAt,
+	}
+	if !sh.ExpiresAt.IsZero() {
+		expiresAt := sh.ExpiresAt
+		res.ExpiresAt = &expiresAt
+	}
+
+	return res
+}
+
+func updateThingACLEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateNetworkACLReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		acl := things.NetworkACL{Allow: req.Allow, Deny: req.Deny}
+		if err := svc.UpdateThingACL(ctx, req.token, req.id, acl); err != nil {
+			return nil, err
+		}
+
+		return networkACLRes{updated: true}, nil
+	}
+}
+
+func viewThingACLEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		acl, err := svc.ViewThingACL(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildNetworkACLResponse(acl), nil
+	}
+}
+
+func updateOrgACLEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateNetworkACLReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		acl := things.NetworkACL{Allow: req.Allow, Deny: req.Deny}
+		if err := svc.UpdateOrgACL(ctx, req.token, req.id, acl); err != nil {
+			return nil, err
+		}
+
+		return networkACLRes{updated: true}, nil
+	}
+}
+
+func viewOrgACLEndpoint(svc things.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(resourceReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		acl, err := svc.ViewOrgACL(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildNetworkACLResponse(acl), nil
+	}
+}
+
+func buildNetworkACLResponse(acl things.NetworkACL) networkACLRes {
+	res := networkACLRes{Allow: acl.Allow, Deny: acl.Deny}
+	if res.Allow == nil {
+		res.Allow = []string{}
+	}
+	if res.Deny == nil {
+		res.Deny = []string{}
+	}
+
+	return res
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
+	authmocks "github.com/MainfluxLabs/mainflux/auth/mocks"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
@@ -74,6 +75,8 @@ var (
 	usersList = []users.User{admin, user, otherUser}
 	group     = things.Group{Name: "test-group", Description: "test-group-desc", OrgID: orgID}
 	metadata  = map[string]interface{}{"test": "data"}
+
+	consumerGroup = things.ConsumerGroup{Stream: "mainflux.auth", Name: "mainflux.things", Consumers: 1, LastDeliveredID: "1-0", StreamLength: 3, LastEntryID: "3-0"}
 )
 
 type testRequest struct {
@@ -103,18 +106,50 @@ func (tr testRequest) make() (*http.Response, error) {
 	return tr.client.Do(req)
 }
 
+type shareRes struct {
+	ID        string `json:"id"`
+	ThingID   string `json:"thing_id"`
+	Name      string `json:"name,omitempty"`
+	Key       string `json:"key"`
+	Protected bool   `json:"password_protected"`
+}
+
+type sharesRes struct {
+	Shares []shareRes `json:"shares"`
+}
+
+type gatewayRes struct {
+	ThingID  string   `json:"thing_id"`
+	Children []string `json:"children"`
+}
+
+type networkACLRes struct {
+	Allow []string `json:"allow,omitempty"`
+	Deny  []string `json:"deny,omitempty"`
+}
+
 func newService() things.Service {
 	auth := mocks.NewAuthService(admin.ID, usersList)
+	usersByIDs := make(map[string]users.User)
+	for _, u := range usersList {
+		usersByIDs[u.ID] = u
+	}
+	uc := authmocks.NewUsersService(usersByIDs, nil)
 	thingsRepo := thmocks.NewThingRepository()
 	profilesRepo := thmocks.NewProfileRepository(thingsRepo)
-	groupsRepo := thmocks.NewGroupRepository()
 	rolesRepo := thmocks.NewRolesRepository()
+	groupsRepo := thmocks.NewGroupRepository(rolesRepo)
+	sharesRepo := thmocks.NewShareRepository()
+	orgTemplatesRepo := thmocks.NewOrgTemplateRepository()
+	gatewaysRepo := thmocks.NewGatewayRepository()
+	aclsRepo := thmocks.NewNetworkACLRepository()
 	profileCache := thmocks.NewProfileCache()
 	thingCache := thmocks.NewThingCache()
 	groupCache := thmocks.NewGroupCache()
+	consumers := thmocks.NewConsumerGroups(consumerGroup)
 	idProvider := uuid.NewMock()
 
-	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, profileCache, thingCache, groupCache, idProvider)
+	return things.New(auth, uc, thingsRepo, profilesRepo, groupsRepo, rolesRepo, sharesRepo, orgTemplatesRepo, gatewaysRepo, aclsRepo, profileCache, thingCache, groupCache, consumers, idProvider)
 }
 
 func newServer(svc things.Service) *httptest.Server {
@@ -1311,6 +1346,139 @@ func TestListThingsByProfile(t *testing.T) {
 	}
 }
 
+func TestListThingsByKeyPrefix(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pr := prs[0]
+
+	keyPrefix := "a1b2c3d4"
+	data := []thingRes{}
+	for i := 0; i < 15; i++ {
+		thing1 := thing
+		thing1.ID = fmt.Sprintf("%s%012d", prefix, i+1)
+		thing1.GroupID = gr.ID
+		thing1.ProfileID = pr.ID
+		thing1.Key = fmt.Sprintf("%s-%012d", keyPrefix, i+1)
+
+		ths, err := svc.CreateThings(context.Background(), token, thing1)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		th := ths[0]
+
+		data = append(data, thingRes{
+			ID:        th.ID,
+			GroupID:   th.GroupID,
+			ProfileID: th.ProfileID,
+			Name:      th.Name,
+			Key:       th.Key,
+			Metadata:  th.Metadata,
+		})
+	}
+
+	lookupURL := fmt.Sprintf("%s/things/lookup", ts.URL)
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		url    string
+		res    []thingRes
+	}{
+		{
+			desc:   "look up things by key prefix",
+			auth:   adminToken,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?key_prefix=%s&offset=%d&limit=%d", lookupURL, keyPrefix, 0, 5),
+			res:    data[0:5],
+		},
+		{
+			desc:   "look up things by key prefix without limit",
+			auth:   adminToken,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, keyPrefix),
+			res:    data[0:10],
+		},
+		{
+			desc:   "look up thing by full key",
+			auth:   adminToken,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, data[3].Key),
+			res:    data[3:4],
+		},
+		{
+			desc:   "look up things by non-matching key prefix",
+			auth:   adminToken,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, "ffffffff"),
+			res:    []thingRes{},
+		},
+		{
+			desc:   "look up things by key prefix as non-admin user",
+			auth:   token,
+			status: http.StatusForbidden,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, keyPrefix),
+			res:    []thingRes{},
+		},
+		{
+			desc:   "look up things by key prefix with invalid token",
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, keyPrefix),
+			res:    []thingRes{},
+		},
+		{
+			desc:   "look up things by key prefix with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, keyPrefix),
+			res:    []thingRes{},
+		},
+		{
+			desc:   "look up things by too short key prefix",
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?key_prefix=%s", lookupURL, "a1b"),
+			res:    []thingRes{},
+		},
+		{
+			desc:   "look up things without key prefix",
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+			url:    lookupURL,
+			res:    []thingRes{},
+		},
+		{
+			desc:   "look up things by key prefix with limit greater than max",
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?key_prefix=%s&limit=%d", lookupURL, keyPrefix, 110),
+			res:    []thingRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		var data thingsPageRes
+		json.NewDecoder(res.Body).Decode(&data)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.ElementsMatch(t, tc.res, data.Things, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, data.Things))
+	}
+}
 func TestRemoveThing(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
@@ -1376,7 +1544,7 @@ func TestRemoveThing(t *testing.T) {
 	}
 }
 
-func TestRemoveThings(t *testing.T) {
+func TestCreateShare(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
@@ -1388,92 +1556,107 @@ func TestRemoveThings(t *testing.T) {
 	profile.GroupID = grID
 	prs, err := svc.CreateProfiles(context.Background(), token, profile)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	prID := prs[0].ID
 
 	thing.GroupID = grID
-	thing.ProfileID = prID
-	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
 
-	var thingIDs []string
-	for _, th := range ths {
-		thingIDs = append(thingIDs, th.ID)
-	}
+	data := toJSON(map[string]interface{}{"name": "dashboard", "password": "password", "expires_at": time.Now().Add(time.Hour)})
+	expiredData := toJSON(map[string]interface{}{"expires_at": time.Now().Add(-time.Hour)})
+	longPassData := toJSON(map[string]interface{}{"password": strings.Repeat("p", 73)})
 
 	cases := []struct {
 		desc        string
-		data        []string
-		auth        string
+		req         string
+		id          string
 		contentType string
+		auth        string
 		status      int
 	}{
 		{
-			desc:        "remove things with invalid token",
-			data:        thingIDs,
-			auth:        wrongValue,
+			desc:        "create share",
+			req:         data,
+			id:          th.ID,
 			contentType: contentType,
-			status:      http.StatusUnauthorized,
+			auth:        token,
+			status:      http.StatusCreated,
 		},
 		{
-			desc:        "remove things with empty token",
-			data:        thingIDs,
-			auth:        emptyValue,
+			desc:        "create share with empty JSON request",
+			req:         "{}",
+			id:          th.ID,
 			contentType: contentType,
-			status:      http.StatusUnauthorized,
+			auth:        token,
+			status:      http.StatusCreated,
 		},
 		{
-			desc:        "remove things with invalid content type",
-			data:        thingIDs,
+			desc:        "create share with past expiration time",
+			req:         expiredData,
+			id:          th.ID,
+			contentType: contentType,
 			auth:        token,
-			contentType: wrongValue,
-			status:      http.StatusUnsupportedMediaType,
+			status:      http.StatusBadRequest,
 		},
 		{
-			desc:        "remove existing things",
-			data:        thingIDs,
-			auth:        token,
+			desc:        "create share with too long password",
+			req:         longPassData,
+			id:          th.ID,
 			contentType: contentType,
-			status:      http.StatusNoContent,
+			auth:        token,
+			status:      http.StatusBadRequest,
 		},
 		{
-			desc:        "remove non-existent things",
-			data:        []string{wrongValue},
-			auth:        token,
+			desc:        "create share of non-existent thing",
+			req:         data,
+			id:          strconv.FormatUint(wrongID, 10),
 			contentType: contentType,
+			auth:        token,
 			status:      http.StatusNotFound,
 		},
 		{
-			desc:        "remove things with empty thing ids",
-			data:        []string{emptyValue},
-			auth:        token,
+			desc:        "create share with invalid user token",
+			req:         data,
+			id:          th.ID,
 			contentType: contentType,
-			status:      http.StatusBadRequest,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
 		},
 		{
-			desc:        "remove profiles without profile ids",
-			data:        []string{},
-			auth:        token,
+			desc:        "create share with empty user token",
+			req:         data,
+			id:          th.ID,
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "create share with invalid data format",
+			req:         "{",
+			id:          th.ID,
 			contentType: contentType,
+			auth:        token,
 			status:      http.StatusBadRequest,
 		},
+		{
+			desc:        "create share without content type",
+			req:         data,
+			id:          th.ID,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
 	}
 
 	for _, tc := range cases {
-		data := struct {
-			ThingIDs []string `json:"thing_ids"`
-		}{
-			tc.data,
-		}
-
-		body := toJSON(data)
-
 		req := testRequest{
 			client:      ts.Client(),
-			method:      http.MethodPatch,
-			url:         fmt.Sprintf("%s/things", ts.URL),
-			token:       tc.auth,
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/things/%s/shares", ts.URL, tc.id),
 			contentType: tc.contentType,
-			body:        strings.NewReader(body),
+			token:       tc.auth,
+			body:        strings.NewReader(tc.req),
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
@@ -1481,129 +1664,162 @@ func TestRemoveThings(t *testing.T) {
 	}
 }
 
-func TestCreateProfiles(t *testing.T) {
+func TestListSharesByThing(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
 	grs, err := svc.CreateGroups(context.Background(), token, group)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	gr := grs[0]
+	grID := grs[0].ID
 
-	data := `[{"name": "1"}, {"name": "2"}]`
-	invalidData := fmt.Sprintf(`[{"name": "%s"}]`, invalidName)
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	sh, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{Name: "dashboard", Password: "password"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
 	cases := []struct {
-		desc        string
-		data        string
-		contentType string
-		auth        string
-		status      int
-		response    string
+		desc   string
+		id     string
+		auth   string
+		status int
+		res    []shareRes
 	}{
 		{
-			desc:        "create valid profiles",
-			data:        data,
-			contentType: contentType,
-			auth:        token,
-			status:      http.StatusCreated,
-			response:    emptyValue,
+			desc:   "list shares of the thing",
+			id:     th.ID,
+			auth:   token,
+			status: http.StatusOK,
+			res:    []shareRes{{ID: sh.ID, ThingID: th.ID, Name: sh.Name, Key: sh.Key, Protected: true}},
 		},
 		{
-			desc:        "create profile with empty request",
-			data:        emptyValue,
-			contentType: contentType,
-			auth:        token,
-			status:      http.StatusBadRequest,
-			response:    emptyValue,
+			desc:   "list shares of non-existent thing",
+			id:     strconv.FormatUint(wrongID, 10),
+			auth:   token,
+			status: http.StatusNotFound,
 		},
 		{
-			desc:        "create profiles with empty JSON",
-			data:        "[]",
-			contentType: contentType,
-			auth:        token,
-			status:      http.StatusBadRequest,
-			response:    emptyValue,
-		},
-		{
-			desc:        "create profile with invalid auth token",
-			data:        data,
-			contentType: contentType,
-			auth:        wrongValue,
-			status:      http.StatusUnauthorized,
-			response:    emptyValue,
+			desc:   "list shares with invalid user token",
+			id:     th.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
 		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/things/%s/shares", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body sharesRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body.Shares, fmt.Sprintf("%s: expected shares %v got %v", tc.desc, tc.res, body.Shares))
+	}
+}
+
+func TestRemoveShare(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	sh, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc    string
+		shareID string
+		auth    string
+		status  int
+	}{
 		{
-			desc:        "create profile with empty auth token",
-			data:        data,
-			contentType: contentType,
-			auth:        emptyValue,
-			status:      http.StatusUnauthorized,
-			response:    emptyValue,
+			desc:    "remove share with invalid token",
+			shareID: sh.ID,
+			auth:    wrongValue,
+			status:  http.StatusUnauthorized,
 		},
 		{
-			desc:     "create profile with invalid request format",
-			data:     "}",
-			auth:     token,
-			status:   http.StatusUnsupportedMediaType,
-			response: emptyValue,
+			desc:    "remove share with empty token",
+			shareID: sh.ID,
+			auth:    emptyValue,
+			status:  http.StatusUnauthorized,
 		},
 		{
-			desc:        "create profile without content type",
-			data:        data,
-			contentType: emptyValue,
-			auth:        token,
-			status:      http.StatusUnsupportedMediaType,
-			response:    emptyValue,
+			desc:    "remove existing share",
+			shareID: sh.ID,
+			auth:    token,
+			status:  http.StatusNoContent,
 		},
 		{
-			desc:        "create profile with invalid name",
-			data:        invalidData,
-			contentType: contentType,
-			auth:        token,
-			status:      http.StatusBadRequest,
-			response:    emptyValue,
+			desc:    "remove non-existent share",
+			shareID: sh.ID,
+			auth:    token,
+			status:  http.StatusNotFound,
 		},
 	}
 
 	for _, tc := range cases {
 		req := testRequest{
-			client:      ts.Client(),
-			method:      http.MethodPost,
-			url:         fmt.Sprintf("%s/groups/%s/profiles", ts.URL, gr.ID),
-			contentType: tc.contentType,
-			token:       tc.auth,
-			body:        strings.NewReader(tc.data),
+			client: ts.Client(),
+			method: http.MethodDelete,
+			url:    fmt.Sprintf("%s/things/%s/shares/%s", ts.URL, th.ID, tc.shareID),
+			token:  tc.auth,
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
-
-		location := res.Header.Get("Location")
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
-		assert.Equal(t, tc.response, location, fmt.Sprintf("%s: expected response %s got %s", tc.desc, tc.response, location))
 	}
 }
 
-func TestUpdateProfile(t *testing.T) {
+func TestUpdateGateway(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
 	grs, err := svc.CreateGroups(context.Background(), token, group)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	gr := grs[0]
+	grID := grs[0].ID
 
-	profile.GroupID = gr.ID
+	profile.GroupID = grID
 	prs, err := svc.CreateProfiles(context.Background(), token, profile)
-	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
-	pr := prs[0]
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-	c := profile
-	c.Name = "updated_profile"
-	updateData := toJSON(c)
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gw, child := ths[0], ths[1]
 
-	c.Name = invalidName
-	invalidData := toJSON(c)
+	data := toJSON(map[string]interface{}{"children": []string{child.ID}})
+	selfData := toJSON(map[string]interface{}{"children": []string{gw.ID}})
+	emptyIDData := toJSON(map[string]interface{}{"children": []string{emptyValue}})
 
 	cases := []struct {
 		desc        string
@@ -1614,91 +1830,76 @@ func TestUpdateProfile(t *testing.T) {
 		status      int
 	}{
 		{
-			desc:        "update existing profile",
-			req:         updateData,
-			id:          pr.ID,
+			desc:        "update gateway",
+			req:         data,
+			id:          gw.ID,
 			contentType: contentType,
 			auth:        token,
 			status:      http.StatusOK,
 		},
 		{
-			desc:        "update non-existing profile",
-			req:         updateData,
-			id:          strconv.FormatUint(wrongID, 10),
+			desc:        "update gateway without children",
+			req:         "{}",
+			id:          gw.ID,
 			contentType: contentType,
 			auth:        token,
-			status:      http.StatusNotFound,
+			status:      http.StatusBadRequest,
 		},
 		{
-			desc:        "update profile with invalid id",
-			req:         updateData,
-			id:          wrongValue,
+			desc:        "update gateway with empty child id",
+			req:         emptyIDData,
+			id:          gw.ID,
 			contentType: contentType,
 			auth:        token,
-			status:      http.StatusNotFound,
-		},
-		{
-			desc:        "update profile with invalid token",
-			req:         updateData,
-			id:          pr.ID,
-			contentType: contentType,
-			auth:        wrongValue,
-			status:      http.StatusUnauthorized,
+			status:      http.StatusBadRequest,
 		},
 		{
-			desc:        "update profile with empty token",
-			req:         updateData,
-			id:          pr.ID,
+			desc:        "update gateway with itself as child",
+			req:         selfData,
+			id:          gw.ID,
 			contentType: contentType,
-			auth:        emptyValue,
-			status:      http.StatusUnauthorized,
+			auth:        token,
+			status:      http.StatusBadRequest,
 		},
 		{
-			desc:        "update profile with invalid data format",
-			req:         "}",
-			id:          pr.ID,
+			desc:        "update gateway of non-existent thing",
+			req:         data,
+			id:          strconv.FormatUint(wrongID, 10),
 			contentType: contentType,
 			auth:        token,
-			status:      http.StatusBadRequest,
+			status:      http.StatusNotFound,
 		},
 		{
-			desc:        "update profile with empty JSON object",
-			req:         "{}",
-			id:          pr.ID,
+			desc:        "update gateway with invalid user token",
+			req:         data,
+			id:          gw.ID,
 			contentType: contentType,
-			auth:        token,
-			status:      http.StatusBadRequest,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
 		},
 		{
-			desc:        "update profile with empty request",
-			req:         emptyValue,
-			id:          pr.ID,
+			desc:        "update gateway with invalid data format",
+			req:         "{",
+			id:          gw.ID,
 			contentType: contentType,
 			auth:        token,
 			status:      http.StatusBadRequest,
 		},
 		{
-			desc:        "update profile with missing content type",
-			req:         updateData,
-			id:          pr.ID,
+			desc:        "update gateway without content type",
+			req:         data,
+			id:          gw.ID,
 			contentType: emptyValue,
 			auth:        token,
 			status:      http.StatusUnsupportedMediaType,
 		},
-		{
-			desc:        "update profile with invalid name",
-			req:         invalidData,
-			contentType: contentType,
-			auth:        token,
-			status:      http.StatusBadRequest,
-		},
 	}
 
 	for _, tc := range cases {
 		req := testRequest{
 			client:      ts.Client(),
 			method:      http.MethodPut,
-			url:         fmt.Sprintf("%s/profiles/%s", ts.URL, tc.id),
+			url:         fmt.Sprintf("%s/things/%s/gateway", ts.URL, tc.id),
 			contentType: tc.contentType,
 			token:       tc.auth,
 			body:        strings.NewReader(tc.req),
@@ -1709,68 +1910,53 @@ func TestUpdateProfile(t *testing.T) {
 	}
 }
 
-func TestViewProfile(t *testing.T) {
+func TestViewGateway(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
 	grs, err := svc.CreateGroups(context.Background(), token, group)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	gr := grs[0]
+	grID := grs[0].ID
 
-	profile.GroupID = gr.ID
+	profile.GroupID = grID
 	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
-	pr := prs[0]
+	gw, child := ths[0], ths[1]
 
-	data := profileRes{
-		ID:       pr.ID,
-		Name:     pr.Name,
-		GroupID:  pr.GroupID,
-		Metadata: pr.Metadata,
-	}
+	err = svc.UpdateGateway(context.Background(), token, things.Gateway{ThingID: gw.ID, Children: []string{child.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
 	cases := []struct {
 		desc   string
 		id     string
 		auth   string
 		status int
-		res    profileRes
+		res    gatewayRes
 	}{
 		{
-			desc:   "view existing profile",
-			id:     pr.ID,
+			desc:   "view gateway",
+			id:     gw.ID,
 			auth:   token,
 			status: http.StatusOK,
-			res:    data,
+			res:    gatewayRes{ThingID: gw.ID, Children: []string{child.ID}},
 		},
 		{
-			desc:   "view non-existent profile",
-			id:     strconv.FormatUint(wrongID, 10),
+			desc:   "view gateway of thing which isn't gateway",
+			id:     child.ID,
 			auth:   token,
 			status: http.StatusNotFound,
-			res:    profileRes{},
 		},
 		{
-			desc:   "view profile with invalid token",
-			id:     pr.ID,
+			desc:   "view gateway with invalid user token",
+			id:     gw.ID,
 			auth:   wrongValue,
 			status: http.StatusUnauthorized,
-			res:    profileRes{},
-		},
-		{
-			desc:   "view profile with empty token",
-			id:     pr.ID,
-			auth:   emptyValue,
-			status: http.StatusUnauthorized,
-			res:    profileRes{},
-		},
-		{
-			desc:   "view profile with invalid id",
-			id:     wrongValue,
-			auth:   token,
-			status: http.StatusNotFound,
-			res:    profileRes{},
 		},
 	}
 
@@ -1778,302 +1964,180 @@ func TestViewProfile(t *testing.T) {
 		req := testRequest{
 			client: ts.Client(),
 			method: http.MethodGet,
-			url:    fmt.Sprintf("%s/profiles/%s", ts.URL, tc.id),
+			url:    fmt.Sprintf("%s/things/%s/gateway", ts.URL, tc.id),
 			token:  tc.auth,
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
-		var body profileRes
-		json.NewDecoder(res.Body).Decode(&body)
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
-		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+
+		var body gatewayRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected gateway %v got %v", tc.desc, tc.res, body))
 	}
 }
 
-func TestListProfiles(t *testing.T) {
+func TestRemoveGateway(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
 	grs, err := svc.CreateGroups(context.Background(), token, group)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	gr := grs[0]
+	grID := grs[0].ID
 
-	profiles := []profileRes{}
-	for i := 0; i < n; i++ {
-		name := "name_" + fmt.Sprintf("%03d", i+1)
-		id := fmt.Sprintf("%s%012d", prefix, i+1)
-		pr := things.Profile{ID: id, GroupID: gr.ID, Name: name, Metadata: metadata}
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-		prs, err := svc.CreateProfiles(context.Background(), token, pr)
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-		profile := prs[0]
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gw, child := ths[0], ths[1]
 
-		profiles = append(profiles, profileRes{
-			ID:       profile.ID,
-			Name:     profile.Name,
-			Metadata: profile.Metadata,
-			GroupID:  profile.GroupID,
-			Config:   profile.Config,
-		})
-	}
-	profileURL := fmt.Sprintf("%s/profiles", ts.URL)
+	err = svc.UpdateGateway(context.Background(), token, things.Gateway{ThingID: gw.ID, Children: []string{child.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
 	cases := []struct {
 		desc   string
 		auth   string
 		status int
-		url    string
-		res    []profileRes
 	}{
 		{
-			desc:   "get a list of profiles",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 6),
-			res:    profiles[0:6],
-		},
-		{
-			desc:   "get a list of all profiles without limit",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?limit=%d", profileURL, noLimit),
-			res:    []profileRes{},
-		},
-		{
-			desc:   "get a list of profiles with invalid token",
+			desc:   "remove gateway with invalid token",
 			auth:   wrongValue,
 			status: http.StatusUnauthorized,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 1),
-			res:    nil,
 		},
 		{
-			desc:   "get a list of profiles with empty token",
+			desc:   "remove gateway with empty token",
 			auth:   emptyValue,
 			status: http.StatusUnauthorized,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 1),
-			res:    nil,
 		},
 		{
-			desc:   "get a list of profiles with negative offset",
+			desc:   "remove gateway",
 			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, -1, 5),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles with negative limit",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 5, -2),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles with zero limit and offset 1",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 1, 0),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles without offset",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?limit=%d", profileURL, 5),
-			res:    profiles[0:5],
-		},
-		{
-			desc:   "get a list of profiles without limit",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?offset=%d", profileURL, 1),
-			res:    profiles[1:11],
-		},
-		{
-			desc:   "get a list of profiles with redundant query params",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d&value=something", profileURL, 0, 5),
-			res:    profiles[0:5],
-		},
-		{
-			desc:   "get a list of profiles with limit greater than max",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 110),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles with default URL",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s%s", profileURL, emptyValue),
-			res:    profiles[0:10],
-		},
-		{
-			desc:   "get a list of profiles with invalid number of params",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s%s", profileURL, "?offset=4&limit=4&limit=5&offset=5"),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles with invalid offset",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s%s", profileURL, "?offset=e&limit=5"),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles with invalid limit",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s%s", profileURL, "?offset=5&limit=e"),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles with invalid name",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d&name=%s", profileURL, 0, 10, invalidName),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles sorted by name ascendant",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, nameKey, ascKey),
-			res:    profiles[0:6],
-		},
-		{
-			desc:   "get a list of profiles sorted by name descendent",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, nameKey, descKey),
-			res:    profiles[0:6],
-		},
-		{
-			desc:   "get a list of profiles sorted with invalid order",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, "wrong", ascKey),
-			res:    nil,
-		},
-		{
-			desc:   "get a list of profiles sorted by name with invalid direction",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, nameKey, "wrong"),
-			res:    nil,
+			status: http.StatusNoContent,
 		},
 	}
 
 	for _, tc := range cases {
 		req := testRequest{
 			client: ts.Client(),
-			method: http.MethodGet,
-			url:    tc.url,
+			method: http.MethodDelete,
+			url:    fmt.Sprintf("%s/things/%s/gateway", ts.URL, gw.ID),
 			token:  tc.auth,
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
-		var body profilesPageRes
-		json.NewDecoder(res.Body).Decode(&body)
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
-		assert.ElementsMatch(t, tc.res, body.Profiles, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body.Profiles))
 	}
 }
 
-func TestViewProfileByThing(t *testing.T) {
+func TestUpdateThingACL(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
 	grs, err := svc.CreateGroups(context.Background(), token, group)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	gr := grs[0]
+	grID := grs[0].ID
 
-	profile.GroupID = gr.ID
+	profile.GroupID = grID
 	prs, err := svc.CreateProfiles(context.Background(), token, profile)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	pr := prs[0]
 
-	thing.GroupID = gr.ID
-	thing.ProfileID = pr.ID
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
 	ths, err := svc.CreateThings(context.Background(), token, thing)
-	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 	th := ths[0]
 
-	prRes := profileRes{
-		ID:       pr.ID,
-		Name:     pr.Name,
-		GroupID:  pr.GroupID,
-		Metadata: pr.Metadata,
-	}
-
-	profileURL := fmt.Sprintf("%s/things", ts.URL)
+	data := toJSON(networkACLRes{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.1.0.0/16"}})
+	invalidData := toJSON(networkACLRes{Allow: []string{"10.0.0.0"}})
 
 	cases := []struct {
-		desc   string
-		auth   string
-		status int
-		url    string
-		res    profileRes
+		desc        string
+		req         string
+		id          string
+		contentType string
+		auth        string
+		status      int
 	}{
 		{
-			desc:   "view profile by thing",
-			auth:   token,
-			status: http.StatusOK,
-			url:    fmt.Sprintf("%s/%s/profiles", profileURL, th.ID),
-			res:    prRes,
+			desc:        "update thing acl",
+			req:         data,
+			id:          th.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
 		},
 		{
-			desc:   "view profile by thing with invalid token",
-			auth:   wrongValue,
-			status: http.StatusUnauthorized,
-			url:    fmt.Sprintf("%s/%s/profiles", profileURL, th.ID),
-			res:    profileRes{},
+			desc:        "update thing acl with empty acl",
+			req:         "{}",
+			id:          th.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
 		},
 		{
-			desc:   "view profile by thing with empty token",
-			auth:   emptyValue,
-			status: http.StatusUnauthorized,
-			url:    fmt.Sprintf("%s/%s/profiles", profileURL, th.ID),
-			res:    profileRes{},
+			desc:        "update thing acl with invalid network",
+			req:         invalidData,
+			id:          th.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
 		},
 		{
-			desc:   "view profile by thing without thing id",
-			auth:   token,
-			status: http.StatusBadRequest,
-			url:    fmt.Sprintf("%s/%s/profiles", profileURL, emptyValue),
-			res:    profileRes{},
+			desc:        "update thing acl of non-existent thing",
+			req:         data,
+			id:          strconv.FormatUint(wrongID, 10),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
 		},
 		{
-			desc:   "view profile by thing with wrong thing id",
-			auth:   token,
-			status: http.StatusNotFound,
-			url:    fmt.Sprintf("%s/%s/profiles", profileURL, wrongValue),
-			res:    profileRes{},
+			desc:        "update thing acl with invalid user token",
+			req:         data,
+			id:          th.ID,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "update thing acl with invalid data format",
+			req:         "{",
+			id:          th.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update thing acl without content type",
+			req:         data,
+			id:          th.ID,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
 		},
 	}
 
 	for _, tc := range cases {
 		req := testRequest{
-			client: ts.Client(),
-			method: http.MethodGet,
-			url:    tc.url,
-			token:  tc.auth,
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/things/%s/acl", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.req),
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
-		var body profileRes
-		json.NewDecoder(res.Body).Decode(&body)
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
-		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
 	}
 }
 
-func TestRemoveProfile(t *testing.T) {
+func TestViewThingACL(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
@@ -2083,73 +2147,175 @@ func TestRemoveProfile(t *testing.T) {
 	grID := grs[0].ID
 
 	profile.GroupID = grID
-	prs, err := svc.CreateProfiles(context.Background(), token, profile, profile)
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	prID, prID1 := prs[0].ID, prs[1].ID
 
 	thing.GroupID = grID
-	thing.ProfileID = prID1
-	_, err = svc.CreateThings(context.Background(), token, thing)
-	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th, other := ths[0], ths[1]
+
+	err = svc.UpdateThingACL(context.Background(), token, th.ID, things.NetworkACL{Allow: []string{"10.1.2.3/8"}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
 	cases := []struct {
 		desc   string
 		id     string
 		auth   string
 		status int
+		res    networkACLRes
 	}{
 		{
-			desc:   "remove profile with invalid token",
-			id:     prID,
-			auth:   wrongValue,
-			status: http.StatusUnauthorized,
+			desc:   "view thing acl",
+			id:     th.ID,
+			auth:   token,
+			status: http.StatusOK,
+			res:    networkACLRes{Allow: []string{"10.0.0.0/8"}, Deny: []string{}},
 		},
 		{
-			desc:   "remove profile with empty token",
-			id:     prID,
-			auth:   emptyValue,
-			status: http.StatusUnauthorized,
+			desc:   "view thing acl of thing without acl",
+			id:     other.ID,
+			auth:   token,
+			status: http.StatusOK,
+			res:    networkACLRes{Allow: []string{}, Deny: []string{}},
 		},
 		{
-			desc:   "remove profile with invalid token",
-			id:     prID,
+			desc:   "view thing acl with invalid user token",
+			id:     th.ID,
 			auth:   wrongValue,
 			status: http.StatusUnauthorized,
 		},
-		{
-			desc:   "remove existing profile",
-			id:     prID,
-			auth:   token,
-			status: http.StatusNoContent,
-		},
-		{
-			desc:   "remove removed profile",
-			id:     prID,
-			auth:   token,
-			status: http.StatusNotFound,
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/things/%s/acl", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body networkACLRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected acl %v got %v", tc.desc, tc.res, body))
+	}
+}
+
+func TestUpdateOrgACL(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	data := toJSON(networkACLRes{Deny: []string{"192.168.0.0/16"}})
+
+	cases := []struct {
+		desc        string
+		req         string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "update org acl",
+			req:         data,
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusOK,
 		},
 		{
-			desc:   "remove assigned profile",
-			id:     prID1,
+			desc:        "update org acl as org editor",
+			req:         data,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "update org acl with invalid user token",
+			req:         data,
+			auth:        wrongValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "update org acl with invalid network",
+			req:         toJSON(networkACLRes{Deny: []string{"invalid"}}),
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update org acl without content type",
+			req:         data,
+			auth:        adminToken,
+			contentType: emptyValue,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/orgs/%s/acl", ts.URL, orgID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestViewOrgACL(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	err := svc.UpdateOrgACL(context.Background(), adminToken, orgID, things.NetworkACL{Deny: []string{"192.168.0.0/16"}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		res    networkACLRes
+	}{
+		{
+			desc:   "view org acl",
 			auth:   token,
-			status: http.StatusForbidden,
+			status: http.StatusOK,
+			res:    networkACLRes{Allow: []string{}, Deny: []string{"192.168.0.0/16"}},
+		},
+		{
+			desc:   "view org acl with invalid user token",
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
 		},
 	}
 
 	for _, tc := range cases {
 		req := testRequest{
 			client: ts.Client(),
-			method: http.MethodDelete,
-			url:    fmt.Sprintf("%s/profiles/%s", ts.URL, tc.id),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/orgs/%s/acl", ts.URL, orgID),
 			token:  tc.auth,
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body networkACLRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected acl %v got %v", tc.desc, tc.res, body))
 	}
 }
 
-func TestRemoveProfiles(t *testing.T) {
+func TestRemoveThings(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
@@ -2159,17 +2325,19 @@ func TestRemoveProfiles(t *testing.T) {
 	grID := grs[0].ID
 
 	profile.GroupID = grID
-	profile1.GroupID = grID
-	cList := []things.Profile{profile, profile, profile1}
-	prs, err := svc.CreateProfiles(context.Background(), token, cList...)
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	prID := prs[0].ID
 
 	thing.GroupID = grID
-	thing.ProfileID = prs[2].ID
-	_, err = svc.CreateThings(context.Background(), token, thing)
-	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	thing.ProfileID = prID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
 
-	profileIDs := []string{prs[0].ID, prs[1].ID}
+	var thingIDs []string
+	for _, th := range ths {
+		thingIDs = append(thingIDs, th.ID)
+	}
 
 	cases := []struct {
 		desc        string
@@ -2179,42 +2347,42 @@ func TestRemoveProfiles(t *testing.T) {
 		status      int
 	}{
 		{
-			desc:        "remove profiles with invalid token",
-			data:        profileIDs,
+			desc:        "remove things with invalid token",
+			data:        thingIDs,
 			auth:        wrongValue,
 			contentType: contentType,
 			status:      http.StatusUnauthorized,
 		},
 		{
-			desc:        "remove profiles with empty token",
-			data:        profileIDs,
+			desc:        "remove things with empty token",
+			data:        thingIDs,
 			auth:        emptyValue,
 			contentType: contentType,
 			status:      http.StatusUnauthorized,
 		},
 		{
-			desc:        "remove profiles with invalid content type",
-			data:        profileIDs,
+			desc:        "remove things with invalid content type",
+			data:        thingIDs,
 			auth:        token,
 			contentType: wrongValue,
 			status:      http.StatusUnsupportedMediaType,
 		},
 		{
-			desc:        "remove existing profiles",
-			data:        profileIDs,
+			desc:        "remove existing things",
+			data:        thingIDs,
 			auth:        token,
 			contentType: contentType,
 			status:      http.StatusNoContent,
 		},
 		{
-			desc:        "remove non-existent profiles",
-			data:        profileIDs,
+			desc:        "remove non-existent things",
+			data:        []string{wrongValue},
 			auth:        token,
 			contentType: contentType,
 			status:      http.StatusNotFound,
 		},
 		{
-			desc:        "remove profiles with empty profile ids",
+			desc:        "remove things with empty thing ids",
 			data:        []string{emptyValue},
 			auth:        token,
 			contentType: contentType,
@@ -2227,18 +2395,11 @@ func TestRemoveProfiles(t *testing.T) {
 			contentType: contentType,
 			status:      http.StatusBadRequest,
 		},
-		{
-			desc:        "remove assigned profiles",
-			data:        []string{prs[2].ID},
-			auth:        token,
-			contentType: contentType,
-			status:      http.StatusForbidden,
-		},
 	}
 
 	for _, tc := range cases {
 		data := struct {
-			ProfileIDs []string `json:"profile_ids"`
+			ThingIDs []string `json:"thing_ids"`
 		}{
 			tc.data,
 		}
@@ -2248,9 +2409,9 @@ func TestRemoveProfiles(t *testing.T) {
 		req := testRequest{
 			client:      ts.Client(),
 			method:      http.MethodPatch,
-			url:         fmt.Sprintf("%s/profiles", ts.URL),
-			contentType: tc.contentType,
+			url:         fmt.Sprintf("%s/things", ts.URL),
 			token:       tc.auth,
+			contentType: tc.contentType,
 			body:        strings.NewReader(body),
 		}
 		res, err := req.make()
@@ -2259,229 +2420,2069 @@ func TestRemoveProfiles(t *testing.T) {
 	}
 }
 
-func TestRemoveGroups(t *testing.T) {
+func TestCreateProfiles(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
-	var groups []things.Group
-	var groupIDs []string
-	for i := uint64(0); i < 10; i++ {
-		num := strconv.FormatUint(i, 10)
-		group := things.Group{
-			Name:        "test-group-" + num,
-			Description: "test group desc",
-		}
-		grs, err := svc.CreateGroups(context.Background(), token, group)
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-		gr := grs[0]
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
 
-		groups = append(groups, gr)
-		groupIDs = append(groupIDs, gr.ID)
-	}
+	data := `[{"name": "1"}, {"name": "2"}]`
+	invalidData := fmt.Sprintf(`[{"name": "%s"}]`, invalidName)
+	unitsData := `[{"name": "1", "config": {"transformer": {"units": {"degF": "Cel", "psi": "kPa"}}}}]`
+	invalidUnitsData := `[{"name": "1", "config": {"transformer": {"units": {"degF": "kPa"}}}}]`
+	encodingData := `[{"name": "1", "config": {"content_encoding": "zstd"}}]`
+	invalidEncodingData := `[{"name": "1", "config": {"content_encoding": "br"}}]`
+	mqttData := `[{"name": "1", "config": {"mqtt": {"keep_alive": 1200, "max_inflight": 1, "max_packet_size": 1024}}}]`
+	invalidMQTTData := `[{"name": "1", "config": {"mqtt": {"keep_alive": 65536}}}]`
+	unknownMQTTData := `[{"name": "1", "config": {"mqtt": {"session_expiry": 60}}}]`
+	schemaData := `[{"name": "1", "config": {"schema": {"type": "object", "required": ["temp"]}}}]`
+	invalidSchemaData := `[{"name": "1", "config": {"schema": {"type": 5}}}]`
+	remoteSchemaData := `[{"name": "1", "config": {"schema": {"$ref": "http://example.com/schema.json"}}}]`
+	transformerData := `[{"name": "1", "config": {"transformer": {"name": "cbor"}}}]`
+	unknownTransformerData := `[{"name": "1", "config": {"transformer": {"name": "unknown"}}}]`
 
 	cases := []struct {
 		desc        string
-		data        []string
-		auth        string
+		data        string
 		contentType string
+		auth        string
 		status      int
+		response    string
 	}{
 		{
-			desc:        "remove existing groups",
-			data:        groupIDs[:5],
+			desc:        "create valid profiles",
+			data:        data,
+			contentType: contentType,
 			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with unit conversions",
+			data:        unitsData,
 			contentType: contentType,
-			status:      http.StatusNoContent,
+			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
 		},
 		{
-			desc:        "remove non-existent groups",
-			data:        []string{wrongValue},
+			desc:        "create profile with invalid unit conversion",
+			data:        invalidUnitsData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with registered transformer",
+			data:        transformerData,
+			contentType: contentType,
 			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with unknown transformer",
+			data:        unknownTransformerData,
 			contentType: contentType,
-			status:      http.StatusNotFound,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
 		},
 		{
-			desc:        "remove groups with invalid token",
-			data:        groupIDs[len(groupIDs)-5:],
-			auth:        wrongValue,
+			desc:        "create profile with payload schema",
+			data:        schemaData,
 			contentType: contentType,
-			status:      http.StatusUnauthorized,
+			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
 		},
 		{
-			desc:        "remove groups without group ids",
-			data:        []string{},
-			auth:        wrongValue,
+			desc:        "create profile with invalid payload schema",
+			data:        invalidSchemaData,
 			contentType: contentType,
+			auth:        token,
 			status:      http.StatusBadRequest,
+			response:    emptyValue,
 		},
 		{
-			desc:        "remove groups with empty group ids",
-			data:        []string{emptyValue},
-			auth:        wrongValue,
+			desc:        "create profile with remote schema reference",
+			data:        remoteSchemaData,
 			contentType: contentType,
+			auth:        token,
 			status:      http.StatusBadRequest,
+			response:    emptyValue,
 		},
 		{
-			desc:        "remove groups with empty token",
-			data:        groupIDs,
-			auth:        emptyValue,
+			desc:        "create profile with content encoding",
+			data:        encodingData,
 			contentType: contentType,
-			status:      http.StatusUnauthorized,
+			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
 		},
 		{
-			desc:        "remove groups with invalid content type",
-			data:        groupIDs,
+			desc:        "create profile with invalid content encoding",
+			data:        invalidEncodingData,
+			contentType: contentType,
 			auth:        token,
-			contentType: wrongValue,
-			status:      http.StatusUnsupportedMediaType,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
 		},
-	}
-
-	for _, tc := range cases {
-		data := struct {
-			GroupIDs []string `json:"group_ids"`
-		}{
-			tc.data,
-		}
-
-		body := toJSON(data)
-
-		req := testRequest{
-			client:      ts.Client(),
-			method:      http.MethodPatch,
-			url:         fmt.Sprintf("%s/groups", ts.URL),
-			token:       tc.auth,
-			contentType: tc.contentType,
-			body:        strings.NewReader(body),
-		}
-		res, err := req.make()
+		{
+			desc:        "create profile with mqtt session parameters",
+			data:        mqttData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with mqtt session parameter out of range",
+			data:        invalidMQTTData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with unknown mqtt session parameter",
+			data:        unknownMQTTData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with empty request",
+			data:        emptyValue,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profiles with empty JSON",
+			data:        "[]",
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with invalid auth token",
+			data:        data,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with empty auth token",
+			data:        data,
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+			response:    emptyValue,
+		},
+		{
+			desc:     "create profile with invalid request format",
+			data:     "}",
+			auth:     token,
+			status:   http.StatusUnsupportedMediaType,
+			response: emptyValue,
+		},
+		{
+			desc:        "create profile without content type",
+			data:        data,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create profile with invalid name",
+			data:        invalidData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/profiles", ts.URL, gr.ID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+
+		location := res.Header.Get("Location")
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.response, location, fmt.Sprintf("%s: expected response %s got %s", tc.desc, tc.response, location))
+	}
+}
+
+func TestUpdateProfile(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	pr := prs[0]
+
+	c := profile
+	c.Name = "updated_profile"
+	updateData := toJSON(c)
+
+	c.Name = invalidName
+	invalidData := toJSON(c)
+
+	cases := []struct {
+		desc        string
+		req         string
+		id          string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "update existing profile",
+			req:         updateData,
+			id:          pr.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update non-existing profile",
+			req:         updateData,
+			id:          strconv.FormatUint(wrongID, 10),
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "update profile with invalid id",
+			req:         updateData,
+			id:          wrongValue,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "update profile with invalid token",
+			req:         updateData,
+			id:          pr.ID,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "update profile with empty token",
+			req:         updateData,
+			id:          pr.ID,
+			contentType: contentType,
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "update profile with invalid data format",
+			req:         "}",
+			id:          pr.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update profile with empty JSON object",
+			req:         "{}",
+			id:          pr.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update profile with empty request",
+			req:         emptyValue,
+			id:          pr.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update profile with missing content type",
+			req:         updateData,
+			id:          pr.ID,
+			contentType: emptyValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "update profile with invalid name",
+			req:         invalidData,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/profiles/%s", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestViewProfile(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	pr := prs[0]
+
+	data := profileRes{
+		ID:       pr.ID,
+		Name:     pr.Name,
+		GroupID:  pr.GroupID,
+		Metadata: pr.Metadata,
+	}
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+		res    profileRes
+	}{
+		{
+			desc:   "view existing profile",
+			id:     pr.ID,
+			auth:   token,
+			status: http.StatusOK,
+			res:    data,
+		},
+		{
+			desc:   "view non-existent profile",
+			id:     strconv.FormatUint(wrongID, 10),
+			auth:   token,
+			status: http.StatusNotFound,
+			res:    profileRes{},
+		},
+		{
+			desc:   "view profile with invalid token",
+			id:     pr.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			res:    profileRes{},
+		},
+		{
+			desc:   "view profile with empty token",
+			id:     pr.ID,
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			res:    profileRes{},
+		},
+		{
+			desc:   "view profile with invalid id",
+			id:     wrongValue,
+			auth:   token,
+			status: http.StatusNotFound,
+			res:    profileRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/profiles/%s", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		var body profileRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+	}
+}
+
+func TestListProfiles(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profiles := []profileRes{}
+	for i := 0; i < n; i++ {
+		name := "name_" + fmt.Sprintf("%03d", i+1)
+		id := fmt.Sprintf("%s%012d", prefix, i+1)
+		pr := things.Profile{ID: id, GroupID: gr.ID, Name: name, Metadata: metadata}
+
+		prs, err := svc.CreateProfiles(context.Background(), token, pr)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		profile := prs[0]
+
+		profiles = append(profiles, profileRes{
+			ID:       profile.ID,
+			Name:     profile.Name,
+			Metadata: profile.Metadata,
+			GroupID:  profile.GroupID,
+			Config:   profile.Config,
+		})
+	}
+	profileURL := fmt.Sprintf("%s/profiles", ts.URL)
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		url    string
+		res    []profileRes
+	}{
+		{
+			desc:   "get a list of profiles",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 6),
+			res:    profiles[0:6],
+		},
+		{
+			desc:   "get a list of all profiles without limit",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?limit=%d", profileURL, noLimit),
+			res:    []profileRes{},
+		},
+		{
+			desc:   "get a list of profiles with invalid token",
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 1),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 1),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with negative offset",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, -1, 5),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with negative limit",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 5, -2),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with zero limit and offset 1",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 1, 0),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles without offset",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?limit=%d", profileURL, 5),
+			res:    profiles[0:5],
+		},
+		{
+			desc:   "get a list of profiles without limit",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?offset=%d", profileURL, 1),
+			res:    profiles[1:11],
+		},
+		{
+			desc:   "get a list of profiles with redundant query params",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d&value=something", profileURL, 0, 5),
+			res:    profiles[0:5],
+		},
+		{
+			desc:   "get a list of profiles with limit greater than max",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d", profileURL, 0, 110),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with default URL",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s%s", profileURL, emptyValue),
+			res:    profiles[0:10],
+		},
+		{
+			desc:   "get a list of profiles with invalid number of params",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s%s", profileURL, "?offset=4&limit=4&limit=5&offset=5"),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with invalid offset",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s%s", profileURL, "?offset=e&limit=5"),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with invalid limit",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s%s", profileURL, "?offset=5&limit=e"),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles with invalid name",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d&name=%s", profileURL, 0, 10, invalidName),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles sorted by name ascendant",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, nameKey, ascKey),
+			res:    profiles[0:6],
+		},
+		{
+			desc:   "get a list of profiles sorted by name descendent",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, nameKey, descKey),
+			res:    profiles[0:6],
+		},
+		{
+			desc:   "get a list of profiles sorted with invalid order",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, "wrong", ascKey),
+			res:    nil,
+		},
+		{
+			desc:   "get a list of profiles sorted by name with invalid direction",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?offset=%d&limit=%d&order=%s&dir=%s", profileURL, 0, 6, nameKey, "wrong"),
+			res:    nil,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		var body profilesPageRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.ElementsMatch(t, tc.res, body.Profiles, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body.Profiles))
+	}
+}
+
+func TestViewProfileByThing(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pr := prs[0]
+
+	thing.GroupID = gr.ID
+	thing.ProfileID = pr.ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	th := ths[0]
+
+	prRes := profileRes{
+		ID:       pr.ID,
+		Name:     pr.Name,
+		GroupID:  pr.GroupID,
+		Metadata: pr.Metadata,
+	}
+
+	profileURL := fmt.Sprintf("%s/things", ts.URL)
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		url    string
+		res    profileRes
+	}{
+		{
+			desc:   "view profile by thing",
+			auth:   token,
+			status: http.StatusOK,
+			url:    fmt.Sprintf("%s/%s/profiles", profileURL, th.ID),
+			res:    prRes,
+		},
+		{
+			desc:   "view profile by thing with invalid token",
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			url:    fmt.Sprintf("%s/%s/profiles", profileURL, th.ID),
+			res:    profileRes{},
+		},
+		{
+			desc:   "view profile by thing with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			url:    fmt.Sprintf("%s/%s/profiles", profileURL, th.ID),
+			res:    profileRes{},
+		},
+		{
+			desc:   "view profile by thing without thing id",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s/%s/profiles", profileURL, emptyValue),
+			res:    profileRes{},
+		},
+		{
+			desc:   "view profile by thing with wrong thing id",
+			auth:   token,
+			status: http.StatusNotFound,
+			url:    fmt.Sprintf("%s/%s/profiles", profileURL, wrongValue),
+			res:    profileRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		var body profileRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+	}
+}
+
+func TestRemoveProfile(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	prID, prID1 := prs[0].ID, prs[1].ID
+
+	thing.GroupID = grID
+	thing.ProfileID = prID1
+	_, err = svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "remove profile with invalid token",
+			id:     prID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove profile with empty token",
+			id:     prID,
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove profile with invalid token",
+			id:     prID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:   "remove existing profile",
+			id:     prID,
+			auth:   token,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "remove removed profile",
+			id:     prID,
+			auth:   token,
+			status: http.StatusNotFound,
+		},
+		{
+			desc:   "remove assigned profile",
+			id:     prID1,
+			auth:   token,
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodDelete,
+			url:    fmt.Sprintf("%s/profiles/%s", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestRemoveProfiles(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	profile1.GroupID = grID
+	cList := []things.Profile{profile, profile, profile1}
+	prs, err := svc.CreateProfiles(context.Background(), token, cList...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[2].ID
+	_, err = svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	profileIDs := []string{prs[0].ID, prs[1].ID}
+
+	cases := []struct {
+		desc        string
+		data        []string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "remove profiles with invalid token",
+			data:        profileIDs,
+			auth:        wrongValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "remove profiles with empty token",
+			data:        profileIDs,
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "remove profiles with invalid content type",
+			data:        profileIDs,
+			auth:        token,
+			contentType: wrongValue,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "remove existing profiles",
+			data:        profileIDs,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "remove non-existent profiles",
+			data:        profileIDs,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "remove profiles with empty profile ids",
+			data:        []string{emptyValue},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "remove profiles without profile ids",
+			data:        []string{},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "remove assigned profiles",
+			data:        []string{prs[2].ID},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		data := struct {
+			ProfileIDs []string `json:"profile_ids"`
+		}{
+			tc.data,
+		}
+
+		body := toJSON(data)
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/profiles", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestRemoveGroups(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	var groups []things.Group
+	var groupIDs []string
+	for i := uint64(0); i < 10; i++ {
+		num := strconv.FormatUint(i, 10)
+		group := things.Group{
+			Name:        "test-group-" + num,
+			Description: "test group desc",
+		}
+		grs, err := svc.CreateGroups(context.Background(), token, group)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		gr := grs[0]
+
+		groups = append(groups, gr)
+		groupIDs = append(groupIDs, gr.ID)
+	}
+
+	cases := []struct {
+		desc        string
+		data        []string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "remove existing groups",
+			data:        groupIDs[:5],
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "remove non-existent groups",
+			data:        []string{wrongValue},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "remove groups with invalid token",
+			data:        groupIDs[len(groupIDs)-5:],
+			auth:        wrongValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "remove groups without group ids",
+			data:        []string{},
+			auth:        wrongValue,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "remove groups with empty group ids",
+			data:        []string{emptyValue},
+			auth:        wrongValue,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "remove groups with empty token",
+			data:        groupIDs,
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "remove groups with invalid content type",
+			data:        groupIDs,
+			auth:        token,
+			contentType: wrongValue,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		data := struct {
+			GroupIDs []string `json:"group_ids"`
+		}{
+			tc.data,
+		}
+
+		body := toJSON(data)
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/groups", ts.URL),
+			token:       tc.auth,
+			contentType: tc.contentType,
+			body:        strings.NewReader(body),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestTransferGroup(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+	newOrgID := "474106f7-030e-4881-8ab0-151195c29f93"
+
+	data := toJSON(map[string]string{"org_id": newOrgID})
+
+	cases := []struct {
+		desc        string
+		req         string
+		id          string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "transfer group",
+			req:         data,
+			id:          gr.ID,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "transfer non-existent group",
+			req:         data,
+			id:          wrongValue,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "transfer group with invalid token",
+			req:         data,
+			id:          gr.ID,
+			auth:        wrongValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "transfer group with empty token",
+			req:         data,
+			id:          gr.ID,
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "transfer group without org id",
+			req:         "{}",
+			id:          gr.ID,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "transfer group with invalid request format",
+			req:         "}",
+			id:          gr.ID,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "transfer group with invalid content type",
+			req:         data,
+			id:          gr.ID,
+			auth:        token,
+			contentType: wrongValue,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/groups/%s/transfer", ts.URL, tc.id),
+			token:       tc.auth,
+			contentType: tc.contentType,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestBackup(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	var groups []things.Group
+	for i := uint64(0); i < 10; i++ {
+		num := strconv.FormatUint(i, 10)
+		group := things.Group{
+			OrgID:       orgID,
+			Name:        "test-group-" + num,
+			Description: "test group desc",
+		}
+		grs, err := svc.CreateGroups(context.Background(), token, group)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		gr := grs[0]
+
+		groups = append(groups, gr)
+	}
+	gr := groups[0]
+
+	profiles := []things.Profile{}
+	for i := 0; i < 10; i++ {
+		name := "name_" + fmt.Sprintf("%03d", i+1)
+		prs, err := svc.CreateProfiles(context.Background(), token,
+			things.Profile{
+				Name:     name,
+				GroupID:  gr.ID,
+				Metadata: metadata,
+			})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		pr := prs[0]
+
+		profiles = append(profiles, pr)
+	}
+	pr := profiles[0]
+
+	ths := []things.Thing{}
+	for i := 0; i < 10; i++ {
+		name := "name_" + fmt.Sprintf("%03d", i+1)
+		things, err := svc.CreateThings(context.Background(), token,
+			things.Thing{
+				Name:      name,
+				GroupID:   gr.ID,
+				ProfileID: pr.ID,
+				Metadata:  metadata,
+			})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		th := things[0]
+
+		ths = append(ths, th)
+	}
+
+	var thingsRes []backupThingRes
+	for _, th := range ths {
+		thingsRes = append(thingsRes, backupThingRes{
+			ID:        th.ID,
+			GroupID:   th.GroupID,
+			ProfileID: th.ProfileID,
+			Name:      th.Name,
+			Key:       th.Key,
+			Metadata:  th.Metadata,
+		})
+	}
+
+	var profilesRes []backupProfileRes
+	for _, pr := range profiles {
+		profilesRes = append(profilesRes, backupProfileRes{
+			ID:       pr.ID,
+			GroupID:  pr.GroupID,
+			Name:     pr.Name,
+			Metadata: pr.Metadata,
+		})
+	}
+
+	var groupsRes []viewGroupRes
+	for _, gr := range groups {
+		groupsRes = append(groupsRes, viewGroupRes{
+			ID:          gr.ID,
+			OrgID:       gr.OrgID,
+			Name:        gr.Name,
+			Description: gr.Description,
+			Metadata:    gr.Metadata,
+		})
+	}
+
+	backup := backupRes{
+		Groups:   groupsRes,
+		Things:   thingsRes,
+		Profiles: profilesRes,
+	}
+
+	backupURL := fmt.Sprintf("%s/backup", ts.URL)
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		url    string
+		res    backupRes
+	}{
+		{
+			desc:   "backup all things, profiles and groups",
+			auth:   adminToken,
+			status: http.StatusOK,
+			url:    backupURL,
+			res:    backup,
+		},
+		{
+			desc:   "backup with invalid token",
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			url:    backupURL,
+			res:    backupRes{},
+		},
+		{
+			desc:   "backup with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			url:    backupURL,
+			res:    backupRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		var body backupRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.ElementsMatch(t, tc.res.Profiles, body.Profiles, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Profiles, body.Profiles))
+		assert.ElementsMatch(t, tc.res.Things, body.Things, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Things, body.Things))
+		assert.ElementsMatch(t, tc.res.Groups, body.Groups, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Groups, body.Groups))
+	}
+}
+
+func TestListGroupAccess(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	ga := groupAccessRes{
+		OrgID:     orgID,
+		GroupID:   gr.ID,
+		GroupName: gr.Name,
+		MemberID:  user.ID,
+		Email:     user.Email,
+		Role:      things.Owner,
+	}
+	csvRes := fmt.Sprintf("org_id,group_id,group_name,member_id,email,role\n%s,%s,%s,%s,%s,%s\n", orgID, gr.ID, gr.Name, user.ID, user.Email, things.Owner)
+
+	accessURL := fmt.Sprintf("%s/access-review", ts.URL)
+
+	cases := []struct {
+		desc        string
+		auth        string
+		status      int
+		url         string
+		contentType string
+		res         string
+	}{
+		{
+			desc:        "list group access",
+			auth:        adminToken,
+			status:      http.StatusOK,
+			url:         accessURL,
+			contentType: contentType,
+			res:         toJSON(groupAccessPageRes{GroupAccess: []groupAccessRes{ga}}) + "\n",
+		},
+		{
+			desc:        "list group access as csv",
+			auth:        adminToken,
+			status:      http.StatusOK,
+			url:         fmt.Sprintf("%s?format=csv", accessURL),
+			contentType: "text/csv",
+			res:         csvRes,
+		},
+		{
+			desc:   "list group access with invalid format",
+			auth:   adminToken,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?format=xml", accessURL),
+		},
+		{
+			desc:   "list group access as non-admin user",
+			auth:   token,
+			status: http.StatusForbidden,
+			url:    accessURL,
+		},
+		{
+			desc:   "list group access with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			url:    accessURL,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+		assert.Equal(t, tc.contentType, res.Header.Get("Content-Type"), fmt.Sprintf("%s: expected content type %s got %s", tc.desc, tc.contentType, res.Header.Get("Content-Type")))
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, string(body), fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, body))
+	}
+}
+
+func TestRebuildCache(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	pr := profile
+	pr.GroupID = grs[0].ID
+	prs, err := svc.CreateProfiles(context.Background(), token, pr)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	th := thing
+	th.GroupID = grs[0].ID
+	th.ProfileID = prs[0].ID
+	_, err = svc.CreateThings(context.Background(), token, th)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	rebuildURL := fmt.Sprintf("%s/cache/rebuild", ts.URL)
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		res    string
+	}{
+		{
+			desc:   "rebuild cache",
+			auth:   adminToken,
+			status: http.StatusOK,
+			res:    toJSON(rebuildCacheRes{Things: 1, Profiles: 1, Groups: 1, Roles: 1}) + "\n",
+		},
+		{
+			desc:   "rebuild cache as non-admin user",
+			auth:   token,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "rebuild cache with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodPost,
+			url:    rebuildURL,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, string(body), fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, body))
+	}
+}
+
+func TestListConsumerGroups(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	consumersURL := fmt.Sprintf("%s/consumer-groups", ts.URL)
+	cgs := consumerGroupsRes{
+		ConsumerGroups: []consumerGroupRes{
+			{
+				Stream:          consumerGroup.Stream,
+				Name:            consumerGroup.Name,
+				Consumers:       consumerGroup.Consumers,
+				LastDeliveredID: consumerGroup.LastDeliveredID,
+				StreamLength:    consumerGroup.StreamLength,
+				LastEntryID:     consumerGroup.LastEntryID,
+			},
+		},
+	}
+
+	cases := []struct {
+		desc   string
+		auth   string
+		status int
+		res    string
+	}{
+		{
+			desc:   "list consumer groups",
+			auth:   adminToken,
+			status: http.StatusOK,
+			res:    toJSON(cgs) + "\n",
+		},
+		{
+			desc:   "list consumer groups as non-admin user",
+			auth:   token,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "list consumer groups with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    consumersURL,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusOK {
+			continue
+		}
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.res, string(body), fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, body))
+	}
+}
+
+func TestResetConsumerGroup(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	consumersURL := fmt.Sprintf("%s/consumer-groups", ts.URL)
+
+	cases := []struct {
+		desc        string
+		auth        string
+		contentType string
+		req         string
+		status      int
+	}{
+		{
+			desc:        "reset consumer group to stream start",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "0"}),
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "reset consumer group to last entry",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "$"}),
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "reset consumer group to entry",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "1700000000000-1"}),
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "reset consumer group with invalid offset",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "latest"}),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "reset consumer group without stream",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Offset: "0"}),
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "reset consumer group of unknown stream",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: wrongValue, Offset: "0"}),
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "reset consumer group with malformed request",
+			auth:        adminToken,
+			contentType: contentType,
+			req:         "{",
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "reset consumer group without content type",
+			auth:        adminToken,
+			contentType: "",
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "0"}),
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "reset consumer group as non-admin user",
+			auth:        token,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "0"}),
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "reset consumer group with empty token",
+			auth:        emptyValue,
+			contentType: contentType,
+			req:         toJSON(resetConsumerGroupReq{Stream: consumerGroup.Stream, Offset: "0"}),
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         consumersURL,
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestRestore(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+	idProvider := uuid.New()
+
+	thId, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	thKey, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	testThing := things.Thing{
+		ID:       thId,
+		Name:     nameKey,
+		Key:      thKey,
+		Metadata: metadata,
+	}
+
+	var groups []things.Group
+	for i := uint64(0); i < 10; i++ {
+		num := strconv.FormatUint(i, 10)
+		gr := things.Group{
+			ID:          fmt.Sprintf("%s%012d", prefix, i+1),
+			Name:        "test-group-" + num,
+			Description: "test group desc",
+		}
+
+		groups = append(groups, gr)
+	}
+
+	profiles := []things.Profile{}
+	for i := 0; i < n; i++ {
+		prID, err := idProvider.ID()
+		require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+		name := "name_" + fmt.Sprintf("%03d", i+1)
+		profiles = append(profiles, things.Profile{
+			ID:       prID,
+			GroupID:  emptyValue,
+			Name:     name,
+			Metadata: metadata,
+		})
+	}
+
+	thr := []restoreThingReq{
+		{
+			ID:       testThing.ID,
+			Name:     testThing.Name,
+			Key:      testThing.Key,
+			Metadata: testThing.Metadata,
+		},
+	}
+
+	var prr []restoreProfileReq
+	for _, pr := range profiles {
+		prr = append(prr, restoreProfileReq{
+			ID:       pr.ID,
+			Name:     pr.Name,
+			Metadata: pr.Metadata,
+		})
+	}
+
+	var gr []restoreGroupReq
+	for _, group := range groups {
+		gr = append(gr, restoreGroupReq{
+			ID:          group.ID,
+			Name:        group.Name,
+			Description: group.Description,
+			Metadata:    group.Metadata,
+		})
+	}
+
+	resReq := restoreReq{
+		Things:   thr,
+		Profiles: prr,
+		Groups:   gr,
+	}
+
+	data := toJSON(resReq)
+	invalidData := toJSON(restoreReq{})
+	restoreURL := fmt.Sprintf("%s/restore", ts.URL)
+
+	cases := []struct {
+		desc        string
+		auth        string
+		status      int
+		url         string
+		req         string
+		contentType string
+	}{
+		{
+			desc:        "restore all things, profiles and groups",
+			auth:        adminToken,
+			status:      http.StatusCreated,
+			url:         restoreURL,
+			req:         data,
+			contentType: contentType,
+		},
+		{
+			desc:        "restore with invalid token",
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+			url:         restoreURL,
+			req:         data,
+			contentType: contentType,
+		},
+		{
+			desc:        "restore with empty token",
+			auth:        emptyValue,
+			status:      http.StatusUnauthorized,
+			url:         restoreURL,
+			req:         data,
+			contentType: contentType,
+		},
+		{
+			desc:        "restore with invalid request",
+			auth:        token,
+			status:      http.StatusBadRequest,
+			url:         restoreURL,
+			req:         invalidData,
+			contentType: contentType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         tc.url,
+			token:       tc.auth,
+			contentType: tc.contentType,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	prID := prs[0].ID
+
+	thing.GroupID = grID
+	thing.ProfileID = prID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("failed to create thing: %s", err))
+	th := ths[0]
+
+	ir := identifyReq{Token: th.Key}
+	data := toJSON(ir)
+
+	nonexistentData := toJSON(identifyReq{Token: wrongValue})
+
+	cases := map[string]struct {
+		contentType string
+		req         string
+		status      int
+	}{
+		"identify existing thing": {
+			contentType: contentType,
+			req:         data,
+			status:      http.StatusOK,
+		},
+		"identify non-existent thing": {
+			contentType: contentType,
+			req:         nonexistentData,
+			status:      http.StatusNotFound,
+		},
+		"identify with missing content type": {
+			contentType: wrongValue,
+			req:         data,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		"identify with empty JSON request": {
+			contentType: contentType,
+			req:         "{}",
+			status:      http.StatusUnauthorized,
+		},
+		"identify with invalid JSON request": {
+			contentType: contentType,
+			req:         emptyValue,
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for desc, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/identify", ts.URL),
+			contentType: tc.contentType,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", desc, tc.status, res.StatusCode))
+	}
+}
+
+type identifyReq struct {
+	Token string `json:"token"`
+}
+
+type viewMetadataRes struct {
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type thingRes struct {
+	ID        string                 `json:"id"`
+	GroupID   string                 `json:"group_id,omitempty"`
+	ProfileID string                 `json:"profile_id,omitempty"`
+	Name      string                 `json:"name,omitempty"`
+	Key       string                 `json:"key"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type profileRes struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name,omitempty"`
+	GroupID  string                 `json:"group_id,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+}
+
+type thingsPageRes struct {
+	Things []thingRes `json:"things"`
+	Total  uint64     `json:"total"`
+	Offset uint64     `json:"offset"`
+	Limit  uint64     `json:"limit"`
+}
+
+type profilesPageRes struct {
+	Profiles []profileRes `json:"profiles"`
+	Total    uint64       `json:"total"`
+	Offset   uint64       `json:"offset"`
+	Limit    uint64       `json:"limit"`
+}
+
+type backupThingRes struct {
+	ID        string                 `json:"id"`
+	GroupID   string                 `json:"group_id"`
+	ProfileID string                 `json:"profile_id"`
+	Name      string                 `json:"name,omitempty"`
+	Key       string                 `json:"key"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type backupProfileRes struct {
+	ID       string                 `json:"id"`
+	GroupID  string                 `json:"group_id"`
+	Name     string                 `json:"name,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type viewGroupRes struct {
+	ID          string                 `json:"id"`
+	OrgID       string                 `json:"org_id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type backupRes struct {
+	Things   []backupThingRes   `json:"things"`
+	Profiles []backupProfileRes `json:"profiles"`
+	Groups   []viewGroupRes     `json:"groups"`
+}
+
+type groupAccessRes struct {
+	OrgID     string `json:"org_id"`
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+	MemberID  string `json:"member_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+}
+
+type groupAccessPageRes struct {
+	GroupAccess []groupAccessRes `json:"group_access"`
+}
+
+type rebuildCacheRes struct {
+	Things   uint64 `json:"things"`
+	Profiles uint64 `json:"profiles"`
+	Groups   uint64 `json:"groups"`
+	Roles    uint64 `json:"roles"`
+}
+
+type consumerGroupRes struct {
+	Stream          string `json:"stream"`
+	Name            string `json:"name"`
+	Consumers       int64  `json:"consumers"`
+	Pending         int64  `json:"pending"`
+	LastDeliveredID string `json:"last_delivered_id"`
+	StreamLength    int64  `json:"stream_length"`
+	LastEntryID     string `json:"last_entry_id"`
+}
+
+type consumerGroupsRes struct {
+	ConsumerGroups []consumerGroupRes `json:"consumer_groups"`
+}
+
+type resetConsumerGroupReq struct {
+	Stream string `json:"stream,omitempty"`
+	Offset string `json:"offset,omitempty"`
+}
+
+type restoreThingReq struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Key      string                 `json:"key"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+type restoreProfileReq struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+type restoreGroupReq struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+type restoreReq struct {
+	Things   []restoreThingReq   `json:"things"`
+	Profiles []restoreProfileReq `json:"profiles"`
+	Groups   []restoreGroupReq   `json:"groups"`
+}
+
+type profileTemplateReq struct {
+	Name     string                 `json:"name"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type groupTemplateReq struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Profiles    []profileTemplateReq   `json:"profiles,omitempty"`
+}
+
+type updateOrgTemplateReq struct {
+	Groups []groupTemplateReq `json:"groups"`
+}
+
+type profileTemplateRes struct {
+	Name     string                 `json:"name"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type groupTemplateRes struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Profiles    []profileTemplateRes   `json:"profiles"`
+}
+
+type orgTemplateRes struct {
+	Groups    []groupTemplateRes `json:"groups"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+func TestUpdateOrgTemplate(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+
+	data := toJSON(updateOrgTemplateReq{
+		Groups: []groupTemplateReq{{Name: "default", Profiles: []profileTemplateReq{{Name: "default"}}}},
+	})
+	noGroups := toJSON(updateOrgTemplateReq{Groups: []groupTemplateReq{}})
+	noName := toJSON(updateOrgTemplateReq{Groups: []groupTemplateReq{{Profiles: []profileTemplateReq{{Name: "default"}}}}})
+	noProfileName := toJSON(updateOrgTemplateReq{Groups: []groupTemplateReq{{Name: "default", Profiles: []profileTemplateReq{{}}}}})
+
+	cases := []struct {
+		desc        string
+		req         string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "update org template",
+			req:         data,
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update org template as user",
+			req:         data,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "update org template with empty token",
+			req:         data,
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "update org template without groups",
+			req:         noGroups,
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update org template with group without name",
+			req:         noName,
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update org template with profile without name",
+			req:         noProfileName,
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update org template with invalid request format",
+			req:         "{",
+			auth:        adminToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update org template without content type",
+			req:         data,
+			auth:        adminToken,
+			contentType: "",
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/org-template", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
 	}
 }
 
-func TestBackup(t *testing.T) {
+func TestViewOrgTemplate(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
 
-	var groups []things.Group
-	for i := uint64(0); i < 10; i++ {
-		num := strconv.FormatUint(i, 10)
-		group := things.Group{
-			OrgID:       orgID,
-			Name:        "test-group-" + num,
-			Description: "test group desc",
-		}
-		grs, err := svc.CreateGroups(context.Background(), token, group)
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-		gr := grs[0]
-
-		groups = append(groups, gr)
-	}
-	gr := groups[0]
-
-	profiles := []things.Profile{}
-	for i := 0; i < 10; i++ {
-		name := "name_" + fmt.Sprintf("%03d", i+1)
-		prs, err := svc.CreateProfiles(context.Background(), token,
-			things.Profile{
-				Name:     name,
-				GroupID:  gr.ID,
+	ot := things.OrgTemplate{
+		Groups: []things.GroupTemplate{
+			{
+				Name:     "default",
 				Metadata: metadata,
-			})
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-		pr := prs[0]
-
-		profiles = append(profiles, pr)
-	}
-	pr := profiles[0]
-
-	ths := []things.Thing{}
-	for i := 0; i < 10; i++ {
-		name := "name_" + fmt.Sprintf("%03d", i+1)
-		things, err := svc.CreateThings(context.Background(), token,
-			things.Thing{
-				Name:      name,
-				GroupID:   gr.ID,
-				ProfileID: pr.ID,
-				Metadata:  metadata,
-			})
-		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-		th := things[0]
-
-		ths = append(ths, th)
-	}
-
-	var thingsRes []backupThingRes
-	for _, th := range ths {
-		thingsRes = append(thingsRes, backupThingRes{
-			ID:        th.ID,
-			GroupID:   th.GroupID,
-			ProfileID: th.ProfileID,
-			Name:      th.Name,
-			Key:       th.Key,
-			Metadata:  th.Metadata,
-		})
-	}
-
-	var profilesRes []backupProfileRes
-	for _, pr := range profiles {
-		profilesRes = append(profilesRes, backupProfileRes{
-			ID:       pr.ID,
-			GroupID:  pr.GroupID,
-			Name:     pr.Name,
-			Metadata: pr.Metadata,
-		})
+				Profiles: []things.ProfileTemplate{{Name: "default", Config: map[string]interface{}{"content_type": "application/json"}}},
+			},
+		},
 	}
+	err := svc.UpdateOrgTemplate(context.Background(), adminToken, ot)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-	var groupsRes []viewGroupRes
-	for _, gr := range groups {
-		groupsRes = append(groupsRes, viewGroupRes{
-			ID:          gr.ID,
-			OrgID:       gr.OrgID,
-			Name:        gr.Name,
-			Description: gr.Description,
-			Metadata:    gr.Metadata,
-		})
-	}
+	saved, err := svc.ViewOrgTemplate(context.Background(), adminToken)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-	backup := backupRes{
-		Groups:   groupsRes,
-		Things:   thingsRes,
-		Profiles: profilesRes,
+	data := orgTemplateRes{
+		Groups: []groupTemplateRes{
+			{
+				Name:     "default",
+				Metadata: metadata,
+				Profiles: []profileTemplateRes{{Name: "default", Config: map[string]interface{}{"content_type": "application/json"}}},
+			},
+		},
+		UpdatedAt: saved.UpdatedAt,
 	}
 
-	backupURL := fmt.Sprintf("%s/backup", ts.URL)
-
 	cases := []struct {
 		desc   string
 		auth   string
 		status int
-		url    string
-		res    backupRes
+		res    orgTemplateRes
 	}{
 		{
-			desc:   "backup all things, profiles and groups",
+			desc:   "view org template",
 			auth:   adminToken,
 			status: http.StatusOK,
-			url:    backupURL,
-			res:    backup,
+			res:    data,
 		},
 		{
-			desc:   "backup with invalid token",
-			auth:   wrongValue,
-			status: http.StatusUnauthorized,
-			url:    backupURL,
-			res:    backupRes{},
+			desc:   "view org template as user",
+			auth:   token,
+			status: http.StatusForbidden,
+			res:    orgTemplateRes{},
 		},
 		{
-			desc:   "backup with empty token",
+			desc:   "view org template with empty token",
 			auth:   emptyValue,
 			status: http.StatusUnauthorized,
-			url:    backupURL,
-			res:    backupRes{},
+			res:    orgTemplateRes{},
 		},
 	}
 
@@ -2489,161 +4490,192 @@ func TestBackup(t *testing.T) {
 		req := testRequest{
 			client: ts.Client(),
 			method: http.MethodGet,
-			url:    tc.url,
+			url:    fmt.Sprintf("%s/org-template", ts.URL),
 			token:  tc.auth,
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
-		var body backupRes
-		json.NewDecoder(res.Body).Decode(&body)
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
-		assert.ElementsMatch(t, tc.res.Profiles, body.Profiles, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Profiles, body.Profiles))
-		assert.ElementsMatch(t, tc.res.Things, body.Things, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Things, body.Things))
-		assert.ElementsMatch(t, tc.res.Groups, body.Groups, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res.Groups, body.Groups))
+
+		var body orgTemplateRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
 	}
 }
 
-func TestRestore(t *testing.T) {
+func TestRemoveOrgTemplate(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
-	idProvider := uuid.New()
 
-	thId, err := idProvider.ID()
-	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
-	thKey, err := idProvider.ID()
-	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	err := svc.UpdateOrgTemplate(context.Background(), adminToken, things.OrgTemplate{Groups: []things.GroupTemplate{{Name: "default"}}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-	testThing := things.Thing{
-		ID:       thId,
-		Name:     nameKey,
-		Key:      thKey,
-		Metadata: metadata,
+	cases := []struct {
+		desc   string
+		method string
+		auth   string
+		status int
+	}{
+		{
+			desc:   "remove org template as user",
+			method: http.MethodDelete,
+			auth:   token,
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "remove org template",
+			method: http.MethodDelete,
+			auth:   adminToken,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:   "view removed org template",
+			method: http.MethodGet,
+			auth:   adminToken,
+			status: http.StatusNotFound,
+		},
 	}
 
-	var groups []things.Group
-	for i := uint64(0); i < 10; i++ {
-		num := strconv.FormatUint(i, 10)
-		gr := things.Group{
-			ID:          fmt.Sprintf("%s%012d", prefix, i+1),
-			Name:        "test-group-" + num,
-			Description: "test group desc",
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: tc.method,
+			url:    fmt.Sprintf("%s/org-template", ts.URL),
+			token:  tc.auth,
 		}
-
-		groups = append(groups, gr)
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
 	}
+}
 
-	profiles := []things.Profile{}
-	for i := 0; i < n; i++ {
-		prID, err := idProvider.ID()
-		require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+type groupExportRes struct {
+	Profiles []profileRes `json:"profiles"`
+	Things   []thingRes   `json:"things"`
+}
 
-		name := "name_" + fmt.Sprintf("%03d", i+1)
-		profiles = append(profiles, things.Profile{
-			ID:       prID,
-			GroupID:  emptyValue,
-			Name:     name,
-			Metadata: metadata,
-		})
-	}
+type importErrorRes struct {
+	Subject string `json:"subject"`
+	Index   int    `json:"index"`
+	Name    string `json:"name,omitempty"`
+	Error   string `json:"error"`
+}
 
-	thr := []restoreThingReq{
-		{
-			ID:       testThing.ID,
-			Name:     testThing.Name,
-			Key:      testThing.Key,
-			Metadata: testThing.Metadata,
-		},
-	}
+type importGroupRes struct {
+	Profiles []profileRes     `json:"profiles"`
+	Things   []thingRes       `json:"things"`
+	Errors   []importErrorRes `json:"errors"`
+}
 
-	var prr []restoreProfileReq
-	for _, pr := range profiles {
-		prr = append(prr, restoreProfileReq{
-			ID:       pr.ID,
-			Name:     pr.Name,
-			Metadata: pr.Metadata,
-		})
-	}
+func TestExportGroup(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
 
-	var gr []restoreGroupReq
-	for _, group := range groups {
-		gr = append(gr, restoreGroupReq{
-			ID:          group.ID,
-			Name:        group.Name,
-			Description: group.Description,
-			Metadata:    group.Metadata,
-		})
-	}
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	pr := profile
+	pr.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, pr)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pr = prs[0]
+
+	th := thing
+	th.GroupID = grID
+	th.ProfileID = pr.ID
+	ths, err := svc.CreateThings(context.Background(), token, th)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	th = ths[0]
 
-	resReq := restoreReq{
-		Things:   thr,
-		Profiles: prr,
-		Groups:   gr,
+	jsonRes := groupExportRes{
+		Profiles: []profileRes{{ID: pr.ID, Name: pr.Name, Metadata: pr.Metadata}},
+		Things:   []thingRes{{ID: th.ID, ProfileID: th.ProfileID, Name: th.Name, Key: th.Key, Metadata: th.Metadata}},
 	}
+	csvRes := fmt.Sprintf("type,id,name,profile_id,key,config,metadata\nprofile,%s,%s,,,,\"{\"\"test\"\":\"\"data\"\"}\"\nthing,%s,%s,%s,%s,,\"{\"\"test\"\":\"\"data\"\"}\"\n", pr.ID, pr.Name, th.ID, th.Name, pr.ID, th.Key)
 
-	data := toJSON(resReq)
-	invalidData := toJSON(restoreReq{})
-	restoreURL := fmt.Sprintf("%s/restore", ts.URL)
+	exportURL := fmt.Sprintf("%s/groups/%s/export", ts.URL, grID)
 
 	cases := []struct {
 		desc        string
 		auth        string
 		status      int
 		url         string
-		req         string
 		contentType string
+		res         string
 	}{
 		{
-			desc:        "restore all things, profiles and groups",
-			auth:        adminToken,
-			status:      http.StatusCreated,
-			url:         restoreURL,
-			req:         data,
+			desc:        "export group",
+			auth:        token,
+			status:      http.StatusOK,
+			url:         exportURL,
 			contentType: contentType,
+			res:         toJSON(jsonRes) + "\n",
 		},
 		{
-			desc:        "restore with invalid token",
-			auth:        wrongValue,
-			status:      http.StatusUnauthorized,
-			url:         restoreURL,
-			req:         data,
-			contentType: contentType,
+			desc:        "export group as csv",
+			auth:        token,
+			status:      http.StatusOK,
+			url:         fmt.Sprintf("%s?format=csv", exportURL),
+			contentType: "text/csv",
+			res:         csvRes,
 		},
 		{
-			desc:        "restore with empty token",
-			auth:        emptyValue,
-			status:      http.StatusUnauthorized,
-			url:         restoreURL,
-			req:         data,
-			contentType: contentType,
+			desc:   "export group with invalid format",
+			auth:   token,
+			status: http.StatusBadRequest,
+			url:    fmt.Sprintf("%s?format=xml", exportURL),
 		},
 		{
-			desc:        "restore with invalid request",
-			auth:        token,
-			status:      http.StatusBadRequest,
-			url:         restoreURL,
-			req:         invalidData,
-			contentType: contentType,
+			desc:   "export group with invalid token",
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			url:    exportURL,
+		},
+		{
+			desc:   "export group with empty token",
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			url:    exportURL,
+		},
+		{
+			desc:   "export non-existing group",
+			auth:   token,
+			status: http.StatusNotFound,
+			url:    fmt.Sprintf("%s/groups/%s/export", ts.URL, wrongValue),
 		},
 	}
 
 	for _, tc := range cases {
 		req := testRequest{
-			client:      ts.Client(),
-			method:      http.MethodPost,
-			url:         tc.url,
-			token:       tc.auth,
-			contentType: tc.contentType,
-			body:        strings.NewReader(tc.req),
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
 		}
 		res, err := req.make()
 		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
-
+		if tc.status != http.StatusOK {
+			continue
+		}
+		assert.Equal(t, tc.contentType, res.Header.Get("Content-Type"), fmt.Sprintf("%s: expected content type %s got %s", tc.desc, tc.contentType, res.Header.Get("Content-Type")))
+		body, err := io.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		if tc.contentType == contentType {
+			var ger groupExportRes
+			err := json.Unmarshal(body, &ger)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, jsonRes, ger, fmt.Sprintf("%s: expected body %v got %v", tc.desc, jsonRes, ger))
+			continue
+		}
+		assert.Equal(t, tc.res, string(body), fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, body))
 	}
 }
 
-func TestIdentify(t *testing.T) {
+func TestImportGroup(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
 	defer ts.Close()
@@ -2652,161 +4684,154 @@ func TestIdentify(t *testing.T) {
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 	grID := grs[0].ID
 
-	profile.GroupID = grID
-	prs, err := svc.CreateProfiles(context.Background(), token, profile)
-	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
-	prID := prs[0].ID
-
-	thing.GroupID = grID
-	thing.ProfileID = prID
-	ths, err := svc.CreateThings(context.Background(), token, thing)
-	require.Nil(t, err, fmt.Sprintf("failed to create thing: %s", err))
-	th := ths[0]
-
-	ir := identifyReq{Token: th.Key}
-	data := toJSON(ir)
-
-	nonexistentData := toJSON(identifyReq{Token: wrongValue})
+	prID := prefix + "000000000101"
+	thKey := prefix + "000000000102"
+	csvPrID := prefix + "000000000103"
+
+	data := toJSON(map[string]interface{}{
+		"profiles": []profileRes{{ID: prID, Name: profile.Name, Metadata: metadata}},
+		"things":   []thingRes{{Name: thing.Name, ProfileID: prID, Key: thKey, Metadata: metadata}},
+	})
+	csvData := fmt.Sprintf("type,id,name,profile_id,config,metadata\nprofile,%s,%s,,\"{\"\"transformer\"\":{\"\"content_type\"\":\"\"application/json\"\"}}\",\nthing,,%s,%s,,\"{\"\"test\"\":\"\"data\"\"}\"\n", csvPrID, profile1.Name, thing.Name, csvPrID)
+	partialData := toJSON(map[string]interface{}{
+		"things": []thingRes{{Name: "a", ProfileID: prID}, {Name: "b", ProfileID: prID, Key: thKey}},
+	})
+	emptyData := toJSON(map[string]interface{}{"profiles": []profileRes{}, "things": []thingRes{}})
+	invalidNameData := toJSON(map[string]interface{}{"things": []thingRes{{Name: invalidName, ProfileID: prID}}})
+	invalidIDData := toJSON(map[string]interface{}{"profiles": []profileRes{{ID: wrongValue, Name: profile.Name}}})
+	importURL := fmt.Sprintf("%s/groups/%s/import", ts.URL, grID)
 
-	cases := map[string]struct {
+	cases := []struct {
+		desc        string
+		data        string
 		contentType string
-		req         string
+		auth        string
+		url         string
 		status      int
+		profiles    int
+		things      int
+		errors      []importErrorRes
 	}{
-		"identify existing thing": {
+		{
+			desc:        "import group",
+			data:        data,
 			contentType: contentType,
-			req:         data,
-			status:      http.StatusOK,
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusCreated,
+			profiles:    1,
+			things:      1,
+			errors:      []importErrorRes{},
 		},
-		"identify non-existent thing": {
+		{
+			desc:        "import group as csv",
+			data:        csvData,
+			contentType: "text/csv",
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusCreated,
+			profiles:    1,
+			things:      1,
+			errors:      []importErrorRes{},
+		},
+		{
+			desc:        "import group with partial failures",
+			data:        partialData,
 			contentType: contentType,
-			req:         nonexistentData,
-			status:      http.StatusNotFound,
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusMultiStatus,
+			profiles:    0,
+			things:      1,
+			errors:      []importErrorRes{{Subject: things.ThingSub, Index: 1, Name: "b", Error: "entity already exists"}},
 		},
-		"identify with missing content type": {
-			contentType: wrongValue,
-			req:         data,
+		{
+			desc:        "import group with empty lists",
+			data:        emptyData,
+			contentType: contentType,
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import group with invalid thing name",
+			data:        invalidNameData,
+			contentType: contentType,
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import group with invalid profile id",
+			data:        invalidIDData,
+			contentType: contentType,
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import group with invalid csv type",
+			data:        "type,name\nchannel,test\n",
+			contentType: "text/csv",
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import group with invalid csv metadata",
+			data:        "type,name,metadata\nprofile,test,invalid\n",
+			contentType: "text/csv",
+			auth:        token,
+			url:         importURL,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "import group with invalid content type",
+			data:        data,
+			contentType: "application/xml",
+			auth:        token,
+			url:         importURL,
 			status:      http.StatusUnsupportedMediaType,
 		},
-		"identify with empty JSON request": {
+		{
+			desc:        "import group with invalid token",
+			data:        data,
 			contentType: contentType,
-			req:         "{}",
+			auth:        wrongValue,
+			url:         importURL,
 			status:      http.StatusUnauthorized,
 		},
-		"identify with invalid JSON request": {
+		{
+			desc:        "import non-existing group",
+			data:        data,
 			contentType: contentType,
-			req:         emptyValue,
-			status:      http.StatusBadRequest,
+			auth:        token,
+			url:         fmt.Sprintf("%s/groups/%s/import", ts.URL, wrongValue),
+			status:      http.StatusNotFound,
 		},
 	}
 
-	for desc, tc := range cases {
+	for _, tc := range cases {
 		req := testRequest{
 			client:      ts.Client(),
 			method:      http.MethodPost,
-			url:         fmt.Sprintf("%s/identify", ts.URL),
+			url:         tc.url,
 			contentType: tc.contentType,
-			body:        strings.NewReader(tc.req),
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
 		}
 		res, err := req.make()
-		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", desc, err))
-		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", desc, tc.status, res.StatusCode))
-	}
-}
-
-type identifyReq struct {
-	Token string `json:"token"`
-}
-
-type viewMetadataRes struct {
-	Metadata map[string]interface{} `json:"metadata,omitempty"`
-}
-
-type thingRes struct {
-	ID        string                 `json:"id"`
-	GroupID   string                 `json:"group_id,omitempty"`
-	ProfileID string                 `json:"profile_id,omitempty"`
-	Name      string                 `json:"name,omitempty"`
-	Key       string                 `json:"key"`
-	Metadata  map[string]interface{} `json:"metadata,omitempty"`
-}
-
-type profileRes struct {
-	ID       string                 `json:"id"`
-	Name     string                 `json:"name,omitempty"`
-	GroupID  string                 `json:"group_id,omitempty"`
-	Metadata map[string]interface{} `json:"metadata,omitempty"`
-	Config   map[string]interface{} `json:"config,omitempty"`
-}
-
-type thingsPageRes struct {
-	Things []thingRes `json:"things"`
-	Total  uint64     `json:"total"`
-	Offset uint64     `json:"offset"`
-	Limit  uint64     `json:"limit"`
-}
-
-type profilesPageRes struct {
-	Profiles []profileRes `json:"profiles"`
-	Total    uint64       `json:"total"`
-	Offset   uint64       `json:"offset"`
-	Limit    uint64       `json:"limit"`
-}
-
-type backupThingRes struct {
-	ID        string                 `json:"id"`
-	GroupID   string                 `json:"group_id"`
-	ProfileID string                 `json:"profile_id"`
-	Name      string                 `json:"name,omitempty"`
-	Key       string                 `json:"key"`
-	Metadata  map[string]interface{} `json:"metadata,omitempty"`
-}
-
-type backupProfileRes struct {
-	ID       string                 `json:"id"`
-	GroupID  string                 `json:"group_id"`
-	Name     string                 `json:"name,omitempty"`
-	Metadata map[string]interface{} `json:"metadata,omitempty"`
-}
-
-type viewGroupRes struct {
-	ID          string                 `json:"id"`
-	OrgID       string                 `json:"org_id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-}
-
-type backupRes struct {
-	Things   []backupThingRes   `json:"things"`
-	Profiles []backupProfileRes `json:"profiles"`
-	Groups   []viewGroupRes     `json:"groups"`
-}
-
-type restoreThingReq struct {
-	ID       string                 `json:"id"`
-	Name     string                 `json:"name"`
-	Key      string                 `json:"key"`
-	Metadata map[string]interface{} `json:"metadata"`
-}
-
-type restoreProfileReq struct {
-	ID       string                 `json:"id"`
-	Name     string                 `json:"name"`
-	Metadata map[string]interface{} `json:"metadata"`
-}
-
-type restoreGroupReq struct {
-	ID          string                 `json:"id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
-}
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusCreated && tc.status != http.StatusMultiStatus {
+			continue
+		}
 
-type restoreReq struct {
-	Things   []restoreThingReq   `json:"things"`
-	Profiles []restoreProfileReq `json:"profiles"`
-	Groups   []restoreGroupReq   `json:"groups"`
+		var body importGroupRes
+		err = json.NewDecoder(res.Body).Decode(&body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.profiles, len(body.Profiles), fmt.Sprintf("%s: expected %d profiles got %d", tc.desc, tc.profiles, len(body.Profiles)))
+		assert.Equal(t, tc.things, len(body.Things), fmt.Sprintf("%s: expected %d things got %d", tc.desc, tc.things, len(body.Things)))
+		assert.Equal(t, tc.errors, body.Errors, fmt.Sprintf("%s: expected errors %v got %v", tc.desc, tc.errors, body.Errors))
+	}
 }
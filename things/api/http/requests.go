@@ -4,10 +4,16 @@
 package http
 
 import (
+	"encoding/json"
+	"math"
+	"net"
+	"regexp"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/senml"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/gofrs/uuid"
 )
@@ -19,8 +25,29 @@ const (
 	idOrder      = "id"
 	ascDir       = "asc"
 	descDir      = "desc"
+	maxPassSize  = 72
+	minPrefixLen = 4
+	// maxMQTTCount is the limit of the MQTT keep alive, in seconds, and of
+	// the inflight messages, given by their 16-bit fields.
+	maxMQTTCount = 65535
+	// maxMQTTPacketSize is the size of the largest MQTT packet, i.e. the
+	// largest remaining length along with the fixed header.
+	maxMQTTPacketSize = 268435460
 )
 
+// mqttLimits are the upper limits of the MQTT session parameters of the
+// profile config.
+var mqttLimits = map[string]float64{
+	"keep_alive":      maxMQTTCount,
+	"max_inflight":    maxMQTTCount,
+	"receive_maximum": maxMQTTCount,
+	"max_packet_size": maxMQTTPacketSize,
+}
+
+// streamOffsetRegExp matches the consumer group offsets, which are either $
+// for the last entry of the stream, 0 for its start, or the entry ID.
+var streamOffsetRegExp = regexp.MustCompile(`^(\$|[0-9]+(-[0-9]+)?)$`)
+
 type createThingReq struct {
 	ProfileID string                 `json:"profile_id"`
 	Name      string                 `json:"name,omitempty"`
@@ -164,6 +191,10 @@ func (req createProfilesReq) validate() error {
 		if profile.Name == "" || len(profile.Name) > maxNameSize {
 			return apiutil.ErrNameSize
 		}
+
+		if err := validateConfig(profile.Config); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -175,6 +206,7 @@ type updateProfileReq struct {
 	Name     string                 `json:"name,omitempty"`
 	Config   map[string]interface{} `json:"config,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Version  uint64                 `json:"version,omitempty"`
 }
 
 func (req updateProfileReq) validate() error {
@@ -190,7 +222,7 @@ func (req updateProfileReq) validate() error {
 		return apiutil.ErrNameSize
 	}
 
-	return nil
+	return validateConfig(req.Config)
 }
 
 type removeThingsReq struct {
@@ -287,6 +319,28 @@ func (req *listResourcesReq) validate() error {
 	return nil
 }
 
+type listByKeyPrefixReq struct {
+	token        string
+	prefix       string
+	pageMetadata things.PageMetadata
+}
+
+func (req listByKeyPrefixReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.prefix) < minPrefixLen {
+		return apiutil.ErrKeyPrefixSize
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
 type listByIDReq struct {
 	token        string
 	id           string
@@ -331,6 +385,134 @@ func (req backupReq) validate() error {
 	return nil
 }
 
+type adminReq struct {
+	token string
+}
+
+func (req adminReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
+
+type resetConsumerGroupReq struct {
+	token  string
+	Stream string `json:"stream"`
+	Offset string `json:"offset"`
+}
+
+func (req resetConsumerGroupReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.Stream == "" {
+		return apiutil.ErrMissingStream
+	}
+
+	if !streamOffsetRegExp.MatchString(req.Offset) {
+		return apiutil.ErrInvalidStreamOffset
+	}
+
+	return nil
+}
+
+type groupAccessReq struct {
+	token  string
+	format string
+}
+
+func (req groupAccessReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.format != jsonFormat && req.format != csvFormat {
+		return apiutil.ErrInvalidFormat
+	}
+
+	return nil
+}
+
+type exportGroupReq struct {
+	token   string
+	groupID string
+	format  string
+}
+
+func (req exportGroupReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if req.format != jsonFormat && req.format != csvFormat {
+		return apiutil.ErrInvalidFormat
+	}
+
+	return nil
+}
+
+type importGroupReq struct {
+	token    string
+	groupID  string
+	Profiles []createProfileReq `json:"profiles"`
+	Things   []createThingReq   `json:"things"`
+}
+
+func (req importGroupReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.groupID == "" {
+		return apiutil.ErrMissingGroupID
+	}
+
+	if len(req.Profiles) == 0 && len(req.Things) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, profile := range req.Profiles {
+		if profile.ID != "" {
+			if err := validateUUID(profile.ID); err != nil {
+				return err
+			}
+		}
+
+		if profile.Name == "" || len(profile.Name) > maxNameSize {
+			return apiutil.ErrNameSize
+		}
+
+		if err := validateConfig(profile.Config); err != nil {
+			return err
+		}
+	}
+
+	for _, thing := range req.Things {
+		if thing.ProfileID == "" {
+			return apiutil.ErrMissingID
+		}
+
+		if thing.ID != "" {
+			if err := validateUUID(thing.ID); err != nil {
+				return err
+			}
+		}
+
+		if thing.Name == "" || len(thing.Name) > maxNameSize {
+			return apiutil.ErrNameSize
+		}
+	}
+
+	return nil
+}
+
 type restoreThingReq struct {
 	ID       string                 `json:"id"`
 	Name     string                 `json:"name"`
@@ -430,6 +612,28 @@ func (req updateGroupReq) validate() error {
 	return nil
 }
 
+type transferGroupReq struct {
+	token string
+	id    string
+	OrgID string `json:"org_id"`
+}
+
+func (req transferGroupReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.OrgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	return nil
+}
+
 func validateUUID(extID string) (err error) {
 	id, err := uuid.FromString(extID)
 	if id.String() != extID || err != nil {
@@ -439,6 +643,136 @@ func validateUUID(extID string) (err error) {
 	return nil
 }
 
+// validateConfig checks the transformer name and units, the content encoding,
+// the MQTT session parameters and the payload schema of the profile config.
+func validateConfig(config map[string]interface{}) error {
+	if err := validateTransformer(config); err != nil {
+		return err
+	}
+
+	if err := validateUnits(config); err != nil {
+		return err
+	}
+
+	if err := validateMQTT(config); err != nil {
+		return err
+	}
+
+	if err := validateSchema(config); err != nil {
+		return err
+	}
+
+	encoding, ok := config["content_encoding"]
+	if !ok {
+		return nil
+	}
+
+	if e, ok := encoding.(string); !ok || !messaging.ValidEncoding(e) {
+		return apiutil.ErrInvalidContentEncoding
+	}
+
+	return nil
+}
+
+// validateMQTT checks that the MQTT session parameters of the profile config
+// are known, and that they're whole numbers within their limits.
+func validateMQTT(config map[string]interface{}) error {
+	mqtt, ok := config["mqtt"]
+	if !ok {
+		return nil
+	}
+
+	params, ok := mqtt.(map[string]interface{})
+	if !ok {
+		return apiutil.ErrInvalidMQTTConfig
+	}
+
+	for name, value := range params {
+		limit, ok := mqttLimits[name]
+		if !ok {
+			return apiutil.ErrInvalidMQTTConfig
+		}
+
+		v, ok := value.(float64)
+		if !ok || v < 0 || v > limit || v != math.Trunc(v) {
+			return apiutil.ErrInvalidMQTTConfig
+		}
+	}
+
+	return nil
+}
+
+// validateSchema checks that the payload schema of the profile config is
+// a valid JSON schema, which the adapters are able to validate against.
+func validateSchema(config map[string]interface{}) error {
+	schema, ok := config["schema"]
+	if !ok {
+		return nil
+	}
+
+	if _, ok := schema.(map[string]interface{}); !ok {
+		return apiutil.ErrInvalidSchema
+	}
+
+	sb, err := json.Marshal(schema)
+	if err != nil {
+		return apiutil.ErrInvalidSchema
+	}
+
+	if err := messaging.ValidateSchema(string(sb)); err != nil {
+		return apiutil.ErrInvalidSchema
+	}
+
+	return nil
+}
+
+// validateTransformer checks that the profile config references a registered
+// transformer, since the messages referencing an unknown one aren't published.
+func validateTransformer(config map[string]interface{}) error {
+	transformer, ok := config["transformer"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	name, ok := transformer["name"]
+	if !ok {
+		return nil
+	}
+
+	if n, ok := name.(string); !ok || messaging.ValidateTransformer(n) != nil {
+		return apiutil.ErrInvalidTransformer
+	}
+
+	return nil
+}
+
+// validateUnits checks that the units of the profile config transformer are
+// mapped to the units they can be converted to.
+func validateUnits(config map[string]interface{}) error {
+	transformer, ok := config["transformer"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	units, ok := transformer["units"]
+	if !ok {
+		return nil
+	}
+
+	targets, ok := units.(map[string]interface{})
+	if !ok {
+		return apiutil.ErrInvalidUnits
+	}
+
+	for from, to := range targets {
+		if t, ok := to.(string); !ok || !senml.CanConvert(from, t) {
+			return apiutil.ErrInvalidUnits
+		}
+	}
+
+	return nil
+}
+
 type removeGroupsReq struct {
 	token    string
 	GroupIDs []string `json:"group_ids,omitempty"`
@@ -551,3 +885,175 @@ func (req removeGroupRolesReq) validate() error {
 
 	return nil
 }
+
+type createShareReq struct {
+	token     string
+	thingID   string
+	Name      string    `json:"name,omitempty"`
+	Password  string    `json:"password,omitempty"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+func (req createShareReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if len(req.Name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	// The password is hashed using bcrypt, which ignores the bytes after the 72nd one.
+	if len(req.Password) > maxPassSize {
+		return apiutil.ErrMalformedEntity
+	}
+
+	if !req.ExpiresAt.IsZero() && req.ExpiresAt.Before(time.Now()) {
+		return apiutil.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type removeShareReq struct {
+	token   string
+	thingID string
+	shareID string
+}
+
+func (req removeShareReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.thingID == "" || req.shareID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type updateGatewayReq struct {
+	token    string
+	thingID  string
+	Children []string `json:"children"`
+}
+
+func (req updateGatewayReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.thingID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if len(req.Children) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	if len(req.Children) > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	for _, id := range req.Children {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type updateNetworkACLReq struct {
+	token string
+	id    string
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+func (req updateNetworkACLReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if len(req.Allow) > maxLimitSize || len(req.Deny) > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	for _, nets := range [][]string{req.Allow, req.Deny} {
+		for _, n := range nets {
+			if _, _, err := net.ParseCIDR(n); err != nil {
+				return apiutil.ErrMalformedEntity
+			}
+		}
+	}
+
+	return nil
+}
+
+type profileTemplateReq struct {
+	Name     string                 `json:"name"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type groupTemplateReq struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Profiles    []profileTemplateReq   `json:"profiles,omitempty"`
+}
+
+type updateOrgTemplateReq struct {
+	token  string
+	Groups []groupTemplateReq `json:"groups"`
+}
+
+func (req updateOrgTemplateReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.Groups) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, gt := range req.Groups {
+		if gt.Name == "" || len(gt.Name) > maxNameSize {
+			return apiutil.ErrNameSize
+		}
+
+		for _, pt := range gt.Profiles {
+			if pt.Name == "" || len(pt.Name) > maxNameSize {
+				return apiutil.ErrNameSize
+			}
+
+			if err := validateConfig(pt.Config); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type orgTemplateReq struct {
+	token string
+}
+
+func (req orgTemplateReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -21,6 +22,20 @@ var (
 	_ apiutil.Response = (*listGroupRolesRes)(nil)
 	_ apiutil.Response = (*updateGroupRolesRes)(nil)
 	_ apiutil.Response = (*createGroupRolesRes)(nil)
+	_ apiutil.Response = (*groupAccessPageRes)(nil)
+	_ apiutil.Response = (*fileRes)(nil)
+	_ apiutil.Response = (*groupExportRes)(nil)
+	_ apiutil.Response = (*importGroupRes)(nil)
+	_ apiutil.Response = (*rebuildCacheRes)(nil)
+	_ apiutil.Response = (*consumerGroupsRes)(nil)
+	_ apiutil.Response = (*resetConsumerGroupRes)(nil)
+	_ apiutil.Response = (*orgCleanupRes)(nil)
+	_ apiutil.Response = (*shareRes)(nil)
+	_ apiutil.Response = (*sharesRes)(nil)
+	_ apiutil.Response = (*gatewayRes)(nil)
+	_ apiutil.Response = (*networkACLRes)(nil)
+	_ apiutil.Response = (*orgTemplateRes)(nil)
+	_ apiutil.Response = (*updateOrgTemplateRes)(nil)
 )
 
 type removeRes struct{}
@@ -128,6 +143,7 @@ type profileRes struct {
 	Name     string                 `json:"name,omitempty"`
 	Config   map[string]interface{} `json:"config,omitempty"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	Version  uint64                 `json:"version,omitempty"`
 	created  bool
 }
 
@@ -204,6 +220,180 @@ func (res backupRes) Empty() bool {
 	return false
 }
 
+type groupAccessRes struct {
+	OrgID     string `json:"org_id"`
+	GroupID   string `json:"group_id"`
+	GroupName string `json:"group_name"`
+	MemberID  string `json:"member_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+}
+
+type groupAccessPageRes struct {
+	GroupAccess []groupAccessRes `json:"group_access"`
+}
+
+func (res groupAccessPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res groupAccessPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res groupAccessPageRes) Empty() bool {
+	return false
+}
+
+type fileRes struct {
+	name    string
+	content []byte
+}
+
+func (res fileRes) Code() int {
+	return http.StatusOK
+}
+
+func (res fileRes) Headers() map[string]string {
+	return map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s.%s"`, res.name, csvFormat),
+	}
+}
+
+func (res fileRes) Empty() bool {
+	return false
+}
+
+type groupExportRes struct {
+	Profiles []profileRes `json:"profiles"`
+	Things   []thingRes   `json:"things"`
+}
+
+func (res groupExportRes) Code() int {
+	return http.StatusOK
+}
+
+func (res groupExportRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res groupExportRes) Empty() bool {
+	return false
+}
+
+type importErrorRes struct {
+	Subject string `json:"subject"`
+	Index   int    `json:"index"`
+	Name    string `json:"name,omitempty"`
+	Error   string `json:"error"`
+}
+
+type importGroupRes struct {
+	Profiles []profileRes     `json:"profiles"`
+	Things   []thingRes       `json:"things"`
+	Errors   []importErrorRes `json:"errors"`
+}
+
+func (res importGroupRes) Code() int {
+	if len(res.Errors) > 0 {
+		return http.StatusMultiStatus
+	}
+
+	return http.StatusCreated
+}
+
+func (res importGroupRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res importGroupRes) Empty() bool {
+	return false
+}
+
+type rebuildCacheRes struct {
+	Things   uint64 `json:"things"`
+	Profiles uint64 `json:"profiles"`
+	Groups   uint64 `json:"groups"`
+	Roles    uint64 `json:"roles"`
+}
+
+func (res rebuildCacheRes) Code() int {
+	return http.StatusOK
+}
+
+func (res rebuildCacheRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res rebuildCacheRes) Empty() bool {
+	return false
+}
+
+type consumerGroupRes struct {
+	Stream          string `json:"stream"`
+	Name            string `json:"name"`
+	Consumers       int64  `json:"consumers"`
+	Pending         int64  `json:"pending"`
+	LastDeliveredID string `json:"last_delivered_id"`
+	StreamLength    int64  `json:"stream_length"`
+	LastEntryID     string `json:"last_entry_id"`
+}
+
+type consumerGroupsRes struct {
+	ConsumerGroups []consumerGroupRes `json:"consumer_groups"`
+}
+
+func (res consumerGroupsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res consumerGroupsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res consumerGroupsRes) Empty() bool {
+	return false
+}
+
+type cleanupProgressRes struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+type orgCleanupRes struct {
+	OrgID     string               `json:"org_id"`
+	Groups    uint64               `json:"groups"`
+	StartedAt time.Time            `json:"started_at"`
+	Done      bool                 `json:"done"`
+	Consumers []cleanupProgressRes `json:"consumers"`
+}
+
+func (res orgCleanupRes) Code() int {
+	return http.StatusOK
+}
+
+func (res orgCleanupRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res orgCleanupRes) Empty() bool {
+	return false
+}
+
+type resetConsumerGroupRes struct{}
+
+func (res resetConsumerGroupRes) Code() int {
+	return http.StatusOK
+}
+
+func (res resetConsumerGroupRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res resetConsumerGroupRes) Empty() bool {
+	return true
+}
+
 type restoreRes struct{}
 
 func (res restoreRes) Code() int {
@@ -379,3 +569,125 @@ func (res listGroupRolesRes) Headers() map[string]string {
 func (res listGroupRolesRes) Empty() bool {
 	return false
 }
+
+type shareRes struct {
+	ID        string     `json:"id"`
+	ThingID   string     `json:"thing_id"`
+	Name      string     `json:"name,omitempty"`
+	Key       string     `json:"key"`
+	Protected bool       `json:"password_protected"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	created   bool
+}
+
+func (res shareRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res shareRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res shareRes) Empty() bool {
+	return false
+}
+
+type sharesRes struct {
+	Shares []shareRes `json:"shares"`
+}
+
+func (res sharesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res sharesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res sharesRes) Empty() bool {
+	return false
+}
+
+type gatewayRes struct {
+	ThingID  string   `json:"thing_id"`
+	Children []string `json:"children"`
+}
+
+func (res gatewayRes) Code() int {
+	return http.StatusOK
+}
+
+func (res gatewayRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res gatewayRes) Empty() bool {
+	return false
+}
+
+type networkACLRes struct {
+	Allow   []string `json:"allow"`
+	Deny    []string `json:"deny"`
+	updated bool
+}
+
+func (res networkACLRes) Code() int {
+	return http.StatusOK
+}
+
+func (res networkACLRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res networkACLRes) Empty() bool {
+	return res.updated
+}
+
+type profileTemplateRes struct {
+	Name     string                 `json:"name"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type groupTemplateRes struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Profiles    []profileTemplateRes   `json:"profiles"`
+}
+
+type orgTemplateRes struct {
+	Groups    []groupTemplateRes `json:"groups"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+func (res orgTemplateRes) Code() int {
+	return http.StatusOK
+}
+
+func (res orgTemplateRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res orgTemplateRes) Empty() bool {
+	return false
+}
+
+type updateOrgTemplateRes struct{}
+
+func (res updateOrgTemplateRes) Code() int {
+	return http.StatusOK
+}
+
+func (res updateOrgTemplateRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res updateOrgTemplateRes) Empty() bool {
+	return true
+}
@@ -5,7 +5,9 @@ package http
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
 
@@ -23,19 +25,29 @@ import (
 )
 
 const (
-	contentType = "application/json"
-	offsetKey   = "offset"
-	limitKey    = "limit"
-	nameKey     = "name"
-	orderKey    = "order"
-	dirKey      = "dir"
-	metadataKey = "metadata"
-	orgKey      = "org_id"
-	idKey       = "id"
-	defOffset   = 0
-	defLimit    = 10
+	contentType    = "application/json"
+	csvContentType = "text/csv"
+	formatKey      = "format"
+	jsonFormat     = "json"
+	csvFormat      = "csv"
+	offsetKey      = "offset"
+	limitKey       = "limit"
+	nameKey        = "name"
+	orderKey       = "order"
+	dirKey         = "dir"
+	metadataKey    = "metadata"
+	orgKey         = "org_id"
+	idKey          = "id"
+	shareIDKey     = "shareID"
+	keyPrefixKey   = "key_prefix"
+	defOffset      = 0
+	defLimit       = 10
 )
 
+// exportCSVHeader contains the columns of the exported and imported groups in
+// the CSV format. The config and the metadata columns contain JSON objects.
+var exportCSVHeader = []string{"type", "id", "name", "profile_id", "key", "config", "metadata"}
+
 // MakeHandler returns a HTTP handler for API endpoints.
 func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logger) http.Handler {
 	opts := []kithttp.ServerOption{
@@ -86,6 +98,13 @@ func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logge
 		opts...,
 	))
 
+	r.Get("/things/lookup", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_things_by_key_prefix")(listThingsByKeyPrefixEndpoint(svc)),
+		decodeListByKeyPrefix,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Get("/things/:id", kithttp.NewServer(
 		kitot.TraceServer(tracer, "view_thing")(viewThingEndpoint(svc)),
 		decodeRequest,
@@ -100,6 +119,83 @@ func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logge
 		opts...,
 	))
 
+	r.Post("/things/:id/shares", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_share")(createShareEndpoint(svc)),
+		decodeCreateShare,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/things/:id/shares", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_shares_by_thing")(listSharesByThingEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Delete("/things/:id/shares/:shareID", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_share")(removeShareEndpoint(svc)),
+		decodeRemoveShare,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Put("/things/:id/gateway", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_gateway")(updateGatewayEndpoint(svc)),
+		decodeUpdateGateway,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/things/:id/gateway", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_gateway")(viewGatewayEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Delete("/things/:id/gateway", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_gateway")(removeGatewayEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Put("/things/:id/acl", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_thing_acl")(updateThingACLEndpoint(svc)),
+		decodeUpdateNetworkACL,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/things/:id/acl", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_thing_acl")(viewThingACLEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Put("/orgs/:id/acl", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_org_acl")(updateOrgACLEndpoint(svc)),
+		decodeUpdateNetworkACL,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/orgs/:id/acl", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_org_acl")(viewOrgACLEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/orgs/:id/cleanup", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_org_cleanup")(viewOrgCleanupEndpoint(svc)),
+		decodeRequest,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Get("/things", kithttp.NewServer(
 		kitot.TraceServer(tracer, "list_things")(listThingsEndpoint(svc)),
 		decodeList,
@@ -184,6 +280,13 @@ func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logge
 		opts...,
 	))
 
+	r.Post("/groups/:id/transfer", kithttp.NewServer(
+		kitot.TraceServer(tracer, "transfer_group")(transferGroupEndpoint(svc)),
+		decodeTransferGroup,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Delete("/groups/:id", kithttp.NewServer(
 		kitot.TraceServer(tracer, "remove_group")(removeGroupEndpoint(svc)),
 		decodeRequest,
@@ -212,6 +315,20 @@ func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logge
 		opts...,
 	))
 
+	r.Get("/groups/:id/export", kithttp.NewServer(
+		kitot.TraceServer(tracer, "export_group")(exportGroupEndpoint(svc)),
+		decodeExportGroup,
+		encodeFileResponse,
+		opts...,
+	))
+
+	r.Post("/groups/:id/import", kithttp.NewServer(
+		kitot.TraceServer(tracer, "import_group")(importGroupEndpoint(svc)),
+		decodeImportGroup,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Get("/things/:id/groups", kithttp.NewServer(
 		kitot.TraceServer(tracer, "view_group_by_thing")(viewGroupByThingEndpoint(svc)),
 		decodeRequest,
@@ -268,6 +385,34 @@ func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logge
 		opts...,
 	))
 
+	r.Post("/cache/rebuild", kithttp.NewServer(
+		kitot.TraceServer(tracer, "rebuild_cache")(rebuildCacheEndpoint(svc)),
+		decodeAdmin,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/consumer-groups", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_consumer_groups")(listConsumerGroupsEndpoint(svc)),
+		decodeAdmin,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Put("/consumer-groups", kithttp.NewServer(
+		kitot.TraceServer(tracer, "reset_consumer_group")(resetConsumerGroupEndpoint(svc)),
+		decodeResetConsumerGroup,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/access-review", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_group_access")(listGroupAccessEndpoint(svc)),
+		decodeListGroupAccess,
+		encodeFileResponse,
+		opts...,
+	))
+
 	r.Post("/restore", kithttp.NewServer(
 		kitot.TraceServer(tracer, "restore")(restoreEndpoint(svc)),
 		decodeRestore,
@@ -275,6 +420,27 @@ func MakeHandler(tracer opentracing.Tracer, svc things.Service, logger log.Logge
 		opts...,
 	))
 
+	r.Put("/org-template", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_org_template")(updateOrgTemplateEndpoint(svc)),
+		decodeUpdateOrgTemplate,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/org-template", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_org_template")(viewOrgTemplateEndpoint(svc)),
+		decodeOrgTemplate,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Delete("/org-template", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_org_template")(removeOrgTemplateEndpoint(svc)),
+		decodeOrgTemplate,
+		encodeResponse,
+		opts...,
+	))
+
 	r.Post("/identify", kithttp.NewServer(
 		kitot.TraceServer(tracer, "identify")(identifyEndpoint(svc)),
 		decodeIdentify,
@@ -336,6 +502,64 @@ func decodeUpdateKey(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeCreateShare(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createShareReq{
+		token:   apiutil.ExtractBearerToken(r),
+		thingID: bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveShare(_ context.Context, r *http.Request) (interface{}, error) {
+	req := removeShareReq{
+		token:   apiutil.ExtractBearerToken(r),
+		thingID: bone.GetValue(r, idKey),
+		shareID: bone.GetValue(r, shareIDKey),
+	}
+
+	return req, nil
+}
+
+func decodeUpdateGateway(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateGatewayReq{
+		token:   apiutil.ExtractBearerToken(r),
+		thingID: bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateNetworkACL(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateNetworkACLReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
 func decodeViewMetadata(_ context.Context, r *http.Request) (interface{}, error) {
 	req := viewMetadataReq{
 		key: apiutil.ExtractThingKey(r),
@@ -447,6 +671,34 @@ func decodeList(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeListByKeyPrefix(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := apiutil.ReadStringQuery(r, keyPrefixKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := listByKeyPrefixReq{
+		token:  apiutil.ExtractBearerToken(r),
+		prefix: p,
+		pageMetadata: things.PageMetadata{
+			Offset: o,
+			Limit:  l,
+		},
+	}
+
+	return req, nil
+}
+
 func decodeListByMetadata(_ context.Context, r *http.Request) (interface{}, error) {
 	req := listResourcesReq{token: apiutil.ExtractBearerToken(r)}
 	if err := json.NewDecoder(r.Body).Decode(&req.pageMetadata); err != nil {
@@ -574,6 +826,22 @@ func decodeUpdateGroup(_ context.Context, r *http.Request) (interface{}, error)
 	return req, nil
 }
 
+func decodeTransferGroup(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := transferGroupReq{
+		id:    bone.GetValue(r, idKey),
+		token: apiutil.ExtractBearerToken(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
 func decodeRemoveGroups(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
 		return nil, apiutil.ErrUnsupportedContentType
@@ -612,6 +880,137 @@ func decodeBackup(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeListGroupAccess(_ context.Context, r *http.Request) (interface{}, error) {
+	format, err := apiutil.ReadStringQuery(r, formatKey, jsonFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	req := groupAccessReq{
+		token:  apiutil.ExtractBearerToken(r),
+		format: format,
+	}
+
+	return req, nil
+}
+
+func decodeExportGroup(_ context.Context, r *http.Request) (interface{}, error) {
+	format, err := apiutil.ReadStringQuery(r, formatKey, jsonFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	req := exportGroupReq{
+		token:   apiutil.ExtractBearerToken(r),
+		groupID: bone.GetValue(r, idKey),
+		format:  format,
+	}
+
+	return req, nil
+}
+
+func decodeImportGroup(_ context.Context, r *http.Request) (interface{}, error) {
+	req := importGroupReq{
+		token:   apiutil.ExtractBearerToken(r),
+		groupID: bone.GetValue(r, idKey),
+	}
+
+	ct := r.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(ct, contentType):
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+		}
+	case strings.Contains(ct, csvContentType):
+		if err := decodeImportCSV(r.Body, &req); err != nil {
+			return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+		}
+	default:
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	return req, nil
+}
+
+// decodeImportCSV decodes the profiles and the things from the CSV, whose
+// columns are identified by the header, as in exportCSVHeader.
+func decodeImportCSV(body io.Reader, req *importGroupReq) error {
+	reader := csv.NewReader(body)
+	header, err := reader.Read()
+	if err != nil {
+		return err
+	}
+
+	cols := make(map[string]int)
+	for i, name := range header {
+		cols[strings.TrimSpace(name)] = i
+	}
+	if _, ok := cols["type"]; !ok {
+		return errors.New("missing type column")
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		field := func(name string) string {
+			if i, ok := cols[name]; ok {
+				return record[i]
+			}
+			return ""
+		}
+
+		metadata, err := decodeCSVMap(field("metadata"))
+		if err != nil {
+			return err
+		}
+
+		switch field("type") {
+		case things.ProfileSub:
+			config, err := decodeCSVMap(field("config"))
+			if err != nil {
+				return err
+			}
+
+			req.Profiles = append(req.Profiles, createProfileReq{
+				ID:       field("id"),
+				Name:     field("name"),
+				Config:   config,
+				Metadata: metadata,
+			})
+		case things.ThingSub:
+			req.Things = append(req.Things, createThingReq{
+				ID:        field("id"),
+				Name:      field("name"),
+				ProfileID: field("profile_id"),
+				Key:       field("key"),
+				Metadata:  metadata,
+			})
+		default:
+			return errors.New("invalid type")
+		}
+	}
+}
+
+// decodeCSVMap decodes the config or the metadata from the JSON in the CSV field.
+func decodeCSVMap(field string) (map[string]interface{}, error) {
+	if field == "" {
+		return nil, nil
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(field), &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
 func decodeRestore(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
 		return nil, apiutil.ErrUnsupportedContentType
@@ -625,6 +1024,44 @@ func decodeRestore(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeAdmin(_ context.Context, r *http.Request) (interface{}, error) {
+	req := adminReq{token: apiutil.ExtractBearerToken(r)}
+
+	return req, nil
+}
+
+func decodeResetConsumerGroup(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := resetConsumerGroupReq{token: apiutil.ExtractBearerToken(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateOrgTemplate(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateOrgTemplateReq{token: apiutil.ExtractBearerToken(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeOrgTemplate(_ context.Context, r *http.Request) (interface{}, error) {
+	req := orgTemplateReq{token: apiutil.ExtractBearerToken(r)}
+
+	return req, nil
+}
+
 func decodeIdentify(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
 		return nil, apiutil.ErrUnsupportedContentType
@@ -690,7 +1127,23 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeFileResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	fr, ok := response.(fileRes)
+	if !ok {
+		return encodeResponse(ctx, w, response)
+	}
+
+	w.Header().Set("Content-Type", csvContentType)
+	for k, v := range fr.Headers() {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(fr.Code())
+
+	_, err := w.Write(fr.content)
+	return err
+}
+
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	// ErrNotFound can be masked by ErrAuthentication, but it has priority.
 	case errors.Contains(err, errors.ErrNotFound):
@@ -699,7 +1152,8 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrBearerToken,
 		err == apiutil.ErrBearerKey:
 		w.WriteHeader(http.StatusUnauthorized)
-	case errors.Contains(err, errors.ErrAuthorization):
+	case errors.Contains(err, errors.ErrAuthorization),
+		errors.Contains(err, errors.ErrQuotaExceeded):
 		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, apiutil.ErrUnsupportedContentType):
 		w.WriteHeader(http.StatusUnsupportedMediaType)
@@ -709,11 +1163,21 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrEmptyList,
 		err == apiutil.ErrMissingID,
 		err == apiutil.ErrMissingGroupID,
+		err == apiutil.ErrMissingOrgID,
 		err == apiutil.ErrLimitSize,
 		err == apiutil.ErrOffsetSize,
 		err == apiutil.ErrInvalidOrder,
 		err == apiutil.ErrInvalidDirection,
-		err == apiutil.ErrInvalidIDFormat:
+		err == apiutil.ErrInvalidIDFormat,
+		err == apiutil.ErrInvalidFormat,
+		err == apiutil.ErrInvalidUnits,
+		err == apiutil.ErrInvalidTransformer,
+		err == apiutil.ErrInvalidContentEncoding,
+		err == apiutil.ErrInvalidMQTTConfig,
+		err == apiutil.ErrInvalidSchema,
+		err == apiutil.ErrKeyPrefixSize,
+		err == apiutil.ErrMissingStream,
+		err == apiutil.ErrInvalidStreamOffset:
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
@@ -735,7 +1199,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
@@ -30,10 +30,10 @@ func (lm *loggingMiddleware) CreateThings(ctx context.Context, token string, ths
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method create_things for things %s took %s to complete", saved, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateThings(ctx, token, ths...)
@@ -43,10 +43,10 @@ func (lm *loggingMiddleware) UpdateThing(ctx context.Context, token string, thin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_thing for id %s took %s to complete", thing.ID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateThing(ctx, token, thing)
@@ -56,10 +56,10 @@ func (lm *loggingMiddleware) UpdateKey(ctx context.Context, token, id, key strin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_key for id %s and key %s took %s to complete", id, key, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateKey(ctx, token, id, key)
@@ -69,10 +69,10 @@ func (lm *loggingMiddleware) ViewThing(ctx context.Context, token, id string) (t
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_thing for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewThing(ctx, token, id)
@@ -82,10 +82,10 @@ func (lm *loggingMiddleware) ViewMetadataByKey(ctx context.Context, thingKey str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_metadata_by_key took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewMetadataByKey(ctx, thingKey)
@@ -95,22 +95,34 @@ func (lm *loggingMiddleware) ListThings(ctx context.Context, token string, pm th
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_things took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListThings(ctx, token, pm)
 }
 
+func (lm *loggingMiddleware) ListThingsByKeyPrefix(ctx context.Context, token, prefix string, pm things.PageMetadata) (_ things.ThingsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_things_by_key_prefix took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListThingsByKeyPrefix(ctx, token, prefix, pm)
+}
+
 func (lm *loggingMiddleware) ListThingsByProfile(ctx context.Context, token, prID string, pm things.PageMetadata) (_ things.ThingsPage, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_things_by_profile for id %s took %s to complete", prID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListThingsByProfile(ctx, token, prID, pm)
@@ -120,10 +132,10 @@ func (lm *loggingMiddleware) RemoveThings(ctx context.Context, token string, ids
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_things took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveThings(ctx, token, ids...)
@@ -131,12 +143,12 @@ func (lm *loggingMiddleware) RemoveThings(ctx context.Context, token string, ids
 
 func (lm *loggingMiddleware) CreateProfiles(ctx context.Context, token string, profiles ...things.Profile) (saved []things.Profile, err error) {
 	defer func(begin time.Time) {
-		message := fmt.Sprintf("Method create_profiles for profiles %s took %s to complete", saved, time.Since(begin))
+		message := fmt.Sprintf("Method create_profiles for profiles %v took %s to complete", saved, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateProfiles(ctx, token, profiles...)
@@ -146,10 +158,10 @@ func (lm *loggingMiddleware) UpdateProfile(ctx context.Context, token string, pr
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_profile for id %s took %s to complete", profile.ID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateProfile(ctx, token, profile)
@@ -159,10 +171,10 @@ func (lm *loggingMiddleware) ViewProfile(ctx context.Context, token, id string)
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_profile for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewProfile(ctx, token, id)
@@ -172,10 +184,10 @@ func (lm *loggingMiddleware) ListProfiles(ctx context.Context, token string, pm
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_profiles took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListProfiles(ctx, token, pm)
@@ -185,9 +197,9 @@ func (lm *loggingMiddleware) ViewProfileByThing(ctx context.Context, token, thID
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_profile_by_thing for id %s took %s to complete", thID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewProfileByThing(ctx, token, thID)
@@ -197,10 +209,10 @@ func (lm *loggingMiddleware) RemoveProfiles(ctx context.Context, token string, i
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_profiles took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveProfiles(ctx, token, ids...)
@@ -210,35 +222,48 @@ func (lm *loggingMiddleware) GetPubConfByKey(ctx context.Context, key string) (p
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method get_pub_conf_by_key for thing %s took %s to complete", pc.PublisherID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.GetPubConfByKey(ctx, key)
 }
 
-func (lm *loggingMiddleware) GetConfigByThingID(ctx context.Context, thingID string) (config map[string]interface{}, err error) {
+func (lm *loggingMiddleware) GetConfigByThingID(ctx context.Context, thingID string) (_ things.Profile, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method get_config_by_thing_id for thing %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 	return lm.svc.GetConfigByThingID(ctx, thingID)
 }
 
+func (lm *loggingMiddleware) GetPubConfs(ctx context.Context, pm things.PageMetadata) (_ things.PubConfsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_pub_confs for offset %d and limit %d took %s to complete", pm.Offset, pm.Limit, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GetPubConfs(ctx, pm)
+}
+
 func (lm *loggingMiddleware) Authorize(ctx context.Context, ar things.AuthorizeReq) (err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method authorize took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Authorize(ctx, ar)
@@ -248,10 +273,10 @@ func (lm *loggingMiddleware) Identify(ctx context.Context, key string) (id strin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method identify for thing %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Identify(ctx, key)
@@ -261,23 +286,49 @@ func (lm *loggingMiddleware) GetGroupIDByThingID(ctx context.Context, thingID st
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method get_group_id_by_thing_id for thing %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.GetGroupIDByThingID(ctx, thingID)
 }
 
+func (lm *loggingMiddleware) GetStats(ctx context.Context) (_ things.Stats, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_stats took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GetStats(ctx)
+}
+
+func (lm *loggingMiddleware) GetOrgStats(ctx context.Context, orgID string) (_ things.Stats, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_org_stats for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GetOrgStats(ctx, orgID)
+}
+
 func (lm *loggingMiddleware) Backup(ctx context.Context, token string) (bk things.Backup, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method backup took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Backup(ctx, token)
@@ -287,23 +338,88 @@ func (lm *loggingMiddleware) Restore(ctx context.Context, token string, backup t
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method restore took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Restore(ctx, token, backup)
 }
 
+func (lm *loggingMiddleware) ListGroupAccess(ctx context.Context, token string) (gas []things.GroupAccess, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_group_access took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListGroupAccess(ctx, token)
+}
+
+func (lm *loggingMiddleware) RebuildCache(ctx context.Context, token string) (cs things.CacheStats, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method rebuild_cache took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s and cached %d things, %d profiles, %d groups and %d roles without errors.", message, cs.Things, cs.Profiles, cs.Groups, cs.Roles))
+	}(time.Now())
+
+	return lm.svc.RebuildCache(ctx, token)
+}
+
+func (lm *loggingMiddleware) ListConsumerGroups(ctx context.Context, token string) (_ []things.ConsumerGroup, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_consumer_groups took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListConsumerGroups(ctx, token)
+}
+
+func (lm *loggingMiddleware) ResetConsumerGroup(ctx context.Context, token, stream, offset string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method reset_consumer_group for stream %s to offset %s took %s to complete", stream, offset, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ResetConsumerGroup(ctx, token, stream, offset)
+}
+
+func (lm *loggingMiddleware) ViewOrgCleanup(ctx context.Context, token, orgID string) (_ things.OrgCleanup, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_org_cleanup for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewOrgCleanup(ctx, token, orgID)
+}
+
 func (lm *loggingMiddleware) CreateGroups(ctx context.Context, token string, grs ...things.Group) (saved []things.Group, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method create_groups for groups %s took %s to complete", saved, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateGroups(ctx, token, grs...)
@@ -313,23 +429,36 @@ func (lm *loggingMiddleware) UpdateGroup(ctx context.Context, token string, gr t
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_group for id %s took %s to complete", gr.ID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateGroup(ctx, token, gr)
 }
 
+func (lm *loggingMiddleware) TransferGroup(ctx context.Context, token, groupID, orgID string) (g things.Group, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method transfer_group for id %s to org %s took %s to complete", groupID, orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.TransferGroup(ctx, token, groupID, orgID)
+}
+
 func (lm *loggingMiddleware) ViewGroup(ctx context.Context, token, id string) (g things.Group, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_group for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewGroup(ctx, token, id)
@@ -339,10 +468,10 @@ func (lm *loggingMiddleware) ListGroups(ctx context.Context, token, orgID string
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_groups took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListGroups(ctx, token, orgID, pm)
@@ -352,10 +481,10 @@ func (lm *loggingMiddleware) ListGroupsByIDs(ctx context.Context, groupIDs []str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_groups_by_ids for ids %s took %s to complete", groupIDs, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListGroupsByIDs(ctx, groupIDs)
@@ -365,10 +494,10 @@ func (lm *loggingMiddleware) ListThingsByGroup(ctx context.Context, token, group
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_things_by_group for id %s took %s to complete", groupID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListThingsByGroup(ctx, token, groupID, pm)
@@ -378,10 +507,10 @@ func (lm *loggingMiddleware) ViewGroupByThing(ctx context.Context, token, thingI
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_group_by_thing for id %s took %s to complete", thingID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewGroupByThing(ctx, token, thingID)
@@ -391,23 +520,36 @@ func (lm *loggingMiddleware) RemoveGroups(ctx context.Context, token string, ids
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_groups took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveGroups(ctx, token, ids...)
 }
 
+func (lm *loggingMiddleware) RemoveGroupsByOrg(ctx context.Context, orgID string) (ids []string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_groups_by_org for org %s removed %d groups and took %s to complete", orgID, len(ids), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveGroupsByOrg(ctx, orgID)
+}
+
 func (lm *loggingMiddleware) ViewGroupByProfile(ctx context.Context, token, profileID string) (gr things.Group, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_group_by_profile for id %s took %s to complete", profileID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewGroupByProfile(ctx, token, profileID)
@@ -417,10 +559,10 @@ func (lm *loggingMiddleware) ListProfilesByGroup(ctx context.Context, token, gro
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_profiles_by_group for id %s took %s to complete", groupID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListProfilesByGroup(ctx, token, groupID, pm)
@@ -430,7 +572,7 @@ func (lm *loggingMiddleware) CreateRolesByGroup(ctx context.Context, token strin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method create_roles_by_group took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
 	}(time.Now())
@@ -442,9 +584,9 @@ func (lm *loggingMiddleware) ListRolesByGroup(ctx context.Context, token, groupI
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_roles_by_group for id %s took %s to complete", groupID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListRolesByGroup(ctx, token, groupID, pm)
@@ -454,7 +596,7 @@ func (lm *loggingMiddleware) UpdateRolesByGroup(ctx context.Context, token strin
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_roles_by_group took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
 	}(time.Now())
@@ -466,10 +608,287 @@ func (lm *loggingMiddleware) RemoveRolesByGroup(ctx context.Context, token, grou
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_roles_by_group for id %s took %s to complete", groupID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
 	}(time.Now())
 
 	return lm.svc.RemoveRolesByGroup(ctx, token, groupID, memberIDs...)
 }
+
+func (lm *loggingMiddleware) RemoveRolesByMember(ctx context.Context, memberID string) (ids []string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_roles_by_member for member %s removed roles of %d groups and took %s to complete", memberID, len(ids), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveRolesByMember(ctx, memberID)
+}
+
+func (lm *loggingMiddleware) RemoveRolesByOrgMember(ctx context.Context, orgID, memberID string) (ids []string, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_roles_by_org_member for member %s of org %s removed roles of %d groups and took %s to complete", memberID, orgID, len(ids), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveRolesByOrgMember(ctx, orgID, memberID)
+}
+
+func (lm *loggingMiddleware) CreateShare(ctx context.Context, token, thingID string, sh things.Share) (saved things.Share, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_share for thing %s and id %s took %s to complete", thingID, saved.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateShare(ctx, token, thingID, sh)
+}
+
+func (lm *loggingMiddleware) ListSharesByThing(ctx context.Context, token, thingID string) (_ []things.Share, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_shares_by_thing for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListSharesByThing(ctx, token, thingID)
+}
+
+func (lm *loggingMiddleware) RemoveShare(ctx context.Context, token, thingID, shareID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_share for thing %s and id %s took %s to complete", thingID, shareID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveShare(ctx, token, thingID, shareID)
+}
+
+func (lm *loggingMiddleware) GetPubConfByShare(ctx context.Context, key, password string) (pc things.PubConfInfo, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_pub_conf_by_share for thing %s took %s to complete", pc.PublisherID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GetPubConfByShare(ctx, key, password)
+}
+
+func (lm *loggingMiddleware) UpdateGateway(ctx context.Context, token string, gw things.Gateway) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_gateway for thing %s took %s to complete", gw.ThingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateGateway(ctx, token, gw)
+}
+
+func (lm *loggingMiddleware) ViewGateway(ctx context.Context, token, thingID string) (_ things.Gateway, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_gateway for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewGateway(ctx, token, thingID)
+}
+
+func (lm *loggingMiddleware) RemoveGateway(ctx context.Context, token, thingID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_gateway for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveGateway(ctx, token, thingID)
+}
+
+func (lm *loggingMiddleware) GetPubConfByGateway(ctx context.Context, key, thingID string) (_ things.PubConfInfo, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_pub_conf_by_gateway for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GetPubConfByGateway(ctx, key, thingID)
+}
+
+func (lm *loggingMiddleware) UpdateOrgTemplate(ctx context.Context, token string, ot things.OrgTemplate) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_org_template took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateOrgTemplate(ctx, token, ot)
+}
+
+func (lm *loggingMiddleware) ViewOrgTemplate(ctx context.Context, token string) (_ things.OrgTemplate, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_org_template took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewOrgTemplate(ctx, token)
+}
+
+func (lm *loggingMiddleware) RemoveOrgTemplate(ctx context.Context, token string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_org_template took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveOrgTemplate(ctx, token)
+}
+
+func (lm *loggingMiddleware) ProvisionOrg(ctx context.Context, orgID, ownerID string) (grs []things.Group, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method provision_org for org %s created %d groups and took %s to complete", orgID, len(grs), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ProvisionOrg(ctx, orgID, ownerID)
+}
+
+func (lm *loggingMiddleware) UpdateThingACL(ctx context.Context, token, thingID string, acl things.NetworkACL) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_thing_acl for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateThingACL(ctx, token, thingID, acl)
+}
+
+func (lm *loggingMiddleware) ViewThingACL(ctx context.Context, token, thingID string) (_ things.NetworkACL, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_thing_acl for thing %s took %s to complete", thingID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewThingACL(ctx, token, thingID)
+}
+
+func (lm *loggingMiddleware) UpdateOrgACL(ctx context.Context, token, orgID string, acl things.NetworkACL) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_org_acl for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateOrgACL(ctx, token, orgID, acl)
+}
+
+func (lm *loggingMiddleware) ViewOrgACL(ctx context.Context, token, orgID string) (_ things.NetworkACL, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_org_acl for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewOrgACL(ctx, token, orgID)
+}
+
+func (lm *loggingMiddleware) RemoveOrgACL(ctx context.Context, orgID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_org_acl for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveOrgACL(ctx, orgID)
+}
+
+func (lm *loggingMiddleware) ExportGroup(ctx context.Context, token, groupID string) (_ things.GroupExport, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method export_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ExportGroup(ctx, token, groupID)
+}
+
+func (lm *loggingMiddleware) ImportGroup(ctx context.Context, token, groupID string, ge things.GroupExport) (ir things.ImportResult, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method import_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		if len(ir.Errors) > 0 {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with %d failed imports.", message, len(ir.Errors)))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ImportGroup(ctx, token, groupID, ge)
+}
@@ -7,8 +7,10 @@ package api
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	mfmetrics "github.com/MainfluxLabs/mainflux/pkg/metrics"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/go-kit/kit/metrics"
 )
@@ -16,17 +18,27 @@ import (
 var _ things.Service = (*metricsMiddleware)(nil)
 
 type metricsMiddleware struct {
-	counter metrics.Counter
-	latency metrics.Histogram
-	svc     things.Service
+	counter       metrics.Counter
+	latency       metrics.Histogram
+	tenantCounter metrics.Counter
+	tenants       *mfmetrics.Tenants
+	mu            sync.RWMutex
+	groupOrgs     map[string]string
+	svc           things.Service
 }
 
 // MetricsMiddleware instruments core service by tracking request count and latency.
-func MetricsMiddleware(svc things.Service, counter metrics.Counter, latency metrics.Histogram) things.Service {
+// The tenant counter tracks the requests of org and group scoped methods,
+// labelled with the org and the group as configured by tenants. The messages
+// are counted per tenant by the adapters, since they cache the pub confs.
+func MetricsMiddleware(svc things.Service, counter metrics.Counter, latency metrics.Histogram, tenantCounter metrics.Counter, tenants *mfmetrics.Tenants) things.Service {
 	return &metricsMiddleware{
-		counter: counter,
-		latency: latency,
-		svc:     svc,
+		counter:       counter,
+		latency:       latency,
+		tenantCounter: tenantCounter,
+		tenants:       tenants,
+		groupOrgs:     make(map[string]string),
+		svc:           svc,
 	}
 }
 
@@ -84,6 +96,15 @@ func (ms *metricsMiddleware) ListThings(ctx context.Context, token string, pm th
 	return ms.svc.ListThings(ctx, token, pm)
 }
 
+func (ms *metricsMiddleware) ListThingsByKeyPrefix(ctx context.Context, token, prefix string, pm things.PageMetadata) (things.ThingsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_things_by_key_prefix").Add(1)
+		ms.latency.With("method", "list_things_by_key_prefix").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListThingsByKeyPrefix(ctx, token, prefix, pm)
+}
+
 func (ms *metricsMiddleware) ListThingsByProfile(ctx context.Context, token, prID string, pm things.PageMetadata) (things.ThingsPage, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "list_things_by_profile").Add(1)
@@ -165,7 +186,7 @@ func (ms *metricsMiddleware) GetPubConfByKey(ctx context.Context, key string) (t
 	return ms.svc.GetPubConfByKey(ctx, key)
 }
 
-func (ms *metricsMiddleware) GetConfigByThingID(ctx context.Context, thingID string) (map[string]interface{}, error) {
+func (ms *metricsMiddleware) GetConfigByThingID(ctx context.Context, thingID string) (things.Profile, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "get_config_by_thing_id").Add(1)
 		ms.latency.With("method", "get_config_by_thing_id").Observe(time.Since(begin).Seconds())
@@ -173,6 +194,15 @@ func (ms *metricsMiddleware) GetConfigByThingID(ctx context.Context, thingID str
 	return ms.svc.GetConfigByThingID(ctx, thingID)
 }
 
+func (ms *metricsMiddleware) GetPubConfs(ctx context.Context, pm things.PageMetadata) (things.PubConfsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_pub_confs").Add(1)
+		ms.latency.With("method", "get_pub_confs").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetPubConfs(ctx, pm)
+}
+
 func (ms *metricsMiddleware) Authorize(ctx context.Context, ar things.AuthorizeReq) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "authorize").Add(1)
@@ -200,6 +230,24 @@ func (ms *metricsMiddleware) GetGroupIDByThingID(ctx context.Context, thingID st
 	return ms.svc.GetGroupIDByThingID(ctx, thingID)
 }
 
+func (ms *metricsMiddleware) GetStats(ctx context.Context) (things.Stats, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_stats").Add(1)
+		ms.latency.With("method", "get_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetStats(ctx)
+}
+
+func (ms *metricsMiddleware) GetOrgStats(ctx context.Context, orgID string) (things.Stats, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_org_stats").Add(1)
+		ms.latency.With("method", "get_org_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetOrgStats(ctx, orgID)
+}
+
 func (ms *metricsMiddleware) Backup(ctx context.Context, token string) (bk things.Backup, err error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "backup").Add(1)
@@ -218,10 +266,58 @@ func (ms *metricsMiddleware) Restore(ctx context.Context, token string, backup t
 	return ms.svc.Restore(ctx, token, backup)
 }
 
+func (ms *metricsMiddleware) ListGroupAccess(ctx context.Context, token string) ([]things.GroupAccess, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_group_access").Add(1)
+		ms.latency.With("method", "list_group_access").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListGroupAccess(ctx, token)
+}
+
+func (ms *metricsMiddleware) RebuildCache(ctx context.Context, token string) (things.CacheStats, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "rebuild_cache").Add(1)
+		ms.latency.With("method", "rebuild_cache").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RebuildCache(ctx, token)
+}
+
+func (ms *metricsMiddleware) ListConsumerGroups(ctx context.Context, token string) ([]things.ConsumerGroup, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_consumer_groups").Add(1)
+		ms.latency.With("method", "list_consumer_groups").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListConsumerGroups(ctx, token)
+}
+
+func (ms *metricsMiddleware) ResetConsumerGroup(ctx context.Context, token, stream, offset string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "reset_consumer_group").Add(1)
+		ms.latency.With("method", "reset_consumer_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ResetConsumerGroup(ctx, token, stream, offset)
+}
+
+func (ms *metricsMiddleware) ViewOrgCleanup(ctx context.Context, token, orgID string) (things.OrgCleanup, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_org_cleanup").Add(1)
+		ms.latency.With("method", "view_org_cleanup").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewOrgCleanup(ctx, token, orgID)
+}
+
 func (ms *metricsMiddleware) CreateGroups(ctx context.Context, token string, grs ...things.Group) ([]things.Group, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "create_groups").Add(1)
 		ms.latency.With("method", "create_groups").Observe(time.Since(begin).Seconds())
+		if len(grs) > 0 {
+			ms.countTenant(ctx, "create_groups", grs[0].OrgID, "")
+		}
 	}(time.Now())
 
 	return ms.svc.CreateGroups(ctx, token, grs...)
@@ -236,10 +332,28 @@ func (ms *metricsMiddleware) UpdateGroup(ctx context.Context, token string, g th
 	return ms.svc.UpdateGroup(ctx, token, g)
 }
 
+func (ms *metricsMiddleware) TransferGroup(ctx context.Context, token, groupID, orgID string) (things.Group, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "transfer_group").Add(1)
+		ms.latency.With("method", "transfer_group").Observe(time.Since(begin).Seconds())
+		ms.countTenant(ctx, "transfer_group", orgID, groupID)
+	}(time.Now())
+
+	gr, err := ms.svc.TransferGroup(ctx, token, groupID, orgID)
+	if err == nil {
+		ms.mu.Lock()
+		delete(ms.groupOrgs, groupID)
+		ms.mu.Unlock()
+	}
+
+	return gr, err
+}
+
 func (ms *metricsMiddleware) ViewGroup(ctx context.Context, token, id string) (things.Group, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "view_group").Add(1)
 		ms.latency.With("method", "view_group").Observe(time.Since(begin).Seconds())
+		ms.countTenant(ctx, "view_group", "", id)
 	}(time.Now())
 
 	return ms.svc.ViewGroup(ctx, token, id)
@@ -249,6 +363,7 @@ func (ms *metricsMiddleware) ListGroups(ctx context.Context, token, orgID string
 	defer func(begin time.Time) {
 		ms.counter.With("method", "list_group").Add(1)
 		ms.latency.With("method", "list_group").Observe(time.Since(begin).Seconds())
+		ms.countTenant(ctx, "list_group", orgID, "")
 	}(time.Now())
 
 	return ms.svc.ListGroups(ctx, token, orgID, pm)
@@ -267,6 +382,7 @@ func (ms *metricsMiddleware) ListThingsByGroup(ctx context.Context, token, group
 	defer func(begin time.Time) {
 		ms.counter.With("method", "list_things_by_group").Add(1)
 		ms.latency.With("method", "list_things_by_group").Observe(time.Since(begin).Seconds())
+		ms.countTenant(ctx, "list_things_by_group", "", groupID)
 	}(time.Now())
 
 	return ms.svc.ListThingsByGroup(ctx, token, groupID, pm)
@@ -287,13 +403,33 @@ func (ms *metricsMiddleware) RemoveGroups(ctx context.Context, token string, ids
 		ms.latency.With("method", "remove_groups").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.RemoveGroups(ctx, token, ids...)
+	if err := ms.svc.RemoveGroups(ctx, token, ids...); err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	for _, id := range ids {
+		delete(ms.groupOrgs, id)
+	}
+	ms.mu.Unlock()
+
+	return nil
+}
+
+func (ms *metricsMiddleware) RemoveGroupsByOrg(ctx context.Context, orgID string) ([]string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_groups_by_org").Add(1)
+		ms.latency.With("method", "remove_groups_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveGroupsByOrg(ctx, orgID)
 }
 
 func (ms *metricsMiddleware) ListProfilesByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (things.ProfilesPage, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "list_profiles_by_group").Add(1)
 		ms.latency.With("method", "list_profiles_by_group").Observe(time.Since(begin).Seconds())
+		ms.countTenant(ctx, "list_profiles_by_group", "", groupID)
 	}(time.Now())
 
 	return ms.svc.ListProfilesByGroup(ctx, token, groupID, pm)
@@ -343,3 +479,223 @@ func (ms *metricsMiddleware) RemoveRolesByGroup(ctx context.Context, token, grou
 
 	return ms.svc.RemoveRolesByGroup(ctx, token, groupID, memberIDs...)
 }
+
+func (ms *metricsMiddleware) RemoveRolesByMember(ctx context.Context, memberID string) ([]string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_roles_by_member").Add(1)
+		ms.latency.With("method", "remove_roles_by_member").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveRolesByMember(ctx, memberID)
+}
+
+func (ms *metricsMiddleware) RemoveRolesByOrgMember(ctx context.Context, orgID, memberID string) ([]string, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_roles_by_org_member").Add(1)
+		ms.latency.With("method", "remove_roles_by_org_member").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveRolesByOrgMember(ctx, orgID, memberID)
+}
+
+func (ms *metricsMiddleware) countTenant(ctx context.Context, method, orgID, groupID string) {
+	if orgID == "" && groupID != "" && ms.tenants.Enabled() {
+		orgID = ms.groupOrg(ctx, groupID)
+	}
+
+	labels := append([]string{"method", method}, ms.tenants.Labels(orgID, groupID)...)
+	ms.tenantCounter.With(labels...).Add(1)
+}
+
+// groupOrg returns the org of the group, caching it for the subsequent
+// requests of the group.
+func (ms *metricsMiddleware) groupOrg(ctx context.Context, groupID string) string {
+	ms.mu.RLock()
+	orgID, ok := ms.groupOrgs[groupID]
+	ms.mu.RUnlock()
+	if ok {
+		return orgID
+	}
+
+	grs, err := ms.svc.ListGroupsByIDs(ctx, []string{groupID})
+	if err != nil || len(grs) == 0 {
+		return ""
+	}
+
+	ms.mu.Lock()
+	ms.groupOrgs[groupID] = grs[0].OrgID
+	ms.mu.Unlock()
+
+	return grs[0].OrgID
+}
+
+func (ms *metricsMiddleware) CreateShare(ctx context.Context, token, thingID string, sh things.Share) (things.Share, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_share").Add(1)
+		ms.latency.With("method", "create_share").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateShare(ctx, token, thingID, sh)
+}
+
+func (ms *metricsMiddleware) ListSharesByThing(ctx context.Context, token, thingID string) ([]things.Share, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_shares_by_thing").Add(1)
+		ms.latency.With("method", "list_shares_by_thing").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListSharesByThing(ctx, token, thingID)
+}
+
+func (ms *metricsMiddleware) RemoveShare(ctx context.Context, token, thingID, shareID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_share").Add(1)
+		ms.latency.With("method", "remove_share").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveShare(ctx, token, thingID, shareID)
+}
+
+func (ms *metricsMiddleware) GetPubConfByShare(ctx context.Context, key, password string) (things.PubConfInfo, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_pub_conf_by_share").Add(1)
+		ms.latency.With("method", "get_pub_conf_by_share").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetPubConfByShare(ctx, key, password)
+}
+
+func (ms *metricsMiddleware) UpdateGateway(ctx context.Context, token string, gw things.Gateway) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_gateway").Add(1)
+		ms.latency.With("method", "update_gateway").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateGateway(ctx, token, gw)
+}
+
+func (ms *metricsMiddleware) ViewGateway(ctx context.Context, token, thingID string) (things.Gateway, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_gateway").Add(1)
+		ms.latency.With("method", "view_gateway").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewGateway(ctx, token, thingID)
+}
+
+func (ms *metricsMiddleware) RemoveGateway(ctx context.Context, token, thingID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_gateway").Add(1)
+		ms.latency.With("method", "remove_gateway").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveGateway(ctx, token, thingID)
+}
+
+func (ms *metricsMiddleware) GetPubConfByGateway(ctx context.Context, key, thingID string) (things.PubConfInfo, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_pub_conf_by_gateway").Add(1)
+		ms.latency.With("method", "get_pub_conf_by_gateway").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetPubConfByGateway(ctx, key, thingID)
+}
+
+func (ms *metricsMiddleware) UpdateOrgTemplate(ctx context.Context, token string, ot things.OrgTemplate) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_org_template").Add(1)
+		ms.latency.With("method", "update_org_template").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateOrgTemplate(ctx, token, ot)
+}
+
+func (ms *metricsMiddleware) ViewOrgTemplate(ctx context.Context, token string) (things.OrgTemplate, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_org_template").Add(1)
+		ms.latency.With("method", "view_org_template").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewOrgTemplate(ctx, token)
+}
+
+func (ms *metricsMiddleware) RemoveOrgTemplate(ctx context.Context, token string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_org_template").Add(1)
+		ms.latency.With("method", "remove_org_template").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveOrgTemplate(ctx, token)
+}
+
+func (ms *metricsMiddleware) ProvisionOrg(ctx context.Context, orgID, ownerID string) ([]things.Group, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "provision_org").Add(1)
+		ms.latency.With("method", "provision_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ProvisionOrg(ctx, orgID, ownerID)
+}
+
+func (ms *metricsMiddleware) UpdateThingACL(ctx context.Context, token, thingID string, acl things.NetworkACL) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_thing_acl").Add(1)
+		ms.latency.With("method", "update_thing_acl").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateThingACL(ctx, token, thingID, acl)
+}
+
+func (ms *metricsMiddleware) ViewThingACL(ctx context.Context, token, thingID string) (things.NetworkACL, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_thing_acl").Add(1)
+		ms.latency.With("method", "view_thing_acl").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewThingACL(ctx, token, thingID)
+}
+
+func (ms *metricsMiddleware) UpdateOrgACL(ctx context.Context, token, orgID string, acl things.NetworkACL) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_org_acl").Add(1)
+		ms.latency.With("method", "update_org_acl").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateOrgACL(ctx, token, orgID, acl)
+}
+
+func (ms *metricsMiddleware) ViewOrgACL(ctx context.Context, token, orgID string) (things.NetworkACL, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_org_acl").Add(1)
+		ms.latency.With("method", "view_org_acl").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewOrgACL(ctx, token, orgID)
+}
+
+func (ms *metricsMiddleware) RemoveOrgACL(ctx context.Context, orgID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_org_acl").Add(1)
+		ms.latency.With("method", "remove_org_acl").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveOrgACL(ctx, orgID)
+}
+
+func (ms *metricsMiddleware) ExportGroup(ctx context.Context, token, groupID string) (things.GroupExport, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "export_group").Add(1)
+		ms.latency.With("method", "export_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ExportGroup(ctx, token, groupID)
+}
+
+func (ms *metricsMiddleware) ImportGroup(ctx context.Context, token, groupID string, ge things.GroupExport) (things.ImportResult, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "import_group").Add(1)
+		ms.latency.With("method", "import_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ImportGroup(ctx, token, groupID, ge)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+	"time"
+)
+
+// ConsumerGroup represents the event store consumer group, through which the
+// service consumes the events of the stream.
+type ConsumerGroup struct {
+	Stream          string
+	Name            string
+	Consumers       int64
+	Pending         int64
+	LastDeliveredID string
+	StreamLength    int64
+	LastEntryID     string
+}
+
+// ConsumerGroups specifies the inspection and the reset of the event store
+// consumer groups of the service.
+type ConsumerGroups interface {
+	// RetrieveAll retrieves the consumer groups of the service.
+	RetrieveAll(ctx context.Context) ([]ConsumerGroup, error)
+
+	// Reset sets the offset of the consumer group of the stream, which is
+	// the ID of the last entry delivered to the group.
+	Reset(ctx context.Context, stream, offset string) error
+
+	// RetrieveOrgCleanup retrieves the progress of the cleanup of the
+	// removed org by the consumer groups of the things event stream.
+	RetrieveOrgCleanup(ctx context.Context, orgID string) (OrgCleanup, error)
+}
+
+// OrgCleanup represents the cleanup of the entities of the removed org. The
+// service removes the groups of the org and publishes the org.cleanup event
+// after the group.remove events, so that each consumer group of the things
+// event stream is done with the org once it handles the org.cleanup event.
+type OrgCleanup struct {
+	OrgID     string
+	Groups    uint64
+	StartedAt time.Time
+	Consumers []CleanupProgress
+}
+
+// CleanupProgress represents the progress of the org cleanup by the event
+// store consumer group.
+type CleanupProgress struct {
+	Name string
+	Done bool
+}
+
+// CacheStats contains the number of entities stored in the cache by the rebuild.
+type CacheStats struct {
+	Things   uint64
+	Profiles uint64
+	Groups   uint64
+	Roles    uint64
+}
+
+func (ts *thingsService) RebuildCache(ctx context.Context, token string) (CacheStats, error) {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return CacheStats{}, err
+	}
+
+	groups, err := ts.groups.RetrieveAll(ctx)
+	if err != nil {
+		return CacheStats{}, err
+	}
+
+	roles, err := ts.roles.RetrieveAllRolesByGroup(ctx)
+	if err != nil {
+		return CacheStats{}, err
+	}
+
+	things, err := ts.things.RetrieveAll(ctx)
+	if err != nil {
+		return CacheStats{}, err
+	}
+
+	profiles, err := ts.profiles.RetrieveAll(ctx)
+	if err != nil {
+		return CacheStats{}, err
+	}
+
+	if err := ts.thingCache.Flush(ctx); err != nil {
+		return CacheStats{}, err
+	}
+
+	if err := ts.profileCache.Flush(ctx); err != nil {
+		return CacheStats{}, err
+	}
+
+	if err := ts.groupCache.Flush(ctx); err != nil {
+		return CacheStats{}, err
+	}
+
+	for _, g := range groups {
+		if err := ts.groupCache.SaveOrg(ctx, g.ID, g.OrgID); err != nil {
+			return CacheStats{}, err
+		}
+	}
+
+	for _, r := range roles {
+		if err := ts.groupCache.SaveRole(ctx, r.GroupID, r.MemberID, r.Role); err != nil {
+			return CacheStats{}, err
+		}
+	}
+
+	for _, th := range things {
+		if err := ts.thingCache.Save(ctx, th.Key, th.ID); err != nil {
+			return CacheStats{}, err
+		}
+
+		if err := ts.thingCache.SaveGroup(ctx, th.ID, th.GroupID); err != nil {
+			return CacheStats{}, err
+		}
+	}
+
+	for _, pr := range profiles {
+		if err := ts.profileCache.SaveGroup(ctx, pr.ID, pr.GroupID); err != nil {
+			return CacheStats{}, err
+		}
+	}
+
+	return CacheStats{
+		Things:   uint64(len(things)),
+		Profiles: uint64(len(profiles)),
+		Groups:   uint64(len(groups)),
+		Roles:    uint64(len(roles)),
+	}, nil
+}
+
+func (ts *thingsService) ListConsumerGroups(ctx context.Context, token string) ([]ConsumerGroup, error) {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return nil, err
+	}
+
+	return ts.consumers.RetrieveAll(ctx)
+}
+
+func (ts *thingsService) ViewOrgCleanup(ctx context.Context, token, orgID string) (OrgCleanup, error) {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return OrgCleanup{}, err
+	}
+
+	return ts.consumers.RetrieveOrgCleanup(ctx, orgID)
+}
+
+func (ts *thingsService) ResetConsumerGroup(ctx context.Context, token, stream, offset string) error {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return err
+	}
+
+	return ts.consumers.Reset(ctx, stream, offset)
+}
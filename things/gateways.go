@@ -0,0 +1,176 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// Gateway represents the thing which publishes the messages on behalf of its
+// children, using its own key. The children are the things of the gateway
+// group which are explicitly allowed to be published on behalf of.
+type Gateway struct {
+	ThingID  string
+	Children []string
+}
+
+// HasChild returns true if the thing is the child of the gateway.
+func (gw Gateway) HasChild(thingID string) bool {
+	for _, id := range gw.Children {
+		if id == thingID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GatewayRepository specifies a gateway persistence API.
+type GatewayRepository interface {
+	// Save persists the gateway, replacing the children of the existing one.
+	Save(ctx context.Context, gw Gateway) error
+
+	// RetrieveByThing retrieves the gateway of the thing identified by the provided ID.
+	RetrieveByThing(ctx context.Context, thingID string) (Gateway, error)
+
+	// Remove removes the gateway of the thing identified by the provided ID.
+	Remove(ctx context.Context, thingID string) error
+}
+
+// Gateways specifies an API for managing the gateways.
+type Gateways interface {
+	// UpdateGateway makes the thing identified by the provided ID the gateway
+	// of the given children, replacing its existing children.
+	UpdateGateway(ctx context.Context, token string, gw Gateway) error
+
+	// ViewGateway retrieves the gateway of the thing identified by the provided ID.
+	ViewGateway(ctx context.Context, token, thingID string) (Gateway, error)
+
+	// RemoveGateway removes the gateway of the thing identified by the provided
+	// ID, so that it can no longer publish on behalf of its children.
+	RemoveGateway(ctx context.Context, token, thingID string) error
+
+	// GetPubConfByGateway returns the publish configuration of the child thing,
+	// if the key belongs to its gateway.
+	GetPubConfByGateway(ctx context.Context, key, thingID string) (PubConfInfo, error)
+}
+
+func (ts *thingsService) UpdateGateway(ctx context.Context, token string, gw Gateway) error {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  gw.ThingID,
+		Subject: ThingSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return err
+	}
+
+	grID, err := ts.GetGroupIDByThingID(ctx, gw.ThingID)
+	if err != nil {
+		return err
+	}
+
+	// The children are limited to the group of the gateway, so that the
+	// gateway can't publish on behalf of the things its editors can't access.
+	for _, id := range gw.Children {
+		if id == gw.ThingID {
+			return errors.ErrMalformedEntity
+		}
+
+		chGrID, err := ts.GetGroupIDByThingID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if chGrID != grID {
+			return errors.ErrAuthorization
+		}
+	}
+
+	return ts.gateways.Save(ctx, gw)
+}
+
+func (ts *thingsService) ViewGateway(ctx context.Context, token, thingID string) (Gateway, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Viewer,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return Gateway{}, err
+	}
+
+	return ts.gateways.RetrieveByThing(ctx, thingID)
+}
+
+func (ts *thingsService) RemoveGateway(ctx context.Context, token, thingID string) error {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return err
+	}
+
+	return ts.gateways.Remove(ctx, thingID)
+}
+
+func (ts *thingsService) GetPubConfByGateway(ctx context.Context, key, thingID string) (PubConfInfo, error) {
+	gwID, err := ts.Identify(ctx, key)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	gw, err := ts.gateways.RetrieveByThing(ctx, gwID)
+	if err != nil {
+		if errors.Contains(err, errors.ErrNotFound) {
+			return PubConfInfo{}, errors.ErrAuthorization
+		}
+		return PubConfInfo{}, err
+	}
+
+	if !gw.HasChild(thingID) {
+		return PubConfInfo{}, errors.ErrAuthorization
+	}
+
+	// The child moved to another group is no longer published on behalf of.
+	gwGrID, err := ts.GetGroupIDByThingID(ctx, gwID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	chGrID, err := ts.GetGroupIDByThingID(ctx, thingID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	if gwGrID != chGrID {
+		return PubConfInfo{}, errors.ErrAuthorization
+	}
+
+	profile, err := ts.profiles.RetrieveByThing(ctx, thingID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	orgID, err := ts.groupOrgID(ctx, profile.GroupID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	// The messages are published from the network of the gateway, so the
+	// network ACLs of the gateway apply.
+	acls, err := ts.networkACLs(ctx, gwID, orgID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	return PubConfInfo{PublisherID: thingID, OrgID: orgID, ProfileConfig: profile.Config, NetworkACLs: acls, ProfileID: profile.ID, GroupID: profile.GroupID, ProfileVersion: profile.Version}, nil
+}
@@ -41,6 +41,11 @@ type GroupRepository interface {
 	// Update a group
 	Update(ctx context.Context, g Group) (Group, error)
 
+	// Transfer moves a group to the org identified by the group OrgID, and
+	// removes the roles of the members identified by the provided IDs in the
+	// same transaction.
+	Transfer(ctx context.Context, g Group, memberIDs ...string) (Group, error)
+
 	// Remove a groups
 	Remove(ctx context.Context, groupIDs ...string) error
 
@@ -64,6 +69,12 @@ type Groups interface {
 	// UpdateGroup updates the group identified by the provided ID.
 	UpdateGroup(ctx context.Context, token string, g Group) (Group, error)
 
+	// TransferGroup moves the group identified by the provided ID, together
+	// with its things and profiles, to another org. Entity IDs and keys are
+	// preserved, while the roles of the group members which aren't members
+	// of the other org are removed.
+	TransferGroup(ctx context.Context, token, groupID, orgID string) (Group, error)
+
 	// ViewGroup retrieves data about the group identified by ID.
 	ViewGroup(ctx context.Context, token, id string) (Group, error)
 
@@ -85,6 +96,11 @@ type Groups interface {
 	// RemoveGroups removes the groups identified with the provided IDs.
 	RemoveGroups(ctx context.Context, token string, ids ...string) error
 
+	// RemoveGroupsByOrg removes all groups of the removed org identified by the
+	// provided ID, together with their things and profiles, and returns the IDs
+	// of the removed groups.
+	RemoveGroupsByOrg(ctx context.Context, orgID string) ([]string, error)
+
 	// ViewGroupByProfile retrieves group that profile belongs to.
 	ViewGroupByProfile(ctx context.Context, token, profileID string) (Group, error)
 }
@@ -111,6 +127,9 @@ type GroupCache interface {
 
 	// GroupMemberships returns the IDs of the groups the member belongs to.
 	GroupMemberships(context.Context, string) ([]string, error)
+
+	// Flush removes all group orgs and member roles from cache.
+	Flush(context.Context) error
 }
 
 func (ts *thingsService) CreateGroups(ctx context.Context, token string, groups ...Group) ([]Group, error) {
@@ -127,6 +146,10 @@ func (ts *thingsService) CreateGroups(ctx context.Context, token string, groups
 		return []Group{}, err
 	}
 
+	if err := ts.checkOrgQuota(ctx, orgID, auth.GroupsResource, uint64(len(groups))); err != nil {
+		return []Group{}, err
+	}
+
 	grs := []Group{}
 	for _, group := range groups {
 		group.CreatedAt = timestamp
@@ -228,6 +251,35 @@ func (ts *thingsService) RemoveGroups(ctx context.Context, token string, ids ...
 	return ts.groups.Remove(ctx, ids...)
 }
 
+func (ts *thingsService) RemoveGroupsByOrg(ctx context.Context, orgID string) ([]string, error) {
+	if orgID == "" {
+		return nil, errors.ErrMalformedEntity
+	}
+
+	gp, err := ts.groups.RetrieveByAdmin(ctx, orgID, PageMetadata{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, g := range gp.Groups {
+		if err := ts.groupCache.RemoveOrg(ctx, g.ID); err != nil {
+			return nil, err
+		}
+		ids = append(ids, g.ID)
+	}
+
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	if err := ts.groups.Remove(ctx, ids...); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
 func (ts *thingsService) UpdateGroup(ctx context.Context, token string, group Group) (Group, error) {
 	ar := AuthorizeReq{
 		Token:   token,
@@ -243,6 +295,60 @@ func (ts *thingsService) UpdateGroup(ctx context.Context, token string, group Gr
 	return ts.groups.Update(ctx, group)
 }
 
+func (ts *thingsService) TransferGroup(ctx context.Context, token, groupID, orgID string) (Group, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  groupID,
+		Subject: GroupSub,
+		Action:  Owner,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return Group{}, err
+	}
+
+	if err := ts.canAccessOrg(ctx, token, orgID, auth.OrgSub, Editor); err != nil {
+		return Group{}, err
+	}
+
+	// The group roles grant access to the group regardless of its org, so
+	// the roles of the members which aren't members of the other org are
+	// removed together with the transfer.
+	removed, err := ts.nonOrgMembers(ctx, groupID, orgID)
+	if err != nil {
+		return Group{}, err
+	}
+
+	if err := ts.checkTransferQuota(ctx, groupID, orgID); err != nil {
+		return Group{}, err
+	}
+
+	group := Group{
+		ID:        groupID,
+		OrgID:     orgID,
+		UpdatedAt: getTimestmap(),
+	}
+	gr, err := ts.groups.Transfer(ctx, group, removed...)
+	if err != nil {
+		return Group{}, err
+	}
+
+	for _, memberID := range removed {
+		if err := ts.groupCache.RemoveRole(ctx, gr.ID, memberID); err != nil {
+			return Group{}, err
+		}
+	}
+
+	if err := ts.groupCache.RemoveOrg(ctx, gr.ID); err != nil {
+		return Group{}, err
+	}
+
+	if err := ts.groupCache.SaveOrg(ctx, gr.ID, gr.OrgID); err != nil {
+		return Group{}, err
+	}
+
+	return gr, nil
+}
+
 func (ts *thingsService) ViewGroup(ctx context.Context, token, groupID string) (Group, error) {
 	ar := AuthorizeReq{
 		Token:   token,
@@ -327,17 +433,9 @@ func (ts *thingsService) ViewGroupByThing(ctx context.Context, token string, thi
 }
 
 func (ts *thingsService) canAccessGroup(ctx context.Context, token, groupID, action string) error {
-	grOrgID, err := ts.groupCache.ViewOrg(ctx, groupID)
+	grOrgID, err := ts.groupOrgID(ctx, groupID)
 	if err != nil {
-		group, err := ts.groups.RetrieveByID(ctx, groupID)
-		if err != nil {
-			return err
-		}
-		grOrgID = group.OrgID
-
-		if err := ts.groupCache.SaveOrg(ctx, group.ID, group.OrgID); err != nil {
-			return err
-		}
+		return err
 	}
 
 	if err := ts.canAccessOrg(ctx, token, grOrgID, auth.OrgSub, Owner); err == nil {
@@ -389,3 +487,66 @@ func (ts *thingsService) canAccessGroup(ctx context.Context, token, groupID, act
 		return errors.ErrAuthorization
 	}
 }
+
+// nonOrgMembers returns the IDs of the members of the group identified by
+// groupID which aren't members of the org identified by orgID.
+func (ts *thingsService) nonOrgMembers(ctx context.Context, groupID, orgID string) ([]string, error) {
+	var memberIDs []string
+	pm := PageMetadata{Limit: rolesPageLimit}
+	for {
+		page, err := ts.roles.RetrieveRolesByGroup(ctx, groupID, pm)
+		if err != nil {
+			return nil, err
+		}
+		for _, gm := range page.GroupMembers {
+			memberIDs = append(memberIDs, gm.MemberID)
+		}
+
+		pm.Offset += uint64(len(page.GroupMembers))
+		if len(page.GroupMembers) == 0 || pm.Offset >= page.Total {
+			break
+		}
+	}
+
+	if len(memberIDs) == 0 {
+		return nil, nil
+	}
+
+	res, err := ts.auth.FilterOrgMembers(ctx, &protomfx.OrgMembersReq{OrgID: orgID, MemberIDs: memberIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]bool)
+	for _, id := range res.GetMemberIDs() {
+		members[id] = true
+	}
+
+	var ids []string
+	for _, id := range memberIDs {
+		if !members[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// groupOrgID returns the ID of the org the group belongs to, caching it on the first retrieval.
+func (ts *thingsService) groupOrgID(ctx context.Context, groupID string) (string, error) {
+	orgID, err := ts.groupCache.ViewOrg(ctx, groupID)
+	if err == nil {
+		return orgID, nil
+	}
+
+	group, err := ts.groups.RetrieveByID(ctx, groupID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := ts.groupCache.SaveOrg(ctx, group.ID, group.OrgID); err != nil {
+		return "", err
+	}
+
+	return group.OrgID, nil
+}
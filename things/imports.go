@@ -0,0 +1,179 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// exportPageSize is the number of the things and the profiles retrieved at
+// once while exporting the group.
+const exportPageSize = 100
+
+// GroupExport contains the profiles and the things of the group. The things
+// are connected to the profiles by their profile IDs.
+type GroupExport struct {
+	Profiles []Profile
+	Things   []Thing
+}
+
+// ImportError describes the profile or the thing which failed to be imported.
+type ImportError struct {
+	// Subject is either ProfileSub or ThingSub.
+	Subject string
+	// Index is the position of the profile or the thing in the import.
+	Index int
+	Name  string
+	Err   error
+}
+
+// ImportResult contains the imported profiles and things, along with the
+// errors of those which failed to be imported.
+type ImportResult struct {
+	Profiles []Profile
+	Things   []Thing
+	Errors   []ImportError
+}
+
+// Imports specifies an API for the bulk export and import of the groups.
+type Imports interface {
+	// ExportGroup retrieves the profiles and the things of the group
+	// identified by the provided ID, in the format imported by ImportGroup.
+	ExportGroup(ctx context.Context, token, groupID string) (GroupExport, error)
+
+	// ImportGroup creates the profiles and the things in the group identified
+	// by the provided ID, keeping their IDs and keys if provided, so that the
+	// devices migrated from another deployment keep their credentials. The
+	// things are connected to the imported profiles, or to the existing
+	// profiles of the group. The profiles and the things failing to be
+	// imported are reported, while the others are imported.
+	ImportGroup(ctx context.Context, token, groupID string, ge GroupExport) (ImportResult, error)
+}
+
+func (ts *thingsService) ExportGroup(ctx context.Context, token, groupID string) (GroupExport, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  groupID,
+		Subject: GroupSub,
+		Action:  Viewer,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return GroupExport{}, err
+	}
+
+	ge := GroupExport{
+		Profiles: []Profile{},
+		Things:   []Thing{},
+	}
+
+	for pm := (PageMetadata{Limit: exportPageSize}); ; pm.Offset += exportPageSize {
+		pp, err := ts.profiles.RetrieveByGroupIDs(ctx, []string{groupID}, pm)
+		if err != nil {
+			return GroupExport{}, err
+		}
+		ge.Profiles = append(ge.Profiles, pp.Profiles...)
+
+		if len(pp.Profiles) == 0 || pm.Offset+exportPageSize >= pp.Total {
+			break
+		}
+	}
+
+	for pm := (PageMetadata{Limit: exportPageSize}); ; pm.Offset += exportPageSize {
+		tp, err := ts.things.RetrieveByGroupIDs(ctx, []string{groupID}, pm)
+		if err != nil {
+			return GroupExport{}, err
+		}
+		ge.Things = append(ge.Things, tp.Things...)
+
+		if len(tp.Things) == 0 || pm.Offset+exportPageSize >= tp.Total {
+			break
+		}
+	}
+
+	return ge, nil
+}
+
+func (ts *thingsService) ImportGroup(ctx context.Context, token, groupID string, ge GroupExport) (ImportResult, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  groupID,
+		Subject: GroupSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return ImportResult{}, err
+	}
+
+	if err := ts.checkQuota(ctx, auth.ProfilesResource, map[string]uint64{groupID: uint64(len(ge.Profiles))}); err != nil {
+		return ImportResult{}, err
+	}
+
+	if err := ts.checkQuota(ctx, auth.ThingsResource, map[string]uint64{groupID: uint64(len(ge.Things))}); err != nil {
+		return ImportResult{}, err
+	}
+
+	res := ImportResult{
+		Profiles: []Profile{},
+		Things:   []Thing{},
+		Errors:   []ImportError{},
+	}
+
+	imported := make(map[string]bool)
+	for i, profile := range ge.Profiles {
+		profile.GroupID = groupID
+		pr, err := ts.createProfile(ctx, &profile)
+		if err != nil {
+			res.Errors = append(res.Errors, ImportError{Subject: ProfileSub, Index: i, Name: profile.Name, Err: err})
+			continue
+		}
+
+		imported[pr.ID] = true
+		res.Profiles = append(res.Profiles, pr)
+	}
+
+	for i, thing := range ge.Things {
+		thing.GroupID = groupID
+		if !imported[thing.ProfileID] {
+			if err := ts.checkProfileGroup(ctx, thing.ProfileID, groupID); err != nil {
+				res.Errors = append(res.Errors, ImportError{Subject: ThingSub, Index: i, Name: thing.Name, Err: err})
+				continue
+			}
+		}
+
+		th, err := ts.createThing(ctx, &thing)
+		if err != nil {
+			res.Errors = append(res.Errors, ImportError{Subject: ThingSub, Index: i, Name: thing.Name, Err: err})
+			continue
+		}
+
+		res.Things = append(res.Things, th)
+	}
+
+	return res, nil
+}
+
+// checkProfileGroup returns an error if the profile doesn't belong to the group.
+func (ts *thingsService) checkProfileGroup(ctx context.Context, profileID, groupID string) error {
+	prGrID, err := ts.profileCache.ViewGroup(ctx, profileID)
+	if err != nil {
+		profile, err := ts.profiles.RetrieveByID(ctx, profileID)
+		if err != nil {
+			return err
+		}
+		prGrID = profile.GroupID
+
+		if err := ts.profileCache.SaveGroup(ctx, profile.ID, profile.GroupID); err != nil {
+			return err
+		}
+	}
+
+	if prGrID != groupID {
+		return errors.ErrAuthorization
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ things.NetworkACLRepository = (*networkACLRepositoryMock)(nil)
+
+type networkACLRepositoryMock struct {
+	mu        sync.Mutex
+	thingACLs map[string]things.NetworkACL
+	orgACLs   map[string]things.NetworkACL
+}
+
+// NewNetworkACLRepository returns mock of network ACL repository
+func NewNetworkACLRepository() things.NetworkACLRepository {
+	return &networkACLRepositoryMock{
+		thingACLs: make(map[string]things.NetworkACL),
+		orgACLs:   make(map[string]things.NetworkACL),
+	}
+}
+
+func (nrm *networkACLRepositoryMock) SaveByThing(_ context.Context, thingID string, acl things.NetworkACL) error {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	saveACL(nrm.thingACLs, thingID, acl)
+	return nil
+}
+
+func (nrm *networkACLRepositoryMock) SaveByOrg(_ context.Context, orgID string, acl things.NetworkACL) error {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	saveACL(nrm.orgACLs, orgID, acl)
+	return nil
+}
+
+func (nrm *networkACLRepositoryMock) RetrieveByThings(_ context.Context, thingIDs ...string) (map[string]things.NetworkACL, error) {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	return retrieveACLs(nrm.thingACLs, thingIDs), nil
+}
+
+func (nrm *networkACLRepositoryMock) RetrieveByOrgs(_ context.Context, orgIDs ...string) (map[string]things.NetworkACL, error) {
+	nrm.mu.Lock()
+	defer nrm.mu.Unlock()
+
+	return retrieveACLs(nrm.orgACLs, orgIDs), nil
+}
+
+func saveACL(acls map[string]things.NetworkACL, id string, acl things.NetworkACL) {
+	if acl.Empty() {
+		delete(acls, id)
+		return
+	}
+	acls[id] = acl
+}
+
+func retrieveACLs(acls map[string]things.NetworkACL, ids []string) map[string]things.NetworkACL {
+	res := make(map[string]things.NetworkACL)
+	for _, id := range ids {
+		if acl, ok := acls[id]; ok {
+			res[id] = acl
+		}
+	}
+
+	return res
+}
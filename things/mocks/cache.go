@@ -90,6 +90,16 @@ func (tcm *thingCacheMock) RemoveGroup(_ context.Context, thingID string) error
 	return nil
 }
 
+func (tcm *thingCacheMock) Flush(_ context.Context) error {
+	tcm.mu.Lock()
+	defer tcm.mu.Unlock()
+
+	tcm.things = make(map[string]string)
+	tcm.groups = make(map[string]string)
+
+	return nil
+}
+
 type profileCacheMock struct {
 	mu     sync.Mutex
 	groups map[string]string
@@ -130,6 +140,14 @@ func (ccm *profileCacheMock) RemoveGroup(_ context.Context, profileID string) er
 	return nil
 }
 
+func (ccm *profileCacheMock) Flush(_ context.Context) error {
+	ccm.mu.Lock()
+	defer ccm.mu.Unlock()
+
+	ccm.groups = make(map[string]string)
+	return nil
+}
+
 type groupCacheMock struct {
 	mu    sync.Mutex
 	orgs  map[string]string
@@ -169,6 +187,12 @@ func (gcm *groupCacheMock) RemoveOrg(_ context.Context, groupID string) error {
 	defer gcm.mu.Unlock()
 
 	delete(gcm.orgs, groupID)
+	for k := range gcm.roles {
+		if strings.HasPrefix(k, groupID+":") {
+			delete(gcm.roles, k)
+		}
+	}
+
 	return nil
 }
 
@@ -219,6 +243,16 @@ func (gcm *groupCacheMock) GroupMemberships(_ context.Context, memberID string)
 	return groups, nil
 }
 
+func (gcm *groupCacheMock) Flush(_ context.Context) error {
+	gcm.mu.Lock()
+	defer gcm.mu.Unlock()
+
+	gcm.orgs = make(map[string]string)
+	gcm.roles = make(map[string]string)
+
+	return nil
+}
+
 func rKey(groupID, memberID string) string {
 	return fmt.Sprintf("%s:%s", groupID, memberID)
 }
@@ -0,0 +1,86 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ things.ConsumerGroups = (*consumerGroupsMock)(nil)
+
+type consumerGroupsMock struct {
+	mu     sync.Mutex
+	groups map[string]things.ConsumerGroup
+}
+
+// NewConsumerGroups returns mock of the event store consumer groups.
+func NewConsumerGroups(groups ...things.ConsumerGroup) things.ConsumerGroups {
+	cgm := &consumerGroupsMock{
+		groups: make(map[string]things.ConsumerGroup),
+	}
+	for _, g := range groups {
+		cgm.groups[g.Stream] = g
+	}
+
+	return cgm
+}
+
+func (cgm *consumerGroupsMock) RetrieveAll(_ context.Context) ([]things.ConsumerGroup, error) {
+	cgm.mu.Lock()
+	defer cgm.mu.Unlock()
+
+	groups := []things.ConsumerGroup{}
+	for _, g := range cgm.groups {
+		groups = append(groups, g)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Stream < groups[j].Stream
+	})
+
+	return groups, nil
+}
+
+func (cgm *consumerGroupsMock) Reset(_ context.Context, stream, offset string) error {
+	cgm.mu.Lock()
+	defer cgm.mu.Unlock()
+
+	g, ok := cgm.groups[stream]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	if offset == "$" {
+		offset = g.LastEntryID
+	}
+	g.LastDeliveredID = offset
+	cgm.groups[stream] = g
+
+	return nil
+}
+
+func (cgm *consumerGroupsMock) RetrieveOrgCleanup(_ context.Context, orgID string) (things.OrgCleanup, error) {
+	cgm.mu.Lock()
+	defer cgm.mu.Unlock()
+
+	if orgID == "" {
+		return things.OrgCleanup{}, errors.ErrNotFound
+	}
+
+	// The mock treats the last entry of the stream as the org.cleanup event.
+	oc := things.OrgCleanup{OrgID: orgID}
+	for _, g := range cgm.groups {
+		oc.Consumers = append(oc.Consumers, things.CleanupProgress{
+			Name: g.Name,
+			Done: g.LastDeliveredID == g.LastEntryID,
+		})
+	}
+
+	return oc, nil
+}
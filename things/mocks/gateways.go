@@ -0,0 +1,72 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ things.GatewayRepository = (*gatewayRepositoryMock)(nil)
+
+type gatewayRepositoryMock struct {
+	mu       sync.Mutex
+	gateways map[string][]string
+}
+
+// NewGatewayRepository returns mock of gateway repository
+func NewGatewayRepository() things.GatewayRepository {
+	return &gatewayRepositoryMock{
+		gateways: make(map[string][]string),
+	}
+}
+
+func (grm *gatewayRepositoryMock) Save(_ context.Context, gw things.Gateway) error {
+	grm.mu.Lock()
+	defer grm.mu.Unlock()
+
+	children := make([]string, 0, len(gw.Children))
+	for _, id := range gw.Children {
+		for _, ch := range children {
+			if ch == id {
+				return errors.ErrConflict
+			}
+		}
+		children = append(children, id)
+	}
+	sort.Strings(children)
+
+	if len(children) == 0 {
+		delete(grm.gateways, gw.ThingID)
+		return nil
+	}
+	grm.gateways[gw.ThingID] = children
+
+	return nil
+}
+
+func (grm *gatewayRepositoryMock) RetrieveByThing(_ context.Context, thingID string) (things.Gateway, error) {
+	grm.mu.Lock()
+	defer grm.mu.Unlock()
+
+	children, ok := grm.gateways[thingID]
+	if !ok {
+		return things.Gateway{}, errors.ErrNotFound
+	}
+
+	return things.Gateway{ThingID: thingID, Children: append([]string{}, children...)}, nil
+}
+
+func (grm *gatewayRepositoryMock) Remove(_ context.Context, thingID string) error {
+	grm.mu.Lock()
+	defer grm.mu.Unlock()
+
+	delete(grm.gateways, thingID)
+
+	return nil
+}
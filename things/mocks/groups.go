@@ -28,11 +28,15 @@ type groupRepositoryMock struct {
 	profileMembership map[string]string
 	// Map of group profile where group id is a key and profile ids are values.
 	profiles map[string][]string
+	// Roles of the group members, removed when the group is transferred.
+	roles things.RolesRepository
 }
 
-// NewGroupRepository creates in-memory user repository
-func NewGroupRepository() things.GroupRepository {
+// NewGroupRepository creates in-memory group repository, which removes the
+// roles of the transferred groups from the provided roles repository.
+func NewGroupRepository(roles things.RolesRepository) things.GroupRepository {
 	return &groupRepositoryMock{
+		roles:             roles,
 		groups:            make(map[string]things.Group),
 		thingMembership:   make(map[string]string),
 		things:            make(map[string][]string),
@@ -68,6 +72,24 @@ func (grm *groupRepositoryMock) Update(ctx context.Context, group things.Group)
 	return up, nil
 }
 
+func (grm *groupRepositoryMock) Transfer(ctx context.Context, group things.Group, memberIDs ...string) (things.Group, error) {
+	grm.mu.Lock()
+	defer grm.mu.Unlock()
+	gr, ok := grm.groups[group.ID]
+	if !ok {
+		return things.Group{}, errors.ErrNotFound
+	}
+	gr.OrgID = group.OrgID
+	gr.UpdatedAt = group.UpdatedAt
+
+	if err := grm.roles.RemoveRolesByGroup(ctx, group.ID, memberIDs...); err != nil {
+		return things.Group{}, err
+	}
+
+	grm.groups[group.ID] = gr
+	return gr, nil
+}
+
 func (grm *groupRepositoryMock) Remove(ctx context.Context, ids ...string) error {
 	grm.mu.Lock()
 	defer grm.mu.Unlock()
@@ -166,5 +188,22 @@ func (grm *groupRepositoryMock) RetrieveByIDs(ctx context.Context, ids []string,
 }
 
 func (grm *groupRepositoryMock) RetrieveByAdmin(ctx context.Context, orgID string, pm things.PageMetadata) (things.GroupPage, error) {
-	panic("not implemented")
+	grm.mu.Lock()
+	defer grm.mu.Unlock()
+
+	items := make([]things.Group, 0)
+	for _, g := range grm.groups {
+		if orgID == "" || g.OrgID == orgID {
+			items = append(items, g)
+		}
+	}
+
+	page := things.GroupPage{
+		Groups: items,
+		PageMetadata: things.PageMetadata{
+			Total: uint64(len(items)),
+		},
+	}
+
+	return page, nil
 }
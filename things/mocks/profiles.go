@@ -49,10 +49,15 @@ func (crm *profileRepositoryMock) Update(_ context.Context, profile things.Profi
 	crm.mu.Lock()
 	defer crm.mu.Unlock()
 
-	if _, ok := crm.profiles[profile.ID]; !ok {
+	pr, ok := crm.profiles[profile.ID]
+	if !ok {
 		return errors.ErrNotFound
 	}
-	profile.GroupID = crm.profiles[profile.ID].GroupID
+	if profile.Version != 0 && profile.Version != pr.Version {
+		return errors.ErrConflict
+	}
+	profile.GroupID = pr.GroupID
+	profile.Version = pr.Version + 1
 
 	crm.profiles[profile.ID] = profile
 	return nil
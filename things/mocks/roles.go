@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/MainfluxLabs/mainflux/things"
@@ -10,16 +11,16 @@ import (
 var _ things.RolesRepository = (*rolesRepositoryMock)(nil)
 
 type rolesRepositoryMock struct {
-	mu             sync.Mutex
-	groupRoles     map[string]things.GroupMember
-	groupRolesByID map[string]things.GroupMember
+	mu sync.Mutex
+	// Map of group roles, where group id is the key of the roles of the
+	// group members, keyed by member id.
+	groupRoles map[string]map[string]things.GroupMember
 }
 
 // NewRolesRepository returns mock of roles repository
 func NewRolesRepository() things.RolesRepository {
 	return &rolesRepositoryMock{
-		groupRoles:     make(map[string]things.GroupMember),
-		groupRolesByID: make(map[string]things.GroupMember),
+		groupRoles: make(map[string]map[string]things.GroupMember),
 	}
 }
 
@@ -28,8 +29,10 @@ func (mrm *rolesRepositoryMock) SaveRolesByGroup(_ context.Context, gms ...thing
 	defer mrm.mu.Unlock()
 
 	for _, g := range gms {
-		mrm.groupRoles[g.GroupID] = g
-		mrm.groupRolesByID[g.MemberID] = g
+		if _, ok := mrm.groupRoles[g.GroupID]; !ok {
+			mrm.groupRoles[g.GroupID] = make(map[string]things.GroupMember)
+		}
+		mrm.groupRoles[g.GroupID][g.MemberID] = g
 	}
 
 	return nil
@@ -39,11 +42,39 @@ func (mrm *rolesRepositoryMock) RetrieveRole(_ context.Context, gp things.GroupM
 	mrm.mu.Lock()
 	defer mrm.mu.Unlock()
 
-	return mrm.groupRolesByID[gp.MemberID].Role, nil
+	return mrm.groupRoles[gp.GroupID][gp.MemberID].Role, nil
 }
 
 func (mrm *rolesRepositoryMock) RetrieveRolesByGroup(_ context.Context, groupID string, pm things.PageMetadata) (things.GroupMembersPage, error) {
-	panic("not implemented")
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	var gms []things.GroupMember
+	for _, gm := range mrm.groupRoles[groupID] {
+		gms = append(gms, gm)
+	}
+	sort.Slice(gms, func(i, j int) bool {
+		return gms[i].MemberID < gms[j].MemberID
+	})
+
+	total := uint64(len(gms))
+	first := pm.Offset
+	if first > total {
+		first = total
+	}
+	last := first + pm.Limit
+	if last > total {
+		last = total
+	}
+
+	return things.GroupMembersPage{
+		GroupMembers: gms[first:last],
+		PageMetadata: things.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
 }
 
 func (mrm *rolesRepositoryMock) RetrieveGroupIDsByMember(_ context.Context, memberID string) ([]string, error) {
@@ -51,9 +82,9 @@ func (mrm *rolesRepositoryMock) RetrieveGroupIDsByMember(_ context.Context, memb
 	defer mrm.mu.Unlock()
 
 	var grIDs []string
-	for k, gr := range mrm.groupRoles {
-		if gr.MemberID == memberID {
-			grIDs = append(grIDs, k)
+	for grID, gms := range mrm.groupRoles {
+		if _, ok := gms[memberID]; ok {
+			grIDs = append(grIDs, grID)
 		}
 	}
 
@@ -65,8 +96,10 @@ func (mrm *rolesRepositoryMock) RetrieveAllRolesByGroup(_ context.Context) ([]th
 	defer mrm.mu.Unlock()
 
 	var gps []things.GroupMember
-	for _, gp := range mrm.groupRoles {
-		gps = append(gps, gp)
+	for _, gms := range mrm.groupRoles {
+		for _, gp := range gms {
+			gps = append(gps, gp)
+		}
 	}
 
 	return gps, nil
@@ -76,5 +109,12 @@ func (mrm *rolesRepositoryMock) UpdateRolesByGroup(_ context.Context, gms ...thi
 }
 
 func (mrm *rolesRepositoryMock) RemoveRolesByGroup(_ context.Context, groupID string, memberIDs ...string) error {
-	panic("not implemented")
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	for _, memberID := range memberIDs {
+		delete(mrm.groupRoles[groupID], memberID)
+	}
+
+	return nil
 }
@@ -0,0 +1,94 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ things.ShareRepository = (*shareRepositoryMock)(nil)
+
+type shareRepositoryMock struct {
+	mu     sync.Mutex
+	shares map[string]things.Share
+}
+
+// NewShareRepository returns mock of share repository
+func NewShareRepository() things.ShareRepository {
+	return &shareRepositoryMock{
+		shares: make(map[string]things.Share),
+	}
+}
+
+func (srm *shareRepositoryMock) Save(_ context.Context, sh things.Share) (things.Share, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for _, s := range srm.shares {
+		if s.ID == sh.ID || s.Key == sh.Key {
+			return things.Share{}, errors.ErrConflict
+		}
+	}
+
+	srm.shares[sh.ID] = sh
+
+	return sh, nil
+}
+
+func (srm *shareRepositoryMock) RetrieveByID(_ context.Context, id string) (things.Share, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	sh, ok := srm.shares[id]
+	if !ok {
+		return things.Share{}, errors.ErrNotFound
+	}
+
+	return sh, nil
+}
+
+func (srm *shareRepositoryMock) RetrieveByKey(_ context.Context, key string) (things.Share, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for _, sh := range srm.shares {
+		if sh.Key == key {
+			return sh, nil
+		}
+	}
+
+	return things.Share{}, errors.ErrNotFound
+}
+
+func (srm *shareRepositoryMock) RetrieveByThing(_ context.Context, thingID string) ([]things.Share, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	shs := []things.Share{}
+	for _, sh := range srm.shares {
+		if sh.ThingID == thingID {
+			shs = append(shs, sh)
+		}
+	}
+
+	sort.SliceStable(shs, func(i, j int) bool {
+		return shs[i].CreatedAt.Before(shs[j].CreatedAt)
+	})
+
+	return shs, nil
+}
+
+func (srm *shareRepositoryMock) Remove(_ context.Context, id string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	delete(srm.shares, id)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ things.OrgTemplateRepository = (*orgTemplateRepositoryMock)(nil)
+
+type orgTemplateRepositoryMock struct {
+	mu       sync.Mutex
+	template *things.OrgTemplate
+}
+
+// NewOrgTemplateRepository returns mock of org template repository
+func NewOrgTemplateRepository() things.OrgTemplateRepository {
+	return &orgTemplateRepositoryMock{}
+}
+
+func (otrm *orgTemplateRepositoryMock) Save(_ context.Context, ot things.OrgTemplate) error {
+	otrm.mu.Lock()
+	defer otrm.mu.Unlock()
+
+	otrm.template = &ot
+
+	return nil
+}
+
+func (otrm *orgTemplateRepositoryMock) Retrieve(_ context.Context) (things.OrgTemplate, error) {
+	otrm.mu.Lock()
+	defer otrm.mu.Unlock()
+
+	if otrm.template == nil {
+		return things.OrgTemplate{}, errors.ErrNotFound
+	}
+
+	return *otrm.template, nil
+}
+
+func (otrm *orgTemplateRepositoryMock) Remove(_ context.Context) error {
+	otrm.mu.Lock()
+	defer otrm.mu.Unlock()
+
+	otrm.template = nil
+
+	return nil
+}
@@ -6,6 +6,7 @@ package mocks
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -252,3 +253,39 @@ func (trm *thingRepositoryMock) RetrieveByAdmin(_ context.Context, pm things.Pag
 
 	return page, nil
 }
+
+func (trm *thingRepositoryMock) RetrieveByKeyPrefix(_ context.Context, prefix string, pm things.PageMetadata) (things.ThingsPage, error) {
+	trm.mu.Lock()
+	defer trm.mu.Unlock()
+
+	var ths []things.Thing
+	for _, th := range trm.things {
+		if strings.HasPrefix(th.Key, prefix) {
+			ths = append(ths, th)
+		}
+	}
+
+	ths = sortItems(pm, ths, func(i int) (string, string) {
+		return ths[i].Name, ths[i].ID
+	})
+
+	page := things.ThingsPage{
+		PageMetadata: things.PageMetadata{
+			Total:  uint64(len(ths)),
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	if pm.Offset < uint64(len(ths)) {
+		ths = ths[pm.Offset:]
+	} else {
+		ths = []things.Thing{}
+	}
+	if pm.Limit > 0 && pm.Limit < uint64(len(ths)) {
+		ths = ths[:pm.Limit]
+	}
+	page.Things = ths
+
+	return page, nil
+}
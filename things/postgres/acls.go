@@ -0,0 +1,148 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	thingACLsTable = "thing_network_acls"
+	thingACLsKey   = "thing_id"
+	orgACLsTable   = "org_network_acls"
+	orgACLsKey     = "org_id"
+)
+
+var _ things.NetworkACLRepository = (*networkACLRepository)(nil)
+
+type networkACLRepository struct {
+	db Database
+}
+
+// NewNetworkACLRepository instantiates a PostgreSQL implementation of network
+// ACL repository.
+func NewNetworkACLRepository(db Database) things.NetworkACLRepository {
+	return &networkACLRepository{
+		db: db,
+	}
+}
+
+func (nr networkACLRepository) SaveByThing(ctx context.Context, thingID string, acl things.NetworkACL) error {
+	return nr.save(ctx, thingACLsTable, thingACLsKey, thingID, acl)
+}
+
+func (nr networkACLRepository) SaveByOrg(ctx context.Context, orgID string, acl things.NetworkACL) error {
+	return nr.save(ctx, orgACLsTable, orgACLsKey, orgID, acl)
+}
+
+func (nr networkACLRepository) RetrieveByThings(ctx context.Context, thingIDs ...string) (map[string]things.NetworkACL, error) {
+	return nr.retrieve(ctx, thingACLsTable, thingACLsKey, thingIDs)
+}
+
+func (nr networkACLRepository) RetrieveByOrgs(ctx context.Context, orgIDs ...string) (map[string]things.NetworkACL, error) {
+	return nr.retrieve(ctx, orgACLsTable, orgACLsKey, orgIDs)
+}
+
+func (nr networkACLRepository) save(ctx context.Context, table, key, id string, acl things.NetworkACL) error {
+	if acl.Empty() {
+		q := fmt.Sprintf(`DELETE FROM %s WHERE %s = :id;`, table, key)
+		if _, err := nr.db.NamedExecContext(ctx, q, dbNetworkACL{ID: id}); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+		return nil
+	}
+
+	q := fmt.Sprintf(`INSERT INTO %s (%s, allow, deny) VALUES (:id, :allow, :deny)
+		ON CONFLICT (%s) DO UPDATE SET allow = EXCLUDED.allow, deny = EXCLUDED.deny;`, table, key, key)
+
+	dbacl, err := toDBNetworkACL(id, acl)
+	if err != nil {
+		return errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	if _, err := nr.db.NamedExecContext(ctx, q, dbacl); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.ForeignKeyViolation:
+				return errors.Wrap(errors.ErrNotFound, err)
+			}
+		}
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (nr networkACLRepository) retrieve(ctx context.Context, table, key string, ids []string) (map[string]things.NetworkACL, error) {
+	acls := make(map[string]things.NetworkACL)
+	if len(ids) == 0 {
+		return acls, nil
+	}
+
+	q := fmt.Sprintf(`SELECT %s AS id, allow, deny FROM %s WHERE %s = ANY(:ids);`, key, table, key)
+
+	rows, err := nr.db.NamedQueryContext(ctx, q, map[string]interface{}{"ids": ids})
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dbacl dbNetworkACL
+		if err := rows.StructScan(&dbacl); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		acl, err := toNetworkACL(dbacl)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		acls[dbacl.ID] = acl
+	}
+
+	return acls, nil
+}
+
+type dbNetworkACL struct {
+	ID    string `db:"id"`
+	Allow []byte `db:"allow"`
+	Deny  []byte `db:"deny"`
+}
+
+func toDBNetworkACL(id string, acl things.NetworkACL) (dbNetworkACL, error) {
+	allow, err := json.Marshal(acl.Allow)
+	if err != nil {
+		return dbNetworkACL{}, err
+	}
+
+	deny, err := json.Marshal(acl.Deny)
+	if err != nil {
+		return dbNetworkACL{}, err
+	}
+
+	return dbNetworkACL{ID: id, Allow: allow, Deny: deny}, nil
+}
+
+func toNetworkACL(dbacl dbNetworkACL) (things.NetworkACL, error) {
+	var acl things.NetworkACL
+	if err := json.Unmarshal(dbacl.Allow, &acl.Allow); err != nil {
+		return things.NetworkACL{}, err
+	}
+
+	if err := json.Unmarshal(dbacl.Deny, &acl.Deny); err != nil {
+		return things.NetworkACL{}, err
+	}
+
+	return acl, nil
+}
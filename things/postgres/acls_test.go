@@ -0,0 +1,163 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/things/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var acl = things.NetworkACL{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.1.0.0/16"}}
+
+func TestSaveNetworkACLByThing(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	aclRepo := postgres.NewNetworkACLRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+
+	cases := []struct {
+		desc    string
+		thingID string
+		acl     things.NetworkACL
+		err     error
+	}{
+		{
+			desc:    "save thing network ACL",
+			thingID: th.ID,
+			acl:     acl,
+			err:     nil,
+		},
+		{
+			desc:    "replace thing network ACL",
+			thingID: th.ID,
+			acl:     things.NetworkACL{Allow: []string{"192.168.0.0/16"}, Deny: []string{}},
+			err:     nil,
+		},
+		{
+			desc:    "save network ACL of non-existing thing",
+			thingID: wrongID,
+			acl:     acl,
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "save network ACL with invalid thing id",
+			thingID: invalidID,
+			acl:     acl,
+			err:     errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "remove thing network ACL",
+			thingID: th.ID,
+			acl:     things.NetworkACL{},
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := aclRepo.SaveByThing(context.Background(), tc.thingID, tc.acl)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+
+		acls, err := aclRepo.RetrieveByThings(context.Background(), tc.thingID)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		if tc.acl.Empty() {
+			assert.NotContains(t, acls, tc.thingID, fmt.Sprintf("%s: expected ACL to be removed\n", tc.desc))
+			continue
+		}
+		assert.Equal(t, tc.acl, acls[tc.thingID], fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.acl, acls[tc.thingID]))
+	}
+}
+
+func TestRetrieveNetworkACLsByThings(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	aclRepo := postgres.NewNetworkACLRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+	th1 := createThing(t, dbMiddleware)
+	noACL := createThing(t, dbMiddleware)
+
+	err := aclRepo.SaveByThing(context.Background(), th.ID, acl)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = aclRepo.SaveByThing(context.Background(), th1.ID, acl)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		thingIDs []string
+		size     int
+		err      error
+	}{
+		{
+			desc:     "retrieve network ACLs of things",
+			thingIDs: []string{th.ID, th1.ID},
+			size:     2,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve network ACLs of things without ACL",
+			thingIDs: []string{th.ID, noACL.ID, wrongID},
+			size:     1,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve network ACLs without things",
+			thingIDs: []string{},
+			size:     0,
+			err:      nil,
+		},
+		{
+			desc:     "retrieve network ACLs with quoted thing id",
+			thingIDs: []string{th.ID, "') OR ('1'='1"},
+			size:     0,
+			err:      errors.ErrRetrieveEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		acls, err := aclRepo.RetrieveByThings(context.Background(), tc.thingIDs...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(acls), fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.size, len(acls)))
+	}
+}
+
+func TestRetrieveNetworkACLsByOrgs(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	aclRepo := postgres.NewNetworkACLRepository(dbMiddleware)
+
+	orgID := generateUUID(t)
+	err := aclRepo.SaveByOrg(context.Background(), orgID, acl)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		orgIDs []string
+		acls   map[string]things.NetworkACL
+	}{
+		{
+			desc:   "retrieve network ACLs of orgs",
+			orgIDs: []string{orgID, wrongID},
+			acls:   map[string]things.NetworkACL{orgID: acl},
+		},
+		{
+			desc:   "retrieve network ACLs of orgs without ACL",
+			orgIDs: []string{wrongID},
+			acls:   map[string]things.NetworkACL{},
+		},
+	}
+
+	for _, tc := range cases {
+		acls, err := aclRepo.RetrieveByOrgs(context.Background(), tc.orgIDs...)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.acls, acls, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.acls, acls))
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ things.GatewayRepository = (*gatewayRepository)(nil)
+
+type gatewayRepository struct {
+	db Database
+}
+
+// NewGatewayRepository instantiates a PostgreSQL implementation of gateway
+// repository.
+func NewGatewayRepository(db Database) things.GatewayRepository {
+	return &gatewayRepository{
+		db: db,
+	}
+}
+
+func (gr gatewayRepository) Save(ctx context.Context, gw things.Gateway) error {
+	tx, err := gr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	dq := `DELETE FROM gateway_children WHERE gateway_id = :gateway_id;`
+	if _, err := tx.NamedExecContext(ctx, dq, dbGatewayChild{GatewayID: gw.ThingID}); err != nil {
+		tx.Rollback()
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO gateway_children (gateway_id, thing_id) VALUES (:gateway_id, :thing_id);`
+	for _, id := range gw.Children {
+		if _, err := tx.NamedExecContext(ctx, q, dbGatewayChild{GatewayID: gw.ThingID, ThingID: id}); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.ForeignKeyViolation:
+					return errors.Wrap(errors.ErrNotFound, err)
+				case pgerrcode.UniqueViolation:
+					return errors.Wrap(errors.ErrConflict, err)
+				}
+			}
+			return errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (gr gatewayRepository) RetrieveByThing(ctx context.Context, thingID string) (things.Gateway, error) {
+	q := `SELECT gateway_id, thing_id FROM gateway_children WHERE gateway_id = :gateway_id ORDER BY thing_id;`
+
+	rows, err := gr.db.NamedQueryContext(ctx, q, dbGatewayChild{GatewayID: thingID})
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return things.Gateway{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return things.Gateway{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	gw := things.Gateway{ThingID: thingID}
+	for rows.Next() {
+		var dbgc dbGatewayChild
+		if err := rows.StructScan(&dbgc); err != nil {
+			return things.Gateway{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		gw.Children = append(gw.Children, dbgc.ThingID)
+	}
+
+	if len(gw.Children) == 0 {
+		return things.Gateway{}, errors.ErrNotFound
+	}
+
+	return gw, nil
+}
+
+func (gr gatewayRepository) Remove(ctx context.Context, thingID string) error {
+	q := `DELETE FROM gateway_children WHERE gateway_id = :gateway_id;`
+
+	if _, err := gr.db.NamedExecContext(ctx, q, dbGatewayChild{GatewayID: thingID}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbGatewayChild struct {
+	GatewayID string `db:"gateway_id"`
+	ThingID   string `db:"thing_id"`
+}
@@ -0,0 +1,172 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/things/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveGateway(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	gatewayRepo := postgres.NewGatewayRepository(dbMiddleware)
+
+	gw := createThing(t, dbMiddleware)
+	ch := createThing(t, dbMiddleware)
+	ch1 := createThing(t, dbMiddleware)
+
+	cases := []struct {
+		desc    string
+		gateway things.Gateway
+		err     error
+	}{
+		{
+			desc:    "save gateway",
+			gateway: things.Gateway{ThingID: gw.ID, Children: []string{ch.ID, ch1.ID}},
+			err:     nil,
+		},
+		{
+			desc:    "replace gateway children",
+			gateway: things.Gateway{ThingID: gw.ID, Children: []string{ch1.ID}},
+			err:     nil,
+		},
+		{
+			desc:    "save gateway with duplicated child",
+			gateway: things.Gateway{ThingID: gw.ID, Children: []string{ch.ID, ch.ID}},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save gateway with non-existing child",
+			gateway: things.Gateway{ThingID: gw.ID, Children: []string{wrongID}},
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "save gateway of non-existing thing",
+			gateway: things.Gateway{ThingID: wrongID, Children: []string{ch.ID}},
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "save gateway with invalid child id",
+			gateway: things.Gateway{ThingID: gw.ID, Children: []string{invalidID}},
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := gatewayRepo.Save(context.Background(), tc.gateway)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	// The failed saves leave the children of the gateway untouched.
+	res, err := gatewayRepo.RetrieveByThing(context.Background(), gw.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{ch1.ID}, res.Children, fmt.Sprintf("expected children %v got %v\n", []string{ch1.ID}, res.Children))
+}
+
+func TestRetrieveGatewayByThing(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	gatewayRepo := postgres.NewGatewayRepository(dbMiddleware)
+
+	gw := createThing(t, dbMiddleware)
+	noChildren := createThing(t, dbMiddleware)
+	ch := createThing(t, dbMiddleware)
+	ch1 := createThing(t, dbMiddleware)
+
+	err := gatewayRepo.Save(context.Background(), things.Gateway{ThingID: gw.ID, Children: []string{ch.ID, ch1.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		thingID  string
+		children []string
+		err      error
+	}{
+		{
+			desc:     "retrieve gateway of the thing",
+			thingID:  gw.ID,
+			children: []string{ch.ID, ch1.ID},
+			err:      nil,
+		},
+		{
+			desc:     "retrieve gateway of the thing without children",
+			thingID:  noChildren.ID,
+			children: nil,
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "retrieve gateway of the child",
+			thingID:  ch.ID,
+			children: nil,
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "retrieve gateway with invalid thing id",
+			thingID:  invalidID,
+			children: nil,
+			err:      errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := gatewayRepo.RetrieveByThing(context.Background(), tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.ElementsMatch(t, tc.children, res.Children, fmt.Sprintf("%s: expected children %v got %v\n", tc.desc, tc.children, res.Children))
+	}
+}
+
+func TestRemoveGateway(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	gatewayRepo := postgres.NewGatewayRepository(dbMiddleware)
+	thingRepo := postgres.NewThingRepository(dbMiddleware)
+
+	gw := createThing(t, dbMiddleware)
+	gw1 := createThing(t, dbMiddleware)
+	ch := createThing(t, dbMiddleware)
+	ch1 := createThing(t, dbMiddleware)
+
+	err := gatewayRepo.Save(context.Background(), things.Gateway{ThingID: gw.ID, Children: []string{ch.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = gatewayRepo.Save(context.Background(), things.Gateway{ThingID: gw1.ID, Children: []string{ch.ID, ch1.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		thingID string
+		err     error
+	}{
+		{
+			desc:    "remove existing gateway",
+			thingID: gw.ID,
+			err:     nil,
+		},
+		{
+			desc:    "remove removed gateway",
+			thingID: gw.ID,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := gatewayRepo.Remove(context.Background(), tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = gatewayRepo.RetrieveByThing(context.Background(), tc.thingID)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+
+	// The removed child is no longer the child of its gateway.
+	err = thingRepo.Remove(context.Background(), ch.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	res, err := gatewayRepo.RetrieveByThing(context.Background(), gw1.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{ch1.ID}, res.Children, fmt.Sprintf("remove child: expected children %v got %v\n", []string{ch1.ID}, res.Children))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
 )
 
 var _ things.GroupRepository = (*groupRepository)(nil)
@@ -105,6 +106,68 @@ func (gr groupRepository) Update(ctx context.Context, g things.Group) (things.Gr
 	return toGroup(dbu)
 }
 
+func (gr groupRepository) Transfer(ctx context.Context, g things.Group, memberIDs ...string) (things.Group, error) {
+	q := `UPDATE groups SET org_id = :org_id, updated_at = :updated_at WHERE id = :id
+		  RETURNING id, name, org_id, description, metadata, created_at, updated_at`
+	qr := `DELETE FROM group_roles WHERE group_id = :group_id AND member_id = :member_id`
+
+	dbg, err := toDBGroup(g)
+	if err != nil {
+		return things.Group{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	tx, err := gr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return things.Group{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	row, err := sqlx.NamedQueryContext(ctx, tx, q, dbg)
+	if err != nil {
+		tx.Rollback()
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return things.Group{}, errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return things.Group{}, errors.Wrap(errors.ErrConflict, err)
+			}
+		}
+		return things.Group{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if !row.Next() {
+		row.Close()
+		tx.Rollback()
+		return things.Group{}, errors.ErrNotFound
+	}
+
+	dbg = dbGroup{}
+	err = row.StructScan(&dbg)
+	row.Close()
+	if err != nil {
+		tx.Rollback()
+		return things.Group{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	for _, memberID := range memberIDs {
+		dbgm := dbGroupMembers{
+			GroupID:  g.ID,
+			MemberID: memberID,
+		}
+		if _, err := tx.NamedExecContext(ctx, qr, dbgm); err != nil {
+			tx.Rollback()
+			return things.Group{}, errors.Wrap(errors.ErrUpdateEntity, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return things.Group{}, errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	return toGroup(dbg)
+}
+
 func (gr groupRepository) Remove(ctx context.Context, groupIDs ...string) error {
 	qd := `DELETE FROM groups WHERE id = :id`
 
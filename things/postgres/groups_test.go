@@ -217,6 +217,87 @@ func TestUpdateGroup(t *testing.T) {
 	}
 }
 
+func TestTransferGroup(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	groupRepo := postgres.NewGroupRepository(dbMiddleware)
+	rolesRepo := postgres.NewRolesRepository(dbMiddleware)
+
+	group := things.Group{
+		ID:    generateUUID(t),
+		Name:  groupName,
+		OrgID: generateUUID(t),
+	}
+	_, err := groupRepo.Save(context.Background(), group)
+	require.Nil(t, err, fmt.Sprintf("group save got unexpected error: %s", err))
+
+	gms := []things.GroupMember{
+		{GroupID: group.ID, MemberID: generateUUID(t), Role: things.Owner},
+		{GroupID: group.ID, MemberID: generateUUID(t), Role: things.Editor},
+		{GroupID: group.ID, MemberID: generateUUID(t), Role: things.Viewer},
+	}
+	err = rolesRepo.SaveRolesByGroup(context.Background(), gms...)
+	require.Nil(t, err, fmt.Sprintf("roles save got unexpected error: %s", err))
+
+	orgID := generateUUID(t)
+	updatedAt := time.Now().UTC().Round(time.Millisecond)
+
+	cases := []struct {
+		desc  string
+		group things.Group
+		err   error
+	}{
+		{
+			desc: "transfer group",
+			group: things.Group{
+				ID:        group.ID,
+				OrgID:     orgID,
+				UpdatedAt: updatedAt,
+			},
+			err: nil,
+		},
+		{
+			desc: "transfer non-existing group",
+			group: things.Group{
+				ID:        generateUUID(t),
+				OrgID:     orgID,
+				UpdatedAt: updatedAt,
+			},
+			err: errors.ErrNotFound,
+		},
+		{
+			desc: "transfer group with invalid org id",
+			group: things.Group{
+				ID:        group.ID,
+				OrgID:     "invalid",
+				UpdatedAt: updatedAt,
+			},
+			err: errors.ErrMalformedEntity,
+		},
+	}
+
+	// The role of the last member is removed, as if it wasn't a member of
+	// the other org.
+	removed := gms[len(gms)-1]
+	for _, tc := range cases {
+		_, err := groupRepo.Transfer(context.Background(), tc.group, removed.MemberID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	retrieved, err := groupRepo.RetrieveByID(context.Background(), group.ID)
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	assert.Equal(t, orgID, retrieved.OrgID, fmt.Sprintf("expected org id %s got %s\n", orgID, retrieved.OrgID))
+
+	for _, gm := range gms[:len(gms)-1] {
+		role, err := rolesRepo.RetrieveRole(context.Background(), gm)
+		assert.Nil(t, err, fmt.Sprintf("retrieve role of kept member: unexpected error: %s\n", err))
+		assert.Equal(t, gm.Role, role, fmt.Sprintf("retrieve role of kept member: expected %s got %s\n", gm.Role, role))
+	}
+
+	role, err := rolesRepo.RetrieveRole(context.Background(), removed)
+	assert.Nil(t, err, fmt.Sprintf("retrieve role of removed member: unexpected error: %s\n", err))
+	assert.Equal(t, "", role, fmt.Sprintf("retrieve role of removed member: expected no role got %s\n", role))
+}
+
 func TestRemoveGroup(t *testing.T) {
 	dbMiddleware := postgres.NewDatabase(db)
 	groupRepo := postgres.NewGroupRepository(dbMiddleware)
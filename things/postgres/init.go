@@ -6,6 +6,8 @@ package postgres
 import (
 	"fmt"
 
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 	migrate "github.com/rubenv/sql-migrate"
@@ -13,37 +15,45 @@ import (
 
 // Config defines the options that are used when connecting to a PostgreSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the PostgreSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate
-// failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
 		Migrations: []*migrate.Migration{
 			{
 				Id: "things_1",
@@ -172,9 +182,82 @@ func migrateDB(db *sqlx.DB) error {
 					`DROP TABLE connections`,
 				},
 			},
+			{
+				Id: "things_7",
+				Up: []string{
+					`ALTER TABLE profiles ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1`,
+				},
+				Down: []string{
+					`ALTER TABLE profiles DROP COLUMN version`,
+				},
+			},
+			dbutil.SearchMigration("things_8", "things.name", "profiles.name", "groups.name"),
+			{
+				Id: "things_9",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS shares (
+						id          UUID PRIMARY KEY,
+						thing_id    UUID NOT NULL,
+						key         VARCHAR(4096) UNIQUE NOT NULL,
+						name        VARCHAR(1024),
+						password    VARCHAR(254),
+						expires_at  TIMESTAMPTZ,
+						created_at  TIMESTAMPTZ NOT NULL,
+						FOREIGN KEY (thing_id) REFERENCES things (id) ON DELETE CASCADE ON UPDATE CASCADE
+					)`,
+				},
+				Down: []string{
+					"DROP TABLE shares",
+				},
+			},
+			{
+				Id: "things_10",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS org_template (
+						id          BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+						groups      JSONB NOT NULL,
+						updated_at  TIMESTAMPTZ NOT NULL
+					)`,
+				},
+				Down: []string{
+					"DROP TABLE org_template",
+				},
+			},
+			{
+				Id: "things_11",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS gateway_children (
+						gateway_id  UUID NOT NULL,
+						thing_id    UUID NOT NULL,
+						FOREIGN KEY (gateway_id) REFERENCES things (id) ON DELETE CASCADE ON UPDATE CASCADE,
+						FOREIGN KEY (thing_id) REFERENCES things (id) ON DELETE CASCADE ON UPDATE CASCADE,
+						PRIMARY KEY (gateway_id, thing_id)
+					)`,
+				},
+				Down: []string{
+					"DROP TABLE gateway_children",
+				},
+			},
+			{
+				Id: "things_12",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS thing_network_acls (
+						thing_id    UUID PRIMARY KEY,
+						allow       JSONB NOT NULL,
+						deny        JSONB NOT NULL,
+						FOREIGN KEY (thing_id) REFERENCES things (id) ON DELETE CASCADE ON UPDATE CASCADE
+					)`,
+					`CREATE TABLE IF NOT EXISTS org_network_acls (
+						org_id      UUID PRIMARY KEY,
+						allow       JSONB NOT NULL,
+						deny        JSONB NOT NULL
+					)`,
+				},
+				Down: []string{
+					"DROP TABLE thing_network_acls",
+					"DROP TABLE org_network_acls",
+				},
+			},
 		},
 	}
-
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
 }
@@ -39,8 +39,8 @@ func (cr profileRepository) Save(ctx context.Context, profiles ...things.Profile
 		return nil, errors.Wrap(errors.ErrCreateEntity, err)
 	}
 
-	q := `INSERT INTO profiles (id, group_id, name, metadata, config)
-		  VALUES (:id, :group_id, :name, :metadata, :config);`
+	q := `INSERT INTO profiles (id, group_id, name, metadata, config, version)
+		  VALUES (:id, :group_id, :name, :metadata, :config, GREATEST(:version, 1));`
 
 	for _, profile := range profiles {
 		dbpr := toDBProfile(profile)
@@ -71,7 +71,8 @@ func (cr profileRepository) Save(ctx context.Context, profiles ...things.Profile
 }
 
 func (cr profileRepository) Update(ctx context.Context, profile things.Profile) error {
-	q := `UPDATE profiles SET name = :name, metadata = :metadata, config = :config WHERE id = :id;`
+	q := `UPDATE profiles SET name = :name, metadata = :metadata, config = :config, version = version + 1
+		  WHERE id = :id AND (:version = 0 OR version = :version);`
 
 	dbpr := toDBProfile(profile)
 
@@ -96,14 +97,35 @@ func (cr profileRepository) Update(ctx context.Context, profile things.Profile)
 	}
 
 	if cnt == 0 {
-		return errors.ErrNotFound
+		return cr.updateError(ctx, profile)
 	}
 
 	return nil
 }
 
+// updateError returns the error of the update which affected no profile,
+// i.e. ErrConflict if the versioned profile was updated meanwhile, or
+// ErrNotFound if the profile doesn't exist.
+func (cr profileRepository) updateError(ctx context.Context, profile things.Profile) error {
+	if profile.Version == 0 {
+		return errors.ErrNotFound
+	}
+
+	var exists bool
+	q := `SELECT EXISTS (SELECT 1 FROM profiles WHERE id = $1);`
+	if err := cr.db.QueryRowxContext(ctx, q, profile.ID).Scan(&exists); err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if !exists {
+		return errors.ErrNotFound
+	}
+
+	return errors.ErrConflict
+}
+
 func (cr profileRepository) RetrieveByID(ctx context.Context, id string) (things.Profile, error) {
-	q := `SELECT group_id, name, metadata, config FROM profiles WHERE id = $1;`
+	q := `SELECT group_id, name, metadata, config, version FROM profiles WHERE id = $1;`
 
 	dbpr := dbProfile{
 		ID: id,
@@ -141,7 +163,7 @@ func (cr profileRepository) RetrieveByThing(ctx context.Context, thID string) (t
 	}
 
 	var q string
-	q = fmt.Sprintf(`SELECT pr.id, pr.group_id, pr.name, pr.metadata, pr.config
+	q = fmt.Sprintf(`SELECT pr.id, pr.group_id, pr.name, pr.metadata, pr.config, pr.version
 				FROM things ths, profiles pr
 				WHERE ths.profile_id = pr.id and ths.id = :thing;`)
 	params := map[string]interface{}{
@@ -222,10 +244,10 @@ func (cr profileRepository) retrieve(ctx context.Context, groupIDs []string, all
 		whereClause = fmt.Sprintf(" WHERE %s", strings.Join(query, " AND "))
 	}
 
-	q := fmt.Sprintf(`SELECT id, group_id, name, metadata, config FROM profiles %s ORDER BY %s %s %s;`, whereClause, oq, dq, olq)
+	q := fmt.Sprintf(`SELECT id, group_id, name, metadata, config, version FROM profiles %s ORDER BY %s %s %s;`, whereClause, oq, dq, olq)
 
 	if allRows {
-		q = "SELECT id, group_id, name, metadata, config FROM profiles"
+		q = "SELECT id, group_id, name, metadata, config, version FROM profiles"
 	}
 
 	params := map[string]interface{}{
@@ -316,6 +338,7 @@ type dbProfile struct {
 	Name     string  `db:"name"`
 	Config   dbJSONB `db:"config"`
 	Metadata dbJSONB `db:"metadata"`
+	Version  uint64  `db:"version"`
 }
 
 func toDBProfile(pr things.Profile) dbProfile {
@@ -325,6 +348,7 @@ func toDBProfile(pr things.Profile) dbProfile {
 		Name:     pr.Name,
 		Config:   pr.Config,
 		Metadata: pr.Metadata,
+		Version:  pr.Version,
 	}
 }
 
@@ -335,6 +359,7 @@ func toProfile(pr dbProfile) things.Profile {
 		Name:     pr.Name,
 		Config:   pr.Config,
 		Metadata: pr.Metadata,
+		Version:  pr.Version,
 	}
 }
 
@@ -114,6 +114,23 @@ func TestUpdateProfile(t *testing.T) {
 			},
 			err: errors.ErrNotFound,
 		},
+		{
+			desc: "update non-existing profile with version",
+			profile: things.Profile{
+				ID:      nonexistentProfileID,
+				Version: 1,
+			},
+			err: errors.ErrNotFound,
+		},
+		{
+			desc: "update profile with outdated version",
+			profile: things.Profile{
+				ID:      pr.ID,
+				Name:    profileName,
+				Version: 1,
+			},
+			err: errors.ErrConflict,
+		},
 	}
 
 	for _, tc := range cases {
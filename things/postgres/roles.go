@@ -81,7 +81,7 @@ func (pr rolesRepository) RetrieveRole(ctc context.Context, gp things.GroupMembe
 }
 
 func (pr rolesRepository) RetrieveRolesByGroup(ctx context.Context, groupID string, pm things.PageMetadata) (things.GroupMembersPage, error) {
-	q := `SELECT member_id, role FROM group_roles WHERE group_id = :group_id LIMIT :limit OFFSET :offset;`
+	q := `SELECT member_id, role FROM group_roles WHERE group_id = :group_id ORDER BY member_id LIMIT :limit OFFSET :offset;`
 
 	params := map[string]interface{}{
 		"group_id": groupID,
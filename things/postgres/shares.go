@@ -0,0 +1,148 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ things.ShareRepository = (*shareRepository)(nil)
+
+type shareRepository struct {
+	db Database
+}
+
+// NewShareRepository instantiates a PostgreSQL implementation of share
+// repository.
+func NewShareRepository(db Database) things.ShareRepository {
+	return &shareRepository{
+		db: db,
+	}
+}
+
+func (sr shareRepository) Save(ctx context.Context, sh things.Share) (things.Share, error) {
+	q := `INSERT INTO shares (id, thing_id, key, name, password, expires_at, created_at)
+		  VALUES (:id, :thing_id, :key, :name, :password, :expires_at, :created_at);`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, toDBShare(sh)); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return things.Share{}, errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.ForeignKeyViolation:
+				return things.Share{}, errors.Wrap(errors.ErrNotFound, err)
+			case pgerrcode.UniqueViolation:
+				return things.Share{}, errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return things.Share{}, errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+		}
+
+		return things.Share{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return sh, nil
+}
+
+func (sr shareRepository) RetrieveByID(ctx context.Context, id string) (things.Share, error) {
+	q := `SELECT id, thing_id, key, name, password, expires_at, created_at FROM shares WHERE id = $1;`
+
+	return sr.retrieve(ctx, q, id)
+}
+
+func (sr shareRepository) RetrieveByKey(ctx context.Context, key string) (things.Share, error) {
+	q := `SELECT id, thing_id, key, name, password, expires_at, created_at FROM shares WHERE key = $1;`
+
+	return sr.retrieve(ctx, q, key)
+}
+
+func (sr shareRepository) RetrieveByThing(ctx context.Context, thingID string) ([]things.Share, error) {
+	q := `SELECT id, thing_id, key, name, password, expires_at, created_at FROM shares WHERE thing_id = $1 ORDER BY created_at;`
+
+	var dbshs []dbShare
+	if err := sr.db.SelectContext(ctx, &dbshs, q, thingID); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return []things.Share{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return []things.Share{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	shs := []things.Share{}
+	for _, dbsh := range dbshs {
+		shs = append(shs, toShare(dbsh))
+	}
+
+	return shs, nil
+}
+
+func (sr shareRepository) Remove(ctx context.Context, id string) error {
+	q := `DELETE FROM shares WHERE id = :id;`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbShare{ID: id}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (sr shareRepository) retrieve(ctx context.Context, q, arg string) (things.Share, error) {
+	var dbsh dbShare
+	if err := sr.db.QueryRowxContext(ctx, q, arg).StructScan(&dbsh); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return things.Share{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return things.Share{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toShare(dbsh), nil
+}
+
+type dbShare struct {
+	ID        string       `db:"id"`
+	ThingID   string       `db:"thing_id"`
+	Key       string       `db:"key"`
+	Name      string       `db:"name"`
+	Password  string       `db:"password"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+func toDBShare(sh things.Share) dbShare {
+	return dbShare{
+		ID:        sh.ID,
+		ThingID:   sh.ThingID,
+		Key:       sh.Key,
+		Name:      sh.Name,
+		Password:  sh.Password,
+		ExpiresAt: sql.NullTime{Time: sh.ExpiresAt, Valid: !sh.ExpiresAt.IsZero()},
+		CreatedAt: sh.CreatedAt,
+	}
+}
+
+func toShare(dbsh dbShare) things.Share {
+	sh := things.Share{
+		ID:        dbsh.ID,
+		ThingID:   dbsh.ThingID,
+		Key:       dbsh.Key,
+		Name:      dbsh.Name,
+		Password:  dbsh.Password,
+		CreatedAt: dbsh.CreatedAt,
+	}
+	if dbsh.ExpiresAt.Valid {
+		sh.ExpiresAt = dbsh.ExpiresAt.Time
+	}
+
+	return sh
+}
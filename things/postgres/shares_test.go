@@ -0,0 +1,258 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/things/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const shareName = "test-share"
+
+func newShare(t *testing.T, thingID string) things.Share {
+	return things.Share{
+		ID:        generateUUID(t),
+		ThingID:   thingID,
+		Key:       generateUUID(t),
+		Name:      shareName,
+		ExpiresAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestSaveShare(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	shareRepo := postgres.NewShareRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+	sh := newShare(t, th.ID)
+
+	withKey := newShare(t, th.ID)
+	withKey.Key = sh.Key
+
+	cases := []struct {
+		desc  string
+		share things.Share
+		err   error
+	}{
+		{
+			desc:  "save share",
+			share: sh,
+			err:   nil,
+		},
+		{
+			desc:  "save share without expiration",
+			share: things.Share{ID: generateUUID(t), ThingID: th.ID, Key: generateUUID(t), CreatedAt: time.Now()},
+			err:   nil,
+		},
+		{
+			desc:  "save share with existing key",
+			share: withKey,
+			err:   errors.ErrConflict,
+		},
+		{
+			desc:  "save share of non-existing thing",
+			share: newShare(t, wrongID),
+			err:   errors.ErrNotFound,
+		},
+		{
+			desc:  "save share with invalid thing id",
+			share: newShare(t, invalidID),
+			err:   errors.ErrMalformedEntity,
+		},
+		{
+			desc:  "save share with invalid name",
+			share: things.Share{ID: generateUUID(t), ThingID: th.ID, Key: generateUUID(t), Name: invalidName, CreatedAt: time.Now()},
+			err:   errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := shareRepo.Save(context.Background(), tc.share)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveShareByID(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	shareRepo := postgres.NewShareRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+	sh, err := shareRepo.Save(context.Background(), newShare(t, th.ID))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "retrieve existing share",
+			id:   sh.ID,
+			err:  nil,
+		},
+		{
+			desc: "retrieve non-existing share",
+			id:   wrongID,
+			err:  errors.ErrNotFound,
+		},
+		{
+			desc: "retrieve share with invalid id",
+			id:   invalidID,
+			err:  errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := shareRepo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, sh.Key, res.Key, fmt.Sprintf("%s: expected key %s got %s\n", tc.desc, sh.Key, res.Key))
+			assert.Equal(t, sh.ThingID, res.ThingID, fmt.Sprintf("%s: expected thing %s got %s\n", tc.desc, sh.ThingID, res.ThingID))
+			assert.WithinDuration(t, sh.ExpiresAt, res.ExpiresAt, time.Millisecond, fmt.Sprintf("%s: expected expiration %s got %s\n", tc.desc, sh.ExpiresAt, res.ExpiresAt))
+		}
+	}
+}
+
+func TestRetrieveShareByKey(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	shareRepo := postgres.NewShareRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+	sh, err := shareRepo.Save(context.Background(), things.Share{ID: generateUUID(t), ThingID: th.ID, Key: generateUUID(t), CreatedAt: time.Now()})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		key  string
+		err  error
+	}{
+		{
+			desc: "retrieve share by existing key",
+			key:  sh.Key,
+			err:  nil,
+		},
+		{
+			desc: "retrieve share by non-existing key",
+			key:  wrongID,
+			err:  errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := shareRepo.RetrieveByKey(context.Background(), tc.key)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if tc.err == nil {
+			assert.Equal(t, sh.ID, res.ID, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, sh.ID, res.ID))
+			assert.True(t, res.ExpiresAt.IsZero(), fmt.Sprintf("%s: expected share without expiration got %s\n", tc.desc, res.ExpiresAt))
+		}
+	}
+}
+
+func TestRetrieveSharesByThing(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	shareRepo := postgres.NewShareRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+	noShares := createThing(t, dbMiddleware)
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		sh := newShare(t, th.ID)
+		sh.CreatedAt = time.Now().Add(time.Duration(i) * time.Second)
+		_, err := shareRepo.Save(context.Background(), sh)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		ids = append(ids, sh.ID)
+	}
+
+	cases := []struct {
+		desc    string
+		thingID string
+		ids     []string
+		err     error
+	}{
+		{
+			desc:    "retrieve shares of the thing",
+			thingID: th.ID,
+			ids:     ids,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve shares of the thing without shares",
+			thingID: noShares.ID,
+			ids:     []string{},
+			err:     nil,
+		},
+		{
+			desc:    "retrieve shares with invalid thing id",
+			thingID: invalidID,
+			ids:     []string{},
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		shs, err := shareRepo.RetrieveByThing(context.Background(), tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		// The shares are ordered by their creation time.
+		res := []string{}
+		for _, sh := range shs {
+			res = append(res, sh.ID)
+		}
+		assert.Equal(t, tc.ids, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.ids, res))
+	}
+}
+
+func TestRemoveShare(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	shareRepo := postgres.NewShareRepository(dbMiddleware)
+	thingRepo := postgres.NewThingRepository(dbMiddleware)
+
+	th := createThing(t, dbMiddleware)
+	sh, err := shareRepo.Save(context.Background(), newShare(t, th.ID))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	removed, err := shareRepo.Save(context.Background(), newShare(t, th.ID))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing share",
+			id:   removed.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed share",
+			id:   removed.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := shareRepo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = shareRepo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+
+	// The shares are removed together with their thing.
+	err = thingRepo.Remove(context.Background(), th.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	_, err = shareRepo.RetrieveByID(context.Background(), sh.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("remove thing: expected %s got %s\n", errors.ErrNotFound, err))
+}
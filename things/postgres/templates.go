@@ -0,0 +1,153 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+)
+
+var _ things.OrgTemplateRepository = (*orgTemplateRepository)(nil)
+
+type orgTemplateRepository struct {
+	db Database
+}
+
+// NewOrgTemplateRepository instantiates a PostgreSQL implementation of org
+// template repository.
+func NewOrgTemplateRepository(db Database) things.OrgTemplateRepository {
+	return &orgTemplateRepository{
+		db: db,
+	}
+}
+
+func (otr orgTemplateRepository) Save(ctx context.Context, ot things.OrgTemplate) error {
+	q := `INSERT INTO org_template (groups, updated_at) VALUES (:groups, :updated_at)
+		  ON CONFLICT (id) DO UPDATE SET groups = EXCLUDED.groups, updated_at = EXCLUDED.updated_at;`
+
+	dbot, err := toDBOrgTemplate(ot)
+	if err != nil {
+		return errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	if _, err := otr.db.NamedExecContext(ctx, q, dbot); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (otr orgTemplateRepository) Retrieve(ctx context.Context) (things.OrgTemplate, error) {
+	q := `SELECT groups, updated_at FROM org_template;`
+
+	var dbot dbOrgTemplate
+	if err := otr.db.QueryRowxContext(ctx, q).StructScan(&dbot); err != nil {
+		if err == sql.ErrNoRows {
+			return things.OrgTemplate{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return things.OrgTemplate{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	ot, err := toOrgTemplate(dbot)
+	if err != nil {
+		return things.OrgTemplate{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return ot, nil
+}
+
+func (otr orgTemplateRepository) Remove(ctx context.Context) error {
+	q := `DELETE FROM org_template;`
+
+	if _, err := otr.db.NamedExecContext(ctx, q, map[string]interface{}{}); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbOrgTemplate struct {
+	Groups    []byte    `db:"groups"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type dbGroupTemplate struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Profiles    []dbProfileTemplate    `json:"profiles,omitempty"`
+}
+
+type dbProfileTemplate struct {
+	Name     string                 `json:"name"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func toDBOrgTemplate(ot things.OrgTemplate) (dbOrgTemplate, error) {
+	dbgts := []dbGroupTemplate{}
+	for _, gt := range ot.Groups {
+		dbpts := []dbProfileTemplate{}
+		for _, pt := range gt.Profiles {
+			dbpts = append(dbpts, dbProfileTemplate{
+				Name:     pt.Name,
+				Config:   pt.Config,
+				Metadata: pt.Metadata,
+			})
+		}
+
+		dbgts = append(dbgts, dbGroupTemplate{
+			Name:        gt.Name,
+			Description: gt.Description,
+			Metadata:    gt.Metadata,
+			Profiles:    dbpts,
+		})
+	}
+
+	groups, err := json.Marshal(dbgts)
+	if err != nil {
+		return dbOrgTemplate{}, err
+	}
+
+	return dbOrgTemplate{
+		Groups:    groups,
+		UpdatedAt: ot.UpdatedAt,
+	}, nil
+}
+
+func toOrgTemplate(dbot dbOrgTemplate) (things.OrgTemplate, error) {
+	var dbgts []dbGroupTemplate
+	if err := json.Unmarshal(dbot.Groups, &dbgts); err != nil {
+		return things.OrgTemplate{}, err
+	}
+
+	gts := []things.GroupTemplate{}
+	for _, dbgt := range dbgts {
+		pts := []things.ProfileTemplate{}
+		for _, dbpt := range dbgt.Profiles {
+			pts = append(pts, things.ProfileTemplate{
+				Name:     dbpt.Name,
+				Config:   dbpt.Config,
+				Metadata: dbpt.Metadata,
+			})
+		}
+
+		gts = append(gts, things.GroupTemplate{
+			Name:        dbgt.Name,
+			Description: dbgt.Description,
+			Metadata:    dbgt.Metadata,
+			Profiles:    pts,
+		})
+	}
+
+	return things.OrgTemplate{
+		Groups:    gts,
+		UpdatedAt: dbot.UpdatedAt,
+	}, nil
+}
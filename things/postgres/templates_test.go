@@ -0,0 +1,119 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/things/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var orgTemplate = things.OrgTemplate{
+	Groups: []things.GroupTemplate{
+		{
+			Name:        groupName,
+			Description: description,
+			Metadata:    metadata,
+			Profiles: []things.ProfileTemplate{
+				{
+					Name:     profileName,
+					Config:   map[string]interface{}{"contentType": "application/senml+json", "write": true},
+					Metadata: map[string]interface{}{"field": "value"},
+				},
+			},
+		},
+		{
+			Name:     "other-group",
+			Profiles: []things.ProfileTemplate{},
+		},
+	},
+}
+
+func TestSaveOrgTemplate(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	templateRepo := postgres.NewOrgTemplateRepository(dbMiddleware)
+
+	err := templateRepo.Remove(context.Background())
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	replaced := things.OrgTemplate{
+		Groups:    []things.GroupTemplate{{Name: "replaced-group", Profiles: []things.ProfileTemplate{}}},
+		UpdatedAt: time.Now().Add(time.Minute).UTC().Truncate(time.Millisecond),
+	}
+
+	ot := orgTemplate
+	ot.UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+
+	cases := []struct {
+		desc     string
+		template things.OrgTemplate
+		err      error
+	}{
+		{
+			desc:     "save org template",
+			template: ot,
+			err:      nil,
+		},
+		{
+			desc:     "replace org template",
+			template: replaced,
+			err:      nil,
+		},
+		{
+			desc:     "save org template without groups",
+			template: things.OrgTemplate{Groups: []things.GroupTemplate{}, UpdatedAt: ot.UpdatedAt},
+			err:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := templateRepo.Save(context.Background(), tc.template)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		// The org has a single template, replaced on every save.
+		res, err := templateRepo.Retrieve(context.Background())
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.template.Groups, res.Groups, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.template.Groups, res.Groups))
+		assert.True(t, tc.template.UpdatedAt.Equal(res.UpdatedAt), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.template.UpdatedAt, res.UpdatedAt))
+	}
+}
+
+func TestRemoveOrgTemplate(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	templateRepo := postgres.NewOrgTemplateRepository(dbMiddleware)
+
+	ot := orgTemplate
+	ot.UpdatedAt = time.Now()
+	err := templateRepo.Save(context.Background(), ot)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		err  error
+	}{
+		{
+			desc: "remove org template",
+			err:  nil,
+		},
+		{
+			desc: "remove removed org template",
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := templateRepo.Remove(context.Background())
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = templateRepo.Retrieve(context.Background())
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
@@ -202,6 +202,61 @@ func (tr thingRepository) RetrieveByAdmin(ctx context.Context, pm things.PageMet
 	return tr.retrieve(ctx, []string{}, false, pm)
 }
 
+func (tr thingRepository) RetrieveByKeyPrefix(ctx context.Context, prefix string, pm things.PageMetadata) (things.ThingsPage, error) {
+	kq, key := dbutil.GetPrefixQuery("key", prefix)
+	if kq == "" {
+		return things.ThingsPage{}, nil
+	}
+
+	oq := dbutil.GetOrderQuery(pm.Order)
+	dq := dbutil.GetDirQuery(pm.Dir)
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+
+	q := fmt.Sprintf(`SELECT id, group_id, profile_id, name, key, metadata FROM things WHERE %s ORDER BY %s %s %s;`, kq, oq, dq, olq)
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM things WHERE %s;`, kq)
+
+	params := map[string]interface{}{
+		"key":    key,
+		"limit":  pm.Limit,
+		"offset": pm.Offset,
+	}
+
+	rows, err := tr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return things.ThingsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []things.Thing
+	for rows.Next() {
+		dbth := dbThing{}
+		if err := rows.StructScan(&dbth); err != nil {
+			return things.ThingsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		th, err := toThing(dbth)
+		if err != nil {
+			return things.ThingsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		items = append(items, th)
+	}
+
+	total, err := total(ctx, tr.db, cq, params)
+	if err != nil {
+		return things.ThingsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return things.ThingsPage{
+		Things: items,
+		PageMetadata: things.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
 func (tr thingRepository) RetrieveByProfile(ctx context.Context, prID string, pm things.PageMetadata) (things.ThingsPage, error) {
 	oq := dbutil.GetOrderQuery(pm.Order)
 	dq := dbutil.GetDirQuery(pm.Dir)
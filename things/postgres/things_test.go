@@ -390,6 +390,84 @@ func TestRetrieveByKey(t *testing.T) {
 	}
 }
 
+func TestRetrieveByKeyPrefix(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	thingRepo := postgres.NewThingRepository(dbMiddleware)
+	profileRepo := postgres.NewProfileRepository(dbMiddleware)
+
+	group := createGroup(t, dbMiddleware)
+	prID := generateUUID(t)
+
+	p := things.Profile{
+		ID:      prID,
+		GroupID: group.ID,
+		Name:    profileName,
+	}
+	_, err := profileRepo.Save(context.Background(), p)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	prefix := generateUUID(t)[:8]
+	var ths []things.Thing
+	for _, key := range []string{prefix + "-first", prefix + "-second", "%" + prefix} {
+		ths = append(ths, things.Thing{
+			ID:        generateUUID(t),
+			GroupID:   group.ID,
+			ProfileID: prID,
+			Name:      thingName,
+			Key:       key,
+		})
+	}
+
+	_, err = thingRepo.Save(context.Background(), ths...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := map[string]struct {
+		prefix string
+		size   uint64
+		total  uint64
+		limit  uint64
+	}{
+		"retrieve things by key prefix": {
+			prefix: prefix,
+			size:   2,
+			total:  2,
+			limit:  10,
+		},
+		"retrieve things by key prefix with limit": {
+			prefix: prefix,
+			size:   1,
+			total:  2,
+			limit:  1,
+		},
+		"retrieve things by full key": {
+			prefix: prefix + "-first",
+			size:   1,
+			total:  1,
+			limit:  10,
+		},
+		"retrieve things by key prefix matching literally": {
+			prefix: "%",
+			size:   1,
+			total:  1,
+			limit:  10,
+		},
+		"retrieve things by non-existent key prefix": {
+			prefix: wrongID,
+			size:   0,
+			total:  0,
+			limit:  10,
+		},
+	}
+
+	for desc, tc := range cases {
+		page, err := thingRepo.RetrieveByKeyPrefix(context.Background(), tc.prefix, things.PageMetadata{Limit: tc.limit})
+		size := uint64(len(page.Things))
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", desc, err))
+		assert.Equal(t, tc.size, size, fmt.Sprintf("%s: expected size %d got %d\n", desc, tc.size, size))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", desc, tc.total, page.Total))
+	}
+}
+
 func TestRetrieveThingsByGroupIDs(t *testing.T) {
 	dbMiddleware := postgres.NewDatabase(db)
 	err := cleanTestTable(context.Background(), "things", dbMiddleware)
@@ -793,6 +871,26 @@ func testSortThings(t *testing.T, pm things.PageMetadata, ths []things.Thing) {
 	}
 }
 
+func createThing(t *testing.T, dbMiddleware postgres.Database) things.Thing {
+	thingRepo := postgres.NewThingRepository(dbMiddleware)
+	profileRepo := postgres.NewProfileRepository(dbMiddleware)
+
+	group := createGroup(t, dbMiddleware)
+	prs, err := profileRepo.Save(context.Background(), things.Profile{ID: generateUUID(t), GroupID: group.ID, Name: profileName})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	ths, err := thingRepo.Save(context.Background(), things.Thing{
+		ID:        generateUUID(t),
+		GroupID:   group.ID,
+		ProfileID: prs[0].ID,
+		Name:      thingName,
+		Key:       generateUUID(t),
+	})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	return ths[0]
+}
+
 func cleanTestTable(ctx context.Context, table string, db postgres.Database) error {
 	q := fmt.Sprintf(`DELETE FROM %s CASCADE;`, table)
 	_, err := db.NamedExecContext(ctx, q, map[string]interface{}{})
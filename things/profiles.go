@@ -7,6 +7,9 @@ import (
 	"context"
 )
 
+// initialVersion is the version of the created profiles.
+const initialVersion = 1
+
 // Profile represents a Mainflux "communication group". This group contains the
 // things that can exchange messages between each other.
 type Profile struct {
@@ -15,6 +18,9 @@ type Profile struct {
 	Name     string
 	Config   map[string]interface{}
 	Metadata map[string]interface{}
+	// Version is incremented on each profile update. Updates specifying
+	// a version fail with ErrConflict if the profile was updated meanwhile.
+	Version uint64
 }
 
 type Config struct {
@@ -24,6 +30,41 @@ type Config struct {
 	Transformer Transformer `json:"transformer"`
 	SmtpID      string      `json:"smtp_id"`
 	SmppID      string      `json:"smpp_id"`
+	// Writers contains the names of the writers which persist the messages.
+	// The messages are persisted by all writers if no writer is specified.
+	Writers []string `json:"writers,omitempty"`
+	// ContentEncoding is the compression of the payloads exchanged with the
+	// things over MQTT, i.e. gzip or zstd. The payloads are uncompressed if
+	// no encoding is specified.
+	ContentEncoding string `json:"content_encoding,omitempty"`
+	// MQTT contains the MQTT session parameters applied to the things by
+	// the MQTT adapter at CONNECT. The adapter defaults are used if no
+	// parameters are specified.
+	MQTT MQTTConfig `json:"mqtt"`
+	// Schema is the JSON schema the JSON and SenML payloads of the profile
+	// things are validated against by the adapters. The payloads aren't
+	// validated if no schema is specified.
+	Schema map[string]interface{} `json:"schema,omitempty"`
+}
+
+// MQTTConfig contains the MQTT session parameters of the profile things. The
+// zero values leave the parameters unlimited.
+type MQTTConfig struct {
+	// KeepAlive is the keep alive in seconds which replaces the one sent
+	// by the client. The client is disconnected once silent for one and a
+	// half times the keep alive.
+	KeepAlive uint32 `json:"keep_alive,omitempty"`
+	// MaxInflight is the number of the QoS 1 and 2 messages delivered to
+	// the client awaiting its acknowledgement, over which the delivery of
+	// the further messages is held.
+	MaxInflight uint32 `json:"max_inflight,omitempty"`
+	// ReceiveMaximum is the number of the QoS 1 and 2 messages published by
+	// the client awaiting the acknowledgement, over which the client is
+	// disconnected.
+	ReceiveMaximum uint32 `json:"receive_maximum,omitempty"`
+	// MaxPacketSize is the size of the packet in bytes, over which the
+	// client is disconnected, and the messages aren't delivered to it.
+	MaxPacketSize uint32 `json:"max_packet_size,omitempty"`
 }
 
 type Transformer struct {
@@ -32,6 +73,13 @@ type Transformer struct {
 	TimeField    string   `json:"time_field"`
 	TimeFormat   string   `json:"time_format"`
 	TimeLocation string   `json:"time_location"`
+	// Units maps the units of the SenML records to the units their values
+	// are converted to before the records are stored, e.g. degF to Cel.
+	Units map[string]string `json:"units,omitempty"`
+	// Name is the registered name of the transformer which decodes the
+	// payload, e.g. cbor. The transformer of the profile content type is
+	// used if no name is specified.
+	Name string `json:"name,omitempty"`
 }
 
 type Notifier struct {
@@ -96,4 +144,7 @@ type ProfileCache interface {
 
 	// ViewGroup returns group ID by given profile ID.
 	ViewGroup(context.Context, string) (string, error)
+
+	// Flush removes all profiles from cache.
+	Flush(context.Context) error
 }
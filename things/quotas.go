@@ -0,0 +1,96 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// checkQuota returns ErrQuotaExceeded if any org can't have more of the
+// resources created within its groups. The numbers of the resources being
+// created are mapped by the group IDs.
+func (ts *thingsService) checkQuota(ctx context.Context, resource string, counts map[string]uint64) error {
+	orgCounts := make(map[string]uint64)
+	for grID, count := range counts {
+		orgID, err := ts.groupOrgID(ctx, grID)
+		if err != nil {
+			return err
+		}
+		orgCounts[orgID] += count
+	}
+
+	for orgID, count := range orgCounts {
+		if err := ts.checkOrgQuota(ctx, orgID, resource, count); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ts *thingsService) checkOrgQuota(ctx context.Context, orgID, resource string, count uint64) error {
+	st, err := ts.GetOrgStats(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	var usage uint64
+	switch resource {
+	case auth.ThingsResource:
+		usage = st.Things
+	case auth.ProfilesResource:
+		usage = st.Profiles
+	case auth.GroupsResource:
+		usage = st.Groups
+	}
+
+	req := &protomfx.CheckQuotaReq{OrgID: orgID, Resource: resource, Usage: usage, Count: count}
+	if _, err := ts.auth.CheckQuota(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkTransferQuota returns ErrQuotaExceeded if the org can't have the
+// group, together with its things and profiles, transferred to it.
+func (ts *thingsService) checkTransferQuota(ctx context.Context, groupID, orgID string) error {
+	grOrgID, err := ts.groupOrgID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if grOrgID == orgID {
+		return nil
+	}
+
+	// Only the totals are needed, so a single entity is retrieved per page.
+	pm := PageMetadata{Limit: 1}
+
+	tp, err := ts.things.RetrieveByGroupIDs(ctx, []string{groupID}, pm)
+	if err != nil {
+		return err
+	}
+
+	pp, err := ts.profiles.RetrieveByGroupIDs(ctx, []string{groupID}, pm)
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]uint64{
+		auth.GroupsResource:   1,
+		auth.ThingsResource:   tp.Total,
+		auth.ProfilesResource: pp.Total,
+	}
+	for resource, count := range counts {
+		if err := ts.checkOrgQuota(ctx, orgID, resource, count); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
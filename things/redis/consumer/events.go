@@ -0,0 +1,22 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+type createOrgEvent struct {
+	id      string
+	ownerID string
+}
+
+type removeOrgEvent struct {
+	id string
+}
+
+type removeUserEvent struct {
+	id string
+}
+
+type expireMemberEvent struct {
+	orgID    string
+	memberID string
+}
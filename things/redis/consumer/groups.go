@@ -0,0 +1,136 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	noSuchKey = "ERR no such key"
+	noGroup   = "NOGROUP"
+	noStream  = "ERR The XGROUP subcommand requires the key to exist"
+)
+
+var _ things.ConsumerGroups = (*consumerGroups)(nil)
+
+type consumerGroups struct {
+	client *redis.Client
+}
+
+// NewConsumerGroups returns the consumer groups of the streams consumed by
+// the things service.
+func NewConsumerGroups(client *redis.Client) things.ConsumerGroups {
+	return consumerGroups{
+		client: client,
+	}
+}
+
+func (cg consumerGroups) RetrieveAll(ctx context.Context) ([]things.ConsumerGroup, error) {
+	groups, err := cg.client.XInfoGroups(ctx, stream).Result()
+	// The stream is created together with the group, once the service
+	// subscribes to it.
+	if err != nil && strings.HasPrefix(err.Error(), noSuchKey) {
+		return []things.ConsumerGroup{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	info, err := cg.client.XInfoStream(ctx, stream).Result()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	cgs := []things.ConsumerGroup{}
+	for _, g := range groups {
+		if g.Name != group {
+			continue
+		}
+
+		cgs = append(cgs, things.ConsumerGroup{
+			Stream:          stream,
+			Name:            g.Name,
+			Consumers:       g.Consumers,
+			Pending:         g.Pending,
+			LastDeliveredID: g.LastDeliveredID,
+			StreamLength:    info.Length,
+			LastEntryID:     info.LastGeneratedID,
+		})
+	}
+
+	return cgs, nil
+}
+
+func (cg consumerGroups) Reset(ctx context.Context, str, offset string) error {
+	if str != stream {
+		return errors.ErrNotFound
+	}
+
+	err := cg.client.XGroupSetID(ctx, stream, group, offset).Err()
+	if err != nil && (strings.HasPrefix(err.Error(), noGroup) || strings.HasPrefix(err.Error(), noStream)) {
+		return errors.Wrap(errors.ErrNotFound, err)
+	}
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (cg consumerGroups) RetrieveOrgCleanup(ctx context.Context, orgID string) (things.OrgCleanup, error) {
+	rec, err := cg.client.HGetAll(ctx, cleanupPrefix+orgID).Result()
+	if err != nil {
+		return things.OrgCleanup{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	eventID, ok := rec["event_id"]
+	if !ok {
+		return things.OrgCleanup{}, errors.ErrNotFound
+	}
+	groups, _ := strconv.ParseUint(rec["groups"], 10, 64)
+
+	cgs, err := cg.client.XInfoGroups(ctx, thingsStream).Result()
+	if err != nil {
+		return things.OrgCleanup{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	oc := things.OrgCleanup{
+		OrgID:     orgID,
+		Groups:    groups,
+		StartedAt: events.OccurredAt(eventID),
+		Consumers: []things.CleanupProgress{},
+	}
+	for _, g := range cgs {
+		// The consumer group is done once the org.cleanup event is delivered
+		// to it and none of the events up to it is pending.
+		done := events.CompareIDs(g.LastDeliveredID, eventID) >= 0
+		if done {
+			pending, err := cg.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+				Stream: thingsStream,
+				Group:  g.Name,
+				Start:  "-",
+				End:    eventID,
+				Count:  1,
+			}).Result()
+			if err != nil {
+				return things.OrgCleanup{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+			}
+			done = len(pending) == 0
+		}
+
+		oc.Consumers = append(oc.Consumers, things.CleanupProgress{
+			Name: g.Name,
+			Done: done,
+		})
+	}
+
+	return oc, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	stream = "mainflux.auth"
+	group  = "mainflux.things"
+
+	thingsStream    = "mainflux.things"
+	thingsStreamLen = 1000
+
+	// cleanupPrefix is the prefix of the key of the org cleanup record, which
+	// expires after the cleanupTTL.
+	cleanupPrefix = "org_cleanup:"
+	cleanupTTL    = 7 * 24 * time.Hour
+
+	orgPrefix  = "org."
+	orgCreate  = orgPrefix + "create"
+	orgRemove  = orgPrefix + "remove"
+	orgCleanup = orgPrefix + "cleanup"
+
+	memberExpire = orgPrefix + "member.expire"
+
+	userPrefix = "user."
+	userRemove = userPrefix + "remove"
+)
+
+// Subscriber represents event source for auth events.
+type Subscriber interface {
+	// Subscribes to the auth event stream until the context is canceled.
+	Subscribe(ctx context.Context) error
+}
+
+type eventStore struct {
+	svc      things.Service
+	client   *redis.Client
+	consumer string
+	logger   logger.Logger
+}
+
+// NewEventStore returns new event store instance.
+func NewEventStore(svc things.Service, client *redis.Client, consumer string, log logger.Logger) Subscriber {
+	return eventStore{
+		svc:      svc,
+		client:   client,
+		consumer: consumer,
+		logger:   log,
+	}
+}
+
+func (es eventStore) Subscribe(ctx context.Context) error {
+	return events.NewSubscriber(es.client, group, es.consumer, []string{stream}, es.logger).Subscribe(ctx, es.handle)
+}
+
+func (es eventStore) handle(ctx context.Context, event events.Event) error {
+	switch event.Operation {
+	case orgCreate:
+		coe := decodeCreateOrg(event.Values)
+		return es.handleCreateOrg(ctx, coe)
+	case orgRemove:
+		roe := decodeRemoveOrg(event.Values)
+		return es.handleRemoveOrg(ctx, roe)
+	case userRemove:
+		rue := decodeRemoveUser(event.Values)
+		return es.handleRemoveUser(ctx, rue)
+	case memberExpire:
+		mee := decodeExpireMember(event.Values)
+		return es.handleExpireMember(ctx, mee)
+	}
+
+	return nil
+}
+
+func decodeCreateOrg(event map[string]interface{}) createOrgEvent {
+	return createOrgEvent{
+		id:      read(event, "id", ""),
+		ownerID: read(event, "owner_id", ""),
+	}
+}
+
+func decodeRemoveOrg(event map[string]interface{}) removeOrgEvent {
+	return removeOrgEvent{
+		id: read(event, "id", ""),
+	}
+}
+
+func decodeRemoveUser(event map[string]interface{}) removeUserEvent {
+	return removeUserEvent{
+		id: read(event, "id", ""),
+	}
+}
+
+func decodeExpireMember(event map[string]interface{}) expireMemberEvent {
+	return expireMemberEvent{
+		orgID:    read(event, "org_id", ""),
+		memberID: read(event, "member_id", ""),
+	}
+}
+
+func (es eventStore) handleCreateOrg(ctx context.Context, coe createOrgEvent) error {
+	grs, err := es.svc.ProvisionOrg(ctx, coe.id, coe.ownerID)
+	if err != nil {
+		return err
+	}
+
+	if len(grs) > 0 {
+		es.logger.Info(fmt.Sprintf("Provisioned %d groups of the created org %s", len(grs), coe.id))
+	}
+	return nil
+}
+
+func (es eventStore) handleRemoveOrg(ctx context.Context, roe removeOrgEvent) error {
+	es.logger.Info(fmt.Sprintf("Removing groups of the removed org %s", roe.id))
+
+	ids, err := es.svc.RemoveGroupsByOrg(ctx, roe.id)
+	if err != nil {
+		return err
+	}
+
+	es.logger.Info(fmt.Sprintf("Removed %d groups of the removed org %s", len(ids), roe.id))
+
+	if err := es.svc.RemoveOrgACL(ctx, roe.id); err != nil {
+		return err
+	}
+
+	return es.startCleanup(ctx, roe.id, len(ids))
+}
+
+// startCleanup publishes the org.cleanup event after the group.remove events
+// of the removed org, and records its ID, so that the progress of the cleanup
+// by the other services can be tracked.
+func (es eventStore) startCleanup(ctx context.Context, orgID string, groups int) error {
+	id, err := es.client.XAdd(ctx, &redis.XAddArgs{
+		Stream:       thingsStream,
+		MaxLenApprox: thingsStreamLen,
+		Values: map[string]interface{}{
+			"id":        orgID,
+			"groups":    groups,
+			"operation": orgCleanup,
+		},
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	key := cleanupPrefix + orgID
+	if err := es.client.HSet(ctx, key, "event_id", id, "groups", groups).Err(); err != nil {
+		return err
+	}
+
+	return es.client.Expire(ctx, key, cleanupTTL).Err()
+}
+
+func (es eventStore) handleRemoveUser(ctx context.Context, rue removeUserEvent) error {
+	ids, err := es.svc.RemoveRolesByMember(ctx, rue.id)
+	if err != nil {
+		return err
+	}
+
+	es.logger.Info(fmt.Sprintf("Removed roles of the removed user %s in %d groups", rue.id, len(ids)))
+	return nil
+}
+
+func (es eventStore) handleExpireMember(ctx context.Context, mee expireMemberEvent) error {
+	ids, err := es.svc.RemoveRolesByOrgMember(ctx, mee.orgID, mee.memberID)
+	if err != nil {
+		return err
+	}
+
+	es.logger.Info(fmt.Sprintf("Removed roles of the expired member %s of org %s in %d groups", mee.memberID, mee.orgID, len(ids)))
+	return nil
+}
+
+func read(event map[string]interface{}, key, def string) string {
+	val, ok := event[key].(string)
+	if !ok {
+		return def
+	}
+
+	return val
+}
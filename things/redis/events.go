@@ -1,30 +1,48 @@
 package redis
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+)
 
 const (
-	thingPrefix = "thing."
-	thingCreate = thingPrefix + "create"
-	thingUpdate = thingPrefix + "update"
-	thingRemove = thingPrefix + "remove"
+	thingPrefix    = "thing."
+	thingCreate    = thingPrefix + "create"
+	thingUpdate    = thingPrefix + "update"
+	thingUpdateKey = thingPrefix + "update_key"
+	thingRemove    = thingPrefix + "remove"
+	thingUpdateACL = thingPrefix + "update_network_acl"
 
 	profilePrefix = "profile."
 	profileCreate = profilePrefix + "create"
 	profileUpdate = profilePrefix + "update"
 	profileRemove = profilePrefix + "remove"
-)
 
-type event interface {
-	Encode() map[string]interface{}
-}
+	groupPrefix   = "group."
+	groupRemove   = groupPrefix + "remove"
+	groupTransfer = groupPrefix + "transfer"
+
+	orgPrefix    = "org."
+	orgUpdateACL = orgPrefix + "update_network_acl"
+
+	sharePrefix = "share."
+	shareRemove = sharePrefix + "remove"
+)
 
 var (
-	_ event = (*createThingEvent)(nil)
-	_ event = (*updateThingEvent)(nil)
-	_ event = (*removeThingEvent)(nil)
-	_ event = (*createProfileEvent)(nil)
-	_ event = (*updateProfileEvent)(nil)
-	_ event = (*removeProfileEvent)(nil)
+	_ events.Encoder = (*createThingEvent)(nil)
+	_ events.Encoder = (*updateThingEvent)(nil)
+	_ events.Encoder = (*updateKeyEvent)(nil)
+	_ events.Encoder = (*removeThingEvent)(nil)
+	_ events.Encoder = (*createProfileEvent)(nil)
+	_ events.Encoder = (*updateProfileEvent)(nil)
+	_ events.Encoder = (*removeProfileEvent)(nil)
+	_ events.Encoder = (*removeGroupEvent)(nil)
+	_ events.Encoder = (*transferGroupEvent)(nil)
+	_ events.Encoder = (*updateThingACLEvent)(nil)
+	_ events.Encoder = (*updateOrgACLEvent)(nil)
+	_ events.Encoder = (*removeShareEvent)(nil)
 )
 
 type createThingEvent struct {
@@ -89,6 +107,17 @@ func (ute updateThingEvent) Encode() map[string]interface{} {
 	return val
 }
 
+type updateKeyEvent struct {
+	id string
+}
+
+func (uke updateKeyEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        uke.id,
+		"operation": thingUpdateKey,
+	}
+}
+
 type removeThingEvent struct {
 	id string
 }
@@ -168,3 +197,62 @@ func (rce removeProfileEvent) Encode() map[string]interface{} {
 		"operation": profileRemove,
 	}
 }
+
+type removeGroupEvent struct {
+	id string
+}
+
+func (rge removeGroupEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        rge.id,
+		"operation": groupRemove,
+	}
+}
+
+type transferGroupEvent struct {
+	id    string
+	orgID string
+}
+
+func (tge transferGroupEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        tge.id,
+		"org_id":    tge.orgID,
+		"operation": groupTransfer,
+	}
+}
+
+type updateThingACLEvent struct {
+	id string
+}
+
+func (utae updateThingACLEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        utae.id,
+		"operation": thingUpdateACL,
+	}
+}
+
+type updateOrgACLEvent struct {
+	id string
+}
+
+func (uoae updateOrgACLEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        uoae.id,
+		"operation": orgUpdateACL,
+	}
+}
+
+type removeShareEvent struct {
+	id      string
+	thingID string
+}
+
+func (rse removeShareEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"id":        rse.id,
+		"thing_id":  rse.thingID,
+		"operation": shareRemove,
+	}
+}
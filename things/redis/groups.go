@@ -144,6 +144,10 @@ func (gc *groupCache) GroupMemberships(ctx context.Context, memberID string) ([]
 	return groups, nil
 }
 
+func (gc *groupCache) Flush(ctx context.Context) error {
+	return flush(ctx, gc.client, orgByGroupPrefix, membersByGroupPrefix, groupsByMemberPrefix)
+}
+
 func orgByGroupIDKey(groupID string) string {
 	return fmt.Sprintf("%s:%s", orgByGroupPrefix, groupID)
 }
@@ -73,6 +73,10 @@ func (pc *profileCache) ViewGroup(ctx context.Context, profileID string) (string
 	return groupID, nil
 }
 
+func (pc *profileCache) Flush(ctx context.Context) error {
+	return flush(ctx, pc.client, groupByProfilePrefix, profilesByGroupPrefix)
+}
+
 func groupByProfileIDKey(profileID string) string {
 	return fmt.Sprintf("%s:%s", groupByProfilePrefix, profileID)
 }
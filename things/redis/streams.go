@@ -6,6 +6,7 @@ package redis
 import (
 	"context"
 
+	"github.com/MainfluxLabs/mainflux/pkg/events"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/go-redis/redis/v8"
 )
@@ -48,7 +49,7 @@ func (es eventStore) CreateThings(ctx context.Context, token string, ths ...thin
 		record := &redis.XAddArgs{
 			Stream:       streamID,
 			MaxLenApprox: streamLen,
-			Values:       event.Encode(),
+			Values:       events.Encode(ctx, event),
 		}
 		es.client.XAdd(ctx, record).Err()
 	}
@@ -70,18 +71,31 @@ func (es eventStore) UpdateThing(ctx context.Context, token string, thing things
 	record := &redis.XAddArgs{
 		Stream:       streamID,
 		MaxLenApprox: streamLen,
-		Values:       event.Encode(),
+		Values:       events.Encode(ctx, event),
 	}
 	es.client.XAdd(ctx, record).Err()
 
 	return nil
 }
 
-// UpdateKey doesn't send event because key shouldn't be sent over stream.
-// Maybe we can start publishing this event at some point, without key value
-// in order to notify adapters to disconnect connected things after key update.
+// UpdateKey sends event without the key value, because key shouldn't be sent
+// over stream. The event notifies adapters to invalidate the cached thing key.
 func (es eventStore) UpdateKey(ctx context.Context, token, id, key string) error {
-	return es.svc.UpdateKey(ctx, token, id, key)
+	if err := es.svc.UpdateKey(ctx, token, id, key); err != nil {
+		return err
+	}
+
+	event := updateKeyEvent{
+		id: id,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return nil
 }
 
 func (es eventStore) ViewThing(ctx context.Context, token, id string) (things.Thing, error) {
@@ -96,6 +110,10 @@ func (es eventStore) ListThings(ctx context.Context, token string, pm things.Pag
 	return es.svc.ListThings(ctx, token, pm)
 }
 
+func (es eventStore) ListThingsByKeyPrefix(ctx context.Context, token, prefix string, pm things.PageMetadata) (things.ThingsPage, error) {
+	return es.svc.ListThingsByKeyPrefix(ctx, token, prefix, pm)
+}
+
 func (es eventStore) ListThingsByProfile(ctx context.Context, token, prID string, pm things.PageMetadata) (things.ThingsPage, error) {
 	return es.svc.ListThingsByProfile(ctx, token, prID, pm)
 }
@@ -108,6 +126,26 @@ func (es eventStore) Restore(ctx context.Context, token string, backup things.Ba
 	return es.svc.Restore(ctx, token, backup)
 }
 
+func (es eventStore) ListGroupAccess(ctx context.Context, token string) ([]things.GroupAccess, error) {
+	return es.svc.ListGroupAccess(ctx, token)
+}
+
+func (es eventStore) RebuildCache(ctx context.Context, token string) (things.CacheStats, error) {
+	return es.svc.RebuildCache(ctx, token)
+}
+
+func (es eventStore) ListConsumerGroups(ctx context.Context, token string) ([]things.ConsumerGroup, error) {
+	return es.svc.ListConsumerGroups(ctx, token)
+}
+
+func (es eventStore) ResetConsumerGroup(ctx context.Context, token, stream, offset string) error {
+	return es.svc.ResetConsumerGroup(ctx, token, stream, offset)
+}
+
+func (es eventStore) ViewOrgCleanup(ctx context.Context, token, orgID string) (things.OrgCleanup, error) {
+	return es.svc.ViewOrgCleanup(ctx, token, orgID)
+}
+
 func (es eventStore) RemoveThings(ctx context.Context, token string, ids ...string) error {
 	for _, id := range ids {
 		if err := es.svc.RemoveThings(ctx, token, id); err != nil {
@@ -120,7 +158,7 @@ func (es eventStore) RemoveThings(ctx context.Context, token string, ids ...stri
 		record := &redis.XAddArgs{
 			Stream:       streamID,
 			MaxLenApprox: streamLen,
-			Values:       event.Encode(),
+			Values:       events.Encode(ctx, event),
 		}
 		es.client.XAdd(ctx, record).Err()
 	}
@@ -144,7 +182,7 @@ func (es eventStore) CreateProfiles(ctx context.Context, token string, profiles
 		record := &redis.XAddArgs{
 			Stream:       streamID,
 			MaxLenApprox: streamLen,
-			Values:       event.Encode(),
+			Values:       events.Encode(ctx, event),
 		}
 		es.client.XAdd(ctx, record).Err()
 	}
@@ -165,7 +203,7 @@ func (es eventStore) UpdateProfile(ctx context.Context, token string, profile th
 	record := &redis.XAddArgs{
 		Stream:       streamID,
 		MaxLenApprox: streamLen,
-		Values:       event.Encode(),
+		Values:       events.Encode(ctx, event),
 	}
 	es.client.XAdd(ctx, record).Err()
 
@@ -196,7 +234,7 @@ func (es eventStore) RemoveProfiles(ctx context.Context, token string, ids ...st
 		record := &redis.XAddArgs{
 			Stream:       streamID,
 			MaxLenApprox: streamLen,
-			Values:       event.Encode(),
+			Values:       events.Encode(ctx, event),
 		}
 		es.client.XAdd(ctx, record).Err()
 
@@ -209,7 +247,11 @@ func (es eventStore) GetPubConfByKey(ctx context.Context, key string) (things.Pu
 	return es.svc.GetPubConfByKey(ctx, key)
 }
 
-func (es eventStore) GetConfigByThingID(ctx context.Context, thingID string) (map[string]interface{}, error) {
+func (es eventStore) GetPubConfs(ctx context.Context, pm things.PageMetadata) (things.PubConfsPage, error) {
+	return es.svc.GetPubConfs(ctx, pm)
+}
+
+func (es eventStore) GetConfigByThingID(ctx context.Context, thingID string) (things.Profile, error) {
 	return es.svc.GetConfigByThingID(ctx, thingID)
 }
 
@@ -225,6 +267,14 @@ func (es eventStore) GetGroupIDByThingID(ctx context.Context, thingID string) (s
 	return es.svc.GetGroupIDByThingID(ctx, thingID)
 }
 
+func (es eventStore) GetStats(ctx context.Context) (things.Stats, error) {
+	return es.svc.GetStats(ctx)
+}
+
+func (es eventStore) GetOrgStats(ctx context.Context, orgID string) (things.Stats, error) {
+	return es.svc.GetOrgStats(ctx, orgID)
+}
+
 func (es eventStore) ListThingsByGroup(ctx context.Context, token, groupID string, pm things.PageMetadata) (things.ThingsPage, error) {
 	return es.svc.ListThingsByGroup(ctx, token, groupID, pm)
 }
@@ -246,13 +296,64 @@ func (es eventStore) ListGroupsByIDs(ctx context.Context, groupIDs []string) ([]
 }
 
 func (es eventStore) RemoveGroups(ctx context.Context, token string, ids ...string) error {
-	return es.svc.RemoveGroups(ctx, token, ids...)
+	if err := es.svc.RemoveGroups(ctx, token, ids...); err != nil {
+		return err
+	}
+
+	es.removeGroups(ctx, ids...)
+
+	return nil
+}
+
+func (es eventStore) RemoveGroupsByOrg(ctx context.Context, orgID string) ([]string, error) {
+	ids, err := es.svc.RemoveGroupsByOrg(ctx, orgID)
+	if err != nil {
+		return ids, err
+	}
+
+	es.removeGroups(ctx, ids...)
+
+	return ids, nil
+}
+
+func (es eventStore) removeGroups(ctx context.Context, ids ...string) {
+	for _, id := range ids {
+		event := removeGroupEvent{
+			id: id,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
 }
 
 func (es eventStore) UpdateGroup(ctx context.Context, token string, group things.Group) (things.Group, error) {
 	return es.svc.UpdateGroup(ctx, token, group)
 }
 
+func (es eventStore) TransferGroup(ctx context.Context, token, groupID, orgID string) (things.Group, error) {
+	gr, err := es.svc.TransferGroup(ctx, token, groupID, orgID)
+	if err != nil {
+		return gr, err
+	}
+
+	event := transferGroupEvent{
+		id:    gr.ID,
+		orgID: gr.OrgID,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return gr, nil
+}
+
 func (es eventStore) ViewGroup(ctx context.Context, token, id string) (things.Group, error) {
 	return es.svc.ViewGroup(ctx, token, id)
 }
@@ -280,3 +381,166 @@ func (es eventStore) UpdateRolesByGroup(ctx context.Context, token string, gms .
 func (es eventStore) RemoveRolesByGroup(ctx context.Context, token, groupID string, memberIDs ...string) error {
 	return es.svc.RemoveRolesByGroup(ctx, token, groupID, memberIDs...)
 }
+
+func (es eventStore) RemoveRolesByMember(ctx context.Context, memberID string) ([]string, error) {
+	return es.svc.RemoveRolesByMember(ctx, memberID)
+}
+
+func (es eventStore) RemoveRolesByOrgMember(ctx context.Context, orgID, memberID string) ([]string, error) {
+	return es.svc.RemoveRolesByOrgMember(ctx, orgID, memberID)
+}
+
+func (es eventStore) CreateShare(ctx context.Context, token, thingID string, sh things.Share) (things.Share, error) {
+	return es.svc.CreateShare(ctx, token, thingID, sh)
+}
+
+func (es eventStore) ListSharesByThing(ctx context.Context, token, thingID string) ([]things.Share, error) {
+	return es.svc.ListSharesByThing(ctx, token, thingID)
+}
+
+func (es eventStore) RemoveShare(ctx context.Context, token, thingID, shareID string) error {
+	if err := es.svc.RemoveShare(ctx, token, thingID, shareID); err != nil {
+		return err
+	}
+
+	event := removeShareEvent{
+		id:      shareID,
+		thingID: thingID,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return nil
+}
+
+func (es eventStore) GetPubConfByShare(ctx context.Context, key, password string) (things.PubConfInfo, error) {
+	return es.svc.GetPubConfByShare(ctx, key, password)
+}
+
+func (es eventStore) UpdateGateway(ctx context.Context, token string, gw things.Gateway) error {
+	return es.svc.UpdateGateway(ctx, token, gw)
+}
+
+func (es eventStore) ViewGateway(ctx context.Context, token, thingID string) (things.Gateway, error) {
+	return es.svc.ViewGateway(ctx, token, thingID)
+}
+
+func (es eventStore) RemoveGateway(ctx context.Context, token, thingID string) error {
+	return es.svc.RemoveGateway(ctx, token, thingID)
+}
+
+func (es eventStore) GetPubConfByGateway(ctx context.Context, key, thingID string) (things.PubConfInfo, error) {
+	return es.svc.GetPubConfByGateway(ctx, key, thingID)
+}
+
+func (es eventStore) UpdateThingACL(ctx context.Context, token, thingID string, acl things.NetworkACL) error {
+	if err := es.svc.UpdateThingACL(ctx, token, thingID, acl); err != nil {
+		return err
+	}
+
+	event := updateThingACLEvent{
+		id: thingID,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return nil
+}
+
+func (es eventStore) ViewThingACL(ctx context.Context, token, thingID string) (things.NetworkACL, error) {
+	return es.svc.ViewThingACL(ctx, token, thingID)
+}
+
+func (es eventStore) UpdateOrgACL(ctx context.Context, token, orgID string, acl things.NetworkACL) error {
+	if err := es.svc.UpdateOrgACL(ctx, token, orgID, acl); err != nil {
+		return err
+	}
+
+	event := updateOrgACLEvent{
+		id: orgID,
+	}
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, event),
+	}
+	es.client.XAdd(ctx, record).Err()
+
+	return nil
+}
+
+func (es eventStore) ViewOrgACL(ctx context.Context, token, orgID string) (things.NetworkACL, error) {
+	return es.svc.ViewOrgACL(ctx, token, orgID)
+}
+
+func (es eventStore) RemoveOrgACL(ctx context.Context, orgID string) error {
+	return es.svc.RemoveOrgACL(ctx, orgID)
+}
+
+func (es eventStore) UpdateOrgTemplate(ctx context.Context, token string, ot things.OrgTemplate) error {
+	return es.svc.UpdateOrgTemplate(ctx, token, ot)
+}
+
+func (es eventStore) ViewOrgTemplate(ctx context.Context, token string) (things.OrgTemplate, error) {
+	return es.svc.ViewOrgTemplate(ctx, token)
+}
+
+func (es eventStore) RemoveOrgTemplate(ctx context.Context, token string) error {
+	return es.svc.RemoveOrgTemplate(ctx, token)
+}
+
+func (es eventStore) ProvisionOrg(ctx context.Context, orgID, ownerID string) ([]things.Group, error) {
+	return es.svc.ProvisionOrg(ctx, orgID, ownerID)
+}
+
+func (es eventStore) ExportGroup(ctx context.Context, token, groupID string) (things.GroupExport, error) {
+	return es.svc.ExportGroup(ctx, token, groupID)
+}
+
+func (es eventStore) ImportGroup(ctx context.Context, token, groupID string, ge things.GroupExport) (things.ImportResult, error) {
+	ir, err := es.svc.ImportGroup(ctx, token, groupID, ge)
+	if err != nil {
+		return ir, err
+	}
+
+	for _, profile := range ir.Profiles {
+		event := createProfileEvent{
+			id:       profile.ID,
+			groupID:  profile.GroupID,
+			name:     profile.Name,
+			metadata: profile.Metadata,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
+
+	for _, thing := range ir.Things {
+		event := createThingEvent{
+			id:        thing.ID,
+			groupID:   thing.GroupID,
+			profileID: thing.ProfileID,
+			name:      thing.Name,
+			metadata:  thing.Metadata,
+		}
+		record := &redis.XAddArgs{
+			Stream:       streamID,
+			MaxLenApprox: streamLen,
+			Values:       events.Encode(ctx, event),
+		}
+		es.client.XAdd(ctx, record).Err()
+	}
+
+	return ir, nil
+}
@@ -34,12 +34,16 @@ const (
 	thingPrefix    = "thing."
 	thingCreate    = thingPrefix + "create"
 	thingUpdate    = thingPrefix + "update"
+	thingUpdateKey = thingPrefix + "update_key"
 	thingRemove    = thingPrefix + "remove"
 
 	profilePrefix = "profile."
 	profileCreate = profilePrefix + "create"
 	profileUpdate = profilePrefix + "update"
 	profileRemove = profilePrefix + "remove"
+
+	sharePrefix = "share."
+	shareRemove = sharePrefix + "remove"
 )
 
 var (
@@ -55,14 +59,19 @@ func newService(tokens map[string]string) things.Service {
 	auth := mocks.NewAuthService("", usersList)
 	thingsRepo := thmocks.NewThingRepository()
 	profilesRepo := thmocks.NewProfileRepository(thingsRepo)
-	groupsRepo := thmocks.NewGroupRepository()
 	rolesRepo := thmocks.NewRolesRepository()
+	groupsRepo := thmocks.NewGroupRepository(rolesRepo)
+	sharesRepo := thmocks.NewShareRepository()
+	orgTemplatesRepo := thmocks.NewOrgTemplateRepository()
+	gatewaysRepo := thmocks.NewGatewayRepository()
+	aclsRepo := thmocks.NewNetworkACLRepository()
 	profileCache := thmocks.NewProfileCache()
 	thingCache := thmocks.NewThingCache()
 	groupCache := thmocks.NewGroupCache()
+	consumers := thmocks.NewConsumerGroups()
 	idProvider := uuid.NewMock()
 
-	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, profileCache, thingCache, groupCache, idProvider)
+	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, sharesRepo, orgTemplatesRepo, gatewaysRepo, aclsRepo, profileCache, thingCache, groupCache, consumers, idProvider)
 }
 
 func TestCreateThings(t *testing.T) {
@@ -206,6 +215,73 @@ func TestUpdateThing(t *testing.T) {
 	}
 }
 
+func TestUpdateKey(t *testing.T) {
+	_ = redisClient.FlushAll(context.Background()).Err()
+
+	svc := newService(map[string]string{token: email})
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	prID := prs[0].ID
+
+	// Create thing without sending event.
+	sths, err := svc.CreateThings(context.Background(), token, things.Thing{Name: "a", GroupID: grID, ProfileID: prID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error %s", err))
+	sth := sths[0]
+
+	svc = redis.NewEventStoreMiddleware(svc, redisClient)
+
+	cases := []struct {
+		desc  string
+		id    string
+		key   string
+		err   error
+		event map[string]interface{}
+	}{
+		{
+			desc: "update key of existing thing successfully",
+			id:   sth.ID,
+			key:  "new-key",
+			err:  nil,
+			event: map[string]interface{}{
+				"id":        sth.ID,
+				"operation": thingUpdateKey,
+			},
+		},
+		{
+			desc:  "update key of non-existent thing",
+			id:    strconv.FormatUint(math.MaxUint64, 10),
+			key:   "other-key",
+			err:   errors.ErrNotFound,
+			event: nil,
+		},
+	}
+
+	lastID := "0"
+	for _, tc := range cases {
+		err := svc.UpdateKey(context.Background(), token, tc.id, tc.key)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		streams := redisClient.XRead(context.Background(), &r.XReadArgs{
+			Streams: []string{streamID, lastID},
+			Count:   1,
+			Block:   time.Second,
+		}).Val()
+
+		var event map[string]interface{}
+		if len(streams) > 0 && len(streams[0].Messages) > 0 {
+			msg := streams[0].Messages[0]
+			event = msg.Values
+			lastID = msg.ID
+		}
+
+		assert.Equal(t, tc.event, event, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.event, event))
+	}
+}
+
 func TestViewThing(t *testing.T) {
 	_ = redisClient.FlushAll(context.Background()).Err()
 
@@ -598,3 +674,74 @@ func TestRemoveProfile(t *testing.T) {
 		assert.Equal(t, tc.event, event, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.event, event))
 	}
 }
+
+func TestRemoveShare(t *testing.T) {
+	_ = redisClient.FlushAll(context.Background()).Err()
+
+	svc := newService(map[string]string{token: email})
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	ths, err := svc.CreateThings(context.Background(), token, things.Thing{Name: "a", GroupID: grID, ProfileID: prs[0].ID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	th := ths[0]
+
+	// Create share without sending event.
+	sh, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{Name: "share"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	svc = redis.NewEventStoreMiddleware(svc, redisClient)
+
+	cases := []struct {
+		desc  string
+		id    string
+		key   string
+		err   error
+		event map[string]interface{}
+	}{
+		{
+			desc: "remove existing share successfully",
+			id:   sh.ID,
+			key:  token,
+			err:  nil,
+			event: map[string]interface{}{
+				"id":        sh.ID,
+				"thing_id":  th.ID,
+				"operation": shareRemove,
+			},
+		},
+		{
+			desc:  "remove non-existent share",
+			id:    strconv.FormatUint(math.MaxUint64, 10),
+			key:   token,
+			err:   errors.ErrNotFound,
+			event: nil,
+		},
+	}
+
+	lastID := "0"
+	for _, tc := range cases {
+		err := svc.RemoveShare(context.Background(), tc.key, th.ID, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		streams := redisClient.XRead(context.Background(), &r.XReadArgs{
+			Streams: []string{streamID, lastID},
+			Count:   1,
+			Block:   time.Second,
+		}).Val()
+
+		var event map[string]interface{}
+		if len(streams) > 0 && len(streams[0].Messages) > 0 {
+			msg := streams[0].Messages[0]
+			event = msg.Values
+			lastID = msg.ID
+		}
+
+		assert.Equal(t, tc.event, event, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.event, event))
+	}
+}
@@ -17,6 +17,8 @@ const (
 	idByKeyPrefix       = "id_by_key"
 	groupByThingPrefix  = "gr_by_th"
 	thingsByGroupPrefix = "ths_by_gr"
+
+	flushBatchSize = 1000
 )
 
 var _ things.ThingCache = (*thingCache)(nil)
@@ -120,6 +122,42 @@ func (tc *thingCache) RemoveGroup(ctx context.Context, thingID string) error {
 	return nil
 }
 
+func (tc *thingCache) Flush(ctx context.Context) error {
+	return flush(ctx, tc.client, keyByIDPrefix, idByKeyPrefix, groupByThingPrefix, thingsByGroupPrefix)
+}
+
+// flush removes all keys with the given prefixes. The keys are scanned in
+// batches, so that the flush doesn't block the other cache clients.
+func flush(ctx context.Context, client *redis.Client, prefixes ...string) error {
+	for _, prefix := range prefixes {
+		iter := client.Scan(ctx, 0, fmt.Sprintf("%s:*", prefix), flushBatchSize).Iterator()
+
+		keys := []string{}
+		for iter.Next(ctx) {
+			keys = append(keys, iter.Val())
+			if len(keys) < flushBatchSize {
+				continue
+			}
+
+			if err := client.Unlink(ctx, keys...).Err(); err != nil {
+				return errors.Wrap(errors.ErrRemoveEntity, err)
+			}
+			keys = keys[:0]
+		}
+		if err := iter.Err(); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+
+		if len(keys) > 0 {
+			if err := client.Unlink(ctx, keys...).Err(); err != nil {
+				return errors.Wrap(errors.ErrRemoveEntity, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func idByThingKeyKey(thingKey string) string {
 	return fmt.Sprintf("%s:%s", idByKeyPrefix, thingKey)
 }
@@ -120,3 +120,24 @@ func TestThingRemove(t *testing.T) {
 	}
 
 }
+
+func TestThingFlush(t *testing.T) {
+	thingCache := redis.NewThingCache(redisClient)
+
+	keys := []string{}
+	for i := 0; i < 10; i++ {
+		key, err := idProvider.ID()
+		require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+		err = thingCache.Save(context.Background(), key, fmt.Sprintf("%d", i))
+		require.Nil(t, err, fmt.Sprintf("Save thing to cache: expected nil got %s", err))
+		keys = append(keys, key)
+	}
+
+	err := thingCache.Flush(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("Flush things from cache: expected nil got %s", err))
+
+	for _, key := range keys {
+		_, err := thingCache.ID(context.Background(), key)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("Get ID of flushed thing: expected %s got %s\n", errors.ErrNotFound, err))
+	}
+}
@@ -19,6 +19,10 @@ type GroupMembersPage struct {
 	GroupMembers []GroupMember
 }
 
+// rolesPageLimit is the number of group roles retrieved at once when all of
+// the roles of a group are listed.
+const rolesPageLimit = 100
+
 type RolesRepository interface {
 	// SaveRolesByGroup saves group roles by group ID.
 	SaveRolesByGroup(ctx context.Context, gms ...GroupMember) error
@@ -54,6 +58,14 @@ type Roles interface {
 
 	// RemoveRolesByGroup removes roles of the group identified by the provided ID.
 	RemoveRolesByGroup(ctx context.Context, token, groupID string, memberIDs ...string) error
+
+	// RemoveRolesByMember removes the roles of the deleted member identified by
+	// the provided ID in all groups, and returns the IDs of the groups.
+	RemoveRolesByMember(ctx context.Context, memberID string) ([]string, error)
+
+	// RemoveRolesByOrgMember removes the roles of the member identified by the
+	// provided ID in the groups of the org, and returns the IDs of the groups.
+	RemoveRolesByOrgMember(ctx context.Context, orgID, memberID string) ([]string, error)
 }
 
 func (ts *thingsService) CreateRolesByGroup(ctx context.Context, token string, gms ...GroupMember) error {
@@ -222,3 +234,59 @@ func (ts *thingsService) RemoveRolesByGroup(ctx context.Context, token, groupID
 
 	return nil
 }
+
+func (ts *thingsService) RemoveRolesByMember(ctx context.Context, memberID string) ([]string, error) {
+	if memberID == "" {
+		return nil, errors.ErrMalformedEntity
+	}
+
+	grIDs, err := ts.roles.RetrieveGroupIDsByMember(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grID := range grIDs {
+		if err := ts.roles.RemoveRolesByGroup(ctx, grID, memberID); err != nil {
+			return nil, err
+		}
+
+		if err := ts.groupCache.RemoveRole(ctx, grID, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	return grIDs, nil
+}
+
+func (ts *thingsService) RemoveRolesByOrgMember(ctx context.Context, orgID, memberID string) ([]string, error) {
+	if orgID == "" || memberID == "" {
+		return nil, errors.ErrMalformedEntity
+	}
+
+	grIDs, err := ts.roles.RetrieveGroupIDsByMember(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, grID := range grIDs {
+		grOrgID, err := ts.groupOrgID(ctx, grID)
+		if err != nil {
+			return nil, err
+		}
+		if grOrgID != orgID {
+			continue
+		}
+
+		if err := ts.roles.RemoveRolesByGroup(ctx, grID, memberID); err != nil {
+			return nil, err
+		}
+
+		if err := ts.groupCache.RemoveRole(ctx, grID, memberID); err != nil {
+			return nil, err
+		}
+		ids = append(ids, grID)
+	}
+
+	return ids, nil
+}
@@ -45,6 +45,10 @@ type Service interface {
 	// user identified by the provided key.
 	ListThings(ctx context.Context, token string, pm PageMetadata) (ThingsPage, error)
 
+	// ListThingsByKeyPrefix retrieves the things whose keys start with the
+	// provided prefix. Only the root admin can look up the things by key.
+	ListThingsByKeyPrefix(ctx context.Context, token, prefix string, pm PageMetadata) (ThingsPage, error)
+
 	// ListThingsByProfile retrieves data about subset of things that are
 	// connected or not connected to specified profile and belong to the user identified by
 	// the provided key.
@@ -85,8 +89,13 @@ type Service interface {
 	// provided key and returns thing's id if access is allowed.
 	GetPubConfByKey(ctx context.Context, key string) (PubConfInfo, error)
 
-	// GetConfigByThingID returns profile config for given thing ID.
-	GetConfigByThingID(ctx context.Context, thingID string) (map[string]interface{}, error)
+	// GetConfigByThingID returns the profile, whose config and version are
+	// used, for given thing ID.
+	GetConfigByThingID(ctx context.Context, thingID string) (Profile, error)
+
+	// GetPubConfs retrieves a subset of the publish configurations of all things,
+	// together with the thing keys, used to warm the adapter caches.
+	GetPubConfs(ctx context.Context, pm PageMetadata) (PubConfsPage, error)
 
 	// Authorize determines whether the group and its things and profiles can be accessed by
 	// the given user and returns error if it cannot.
@@ -98,15 +107,51 @@ type Service interface {
 	// GetGroupIDByThingID returns a thing's group ID for given thing ID.
 	GetGroupIDByThingID(ctx context.Context, thingID string) (string, error)
 
+	// GetStats returns the number of things, profiles and groups of all users.
+	GetStats(ctx context.Context) (Stats, error)
+
+	// GetOrgStats returns the number of things, profiles and groups of the org.
+	GetOrgStats(ctx context.Context, orgID string) (Stats, error)
+
 	// Backup retrieves all things, profiles, groups, and groups roles for all users. Only accessible by admin.
 	Backup(ctx context.Context, token string) (Backup, error)
 
 	// Restore adds things, profiles, groups, and groups roles from a backup. Only accessible by admin.
 	Restore(ctx context.Context, token string, backup Backup) error
 
+	// ListGroupAccess retrieves the effective access of all members to all groups,
+	// used for the periodic access reviews. Only accessible by admin.
+	ListGroupAccess(ctx context.Context, token string) ([]GroupAccess, error)
+
+	// RebuildCache flushes the things, profiles and groups caches and rebuilds
+	// them from the database. Only accessible by admin.
+	RebuildCache(ctx context.Context, token string) (CacheStats, error)
+
+	// ListConsumerGroups retrieves the event store consumer groups of the service.
+	// Only accessible by admin.
+	ListConsumerGroups(ctx context.Context, token string) ([]ConsumerGroup, error)
+
+	// ResetConsumerGroup sets the offset of the event store consumer group of the
+	// stream. Only accessible by admin.
+	ResetConsumerGroup(ctx context.Context, token, stream, offset string) error
+
+	// ViewOrgCleanup retrieves the progress of the cleanup of the removed org
+	// across the services. Only accessible by admin.
+	ViewOrgCleanup(ctx context.Context, token, orgID string) (OrgCleanup, error)
+
 	Groups
 
 	Roles
+
+	Shares
+
+	OrgTemplates
+
+	Gateways
+
+	NetworkACLs
+
+	Imports
 }
 
 // PageMetadata contains page metadata that helps navigation.
@@ -127,6 +172,13 @@ type Backup struct {
 	GroupRoles []GroupMember
 }
 
+// Stats contains the number of things, profiles and groups of all users.
+type Stats struct {
+	Things   uint64
+	Profiles uint64
+	Groups   uint64
+}
+
 type AuthorizeReq struct {
 	Token   string
 	Object  string
@@ -136,7 +188,34 @@ type AuthorizeReq struct {
 
 type PubConfInfo struct {
 	PublisherID   string
+	OrgID         string
 	ProfileConfig map[string]interface{}
+	// NetworkACLs are the network ACLs of the thing and of its org, each of
+	// which has to permit the network the thing connects from.
+	NetworkACLs []NetworkACL
+	ProfileID   string
+	GroupID     string
+	// ProfileVersion is the version of the profile config, which the
+	// adapters pass on with the published messages.
+	ProfileVersion uint64
+	// ShareID and ExpiresAt identify the share the publish configuration
+	// is retrieved by, so that the subscriptions made using the share are
+	// stopped once it expires or is removed.
+	ShareID   string
+	ExpiresAt time.Time
+}
+
+// ThingPubConf represents the publish configuration of the thing identified by the key.
+type ThingPubConf struct {
+	Key string
+	PubConfInfo
+}
+
+// PubConfsPage contains page related metadata as well as a list of
+// publish configurations that belong to this page.
+type PubConfsPage struct {
+	PageMetadata
+	PubConfs []ThingPubConf
 }
 
 var _ Service = (*thingsService)(nil)
@@ -148,14 +227,19 @@ type thingsService struct {
 	profiles     ProfileRepository
 	groups       GroupRepository
 	roles        RolesRepository
+	shares       ShareRepository
+	orgTemplates OrgTemplateRepository
+	gateways     GatewayRepository
+	acls         NetworkACLRepository
 	profileCache ProfileCache
 	thingCache   ThingCache
 	groupCache   GroupCache
+	consumers    ConsumerGroups
 	idProvider   uuid.IDProvider
 }
 
 // New instantiates the things service implementation.
-func New(auth protomfx.AuthServiceClient, users protomfx.UsersServiceClient, things ThingRepository, profiles ProfileRepository, groups GroupRepository, roles RolesRepository, pcache ProfileCache, tcache ThingCache, gcache GroupCache, idp uuid.IDProvider) Service {
+func New(auth protomfx.AuthServiceClient, users protomfx.UsersServiceClient, things ThingRepository, profiles ProfileRepository, groups GroupRepository, roles RolesRepository, shares ShareRepository, orgTemplates OrgTemplateRepository, gateways GatewayRepository, acls NetworkACLRepository, pcache ProfileCache, tcache ThingCache, gcache GroupCache, consumers ConsumerGroups, idp uuid.IDProvider) Service {
 	return &thingsService{
 		auth:         auth,
 		users:        users,
@@ -163,15 +247,20 @@ func New(auth protomfx.AuthServiceClient, users protomfx.UsersServiceClient, thi
 		profiles:     profiles,
 		groups:       groups,
 		roles:        roles,
+		shares:       shares,
+		orgTemplates: orgTemplates,
+		gateways:     gateways,
+		acls:         acls,
 		profileCache: pcache,
 		thingCache:   tcache,
 		groupCache:   gcache,
+		consumers:    consumers,
 		idProvider:   idp,
 	}
 }
 
 func (ts *thingsService) CreateThings(ctx context.Context, token string, things ...Thing) ([]Thing, error) {
-	ths := []Thing{}
+	counts := make(map[string]uint64)
 	for _, thing := range things {
 		ar := AuthorizeReq{
 			Token:   token,
@@ -200,6 +289,15 @@ func (ts *thingsService) CreateThings(ctx context.Context, token string, things
 			return nil, errors.ErrAuthorization
 		}
 
+		counts[thing.GroupID]++
+	}
+
+	if err := ts.checkQuota(ctx, auth.ThingsResource, counts); err != nil {
+		return []Thing{}, err
+	}
+
+	ths := []Thing{}
+	for _, thing := range things {
 		th, err := ts.createThing(ctx, &thing)
 		if err != nil {
 			return []Thing{}, err
@@ -355,6 +453,14 @@ func (ts *thingsService) ListThings(ctx context.Context, token string, pm PageMe
 	return ts.things.RetrieveByGroupIDs(ctx, grIDs, pm)
 }
 
+func (ts *thingsService) ListThingsByKeyPrefix(ctx context.Context, token, prefix string, pm PageMetadata) (ThingsPage, error) {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return ThingsPage{}, err
+	}
+
+	return ts.things.RetrieveByKeyPrefix(ctx, prefix, pm)
+}
+
 func (ts *thingsService) ListThingsByProfile(ctx context.Context, token, prID string, pm PageMetadata) (ThingsPage, error) {
 	ar := AuthorizeReq{
 		Token:   token,
@@ -403,7 +509,7 @@ func (ts *thingsService) RemoveThings(ctx context.Context, token string, ids ...
 }
 
 func (ts *thingsService) CreateProfiles(ctx context.Context, token string, profiles ...Profile) ([]Profile, error) {
-	prs := []Profile{}
+	counts := make(map[string]uint64)
 	for _, profile := range profiles {
 		ar := AuthorizeReq{
 			Token:   token,
@@ -415,6 +521,15 @@ func (ts *thingsService) CreateProfiles(ctx context.Context, token string, profi
 			return nil, err
 		}
 
+		counts[profile.GroupID]++
+	}
+
+	if err := ts.checkQuota(ctx, auth.ProfilesResource, counts); err != nil {
+		return []Profile{}, err
+	}
+
+	prs := []Profile{}
+	for _, profile := range profiles {
 		pr, err := ts.createProfile(ctx, &profile)
 		if err != nil {
 			return []Profile{}, err
@@ -432,6 +547,7 @@ func (ts *thingsService) createProfile(ctx context.Context, profile *Profile) (P
 		}
 		profile.ID = prID
 	}
+	profile.Version = initialVersion
 
 	prs, err := ts.profiles.Save(ctx, *profile)
 	if err != nil {
@@ -566,15 +682,95 @@ func (ts *thingsService) GetPubConfByKey(ctx context.Context, thingKey string) (
 		return PubConfInfo{}, err
 	}
 
-	return PubConfInfo{PublisherID: thID, ProfileConfig: profile.Config}, nil
+	orgID, err := ts.groupOrgID(ctx, profile.GroupID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	acls, err := ts.networkACLs(ctx, thID, orgID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	return PubConfInfo{PublisherID: thID, OrgID: orgID, ProfileConfig: profile.Config, NetworkACLs: acls, ProfileID: profile.ID, GroupID: profile.GroupID, ProfileVersion: profile.Version}, nil
 }
 
-func (ts *thingsService) GetConfigByThingID(ctx context.Context, thingID string) (map[string]interface{}, error) {
-	profile, err := ts.profiles.RetrieveByThing(ctx, thingID)
+func (ts *thingsService) GetConfigByThingID(ctx context.Context, thingID string) (Profile, error) {
+	return ts.profiles.RetrieveByThing(ctx, thingID)
+}
+
+func (ts *thingsService) GetPubConfs(ctx context.Context, pm PageMetadata) (PubConfsPage, error) {
+	tp, err := ts.things.RetrieveByAdmin(ctx, pm)
 	if err != nil {
-		return map[string]interface{}{}, err
+		return PubConfsPage{}, err
 	}
-	return profile.Config, nil
+
+	thIDs := []string{}
+	for _, th := range tp.Things {
+		thIDs = append(thIDs, th.ID)
+	}
+
+	thACLs, err := ts.acls.RetrieveByThings(ctx, thIDs...)
+	if err != nil {
+		return PubConfsPage{}, err
+	}
+
+	// The profiles and the orgs are retrieved once per page, and the network
+	// ACLs of all orgs of the page at once.
+	profiles := make(map[string]Profile)
+	orgs := make(map[string]string)
+	orgIDs := []string{}
+	seen := make(map[string]bool)
+	for _, th := range tp.Things {
+		if _, ok := profiles[th.ProfileID]; !ok {
+			pr, err := ts.profiles.RetrieveByID(ctx, th.ProfileID)
+			if err != nil {
+				return PubConfsPage{}, err
+			}
+			profiles[th.ProfileID] = pr
+		}
+
+		if _, ok := orgs[th.GroupID]; !ok {
+			orgID, err := ts.groupOrgID(ctx, th.GroupID)
+			if err != nil {
+				return PubConfsPage{}, err
+			}
+			orgs[th.GroupID] = orgID
+			if !seen[orgID] {
+				seen[orgID] = true
+				orgIDs = append(orgIDs, orgID)
+			}
+		}
+	}
+
+	orgACLs, err := ts.acls.RetrieveByOrgs(ctx, orgIDs...)
+	if err != nil {
+		return PubConfsPage{}, err
+	}
+
+	pcs := []ThingPubConf{}
+	for _, th := range tp.Things {
+		orgID := orgs[th.GroupID]
+		pcs = append(pcs, ThingPubConf{
+			Key: th.Key,
+			PubConfInfo: PubConfInfo{
+				PublisherID:    th.ID,
+				OrgID:          orgID,
+				ProfileConfig:  profiles[th.ProfileID].Config,
+				NetworkACLs:    nonEmptyACLs(thACLs[th.ID], orgACLs[orgID]),
+				ProfileID:      th.ProfileID,
+				GroupID:        th.GroupID,
+				ProfileVersion: profiles[th.ProfileID].Version,
+			},
+		})
+	}
+
+	page := PubConfsPage{
+		PageMetadata: tp.PageMetadata,
+		PubConfs:     pcs,
+	}
+
+	return page, nil
 }
 
 func (ts *thingsService) Authorize(ctx context.Context, ar AuthorizeReq) error {
@@ -651,6 +847,67 @@ func (ts *thingsService) GetGroupIDByThingID(ctx context.Context, thingID string
 	return thGrID, nil
 }
 
+func (ts *thingsService) GetStats(ctx context.Context) (Stats, error) {
+	// Only the totals are needed, so a single entity is retrieved per page.
+	pm := PageMetadata{Limit: 1}
+
+	tp, err := ts.things.RetrieveByAdmin(ctx, pm)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	pp, err := ts.profiles.RetrieveByAdmin(ctx, pm)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	gp, err := ts.groups.RetrieveByAdmin(ctx, "", pm)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return Stats{
+		Things:   tp.Total,
+		Profiles: pp.Total,
+		Groups:   gp.Total,
+	}, nil
+}
+
+func (ts *thingsService) GetOrgStats(ctx context.Context, orgID string) (Stats, error) {
+	gp, err := ts.groups.RetrieveByAdmin(ctx, orgID, PageMetadata{})
+	if err != nil {
+		return Stats{}, err
+	}
+
+	if len(gp.Groups) == 0 {
+		return Stats{}, nil
+	}
+
+	grIDs := make([]string, 0, len(gp.Groups))
+	for _, gr := range gp.Groups {
+		grIDs = append(grIDs, gr.ID)
+	}
+
+	// Only the totals are needed, so a single entity is retrieved per page.
+	pm := PageMetadata{Limit: 1}
+
+	tp, err := ts.things.RetrieveByGroupIDs(ctx, grIDs, pm)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	pp, err := ts.profiles.RetrieveByGroupIDs(ctx, grIDs, pm)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return Stats{
+		Things:   tp.Total,
+		Profiles: pp.Total,
+		Groups:   uint64(len(gp.Groups)),
+	}, nil
+}
+
 func (ts *thingsService) Backup(ctx context.Context, token string) (Backup, error) {
 	if err := ts.isAdmin(ctx, token); err != nil {
 		return Backup{}, err
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
+	authmocks "github.com/MainfluxLabs/mainflux/auth/mocks"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	authmock "github.com/MainfluxLabs/mainflux/pkg/mocks"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/MainfluxLabs/mainflux/things/mocks"
@@ -51,20 +54,35 @@ var (
 	usersList   = []users.User{admin, user, otherUser}
 	group       = things.Group{OrgID: orgID, Name: "test-group", Description: "test-group-desc"}
 	metadata    = map[string]interface{}{"test": "data"}
+
+	consumerGroup = things.ConsumerGroup{Stream: "mainflux.auth", Name: "mainflux.things", LastDeliveredID: "1-0", StreamLength: 3, LastEntryID: "3-0"}
 )
 
 func newService() things.Service {
-	auth := authmock.NewAuthService(admin.ID, usersList)
+	return newServiceWithAuth(authmock.NewAuthService(admin.ID, usersList))
+}
+
+func newServiceWithAuth(auth protomfx.AuthServiceClient) things.Service {
+	usersByIDs := make(map[string]users.User)
+	for _, u := range usersList {
+		usersByIDs[u.ID] = u
+	}
+	uc := authmocks.NewUsersService(usersByIDs, nil)
 	thingsRepo := mocks.NewThingRepository()
 	profilesRepo := mocks.NewProfileRepository(thingsRepo)
-	groupsRepo := mocks.NewGroupRepository()
 	rolesRepo := mocks.NewRolesRepository()
+	groupsRepo := mocks.NewGroupRepository(rolesRepo)
+	sharesRepo := mocks.NewShareRepository()
+	orgTemplatesRepo := mocks.NewOrgTemplateRepository()
+	gatewaysRepo := mocks.NewGatewayRepository()
+	aclsRepo := mocks.NewNetworkACLRepository()
 	profileCache := mocks.NewProfileCache()
 	thingCache := mocks.NewThingCache()
 	groupCache := mocks.NewGroupCache()
+	consumers := mocks.NewConsumerGroups(consumerGroup)
 	idProvider := uuid.NewMock()
 
-	return things.New(auth, nil, thingsRepo, profilesRepo, groupsRepo, rolesRepo, profileCache, thingCache, groupCache, idProvider)
+	return things.New(auth, uc, thingsRepo, profilesRepo, groupsRepo, rolesRepo, sharesRepo, orgTemplatesRepo, gatewaysRepo, aclsRepo, profileCache, thingCache, groupCache, consumers, idProvider)
 }
 
 func TestInit(t *testing.T) {
@@ -143,6 +161,97 @@ func TestCreateThings(t *testing.T) {
 	}
 }
 
+func TestCreateWithQuotas(t *testing.T) {
+	quotas := map[string]map[string]uint64{
+		orgID: {auth.ThingsResource: 3, auth.ProfilesResource: 1, auth.GroupsResource: 2},
+	}
+	svc := newServiceWithAuth(authmock.NewQuotaAuthService(admin.ID, usersList, quotas))
+
+	grs, err := svc.CreateGroups(context.Background(), token, group, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID, grID1 := grs[0].ID, grs[1].ID
+
+	_, err = svc.CreateGroups(context.Background(), token, group)
+	assert.True(t, errors.Contains(err, errors.ErrQuotaExceeded), fmt.Sprintf("create group over quota: expected %s got %s\n", errors.ErrQuotaExceeded, err))
+
+	prs, err := svc.CreateProfiles(context.Background(), token, things.Profile{Name: "a", GroupID: grID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	prID := prs[0].ID
+
+	_, err = svc.CreateProfiles(context.Background(), token, things.Profile{Name: "b", GroupID: grID1})
+	assert.True(t, errors.Contains(err, errors.ErrQuotaExceeded), fmt.Sprintf("create profile over quota: expected %s got %s\n", errors.ErrQuotaExceeded, err))
+
+	_, err = svc.CreateThings(context.Background(), token, things.Thing{Name: "a", GroupID: grID, ProfileID: prID}, things.Thing{Name: "b", GroupID: grID, ProfileID: prID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		things []things.Thing
+		err    error
+	}{
+		{
+			desc:   "create things over quota",
+			things: []things.Thing{{Name: "c", GroupID: grID, ProfileID: prID}, {Name: "d", GroupID: grID, ProfileID: prID}},
+			err:    errors.ErrQuotaExceeded,
+		},
+		{
+			desc:   "create things within quota",
+			things: []things.Thing{{Name: "c", GroupID: grID, ProfileID: prID}},
+			err:    nil,
+		},
+		{
+			desc:   "create things when quota is reached",
+			things: []things.Thing{{Name: "d", GroupID: grID, ProfileID: prID}},
+			err:    errors.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.CreateThings(context.Background(), token, tc.things...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestTransferGroupWithQuotas(t *testing.T) {
+	fullOrgID := "474106f7-030e-4881-8ab0-151195c29f93"
+	quotas := map[string]map[string]uint64{
+		fullOrgID: {auth.ThingsResource: 1},
+	}
+	svc := newServiceWithAuth(authmock.NewQuotaAuthService(admin.ID, usersList, quotas))
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	prs, err := svc.CreateProfiles(context.Background(), token, things.Profile{Name: "a", GroupID: grID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	_, err = svc.CreateThings(context.Background(), token, things.Thing{Name: "a", GroupID: grID, ProfileID: prs[0].ID}, things.Thing{Name: "b", GroupID: grID, ProfileID: prs[0].ID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		orgID string
+		err   error
+	}{
+		{
+			desc:  "transfer group to org over quota",
+			orgID: fullOrgID,
+			err:   errors.ErrQuotaExceeded,
+		},
+		{
+			desc:  "transfer group to org within quota",
+			orgID: "574106f7-030e-4881-8ab0-151195c29f94",
+			err:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.TransferGroup(context.Background(), token, grID, tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
 func TestUpdateThing(t *testing.T) {
 	svc := newService()
 
@@ -759,6 +868,188 @@ func TestRemoveThings(t *testing.T) {
 	}
 }
 
+func TestRemoveGroupsByOrg(t *testing.T) {
+	svc := newService()
+	otherGroup := group
+	otherGroup.OrgID = "474106f7-030e-4881-8ab0-151195c29f93"
+	grs, err := svc.CreateGroups(context.Background(), token, group, group, otherGroup)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	profile.GroupID = grs[0].ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grs[0].ID
+	thing.ProfileID = prs[0].ID
+	_, err = svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		orgID string
+		size  int
+		err   error
+	}{
+		{
+			desc:  "remove groups of org",
+			orgID: orgID,
+			size:  2,
+			err:   nil,
+		},
+		{
+			desc:  "remove groups of org without groups",
+			orgID: orgID,
+			size:  0,
+			err:   nil,
+		},
+		{
+			desc:  "remove groups without org id",
+			orgID: wrongID,
+			size:  0,
+			err:   errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		ids, err := svc.RemoveGroupsByOrg(context.Background(), tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(ids), fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.size, len(ids)))
+	}
+
+	_, err = svc.ViewGroup(context.Background(), token, grs[2].ID)
+	assert.Nil(t, err, fmt.Sprintf("group of other org: unexpected error: %s\n", err))
+}
+
+func TestTransferGroup(t *testing.T) {
+	viewer := users.User{ID: "774106f7-030e-4881-8ab0-151195c29f96", Email: "viewer@example.com", Password: password, Role: auth.Viewer}
+	member := users.User{ID: "974106f7-030e-4881-8ab0-151195c29f98", Email: "member@example.com", Password: password, Role: auth.Admin}
+	newOrgID := "474106f7-030e-4881-8ab0-151195c29f93"
+	orgMembers := map[string][]string{newOrgID: {user.ID, member.ID}}
+	svc := newServiceWithAuth(authmock.NewMembersAuthService(admin.ID, append(usersList, viewer, member), orgMembers))
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	gms := []things.GroupMember{
+		{GroupID: gr.ID, MemberID: viewer.ID, Role: things.Viewer},
+		{GroupID: gr.ID, MemberID: member.ID, Role: things.Viewer},
+	}
+	err = svc.CreateRolesByGroup(context.Background(), token, gms...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = svc.ViewGroup(context.Background(), viewer.Email, gr.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		groupID string
+		orgID   string
+		err     error
+	}{
+		{
+			desc:    "transfer group with wrong credentials",
+			token:   wrongValue,
+			groupID: gr.ID,
+			orgID:   newOrgID,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "transfer group without group ownership",
+			token:   viewer.Email,
+			groupID: gr.ID,
+			orgID:   newOrgID,
+			err:     errors.ErrAuthorization,
+		},
+		{
+			desc:    "transfer group",
+			token:   token,
+			groupID: gr.ID,
+			orgID:   newOrgID,
+			err:     nil,
+		},
+		{
+			desc:    "transfer non-existing group",
+			token:   token,
+			groupID: wrongValue,
+			orgID:   newOrgID,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.TransferGroup(context.Background(), tc.token, tc.groupID, tc.orgID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	transferred, err := svc.ViewGroup(context.Background(), token, gr.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, newOrgID, transferred.OrgID, fmt.Sprintf("expected org id %s got %s\n", newOrgID, transferred.OrgID))
+
+	_, err = svc.ViewGroup(context.Background(), member.Email, gr.ID)
+	assert.Nil(t, err, fmt.Sprintf("viewing transferred group by member of both orgs: unexpected error: %s\n", err))
+
+	_, err = svc.ViewGroup(context.Background(), viewer.Email, gr.ID)
+	assert.True(t, errors.Contains(err, errors.ErrAuthorization), fmt.Sprintf("viewing transferred group by member of source org only: expected %s got %s\n", errors.ErrAuthorization, err))
+
+	_, err = svc.TransferGroup(context.Background(), viewer.Email, gr.ID, orgID)
+	assert.True(t, errors.Contains(err, errors.ErrAuthorization), fmt.Sprintf("transferring transferred group by existing viewer: expected %s got %s\n", errors.ErrAuthorization, err))
+}
+
+func TestRemoveRolesByOrgMember(t *testing.T) {
+	svc := newService()
+	otherGroup := group
+	otherGroup.OrgID = "474106f7-030e-4881-8ab0-151195c29f93"
+	grs, err := svc.CreateGroups(context.Background(), token, group, group, otherGroup)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		orgID    string
+		memberID string
+		size     int
+		err      error
+	}{
+		{
+			desc:     "remove roles of org member",
+			orgID:    orgID,
+			memberID: user.ID,
+			size:     2,
+			err:      nil,
+		},
+		{
+			desc:     "remove roles of org member without roles",
+			orgID:    orgID,
+			memberID: user.ID,
+			size:     0,
+			err:      nil,
+		},
+		{
+			desc:     "remove roles of org member without org id",
+			orgID:    wrongID,
+			memberID: user.ID,
+			size:     0,
+			err:      errors.ErrMalformedEntity,
+		},
+		{
+			desc:     "remove roles of org member without member id",
+			orgID:    orgID,
+			memberID: wrongID,
+			size:     0,
+			err:      errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		ids, err := svc.RemoveRolesByOrgMember(context.Background(), tc.orgID, tc.memberID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(ids), fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.size, len(ids)))
+	}
+
+	ids, err := svc.RemoveRolesByMember(context.Background(), user.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{grs[2].ID}, ids, fmt.Sprintf("role in group of other org: expected %v got %v\n", []string{grs[2].ID}, ids))
+}
+
 func TestCreateProfiles(t *testing.T) {
 	svc := newService()
 	grs, err := svc.CreateGroups(context.Background(), token, group)
@@ -823,6 +1114,12 @@ func TestUpdateProfile(t *testing.T) {
 	pr := prs[0]
 	other := things.Profile{ID: wrongID}
 
+	current := pr
+	current.Version = pr.Version + 1
+
+	unversioned := pr
+	unversioned.Version = 0
+
 	cases := []struct {
 		desc    string
 		profile things.Profile
@@ -835,6 +1132,24 @@ func TestUpdateProfile(t *testing.T) {
 			token:   token,
 			err:     nil,
 		},
+		{
+			desc:    "update profile with outdated version",
+			profile: pr,
+			token:   token,
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "update profile with current version",
+			profile: current,
+			token:   token,
+			err:     nil,
+		},
+		{
+			desc:    "update profile without version",
+			profile: unversioned,
+			token:   token,
+			err:     nil,
+		},
 		{
 			desc:    "update profile with wrong credentials",
 			profile: pr,
@@ -1235,12 +1550,14 @@ func TestGetPubConfByKey(t *testing.T) {
 	th := ths[0]
 
 	cases := map[string]struct {
-		key string
-		err error
+		key   string
+		orgID string
+		err   error
 	}{
 		"allowed access": {
-			key: th.Key,
-			err: nil,
+			key:   th.Key,
+			orgID: gr.OrgID,
+			err:   nil,
 		},
 		"non-existing thing": {
 			key: wrongValue,
@@ -1249,8 +1566,68 @@ func TestGetPubConfByKey(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		_, err := svc.GetPubConfByKey(context.Background(), tc.key)
+		pc, err := svc.GetPubConfByKey(context.Background(), tc.key)
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected '%s' got '%s'\n", desc, tc.err, err))
+		assert.Equal(t, tc.orgID, pc.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", desc, tc.orgID, pc.OrgID))
+	}
+}
+
+func TestGetPubConfs(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pr := prs[0]
+
+	ths := []things.Thing{}
+	for i := 0; i < 2; i++ {
+		th := thing
+		th.Name = fmt.Sprintf("%s-%d", thing.Name, i)
+		th.GroupID = gr.ID
+		th.ProfileID = pr.ID
+		ths = append(ths, th)
+	}
+	ths, err = svc.CreateThings(context.Background(), token, ths...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	keys := map[string]string{}
+	for _, th := range ths {
+		keys[th.ID] = th.Key
+	}
+
+	cases := map[string]struct {
+		pm   things.PageMetadata
+		size int
+	}{
+		"get all publish configurations": {
+			pm:   things.PageMetadata{Offset: 0, Limit: 10},
+			size: 2,
+		},
+		"get publish configurations with offset": {
+			pm:   things.PageMetadata{Offset: 1, Limit: 10},
+			size: 1,
+		},
+		"get publish configurations with offset beyond total": {
+			pm:   things.PageMetadata{Offset: 5, Limit: 10},
+			size: 0,
+		},
+	}
+
+	for desc, tc := range cases {
+		page, err := svc.GetPubConfs(context.Background(), tc.pm)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", desc, err))
+		assert.Equal(t, uint64(len(ths)), page.Total, fmt.Sprintf("%s: expected total %d got %d\n", desc, len(ths), page.Total))
+		assert.Len(t, page.PubConfs, tc.size, fmt.Sprintf("%s: expected %d publish configurations got %d\n", desc, tc.size, len(page.PubConfs)))
+		for _, pc := range page.PubConfs {
+			assert.Equal(t, keys[pc.PublisherID], pc.Key, fmt.Sprintf("%s: expected key %s got %s\n", desc, keys[pc.PublisherID], pc.Key))
+			assert.Equal(t, pr.Config, pc.ProfileConfig, fmt.Sprintf("%s: expected profile config %v got %v\n", desc, pr.Config, pc.ProfileConfig))
+			assert.Equal(t, gr.OrgID, pc.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", desc, gr.OrgID, pc.OrgID))
+		}
 	}
 }
 
@@ -1296,6 +1673,35 @@ func TestIdentify(t *testing.T) {
 	}
 }
 
+func TestGetStats(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pr := prs[0]
+
+	ths := []things.Thing{}
+	for i := uint64(0); i < n; i++ {
+		th := thing
+		th.Name = fmt.Sprintf("%s-%d", thing.Name, i)
+		th.GroupID = gr.ID
+		th.ProfileID = pr.ID
+		ths = append(ths, th)
+	}
+	_, err = svc.CreateThings(context.Background(), token, ths...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	stats, err := svc.GetStats(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	expected := things.Stats{Things: n, Profiles: 1, Groups: 1}
+	assert.Equal(t, expected, stats, fmt.Sprintf("expected %v got %v\n", expected, stats))
+}
+
 func TestBackup(t *testing.T) {
 	svc := newService()
 
@@ -1460,29 +1866,298 @@ func TestRestore(t *testing.T) {
 	}
 }
 
-func testSortThings(t *testing.T, pm things.PageMetadata, ths []things.Thing) {
-	switch pm.Order {
-	case "name":
-		current := ths[0]
-		for _, res := range ths {
-			if pm.Dir == "asc" {
-				assert.GreaterOrEqual(t, res.Name, current.Name)
-			}
-			if pm.Dir == "desc" {
-				assert.GreaterOrEqual(t, current.Name, res.Name)
-			}
-			current = res
-		}
-	default:
-		break
+func TestListGroupAccess(t *testing.T) {
+	svc := newService()
+
+	var gas []things.GroupAccess
+	for i := 0; i < 5; i++ {
+		gr := group
+		gr.Name = fmt.Sprintf("%s%d", prefixName, i)
+		grs, err := svc.CreateGroups(context.Background(), token, gr)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+		gas = append(gas, things.GroupAccess{
+			OrgID:     orgID,
+			GroupID:   grs[0].ID,
+			GroupName: grs[0].Name,
+			MemberID:  user.ID,
+			Email:     user.Email,
+			Role:      things.Owner,
+		})
 	}
-}
 
-func testSortProfiles(t *testing.T, pm things.PageMetadata, prs []things.Profile) {
-	switch pm.Order {
-	case "name":
-		current := prs[0]
-		for _, res := range prs {
+	cases := []struct {
+		desc  string
+		token string
+		gas   []things.GroupAccess
+		err   error
+	}{
+		{
+			desc:  "list group access",
+			token: adminToken,
+			gas:   gas,
+			err:   nil,
+		},
+		{
+			desc:  "list group access as non-admin user",
+			token: token,
+			gas:   []things.GroupAccess{},
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "list group access with invalid token",
+			token: wrongValue,
+			gas:   []things.GroupAccess{},
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		gas, err := svc.ListGroupAccess(context.Background(), tc.token)
+		assert.ElementsMatch(t, tc.gas, gas, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.gas, gas))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRebuildCache(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	pr := profile
+	pr.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, pr)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	ths := []things.Thing{}
+	for i := 0; i < 5; i++ {
+		ths = append(ths, things.Thing{Name: fmt.Sprintf("%s%d", prefixName, i), GroupID: gr.ID, ProfileID: prs[0].ID})
+	}
+	ths, err = svc.CreateThings(context.Background(), token, ths...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		stats things.CacheStats
+		err   error
+	}{
+		{
+			desc:  "rebuild cache",
+			token: adminToken,
+			stats: things.CacheStats{Things: 5, Profiles: 1, Groups: 1, Roles: 1},
+			err:   nil,
+		},
+		{
+			desc:  "rebuild cache as non-admin user",
+			token: token,
+			stats: things.CacheStats{},
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "rebuild cache with invalid token",
+			token: wrongValue,
+			stats: things.CacheStats{},
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		stats, err := svc.RebuildCache(context.Background(), tc.token)
+		assert.Equal(t, tc.stats, stats, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.stats, stats))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	for _, th := range ths {
+		id, err := svc.Identify(context.Background(), th.Key)
+		assert.Nil(t, err, fmt.Sprintf("identify thing after rebuild: unexpected error: %s", err))
+		assert.Equal(t, th.ID, id, fmt.Sprintf("identify thing after rebuild: expected %s got %s", th.ID, id))
+	}
+}
+
+func TestListConsumerGroups(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc  string
+		token string
+		cgs   []things.ConsumerGroup
+		err   error
+	}{
+		{
+			desc:  "list consumer groups",
+			token: adminToken,
+			cgs:   []things.ConsumerGroup{consumerGroup},
+			err:   nil,
+		},
+		{
+			desc:  "list consumer groups as non-admin user",
+			token: token,
+			cgs:   nil,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "list consumer groups with invalid token",
+			token: wrongValue,
+			cgs:   nil,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		cgs, err := svc.ListConsumerGroups(context.Background(), tc.token)
+		assert.Equal(t, tc.cgs, cgs, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cgs, cgs))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestResetConsumerGroup(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc            string
+		token           string
+		stream          string
+		offset          string
+		lastDeliveredID string
+		err             error
+	}{
+		{
+			desc:            "reset consumer group to stream start",
+			token:           adminToken,
+			stream:          consumerGroup.Stream,
+			offset:          "0",
+			lastDeliveredID: "0",
+			err:             nil,
+		},
+		{
+			desc:            "reset consumer group to last entry",
+			token:           adminToken,
+			stream:          consumerGroup.Stream,
+			offset:          "$",
+			lastDeliveredID: consumerGroup.LastEntryID,
+			err:             nil,
+		},
+		{
+			desc:            "reset consumer group to entry",
+			token:           adminToken,
+			stream:          consumerGroup.Stream,
+			offset:          "2-0",
+			lastDeliveredID: "2-0",
+			err:             nil,
+		},
+		{
+			desc:            "reset consumer group of unknown stream",
+			token:           adminToken,
+			stream:          wrongValue,
+			offset:          "0",
+			lastDeliveredID: "2-0",
+			err:             errors.ErrNotFound,
+		},
+		{
+			desc:            "reset consumer group as non-admin user",
+			token:           token,
+			stream:          consumerGroup.Stream,
+			offset:          "0",
+			lastDeliveredID: "2-0",
+			err:             errors.ErrAuthorization,
+		},
+		{
+			desc:            "reset consumer group with invalid token",
+			token:           wrongValue,
+			stream:          consumerGroup.Stream,
+			offset:          "0",
+			lastDeliveredID: "2-0",
+			err:             errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.ResetConsumerGroup(context.Background(), tc.token, tc.stream, tc.offset)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		cgs, err := svc.ListConsumerGroups(context.Background(), adminToken)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		assert.Equal(t, tc.lastDeliveredID, cgs[0].LastDeliveredID, fmt.Sprintf("%s: expected offset %s got %s\n", tc.desc, tc.lastDeliveredID, cgs[0].LastDeliveredID))
+	}
+}
+
+func TestViewOrgCleanup(t *testing.T) {
+	svc := newService()
+
+	orgID := "org-cleanup"
+	cases := []struct {
+		desc    string
+		token   string
+		orgID   string
+		cleanup things.OrgCleanup
+		err     error
+	}{
+		{
+			desc:  "view org cleanup",
+			token: adminToken,
+			orgID: orgID,
+			cleanup: things.OrgCleanup{
+				OrgID:     orgID,
+				Consumers: []things.CleanupProgress{{Name: consumerGroup.Name, Done: false}},
+			},
+			err: nil,
+		},
+		{
+			desc:    "view cleanup of unknown org",
+			token:   adminToken,
+			orgID:   "",
+			cleanup: things.OrgCleanup{},
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "view org cleanup as non-admin user",
+			token:   token,
+			orgID:   orgID,
+			cleanup: things.OrgCleanup{},
+			err:     errors.ErrAuthorization,
+		},
+		{
+			desc:    "view org cleanup with invalid token",
+			token:   wrongValue,
+			orgID:   orgID,
+			cleanup: things.OrgCleanup{},
+			err:     errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		oc, err := svc.ViewOrgCleanup(context.Background(), tc.token, tc.orgID)
+		assert.Equal(t, tc.cleanup, oc, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.cleanup, oc))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func testSortThings(t *testing.T, pm things.PageMetadata, ths []things.Thing) {
+	switch pm.Order {
+	case "name":
+		current := ths[0]
+		for _, res := range ths {
+			if pm.Dir == "asc" {
+				assert.GreaterOrEqual(t, res.Name, current.Name)
+			}
+			if pm.Dir == "desc" {
+				assert.GreaterOrEqual(t, current.Name, res.Name)
+			}
+			current = res
+		}
+	default:
+		break
+	}
+}
+
+func testSortProfiles(t *testing.T, pm things.PageMetadata, prs []things.Profile) {
+	switch pm.Order {
+	case "name":
+		current := prs[0]
+		for _, res := range prs {
 			if pm.Dir == "asc" {
 				assert.GreaterOrEqual(t, res.Name, current.Name)
 			}
@@ -1495,3 +2170,1009 @@ func testSortProfiles(t *testing.T, pm things.PageMetadata, prs []things.Profile
 		break
 	}
 }
+
+func TestCreateShare(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	cases := []struct {
+		desc    string
+		token   string
+		thingID string
+		share   things.Share
+		err     error
+	}{
+		{
+			desc:    "create share",
+			token:   token,
+			thingID: th.ID,
+			share:   things.Share{Name: "dashboard"},
+			err:     nil,
+		},
+		{
+			desc:    "create share with password and expiration time",
+			token:   token,
+			thingID: th.ID,
+			share:   things.Share{Password: password, ExpiresAt: time.Now().Add(time.Hour)},
+			err:     nil,
+		},
+		{
+			desc:    "create share with invalid credentials",
+			token:   wrongValue,
+			thingID: th.ID,
+			share:   things.Share{},
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "create share of non-existing thing",
+			token:   token,
+			thingID: wrongValue,
+			share:   things.Share{},
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		sh, err := svc.CreateShare(context.Background(), tc.token, tc.thingID, tc.share)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.NotEmpty(t, sh.Key, fmt.Sprintf("%s: expected non-empty share key", tc.desc))
+		}
+		if err == nil && tc.share.Password != "" {
+			assert.NotEqual(t, tc.share.Password, sh.Password, fmt.Sprintf("%s: expected hashed password", tc.desc))
+		}
+	}
+}
+
+func TestListSharesByThing(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	var shares []things.Share
+	for i := 0; i < 3; i++ {
+		sh, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{Name: fmt.Sprintf("share-%d", i)})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+		shares = append(shares, sh)
+	}
+
+	cases := []struct {
+		desc    string
+		token   string
+		thingID string
+		size    int
+		err     error
+	}{
+		{
+			desc:    "list shares of the thing",
+			token:   token,
+			thingID: th.ID,
+			size:    len(shares),
+			err:     nil,
+		},
+		{
+			desc:    "list shares with invalid credentials",
+			token:   wrongValue,
+			thingID: th.ID,
+			size:    0,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "list shares of non-existing thing",
+			token:   token,
+			thingID: wrongValue,
+			size:    0,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		shs, err := svc.ListSharesByThing(context.Background(), tc.token, tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(shs), fmt.Sprintf("%s: expected %d got %d\n", tc.desc, tc.size, len(shs)))
+	}
+}
+
+func TestRemoveShare(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th, th1 := ths[0], ths[1]
+
+	sh, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		thingID string
+		shareID string
+		err     error
+	}{
+		{
+			desc:    "remove share with invalid credentials",
+			token:   wrongValue,
+			thingID: th.ID,
+			shareID: sh.ID,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "remove share of other thing",
+			token:   token,
+			thingID: th1.ID,
+			shareID: sh.ID,
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "remove share",
+			token:   token,
+			thingID: th.ID,
+			shareID: sh.ID,
+			err:     nil,
+		},
+		{
+			desc:    "remove removed share",
+			token:   token,
+			thingID: th.ID,
+			shareID: sh.ID,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveShare(context.Background(), tc.token, tc.thingID, tc.shareID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	_, err = svc.GetPubConfByShare(context.Background(), sh.Key, "")
+	assert.True(t, errors.Contains(err, errors.ErrAuthentication), fmt.Sprintf("get pub conf by removed share: expected %s got %s\n", errors.ErrAuthentication, err))
+}
+
+func TestGetPubConfByShare(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = gr.ID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	sh, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	protected, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{Password: password})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	expired, err := svc.CreateShare(context.Background(), token, th.ID, things.Share{ExpiresAt: time.Now().Add(-time.Minute)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := map[string]struct {
+		key         string
+		password    string
+		publisherID string
+		orgID       string
+		shareID     string
+		err         error
+	}{
+		"allowed access": {
+			key:         sh.Key,
+			publisherID: th.ID,
+			orgID:       gr.OrgID,
+			shareID:     sh.ID,
+			err:         nil,
+		},
+		"allowed access with password": {
+			key:         protected.Key,
+			password:    password,
+			publisherID: th.ID,
+			orgID:       gr.OrgID,
+			shareID:     protected.ID,
+			err:         nil,
+		},
+		"access with wrong password": {
+			key:      protected.Key,
+			password: wrongValue,
+			err:      errors.ErrAuthentication,
+		},
+		"access without password": {
+			key: protected.Key,
+			err: errors.ErrAuthentication,
+		},
+		"access with expired share": {
+			key: expired.Key,
+			err: things.ErrShareExpired,
+		},
+		"access with non-existing share": {
+			key: wrongValue,
+			err: errors.ErrAuthentication,
+		},
+	}
+
+	for desc, tc := range cases {
+		pc, err := svc.GetPubConfByShare(context.Background(), tc.key, tc.password)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected '%s' got '%s'\n", desc, tc.err, err))
+		assert.Equal(t, tc.publisherID, pc.PublisherID, fmt.Sprintf("%s: expected publisher %s got %s\n", desc, tc.publisherID, pc.PublisherID))
+		assert.Equal(t, tc.orgID, pc.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", desc, tc.orgID, pc.OrgID))
+		assert.Equal(t, tc.shareID, pc.ShareID, fmt.Sprintf("%s: expected share %s got %s\n", desc, tc.shareID, pc.ShareID))
+	}
+}
+
+func TestUpdateGateway(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group, things.Group{OrgID: orgID, Name: "other-group"})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID, otherGrID := grs[0].ID, grs[1].ID
+
+	prs, err := svc.CreateProfiles(context.Background(), token, things.Profile{Name: "test", GroupID: grID}, things.Profile{Name: "test", GroupID: otherGrID})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	ths, err := svc.CreateThings(context.Background(), token,
+		things.Thing{Name: "gateway", GroupID: grID, ProfileID: prs[0].ID},
+		things.Thing{Name: "child", GroupID: grID, ProfileID: prs[0].ID},
+		things.Thing{Name: "other", GroupID: otherGrID, ProfileID: prs[1].ID},
+	)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gwID, childID, otherID := ths[0].ID, ths[1].ID, ths[2].ID
+
+	cases := []struct {
+		desc    string
+		token   string
+		gateway things.Gateway
+		err     error
+	}{
+		{
+			desc:    "update gateway",
+			token:   token,
+			gateway: things.Gateway{ThingID: gwID, Children: []string{childID}},
+			err:     nil,
+		},
+		{
+			desc:    "update gateway with invalid credentials",
+			token:   wrongValue,
+			gateway: things.Gateway{ThingID: gwID, Children: []string{childID}},
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "update gateway of non-existing thing",
+			token:   token,
+			gateway: things.Gateway{ThingID: wrongValue, Children: []string{childID}},
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "update gateway with non-existing child",
+			token:   token,
+			gateway: things.Gateway{ThingID: gwID, Children: []string{wrongValue}},
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "update gateway with itself as child",
+			token:   token,
+			gateway: things.Gateway{ThingID: gwID, Children: []string{gwID}},
+			err:     errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "update gateway with child of other group",
+			token:   token,
+			gateway: things.Gateway{ThingID: gwID, Children: []string{otherID}},
+			err:     errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateGateway(context.Background(), tc.token, tc.gateway)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	gw, err := svc.ViewGateway(context.Background(), token, gwID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	assert.Equal(t, []string{childID}, gw.Children, fmt.Sprintf("expected children %v got %v\n", []string{childID}, gw.Children))
+}
+
+func TestViewGateway(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gwID, childID, otherID := ths[0].ID, ths[1].ID, ths[2].ID
+
+	gw := things.Gateway{ThingID: gwID, Children: []string{childID}}
+	err = svc.UpdateGateway(context.Background(), token, gw)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		thingID string
+		gateway things.Gateway
+		err     error
+	}{
+		{
+			desc:    "view gateway",
+			token:   token,
+			thingID: gwID,
+			gateway: gw,
+			err:     nil,
+		},
+		{
+			desc:    "view gateway with invalid credentials",
+			token:   wrongValue,
+			thingID: gwID,
+			gateway: things.Gateway{},
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "view gateway of thing which isn't gateway",
+			token:   token,
+			thingID: otherID,
+			gateway: things.Gateway{},
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		gw, err := svc.ViewGateway(context.Background(), tc.token, tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.gateway, gw, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.gateway, gw))
+	}
+}
+
+func TestRemoveGateway(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gw, child := ths[0], ths[1]
+
+	err = svc.UpdateGateway(context.Background(), token, things.Gateway{ThingID: gw.ID, Children: []string{child.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		thingID string
+		err     error
+	}{
+		{
+			desc:    "remove gateway with invalid credentials",
+			token:   wrongValue,
+			thingID: gw.ID,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "remove gateway",
+			token:   token,
+			thingID: gw.ID,
+			err:     nil,
+		},
+		{
+			desc:    "remove removed gateway",
+			token:   token,
+			thingID: gw.ID,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveGateway(context.Background(), tc.token, tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	_, err = svc.GetPubConfByGateway(context.Background(), gw.Key, child.ID)
+	assert.True(t, errors.Contains(err, errors.ErrAuthorization), fmt.Sprintf("get pub conf by removed gateway: expected %s got %s\n", errors.ErrAuthorization, err))
+}
+
+func TestGetPubConfByGateway(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	gr := grs[0]
+
+	profile.GroupID = gr.ID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = gr.ID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing, thing, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	gw, child, other := ths[0], ths[1], ths[2]
+
+	err = svc.UpdateGateway(context.Background(), token, things.Gateway{ThingID: gw.ID, Children: []string{child.ID}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := map[string]struct {
+		key         string
+		thingID     string
+		publisherID string
+		orgID       string
+		err         error
+	}{
+		"allowed access": {
+			key:         gw.Key,
+			thingID:     child.ID,
+			publisherID: child.ID,
+			orgID:       gr.OrgID,
+			err:         nil,
+		},
+		"access on behalf of thing which isn't child": {
+			key:     gw.Key,
+			thingID: other.ID,
+			err:     errors.ErrAuthorization,
+		},
+		"access on behalf of gateway itself": {
+			key:     gw.Key,
+			thingID: gw.ID,
+			err:     errors.ErrAuthorization,
+		},
+		"access with key of thing which isn't gateway": {
+			key:     other.Key,
+			thingID: child.ID,
+			err:     errors.ErrAuthorization,
+		},
+		"access with invalid key": {
+			key:     wrongValue,
+			thingID: child.ID,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for desc, tc := range cases {
+		pc, err := svc.GetPubConfByGateway(context.Background(), tc.key, tc.thingID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected '%s' got '%s'\n", desc, tc.err, err))
+		assert.Equal(t, tc.publisherID, pc.PublisherID, fmt.Sprintf("%s: expected publisher %s got %s\n", desc, tc.publisherID, pc.PublisherID))
+		assert.Equal(t, tc.orgID, pc.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", desc, tc.orgID, pc.OrgID))
+	}
+}
+
+func TestUpdateThingACL(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	cases := []struct {
+		desc    string
+		token   string
+		thingID string
+		acl     things.NetworkACL
+		saved   things.NetworkACL
+		err     error
+	}{
+		{
+			desc:    "update thing acl",
+			token:   token,
+			thingID: th.ID,
+			acl:     things.NetworkACL{Allow: []string{"10.1.2.3/8", "10.0.0.0/8"}, Deny: []string{"2001:db8::1/32"}},
+			saved:   things.NetworkACL{Allow: []string{"10.0.0.0/8"}, Deny: []string{"2001:db8::/32"}},
+			err:     nil,
+		},
+		{
+			desc:    "update thing acl with malformed network",
+			token:   token,
+			thingID: th.ID,
+			acl:     things.NetworkACL{Allow: []string{"10.0.0.1"}},
+			saved:   things.NetworkACL{Allow: []string{"10.0.0.0/8"}, Deny: []string{"2001:db8::/32"}},
+			err:     errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "update thing acl with invalid credentials",
+			token:   wrongValue,
+			thingID: th.ID,
+			acl:     things.NetworkACL{Deny: []string{"10.0.0.0/8"}},
+			saved:   things.NetworkACL{Allow: []string{"10.0.0.0/8"}, Deny: []string{"2001:db8::/32"}},
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "remove thing acl",
+			token:   token,
+			thingID: th.ID,
+			acl:     things.NetworkACL{},
+			saved:   things.NetworkACL{},
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateThingACL(context.Background(), tc.token, tc.thingID, tc.acl)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		acl, err := svc.ViewThingACL(context.Background(), token, tc.thingID)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.saved, acl, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.saved, acl))
+	}
+}
+
+func TestUpdateOrgACL(t *testing.T) {
+	svc := newService()
+
+	acl := things.NetworkACL{Allow: []string{"192.168.0.0/16"}, Deny: []string{}}
+
+	cases := []struct {
+		desc  string
+		token string
+		acl   things.NetworkACL
+		err   error
+	}{
+		{
+			desc:  "update org acl",
+			token: adminToken,
+			acl:   acl,
+			err:   nil,
+		},
+		{
+			desc:  "update org acl as org editor",
+			token: token,
+			acl:   acl,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "update org acl with malformed network",
+			token: adminToken,
+			acl:   things.NetworkACL{Deny: []string{"192.168.0.300/24"}},
+			err:   errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateOrgACL(context.Background(), tc.token, orgID, tc.acl)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	saved, err := svc.ViewOrgACL(context.Background(), token, orgID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	assert.Equal(t, acl, saved, fmt.Sprintf("expected %v got %v\n", acl, saved))
+
+	err = svc.RemoveOrgACL(context.Background(), orgID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	saved, err = svc.ViewOrgACL(context.Background(), token, orgID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	assert.Equal(t, things.NetworkACL{}, saved, fmt.Sprintf("expected empty acl got %v\n", saved))
+}
+
+func TestGetPubConfByKeyNetworkACLs(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	profile.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, profile)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	thing.GroupID = grID
+	thing.ProfileID = prs[0].ID
+	ths, err := svc.CreateThings(context.Background(), token, thing)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	th := ths[0]
+
+	thACL := things.NetworkACL{Allow: []string{"10.0.0.0/8"}, Deny: []string{}}
+	orgACL := things.NetworkACL{Allow: []string{}, Deny: []string{"10.1.0.0/16"}}
+
+	pc, err := svc.GetPubConfByKey(context.Background(), th.Key)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	assert.Empty(t, pc.NetworkACLs, fmt.Sprintf("expected no acls got %v\n", pc.NetworkACLs))
+
+	err = svc.UpdateThingACL(context.Background(), token, th.ID, thACL)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	err = svc.UpdateOrgACL(context.Background(), adminToken, orgID, orgACL)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	pc, err = svc.GetPubConfByKey(context.Background(), th.Key)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	assert.Equal(t, []things.NetworkACL{thACL, orgACL}, pc.NetworkACLs, fmt.Sprintf("expected %v got %v\n", []things.NetworkACL{thACL, orgACL}, pc.NetworkACLs))
+
+	page, err := svc.GetPubConfs(context.Background(), things.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	require.Len(t, page.PubConfs, 1)
+	assert.Equal(t, []things.NetworkACL{thACL, orgACL}, page.PubConfs[0].NetworkACLs, fmt.Sprintf("expected %v got %v\n", []things.NetworkACL{thACL, orgACL}, page.PubConfs[0].NetworkACLs))
+}
+
+func TestUpdateOrgTemplate(t *testing.T) {
+	svc := newService()
+
+	ot := things.OrgTemplate{
+		Groups: []things.GroupTemplate{{Name: "default", Profiles: []things.ProfileTemplate{{Name: "default"}}}},
+	}
+
+	cases := []struct {
+		desc  string
+		token string
+		err   error
+	}{
+		{
+			desc:  "update org template as admin",
+			token: adminToken,
+			err:   nil,
+		},
+		{
+			desc:  "update org template as user",
+			token: token,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "update org template with invalid token",
+			token: wrongValue,
+			err:   errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateOrgTemplate(context.Background(), tc.token, ot)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestViewOrgTemplate(t *testing.T) {
+	svc := newService()
+
+	_, err := svc.ViewOrgTemplate(context.Background(), adminToken)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("view missing org template: expected %s got %s\n", errors.ErrNotFound, err))
+
+	ot := things.OrgTemplate{
+		Groups: []things.GroupTemplate{{Name: "default", Profiles: []things.ProfileTemplate{{Name: "default"}}}},
+	}
+	err = svc.UpdateOrgTemplate(context.Background(), adminToken, ot)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		token  string
+		groups []things.GroupTemplate
+		err    error
+	}{
+		{
+			desc:   "view org template as admin",
+			token:  adminToken,
+			groups: ot.Groups,
+			err:    nil,
+		},
+		{
+			desc:   "view org template as user",
+			token:  token,
+			groups: nil,
+			err:    errors.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := svc.ViewOrgTemplate(context.Background(), tc.token)
+		assert.Equal(t, tc.groups, res.Groups, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.groups, res.Groups))
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRemoveOrgTemplate(t *testing.T) {
+	svc := newService()
+
+	ot := things.OrgTemplate{
+		Groups: []things.GroupTemplate{{Name: "default"}},
+	}
+	err := svc.UpdateOrgTemplate(context.Background(), adminToken, ot)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = svc.RemoveOrgTemplate(context.Background(), token)
+	assert.True(t, errors.Contains(err, errors.ErrAuthorization), fmt.Sprintf("remove org template as user: expected %s got %s\n", errors.ErrAuthorization, err))
+
+	err = svc.RemoveOrgTemplate(context.Background(), adminToken)
+	assert.Nil(t, err, fmt.Sprintf("remove org template as admin: unexpected error: %s\n", err))
+
+	_, err = svc.ViewOrgTemplate(context.Background(), adminToken)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("view removed org template: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestProvisionOrg(t *testing.T) {
+	svc := newService()
+
+	grs, err := svc.ProvisionOrg(context.Background(), orgID, user.ID)
+	assert.Nil(t, err, fmt.Sprintf("provision org without template: unexpected error: %s\n", err))
+	assert.Empty(t, grs, "provision org without template: expected no groups")
+
+	ot := things.OrgTemplate{
+		Groups: []things.GroupTemplate{
+			{
+				Name:        "devices",
+				Description: "devices group",
+				Profiles:    []things.ProfileTemplate{{Name: "sensors", Config: map[string]interface{}{"content_type": "application/senml+json"}}, {Name: "actuators"}},
+			},
+			{
+				Name: "gateways",
+			},
+		},
+	}
+	err = svc.UpdateOrgTemplate(context.Background(), adminToken, ot)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		orgID    string
+		ownerID  string
+		groups   int
+		profiles []int
+		err      error
+	}{
+		{
+			desc:     "provision org",
+			orgID:    orgID,
+			ownerID:  user.ID,
+			groups:   2,
+			profiles: []int{2, 0},
+			err:      nil,
+		},
+		{
+			desc:    "provision already provisioned org",
+			orgID:   orgID,
+			ownerID: user.ID,
+			groups:  0,
+			err:     nil,
+		},
+		{
+			desc:    "provision org without org ID",
+			orgID:   "",
+			ownerID: user.ID,
+			groups:  0,
+			err:     errors.ErrMalformedEntity,
+		},
+		{
+			desc:    "provision org without owner ID",
+			orgID:   orgID,
+			ownerID: "",
+			groups:  0,
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		grs, err := svc.ProvisionOrg(context.Background(), tc.orgID, tc.ownerID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.groups, len(grs), fmt.Sprintf("%s: expected %d groups got %d\n", tc.desc, tc.groups, len(grs)))
+
+		for i, gr := range grs {
+			assert.Equal(t, tc.orgID, gr.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", tc.desc, tc.orgID, gr.OrgID))
+
+			// The org owner owns the provisioned groups.
+			pp, err := svc.ListProfilesByGroup(context.Background(), token, gr.ID, things.PageMetadata{Limit: n})
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+			assert.Equal(t, tc.profiles[i], len(pp.Profiles), fmt.Sprintf("%s: expected %d profiles got %d\n", tc.desc, tc.profiles[i], len(pp.Profiles)))
+		}
+	}
+}
+
+func TestExportGroup(t *testing.T) {
+	svc := newService()
+	grs, err := svc.CreateGroups(context.Background(), token, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID := grs[0].ID
+
+	pr := profile
+	pr.GroupID = grID
+	prs, err := svc.CreateProfiles(context.Background(), token, pr)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	prID := prs[0].ID
+
+	ths := []things.Thing{}
+	for i := 0; i < 3; i++ {
+		ths = append(ths, things.Thing{Name: fmt.Sprintf("name-%d", i), GroupID: grID, ProfileID: prID, Metadata: metadata})
+	}
+	ths, err = svc.CreateThings(context.Background(), token, ths...)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		token   string
+		groupID string
+		export  things.GroupExport
+		err     error
+	}{
+		{
+			desc:    "export group",
+			token:   token,
+			groupID: grID,
+			export:  things.GroupExport{Profiles: prs, Things: ths},
+			err:     nil,
+		},
+		{
+			desc:    "export group with wrong credentials",
+			token:   wrongValue,
+			groupID: grID,
+			export:  things.GroupExport{},
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "export non-existing group",
+			token:   token,
+			groupID: wrongValue,
+			export:  things.GroupExport{},
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		ge, err := svc.ExportGroup(context.Background(), tc.token, tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.ElementsMatch(t, tc.export.Profiles, ge.Profiles, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.export.Profiles, ge.Profiles))
+		assert.ElementsMatch(t, tc.export.Things, ge.Things, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.export.Things, ge.Things))
+	}
+}
+
+func TestImportGroup(t *testing.T) {
+	svc := newService()
+	grs, err := svc.CreateGroups(context.Background(), token, group, group)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	grID, grID1 := grs[0].ID, grs[1].ID
+
+	pr := profile
+	pr.GroupID = grID1
+	prs, err := svc.CreateProfiles(context.Background(), token, pr)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	otherPrID := prs[0].ID
+
+	prID := prefixID + "000000000101"
+	thID := prefixID + "000000000102"
+	thKey := prefixID + "000000000103"
+
+	ge := things.GroupExport{
+		Profiles: []things.Profile{{ID: prID, Name: "profile", Metadata: metadata}},
+		Things: []things.Thing{
+			{ID: thID, Name: "thing", Key: thKey, ProfileID: prID, Metadata: metadata},
+			{Name: "generated", ProfileID: prID},
+		},
+	}
+
+	ir, err := svc.ImportGroup(context.Background(), token, grID, ge)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Empty(t, ir.Errors, fmt.Sprintf("import group: expected no errors got %v\n", ir.Errors))
+	require.Len(t, ir.Profiles, 1)
+	require.Len(t, ir.Things, 2)
+	assert.Equal(t, prID, ir.Profiles[0].ID, fmt.Sprintf("import group: expected profile id %s got %s\n", prID, ir.Profiles[0].ID))
+	assert.Equal(t, grID, ir.Profiles[0].GroupID, fmt.Sprintf("import group: expected profile group id %s got %s\n", grID, ir.Profiles[0].GroupID))
+	assert.Equal(t, thID, ir.Things[0].ID, fmt.Sprintf("import group: expected thing id %s got %s\n", thID, ir.Things[0].ID))
+	assert.Equal(t, thKey, ir.Things[0].Key, fmt.Sprintf("import group: expected thing key %s got %s\n", thKey, ir.Things[0].Key))
+	assert.NotEmpty(t, ir.Things[1].ID, "import group: expected generated thing id")
+	assert.NotEmpty(t, ir.Things[1].Key, "import group: expected generated thing key")
+
+	cases := []struct {
+		desc    string
+		token   string
+		groupID string
+		export  things.GroupExport
+		things  int
+		errors  []things.ImportError
+		err     error
+	}{
+		{
+			desc:    "import things connected to existing profile",
+			token:   token,
+			groupID: grID,
+			export:  things.GroupExport{Things: []things.Thing{{Name: "a", ProfileID: prID}}},
+			things:  1,
+			errors:  []things.ImportError{},
+			err:     nil,
+		},
+		{
+			desc:    "import things with partial failures",
+			token:   token,
+			groupID: grID,
+			export: things.GroupExport{Things: []things.Thing{
+				{Name: "b", ProfileID: prID},
+				{Name: "c", Key: thKey, ProfileID: prID},
+				{Name: "d", ProfileID: otherPrID},
+				{Name: "e", ProfileID: wrongValue},
+			}},
+			things: 1,
+			errors: []things.ImportError{
+				{Subject: things.ThingSub, Index: 1, Name: "c", Err: errors.ErrConflict},
+				{Subject: things.ThingSub, Index: 2, Name: "d", Err: errors.ErrAuthorization},
+				{Subject: things.ThingSub, Index: 3, Name: "e", Err: errors.ErrNotFound},
+			},
+			err: nil,
+		},
+		{
+			desc:    "import group with wrong credentials",
+			token:   wrongValue,
+			groupID: grID,
+			export:  ge,
+			errors:  []things.ImportError{},
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "import non-existing group",
+			token:   token,
+			groupID: wrongValue,
+			export:  ge,
+			errors:  []things.ImportError{},
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		ir, err := svc.ImportGroup(context.Background(), tc.token, tc.groupID, tc.export)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.things, len(ir.Things), fmt.Sprintf("%s: expected %d things got %d\n", tc.desc, tc.things, len(ir.Things)))
+		require.Equal(t, len(tc.errors), len(ir.Errors), fmt.Sprintf("%s: expected %d errors got %d\n", tc.desc, len(tc.errors), len(ir.Errors)))
+		for i, ie := range ir.Errors {
+			assert.Equal(t, tc.errors[i].Subject, ie.Subject, fmt.Sprintf("%s: expected subject %s got %s\n", tc.desc, tc.errors[i].Subject, ie.Subject))
+			assert.Equal(t, tc.errors[i].Index, ie.Index, fmt.Sprintf("%s: expected index %d got %d\n", tc.desc, tc.errors[i].Index, ie.Index))
+			assert.Equal(t, tc.errors[i].Name, ie.Name, fmt.Sprintf("%s: expected name %s got %s\n", tc.desc, tc.errors[i].Name, ie.Name))
+			assert.True(t, errors.Contains(ie.Err, tc.errors[i].Err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.errors[i].Err, ie.Err))
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrShareExpired indicates that the share link has expired.
+var ErrShareExpired = errors.New("share link expired")
+
+// Share represents the read-only link to the messages of the thing. The share
+// is identified by its key, and it can be protected by the password and
+// limited by the expiration time.
+type Share struct {
+	ID        string
+	ThingID   string
+	Key       string
+	Name      string
+	Password  string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// Expired verifies if the share has expired. The share without the
+// expiration time never expires.
+func (sh Share) Expired() bool {
+	if sh.ExpiresAt.IsZero() {
+		return false
+	}
+
+	return sh.ExpiresAt.UTC().Before(time.Now().UTC())
+}
+
+// ShareRepository specifies a share persistence API.
+type ShareRepository interface {
+	// Save persists the share.
+	Save(ctx context.Context, sh Share) (Share, error)
+
+	// RetrieveByID retrieves the share having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (Share, error)
+
+	// RetrieveByKey retrieves the share having the provided key.
+	RetrieveByKey(ctx context.Context, key string) (Share, error)
+
+	// RetrieveByThing retrieves all shares of the thing identified by the provided ID.
+	RetrieveByThing(ctx context.Context, thingID string) ([]Share, error)
+
+	// Remove removes the share having the provided identifier.
+	Remove(ctx context.Context, id string) error
+}
+
+// Shares specifies an API for managing the shares of the things.
+type Shares interface {
+	// CreateShare creates the share of the thing identified by the provided ID.
+	CreateShare(ctx context.Context, token, thingID string, sh Share) (Share, error)
+
+	// ListSharesByThing retrieves the shares of the thing identified by the provided ID.
+	ListSharesByThing(ctx context.Context, token, thingID string) ([]Share, error)
+
+	// RemoveShare revokes the share of the thing identified by the provided ID.
+	RemoveShare(ctx context.Context, token, thingID, shareID string) error
+
+	// GetPubConfByShare returns the publish configuration of the shared thing,
+	// if the share key and password are valid and the share hasn't expired.
+	GetPubConfByShare(ctx context.Context, key, password string) (PubConfInfo, error)
+}
+
+func (ts *thingsService) CreateShare(ctx context.Context, token, thingID string, sh Share) (Share, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return Share{}, err
+	}
+
+	id, err := ts.idProvider.ID()
+	if err != nil {
+		return Share{}, err
+	}
+
+	key, err := ts.idProvider.ID()
+	if err != nil {
+		return Share{}, err
+	}
+
+	sh.ID = id
+	sh.ThingID = thingID
+	sh.Key = key
+	sh.CreatedAt = getTimestmap()
+
+	if sh.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(sh.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return Share{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		sh.Password = string(hash)
+	}
+
+	return ts.shares.Save(ctx, sh)
+}
+
+func (ts *thingsService) ListSharesByThing(ctx context.Context, token, thingID string) ([]Share, error) {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return []Share{}, err
+	}
+
+	return ts.shares.RetrieveByThing(ctx, thingID)
+}
+
+func (ts *thingsService) RemoveShare(ctx context.Context, token, thingID, shareID string) error {
+	ar := AuthorizeReq{
+		Token:   token,
+		Object:  thingID,
+		Subject: ThingSub,
+		Action:  Editor,
+	}
+	if err := ts.Authorize(ctx, ar); err != nil {
+		return err
+	}
+
+	sh, err := ts.shares.RetrieveByID(ctx, shareID)
+	if err != nil {
+		return err
+	}
+
+	if sh.ThingID != thingID {
+		return errors.ErrNotFound
+	}
+
+	return ts.shares.Remove(ctx, shareID)
+}
+
+func (ts *thingsService) GetPubConfByShare(ctx context.Context, key, password string) (PubConfInfo, error) {
+	sh, err := ts.shares.RetrieveByKey(ctx, key)
+	if err != nil {
+		return PubConfInfo{}, errors.Wrap(errors.ErrAuthentication, err)
+	}
+
+	if sh.Expired() {
+		return PubConfInfo{}, errors.Wrap(errors.ErrAuthentication, ErrShareExpired)
+	}
+
+	if sh.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(sh.Password), []byte(password)); err != nil {
+			return PubConfInfo{}, errors.ErrAuthentication
+		}
+	}
+
+	profile, err := ts.profiles.RetrieveByThing(ctx, sh.ThingID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	orgID, err := ts.groupOrgID(ctx, profile.GroupID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	acls, err := ts.networkACLs(ctx, sh.ThingID, orgID)
+	if err != nil {
+		return PubConfInfo{}, err
+	}
+
+	return PubConfInfo{PublisherID: sh.ThingID, OrgID: orgID, ProfileConfig: profile.Config, NetworkACLs: acls, ProfileID: profile.ID, GroupID: profile.GroupID, ProfileVersion: profile.Version, ShareID: sh.ID, ExpiresAt: sh.ExpiresAt}, nil
+}
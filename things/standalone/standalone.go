@@ -45,10 +45,31 @@ func (repo singleUserRepo) Identify(ctx context.Context, token *protomfx.Token,
 	return &protomfx.UserIdentity{Id: repo.email, Email: repo.email}, nil
 }
 
+func (repo singleUserRepo) IdentifyBatch(ctx context.Context, req *protomfx.TokensReq, opts ...grpc.CallOption) (*protomfx.UserIdentitiesRes, error) {
+	ids := []*protomfx.UserIdentity{}
+	for _, token := range req.GetTokens() {
+		id, err := repo.Identify(ctx, &protomfx.Token{Value: token}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return &protomfx.UserIdentitiesRes{Identities: ids}, nil
+}
+
+func (repo singleUserRepo) IdentifyDelegated(ctx context.Context, token *protomfx.Token, opts ...grpc.CallOption) (*protomfx.DelegatedIdentity, error) {
+	return nil, errUnsupported
+}
+
 func (repo singleUserRepo) Authorize(ctx context.Context, req *protomfx.AuthorizeReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
 	return &empty.Empty{}, errUnsupported
 }
 
+func (repo singleUserRepo) AuthorizeBatch(ctx context.Context, req *protomfx.AuthorizeBatchReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	return &empty.Empty{}, errUnsupported
+}
+
 func (repo singleUserRepo) AssignRole(ctx context.Context, req *protomfx.AssignRoleReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
 	return &empty.Empty{}, errUnsupported
 }
@@ -56,3 +77,31 @@ func (repo singleUserRepo) AssignRole(ctx context.Context, req *protomfx.AssignR
 func (repo singleUserRepo) RetrieveRole(ctx context.Context, req *protomfx.RetrieveRoleReq, _ ...grpc.CallOption) (r *protomfx.RetrieveRoleRes, err error) {
 	return &protomfx.RetrieveRoleRes{}, errUnsupported
 }
+
+func (repo singleUserRepo) AssignMembers(ctx context.Context, req *protomfx.AssignMembersReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	return &empty.Empty{}, errUnsupported
+}
+
+func (repo singleUserRepo) SignPayload(ctx context.Context, req *protomfx.SignPayloadReq, _ ...grpc.CallOption) (*protomfx.SignPayloadRes, error) {
+	return &protomfx.SignPayloadRes{}, errUnsupported
+}
+
+func (repo singleUserRepo) RetrieveSigningKey(ctx context.Context, req *protomfx.SigningKeyReq, _ ...grpc.CallOption) (*protomfx.SigningKeyRes, error) {
+	return &protomfx.SigningKeyRes{}, errUnsupported
+}
+
+// CheckQuota allows creating any number of the resources, since the single
+// user deployments have no quotas.
+func (repo singleUserRepo) CheckQuota(ctx context.Context, req *protomfx.CheckQuotaReq, _ ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+func (repo singleUserRepo) RemoveUser(ctx context.Context, req *protomfx.RemoveUserReq, _ ...grpc.CallOption) (r *empty.Empty, err error) {
+	return &empty.Empty{}, errUnsupported
+}
+
+// FilterOrgMembers returns all of the provided members, since the single
+// user is the member of every org.
+func (repo singleUserRepo) FilterOrgMembers(ctx context.Context, req *protomfx.OrgMembersReq, _ ...grpc.CallOption) (*protomfx.OrgMembersRes, error) {
+	return &protomfx.OrgMembersRes{MemberIDs: req.GetMemberIDs()}, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package things
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+// OrgTemplate represents the default entities provisioned in each newly
+// created org, so that the tenant onboarding doesn't repeat the same
+// manual setup. The org template is configured by the platform admin.
+type OrgTemplate struct {
+	Groups    []GroupTemplate
+	UpdatedAt time.Time
+}
+
+// GroupTemplate represents the default group created in the new org,
+// together with its profiles.
+type GroupTemplate struct {
+	Name        string
+	Description string
+	Metadata    Metadata
+	Profiles    []ProfileTemplate
+}
+
+// ProfileTemplate represents the default profile created in the group of the new org.
+type ProfileTemplate struct {
+	Name     string
+	Config   map[string]interface{}
+	Metadata map[string]interface{}
+}
+
+// OrgTemplateRepository specifies an org template persistence API.
+type OrgTemplateRepository interface {
+	// Save persists the org template, replacing the existing one.
+	Save(ctx context.Context, ot OrgTemplate) error
+
+	// Retrieve retrieves the org template.
+	Retrieve(ctx context.Context) (OrgTemplate, error)
+
+	// Remove removes the org template.
+	Remove(ctx context.Context) error
+}
+
+// OrgTemplates specifies an API for managing the org template and
+// provisioning the newly created orgs.
+type OrgTemplates interface {
+	// UpdateOrgTemplate replaces the org template. Only accessible by admin.
+	UpdateOrgTemplate(ctx context.Context, token string, ot OrgTemplate) error
+
+	// ViewOrgTemplate retrieves the org template. Only accessible by admin.
+	ViewOrgTemplate(ctx context.Context, token string) (OrgTemplate, error)
+
+	// RemoveOrgTemplate removes the org template, so that the new orgs are
+	// no longer provisioned. Only accessible by admin.
+	RemoveOrgTemplate(ctx context.Context, token string) error
+
+	// ProvisionOrg creates the groups and profiles of the org template in
+	// the org identified by the provided ID, owned by the org owner. The org
+	// which already has groups is left as it is.
+	ProvisionOrg(ctx context.Context, orgID, ownerID string) ([]Group, error)
+}
+
+func (ts *thingsService) UpdateOrgTemplate(ctx context.Context, token string, ot OrgTemplate) error {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return err
+	}
+	ot.UpdatedAt = getTimestmap()
+
+	return ts.orgTemplates.Save(ctx, ot)
+}
+
+func (ts *thingsService) ViewOrgTemplate(ctx context.Context, token string) (OrgTemplate, error) {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return OrgTemplate{}, err
+	}
+
+	return ts.orgTemplates.Retrieve(ctx)
+}
+
+func (ts *thingsService) RemoveOrgTemplate(ctx context.Context, token string) error {
+	if err := ts.isAdmin(ctx, token); err != nil {
+		return err
+	}
+
+	return ts.orgTemplates.Remove(ctx)
+}
+
+func (ts *thingsService) ProvisionOrg(ctx context.Context, orgID, ownerID string) ([]Group, error) {
+	if orgID == "" || ownerID == "" {
+		return nil, errors.ErrMalformedEntity
+	}
+
+	ot, err := ts.orgTemplates.Retrieve(ctx)
+	if err != nil {
+		if errors.Contains(err, errors.ErrNotFound) {
+			return []Group{}, nil
+		}
+		return nil, err
+	}
+
+	gp, err := ts.groups.RetrieveByAdmin(ctx, orgID, PageMetadata{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if gp.Total > 0 {
+		return []Group{}, nil
+	}
+
+	timestamp := getTimestmap()
+	grs := []Group{}
+	for _, gt := range ot.Groups {
+		group := Group{
+			OrgID:       orgID,
+			Name:        gt.Name,
+			Description: gt.Description,
+			Metadata:    gt.Metadata,
+			CreatedAt:   timestamp,
+			UpdatedAt:   timestamp,
+		}
+
+		gr, err := ts.createGroup(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+
+		gm := GroupMember{
+			MemberID: ownerID,
+			GroupID:  gr.ID,
+			Role:     Owner,
+		}
+		if err := ts.roles.SaveRolesByGroup(ctx, gm); err != nil {
+			return nil, err
+		}
+
+		if err := ts.groupCache.SaveRole(ctx, gr.ID, ownerID, Owner); err != nil {
+			return nil, err
+		}
+
+		for _, pt := range gt.Profiles {
+			profile := Profile{
+				GroupID:  gr.ID,
+				Name:     pt.Name,
+				Config:   pt.Config,
+				Metadata: pt.Metadata,
+			}
+			if _, err := ts.createProfile(ctx, &profile); err != nil {
+				return nil, err
+			}
+		}
+
+		grs = append(grs, gr)
+	}
+
+	return grs, nil
+}
@@ -66,6 +66,9 @@ type ThingRepository interface {
 
 	// RetrieveByAdmin retrieves all things for all users with pagination.
 	RetrieveByAdmin(ctx context.Context, pm PageMetadata) (ThingsPage, error)
+
+	// RetrieveByKeyPrefix retrieves the subset of things whose keys start with the provided prefix.
+	RetrieveByKeyPrefix(ctx context.Context, prefix string, pm PageMetadata) (ThingsPage, error)
 }
 
 // ThingCache contains thing caching interface.
@@ -87,4 +90,7 @@ type ThingCache interface {
 
 	// RemoveGroup removes group ID by given thing ID.
 	RemoveGroup(context.Context, string) error
+
+	// Flush removes all things from cache.
+	Flush(context.Context) error
 }
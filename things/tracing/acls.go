@@ -0,0 +1,65 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/things"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveACLByThingOp      = "save_network_acl_by_thing"
+	saveACLByOrgOp        = "save_network_acl_by_org"
+	retrieveACLsByThingOp = "retrieve_network_acls_by_things"
+	retrieveACLsByOrgOp   = "retrieve_network_acls_by_orgs"
+)
+
+var _ things.NetworkACLRepository = (*networkACLRepositoryMiddleware)(nil)
+
+type networkACLRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   things.NetworkACLRepository
+}
+
+// NetworkACLRepositoryMiddleware tracks request and their latency, and adds spans to context.
+func NetworkACLRepositoryMiddleware(tracer opentracing.Tracer, nr things.NetworkACLRepository) things.NetworkACLRepository {
+	return networkACLRepositoryMiddleware{
+		tracer: tracer,
+		repo:   nr,
+	}
+}
+
+func (nrm networkACLRepositoryMiddleware) SaveByThing(ctx context.Context, thingID string, acl things.NetworkACL) error {
+	span := createSpan(ctx, nrm.tracer, saveACLByThingOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return nrm.repo.SaveByThing(ctx, thingID, acl)
+}
+
+func (nrm networkACLRepositoryMiddleware) SaveByOrg(ctx context.Context, orgID string, acl things.NetworkACL) error {
+	span := createSpan(ctx, nrm.tracer, saveACLByOrgOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return nrm.repo.SaveByOrg(ctx, orgID, acl)
+}
+
+func (nrm networkACLRepositoryMiddleware) RetrieveByThings(ctx context.Context, thingIDs ...string) (map[string]things.NetworkACL, error) {
+	span := createSpan(ctx, nrm.tracer, retrieveACLsByThingOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return nrm.repo.RetrieveByThings(ctx, thingIDs...)
+}
+
+func (nrm networkACLRepositoryMiddleware) RetrieveByOrgs(ctx context.Context, orgIDs ...string) (map[string]things.NetworkACL, error) {
+	span := createSpan(ctx, nrm.tracer, retrieveACLsByOrgOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return nrm.repo.RetrieveByOrgs(ctx, orgIDs...)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/things"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveGatewayOp            = "save_gateway"
+	retrieveGatewayByThingOp = "retrieve_gateway_by_thing"
+	removeGatewayOp          = "remove_gateway"
+)
+
+var _ things.GatewayRepository = (*gatewayRepositoryMiddleware)(nil)
+
+type gatewayRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   things.GatewayRepository
+}
+
+// GatewayRepositoryMiddleware tracks request and their latency, and adds spans to context.
+func GatewayRepositoryMiddleware(tracer opentracing.Tracer, gr things.GatewayRepository) things.GatewayRepository {
+	return gatewayRepositoryMiddleware{
+		tracer: tracer,
+		repo:   gr,
+	}
+}
+
+func (grm gatewayRepositoryMiddleware) Save(ctx context.Context, gw things.Gateway) error {
+	span := createSpan(ctx, grm.tracer, saveGatewayOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return grm.repo.Save(ctx, gw)
+}
+
+func (grm gatewayRepositoryMiddleware) RetrieveByThing(ctx context.Context, thingID string) (things.Gateway, error) {
+	span := createSpan(ctx, grm.tracer, retrieveGatewayByThingOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return grm.repo.RetrieveByThing(ctx, thingID)
+}
+
+func (grm gatewayRepositoryMiddleware) Remove(ctx context.Context, thingID string) error {
+	span := createSpan(ctx, grm.tracer, removeGatewayOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return grm.repo.Remove(ctx, thingID)
+}
@@ -11,6 +11,7 @@ const (
 	saveGroupOp                = "save_group"
 	saveOrgIDByGroupIDOp       = "save_org_id_by_group_id"
 	updateGroupOp              = "update_group"
+	transferGroupOp            = "transfer_group"
 	removeGroupOp              = "remove_group"
 	retrieveAllOp              = "retrieve_all"
 	retrieveGroupByIDOp        = "retrieve_group_by_id"
@@ -20,6 +21,7 @@ const (
 	retrieveRoleOp             = "retrieve_role"
 	removeRoleOp               = "remove_role"
 	retrieveGroupIDsByMemberOp = "retrieve_group_ids_by_member"
+	flushGroupsOp              = "flush_groups"
 )
 
 var _ things.GroupRepository = (*groupRepositoryMiddleware)(nil)
@@ -53,6 +55,14 @@ func (grm groupRepositoryMiddleware) Update(ctx context.Context, g things.Group)
 	return grm.repo.Update(ctx, g)
 }
 
+func (grm groupRepositoryMiddleware) Transfer(ctx context.Context, g things.Group, memberIDs ...string) (things.Group, error) {
+	span := createSpan(ctx, grm.tracer, transferGroupOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return grm.repo.Transfer(ctx, g, memberIDs...)
+}
+
 func (grm groupRepositoryMiddleware) Remove(ctx context.Context, groupIDs ...string) error {
 	span := createSpan(ctx, grm.tracer, removeGroupOp)
 	defer span.Finish()
@@ -161,3 +171,11 @@ func (gcm groupCacheMiddleware) GroupMemberships(ctx context.Context, memberID s
 
 	return gcm.cache.GroupMemberships(ctx, memberID)
 }
+
+func (gcm groupCacheMiddleware) Flush(ctx context.Context) error {
+	span := createSpan(ctx, gcm.tracer, flushGroupsOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return gcm.cache.Flush(ctx)
+}
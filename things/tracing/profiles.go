@@ -21,6 +21,7 @@ const (
 	removeGroupIDByProfileIDOp   = "remove_group_id_by_profile_id"
 	retrieveAllProfilesOp        = "retrieve_all_profiles"
 	retrieveGroupIDByProfileIDOp = "retrieve_group_id_by_profile_id"
+	flushProfilesOp              = "flush_profiles"
 )
 
 var (
@@ -143,3 +144,11 @@ func (ccm profileCacheMiddleware) RemoveGroup(ctx context.Context, profileID str
 
 	return ccm.cache.RemoveGroup(ctx, profileID)
 }
+
+func (ccm profileCacheMiddleware) Flush(ctx context.Context) error {
+	span := createSpan(ctx, ccm.tracer, flushProfilesOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return ccm.cache.Flush(ctx)
+}
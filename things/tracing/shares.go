@@ -0,0 +1,74 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/things"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveShareOp             = "save_share"
+	retrieveShareByIDOp     = "retrieve_share_by_id"
+	retrieveShareByKeyOp    = "retrieve_share_by_key"
+	retrieveSharesByThingOp = "retrieve_shares_by_thing"
+	removeShareOp           = "remove_share"
+)
+
+var _ things.ShareRepository = (*shareRepositoryMiddleware)(nil)
+
+type shareRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   things.ShareRepository
+}
+
+// ShareRepositoryMiddleware tracks request and their latency, and adds spans to context.
+func ShareRepositoryMiddleware(tracer opentracing.Tracer, sr things.ShareRepository) things.ShareRepository {
+	return shareRepositoryMiddleware{
+		tracer: tracer,
+		repo:   sr,
+	}
+}
+
+func (srm shareRepositoryMiddleware) Save(ctx context.Context, sh things.Share) (things.Share, error) {
+	span := createSpan(ctx, srm.tracer, saveShareOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.Save(ctx, sh)
+}
+
+func (srm shareRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (things.Share, error) {
+	span := createSpan(ctx, srm.tracer, retrieveShareByIDOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByID(ctx, id)
+}
+
+func (srm shareRepositoryMiddleware) RetrieveByKey(ctx context.Context, key string) (things.Share, error) {
+	span := createSpan(ctx, srm.tracer, retrieveShareByKeyOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByKey(ctx, key)
+}
+
+func (srm shareRepositoryMiddleware) RetrieveByThing(ctx context.Context, thingID string) ([]things.Share, error) {
+	span := createSpan(ctx, srm.tracer, retrieveSharesByThingOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByThing(ctx, thingID)
+}
+
+func (srm shareRepositoryMiddleware) Remove(ctx context.Context, id string) error {
+	span := createSpan(ctx, srm.tracer, removeShareOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.Remove(ctx, id)
+}
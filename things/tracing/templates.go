@@ -0,0 +1,56 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/things"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveOrgTemplateOp     = "save_org_template"
+	retrieveOrgTemplateOp = "retrieve_org_template"
+	removeOrgTemplateOp   = "remove_org_template"
+)
+
+var _ things.OrgTemplateRepository = (*orgTemplateRepositoryMiddleware)(nil)
+
+type orgTemplateRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   things.OrgTemplateRepository
+}
+
+// OrgTemplateRepositoryMiddleware tracks request and their latency, and adds spans to context.
+func OrgTemplateRepositoryMiddleware(tracer opentracing.Tracer, otr things.OrgTemplateRepository) things.OrgTemplateRepository {
+	return orgTemplateRepositoryMiddleware{
+		tracer: tracer,
+		repo:   otr,
+	}
+}
+
+func (otrm orgTemplateRepositoryMiddleware) Save(ctx context.Context, ot things.OrgTemplate) error {
+	span := createSpan(ctx, otrm.tracer, saveOrgTemplateOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return otrm.repo.Save(ctx, ot)
+}
+
+func (otrm orgTemplateRepositoryMiddleware) Retrieve(ctx context.Context) (things.OrgTemplate, error) {
+	span := createSpan(ctx, otrm.tracer, retrieveOrgTemplateOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return otrm.repo.Retrieve(ctx)
+}
+
+func (otrm orgTemplateRepositoryMiddleware) Remove(ctx context.Context) error {
+	span := createSpan(ctx, otrm.tracer, removeOrgTemplateOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return otrm.repo.Remove(ctx)
+}
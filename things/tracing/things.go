@@ -6,25 +6,28 @@ package tracing
 import (
 	"context"
 
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	"github.com/MainfluxLabs/mainflux/things"
 	opentracing "github.com/opentracing/opentracing-go"
 )
 
 const (
-	saveThingOp                = "save_thing"
-	saveThingsOp               = "save_things"
-	updateThingOp              = "update_thing"
-	updateThingKeyOp           = "update_thing_by_key"
-	retrieveThingByIDOp        = "retrieve_thing_by_id"
-	retrieveThingByKeyOp       = "retrieve_thing_by_key"
-	retrieveThingsByProfileOp  = "retrieve_things_by_profile"
-	retrieveThingsByGroupIDsOp = "retrieve_things_by_group_ids"
-	removeThingOp              = "remove_thing"
-	retrieveThingIDByKeyOp     = "retrieve_id_by_key"
-	retrieveAllThingsOp        = "retrieve_all_things"
-	saveGroupIDByThingIDOp     = "save_group_id_by_thing_id"
-	retrieveGroupIDByThingIDOp = "retrieve_group_id_by_thing_id"
-	removeGroupIDByThingIDOp   = "remove_group_id_by_thing_id"
+	saveThingOp                 = "save_thing"
+	saveThingsOp                = "save_things"
+	updateThingOp               = "update_thing"
+	updateThingKeyOp            = "update_thing_by_key"
+	retrieveThingByIDOp         = "retrieve_thing_by_id"
+	retrieveThingByKeyOp        = "retrieve_thing_by_key"
+	retrieveThingsByProfileOp   = "retrieve_things_by_profile"
+	retrieveThingsByGroupIDsOp  = "retrieve_things_by_group_ids"
+	retrieveThingsByKeyPrefixOp = "retrieve_things_by_key_prefix"
+	removeThingOp               = "remove_thing"
+	retrieveThingIDByKeyOp      = "retrieve_id_by_key"
+	retrieveAllThingsOp         = "retrieve_all_things"
+	saveGroupIDByThingIDOp      = "save_group_id_by_thing_id"
+	retrieveGroupIDByThingIDOp  = "retrieve_group_id_by_thing_id"
+	removeGroupIDByThingIDOp    = "remove_group_id_by_thing_id"
+	flushThingsOp               = "flush_things"
 )
 
 var (
@@ -126,6 +129,14 @@ func (trm thingRepositoryMiddleware) RetrieveByAdmin(ctx context.Context, pm thi
 	return trm.repo.RetrieveByAdmin(ctx, pm)
 }
 
+func (trm thingRepositoryMiddleware) RetrieveByKeyPrefix(ctx context.Context, prefix string, pm things.PageMetadata) (things.ThingsPage, error) {
+	span := createSpan(ctx, trm.tracer, retrieveThingsByKeyPrefixOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return trm.repo.RetrieveByKeyPrefix(ctx, prefix, pm)
+}
+
 type thingCacheMiddleware struct {
 	tracer opentracing.Tracer
 	cache  things.ThingCache
@@ -188,12 +199,21 @@ func (tcm thingCacheMiddleware) RemoveGroup(ctx context.Context, thingID string)
 	return tcm.cache.RemoveGroup(ctx, thingID)
 }
 
+func (tcm thingCacheMiddleware) Flush(ctx context.Context) error {
+	span := createSpan(ctx, tcm.tracer, flushThingsOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return tcm.cache.Flush(ctx)
+}
+
 func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
 	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
 		return tracer.StartSpan(
 			opName,
 			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
 		)
 	}
-	return tracer.StartSpan(opName)
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
 }
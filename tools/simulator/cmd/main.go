@@ -0,0 +1,79 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"log"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/tools/simulator"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	confFile := ""
+	sconf := simulator.Config{}
+
+	var rootCmd = &cobra.Command{
+		Use:   "simulator",
+		Short: "simulator is device simulation and load generation tool for Mainflux",
+		Long: `Tool for simulating series of Mainflux things publishing SenML or JSON traffic
+over MQTT, HTTP or WebSocket adapters, reporting publish latencies and failures.
+Complete documentation is available at https://mainfluxlabs.github.io/docs`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if confFile != "" {
+				viper.SetConfigFile(confFile)
+
+				if err := viper.ReadInConfig(); err != nil {
+					log.Printf("Failed to load config - %s", err.Error())
+				}
+
+				if err := viper.Unmarshal(&sconf); err != nil {
+					log.Printf("Unable to decode into struct, %v", err)
+				}
+			}
+
+			simulator.Simulate(sconf)
+		},
+	}
+
+	// Mainflux
+	rootCmd.PersistentFlags().StringVarP(&sconf.Mainflux.URL, "host", "", "http://localhost", "address for mainflux instance")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Mainflux.Token, "token", "", "", "mainflux user token")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Mainflux.Email, "email", "u", "", "mainflux user email, used if the token is not set")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Mainflux.Password, "password", "", "", "mainflux user password")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Mainflux.GroupID, "group", "g", "", "group of the registered things")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Mainflux.ProfileID, "profile", "", "", "profile of the registered things")
+
+	// Adapters
+	rootCmd.PersistentFlags().StringVarP(&sconf.Adapters.MQTTURL, "mqtt", "", "tcp://localhost:1883", "address for mqtt adapter")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Adapters.HTTPURL, "http", "", "http://localhost/http", "address for http adapter")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Adapters.WSURL, "ws", "", "ws://localhost/ws", "address for websocket adapter")
+	rootCmd.PersistentFlags().IntVarP(&sconf.Adapters.QoS, "qos", "q", 1, "QoS for mqtt messages, values 0 1 2")
+
+	// Things
+	rootCmd.PersistentFlags().StringVarP(&sconf.Things.File, "things", "t", "", "things file, created with the registered things if it doesn't exist")
+	rootCmd.PersistentFlags().IntVarP(&sconf.Things.Num, "num", "n", 10, "number of things to register")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Things.Prefix, "prefix", "", "simulator", "name prefix for registered things")
+
+	// Traffic
+	rootCmd.PersistentFlags().StringVarP(&sconf.Traffic.Protocol, "protocol", "p", "mqtt", "publish protocol: mqtt|http|ws")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Traffic.Format, "format", "f", "senml", "traffic format: senml|json")
+	rootCmd.PersistentFlags().StringVarP(&sconf.Traffic.Subtopic, "subtopic", "s", "", "subtopic of published messages")
+	rootCmd.PersistentFlags().Float64VarP(&sconf.Traffic.Rate, "rate", "r", 1, "messages per second published by each thing")
+	rootCmd.PersistentFlags().DurationVarP(&sconf.Traffic.Duration, "duration", "d", time.Minute, "duration of simulation")
+	rootCmd.PersistentFlags().DurationVarP(&sconf.Traffic.Timeout, "timeout", "", 5*time.Second, "connect and publish timeout")
+
+	// Output
+	rootCmd.PersistentFlags().StringVarP(&sconf.Output.Format, "output", "o", "text", "results output format: text|json")
+	rootCmd.PersistentFlags().BoolVarP(&sconf.Output.Quiet, "quiet", "", false, "suppress progress messages")
+
+	// Config file
+	rootCmd.PersistentFlags().StringVarP(&confFile, "config", "c", "", "config file for simulator")
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
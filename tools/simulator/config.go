@@ -0,0 +1,71 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import "time"
+
+const (
+	// MQTT represents the MQTT adapter protocol.
+	MQTT = "mqtt"
+	// HTTP represents the HTTP adapter protocol.
+	HTTP = "http"
+	// WS represents the WebSocket adapter protocol.
+	WS = "ws"
+
+	// SenML represents the SenML JSON traffic format.
+	SenML = "senml"
+	// JSON represents the plain JSON traffic format.
+	JSON = "json"
+
+	// TextOutput represents the human readable results output.
+	TextOutput = "text"
+	// JSONOutput represents the JSON results output.
+	JSONOutput = "json"
+)
+
+// Keep struct names exported, otherwise Viper unmarshalling won't work
+type mainfluxConfig struct {
+	URL       string `toml:"url" mapstructure:"url"`
+	Token     string `toml:"token" mapstructure:"token"`
+	Email     string `toml:"email" mapstructure:"email"`
+	Password  string `toml:"password" mapstructure:"password"`
+	GroupID   string `toml:"group_id" mapstructure:"group_id"`
+	ProfileID string `toml:"profile_id" mapstructure:"profile_id"`
+}
+
+type adaptersConfig struct {
+	MQTTURL string `toml:"mqtt_url" mapstructure:"mqtt_url"`
+	HTTPURL string `toml:"http_url" mapstructure:"http_url"`
+	WSURL   string `toml:"ws_url" mapstructure:"ws_url"`
+	QoS     int    `toml:"qos" mapstructure:"qos"`
+}
+
+type thingsConfig struct {
+	File   string `toml:"file" mapstructure:"file"`
+	Num    int    `toml:"num" mapstructure:"num"`
+	Prefix string `toml:"prefix" mapstructure:"prefix"`
+}
+
+type trafficConfig struct {
+	Protocol string        `toml:"protocol" mapstructure:"protocol"`
+	Format   string        `toml:"format" mapstructure:"format"`
+	Subtopic string        `toml:"subtopic" mapstructure:"subtopic"`
+	Rate     float64       `toml:"rate" mapstructure:"rate"`
+	Duration time.Duration `toml:"duration" mapstructure:"duration"`
+	Timeout  time.Duration `toml:"timeout" mapstructure:"timeout"`
+}
+
+type outputConfig struct {
+	Format string `toml:"format" mapstructure:"format"`
+	Quiet  bool   `toml:"quiet" mapstructure:"quiet"`
+}
+
+// Config struct holds simulation configuration
+type Config struct {
+	Mainflux mainfluxConfig `toml:"mainflux" mapstructure:"mainflux"`
+	Adapters adaptersConfig `toml:"adapters" mapstructure:"adapters"`
+	Things   thingsConfig   `toml:"things" mapstructure:"things"`
+	Traffic  trafficConfig  `toml:"traffic" mapstructure:"traffic"`
+	Output   outputConfig   `toml:"output" mapstructure:"output"`
+}
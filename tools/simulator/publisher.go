@@ -0,0 +1,166 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/gorilla/websocket"
+)
+
+var errPublishTimeout = errors.New("publish timed out")
+
+// publisher publishes the messages of the single thing.
+type publisher interface {
+	publish(payload []byte) error
+	close() error
+}
+
+func newPublisher(cfg Config, th thing, id int) (publisher, error) {
+	path := "/messages"
+	if cfg.Traffic.Subtopic != "" {
+		path = fmt.Sprintf("/messages/%s", strings.ReplaceAll(cfg.Traffic.Subtopic, ".", "/"))
+	}
+
+	switch cfg.Traffic.Protocol {
+	case MQTT:
+		return newMQTTPublisher(cfg, th, id, path)
+	case HTTP:
+		return newHTTPPublisher(cfg, th, path), nil
+	case WS:
+		return newWSPublisher(cfg, th, path)
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s", cfg.Traffic.Protocol)
+	}
+}
+
+type mqttPublisher struct {
+	client  mqtt.Client
+	topic   string
+	qos     byte
+	timeout time.Duration
+}
+
+func newMQTTPublisher(cfg Config, th thing, id int, topic string) (publisher, error) {
+	opts := mqtt.NewClientOptions().
+		AddBroker(cfg.Adapters.MQTTURL).
+		SetClientID(fmt.Sprintf("simulator-%d-%s", id, th.ThingID)).
+		SetUsername(th.ThingID).
+		SetPassword(th.ThingKey).
+		SetCleanSession(true).
+		SetAutoReconnect(true).
+		SetConnectTimeout(cfg.Traffic.Timeout)
+
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	if !token.WaitTimeout(cfg.Traffic.Timeout) {
+		return nil, errPublishTimeout
+	}
+	if err := token.Error(); err != nil {
+		return nil, err
+	}
+
+	return &mqttPublisher{
+		client:  client,
+		topic:   topic,
+		qos:     byte(cfg.Adapters.QoS),
+		timeout: cfg.Traffic.Timeout,
+	}, nil
+}
+
+func (mp *mqttPublisher) publish(payload []byte) error {
+	token := mp.client.Publish(mp.topic, mp.qos, false, payload)
+	if !token.WaitTimeout(mp.timeout) {
+		return errPublishTimeout
+	}
+
+	return token.Error()
+}
+
+func (mp *mqttPublisher) close() error {
+	mp.client.Disconnect(uint(mp.timeout.Milliseconds()))
+	return nil
+}
+
+type httpPublisher struct {
+	client      *http.Client
+	url         string
+	key         string
+	contentType string
+}
+
+func newHTTPPublisher(cfg Config, th thing, path string) publisher {
+	return &httpPublisher{
+		client:      &http.Client{Timeout: cfg.Traffic.Timeout},
+		url:         cfg.Adapters.HTTPURL + path,
+		key:         th.ThingKey,
+		contentType: contentType(cfg.Traffic.Format),
+	}
+}
+
+func (hp *httpPublisher) publish(payload []byte) error {
+	req, err := http.NewRequest(http.MethodPost, hp.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", hp.contentType)
+	req.Header.Set("Authorization", apiutil.ThingPrefix+hp.key)
+
+	res, err := hp.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return errors.New(res.Status)
+	}
+
+	return nil
+}
+
+func (hp *httpPublisher) close() error {
+	hp.client.CloseIdleConnections()
+	return nil
+}
+
+// wsPublisher publishes the messages over the WebSocket connection. Since the
+// WebSocket adapter doesn't acknowledge the messages, the latency covers only
+// writing the message to the connection.
+type wsPublisher struct {
+	conn    *websocket.Conn
+	timeout time.Duration
+}
+
+func newWSPublisher(cfg Config, th thing, path string) (publisher, error) {
+	u := fmt.Sprintf("%s%s?authorization=%s", cfg.Adapters.WSURL, path, url.QueryEscape(th.ThingKey))
+
+	dialer := websocket.Dialer{HandshakeTimeout: cfg.Traffic.Timeout}
+	conn, _, err := dialer.Dial(u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsPublisher{conn: conn, timeout: cfg.Traffic.Timeout}, nil
+}
+
+func (wp *wsPublisher) publish(payload []byte) error {
+	if err := wp.conn.SetWriteDeadline(time.Now().Add(wp.timeout)); err != nil {
+		return err
+	}
+
+	return wp.conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+func (wp *wsPublisher) close() error {
+	return wp.conn.Close()
+}
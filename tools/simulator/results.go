@@ -0,0 +1,136 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxErrors is the number of distinct publish errors kept in the results.
+const maxErrors = 10
+
+// recorder collects the publish latencies and failures of all things.
+type recorder struct {
+	mu        sync.Mutex
+	latencies []float64
+	failures  uint64
+	errors    map[string]uint64
+}
+
+func newRecorder() *recorder {
+	return &recorder{errors: make(map[string]uint64)}
+}
+
+func (r *recorder) record(latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		r.failures++
+		if _, ok := r.errors[err.Error()]; ok || len(r.errors) < maxErrors {
+			r.errors[err.Error()]++
+		}
+		return
+	}
+
+	r.latencies = append(r.latencies, float64(latency.Microseconds())/1000)
+}
+
+// Latency holds the publish latency statistics in milliseconds.
+type Latency struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// Results holds the simulation results.
+type Results struct {
+	Protocol   string            `json:"protocol"`
+	Format     string            `json:"format"`
+	Things     int               `json:"things"`
+	Rate       float64           `json:"rate"`
+	Duration   float64           `json:"duration"`
+	Sent       uint64            `json:"sent"`
+	Failed     uint64            `json:"failed"`
+	Throughput float64           `json:"throughput"`
+	Latency    Latency           `json:"latency_ms"`
+	Errors     map[string]uint64 `json:"errors,omitempty"`
+}
+
+func (r *recorder) results(cfg Config, things int, elapsed time.Duration) Results {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := Results{
+		Protocol:   cfg.Traffic.Protocol,
+		Format:     cfg.Traffic.Format,
+		Things:     things,
+		Rate:       cfg.Traffic.Rate,
+		Duration:   elapsed.Seconds(),
+		Sent:       uint64(len(r.latencies)),
+		Failed:     r.failures,
+		Throughput: float64(len(r.latencies)) / elapsed.Seconds(),
+		Errors:     r.errors,
+	}
+
+	if len(r.latencies) == 0 {
+		return res
+	}
+
+	lat := append([]float64{}, r.latencies...)
+	sort.Float64s(lat)
+
+	var sum float64
+	for _, l := range lat {
+		sum += l
+	}
+
+	res.Latency = Latency{
+		Min:  lat[0],
+		Mean: sum / float64(len(lat)),
+		P50:  percentile(lat, 50),
+		P95:  percentile(lat, 95),
+		P99:  percentile(lat, 99),
+		Max:  lat[len(lat)-1],
+	}
+
+	return res
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+func (res Results) write(w io.Writer, format string) error {
+	if format == JSONOutput {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res)
+	}
+
+	fmt.Fprintf(w, "Protocol: %s, format: %s, things: %d, rate: %.2f msg/s per thing\n", res.Protocol, res.Format, res.Things, res.Rate)
+	fmt.Fprintf(w, "Sent: %d, failed: %d, duration: %.2fs, throughput: %.2f msg/s\n", res.Sent, res.Failed, res.Duration, res.Throughput)
+	fmt.Fprintf(w, "Latency (ms): min %.2f, mean %.2f, p50 %.2f, p95 %.2f, p99 %.2f, max %.2f\n",
+		res.Latency.Min, res.Latency.Mean, res.Latency.P50, res.Latency.P95, res.Latency.P99, res.Latency.Max)
+	for e, n := range res.Errors {
+		fmt.Fprintf(w, "Error (%d times): %s\n", n, e)
+	}
+
+	return nil
+}
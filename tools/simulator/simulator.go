@@ -0,0 +1,136 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Simulate registers the virtual things, or loads the existing ones, and
+// publishes the simulated traffic of each thing at the configured rate until
+// the configured duration elapses or the simulation is interrupted. The
+// publish latencies and failures are written to the standard output.
+func Simulate(cfg Config) {
+	if err := validate(cfg); err != nil {
+		log.Fatalf("Invalid configuration: %s", err)
+	}
+
+	things, err := loadThings(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load things: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Traffic.Duration)
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigs:
+			log.Println("Simulation interrupted")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	rec := newRecorder()
+	interval := time.Duration(float64(time.Second) / cfg.Traffic.Rate)
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i, th := range things {
+		pub, err := newPublisher(cfg, th, i)
+		if err != nil {
+			log.Printf("Failed to connect thing %s: %s\n", th.ThingID, err)
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, pub publisher) {
+			defer wg.Done()
+			defer pub.close()
+
+			run(ctx, pub, newGenerator(cfg.Traffic.Format, start.UnixNano()+int64(i)), interval, rec)
+		}(i, pub)
+	}
+
+	if !cfg.Output.Quiet {
+		log.Printf("Publishing %s %s traffic of %d things for %s\n", cfg.Traffic.Protocol, cfg.Traffic.Format, len(things), cfg.Traffic.Duration)
+	}
+
+	wg.Wait()
+
+	res := rec.results(cfg, len(things), time.Since(start))
+	if err := res.write(os.Stdout, cfg.Output.Format); err != nil {
+		log.Fatalf("Failed to write results: %s", err)
+	}
+}
+
+// run publishes the messages of the single thing at the given interval. The
+// messages are published one at a time, so the ticks which elapse while the
+// previous message is being published are dropped, and the publisher doesn't
+// exceed the configured rate.
+func run(ctx context.Context, pub publisher, gen *generator, interval time.Duration, rec *recorder) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case t := <-ticker.C:
+			payload, err := gen.payload(t)
+			if err != nil {
+				rec.record(0, err)
+				continue
+			}
+
+			sent := time.Now()
+			err = pub.publish(payload)
+			rec.record(time.Since(sent), err)
+		}
+	}
+}
+
+func validate(cfg Config) error {
+	switch cfg.Traffic.Protocol {
+	case MQTT, HTTP, WS:
+	default:
+		return fmt.Errorf("unsupported protocol %s", cfg.Traffic.Protocol)
+	}
+
+	switch cfg.Traffic.Format {
+	case SenML, JSON:
+	default:
+		return fmt.Errorf("unsupported format %s", cfg.Traffic.Format)
+	}
+
+	switch cfg.Output.Format {
+	case TextOutput, JSONOutput:
+	default:
+		return fmt.Errorf("unsupported output format %s", cfg.Output.Format)
+	}
+
+	if cfg.Traffic.Rate <= 0 {
+		return fmt.Errorf("rate must be positive")
+	}
+
+	if cfg.Traffic.Duration <= 0 || cfg.Traffic.Timeout <= 0 {
+		return fmt.Errorf("duration and timeout must be positive")
+	}
+
+	if cfg.Adapters.QoS < 0 || cfg.Adapters.QoS > 2 {
+		return fmt.Errorf("invalid QoS %d", cfg.Adapters.QoS)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	sdk "github.com/MainfluxLabs/mainflux/pkg/sdk/go"
+	"github.com/pelletier/go-toml"
+)
+
+// thing represents the virtual device, in the format of the mqtt-bench connections file.
+type thing struct {
+	ThingID  string `toml:"thing_id" mapstructure:"thing_id"`
+	ThingKey string `toml:"thing_key" mapstructure:"thing_key"`
+}
+
+type thingsFile struct {
+	Things []thing `toml:"things" mapstructure:"things"`
+}
+
+// loadThings returns the things from the things file if it exists. Otherwise,
+// the things are registered and saved to the things file, so that the
+// following runs reuse them.
+func loadThings(cfg Config) ([]thing, error) {
+	if cfg.Things.File != "" {
+		data, err := os.ReadFile(cfg.Things.File)
+		switch {
+		case err == nil:
+			tf := thingsFile{}
+			if err := toml.Unmarshal(data, &tf); err != nil {
+				return nil, fmt.Errorf("failed to load things file %s: %w", cfg.Things.File, err)
+			}
+			if len(tf.Things) == 0 {
+				return nil, fmt.Errorf("things file %s contains no things", cfg.Things.File)
+			}
+			return tf.Things, nil
+		case !errors.Is(err, os.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	ths, err := registerThings(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Things.File != "" {
+		data, err := toml.Marshal(thingsFile{Things: ths})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(cfg.Things.File, data, 0600); err != nil {
+			return nil, err
+		}
+		log.Printf("Saved %d things to %s\n", len(ths), cfg.Things.File)
+	}
+
+	return ths, nil
+}
+
+func registerThings(cfg Config) ([]thing, error) {
+	if cfg.Things.Num <= 0 {
+		return nil, errors.New("number of things to register must be positive")
+	}
+
+	if cfg.Mainflux.GroupID == "" || cfg.Mainflux.ProfileID == "" {
+		return nil, errors.New("group and profile are required to register things")
+	}
+
+	s := sdk.NewSDK(sdk.Config{
+		AuthURL:   cfg.Mainflux.URL,
+		ThingsURL: cfg.Mainflux.URL,
+		UsersURL:  cfg.Mainflux.URL,
+	})
+
+	token := cfg.Mainflux.Token
+	if token == "" {
+		t, err := s.CreateToken(sdk.User{Email: cfg.Mainflux.Email, Password: cfg.Mainflux.Password})
+		if err != nil {
+			return nil, fmt.Errorf("failed to login user: %w", err)
+		}
+		token = t
+	}
+
+	ths := make([]sdk.Thing, cfg.Things.Num)
+	for i := range ths {
+		ths[i] = sdk.Thing{
+			Name:      fmt.Sprintf("%s-%d", cfg.Things.Prefix, i),
+			ProfileID: cfg.Mainflux.ProfileID,
+			Metadata:  map[string]interface{}{"simulated": true},
+		}
+	}
+
+	ths, err := s.CreateThings(ths, cfg.Mainflux.GroupID, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create things: %w", err)
+	}
+	log.Printf("Registered %d things\n", len(ths))
+
+	res := make([]thing, len(ths))
+	for i, th := range ths {
+		res[i] = thing{ThingID: th.ID, ThingKey: th.Key}
+	}
+
+	return res, nil
+}
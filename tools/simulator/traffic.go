@@ -0,0 +1,83 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package simulator
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// cycle is the period of the simulated measurements, so that a short run
+// still covers the whole range of the values.
+const cycle = 10 * time.Minute
+
+// sensor simulates the measurement which oscillates around its base value
+// with the random noise. Each thing has its own phase, so that the things
+// don't report the same values at the same time.
+type sensor struct {
+	name      string
+	unit      string
+	base      float64
+	amplitude float64
+	noise     float64
+}
+
+var sensors = []sensor{
+	{name: "temperature", unit: "Cel", base: 21, amplitude: 4, noise: 0.3},
+	{name: "humidity", unit: "%RH", base: 45, amplitude: 10, noise: 1},
+	{name: "voltage", unit: "V", base: 230, amplitude: 3, noise: 0.5},
+}
+
+type generator struct {
+	format string
+	phase  float64
+	rnd    *rand.Rand
+}
+
+func newGenerator(format string, seed int64) *generator {
+	rnd := rand.New(rand.NewSource(seed))
+	return &generator{
+		format: format,
+		phase:  rnd.Float64() * 2 * math.Pi,
+		rnd:    rnd,
+	}
+}
+
+func (g *generator) value(s sensor, t time.Time) float64 {
+	x := 2*math.Pi*float64(t.UnixNano()%int64(cycle))/float64(cycle) + g.phase
+	v := s.base + s.amplitude*math.Sin(x) + s.noise*g.rnd.NormFloat64()
+	return math.Round(v*100) / 100
+}
+
+// payload returns the message payload holding the values of all sensors measured at the given time.
+func (g *generator) payload(t time.Time) ([]byte, error) {
+	switch g.format {
+	case JSON:
+		p := map[string]interface{}{"created": t.UnixNano()}
+		for _, s := range sensors {
+			p[s.name] = g.value(s, t)
+		}
+		return json.Marshal(p)
+	default:
+		recs := []map[string]interface{}{}
+		for i, s := range sensors {
+			rec := map[string]interface{}{"n": s.name, "u": s.unit, "v": g.value(s, t)}
+			if i == 0 {
+				rec["bt"] = float64(t.UnixNano()) / float64(time.Second)
+			}
+			recs = append(recs, rec)
+		}
+		return json.Marshal(recs)
+	}
+}
+
+func contentType(format string) string {
+	if format == JSON {
+		return "application/json"
+	}
+
+	return "application/senml+json"
+}
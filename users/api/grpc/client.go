@@ -11,6 +11,7 @@ import (
 	"github.com/go-kit/kit/endpoint"
 	kitot "github.com/go-kit/kit/tracing/opentracing"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc"
 )
@@ -23,6 +24,7 @@ type grpcClient struct {
 	timeout          time.Duration
 	getUsersByIDs    endpoint.Endpoint
 	getUsersByEmails endpoint.Endpoint
+	getStats         endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -45,6 +47,14 @@ func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Du
 			decodeGetUsersResponse,
 			protomfx.UsersRes{},
 		).Endpoint()),
+		getStats: kitot.TraceClient(tracer, "get_stats")(kitgrpc.NewClient(
+			conn,
+			svcName,
+			"GetStats",
+			encodeGetStatsRequest,
+			decodeGetStatsResponse,
+			protomfx.UsersStatsRes{},
+		).Endpoint()),
 	}
 }
 
@@ -78,6 +88,20 @@ func (clent grpcClient) GetUsersByEmails(ctx context.Context, req *protomfx.User
 
 }
 
+func (clent grpcClient) GetStats(ctx context.Context, _ *empty.Empty, _ ...grpc.CallOption) (*protomfx.UsersStatsRes, error) {
+	ctx, close := context.WithTimeout(ctx, clent.timeout)
+	defer close()
+
+	res, err := clent.getStats(ctx, getStatsReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	sr := res.(getStatsRes)
+
+	return &protomfx.UsersStatsRes{Users: sr.users}, nil
+}
+
 func encodeGetUsersByIDsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(getUsersByIDsReq)
 	return &protomfx.UsersByIDsReq{Ids: req.ids}, nil
@@ -88,7 +112,16 @@ func encodeGetUsersByEmailsRequest(_ context.Context, grpcReq interface{}) (inte
 	return &protomfx.UsersByEmailsReq{Emails: req.emails}, nil
 }
 
+func encodeGetStatsRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return &empty.Empty{}, nil
+}
+
 func decodeGetUsersResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(*protomfx.UsersRes)
 	return getUsersRes{users: res.GetUsers()}, nil
 }
+
+func decodeGetStatsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*protomfx.UsersStatsRes)
+	return getStatsRes{users: res.GetUsers()}, nil
+}
@@ -64,3 +64,14 @@ func listUsersByEmailsEndpoint(svc users.Service) endpoint.Endpoint {
 		return getUsersRes{users: mu}, nil
 	}
 }
+
+func getStatsEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		st, err := svc.GetStats(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return getStatsRes{users: st.Users}, nil
+	}
+}
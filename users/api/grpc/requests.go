@@ -28,3 +28,5 @@ func (req getUsersByEmailsReq) validate() error {
 
 	return nil
 }
+
+type getStatsReq struct{}
@@ -8,3 +8,7 @@ import protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 type getUsersRes struct {
 	users []*protomfx.User
 }
+
+type getStatsRes struct {
+	users uint64
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/MainfluxLabs/mainflux/users"
 	kitot "github.com/go-kit/kit/tracing/opentracing"
 	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +23,7 @@ var _ protomfx.UsersServiceServer = (*grpcServer)(nil)
 type grpcServer struct {
 	getUsersByIDs    kitgrpc.Handler
 	getUsersByEmails kitgrpc.Handler
+	getStats         kitgrpc.Handler
 }
 
 // NewServer returns new UsersServiceServer instance.
@@ -38,6 +40,11 @@ func NewServer(tracer opentracing.Tracer, svc users.Service) protomfx.UsersServi
 			decodeGetUsersByEmailsRequest,
 			encodeGetUsersResponse,
 		),
+		getStats: kitgrpc.NewServer(
+			kitot.TraceServer(tracer, "get_stats")(getStatsEndpoint(svc)),
+			decodeGetStatsRequest,
+			encodeGetStatsResponse,
+		),
 	}
 }
 
@@ -59,6 +66,15 @@ func (s *grpcServer) GetUsersByEmails(ctx context.Context, req *protomfx.UsersBy
 	return res.(*protomfx.UsersRes), nil
 }
 
+func (s *grpcServer) GetStats(ctx context.Context, req *empty.Empty) (*protomfx.UsersStatsRes, error) {
+	_, res, err := s.getStats.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*protomfx.UsersStatsRes), nil
+}
+
 func decodeGetUsersByIDsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*protomfx.UsersByIDsReq)
 	return getUsersByIDsReq{ids: req.GetIds()}, nil
@@ -69,11 +85,20 @@ func decodeGetUsersByEmailsRequest(_ context.Context, grpcReq interface{}) (inte
 	return getUsersByEmailsReq{emails: req.GetEmails()}, nil
 }
 
+func decodeGetStatsRequest(_ context.Context, _ interface{}) (interface{}, error) {
+	return getStatsReq{}, nil
+}
+
 func encodeGetUsersResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
 	res := grpcRes.(getUsersRes)
 	return &protomfx.UsersRes{Users: res.users}, nil
 }
 
+func encodeGetStatsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(getStatsRes)
+	return &protomfx.UsersStatsRes{Users: res.users}, nil
+}
+
 func encodeError(err error) error {
 	switch {
 	case err == nil:
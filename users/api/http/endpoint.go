@@ -143,6 +143,8 @@ func listUsersEndpoint(svc users.Service) endpoint.Endpoint {
 			Limit:    req.limit,
 			Email:    req.email,
 			Status:   req.status,
+			Order:    req.order,
+			Dir:      req.dir,
 			Metadata: req.metadata,
 		}
 		up, err := svc.ListUsers(ctx, req.token, pm)
@@ -186,11 +188,11 @@ func passwordChangeEndpoint(svc users.Service) endpoint.Endpoint {
 
 func loginEndpoint(svc users.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(userReq)
+		req := request.(loginReq)
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
-		token, err := svc.Login(ctx, req.user)
+		token, err := svc.Login(ctx, req.user, req.attempt)
 		if err != nil {
 			return nil, err
 		}
@@ -259,6 +261,49 @@ func restoreEndpoint(svc users.Service) endpoint.Endpoint {
 	}
 }
 
+func importUsersEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importUsersReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		var ius []users.ImportedUser
+		for _, u := range req.Users {
+			iu := users.ImportedUser{
+				User: users.User{
+					Email:    u.Email,
+					Password: u.PasswordHash,
+					Metadata: u.Metadata,
+					Status:   u.Status,
+				},
+			}
+
+			for _, o := range u.Orgs {
+				iu.Memberships = append(iu.Memberships, users.OrgMembership{OrgID: o.OrgID, Role: o.Role})
+			}
+
+			ius = append(ius, iu)
+		}
+
+		us, err := svc.ImportUsers(ctx, req.token, ius...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := importUsersRes{Users: []viewUserRes{}}
+		for _, u := range us {
+			res.Users = append(res.Users, viewUserRes{
+				ID:       u.ID,
+				Email:    u.Email,
+				Metadata: u.Metadata,
+			})
+		}
+
+		return res, nil
+	}
+}
+
 func buildUsersResponse(up users.UserPage) userPageRes {
 	res := userPageRes{
 		pageRes: pageRes{
@@ -328,3 +373,52 @@ func buildBackup(req restoreReq) (users.User, []users.User) {
 
 	return admin, u
 }
+
+// Account deletion request endpoint. The link confirming the deletion is
+// generated in the same way as the password reset link, using the
+// MF_TOKEN_DELETION_ENDPOINT env and the value from Referer header for host.
+func requestDeletionEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(deletionReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RequestDeletion(ctx, req.token, req.Password, req.Host); err != nil {
+			return nil, err
+		}
+
+		return passwResetReqRes{Msg: DeletionMailSent}, nil
+	}
+}
+
+func confirmDeletionEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(confirmDeletionReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		scheduledAt, err := svc.ConfirmDeletion(ctx, req.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		return deletionRes{ScheduledAt: scheduledAt}, nil
+	}
+}
+
+func cancelDeletionEndpoint(svc users.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(cancelDeletionReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.CancelDeletion(ctx, req.token); err != nil {
+			return nil, err
+		}
+
+		return deleteRes{}, nil
+	}
+}
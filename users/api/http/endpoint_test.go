@@ -9,18 +9,21 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/users"
 	httpapi "github.com/MainfluxLabs/mainflux/users/api/http"
@@ -38,6 +41,7 @@ const (
 	validPass    = "password"
 	invalidToken = "invalid"
 	invalidPass  = "wrong"
+	captchaToken = "captcha-token"
 	prefix       = "fe6b4e92-cc98-425e-b0aa-"
 	userNum      = 101
 )
@@ -91,7 +95,7 @@ func newService() users.Service {
 	hasher := usmocks.NewHasher()
 	auth := mocks.NewAuthService(admin.ID, usersList)
 	email := usmocks.NewEmailer()
-	return users.New(usersRepo, hasher, auth, email, idProvider, passRegex)
+	return users.New(usersRepo, usmocks.NewDeletionRepository(), hasher, auth, email, idProvider, passRegex, time.Hour, nil, nil)
 }
 
 func newServer(svc users.Service) *httptest.Server {
@@ -257,6 +261,107 @@ func TestLogin(t *testing.T) {
 	}
 }
 
+func TestLoginThrottling(t *testing.T) {
+	usersRepo := usmocks.NewUserRepository(usersList)
+	auth := mocks.NewAuthService(admin.ID, usersList)
+	throttle := usmocks.NewLoginThrottle(users.ThrottleConfig{CaptchaAfter: 1, MaxFailures: 2})
+	captcha := usmocks.NewCaptchaVerifier(captchaToken)
+	svc := users.New(usersRepo, usmocks.NewDeletionRepository(), usmocks.NewHasher(), auth, usmocks.NewEmailer(), idProvider, passRegex, time.Hour, throttle, captcha)
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	credentials := func(password, captcha string) string {
+		return toJSON(map[string]string{"email": user.Email, "password": password, "captcha_token": captcha})
+	}
+
+	mfxTok, err := mocks.NewAuthService("", usersList).Issue(context.Background(), &protomfx.IssueReq{Id: user.ID, Email: user.Email, Type: 0})
+	require.Nil(t, err, fmt.Sprintf("issue token for user got unexpected error: %s", err))
+	tokenData := toJSON(map[string]string{"token": mfxTok.GetValue()})
+	captchaRes := toJSON(apiutil.ErrorRes{Err: users.ErrCaptchaRequired.Error()})
+	throttledRes := toJSON(apiutil.ErrorRes{Err: users.ErrLoginThrottled.Error()})
+
+	cases := []struct {
+		desc   string
+		req    string
+		status int
+		res    string
+	}{
+		{"login with invalid credentials", credentials("invalid_password", ""), http.StatusUnauthorized, unauthRes},
+		{"login without captcha after failed attempt", credentials(user.Password, ""), http.StatusUnauthorized, captchaRes},
+		{"login with invalid captcha after failed attempt", credentials(user.Password, "invalid"), http.StatusUnauthorized, captchaRes},
+		{"login with captcha after failed attempt", credentials(user.Password, captchaToken), http.StatusCreated, tokenData},
+		{"login with captcha and invalid credentials", credentials("invalid_password", captchaToken), http.StatusUnauthorized, unauthRes},
+		{"login after exceeding failed attempts", credentials(user.Password, captchaToken), http.StatusTooManyRequests, throttledRes},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      client,
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/tokens", ts.URL),
+			contentType: contentType,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		body, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		token := strings.Trim(string(body), "\n")
+
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res, token, fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, token))
+	}
+}
+
+func TestLoginThrottlingClientAddr(t *testing.T) {
+	_, loopback, err := net.ParseCIDR("127.0.0.0/8")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		proxies []*net.IPNet
+		status  int
+	}{
+		{
+			desc:   "login with changing forwarded address from untrusted address",
+			status: http.StatusTooManyRequests,
+		},
+		{
+			desc:    "login with changing forwarded address from trusted proxy",
+			proxies: []*net.IPNet{loopback},
+			status:  http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		usersRepo := usmocks.NewUserRepository(usersList)
+		auth := mocks.NewAuthService(admin.ID, usersList)
+		throttle := usmocks.NewLoginThrottle(users.ThrottleConfig{MaxFailures: 2})
+		svc := users.New(usersRepo, usmocks.NewDeletionRepository(), usmocks.NewHasher(), auth, usmocks.NewEmailer(), idProvider, passRegex, time.Hour, throttle, nil)
+		ts := httptest.NewServer(servershttp.ClientAddr(httpapi.MakeHandler(svc, mocktracer.New(), logger.NewMock()), tc.proxies))
+
+		var status int
+		for i := 0; i < 3; i++ {
+			// Each attempt uses another email, so that only the client
+			// address is throttled.
+			body := toJSON(map[string]string{"email": fmt.Sprintf("user%d@example.com", i), "password": invalidPass})
+			req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/tokens", ts.URL), strings.NewReader(body))
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			req.Header.Set("Content-Type", contentType)
+			req.Header.Set("X-Forwarded-For", fmt.Sprintf("192.0.2.%d", i+1))
+
+			res, err := ts.Client().Do(req)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			res.Body.Close()
+			status = res.StatusCode
+		}
+		ts.Close()
+
+		assert.Equal(t, tc.status, status, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, status))
+	}
+}
+
 func TestUser(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
@@ -303,7 +408,7 @@ func TestListUsers(t *testing.T) {
 	defer ts.Close()
 	client := ts.Client()
 
-	token, err := svc.Login(context.Background(), admin)
+	token, err := svc.Login(context.Background(), admin, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	var data []viewUserRes
@@ -356,15 +461,29 @@ func TestListUsers(t *testing.T) {
 			res:    data,
 		},
 		{
-			desc:   "get list of users with limit ordered by name descendent",
-			url:    fmt.Sprintf("%s/users?offset=%d&limit=%d&order=name&dir=desc", ts.URL, 0, 5),
+			desc:   "get list of users with limit ordered by email descendent",
+			url:    fmt.Sprintf("%s/users?offset=%d&limit=%d&order=email&dir=desc", ts.URL, 0, 5),
 			token:  token,
 			status: http.StatusOK,
 			res:    data[0:5],
 		},
 		{
-			desc:   "get list of users with invalid token",
+			desc:   "get list of users with invalid order",
 			url:    fmt.Sprintf("%s/users?offset=%d&limit=%d&order=wrong", ts.URL, 0, 5),
+			token:  token,
+			status: http.StatusBadRequest,
+			res:    nil,
+		},
+		{
+			desc:   "get list of users with invalid direction",
+			url:    fmt.Sprintf("%s/users?offset=%d&limit=%d&dir=wrong", ts.URL, 0, 5),
+			token:  token,
+			status: http.StatusBadRequest,
+			res:    nil,
+		},
+		{
+			desc:   "get list of users with invalid token",
+			url:    fmt.Sprintf("%s/users?offset=%d&limit=%d", ts.URL, 0, 5),
 			token:  invalidToken,
 			status: http.StatusUnauthorized,
 			res:    nil,
@@ -464,7 +583,7 @@ func TestUpdateUser(t *testing.T) {
 	defer ts.Close()
 	client := ts.Client()
 
-	token, err := svc.Login(context.Background(), user)
+	token, err := svc.Login(context.Background(), user, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	data := toJSON(metadata)
@@ -518,6 +637,65 @@ func TestUpdateUser(t *testing.T) {
 
 }
 
+func TestImportUsers(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	imported := map[string]interface{}{
+		"email":         "imported@example.com",
+		"password_hash": "$2a$10$hash",
+		"orgs":          []map[string]string{{"org_id": "574106f7-030e-4881-8ab0-151195c29f97", "role": "editor"}},
+	}
+
+	invalid := func(key string, value interface{}) string {
+		u := map[string]interface{}{}
+		for k, v := range imported {
+			u[k] = v
+		}
+		u[key] = value
+		return toJSON(map[string]interface{}{"users": []map[string]interface{}{u}})
+	}
+
+	data := toJSON(map[string]interface{}{"users": []map[string]interface{}{imported}})
+
+	cases := []struct {
+		desc        string
+		req         string
+		contentType string
+		token       string
+		status      int
+	}{
+		{"import users", data, contentType, admin.Email, http.StatusCreated},
+		{"import existing users", data, contentType, admin.Email, http.StatusConflict},
+		{"import users with empty list", `{"users":[]}`, contentType, admin.Email, http.StatusBadRequest},
+		{"import users without password hash", invalid("password_hash", ""), contentType, admin.Email, http.StatusBadRequest},
+		{"import users with invalid email", invalid("email", invalidEmail), contentType, admin.Email, http.StatusBadRequest},
+		{"import users with invalid status", invalid("status", "wrong"), contentType, admin.Email, http.StatusBadRequest},
+		{"import users with invalid org role", invalid("orgs", []map[string]string{{"org_id": "574106f7-030e-4881-8ab0-151195c29f97", "role": "owner"}}), contentType, admin.Email, http.StatusBadRequest},
+		{"import users with invalid request format", "{", contentType, admin.Email, http.StatusBadRequest},
+		{"import users with missing content type", data, "", admin.Email, http.StatusUnsupportedMediaType},
+		{"import users with non-admin token", data, contentType, user.Email, http.StatusForbidden},
+		{"import users with invalid token", data, contentType, invalidToken, http.StatusUnauthorized},
+		{"import users with empty token", data, contentType, "", http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      client,
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/users/import", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
 func TestPasswordResetRequest(t *testing.T) {
 	svc := newService()
 	ts := newServer(svc)
@@ -713,6 +891,142 @@ func TestPasswordChange(t *testing.T) {
 	}
 }
 
+func TestRequestDeletion(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	token, err := svc.Login(context.Background(), user, users.LoginAttempt{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	data := toJSON(struct {
+		Password string `json:"password"`
+	}{user.Password})
+	wrongPassData := toJSON(struct {
+		Password string `json:"password"`
+	}{invalidPass})
+
+	expected := toJSON(struct {
+		Msg string `json:"msg"`
+	}{
+		httpapi.DeletionMailSent,
+	})
+
+	cases := []struct {
+		desc        string
+		req         string
+		contentType string
+		token       string
+		status      int
+		res         string
+	}{
+		{"request deletion", data, contentType, token, http.StatusCreated, expected},
+		{"request deletion with wrong password", wrongPassData, contentType, token, http.StatusUnauthorized, unauthRes},
+		{"request deletion with invalid token", data, contentType, invalidToken, http.StatusUnauthorized, unauthRes},
+		{"request deletion with empty token", data, contentType, "", http.StatusUnauthorized, missingTokRes},
+		{"request deletion with empty JSON request", "{}", contentType, token, http.StatusBadRequest, missingPassRes},
+		{"request deletion with invalid request format", "{", contentType, token, http.StatusBadRequest, malformedRes},
+		{"request deletion with missing content type", data, "", token, http.StatusUnsupportedMediaType, unsupportedRes},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      client,
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/users/deletion", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		body, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		msg := strings.Trim(string(body), "\n")
+
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res, msg, fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, msg))
+	}
+}
+
+func TestConfirmDeletion(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	invalidData := toJSON(struct {
+		Token string `json:"token"`
+	}{invalidToken})
+
+	cases := []struct {
+		desc        string
+		req         string
+		contentType string
+		status      int
+		res         string
+	}{
+		{"confirm deletion with invalid token", invalidData, contentType, http.StatusUnauthorized, unauthRes},
+		{"confirm deletion with empty JSON request", "{}", contentType, http.StatusUnauthorized, missingTokRes},
+		{"confirm deletion with invalid request format", "{", contentType, http.StatusBadRequest, malformedRes},
+		{"confirm deletion with missing content type", invalidData, "", http.StatusUnsupportedMediaType, unsupportedRes},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      client,
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/users/deletion", ts.URL),
+			contentType: tc.contentType,
+			body:        strings.NewReader(tc.req),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		body, err := ioutil.ReadAll(res.Body)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		msg := strings.Trim(string(body), "\n")
+
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.res, msg, fmt.Sprintf("%s: expected body %s got %s", tc.desc, tc.res, msg))
+	}
+}
+
+func TestCancelDeletion(t *testing.T) {
+	svc := newService()
+	ts := newServer(svc)
+	defer ts.Close()
+	client := ts.Client()
+
+	token, err := svc.Login(context.Background(), user, users.LoginAttempt{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = svc.RequestDeletion(context.Background(), token, user.Password, "http://localhost")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		token  string
+		status int
+	}{
+		{"cancel deletion", token, http.StatusNoContent},
+		{"cancel cancelled deletion", token, http.StatusNotFound},
+		{"cancel deletion with invalid token", invalidToken, http.StatusUnauthorized},
+		{"cancel deletion with empty token", "", http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: client,
+			method: http.MethodDelete,
+			url:    fmt.Sprintf("%s/users/deletion", ts.URL),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
 type viewUserRes struct {
 	ID    string `json:"id"`
 	Email string `json:"email"`
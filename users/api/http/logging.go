@@ -30,10 +30,10 @@ func (lm *loggingMiddleware) SelfRegister(ctx context.Context, user users.User)
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method self_register for user %s took %s to complete", user.Email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 
 	}(time.Now())
 
@@ -44,10 +44,10 @@ func (lm *loggingMiddleware) RegisterAdmin(ctx context.Context, user users.User)
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method register_admin for user %s took %s to complete", user.Email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 
 	}(time.Now())
 
@@ -58,37 +58,37 @@ func (lm *loggingMiddleware) Register(ctx context.Context, token string, user us
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method register for user %s took %s to complete", user.Email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 
 	}(time.Now())
 
 	return lm.svc.Register(ctx, token, user)
 }
 
-func (lm *loggingMiddleware) Login(ctx context.Context, user users.User) (token string, err error) {
+func (lm *loggingMiddleware) Login(ctx context.Context, user users.User, attempt users.LoginAttempt) (token string, err error) {
 	defer func(begin time.Time) {
-		message := fmt.Sprintf("Method login for user %s and token %s took %s to complete", user.Email, token, time.Since(begin))
+		message := fmt.Sprintf("Method login for user %s from %s and token %s took %s to complete", user.Email, attempt.IP, token, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
-	return lm.svc.Login(ctx, user)
+	return lm.svc.Login(ctx, user, attempt)
 }
 
 func (lm *loggingMiddleware) ViewUser(ctx context.Context, token, id string) (u users.User, err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_user for user %s took %s to complete", u.Email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewUser(ctx, token, id)
@@ -98,10 +98,10 @@ func (lm *loggingMiddleware) ViewProfile(ctx context.Context, token string) (u u
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_profile for user %s took %s to complete", u.Email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewProfile(ctx, token)
@@ -111,10 +111,10 @@ func (lm *loggingMiddleware) ListUsers(ctx context.Context, token string, pm use
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_users took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListUsers(ctx, token, pm)
@@ -124,10 +124,10 @@ func (lm *loggingMiddleware) ListUsersByIDs(ctx context.Context, ids []string) (
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_users_by_ids for ids %s took %s to complete", ids, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListUsersByIDs(ctx, ids)
@@ -137,23 +137,36 @@ func (lm *loggingMiddleware) ListUsersByEmails(ctx context.Context, emails []str
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_users_by_emails for emails %s took %s to complete", emails, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListUsersByEmails(ctx, emails)
 }
 
+func (lm *loggingMiddleware) GetStats(ctx context.Context) (_ users.Stats, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method get_stats took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.GetStats(ctx)
+}
+
 func (lm *loggingMiddleware) UpdateUser(ctx context.Context, token string, u users.User) (err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_user for user %s took %s to complete", u.Email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateUser(ctx, token, u)
@@ -163,10 +176,10 @@ func (lm *loggingMiddleware) GenerateResetToken(ctx context.Context, email, host
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method generate_reset_token for user %s took %s to complete", email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.GenerateResetToken(ctx, email, host)
@@ -176,10 +189,10 @@ func (lm *loggingMiddleware) ChangePassword(ctx context.Context, email, password
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method change_password for user %s took %s to complete", email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ChangePassword(ctx, email, password, oldPassword)
@@ -189,10 +202,10 @@ func (lm *loggingMiddleware) ResetPassword(ctx context.Context, email, password
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method reset_password for user %s took %s to complete", email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ResetPassword(ctx, email, password)
@@ -202,10 +215,10 @@ func (lm *loggingMiddleware) SendPasswordReset(ctx context.Context, host, email,
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method send_password_reset for user %s took %s to complete", email, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.SendPasswordReset(ctx, host, email, token)
@@ -215,10 +228,10 @@ func (lm *loggingMiddleware) EnableUser(ctx context.Context, token string, id st
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method enable_user for user %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.EnableUser(ctx, token, id)
@@ -228,10 +241,10 @@ func (lm *loggingMiddleware) DisableUser(ctx context.Context, token string, id s
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method disable_user for user %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.DisableUser(ctx, token, id)
@@ -240,7 +253,7 @@ func (lm *loggingMiddleware) DisableUser(ctx context.Context, token string, id s
 func (lm *loggingMiddleware) Backup(ctx context.Context, token string) (users.User, []users.User, error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method backup took %s to complete", time.Since(begin))
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Backup(ctx, token)
@@ -249,8 +262,73 @@ func (lm *loggingMiddleware) Backup(ctx context.Context, token string) (users.Us
 func (lm *loggingMiddleware) Restore(ctx context.Context, token string, admin users.User, users []users.User) error {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method restore took %s to complete", time.Since(begin))
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Restore(ctx, token, admin, users)
 }
+
+func (lm *loggingMiddleware) ImportUsers(ctx context.Context, token string, ius ...users.ImportedUser) (us []users.User, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method import_users for %d users took %s to complete", len(ius), time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ImportUsers(ctx, token, ius...)
+}
+
+func (lm *loggingMiddleware) RequestDeletion(ctx context.Context, token, password, host string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method request_deletion took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RequestDeletion(ctx, token, password, host)
+}
+
+func (lm *loggingMiddleware) ConfirmDeletion(ctx context.Context, confirmToken string) (scheduledAt time.Time, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method confirm_deletion took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ConfirmDeletion(ctx, confirmToken)
+}
+
+func (lm *loggingMiddleware) CancelDeletion(ctx context.Context, token string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method cancel_deletion took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CancelDeletion(ctx, token)
+}
+
+func (lm *loggingMiddleware) RemoveScheduledUsers(ctx context.Context, now time.Time) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_scheduled_users took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveScheduledUsers(ctx, now)
+}
@@ -57,13 +57,13 @@ func (ms *metricsMiddleware) Register(ctx context.Context, token string, user us
 	return ms.svc.Register(ctx, token, user)
 }
 
-func (ms *metricsMiddleware) Login(ctx context.Context, user users.User) (string, error) {
+func (ms *metricsMiddleware) Login(ctx context.Context, user users.User, attempt users.LoginAttempt) (string, error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "login").Add(1)
 		ms.latency.With("method", "login").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.Login(ctx, user)
+	return ms.svc.Login(ctx, user, attempt)
 }
 
 func (ms *metricsMiddleware) ViewUser(ctx context.Context, token, id string) (users.User, error) {
@@ -111,6 +111,15 @@ func (ms *metricsMiddleware) ListUsersByEmails(ctx context.Context, emails []str
 	return ms.svc.ListUsersByEmails(ctx, emails)
 }
 
+func (ms *metricsMiddleware) GetStats(ctx context.Context) (users.Stats, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "get_stats").Add(1)
+		ms.latency.With("method", "get_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.GetStats(ctx)
+}
+
 func (ms *metricsMiddleware) UpdateUser(ctx context.Context, token string, u users.User) (err error) {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "update_user").Add(1)
@@ -191,3 +200,48 @@ func (ms *metricsMiddleware) Restore(ctx context.Context, token string, admin us
 
 	return ms.svc.Restore(ctx, token, admin, users)
 }
+
+func (ms *metricsMiddleware) ImportUsers(ctx context.Context, token string, ius ...users.ImportedUser) ([]users.User, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "import_users").Add(1)
+		ms.latency.With("method", "import_users").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ImportUsers(ctx, token, ius...)
+}
+
+func (ms *metricsMiddleware) RequestDeletion(ctx context.Context, token, password, host string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "request_deletion").Add(1)
+		ms.latency.With("method", "request_deletion").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RequestDeletion(ctx, token, password, host)
+}
+
+func (ms *metricsMiddleware) ConfirmDeletion(ctx context.Context, confirmToken string) (time.Time, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "confirm_deletion").Add(1)
+		ms.latency.With("method", "confirm_deletion").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ConfirmDeletion(ctx, confirmToken)
+}
+
+func (ms *metricsMiddleware) CancelDeletion(ctx context.Context, token string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "cancel_deletion").Add(1)
+		ms.latency.With("method", "cancel_deletion").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CancelDeletion(ctx, token)
+}
+
+func (ms *metricsMiddleware) RemoveScheduledUsers(ctx context.Context, now time.Time) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_scheduled_users").Add(1)
+		ms.latency.With("method", "remove_scheduled_users").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveScheduledUsers(ctx, now)
+}
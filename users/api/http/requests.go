@@ -4,7 +4,9 @@
 package http
 
 import (
+	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/email"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/users"
 )
@@ -12,13 +14,18 @@ import (
 const (
 	maxLimitSize = 100
 	maxEmailSize = 1024
+	emailOrder   = "email"
+	idOrder      = "id"
+	ascDir       = "asc"
+	descDir      = "desc"
 )
 
-type userReq struct {
-	user users.User
+type loginReq struct {
+	user    users.User
+	attempt users.LoginAttempt
 }
 
-func (req userReq) validate() error {
+func (req loginReq) validate() error {
 	return req.user.Validate()
 }
 
@@ -60,6 +67,8 @@ type listUsersReq struct {
 	offset   uint64
 	limit    uint64
 	email    string
+	order    string
+	dir      string
 	metadata users.Metadata
 }
 
@@ -81,6 +90,16 @@ func (req listUsersReq) validate() error {
 		return apiutil.ErrInvalidStatus
 	}
 
+	if req.order != "" &&
+		req.order != emailOrder && req.order != idOrder {
+		return apiutil.ErrInvalidOrder
+	}
+
+	if req.dir != "" &&
+		req.dir != ascDir && req.dir != descDir {
+		return apiutil.ErrInvalidDirection
+	}
+
 	return nil
 }
 
@@ -209,3 +228,105 @@ func (req restoreReq) validate() error {
 
 	return nil
 }
+
+type orgMembershipReq struct {
+	OrgID string `json:"org_id"`
+	Role  string `json:"role"`
+}
+
+type importUserReq struct {
+	Email        string                 `json:"email"`
+	PasswordHash string                 `json:"password_hash"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Status       string                 `json:"status,omitempty"`
+	Orgs         []orgMembershipReq     `json:"orgs,omitempty"`
+}
+
+type importUsersReq struct {
+	token string
+	Users []importUserReq `json:"users"`
+}
+
+func (req importUsersReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.Users) == 0 {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, u := range req.Users {
+		if u.PasswordHash == "" {
+			return apiutil.ErrMissingPass
+		}
+
+		if !email.IsEmail(u.Email) {
+			return apiutil.ErrMalformedEntity
+		}
+
+		if u.Status != "" &&
+			u.Status != users.EnabledStatusKey &&
+			u.Status != users.DisabledStatusKey {
+			return apiutil.ErrInvalidStatus
+		}
+
+		for _, o := range u.Orgs {
+			if o.OrgID == "" {
+				return apiutil.ErrMissingID
+			}
+
+			if o.Role != auth.Admin && o.Role != auth.Editor && o.Role != auth.Viewer {
+				return apiutil.ErrInvalidMemberRole
+			}
+		}
+	}
+
+	return nil
+}
+
+type deletionReq struct {
+	token    string
+	Password string `json:"password"`
+	Host     string `json:"host"`
+}
+
+func (req deletionReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.Password == "" {
+		return apiutil.ErrMissingPass
+	}
+
+	if req.Host == "" {
+		return apiutil.ErrMissingHost
+	}
+
+	return nil
+}
+
+type confirmDeletionReq struct {
+	Token string `json:"token"`
+}
+
+func (req confirmDeletionReq) validate() error {
+	if req.Token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
+
+type cancelDeletionReq struct {
+	token string
+}
+
+func (req cancelDeletionReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	return nil
+}
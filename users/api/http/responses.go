@@ -6,6 +6,7 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 )
@@ -16,11 +17,15 @@ var (
 	_ apiutil.Response = (*passwChangeRes)(nil)
 	_ apiutil.Response = (*createUserRes)(nil)
 	_ apiutil.Response = (*deleteRes)(nil)
+	_ apiutil.Response = (*deletionRes)(nil)
 )
 
 // MailSent message response when link is sent
 const MailSent = "Email with reset link is sent"
 
+// DeletionMailSent message response when account deletion confirmation link is sent
+const DeletionMailSent = "Email with account deletion confirmation link is sent"
+
 type pageRes struct {
 	Total  uint64 `json:"total"`
 	Offset uint64 `json:"offset"`
@@ -203,3 +208,35 @@ func (res restoreRes) Headers() map[string]string {
 func (res restoreRes) Empty() bool {
 	return true
 }
+
+type importUsersRes struct {
+	Users []viewUserRes `json:"users"`
+}
+
+func (res importUsersRes) Code() int {
+	return http.StatusCreated
+}
+
+func (res importUsersRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res importUsersRes) Empty() bool {
+	return false
+}
+
+type deletionRes struct {
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+func (res deletionRes) Code() int {
+	return http.StatusOK
+}
+
+func (res deletionRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deletionRes) Empty() bool {
+	return false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/users"
 	kitot "github.com/go-kit/kit/tracing/opentracing"
@@ -29,6 +30,8 @@ const (
 	emailKey    = "email"
 	metadataKey = "metadata"
 	statusKey   = "status"
+	orderKey    = "order"
+	dirKey      = "dir"
 	defOffset   = 0
 	defLimit    = 10
 )
@@ -139,6 +142,34 @@ func MakeHandler(svc users.Service, tracer opentracing.Tracer, logger logger.Log
 		opts...,
 	))
 
+	mux.Post("/users/import", kithttp.NewServer(
+		kitot.TraceServer(tracer, "import_users")(importUsersEndpoint(svc)),
+		decodeImportUsers,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Post("/users/deletion", kithttp.NewServer(
+		kitot.TraceServer(tracer, "request_deletion")(requestDeletionEndpoint(svc)),
+		decodeRequestDeletion,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Put("/users/deletion", kithttp.NewServer(
+		kitot.TraceServer(tracer, "confirm_deletion")(confirmDeletionEndpoint(svc)),
+		decodeConfirmDeletion,
+		encodeResponse,
+		opts...,
+	))
+
+	mux.Delete("/users/deletion", kithttp.NewServer(
+		kitot.TraceServer(tracer, "cancel_deletion")(cancelDeletionEndpoint(svc)),
+		decodeCancelDeletion,
+		encodeResponse,
+		opts...,
+	))
+
 	mux.GetFunc("/health", mainflux.Health("users"))
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -185,12 +216,25 @@ func decodeListUsers(_ context.Context, r *http.Request) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	or, err := apiutil.ReadStringQuery(r, orderKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := apiutil.ReadStringQuery(r, dirKey, "")
+	if err != nil {
+		return nil, err
+	}
+
 	req := listUsersReq{
 		token:    apiutil.ExtractBearerToken(r),
 		status:   s,
 		offset:   o,
 		limit:    l,
 		email:    e,
+		order:    or,
+		dir:      d,
 		metadata: m,
 	}
 	return req, nil
@@ -210,12 +254,27 @@ func decodeCredentials(_ context.Context, r *http.Request) (interface{}, error)
 		return nil, apiutil.ErrUnsupportedContentType
 	}
 
-	var user users.User
-	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+	var creds struct {
+		users.User
+		CaptchaToken string `json:"captcha_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&creds); err != nil {
 		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
 	}
-	user.Email = strings.TrimSpace(user.Email)
-	return userReq{user}, nil
+	creds.Email = strings.TrimSpace(creds.Email)
+
+	// The client address is taken from the X-Forwarded-For header only if
+	// the request is sent by the trusted proxy, so that the clients can't
+	// evade the throttling by changing the header.
+	req := loginReq{
+		user: creds.User,
+		attempt: users.LoginAttempt{
+			IP:           servershttp.ClientIP(r),
+			CaptchaToken: creds.CaptchaToken,
+		},
+	}
+
+	return req, nil
 }
 
 func decodeRegisterUser(_ context.Context, r *http.Request) (interface{}, error) {
@@ -319,6 +378,52 @@ func decodeRestore(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeImportUsers(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := importUsersReq{token: apiutil.ExtractBearerToken(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRequestDeletion(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := deletionReq{token: apiutil.ExtractBearerToken(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	req.Host = r.Header.Get("Referer")
+	return req, nil
+}
+
+func decodeConfirmDeletion(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	var req confirmDeletionReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeCancelDeletion(_ context.Context, r *http.Request) (interface{}, error) {
+	req := cancelDeletionReq{token: apiutil.ExtractBearerToken(r)}
+
+	return req, nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	if ar, ok := response.(apiutil.Response); ok {
 		for k, v := range ar.Headers() {
@@ -335,7 +440,7 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, apiutil.ErrInvalidQueryParams),
 		errors.Contains(err, apiutil.ErrMalformedEntity),
@@ -346,11 +451,21 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrMissingConfPass,
 		err == apiutil.ErrLimitSize,
 		err == apiutil.ErrOffsetSize,
-		err == apiutil.ErrInvalidResetPass:
+		err == apiutil.ErrInvalidOrder,
+		err == apiutil.ErrInvalidDirection,
+		err == apiutil.ErrInvalidResetPass,
+		err == apiutil.ErrInvalidStatus,
+		err == apiutil.ErrInvalidMemberRole,
+		err == apiutil.ErrEmptyList,
+		err == apiutil.ErrMissingID,
+		errors.Contains(err, users.ErrPasswordHash):
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrAuthentication),
+		errors.Contains(err, users.ErrCaptchaRequired),
 		err == apiutil.ErrBearerToken:
 		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Contains(err, users.ErrLoginThrottled):
+		w.WriteHeader(http.StatusTooManyRequests)
 	case errors.Contains(err, errors.ErrAuthorization):
 		w.WriteHeader(http.StatusForbidden)
 	case errors.Contains(err, errors.ErrConflict),
@@ -362,7 +477,8 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		w.WriteHeader(http.StatusNotFound)
 
 	case errors.Contains(err, uuid.ErrGeneratingID),
-		errors.Contains(err, users.ErrRecoveryToken):
+		errors.Contains(err, users.ErrRecoveryToken),
+		errors.Contains(err, users.ErrDeletionToken):
 		w.WriteHeader(http.StatusInternalServerError)
 
 	case errors.Contains(err, errors.ErrCreateEntity),
@@ -377,7 +493,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
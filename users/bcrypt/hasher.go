@@ -15,6 +15,7 @@ const cost int = 10
 var (
 	errHashPassword    = errors.New("Generate hash from password failed")
 	errComparePassword = errors.New("Compare hash and password failed")
+	errInvalidHash     = errors.New("Invalid bcrypt hash")
 )
 
 var _ users.Hasher = (*bcryptHasher)(nil)
@@ -42,3 +43,10 @@ func (bh *bcryptHasher) Compare(plain, hashed string) error {
 	}
 	return nil
 }
+
+func (bh *bcryptHasher) Validate(hashed string) error {
+	if _, err := bcrypt.Cost([]byte(hashed)); err != nil {
+		return errors.Wrap(errInvalidHash, err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package captcha contains the CAPTCHA verifier implementation using the
+// siteverify API of hCaptcha, which is also implemented by reCAPTCHA and
+// Cloudflare Turnstile.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+)
+
+// HCaptchaURL is the hCaptcha token verification URL.
+const HCaptchaURL = "https://api.hcaptcha.com/siteverify"
+
+var (
+	// ErrVerify indicates the failure to call the CAPTCHA verification API.
+	ErrVerify = errors.New("failed to verify captcha")
+
+	// ErrInvalidToken indicates that the CAPTCHA token is rejected by the
+	// verification API.
+	ErrInvalidToken = errors.New("invalid captcha token")
+)
+
+type verifyRes struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+var _ users.CaptchaVerifier = (*verifier)(nil)
+
+type verifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewVerifier returns the CAPTCHA verifier, which verifies the tokens using
+// the siteverify API at the given URL and the site secret.
+func NewVerifier(url, secret string, timeout time.Duration) users.CaptchaVerifier {
+	return &verifier{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (v *verifier) Verify(ctx context.Context, token, ip string) error {
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+	if ip != "" {
+		form.Set("remoteip", ip)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(ErrVerify, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return errors.Wrap(ErrVerify, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Wrap(ErrVerify, fmt.Errorf("unexpected status code %d", res.StatusCode))
+	}
+
+	var vr verifyRes
+	if err := json.NewDecoder(res.Body).Decode(&vr); err != nil {
+		return errors.Wrap(ErrVerify, err)
+	}
+
+	switch {
+	case vr.Success:
+		return nil
+	case len(vr.ErrorCodes) == 0:
+		return ErrInvalidToken
+	default:
+		return errors.Wrap(ErrInvalidToken, errors.New(strings.Join(vr.ErrorCodes, ", ")))
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package captcha_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users/captcha"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	secret          = "site-secret"
+	validToken      = "valid-token"
+	clientIP        = "10.0.0.1"
+	unavailablePath = "/unavailable"
+)
+
+func newServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == unavailablePath {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.PostForm.Get("secret") != secret:
+			w.Write([]byte(`{"success":false,"error-codes":["invalid-input-secret"]}`))
+		case r.PostForm.Get("response") == validToken && r.PostForm.Get("remoteip") == clientIP:
+			w.Write([]byte(`{"success":true}`))
+		default:
+			w.Write([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`))
+		}
+	}))
+}
+
+func TestVerify(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		url    string
+		secret string
+		token  string
+		err    error
+	}{
+		{
+			desc:   "verify valid token",
+			url:    ts.URL,
+			secret: secret,
+			token:  validToken,
+			err:    nil,
+		},
+		{
+			desc:   "verify invalid token",
+			url:    ts.URL,
+			secret: secret,
+			token:  "invalid-token",
+			err:    captcha.ErrInvalidToken,
+		},
+		{
+			desc:   "verify token with invalid secret",
+			url:    ts.URL,
+			secret: "invalid-secret",
+			token:  validToken,
+			err:    captcha.ErrInvalidToken,
+		},
+		{
+			desc:   "verify token with unavailable API",
+			url:    ts.URL + unavailablePath,
+			secret: secret,
+			token:  validToken,
+			err:    captcha.ErrVerify,
+		},
+	}
+
+	for _, tc := range cases {
+		v := captcha.NewVerifier(tc.url, tc.secret, time.Second)
+		err := v.Verify(context.Background(), tc.token, clientIP)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
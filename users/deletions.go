@@ -0,0 +1,143 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// deletionTokenDuration is the period within which the account deletion
+// has to be confirmed using the token sent by email.
+const deletionTokenDuration = 24 * time.Hour
+
+var (
+	// ErrDeletionToken indicates error in generating account deletion token.
+	ErrDeletionToken = errors.New("failed to generate account deletion token")
+
+	// ErrRemoveUser indicates failure to remove the scheduled user.
+	ErrRemoveUser = errors.New("failed to remove user")
+)
+
+// DeletionRequest represents the request of the user to delete their account.
+// The deletion is scheduled once the request is confirmed by email.
+type DeletionRequest struct {
+	UserID      string
+	Token       string
+	RequestedAt time.Time
+	ScheduledAt time.Time
+}
+
+// DeletionRepository specifies an account deletion requests persistence API.
+type DeletionRepository interface {
+	// Save persists the deletion request, replacing the previous request of
+	// the user, if any.
+	Save(ctx context.Context, dr DeletionRequest) error
+
+	// RetrieveByToken retrieves the deletion request by its confirmation token.
+	RetrieveByToken(ctx context.Context, token string) (DeletionRequest, error)
+
+	// RetrieveScheduled retrieves the confirmed deletion requests scheduled up
+	// to the provided time.
+	RetrieveScheduled(ctx context.Context, before time.Time) ([]DeletionRequest, error)
+
+	// Remove removes the deletion request of the user.
+	Remove(ctx context.Context, userID string) error
+}
+
+func (svc usersService) RequestDeletion(ctx context.Context, token, password, host string) error {
+	idn, err := svc.identify(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	// The root admin account can't be deleted, since it's needed to manage
+	// the platform.
+	if err := svc.isAdmin(ctx, token); err == nil {
+		return errors.ErrAuthorization
+	}
+
+	if _, err := svc.authenticate(ctx, idn.email, password); err != nil {
+		return errors.ErrAuthentication
+	}
+
+	t, err := svc.idProvider.ID()
+	if err != nil {
+		return errors.Wrap(ErrDeletionToken, err)
+	}
+
+	dr := DeletionRequest{
+		UserID:      idn.id,
+		Token:       t,
+		RequestedAt: time.Now().UTC(),
+	}
+	if err := svc.deletions.Save(ctx, dr); err != nil {
+		return err
+	}
+
+	return svc.email.SendDeletionConfirmation([]string{idn.email}, host, t)
+}
+
+func (svc usersService) ConfirmDeletion(ctx context.Context, confirmToken string) (time.Time, error) {
+	dr, err := svc.deletions.RetrieveByToken(ctx, confirmToken)
+	if err != nil {
+		return time.Time{}, errors.Wrap(errors.ErrAuthentication, err)
+	}
+
+	now := time.Now().UTC()
+	if now.After(dr.RequestedAt.Add(deletionTokenDuration)) {
+		return time.Time{}, errors.ErrAuthentication
+	}
+
+	// The token is cleared, so that the confirmation link can't be reused
+	// once the deletion is cancelled.
+	dr.Token = ""
+	dr.ScheduledAt = now.Add(svc.gracePeriod)
+	if err := svc.deletions.Save(ctx, dr); err != nil {
+		return time.Time{}, err
+	}
+
+	return dr.ScheduledAt, nil
+}
+
+func (svc usersService) CancelDeletion(ctx context.Context, token string) error {
+	idn, err := svc.identify(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return svc.deletions.Remove(ctx, idn.id)
+}
+
+func (svc usersService) RemoveScheduledUsers(ctx context.Context, now time.Time) error {
+	drs, err := svc.deletions.RetrieveScheduled(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	// The failed removals don't stop the others, and are retried on the
+	// next run, since the deletion requests are removed along with the users.
+	var rerr error
+	for _, dr := range drs {
+		if err := svc.removeUser(ctx, dr.UserID); err != nil {
+			rerr = errors.Wrap(ErrRemoveUser, err)
+		}
+	}
+
+	return rerr
+}
+
+// removeUser removes the user data owned by the auth service, which in turn
+// triggers the removal of the data owned by the other services, before the
+// user account itself.
+func (svc usersService) removeUser(ctx context.Context, id string) error {
+	if _, err := svc.auth.RemoveUser(ctx, &protomfx.RemoveUserReq{Id: id}); err != nil {
+		return err
+	}
+
+	return svc.users.Remove(ctx, id)
+}
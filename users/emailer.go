@@ -6,4 +6,5 @@ package users
 // Emailer wrapper around the email
 type Emailer interface {
 	SendPasswordReset(To []string, host, token string) error
+	SendDeletionConfirmation(To []string, host, token string) error
 }
@@ -12,4 +12,7 @@ type Hasher interface {
 	// Compare compares plain-text version to the hashed one. An error should
 	// indicate failed comparison.
 	Compare(string, string) error
+
+	// Validate checks whether the given string is a valid hash.
+	Validate(string) error
 }
@@ -0,0 +1,74 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+)
+
+var _ users.DeletionRepository = (*deletionRepositoryMock)(nil)
+
+type deletionRepositoryMock struct {
+	mu        sync.Mutex
+	deletions map[string]users.DeletionRequest
+}
+
+// NewDeletionRepository creates in-memory account deletion requests repository.
+func NewDeletionRepository() users.DeletionRepository {
+	return &deletionRepositoryMock{
+		deletions: make(map[string]users.DeletionRequest),
+	}
+}
+
+func (drm *deletionRepositoryMock) Save(_ context.Context, dr users.DeletionRequest) error {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	drm.deletions[dr.UserID] = dr
+	return nil
+}
+
+func (drm *deletionRepositoryMock) RetrieveByToken(_ context.Context, token string) (users.DeletionRequest, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	for _, dr := range drm.deletions {
+		if token != "" && dr.Token == token {
+			return dr, nil
+		}
+	}
+
+	return users.DeletionRequest{}, errors.ErrNotFound
+}
+
+func (drm *deletionRepositoryMock) RetrieveScheduled(_ context.Context, before time.Time) ([]users.DeletionRequest, error) {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	drs := []users.DeletionRequest{}
+	for _, dr := range drm.deletions {
+		if !dr.ScheduledAt.IsZero() && !dr.ScheduledAt.After(before) {
+			drs = append(drs, dr)
+		}
+	}
+
+	return drs, nil
+}
+
+func (drm *deletionRepositoryMock) Remove(_ context.Context, userID string) error {
+	drm.mu.Lock()
+	defer drm.mu.Unlock()
+
+	if _, ok := drm.deletions[userID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	delete(drm.deletions, userID)
+	return nil
+}
@@ -32,3 +32,11 @@ func (hm *hasherMock) Compare(plain, hashed string) error {
 
 	return nil
 }
+
+func (hm *hasherMock) Validate(hashed string) error {
+	if hashed == "" {
+		return errors.ErrMalformedEntity
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+)
+
+var _ users.LoginThrottle = (*throttleMock)(nil)
+
+type throttleMock struct {
+	mu       sync.Mutex
+	cfg      users.ThrottleConfig
+	failures map[string]int
+}
+
+// NewLoginThrottle creates in-memory login throttle, whose failed attempts
+// are never forgotten.
+func NewLoginThrottle(cfg users.ThrottleConfig) users.LoginThrottle {
+	return &throttleMock{
+		cfg:      cfg,
+		failures: make(map[string]int),
+	}
+}
+
+func (tm *throttleMock) Check(_ context.Context, ip, email string) (bool, error) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	failures := tm.failures["ip:"+ip]
+	if n := tm.failures["email:"+strings.ToLower(email)]; n > failures {
+		failures = n
+	}
+
+	if tm.cfg.MaxFailures > 0 && failures >= tm.cfg.MaxFailures {
+		return false, users.ErrLoginThrottled
+	}
+
+	return tm.cfg.CaptchaAfter > 0 && failures >= tm.cfg.CaptchaAfter, nil
+}
+
+func (tm *throttleMock) Fail(_ context.Context, ip, email string) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	tm.failures["ip:"+ip]++
+	tm.failures["email:"+strings.ToLower(email)]++
+
+	return nil
+}
+
+func (tm *throttleMock) Reset(_ context.Context, email string) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	delete(tm.failures, "email:"+strings.ToLower(email))
+
+	return nil
+}
+
+var _ users.CaptchaVerifier = (*captchaMock)(nil)
+
+type captchaMock struct {
+	token string
+}
+
+// NewCaptchaVerifier creates CAPTCHA verifier which accepts only the given token.
+func NewCaptchaVerifier(token string) users.CaptchaVerifier {
+	return &captchaMock{token: token}
+}
+
+func (cm *captchaMock) Verify(_ context.Context, token, _ string) error {
+	if token != cm.token {
+		return errors.ErrAuthentication
+	}
+
+	return nil
+}
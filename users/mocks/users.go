@@ -49,6 +49,24 @@ func (urm *userRepositoryMock) Save(ctx context.Context, u users.User) (string,
 	return u.ID, nil
 }
 
+func (urm *userRepositoryMock) SaveUsers(ctx context.Context, us ...users.User) error {
+	urm.mu.Lock()
+	defer urm.mu.Unlock()
+
+	for _, u := range us {
+		if _, ok := urm.usersByEmail[u.Email]; ok {
+			return errors.ErrConflict
+		}
+	}
+
+	for _, u := range us {
+		urm.usersByEmail[u.Email] = u
+		urm.usersByID[u.ID] = u
+	}
+
+	return nil
+}
+
 func (urm *userRepositoryMock) Update(ctx context.Context, u users.User) error {
 	urm.mu.Lock()
 	defer urm.mu.Unlock()
@@ -177,6 +195,19 @@ func (urm *userRepositoryMock) ChangeStatus(ctx context.Context, id, status stri
 	return nil
 }
 
+func (urm *userRepositoryMock) Remove(ctx context.Context, id string) error {
+	urm.mu.Lock()
+	defer urm.mu.Unlock()
+
+	u, ok := urm.usersByID[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	delete(urm.usersByID, id)
+	delete(urm.usersByEmail, u.Email)
+	return nil
+}
+
 func sortUsers(us map[string]users.User) []users.User {
 	users := []users.User{}
 	ids := make([]string, 0, len(us))
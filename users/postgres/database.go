@@ -20,6 +20,7 @@ type Database interface {
 	QueryRowxContext(context.Context, string, ...interface{}) *sqlx.Row
 	NamedQueryContext(context.Context, string, interface{}) (*sqlx.Rows, error)
 	GetContext(context.Context, interface{}, string, ...interface{}) error
+	BeginTxx(context.Context, *sql.TxOptions) (*sqlx.Tx, error)
 }
 
 // NewDatabase creates a ThingDatabase instance
@@ -49,6 +50,16 @@ func (dm database) GetContext(ctx context.Context, dest interface{}, query strin
 	return dm.db.GetContext(ctx, dest, query, args...)
 }
 
+func (dm database) BeginTxx(ctx context.Context, opts *sql.TxOptions) (*sqlx.Tx, error) {
+	span := opentracing.SpanFromContext(ctx)
+	if span != nil {
+		span.SetTag("span.kind", "client")
+		span.SetTag("peer.service", "postgres")
+		span.SetTag("db.type", "sql")
+	}
+	return dm.db.BeginTxx(ctx, opts)
+}
+
 func addSpanTags(ctx context.Context, query string) {
 	span := opentracing.SpanFromContext(ctx)
 	if span != nil {
@@ -0,0 +1,139 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ users.DeletionRepository = (*deletionRepository)(nil)
+
+type deletionRepository struct {
+	db Database
+}
+
+// NewDeletionRepo instantiates a PostgreSQL implementation of account
+// deletion requests repository.
+func NewDeletionRepo(db Database) users.DeletionRepository {
+	return &deletionRepository{
+		db: db,
+	}
+}
+
+func (dr deletionRepository) Save(ctx context.Context, d users.DeletionRequest) error {
+	q := `INSERT INTO user_deletions (user_id, token, requested_at, scheduled_at)
+		  VALUES (:user_id, :token, :requested_at, :scheduled_at)
+		  ON CONFLICT (user_id) DO UPDATE SET token = :token, requested_at = :requested_at, scheduled_at = :scheduled_at`
+
+	if _, err := dr.db.NamedExecContext(ctx, q, toDBDeletion(d)); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.ForeignKeyViolation:
+				return errors.Wrap(errors.ErrNotFound, err)
+			}
+		}
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (dr deletionRepository) RetrieveByToken(ctx context.Context, token string) (users.DeletionRequest, error) {
+	q := `SELECT user_id, token, requested_at, scheduled_at FROM user_deletions WHERE token = $1 AND token <> ''`
+
+	var dbd dbDeletion
+	if err := dr.db.QueryRowxContext(ctx, q, token).StructScan(&dbd); err != nil {
+		if err == sql.ErrNoRows {
+			return users.DeletionRequest{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return users.DeletionRequest{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return toDeletion(dbd), nil
+}
+
+func (dr deletionRepository) RetrieveScheduled(ctx context.Context, before time.Time) ([]users.DeletionRequest, error) {
+	q := `SELECT user_id, token, requested_at, scheduled_at FROM user_deletions
+		  WHERE scheduled_at IS NOT NULL AND scheduled_at <= :before ORDER BY scheduled_at`
+
+	params := map[string]interface{}{
+		"before": before,
+	}
+
+	rows, err := dr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	drs := []users.DeletionRequest{}
+	for rows.Next() {
+		var dbd dbDeletion
+		if err := rows.StructScan(&dbd); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		drs = append(drs, toDeletion(dbd))
+	}
+
+	return drs, nil
+}
+
+func (dr deletionRepository) Remove(ctx context.Context, userID string) error {
+	q := `DELETE FROM user_deletions WHERE user_id = :user_id`
+
+	res, err := dr.db.NamedExecContext(ctx, q, dbDeletion{UserID: userID})
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok && pgErr.Code == pgerrcode.InvalidTextRepresentation {
+			return errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+type dbDeletion struct {
+	UserID      string       `db:"user_id"`
+	Token       string       `db:"token"`
+	RequestedAt time.Time    `db:"requested_at"`
+	ScheduledAt sql.NullTime `db:"scheduled_at"`
+}
+
+func toDBDeletion(d users.DeletionRequest) dbDeletion {
+	return dbDeletion{
+		UserID:      d.UserID,
+		Token:       d.Token,
+		RequestedAt: d.RequestedAt,
+		ScheduledAt: sql.NullTime{Time: d.ScheduledAt, Valid: !d.ScheduledAt.IsZero()},
+	}
+}
+
+func toDeletion(dbd dbDeletion) users.DeletionRequest {
+	return users.DeletionRequest{
+		UserID:      dbd.UserID,
+		Token:       dbd.Token,
+		RequestedAt: dbd.RequestedAt,
+		ScheduledAt: dbd.ScheduledAt.Time,
+	}
+}
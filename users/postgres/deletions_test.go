@@ -0,0 +1,198 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/MainfluxLabs/mainflux/users/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const invalidID = "invalid"
+
+func createUser(t *testing.T, repo users.UserRepository) users.User {
+	uid, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	user := users.User{
+		ID:       uid,
+		Email:    fmt.Sprintf("%s@example.com", uid),
+		Password: password,
+		Status:   users.EnabledStatusKey,
+	}
+	_, err = repo.Save(context.Background(), user)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	return user
+}
+
+func TestSaveDeletion(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	userRepo := postgres.NewUserRepo(dbMiddleware)
+	repo := postgres.NewDeletionRepo(dbMiddleware)
+
+	user := createUser(t, userRepo)
+	wrongID, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	now := time.Now()
+
+	cases := []struct {
+		desc     string
+		deletion users.DeletionRequest
+		err      error
+	}{
+		{
+			desc:     "save deletion request",
+			deletion: users.DeletionRequest{UserID: user.ID, Token: "token", RequestedAt: now},
+			err:      nil,
+		},
+		{
+			desc:     "replace deletion request",
+			deletion: users.DeletionRequest{UserID: user.ID, RequestedAt: now, ScheduledAt: now.Add(time.Hour)},
+			err:      nil,
+		},
+		{
+			desc:     "save deletion request of non-existing user",
+			deletion: users.DeletionRequest{UserID: wrongID, Token: "token", RequestedAt: now},
+			err:      errors.ErrNotFound,
+		},
+		{
+			desc:     "save deletion request with invalid user id",
+			deletion: users.DeletionRequest{UserID: invalidID, Token: "token", RequestedAt: now},
+			err:      errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Save(context.Background(), tc.deletion)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	// The replaced request is no longer retrieved by its token.
+	_, err = repo.RetrieveByToken(context.Background(), "token")
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("retrieve replaced deletion request: expected %s got %s\n", errors.ErrNotFound, err))
+}
+
+func TestRetrieveDeletionByToken(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	userRepo := postgres.NewUserRepo(dbMiddleware)
+	repo := postgres.NewDeletionRepo(dbMiddleware)
+
+	user := createUser(t, userRepo)
+	confirmed := createUser(t, userRepo)
+
+	token, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = repo.Save(context.Background(), users.DeletionRequest{UserID: user.ID, Token: token, RequestedAt: time.Now()})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = repo.Save(context.Background(), users.DeletionRequest{UserID: confirmed.ID, RequestedAt: time.Now(), ScheduledAt: time.Now()})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		token  string
+		userID string
+		err    error
+	}{
+		{
+			desc:   "retrieve deletion request by existing token",
+			token:  token,
+			userID: user.ID,
+			err:    nil,
+		},
+		{
+			desc:   "retrieve deletion request by non-existing token",
+			token:  "non-existing",
+			userID: "",
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "retrieve deletion request by empty token",
+			token:  "",
+			userID: "",
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByToken(context.Background(), tc.token)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.userID, res.UserID, fmt.Sprintf("%s: expected user %s got %s\n", tc.desc, tc.userID, res.UserID))
+	}
+}
+
+func TestRetrieveScheduledDeletions(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	userRepo := postgres.NewUserRepo(dbMiddleware)
+	repo := postgres.NewDeletionRepo(dbMiddleware)
+
+	now := time.Now()
+	due := createUser(t, userRepo)
+	notDue := createUser(t, userRepo)
+	unconfirmed := createUser(t, userRepo)
+
+	err := repo.Save(context.Background(), users.DeletionRequest{UserID: due.ID, RequestedAt: now, ScheduledAt: now.Add(-time.Minute)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = repo.Save(context.Background(), users.DeletionRequest{UserID: notDue.ID, RequestedAt: now, ScheduledAt: now.Add(time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = repo.Save(context.Background(), users.DeletionRequest{UserID: unconfirmed.ID, Token: unconfirmed.ID, RequestedAt: now})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	drs, err := repo.RetrieveScheduled(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	ids := map[string]bool{}
+	for _, d := range drs {
+		ids[d.UserID] = true
+	}
+	assert.True(t, ids[due.ID], "expected due deletion request to be retrieved")
+	assert.False(t, ids[notDue.ID], "expected deletion request which isn't due not to be retrieved")
+	assert.False(t, ids[unconfirmed.ID], "expected unconfirmed deletion request not to be retrieved")
+}
+
+func TestRemoveDeletion(t *testing.T) {
+	dbMiddleware := postgres.NewDatabase(db)
+	userRepo := postgres.NewUserRepo(dbMiddleware)
+	repo := postgres.NewDeletionRepo(dbMiddleware)
+
+	user := createUser(t, userRepo)
+	err := repo.Save(context.Background(), users.DeletionRequest{UserID: user.ID, Token: user.ID, RequestedAt: time.Now()})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		userID string
+		err    error
+	}{
+		{
+			desc:   "remove existing deletion request",
+			userID: user.ID,
+			err:    nil,
+		},
+		{
+			desc:   "remove removed deletion request",
+			userID: user.ID,
+			err:    errors.ErrNotFound,
+		},
+		{
+			desc:   "remove deletion request with invalid user id",
+			userID: invalidID,
+			err:    errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.userID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
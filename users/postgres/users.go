@@ -66,6 +66,48 @@ func (ur userRepository) Save(ctx context.Context, user users.User) (string, err
 	return id, nil
 }
 
+func (ur userRepository) SaveUsers(ctx context.Context, us ...users.User) error {
+	tx, err := ur.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO users (email, password, id, metadata, status) VALUES (:email, :password, :id, :metadata, :status)`
+
+	for _, user := range us {
+		if user.ID == "" || user.Email == "" {
+			tx.Rollback()
+			return errors.ErrMalformedEntity
+		}
+
+		dbu, err := toDBUser(user)
+		if err != nil {
+			tx.Rollback()
+			return errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbu); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return errors.Wrap(errors.ErrConflict, err)
+				}
+			}
+			return errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
 func (ur userRepository) Update(ctx context.Context, user users.User) error {
 	q := `UPDATE users SET(email, password, metadata, status) VALUES (:email, :password, :metadata, :status) WHERE email = :email;`
 
@@ -138,11 +180,9 @@ func (ur userRepository) RetrieveByID(ctx context.Context, id string) (users.Use
 }
 
 func (ur userRepository) RetrieveByIDs(ctx context.Context, userIDs []string, pm users.PageMetadata) (users.UserPage, error) {
-	eq, ep, err := createEmailQuery("", pm.Email)
-	if err != nil {
-		return users.UserPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
-	}
-
+	eq, ep := dbutil.GetSearchQuery("email", pm.Email)
+	oq := getOrderQuery(pm.Order)
+	dq := getDirQuery(pm.Dir)
 	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
 
 	mp, mq, err := dbutil.GetMetadataQuery("", pm.Metadata)
@@ -173,7 +213,7 @@ func (ur userRepository) RetrieveByIDs(ctx context.Context, userIDs []string, pm
 		emq = fmt.Sprintf(" WHERE %s", strings.Join(query, " AND "))
 	}
 
-	q := fmt.Sprintf(`SELECT id, email, metadata FROM users %s ORDER BY email %s;`, emq, olq)
+	q := fmt.Sprintf(`SELECT id, email, metadata FROM users %s ORDER BY %s %s %s;`, emq, oq, dq, olq)
 
 	params := map[string]interface{}{
 		"limit":    pm.Limit,
@@ -278,6 +318,30 @@ func (ur userRepository) ChangeStatus(ctx context.Context, id, status string) er
 	return nil
 }
 
+func (ur userRepository) Remove(ctx context.Context, id string) error {
+	q := `DELETE FROM users WHERE id = :id`
+
+	res, err := ur.db.NamedExecContext(ctx, q, dbUser{ID: id})
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok && pgErr.Code == pgerrcode.InvalidTextRepresentation {
+			return errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
 type dbUser struct {
 	ID       string `db:"id"`
 	Email    string `db:"email"`
@@ -337,14 +401,22 @@ func toUser(dbu dbUser) (users.User, error) {
 	}, nil
 }
 
-func createEmailQuery(entity string, email string) (string, string, error) {
-	if email == "" {
-		return "", "", nil
+// getOrderQuery returns the column the users are ordered by, the email by default.
+func getOrderQuery(order string) string {
+	switch order {
+	case "id":
+		return "id"
+	default:
+		return "email"
 	}
+}
 
-	// Create LIKE operator to search Users with email containing a given string
-	param := fmt.Sprintf(`%%%s%%`, email)
-	query := fmt.Sprintf("%semail LIKE :email", entity)
-
-	return query, param, nil
+// getDirQuery returns the users order direction, ascending by default.
+func getDirQuery(dir string) string {
+	switch dir {
+	case "desc":
+		return "DESC"
+	default:
+		return "ASC"
+	}
 }
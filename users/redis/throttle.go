@@ -0,0 +1,124 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	loginPrefix = "login_failures"
+	ipKind      = "ip"
+	emailKind   = "email"
+)
+
+var _ users.LoginThrottle = (*loginThrottle)(nil)
+
+type loginThrottle struct {
+	client *redis.Client
+	cfg    users.ThrottleConfig
+}
+
+// NewLoginThrottle returns Redis backed login throttle, which counts the
+// failed login attempts of the IP address and the email until no attempt
+// fails within the configured window.
+func NewLoginThrottle(client *redis.Client, cfg users.ThrottleConfig) users.LoginThrottle {
+	return &loginThrottle{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+func (lt *loginThrottle) Check(ctx context.Context, ip, email string) (bool, error) {
+	keys := lt.keys(ip, email)
+	if len(keys) == 0 {
+		return false, nil
+	}
+
+	vals, err := lt.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return false, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	failures := 0
+	for _, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		if n, err := strconv.Atoi(s); err == nil && n > failures {
+			failures = n
+		}
+	}
+
+	if lt.cfg.MaxFailures > 0 && failures >= lt.cfg.MaxFailures {
+		return false, users.ErrLoginThrottled
+	}
+
+	return lt.cfg.CaptchaAfter > 0 && failures >= lt.cfg.CaptchaAfter, nil
+}
+
+func (lt *loginThrottle) Fail(ctx context.Context, ip, email string) error {
+	keys := lt.keys(ip, email)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	pipe := lt.client.TxPipeline()
+	for _, k := range keys {
+		pipe.Incr(ctx, k)
+		pipe.Expire(ctx, k, lt.cfg.Window)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (lt *loginThrottle) Reset(ctx context.Context, email string) error {
+	if email == "" {
+		return nil
+	}
+
+	if err := lt.client.Del(ctx, key(emailKind, email)).Err(); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (lt *loginThrottle) keys(ip, email string) []string {
+	keys := []string{}
+	if ip != "" {
+		keys = append(keys, key(ipKind, ip))
+	}
+	if email != "" {
+		keys = append(keys, key(emailKind, email))
+	}
+
+	return keys
+}
+
+// key returns the key of the failed attempts counter. The emails are case
+// insensitive and hashed, so that they aren't stored in plain text.
+func key(kind, value string) string {
+	if kind == emailKind {
+		sum := sha256.Sum256([]byte(strings.ToLower(value)))
+		value = hex.EncodeToString(sum[:])
+	}
+
+	return fmt.Sprintf("%s:%s:%s", loginPrefix, kind, value)
+}
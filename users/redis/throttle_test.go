@@ -0,0 +1,86 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/users"
+	"github.com/MainfluxLabs/mainflux/users/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ip    = "10.0.0.1"
+	email = "user@example.com"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	throttle := redis.NewLoginThrottle(redisClient, users.ThrottleConfig{CaptchaAfter: 1, MaxFailures: 2, Window: time.Minute})
+	ctx := context.Background()
+
+	captcha, err := throttle.Check(ctx, ip, email)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.False(t, captcha, "check without failed attempts: expected captcha not to be required")
+
+	err = throttle.Fail(ctx, ip, email)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		ip      string
+		email   string
+		captcha bool
+		err     error
+	}{
+		{
+			desc:    "check ip and email with failed attempt",
+			ip:      ip,
+			email:   email,
+			captcha: true,
+			err:     nil,
+		},
+		{
+			desc:    "check email with failed attempt in other case",
+			ip:      "",
+			email:   "User@Example.com",
+			captcha: true,
+			err:     nil,
+		},
+		{
+			desc:    "check other ip and email",
+			ip:      "10.0.0.2",
+			email:   "other@example.com",
+			captcha: false,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		captcha, err := throttle.Check(ctx, tc.ip, tc.email)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.captcha, captcha, fmt.Sprintf("%s: expected captcha %t got %t\n", tc.desc, tc.captcha, captcha))
+	}
+
+	err = throttle.Fail(ctx, ip, email)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	_, err = throttle.Check(ctx, "", email)
+	assert.True(t, errors.Contains(err, users.ErrLoginThrottled), fmt.Sprintf("check email exceeding failed attempts: expected %s got %s\n", users.ErrLoginThrottled, err))
+
+	err = throttle.Reset(ctx, email)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	captcha, err = throttle.Check(ctx, "", email)
+	assert.Nil(t, err, fmt.Sprintf("check reset email: expected nil got %s\n", err))
+	assert.False(t, captcha, "check reset email: expected captcha not to be required")
+
+	_, err = throttle.Check(ctx, ip, "")
+	assert.True(t, errors.Contains(err, users.ErrLoginThrottled), fmt.Sprintf("check ip after email reset: expected %s got %s\n", users.ErrLoginThrottled, err))
+}
@@ -6,6 +6,7 @@ package users
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
@@ -33,6 +34,9 @@ var (
 
 	// ErrAlreadyDisabledUser indicates the user is already disabled.
 	ErrAlreadyDisabledUser = errors.New("the user is already disabled")
+
+	// ErrPasswordHash indicates invalid password hash.
+	ErrPasswordHash = errors.New("invalid password hash")
 )
 
 // Service specifies an API that must be fullfiled by the domain service
@@ -55,8 +59,9 @@ type Service interface {
 
 	// Login authenticates the user given its credentials. Successful
 	// authentication generates new access token. Failed invocations are
-	// identified by the non-nil error values in the response.
-	Login(ctx context.Context, user User) (string, error)
+	// identified by the non-nil error values in the response. The failed
+	// attempts are throttled per client IP address and per email.
+	Login(ctx context.Context, user User, attempt LoginAttempt) (string, error)
 
 	// ViewUser retrieves user info for a given user ID and an authorized token.
 	ViewUser(ctx context.Context, token, id string) (User, error)
@@ -73,6 +78,9 @@ type Service interface {
 	// ListUsersByEmails retrieves users list for the given emails.
 	ListUsersByEmails(ctx context.Context, emails []string) ([]User, error)
 
+	// GetStats returns the number of users, regardless of their status.
+	GetStats(ctx context.Context) (Stats, error)
+
 	// UpdateUser updates the user metadata.
 	UpdateUser(ctx context.Context, token string, user User) error
 
@@ -101,6 +109,29 @@ type Service interface {
 
 	// Restore restores users from backup. Only accessible by admin.
 	Restore(ctx context.Context, token string, admin User, users []User) error
+
+	// ImportUsers creates the user accounts with the already hashed passwords
+	// and assigns them to their orgs, so that the users migrated from other
+	// platforms keep their passwords. Only accessible by admin.
+	ImportUsers(ctx context.Context, token string, ius ...ImportedUser) ([]User, error)
+
+	// RequestDeletion sends the link confirming the deletion of the account of
+	// the authenticated user to the user email. The password is re-entered to
+	// request the deletion, while the host is used for generating the link.
+	RequestDeletion(ctx context.Context, token, password, host string) error
+
+	// ConfirmDeletion schedules the deletion of the account confirmed using
+	// the token sent by email after the grace period, and returns the time of
+	// the deletion.
+	ConfirmDeletion(ctx context.Context, confirmToken string) (time.Time, error)
+
+	// CancelDeletion cancels the requested or scheduled deletion of the account
+	// of the authenticated user.
+	CancelDeletion(ctx context.Context, token string) error
+
+	// RemoveScheduledUsers removes the accounts whose deletion is scheduled up
+	// to the provided time, along with their org memberships, keys and roles.
+	RemoveScheduledUsers(ctx context.Context, now time.Time) error
 }
 
 // PageMetadata contains page metadata that helps navigation.
@@ -110,35 +141,66 @@ type PageMetadata struct {
 	Limit    uint64
 	Email    string
 	Status   string
+	Order    string
+	Dir      string
 	Metadata Metadata
 }
 
+// OrgMembership represents the membership of a user in an org.
+type OrgMembership struct {
+	OrgID string
+	Role  string
+}
+
+// ImportedUser represents a user account imported from another platform,
+// whose password is already hashed.
+type ImportedUser struct {
+	User
+	Memberships []OrgMembership
+}
+
 // UserPage contains a page of users.
 type UserPage struct {
 	PageMetadata
 	Users []User
 }
 
+// Stats contains the number of users.
+type Stats struct {
+	Users uint64
+}
+
 var _ Service = (*usersService)(nil)
 
 type usersService struct {
-	users      UserRepository
-	hasher     Hasher
-	email      Emailer
-	auth       protomfx.AuthServiceClient
-	idProvider uuid.IDProvider
-	passRegex  *regexp.Regexp
+	users       UserRepository
+	deletions   DeletionRepository
+	hasher      Hasher
+	email       Emailer
+	auth        protomfx.AuthServiceClient
+	idProvider  uuid.IDProvider
+	passRegex   *regexp.Regexp
+	gracePeriod time.Duration
+	throttle    LoginThrottle
+	captcha     CaptchaVerifier
 }
 
-// New instantiates the users service implementation
-func New(users UserRepository, hasher Hasher, auth protomfx.AuthServiceClient, e Emailer, idp uuid.IDProvider, passRegex *regexp.Regexp) Service {
+// New instantiates the users service implementation. The accounts are
+// deleted after the grace period since the deletion is confirmed. The failed
+// logins aren't throttled if the throttle is nil, and the CAPTCHA is never
+// required if the verifier is nil.
+func New(users UserRepository, deletions DeletionRepository, hasher Hasher, auth protomfx.AuthServiceClient, e Emailer, idp uuid.IDProvider, passRegex *regexp.Regexp, gracePeriod time.Duration, throttle LoginThrottle, captcha CaptchaVerifier) Service {
 	return &usersService{
-		users:      users,
-		hasher:     hasher,
-		auth:       auth,
-		email:      e,
-		idProvider: idp,
-		passRegex:  passRegex,
+		users:       users,
+		deletions:   deletions,
+		hasher:      hasher,
+		auth:        auth,
+		email:       e,
+		idProvider:  idp,
+		passRegex:   passRegex,
+		gracePeriod: gracePeriod,
+		throttle:    throttle,
+		captcha:     captcha,
 	}
 }
 
@@ -169,7 +231,7 @@ func (svc usersService) SelfRegister(ctx context.Context, user User) (string, er
 }
 
 func (svc usersService) RegisterAdmin(ctx context.Context, user User) error {
-	if u, err := svc.users.RetrieveByEmail(context.Background(), user.Email); err == nil {
+	if u, err := svc.users.RetrieveByEmail(ctx, user.Email); err == nil {
 		role, err := svc.auth.RetrieveRole(ctx, &protomfx.RetrieveRoleReq{Id: u.ID})
 		if err != nil {
 			return err
@@ -264,17 +326,71 @@ func (svc usersService) Register(ctx context.Context, token string, user User) (
 	return uid, nil
 }
 
-func (svc usersService) Login(ctx context.Context, user User) (string, error) {
-	dbUser, err := svc.users.RetrieveByEmail(ctx, user.Email)
+func (svc usersService) Login(ctx context.Context, user User, attempt LoginAttempt) (string, error) {
+	if err := svc.checkLogin(ctx, user.Email, attempt); err != nil {
+		return "", err
+	}
+
+	dbUser, err := svc.authenticate(ctx, user.Email, user.Password)
 	if err != nil {
-		return "", errors.Wrap(errors.ErrAuthentication, err)
+		if svc.throttle != nil {
+			if err := svc.throttle.Fail(ctx, attempt.IP, user.Email); err != nil {
+				return "", err
+			}
+		}
+		return "", err
 	}
-	if err := svc.hasher.Compare(user.Password, dbUser.Password); err != nil {
-		return "", errors.Wrap(errors.ErrAuthentication, err)
+
+	if svc.throttle != nil {
+		if err := svc.throttle.Reset(ctx, user.Email); err != nil {
+			return "", err
+		}
 	}
+
 	return svc.issue(ctx, dbUser.ID, dbUser.Email, auth.LoginKey)
 }
 
+// checkLogin returns an error if the login attempt is throttled, or if it
+// requires the CAPTCHA which isn't solved.
+func (svc usersService) checkLogin(ctx context.Context, email string, attempt LoginAttempt) error {
+	if svc.throttle == nil {
+		return nil
+	}
+
+	captcha, err := svc.throttle.Check(ctx, attempt.IP, email)
+	if err != nil {
+		return err
+	}
+
+	if !captcha || svc.captcha == nil {
+		return nil
+	}
+
+	if attempt.CaptchaToken == "" {
+		return ErrCaptchaRequired
+	}
+
+	if err := svc.captcha.Verify(ctx, attempt.CaptchaToken, attempt.IP); err != nil {
+		return errors.Wrap(ErrCaptchaRequired, err)
+	}
+
+	return nil
+}
+
+// authenticate returns the user with the given credentials. Unlike the login,
+// it isn't throttled, since it's used to confirm the password of the already
+// authenticated user.
+func (svc usersService) authenticate(ctx context.Context, email, password string) (User, error) {
+	dbUser, err := svc.users.RetrieveByEmail(ctx, email)
+	if err != nil {
+		return User{}, errors.Wrap(errors.ErrAuthentication, err)
+	}
+	if err := svc.hasher.Compare(password, dbUser.Password); err != nil {
+		return User{}, errors.Wrap(errors.ErrAuthentication, err)
+	}
+	return dbUser, nil
+}
+
 func (svc usersService) ViewUser(ctx context.Context, token, id string) (User, error) {
 	if _, err := svc.identify(ctx, token); err != nil {
 		return User{}, err
@@ -333,6 +449,17 @@ func (svc usersService) ListUsersByIDs(ctx context.Context, ids []string) (UserP
 	return svc.users.RetrieveByIDs(ctx, ids, pm)
 }
 
+func (svc usersService) GetStats(ctx context.Context) (Stats, error) {
+	// Only the total is needed, so a single user is retrieved.
+	pm := PageMetadata{Limit: 1, Status: AllStatusKey}
+	up, err := svc.users.RetrieveByIDs(ctx, nil, pm)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return Stats{Users: up.Total}, nil
+}
+
 func (svc usersService) ListUsersByEmails(ctx context.Context, emails []string) ([]User, error) {
 	var users []User
 	for _, email := range emails {
@@ -400,6 +527,66 @@ func (svc usersService) Restore(ctx context.Context, token string, admin User, u
 	return nil
 }
 
+func (svc usersService) ImportUsers(ctx context.Context, token string, ius ...ImportedUser) ([]User, error) {
+	if err := svc.isAdmin(ctx, token); err != nil {
+		return []User{}, err
+	}
+
+	var us []User
+	membersByOrg := make(map[string][]*protomfx.OrgMember)
+	for _, iu := range ius {
+		user := iu.User
+		if err := svc.hasher.Validate(user.Password); err != nil {
+			return []User{}, errors.Wrap(ErrPasswordHash, err)
+		}
+
+		uid, err := svc.idProvider.ID()
+		if err != nil {
+			return []User{}, err
+		}
+		user.ID = uid
+
+		if user.Status == "" {
+			user.Status = EnabledStatusKey
+		}
+
+		us = append(us, user)
+		for _, m := range iu.Memberships {
+			if m.OrgID == "" {
+				return []User{}, errors.ErrMalformedEntity
+			}
+			membersByOrg[m.OrgID] = append(membersByOrg[m.OrgID], &protomfx.OrgMember{Email: user.Email, Role: m.Role})
+		}
+	}
+
+	if err := svc.users.SaveUsers(ctx, us...); err != nil {
+		return []User{}, err
+	}
+
+	for orgID, oms := range membersByOrg {
+		req := protomfx.AssignMembersReq{
+			Token:   token,
+			OrgID:   orgID,
+			Members: oms,
+		}
+
+		if _, err := svc.auth.AssignMembers(ctx, &req); err != nil {
+			// The imported users are removed along with the memberships
+			// assigned so far, so that the import either succeeds as a
+			// whole or can be retried.
+			for _, u := range us {
+				if rerr := svc.removeUser(ctx, u.ID); rerr != nil {
+					return []User{}, errors.Wrap(err, rerr)
+				}
+			}
+
+			return []User{}, err
+		}
+	}
+
+	return us, nil
+}
+
 func (svc usersService) UpdateUser(ctx context.Context, token string, u User) error {
 	idn, err := svc.identify(ctx, token)
 	if err != nil {
@@ -454,15 +641,10 @@ func (svc usersService) ChangePassword(ctx context.Context, authToken, password,
 	if !svc.passRegex.MatchString(password) {
 		return ErrPasswordFormat
 	}
-	u := User{
-		Email:    ir.email,
-		ID:       ir.id,
-		Password: oldPassword,
-	}
-	if _, err := svc.Login(ctx, u); err != nil {
+	if _, err := svc.authenticate(ctx, ir.email, oldPassword); err != nil {
 		return errors.ErrAuthentication
 	}
-	u, err = svc.users.RetrieveByID(ctx, ir.id)
+	u, err := svc.users.RetrieveByID(ctx, ir.id)
 	if err != nil || u.Email == "" {
 		return errors.ErrNotFound
 	}
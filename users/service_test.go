@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
@@ -15,13 +16,17 @@ import (
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/users"
 	usmocks "github.com/MainfluxLabs/mainflux/users/mocks"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 const (
-	wrong   = "wrong-value"
-	userNum = 101
+	wrong        = "wrong-value"
+	userNum      = 101
+	clientIP     = "10.0.0.1"
+	captchaToken = "captcha-token"
 )
 
 var (
@@ -44,7 +49,7 @@ func newService() users.Service {
 	authSvc := mocks.NewAuthService(admin.ID, usersList)
 	e := usmocks.NewEmailer()
 
-	return users.New(userRepo, hasher, authSvc, e, idProvider, passRegex)
+	return users.New(userRepo, usmocks.NewDeletionRepository(), hasher, authSvc, e, idProvider, passRegex, time.Hour, nil, nil)
 }
 
 func TestSelfRegister(t *testing.T) {
@@ -127,15 +132,101 @@ func TestLogin(t *testing.T) {
 	}
 
 	for desc, tc := range cases {
-		_, err := svc.Login(context.Background(), tc.user)
+		_, err := svc.Login(context.Background(), tc.user, users.LoginAttempt{})
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.err, err))
 	}
 }
 
+func TestLoginThrottling(t *testing.T) {
+	hasher := usmocks.NewHasher()
+	userRepo := usmocks.NewUserRepository(usersList)
+	authSvc := mocks.NewAuthService(admin.ID, usersList)
+	throttle := usmocks.NewLoginThrottle(users.ThrottleConfig{CaptchaAfter: 2, MaxFailures: 4})
+	captcha := usmocks.NewCaptchaVerifier(captchaToken)
+	svc := users.New(userRepo, usmocks.NewDeletionRepository(), hasher, authSvc, usmocks.NewEmailer(), idProvider, passRegex, time.Hour, throttle, captcha)
+
+	wrongPassword := users.User{Email: registerUser.Email, Password: wrong}
+	attempt := users.LoginAttempt{IP: clientIP}
+	solved := users.LoginAttempt{IP: clientIP, CaptchaToken: captchaToken}
+
+	cases := []struct {
+		desc    string
+		user    users.User
+		attempt users.LoginAttempt
+		err     error
+	}{
+		{
+			desc:    "login with wrong password",
+			user:    wrongPassword,
+			attempt: attempt,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "login with wrong password again",
+			user:    wrongPassword,
+			attempt: attempt,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "login without captcha after failed attempts",
+			user:    registerUser,
+			attempt: attempt,
+			err:     users.ErrCaptchaRequired,
+		},
+		{
+			desc:    "login with invalid captcha after failed attempts",
+			user:    registerUser,
+			attempt: users.LoginAttempt{IP: clientIP, CaptchaToken: wrong},
+			err:     users.ErrCaptchaRequired,
+		},
+		{
+			desc:    "login with captcha and wrong password",
+			user:    wrongPassword,
+			attempt: solved,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "login with captcha and good credentials",
+			user:    registerUser,
+			attempt: solved,
+			err:     nil,
+		},
+		{
+			desc:    "login without captcha from ip with failed attempts",
+			user:    registerUser,
+			attempt: attempt,
+			err:     users.ErrCaptchaRequired,
+		},
+		{
+			desc:    "login with captcha and wrong password from ip with failed attempts",
+			user:    wrongPassword,
+			attempt: solved,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "login from ip exceeding failed attempts",
+			user:    registerUser,
+			attempt: solved,
+			err:     users.ErrLoginThrottled,
+		},
+		{
+			desc:    "login from other ip",
+			user:    registerUser,
+			attempt: users.LoginAttempt{IP: "10.0.0.2"},
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.Login(context.Background(), tc.user, tc.attempt)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
 func TestViewUser(t *testing.T) {
 	svc := newService()
 
-	token, err := svc.Login(context.Background(), user)
+	token, err := svc.Login(context.Background(), user, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	cases := map[string]struct {
@@ -174,10 +265,10 @@ func TestViewUser(t *testing.T) {
 func TestViewProfile(t *testing.T) {
 	svc := newService()
 
-	token, err := svc.Login(context.Background(), user)
+	token, err := svc.Login(context.Background(), user, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-	adminToken, err := svc.Login(context.Background(), admin)
+	adminToken, err := svc.Login(context.Background(), admin, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	cases := map[string]struct {
@@ -212,10 +303,10 @@ func TestViewProfile(t *testing.T) {
 func TestListUsers(t *testing.T) {
 	svc := newService()
 
-	token, err := svc.Login(context.Background(), admin)
+	token, err := svc.Login(context.Background(), admin, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
-	unauthUserToken, err := svc.Login(context.Background(), unauthUser)
+	unauthUserToken, err := svc.Login(context.Background(), unauthUser, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	page, err := svc.ListUsers(context.Background(), token, users.PageMetadata{})
@@ -308,10 +399,124 @@ func TestListUsers(t *testing.T) {
 	}
 }
 
+func TestGetStats(t *testing.T) {
+	svc := newService()
+
+	_, err := svc.SelfRegister(context.Background(), selfRegister)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	stats, err := svc.GetStats(context.Background())
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	expected := users.Stats{Users: uint64(len(usersList) + 1)}
+	assert.Equal(t, expected, stats, fmt.Sprintf("expected %v got %v", expected, stats))
+}
+
+func TestImportUsers(t *testing.T) {
+	svc := newService()
+
+	imported := users.ImportedUser{
+		User:        users.User{Email: "imported@example.com", Password: "$2a$10$hash"},
+		Memberships: []users.OrgMembership{{OrgID: "574106f7-030e-4881-8ab0-151195c29f97", Role: "viewer"}},
+	}
+	withoutHash := users.ImportedUser{User: users.User{Email: "without-hash@example.com"}}
+
+	cases := []struct {
+		desc  string
+		users []users.ImportedUser
+		token string
+		err   error
+	}{
+		{
+			desc:  "import users",
+			users: []users.ImportedUser{imported},
+			token: admin.Email,
+			err:   nil,
+		},
+		{
+			desc:  "import existing users",
+			users: []users.ImportedUser{imported},
+			token: admin.Email,
+			err:   errors.ErrConflict,
+		},
+		{
+			desc:  "import users without password hash",
+			users: []users.ImportedUser{withoutHash},
+			token: admin.Email,
+			err:   users.ErrPasswordHash,
+		},
+		{
+			desc:  "import users with non-admin token",
+			users: []users.ImportedUser{withoutHash},
+			token: unauthUser.Email,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "import users with invalid token",
+			users: []users.ImportedUser{withoutHash},
+			token: wrong,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.ImportUsers(context.Background(), tc.token, tc.users...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	up, err := svc.ListUsers(context.Background(), admin.Email, users.PageMetadata{Email: imported.Email})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	require.Len(t, up.Users, 1, "list imported users: expected single user")
+	assert.Equal(t, imported.Password, up.Users[0].Password, fmt.Sprintf("list imported users: expected password hash %s got %s", imported.Password, up.Users[0].Password))
+	assert.Equal(t, users.EnabledStatusKey, up.Users[0].Status, fmt.Sprintf("list imported users: expected status %s got %s", users.EnabledStatusKey, up.Users[0].Status))
+}
+
+// failingAssignAuth fails to assign the members of the given org.
+type failingAssignAuth struct {
+	protomfx.AuthServiceClient
+	orgID string
+}
+
+func (a failingAssignAuth) AssignMembers(ctx context.Context, req *protomfx.AssignMembersReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if req.GetOrgID() == a.orgID {
+		return &empty.Empty{}, errors.ErrConflict
+	}
+
+	return a.AuthServiceClient.AssignMembers(ctx, req, opts...)
+}
+
+func TestImportUsersRollback(t *testing.T) {
+	const missingOrgID = "574106f7-030e-4881-8ab0-151195c29f98"
+
+	authSvc := failingAssignAuth{AuthServiceClient: mocks.NewAuthService(admin.ID, usersList), orgID: missingOrgID}
+	svc := users.New(usmocks.NewUserRepository(usersList), usmocks.NewDeletionRepository(), usmocks.NewHasher(), authSvc, usmocks.NewEmailer(), idProvider, passRegex, time.Hour, nil, nil)
+
+	ius := []users.ImportedUser{
+		{
+			User:        users.User{Email: "first-imported@example.com", Password: "$2a$10$hash"},
+			Memberships: []users.OrgMembership{{OrgID: "574106f7-030e-4881-8ab0-151195c29f97", Role: "viewer"}},
+		},
+		{
+			User:        users.User{Email: "second-imported@example.com", Password: "$2a$10$hash"},
+			Memberships: []users.OrgMembership{{OrgID: missingOrgID, Role: "viewer"}},
+		},
+	}
+
+	_, err := svc.ImportUsers(context.Background(), admin.Email, ius...)
+	assert.True(t, errors.Contains(err, errors.ErrConflict), fmt.Sprintf("import users to missing org: expected %s got %s\n", errors.ErrConflict, err))
+
+	for _, iu := range ius {
+		_, err := svc.ListUsersByEmails(context.Background(), []string{iu.Email})
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("import users to missing org: expected %s to be removed", iu.Email))
+	}
+
+	_, err = svc.ImportUsers(context.Background(), admin.Email, ius[0])
+	assert.Nil(t, err, fmt.Sprintf("retry import users: unexpected error %s", err))
+}
+
 func TestUpdateUser(t *testing.T) {
 	svc := newService()
 
-	token, err := svc.Login(context.Background(), registerUser)
+	token, err := svc.Login(context.Background(), registerUser, users.LoginAttempt{})
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	registerUser.Metadata = map[string]interface{}{"meta": "test"}
@@ -358,7 +563,7 @@ func TestGenerateResetToken(t *testing.T) {
 
 func TestChangePassword(t *testing.T) {
 	svc := newService()
-	token, _ := svc.Login(context.Background(), registerUser)
+	token, _ := svc.Login(context.Background(), registerUser, users.LoginAttempt{})
 
 	cases := map[string]struct {
 		token       string
@@ -401,7 +606,7 @@ func TestResetPassword(t *testing.T) {
 
 func TestSendPasswordReset(t *testing.T) {
 	svc := newService()
-	token, _ := svc.Login(context.Background(), registerUser)
+	token, _ := svc.Login(context.Background(), registerUser, users.LoginAttempt{})
 
 	cases := map[string]struct {
 		token string
@@ -417,3 +622,154 @@ func TestSendPasswordReset(t *testing.T) {
 
 	}
 }
+
+func newServiceWithDeletions(deletions users.DeletionRepository) users.Service {
+	hasher := usmocks.NewHasher()
+	userRepo := usmocks.NewUserRepository(usersList)
+	authSvc := mocks.NewAuthService(admin.ID, usersList)
+	e := usmocks.NewEmailer()
+
+	return users.New(userRepo, deletions, hasher, authSvc, e, idProvider, passRegex, time.Hour, nil, nil)
+}
+
+func TestRequestDeletion(t *testing.T) {
+	svc := newService()
+	token, err := svc.Login(context.Background(), registerUser, users.LoginAttempt{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	adminToken, err := svc.Login(context.Background(), admin, users.LoginAttempt{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := map[string]struct {
+		token    string
+		password string
+		err      error
+	}{
+		"request deletion":                     {token, registerUser.Password, nil},
+		"request deletion with wrong password": {token, wrong, errors.ErrAuthentication},
+		"request deletion with invalid token":  {wrong, registerUser.Password, errors.ErrAuthentication},
+		"request deletion of root admin":       {adminToken, admin.Password, errors.ErrAuthorization},
+	}
+
+	for desc, tc := range cases {
+		err := svc.RequestDeletion(context.Background(), tc.token, tc.password, host)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", desc, tc.err, err))
+	}
+}
+
+func TestConfirmDeletion(t *testing.T) {
+	deletions := usmocks.NewDeletionRepository()
+	svc := newServiceWithDeletions(deletions)
+
+	now := time.Now().UTC()
+	err := deletions.Save(context.Background(), users.DeletionRequest{UserID: registerUser.ID, Token: "token", RequestedAt: now})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	err = deletions.Save(context.Background(), users.DeletionRequest{UserID: user.ID, Token: "expired", RequestedAt: now.Add(-48 * time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		err   error
+	}{
+		{
+			desc:  "confirm deletion",
+			token: "token",
+			err:   nil,
+		},
+		{
+			desc:  "confirm deletion with used token",
+			token: "token",
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "confirm deletion with expired token",
+			token: "expired",
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "confirm deletion with invalid token",
+			token: wrong,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		scheduledAt, err := svc.ConfirmDeletion(context.Background(), tc.token)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.True(t, scheduledAt.After(now), fmt.Sprintf("%s: expected deletion scheduled after %s got %s\n", tc.desc, now, scheduledAt))
+		}
+	}
+}
+
+func TestCancelDeletion(t *testing.T) {
+	svc := newService()
+	token, err := svc.Login(context.Background(), registerUser, users.LoginAttempt{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.RequestDeletion(context.Background(), token, registerUser.Password, host)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc  string
+		token string
+		err   error
+	}{
+		{
+			desc:  "cancel deletion",
+			token: token,
+			err:   nil,
+		},
+		{
+			desc:  "cancel cancelled deletion",
+			token: token,
+			err:   errors.ErrNotFound,
+		},
+		{
+			desc:  "cancel deletion with invalid token",
+			token: wrong,
+			err:   errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.CancelDeletion(context.Background(), tc.token)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRemoveScheduledUsers(t *testing.T) {
+	deletions := usmocks.NewDeletionRepository()
+	svc := newServiceWithDeletions(deletions)
+
+	now := time.Now().UTC()
+	err := deletions.Save(context.Background(), users.DeletionRequest{UserID: registerUser.ID, RequestedAt: now, ScheduledAt: now.Add(-time.Minute)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	err = deletions.Save(context.Background(), users.DeletionRequest{UserID: user.ID, RequestedAt: now, ScheduledAt: now.Add(time.Hour)})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	err = svc.RemoveScheduledUsers(context.Background(), now)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+
+	cases := []struct {
+		desc string
+		user users.User
+		err  error
+	}{
+		{
+			desc: "login as removed user",
+			user: registerUser,
+			err:  errors.ErrAuthentication,
+		},
+		{
+			desc: "login as user scheduled for removal",
+			user: users.User{Email: user.Email, Password: user.Password},
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.Login(context.Background(), tc.user, users.LoginAttempt{})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+)
+
+var (
+	// ErrLoginThrottled indicates that the client exceeded the allowed number
+	// of failed login attempts.
+	ErrLoginThrottled = errors.New("too many failed login attempts")
+
+	// ErrCaptchaRequired indicates that the login requires the valid CAPTCHA token.
+	ErrCaptchaRequired = errors.New("captcha verification required")
+)
+
+// LoginAttempt contains the data of the client attempting to log in, used to
+// throttle the failed login attempts.
+type LoginAttempt struct {
+	IP           string
+	CaptchaToken string
+}
+
+// ThrottleConfig contains the limits of the failed login attempts, counted
+// per client IP address and per account email.
+type ThrottleConfig struct {
+	// CaptchaAfter is the number of failed attempts after which the CAPTCHA
+	// has to be verified. The CAPTCHA is never required if it's 0.
+	CaptchaAfter int
+	// MaxFailures is the number of failed attempts after which the login is
+	// rejected. The login is never rejected if it's 0.
+	MaxFailures int
+	// Window is the period since the last failed attempt after which the
+	// failed attempts are forgotten.
+	Window time.Duration
+}
+
+// LoginThrottle specifies an API for throttling the failed login attempts.
+type LoginThrottle interface {
+	// Check returns ErrLoginThrottled if the IP address or the email exceeded
+	// the allowed number of failed attempts, and reports whether the CAPTCHA
+	// has to be verified.
+	Check(ctx context.Context, ip, email string) (bool, error)
+
+	// Fail records the failed login attempt of the IP address and the email.
+	Fail(ctx context.Context, ip, email string) error
+
+	// Reset clears the failed login attempts of the email. The attempts of
+	// the IP address are kept, so that the client can't clear them by logging
+	// in to its own account.
+	Reset(ctx context.Context, email string) error
+}
+
+// CaptchaVerifier specifies an API for verifying the CAPTCHA tokens, e.g.
+// using the hCaptcha or reCAPTCHA verification API.
+type CaptchaVerifier interface {
+	// Verify returns an error if the CAPTCHA token solved by the client with
+	// the given IP address isn't valid.
+	Verify(ctx context.Context, token, ip string) error
+}
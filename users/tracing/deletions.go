@@ -0,0 +1,67 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/users"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+const (
+	saveDeletionOp            = "save_deletion"
+	retrieveDeletionByTokenOp = "retrieve_deletion_by_token"
+	retrieveScheduledOp       = "retrieve_scheduled_deletions"
+	removeDeletionOp          = "remove_deletion"
+)
+
+var _ users.DeletionRepository = (*deletionRepositoryMiddleware)(nil)
+
+type deletionRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   users.DeletionRepository
+}
+
+// DeletionRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func DeletionRepositoryMiddleware(repo users.DeletionRepository, tracer opentracing.Tracer) users.DeletionRepository {
+	return deletionRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (drm deletionRepositoryMiddleware) Save(ctx context.Context, dr users.DeletionRequest) error {
+	span := createSpan(ctx, drm.tracer, saveDeletionOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Save(ctx, dr)
+}
+
+func (drm deletionRepositoryMiddleware) RetrieveByToken(ctx context.Context, token string) (users.DeletionRequest, error) {
+	span := createSpan(ctx, drm.tracer, retrieveDeletionByTokenOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveByToken(ctx, token)
+}
+
+func (drm deletionRepositoryMiddleware) RetrieveScheduled(ctx context.Context, before time.Time) ([]users.DeletionRequest, error) {
+	span := createSpan(ctx, drm.tracer, retrieveScheduledOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.RetrieveScheduled(ctx, before)
+}
+
+func (drm deletionRepositoryMiddleware) Remove(ctx context.Context, userID string) error {
+	span := createSpan(ctx, drm.tracer, removeDeletionOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return drm.repo.Remove(ctx, userID)
+}
@@ -8,12 +8,14 @@ package tracing
 import (
 	"context"
 
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	"github.com/MainfluxLabs/mainflux/users"
 	opentracing "github.com/opentracing/opentracing-go"
 )
 
 const (
 	saveOp            = "save"
+	saveUsersOp       = "save_users"
 	updateOp          = "update"
 	retrieveByEmailOp = "retrieve_by_email"
 	retrieveByIDOp    = "retrieve_by_id"
@@ -21,6 +23,7 @@ const (
 	retrieveAllOp     = "retrieve_all"
 	updatePasswordOp  = "update_password"
 	changeStatusOp    = "change_status"
+	removeOp          = "remove"
 )
 
 var _ users.UserRepository = (*userRepositoryMiddleware)(nil)
@@ -47,6 +50,14 @@ func (urm userRepositoryMiddleware) Save(ctx context.Context, user users.User) (
 	return urm.repo.Save(ctx, user)
 }
 
+func (urm userRepositoryMiddleware) SaveUsers(ctx context.Context, us ...users.User) error {
+	span := createSpan(ctx, urm.tracer, saveUsersOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return urm.repo.SaveUsers(ctx, us...)
+}
+
 func (urm userRepositoryMiddleware) UpdateUser(ctx context.Context, user users.User) error {
 	span := createSpan(ctx, urm.tracer, updateOp)
 	defer span.Finish()
@@ -103,12 +114,21 @@ func (urm userRepositoryMiddleware) ChangeStatus(ctx context.Context, id, status
 	return urm.repo.ChangeStatus(ctx, id, status)
 }
 
+func (urm userRepositoryMiddleware) Remove(ctx context.Context, id string) error {
+	span := createSpan(ctx, urm.tracer, removeOp)
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return urm.repo.Remove(ctx, id)
+}
+
 func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
 	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
 		return tracer.StartSpan(
 			opName,
 			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
 		)
 	}
-	return tracer.StartSpan(opName)
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
 }
@@ -44,6 +44,10 @@ type UserRepository interface {
 	// operation failure.
 	Save(ctx context.Context, u User) (string, error)
 
+	// SaveUsers persists the user accounts in a single transaction. A non-nil
+	// error is returned to indicate operation failure.
+	SaveUsers(ctx context.Context, us ...User) error
+
 	// UpdateUser updates the user metadata.
 	UpdateUser(ctx context.Context, u User) error
 
@@ -64,4 +68,7 @@ type UserRepository interface {
 
 	// RetrieveAll retrieves all users.
 	RetrieveAll(ctx context.Context) ([]User, error)
+
+	// Remove removes the user account identified by the provided ID.
+	Remove(ctx context.Context, id string) error
 }
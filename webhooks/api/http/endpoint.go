@@ -5,7 +5,9 @@ package http
 
 import (
 	"context"
+	"time"
 
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 	"github.com/go-kit/kit/endpoint"
 )
@@ -20,11 +22,19 @@ func createWebhooksEndpoint(svc webhooks.Service) endpoint.Endpoint {
 		whs := []webhooks.Webhook{}
 		for _, wReq := range req.Webhooks {
 			wh := webhooks.Webhook{
-				GroupID:  req.groupID,
-				Name:     wReq.Name,
-				Url:      wReq.Url,
-				Headers:  wReq.Headers,
-				Metadata: wReq.Metadata,
+				GroupID:        req.groupID,
+				Name:           wReq.Name,
+				Url:            wReq.Url,
+				Headers:        wReq.Headers,
+				Metadata:       wReq.Metadata,
+				ClientCert:     wReq.ClientCert,
+				ClientKey:      wReq.ClientKey,
+				CACert:         wReq.CACert,
+				ProxyURL:       wReq.ProxyURL,
+				BatchSize:      wReq.BatchSize,
+				BatchInterval:  time.Duration(wReq.BatchInterval) * time.Second,
+				MaxPayloadSize: wReq.MaxPayloadSize,
+				Filter:         wReq.Filter,
 			}
 			whs = append(whs, wh)
 		}
@@ -78,11 +88,19 @@ func updateWebhookEndpoint(svc webhooks.Service) endpoint.Endpoint {
 		}
 
 		webhook := webhooks.Webhook{
-			ID:       req.id,
-			Name:     req.Name,
-			Url:      req.Url,
-			Headers:  req.Headers,
-			Metadata: req.Metadata,
+			ID:             req.id,
+			Name:           req.Name,
+			Url:            req.Url,
+			Headers:        req.Headers,
+			Metadata:       req.Metadata,
+			ClientCert:     req.ClientCert,
+			ClientKey:      req.ClientKey,
+			CACert:         req.CACert,
+			ProxyURL:       req.ProxyURL,
+			BatchSize:      req.BatchSize,
+			BatchInterval:  time.Duration(req.BatchInterval) * time.Second,
+			MaxPayloadSize: req.MaxPayloadSize,
+			Filter:         req.Filter,
 		}
 
 		if err := svc.UpdateWebhook(ctx, req.token, webhook); err != nil {
@@ -108,6 +126,260 @@ func removeWebhooksEndpoint(svc webhooks.Service) endpoint.Endpoint {
 	}
 }
 
+func listRecentMessagesEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listRecentMessagesReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		msgs, err := svc.ListRecentMessages(ctx, req.token, req.id, req.limit)
+		if err != nil {
+			return nil, err
+		}
+
+		res := recentMessagesRes{Messages: []json.Message{}}
+		res.Messages = append(res.Messages, msgs...)
+
+		return res, nil
+	}
+}
+
+func testWebhookEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(testWebhookReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.TestWebhook(ctx, req.token, req.id, req.Payload); err != nil {
+			return nil, err
+		}
+
+		return testWebhookRes{}, nil
+	}
+}
+
+func createSecretsEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createSecretsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ss := []webhooks.Secret{}
+		for _, sReq := range req.Secrets {
+			ss = append(ss, webhooks.Secret{Name: sReq.Name, Value: sReq.Value})
+		}
+
+		saved, err := svc.CreateSecrets(ctx, req.token, req.orgID, ss...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := secretsRes{Secrets: []secretRes{}, created: true}
+		for _, s := range saved {
+			res.Secrets = append(res.Secrets, buildSecretResponse(s))
+		}
+
+		return res, nil
+	}
+}
+
+func listSecretsByOrgEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listByOrgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListSecretsByOrg(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := secretsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			Secrets: []secretRes{},
+		}
+		for _, s := range page.Secrets {
+			res.Secrets = append(res.Secrets, buildSecretResponse(s))
+		}
+
+		return res, nil
+	}
+}
+
+func viewSecretEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(secretReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		secret, err := svc.ViewSecret(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildSecretResponse(secret), nil
+	}
+}
+
+func updateSecretEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateSecretReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		secret := webhooks.Secret{
+			ID:    req.id,
+			Name:  req.Name,
+			Value: req.Value,
+		}
+
+		if err := svc.UpdateSecret(ctx, req.token, secret); err != nil {
+			return nil, err
+		}
+
+		return secretRes{updated: true}, nil
+	}
+}
+
+func removeSecretsEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeSecretsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveSecrets(ctx, req.token, req.SecretIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func createEventWebhooksEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createEventWebhooksReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ewhs := []webhooks.EventWebhook{}
+		for _, ewhReq := range req.EventWebhooks {
+			ewh := webhooks.EventWebhook{
+				Name:       ewhReq.Name,
+				Url:        ewhReq.Url,
+				Headers:    ewhReq.Headers,
+				EventTypes: ewhReq.EventTypes,
+			}
+			ewhs = append(ewhs, ewh)
+		}
+
+		saved, err := svc.CreateEventWebhooks(ctx, req.token, req.orgID, ewhs...)
+		if err != nil {
+			return nil, err
+		}
+
+		res := eventWebhooksRes{EventWebhooks: []eventWebhookRes{}, created: true}
+		for _, ewh := range saved {
+			res.EventWebhooks = append(res.EventWebhooks, buildEventWebhookResponse(ewh))
+		}
+
+		return res, nil
+	}
+}
+
+func listEventWebhooksByOrgEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listByOrgReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListEventWebhooksByOrg(ctx, req.token, req.id, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := eventWebhooksPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+			},
+			EventWebhooks: []eventWebhookRes{},
+		}
+		for _, ewh := range page.EventWebhooks {
+			res.EventWebhooks = append(res.EventWebhooks, buildEventWebhookResponse(ewh))
+		}
+
+		return res, nil
+	}
+}
+
+func viewEventWebhookEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(eventWebhookReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ewh, err := svc.ViewEventWebhook(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return buildEventWebhookResponse(ewh), nil
+	}
+}
+
+func updateEventWebhookEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateEventWebhookReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		ewh := webhooks.EventWebhook{
+			ID:         req.id,
+			Name:       req.Name,
+			Url:        req.Url,
+			Headers:    req.Headers,
+			EventTypes: req.EventTypes,
+		}
+
+		if err := svc.UpdateEventWebhook(ctx, req.token, ewh); err != nil {
+			return nil, err
+		}
+
+		return eventWebhookRes{updated: true}, nil
+	}
+}
+
+func removeEventWebhooksEndpoint(svc webhooks.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeEventWebhooksReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveEventWebhooks(ctx, req.token, req.EventWebhookIDs...); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
 func buildWebhooksByGroupResponse(wp webhooks.WebhooksPage) WebhooksPageRes {
 	res := WebhooksPageRes{
 		pageRes: pageRes{
@@ -120,12 +392,19 @@ func buildWebhooksByGroupResponse(wp webhooks.WebhooksPage) WebhooksPageRes {
 
 	for _, wh := range wp.Webhooks {
 		webhook := webhookResponse{
-			ID:         wh.ID,
-			GroupID:    wh.GroupID,
-			Name:       wh.Name,
-			Url:        wh.Url,
-			ResHeaders: wh.Headers,
-			Metadata:   wh.Metadata,
+			ID:             wh.ID,
+			GroupID:        wh.GroupID,
+			Name:           wh.Name,
+			Url:            wh.Url,
+			ResHeaders:     wh.Headers,
+			Metadata:       wh.Metadata,
+			ClientCert:     wh.ClientCert,
+			CACert:         wh.CACert,
+			ProxyURL:       wh.ProxyURL,
+			BatchSize:      wh.BatchSize,
+			BatchInterval:  uint(wh.BatchInterval / time.Second),
+			MaxPayloadSize: wh.MaxPayloadSize,
+			Filter:         filterResponse(wh.Filter),
 		}
 		res.Webhooks = append(res.Webhooks, webhook)
 	}
@@ -137,12 +416,19 @@ func buildWebhooksResponse(webhooks []webhooks.Webhook, created bool) webhooksRe
 	res := webhooksRes{Webhooks: []webhookResponse{}, created: created}
 	for _, wh := range webhooks {
 		webhook := webhookResponse{
-			ID:         wh.ID,
-			GroupID:    wh.GroupID,
-			Name:       wh.Name,
-			Url:        wh.Url,
-			ResHeaders: wh.Headers,
-			Metadata:   wh.Metadata,
+			ID:             wh.ID,
+			GroupID:        wh.GroupID,
+			Name:           wh.Name,
+			Url:            wh.Url,
+			ResHeaders:     wh.Headers,
+			Metadata:       wh.Metadata,
+			ClientCert:     wh.ClientCert,
+			CACert:         wh.CACert,
+			ProxyURL:       wh.ProxyURL,
+			BatchSize:      wh.BatchSize,
+			BatchInterval:  uint(wh.BatchInterval / time.Second),
+			MaxPayloadSize: wh.MaxPayloadSize,
+			Filter:         filterResponse(wh.Filter),
 		}
 		res.Webhooks = append(res.Webhooks, webhook)
 	}
@@ -152,14 +438,50 @@ func buildWebhooksResponse(webhooks []webhooks.Webhook, created bool) webhooksRe
 
 func buildWebhookResponse(webhook webhooks.Webhook, updated bool) webhookResponse {
 	wh := webhookResponse{
-		ID:         webhook.ID,
-		GroupID:    webhook.GroupID,
-		Name:       webhook.Name,
-		Url:        webhook.Url,
-		ResHeaders: webhook.Headers,
-		Metadata:   webhook.Metadata,
-		updated:    updated,
+		ID:             webhook.ID,
+		GroupID:        webhook.GroupID,
+		Name:           webhook.Name,
+		Url:            webhook.Url,
+		ResHeaders:     webhook.Headers,
+		Metadata:       webhook.Metadata,
+		ClientCert:     webhook.ClientCert,
+		CACert:         webhook.CACert,
+		ProxyURL:       webhook.ProxyURL,
+		BatchSize:      webhook.BatchSize,
+		BatchInterval:  uint(webhook.BatchInterval / time.Second),
+		MaxPayloadSize: webhook.MaxPayloadSize,
+		Filter:         filterResponse(webhook.Filter),
+		updated:        updated,
 	}
 
 	return wh
 }
+
+// filterResponse returns the filter of the webhook, or nil if the webhook
+// forwards all the messages, so that the filter is omitted.
+func filterResponse(f webhooks.Filter) *webhooks.Filter {
+	if f.Empty() {
+		return nil
+	}
+
+	return &f
+}
+
+func buildSecretResponse(secret webhooks.Secret) secretRes {
+	return secretRes{
+		ID:    secret.ID,
+		OrgID: secret.OrgID,
+		Name:  secret.Name,
+	}
+}
+
+func buildEventWebhookResponse(ewh webhooks.EventWebhook) eventWebhookRes {
+	return eventWebhookRes{
+		ID:         ewh.ID,
+		OrgID:      ewh.OrgID,
+		Name:       ewh.Name,
+		Url:        ewh.Url,
+		ResHeaders: ewh.Headers,
+		EventTypes: ewh.EventTypes,
+	}
+}
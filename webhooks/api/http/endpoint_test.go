@@ -12,11 +12,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/logger"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
+	mfjson "github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/users"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 	httpapi "github.com/MainfluxLabs/mainflux/webhooks/api/http"
 	whmocks "github.com/MainfluxLabs/mainflux/webhooks/mocks"
@@ -32,11 +35,13 @@ const (
 	contentType = "application/json"
 	emptyValue  = ""
 	groupID     = "50e6b371-60ff-45cf-bb52-8200e7cde536"
+	orgID       = "1ad4b5a9-3f2e-4f0c-9a71-bd4e1a6a7c2e"
 	prefixID    = "fe6b4e92-cc98-425e-b0aa-"
 	prefixName  = "test-webhook-"
 	nameKey     = "name"
 	ascKey      = "asc"
 	descKey     = "desc"
+	msgsSize    = 5
 )
 
 var (
@@ -44,6 +49,9 @@ var (
 	webhook       = webhooks.Webhook{GroupID: groupID, Name: "test-webhook", Url: "https://test.webhook.com", Headers: headers, Metadata: map[string]interface{}{"test": "data"}}
 	invalidIDRes  = toJSON(apiutil.ErrorRes{Err: apiutil.ErrMissingID.Error()})
 	missingTokRes = toJSON(apiutil.ErrorRes{Err: apiutil.ErrBearerToken.Error()})
+	secret        = webhooks.Secret{Name: "api_key", Value: "9f1c2e4a"}
+	eventWebhook  = webhooks.EventWebhook{Name: "event-webhook", Url: "https://test.webhook.com/events", Headers: headers, EventTypes: []string{webhooks.ThingCreateEvent}}
+	usersList     = []users.User{{ID: "5aa2b5e7-5d3a-4a4b-9f2d-3c1a1b2e4f60", Email: token, Role: auth.Owner}}
 )
 
 func newHTTPServer(svc webhooks.Service) *httptest.Server {
@@ -58,12 +66,16 @@ func toJSON(data interface{}) string {
 }
 
 func newService() webhooks.Service {
-	groups := mocks.NewThingsServiceClient(nil, nil, map[string]things.Group{token: {ID: groupID}})
+	groups := mocks.NewThingsServiceClient(nil, nil, map[string]things.Group{token: {ID: groupID}, groupID: {ID: groupID, OrgID: orgID}})
+	ac := mocks.NewAuthService("", usersList)
 	webhookRepo := whmocks.NewWebhookRepository()
+	secretRepo := whmocks.NewSecretRepository()
+	eventRepo := whmocks.NewEventWebhookRepository()
+	messageRepo := whmocks.NewMessageRepository(msgsSize)
 	forwarder := whmocks.NewForwarder()
 	idProvider := uuid.NewMock()
 
-	return webhooks.New(groups, webhookRepo, forwarder, idProvider)
+	return webhooks.New(groups, ac, webhookRepo, secretRepo, eventRepo, messageRepo, forwarder, idProvider)
 }
 
 type testRequest struct {
@@ -100,6 +112,13 @@ func TestCreateWebhooks(t *testing.T) {
 	validData := `[{"name":"value","url":"https://api.example.com","headers":{"Content-Type":"application/json"}}]`
 	invalidName := fmt.Sprintf(`[{"name":"%s","url":"https://api.example.com","headers":{"Content-Type":"application/json"}}]`, emptyValue)
 	invalidUrl := fmt.Sprintf(`[{"name":"value","url":"%s","headers":{"Content-Type":"application/json"}}]`, invalidUrl)
+	missingCert := `[{"name":"value","url":"https://api.example.com","client_key":"key"}]`
+	invalidCert := `[{"name":"value","url":"https://api.example.com","client_cert":"cert","client_key":"key"}]`
+	invalidProxy := `[{"name":"value","url":"https://api.example.com","proxy_url":"proxy"}]`
+	batchData := `[{"name":"batch","url":"https://api.example.com","batch_size":10,"batch_interval":5,"max_payload_size":1024}]`
+	invalidBatch := `[{"name":"value","url":"https://api.example.com","batch_size":-1}]`
+	filterData := `[{"name":"filter","url":"https://api.example.com","filter":{"operator":"OR","conditions":[{"field":"payload.temperature","comparator":"gt","value":30},{"field":"subtopic","comparator":"eq","value":"alarms"}]}}]`
+	invalidFilter := `[{"name":"value","url":"https://api.example.com","filter":{"conditions":[{"field":"payload.temperature","comparator":"like","value":30}]}}]`
 
 	cases := []struct {
 		desc        string
@@ -119,6 +138,42 @@ func TestCreateWebhooks(t *testing.T) {
 			status:      http.StatusCreated,
 			response:    emptyValue,
 		},
+		{
+			desc:        "create webhooks with batching",
+			data:        batchData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create webhooks with filter",
+			data:        filterData,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create webhooks with invalid filter",
+			data:        invalidFilter,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create webhooks with invalid batch size",
+			data:        invalidBatch,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
 		{
 			desc:        "create webhooks with empty request",
 			data:        emptyValue,
@@ -173,6 +228,33 @@ func TestCreateWebhooks(t *testing.T) {
 			status:      http.StatusBadRequest,
 			response:    emptyValue,
 		},
+		{
+			desc:        "create webhooks with client key without certificate",
+			data:        missingCert,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create webhooks with invalid client certificate",
+			data:        invalidCert,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
+		{
+			desc:        "create webhooks with invalid proxy url",
+			data:        invalidProxy,
+			groupID:     groupID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+			response:    emptyValue,
+		},
 		{
 			desc:        "create webhooks with empty JSON array",
 			data:        "[]",
@@ -452,6 +534,10 @@ func TestUpdateWebhook(t *testing.T) {
 	wh2.Name = emptyValue
 	invalidData := toJSON(wh2)
 
+	wh3 := webhook
+	wh3.Filter = webhooks.Filter{Conditions: []webhooks.Condition{{Field: "name", Comparator: webhooks.EqualKey, Value: "value"}}}
+	invalidFilter := toJSON(wh3)
+
 	cases := []struct {
 		desc        string
 		req         string
@@ -468,6 +554,14 @@ func TestUpdateWebhook(t *testing.T) {
 			auth:        token,
 			status:      http.StatusOK,
 		},
+		{
+			desc:        "update webhook with invalid filter",
+			req:         invalidFilter,
+			id:          wh1.ID,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
 		{
 			desc:        "update webhook with empty JSON request",
 			req:         "{}",
@@ -689,3 +783,624 @@ func TestRemoveWebhooks(t *testing.T) {
 		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
 	}
 }
+
+func TestListRecentMessages(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	whs, err := svc.CreateWebhooks(context.Background(), token, webhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	wh := whs[0]
+
+	msg := mfjson.Message{
+		ProfileConfig: mfjson.Config{"webhook_id": wh.ID},
+		Payload:       mfjson.Payload{"key1": "val1"},
+	}
+	for i := 0; i < msgsSize; i++ {
+		err := svc.Consume(mfjson.Messages{Data: []mfjson.Message{msg}})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc   string
+		url    string
+		auth   string
+		status int
+		size   int
+	}{
+		{
+			desc:   "list recent messages",
+			url:    fmt.Sprintf("%s/webhooks/%s/messages", ts.URL, wh.ID),
+			auth:   token,
+			status: http.StatusOK,
+			size:   msgsSize,
+		},
+		{
+			desc:   "list recent messages with limit",
+			url:    fmt.Sprintf("%s/webhooks/%s/messages?limit=2", ts.URL, wh.ID),
+			auth:   token,
+			status: http.StatusOK,
+			size:   2,
+		},
+		{
+			desc:   "list recent messages with limit greater than max",
+			url:    fmt.Sprintf("%s/webhooks/%s/messages?limit=110", ts.URL, wh.ID),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list recent messages with invalid limit",
+			url:    fmt.Sprintf("%s/webhooks/%s/messages?limit=invalid", ts.URL, wh.ID),
+			auth:   token,
+			status: http.StatusBadRequest,
+			size:   0,
+		},
+		{
+			desc:   "list recent messages with empty token",
+			url:    fmt.Sprintf("%s/webhooks/%s/messages", ts.URL, wh.ID),
+			auth:   emptyValue,
+			status: http.StatusUnauthorized,
+			size:   0,
+		},
+		{
+			desc:   "list recent messages of non-existing webhook",
+			url:    fmt.Sprintf("%s/webhooks/%s/messages", ts.URL, wrongValue),
+			auth:   token,
+			status: http.StatusNotFound,
+			size:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    tc.url,
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		var body struct {
+			Messages []mfjson.Message `json:"messages"`
+		}
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.size, len(body.Messages), fmt.Sprintf("%s: expected %d messages got %d", tc.desc, tc.size, len(body.Messages)))
+	}
+}
+
+func TestTestWebhook(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	whs, err := svc.CreateWebhooks(context.Background(), token, webhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	wh := whs[0]
+
+	otherWhs, err := svc.CreateWebhooks(context.Background(), token, webhooks.Webhook{GroupID: groupID, Name: "other-webhook", Url: webhook.Url})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	otherWh := otherWhs[0]
+
+	msg := mfjson.Message{
+		ProfileConfig: mfjson.Config{"webhook_id": wh.ID},
+		Payload:       mfjson.Payload{"key1": "val1"},
+	}
+	err = svc.Consume(mfjson.Messages{Data: []mfjson.Message{msg}})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	validData := `{"payload":{"key1":"val1"}}`
+
+	cases := []struct {
+		desc        string
+		id          string
+		data        string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "test webhook with payload",
+			id:          wh.ID,
+			data:        validData,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "test webhook with recent message",
+			id:          wh.ID,
+			data:        emptyValue,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "test webhook without recent messages",
+			id:          otherWh.ID,
+			data:        `{}`,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "test webhook with invalid request format",
+			id:          wh.ID,
+			data:        "}{",
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "test webhook with invalid content type",
+			id:          wh.ID,
+			data:        validData,
+			auth:        token,
+			contentType: wrongValue,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "test webhook with empty token",
+			id:          wh.ID,
+			data:        validData,
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "test non-existing webhook",
+			id:          wrongValue,
+			data:        validData,
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/webhooks/%s/test", ts.URL, tc.id),
+			token:       tc.auth,
+			contentType: tc.contentType,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+type secretRes struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+func TestCreateSecrets(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create secrets",
+			data:        `[{"name":"api_key","value":"9f1c2e4a"}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create existing secret",
+			data:        `[{"name":"api_key","value":"9f1c2e4a"}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create secret with invalid name",
+			data:        `[{"name":"api key","value":"9f1c2e4a"}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create secret without value",
+			data:        `[{"name":"token"}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create secrets with empty list",
+			data:        `[]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create secrets with invalid content type",
+			data:        `[{"name":"token","value":"9f1c2e4a"}]`,
+			contentType: wrongValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create secrets with invalid auth token",
+			data:        `[{"name":"token","value":"9f1c2e4a"}]`,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/orgs/%s/secrets", ts.URL, orgID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestViewSecret(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	ss, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	s := ss[0]
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+		res    secretRes
+	}{
+		{
+			desc:   "view secret",
+			id:     s.ID,
+			auth:   token,
+			status: http.StatusOK,
+			res:    secretRes{ID: s.ID, OrgID: orgID, Name: s.Name},
+		},
+		{
+			desc:   "view non-existing secret",
+			id:     wrongValue,
+			auth:   token,
+			status: http.StatusNotFound,
+			res:    secretRes{},
+		},
+		{
+			desc:   "view secret with invalid auth token",
+			id:     s.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			res:    secretRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/secrets/%s", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body secretRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+	}
+}
+
+func TestUpdateSecret(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	ss, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	s := ss[0]
+
+	cases := []struct {
+		desc        string
+		id          string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "update secret",
+			id:          s.ID,
+			data:        `{"name":"renamed","value":"updated"}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update secret name only",
+			id:          s.ID,
+			data:        `{"name":"api_key"}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusOK,
+		},
+		{
+			desc:        "update secret with invalid name",
+			id:          s.ID,
+			data:        `{"name":"api.key"}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "update non-existing secret",
+			id:          wrongValue,
+			data:        `{"name":"renamed"}`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "update secret with invalid content type",
+			id:          s.ID,
+			data:        `{"name":"renamed"}`,
+			contentType: wrongValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/secrets/%s", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestRemoveSecrets(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	ss, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc        string
+		data        []string
+		auth        string
+		contentType string
+		status      int
+	}{
+		{
+			desc:        "remove secrets with empty token",
+			data:        []string{ss[0].ID},
+			auth:        emptyValue,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "remove existing secrets",
+			data:        []string{ss[0].ID},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNoContent,
+		},
+		{
+			desc:        "remove non-existent secrets",
+			data:        []string{wrongValue},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "remove secrets with empty list",
+			data:        []string{},
+			auth:        token,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		data := struct {
+			SecretIDs []string `json:"secret_ids"`
+		}{
+			tc.data,
+		}
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPatch,
+			url:         fmt.Sprintf("%s/secrets", ts.URL),
+			token:       tc.auth,
+			contentType: tc.contentType,
+			body:        strings.NewReader(toJSON(data)),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+type eventWebhookRes struct {
+	ID         string            `json:"id"`
+	OrgID      string            `json:"org_id"`
+	Name       string            `json:"name"`
+	Url        string            `json:"url"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	EventTypes []string          `json:"event_types"`
+}
+
+func TestCreateEventWebhooks(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	cases := []struct {
+		desc        string
+		data        string
+		contentType string
+		auth        string
+		status      int
+	}{
+		{
+			desc:        "create event webhooks",
+			data:        `[{"name":"events","url":"https://test.webhook.com/events","event_types":["thing.create","alarm.create"]}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "create existing event webhook",
+			data:        `[{"name":"events","url":"https://test.webhook.com/events","event_types":["thing.create"]}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusConflict,
+		},
+		{
+			desc:        "create event webhook with unknown event type",
+			data:        `[{"name":"unknown","url":"https://test.webhook.com/events","event_types":["thing.unknown"]}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create event webhook without event types",
+			data:        `[{"name":"empty","url":"https://test.webhook.com/events","event_types":[]}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create event webhook with invalid url",
+			data:        `[{"name":"invalid","url":"invalid-url","event_types":["cert.issue"]}]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create event webhooks with empty list",
+			data:        `[]`,
+			contentType: contentType,
+			auth:        token,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "create event webhooks with invalid content type",
+			data:        `[{"name":"events","url":"https://test.webhook.com/events","event_types":["thing.create"]}]`,
+			contentType: wrongValue,
+			auth:        token,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "create event webhooks with invalid auth token",
+			data:        `[{"name":"events","url":"https://test.webhook.com/events","event_types":["thing.create"]}]`,
+			contentType: contentType,
+			auth:        wrongValue,
+			status:      http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/orgs/%s/event-webhooks", ts.URL, orgID),
+			contentType: tc.contentType,
+			token:       tc.auth,
+			body:        strings.NewReader(tc.data),
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestViewEventWebhook(t *testing.T) {
+	svc := newService()
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	ewhs, err := svc.CreateEventWebhooks(context.Background(), token, orgID, eventWebhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	ewh := ewhs[0]
+
+	cases := []struct {
+		desc   string
+		id     string
+		auth   string
+		status int
+		res    eventWebhookRes
+	}{
+		{
+			desc:   "view event webhook",
+			id:     ewh.ID,
+			auth:   token,
+			status: http.StatusOK,
+			res:    eventWebhookRes{ID: ewh.ID, OrgID: orgID, Name: ewh.Name, Url: ewh.Url, Headers: ewh.Headers, EventTypes: ewh.EventTypes},
+		},
+		{
+			desc:   "view non-existing event webhook",
+			id:     wrongValue,
+			auth:   token,
+			status: http.StatusNotFound,
+			res:    eventWebhookRes{},
+		},
+		{
+			desc:   "view event webhook with invalid auth token",
+			id:     ewh.ID,
+			auth:   wrongValue,
+			status: http.StatusUnauthorized,
+			res:    eventWebhookRes{},
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/event-webhooks/%s", ts.URL, tc.id),
+			token:  tc.auth,
+		}
+		res, err := req.make()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body eventWebhookRes
+		json.NewDecoder(res.Body).Decode(&body)
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected body %v got %v", tc.desc, tc.res, body))
+	}
+}
@@ -5,9 +5,11 @@ package http
 
 import (
 	"net/url"
+	"regexp"
 
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 )
 
@@ -21,18 +23,42 @@ const (
 	descDir      = "desc"
 )
 
-var ErrInvalidUrl = errors.New("missing or invalid url")
+var (
+	ErrInvalidUrl = errors.New("missing or invalid url")
+
+	// ErrInvalidClientCert indicates a client key sent without the client certificate.
+	ErrInvalidClientCert = errors.New("client certificate and key must be provided together")
+
+	// ErrInvalidSecretName indicates the secret name which can't be referenced in the webhooks.
+	ErrInvalidSecretName = errors.New("secret name must contain only letters, digits, underscores and hyphens")
+
+	// ErrMissingSecretValue indicates the missing secret value.
+	ErrMissingSecretValue = errors.New("missing secret value")
+
+	// ErrInvalidEventType indicates the missing or unknown event type of the event webhook.
+	ErrInvalidEventType = errors.New("missing or invalid event type")
+
+	secretName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
 
 type apiReq interface {
 	validate() error
 }
 
 type createWebhookReq struct {
-	ID       string                 `json:"id,omitempty"`
-	Name     string                 `json:"name"`
-	Url      string                 `json:"url"`
-	Headers  map[string]string      `json:"headers,omitempty"`
-	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	ID             string                 `json:"id,omitempty"`
+	Name           string                 `json:"name"`
+	Url            string                 `json:"url"`
+	Headers        map[string]string      `json:"headers,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	ClientCert     string                 `json:"client_cert,omitempty"`
+	ClientKey      string                 `json:"client_key,omitempty"`
+	CACert         string                 `json:"ca_cert,omitempty"`
+	ProxyURL       string                 `json:"proxy_url,omitempty"`
+	BatchSize      uint                   `json:"batch_size,omitempty"`
+	BatchInterval  uint                   `json:"batch_interval,omitempty"`
+	MaxPayloadSize uint                   `json:"max_payload_size,omitempty"`
+	Filter         webhooks.Filter        `json:"filter"`
 }
 
 type createWebhooksReq struct {
@@ -73,7 +99,11 @@ func (req createWebhookReq) validate() error {
 		return ErrInvalidUrl
 	}
 
-	return nil
+	if (req.ClientCert == "") != (req.ClientKey == "") {
+		return ErrInvalidClientCert
+	}
+
+	return req.Filter.Validate()
 }
 
 type webhookReq struct {
@@ -124,12 +154,20 @@ func (req listWebhooksReq) validate() error {
 }
 
 type updateWebhookReq struct {
-	token    string
-	id       string
-	Name     string                 `json:"name"`
-	Url      string                 `json:"url"`
-	Headers  map[string]string      `json:"headers,omitempty"`
-	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	token          string
+	id             string
+	Name           string                 `json:"name"`
+	Url            string                 `json:"url"`
+	Headers        map[string]string      `json:"headers,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	ClientCert     string                 `json:"client_cert,omitempty"`
+	ClientKey      string                 `json:"client_key,omitempty"`
+	CACert         string                 `json:"ca_cert,omitempty"`
+	ProxyURL       string                 `json:"proxy_url,omitempty"`
+	BatchSize      uint                   `json:"batch_size,omitempty"`
+	BatchInterval  uint                   `json:"batch_interval,omitempty"`
+	MaxPayloadSize uint                   `json:"max_payload_size,omitempty"`
+	Filter         webhooks.Filter        `json:"filter"`
 }
 
 func (req updateWebhookReq) validate() error {
@@ -150,7 +188,11 @@ func (req updateWebhookReq) validate() error {
 		return ErrInvalidUrl
 	}
 
-	return nil
+	if req.ClientKey != "" && req.ClientCert == "" {
+		return ErrInvalidClientCert
+	}
+
+	return req.Filter.Validate()
 }
 
 type removeWebhooksReq struct {
@@ -175,3 +217,313 @@ func (req removeWebhooksReq) validate() error {
 
 	return nil
 }
+
+type listRecentMessagesReq struct {
+	token string
+	id    string
+	limit uint64
+}
+
+func (req listRecentMessagesReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type testWebhookReq struct {
+	token   string
+	id      string
+	Payload json.Payload `json:"payload,omitempty"`
+}
+
+func (req testWebhookReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type createSecretReq struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func (req createSecretReq) validate() error {
+	if err := validateSecretName(req.Name); err != nil {
+		return err
+	}
+
+	if req.Value == "" {
+		return ErrMissingSecretValue
+	}
+
+	return nil
+}
+
+type createSecretsReq struct {
+	token   string
+	orgID   string
+	Secrets []createSecretReq `json:"secrets"`
+}
+
+func (req createSecretsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	if len(req.Secrets) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, s := range req.Secrets {
+		if err := s.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type secretReq struct {
+	token string
+	id    string
+}
+
+func (req secretReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listByOrgReq struct {
+	token        string
+	id           string
+	pageMetadata webhooks.PageMetadata
+}
+
+func (req listByOrgReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if req.pageMetadata.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	if req.pageMetadata.Order != "" &&
+		req.pageMetadata.Order != nameOrder && req.pageMetadata.Order != idOrder {
+		return apiutil.ErrInvalidOrder
+	}
+
+	if req.pageMetadata.Dir != "" &&
+		req.pageMetadata.Dir != ascDir && req.pageMetadata.Dir != descDir {
+		return apiutil.ErrInvalidDirection
+	}
+
+	return nil
+}
+
+type updateSecretReq struct {
+	token string
+	id    string
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+func (req updateSecretReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateSecretName(req.Name)
+}
+
+type removeSecretsReq struct {
+	token     string
+	SecretIDs []string `json:"secret_ids,omitempty"`
+}
+
+func (req removeSecretsReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.SecretIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.SecretIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+type createEventWebhookReq struct {
+	Name       string            `json:"name"`
+	Url        string            `json:"url"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	EventTypes []string          `json:"event_types"`
+}
+
+func (req createEventWebhookReq) validate() error {
+	return validateEventWebhook(req.Name, req.Url, req.EventTypes)
+}
+
+type createEventWebhooksReq struct {
+	token         string
+	orgID         string
+	EventWebhooks []createEventWebhookReq `json:"event_webhooks"`
+}
+
+func (req createEventWebhooksReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.orgID == "" {
+		return apiutil.ErrMissingOrgID
+	}
+
+	if len(req.EventWebhooks) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, ewh := range req.EventWebhooks {
+		if err := ewh.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type eventWebhookReq struct {
+	token string
+	id    string
+}
+
+func (req eventWebhookReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type updateEventWebhookReq struct {
+	token      string
+	id         string
+	Name       string            `json:"name"`
+	Url        string            `json:"url"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	EventTypes []string          `json:"event_types"`
+}
+
+func (req updateEventWebhookReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return validateEventWebhook(req.Name, req.Url, req.EventTypes)
+}
+
+type removeEventWebhooksReq struct {
+	token           string
+	EventWebhookIDs []string `json:"event_webhook_ids,omitempty"`
+}
+
+func (req removeEventWebhooksReq) validate() error {
+	if req.token == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if len(req.EventWebhookIDs) < minLen {
+		return apiutil.ErrEmptyList
+	}
+
+	for _, id := range req.EventWebhookIDs {
+		if id == "" {
+			return apiutil.ErrMissingID
+		}
+	}
+
+	return nil
+}
+
+func validateEventWebhook(name, whURL string, eventTypes []string) error {
+	if name == "" || len(name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if _, err := url.ParseRequestURI(whURL); err != nil {
+		return ErrInvalidUrl
+	}
+
+	if len(eventTypes) < minLen {
+		return ErrInvalidEventType
+	}
+
+	for _, t := range eventTypes {
+		if !webhooks.ValidEventType(t) {
+			return ErrInvalidEventType
+		}
+	}
+
+	return nil
+}
+
+func validateSecretName(name string) error {
+	if name == "" || len(name) > maxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	if !secretName.MatchString(name) {
+		return ErrInvalidSecretName
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/webhooks"
 )
 
 var (
@@ -14,6 +16,14 @@ var (
 	_ apiutil.Response = (*webhooksRes)(nil)
 	_ apiutil.Response = (*removeRes)(nil)
 	_ apiutil.Response = (*removeRes)(nil)
+	_ apiutil.Response = (*recentMessagesRes)(nil)
+	_ apiutil.Response = (*testWebhookRes)(nil)
+	_ apiutil.Response = (*secretRes)(nil)
+	_ apiutil.Response = (*secretsRes)(nil)
+	_ apiutil.Response = (*secretsPageRes)(nil)
+	_ apiutil.Response = (*eventWebhookRes)(nil)
+	_ apiutil.Response = (*eventWebhooksRes)(nil)
+	_ apiutil.Response = (*eventWebhooksPageRes)(nil)
 )
 
 type pageRes struct {
@@ -26,13 +36,20 @@ type pageRes struct {
 }
 
 type webhookResponse struct {
-	ID         string                 `json:"id"`
-	GroupID    string                 `json:"group_id"`
-	Name       string                 `json:"name"`
-	Url        string                 `json:"url"`
-	ResHeaders map[string]string      `json:"headers,omitempty"`
-	Metadata   map[string]interface{} `json:"metadata,omitempty"`
-	updated    bool
+	ID             string                 `json:"id"`
+	GroupID        string                 `json:"group_id"`
+	Name           string                 `json:"name"`
+	Url            string                 `json:"url"`
+	ResHeaders     map[string]string      `json:"headers,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	ClientCert     string                 `json:"client_cert,omitempty"`
+	CACert         string                 `json:"ca_cert,omitempty"`
+	ProxyURL       string                 `json:"proxy_url,omitempty"`
+	BatchSize      uint                   `json:"batch_size,omitempty"`
+	BatchInterval  uint                   `json:"batch_interval,omitempty"`
+	MaxPayloadSize uint                   `json:"max_payload_size,omitempty"`
+	Filter         *webhooks.Filter       `json:"filter,omitempty"`
+	updated        bool
 }
 
 func (res webhookResponse) Code() int {
@@ -101,3 +118,151 @@ func (res WebhooksPageRes) Headers() map[string]string {
 func (res WebhooksPageRes) Empty() bool {
 	return false
 }
+
+type recentMessagesRes struct {
+	Messages []json.Message `json:"messages"`
+}
+
+func (res recentMessagesRes) Code() int {
+	return http.StatusOK
+}
+
+func (res recentMessagesRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res recentMessagesRes) Empty() bool {
+	return false
+}
+
+type testWebhookRes struct{}
+
+func (res testWebhookRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res testWebhookRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res testWebhookRes) Empty() bool {
+	return true
+}
+
+// secretRes never contains the secret value.
+type secretRes struct {
+	ID      string `json:"id"`
+	OrgID   string `json:"org_id"`
+	Name    string `json:"name"`
+	updated bool
+}
+
+func (res secretRes) Code() int {
+	return http.StatusOK
+}
+
+func (res secretRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res secretRes) Empty() bool {
+	return res.updated
+}
+
+type secretsRes struct {
+	Secrets []secretRes `json:"secrets"`
+	created bool
+}
+
+func (res secretsRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res secretsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res secretsRes) Empty() bool {
+	return false
+}
+
+type secretsPageRes struct {
+	pageRes
+	Secrets []secretRes `json:"secrets"`
+}
+
+func (res secretsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res secretsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res secretsPageRes) Empty() bool {
+	return false
+}
+
+type eventWebhookRes struct {
+	ID         string            `json:"id"`
+	OrgID      string            `json:"org_id"`
+	Name       string            `json:"name"`
+	Url        string            `json:"url"`
+	ResHeaders map[string]string `json:"headers,omitempty"`
+	EventTypes []string          `json:"event_types"`
+	updated    bool
+}
+
+func (res eventWebhookRes) Code() int {
+	return http.StatusOK
+}
+
+func (res eventWebhookRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res eventWebhookRes) Empty() bool {
+	return res.updated
+}
+
+type eventWebhooksRes struct {
+	EventWebhooks []eventWebhookRes `json:"event_webhooks"`
+	created       bool
+}
+
+func (res eventWebhooksRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res eventWebhooksRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res eventWebhooksRes) Empty() bool {
+	return false
+}
+
+type eventWebhooksPageRes struct {
+	pageRes
+	EventWebhooks []eventWebhookRes `json:"event_webhooks"`
+}
+
+func (res eventWebhooksPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res eventWebhooksPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res eventWebhooksPageRes) Empty() bool {
+	return false
+}
@@ -6,6 +6,7 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strings"
 
@@ -64,6 +65,18 @@ func MakeHandler(tracer opentracing.Tracer, svc webhooks.Service, logger log.Log
 		encodeResponse,
 		opts...,
 	))
+	r.Get("/webhooks/:id/messages", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_recent_messages")(listRecentMessagesEndpoint(svc)),
+		decodeListRecentMessages,
+		encodeResponse,
+		opts...,
+	))
+	r.Post("/webhooks/:id/test", kithttp.NewServer(
+		kitot.TraceServer(tracer, "test_webhook")(testWebhookEndpoint(svc)),
+		decodeTestWebhook,
+		encodeResponse,
+		opts...,
+	))
 	r.Patch("/webhooks", kithttp.NewServer(
 		kitot.TraceServer(tracer, "remove_webhooks")(removeWebhooksEndpoint(svc)),
 		decodeRemoveWebhooks,
@@ -71,6 +84,68 @@ func MakeHandler(tracer opentracing.Tracer, svc webhooks.Service, logger log.Log
 		opts...,
 	))
 
+	r.Post("/orgs/:id/secrets", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_secrets")(createSecretsEndpoint(svc)),
+		decodeCreateSecrets,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/orgs/:id/secrets", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_secrets_by_org")(listSecretsByOrgEndpoint(svc)),
+		decodeListByOrg,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/secrets/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_secret")(viewSecretEndpoint(svc)),
+		decodeSecretRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/secrets/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_secret")(updateSecretEndpoint(svc)),
+		decodeUpdateSecret,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/secrets", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_secrets")(removeSecretsEndpoint(svc)),
+		decodeRemoveSecrets,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Post("/orgs/:id/event-webhooks", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_event_webhooks")(createEventWebhooksEndpoint(svc)),
+		decodeCreateEventWebhooks,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/orgs/:id/event-webhooks", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_event_webhooks_by_org")(listEventWebhooksByOrgEndpoint(svc)),
+		decodeListByOrg,
+		encodeResponse,
+		opts...,
+	))
+	r.Get("/event-webhooks/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_event_webhook")(viewEventWebhookEndpoint(svc)),
+		decodeEventWebhookRequest,
+		encodeResponse,
+		opts...,
+	))
+	r.Put("/event-webhooks/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_event_webhook")(updateEventWebhookEndpoint(svc)),
+		decodeUpdateEventWebhook,
+		encodeResponse,
+		opts...,
+	))
+	r.Patch("/event-webhooks", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_event_webhooks")(removeEventWebhooksEndpoint(svc)),
+		decodeRemoveEventWebhooks,
+		encodeResponse,
+		opts...,
+	))
+
 	r.GetFunc("/health", mainflux.Health("webhooks"))
 	r.Handle("/metrics", promhttp.Handler())
 
@@ -147,6 +222,37 @@ func decodeUpdateWebhook(_ context.Context, r *http.Request) (interface{}, error
 	return req, nil
 }
 
+func decodeListRecentMessages(_ context.Context, r *http.Request) (interface{}, error) {
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listRecentMessagesReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+		limit: l,
+	}
+
+	return req, nil
+}
+
+func decodeTestWebhook(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := testWebhookReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
 func decodeRemoveWebhooks(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
 		return nil, apiutil.ErrUnsupportedContentType
@@ -163,6 +269,143 @@ func decodeRemoveWebhooks(_ context.Context, r *http.Request) (interface{}, erro
 	return req, nil
 }
 
+func decodeCreateSecrets(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createSecretsReq{token: apiutil.ExtractBearerToken(r), orgID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Secrets); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeListByOrg(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := apiutil.ReadUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := apiutil.ReadLimitQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	or, err := apiutil.ReadStringQuery(r, orderKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := apiutil.ReadStringQuery(r, dirKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	req := listByOrgReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+		pageMetadata: webhooks.PageMetadata{
+			Offset: o,
+			Limit:  l,
+			Order:  or,
+			Dir:    d,
+		},
+	}
+
+	return req, nil
+}
+
+func decodeSecretRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := secretReq{token: apiutil.ExtractBearerToken(r), id: bone.GetValue(r, idKey)}
+
+	return req, nil
+}
+
+func decodeUpdateSecret(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateSecretReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveSecrets(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeSecretsReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeCreateEventWebhooks(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := createEventWebhooksReq{token: apiutil.ExtractBearerToken(r), orgID: bone.GetValue(r, idKey)}
+	if err := json.NewDecoder(r.Body).Decode(&req.EventWebhooks); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeEventWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := eventWebhookReq{token: apiutil.ExtractBearerToken(r), id: bone.GetValue(r, idKey)}
+
+	return req, nil
+}
+
+func decodeUpdateEventWebhook(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateEventWebhookReq{
+		token: apiutil.ExtractBearerToken(r),
+		id:    bone.GetValue(r, idKey),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
+func decodeRemoveEventWebhooks(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := removeEventWebhooksReq{
+		token: apiutil.ExtractBearerToken(r),
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedEntity, err)
+	}
+
+	return req, nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", contentType)
 
@@ -181,7 +424,7 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 	return json.NewEncoder(w).Encode(response)
 }
 
-func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+func encodeError(ctx context.Context, err error, w http.ResponseWriter) {
 	switch {
 	case errors.Contains(err, errors.ErrNotFound):
 		w.WriteHeader(http.StatusNotFound)
@@ -204,10 +447,19 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		err == apiutil.ErrOffsetSize,
 		err == apiutil.ErrInvalidOrder,
 		err == apiutil.ErrInvalidDirection,
-		err == ErrInvalidUrl:
+		err == apiutil.ErrMissingOrgID,
+		err == ErrInvalidUrl,
+		err == ErrInvalidClientCert,
+		err == ErrInvalidSecretName,
+		err == ErrMissingSecretValue,
+		err == ErrInvalidEventType,
+		errors.Contains(err, webhooks.ErrUnknownSecret),
+		errors.Contains(err, webhooks.ErrInvalidFilter):
 		w.WriteHeader(http.StatusBadRequest)
 	case errors.Contains(err, errors.ErrConflict):
 		w.WriteHeader(http.StatusConflict)
+	case errors.Contains(err, webhooks.ErrForward):
+		w.WriteHeader(http.StatusBadGateway)
 	case errors.Contains(err, errors.ErrScanMetadata):
 		w.WriteHeader(http.StatusUnprocessableEntity)
 	case errors.Contains(err, errors.ErrCreateEntity),
@@ -221,7 +473,7 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 
 	if errorVal, ok := err.(errors.Error); ok {
 		w.Header().Set("Content-Type", contentType)
-		if err := json.NewEncoder(w).Encode(apiutil.ErrorRes{Err: errorVal.Msg()}); err != nil {
+		if err := json.NewEncoder(w).Encode(apiutil.NewErrorRes(ctx, errorVal.Msg())); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 		}
 	}
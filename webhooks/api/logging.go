@@ -12,6 +12,7 @@ import (
 	"time"
 
 	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 )
 
@@ -29,12 +30,12 @@ func LoggingMiddleware(svc webhooks.Service, logger log.Logger) webhooks.Service
 
 func (lm *loggingMiddleware) CreateWebhooks(ctx context.Context, token string, webhooks ...webhooks.Webhook) (response []webhooks.Webhook, err error) {
 	defer func(begin time.Time) {
-		message := fmt.Sprintf("Method create_webhooks for webhooks %s took %s to complete", response, time.Since(begin))
+		message := fmt.Sprintf("Method create_webhooks for webhooks %v took %s to complete", response, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.CreateWebhooks(ctx, token, webhooks...)
@@ -44,10 +45,10 @@ func (lm *loggingMiddleware) ListWebhooksByGroup(ctx context.Context, token, gro
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method list_webhooks_by_group for id %s took %s to complete", groupID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ListWebhooksByGroup(ctx, token, groupID, pm)
@@ -57,10 +58,10 @@ func (lm *loggingMiddleware) ViewWebhook(ctx context.Context, token, id string)
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method view_webhook for id %s took %s to complete", id, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.ViewWebhook(ctx, token, id)
@@ -70,10 +71,10 @@ func (lm *loggingMiddleware) UpdateWebhook(ctx context.Context, token string, we
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method update_webhook for id %s took %s to complete", webhook.ID, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.UpdateWebhook(ctx, token, webhook)
@@ -83,15 +84,223 @@ func (lm *loggingMiddleware) RemoveWebhooks(ctx context.Context, token string, i
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method remove_webhooks took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.RemoveWebhooks(ctx, token, id...)
 }
 
+func (lm *loggingMiddleware) RemoveWebhooksByGroup(ctx context.Context, groupID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_webhooks_by_group for group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveWebhooksByGroup(ctx, groupID)
+}
+
+func (lm *loggingMiddleware) ListRecentMessages(ctx context.Context, token, id string, limit uint64) (response []json.Message, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_recent_messages for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListRecentMessages(ctx, token, id, limit)
+}
+
+func (lm *loggingMiddleware) TestWebhook(ctx context.Context, token, id string, payload json.Payload) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method test_webhook for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.TestWebhook(ctx, token, id, payload)
+}
+
+func (lm *loggingMiddleware) CreateSecrets(ctx context.Context, token, orgID string, secrets ...webhooks.Secret) (response []webhooks.Secret, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_secrets for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateSecrets(ctx, token, orgID, secrets...)
+}
+
+func (lm *loggingMiddleware) ListSecretsByOrg(ctx context.Context, token, orgID string, pm webhooks.PageMetadata) (response webhooks.SecretsPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_secrets_by_org for id %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListSecretsByOrg(ctx, token, orgID, pm)
+}
+
+func (lm *loggingMiddleware) ViewSecret(ctx context.Context, token, id string) (response webhooks.Secret, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_secret for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewSecret(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateSecret(ctx context.Context, token string, secret webhooks.Secret) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_secret for id %s took %s to complete", secret.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateSecret(ctx, token, secret)
+}
+
+func (lm *loggingMiddleware) RemoveSecrets(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_secrets took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveSecrets(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveSecretsByOrg(ctx context.Context, orgID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_secrets_by_org for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveSecretsByOrg(ctx, orgID)
+}
+
+func (lm *loggingMiddleware) CreateEventWebhooks(ctx context.Context, token, orgID string, ewhs ...webhooks.EventWebhook) (response []webhooks.EventWebhook, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_event_webhooks for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.CreateEventWebhooks(ctx, token, orgID, ewhs...)
+}
+
+func (lm *loggingMiddleware) ListEventWebhooksByOrg(ctx context.Context, token, orgID string, pm webhooks.PageMetadata) (response webhooks.EventWebhooksPage, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_event_webhooks_by_org for id %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ListEventWebhooksByOrg(ctx, token, orgID, pm)
+}
+
+func (lm *loggingMiddleware) ViewEventWebhook(ctx context.Context, token, id string) (response webhooks.EventWebhook, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_event_webhook for id %s took %s to complete", id, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.ViewEventWebhook(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateEventWebhook(ctx context.Context, token string, ewh webhooks.EventWebhook) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_event_webhook for id %s took %s to complete", ewh.ID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UpdateEventWebhook(ctx, token, ewh)
+}
+
+func (lm *loggingMiddleware) RemoveEventWebhooks(ctx context.Context, token string, ids ...string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_event_webhooks took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveEventWebhooks(ctx, token, ids...)
+}
+
+func (lm *loggingMiddleware) RemoveEventWebhooksByOrg(ctx context.Context, orgID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_event_webhooks_by_org for org %s took %s to complete", orgID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveEventWebhooksByOrg(ctx, orgID)
+}
+
+func (lm *loggingMiddleware) HandleEvent(ctx context.Context, event webhooks.Event) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method handle_event for event %s took %s to complete", event.Type, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s.", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.HandleEvent(ctx, event)
+}
+
 func (lm *loggingMiddleware) Consume(message interface{}) (err error) {
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method consume took %s to complete", time.Since(begin))
@@ -10,6 +10,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 	"github.com/go-kit/kit/metrics"
 )
@@ -77,6 +78,150 @@ func (ms *metricsMiddleware) RemoveWebhooks(ctx context.Context, token string, i
 	return ms.svc.RemoveWebhooks(ctx, token, id...)
 }
 
+func (ms *metricsMiddleware) RemoveWebhooksByGroup(ctx context.Context, groupID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_webhooks_by_group").Add(1)
+		ms.latency.With("method", "remove_webhooks_by_group").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveWebhooksByGroup(ctx, groupID)
+}
+
+func (ms *metricsMiddleware) ListRecentMessages(ctx context.Context, token, id string, limit uint64) ([]json.Message, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_recent_messages").Add(1)
+		ms.latency.With("method", "list_recent_messages").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListRecentMessages(ctx, token, id, limit)
+}
+
+func (ms *metricsMiddleware) TestWebhook(ctx context.Context, token, id string, payload json.Payload) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "test_webhook").Add(1)
+		ms.latency.With("method", "test_webhook").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.TestWebhook(ctx, token, id, payload)
+}
+
+func (ms *metricsMiddleware) CreateSecrets(ctx context.Context, token, orgID string, secrets ...webhooks.Secret) ([]webhooks.Secret, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_secrets").Add(1)
+		ms.latency.With("method", "create_secrets").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateSecrets(ctx, token, orgID, secrets...)
+}
+
+func (ms *metricsMiddleware) ListSecretsByOrg(ctx context.Context, token, orgID string, pm webhooks.PageMetadata) (webhooks.SecretsPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_secrets_by_org").Add(1)
+		ms.latency.With("method", "list_secrets_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListSecretsByOrg(ctx, token, orgID, pm)
+}
+
+func (ms *metricsMiddleware) ViewSecret(ctx context.Context, token, id string) (webhooks.Secret, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_secret").Add(1)
+		ms.latency.With("method", "view_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewSecret(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateSecret(ctx context.Context, token string, secret webhooks.Secret) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_secret").Add(1)
+		ms.latency.With("method", "update_secret").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateSecret(ctx, token, secret)
+}
+
+func (ms *metricsMiddleware) RemoveSecrets(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_secrets").Add(1)
+		ms.latency.With("method", "remove_secrets").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveSecrets(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveSecretsByOrg(ctx context.Context, orgID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_secrets_by_org").Add(1)
+		ms.latency.With("method", "remove_secrets_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveSecretsByOrg(ctx, orgID)
+}
+
+func (ms *metricsMiddleware) CreateEventWebhooks(ctx context.Context, token, orgID string, ewhs ...webhooks.EventWebhook) ([]webhooks.EventWebhook, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_event_webhooks").Add(1)
+		ms.latency.With("method", "create_event_webhooks").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.CreateEventWebhooks(ctx, token, orgID, ewhs...)
+}
+
+func (ms *metricsMiddleware) ListEventWebhooksByOrg(ctx context.Context, token, orgID string, pm webhooks.PageMetadata) (webhooks.EventWebhooksPage, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_event_webhooks_by_org").Add(1)
+		ms.latency.With("method", "list_event_webhooks_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListEventWebhooksByOrg(ctx, token, orgID, pm)
+}
+
+func (ms *metricsMiddleware) ViewEventWebhook(ctx context.Context, token, id string) (webhooks.EventWebhook, error) {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_event_webhook").Add(1)
+		ms.latency.With("method", "view_event_webhook").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewEventWebhook(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateEventWebhook(ctx context.Context, token string, ewh webhooks.EventWebhook) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_event_webhook").Add(1)
+		ms.latency.With("method", "update_event_webhook").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateEventWebhook(ctx, token, ewh)
+}
+
+func (ms *metricsMiddleware) RemoveEventWebhooks(ctx context.Context, token string, ids ...string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_event_webhooks").Add(1)
+		ms.latency.With("method", "remove_event_webhooks").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveEventWebhooks(ctx, token, ids...)
+}
+
+func (ms *metricsMiddleware) RemoveEventWebhooksByOrg(ctx context.Context, orgID string) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_event_webhooks_by_org").Add(1)
+		ms.latency.With("method", "remove_event_webhooks_by_org").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveEventWebhooksByOrg(ctx, orgID)
+}
+
+func (ms *metricsMiddleware) HandleEvent(ctx context.Context, event webhooks.Event) error {
+	defer func(begin time.Time) {
+		ms.counter.With("method", "handle_event").Add(1)
+		ms.latency.With("method", "handle_event").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.HandleEvent(ctx, event)
+}
+
 func (ms *metricsMiddleware) Consume(message interface{}) error {
 	defer func(begin time.Time) {
 		ms.counter.With("method", "consume").Add(1)
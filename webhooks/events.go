@@ -0,0 +1,256 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	gojson "encoding/json"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+)
+
+const (
+	// ThingCreateEvent is the type of the event sent when a thing is created.
+	ThingCreateEvent = "thing.create"
+	// MemberAssignEvent is the type of the event sent when a user is added to an org.
+	MemberAssignEvent = "org.member.assign"
+	// CertIssueEvent is the type of the event sent when a thing certificate is issued or renewed.
+	CertIssueEvent = "cert.issue"
+	// AlarmEvent is the type of the event sent when an alarm is raised.
+	AlarmEvent = "alarm.create"
+)
+
+// EventTypes contains the types of the platform events the event webhooks can subscribe to.
+var EventTypes = []string{ThingCreateEvent, MemberAssignEvent, CertIssueEvent, AlarmEvent}
+
+// Event represents the platform lifecycle event. The org of the event is
+// resolved from the group, or the thing, if the org ID is empty.
+type Event struct {
+	Type    string
+	OrgID   string
+	GroupID string
+	ThingID string
+	Time    time.Time
+	Data    map[string]interface{}
+}
+
+// EventWebhook represents the org webhook which the platform events of the
+// subscribed types are sent to. Unlike the group webhooks, the event webhooks
+// aren't sent the thing messages.
+type EventWebhook struct {
+	ID         string
+	OrgID      string
+	Name       string
+	Url        string
+	Headers    map[string]string
+	EventTypes []string
+}
+
+// EventWebhooksPage contains page related metadata as well as a list of
+// event webhooks that belong to this page.
+type EventWebhooksPage struct {
+	PageMetadata
+	EventWebhooks []EventWebhook
+}
+
+// EventWebhookRepository specifies an event webhook persistence API.
+type EventWebhookRepository interface {
+	// Save persists multiple event webhooks. Event webhooks are saved using
+	// a transaction. If one event webhook fails then none will be saved.
+	Save(ctx context.Context, ewhs ...EventWebhook) ([]EventWebhook, error)
+
+	// RetrieveByOrgID retrieves event webhooks related to a certain org
+	// identified by a given ID.
+	RetrieveByOrgID(ctx context.Context, orgID string, pm PageMetadata) (EventWebhooksPage, error)
+
+	// RetrieveByEvent retrieves the event webhooks of the org identified by
+	// a given ID, which are subscribed to the provided event type.
+	RetrieveByEvent(ctx context.Context, orgID, eventType string) ([]EventWebhook, error)
+
+	// RetrieveByID retrieves the event webhook having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (EventWebhook, error)
+
+	// Update performs an update to the existing event webhook. A non-nil
+	// error is returned to indicate operation failure.
+	Update(ctx context.Context, ewh EventWebhook) error
+
+	// Remove removes the event webhooks having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByOrgID removes the event webhooks related to a certain org
+	// identified by a given ID.
+	RemoveByOrgID(ctx context.Context, orgID string) error
+}
+
+func (ws *webhooksService) CreateEventWebhooks(ctx context.Context, token, orgID string, eventWebhooks ...EventWebhook) ([]EventWebhook, error) {
+	if _, err := ws.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: orgID, Subject: auth.OrgSub, Action: auth.Admin}); err != nil {
+		return []EventWebhook{}, err
+	}
+
+	ewhs := []EventWebhook{}
+	for _, ewh := range eventWebhooks {
+		id, err := ws.idProvider.ID()
+		if err != nil {
+			return []EventWebhook{}, err
+		}
+
+		ewh.ID = id
+		ewh.OrgID = orgID
+		ewhs = append(ewhs, ewh)
+	}
+
+	return ws.events.Save(ctx, ewhs...)
+}
+
+func (ws *webhooksService) ListEventWebhooksByOrg(ctx context.Context, token, orgID string, pm PageMetadata) (EventWebhooksPage, error) {
+	if _, err := ws.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: orgID, Subject: auth.OrgSub, Action: auth.Viewer}); err != nil {
+		return EventWebhooksPage{}, err
+	}
+
+	return ws.events.RetrieveByOrgID(ctx, orgID, pm)
+}
+
+func (ws *webhooksService) ViewEventWebhook(ctx context.Context, token, id string) (EventWebhook, error) {
+	return ws.retrieveEventWebhook(ctx, token, id, auth.Viewer)
+}
+
+func (ws *webhooksService) UpdateEventWebhook(ctx context.Context, token string, eventWebhook EventWebhook) error {
+	ewh, err := ws.retrieveEventWebhook(ctx, token, eventWebhook.ID, auth.Admin)
+	if err != nil {
+		return err
+	}
+	eventWebhook.OrgID = ewh.OrgID
+
+	return ws.events.Update(ctx, eventWebhook)
+}
+
+func (ws *webhooksService) RemoveEventWebhooks(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		ewh, err := ws.events.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: ewh.OrgID, Subject: auth.OrgSub, Action: auth.Admin})
+	}
+
+	if _, err := ws.auth.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return ws.events.Remove(ctx, ids...)
+}
+
+func (ws *webhooksService) RemoveEventWebhooksByOrg(ctx context.Context, orgID string) error {
+	return ws.events.RemoveByOrgID(ctx, orgID)
+}
+
+func (ws *webhooksService) HandleEvent(ctx context.Context, event Event) error {
+	orgID, err := ws.eventOrg(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	ewhs, err := ws.events.RetrieveByEvent(ctx, orgID, event.Type)
+	if err != nil {
+		return err
+	}
+
+	if len(ewhs) == 0 {
+		return nil
+	}
+
+	payload := json.Payload{
+		"type":   event.Type,
+		"org_id": orgID,
+		"time":   event.Time,
+		"data":   event.Data,
+	}
+
+	// The event is sent to all the event webhooks, even if some of them fail.
+	var ferr error
+	for _, ewh := range ewhs {
+		msg := json.Message{
+			Payload:       payload,
+			ProfileConfig: json.Config{"webhook_id": ewh.ID},
+		}
+		wh := Webhook{
+			ID:      ewh.ID,
+			Url:     ewh.Url,
+			Headers: ewh.Headers,
+		}
+
+		if err := ws.forwardToOrg(ctx, msg, wh, orgID); err != nil {
+			ferr = err
+		}
+	}
+
+	return ferr
+}
+
+func (ws *webhooksService) retrieveEventWebhook(ctx context.Context, token, id, action string) (EventWebhook, error) {
+	ewh, err := ws.events.RetrieveByID(ctx, id)
+	if err != nil {
+		return EventWebhook{}, err
+	}
+
+	if _, err := ws.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: ewh.OrgID, Subject: auth.OrgSub, Action: action}); err != nil {
+		return EventWebhook{}, err
+	}
+
+	return ewh, nil
+}
+
+// eventOrg returns the ID of the org the event belongs to.
+func (ws *webhooksService) eventOrg(ctx context.Context, event Event) (string, error) {
+	if event.OrgID != "" {
+		return event.OrgID, nil
+	}
+
+	groupID := event.GroupID
+	if groupID == "" && event.ThingID != "" {
+		res, err := ws.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: event.ThingID})
+		if err != nil {
+			return "", err
+		}
+		groupID = res.GetValue()
+	}
+
+	if groupID == "" {
+		return "", errors.ErrMalformedEntity
+	}
+
+	return ws.groupOrg(ctx, groupID)
+}
+
+// alarmEvent returns the event of the alarm published by the alarm message.
+func alarmEvent(msg protomfx.Message) (Event, error) {
+	var data map[string]interface{}
+	if err := gojson.Unmarshal(msg.Payload, &data); err != nil {
+		return Event{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return Event{
+		Type:    AlarmEvent,
+		OrgID:   msg.OrgID,
+		ThingID: msg.Publisher,
+		Time:    time.Unix(0, msg.Created),
+		Data:    data,
+	}, nil
+}
+
+// ValidEventType reports whether the event webhooks can subscribe to the provided event type.
+func ValidEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
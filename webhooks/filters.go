@@ -0,0 +1,218 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+)
+
+const (
+	// AndOperator requires all the filter conditions to be met.
+	AndOperator = "AND"
+	// OrOperator requires any of the filter conditions to be met.
+	OrOperator = "OR"
+
+	EqualKey            = "eq"
+	NotEqualKey         = "ne"
+	LowerThanKey        = "lt"
+	LowerThanEqualKey   = "le"
+	GreaterThanKey      = "gt"
+	GreaterThanEqualKey = "ge"
+
+	// PayloadField prefixes the fields of the message payload. The nested
+	// payload fields are separated by dots, e.g. payload.sensor.temperature.
+	PayloadField   = "payload."
+	SubtopicField  = "subtopic"
+	PublisherField = "publisher"
+	ProtocolField  = "protocol"
+)
+
+// ErrInvalidFilter indicates the webhook filter which can't be evaluated.
+var ErrInvalidFilter = errors.New("invalid webhook filter")
+
+// Condition compares the message field with the value. The numeric values
+// are compared numerically, while the other values are compared as strings.
+// The booleans can only be compared for equality. The condition referring to
+// the field the message doesn't have isn't met.
+type Condition struct {
+	Field      string      `json:"field"`
+	Comparator string      `json:"comparator"`
+	Value      interface{} `json:"value"`
+}
+
+// Filter selects the messages forwarded to the webhook. The conditions are
+// combined using the operator, which is AND if omitted. The empty filter
+// selects all the messages.
+type Filter struct {
+	Operator   string      `json:"operator,omitempty"`
+	Conditions []Condition `json:"conditions"`
+}
+
+// Empty reports whether the filter has no conditions.
+func (f Filter) Empty() bool {
+	return len(f.Conditions) == 0
+}
+
+// Validate returns ErrInvalidFilter if the filter has the unknown operator,
+// or any condition has the unknown field or comparator, or the value which
+// can't be compared using the comparator.
+func (f Filter) Validate() error {
+	switch f.Operator {
+	case "", AndOperator, OrOperator:
+	default:
+		return ErrInvalidFilter
+	}
+
+	for _, c := range f.Conditions {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Match reports whether the message is selected by the filter.
+func (f Filter) Match(msg json.Message) bool {
+	if f.Empty() {
+		return true
+	}
+
+	for _, c := range f.Conditions {
+		met := c.match(msg)
+		if f.Operator == OrOperator && met {
+			return true
+		}
+		if f.Operator != OrOperator && !met {
+			return false
+		}
+	}
+
+	return f.Operator != OrOperator
+}
+
+func (c Condition) validate() error {
+	switch {
+	case c.Field == SubtopicField, c.Field == PublisherField, c.Field == ProtocolField:
+	case strings.HasPrefix(c.Field, PayloadField) && len(c.Field) > len(PayloadField):
+	default:
+		return ErrInvalidFilter
+	}
+
+	switch c.Value.(type) {
+	case float64, string:
+	case bool:
+		if c.Comparator != EqualKey && c.Comparator != NotEqualKey {
+			return ErrInvalidFilter
+		}
+	default:
+		return ErrInvalidFilter
+	}
+
+	switch c.Comparator {
+	case EqualKey, NotEqualKey, LowerThanKey, LowerThanEqualKey, GreaterThanKey, GreaterThanEqualKey:
+		return nil
+	default:
+		return ErrInvalidFilter
+	}
+}
+
+func (c Condition) match(msg json.Message) bool {
+	val, ok := fieldValue(msg, c.Field)
+	if !ok {
+		return false
+	}
+
+	switch want := c.Value.(type) {
+	case float64:
+		got, ok := val.(float64)
+		if !ok {
+			return false
+		}
+		return compare(c.Comparator, cmpFloat(got, want))
+	case string:
+		got, ok := val.(string)
+		if !ok {
+			return false
+		}
+		return compare(c.Comparator, strings.Compare(got, want))
+	case bool:
+		got, ok := val.(bool)
+		if !ok {
+			return false
+		}
+		return (c.Comparator == EqualKey) == (got == want)
+	default:
+		return false
+	}
+}
+
+// fieldValue returns the value of the message field. The numeric payload
+// values are returned as float64, as they are decoded from JSON.
+func fieldValue(msg json.Message, field string) (interface{}, bool) {
+	switch field {
+	case SubtopicField:
+		return msg.Subtopic, true
+	case PublisherField:
+		return msg.Publisher, true
+	case ProtocolField:
+		return msg.Protocol, true
+	}
+
+	var val interface{} = map[string]interface{}(msg.Payload)
+	for _, key := range strings.Split(strings.TrimPrefix(field, PayloadField), ".") {
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if val, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	default:
+		return val, true
+	}
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compare reports whether the comparison result satisfies the comparator.
+func compare(comparator string, res int) bool {
+	switch comparator {
+	case EqualKey:
+		return res == 0
+	case NotEqualKey:
+		return res != 0
+	case LowerThanKey:
+		return res < 0
+	case LowerThanEqualKey:
+		return res <= 0
+	case GreaterThanKey:
+		return res > 0
+	case GreaterThanEqualKey:
+		return res >= 0
+	default:
+		return false
+	}
+}
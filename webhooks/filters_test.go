@@ -0,0 +1,152 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		filter webhooks.Filter
+		err    error
+	}{
+		{
+			desc:   "validate empty filter",
+			filter: webhooks.Filter{},
+			err:    nil,
+		},
+		{
+			desc: "validate filter",
+			filter: webhooks.Filter{
+				Operator: webhooks.OrOperator,
+				Conditions: []webhooks.Condition{
+					{Field: "payload.temperature", Comparator: webhooks.GreaterThanKey, Value: 30.0},
+					{Field: webhooks.SubtopicField, Comparator: webhooks.EqualKey, Value: "alarms"},
+					{Field: "payload.door.open", Comparator: webhooks.EqualKey, Value: true},
+				},
+			},
+			err: nil,
+		},
+		{
+			desc:   "validate filter with unknown operator",
+			filter: webhooks.Filter{Operator: "XOR", Conditions: []webhooks.Condition{{Field: webhooks.SubtopicField, Comparator: webhooks.EqualKey, Value: "a"}}},
+			err:    webhooks.ErrInvalidFilter,
+		},
+		{
+			desc:   "validate filter with unknown field",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "name", Comparator: webhooks.EqualKey, Value: "a"}}},
+			err:    webhooks.ErrInvalidFilter,
+		},
+		{
+			desc:   "validate filter with empty payload field",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: webhooks.PayloadField, Comparator: webhooks.EqualKey, Value: "a"}}},
+			err:    webhooks.ErrInvalidFilter,
+		},
+		{
+			desc:   "validate filter with unknown comparator",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.v", Comparator: "like", Value: "a"}}},
+			err:    webhooks.ErrInvalidFilter,
+		},
+		{
+			desc:   "validate filter ordering booleans",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.v", Comparator: webhooks.LowerThanKey, Value: true}}},
+			err:    webhooks.ErrInvalidFilter,
+		},
+		{
+			desc:   "validate filter without value",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.v", Comparator: webhooks.EqualKey}}},
+			err:    webhooks.ErrInvalidFilter,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tc.filter.Validate()
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestFilterMatch(t *testing.T) {
+	msg := json.Message{
+		Subtopic:  "sensors",
+		Publisher: "thing",
+		Payload: json.Payload{
+			"temperature": 32.5,
+			"unit":        "C",
+			"door":        map[string]interface{}{"open": true},
+		},
+	}
+
+	hot := webhooks.Condition{Field: "payload.temperature", Comparator: webhooks.GreaterThanKey, Value: 30.0}
+	cold := webhooks.Condition{Field: "payload.temperature", Comparator: webhooks.LowerThanEqualKey, Value: 10.0}
+
+	cases := []struct {
+		desc   string
+		filter webhooks.Filter
+		match  bool
+	}{
+		{
+			desc:   "match empty filter",
+			filter: webhooks.Filter{},
+			match:  true,
+		},
+		{
+			desc:   "match numeric condition",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{hot}},
+			match:  true,
+		},
+		{
+			desc:   "match unmet numeric condition",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{cold}},
+			match:  false,
+		},
+		{
+			desc:   "match string condition",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.unit", Comparator: webhooks.EqualKey, Value: "C"}}},
+			match:  true,
+		},
+		{
+			desc:   "match nested boolean condition",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.door.open", Comparator: webhooks.NotEqualKey, Value: false}}},
+			match:  true,
+		},
+		{
+			desc:   "match metadata condition",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: webhooks.SubtopicField, Comparator: webhooks.EqualKey, Value: "alarms"}}},
+			match:  false,
+		},
+		{
+			desc:   "match condition on missing field",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.humidity", Comparator: webhooks.NotEqualKey, Value: 0.0}}},
+			match:  false,
+		},
+		{
+			desc:   "match condition of mismatched type",
+			filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.unit", Comparator: webhooks.EqualKey, Value: 1.0}}},
+			match:  false,
+		},
+		{
+			desc:   "match conditions combined with AND",
+			filter: webhooks.Filter{Operator: webhooks.AndOperator, Conditions: []webhooks.Condition{hot, cold}},
+			match:  false,
+		},
+		{
+			desc:   "match conditions combined with OR",
+			filter: webhooks.Filter{Operator: webhooks.OrOperator, Conditions: []webhooks.Condition{cold, hot}},
+			match:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		match := tc.filter.Match(msg)
+		assert.Equal(t, tc.match, match, fmt.Sprintf("%s: expected %t got %t\n", tc.desc, tc.match, match))
+	}
+}
@@ -1,37 +1,265 @@
 package webhooks
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
+	"github.com/MainfluxLabs/mainflux/logger"
 	clientshttp "github.com/MainfluxLabs/mainflux/pkg/clients/http"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	mfjson "github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 )
 
+// ErrPayloadTooLarge indicates that the message payload exceeds the webhook max payload size.
+var ErrPayloadTooLarge = errors.New("payload exceeds webhook max payload size")
+
 type Forwarder interface {
-	// Forward method is used to forward the received message to a certain url
+	// Forward method is used to forward the received message to a certain url.
+	// The message not selected by the webhook filter is dropped.
 	Forward(ctx context.Context, message mfjson.Message, wh Webhook) error
+
+	// Close sends the pending batches of the webhooks in batching mode.
+	Close() error
 }
 
 var _ Forwarder = (*forwarder)(nil)
 
-type forwarder struct{}
+type client struct {
+	cfg    clientshttp.ClientConfig
+	client *http.Client
+}
+
+// batch contains the payloads accumulated for the webhook in batching mode.
+type batch struct {
+	wh       Webhook
+	payloads [][]byte
+	size     uint
+	timer    *time.Timer
+}
+
+type forwarder struct {
+	mu      sync.Mutex
+	clients map[string]client
+	batches map[string]*batch
+	closed  bool
+	logger  logger.Logger
+}
 
-func NewForwarder() Forwarder {
-	return &forwarder{}
+func NewForwarder(logger logger.Logger) Forwarder {
+	return &forwarder{
+		clients: make(map[string]client),
+		batches: make(map[string]*batch),
+		logger:  logger,
+	}
 }
 
 func (fw *forwarder) Forward(_ context.Context, msg mfjson.Message, wh Webhook) error {
-	body, err := json.Marshal(msg.Payload)
+	if !wh.Filter.Match(msg) {
+		return nil
+	}
+
+	payload, err := json.Marshal(msg.Payload)
 	if err != nil {
 		return err
 	}
-	_, err = clientshttp.SendRequest(http.MethodPost, wh.Url, body, wh.Headers)
+
+	if !wh.Batching() {
+		if wh.MaxPayloadSize > 0 && uint(len(payload)) > wh.MaxPayloadSize {
+			return ErrPayloadTooLarge
+		}
+
+		// The pending batch of the webhook whose batching mode was
+		// disabled is sent first, so that the message order is kept.
+		if err := fw.flush(wh.ID); err != nil {
+			return err
+		}
+
+		return fw.send(wh, payload)
+	}
+
+	if wh.MaxPayloadSize > 0 && batchSize(payload) > wh.MaxPayloadSize {
+		return ErrPayloadTooLarge
+	}
+
+	return fw.add(wh, payload)
+}
+
+func (fw *forwarder) Close() error {
+	fw.mu.Lock()
+	fw.closed = true
+	batches := make([]*batch, 0, len(fw.batches))
+	for id, b := range fw.batches {
+		fw.detach(id, b)
+		batches = append(batches, b)
+	}
+	fw.mu.Unlock()
+
+	var res error
+	for _, b := range batches {
+		if err := fw.sendBatch(b); err != nil {
+			fw.logger.Error(fmt.Sprintf("Failed to flush batch of webhook %s: %s", b.wh.ID, err))
+			res = err
+		}
+	}
+
+	return res
+}
+
+// add appends the payload to the webhook batch. The batch is sent before the
+// payload is appended if the payload would exceed the max payload size, and
+// after the payload is appended if the batch size is reached.
+func (fw *forwarder) add(wh Webhook, payload []byte) error {
+	fw.mu.Lock()
+	if fw.closed {
+		fw.mu.Unlock()
+		return fw.send(wh, batchBody([][]byte{payload}))
+	}
+
+	var full []*batch
+	b, ok := fw.batches[wh.ID]
+	if ok && wh.MaxPayloadSize > 0 && b.size+uint(len(payload))+1 > wh.MaxPayloadSize {
+		fw.detach(wh.ID, b)
+		full = append(full, b)
+		ok = false
+	}
+
+	if !ok {
+		b = &batch{size: 2}
+		fw.batches[wh.ID] = b
+		if wh.BatchInterval > 0 {
+			id, nb := wh.ID, b
+			b.timer = time.AfterFunc(wh.BatchInterval, func() { fw.expire(id, nb) })
+		}
+	}
+
+	if len(b.payloads) > 0 {
+		b.size++
+	}
+	b.wh = wh
+	b.payloads = append(b.payloads, payload)
+	b.size += uint(len(payload))
+
+	if wh.BatchSize > 0 && uint(len(b.payloads)) >= wh.BatchSize {
+		fw.detach(wh.ID, b)
+		full = append(full, b)
+	}
+	fw.mu.Unlock()
+
+	for _, b := range full {
+		if err := fw.sendBatch(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expire sends the batch once the webhook batch interval has elapsed,
+// unless the batch has been sent in the meantime.
+func (fw *forwarder) expire(id string, b *batch) {
+	fw.mu.Lock()
+	if fw.batches[id] != b {
+		fw.mu.Unlock()
+		return
+	}
+	fw.detach(id, b)
+	fw.mu.Unlock()
+
+	if err := fw.sendBatch(b); err != nil {
+		fw.logger.Error(fmt.Sprintf("Failed to send batch of webhook %s: %s", id, err))
+	}
+}
+
+// flush sends the pending batch of the webhook, if any.
+func (fw *forwarder) flush(id string) error {
+	fw.mu.Lock()
+	b, ok := fw.batches[id]
+	if ok {
+		fw.detach(id, b)
+	}
+	fw.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return fw.sendBatch(b)
+}
+
+// detach removes the batch from the pending batches. It must be called with the lock held.
+func (fw *forwarder) detach(id string, b *batch) {
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+	delete(fw.batches, id)
+}
+
+func (fw *forwarder) sendBatch(b *batch) error {
+	return fw.send(b.wh, batchBody(b.payloads))
+}
+
+func (fw *forwarder) send(wh Webhook, body []byte) error {
+	cfg := clientConfig(wh)
+	if cfg.Empty() {
+		if _, err := clientshttp.SendRequest(http.MethodPost, wh.Url, body, wh.Headers); err != nil {
+			return errors.Wrap(clientshttp.ErrSendRequest, err)
+		}
+		return nil
+	}
+
+	c, err := fw.client(wh.ID, cfg)
 	if err != nil {
+		return err
+	}
+
+	if _, err := clientshttp.SendRequestWithClient(c, http.MethodPost, wh.Url, body, wh.Headers); err != nil {
 		return errors.Wrap(clientshttp.ErrSendRequest, err)
 	}
 
 	return nil
 }
+
+// client returns the HTTP client of the webhook, so that the connections
+// to the webhook URL are reused. The client is recreated when the webhook
+// TLS or proxy settings change.
+func (fw *forwarder) client(id string, cfg clientshttp.ClientConfig) (*http.Client, error) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+
+	if c, ok := fw.clients[id]; ok && c.cfg == cfg {
+		return c.client, nil
+	}
+
+	hc, err := clientshttp.NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	fw.clients[id] = client{cfg: cfg, client: hc}
+
+	return hc, nil
+}
+
+func clientConfig(wh Webhook) clientshttp.ClientConfig {
+	return clientshttp.ClientConfig{
+		ClientCert: wh.ClientCert,
+		ClientKey:  wh.ClientKey,
+		CACert:     wh.CACert,
+		ProxyURL:   wh.ProxyURL,
+	}
+}
+
+// batchBody returns the JSON array of the payloads.
+func batchBody(payloads [][]byte) []byte {
+	body := bytes.Join(payloads, []byte(","))
+	return append(append([]byte("["), body...), ']')
+}
+
+// batchSize returns the size of the JSON array containing only the payload.
+func batchSize(payload []byte) uint {
+	return uint(len(payload)) + 2
+}
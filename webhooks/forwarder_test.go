@@ -0,0 +1,153 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/stretchr/testify/assert"
+)
+
+type receiver struct {
+	mu     sync.Mutex
+	bodies []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	r.bodies = append(r.bodies, string(body))
+	r.mu.Unlock()
+}
+
+func (r *receiver) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.bodies...)
+}
+
+func TestForward(t *testing.T) {
+	msg := func(i int) json.Message {
+		return json.Message{Payload: map[string]interface{}{"v": i}}
+	}
+
+	cases := []struct {
+		desc   string
+		wh     webhooks.Webhook
+		msgs   int
+		bodies []string
+		closed []string
+		err    error
+	}{
+		{
+			desc:   "forward messages without batching",
+			wh:     webhooks.Webhook{ID: "1"},
+			msgs:   2,
+			bodies: []string{`{"v":0}`, `{"v":1}`},
+			closed: []string{`{"v":0}`, `{"v":1}`},
+			err:    nil,
+		},
+		{
+			desc:   "forward messages in batches of size",
+			wh:     webhooks.Webhook{ID: "2", BatchSize: 2},
+			msgs:   5,
+			bodies: []string{`[{"v":0},{"v":1}]`, `[{"v":2},{"v":3}]`},
+			closed: []string{`[{"v":0},{"v":1}]`, `[{"v":2},{"v":3}]`, `[{"v":4}]`},
+			err:    nil,
+		},
+		{
+			desc:   "forward messages in batches limited by max payload size",
+			wh:     webhooks.Webhook{ID: "3", BatchSize: 10, MaxPayloadSize: 17},
+			msgs:   3,
+			bodies: []string{`[{"v":0},{"v":1}]`},
+			closed: []string{`[{"v":0},{"v":1}]`, `[{"v":2}]`},
+			err:    nil,
+		},
+		{
+			desc:   "forward messages matching filter",
+			wh:     webhooks.Webhook{ID: "6", Filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.v", Comparator: webhooks.GreaterThanEqualKey, Value: 1.0}}}},
+			msgs:   3,
+			bodies: []string{`{"v":1}`, `{"v":2}`},
+			closed: []string{`{"v":1}`, `{"v":2}`},
+			err:    nil,
+		},
+		{
+			desc:   "forward batched messages matching filter",
+			wh:     webhooks.Webhook{ID: "7", BatchSize: 2, Filter: webhooks.Filter{Conditions: []webhooks.Condition{{Field: "payload.v", Comparator: webhooks.NotEqualKey, Value: 1.0}}}},
+			msgs:   3,
+			bodies: []string{`[{"v":0},{"v":2}]`},
+			closed: []string{`[{"v":0},{"v":2}]`},
+			err:    nil,
+		},
+		{
+			desc:   "forward message exceeding max payload size",
+			wh:     webhooks.Webhook{ID: "4", MaxPayloadSize: 5},
+			msgs:   1,
+			bodies: []string{},
+			closed: []string{},
+			err:    webhooks.ErrPayloadTooLarge,
+		},
+		{
+			desc:   "forward batched message exceeding max payload size",
+			wh:     webhooks.Webhook{ID: "5", BatchSize: 2, MaxPayloadSize: 8},
+			msgs:   1,
+			bodies: []string{},
+			closed: []string{},
+			err:    webhooks.ErrPayloadTooLarge,
+		},
+	}
+
+	for _, tc := range cases {
+		rcv := &receiver{}
+		ts := httptest.NewServer(rcv)
+		tc.wh.Url = ts.URL
+
+		fw := webhooks.NewForwarder(logger.NewMock())
+		var err error
+		for i := 0; i < tc.msgs && err == nil; i++ {
+			err = fw.Forward(context.Background(), msg(i), tc.wh)
+		}
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.bodies, rcv.received(), fmt.Sprintf("%s: unexpected requests before close\n", tc.desc))
+
+		err = fw.Close()
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error on close: %s\n", tc.desc, err))
+		assert.Equal(t, tc.closed, rcv.received(), fmt.Sprintf("%s: unexpected requests after close\n", tc.desc))
+
+		ts.Close()
+	}
+}
+
+func TestForwardBatchInterval(t *testing.T) {
+	rcv := &receiver{}
+	ts := httptest.NewServer(rcv)
+	defer ts.Close()
+
+	wh := webhooks.Webhook{ID: "1", Url: ts.URL, BatchInterval: 50 * time.Millisecond}
+	fw := webhooks.NewForwarder(logger.NewMock())
+
+	for i := 0; i < 2; i++ {
+		err := fw.Forward(context.Background(), json.Message{Payload: map[string]interface{}{"v": i}}, wh)
+		assert.Nil(t, err, fmt.Sprintf("unexpected error: %s\n", err))
+	}
+	assert.Empty(t, rcv.received(), "expected no requests before the batch interval elapses")
+
+	assert.Eventually(t, func() bool { return len(rcv.received()) == 1 }, time.Second, 10*time.Millisecond, "expected the batch to be sent after the batch interval")
+	assert.Equal(t, []string{`[{"v":0},{"v":1}]`}, rcv.received())
+
+	err := fw.Close()
+	assert.Nil(t, err, fmt.Sprintf("unexpected error on close: %s\n", err))
+	assert.Len(t, rcv.received(), 1, "expected no requests on close")
+}
@@ -0,0 +1,22 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+)
+
+// MessageRepository specifies the recent messages persistence API. The
+// recent messages of a webhook are the last messages it received, kept as
+// samples for developing and testing the webhook integrations.
+type MessageRepository interface {
+	// Save stores the message as the most recent message of the webhook.
+	Save(ctx context.Context, webhookID string, msg json.Message) error
+
+	// RetrieveByWebhook retrieves at most limit recent messages of the
+	// webhook, the most recent message first.
+	RetrieveByWebhook(ctx context.Context, webhookID string, limit uint64) ([]json.Message, error)
+}
@@ -0,0 +1,138 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+)
+
+var _ webhooks.EventWebhookRepository = (*eventWebhookRepositoryMock)(nil)
+
+type eventWebhookRepositoryMock struct {
+	mu            sync.Mutex
+	eventWebhooks map[string]webhooks.EventWebhook
+}
+
+func NewEventWebhookRepository() webhooks.EventWebhookRepository {
+	return &eventWebhookRepositoryMock{
+		eventWebhooks: make(map[string]webhooks.EventWebhook),
+	}
+}
+
+func (erm *eventWebhookRepositoryMock) Save(_ context.Context, ewhs ...webhooks.EventWebhook) ([]webhooks.EventWebhook, error) {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+
+	for _, ewh := range ewhs {
+		for _, e := range erm.eventWebhooks {
+			if e.OrgID == ewh.OrgID && e.Name == ewh.Name {
+				return []webhooks.EventWebhook{}, errors.ErrConflict
+			}
+		}
+
+		erm.eventWebhooks[ewh.ID] = ewh
+	}
+
+	return ewhs, nil
+}
+
+func (erm *eventWebhookRepositoryMock) RetrieveByOrgID(_ context.Context, orgID string, pm webhooks.PageMetadata) (webhooks.EventWebhooksPage, error) {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+	var items []webhooks.EventWebhook
+
+	first := uint64(pm.Offset) + 1
+	last := first + uint64(pm.Limit)
+
+	for _, ewh := range erm.eventWebhooks {
+		if ewh.OrgID == orgID {
+			id := uuid.ParseID(ewh.ID)
+			if id >= first && id < last || pm.Limit == 0 {
+				items = append(items, ewh)
+			}
+		}
+	}
+
+	return webhooks.EventWebhooksPage{
+		EventWebhooks: items,
+		PageMetadata: webhooks.PageMetadata{
+			Total:  uint64(len(items)),
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (erm *eventWebhookRepositoryMock) RetrieveByEvent(_ context.Context, orgID, eventType string) ([]webhooks.EventWebhook, error) {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+
+	var items []webhooks.EventWebhook
+	for _, ewh := range erm.eventWebhooks {
+		if ewh.OrgID != orgID {
+			continue
+		}
+
+		for _, t := range ewh.EventTypes {
+			if t == eventType {
+				items = append(items, ewh)
+				break
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func (erm *eventWebhookRepositoryMock) RetrieveByID(_ context.Context, id string) (webhooks.EventWebhook, error) {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+
+	if ewh, ok := erm.eventWebhooks[id]; ok {
+		return ewh, nil
+	}
+
+	return webhooks.EventWebhook{}, errors.ErrNotFound
+}
+
+func (erm *eventWebhookRepositoryMock) Update(_ context.Context, ewh webhooks.EventWebhook) error {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+
+	if _, ok := erm.eventWebhooks[ewh.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	erm.eventWebhooks[ewh.ID] = ewh
+
+	return nil
+}
+
+func (erm *eventWebhookRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := erm.eventWebhooks[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(erm.eventWebhooks, id)
+	}
+
+	return nil
+}
+
+func (erm *eventWebhookRepositoryMock) RemoveByOrgID(_ context.Context, orgID string) error {
+	erm.mu.Lock()
+	defer erm.mu.Unlock()
+
+	for id, ewh := range erm.eventWebhooks {
+		if ewh.OrgID == orgID {
+			delete(erm.eventWebhooks, id)
+		}
+	}
+
+	return nil
+}
@@ -22,3 +22,7 @@ func (mf *forwarder) Forward(ctx context.Context, message json.Message, wh webho
 	}
 	return nil
 }
+
+func (mf *forwarder) Close() error {
+	return nil
+}
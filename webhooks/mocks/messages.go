@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+)
+
+var _ webhooks.MessageRepository = (*messageRepositoryMock)(nil)
+
+type messageRepositoryMock struct {
+	mu       sync.Mutex
+	size     int
+	messages map[string][]json.Message
+}
+
+// NewMessageRepository returns mock recent messages repository keeping
+// the last size messages of each webhook.
+func NewMessageRepository(size int) webhooks.MessageRepository {
+	return &messageRepositoryMock{
+		size:     size,
+		messages: make(map[string][]json.Message),
+	}
+}
+
+func (mrm *messageRepositoryMock) Save(_ context.Context, webhookID string, msg json.Message) error {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	msgs := append([]json.Message{msg}, mrm.messages[webhookID]...)
+	if len(msgs) > mrm.size {
+		msgs = msgs[:mrm.size]
+	}
+	mrm.messages[webhookID] = msgs
+
+	return nil
+}
+
+func (mrm *messageRepositoryMock) RetrieveByWebhook(_ context.Context, webhookID string, limit uint64) ([]json.Message, error) {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	msgs := mrm.messages[webhookID]
+	if uint64(len(msgs)) > limit {
+		msgs = msgs[:limit]
+	}
+
+	return append([]json.Message{}, msgs...), nil
+}
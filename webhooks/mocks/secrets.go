@@ -0,0 +1,117 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+)
+
+var _ webhooks.SecretRepository = (*secretRepositoryMock)(nil)
+
+type secretRepositoryMock struct {
+	mu      sync.Mutex
+	secrets map[string]webhooks.Secret
+}
+
+func NewSecretRepository() webhooks.SecretRepository {
+	return &secretRepositoryMock{
+		secrets: make(map[string]webhooks.Secret),
+	}
+}
+
+func (srm *secretRepositoryMock) Save(_ context.Context, ss ...webhooks.Secret) ([]webhooks.Secret, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for _, s := range ss {
+		for _, sc := range srm.secrets {
+			if sc.OrgID == s.OrgID && sc.Name == s.Name {
+				return []webhooks.Secret{}, errors.ErrConflict
+			}
+		}
+
+		srm.secrets[s.ID] = s
+	}
+
+	return ss, nil
+}
+
+func (srm *secretRepositoryMock) RetrieveByOrgID(_ context.Context, orgID string, pm webhooks.PageMetadata) (webhooks.SecretsPage, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+	var items []webhooks.Secret
+
+	first := uint64(pm.Offset) + 1
+	last := first + uint64(pm.Limit)
+
+	for _, s := range srm.secrets {
+		if s.OrgID == orgID {
+			id := uuid.ParseID(s.ID)
+			if id >= first && id < last || pm.Limit == 0 {
+				items = append(items, s)
+			}
+		}
+	}
+
+	return webhooks.SecretsPage{
+		Secrets: items,
+		PageMetadata: webhooks.PageMetadata{
+			Total:  uint64(len(items)),
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}, nil
+}
+
+func (srm *secretRepositoryMock) RetrieveByID(_ context.Context, id string) (webhooks.Secret, error) {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	if s, ok := srm.secrets[id]; ok {
+		return s, nil
+	}
+
+	return webhooks.Secret{}, errors.ErrNotFound
+}
+
+func (srm *secretRepositoryMock) Update(_ context.Context, s webhooks.Secret) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	if _, ok := srm.secrets[s.ID]; !ok {
+		return errors.ErrNotFound
+	}
+	srm.secrets[s.ID] = s
+
+	return nil
+}
+
+func (srm *secretRepositoryMock) Remove(_ context.Context, ids ...string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for _, id := range ids {
+		if _, ok := srm.secrets[id]; !ok {
+			return errors.ErrNotFound
+		}
+		delete(srm.secrets, id)
+	}
+
+	return nil
+}
+
+func (srm *secretRepositoryMock) RemoveByOrgID(_ context.Context, orgID string) error {
+	srm.mu.Lock()
+	defer srm.mu.Unlock()
+
+	for id, s := range srm.secrets {
+		if s.OrgID == orgID {
+			delete(srm.secrets, id)
+		}
+	}
+
+	return nil
+}
@@ -105,3 +105,16 @@ func (wrm *webhookRepositoryMock) Remove(_ context.Context, ids ...string) error
 
 	return nil
 }
+
+func (wrm *webhookRepositoryMock) RemoveByGroupID(_ context.Context, groupID string) error {
+	wrm.mu.Lock()
+	defer wrm.mu.Unlock()
+
+	for id, wh := range wrm.webhooks {
+		if wh.GroupID == groupID {
+			delete(wrm.webhooks, id)
+		}
+	}
+
+	return nil
+}
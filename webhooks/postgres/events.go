@@ -0,0 +1,299 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ webhooks.EventWebhookRepository = (*eventWebhookRepository)(nil)
+
+type eventWebhookRepository struct {
+	db Database
+}
+
+// NewEventWebhookRepository instantiates a PostgreSQL implementation of event webhook repository.
+func NewEventWebhookRepository(db Database) webhooks.EventWebhookRepository {
+	return &eventWebhookRepository{
+		db: db,
+	}
+}
+
+func (er eventWebhookRepository) Save(ctx context.Context, ewhs ...webhooks.EventWebhook) ([]webhooks.EventWebhook, error) {
+	tx, err := er.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO event_webhooks (id, org_id, name, url, headers, event_types)
+		VALUES (:id, :org_id, :name, :url, :headers, :event_types);`
+
+	for _, ewh := range ewhs {
+		dbewh, err := toDBEventWebhook(ewh)
+		if err != nil {
+			tx.Rollback()
+			return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbewh); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return ewhs, nil
+}
+
+func (er eventWebhookRepository) RetrieveByOrgID(ctx context.Context, orgID string, pm webhooks.PageMetadata) (webhooks.EventWebhooksPage, error) {
+	if _, err := uuid.FromString(orgID); err != nil {
+		return webhooks.EventWebhooksPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	oq := dbutil.GetOrderQuery(pm.Order)
+	dq := dbutil.GetDirQuery(pm.Dir)
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+
+	q := fmt.Sprintf(`SELECT id, org_id, name, url, headers, event_types FROM event_webhooks WHERE org_id = :org_id ORDER BY %s %s %s;`, oq, dq, olq)
+	qc := `SELECT COUNT(*) FROM event_webhooks WHERE org_id = $1;`
+
+	params := map[string]interface{}{
+		"org_id": orgID,
+		"limit":  pm.Limit,
+		"offset": pm.Offset,
+	}
+
+	items, err := er.retrieve(ctx, q, params)
+	if err != nil {
+		return webhooks.EventWebhooksPage{}, err
+	}
+
+	var total uint64
+	if err := er.db.GetContext(ctx, &total, qc, orgID); err != nil {
+		return webhooks.EventWebhooksPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	page := webhooks.EventWebhooksPage{
+		EventWebhooks: items,
+		PageMetadata: webhooks.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (er eventWebhookRepository) RetrieveByEvent(ctx context.Context, orgID, eventType string) ([]webhooks.EventWebhook, error) {
+	if _, err := uuid.FromString(orgID); err != nil {
+		return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	types, err := json.Marshal([]string{eventType})
+	if err != nil {
+		return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	q := `SELECT id, org_id, name, url, headers, event_types FROM event_webhooks
+		WHERE org_id = :org_id AND event_types @> :event_types;`
+
+	params := map[string]interface{}{
+		"org_id":      orgID,
+		"event_types": types,
+	}
+
+	return er.retrieve(ctx, q, params)
+}
+
+func (er eventWebhookRepository) RetrieveByID(ctx context.Context, id string) (webhooks.EventWebhook, error) {
+	q := `SELECT id, org_id, name, url, headers, event_types FROM event_webhooks WHERE id = $1;`
+
+	dbewh := dbEventWebhook{}
+	if err := er.db.QueryRowxContext(ctx, q, id).StructScan(&dbewh); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return webhooks.EventWebhook{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return webhooks.EventWebhook{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	ewh, err := toEventWebhook(dbewh)
+	if err != nil {
+		return webhooks.EventWebhook{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return ewh, nil
+}
+
+func (er eventWebhookRepository) Update(ctx context.Context, ewh webhooks.EventWebhook) error {
+	q := `UPDATE event_webhooks SET name = :name, url = :url, headers = :headers, event_types = :event_types WHERE id = :id;`
+
+	dbewh, err := toDBEventWebhook(ewh)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, err := er.db.NamedExecContext(ctx, q, dbewh)
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (er eventWebhookRepository) Remove(ctx context.Context, ids ...string) error {
+	for _, id := range ids {
+		dbewh := dbEventWebhook{ID: id}
+		q := `DELETE FROM event_webhooks WHERE id = :id;`
+
+		if _, err := er.db.NamedExecContext(ctx, q, dbewh); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (er eventWebhookRepository) RemoveByOrgID(ctx context.Context, orgID string) error {
+	dbewh := dbEventWebhook{OrgID: orgID}
+	q := `DELETE FROM event_webhooks WHERE org_id = :org_id;`
+
+	if _, err := er.db.NamedExecContext(ctx, q, dbewh); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (er eventWebhookRepository) retrieve(ctx context.Context, query string, params map[string]interface{}) ([]webhooks.EventWebhook, error) {
+	rows, err := er.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []webhooks.EventWebhook
+	for rows.Next() {
+		dbewh := dbEventWebhook{}
+		if err := rows.StructScan(&dbewh); err != nil {
+			return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		ewh, err := toEventWebhook(dbewh)
+		if err != nil {
+			return []webhooks.EventWebhook{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		items = append(items, ewh)
+	}
+
+	return items, nil
+}
+
+type dbEventWebhook struct {
+	ID         string `db:"id"`
+	OrgID      string `db:"org_id"`
+	Name       string `db:"name"`
+	Url        string `db:"url"`
+	Headers    []byte `db:"headers"`
+	EventTypes []byte `db:"event_types"`
+}
+
+func toDBEventWebhook(ewh webhooks.EventWebhook) (dbEventWebhook, error) {
+	headers := []byte("{}")
+	if len(ewh.Headers) > 0 {
+		b, err := json.Marshal(ewh.Headers)
+		if err != nil {
+			return dbEventWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		headers = b
+	}
+
+	types := []byte("[]")
+	if len(ewh.EventTypes) > 0 {
+		b, err := json.Marshal(ewh.EventTypes)
+		if err != nil {
+			return dbEventWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		types = b
+	}
+
+	return dbEventWebhook{
+		ID:         ewh.ID,
+		OrgID:      ewh.OrgID,
+		Name:       ewh.Name,
+		Url:        ewh.Url,
+		Headers:    headers,
+		EventTypes: types,
+	}, nil
+}
+
+func toEventWebhook(dbewh dbEventWebhook) (webhooks.EventWebhook, error) {
+	var headers map[string]string
+	if err := json.Unmarshal(dbewh.Headers, &headers); err != nil {
+		return webhooks.EventWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	var types []string
+	if err := json.Unmarshal(dbewh.EventTypes, &types); err != nil {
+		return webhooks.EventWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return webhooks.EventWebhook{
+		ID:         dbewh.ID,
+		OrgID:      dbewh.OrgID,
+		Name:       dbewh.Name,
+		Url:        dbewh.Url,
+		Headers:    headers,
+		EventTypes: types,
+	}, nil
+}
@@ -0,0 +1,325 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/MainfluxLabs/mainflux/webhooks/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	eventWebhookName = "event-webhook"
+	eventWebhookURL  = "https://test.webhook.com"
+	invalidID        = "invalid"
+)
+
+func newEventWebhook(t *testing.T, orgID, name string, types ...string) webhooks.EventWebhook {
+	return webhooks.EventWebhook{
+		ID:         generateUUID(t),
+		OrgID:      orgID,
+		Name:       name,
+		Url:        eventWebhookURL,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		EventTypes: types,
+	}
+}
+
+func saveEventWebhook(t *testing.T, repo webhooks.EventWebhookRepository, ewh webhooks.EventWebhook) webhooks.EventWebhook {
+	_, err := repo.Save(context.Background(), ewh)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return ewh
+}
+
+func TestSaveEventWebhooks(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	orgID := generateUUID(t)
+
+	cases := []struct {
+		desc     string
+		webhooks []webhooks.EventWebhook
+		err      error
+	}{
+		{
+			desc: "save event webhooks",
+			webhooks: []webhooks.EventWebhook{
+				newEventWebhook(t, orgID, eventWebhookName, webhooks.ThingCreateEvent),
+				newEventWebhook(t, orgID, "other", webhooks.EventTypes...),
+			},
+			err: nil,
+		},
+		{
+			desc:     "save event webhook with existing name",
+			webhooks: []webhooks.EventWebhook{newEventWebhook(t, orgID, eventWebhookName, webhooks.AlarmEvent)},
+			err:      errors.ErrConflict,
+		},
+		{
+			desc:     "save event webhook with existing name of other org",
+			webhooks: []webhooks.EventWebhook{newEventWebhook(t, generateUUID(t), eventWebhookName, webhooks.AlarmEvent)},
+			err:      nil,
+		},
+		{
+			desc:     "save event webhook with invalid org id",
+			webhooks: []webhooks.EventWebhook{newEventWebhook(t, invalidID, eventWebhookName, webhooks.AlarmEvent)},
+			err:      errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.webhooks...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveEventWebhookByID(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	ewh := saveEventWebhook(t, repo, newEventWebhook(t, generateUUID(t), eventWebhookName, webhooks.ThingCreateEvent))
+
+	cases := []struct {
+		desc    string
+		id      string
+		webhook webhooks.EventWebhook
+		err     error
+	}{
+		{
+			desc:    "retrieve existing event webhook",
+			id:      ewh.ID,
+			webhook: ewh,
+			err:     nil,
+		},
+		{
+			desc:    "retrieve non-existing event webhook",
+			id:      generateUUID(t),
+			webhook: webhooks.EventWebhook{},
+			err:     errors.ErrNotFound,
+		},
+		{
+			desc:    "retrieve event webhook with invalid id",
+			id:      invalidID,
+			webhook: webhooks.EventWebhook{},
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.webhook, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.webhook, res))
+	}
+}
+
+func TestRetrieveEventWebhooksByOrgID(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	orgID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		saveEventWebhook(t, repo, newEventWebhook(t, orgID, fmt.Sprintf("%s-%d", eventWebhookName, i), webhooks.ThingCreateEvent))
+	}
+
+	cases := []struct {
+		desc  string
+		orgID string
+		pm    webhooks.PageMetadata
+		size  uint64
+		total uint64
+		err   error
+	}{
+		{
+			desc:  "retrieve all event webhooks of the org",
+			orgID: orgID,
+			pm:    webhooks.PageMetadata{Offset: 0, Limit: n},
+			size:  n,
+			total: n,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve subset of event webhooks of the org",
+			orgID: orgID,
+			pm:    webhooks.PageMetadata{Offset: 1, Limit: 2},
+			size:  2,
+			total: n,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve event webhooks of the org without event webhooks",
+			orgID: generateUUID(t),
+			pm:    webhooks.PageMetadata{Offset: 0, Limit: n},
+			size:  0,
+			total: 0,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve event webhooks with invalid org id",
+			orgID: invalidID,
+			pm:    webhooks.PageMetadata{Offset: 0, Limit: n},
+			size:  0,
+			total: 0,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByOrgID(context.Background(), tc.orgID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.EventWebhooks)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.EventWebhooks)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestRetrieveEventWebhooksByEvent(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	orgID := generateUUID(t)
+
+	thingWebhook := saveEventWebhook(t, repo, newEventWebhook(t, orgID, eventWebhookName, webhooks.ThingCreateEvent))
+	allWebhook := saveEventWebhook(t, repo, newEventWebhook(t, orgID, "all", webhooks.EventTypes...))
+	saveEventWebhook(t, repo, newEventWebhook(t, generateUUID(t), eventWebhookName, webhooks.ThingCreateEvent))
+
+	cases := []struct {
+		desc      string
+		orgID     string
+		eventType string
+		ids       []string
+		err       error
+	}{
+		{
+			desc:      "retrieve event webhooks subscribed to the event",
+			orgID:     orgID,
+			eventType: webhooks.ThingCreateEvent,
+			ids:       []string{thingWebhook.ID, allWebhook.ID},
+			err:       nil,
+		},
+		{
+			desc:      "retrieve event webhooks subscribed to other event",
+			orgID:     orgID,
+			eventType: webhooks.AlarmEvent,
+			ids:       []string{allWebhook.ID},
+			err:       nil,
+		},
+		{
+			desc:      "retrieve event webhooks subscribed to unknown event",
+			orgID:     orgID,
+			eventType: "unknown",
+			ids:       []string{},
+			err:       nil,
+		},
+		{
+			desc:      "retrieve event webhooks with invalid org id",
+			orgID:     invalidID,
+			eventType: webhooks.ThingCreateEvent,
+			ids:       []string{},
+			err:       errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		ewhs, err := repo.RetrieveByEvent(context.Background(), tc.orgID, tc.eventType)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		ids := []string{}
+		for _, ewh := range ewhs {
+			ids = append(ids, ewh.ID)
+		}
+		assert.ElementsMatch(t, tc.ids, ids, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.ids, ids))
+	}
+}
+
+func TestUpdateEventWebhook(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	orgID := generateUUID(t)
+
+	ewh := saveEventWebhook(t, repo, newEventWebhook(t, orgID, eventWebhookName, webhooks.ThingCreateEvent))
+	other := saveEventWebhook(t, repo, newEventWebhook(t, orgID, "other", webhooks.ThingCreateEvent))
+
+	updated := ewh
+	updated.Url = "https://updated.webhook.com"
+	updated.Headers = map[string]string{"Authorization": "token"}
+	updated.EventTypes = []string{webhooks.CertIssueEvent, webhooks.AlarmEvent}
+
+	conflicting := ewh
+	conflicting.Name = other.Name
+
+	cases := []struct {
+		desc    string
+		webhook webhooks.EventWebhook
+		err     error
+	}{
+		{
+			desc:    "update existing event webhook",
+			webhook: updated,
+			err:     nil,
+		},
+		{
+			desc:    "update event webhook with existing name",
+			webhook: conflicting,
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "update non-existing event webhook",
+			webhook: newEventWebhook(t, orgID, "missing", webhooks.ThingCreateEvent),
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.webhook)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), ewh.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, updated, res, fmt.Sprintf("expected %v got %v\n", updated, res))
+}
+
+func TestRemoveEventWebhooks(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	ewh := saveEventWebhook(t, repo, newEventWebhook(t, generateUUID(t), eventWebhookName, webhooks.ThingCreateEvent))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "remove existing event webhook",
+			id:   ewh.ID,
+			err:  nil,
+		},
+		{
+			desc: "remove removed event webhook",
+			id:   ewh.ID,
+			err:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		_, err = repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, errors.ErrNotFound, err))
+	}
+}
+
+func TestRemoveEventWebhooksByOrgID(t *testing.T) {
+	repo := postgres.NewEventWebhookRepository(postgres.NewDatabase(db))
+	orgID := generateUUID(t)
+
+	for i := 0; i < 2; i++ {
+		saveEventWebhook(t, repo, newEventWebhook(t, orgID, fmt.Sprintf("%s-%d", eventWebhookName, i), webhooks.ThingCreateEvent))
+	}
+
+	err := repo.RemoveByOrgID(context.Background(), orgID)
+	assert.Nil(t, err, fmt.Sprintf("remove event webhooks by org: expected nil got %s\n", err))
+
+	page, err := repo.RetrieveByOrgID(context.Background(), orgID, webhooks.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("expected total 0 got %d\n", page.Total))
+}
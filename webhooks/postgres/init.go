@@ -6,41 +6,52 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
 	"github.com/jmoiron/sqlx"
 
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/migrations"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
 // Config defines the options that are used when connecting to a PostgreSQL instance
 type Config struct {
-	Host        string
-	Port        string
-	User        string
-	Pass        string
-	Name        string
-	SSLMode     string
-	SSLCert     string
-	SSLKey      string
-	SSLRootCert string
+	Host           string
+	Port           string
+	User           string
+	Pass           string
+	Name           string
+	SSLMode        string
+	SSLCert        string
+	SSLKey         string
+	SSLRootCert    string
+	SkipMigrations bool
 }
 
-// Connect creates a connection to the PostgreSQL instance and applies any
-// unapplied database migrations. A non-nil error is returned to indicate
-// failure.
-func Connect(cfg Config) (*sqlx.DB, error) {
+// Open creates a connection to the PostgreSQL instance without applying
+// database migrations. A non-nil error is returned to indicate failure.
+func Open(cfg Config) (*sqlx.DB, error) {
 	url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
 
-	db, err := sqlx.Open("pgx", url)
+	return sqlx.Open("pgx", url)
+}
+
+// Connect creates a connection to the PostgreSQL instance and applies any
+// unapplied database migrations, or checks that there are none if the
+// migrations are skipped. A non-nil error is returned to indicate failure.
+func Connect(cfg Config) (*sqlx.DB, error) {
+	db, err := Open(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := migrateDB(db); err != nil {
+	if err := migrations.Start(db, Migrations(), !cfg.SkipMigrations); err != nil {
 		return nil, err
 	}
+
 	return db, nil
 }
 
-func migrateDB(db *sqlx.DB) error {
-	migrations := &migrate.MemoryMigrationSource{
+// Migrations returns the database migrations of the service.
+func Migrations() migrate.MigrationSource {
+	return &migrate.MemoryMigrationSource{
 		Migrations: []*migrate.Migration{
 			{
 				Id: "webhooks_1",
@@ -57,8 +68,84 @@ func migrateDB(db *sqlx.DB) error {
 				},
 				Down: []string{"DROP TABLE webhooks"},
 			},
+			{
+				Id: "webhooks_2",
+				Up: []string{
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS client_cert TEXT NOT NULL DEFAULT ''`,
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS client_key TEXT NOT NULL DEFAULT ''`,
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS ca_cert TEXT NOT NULL DEFAULT ''`,
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS proxy_url VARCHAR(254) NOT NULL DEFAULT ''`,
+				},
+				Down: []string{
+					`ALTER TABLE webhooks DROP COLUMN client_cert`,
+					`ALTER TABLE webhooks DROP COLUMN client_key`,
+					`ALTER TABLE webhooks DROP COLUMN ca_cert`,
+					`ALTER TABLE webhooks DROP COLUMN proxy_url`,
+				},
+			},
+			dbutil.SearchMigration("webhooks_3"),
+			{
+				Id: "webhooks_4",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS secrets (
+						id          UUID PRIMARY KEY,
+						org_id      UUID NOT NULL,
+						name        VARCHAR(254) NOT NULL,
+						value       BYTEA NOT NULL,
+						CONSTRAINT  unique_org_secret_name UNIQUE (org_id, name)
+					)`,
+				},
+				Down: []string{"DROP TABLE secrets"},
+			},
+			{
+				Id: "webhooks_5",
+				Up: []string{
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS batch_size INTEGER NOT NULL DEFAULT 0`,
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS batch_interval INTEGER NOT NULL DEFAULT 0`,
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS max_payload_size INTEGER NOT NULL DEFAULT 0`,
+				},
+				Down: []string{
+					`ALTER TABLE webhooks DROP COLUMN batch_size`,
+					`ALTER TABLE webhooks DROP COLUMN batch_interval`,
+					`ALTER TABLE webhooks DROP COLUMN max_payload_size`,
+				},
+			},
+			{
+				Id: "webhooks_6",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS processed_messages (
+						id           VARCHAR(64) PRIMARY KEY,
+						processed_at TIMESTAMPTZ NOT NULL
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_processed_messages_processed_at ON processed_messages (processed_at)`,
+				},
+				Down: []string{"DROP TABLE processed_messages"},
+			},
+			{
+				Id: "webhooks_7",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS event_webhooks (
+						id          UUID PRIMARY KEY,
+						org_id      UUID NOT NULL,
+						name        VARCHAR(254) NOT NULL,
+						url         VARCHAR(254) NOT NULL,
+						headers     JSONB,
+						event_types JSONB NOT NULL,
+						CONSTRAINT  unique_org_event_webhook_name UNIQUE (org_id, name)
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_event_webhooks_event_types ON event_webhooks USING GIN (event_types)`,
+				},
+				Down: []string{"DROP TABLE event_webhooks"},
+			},
+			{
+				Id: "webhooks_8",
+				Up: []string{
+					`ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS filter JSONB NOT NULL DEFAULT '{}'`,
+				},
+				Down: []string{
+					`ALTER TABLE webhooks DROP COLUMN filter`,
+				},
+			},
 		},
 	}
-	_, err := migrate.Exec(db.DB, "postgres", migrations, migrate.Up)
-	return err
 }
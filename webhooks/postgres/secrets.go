@@ -0,0 +1,254 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
+	"github.com/MainfluxLabs/mainflux/pkg/encryption"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var _ webhooks.SecretRepository = (*secretRepository)(nil)
+
+type secretRepository struct {
+	db     Database
+	cipher encryption.Cipher
+}
+
+// NewSecretRepository instantiates a PostgreSQL implementation of secret
+// repository. The secret values are encrypted using AES-GCM with the
+// SHA-256 hash of the provided key.
+func NewSecretRepository(db Database, key string) (webhooks.SecretRepository, error) {
+	c, err := encryption.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretRepository{
+		db:     db,
+		cipher: c,
+	}, nil
+}
+
+func (sr secretRepository) Save(ctx context.Context, ss ...webhooks.Secret) ([]webhooks.Secret, error) {
+	tx, err := sr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return []webhooks.Secret{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	q := `INSERT INTO secrets (id, org_id, name, value) VALUES (:id, :org_id, :name, :value);`
+
+	for _, secret := range ss {
+		dbs, err := sr.toDBSecret(secret)
+		if err != nil {
+			tx.Rollback()
+			return []webhooks.Secret{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+
+		if _, err := tx.NamedExecContext(ctx, q, dbs); err != nil {
+			tx.Rollback()
+			pgErr, ok := err.(*pgconn.PgError)
+			if ok {
+				switch pgErr.Code {
+				case pgerrcode.InvalidTextRepresentation:
+					return []webhooks.Secret{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				case pgerrcode.UniqueViolation:
+					return []webhooks.Secret{}, errors.Wrap(errors.ErrConflict, err)
+				case pgerrcode.StringDataRightTruncationDataException:
+					return []webhooks.Secret{}, errors.Wrap(errors.ErrMalformedEntity, err)
+				}
+			}
+
+			return []webhooks.Secret{}, errors.Wrap(errors.ErrCreateEntity, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []webhooks.Secret{}, errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return ss, nil
+}
+
+func (sr secretRepository) RetrieveByOrgID(ctx context.Context, orgID string, pm webhooks.PageMetadata) (webhooks.SecretsPage, error) {
+	if _, err := uuid.FromString(orgID); err != nil {
+		return webhooks.SecretsPage{}, errors.Wrap(errors.ErrNotFound, err)
+	}
+
+	oq := dbutil.GetOrderQuery(pm.Order)
+	dq := dbutil.GetDirQuery(pm.Dir)
+	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
+
+	q := fmt.Sprintf(`SELECT id, org_id, name, value FROM secrets WHERE org_id = :org_id ORDER BY %s %s %s;`, oq, dq, olq)
+	qc := `SELECT COUNT(*) FROM secrets WHERE org_id = $1;`
+
+	params := map[string]interface{}{
+		"org_id": orgID,
+		"limit":  pm.Limit,
+		"offset": pm.Offset,
+	}
+
+	rows, err := sr.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return webhooks.SecretsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+	defer rows.Close()
+
+	var items []webhooks.Secret
+	for rows.Next() {
+		dbs := dbSecret{}
+		if err := rows.StructScan(&dbs); err != nil {
+			return webhooks.SecretsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		secret, err := sr.toSecret(dbs)
+		if err != nil {
+			return webhooks.SecretsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+
+		items = append(items, secret)
+	}
+
+	var total uint64
+	if err := sr.db.GetContext(ctx, &total, qc, orgID); err != nil {
+		return webhooks.SecretsPage{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	page := webhooks.SecretsPage{
+		Secrets: items,
+		PageMetadata: webhooks.PageMetadata{
+			Total:  total,
+			Offset: pm.Offset,
+			Limit:  pm.Limit,
+		},
+	}
+
+	return page, nil
+}
+
+func (sr secretRepository) RetrieveByID(ctx context.Context, id string) (webhooks.Secret, error) {
+	q := `SELECT id, org_id, name, value FROM secrets WHERE id = $1;`
+
+	dbs := dbSecret{}
+	if err := sr.db.QueryRowxContext(ctx, q, id).StructScan(&dbs); err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		//  If there is no result or ID is in an invalid format, return ErrNotFound.
+		if err == sql.ErrNoRows || ok && pgerrcode.InvalidTextRepresentation == pgErr.Code {
+			return webhooks.Secret{}, errors.Wrap(errors.ErrNotFound, err)
+		}
+		return webhooks.Secret{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	secret, err := sr.toSecret(dbs)
+	if err != nil {
+		return webhooks.Secret{}, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	return secret, nil
+}
+
+func (sr secretRepository) Update(ctx context.Context, s webhooks.Secret) error {
+	q := `UPDATE secrets SET name = :name, value = :value WHERE id = :id;`
+
+	dbs, err := sr.toDBSecret(s)
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	res, err := sr.db.NamedExecContext(ctx, q, dbs)
+	if err != nil {
+		pgErr, ok := err.(*pgconn.PgError)
+		if ok {
+			switch pgErr.Code {
+			case pgerrcode.InvalidTextRepresentation:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			case pgerrcode.UniqueViolation:
+				return errors.Wrap(errors.ErrConflict, err)
+			case pgerrcode.StringDataRightTruncationDataException:
+				return errors.Wrap(errors.ErrMalformedEntity, err)
+			}
+		}
+
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(errors.ErrUpdateEntity, err)
+	}
+
+	if cnt == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (sr secretRepository) Remove(ctx context.Context, ids ...string) error {
+	for _, id := range ids {
+		dbs := dbSecret{ID: id}
+		q := `DELETE FROM secrets WHERE id = :id;`
+
+		if _, err := sr.db.NamedExecContext(ctx, q, dbs); err != nil {
+			return errors.Wrap(errors.ErrRemoveEntity, err)
+		}
+	}
+
+	return nil
+}
+
+func (sr secretRepository) RemoveByOrgID(ctx context.Context, orgID string) error {
+	dbs := dbSecret{OrgID: orgID}
+	q := `DELETE FROM secrets WHERE org_id = :org_id;`
+
+	if _, err := sr.db.NamedExecContext(ctx, q, dbs); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+type dbSecret struct {
+	ID    string `db:"id"`
+	OrgID string `db:"org_id"`
+	Name  string `db:"name"`
+	Value []byte `db:"value"`
+}
+
+// toDBSecret encrypts the secret value, bound to the org of the secret.
+func (sr secretRepository) toDBSecret(s webhooks.Secret) (dbSecret, error) {
+	value, err := sr.cipher.Encrypt([]byte(s.Value), []byte(s.OrgID))
+	if err != nil {
+		return dbSecret{}, err
+	}
+
+	return dbSecret{
+		ID:    s.ID,
+		OrgID: s.OrgID,
+		Name:  s.Name,
+		Value: value,
+	}, nil
+}
+
+func (sr secretRepository) toSecret(dbs dbSecret) (webhooks.Secret, error) {
+	value, err := sr.cipher.Decrypt(dbs.Value, []byte(dbs.OrgID))
+	if err != nil {
+		return webhooks.Secret{}, err
+	}
+
+	return webhooks.Secret{
+		ID:    dbs.ID,
+		OrgID: dbs.OrgID,
+		Name:  dbs.Name,
+		Value: string(value),
+	}, nil
+}
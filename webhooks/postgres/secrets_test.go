@@ -0,0 +1,290 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/MainfluxLabs/mainflux/webhooks/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	encryptionKey = "encryption-key"
+	secretName    = "api_key"
+	secretValue   = "secret-value"
+)
+
+func newSecretRepository(t *testing.T) webhooks.SecretRepository {
+	repo, err := postgres.NewSecretRepository(postgres.NewDatabase(db), encryptionKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	return repo
+}
+
+func generateUUID(t *testing.T) string {
+	id, err := idProvider.ID()
+	require.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	return id
+}
+
+func TestSaveSecrets(t *testing.T) {
+	repo := newSecretRepository(t)
+	orgID := generateUUID(t)
+
+	secret := webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: secretName, Value: secretValue}
+
+	cases := []struct {
+		desc    string
+		secrets []webhooks.Secret
+		err     error
+	}{
+		{
+			desc:    "save secret",
+			secrets: []webhooks.Secret{secret},
+			err:     nil,
+		},
+		{
+			desc:    "save secret with existing name",
+			secrets: []webhooks.Secret{{ID: generateUUID(t), OrgID: orgID, Name: secretName, Value: secretValue}},
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "save secret with invalid org id",
+			secrets: []webhooks.Secret{{ID: generateUUID(t), OrgID: "invalid", Name: secretName, Value: secretValue}},
+			err:     errors.ErrMalformedEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Save(context.Background(), tc.secrets...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestSecretEncryption(t *testing.T) {
+	repo := newSecretRepository(t)
+	secret := webhooks.Secret{ID: generateUUID(t), OrgID: generateUUID(t), Name: secretName, Value: secretValue}
+
+	_, err := repo.Save(context.Background(), secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	var stored []byte
+	err = db.Get(&stored, `SELECT value FROM secrets WHERE id = $1;`, secret.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.NotContains(t, string(stored), secretValue, "expected secret value to be stored encrypted")
+
+	res, err := repo.RetrieveByID(context.Background(), secret.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, secret, res, fmt.Sprintf("expected %v got %v", secret, res))
+
+	// The secret value is bound to its org.
+	_, err = db.Exec(`UPDATE secrets SET org_id = $1 WHERE id = $2;`, generateUUID(t), secret.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = repo.RetrieveByID(context.Background(), secret.ID)
+	assert.True(t, errors.Contains(err, errors.ErrRetrieveEntity), fmt.Sprintf("expected %s got %s", errors.ErrRetrieveEntity, err))
+
+	// The secret value can't be decrypted using the other key.
+	other, err := postgres.NewSecretRepository(postgres.NewDatabase(db), "other-key")
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = other.RetrieveByID(context.Background(), secret.ID)
+	assert.True(t, errors.Contains(err, errors.ErrRetrieveEntity), fmt.Sprintf("expected %s got %s", errors.ErrRetrieveEntity, err))
+}
+
+func TestRetrieveSecretByID(t *testing.T) {
+	repo := newSecretRepository(t)
+	secret := webhooks.Secret{ID: generateUUID(t), OrgID: generateUUID(t), Name: secretName, Value: secretValue}
+
+	_, err := repo.Save(context.Background(), secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "retrieve existing secret",
+			id:   secret.ID,
+			err:  nil,
+		},
+		{
+			desc: "retrieve non-existing secret",
+			id:   generateUUID(t),
+			err:  errors.ErrNotFound,
+		},
+		{
+			desc: "retrieve secret with invalid id",
+			id:   "invalid",
+			err:  errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.RetrieveByID(context.Background(), tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRetrieveSecretsByOrgID(t *testing.T) {
+	repo := newSecretRepository(t)
+	orgID := generateUUID(t)
+
+	n := uint64(5)
+	for i := uint64(0); i < n; i++ {
+		s := webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: fmt.Sprintf("%s_%d", secretName, i), Value: secretValue}
+		_, err := repo.Save(context.Background(), s)
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc  string
+		orgID string
+		pm    webhooks.PageMetadata
+		size  uint64
+		total uint64
+		err   error
+	}{
+		{
+			desc:  "retrieve all secrets of org",
+			orgID: orgID,
+			pm:    webhooks.PageMetadata{},
+			size:  n,
+			total: n,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve page of secrets of org",
+			orgID: orgID,
+			pm:    webhooks.PageMetadata{Offset: 1, Limit: 2},
+			size:  2,
+			total: n,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve secrets of org without secrets",
+			orgID: generateUUID(t),
+			pm:    webhooks.PageMetadata{},
+			size:  0,
+			total: 0,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve secrets with invalid org id",
+			orgID: "invalid",
+			pm:    webhooks.PageMetadata{},
+			size:  0,
+			total: 0,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByOrgID(context.Background(), tc.orgID, tc.pm)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, uint64(len(page.Secrets)), fmt.Sprintf("%s: expected size %d got %d\n", tc.desc, tc.size, len(page.Secrets)))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
+
+func TestUpdateSecret(t *testing.T) {
+	repo := newSecretRepository(t)
+	orgID := generateUUID(t)
+	secret := webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: secretName, Value: secretValue}
+	other := webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: "other", Value: secretValue}
+
+	_, err := repo.Save(context.Background(), secret, other)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	updated := secret
+	updated.Value = "updated-value"
+
+	cases := []struct {
+		desc   string
+		secret webhooks.Secret
+		err    error
+	}{
+		{
+			desc:   "update secret",
+			secret: updated,
+			err:    nil,
+		},
+		{
+			desc:   "update secret with existing name",
+			secret: webhooks.Secret{ID: other.ID, OrgID: orgID, Name: secretName, Value: secretValue},
+			err:    errors.ErrConflict,
+		},
+		{
+			desc:   "update non-existing secret",
+			secret: webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: "missing", Value: secretValue},
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Update(context.Background(), tc.secret)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	res, err := repo.RetrieveByID(context.Background(), secret.ID)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, updated, res, fmt.Sprintf("expected %v got %v", updated, res))
+}
+
+func TestRemoveSecrets(t *testing.T) {
+	repo := newSecretRepository(t)
+	secret := webhooks.Secret{ID: generateUUID(t), OrgID: generateUUID(t), Name: secretName, Value: secretValue}
+
+	_, err := repo.Save(context.Background(), secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = repo.Remove(context.Background(), secret.ID)
+	assert.Nil(t, err, fmt.Sprintf("remove secret: unexpected error: %s", err))
+
+	_, err = repo.RetrieveByID(context.Background(), secret.ID)
+	assert.True(t, errors.Contains(err, errors.ErrNotFound), fmt.Sprintf("retrieve removed secret: expected %s got %s", errors.ErrNotFound, err))
+}
+
+func TestRemoveSecretsByOrgID(t *testing.T) {
+	repo := newSecretRepository(t)
+	orgID := generateUUID(t)
+	otherOrgID := generateUUID(t)
+
+	_, err := repo.Save(context.Background(),
+		webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: secretName, Value: secretValue},
+		webhooks.Secret{ID: generateUUID(t), OrgID: orgID, Name: "other", Value: secretValue},
+		webhooks.Secret{ID: generateUUID(t), OrgID: otherOrgID, Name: secretName, Value: secretValue},
+	)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = repo.RemoveByOrgID(context.Background(), orgID)
+	assert.Nil(t, err, fmt.Sprintf("remove secrets by org: unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		orgID string
+		total uint64
+	}{
+		{
+			desc:  "retrieve secrets of removed org",
+			orgID: orgID,
+			total: 0,
+		},
+		{
+			desc:  "retrieve secrets of other org",
+			orgID: otherOrgID,
+			total: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveByOrgID(context.Background(), tc.orgID, webhooks.PageMetadata{})
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s\n", tc.desc, err))
+		assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d\n", tc.desc, tc.total, page.Total))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/MainfluxLabs/mainflux/pkg/dbutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
@@ -34,7 +35,10 @@ func (wr webhookRepository) Save(ctx context.Context, whs ...webhooks.Webhook) (
 		return []webhooks.Webhook{}, errors.Wrap(errors.ErrCreateEntity, err)
 	}
 
-	q := `INSERT INTO webhooks (id, group_id, name, url, headers, metadata) VALUES (:id, :group_id, :name, :url, :headers, :metadata);`
+	q := `INSERT INTO webhooks (id, group_id, name, url, headers, metadata, client_cert, client_key, ca_cert, proxy_url,
+		batch_size, batch_interval, max_payload_size, filter)
+		VALUES (:id, :group_id, :name, :url, :headers, :metadata, :client_cert, :client_key, :ca_cert, :proxy_url,
+		:batch_size, :batch_interval, :max_payload_size, :filter);`
 
 	for _, webhook := range whs {
 		dbWh, err := toDBWebhook(webhook)
@@ -75,7 +79,8 @@ func (wr webhookRepository) RetrieveByGroupID(ctx context.Context, groupID strin
 	dq := dbutil.GetDirQuery(pm.Dir)
 	olq := dbutil.GetOffsetLimitQuery(pm.Limit)
 
-	q := fmt.Sprintf(`SELECT id, group_id, name, url, headers, metadata FROM webhooks WHERE group_id = :group_id ORDER BY %s %s %s;`, oq, dq, olq)
+	q := fmt.Sprintf(`SELECT id, group_id, name, url, headers, metadata, client_cert, client_key, ca_cert, proxy_url,
+		batch_size, batch_interval, max_payload_size, filter FROM webhooks WHERE group_id = :group_id ORDER BY %s %s %s;`, oq, dq, olq)
 	qc := `SELECT COUNT(*) FROM webhooks WHERE group_id = $1;`
 
 	params := map[string]interface{}{
@@ -122,7 +127,8 @@ func (wr webhookRepository) RetrieveByGroupID(ctx context.Context, groupID strin
 }
 
 func (wr webhookRepository) RetrieveByID(ctx context.Context, id string) (webhooks.Webhook, error) {
-	q := `SELECT group_id, name, url, headers, metadata FROM webhooks WHERE id = $1;`
+	q := `SELECT group_id, name, url, headers, metadata, client_cert, client_key, ca_cert, proxy_url,
+		batch_size, batch_interval, max_payload_size, filter FROM webhooks WHERE id = $1;`
 
 	dbwh := dbWebhook{ID: id}
 	if err := wr.db.QueryRowxContext(ctx, q, id).StructScan(&dbwh); err != nil {
@@ -138,7 +144,10 @@ func (wr webhookRepository) RetrieveByID(ctx context.Context, id string) (webhoo
 }
 
 func (wr webhookRepository) Update(ctx context.Context, w webhooks.Webhook) error {
-	q := `UPDATE webhooks SET name = :name, url = :url, headers = :headers, metadata = :metadata WHERE id = :id;`
+	q := `UPDATE webhooks SET name = :name, url = :url, headers = :headers, metadata = :metadata,
+		client_cert = :client_cert, client_key = :client_key, ca_cert = :ca_cert, proxy_url = :proxy_url,
+		batch_size = :batch_size, batch_interval = :batch_interval, max_payload_size = :max_payload_size,
+		filter = :filter WHERE id = :id;`
 
 	dbwh, err := toDBWebhook(w)
 	if err != nil {
@@ -186,13 +195,32 @@ func (wr webhookRepository) Remove(ctx context.Context, ids ...string) error {
 	return nil
 }
 
+func (wr webhookRepository) RemoveByGroupID(ctx context.Context, groupID string) error {
+	dbwh := dbWebhook{GroupID: groupID}
+	q := `DELETE FROM webhooks WHERE group_id = :group_id;`
+
+	if _, err := wr.db.NamedExecContext(ctx, q, dbwh); err != nil {
+		return errors.Wrap(errors.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
 type dbWebhook struct {
-	ID       string `db:"id"`
-	GroupID  string `db:"group_id"`
-	Name     string `db:"name"`
-	Url      string `db:"url"`
-	Headers  []byte `db:"headers"`
-	Metadata []byte `db:"metadata"`
+	ID             string `db:"id"`
+	GroupID        string `db:"group_id"`
+	Name           string `db:"name"`
+	Url            string `db:"url"`
+	Headers        []byte `db:"headers"`
+	Metadata       []byte `db:"metadata"`
+	ClientCert     string `db:"client_cert"`
+	ClientKey      string `db:"client_key"`
+	CACert         string `db:"ca_cert"`
+	ProxyURL       string `db:"proxy_url"`
+	BatchSize      uint   `db:"batch_size"`
+	BatchInterval  uint   `db:"batch_interval"`
+	MaxPayloadSize uint   `db:"max_payload_size"`
+	Filter         []byte `db:"filter"`
 }
 
 func toDBWebhook(wh webhooks.Webhook) (dbWebhook, error) {
@@ -214,13 +242,30 @@ func toDBWebhook(wh webhooks.Webhook) (dbWebhook, error) {
 		metadata = b
 	}
 
+	filter := []byte("{}")
+	if !wh.Filter.Empty() {
+		b, err := json.Marshal(wh.Filter)
+		if err != nil {
+			return dbWebhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		filter = b
+	}
+
 	return dbWebhook{
-		ID:       wh.ID,
-		GroupID:  wh.GroupID,
-		Name:     wh.Name,
-		Url:      wh.Url,
-		Headers:  headers,
-		Metadata: metadata,
+		ID:             wh.ID,
+		GroupID:        wh.GroupID,
+		Name:           wh.Name,
+		Url:            wh.Url,
+		Headers:        headers,
+		Metadata:       metadata,
+		ClientCert:     wh.ClientCert,
+		ClientKey:      wh.ClientKey,
+		CACert:         wh.CACert,
+		ProxyURL:       wh.ProxyURL,
+		BatchSize:      wh.BatchSize,
+		BatchInterval:  uint(wh.BatchInterval / time.Second),
+		MaxPayloadSize: wh.MaxPayloadSize,
+		Filter:         filter,
 	}, nil
 }
 
@@ -235,12 +280,25 @@ func toWebhook(dbW dbWebhook) (webhooks.Webhook, error) {
 		return webhooks.Webhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
 	}
 
+	var filter webhooks.Filter
+	if err := json.Unmarshal(dbW.Filter, &filter); err != nil {
+		return webhooks.Webhook{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
 	return webhooks.Webhook{
-		ID:       dbW.ID,
-		GroupID:  dbW.GroupID,
-		Name:     dbW.Name,
-		Url:      dbW.Url,
-		Headers:  headers,
-		Metadata: metadata,
+		ID:             dbW.ID,
+		GroupID:        dbW.GroupID,
+		Name:           dbW.Name,
+		Url:            dbW.Url,
+		Headers:        headers,
+		Metadata:       metadata,
+		ClientCert:     dbW.ClientCert,
+		ClientKey:      dbW.ClientKey,
+		CACert:         dbW.CACert,
+		ProxyURL:       dbW.ProxyURL,
+		BatchSize:      dbW.BatchSize,
+		BatchInterval:  time.Duration(dbW.BatchInterval) * time.Second,
+		MaxPayloadSize: dbW.MaxPayloadSize,
+		Filter:         filter,
 	}, nil
 }
@@ -0,0 +1,32 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+type removeGroupEvent struct {
+	id string
+}
+
+type createThingEvent struct {
+	id        string
+	groupID   string
+	profileID string
+	name      string
+}
+
+type removeOrgEvent struct {
+	id string
+}
+
+type assignMemberEvent struct {
+	orgID string
+	email string
+	role  string
+}
+
+type issueCertEvent struct {
+	serial  string
+	thingID string
+	orgID   string
+	expire  string
+}
@@ -0,0 +1,163 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	thingsStream = "mainflux.things"
+	authStream   = "mainflux.auth"
+	certsStream  = "mainflux.certs"
+	group        = "mainflux.webhooks"
+
+	thingPrefix = "thing."
+	thingCreate = thingPrefix + "create"
+
+	groupPrefix = "group."
+	groupRemove = groupPrefix + "remove"
+
+	orgPrefix       = "org."
+	orgRemove       = orgPrefix + "remove"
+	orgMemberAssign = orgPrefix + "member.assign"
+
+	certPrefix = "cert."
+	certIssue  = certPrefix + "issue"
+)
+
+var streams = []string{thingsStream, authStream, certsStream}
+
+// Subscriber represents event source for things, auth and certs events.
+type Subscriber interface {
+	// Subscribes to the things, auth and certs event streams until the
+	// context is canceled.
+	Subscribe(ctx context.Context) error
+}
+
+type eventStore struct {
+	svc      webhooks.Service
+	client   *redis.Client
+	consumer string
+	logger   logger.Logger
+}
+
+// NewEventStore returns new event store instance.
+func NewEventStore(svc webhooks.Service, client *redis.Client, consumer string, log logger.Logger) Subscriber {
+	return eventStore{
+		svc:      svc,
+		client:   client,
+		consumer: consumer,
+		logger:   log,
+	}
+}
+
+func (es eventStore) Subscribe(ctx context.Context) error {
+	return events.NewSubscriber(es.client, group, es.consumer, streams, es.logger).Subscribe(ctx, es.handle)
+}
+
+func (es eventStore) handle(ctx context.Context, event events.Event) error {
+	switch event.Operation {
+	case groupRemove:
+		rge := decodeRemoveGroup(event.Values)
+		return es.svc.RemoveWebhooksByGroup(ctx, rge.id)
+	case thingCreate:
+		cte := decodeCreateThing(event.Values)
+		return es.svc.HandleEvent(ctx, webhooks.Event{
+			Type:    webhooks.ThingCreateEvent,
+			GroupID: cte.groupID,
+			Time:    event.OccurredAt,
+			Data: map[string]interface{}{
+				"id":         cte.id,
+				"group_id":   cte.groupID,
+				"profile_id": cte.profileID,
+				"name":       cte.name,
+			},
+		})
+	case orgRemove:
+		roe := decodeRemoveOrg(event.Values)
+		if err := es.svc.RemoveEventWebhooksByOrg(ctx, roe.id); err != nil {
+			return err
+		}
+		return es.svc.RemoveSecretsByOrg(ctx, roe.id)
+	case orgMemberAssign:
+		ame := decodeAssignMember(event.Values)
+		return es.svc.HandleEvent(ctx, webhooks.Event{
+			Type:  webhooks.MemberAssignEvent,
+			OrgID: ame.orgID,
+			Time:  event.OccurredAt,
+			Data: map[string]interface{}{
+				"email": ame.email,
+				"role":  ame.role,
+			},
+		})
+	case certIssue:
+		ice := decodeIssueCert(event.Values)
+		return es.svc.HandleEvent(ctx, webhooks.Event{
+			Type:    webhooks.CertIssueEvent,
+			OrgID:   ice.orgID,
+			ThingID: ice.thingID,
+			Time:    event.OccurredAt,
+			Data: map[string]interface{}{
+				"serial":   ice.serial,
+				"thing_id": ice.thingID,
+				"expire":   ice.expire,
+			},
+		})
+	}
+
+	return nil
+}
+
+func decodeRemoveGroup(event map[string]interface{}) removeGroupEvent {
+	return removeGroupEvent{
+		id: read(event, "id", ""),
+	}
+}
+
+func decodeCreateThing(event map[string]interface{}) createThingEvent {
+	return createThingEvent{
+		id:        read(event, "id", ""),
+		groupID:   read(event, "group_id", ""),
+		profileID: read(event, "profile_id", ""),
+		name:      read(event, "name", ""),
+	}
+}
+
+func decodeRemoveOrg(event map[string]interface{}) removeOrgEvent {
+	return removeOrgEvent{
+		id: read(event, "id", ""),
+	}
+}
+
+func decodeAssignMember(event map[string]interface{}) assignMemberEvent {
+	return assignMemberEvent{
+		orgID: read(event, "org_id", ""),
+		email: read(event, "email", ""),
+		role:  read(event, "role", ""),
+	}
+}
+
+func decodeIssueCert(event map[string]interface{}) issueCertEvent {
+	return issueCertEvent{
+		serial:  read(event, "serial", ""),
+		thingID: read(event, "thing_id", ""),
+		orgID:   read(event, "org_id", ""),
+		expire:  read(event, "expire", ""),
+	}
+}
+
+func read(event map[string]interface{}, key, def string) string {
+	val, ok := event[key].(string)
+	if !ok {
+		return def
+	}
+
+	return val
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	mfjson "github.com/MainfluxLabs/mainflux/pkg/transformers/json"
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/go-redis/redis/v8"
+)
+
+const messagesPrefix = "webhook_messages"
+
+var _ webhooks.MessageRepository = (*messageRepository)(nil)
+
+type messageRepository struct {
+	client *redis.Client
+	size   int64
+	ttl    time.Duration
+}
+
+// NewMessageRepository returns redis recent messages repository, which keeps
+// the last size messages of each webhook, until no message is received for
+// the ttl. Messages are not stored if the size is zero.
+func NewMessageRepository(client *redis.Client, size uint64, ttl time.Duration) webhooks.MessageRepository {
+	return &messageRepository{
+		client: client,
+		size:   int64(size),
+		ttl:    ttl,
+	}
+}
+
+func (mr *messageRepository) Save(ctx context.Context, webhookID string, msg mfjson.Message) error {
+	if mr.size == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	key := messagesKey(webhookID)
+	pipe := mr.client.TxPipeline()
+	pipe.LPush(ctx, key, data)
+	pipe.LTrim(ctx, key, 0, mr.size-1)
+	pipe.Expire(ctx, key, mr.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(errors.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (mr *messageRepository) RetrieveByWebhook(ctx context.Context, webhookID string, limit uint64) ([]mfjson.Message, error) {
+	msgs := []mfjson.Message{}
+	if limit == 0 {
+		return msgs, nil
+	}
+
+	vals, err := mr.client.LRange(ctx, messagesKey(webhookID), 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+	}
+
+	for _, val := range vals {
+		var msg mfjson.Message
+		if err := json.Unmarshal([]byte(val), &msg); err != nil {
+			return nil, errors.Wrap(errors.ErrRetrieveEntity, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+func messagesKey(webhookID string) string {
+	return fmt.Sprintf("%s:%s", messagesPrefix, webhookID)
+}
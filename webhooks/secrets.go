@@ -0,0 +1,229 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/MainfluxLabs/mainflux/auth"
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+)
+
+// ErrUnknownSecret indicates that the webhook references the secret
+// which doesn't exist in the webhook group org.
+var ErrUnknownSecret = errors.New("webhook references unknown secret")
+
+// secretRef matches the {{secrets.<name>}} references in the webhook URLs and headers.
+var secretRef = regexp.MustCompile(`\{\{\s*secrets\.([A-Za-z0-9_-]+)\s*\}\}`)
+
+// Secret represents the org secret. The secrets are referenced in the webhook
+// URLs and header values as {{secrets.<name>}}, and the references are resolved
+// when the messages are forwarded, so the secret values are never stored in the
+// webhooks themselves.
+type Secret struct {
+	ID    string
+	OrgID string
+	Name  string
+	Value string
+}
+
+// SecretsPage contains page related metadata as well as a list of
+// secrets that belong to this page.
+type SecretsPage struct {
+	PageMetadata
+	Secrets []Secret
+}
+
+// SecretRepository specifies a secret persistence API. The secret values are
+// encrypted at rest.
+type SecretRepository interface {
+	// Save persists multiple secrets. Secrets are saved using a transaction.
+	// If one secret fails then none will be saved.
+	Save(ctx context.Context, ss ...Secret) ([]Secret, error)
+
+	// RetrieveByOrgID retrieves secrets related to a certain org identified
+	// by a given ID. All the secrets of the org are retrieved if the limit is 0.
+	RetrieveByOrgID(ctx context.Context, orgID string, pm PageMetadata) (SecretsPage, error)
+
+	// RetrieveByID retrieves the secret having the provided identifier.
+	RetrieveByID(ctx context.Context, id string) (Secret, error)
+
+	// Update performs an update to the existing secret. A non-nil error is
+	// returned to indicate operation failure.
+	Update(ctx context.Context, s Secret) error
+
+	// Remove removes the secrets having the provided identifiers.
+	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByOrgID removes the secrets related to a certain org
+	// identified by a given ID.
+	RemoveByOrgID(ctx context.Context, orgID string) error
+}
+
+func (ws *webhooksService) CreateSecrets(ctx context.Context, token, orgID string, secrets ...Secret) ([]Secret, error) {
+	if _, err := ws.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: orgID, Subject: auth.OrgSub, Action: auth.Admin}); err != nil {
+		return []Secret{}, err
+	}
+
+	ss := []Secret{}
+	for _, secret := range secrets {
+		id, err := ws.idProvider.ID()
+		if err != nil {
+			return []Secret{}, err
+		}
+
+		secret.ID = id
+		secret.OrgID = orgID
+		ss = append(ss, secret)
+	}
+
+	return ws.secrets.Save(ctx, ss...)
+}
+
+func (ws *webhooksService) ListSecretsByOrg(ctx context.Context, token, orgID string, pm PageMetadata) (SecretsPage, error) {
+	if _, err := ws.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: orgID, Subject: auth.OrgSub, Action: auth.Viewer}); err != nil {
+		return SecretsPage{}, err
+	}
+
+	return ws.secrets.RetrieveByOrgID(ctx, orgID, pm)
+}
+
+func (ws *webhooksService) ViewSecret(ctx context.Context, token, id string) (Secret, error) {
+	return ws.retrieveSecret(ctx, token, id, auth.Viewer)
+}
+
+func (ws *webhooksService) UpdateSecret(ctx context.Context, token string, secret Secret) error {
+	s, err := ws.retrieveSecret(ctx, token, secret.ID, auth.Admin)
+	if err != nil {
+		return err
+	}
+
+	// The secret values are never returned to the users, so the stored
+	// value is kept if only the name is updated.
+	if secret.Value == "" {
+		secret.Value = s.Value
+	}
+	secret.OrgID = s.OrgID
+
+	return ws.secrets.Update(ctx, secret)
+}
+
+func (ws *webhooksService) RemoveSecrets(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
+	for _, id := range ids {
+		secret, err := ws.secrets.RetrieveByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: secret.OrgID, Subject: auth.OrgSub, Action: auth.Admin})
+	}
+
+	if _, err := ws.auth.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
+	}
+
+	return ws.secrets.Remove(ctx, ids...)
+}
+
+func (ws *webhooksService) RemoveSecretsByOrg(ctx context.Context, orgID string) error {
+	return ws.secrets.RemoveByOrgID(ctx, orgID)
+}
+
+func (ws *webhooksService) retrieveSecret(ctx context.Context, token, id, action string) (Secret, error) {
+	secret, err := ws.secrets.RetrieveByID(ctx, id)
+	if err != nil {
+		return Secret{}, err
+	}
+
+	if _, err := ws.auth.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: secret.OrgID, Subject: auth.OrgSub, Action: action}); err != nil {
+		return Secret{}, err
+	}
+
+	return secret, nil
+}
+
+// groupOrg returns the ID of the org the group belongs to.
+func (ws *webhooksService) groupOrg(ctx context.Context, groupID string) (string, error) {
+	res, err := ws.things.GetGroupsByIDs(ctx, &protomfx.GroupsReq{Ids: []string{groupID}})
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.GetGroups()) == 0 {
+		return "", errors.ErrNotFound
+	}
+
+	return res.GetGroups()[0].GetOrgID(), nil
+}
+
+// orgSecrets returns the values of the secrets of the org, by their names.
+func (ws *webhooksService) orgSecrets(ctx context.Context, orgID string) (map[string]string, error) {
+	page, err := ws.secrets.RetrieveByOrgID(ctx, orgID, PageMetadata{})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string]string, len(page.Secrets))
+	for _, s := range page.Secrets {
+		secrets[s.Name] = s.Value
+	}
+
+	return secrets, nil
+}
+
+// referencesSecrets reports whether the webhook URL or headers reference any secret.
+func referencesSecrets(wh Webhook) bool {
+	if secretRef.MatchString(wh.Url) {
+		return true
+	}
+
+	for _, v := range wh.Headers {
+		if secretRef.MatchString(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveSecrets returns the webhook whose URL and headers have the secret
+// references replaced with the secret values.
+func resolveSecrets(wh Webhook, secrets map[string]string) (Webhook, error) {
+	url, err := resolve(wh.Url, secrets)
+	if err != nil {
+		return Webhook{}, err
+	}
+	wh.Url = url
+
+	headers := make(map[string]string, len(wh.Headers))
+	for k, v := range wh.Headers {
+		if headers[k], err = resolve(v, secrets); err != nil {
+			return Webhook{}, err
+		}
+	}
+	wh.Headers = headers
+
+	return wh, nil
+}
+
+func resolve(s string, secrets map[string]string) (string, error) {
+	var unknown []string
+	res := secretRef.ReplaceAllStringFunc(s, func(ref string) string {
+		name := secretRef.FindStringSubmatch(ref)[1]
+		v, ok := secrets[name]
+		if !ok {
+			unknown = append(unknown, name)
+		}
+		return v
+	})
+
+	if len(unknown) > 0 {
+		return "", errors.Wrap(ErrUnknownSecret, errors.New(strings.Join(unknown, ", ")))
+	}
+
+	return res, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/MainfluxLabs/mainflux/consumers"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	clientshttp "github.com/MainfluxLabs/mainflux/pkg/clients/http"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -16,7 +17,12 @@ import (
 	"github.com/MainfluxLabs/mainflux/things"
 )
 
-var ErrForward = errors.New("failed to forward message")
+var (
+	ErrForward = errors.New("failed to forward message")
+
+	// ErrNoRecentMessages indicates that the webhook has no recent message to be tested with.
+	ErrNoRecentMessages = errors.New("no recent messages of the webhook")
+)
 
 // Service specifies an API that must be fullfiled by the domain service
 // implementation, and all of its decorators (e.g. logging & metrics).
@@ -40,6 +46,64 @@ type Service interface {
 	// belongs to the user identified by the provided key.
 	RemoveWebhooks(ctx context.Context, token string, id ...string) error
 
+	// RemoveWebhooksByGroup removes the webhooks of the removed group
+	// identified by the provided ID.
+	RemoveWebhooksByGroup(ctx context.Context, groupID string) error
+
+	// ListRecentMessages retrieves at most limit recent messages forwarded
+	// to the webhook identified by the provided ID, the most recent first.
+	ListRecentMessages(ctx context.Context, token, id string, limit uint64) ([]json.Message, error)
+
+	// TestWebhook forwards the provided payload to the webhook identified by
+	// the provided ID. If the payload is empty, the payload of the most
+	// recent message forwarded to the webhook is sent.
+	TestWebhook(ctx context.Context, token, id string, payload json.Payload) error
+
+	// CreateSecrets creates secrets for certain org identified by the provided ID.
+	CreateSecrets(ctx context.Context, token, orgID string, secrets ...Secret) ([]Secret, error)
+
+	// ListSecretsByOrg retrieves data about a subset of secrets
+	// related to a certain org identified by the provided ID.
+	ListSecretsByOrg(ctx context.Context, token, orgID string, pm PageMetadata) (SecretsPage, error)
+
+	// ViewSecret retrieves data about the secret identified with the provided ID.
+	ViewSecret(ctx context.Context, token, id string) (Secret, error)
+
+	// UpdateSecret updates the secret identified by the provided ID. The stored
+	// value is kept if the value is empty.
+	UpdateSecret(ctx context.Context, token string, secret Secret) error
+
+	// RemoveSecrets removes the secrets identified with the provided IDs.
+	RemoveSecrets(ctx context.Context, token string, ids ...string) error
+
+	// RemoveSecretsByOrg removes the secrets of the removed org identified
+	// by the provided ID.
+	RemoveSecretsByOrg(ctx context.Context, orgID string) error
+
+	// CreateEventWebhooks creates event webhooks for certain org identified by the provided ID.
+	CreateEventWebhooks(ctx context.Context, token, orgID string, ewhs ...EventWebhook) ([]EventWebhook, error)
+
+	// ListEventWebhooksByOrg retrieves data about a subset of event webhooks
+	// related to a certain org identified by the provided ID.
+	ListEventWebhooksByOrg(ctx context.Context, token, orgID string, pm PageMetadata) (EventWebhooksPage, error)
+
+	// ViewEventWebhook retrieves data about the event webhook identified with the provided ID.
+	ViewEventWebhook(ctx context.Context, token, id string) (EventWebhook, error)
+
+	// UpdateEventWebhook updates the event webhook identified by the provided ID.
+	UpdateEventWebhook(ctx context.Context, token string, ewh EventWebhook) error
+
+	// RemoveEventWebhooks removes the event webhooks identified with the provided IDs.
+	RemoveEventWebhooks(ctx context.Context, token string, ids ...string) error
+
+	// RemoveEventWebhooksByOrg removes the event webhooks of the removed org
+	// identified by the provided ID.
+	RemoveEventWebhooksByOrg(ctx context.Context, orgID string) error
+
+	// HandleEvent sends the platform event to the event webhooks of the
+	// event org which are subscribed to the event type.
+	HandleEvent(ctx context.Context, event Event) error
+
 	consumers.Consumer
 }
 
@@ -55,7 +119,11 @@ type PageMetadata struct {
 
 type webhooksService struct {
 	things     protomfx.ThingsServiceClient
+	auth       protomfx.AuthServiceClient
 	webhooks   WebhookRepository
+	secrets    SecretRepository
+	events     EventWebhookRepository
+	messages   MessageRepository
 	subscriber messaging.Subscriber
 	forwarder  Forwarder
 	idProvider uuid.IDProvider
@@ -64,10 +132,15 @@ type webhooksService struct {
 var _ Service = (*webhooksService)(nil)
 
 // New instantiates the webhooks service implementation.
-func New(things protomfx.ThingsServiceClient, webhooks WebhookRepository, forwarder Forwarder, idp uuid.IDProvider) Service {
+func New(things protomfx.ThingsServiceClient, auth protomfx.AuthServiceClient, webhooks WebhookRepository, secrets SecretRepository,
+	events EventWebhookRepository, messages MessageRepository, forwarder Forwarder, idp uuid.IDProvider) Service {
 	return &webhooksService{
 		things:     things,
+		auth:       auth,
 		webhooks:   webhooks,
+		secrets:    secrets,
+		events:     events,
+		messages:   messages,
 		forwarder:  forwarder,
 		idProvider: idp,
 	}
@@ -92,6 +165,10 @@ func (ws *webhooksService) createWebhook(ctx context.Context, webhook *Webhook,
 		return Webhook{}, err
 	}
 
+	if err := validateClientConfig(*webhook); err != nil {
+		return Webhook{}, err
+	}
+
 	id, err := ws.idProvider.ID()
 	if err != nil {
 		return Webhook{}, err
@@ -147,18 +224,31 @@ func (ws *webhooksService) UpdateWebhook(ctx context.Context, token string, webh
 		return err
 	}
 
+	// The client key is never returned to the users, so the stored key
+	// is kept if only the client certificate is sent.
+	if webhook.ClientCert != "" && webhook.ClientKey == "" {
+		webhook.ClientKey = wh.ClientKey
+	}
+
+	if err := validateClientConfig(webhook); err != nil {
+		return err
+	}
+
 	return ws.webhooks.Update(ctx, webhook)
 }
 
 func (ws *webhooksService) RemoveWebhooks(ctx context.Context, token string, ids ...string) error {
+	ars := []*protomfx.AuthorizeReq{}
 	for _, id := range ids {
 		webhook, err := ws.webhooks.RetrieveByID(ctx, id)
 		if err != nil {
 			return err
 		}
-		if _, err := ws.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: webhook.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
-			return errors.Wrap(errors.ErrAuthorization, err)
-		}
+		ars = append(ars, &protomfx.AuthorizeReq{Token: token, Object: webhook.GroupID, Subject: things.GroupSub, Action: things.Editor})
+	}
+
+	if _, err := ws.things.AuthorizeBatch(ctx, &protomfx.AuthorizeBatchReq{Requests: ars}); err != nil {
+		return errors.Wrap(errors.ErrAuthorization, err)
 	}
 
 	if err := ws.webhooks.Remove(ctx, ids...); err != nil {
@@ -168,9 +258,65 @@ func (ws *webhooksService) RemoveWebhooks(ctx context.Context, token string, ids
 	return nil
 }
 
+func (ws *webhooksService) RemoveWebhooksByGroup(ctx context.Context, groupID string) error {
+	return ws.webhooks.RemoveByGroupID(ctx, groupID)
+}
+
+func (ws *webhooksService) ListRecentMessages(ctx context.Context, token, id string, limit uint64) ([]json.Message, error) {
+	if _, err := ws.ViewWebhook(ctx, token, id); err != nil {
+		return nil, err
+	}
+
+	return ws.messages.RetrieveByWebhook(ctx, id, limit)
+}
+
+func (ws *webhooksService) TestWebhook(ctx context.Context, token, id string, payload json.Payload) error {
+	wh, err := ws.webhooks.RetrieveByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ws.things.Authorize(ctx, &protomfx.AuthorizeReq{Token: token, Object: wh.GroupID, Subject: things.GroupSub, Action: things.Editor}); err != nil {
+		return err
+	}
+
+	msg := json.Message{
+		Payload:       payload,
+		ProfileConfig: json.Config{"webhook_id": wh.ID},
+	}
+
+	if len(payload) == 0 {
+		msgs, err := ws.messages.RetrieveByWebhook(ctx, wh.ID, 1)
+		if err != nil {
+			return err
+		}
+
+		if len(msgs) == 0 {
+			return errors.Wrap(errors.ErrNotFound, ErrNoRecentMessages)
+		}
+		msg = msgs[0]
+	}
+
+	// The test message is sent immediately, regardless of the batching mode
+	// and the filter.
+	wh.BatchSize, wh.BatchInterval = 0, 0
+	wh.Filter = Filter{}
+
+	return ws.forward(ctx, msg, wh)
+}
+
 func (ws *webhooksService) Consume(message interface{}) error {
 	ctx := context.Background()
 
+	if msg, ok := message.(protomfx.Message); ok && msg.Protocol == messaging.AlarmProtocol {
+		event, err := alarmEvent(msg)
+		if err != nil {
+			return err
+		}
+
+		return ws.HandleEvent(ctx, event)
+	}
+
 	if v, ok := message.(json.Messages); ok {
 		msgs := v.Data
 		for _, msg := range msgs {
@@ -183,10 +329,67 @@ func (ws *webhooksService) Consume(message interface{}) error {
 				return err
 			}
 
-			if err := ws.forwarder.Forward(ctx, msg, wh); err != nil {
-				return errors.Wrap(ErrForward, err)
+			// The message is kept before it is forwarded, so that the samples
+			// are available while the webhook URL is not reachable yet.
+			saveErr := ws.messages.Save(ctx, wh.ID, msg)
+
+			if err := ws.forward(ctx, msg, wh); err != nil {
+				return err
 			}
+
+			if saveErr != nil {
+				return saveErr
+			}
+		}
+	}
+
+	return nil
+}
+
+// forward forwards the message to the webhook, having the secret references
+// of the webhook resolved.
+func (ws *webhooksService) forward(ctx context.Context, msg json.Message, wh Webhook) error {
+	var orgID string
+	if referencesSecrets(wh) {
+		id, err := ws.groupOrg(ctx, wh.GroupID)
+		if err != nil {
+			return err
+		}
+		orgID = id
+	}
+
+	return ws.forwardToOrg(ctx, msg, wh, orgID)
+}
+
+// forwardToOrg forwards the message to the webhook, having the secret
+// references of the webhook resolved with the secrets of the provided org.
+func (ws *webhooksService) forwardToOrg(ctx context.Context, msg json.Message, wh Webhook, orgID string) error {
+	if referencesSecrets(wh) {
+		secrets, err := ws.orgSecrets(ctx, orgID)
+		if err != nil {
+			return err
 		}
+
+		if wh, err = resolveSecrets(wh, secrets); err != nil {
+			return err
+		}
+	}
+
+	if err := ws.forwarder.Forward(ctx, msg, wh); err != nil {
+		return errors.Wrap(ErrForward, err)
+	}
+
+	return nil
+}
+
+func validateClientConfig(wh Webhook) error {
+	cfg := clientConfig(wh)
+	if cfg.Empty() {
+		return nil
+	}
+
+	if _, err := clientshttp.NewClient(cfg); err != nil {
+		return errors.Wrap(errors.ErrMalformedEntity, err)
 	}
 
 	return nil
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/MainfluxLabs/mainflux/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/mocks"
 	"github.com/MainfluxLabs/mainflux/pkg/transformers/json"
 	"github.com/MainfluxLabs/mainflux/pkg/uuid"
 	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/MainfluxLabs/mainflux/users"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 	whMock "github.com/MainfluxLabs/mainflux/webhooks/mocks"
 	"github.com/stretchr/testify/assert"
@@ -21,28 +23,42 @@ const (
 	token       = "admin@example.com"
 	wrongValue  = "wrong-value"
 	emptyValue  = ""
+	viewerToken = "viewer@example.com"
 	groupID     = "574106f7-030e-4881-8ab0-151195c29f94"
+	orgID       = "1ad4b5a9-3f2e-4f0c-9a71-bd4e1a6a7c2e"
+	thingID     = "9d3b2f5e-1c4a-4e7b-8a6d-0f2e3c4b5a69"
 	prefixID    = "fe6b4e92-cc98-425e-b0aa-"
 	prefixName  = "test-webhook-"
 	webhookName = "test-webhook"
+	msgsSize    = 5
 	nameKey     = "name"
 	ascKey      = "asc"
 	descKey     = "desc"
 )
 
 var (
-	headers  = map[string]string{"Content-Type:": "application/json"}
-	metadata = map[string]interface{}{"test": "data"}
-	webhook  = webhooks.Webhook{GroupID: groupID, Name: webhookName, Url: "https://test.webhook.com", Headers: headers, Metadata: metadata}
+	headers   = map[string]string{"Content-Type:": "application/json"}
+	metadata  = map[string]interface{}{"test": "data"}
+	webhook   = webhooks.Webhook{GroupID: groupID, Name: webhookName, Url: "https://test.webhook.com", Headers: headers, Metadata: metadata}
+	secret    = webhooks.Secret{Name: "api_key", Value: "9f1c2e4a"}
+	eventWh   = webhooks.EventWebhook{Name: "event-webhook", Url: "https://test.webhook.com/events", Headers: headers, EventTypes: []string{webhooks.ThingCreateEvent, webhooks.AlarmEvent}}
+	usersList = []users.User{
+		{ID: "5aa2b5e7-5d3a-4a4b-9f2d-3c1a1b2e4f60", Email: token, Role: auth.Owner},
+		{ID: "7c3e1f2a-6b4d-4e8a-8f1c-2d3e4f5a6b70", Email: viewerToken, Role: auth.Viewer},
+	}
 )
 
 func newService() webhooks.Service {
-	ths := mocks.NewThingsServiceClient(nil, nil, map[string]things.Group{token: {ID: groupID}})
+	ths := mocks.NewThingsServiceClient(nil, map[string]string{thingID: groupID}, map[string]things.Group{token: {ID: groupID}, groupID: {ID: groupID, OrgID: orgID}})
+	ac := mocks.NewAuthService("", usersList)
 	webhookRepo := whMock.NewWebhookRepository()
+	secretRepo := whMock.NewSecretRepository()
+	eventRepo := whMock.NewEventWebhookRepository()
+	messageRepo := whMock.NewMessageRepository(msgsSize)
 	forwarder := whMock.NewForwarder()
 	idProvider := uuid.NewMock()
 
-	return webhooks.New(ths, webhookRepo, forwarder, idProvider)
+	return webhooks.New(ths, ac, webhookRepo, secretRepo, eventRepo, messageRepo, forwarder, idProvider)
 }
 
 func TestCreateWebhooks(t *testing.T) {
@@ -66,6 +82,23 @@ func TestCreateWebhooks(t *testing.T) {
 	invalidUrlWh := webhook
 	invalidUrlWh.Url = wrongValue
 
+	invalidCertWh := webhook
+	invalidCertWh.Name = "invalid-cert"
+	invalidCertWh.ClientCert = wrongValue
+	invalidCertWh.ClientKey = wrongValue
+
+	invalidCAWh := webhook
+	invalidCAWh.Name = "invalid-ca"
+	invalidCAWh.CACert = wrongValue
+
+	invalidProxyWh := webhook
+	invalidProxyWh.Name = "invalid-proxy"
+	invalidProxyWh.ProxyURL = wrongValue
+
+	proxyWh := webhook
+	proxyWh.Name = "proxy"
+	proxyWh.ProxyURL = "http://proxy.example.com:3128"
+
 	cases := []struct {
 		desc     string
 		webhooks []webhooks.Webhook
@@ -102,6 +135,30 @@ func TestCreateWebhooks(t *testing.T) {
 			token:    token,
 			err:      nil,
 		},
+		{
+			desc:     "create webhook with invalid client certificate",
+			webhooks: []webhooks.Webhook{invalidCertWh},
+			token:    token,
+			err:      errors.ErrMalformedEntity,
+		},
+		{
+			desc:     "create webhook with invalid ca certificate",
+			webhooks: []webhooks.Webhook{invalidCAWh},
+			token:    token,
+			err:      errors.ErrMalformedEntity,
+		},
+		{
+			desc:     "create webhook with invalid proxy url",
+			webhooks: []webhooks.Webhook{invalidProxyWh},
+			token:    token,
+			err:      errors.ErrMalformedEntity,
+		},
+		{
+			desc:     "create webhook with proxy url",
+			webhooks: []webhooks.Webhook{proxyWh},
+			token:    token,
+			err:      nil,
+		},
 	}
 
 	for desc, tc := range cases {
@@ -344,6 +401,40 @@ func TestRemoveWebhooks(t *testing.T) {
 	}
 }
 
+func TestRemoveWebhooksByGroup(t *testing.T) {
+	svc := newService()
+	otherWebhook := webhook
+	otherWebhook.Name = fmt.Sprintf("%s%012d", prefixName, 1)
+	_, err := svc.CreateWebhooks(context.Background(), token, webhook, otherWebhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc    string
+		groupID string
+		err     error
+	}{
+		{
+			desc:    "remove webhooks by group",
+			groupID: groupID,
+			err:     nil,
+		},
+		{
+			desc:    "remove webhooks by group without webhooks",
+			groupID: wrongValue,
+			err:     nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveWebhooksByGroup(context.Background(), tc.groupID)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+
+	whp, err := svc.ListWebhooksByGroup(context.Background(), token, groupID, webhooks.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 0, len(whp.Webhooks), fmt.Sprintf("expected no webhooks got %d\n", len(whp.Webhooks)))
+}
+
 func TestConsume(t *testing.T) {
 	svc := newService()
 	whs, err := svc.CreateWebhooks(context.Background(), token, webhook)
@@ -410,3 +501,523 @@ func TestConsume(t *testing.T) {
 		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
+
+func TestListRecentMessages(t *testing.T) {
+	svc := newService()
+	whs, err := svc.CreateWebhooks(context.Background(), token, webhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	wh := whs[0]
+
+	n := msgsSize + 2
+	for i := 0; i < n; i++ {
+		msg := json.Message{
+			ProfileConfig: json.Config{"webhook_id": wh.ID},
+			Payload:       json.Payload{"count": float64(i)},
+		}
+		err := svc.Consume(json.Messages{Data: []json.Message{msg}})
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc  string
+		token string
+		id    string
+		limit uint64
+		size  int
+		err   error
+	}{
+		{
+			desc:  "list recent messages",
+			token: token,
+			id:    wh.ID,
+			limit: 10,
+			size:  msgsSize,
+			err:   nil,
+		},
+		{
+			desc:  "list recent messages with limit",
+			token: token,
+			id:    wh.ID,
+			limit: 2,
+			size:  2,
+			err:   nil,
+		},
+		{
+			desc:  "list recent messages with wrong credentials",
+			token: wrongValue,
+			id:    wh.ID,
+			limit: 10,
+			size:  0,
+			err:   errors.ErrAuthentication,
+		},
+		{
+			desc:  "list recent messages of non-existing webhook",
+			token: token,
+			id:    wrongValue,
+			limit: 10,
+			size:  0,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		msgs, err := svc.ListRecentMessages(context.Background(), tc.token, tc.id, tc.limit)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		assert.Equal(t, tc.size, len(msgs), fmt.Sprintf("%s: expected %d messages got %d\n", tc.desc, tc.size, len(msgs)))
+		if len(msgs) > 0 {
+			assert.Equal(t, float64(n-1), msgs[0].Payload["count"], fmt.Sprintf("%s: expected the most recent message first\n", tc.desc))
+		}
+	}
+}
+
+func TestTestWebhook(t *testing.T) {
+	svc := newService()
+	whs, err := svc.CreateWebhooks(context.Background(), token, webhook)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	wh := whs[0]
+
+	payload := json.Payload{"key1": "val1"}
+
+	cases := []struct {
+		desc     string
+		token    string
+		id       string
+		payload  json.Payload
+		messages []json.Message
+		err      error
+	}{
+		{
+			desc:    "test webhook with payload",
+			token:   token,
+			id:      wh.ID,
+			payload: payload,
+			err:     nil,
+		},
+		{
+			desc:  "test webhook without recent messages",
+			token: token,
+			id:    wh.ID,
+			err:   webhooks.ErrNoRecentMessages,
+		},
+		{
+			desc:  "test webhook with recent message",
+			token: token,
+			id:    wh.ID,
+			messages: []json.Message{{
+				ProfileConfig: json.Config{"webhook_id": wh.ID},
+				Payload:       payload,
+			}},
+			err: nil,
+		},
+		{
+			desc:    "test webhook with wrong credentials",
+			token:   wrongValue,
+			id:      wh.ID,
+			payload: payload,
+			err:     errors.ErrAuthentication,
+		},
+		{
+			desc:    "test non-existing webhook",
+			token:   token,
+			id:      wrongValue,
+			payload: payload,
+			err:     errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		if len(tc.messages) > 0 {
+			err := svc.Consume(json.Messages{Data: tc.messages})
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+		}
+
+		err := svc.TestWebhook(context.Background(), tc.token, tc.id, tc.payload)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestCreateSecrets(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc    string
+		secrets []webhooks.Secret
+		token   string
+		orgID   string
+		err     error
+	}{
+		{
+			desc:    "create secrets",
+			secrets: []webhooks.Secret{secret},
+			token:   token,
+			orgID:   orgID,
+			err:     nil,
+		},
+		{
+			desc:    "create existing secret",
+			secrets: []webhooks.Secret{secret},
+			token:   token,
+			orgID:   orgID,
+			err:     errors.ErrConflict,
+		},
+		{
+			desc:    "create secrets without admin role",
+			secrets: []webhooks.Secret{{Name: "viewer", Value: "value"}},
+			token:   viewerToken,
+			orgID:   orgID,
+			err:     errors.ErrAuthorization,
+		},
+		{
+			desc:    "create secrets with wrong credentials",
+			secrets: []webhooks.Secret{{Name: "wrong", Value: "value"}},
+			token:   wrongValue,
+			orgID:   orgID,
+			err:     errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		ss, err := svc.CreateSecrets(context.Background(), tc.token, tc.orgID, tc.secrets...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			for _, s := range ss {
+				assert.Equal(t, tc.orgID, s.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", tc.desc, tc.orgID, s.OrgID))
+			}
+		}
+	}
+}
+
+func TestUpdateSecret(t *testing.T) {
+	svc := newService()
+	ss, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	s := ss[0]
+
+	cases := []struct {
+		desc   string
+		secret webhooks.Secret
+		token  string
+		value  string
+		err    error
+	}{
+		{
+			desc:   "update secret name",
+			secret: webhooks.Secret{ID: s.ID, Name: "renamed"},
+			token:  token,
+			value:  s.Value,
+			err:    nil,
+		},
+		{
+			desc:   "update secret value",
+			secret: webhooks.Secret{ID: s.ID, Name: "renamed", Value: "updated"},
+			token:  token,
+			value:  "updated",
+			err:    nil,
+		},
+		{
+			desc:   "update secret without admin role",
+			secret: webhooks.Secret{ID: s.ID, Name: "viewer", Value: "viewer"},
+			token:  viewerToken,
+			value:  "updated",
+			err:    errors.ErrAuthorization,
+		},
+		{
+			desc:   "update non-existing secret",
+			secret: webhooks.Secret{ID: wrongValue, Name: "renamed"},
+			token:  token,
+			value:  "updated",
+			err:    errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateSecret(context.Background(), tc.token, tc.secret)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+
+		res, err := svc.ViewSecret(context.Background(), token, s.ID)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.value, res.Value, fmt.Sprintf("%s: expected value %s got %s\n", tc.desc, tc.value, res.Value))
+	}
+}
+
+func TestRemoveSecrets(t *testing.T) {
+	svc := newService()
+	ss, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	s := ss[0]
+
+	cases := []struct {
+		desc  string
+		id    string
+		token string
+		err   error
+	}{
+		{
+			desc:  "remove secret without admin role",
+			id:    s.ID,
+			token: viewerToken,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "remove existing secret",
+			id:    s.ID,
+			token: token,
+			err:   nil,
+		},
+		{
+			desc:  "remove non-existing secret",
+			id:    s.ID,
+			token: token,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveSecrets(context.Background(), tc.token, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestRemoveSecretsByOrg(t *testing.T) {
+	svc := newService()
+	_, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = svc.RemoveSecretsByOrg(context.Background(), orgID)
+	assert.Nil(t, err, fmt.Sprintf("remove secrets by org: unexpected error: %s", err))
+
+	page, err := svc.ListSecretsByOrg(context.Background(), token, orgID, webhooks.PageMetadata{})
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, fmt.Sprintf("remove secrets by org: expected no secrets got %d", page.Total))
+}
+
+func TestForwardWithSecrets(t *testing.T) {
+	svc := newService()
+	_, err := svc.CreateSecrets(context.Background(), token, orgID, secret)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	known := webhook
+	known.Name = "known-secret"
+	known.Url = "https://test.webhook.com/{{secrets.api_key}}"
+	known.Headers = map[string]string{"Authorization": "Bearer {{ secrets.api_key }}"}
+
+	unknown := webhook
+	unknown.Name = "unknown-secret"
+	unknown.Headers = map[string]string{"Authorization": "Bearer {{secrets.unknown}}"}
+
+	whs, err := svc.CreateWebhooks(context.Background(), token, known, unknown)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc string
+		id   string
+		err  error
+	}{
+		{
+			desc: "forward to webhook referencing existing secret",
+			id:   whs[0].ID,
+			err:  nil,
+		},
+		{
+			desc: "forward to webhook referencing unknown secret",
+			id:   whs[1].ID,
+			err:  webhooks.ErrUnknownSecret,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.TestWebhook(context.Background(), token, tc.id, json.Payload{"key": "value"})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestCreateEventWebhooks(t *testing.T) {
+	svc := newService()
+
+	cases := []struct {
+		desc          string
+		eventWebhooks []webhooks.EventWebhook
+		token         string
+		err           error
+	}{
+		{
+			desc:          "create event webhooks",
+			eventWebhooks: []webhooks.EventWebhook{eventWh},
+			token:         token,
+			err:           nil,
+		},
+		{
+			desc:          "create existing event webhook",
+			eventWebhooks: []webhooks.EventWebhook{eventWh},
+			token:         token,
+			err:           errors.ErrConflict,
+		},
+		{
+			desc:          "create event webhooks without admin role",
+			eventWebhooks: []webhooks.EventWebhook{{Name: "viewer", Url: eventWh.Url, EventTypes: eventWh.EventTypes}},
+			token:         viewerToken,
+			err:           errors.ErrAuthorization,
+		},
+		{
+			desc:          "create event webhooks with wrong credentials",
+			eventWebhooks: []webhooks.EventWebhook{{Name: "wrong", Url: eventWh.Url, EventTypes: eventWh.EventTypes}},
+			token:         wrongValue,
+			err:           errors.ErrAuthentication,
+		},
+	}
+
+	for _, tc := range cases {
+		ewhs, err := svc.CreateEventWebhooks(context.Background(), tc.token, orgID, tc.eventWebhooks...)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			for _, ewh := range ewhs {
+				assert.Equal(t, orgID, ewh.OrgID, fmt.Sprintf("%s: expected org %s got %s\n", tc.desc, orgID, ewh.OrgID))
+			}
+		}
+	}
+}
+
+func TestUpdateEventWebhook(t *testing.T) {
+	svc := newService()
+	ewhs, err := svc.CreateEventWebhooks(context.Background(), token, orgID, eventWh)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	ewh := ewhs[0]
+
+	updated := ewh
+	updated.Name = "updated"
+	updated.EventTypes = []string{webhooks.CertIssueEvent}
+
+	cases := []struct {
+		desc         string
+		eventWebhook webhooks.EventWebhook
+		token        string
+		err          error
+	}{
+		{
+			desc:         "update event webhook",
+			eventWebhook: updated,
+			token:        token,
+			err:          nil,
+		},
+		{
+			desc:         "update event webhook without admin role",
+			eventWebhook: updated,
+			token:        viewerToken,
+			err:          errors.ErrAuthorization,
+		},
+		{
+			desc:         "update non-existing event webhook",
+			eventWebhook: webhooks.EventWebhook{ID: wrongValue, Name: "updated"},
+			token:        token,
+			err:          errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.UpdateEventWebhook(context.Background(), tc.token, tc.eventWebhook)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			res, err := svc.ViewEventWebhook(context.Background(), tc.token, tc.eventWebhook.ID)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.eventWebhook, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.eventWebhook, res))
+		}
+	}
+}
+
+func TestRemoveEventWebhooks(t *testing.T) {
+	svc := newService()
+	ewhs, err := svc.CreateEventWebhooks(context.Background(), token, orgID, eventWh)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	id := ewhs[0].ID
+
+	cases := []struct {
+		desc  string
+		id    string
+		token string
+		err   error
+	}{
+		{
+			desc:  "remove event webhook without admin role",
+			id:    id,
+			token: viewerToken,
+			err:   errors.ErrAuthorization,
+		},
+		{
+			desc:  "remove event webhook",
+			id:    id,
+			token: token,
+			err:   nil,
+		},
+		{
+			desc:  "remove removed event webhook",
+			id:    id,
+			token: token,
+			err:   errors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.RemoveEventWebhooks(context.Background(), tc.token, tc.id)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	svc := newService()
+	_, err := svc.CreateEventWebhooks(context.Background(), token, orgID, eventWh)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	unknown := eventWh
+	unknown.Name = "unknown-secret"
+	unknown.EventTypes = []string{webhooks.CertIssueEvent}
+	unknown.Headers = map[string]string{"Authorization": "Bearer {{secrets.unknown}}"}
+	_, err = svc.CreateEventWebhooks(context.Background(), token, orgID, unknown)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		event webhooks.Event
+		err   error
+	}{
+		{
+			desc:  "handle event of org",
+			event: webhooks.Event{Type: webhooks.ThingCreateEvent, OrgID: orgID},
+			err:   nil,
+		},
+		{
+			desc:  "handle event of group",
+			event: webhooks.Event{Type: webhooks.ThingCreateEvent, GroupID: groupID},
+			err:   nil,
+		},
+		{
+			desc:  "handle event of thing",
+			event: webhooks.Event{Type: webhooks.AlarmEvent, ThingID: thingID},
+			err:   nil,
+		},
+		{
+			desc:  "handle event without subscribed event webhooks",
+			event: webhooks.Event{Type: webhooks.MemberAssignEvent, OrgID: orgID},
+			err:   nil,
+		},
+		{
+			desc:  "handle event of non-existing group",
+			event: webhooks.Event{Type: webhooks.ThingCreateEvent, GroupID: wrongValue},
+			err:   errors.ErrNotFound,
+		},
+		{
+			desc:  "handle event without org",
+			event: webhooks.Event{Type: webhooks.ThingCreateEvent},
+			err:   errors.ErrMalformedEntity,
+		},
+		{
+			desc:  "handle event of event webhook referencing unknown secret",
+			event: webhooks.Event{Type: webhooks.CertIssueEvent, OrgID: orgID},
+			err:   webhooks.ErrUnknownSecret,
+		},
+	}
+
+	for _, tc := range cases {
+		err := svc.HandleEvent(context.Background(), tc.event)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
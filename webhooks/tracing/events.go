@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/opentracing/opentracing-go"
+)
+
+var (
+	_ webhooks.EventWebhookRepository = (*eventWebhookRepositoryMiddleware)(nil)
+)
+
+type eventWebhookRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   webhooks.EventWebhookRepository
+}
+
+// EventWebhookRepositoryMiddleware tracks request and their latency, and adds
+// spans to context.
+func EventWebhookRepositoryMiddleware(tracer opentracing.Tracer, repo webhooks.EventWebhookRepository) webhooks.EventWebhookRepository {
+	return eventWebhookRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (erm eventWebhookRepositoryMiddleware) Save(ctx context.Context, ewhs ...webhooks.EventWebhook) ([]webhooks.EventWebhook, error) {
+	span := createSpan(ctx, erm.tracer, "save_event_webhooks")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.Save(ctx, ewhs...)
+}
+
+func (erm eventWebhookRepositoryMiddleware) RetrieveByOrgID(ctx context.Context, orgID string, pm webhooks.PageMetadata) (webhooks.EventWebhooksPage, error) {
+	span := createSpan(ctx, erm.tracer, "retrieve_event_webhooks_by_org_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.RetrieveByOrgID(ctx, orgID, pm)
+}
+
+func (erm eventWebhookRepositoryMiddleware) RetrieveByEvent(ctx context.Context, orgID, eventType string) ([]webhooks.EventWebhook, error) {
+	span := createSpan(ctx, erm.tracer, "retrieve_event_webhooks_by_event")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.RetrieveByEvent(ctx, orgID, eventType)
+}
+
+func (erm eventWebhookRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (webhooks.EventWebhook, error) {
+	span := createSpan(ctx, erm.tracer, "retrieve_event_webhook_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.RetrieveByID(ctx, id)
+}
+
+func (erm eventWebhookRepositoryMiddleware) Update(ctx context.Context, ewh webhooks.EventWebhook) error {
+	span := createSpan(ctx, erm.tracer, "update_event_webhook")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.Update(ctx, ewh)
+}
+
+func (erm eventWebhookRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, erm.tracer, "remove_event_webhooks")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.Remove(ctx, ids...)
+}
+
+func (erm eventWebhookRepositoryMiddleware) RemoveByOrgID(ctx context.Context, orgID string) error {
+	span := createSpan(ctx, erm.tracer, "remove_event_webhooks_by_org_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return erm.repo.RemoveByOrgID(ctx, orgID)
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/webhooks"
+	"github.com/opentracing/opentracing-go"
+)
+
+var (
+	_ webhooks.SecretRepository = (*secretRepositoryMiddleware)(nil)
+)
+
+type secretRepositoryMiddleware struct {
+	tracer opentracing.Tracer
+	repo   webhooks.SecretRepository
+}
+
+// SecretRepositoryMiddleware tracks request and their latency, and adds spans
+// to context.
+func SecretRepositoryMiddleware(tracer opentracing.Tracer, repo webhooks.SecretRepository) webhooks.SecretRepository {
+	return secretRepositoryMiddleware{
+		tracer: tracer,
+		repo:   repo,
+	}
+}
+
+func (srm secretRepositoryMiddleware) Save(ctx context.Context, ss ...webhooks.Secret) ([]webhooks.Secret, error) {
+	span := createSpan(ctx, srm.tracer, "save_secrets")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.Save(ctx, ss...)
+}
+
+func (srm secretRepositoryMiddleware) RetrieveByOrgID(ctx context.Context, orgID string, pm webhooks.PageMetadata) (webhooks.SecretsPage, error) {
+	span := createSpan(ctx, srm.tracer, "retrieve_secrets_by_org_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByOrgID(ctx, orgID, pm)
+}
+
+func (srm secretRepositoryMiddleware) RetrieveByID(ctx context.Context, id string) (webhooks.Secret, error) {
+	span := createSpan(ctx, srm.tracer, "retrieve_secret_by_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RetrieveByID(ctx, id)
+}
+
+func (srm secretRepositoryMiddleware) Update(ctx context.Context, s webhooks.Secret) error {
+	span := createSpan(ctx, srm.tracer, "update_secret")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.Update(ctx, s)
+}
+
+func (srm secretRepositoryMiddleware) Remove(ctx context.Context, ids ...string) error {
+	span := createSpan(ctx, srm.tracer, "remove_secrets")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.Remove(ctx, ids...)
+}
+
+func (srm secretRepositoryMiddleware) RemoveByOrgID(ctx context.Context, orgID string) error {
+	span := createSpan(ctx, srm.tracer, "remove_secrets_by_org_id")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return srm.repo.RemoveByOrgID(ctx, orgID)
+}
@@ -3,6 +3,7 @@ package tracing
 import (
 	"context"
 
+	"github.com/MainfluxLabs/mainflux/pkg/jaeger"
 	"github.com/MainfluxLabs/mainflux/webhooks"
 	"github.com/opentracing/opentracing-go"
 )
@@ -65,12 +66,21 @@ func (wrm webhookRepositoryMiddleware) Remove(ctx context.Context, ids ...string
 	return wrm.repo.Remove(ctx, ids...)
 }
 
+func (wrm webhookRepositoryMiddleware) RemoveByGroupID(ctx context.Context, groupID string) error {
+	span := createSpan(ctx, wrm.tracer, "remove_webhooks_by_group")
+	defer span.Finish()
+	ctx = opentracing.ContextWithSpan(ctx, span)
+
+	return wrm.repo.RemoveByGroupID(ctx, groupID)
+}
+
 func createSpan(ctx context.Context, tracer opentracing.Tracer, opName string) opentracing.Span {
 	if parentSpan := opentracing.SpanFromContext(ctx); parentSpan != nil {
 		return tracer.StartSpan(
 			opName,
 			opentracing.ChildOf(parentSpan.Context()),
+			jaeger.RequestIDTag(ctx),
 		)
 	}
-	return tracer.StartSpan(opName)
+	return tracer.StartSpan(opName, jaeger.RequestIDTag(ctx))
 }
@@ -2,6 +2,7 @@ package webhooks
 
 import (
 	"context"
+	"time"
 )
 
 type Metadata map[string]interface{}
@@ -13,6 +14,29 @@ type Webhook struct {
 	Url      string
 	Headers  map[string]string
 	Metadata Metadata
+	// ClientCert and ClientKey are the PEM encoded client certificate and
+	// key presented to the webhook URL. CACert contains the PEM encoded CA
+	// certificates trusted in addition to the system ones, and ProxyURL is
+	// the proxy which the requests are sent through.
+	ClientCert string
+	ClientKey  string
+	CACert     string
+	ProxyURL   string
+	// BatchSize and BatchInterval enable the batching mode, in which the
+	// messages are accumulated and sent as a JSON array once BatchSize
+	// messages are accumulated or BatchInterval elapses. MaxPayloadSize
+	// limits the size of the request body in bytes.
+	BatchSize      uint
+	BatchInterval  time.Duration
+	MaxPayloadSize uint
+	// Filter selects the messages forwarded to the webhook, the others
+	// are dropped by the forwarder.
+	Filter Filter
+}
+
+// Batching reports whether the messages are sent to the webhook in batches.
+func (wh Webhook) Batching() bool {
+	return wh.BatchSize > 0 || wh.BatchInterval > 0
 }
 
 type WebhooksPage struct {
@@ -39,4 +63,8 @@ type WebhookRepository interface {
 
 	// Remove removes the webhooks having the provided identifiers
 	Remove(ctx context.Context, ids ...string) error
+
+	// RemoveByGroupID removes the webhooks related to
+	// a certain group identified by a given ID.
+	RemoveByGroupID(ctx context.Context, groupID string) error
 }
@@ -8,10 +8,26 @@ package ws
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/MainfluxLabs/mainflux/auth"
+	mfauth "github.com/MainfluxLabs/mainflux/pkg/auth"
 	"github.com/MainfluxLabs/mainflux/pkg/errors"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+)
+
+const (
+	protocol = "websocket"
+
+	// delegatedCheckInterval is the interval of checking whether the
+	// delegated token of the subscription is revoked.
+	delegatedCheckInterval = time.Minute
 )
 
 var (
@@ -41,20 +57,58 @@ type Service interface {
 
 	// Unsubscribe method is used to stop observing resource.
 	Unsubscribe(ctx context.Context, thingKey, subtopic string) error
+
+	// SubscribeDelegated subscribes to the messages published by the thing
+	// which the delegated token is scoped to. The connection is closed once
+	// the token expires or is revoked.
+	SubscribeDelegated(ctx context.Context, token, subtopic string, client *Client) error
+
+	// UnsubscribeDelegated stops the subscription made using the delegated token.
+	UnsubscribeDelegated(ctx context.Context, token, subtopic string) error
+
+	// SubscribeShared subscribes to the messages published by the thing
+	// which is shared using the share key and the optional password. The
+	// connection is closed once the share expires or is removed.
+	SubscribeShared(ctx context.Context, shareKey, password, subtopic string, client *Client) error
+
+	// UnsubscribeShared stops the subscription of the client made using the share key.
+	UnsubscribeShared(ctx context.Context, shareKey, subtopic string, client *Client) error
+
+	// RemoveShare closes the connections of the clients subscribed
+	// using the removed share.
+	RemoveShare(ctx context.Context, shareID string) error
+
+	// Tap mirrors the messages published by the things of the group, on
+	// the subtopic and its children, to the client of the group admin
+	// until the TTL expires.
+	Tap(ctx context.Context, token, groupID, subtopic string, ttl time.Duration, client *Client) (Tap, error)
+
+	// Untap stops the tap of the group and subtopic opened using the token.
+	Untap(ctx context.Context, token, groupID, subtopic string) error
 }
 
 var _ Service = (*adapterService)(nil)
 
 type adapterService struct {
-	things protomfx.ThingsServiceClient
-	pubsub messaging.PubSub
+	things     protomfx.ThingsServiceClient
+	auth       protomfx.AuthServiceClient
+	pubsub     messaging.PubSub
+	network    mfauth.NetworkAuthorizer
+	idProvider uuid.IDProvider
+	// shared holds the clients subscribed using the share, by the share ID.
+	mu     sync.Mutex
+	shared map[string]map[*Client]struct{}
 }
 
 // New instantiates the WS adapter implementation
-func New(things protomfx.ThingsServiceClient, pubsub messaging.PubSub) Service {
+func New(things protomfx.ThingsServiceClient, auth protomfx.AuthServiceClient, pubsub messaging.PubSub, network mfauth.NetworkAuthorizer, idp uuid.IDProvider) Service {
 	return &adapterService{
-		things: things,
-		pubsub: pubsub,
+		things:     things,
+		auth:       auth,
+		pubsub:     pubsub,
+		network:    network,
+		idProvider: idp,
+		shared:     make(map[string]map[*Client]struct{}),
 	}
 }
 
@@ -68,6 +122,10 @@ func (svc *adapterService) Publish(ctx context.Context, thingKey string, msg pro
 		return ErrFailedMessagePublish
 	}
 
+	if err := messaging.ValidatePayload(pc.GetProfileConfig(), msg.Payload); err != nil {
+		return err
+	}
+
 	m := messaging.CreateMessage(pc, msg.Protocol, msg.Subtopic, &msg.Payload)
 
 	if err := svc.pubsub.Publish(m); err != nil {
@@ -105,6 +163,176 @@ func (svc *adapterService) Unsubscribe(ctx context.Context, thingKey, subtopic s
 	return svc.pubsub.Unsubscribe(pc.PublisherID, subtopic)
 }
 
+func (svc *adapterService) SubscribeDelegated(ctx context.Context, token, subtopic string, c *Client) error {
+	if token == "" {
+		return ErrUnauthorizedAccess
+	}
+
+	di, err := svc.auth.IdentifyDelegated(ctx, &protomfx.Token{Value: token})
+	if err != nil || di.GetAction() != auth.SubscribeAction {
+		return ErrUnauthorizedAccess
+	}
+
+	c.id = delegatedID(token)
+	c.thingID = di.GetThingID()
+
+	if err := svc.pubsub.Subscribe(c.id, subtopic, c); err != nil {
+		return err
+	}
+
+	go svc.watchDelegated(token, di.GetExpiresAt(), c)
+
+	return nil
+}
+
+// watchDelegated closes the connection of the client subscribed using the
+// delegated token once the token expires or is revoked, which makes the API
+// stop the subscription, as if the client closed the connection. The token
+// without the expiration time is only checked for the revocation.
+func (svc *adapterService) watchDelegated(token string, expiresAt int64, c *Client) {
+	var expiry <-chan time.Time
+	if expiresAt != 0 {
+		t := time.NewTimer(time.Until(time.Unix(0, expiresAt)))
+		defer t.Stop()
+		expiry = t.C
+	}
+
+	check := time.NewTicker(delegatedCheckInterval)
+	defer check.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-expiry:
+			c.Cancel()
+			return
+		case <-check.C:
+			di, err := svc.auth.IdentifyDelegated(context.Background(), &protomfx.Token{Value: token})
+			if err != nil || di.GetAction() != auth.SubscribeAction {
+				c.Cancel()
+				return
+			}
+		}
+	}
+}
+
+func (svc *adapterService) UnsubscribeDelegated(ctx context.Context, token, subtopic string) error {
+	if token == "" {
+		return ErrUnauthorizedAccess
+	}
+
+	// The token isn't identified, so that the subscription
+	// is stopped after the delegated key expires as well.
+	return svc.pubsub.Unsubscribe(delegatedID(token), subtopic)
+}
+
+func (svc *adapterService) SubscribeShared(ctx context.Context, shareKey, password, subtopic string, c *Client) error {
+	if shareKey == "" {
+		return ErrUnauthorizedAccess
+	}
+
+	pc, err := svc.things.GetPubConfByShare(ctx, &protomfx.PubConfByShareReq{Key: shareKey, Password: password})
+	if err != nil {
+		return ErrUnauthorizedAccess
+	}
+
+	connID, err := svc.idProvider.ID()
+	if err != nil {
+		return ErrFailedSubscription
+	}
+
+	c.id = sharedID(shareKey, connID)
+	c.thingID = pc.GetPublisherID()
+
+	if err := svc.pubsub.Subscribe(c.id, subtopic, c); err != nil {
+		return err
+	}
+
+	svc.addShared(pc.GetShareID(), c)
+	go svc.watchShared(pc.GetShareID(), pc.GetExpiresAt(), c)
+
+	return nil
+}
+
+// watchShared closes the connection of the client subscribed using the share
+// once the share expires, and stops tracking the client once the connection
+// is closed. The share without the expiration time is only closed once it is
+// removed.
+func (svc *adapterService) watchShared(shareID string, expiresAt int64, c *Client) {
+	defer svc.removeShared(shareID, c)
+
+	var expiry <-chan time.Time
+	if expiresAt != 0 {
+		t := time.NewTimer(time.Until(time.Unix(0, expiresAt)))
+		defer t.Stop()
+		expiry = t.C
+	}
+
+	select {
+	case <-c.done:
+	case <-expiry:
+		c.Cancel()
+	}
+}
+
+func (svc *adapterService) UnsubscribeShared(ctx context.Context, shareKey, subtopic string, c *Client) error {
+	if shareKey == "" || !strings.HasPrefix(c.id, delegatedID(shareKey)+"/") {
+		return ErrUnauthorizedAccess
+	}
+
+	return svc.pubsub.Unsubscribe(c.id, subtopic)
+}
+
+func (svc *adapterService) RemoveShare(ctx context.Context, shareID string) error {
+	svc.mu.Lock()
+	clients := make([]*Client, 0, len(svc.shared[shareID]))
+	for c := range svc.shared[shareID] {
+		clients = append(clients, c)
+	}
+	svc.mu.Unlock()
+
+	for _, c := range clients {
+		c.Cancel()
+	}
+
+	return nil
+}
+
+func (svc *adapterService) addShared(shareID string, c *Client) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	if _, ok := svc.shared[shareID]; !ok {
+		svc.shared[shareID] = make(map[*Client]struct{})
+	}
+	svc.shared[shareID][c] = struct{}{}
+}
+
+func (svc *adapterService) removeShared(shareID string, c *Client) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	delete(svc.shared[shareID], c)
+	if len(svc.shared[shareID]) == 0 {
+		delete(svc.shared, shareID)
+	}
+}
+
+// delegatedID returns the subscriber ID of the delegated token, so that the
+// connections using the same token replace each other, as the connections
+// using the same thing key do.
+func delegatedID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// sharedID returns the subscriber ID of the connection using the share key,
+// so that every viewer of the shared link gets the messages of the thing.
+func sharedID(shareKey, connID string) string {
+	return delegatedID(shareKey) + "/" + connID
+}
+
 func (svc *adapterService) authorize(ctx context.Context, thingKey string) (*protomfx.PubConfByKeyRes, error) {
 	ar := &protomfx.PubConfByKeyReq{
 		Key: thingKey,
@@ -114,5 +342,9 @@ func (svc *adapterService) authorize(ctx context.Context, thingKey string) (*pro
 		return nil, errors.Wrap(errors.ErrAuthorization, err)
 	}
 
+	if err := svc.network.Authorize(ctx, pc, protocol); err != nil {
+		return nil, errors.Wrap(errors.ErrAuthorization, err)
+	}
+
 	return pc, nil
 }
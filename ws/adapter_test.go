@@ -5,14 +5,26 @@ package ws_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/MainfluxLabs/mainflux/auth"
+	mfauth "github.com/MainfluxLabs/mainflux/pkg/auth"
 	thmock "github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/MainfluxLabs/mainflux/ws"
 	"github.com/MainfluxLabs/mainflux/ws/mocks"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -21,8 +33,25 @@ const (
 	thingKey  = "thing_key"
 	subTopic  = "subtopic"
 	protocol  = "ws"
+	token     = "delegated_token"
+	pubToken  = "publish_token"
+	shareKey  = "share_key"
+	password  = "password"
+	userToken = "user_token"
+	email     = "admin@example.com"
+	groupID   = "group_id"
+	otherID   = "2"
 )
 
+var delegated = map[string]*protomfx.DelegatedIdentity{
+	token:    {Id: id, ThingID: id, Action: auth.SubscribeAction},
+	pubToken: {Id: id, ThingID: id, Action: "publish"},
+}
+
+var users = map[string]*protomfx.UserIdentity{
+	userToken: {Id: id, Email: email},
+}
+
 var msg = protomfx.Message{
 	ProfileID: profileID,
 	Publisher: id,
@@ -33,7 +62,7 @@ var msg = protomfx.Message{
 
 func newService(tc protomfx.ThingsServiceClient) (ws.Service, mocks.MockPubSub) {
 	pubsub := mocks.NewPubSub()
-	return ws.New(tc, pubsub), pubsub
+	return ws.New(tc, mocks.NewAuthService(delegated, users), pubsub, mfauth.NewNetworkAuthorizer(nil), uuid.NewMock()), pubsub
 }
 
 func TestPublish(t *testing.T) {
@@ -82,10 +111,17 @@ func TestPublish(t *testing.T) {
 			msg:      protomfx.Message{},
 			err:      ws.ErrUnauthorizedAccess,
 		},
+		{
+			desc:     "publish a valid message from network which isn't allowed",
+			thingKey: "restricted",
+			msg:      msg,
+			err:      ws.ErrUnauthorizedAccess,
+		},
 	}
 
 	for _, tc := range cases {
-		err := svc.Publish(context.Background(), tc.thingKey, tc.msg)
+		ctx := mfauth.ContextWithClientAddr(context.Background(), "127.0.0.1")
+		err := svc.Publish(ctx, tc.thingKey, tc.msg)
 		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
@@ -194,3 +230,559 @@ func TestUnsubscribe(t *testing.T) {
 		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 	}
 }
+
+func TestSubscribeDelegated(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, nil, nil)
+	svc, pubsub := newService(thingsClient)
+
+	c := ws.NewClient(nil)
+
+	cases := []struct {
+		desc     string
+		token    string
+		subtopic string
+		fail     bool
+		err      error
+	}{
+		{
+			desc:     "subscribe with valid delegated token and subtopic",
+			token:    token,
+			subtopic: subTopic,
+			fail:     false,
+			err:      nil,
+		},
+		{
+			desc:     "subscribe with subscribe set to fail",
+			token:    token,
+			subtopic: subTopic,
+			fail:     true,
+			err:      ws.ErrFailedSubscription,
+		},
+		{
+			desc:     "subscribe with delegated token scoped to other action",
+			token:    pubToken,
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:     "subscribe with invalid delegated token",
+			token:    "invalid",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:     "subscribe with thing key as delegated token",
+			token:    thingKey,
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:     "subscribe with empty delegated token",
+			token:    "",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+	}
+
+	for _, tc := range cases {
+		pubsub.SetFail(tc.fail)
+		err := svc.SubscribeDelegated(context.Background(), tc.token, tc.subtopic, c)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestSubscribeDelegatedExpiry(t *testing.T) {
+	closed := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				close(closed)
+				return
+			}
+		}
+	}))
+	defer s.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(s.URL, "http", "ws", 1), nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	expiring := map[string]*protomfx.DelegatedIdentity{
+		token: {Id: id, ThingID: id, Action: auth.SubscribeAction, ExpiresAt: time.Now().Add(100 * time.Millisecond).UnixNano()},
+	}
+	svc := ws.New(thmock.NewThingsServiceClient(nil, nil, nil), mocks.NewAuthService(expiring, users), mocks.NewPubSub(), mfauth.NewNetworkAuthorizer(nil), uuid.NewMock())
+
+	err = svc.SubscribeDelegated(context.Background(), token, subTopic, ws.NewClient(conn))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "expected connection to be closed once the delegated token expires")
+	}
+}
+
+func TestUnsubscribeDelegated(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, nil, nil)
+	svc, pubsub := newService(thingsClient)
+
+	cases := []struct {
+		desc     string
+		token    string
+		subtopic string
+		fail     bool
+		err      error
+	}{
+		{
+			desc:     "unsubscribe with valid delegated token and subtopic",
+			token:    token,
+			subtopic: subTopic,
+			fail:     false,
+			err:      nil,
+		},
+		{
+			desc:     "unsubscribe with unsubscribe set to fail",
+			token:    token,
+			subtopic: subTopic,
+			fail:     true,
+			err:      ws.ErrFailedUnsubscribe,
+		},
+		{
+			desc:     "unsubscribe with empty delegated token",
+			token:    "",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+	}
+
+	for _, tc := range cases {
+		pubsub.SetFail(tc.fail)
+		err := svc.UnsubscribeDelegated(context.Background(), tc.token, tc.subtopic)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestSubscribeShared(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, map[string]string{shareKey: id}, nil)
+	svc, pubsub := newService(thingsClient)
+
+	c := ws.NewClient(nil)
+
+	cases := []struct {
+		desc     string
+		shareKey string
+		subtopic string
+		fail     bool
+		err      error
+	}{
+		{
+			desc:     "subscribe with valid share key and subtopic",
+			shareKey: shareKey,
+			subtopic: subTopic,
+			fail:     false,
+			err:      nil,
+		},
+		{
+			desc:     "subscribe with subscribe set to fail",
+			shareKey: shareKey,
+			subtopic: subTopic,
+			fail:     true,
+			err:      ws.ErrFailedSubscription,
+		},
+		{
+			desc:     "subscribe with invalid share key",
+			shareKey: "invalid",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:     "subscribe with empty share key",
+			shareKey: "",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+	}
+
+	for _, tc := range cases {
+		pubsub.SetFail(tc.fail)
+		err := svc.SubscribeShared(context.Background(), tc.shareKey, password, tc.subtopic, c)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestSubscribeSharedViewers(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, map[string]string{shareKey: id}, nil)
+	svc, pubsub := newService(thingsClient)
+
+	for i := 0; i < 2; i++ {
+		err := svc.SubscribeShared(context.Background(), shareKey, password, subTopic, ws.NewClient(nil))
+		require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	assert.Equal(t, 2, pubsub.Subscriptions(), "expected every viewer of the share to be subscribed")
+}
+
+func TestSubscribeSharedExpiry(t *testing.T) {
+	conn, closed := dialClosable(t)
+	defer conn.Close()
+
+	thingsClient := expiringThings{
+		ThingsServiceClient: thmock.NewThingsServiceClient(nil, map[string]string{shareKey: id}, nil),
+		expiresAt:           time.Now().Add(100 * time.Millisecond).UnixNano(),
+	}
+	svc, _ := newService(thingsClient)
+
+	err := svc.SubscribeShared(context.Background(), shareKey, password, subTopic, ws.NewClient(conn))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "expected connection to be closed once the share expires")
+	}
+}
+
+func TestRemoveShare(t *testing.T) {
+	conn, closed := dialClosable(t)
+	defer conn.Close()
+
+	thingsClient := thmock.NewThingsServiceClient(nil, map[string]string{shareKey: id}, nil)
+	svc, _ := newService(thingsClient)
+
+	err := svc.SubscribeShared(context.Background(), shareKey, password, subTopic, ws.NewClient(conn))
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = svc.RemoveShare(context.Background(), "other")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	select {
+	case <-closed:
+		assert.Fail(t, "expected connection not to be closed once the other share is removed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The mock share ID is the share key.
+	err = svc.RemoveShare(context.Background(), shareKey)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "expected connection to be closed once the share is removed")
+	}
+}
+
+func TestUnsubscribeShared(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, map[string]string{shareKey: id}, nil)
+	svc, pubsub := newService(thingsClient)
+
+	c := ws.NewClient(nil)
+	err := svc.SubscribeShared(context.Background(), shareKey, password, subTopic, c)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		shareKey string
+		subtopic string
+		fail     bool
+		err      error
+	}{
+		{
+			desc:     "unsubscribe with valid share key and subtopic",
+			shareKey: shareKey,
+			subtopic: subTopic,
+			fail:     false,
+			err:      nil,
+		},
+		{
+			desc:     "unsubscribe with unsubscribe set to fail",
+			shareKey: shareKey,
+			subtopic: subTopic,
+			fail:     true,
+			err:      ws.ErrFailedUnsubscribe,
+		},
+		{
+			desc:     "unsubscribe with other share key",
+			shareKey: "other",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:     "unsubscribe with empty share key",
+			shareKey: "",
+			subtopic: subTopic,
+			fail:     false,
+			err:      ws.ErrUnauthorizedAccess,
+		},
+	}
+
+	for _, tc := range cases {
+		pubsub.SetFail(tc.fail)
+		err := svc.UnsubscribeShared(context.Background(), tc.shareKey, tc.subtopic, c)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+// expiringThings returns the publish configurations of the shares
+// expiring at the given time.
+type expiringThings struct {
+	protomfx.ThingsServiceClient
+	expiresAt int64
+}
+
+func (et expiringThings) GetPubConfByShare(ctx context.Context, in *protomfx.PubConfByShareReq, opts ...grpc.CallOption) (*protomfx.PubConfByKeyRes, error) {
+	pc, err := et.ThingsServiceClient.GetPubConfByShare(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	pc.ExpiresAt = et.expiresAt
+
+	return pc, nil
+}
+
+// dialClosable returns the client connection to the test server, and the
+// channel closed once the connection is closed.
+func dialClosable(t *testing.T) (*websocket.Conn, <-chan struct{}) {
+	closed := make(chan struct{})
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				close(closed)
+				return
+			}
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(s.URL, "http", "ws", 1), nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	return conn, closed
+}
+
+func TestTap(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, nil, map[string]things.Group{userToken: {ID: groupID}})
+	svc, pubsub := newService(thingsClient)
+
+	c := ws.NewClient(nil)
+
+	cases := []struct {
+		desc    string
+		token   string
+		groupID string
+		ttl     time.Duration
+		fail    bool
+		err     error
+	}{
+		{
+			desc:    "tap group with default ttl",
+			token:   userToken,
+			groupID: groupID,
+			ttl:     0,
+			fail:    false,
+			err:     nil,
+		},
+		{
+			desc:    "tap group with max ttl",
+			token:   userToken,
+			groupID: groupID,
+			ttl:     ws.MaxTapTTL,
+			fail:    false,
+			err:     nil,
+		},
+		{
+			desc:    "tap group with too long ttl",
+			token:   userToken,
+			groupID: groupID,
+			ttl:     ws.MaxTapTTL + time.Second,
+			fail:    false,
+			err:     ws.ErrInvalidTapTTL,
+		},
+		{
+			desc:    "tap group with negative ttl",
+			token:   userToken,
+			groupID: groupID,
+			ttl:     -time.Second,
+			fail:    false,
+			err:     ws.ErrInvalidTapTTL,
+		},
+		{
+			desc:    "tap group with subscribe set to fail",
+			token:   userToken,
+			groupID: groupID,
+			ttl:     time.Minute,
+			fail:    true,
+			err:     ws.ErrFailedSubscription,
+		},
+		{
+			desc:    "tap group with invalid token",
+			token:   "invalid",
+			groupID: groupID,
+			ttl:     time.Minute,
+			fail:    false,
+			err:     ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:    "tap group with empty token",
+			token:   "",
+			groupID: groupID,
+			ttl:     time.Minute,
+			fail:    false,
+			err:     ws.ErrUnauthorizedAccess,
+		},
+		{
+			desc:    "tap group of other user",
+			token:   userToken,
+			groupID: "other_group",
+			ttl:     time.Minute,
+			fail:    false,
+			err:     ws.ErrUnauthorizedAccess,
+		},
+	}
+
+	for _, tc := range cases {
+		pubsub.SetFail(tc.fail)
+		tap, err := svc.Tap(context.Background(), tc.token, tc.groupID, subTopic, tc.ttl, c)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if err == nil {
+			assert.Equal(t, id, tap.UserID, fmt.Sprintf("%s: expected user %s got %s\n", tc.desc, id, tap.UserID))
+			assert.Equal(t, email, tap.Email, fmt.Sprintf("%s: expected email %s got %s\n", tc.desc, email, tap.Email))
+			assert.False(t, tap.ExpiresAt.IsZero(), fmt.Sprintf("%s: expected expiration time\n", tc.desc))
+		}
+	}
+}
+
+func TestUntap(t *testing.T) {
+	thingsClient := thmock.NewThingsServiceClient(nil, nil, map[string]things.Group{userToken: {ID: groupID}})
+	svc, pubsub := newService(thingsClient)
+
+	cases := []struct {
+		desc  string
+		token string
+		fail  bool
+		err   error
+	}{
+		{
+			desc:  "untap group",
+			token: userToken,
+			fail:  false,
+			err:   nil,
+		},
+		{
+			desc:  "untap group with unsubscribe set to fail",
+			token: userToken,
+			fail:  true,
+			err:   ws.ErrFailedUnsubscribe,
+		},
+		{
+			desc:  "untap group with empty token",
+			token: "",
+			fail:  false,
+			err:   ws.ErrUnauthorizedAccess,
+		},
+	}
+
+	for _, tc := range cases {
+		pubsub.SetFail(tc.fail)
+		err := svc.Untap(context.Background(), tc.token, groupID, subTopic)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+	}
+}
+
+func TestTapHandle(t *testing.T) {
+	received := make(chan []byte)
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			received <- message
+		}
+	}))
+	defer s.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.Replace(s.URL, "http", "ws", 1), nil)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	defer conn.Close()
+
+	thingsClient := thmock.NewThingsServiceClient(nil, map[string]string{id: groupID, otherID: "other_group"}, map[string]things.Group{userToken: {ID: groupID}})
+	svc, _ := newService(thingsClient)
+
+	c := ws.NewClient(conn)
+	_, err = svc.Tap(context.Background(), userToken, groupID, subTopic, time.Minute, c)
+	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc      string
+		publisher string
+		subtopic  string
+		mirrored  bool
+	}{
+		{
+			desc:      "handle message of tapped group on tapped subtopic",
+			publisher: id,
+			subtopic:  subTopic,
+			mirrored:  true,
+		},
+		{
+			desc:      "handle message of tapped group on child subtopic",
+			publisher: id,
+			subtopic:  subTopic + ".child",
+			mirrored:  true,
+		},
+		{
+			desc:      "handle message of tapped group on other subtopic",
+			publisher: id,
+			subtopic:  "other",
+			mirrored:  false,
+		},
+		{
+			desc:      "handle message of other group",
+			publisher: otherID,
+			subtopic:  subTopic,
+			mirrored:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		m := msg
+		m.Publisher = tc.publisher
+		m.Subtopic = tc.subtopic
+		err := c.Handle(m)
+		assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		select {
+		case data := <-received:
+			assert.True(t, tc.mirrored, fmt.Sprintf("%s: unexpected mirrored message %s", tc.desc, data))
+			var tm map[string]interface{}
+			err := json.Unmarshal(data, &tm)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.publisher, tm["publisher"], fmt.Sprintf("%s: expected publisher %s got %v", tc.desc, tc.publisher, tm["publisher"]))
+			assert.Equal(t, string(msg.Payload), tm["payload"], fmt.Sprintf("%s: expected payload %s got %v", tc.desc, msg.Payload, tm["payload"]))
+		case <-time.After(100 * time.Millisecond):
+			assert.False(t, tc.mirrored, fmt.Sprintf("%s: expected mirrored message", tc.desc))
+		}
+	}
+}
@@ -10,9 +10,14 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/MainfluxLabs/mainflux/auth"
 	log "github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
+	mfauth "github.com/MainfluxLabs/mainflux/pkg/auth"
 	thmocks "github.com/MainfluxLabs/mainflux/pkg/mocks"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/pkg/uuid"
+	"github.com/MainfluxLabs/mainflux/things"
 	"github.com/MainfluxLabs/mainflux/ws"
 	"github.com/MainfluxLabs/mainflux/ws/api"
 	"github.com/MainfluxLabs/mainflux/ws/mocks"
@@ -25,13 +30,23 @@ const (
 	id        = "1"
 	thingKey  = "c02ff576-ccd5-40f6-ba5f-c85377aad529"
 	protocol  = "ws"
+	token     = "delegated_token"
+	shareKey  = "share_key"
+	userToken = "user_token"
+	email     = "admin@example.com"
+	groupID   = "group_id"
 )
 
 var msg = []byte(`[{"n":"current","t":-1,"v":1.6}]`)
 
 func newService(tc protomfx.ThingsServiceClient) (ws.Service, mocks.MockPubSub) {
 	pubsub := mocks.NewPubSub()
-	return ws.New(tc, pubsub), pubsub
+	ac := mocks.NewAuthService(map[string]*protomfx.DelegatedIdentity{
+		token: {Id: id, ThingID: id, Action: auth.SubscribeAction},
+	}, map[string]*protomfx.UserIdentity{
+		userToken: {Id: id, Email: email},
+	})
+	return ws.New(tc, ac, pubsub, mfauth.NewNetworkAuthorizer(nil), uuid.NewMock()), pubsub
 }
 
 func newHTTPServer(svc ws.Service) *httptest.Server {
@@ -185,3 +200,161 @@ func TestHandshake(t *testing.T) {
 		}
 	}
 }
+
+func TestDelegatedHandshake(t *testing.T) {
+	thingsClient := thmocks.NewThingsServiceClient(nil, nil, nil)
+	svc, _ := newService(thingsClient)
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = protocol
+
+	cases := []struct {
+		desc   string
+		url    string
+		header http.Header
+		status int
+	}{
+		{
+			desc:   "connect with delegated token as query parameter",
+			url:    fmt.Sprintf("%s/messages/subtopic?token=%s", u, token),
+			header: http.Header{},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "connect with delegated token as bearer header",
+			url:    fmt.Sprintf("%s/messages/subtopic", u),
+			header: http.Header{"Authorization": []string{apiutil.BearerPrefix + token}},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "connect with empty delegated token as query parameter",
+			url:    fmt.Sprintf("%s/messages/subtopic?token=", u),
+			header: http.Header{},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		conn, res, err := websocket.DefaultDialer.Dial(tc.url, tc.header)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code '%d' got '%d'\n", tc.desc, tc.status, res.StatusCode))
+
+		if tc.status == http.StatusSwitchingProtocols {
+			assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error %s\n", tc.desc, err))
+
+			// The messages sent by the subscribe-only client are dropped.
+			err = conn.WriteMessage(websocket.TextMessage, msg)
+			assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error %s\n", tc.desc, err))
+			conn.Close()
+		}
+	}
+}
+
+func TestSharedHandshake(t *testing.T) {
+	thingsClient := thmocks.NewThingsServiceClient(nil, map[string]string{shareKey: id}, nil)
+	svc, _ := newService(thingsClient)
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = protocol
+
+	cases := []struct {
+		desc   string
+		url    string
+		header http.Header
+		status int
+	}{
+		{
+			desc:   "connect with share key",
+			url:    fmt.Sprintf("%s/messages/subtopic?share=%s", u, shareKey),
+			header: http.Header{},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "connect with share key and password",
+			url:    fmt.Sprintf("%s/messages/subtopic?share=%s", u, shareKey),
+			header: http.Header{"X-Share-Password": []string{"password"}},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "connect with empty share key",
+			url:    fmt.Sprintf("%s/messages/subtopic?share=", u),
+			header: http.Header{},
+			status: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range cases {
+		conn, res, err := websocket.DefaultDialer.Dial(tc.url, tc.header)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code '%d' got '%d'\n", tc.desc, tc.status, res.StatusCode))
+
+		if tc.status == http.StatusSwitchingProtocols {
+			assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error %s\n", tc.desc, err))
+
+			// The messages sent by the subscribe-only client are dropped.
+			err = conn.WriteMessage(websocket.TextMessage, msg)
+			assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error %s\n", tc.desc, err))
+			conn.Close()
+		}
+	}
+}
+
+func TestTapHandshake(t *testing.T) {
+	thingsClient := thmocks.NewThingsServiceClient(nil, nil, map[string]things.Group{userToken: {ID: groupID}})
+	svc, _ := newService(thingsClient)
+	ts := newHTTPServer(svc)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = protocol
+
+	cases := []struct {
+		desc   string
+		url    string
+		header http.Header
+		status int
+	}{
+		{
+			desc:   "tap group",
+			url:    fmt.Sprintf("%s/groups/%s/tap?token=%s", u, groupID, userToken),
+			header: http.Header{},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "tap group with token in header",
+			url:    fmt.Sprintf("%s/groups/%s/tap", u, groupID),
+			header: http.Header{"Authorization": []string{apiutil.BearerPrefix + userToken}},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "tap group with subtopic and ttl",
+			url:    fmt.Sprintf("%s/groups/%s/tap?token=%s&subtopic=subtopic&ttl=15m", u, groupID, userToken),
+			header: http.Header{},
+			status: http.StatusSwitchingProtocols,
+		},
+		{
+			desc:   "tap group without token",
+			url:    fmt.Sprintf("%s/groups/%s/tap", u, groupID),
+			header: http.Header{},
+			status: http.StatusForbidden,
+		},
+		{
+			desc:   "tap group with invalid ttl",
+			url:    fmt.Sprintf("%s/groups/%s/tap?token=%s&ttl=invalid", u, groupID, userToken),
+			header: http.Header{},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		conn, res, err := websocket.DefaultDialer.Dial(tc.url, tc.header)
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code '%d' got '%d'\n", tc.desc, tc.status, res.StatusCode))
+
+		if tc.status == http.StatusSwitchingProtocols {
+			assert.Nil(t, err, fmt.Sprintf("%s: got unexpected error %s\n", tc.desc, err))
+			conn.Close()
+		}
+	}
+}
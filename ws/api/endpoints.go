@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/MainfluxLabs/mainflux/pkg/apiutil"
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	"github.com/MainfluxLabs/mainflux/ws"
 	"github.com/go-zoo/bone"
 	"github.com/gorilla/websocket"
@@ -32,7 +34,7 @@ func handshake(svc ws.Service) http.HandlerFunc {
 		req.conn = conn
 		client := ws.NewClient(conn)
 
-		if err := svc.Subscribe(context.Background(), req.thingKey, req.subtopic, client); err != nil {
+		if err := subscribe(svc, req, client); err != nil {
 			req.conn.Close()
 			return
 		}
@@ -41,24 +43,95 @@ func handshake(svc ws.Service) http.HandlerFunc {
 		msgs := make(chan []byte)
 
 		// Listen for messages and publish them to broker
-		go process(svc, req, msgs)
+		go process(svc, req, client, msgs)
 		go listen(conn, msgs)
 	}
 }
 
+// tapHandshake upgrades the connection of the group admin to the read-only
+// stream of the group messages, which is closed once the tap expires.
+func tapHandshake(svc ws.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, err := decodeTapRequest(r)
+		if err != nil {
+			encodeError(w, err)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("Failed to upgrade connection to websocket: %s", err.Error()))
+			return
+		}
+		client := ws.NewClient(conn)
+
+		if _, err := svc.Tap(context.Background(), req.token, req.groupID, req.subtopic, req.ttl, client); err != nil {
+			// The connection is already upgraded, so the
+			// error is reported using the close message.
+			msg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error())
+			conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+			conn.Close()
+			return
+		}
+
+		msgs := make(chan []byte)
+		go listen(conn, msgs)
+		go func() {
+			// The tap is read-only, so the messages sent by
+			// the client are dropped.
+			for range msgs {
+			}
+			svc.Untap(context.Background(), req.token, req.groupID, req.subtopic)
+		}()
+	}
+}
+
+func subscribe(svc ws.Service, req getConnByKey, client *ws.Client) error {
+	switch {
+	case req.token != "":
+		return svc.SubscribeDelegated(req.context(), req.token, req.subtopic, client)
+	case req.shareKey != "":
+		return svc.SubscribeShared(req.context(), req.shareKey, req.password, req.subtopic, client)
+	default:
+		return svc.Subscribe(req.context(), req.thingKey, req.subtopic, client)
+	}
+}
+
+func unsubscribe(svc ws.Service, req getConnByKey, client *ws.Client) error {
+	switch {
+	case req.token != "":
+		return svc.UnsubscribeDelegated(req.context(), req.token, req.subtopic)
+	case req.shareKey != "":
+		return svc.UnsubscribeShared(req.context(), req.shareKey, req.subtopic, client)
+	default:
+		return svc.Unsubscribe(req.context(), req.thingKey, req.subtopic)
+	}
+}
+
+// decodeRequest reads the delegated token from the Bearer authorization
+// header or the token query parameter, the share key from the share query
+// parameter and its password from the share password header, and the thing
+// key from the authorization header or query parameter.
 func decodeRequest(r *http.Request) (getConnByKey, error) {
+	req := getConnByKey{}
+
 	authKey := r.Header.Get("Authorization")
-	if authKey == "" {
-		authKeys := bone.GetQuery(r, "authorization")
-		if len(authKeys) == 0 {
-			logger.Debug("Missing authorization key.")
-			return getConnByKey{}, errUnauthorizedAccess
-		}
-		authKey = authKeys[0]
+	switch {
+	case strings.HasPrefix(authKey, apiutil.BearerPrefix):
+		req.token = strings.TrimPrefix(authKey, apiutil.BearerPrefix)
+	case authKey != "":
+		req.thingKey = authKey
+	case len(bone.GetQuery(r, "token")) > 0:
+		req.token = bone.GetQuery(r, "token")[0]
+	case len(bone.GetQuery(r, "share")) > 0:
+		req.shareKey = bone.GetQuery(r, "share")[0]
+		req.password = r.Header.Get(sharePasswordHeader)
+	case len(bone.GetQuery(r, "authorization")) > 0:
+		req.thingKey = bone.GetQuery(r, "authorization")[0]
 	}
 
-	req := getConnByKey{
-		thingKey: authKey,
+	if req.thingKey == "" && req.token == "" && req.shareKey == "" {
+		logger.Debug("Missing authorization key.")
+		return getConnByKey{}, errUnauthorizedAccess
 	}
 
 	subtopic, err := messaging.ExtractSubtopic(r.RequestURI)
@@ -73,6 +146,45 @@ func decodeRequest(r *http.Request) (getConnByKey, error) {
 	}
 
 	req.subtopic = subject
+	req.addr = servershttp.ClientIP(r)
+
+	return req, nil
+}
+
+// decodeTapRequest reads the user token from the Bearer authorization
+// header or the token query parameter, and the optional subtopic and TTL
+// from the subtopic and ttl query parameters.
+func decodeTapRequest(r *http.Request) (tapReq, error) {
+	req := tapReq{groupID: bone.GetValue(r, "id")}
+
+	authKey := r.Header.Get("Authorization")
+	switch {
+	case strings.HasPrefix(authKey, apiutil.BearerPrefix):
+		req.token = strings.TrimPrefix(authKey, apiutil.BearerPrefix)
+	case len(bone.GetQuery(r, "token")) > 0:
+		req.token = bone.GetQuery(r, "token")[0]
+	}
+
+	if req.token == "" {
+		logger.Debug("Missing authorization token.")
+		return tapReq{}, errUnauthorizedAccess
+	}
+
+	if len(bone.GetQuery(r, "subtopic")) > 0 {
+		subject, err := messaging.CreateSubject(bone.GetQuery(r, "subtopic")[0])
+		if err != nil {
+			return tapReq{}, err
+		}
+		req.subtopic = subject
+	}
+
+	if len(bone.GetQuery(r, "ttl")) > 0 {
+		ttl, err := time.ParseDuration(bone.GetQuery(r, "ttl")[0])
+		if err != nil {
+			return tapReq{}, ws.ErrInvalidTapTTL
+		}
+		req.ttl = ttl
+	}
 
 	return req, nil
 }
@@ -98,17 +210,23 @@ func listen(conn *websocket.Conn, msgs chan<- []byte) {
 	}
 }
 
-func process(svc ws.Service, req getConnByKey, msgs <-chan []byte) {
+func process(svc ws.Service, req getConnByKey, client *ws.Client, msgs <-chan []byte) {
 	for msg := range msgs {
+		// The connections using the delegated token or the share
+		// key are subscribe-only, so their messages are dropped.
+		if req.subscribeOnly() {
+			continue
+		}
+
 		m := protomfx.Message{
 			Subtopic: req.subtopic,
 			Protocol: "websocket",
 			Payload:  msg,
 			Created:  time.Now().UnixNano(),
 		}
-		svc.Publish(context.Background(), req.thingKey, m)
+		svc.Publish(req.context(), req.thingKey, m)
 	}
-	if err := svc.Unsubscribe(context.Background(), req.thingKey, req.subtopic); err != nil {
+	if err := unsubscribe(svc, req, client); err != nil {
 		req.conn.Close()
 	}
 }
@@ -121,7 +239,7 @@ func encodeError(w http.ResponseWriter, err error) {
 		statusCode = http.StatusBadRequest
 	case errUnauthorizedAccess:
 		statusCode = http.StatusForbidden
-	case messaging.ErrMalformedSubtopic, apiutil.ErrMalformedEntity:
+	case messaging.ErrMalformedSubtopic, apiutil.ErrMalformedEntity, ws.ErrInvalidTapTTL:
 		statusCode = http.StatusBadRequest
 	default:
 		statusCode = http.StatusNotFound
@@ -35,10 +35,10 @@ func (lm *loggingMiddleware) Publish(ctx context.Context, thingKey string, msg p
 		}
 		message := fmt.Sprintf("Method publish to %s took %s to complete", destProfile, time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Publish(ctx, thingKey, msg)
@@ -48,10 +48,10 @@ func (lm *loggingMiddleware) Subscribe(ctx context.Context, thingKey, subtopic s
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method subscribe took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Subscribe(ctx, thingKey, subtopic, c)
@@ -61,11 +61,102 @@ func (lm *loggingMiddleware) Unsubscribe(ctx context.Context, thingKey, subtopic
 	defer func(begin time.Time) {
 		message := fmt.Sprintf("Method unsubscribe took %s to complete", time.Since(begin))
 		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s", message, err))
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
 			return
 		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
 	}(time.Now())
 
 	return lm.svc.Unsubscribe(ctx, thingKey, subtopic)
 }
+
+func (lm *loggingMiddleware) SubscribeDelegated(ctx context.Context, token, subtopic string, c *ws.Client) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method subscribe_delegated took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.SubscribeDelegated(ctx, token, subtopic, c)
+}
+
+func (lm *loggingMiddleware) UnsubscribeDelegated(ctx context.Context, token, subtopic string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method unsubscribe_delegated took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UnsubscribeDelegated(ctx, token, subtopic)
+}
+
+func (lm *loggingMiddleware) SubscribeShared(ctx context.Context, shareKey, password, subtopic string, c *ws.Client) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method subscribe_shared took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.SubscribeShared(ctx, shareKey, password, subtopic, c)
+}
+
+func (lm *loggingMiddleware) UnsubscribeShared(ctx context.Context, shareKey, subtopic string, c *ws.Client) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method unsubscribe_shared took %s to complete", time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.UnsubscribeShared(ctx, shareKey, subtopic, c)
+}
+
+func (lm *loggingMiddleware) RemoveShare(ctx context.Context, shareID string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_share for share %s took %s to complete", shareID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.RemoveShare(ctx, shareID)
+}
+
+func (lm *loggingMiddleware) Tap(ctx context.Context, token, groupID, subtopic string, ttl time.Duration, c *ws.Client) (t ws.Tap, err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method tap of group %s and subtopic %s took %s to complete", groupID, subtopic, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors. Tap %s opened by user %s (%s) until %s.", message, t.ID, t.UserID, t.Email, t.ExpiresAt.Format(time.RFC3339)))
+	}(time.Now())
+
+	return lm.svc.Tap(ctx, token, groupID, subtopic, ttl, c)
+}
+
+func (lm *loggingMiddleware) Untap(ctx context.Context, token, groupID, subtopic string) (err error) {
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method untap of group %s took %s to complete", groupID, time.Since(begin))
+		if err != nil {
+			lm.logger.WithContext(ctx).Warn(fmt.Sprintf("%s with error: %s", message, err))
+			return
+		}
+		lm.logger.WithContext(ctx).Info(fmt.Sprintf("%s without errors.", message))
+	}(time.Now())
+
+	return lm.svc.Untap(ctx, token, groupID, subtopic)
+}
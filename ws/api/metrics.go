@@ -57,3 +57,66 @@ func (mm *metricsMiddleware) Unsubscribe(ctx context.Context, thingKey, subtopic
 
 	return mm.svc.Unsubscribe(ctx, thingKey, subtopic)
 }
+
+func (mm *metricsMiddleware) SubscribeDelegated(ctx context.Context, token, subtopic string, c *ws.Client) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "subscribe_delegated").Add(1)
+		mm.latency.With("method", "subscribe_delegated").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.SubscribeDelegated(ctx, token, subtopic, c)
+}
+
+func (mm *metricsMiddleware) UnsubscribeDelegated(ctx context.Context, token, subtopic string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "unsubscribe_delegated").Add(1)
+		mm.latency.With("method", "unsubscribe_delegated").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.UnsubscribeDelegated(ctx, token, subtopic)
+}
+
+func (mm *metricsMiddleware) SubscribeShared(ctx context.Context, shareKey, password, subtopic string, c *ws.Client) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "subscribe_shared").Add(1)
+		mm.latency.With("method", "subscribe_shared").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.SubscribeShared(ctx, shareKey, password, subtopic, c)
+}
+
+func (mm *metricsMiddleware) UnsubscribeShared(ctx context.Context, shareKey, subtopic string, c *ws.Client) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "unsubscribe_shared").Add(1)
+		mm.latency.With("method", "unsubscribe_shared").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.UnsubscribeShared(ctx, shareKey, subtopic, c)
+}
+
+func (mm *metricsMiddleware) RemoveShare(ctx context.Context, shareID string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_share").Add(1)
+		mm.latency.With("method", "remove_share").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.RemoveShare(ctx, shareID)
+}
+
+func (mm *metricsMiddleware) Tap(ctx context.Context, token, groupID, subtopic string, ttl time.Duration, c *ws.Client) (ws.Tap, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "tap").Add(1)
+		mm.latency.With("method", "tap").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.Tap(ctx, token, groupID, subtopic, ttl, c)
+}
+
+func (mm *metricsMiddleware) Untap(ctx context.Context, token, groupID, subtopic string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "untap").Add(1)
+		mm.latency.With("method", "untap").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.Untap(ctx, token, groupID, subtopic)
+}
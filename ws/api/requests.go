@@ -3,10 +3,42 @@
 
 package api
 
-import "github.com/gorilla/websocket"
+import (
+	"context"
+	"time"
 
+	"github.com/MainfluxLabs/mainflux/pkg/auth"
+	"github.com/gorilla/websocket"
+)
+
+// getConnByKey holds either the thing key, or the delegated token
+// or the share key and password of the subscribe-only connection.
 type getConnByKey struct {
 	thingKey string
+	token    string
+	shareKey string
+	password string
 	subtopic string
+	addr     string
 	conn     *websocket.Conn
 }
+
+// context returns the context carrying the client address, which the
+// network ACLs of the thing are enforced on.
+func (req getConnByKey) context() context.Context {
+	return auth.ContextWithClientAddr(context.Background(), req.addr)
+}
+
+// subscribeOnly reports whether the messages received from the client
+// are dropped instead of published.
+func (req getConnByKey) subscribeOnly() bool {
+	return req.token != "" || req.shareKey != ""
+}
+
+// tapReq holds the user token of the group admin tapping the group.
+type tapReq struct {
+	token    string
+	groupID  string
+	subtopic string
+	ttl      time.Duration
+}
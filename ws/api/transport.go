@@ -9,6 +9,7 @@ import (
 
 	"github.com/MainfluxLabs/mainflux"
 	log "github.com/MainfluxLabs/mainflux/logger"
+	servershttp "github.com/MainfluxLabs/mainflux/pkg/servers/http"
 	"github.com/MainfluxLabs/mainflux/ws"
 	"github.com/go-zoo/bone"
 	"github.com/gorilla/websocket"
@@ -18,6 +19,7 @@ import (
 const (
 	protocol            = "ws"
 	readwriteBufferSize = 1024
+	sharePasswordHeader = "X-Share-Password"
 )
 
 var (
@@ -39,10 +41,11 @@ func MakeHandler(svc ws.Service, l log.Logger) http.Handler {
 	logger = l
 
 	mux := bone.New()
-	mux.GetFunc("/profiles/:id/messages", handshake(svc))
-	mux.GetFunc("/profiles/:id/messages/*", handshake(svc))
-	mux.GetFunc("/messages", handshake(svc))
-	mux.GetFunc("/messages/*", handshake(svc))
+	mux.Get("/profiles/:id/messages", servershttp.WithoutTimeout(handshake(svc)))
+	mux.Get("/profiles/:id/messages/*", servershttp.WithoutTimeout(handshake(svc)))
+	mux.Get("/messages", servershttp.WithoutTimeout(handshake(svc)))
+	mux.Get("/messages/*", servershttp.WithoutTimeout(handshake(svc)))
+	mux.Get("/groups/:id/tap", servershttp.WithoutTimeout(tapHandshake(svc)))
 	mux.GetFunc("/version", mainflux.Health(protocol))
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -4,14 +4,21 @@
 package ws
 
 import (
+	"sync"
+
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
 	"github.com/gorilla/websocket"
 )
 
 // Client handles messaging and websocket connection
 type Client struct {
-	conn *websocket.Conn
-	id   string
+	conn    *websocket.Conn
+	id      string
+	thingID string
+	tap     *tapFilter
+	// done is closed once the client is cancelled.
+	done       chan struct{}
+	cancelOnce sync.Once
 }
 
 // NewClient returns a new Client object
@@ -19,11 +26,13 @@ func NewClient(c *websocket.Conn) *Client {
 	return &Client{
 		conn: c,
 		id:   "",
+		done: make(chan struct{}),
 	}
 }
 
 // Cancel handles the websocket connection after unsubscribing
 func (c *Client) Cancel() error {
+	c.cancelOnce.Do(func() { close(c.done) })
 	if c.conn == nil {
 		return nil
 	}
@@ -32,9 +41,18 @@ func (c *Client) Cancel() error {
 
 // Handle handles the sending and receiving of messages via the broker
 func (c *Client) Handle(msg protomfx.Message) error {
+	// The tap client receives the messages of the tapped group only.
+	if c.tap != nil {
+		return c.tap.handle(c.conn, msg)
+	}
 	// To prevent publisher from receiving its own published message
 	if msg.GetPublisher() == c.id {
 		return nil
 	}
+	// The client subscribed using the delegated token
+	// receives the messages of the single thing only.
+	if c.thingID != "" && msg.GetPublisher() != c.thingID {
+		return nil
+	}
 	return c.conn.WriteMessage(websocket.TextMessage, msg.Payload)
 }
@@ -0,0 +1,42 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"google.golang.org/grpc"
+)
+
+var _ protomfx.AuthServiceClient = (*authServiceMock)(nil)
+
+type authServiceMock struct {
+	protomfx.AuthServiceClient
+	delegated map[string]*protomfx.DelegatedIdentity
+	users     map[string]*protomfx.UserIdentity
+}
+
+// NewAuthService returns mock of the auth service, which identifies the
+// given delegated and user tokens. The other calls aren't used by the adapter.
+func NewAuthService(delegated map[string]*protomfx.DelegatedIdentity, users map[string]*protomfx.UserIdentity) protomfx.AuthServiceClient {
+	return &authServiceMock{delegated: delegated, users: users}
+}
+
+func (svc authServiceMock) Identify(_ context.Context, in *protomfx.Token, _ ...grpc.CallOption) (*protomfx.UserIdentity, error) {
+	if ui, ok := svc.users[in.GetValue()]; ok {
+		return ui, nil
+	}
+
+	return nil, errors.ErrAuthentication
+}
+
+func (svc authServiceMock) IdentifyDelegated(_ context.Context, in *protomfx.Token, _ ...grpc.CallOption) (*protomfx.DelegatedIdentity, error) {
+	if di, ok := svc.delegated[in.GetValue()]; ok {
+		return di, nil
+	}
+
+	return nil, errors.ErrAuthentication
+}
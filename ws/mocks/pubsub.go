@@ -6,6 +6,7 @@ package mocks
 import (
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"github.com/MainfluxLabs/mainflux/pkg/messaging"
 	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
@@ -21,17 +22,21 @@ type MockPubSub interface {
 	Unsubscribe(string, string) error
 	SetFail(bool)
 	SetConn(*websocket.Conn)
+	// Subscriptions returns the number of the distinct subscriber IDs.
+	Subscriptions() int
 	Close() error
 }
 
 type mockPubSub struct {
 	fail bool
 	conn *websocket.Conn
+	mu   sync.Mutex
+	ids  map[string]struct{}
 }
 
 // NewPubSub returns mock message publisher-subscriber
 func NewPubSub() MockPubSub {
-	return &mockPubSub{false, nil}
+	return &mockPubSub{ids: make(map[string]struct{})}
 }
 func (pubsub *mockPubSub) Publish(msg protomfx.Message) error {
 	if pubsub.conn != nil {
@@ -48,20 +53,32 @@ func (pubsub *mockPubSub) Publish(msg protomfx.Message) error {
 	return nil
 }
 
-func (pubsub *mockPubSub) Subscribe(string, string, messaging.MessageHandler) error {
+func (pubsub *mockPubSub) Subscribe(id string, _ string, _ messaging.MessageHandler) error {
 	if pubsub.fail {
 		return ws.ErrFailedSubscription
 	}
+	pubsub.mu.Lock()
+	defer pubsub.mu.Unlock()
+	pubsub.ids[id] = struct{}{}
 	return nil
 }
 
-func (pubsub *mockPubSub) Unsubscribe(string, string) error {
+func (pubsub *mockPubSub) Unsubscribe(id string, _ string) error {
 	if pubsub.fail {
 		return ws.ErrFailedUnsubscribe
 	}
+	pubsub.mu.Lock()
+	defer pubsub.mu.Unlock()
+	delete(pubsub.ids, id)
 	return nil
 }
 
+func (pubsub *mockPubSub) Subscriptions() int {
+	pubsub.mu.Lock()
+	defer pubsub.mu.Unlock()
+	return len(pubsub.ids)
+}
+
 func (pubsub *mockPubSub) SetFail(fail bool) {
 	pubsub.fail = fail
 }
@@ -0,0 +1,85 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/logger"
+	"github.com/MainfluxLabs/mainflux/ws"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	stream   = "mainflux.things"
+	readWait = time.Second
+
+	shareRemove = "share.remove"
+)
+
+// Subscriber represents event source for things events.
+type Subscriber interface {
+	// Subscribes to the things event stream until the context is canceled.
+	Subscribe(ctx context.Context) error
+}
+
+type eventStore struct {
+	svc    ws.Service
+	client *redis.Client
+	logger logger.Logger
+}
+
+// NewEventStore returns new event store instance. The stream is read without
+// a consumer group, so that every adapter instance closes the connections it
+// holds.
+func NewEventStore(svc ws.Service, client *redis.Client, log logger.Logger) Subscriber {
+	return eventStore{
+		svc:    svc,
+		client: client,
+		logger: log,
+	}
+}
+
+func (es eventStore) Subscribe(ctx context.Context) error {
+	// Only the connections opened after the start are held,
+	// so the events published before it are skipped.
+	lastID := "$"
+	for {
+		streams, err := es.client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{stream, lastID},
+			Count:   100,
+			Block:   readWait,
+		}).Result()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err == redis.Nil || (err == nil && len(streams) == 0) {
+			continue
+		}
+		if err != nil {
+			es.logger.Warn(fmt.Sprintf("Failed to read things events: %s", err))
+			time.Sleep(readWait)
+			continue
+		}
+
+		for _, msg := range streams[0].Messages {
+			lastID = msg.ID
+			es.handle(ctx, msg.Values)
+		}
+	}
+}
+
+// handle closes the connections subscribed using the removed share.
+func (es eventStore) handle(ctx context.Context, values map[string]interface{}) {
+	if values["operation"] != shareRemove {
+		return
+	}
+
+	id, _ := values["id"].(string)
+	if err := es.svc.RemoveShare(ctx, id); err != nil {
+		es.logger.Warn(fmt.Sprintf("Failed to handle %s event: %s", shareRemove, err))
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+)
+
+const (
+	tapPrefix = "tap."
+	tapOpen   = tapPrefix + "open"
+	tapClose  = tapPrefix + "close"
+)
+
+var (
+	_ events.Encoder = (*openTapEvent)(nil)
+	_ events.Encoder = (*closeTapEvent)(nil)
+)
+
+type openTapEvent struct {
+	id        string
+	groupID   string
+	subtopic  string
+	userID    string
+	email     string
+	expiresAt time.Time
+}
+
+func (ote openTapEvent) Encode() map[string]interface{} {
+	val := map[string]interface{}{
+		"id":         ote.id,
+		"group_id":   ote.groupID,
+		"user_id":    ote.userID,
+		"email":      ote.email,
+		"expires_at": ote.expiresAt.Unix(),
+		"operation":  tapOpen,
+	}
+
+	if ote.subtopic != "" {
+		val["subtopic"] = ote.subtopic
+	}
+
+	return val
+}
+
+type closeTapEvent struct {
+	groupID string
+}
+
+func (cte closeTapEvent) Encode() map[string]interface{} {
+	return map[string]interface{}{
+		"group_id":  cte.groupID,
+		"operation": tapClose,
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/events"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/ws"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	streamID  = "mainflux.ws"
+	streamLen = 1000
+)
+
+var _ ws.Service = (*eventStore)(nil)
+
+type eventStore struct {
+	svc    ws.Service
+	client *redis.Client
+}
+
+// NewEventStoreMiddleware returns wrapper around ws service that sends
+// the tap events to event store, so that the taps are audited.
+func NewEventStoreMiddleware(svc ws.Service, client *redis.Client) ws.Service {
+	return eventStore{
+		svc:    svc,
+		client: client,
+	}
+}
+
+func (es eventStore) Publish(ctx context.Context, thingKey string, msg protomfx.Message) error {
+	return es.svc.Publish(ctx, thingKey, msg)
+}
+
+func (es eventStore) Subscribe(ctx context.Context, thingKey, subtopic string, c *ws.Client) error {
+	return es.svc.Subscribe(ctx, thingKey, subtopic, c)
+}
+
+func (es eventStore) Unsubscribe(ctx context.Context, thingKey, subtopic string) error {
+	return es.svc.Unsubscribe(ctx, thingKey, subtopic)
+}
+
+func (es eventStore) SubscribeDelegated(ctx context.Context, token, subtopic string, c *ws.Client) error {
+	return es.svc.SubscribeDelegated(ctx, token, subtopic, c)
+}
+
+func (es eventStore) UnsubscribeDelegated(ctx context.Context, token, subtopic string) error {
+	return es.svc.UnsubscribeDelegated(ctx, token, subtopic)
+}
+
+func (es eventStore) SubscribeShared(ctx context.Context, shareKey, password, subtopic string, c *ws.Client) error {
+	return es.svc.SubscribeShared(ctx, shareKey, password, subtopic, c)
+}
+
+func (es eventStore) UnsubscribeShared(ctx context.Context, shareKey, subtopic string, c *ws.Client) error {
+	return es.svc.UnsubscribeShared(ctx, shareKey, subtopic, c)
+}
+
+func (es eventStore) RemoveShare(ctx context.Context, shareID string) error {
+	return es.svc.RemoveShare(ctx, shareID)
+}
+
+func (es eventStore) Tap(ctx context.Context, token, groupID, subtopic string, ttl time.Duration, c *ws.Client) (ws.Tap, error) {
+	t, err := es.svc.Tap(ctx, token, groupID, subtopic, ttl, c)
+	if err != nil {
+		return t, err
+	}
+
+	event := openTapEvent{
+		id:        t.ID,
+		groupID:   t.GroupID,
+		subtopic:  t.Subtopic,
+		userID:    t.UserID,
+		email:     t.Email,
+		expiresAt: t.ExpiresAt,
+	}
+	es.add(ctx, event)
+
+	return t, nil
+}
+
+func (es eventStore) Untap(ctx context.Context, token, groupID, subtopic string) error {
+	if err := es.svc.Untap(ctx, token, groupID, subtopic); err != nil {
+		return err
+	}
+
+	es.add(ctx, closeTapEvent{groupID: groupID})
+
+	return nil
+}
+
+func (es eventStore) add(ctx context.Context, e events.Encoder) {
+	record := &redis.XAddArgs{
+		Stream:       streamID,
+		MaxLenApprox: streamLen,
+		Values:       events.Encode(ctx, e),
+	}
+	es.client.XAdd(ctx, record).Err()
+}
@@ -0,0 +1,243 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MainfluxLabs/mainflux/pkg/errors"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging"
+	"github.com/MainfluxLabs/mainflux/pkg/messaging/brokers"
+	protomfx "github.com/MainfluxLabs/mainflux/pkg/proto"
+	"github.com/MainfluxLabs/mainflux/things"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// DefTapTTL is the duration of the tap whose TTL isn't provided.
+	DefTapTTL = 10 * time.Minute
+
+	// MaxTapTTL is the longest allowed duration of the tap.
+	MaxTapTTL = time.Hour
+
+	// maxTapGroups is the maximum number of the publisher groups cached
+	// by the tap.
+	maxTapGroups = 1000
+
+	tapGroupTimeout = time.Second
+	messagesSuffix  = "messages"
+)
+
+// ErrInvalidTapTTL indicates that the tap TTL is negative or too long.
+var ErrInvalidTapTTL = errors.New("invalid tap ttl")
+
+// tapSubjects returns the subjects of the messages mirrored by the tap. If
+// the subtopic is provided, the subjects are limited to the subtopic and its
+// child subtopics. The subjects don't identify the publishers, so the
+// messages of the other groups are filtered out by the tap itself.
+func tapSubjects(subtopic string) []string {
+	if subtopic == "" {
+		return []string{brokers.SubjectSenML, brokers.SubjectJSON}
+	}
+
+	var subjects []string
+	for _, format := range []string{messaging.SenMLFormat, messaging.JSONFormat} {
+		subject := fmt.Sprintf("%s.%s.%s", format, messagesSuffix, subtopic)
+		subjects = append(subjects, subject, subject+".>")
+	}
+
+	return subjects
+}
+
+// Tap represents the temporary mirroring of the messages published by the
+// things of the group to the debug stream of the user.
+type Tap struct {
+	ID        string
+	GroupID   string
+	Subtopic  string
+	UserID    string
+	Email     string
+	ExpiresAt time.Time
+}
+
+// tapMessage represents the mirrored message written to the tap stream.
+type tapMessage struct {
+	Publisher string `json:"publisher"`
+	Subtopic  string `json:"subtopic,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	Created   int64  `json:"created"`
+	Payload   string `json:"payload"`
+}
+
+// tapFilter mirrors the messages of the tapped group and subtopic. The
+// groups of the publishers are cached for the lifetime of the tap, up to
+// maxTapGroups publishers.
+type tapFilter struct {
+	things   protomfx.ThingsServiceClient
+	groupID  string
+	subtopic string
+	// mu guards the groups cache, and writeMu serializes the writes to the
+	// connection, since the tap subscribes to several subjects, which are
+	// handled concurrently.
+	mu      sync.Mutex
+	writeMu sync.Mutex
+	groups  map[string]string
+}
+
+func (tf *tapFilter) handle(conn *websocket.Conn, msg protomfx.Message) error {
+	if !tf.matches(msg) {
+		return nil
+	}
+
+	tf.writeMu.Lock()
+	defer tf.writeMu.Unlock()
+
+	return tf.write(conn, msg)
+}
+
+func (tf *tapFilter) matches(msg protomfx.Message) bool {
+	if tf.subtopic != "" && msg.GetSubtopic() != tf.subtopic && !strings.HasPrefix(msg.GetSubtopic(), tf.subtopic+".") {
+		return false
+	}
+
+	grID, err := tf.publisherGroup(msg.GetPublisher())
+	if err != nil {
+		return false
+	}
+
+	return grID == tf.groupID
+}
+
+// publisherGroup returns the group of the publisher, retrieving it from the
+// Things service outside of the lock if it isn't cached.
+func (tf *tapFilter) publisherGroup(publisher string) (string, error) {
+	tf.mu.Lock()
+	grID, ok := tf.groups[publisher]
+	tf.mu.Unlock()
+	if ok {
+		return grID, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tapGroupTimeout)
+	defer cancel()
+
+	res, err := tf.things.GetGroupIDByThingID(ctx, &protomfx.ThingID{Value: publisher})
+	if err != nil {
+		return "", err
+	}
+	grID = res.GetValue()
+
+	tf.mu.Lock()
+	defer tf.mu.Unlock()
+
+	// The arbitrary cached publisher is evicted once the cache is full.
+	if len(tf.groups) >= maxTapGroups {
+		for p := range tf.groups {
+			delete(tf.groups, p)
+			break
+		}
+	}
+	tf.groups[publisher] = grID
+
+	return grID, nil
+}
+
+func (tf *tapFilter) write(conn *websocket.Conn, msg protomfx.Message) error {
+	data, err := json.Marshal(tapMessage{
+		Publisher: msg.GetPublisher(),
+		Subtopic:  msg.GetSubtopic(),
+		Protocol:  msg.GetProtocol(),
+		Created:   msg.GetCreated(),
+		Payload:   string(msg.GetPayload()),
+	})
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (svc *adapterService) Tap(ctx context.Context, token, groupID, subtopic string, ttl time.Duration, c *Client) (Tap, error) {
+	if token == "" {
+		return Tap{}, ErrUnauthorizedAccess
+	}
+
+	switch {
+	case ttl == 0:
+		ttl = DefTapTTL
+	case ttl < 0 || ttl > MaxTapTTL:
+		return Tap{}, ErrInvalidTapTTL
+	}
+
+	user, err := svc.auth.Identify(ctx, &protomfx.Token{Value: token})
+	if err != nil {
+		return Tap{}, ErrUnauthorizedAccess
+	}
+
+	ar := &protomfx.AuthorizeReq{Token: token, Object: groupID, Subject: things.GroupSub, Action: things.Admin}
+	if _, err := svc.things.Authorize(ctx, ar); err != nil {
+		return Tap{}, ErrUnauthorizedAccess
+	}
+
+	t := Tap{
+		ID:        tapID(token, groupID),
+		GroupID:   groupID,
+		Subtopic:  subtopic,
+		UserID:    user.GetId(),
+		Email:     user.GetEmail(),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	c.id = t.ID
+	c.tap = &tapFilter{
+		things:   svc.things,
+		groupID:  groupID,
+		subtopic: subtopic,
+		groups:   make(map[string]string),
+	}
+
+	subjects := tapSubjects(subtopic)
+	for i, subject := range subjects {
+		if err := svc.pubsub.Subscribe(c.id, subject, c); err != nil {
+			for _, s := range subjects[:i] {
+				svc.pubsub.Unsubscribe(c.id, s)
+			}
+			return Tap{}, err
+		}
+	}
+
+	// Closing the connection once the tap expires makes the API
+	// stop the tap, as if the user closed the connection.
+	time.AfterFunc(ttl, func() { c.Cancel() })
+
+	return t, nil
+}
+
+func (svc *adapterService) Untap(ctx context.Context, token, groupID, subtopic string) error {
+	if token == "" {
+		return ErrUnauthorizedAccess
+	}
+
+	// The token isn't identified, so that the expired tap is stopped
+	// after the token expires as well.
+	id := tapID(token, groupID)
+	for _, subject := range tapSubjects(subtopic) {
+		if err := svc.pubsub.Unsubscribe(id, subject); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tapID returns the subscriber ID of the tap, so that the taps of the
+// same group opened using the same token replace each other.
+func tapID(token, groupID string) string {
+	return delegatedID(token + groupID)
+}